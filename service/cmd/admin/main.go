@@ -0,0 +1,173 @@
+// Command admin calls the server's /admin endpoints for operational tasks
+//
+// Usage:
+//
+//	ADMIN_TOKEN=... go run ./cmd/admin [-url http://localhost:8080] <command> [args]
+//
+// Commands:
+//
+//	health                              Database, pool and job queue details
+//	sync-stats                          Per-user calendar sync health
+//	stuck-jobs [-running-for] [-pending-for]
+//	                                    Sync jobs running or pending too long
+//	resync <calendar-id>                Clear the sync token and queue a full resync
+//	clear-sync-token <calendar-id>      Clear the sync token only
+//	backfill-aggregates [-days 365]     Compute daily project hours for all users
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func main() {
+	baseURL := flag.String("url", getEnv("TIMESHEET_URL", "http://localhost:8080"), "server base URL (TIMESHEET_URL)")
+	flag.Usage = usage
+	flag.Parse()
+
+	token := os.Getenv("ADMIN_TOKEN")
+	if token == "" {
+		fmt.Fprintln(os.Stderr, "ADMIN_TOKEN must be set")
+		os.Exit(2)
+	}
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	c := &client{
+		baseURL: strings.TrimSuffix(*baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}
+
+	cmd, args := flag.Arg(0), flag.Args()[1:]
+	var err error
+	switch cmd {
+	case "health":
+		err = c.do(http.MethodGet, "/admin/health")
+	case "sync-stats":
+		err = c.do(http.MethodGet, "/admin/sync-stats")
+	case "stuck-jobs":
+		fs := flag.NewFlagSet("stuck-jobs", flag.ExitOnError)
+		runningFor := fs.Duration("running-for", 30*time.Minute, "flag running jobs claimed longer ago than this")
+		pendingFor := fs.Duration("pending-for", 2*time.Hour, "flag pending jobs created longer ago than this")
+		fs.Parse(args)
+		q := url.Values{}
+		q.Set("running_for", runningFor.String())
+		q.Set("pending_for", pendingFor.String())
+		err = c.do(http.MethodGet, "/admin/sync-jobs/stuck?"+q.Encode())
+	case "resync":
+		var id string
+		if id, err = calendarArg(args); err == nil {
+			err = c.do(http.MethodPost, "/admin/calendars/"+id+"/resync")
+		}
+	case "clear-sync-token":
+		var id string
+		if id, err = calendarArg(args); err == nil {
+			err = c.do(http.MethodPost, "/admin/calendars/"+id+"/clear-sync-token")
+		}
+	case "backfill-aggregates":
+		fs := flag.NewFlagSet("backfill-aggregates", flag.ExitOnError)
+		days := fs.Int("days", 365, "number of days back to compute")
+		fs.Parse(args)
+		err = c.do(http.MethodPost, "/admin/aggregates/backfill?days="+strconv.Itoa(*days))
+	default:
+		err = fmt.Errorf("unknown command %q", cmd)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+type client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// do sends the request and prints the response body as indented JSON.
+// Non-2xx responses are returned as errors.
+func (c *client) do(method, path string) error {
+	req, err := http.NewRequest(method, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Admin-Token", c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Message != "" {
+			return fmt.Errorf("%s: %s", resp.Status, apiErr.Message)
+		}
+		return fmt.Errorf("%s", resp.Status)
+	}
+
+	if len(body) == 0 {
+		fmt.Println("OK")
+		return nil
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, body, "", "  "); err != nil {
+		os.Stdout.Write(body)
+		return nil
+	}
+	out.WriteByte('\n')
+	out.WriteTo(os.Stdout)
+	return nil
+}
+
+func calendarArg(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf("expected a calendar ID")
+	}
+	return url.PathEscape(args[0]), nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `Usage: admin [-url URL] <command> [args]
+
+Commands:
+  health                              Database, pool and job queue details
+  sync-stats                          Per-user calendar sync health
+  stuck-jobs [-running-for 30m] [-pending-for 2h]
+                                      Sync jobs running or pending too long
+  resync <calendar-id>                Clear the sync token and queue a full resync
+  clear-sync-token <calendar-id>      Clear the sync token only
+  backfill-aggregates [-days 365]     Compute daily project hours for all users
+
+ADMIN_TOKEN must be set to the server's admin token.
+`)
+	flag.PrintDefaults()
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// initialisms are written in upper case in Go identifiers
+var initialisms = map[string]bool{
+	"id": true, "ids": true, "url": true, "uri": true, "api": true, "json": true,
+}
+
+// generateHandlers emits a typed argument struct per tool, the ToolHandler
+// interface with one method per tool, and RegisterTools, which decodes raw
+// arguments and dispatches to the interface. A tool added to the spec adds
+// an interface method, so the build fails until it is implemented.
+func generateHandlers(tools []MCPTool) ([]byte, error) {
+	var sb strings.Builder
+
+	sb.WriteString(`// Code generated by mcp-codegen from api-spec.yaml. DO NOT EDIT.
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+`)
+
+	for _, t := range tools {
+		writeArgsStruct(&sb, t)
+	}
+
+	sb.WriteString(`// ToolHandler implements the MCP tools
+type ToolHandler interface {
+`)
+	for _, t := range tools {
+		name := goName(t.Name)
+		fmt.Fprintf(&sb, "\t// %s implements the %s tool\n", name, t.Name)
+		fmt.Fprintf(&sb, "\t%s(ctx context.Context, userID uuid.UUID, args %sArgs) (any, error)\n", name, name)
+	}
+	sb.WriteString(`}
+
+// ToolFunc runs a tool with the arguments of a tools/call request
+type ToolFunc func(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error)
+
+// RegisterTools returns the function for each tool name, which checks the
+// required arguments and decodes them into the tool's typed struct before
+// calling the handler
+func RegisterTools(h ToolHandler) map[string]ToolFunc {
+	return map[string]ToolFunc{
+`)
+	for _, t := range tools {
+		name := goName(t.Name)
+		required := requiredProps(t)
+		quoted := make([]string, len(required))
+		for i, r := range required {
+			quoted[i] = fmt.Sprintf("%q", r)
+		}
+		fmt.Fprintf(&sb, `		%q: func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args %sArgs
+			if err := decodeArgs(raw, &args%s); err != nil {
+				return nil, fmt.Errorf("invalid arguments for %s: %%w", err)
+			}
+			return h.%s(ctx, userID, args)
+		},
+`, t.Name, name, prefixComma(quoted), t.Name, name)
+	}
+	sb.WriteString(`	}
+}
+
+// decodeArgs checks that the required arguments are present and decodes
+// the arguments into v
+func decodeArgs(raw map[string]any, v any, required ...string) error {
+	for _, name := range required {
+		if val, ok := raw[name]; !ok || val == nil {
+			return fmt.Errorf("%s is required", name)
+		}
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+`)
+
+	return format.Source([]byte(sb.String()))
+}
+
+// writeArgsStruct emits the argument struct of a tool. Required arguments
+// are values and optional ones pointers, as in the REST types.
+func writeArgsStruct(sb *strings.Builder, t MCPTool) {
+	name := goName(t.Name)
+	props, _ := t.InputSchema["properties"].(map[string]interface{})
+	required := requiredProps(t)
+
+	names := make([]string, 0, len(props))
+	for p := range props {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(sb, "// %sArgs are the arguments of the %s tool\n", name, t.Name)
+	fmt.Fprintf(sb, "type %sArgs struct {\n", name)
+	for _, p := range names {
+		prop, _ := props[p].(map[string]interface{})
+		if desc, ok := prop["description"].(string); ok && desc != "" {
+			fmt.Fprintf(sb, "\t// %s %s\n", goName(p), strings.ReplaceAll(desc, "\n", " "))
+		}
+		typ := goType(prop)
+		if contains(required, p) {
+			fmt.Fprintf(sb, "\t%s %s `json:%q`\n", goName(p), typ, p)
+		} else {
+			if !strings.HasPrefix(typ, "[]") && !strings.HasPrefix(typ, "map[") && typ != "any" {
+				typ = "*" + typ
+			}
+			fmt.Fprintf(sb, "\t%s %s `json:%q`\n", goName(p), typ, p+",omitempty")
+		}
+	}
+	sb.WriteString("}\n\n")
+}
+
+// requiredProps returns a tool's required argument names
+func requiredProps(t MCPTool) []string {
+	switch req := t.InputSchema["required"].(type) {
+	case []string:
+		return req
+	case []interface{}:
+		out := make([]string, 0, len(req))
+		for _, r := range req {
+			if s, ok := r.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// goType maps a JSON schema property to a Go type
+func goType(prop map[string]interface{}) string {
+	switch prop["type"] {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "object":
+		return "map[string]any"
+	case "array":
+		if items, ok := prop["items"].(map[string]interface{}); ok {
+			return "[]" + goType(items)
+		}
+		return "[]any"
+	}
+	return "any"
+}
+
+// goName converts a snake_case name to an exported Go identifier
+func goName(s string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		if initialisms[part] {
+			sb.WriteString(strings.ToUpper(part))
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
+
+// prefixComma joins values with a leading comma, for appending arguments
+func prefixComma(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(values, ", ")
+}
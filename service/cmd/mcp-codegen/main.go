@@ -3,10 +3,17 @@
 // Usage:
 //
 //	go run ./cmd/mcp-codegen ../docs/v2/api-spec.yaml
+//
+// With -check it validates the x-mcp extensions and exits non-zero if the
+// generated files are out of date, without writing them:
+//
+//	go run ./cmd/mcp-codegen -check ../docs/v2/api-spec.yaml
 package main
 
 import (
+	"bytes"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -71,16 +78,23 @@ type CustomParam struct {
 }
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Fprintf(os.Stderr, "Usage: %s <openapi-spec.yaml>\n", os.Args[0])
+	check := flag.Bool("check", false, "verify the generated files are up to date instead of writing them")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-check] <openapi-spec.yaml> [output.go]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
 		os.Exit(1)
 	}
 
-	specPath := os.Args[1]
+	specPath := flag.Arg(0)
 	outputPath := "internal/mcp/tools.gen.go"
 
-	if len(os.Args) > 2 {
-		outputPath = os.Args[2]
+	if flag.NArg() > 1 {
+		outputPath = flag.Arg(1)
 	}
 
 	// Load OpenAPI spec
@@ -95,23 +109,30 @@ func main() {
 
 	// Extract x-mcp config from info
 	var mcpConfig MCPConfig
+	var problems []string
 	if ext, ok := doc.Info.Extensions["x-mcp"]; ok {
-		data, err := json.Marshal(ext)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error marshaling x-mcp config: %v\n", err)
-			os.Exit(1)
-		}
-		if err := json.Unmarshal(data, &mcpConfig); err != nil {
-			fmt.Fprintf(os.Stderr, "Error unmarshaling x-mcp config: %v\n", err)
-			os.Exit(1)
+		if err := decodeExtension(ext, &mcpConfig); err != nil {
+			problems = append(problems, fmt.Sprintf("info: malformed x-mcp: %v", err))
 		}
 	}
+	problems = append(problems, validateConfig(doc, mcpConfig)...)
 
 	// Extract tools from operations
-	operationTools := extractOperationTools(doc)
+	operationTools, opProblems := extractOperationTools(doc)
+	problems = append(problems, opProblems...)
 
 	// Merge all tools (MCP-only + operation-derived)
 	allTools := append(operationTools, mcpConfig.Tools...)
+	problems = append(problems, validateToolNames(allTools)...)
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		fmt.Fprintf(os.Stderr, "Invalid x-mcp extensions in %s (%d problems):\n", specPath, len(problems))
+		for _, p := range problems {
+			fmt.Fprintf(os.Stderr, "  - %s\n", p)
+		}
+		os.Exit(1)
+	}
 
 	// Sort tools by name for deterministic output
 	sort.Slice(allTools, func(i, j int) bool {
@@ -121,8 +142,32 @@ func main() {
 	// Generate code
 	code := generateCode(mcpConfig, allTools)
 
-	// Write output
+	// Typed arguments and dispatch go next to the definitions
 	outputDir := filepath.Dir(outputPath)
+	handlersPath := filepath.Join(outputDir, "handlers.gen.go")
+	handlersCode, err := generateHandlers(allTools)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating handlers: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *check {
+		stale := false
+		for path, want := range map[string][]byte{outputPath: []byte(code), handlersPath: handlersCode} {
+			if err := checkFile(path, want); err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				stale = true
+			}
+		}
+		if stale {
+			fmt.Fprintf(os.Stderr, "Run: go run ./cmd/mcp-codegen %s\n", specPath)
+			os.Exit(1)
+		}
+		fmt.Printf("%s and %s are up to date\n", outputPath, handlersPath)
+		return
+	}
+
+	// Write output
 	if err := os.MkdirAll(outputDir, 0755); err != nil {
 		fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
 		os.Exit(1)
@@ -133,11 +178,45 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := os.WriteFile(handlersPath, handlersCode, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing handlers file: %v\n", err)
+		os.Exit(1)
+	}
+
 	fmt.Printf("Generated %s with %d tools and %d resources\n", outputPath, len(allTools), len(mcpConfig.Resources))
+	fmt.Printf("Generated %s\n", handlersPath)
+}
+
+// checkFile reports whether the file at path differs from the generated code
+func checkFile(path string, want []byte) error {
+	got, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if !bytes.Equal(got, want) {
+		return fmt.Errorf("%s is out of date", path)
+	}
+	return nil
 }
 
-func extractOperationTools(doc *openapi3.T) []MCPTool {
+// decodeExtension decodes an x-mcp extension value into v, rejecting
+// unknown fields so misspelled keys are reported rather than ignored
+func decodeExtension(ext any, v any) error {
+	data, err := json.Marshal(ext)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// extractOperationTools builds the tools declared on operations. Problems
+// with the x-mcp extensions are returned rather than skipped, prefixed with
+// the operation they were found on.
+func extractOperationTools(doc *openapi3.T) ([]MCPTool, []string) {
 	var tools []MCPTool
+	var problems []string
 
 	for path, pathItem := range doc.Paths.Map() {
 		for method, op := range pathItem.Operations() {
@@ -150,12 +229,18 @@ func extractOperationTools(doc *openapi3.T) []MCPTool {
 				continue
 			}
 
+			where := method + " " + path
 			var mcpExt MCPOperationExt
-			data, err := json.Marshal(ext)
-			if err != nil {
+			if err := decodeExtension(ext, &mcpExt); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: malformed x-mcp: %v", where, err))
 				continue
 			}
-			if err := json.Unmarshal(data, &mcpExt); err != nil {
+
+			opProblems := validateOperationExt(doc, op, mcpExt)
+			for _, p := range opProblems {
+				problems = append(problems, where+": "+p)
+			}
+			if len(opProblems) > 0 {
 				continue
 			}
 
@@ -173,7 +258,7 @@ func extractOperationTools(doc *openapi3.T) []MCPTool {
 		}
 	}
 
-	return tools
+	return tools, problems
 }
 
 func buildTool(name, description string, customHandler bool, path, method string, op *openapi3.Operation, customParams []CustomParam, pathParamsAsInput bool) MCPTool {
@@ -227,21 +312,19 @@ func buildInputSchema(op *openapi3.Operation, customParams []CustomParam, pathPa
 	// Add request body properties
 	if op.RequestBody != nil && op.RequestBody.Value != nil {
 		if content, ok := op.RequestBody.Value.Content["application/json"]; ok && content.Schema != nil {
-			bodySchema := content.Schema.Value
-			if bodySchema != nil && bodySchema.Type != nil && bodySchema.Type.Is("object") {
-				for propName, propRef := range bodySchema.Properties {
-					if propRef == nil || propRef.Value == nil {
-						continue
-					}
-					propSchema := schemaToMap(propRef)
-					snakeName := toSnakeCase(propName)
-					properties[snakeName] = propSchema
+			bodyProps, bodyRequired := objectProperties(content.Schema.Value)
+			for propName, propRef := range bodyProps {
+				if propRef == nil || propRef.Value == nil {
+					continue
 				}
-				for _, req := range bodySchema.Required {
-					snakeName := toSnakeCase(req)
-					if !contains(required, snakeName) {
-						required = append(required, snakeName)
-					}
+				propSchema := schemaToMap(propRef)
+				snakeName := toSnakeCase(propName)
+				properties[snakeName] = propSchema
+			}
+			for _, req := range bodyRequired {
+				snakeName := toSnakeCase(req)
+				if !contains(required, snakeName) {
+					required = append(required, snakeName)
 				}
 			}
 		}
@@ -275,7 +358,44 @@ func buildInputSchema(op *openapi3.Operation, customParams []CustomParam, pathPa
 	return result
 }
 
+// objectProperties returns the properties and required names of an object
+// schema, including those its allOf members contribute
+func objectProperties(schema *openapi3.Schema) (openapi3.Schemas, []string) {
+	if schema == nil {
+		return nil, nil
+	}
+	props := make(openapi3.Schemas)
+	var required []string
+	if schema.Type == nil || schema.Type.Is("object") {
+		for name, prop := range schema.Properties {
+			props[name] = prop
+		}
+		required = append(required, schema.Required...)
+	}
+	for _, member := range schema.AllOf {
+		if member == nil {
+			continue
+		}
+		memberProps, memberRequired := objectProperties(member.Value)
+		for name, prop := range memberProps {
+			props[name] = prop
+		}
+		required = append(required, memberRequired...)
+	}
+	return props, required
+}
+
+// schemaToMap converts an OpenAPI schema to a JSON schema map, following
+// $refs (resolved when the spec was loaded) into nested objects, array
+// items and oneOf/anyOf/allOf alternatives
 func schemaToMap(schemaRef *openapi3.SchemaRef) map[string]interface{} {
+	return convertSchema(schemaRef, make(map[*openapi3.Schema]bool))
+}
+
+// convertSchema does the work of schemaToMap. visiting holds the schemas
+// being converted further up, so recursive schemas end in a plain object
+// instead of looping.
+func convertSchema(schemaRef *openapi3.SchemaRef, visiting map[*openapi3.Schema]bool) map[string]interface{} {
 	if schemaRef == nil || schemaRef.Value == nil {
 		return map[string]interface{}{"type": "string"}
 	}
@@ -283,6 +403,16 @@ func schemaToMap(schemaRef *openapi3.SchemaRef) map[string]interface{} {
 	schema := schemaRef.Value
 	result := make(map[string]interface{})
 
+	if visiting[schema] {
+		result["type"] = "object"
+		if schema.Description != "" {
+			result["description"] = schema.Description
+		}
+		return result
+	}
+	visiting[schema] = true
+	defer delete(visiting, schema)
+
 	// Handle type
 	if schema.Type != nil {
 		types := schema.Type.Slice()
@@ -318,6 +448,44 @@ func schemaToMap(schemaRef *openapi3.SchemaRef) map[string]interface{} {
 		}
 	}
 
+	// Handle nested object properties
+	if len(schema.Properties) > 0 {
+		props := make(map[string]interface{}, len(schema.Properties))
+		for name, prop := range schema.Properties {
+			props[name] = convertSchema(prop, visiting)
+		}
+		result["properties"] = props
+		if len(schema.Required) > 0 {
+			required := append([]string(nil), schema.Required...)
+			sort.Strings(required)
+			result["required"] = required
+		}
+	}
+	if ap := schema.AdditionalProperties.Schema; ap != nil {
+		result["additionalProperties"] = convertSchema(ap, visiting)
+	}
+
+	// Handle array items
+	if schema.Items != nil {
+		result["items"] = convertSchema(schema.Items, visiting)
+	}
+
+	// Handle composition
+	for key, refs := range map[string]openapi3.SchemaRefs{
+		"oneOf": schema.OneOf,
+		"anyOf": schema.AnyOf,
+		"allOf": schema.AllOf,
+	} {
+		if len(refs) == 0 {
+			continue
+		}
+		alternatives := make([]interface{}, len(refs))
+		for i, ref := range refs {
+			alternatives[i] = convertSchema(ref, visiting)
+		}
+		result[key] = alternatives
+	}
+
 	return result
 }
 
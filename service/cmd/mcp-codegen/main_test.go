@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const nestedSpec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: "1"
+paths:
+  /api/invoices:
+    post:
+      x-mcp:
+        tool: create_invoice
+        description: Create an invoice
+      requestBody:
+        content:
+          application/json:
+            schema:
+              allOf:
+                - $ref: '#/components/schemas/InvoiceBase'
+                - type: object
+                  required: [lines]
+                  properties:
+                    lines:
+                      type: array
+                      items:
+                        $ref: '#/components/schemas/Line'
+      responses:
+        '200':
+          description: OK
+components:
+  schemas:
+    InvoiceBase:
+      type: object
+      required: [clientName]
+      properties:
+        clientName:
+          type: string
+        billTo:
+          oneOf:
+            - type: string
+            - $ref: '#/components/schemas/Address'
+    Address:
+      type: object
+      properties:
+        city:
+          type: string
+    Line:
+      type: object
+      required: [hours]
+      properties:
+        hours:
+          type: number
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Line'
+`
+
+func TestBuildInputSchema_Nested(t *testing.T) {
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(nestedSpec))
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	tools, problems := extractOperationTools(doc)
+	if len(problems) > 0 || len(tools) != 1 {
+		t.Fatalf("expected one tool, got %d (%v)", len(tools), problems)
+	}
+
+	data, _ := json.Marshal(tools[0].InputSchema)
+	want := `{"properties":{` +
+		`"bill_to":{"oneOf":[{"type":"string"},{"properties":{"city":{"type":"string"}},"type":"object"}]},` +
+		`"client_name":{"type":"string"},` +
+		`"lines":{"items":{"properties":{"children":{"items":{"type":"object"},"type":"array"},"hours":{"type":"number"}},"required":["hours"],"type":"object"},"type":"array"}},` +
+		`"required":["client_name","lines"],"type":"object"}`
+	if string(data) != want {
+		t.Errorf("input schema\n got: %s\nwant: %s", data, want)
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// paramTypes are the JSON schema types a custom parameter may declare
+var paramTypes = map[string]bool{
+	"string": true, "integer": true, "number": true, "boolean": true, "array": true, "object": true,
+}
+
+// validateConfig checks the info-level x-mcp config: resources need a URI
+// and name, and MCP-only tools need a name, a description and an object
+// input schema whose references and required properties are defined
+func validateConfig(doc *openapi3.T, cfg MCPConfig) []string {
+	var problems []string
+
+	for i, r := range cfg.Resources {
+		if r.URI == "" || r.Name == "" {
+			problems = append(problems, fmt.Sprintf("info: x-mcp resources[%d] needs a uri and a name", i))
+		}
+	}
+
+	for i, t := range cfg.Tools {
+		where := fmt.Sprintf("info: x-mcp tools[%d]", i)
+		if t.Name != "" {
+			where = fmt.Sprintf("info: x-mcp tool %s", t.Name)
+		} else {
+			problems = append(problems, where+" has no name")
+		}
+		if t.Description == "" {
+			problems = append(problems, where+" has no description")
+		}
+		if t.InputSchema == nil {
+			problems = append(problems, where+" has no inputSchema")
+			continue
+		}
+		if typ, _ := t.InputSchema["type"].(string); typ != "object" {
+			problems = append(problems, where+": inputSchema must be of type object")
+		}
+		for _, ref := range schemaRefs(t.InputSchema) {
+			if !schemaDefined(doc, ref) {
+				problems = append(problems, fmt.Sprintf("%s references undefined schema %q", where, ref))
+			}
+		}
+		props, _ := t.InputSchema["properties"].(map[string]interface{})
+		for _, name := range requiredProps(t) {
+			if _, ok := props[name]; !ok {
+				problems = append(problems, fmt.Sprintf("%s requires undefined parameter %q", where, name))
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateOperationExt checks an operation's x-mcp extension against the
+// operation it is declared on
+func validateOperationExt(doc *openapi3.T, op *openapi3.Operation, ext MCPOperationExt) []string {
+	var problems []string
+
+	if ext.Tool == "" && len(ext.Tools) == 0 {
+		problems = append(problems, "x-mcp declares no tool")
+	}
+	if ext.Tool != "" && ext.Description == "" {
+		problems = append(problems, fmt.Sprintf("tool %s has no description", ext.Tool))
+	}
+
+	params := operationParams(op)
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		if content, ok := op.RequestBody.Value.Content["application/json"]; ok {
+			if content.Schema == nil || content.Schema.Value == nil {
+				problems = append(problems, "request body references an undefined schema")
+			} else if content.Schema.Ref != "" && !schemaDefined(doc, content.Schema.Ref) {
+				problems = append(problems, fmt.Sprintf("request body references undefined schema %q", content.Schema.Ref))
+			}
+		}
+	}
+
+	if ext.PathParamsAsInput && !hasPathParams(op) {
+		problems = append(problems, "path_params_as_input is set but the operation has no path parameters")
+	}
+
+	problems = append(problems, validateToolParams(ext.Tool, ext.PresetParams, ext.CustomParams, params)...)
+	for i, t := range ext.Tools {
+		name := t.Tool
+		if name == "" {
+			problems = append(problems, fmt.Sprintf("tools[%d] has no tool name", i))
+			name = fmt.Sprintf("tools[%d]", i)
+		} else if t.Description == "" {
+			problems = append(problems, fmt.Sprintf("tool %s has no description", name))
+		}
+		problems = append(problems, validateToolParams(name, t.PresetParams, t.CustomParams, params)...)
+	}
+
+	return problems
+}
+
+// validateToolParams checks that preset parameters exist on the operation
+// and that custom parameters are named and typed
+func validateToolParams(tool string, presets map[string]string, custom []CustomParam, params map[string]bool) []string {
+	var problems []string
+
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !params[name] {
+			problems = append(problems, fmt.Sprintf("tool %s presets undefined parameter %q", tool, name))
+		}
+	}
+
+	for i, cp := range custom {
+		if cp.Name == "" {
+			problems = append(problems, fmt.Sprintf("tool %s custom_params[%d] has no name", tool, i))
+			continue
+		}
+		if !paramTypes[cp.Type] {
+			problems = append(problems, fmt.Sprintf("tool %s custom param %q has unsupported type %q", tool, cp.Name, cp.Type))
+		}
+	}
+
+	return problems
+}
+
+// validateToolNames reports tools defined more than once
+func validateToolNames(tools []MCPTool) []string {
+	var problems []string
+	seen := make(map[string]bool, len(tools))
+	for _, t := range tools {
+		if t.Name == "" {
+			continue
+		}
+		if seen[t.Name] {
+			problems = append(problems, fmt.Sprintf("tool %s is defined more than once", t.Name))
+		}
+		seen[t.Name] = true
+	}
+	return problems
+}
+
+// operationParams returns the names an operation accepts, as parameters
+// or request body properties, in both their spec and snake_case spellings
+func operationParams(op *openapi3.Operation) map[string]bool {
+	params := make(map[string]bool)
+	for _, ref := range op.Parameters {
+		if ref != nil && ref.Value != nil {
+			params[ref.Value.Name] = true
+			params[toSnakeCase(ref.Value.Name)] = true
+		}
+	}
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		if content, ok := op.RequestBody.Value.Content["application/json"]; ok && content.Schema != nil && content.Schema.Value != nil {
+			for name := range content.Schema.Value.Properties {
+				params[name] = true
+				params[toSnakeCase(name)] = true
+			}
+		}
+	}
+	return params
+}
+
+func hasPathParams(op *openapi3.Operation) bool {
+	for _, ref := range op.Parameters {
+		if ref != nil && ref.Value != nil && ref.Value.In == "path" {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaRefs returns the $ref values found anywhere in a JSON schema
+func schemaRefs(v any) []string {
+	var refs []string
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			if s, ok := child.(string); ok && k == "$ref" {
+				refs = append(refs, s)
+				continue
+			}
+			refs = append(refs, schemaRefs(child)...)
+		}
+	case []interface{}:
+		for _, child := range v {
+			refs = append(refs, schemaRefs(child)...)
+		}
+	}
+	return refs
+}
+
+// schemaDefined reports whether a local schema reference resolves
+func schemaDefined(doc *openapi3.T, ref string) bool {
+	name, ok := strings.CutPrefix(ref, "#/components/schemas/")
+	if !ok {
+		// External references were resolved when the spec was loaded
+		return !strings.HasPrefix(ref, "#")
+	}
+	_, ok = doc.Components.Schemas[name]
+	return ok
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+const validateSpec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: "1"
+  x-mcp:
+    tools:
+      - name: mcp_only
+        description: An MCP-only tool
+        inputSchema:
+          type: object
+          properties:
+            filter:
+              $ref: '#/components/schemas/Missing'
+          required: [filter, other]
+paths:
+  /api/things/{id}:
+    get:
+      x-mcp:
+        tool: get_thing
+        preset_params:
+          status: active
+        custom_params:
+          - name: verbose
+            type: flag
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: OK
+  /api/things:
+    get:
+      x-mcp:
+        tools:
+          - tool: list_things
+            description: List things
+          - description: Unnamed
+        path_params_as_input: true
+      responses:
+        '200':
+          description: OK
+components:
+  schemas:
+    Thing:
+      type: object
+`
+
+func TestValidateExtensions(t *testing.T) {
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(validateSpec))
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	var cfg MCPConfig
+	if err := decodeExtension(doc.Info.Extensions["x-mcp"], &cfg); err != nil {
+		t.Fatalf("decode config: %v", err)
+	}
+	_, problems := extractOperationTools(doc)
+	problems = append(problems, validateConfig(doc, cfg)...)
+	got := strings.Join(problems, "\n")
+
+	for _, want := range []string{
+		`info: x-mcp tool mcp_only references undefined schema "#/components/schemas/Missing"`,
+		`info: x-mcp tool mcp_only requires undefined parameter "other"`,
+		`GET /api/things/{id}: tool get_thing has no description`,
+		`GET /api/things/{id}: tool get_thing presets undefined parameter "status"`,
+		`GET /api/things/{id}: tool get_thing custom param "verbose" has unsupported type "flag"`,
+		`GET /api/things: tools[1] has no tool name`,
+		`GET /api/things: path_params_as_input is set but the operation has no path parameters`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing problem %q in:\n%s", want, got)
+		}
+	}
+	if len(problems) != 7 {
+		t.Errorf("expected 7 problems, got %d:\n%s", len(problems), got)
+	}
+
+	if p := validateToolNames([]MCPTool{{Name: "a"}, {Name: "b"}, {Name: "a"}}); len(p) != 1 {
+		t.Errorf("expected one duplicate, got %v", p)
+	}
+}
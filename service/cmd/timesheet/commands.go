@@ -0,0 +1,357 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/pkg/client"
+)
+
+const dateLayout = "2006-01-02"
+
+// today lists a day's time entries with a total
+func (c *cli) today(ctx context.Context, date string) error {
+	day, err := parseDate("date", date)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.api.ListTimeEntriesWithResponse(ctx, &client.ListTimeEntriesParams{StartDate: &day, EndDate: &day})
+	if err != nil {
+		return err
+	}
+	entries, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.print(entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintf(c.out, "No time entries on %s\n", date)
+		return nil
+	}
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROJECT\tHOURS\tSOURCE\tDESCRIPTION")
+	var total float32
+	for _, e := range entries {
+		fmt.Fprintf(w, "%s\t%.2f\t%s\t%s\n", entryProject(e), e.Hours, e.Source, entryDescription(e))
+		total += e.Hours
+	}
+	fmt.Fprintf(w, "TOTAL\t%.2f\t\t\n", total)
+	return w.Flush()
+}
+
+type classifyOptions struct {
+	start, end   string
+	dryRun, skip bool
+}
+
+// classify runs the classification rules, or classifies a single event
+// when given its ID
+func (c *cli) classify(ctx context.Context, opts classifyOptions, args []string) error {
+	if len(args) > 0 {
+		return c.classifyEvent(ctx, args, opts.skip)
+	}
+
+	var req client.ApplyRulesRequest
+	var err error
+	if req.StartDate, err = dateFlag("start", opts.start); err != nil {
+		return err
+	}
+	if req.EndDate, err = dateFlag("end", opts.end); err != nil {
+		return err
+	}
+	if opts.dryRun {
+		req.DryRun = &opts.dryRun
+	}
+
+	res, err := c.api.ApplyRulesWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.print(resp)
+	}
+
+	verb := "Classified"
+	if opts.dryRun {
+		verb = "Would classify"
+	}
+	fmt.Fprintf(c.out, "%s %d events; %d matched no rule\n", verb, len(resp.Classified), resp.Skipped)
+	if len(resp.Classified) == 0 {
+		return nil
+	}
+	projects, err := c.projects(ctx)
+	if err != nil {
+		return err
+	}
+	names := make(map[openapi_types.UUID]string, len(projects))
+	for _, p := range projects {
+		names[p.Id] = projectLabel(p)
+	}
+
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "EVENT\tPROJECT\tCONFIDENCE\tREVIEW")
+	for _, e := range resp.Classified {
+		project, ok := names[e.ProjectId]
+		if !ok {
+			project = e.ProjectId.String()
+		}
+		review := ""
+		if e.NeedsReview {
+			review = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%.0f%%\t%s\n", e.EventId, project, e.Confidence*100, review)
+	}
+	return w.Flush()
+}
+
+func (c *cli) classifyEvent(ctx context.Context, args []string, skip bool) error {
+	var req client.ClassifyEventRequest
+	id, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid event ID %q", args[0])
+	}
+	switch {
+	case skip && len(args) == 1:
+		req.Skip = &skip
+	case !skip && len(args) == 2:
+		p, err := c.findProject(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		req.ProjectId = &p.Id
+	default:
+		return errors.New("expected an event ID and a project, or --skip and an event ID")
+	}
+
+	res, err := c.api.ClassifyCalendarEventWithResponse(ctx, id, req)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.print(resp)
+	}
+	fmt.Fprintf(c.out, "%s: %s\n", resp.Event.Title, resp.Event.ClassificationStatus)
+	if e := resp.TimeEntry; e != nil {
+		fmt.Fprintf(c.out, "%s now has %.2f hours on %s\n", entryProject(*e), e.Hours, e.Date)
+	}
+	return nil
+}
+
+// addEntry creates a manual time entry from a duration, a project and an
+// optional description, adding to any entry the project already has that day
+func (c *cli) addEntry(ctx context.Context, date string, args []string) error {
+	hours, err := parseHours(args[0])
+	if err != nil {
+		return err
+	}
+	d, err := parseDate("date", date)
+	if err != nil {
+		return err
+	}
+	p, err := c.findProject(ctx, args[1])
+	if err != nil {
+		return err
+	}
+	req := client.TimeEntryCreate{ProjectId: p.Id, Date: d, Hours: hours}
+	if len(args) > 2 && args[2] != "" {
+		req.Description = &args[2]
+	}
+
+	res, err := c.api.CreateTimeEntryWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	entry, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON201)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.print(entry)
+	}
+	fmt.Fprintf(c.out, "%s: %.2f hours on %s\n", projectLabel(*p), entry.Hours, entry.Date)
+	return nil
+}
+
+type invoiceOptions struct {
+	start, end, date string
+}
+
+// createInvoice invoices a project's unbilled entries for a period
+func (c *cli) createInvoice(ctx context.Context, opts invoiceOptions, project string) error {
+	var req client.InvoiceCreate
+	var err error
+	if req.PeriodStart, err = parseDate("start", opts.start); err != nil {
+		return err
+	}
+	if req.PeriodEnd, err = parseDate("end", opts.end); err != nil {
+		return err
+	}
+	if req.InvoiceDate, err = dateFlag("date", opts.date); err != nil {
+		return err
+	}
+	p, err := c.findProject(ctx, project)
+	if err != nil {
+		return err
+	}
+	req.ProjectId = p.Id
+
+	res, err := c.api.CreateInvoiceWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	invoice, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON201)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.print(invoice)
+	}
+	w := tabwriter.NewWriter(c.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INVOICE\tPROJECT\tPERIOD\tHOURS\tAMOUNT\tSTATUS")
+	fmt.Fprintf(w, "%s\t%s\t%s to %s\t%.2f\t%.2f\t%s\n",
+		invoice.InvoiceNumber, projectLabel(*p), invoice.PeriodStart, invoice.PeriodEnd,
+		invoice.TotalHours, invoice.TotalAmount, invoice.Status)
+	return w.Flush()
+}
+
+// projects lists the user's active projects
+func (c *cli) projects(ctx context.Context) ([]client.Project, error) {
+	var projects []client.Project
+	for p, err := range c.api.AllProjects(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, p)
+	}
+	return projects, nil
+}
+
+func (c *cli) findProject(ctx context.Context, query string) (*client.Project, error) {
+	projects, err := c.projects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return matchProject(projects, query)
+}
+
+// matchProject finds the project a query names: by short code, then by
+// name, then by the only name containing it, ignoring case
+func matchProject(projects []client.Project, query string) (*client.Project, error) {
+	q := strings.ToLower(strings.TrimSpace(query))
+	for i, p := range projects {
+		if p.ShortCode != nil && strings.ToLower(*p.ShortCode) == q {
+			return &projects[i], nil
+		}
+	}
+	for i, p := range projects {
+		if strings.ToLower(p.Name) == q {
+			return &projects[i], nil
+		}
+	}
+
+	var found []int
+	for i, p := range projects {
+		if strings.Contains(strings.ToLower(p.Name), q) {
+			found = append(found, i)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no project matches %q", query)
+	case 1:
+		return &projects[found[0]], nil
+	}
+	names := make([]string, len(found))
+	for i, idx := range found {
+		names[i] = projectLabel(projects[idx])
+	}
+	return nil, fmt.Errorf("%q matches several projects: %s", query, strings.Join(names, ", "))
+}
+
+// parseHours reads a duration as decimal hours ("1.5") or a Go duration
+// ("2h", "90m", "1h30m")
+func parseHours(s string) (float32, error) {
+	hours, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		d, derr := time.ParseDuration(s)
+		if derr != nil {
+			return 0, fmt.Errorf("invalid duration %q, expected e.g. 2h, 90m or 1.5", s)
+		}
+		hours = d.Hours()
+	}
+	if hours <= 0 || hours > 24 {
+		return 0, fmt.Errorf("duration %q must be more than 0 and at most 24 hours", s)
+	}
+	return float32(hours), nil
+}
+
+// parseDate parses a YYYY-MM-DD flag value
+func parseDate(name, value string) (openapi_types.Date, error) {
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return openapi_types.Date{}, fmt.Errorf("invalid --%s %q, expected YYYY-MM-DD", name, value)
+	}
+	return openapi_types.Date{Time: t}, nil
+}
+
+// dateFlag parses an optional YYYY-MM-DD flag value
+func dateFlag(name, value string) (*openapi_types.Date, error) {
+	if value == "" {
+		return nil, nil
+	}
+	d, err := parseDate(name, value)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// lastMonth returns the first and last day of the month before now's
+func lastMonth(now time.Time) (time.Time, time.Time) {
+	firstOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return firstOfMonth.AddDate(0, -1, 0), firstOfMonth.AddDate(0, 0, -1)
+}
+
+func projectLabel(p client.Project) string {
+	if p.ShortCode != nil && *p.ShortCode != "" {
+		return *p.ShortCode + " " + p.Name
+	}
+	return p.Name
+}
+
+func entryProject(e client.TimeEntry) string {
+	if e.Project != nil {
+		return projectLabel(*e.Project)
+	}
+	return e.ProjectId.String()
+}
+
+func entryDescription(e client.TimeEntry) string {
+	switch {
+	case e.Description != nil && *e.Description != "":
+		return *e.Description
+	case e.Title != nil:
+		return *e.Title
+	}
+	return ""
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/pkg/client"
+)
+
+func newCLI(t *testing.T, srv *httptest.Server, token string, out *bytes.Buffer) *cli {
+	t.Helper()
+	api, err := client.New(srv.URL, token, client.WithHTTPClient(srv.Client()))
+	if err != nil {
+		t.Fatalf("client.New: %v", err)
+	}
+	return &cli{api: api, out: out}
+}
+
+// run executes a command line against c
+func run(c *cli, args ...string) error {
+	root := newRootCmd(c)
+	root.SetArgs(args)
+	return root.ExecuteContext(context.Background())
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestParseHours(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float32
+	}{
+		{"2h", 2},
+		{"90m", 1.5},
+		{"1h30m", 1.5},
+		{"1.5", 1.5},
+		{"0.25", 0.25},
+	}
+	for _, tt := range tests {
+		got, err := parseHours(tt.in)
+		if err != nil {
+			t.Errorf("parseHours(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseHours(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "two hours", "0", "-1h", "25h"} {
+		if _, err := parseHours(in); err == nil {
+			t.Errorf("parseHours(%q) should fail", in)
+		}
+	}
+}
+
+func TestMatchProject(t *testing.T) {
+	code := "ACM"
+	projects := []client.Project{
+		{Id: uuid.New(), Name: "Acme Corp Website", ShortCode: &code},
+		{Id: uuid.New(), Name: "Acme Mobile"},
+		{Id: uuid.New(), Name: "Internal"},
+	}
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"acm", "Acme Corp Website"},
+		{"acme mobile", "Acme Mobile"},
+		{"intern", "Internal"},
+		{"website", "Acme Corp Website"},
+	}
+	for _, tt := range tests {
+		p, err := matchProject(projects, tt.query)
+		if err != nil {
+			t.Errorf("matchProject(%q): %v", tt.query, err)
+			continue
+		}
+		if p.Name != tt.want {
+			t.Errorf("matchProject(%q) = %q, want %q", tt.query, p.Name, tt.want)
+		}
+	}
+
+	if _, err := matchProject(projects, "acme"); err == nil || !strings.Contains(err.Error(), "several") {
+		t.Errorf("expected an ambiguous query to fail, got %v", err)
+	}
+	if _, err := matchProject(projects, "globex"); err == nil {
+		t.Error("expected an unknown project to fail")
+	}
+}
+
+func TestLastMonth(t *testing.T) {
+	start, end := lastMonth(time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC))
+	if got := start.Format(dateLayout); got != "2026-02-01" {
+		t.Errorf("start = %s, want 2026-02-01", got)
+	}
+	if got := end.Format(dateLayout); got != "2026-02-28" {
+		t.Errorf("end = %s, want 2026-02-28", got)
+	}
+
+	start, _ = lastMonth(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+	if got := start.Format(dateLayout); got != "2025-12-01" {
+		t.Errorf("start = %s, want 2025-12-01", got)
+	}
+}
+
+func TestAddEntry(t *testing.T) {
+	projectID := uuid.New()
+	code := "ACM"
+	var got client.TimeEntryCreate
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ts_test" {
+			writeJSON(w, http.StatusUnauthorized, client.Error{Code: "unauthorized", Message: "Invalid API key"})
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/projects":
+			writeJSON(w, http.StatusOK, []client.Project{{Id: projectID, Name: "Acme Corp", ShortCode: &code}})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/time-entries":
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Errorf("decoding request: %v", err)
+			}
+			writeJSON(w, http.StatusCreated, client.TimeEntry{ProjectId: got.ProjectId, Date: got.Date, Hours: got.Hours, Source: "manual"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	var out bytes.Buffer
+	c := newCLI(t, srv, "ts_test", &out)
+	if err := run(c, "entry", "add", "--date", "2026-10-01", "2h", "acme", "code review"); err != nil {
+		t.Fatalf("addEntry: %v", err)
+	}
+	if got.ProjectId != projectID || got.Hours != 2 || got.Date.String() != "2026-10-01" {
+		t.Errorf("unexpected request %+v", got)
+	}
+	if got.Description == nil || *got.Description != "code review" {
+		t.Errorf("expected the description to be sent, got %v", got.Description)
+	}
+	if want := "ACM Acme Corp: 2.00 hours on 2026-10-01\n"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+
+	c = newCLI(t, srv, "ts_wrong", &out)
+	err := run(c, "entry", "add", "1h", "acme")
+	if err == nil || !strings.Contains(err.Error(), "Invalid API key") {
+		t.Errorf("expected the API's message in the error, got %v", err)
+	}
+
+	if err := run(c, "entry", "add", "1h"); err == nil || !strings.Contains(err.Error(), "accepts between 2 and 3 arg(s)") {
+		t.Errorf("expected a missing project to be refused, got %v", err)
+	}
+}
+
+func TestRootCmd_RequiresAPIKey(t *testing.T) {
+	t.Setenv("TIMESHEET_API_KEY", "")
+	var out bytes.Buffer
+	err := run(&cli{out: &out}, "today")
+	if err == nil || !strings.Contains(err.Error(), "TIMESHEET_API_KEY") {
+		t.Errorf("expected a missing API key to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+// Command timesheet works with the Timesheet API from a terminal
+//
+// Usage:
+//
+//	TIMESHEET_API_KEY=ts_... go run ./cmd/timesheet [--url http://localhost:8080] [--json] <command> [args]
+//
+// Commands:
+//
+//	today [--date YYYY-MM-DD]              Time entries for a day
+//	classify [--start] [--end] [--dry-run] Run classification rules on pending events
+//	classify [--skip] <event-id> [project] Classify one event, or skip it
+//	entry add [--date] <duration> <project> [description]
+//	                                       Add a manual time entry, e.g. 2h acme "code review"
+//	invoice create [--start] [--end] [--date] <project>
+//	                                       Invoice unbilled entries (defaults to last month)
+//	review [--start] [--end]               Triage pending events one keypress at a time
+//
+// Projects are matched by short code, name, or a unique part of the name.
+// Durations are hours ("1.5") or Go durations ("2h", "90m", "1h30m").
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/michaelw/timesheet-app/service/pkg/client"
+)
+
+func main() {
+	// Interrupting cancels the command's requests; review also uses it to
+	// leave the terminal as it found it
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := newRootCmd(&cli{out: os.Stdout}).ExecuteContext(ctx)
+	interrupted := ctx.Err() != nil
+	stop()
+	switch {
+	case interrupted:
+		os.Exit(130)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+type cli struct {
+	api  *client.ClientWithResponses
+	json bool
+	out  io.Writer
+}
+
+// print writes v as indented JSON
+func (c *cli) print(v any) error {
+	enc := json.NewEncoder(c.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// newRootCmd builds the command tree. The API client is created once the
+// flags are parsed, unless c already has one.
+func newRootCmd(c *cli) *cobra.Command {
+	var baseURL string
+	root := &cobra.Command{
+		Use:   "timesheet",
+		Short: "Work with the Timesheet API from a terminal",
+		Long: `Work with the Timesheet API from a terminal.
+
+Projects are matched by short code, name, or a unique part of the name.
+Durations are hours ("1.5") or Go durations ("2h", "90m", "1h30m").
+
+Environment:
+  TIMESHEET_API_KEY   API key created in Settings (required)
+  TIMESHEET_URL       Server base URL (default http://localhost:8080)`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if c.api != nil {
+				return nil
+			}
+			token := os.Getenv("TIMESHEET_API_KEY")
+			if token == "" {
+				return errors.New("TIMESHEET_API_KEY must be set")
+			}
+			api, err := client.New(strings.TrimSuffix(baseURL, "/"), token)
+			if err != nil {
+				return err
+			}
+			c.api = api
+			return nil
+		},
+	}
+	root.SetOut(c.out)
+	root.PersistentFlags().StringVar(&baseURL, "url", getEnv("TIMESHEET_URL", "http://localhost:8080"), "server base URL (TIMESHEET_URL)")
+	root.PersistentFlags().BoolVar(&c.json, "json", false, "print API responses as JSON instead of tables")
+
+	entry := &cobra.Command{Use: "entry", Short: "Manage time entries"}
+	entry.AddCommand(c.addEntryCmd())
+	invoice := &cobra.Command{Use: "invoice", Short: "Manage invoices"}
+	invoice.AddCommand(c.createInvoiceCmd())
+	root.AddCommand(c.todayCmd(), c.classifyCmd(), entry, invoice, c.reviewCmd())
+	return root
+}
+
+func (c *cli) todayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "today",
+		Short: "Time entries for a day",
+		Args:  cobra.NoArgs,
+	}
+	date := cmd.Flags().String("date", time.Now().Format(dateLayout), "day to list (YYYY-MM-DD)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return c.today(cmd.Context(), *date)
+	}
+	return cmd
+}
+
+func (c *cli) classifyCmd() *cobra.Command {
+	var opts classifyOptions
+	cmd := &cobra.Command{
+		Use:   "classify [--skip] [event-id [project]]",
+		Short: "Run classification rules on pending events, or classify one event",
+		Long: `Without arguments, run the classification rules on pending events.
+With an event ID and a project, classify that event; with --skip and an
+event ID, skip it.`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.classify(cmd.Context(), opts, args)
+		},
+	}
+	cmd.Flags().StringVar(&opts.start, "start", "", "only events from this day (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.end, "end", "", "only events until this day (YYYY-MM-DD)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "show what the rules would classify without changing anything")
+	cmd.Flags().BoolVar(&opts.skip, "skip", false, "skip the event instead of assigning it to a project")
+	return cmd
+}
+
+func (c *cli) addEntryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add <duration> <project> [description]",
+		Short:   "Add a manual time entry",
+		Example: `  timesheet entry add 2h acme "code review"`,
+		Args:    cobra.RangeArgs(2, 3),
+	}
+	date := cmd.Flags().String("date", time.Now().Format(dateLayout), "day of the entry (YYYY-MM-DD)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return c.addEntry(cmd.Context(), *date, args)
+	}
+	return cmd
+}
+
+func (c *cli) createInvoiceCmd() *cobra.Command {
+	var opts invoiceOptions
+	cmd := &cobra.Command{
+		Use:   "create <project>",
+		Short: "Invoice a project's unbilled entries (defaults to last month)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.createInvoice(cmd.Context(), opts, args[0])
+		},
+	}
+	start, end := lastMonth(time.Now())
+	cmd.Flags().StringVar(&opts.start, "start", start.Format(dateLayout), "first day of the period (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.end, "end", end.Format(dateLayout), "last day of the period (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.date, "date", "", "invoice date (YYYY-MM-DD, defaults to today)")
+	return cmd
+}
+
+func (c *cli) reviewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "review",
+		Short: "Triage pending events one keypress at a time",
+		Args:  cobra.NoArgs,
+	}
+	start := cmd.Flags().String("start", "", "only events from this day (YYYY-MM-DD, defaults to 30 days ago)")
+	end := cmd.Flags().String("end", "", "only events until this day (YYYY-MM-DD, defaults to today)")
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		return c.review(cmd.Context(), *start, *end)
+	}
+	return cmd
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,477 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/pkg/client"
+)
+
+const reviewKeys = "[1-9] classify  [a] accept suggestion  [s] skip  [/] find project  [t] all with this title\n" +
+	"[n/p] next/previous  [r] reload  [q] quit"
+
+// reviewer pages through pending events, classifying one keypress at a
+// time. It is a bubbletea model: API calls run as commands whose results
+// come back as messages, and bubbletea puts the terminal back however the
+// program ends, including on Ctrl-C and SIGTERM.
+type reviewer struct {
+	ctx       context.Context
+	c         *cli
+	query     client.ListCalendarEventsParams
+	projects  []client.Project
+	events    []client.CalendarEvent
+	explained map[openapi_types.UUID]*client.ClassificationExplanation
+	fetching  map[openapi_types.UUID]bool
+	pos       int
+	byTitle   bool    // The next decision applies to every pending event with this title
+	status    string  // Outcome of the last key
+	prompt    *string // Project search being typed after /, if any
+	busy      bool    // A classification or reload is in flight
+	done      bool
+}
+
+// eventsMsg carries the pending events after a reload
+type eventsMsg struct {
+	events []client.CalendarEvent
+	status string
+	err    error
+}
+
+// decidedMsg reports that an event was classified or skipped
+type decidedMsg struct {
+	id     openapi_types.UUID
+	status string
+	err    error
+}
+
+// explainedMsg carries how the classifier sees an event. A failed
+// explanation is shown as missing rather than stopping the review.
+type explainedMsg struct {
+	id          openapi_types.UUID
+	explanation *client.ClassificationExplanation
+}
+
+// review starts an interactive triage of pending events
+func (c *cli) review(ctx context.Context, start, end string) error {
+	pending := client.Pending
+	q := client.ListCalendarEventsParams{ClassificationStatus: &pending}
+	var err error
+	if q.StartDate, err = dateFlag("start", start); err != nil {
+		return err
+	}
+	if q.EndDate, err = dateFlag("end", end); err != nil {
+		return err
+	}
+
+	projects, err := c.projects(ctx)
+	if err != nil {
+		return err
+	}
+	r := &reviewer{ctx: ctx, c: c, query: q, projects: projects}
+	events, err := c.pendingEvents(ctx, q)
+	if err != nil {
+		return err
+	}
+	r.setEvents(events)
+	if len(r.events) == 0 {
+		fmt.Fprint(c.out, r.View())
+		return nil
+	}
+
+	// Cancelling ctx, as the signal handler in main does, ends the program
+	// and restores the terminal too
+	_, err = tea.NewProgram(r, tea.WithContext(ctx), tea.WithOutput(c.out)).Run()
+	if errors.Is(err, tea.ErrProgramKilled) && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (r *reviewer) Init() tea.Cmd {
+	return r.explainCurrent()
+}
+
+func (r *reviewer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if r.prompt != nil {
+			return r, r.promptKey(msg)
+		}
+		return r, r.key(msg.String())
+	case eventsMsg:
+		r.busy = false
+		if msg.err != nil {
+			r.status = "Error: " + msg.err.Error()
+			return r, nil
+		}
+		r.setEvents(msg.events)
+		r.status = msg.status
+		return r, r.next()
+	case decidedMsg:
+		r.busy = false
+		if msg.err != nil {
+			// Show API errors and carry on, so one failure doesn't end the session
+			r.status = "Error: " + msg.err.Error()
+			return r, nil
+		}
+		for i, e := range r.events {
+			if e.Id == msg.id {
+				r.events = append(r.events[:i], r.events[i+1:]...)
+				break
+			}
+		}
+		if r.pos >= len(r.events) && r.pos > 0 {
+			r.pos--
+		}
+		r.status = msg.status
+		return r, r.next()
+	case explainedMsg:
+		r.explained[msg.id] = msg.explanation
+		delete(r.fetching, msg.id)
+	}
+	return r, nil
+}
+
+// setEvents replaces the pending events, keeping the position
+func (r *reviewer) setEvents(events []client.CalendarEvent) {
+	r.events = events
+	r.explained = make(map[openapi_types.UUID]*client.ClassificationExplanation)
+	r.fetching = make(map[openapi_types.UUID]bool)
+	if r.pos >= len(r.events) {
+		r.pos = max(len(r.events)-1, 0)
+	}
+}
+
+// next moves on after the events changed: quit when none are left,
+// otherwise fetch the explanation of the current one
+func (r *reviewer) next() tea.Cmd {
+	if len(r.events) == 0 {
+		r.done = true
+		return tea.Quit
+	}
+	return r.explainCurrent()
+}
+
+// key acts on a keypress
+func (r *reviewer) key(k string) tea.Cmd {
+	if k == "q" || k == "ctrl+c" {
+		r.done = true
+		return tea.Quit
+	}
+	if r.busy {
+		return nil
+	}
+
+	r.status = ""
+	switch {
+	case k == "n" || k == "j" || k == " ":
+		if r.pos < len(r.events)-1 {
+			r.pos++
+		}
+		return r.explainCurrent()
+	case k == "p" || k == "k":
+		if r.pos > 0 {
+			r.pos--
+		}
+		return r.explainCurrent()
+	case k == "r":
+		return r.reload()
+	case k == "t":
+		r.byTitle = !r.byTitle
+	case k == "s":
+		return r.decide(nil, true)
+	case k == "a":
+		e, ok := r.explained[r.events[r.pos].Id]
+		switch {
+		case !ok:
+			r.status = "Still loading the suggestion"
+		case e == nil:
+			r.status = "No suggestion to accept"
+		case e.WinnerProjectId != nil:
+			p := r.project(*e.WinnerProjectId)
+			if p == nil {
+				r.status = "Error: the suggested project is archived"
+				return nil
+			}
+			return r.decide(p, false)
+		case e.WouldBeSkipped != nil && *e.WouldBeSkipped:
+			return r.decide(nil, true)
+		default:
+			r.status = "No suggestion to accept"
+		}
+	case len(k) == 1 && k[0] >= '1' && k[0] <= '9':
+		i := int(k[0] - '1')
+		if i >= len(r.projects) {
+			r.status = fmt.Sprintf("No project %s", k)
+			return nil
+		}
+		return r.decide(&r.projects[i], false)
+	case k == "/":
+		r.prompt = new(string)
+	default:
+		r.status = fmt.Sprintf("Unknown key %q", k)
+	}
+	return nil
+}
+
+// promptKey edits the project search, classifying to the project it names
+// on Enter. Escape cancels.
+func (r *reviewer) promptKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		r.done = true
+		return tea.Quit
+	case tea.KeyEsc:
+		r.prompt = nil
+	case tea.KeyEnter:
+		query := *r.prompt
+		r.prompt = nil
+		if strings.TrimSpace(query) == "" {
+			return nil
+		}
+		p, err := matchProject(r.projects, query)
+		if err != nil {
+			r.status = "Error: " + err.Error()
+			return nil
+		}
+		return r.decide(p, false)
+	case tea.KeyBackspace:
+		if runes := []rune(*r.prompt); len(runes) > 0 {
+			*r.prompt = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		*r.prompt += string(msg.Runes)
+	}
+	return nil
+}
+
+// decide classifies the current event to a project or skips it. In title
+// mode every pending event whose title contains this one is classified
+// with the bulk endpoint.
+func (r *reviewer) decide(p *client.Project, skip bool) tea.Cmd {
+	event := r.events[r.pos]
+	target := "skipped"
+	if p != nil {
+		target = projectLabel(*p)
+	}
+
+	if r.byTitle {
+		title := strings.TrimSpace(strings.ReplaceAll(event.Title, `"`, ""))
+		if title == "" {
+			r.status = "Error: the event has no title to match"
+			return nil
+		}
+		req := client.BulkClassifyRequest{Query: fmt.Sprintf(`status:pending title:"%s"`, title)}
+		if skip {
+			req.Skip = &skip
+		} else {
+			req.ProjectId = &p.Id
+		}
+		r.byTitle = false
+		r.busy = true
+		ctx, c, query := r.ctx, r.c, r.query
+		return func() tea.Msg {
+			res, err := c.api.BulkClassifyEventsWithResponse(ctx, req)
+			if err != nil {
+				return eventsMsg{err: err}
+			}
+			resp, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON200)
+			if err != nil {
+				return eventsMsg{err: err}
+			}
+			events, err := c.pendingEvents(ctx, query)
+			status := fmt.Sprintf("%d events titled %q → %s", resp.ClassifiedCount+resp.SkippedCount, title, target)
+			return eventsMsg{events: events, status: status, err: err}
+		}
+	}
+
+	var req client.ClassifyEventRequest
+	if skip {
+		req.Skip = &skip
+	} else {
+		req.ProjectId = &p.Id
+	}
+	r.busy = true
+	ctx, api := r.ctx, r.c.api
+	return func() tea.Msg {
+		res, err := api.ClassifyCalendarEventWithResponse(ctx, event.Id, req)
+		if err == nil {
+			err = client.CheckResponse(res.HTTPResponse, res.Body)
+		}
+		return decidedMsg{id: event.Id, status: fmt.Sprintf("%s → %s", event.Title, target), err: err}
+	}
+}
+
+// reload fetches the pending events again
+func (r *reviewer) reload() tea.Cmd {
+	r.busy = true
+	ctx, c, query := r.ctx, r.c, r.query
+	return func() tea.Msg {
+		events, err := c.pendingEvents(ctx, query)
+		return eventsMsg{events: events, err: err}
+	}
+}
+
+func (c *cli) pendingEvents(ctx context.Context, query client.ListCalendarEventsParams) ([]client.CalendarEvent, error) {
+	res, err := c.api.ListCalendarEventsWithResponse(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+	return client.Decoded(res.HTTPResponse, res.Body, res.JSON200)
+}
+
+// explainCurrent fetches how the classifier sees the current event, once
+// per event
+func (r *reviewer) explainCurrent() tea.Cmd {
+	if len(r.events) == 0 {
+		return nil
+	}
+	id := r.events[r.pos].Id
+	if _, ok := r.explained[id]; ok || r.fetching[id] {
+		return nil
+	}
+	r.fetching[id] = true
+	ctx, api := r.ctx, r.c.api
+	return func() tea.Msg {
+		var e *client.ClassificationExplanation
+		if res, err := api.ExplainEventClassificationWithResponse(ctx, id); err == nil {
+			if explanation, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON200); err == nil {
+				e = &explanation
+			}
+		}
+		return explainedMsg{id: id, explanation: e}
+	}
+}
+
+func (r *reviewer) project(id openapi_types.UUID) *client.Project {
+	for i := range r.projects {
+		if r.projects[i].Id == id {
+			return &r.projects[i]
+		}
+	}
+	return nil
+}
+
+func (r *reviewer) View() string {
+	var out strings.Builder
+	if len(r.events) == 0 {
+		if r.status != "" {
+			fmt.Fprintln(&out, r.status)
+		}
+		fmt.Fprintln(&out, "No pending events to review")
+		return out.String()
+	}
+	if r.done {
+		return ""
+	}
+	event := r.events[r.pos]
+
+	fmt.Fprintf(&out, "Pending event %d of %d\n\n", r.pos+1, len(r.events))
+	fmt.Fprintln(&out, event.Title)
+	fmt.Fprintln(&out, eventWhen(event))
+	if event.Attendees != nil && len(*event.Attendees) > 0 {
+		fmt.Fprintln(&out, "Attendees:", attendeeList(*event.Attendees, 5))
+	}
+	fmt.Fprintln(&out)
+
+	if e, ok := r.explained[event.Id]; !ok {
+		fmt.Fprintln(&out, "Loading explanation…")
+	} else if e == nil {
+		fmt.Fprintln(&out, "No explanation available")
+	} else {
+		fmt.Fprintln(&out, e.Outcome)
+		for i, s := range e.TargetScores {
+			if i == 3 {
+				break
+			}
+			name := s.TargetId.String()
+			if s.TargetName != nil {
+				name = *s.TargetName
+			}
+			fmt.Fprintf(&out, "  %-30s %.2f\n", name, s.TotalWeight)
+		}
+		for _, ev := range e.RuleEvaluations {
+			if !ev.Matched {
+				continue
+			}
+			target := "skip"
+			if ev.TargetName != nil {
+				target = *ev.TargetName
+			}
+			fmt.Fprintf(&out, "  matched %s → %s\n", ev.Query, target)
+		}
+	}
+	fmt.Fprintln(&out)
+
+	w := tabwriter.NewWriter(&out, 0, 0, 3, ' ', 0)
+	for i, p := range r.projects {
+		if i == 9 {
+			break
+		}
+		sep := "\t"
+		if i%3 == 2 || i == len(r.projects)-1 || i == 8 {
+			sep = "\n"
+		}
+		fmt.Fprintf(w, "%d %s%s", i+1, projectLabel(p), sep)
+	}
+	w.Flush()
+	if len(r.projects) > 9 {
+		fmt.Fprintf(&out, "…and %d more, press / to find them\n", len(r.projects)-9)
+	}
+	fmt.Fprintln(&out)
+
+	if r.byTitle {
+		fmt.Fprintf(&out, "Next decision applies to every pending event titled %q\n", event.Title)
+	}
+	switch {
+	case r.busy:
+		fmt.Fprintln(&out, "Working…")
+	case r.status != "":
+		fmt.Fprintln(&out, r.status)
+	}
+	if r.prompt != nil {
+		fmt.Fprintf(&out, "Project: %s\n", *r.prompt)
+	} else {
+		fmt.Fprintln(&out, reviewKeys)
+	}
+	return out.String()
+}
+
+func eventWhen(e client.CalendarEvent) string {
+	start, end := e.StartTime.Local(), e.EndTime.Local()
+	when := start.Format("Mon Jan 2")
+	if e.IsAllDay != nil && *e.IsAllDay {
+		when += " (all day)"
+	} else {
+		when += fmt.Sprintf(" %s-%s (%s)", start.Format("15:04"), end.Format("15:04"), formatDuration(end.Sub(start)))
+	}
+	if e.CalendarName != nil && *e.CalendarName != "" {
+		when += " · " + *e.CalendarName
+	}
+	return when
+}
+
+func formatDuration(d time.Duration) string {
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	}
+	return fmt.Sprintf("%dh%dm", h, m)
+}
+
+func attendeeList(attendees []string, limit int) string {
+	if len(attendees) <= limit {
+		return strings.Join(attendees, ", ")
+	}
+	return fmt.Sprintf("%s (+%d)", strings.Join(attendees[:limit], ", "), len(attendees)-limit)
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/pkg/client"
+)
+
+// reviewServer fakes the endpoints review uses, recording the decisions
+type reviewServer struct {
+	mu       sync.Mutex
+	projects []client.Project
+	events   []client.CalendarEvent
+	winner   uuid.UUID
+	classify map[uuid.UUID]client.ClassifyEventRequest
+	bulk     []client.BulkClassifyRequest
+}
+
+func (s *reviewServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := r.URL.Path
+	switch {
+	case r.Method == http.MethodGet && path == "/api/projects":
+		writeJSON(w, http.StatusOK, s.projects)
+	case r.Method == http.MethodGet && path == "/api/calendar-events":
+		if r.URL.Query().Get("classification_status") != "pending" {
+			http.Error(w, "expected pending events", http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.events)
+	case r.Method == http.MethodGet && strings.HasSuffix(path, "/explain"):
+		name := "Acme Corp"
+		writeJSON(w, http.StatusOK, client.ClassificationExplanation{
+			Outcome:         "Classified to Acme Corp (80% confidence)",
+			WinnerProjectId: &s.winner,
+			TargetScores:    []client.TargetScore{{TargetId: s.winner, TargetName: &name, TotalWeight: 2}},
+			RuleEvaluations: []client.RuleEvaluation{{Query: "domain:acme.com", Matched: true, TargetName: &name}},
+		})
+	case r.Method == http.MethodPut && strings.HasSuffix(path, "/classify"):
+		var req client.ClassifyEventRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		id := uuid.MustParse(strings.Split(path, "/")[3])
+		s.classify[id] = req
+		s.remove(func(e client.CalendarEvent) bool { return e.Id == id })
+		writeJSON(w, http.StatusOK, client.ClassifyEventResponse{})
+	case r.Method == http.MethodPost && path == "/api/calendar-events/bulk-classify":
+		var req client.BulkClassifyRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		s.bulk = append(s.bulk, req)
+		n := s.remove(func(e client.CalendarEvent) bool { return strings.Contains(req.Query, e.Title) })
+		writeJSON(w, http.StatusOK, client.BulkClassifyResponse{ClassifiedCount: n})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *reviewServer) remove(match func(client.CalendarEvent) bool) int {
+	kept := s.events[:0]
+	for _, e := range s.events {
+		if !match(e) {
+			kept = append(kept, e)
+		}
+	}
+	n := len(s.events) - len(kept)
+	s.events = kept
+	return n
+}
+
+func newReviewer(t *testing.T, s *reviewServer) *reviewer {
+	t.Helper()
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	c := newCLI(t, srv, "ts_test", &bytes.Buffer{})
+	ctx := context.Background()
+	projects, err := c.projects(ctx)
+	if err != nil {
+		t.Fatalf("projects: %v", err)
+	}
+	pending := client.Pending
+	r := &reviewer{
+		ctx:      ctx,
+		c:        c,
+		query:    client.ListCalendarEventsParams{ClassificationStatus: &pending},
+		projects: projects,
+	}
+	events, err := c.pendingEvents(ctx, r.query)
+	if err != nil {
+		t.Fatalf("pendingEvents: %v", err)
+	}
+	r.setEvents(events)
+	return r
+}
+
+// press feeds keys to the reviewer as bubbletea would, running the
+// commands they start to completion, and returns every screen shown. It
+// stops at the first key that quits.
+func press(r *reviewer, keys string) string {
+	var screens strings.Builder
+	quit := false
+	var dispatch func(msg tea.Msg)
+	dispatch = func(msg tea.Msg) {
+		_, cmd := r.Update(msg)
+		screens.WriteString(r.View())
+		for _, msg := range runCmd(cmd) {
+			if _, ok := msg.(tea.QuitMsg); ok {
+				quit = true
+				continue
+			}
+			dispatch(msg)
+		}
+	}
+
+	for _, msg := range runCmd(r.Init()) {
+		dispatch(msg)
+	}
+	for _, k := range keys {
+		if quit {
+			break
+		}
+		switch k {
+		case '\n':
+			dispatch(tea.KeyMsg{Type: tea.KeyEnter})
+		case ' ':
+			dispatch(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{k}})
+		default:
+			dispatch(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{k}})
+		}
+	}
+	return screens.String()
+}
+
+func runCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, runCmd(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func pendingEvent(title string, start time.Time) client.CalendarEvent {
+	return client.CalendarEvent{
+		Id:                   uuid.New(),
+		Title:                title,
+		StartTime:            start,
+		EndTime:              start.Add(30 * time.Minute),
+		ClassificationStatus: client.CalendarEventClassificationStatusPending,
+	}
+}
+
+func TestReview(t *testing.T) {
+	start := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+	acme, internal := uuid.New(), uuid.New()
+	s := &reviewServer{
+		projects: []client.Project{{Id: acme, Name: "Acme Corp"}, {Id: internal, Name: "Internal"}},
+		events: []client.CalendarEvent{
+			pendingEvent("Acme sync", start),
+			pendingEvent("Lunch", start.Add(2*time.Hour)),
+			pendingEvent("Planning", start.Add(4*time.Hour)),
+		},
+		winner:   acme,
+		classify: make(map[uuid.UUID]client.ClassifyEventRequest),
+	}
+	acmeSync, lunch, planning := s.events[0].Id, s.events[1].Id, s.events[2].Id
+
+	// Accept the suggestion, skip, then find a project by name
+	r := newReviewer(t, s)
+	out := press(r, "as/intern\n")
+
+	if req := s.classify[acmeSync]; req.ProjectId == nil || *req.ProjectId != acme {
+		t.Errorf("expected the suggestion to be accepted, got %+v", req)
+	}
+	if req := s.classify[lunch]; req.Skip == nil || !*req.Skip {
+		t.Errorf("expected lunch to be skipped, got %+v", req)
+	}
+	if req := s.classify[planning]; req.ProjectId == nil || *req.ProjectId != internal {
+		t.Errorf("expected planning to be classified to Internal, got %+v", req)
+	}
+	for _, want := range []string{"Pending event 1 of 3", "matched domain:acme.com → Acme Corp", "No pending events to review"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected the output to contain %q", want)
+		}
+	}
+}
+
+func TestReview_ByTitle(t *testing.T) {
+	start := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+	acme := uuid.New()
+	s := &reviewServer{
+		projects: []client.Project{{Id: acme, Name: "Acme Corp"}},
+		events: []client.CalendarEvent{
+			pendingEvent("Standup", start),
+			pendingEvent("Standup", start.AddDate(0, 0, 1)),
+			pendingEvent("1:1", start.AddDate(0, 0, 1)),
+		},
+		winner:   acme,
+		classify: make(map[uuid.UUID]client.ClassifyEventRequest),
+	}
+
+	// Classify every standup at once, then leave
+	r := newReviewer(t, s)
+	out := press(r, "t1q")
+
+	if len(s.bulk) != 1 {
+		t.Fatalf("expected one bulk classification, got %d", len(s.bulk))
+	}
+	if got := s.bulk[0]; got.Query != `status:pending title:"Standup"` || got.ProjectId == nil || *got.ProjectId != acme {
+		t.Errorf("unexpected bulk request %+v", got)
+	}
+	if len(s.classify) != 0 {
+		t.Errorf("expected no single classifications, got %d", len(s.classify))
+	}
+	if !strings.Contains(out, `2 events titled "Standup" → Acme Corp`) {
+		t.Errorf("expected the bulk result in the output:\n%s", out)
+	}
+	if len(r.events) != 1 || r.byTitle {
+		t.Errorf("expected one event left and title mode off, got %d events, byTitle=%v", len(r.events), r.byTitle)
+	}
+}
+
+func TestReview_Quit(t *testing.T) {
+	start := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC)
+	s := &reviewServer{
+		events:   []client.CalendarEvent{pendingEvent("Standup", start)},
+		classify: make(map[uuid.UUID]client.ClassifyEventRequest),
+	}
+
+	// Ctrl-C quits from the prompt as well as from the event screen
+	r := newReviewer(t, s)
+	press(r, "/ac")
+	if _, cmd := r.Update(tea.KeyMsg{Type: tea.KeyCtrlC}); cmd == nil || cmd() != tea.Quit() {
+		t.Errorf("expected Ctrl-C to quit")
+	}
+	if !r.done || len(s.classify) != 0 {
+		t.Errorf("expected to quit without classifying, done=%v", r.done)
+	}
+	if r.View() != "" {
+		t.Errorf("expected the screen to be cleared on quit, got:\n%s", r.View())
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		30 * time.Minute: "30m",
+		time.Hour:        "1h",
+		90 * time.Minute: "1h30m",
+	}
+	for d, want := range tests {
+		if got := formatDuration(d); got != want {
+			t.Errorf("formatDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Package aggregate maintains the daily per-project hour totals used by
+// summaries and reports. Database triggers mark days stale when entries,
+// classifications or the settings that filter them change; stale days are
+// recomputed on read, so reports never see outdated totals.
+package aggregate
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+const dateKeyLayout = "2006-01-02"
+
+// Totals sums entry hours per project for each of the given days. Entries
+// on other days and zero totals are left out.
+func Totals(userID uuid.UUID, entries []*store.TimeEntry, days []store.StaleDay) []*store.DailyProjectHours {
+	wanted := make(map[string]time.Time, len(days))
+	for _, d := range days {
+		wanted[d.Date.Format(dateKeyLayout)] = d.Date
+	}
+
+	type key struct {
+		date      string
+		projectID uuid.UUID
+	}
+	sums := make(map[key]float64)
+	var order []key
+	for _, e := range entries {
+		k := key{date: e.Date.Format(dateKeyLayout), projectID: e.ProjectID}
+		if _, ok := wanted[k.date]; !ok {
+			continue
+		}
+		if _, seen := sums[k]; !seen {
+			order = append(order, k)
+		}
+		sums[k] += e.Hours
+	}
+
+	totals := make([]*store.DailyProjectHours, 0, len(order))
+	for _, k := range order {
+		if sums[k] == 0 {
+			continue
+		}
+		totals = append(totals, &store.DailyProjectHours{
+			UserID:    userID,
+			ProjectID: k.projectID,
+			Date:      wanted[k.date],
+			Hours:     sums[k],
+		})
+	}
+	return totals
+}
+
+// Batches splits stale days into runs spanning at most maxSpan days, so a
+// long backlog is recomputed in bounded queries
+func Batches(days []store.StaleDay, maxSpan int) [][]store.StaleDay {
+	var batches [][]store.StaleDay
+	var current []store.StaleDay
+	for _, d := range days {
+		if len(current) > 0 && d.Date.Sub(current[0].Date) >= time.Duration(maxSpan)*24*time.Hour {
+			batches = append(batches, current)
+			current = nil
+		}
+		current = append(current, d)
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
@@ -0,0 +1,63 @@
+package aggregate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func day(d int) time.Time {
+	return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestTotals(t *testing.T) {
+	userID := uuid.New()
+	acme, globex := uuid.New(), uuid.New()
+	entries := []*store.TimeEntry{
+		{ProjectID: acme, Date: day(3), Hours: 2},
+		{ProjectID: acme, Date: day(3), Hours: 1.5},
+		{ProjectID: globex, Date: day(3), Hours: 1},
+		{ProjectID: acme, Date: day(4), Hours: 0},
+		// Outside the stale days
+		{ProjectID: acme, Date: day(5), Hours: 8},
+	}
+
+	totals := Totals(userID, entries, []store.StaleDay{{Date: day(3)}, {Date: day(4)}})
+	if len(totals) != 2 {
+		t.Fatalf("expected 2 totals, got %d", len(totals))
+	}
+	for _, tot := range totals {
+		if tot.UserID != userID || !tot.Date.Equal(day(3)) {
+			t.Errorf("unexpected total %+v", tot)
+		}
+		switch tot.ProjectID {
+		case acme:
+			if tot.Hours != 3.5 {
+				t.Errorf("expected 3.5h for acme, got %v", tot.Hours)
+			}
+		case globex:
+			if tot.Hours != 1 {
+				t.Errorf("expected 1h for globex, got %v", tot.Hours)
+			}
+		}
+	}
+}
+
+func TestBatches(t *testing.T) {
+	days := []store.StaleDay{{Date: day(1)}, {Date: day(2)}, {Date: day(8)}, {Date: day(9)}, {Date: day(20)}}
+
+	batches := Batches(days, 7)
+	if len(batches) != 3 {
+		t.Fatalf("expected 3 batches, got %d", len(batches))
+	}
+	if len(batches[0]) != 2 || len(batches[1]) != 2 || len(batches[2]) != 1 {
+		t.Errorf("unexpected batch sizes %d/%d/%d", len(batches[0]), len(batches[1]), len(batches[2]))
+	}
+
+	if got := Batches(nil, 7); len(got) != 0 {
+		t.Errorf("expected no batches for no days, got %d", len(got))
+	}
+}
@@ -0,0 +1,97 @@
+package aggregate
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+)
+
+// maxBatchDays bounds the date span recomputed in one query
+const maxBatchDays = 31
+
+// DefaultBackfillDays is how far back a backfill computes by default
+const DefaultBackfillDays = 365
+
+// Service reads and refreshes the daily project hours aggregate
+type Service struct {
+	totals       *store.DailyProjectHoursStore
+	timeEntrySvc *timeentry.Service
+}
+
+// NewService creates a new aggregate service
+func NewService(totals *store.DailyProjectHoursStore, timeEntrySvc *timeentry.Service) *Service {
+	return &Service{
+		totals:       totals,
+		timeEntrySvc: timeEntrySvc,
+	}
+}
+
+// DailyHours returns per-project totals for each day in the inclusive range,
+// recomputing any stale days first. Days without hours have no rows.
+func (s *Service) DailyHours(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]*store.DailyProjectHours, error) {
+	if _, err := s.Refresh(ctx, userID, start, end); err != nil {
+		return nil, err
+	}
+	return s.totals.List(ctx, userID, start, end, nil)
+}
+
+// Refresh recomputes the stale days in the inclusive range and returns how
+// many were recomputed
+func (s *Service) Refresh(ctx context.Context, userID uuid.UUID, start, end time.Time) (int, error) {
+	stale, err := s.totals.StaleDays(ctx, userID, start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, batch := range Batches(stale, maxBatchDays) {
+		first := batch[0].Date
+		// Events are listed by start time before the end bound, so ask for
+		// the day after the last one to include it
+		last := batch[len(batch)-1].Date.AddDate(0, 0, 1)
+		entries, err := s.timeEntrySvc.ListWithEphemeral(ctx, userID, &first, &last, nil)
+		if err != nil {
+			return 0, err
+		}
+		if err := s.totals.Replace(ctx, userID, batch, Totals(userID, entries, batch)); err != nil {
+			return 0, err
+		}
+	}
+	return len(stale), nil
+}
+
+// BackfillResult summarizes a backfill run
+type BackfillResult struct {
+	Users int `json:"users"`
+	Days  int `json:"days"`
+}
+
+// Backfill computes the aggregate for every user over the inclusive range.
+// Days that are already current are skipped, so it is safe to rerun. A user
+// that fails is logged and skipped.
+func (s *Service) Backfill(ctx context.Context, start, end time.Time) (BackfillResult, error) {
+	var result BackfillResult
+
+	userIDs, err := s.totals.ListUserIDs(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		days, err := s.Refresh(ctx, userID, start, end)
+		if err != nil {
+			log.Printf("[AGGREGATE] backfill failed for user %s: %v", userID, err)
+			continue
+		}
+		result.Users++
+		result.Days += days
+	}
+	return result, nil
+}
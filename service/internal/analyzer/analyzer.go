@@ -240,6 +240,69 @@ func RoundMinutes(minutes int, cfg RoundingConfig) (int, string) {
 	return rounded, description
 }
 
+// EventContribution describes how much of an event counted toward its entry.
+// Events are taken in start order and time already covered by an earlier
+// event is attributed to that event, so the counted minutes of all events
+// add up to the union.
+type EventContribution struct {
+	ID         string
+	Title      string
+	Start      time.Time
+	End        time.Time
+	IsAllDay   bool
+	RawMinutes int
+
+	// CountedStart and CountedEnd bound the part of the event not covered
+	// by earlier events. Both are zero when nothing counted.
+	CountedStart   time.Time
+	CountedEnd     time.Time
+	CountedMinutes int
+	OverlapMinutes int
+}
+
+// Contributions breaks the union in a calculation audit trail down by event.
+// All-day events, and events whose times can't be parsed, contribute nothing.
+func Contributions(details CalculationDetails) []EventContribution {
+	result := make([]EventContribution, len(details.Events))
+	for i, d := range details.Events {
+		result[i] = EventContribution{
+			ID:         d.ID,
+			Title:      d.Title,
+			IsAllDay:   d.IsAllDay,
+			RawMinutes: d.RawMinutes,
+		}
+		result[i].Start, _ = time.Parse(time.RFC3339, d.Start)
+		result[i].End, _ = time.Parse(time.RFC3339, d.End)
+	}
+
+	// Same ordering as computeTimeUnion
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	var coveredUntil time.Time
+	for i := range result {
+		c := &result[i]
+		if c.IsAllDay || c.Start.IsZero() || c.End.IsZero() {
+			continue
+		}
+
+		start := c.Start
+		if start.Before(coveredUntil) {
+			start = coveredUntil
+		}
+		if c.End.After(start) {
+			c.CountedStart = start
+			c.CountedEnd = c.End
+			c.CountedMinutes = int(c.End.Sub(start).Minutes())
+			coveredUntil = c.End
+		}
+		c.OverlapMinutes = c.RawMinutes - c.CountedMinutes
+	}
+
+	return result
+}
+
 // generateTitle creates a short title from the event(s).
 func generateTitle(events []Event) string {
 	if len(events) == 0 {
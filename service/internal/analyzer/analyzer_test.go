@@ -358,6 +358,88 @@ func TestComputeCalculationDetails(t *testing.T) {
 	}
 }
 
+func TestContributions(t *testing.T) {
+	date := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	projectID := uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	events := []Event{
+		{
+			ID:        uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			ProjectID: projectID,
+			Title:     "Review",
+			StartTime: date.Add(9*time.Hour + 30*time.Minute),
+			EndTime:   date.Add(10*time.Hour + 30*time.Minute),
+		},
+		{
+			ID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+			ProjectID: projectID,
+			Title:     "Planning",
+			StartTime: date.Add(9 * time.Hour),
+			EndTime:   date.Add(10 * time.Hour),
+		},
+		{
+			ID:        uuid.MustParse("33333333-3333-3333-3333-333333333333"),
+			ProjectID: projectID,
+			Title:     "Sync",
+			StartTime: date.Add(9*time.Hour + 45*time.Minute),
+			EndTime:   date.Add(10*time.Hour + 15*time.Minute),
+		},
+		{
+			ID:        uuid.MustParse("44444444-4444-4444-4444-444444444444"),
+			ProjectID: projectID,
+			Title:     "Offsite",
+			StartTime: date,
+			EndTime:   date.Add(24 * time.Hour),
+			IsAllDay:  true,
+		},
+	}
+
+	details := Compute(date, events, DefaultRoundingConfig())[0].CalculationDetails
+	contributions := Contributions(details)
+
+	if len(contributions) != 4 {
+		t.Fatalf("expected 4 contributions, got %d", len(contributions))
+	}
+
+	tests := []struct {
+		title   string
+		counted int
+		overlap int
+	}{
+		{"Offsite", 0, 0},
+		{"Planning", 60, 0},
+		{"Review", 30, 30},
+		{"Sync", 0, 30},
+	}
+
+	total := 0
+	for i, tt := range tests {
+		c := contributions[i]
+		if c.Title != tt.title {
+			t.Fatalf("contribution %d: expected %s, got %s", i, tt.title, c.Title)
+		}
+		if c.CountedMinutes != tt.counted {
+			t.Errorf("%s: expected counted=%d, got %d", tt.title, tt.counted, c.CountedMinutes)
+		}
+		if c.OverlapMinutes != tt.overlap {
+			t.Errorf("%s: expected overlap=%d, got %d", tt.title, tt.overlap, c.OverlapMinutes)
+		}
+		total += c.CountedMinutes
+	}
+
+	if total != details.UnionMinutes {
+		t.Errorf("expected counted minutes to add up to union %d, got %d", details.UnionMinutes, total)
+	}
+
+	review := contributions[2]
+	if !review.CountedStart.Equal(date.Add(10*time.Hour)) || !review.CountedEnd.Equal(date.Add(10*time.Hour+30*time.Minute)) {
+		t.Errorf("expected Review to count 10:00-10:30, got %s-%s", review.CountedStart.Format("15:04"), review.CountedEnd.Format("15:04"))
+	}
+	if !contributions[3].CountedStart.IsZero() {
+		t.Errorf("expected Sync to have no counted interval")
+	}
+}
+
 func TestGenerateTitle(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -0,0 +1,167 @@
+// Package anomaly flags days whose attributed hours look wrong: too many
+// hours, no hours on a busy workday, or a total far from the user's norm.
+package anomaly
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Kind identifies which check flagged a day
+type Kind string
+
+const (
+	KindExcessiveHours Kind = "excessive_hours"
+	KindMissingHours   Kind = "missing_hours"
+	KindUnusualTotal   Kind = "unusual_total"
+)
+
+const (
+	// MaxDailyHours is the total above which a day is flagged as excessive
+	MaxDailyHours = 12.0
+	// MinMeetingsForMissing is how many classified meetings a workday with no
+	// hours needs before it is flagged
+	MinMeetingsForMissing = 3
+	// HistoryDays is how far back the baseline for unusual totals looks
+	HistoryDays = 56
+	// minHistorySamples is the fewest worked days needed for a baseline
+	minHistorySamples = 10
+	// unusualStdDevs is how many standard deviations from the mean a total
+	// must be to count as unusual
+	unusualStdDevs = 3.0
+	// minUnusualDelta keeps a very steady history from flagging small changes
+	minUnusualDelta = 3.0
+)
+
+// Day is the attributed hours and classified meeting count for one date
+type Day struct {
+	Date             time.Time
+	Hours            float64
+	ClassifiedEvents int
+}
+
+// Finding is a single flagged day
+type Finding struct {
+	Date       time.Time
+	Kind       Kind
+	Hours      float64
+	Baseline   *float64 // Historical mean, for unusual totals
+	EventCount *int     // Classified meetings, for missing hours
+	Message    string
+}
+
+// Detect checks each day in the inclusive range [start, end]. days may also
+// include earlier dates, which form the baseline for unusual totals; dates
+// with no entry count as zero hours. dailyHours is the working-hours
+// profile, Monday first, and decides which days are workdays.
+func Detect(dailyHours [7]float64, days []Day, start, end time.Time) []Finding {
+	start = truncateDay(start)
+	end = truncateDay(end)
+
+	byDate := make(map[time.Time]Day, len(days))
+	for _, d := range days {
+		date := truncateDay(d.Date)
+		existing := byDate[date]
+		existing.Date = date
+		existing.Hours += d.Hours
+		existing.ClassifiedEvents += d.ClassifiedEvents
+		byDate[date] = existing
+	}
+
+	var findings []Finding
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		day := byDate[date]
+		hours := round2(day.Hours)
+
+		if hours > MaxDailyHours {
+			findings = append(findings, Finding{
+				Date:    date,
+				Kind:    KindExcessiveHours,
+				Hours:   hours,
+				Message: fmt.Sprintf("%.2fh attributed, more than %.0fh", hours, MaxDailyHours),
+			})
+			continue
+		}
+
+		if hours == 0 {
+			if dailyHours[weekdayIndex(date)] > 0 && day.ClassifiedEvents >= MinMeetingsForMissing {
+				count := day.ClassifiedEvents
+				findings = append(findings, Finding{
+					Date:       date,
+					Kind:       KindMissingHours,
+					Hours:      0,
+					EventCount: &count,
+					Message:    fmt.Sprintf("No hours on a workday with %d classified meetings", count),
+				})
+			}
+			continue
+		}
+
+		mean, stddev, ok := baseline(byDate, date)
+		if !ok {
+			continue
+		}
+		delta := math.Abs(hours - mean)
+		if delta >= minUnusualDelta && delta > unusualStdDevs*stddev {
+			m := round2(mean)
+			direction := "above"
+			if hours < mean {
+				direction = "below"
+			}
+			findings = append(findings, Finding{
+				Date:     date,
+				Kind:     KindUnusualTotal,
+				Hours:    hours,
+				Baseline: &m,
+				Message:  fmt.Sprintf("%.2fh is well %s the usual %.2fh", hours, direction, m),
+			})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Date.Before(findings[j].Date)
+	})
+	return findings
+}
+
+// baseline returns the mean and standard deviation of the worked days in the
+// HistoryDays before date
+func baseline(byDate map[time.Time]Day, date time.Time) (mean, stddev float64, ok bool) {
+	var samples []float64
+	for d := date.AddDate(0, 0, -HistoryDays); d.Before(date); d = d.AddDate(0, 0, 1) {
+		if h := byDate[d].Hours; h > 0 {
+			samples = append(samples, h)
+		}
+	}
+	if len(samples) < minHistorySamples {
+		return 0, 0, false
+	}
+
+	var sum float64
+	for _, h := range samples {
+		sum += h
+	}
+	mean = sum / float64(len(samples))
+
+	var variance float64
+	for _, h := range samples {
+		variance += (h - mean) * (h - mean)
+	}
+	stddev = math.Sqrt(variance / float64(len(samples)))
+	return mean, stddev, true
+}
+
+// weekdayIndex maps a date to a Monday-first profile index
+func weekdayIndex(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,101 @@
+package anomaly
+
+import (
+	"testing"
+	"time"
+)
+
+var weekdays = [7]float64{8, 8, 8, 8, 8, 0, 0}
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+// history returns eight-hour workdays for the HistoryDays before end
+func history(end time.Time) []Day {
+	var days []Day
+	for d := end.AddDate(0, 0, -HistoryDays); d.Before(end); d = d.AddDate(0, 0, 1) {
+		if weekdays[weekdayIndex(d)] > 0 {
+			hours := 8.0
+			if d.Day()%2 == 0 {
+				hours = 7.5
+			}
+			days = append(days, Day{Date: d, Hours: hours})
+		}
+	}
+	return days
+}
+
+func TestDetect_ExcessiveHours(t *testing.T) {
+	days := []Day{
+		{Date: date("2025-07-07"), Hours: 9},
+		{Date: date("2025-07-07"), Hours: 4.5},
+		{Date: date("2025-07-08"), Hours: 12},
+	}
+	findings := Detect(weekdays, days, date("2025-07-07"), date("2025-07-08"))
+
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %+v", len(findings), findings)
+	}
+	f := findings[0]
+	if f.Kind != KindExcessiveHours || !f.Date.Equal(date("2025-07-07")) || f.Hours != 13.5 {
+		t.Errorf("unexpected finding %+v", f)
+	}
+}
+
+func TestDetect_MissingHoursOnlyOnBusyWorkdays(t *testing.T) {
+	days := []Day{
+		// Monday, busy and empty: flagged
+		{Date: date("2025-07-07"), ClassifiedEvents: 4},
+		// Tuesday, too few meetings
+		{Date: date("2025-07-08"), ClassifiedEvents: 2},
+		// Saturday is not a workday
+		{Date: date("2025-07-12"), ClassifiedEvents: 5},
+	}
+	findings := Detect(weekdays, days, date("2025-07-07"), date("2025-07-13"))
+
+	if len(findings) != 1 {
+		t.Fatalf("expected 1 finding, got %d: %+v", len(findings), findings)
+	}
+	f := findings[0]
+	if f.Kind != KindMissingHours || !f.Date.Equal(date("2025-07-07")) {
+		t.Errorf("unexpected finding %+v", f)
+	}
+	if f.EventCount == nil || *f.EventCount != 4 {
+		t.Errorf("expected event count 4, got %v", f.EventCount)
+	}
+}
+
+func TestDetect_UnusualTotal(t *testing.T) {
+	start := date("2025-07-07")
+	days := append(history(start),
+		Day{Date: date("2025-07-07"), Hours: 2},
+		Day{Date: date("2025-07-08"), Hours: 8},
+		Day{Date: date("2025-07-09"), Hours: 11.5},
+	)
+	findings := Detect(weekdays, days, start, date("2025-07-09"))
+
+	if len(findings) != 2 {
+		t.Fatalf("expected 2 findings, got %d: %+v", len(findings), findings)
+	}
+	for i, want := range []string{"2025-07-07", "2025-07-09"} {
+		f := findings[i]
+		if f.Kind != KindUnusualTotal || !f.Date.Equal(date(want)) {
+			t.Errorf("finding %d: unexpected %+v", i, f)
+		}
+		if f.Baseline == nil || *f.Baseline < 7.5 || *f.Baseline > 8 {
+			t.Errorf("finding %d: unexpected baseline %v", i, f.Baseline)
+		}
+	}
+}
+
+func TestDetect_NoBaselineWithoutHistory(t *testing.T) {
+	days := []Day{
+		{Date: date("2025-07-01"), Hours: 8},
+		{Date: date("2025-07-07"), Hours: 11.5},
+	}
+	if findings := Detect(weekdays, days, date("2025-07-07"), date("2025-07-07")); len(findings) != 0 {
+		t.Errorf("expected no findings, got %+v", findings)
+	}
+}
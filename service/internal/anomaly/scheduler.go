@@ -0,0 +1,76 @@
+package anomaly
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// DefaultInterval is how often the scheduled analysis runs
+const DefaultInterval = 24 * time.Hour
+
+// Scheduler runs the anomaly analysis periodically
+type Scheduler struct {
+	service  *Service
+	interval time.Duration
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewScheduler creates a new anomaly scheduler
+func NewScheduler(service *Service, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		service:  service,
+		interval: interval,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+}
+
+// Start begins the analysis loop
+func (s *Scheduler) Start(ctx context.Context) {
+	log.Printf("Starting anomaly scheduler (interval: %v)", s.interval)
+
+	go func() {
+		defer close(s.doneCh)
+
+		// Initial delay to let the server start up
+		select {
+		case <-time.After(time.Minute):
+		case <-s.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		s.run(ctx)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.run(ctx)
+			case <-s.stopCh:
+				log.Println("Anomaly scheduler stopped")
+				return
+			case <-ctx.Done():
+				log.Println("Anomaly scheduler context cancelled")
+				return
+			}
+		}
+	}()
+}
+
+// Stop gracefully stops the scheduler
+func (s *Scheduler) Stop() {
+	close(s.stopCh)
+	<-s.doneCh
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	if err := s.service.RunScheduled(ctx); err != nil {
+		log.Printf("Anomaly analysis failed: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package anomaly
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+)
+
+// ScheduledWindowDays is how many completed days the scheduled analysis
+// re-checks on each run
+const ScheduledWindowDays = 7
+
+// Service loads daily totals and classified meetings, runs Detect and keeps
+// the stored anomalies in step with the results
+type Service struct {
+	anomalies    *store.DayAnomalyStore
+	timeEntrySvc *timeentry.Service
+	projects     *store.ProjectStore
+	events       *store.CalendarEventStore
+	workingHours *store.WorkingHoursStore
+}
+
+// NewService creates a new anomaly service
+func NewService(anomalies *store.DayAnomalyStore, timeEntrySvc *timeentry.Service, projects *store.ProjectStore, events *store.CalendarEventStore, workingHours *store.WorkingHoursStore) *Service {
+	return &Service{
+		anomalies:    anomalies,
+		timeEntrySvc: timeEntrySvc,
+		projects:     projects,
+		events:       events,
+		workingHours: workingHours,
+	}
+}
+
+// Analyze checks the inclusive date range for a user, stores what it finds
+// and returns the open anomalies in the range. Projects that don't
+// accumulate hours are left out of the daily totals.
+func (s *Service) Analyze(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]*store.DayAnomaly, error) {
+	start = truncateDay(start)
+	end = truncateDay(end)
+
+	profile, err := s.workingHours.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	skipProject := make(map[uuid.UUID]bool, len(projects))
+	for _, p := range projects {
+		skipProject[p.ID] = p.DoesNotAccumulateHours
+	}
+
+	historyStart := start.AddDate(0, 0, -HistoryDays)
+	entries, err := s.timeEntrySvc.ListWithEphemeral(ctx, userID, &historyStart, &end, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	classified := store.StatusClassified
+	events, err := s.events.List(ctx, userID, &start, &end, &classified, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]Day, 0, len(entries)+len(events))
+	for _, te := range entries {
+		if skipProject[te.ProjectID] {
+			continue
+		}
+		days = append(days, Day{Date: te.Date, Hours: te.Hours})
+	}
+	for _, e := range events {
+		if e.IsSkipped || e.IsAllDay || e.ProjectID == nil {
+			continue
+		}
+		days = append(days, Day{Date: e.StartTime, ClassifiedEvents: 1})
+	}
+
+	findings := Detect(profile.DailyHours, days, start, end)
+	found := make([]*store.DayAnomaly, 0, len(findings))
+	for _, f := range findings {
+		found = append(found, &store.DayAnomaly{
+			UserID:        userID,
+			Date:          f.Date,
+			Kind:          string(f.Kind),
+			Message:       f.Message,
+			Hours:         f.Hours,
+			BaselineHours: f.Baseline,
+			EventCount:    f.EventCount,
+		})
+	}
+
+	if err := s.anomalies.Replace(ctx, userID, start, end, found); err != nil {
+		return nil, err
+	}
+	return s.anomalies.List(ctx, userID, &start, &end, false)
+}
+
+// RunScheduled analyzes the last ScheduledWindowDays completed days for
+// every user with recent activity. A failure for one user is logged and
+// does not stop the others.
+func (s *Service) RunScheduled(ctx context.Context) error {
+	end := truncateDay(time.Now().UTC()).AddDate(0, 0, -1)
+	start := end.AddDate(0, 0, -(ScheduledWindowDays - 1))
+
+	userIDs, err := s.anomalies.ListActiveUserIDs(ctx, start)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, err := s.Analyze(ctx, userID, start, end); err != nil {
+			log.Printf("Anomaly analysis failed for user %s: %v", userID, err)
+		}
+	}
+	return nil
+}
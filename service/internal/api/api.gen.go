@@ -21,9 +21,18 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CalendarSyncFailureCategory.
+const (
+	Auth      CalendarSyncFailureCategory = "auth"
+	Permanent CalendarSyncFailureCategory = "permanent"
+	Quota     CalendarSyncFailureCategory = "quota"
+	Transient CalendarSyncFailureCategory = "transient"
+)
+
 // Defines values for CalendarConnectionProvider.
 const (
-	Google CalendarConnectionProvider = "google"
+	Google          CalendarConnectionProvider = "google"
+	GoogleWorkspace CalendarConnectionProvider = "google_workspace"
 )
 
 // Defines values for CalendarEventClassificationSource.
@@ -40,11 +49,160 @@ const (
 	CalendarEventClassificationStatusPending    CalendarEventClassificationStatus = "pending"
 )
 
+// Defines values for CalendarEventDiffItemKind.
+const (
+	Added     CalendarEventDiffItemKind = "added"
+	Cancelled CalendarEventDiffItemKind = "cancelled"
+	Changed   CalendarEventDiffItemKind = "changed"
+	Moved     CalendarEventDiffItemKind = "moved"
+)
+
+// Defines values for CalendarWriteBackMode.
+const (
+	Color      CalendarWriteBackMode = "color"
+	Off        CalendarWriteBackMode = "off"
+	Properties CalendarWriteBackMode = "properties"
+)
+
+// Defines values for ClassificationActionKind.
+const (
+	ApplyRules   ClassificationActionKind = "apply_rules"
+	BulkClassify ClassificationActionKind = "bulk_classify"
+	Classify     ClassificationActionKind = "classify"
+)
+
+// Defines values for ClassificationJobStatus.
+const (
+	ClassificationJobStatusCompleted ClassificationJobStatus = "completed"
+	ClassificationJobStatusFailed    ClassificationJobStatus = "failed"
+	ClassificationJobStatusPending   ClassificationJobStatus = "pending"
+	ClassificationJobStatusRunning   ClassificationJobStatus = "running"
+)
+
+// Defines values for DayAnomalyKind.
+const (
+	ExcessiveHours DayAnomalyKind = "excessive_hours"
+	MissingHours   DayAnomalyKind = "missing_hours"
+	UnusualTotal   DayAnomalyKind = "unusual_total"
+)
+
+// Defines values for DelegationProvisionMemberStatus.
+const (
+	DelegationProvisionMemberStatusFailed      DelegationProvisionMemberStatus = "failed"
+	DelegationProvisionMemberStatusProvisioned DelegationProvisionMemberStatus = "provisioned"
+	DelegationProvisionMemberStatusSkipped     DelegationProvisionMemberStatus = "skipped"
+)
+
+// Defines values for EventClassificationHistoryEntrySource.
+const (
+	EventClassificationHistoryEntrySourceFingerprint EventClassificationHistoryEntrySource = "fingerprint"
+	EventClassificationHistoryEntrySourceLlm         EventClassificationHistoryEntrySource = "llm"
+	EventClassificationHistoryEntrySourceManual      EventClassificationHistoryEntrySource = "manual"
+	EventClassificationHistoryEntrySourceRule        EventClassificationHistoryEntrySource = "rule"
+)
+
+// Defines values for EventClassificationVoteSource.
+const (
+	EventClassificationVoteSourceFingerprint EventClassificationVoteSource = "fingerprint"
+	EventClassificationVoteSourceRule        EventClassificationVoteSource = "rule"
+)
+
+// Defines values for FingerprintKind.
+const (
+	Domain  FingerprintKind = "domain"
+	Email   FingerprintKind = "email"
+	Keyword FingerprintKind = "keyword"
+)
+
+// Defines values for GoogleAccess.
+const (
+	GoogleAccessCalendar      GoogleAccess = "calendar"
+	GoogleAccessCalendarWrite GoogleAccess = "calendar_write"
+	GoogleAccessSheets        GoogleAccess = "sheets"
+)
+
+// Defines values for InvoiceKind.
+const (
+	InvoiceKindCreditNote InvoiceKind = "credit_note"
+	InvoiceKindInvoice    InvoiceKind = "invoice"
+)
+
 // Defines values for InvoiceStatus.
 const (
-	InvoiceStatusDraft InvoiceStatus = "draft"
-	InvoiceStatusPaid  InvoiceStatus = "paid"
-	InvoiceStatusSent  InvoiceStatus = "sent"
+	InvoiceStatusDraft  InvoiceStatus = "draft"
+	InvoiceStatusPaid   InvoiceStatus = "paid"
+	InvoiceStatusReview InvoiceStatus = "review"
+	InvoiceStatusSent   InvoiceStatus = "sent"
+)
+
+// Defines values for InvoiceLineItemKind.
+const (
+	InvoiceLineItemKindAdjustment InvoiceLineItemKind = "adjustment"
+	InvoiceLineItemKindExpense    InvoiceLineItemKind = "expense"
+	InvoiceLineItemKindFee        InvoiceLineItemKind = "fee"
+	InvoiceLineItemKindOverage    InvoiceLineItemKind = "overage"
+	InvoiceLineItemKindTime       InvoiceLineItemKind = "time"
+)
+
+// Defines values for InvoiceLineItemRateSource.
+const (
+	InvoiceLineItemRateSourceClient  InvoiceLineItemRateSource = "client"
+	InvoiceLineItemRateSourceNone    InvoiceLineItemRateSource = "none"
+	InvoiceLineItemRateSourceProject InvoiceLineItemRateSource = "project"
+)
+
+// Defines values for MemberDelegationInputCalendars.
+const (
+	MemberDelegationInputCalendarsAll     MemberDelegationInputCalendars = "all"
+	MemberDelegationInputCalendarsNone    MemberDelegationInputCalendars = "none"
+	MemberDelegationInputCalendarsPrimary MemberDelegationInputCalendars = "primary"
+)
+
+// Defines values for OrganizationRole.
+const (
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+	OrganizationRoleOwner  OrganizationRole = "owner"
+)
+
+// Defines values for OrganizationDelegationDefaultCalendars.
+const (
+	OrganizationDelegationDefaultCalendarsAll     OrganizationDelegationDefaultCalendars = "all"
+	OrganizationDelegationDefaultCalendarsNone    OrganizationDelegationDefaultCalendars = "none"
+	OrganizationDelegationDefaultCalendarsPrimary OrganizationDelegationDefaultCalendars = "primary"
+)
+
+// Defines values for OrganizationDelegationInputDefaultCalendars.
+const (
+	OrganizationDelegationInputDefaultCalendarsAll     OrganizationDelegationInputDefaultCalendars = "all"
+	OrganizationDelegationInputDefaultCalendarsNone    OrganizationDelegationInputDefaultCalendars = "none"
+	OrganizationDelegationInputDefaultCalendarsPrimary OrganizationDelegationInputDefaultCalendars = "primary"
+)
+
+// Defines values for OrganizationMemberDelegationCalendars.
+const (
+	All     OrganizationMemberDelegationCalendars = "all"
+	None    OrganizationMemberDelegationCalendars = "none"
+	Primary OrganizationMemberDelegationCalendars = "primary"
+)
+
+// Defines values for OrganizationMemberRole.
+const (
+	OrganizationMemberRoleAdmin  OrganizationMemberRole = "admin"
+	OrganizationMemberRoleMember OrganizationMemberRole = "member"
+	OrganizationMemberRoleOwner  OrganizationMemberRole = "owner"
+)
+
+// Defines values for ReconcileRequestResolution.
+const (
+	AcceptComputed ReconcileRequestResolution = "accept_computed"
+	KeepManual     ReconcileRequestResolution = "keep_manual"
+)
+
+// Defines values for ReviewDecisionDecision.
+const (
+	Accept   ReviewDecisionDecision = "accept"
+	Override ReviewDecisionDecision = "override"
 )
 
 // Defines values for RuleEvaluationSource.
@@ -53,6 +211,23 @@ const (
 	RuleEvaluationSourceRule        RuleEvaluationSource = "rule"
 )
 
+// Defines values for RuleLintIssueKind.
+const (
+	AlwaysFalse     RuleLintIssueKind = "always_false"
+	AlwaysTrue      RuleLintIssueKind = "always_true"
+	Conflict        RuleLintIssueKind = "conflict"
+	Duplicate       RuleLintIssueKind = "duplicate"
+	Redundant       RuleLintIssueKind = "redundant"
+	SyntaxError     RuleLintIssueKind = "syntax_error"
+	UnknownProperty RuleLintIssueKind = "unknown_property"
+)
+
+// Defines values for RuleLintIssueSeverity.
+const (
+	RuleLintIssueSeverityError   RuleLintIssueSeverity = "error"
+	RuleLintIssueSeverityWarning RuleLintIssueSeverity = "warning"
+)
+
 // Defines values for TimeEntrySource.
 const (
 	TimeEntrySourceCalendar TimeEntrySource = "calendar"
@@ -60,58 +235,131 @@ const (
 	TimeEntrySourceManual   TimeEntrySource = "manual"
 )
 
+// Defines values for TimeEntryExcludedEventReason.
+const (
+	TimeEntryExcludedEventReasonNotAttended                   TimeEntryExcludedEventReason = "not_attended"
+	TimeEntryExcludedEventReasonNotYetCounted                 TimeEntryExcludedEventReason = "not_yet_counted"
+	TimeEntryExcludedEventReasonProjectDoesNotAccumulateHours TimeEntryExcludedEventReason = "project_does_not_accumulate_hours"
+	TimeEntryExcludedEventReasonSkipped                       TimeEntryExcludedEventReason = "skipped"
+)
+
 // Defines values for ListCalendarEventsParamsClassificationStatus.
 const (
-	ListCalendarEventsParamsClassificationStatusClassified ListCalendarEventsParamsClassificationStatus = "classified"
-	ListCalendarEventsParamsClassificationStatusPending    ListCalendarEventsParamsClassificationStatus = "pending"
-	ListCalendarEventsParamsClassificationStatusSkipped    ListCalendarEventsParamsClassificationStatus = "skipped"
+	Classified ListCalendarEventsParamsClassificationStatus = "classified"
+	Pending    ListCalendarEventsParamsClassificationStatus = "pending"
+	Skipped    ListCalendarEventsParamsClassificationStatus = "skipped"
 )
 
 // Defines values for ListInvoicesParamsStatus.
 const (
-	ListInvoicesParamsStatusDraft ListInvoicesParamsStatus = "draft"
-	ListInvoicesParamsStatusPaid  ListInvoicesParamsStatus = "paid"
-	ListInvoicesParamsStatusSent  ListInvoicesParamsStatus = "sent"
+	ListInvoicesParamsStatusDraft  ListInvoicesParamsStatus = "draft"
+	ListInvoicesParamsStatusPaid   ListInvoicesParamsStatus = "paid"
+	ListInvoicesParamsStatusReview ListInvoicesParamsStatus = "review"
+	ListInvoicesParamsStatusSent   ListInvoicesParamsStatus = "sent"
+)
+
+// Defines values for ExportInvoiceParamsFormat.
+const (
+	Csv    ExportInvoiceParamsFormat = "csv"
+	Pdf    ExportInvoiceParamsFormat = "pdf"
+	Sheets ExportInvoiceParamsFormat = "sheets"
 )
 
 // Defines values for UpdateInvoiceStatusJSONBodyStatus.
 const (
-	Draft UpdateInvoiceStatusJSONBodyStatus = "draft"
-	Paid  UpdateInvoiceStatusJSONBodyStatus = "paid"
-	Sent  UpdateInvoiceStatusJSONBodyStatus = "sent"
+	Draft  UpdateInvoiceStatusJSONBodyStatus = "draft"
+	Paid   UpdateInvoiceStatusJSONBodyStatus = "paid"
+	Review UpdateInvoiceStatusJSONBodyStatus = "review"
+	Sent   UpdateInvoiceStatusJSONBodyStatus = "sent"
 )
 
+// AnalyzeAnomaliesRequest defines model for AnalyzeAnomaliesRequest.
+type AnalyzeAnomaliesRequest struct {
+	// EndDate Defaults to yesterday
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
 // ApiKey defines model for ApiKey.
 type ApiKey struct {
-	CreatedAt time.Time          `json:"created_at"`
-	Id        openapi_types.UUID `json:"id"`
+	// AllowedTools MCP tools this key may call. Empty allows every tool.
+	AllowedTools []string  `json:"allowed_tools"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	// DeniedTools MCP tools this key may never call, even if allowed_tools lists them
+	DeniedTools []string           `json:"denied_tools"`
+	Id          openapi_types.UUID `json:"id"`
 
 	// KeyPrefix First few characters of the key for identification
 	KeyPrefix  string     `json:"key_prefix"`
 	LastUsedAt *time.Time `json:"last_used_at"`
 
 	// Name User-provided name for this key
-	Name   string             `json:"name"`
-	UserId openapi_types.UUID `json:"user_id"`
+	Name string `json:"name"`
+
+	// RequireConfirmation Whether destructive MCP tools (bulk_classify, apply_rules) called with
+	// this key first return a preview and a confirmation token, and only
+	// execute when called again with the token
+	RequireConfirmation bool               `json:"require_confirmation"`
+	UserId              openapi_types.UUID `json:"user_id"`
 }
 
 // ApiKeyCreate defines model for ApiKeyCreate.
 type ApiKeyCreate struct {
+	// AllowedTools MCP tools this key may call. Empty allows every tool.
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// DeniedTools MCP tools this key may never call, even if allowed_tools lists them
+	DeniedTools *[]string `json:"denied_tools,omitempty"`
+
 	// Name A memorable name for this key
 	Name string `json:"name"`
+
+	// RequireConfirmation Whether destructive MCP tools (bulk_classify, apply_rules) called with
+	// this key first return a preview and a confirmation token, and only
+	// execute when called again with the token
+	RequireConfirmation *bool `json:"require_confirmation,omitempty"`
+}
+
+// ApiKeyUpdate Settings to change; omitted fields are left as they are
+type ApiKeyUpdate struct {
+	// AllowedTools MCP tools this key may call. Empty allows every tool.
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// DeniedTools MCP tools this key may never call, even if allowed_tools lists them
+	DeniedTools *[]string `json:"denied_tools,omitempty"`
+
+	// RequireConfirmation Whether destructive MCP tools (bulk_classify, apply_rules) called with
+	// this key first return a preview and a confirmation token, and only
+	// execute when called again with the token
+	RequireConfirmation *bool `json:"require_confirmation,omitempty"`
 }
 
 // ApiKeyWithSecret defines model for ApiKeyWithSecret.
 type ApiKeyWithSecret struct {
-	CreatedAt time.Time          `json:"created_at"`
-	Id        openapi_types.UUID `json:"id"`
+	AllowedTools []string           `json:"allowed_tools"`
+	CreatedAt    time.Time          `json:"created_at"`
+	DeniedTools  []string           `json:"denied_tools"`
+	Id           openapi_types.UUID `json:"id"`
 
 	// Key The full API key. This is only returned once at creation time.
 	// Store it securely - it cannot be retrieved again.
-	Key       string             `json:"key"`
-	KeyPrefix string             `json:"key_prefix"`
-	Name      string             `json:"name"`
-	UserId    openapi_types.UUID `json:"user_id"`
+	Key                 string             `json:"key"`
+	KeyPrefix           string             `json:"key_prefix"`
+	Name                string             `json:"name"`
+	RequireConfirmation bool               `json:"require_confirmation"`
+	UserId              openapi_types.UUID `json:"user_id"`
+}
+
+// ApplyRulesAsyncRequest defines model for ApplyRulesAsyncRequest.
+type ApplyRulesAsyncRequest struct {
+	// BatchSize Events loaded and evaluated per batch
+	BatchSize *int `json:"batch_size,omitempty"`
+
+	// DryRun If true, count what would be classified without making changes
+	DryRun    *bool               `json:"dry_run,omitempty"`
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
 }
 
 // ApplyRulesRequest defines model for ApplyRulesRequest.
@@ -124,12 +372,43 @@ type ApplyRulesRequest struct {
 
 // ApplyRulesResponse defines model for ApplyRulesResponse.
 type ApplyRulesResponse struct {
-	Classified []ClassifiedEvent `json:"classified"`
+	// ActionId Journal entry for this run, when any events changed (not set for dry runs)
+	ActionId   *openapi_types.UUID `json:"action_id,omitempty"`
+	Classified []ClassifiedEvent   `json:"classified"`
 
 	// Skipped Events that matched no rules or were below confidence threshold
 	Skipped int `json:"skipped"`
 }
 
+// AttendeeAlias defines model for AttendeeAlias.
+type AttendeeAlias struct {
+	// AliasEmail Another email of the same person
+	AliasEmail string `json:"alias_email"`
+
+	// CanonicalEmail The person's main email
+	CanonicalEmail string             `json:"canonical_email"`
+	CreatedAt      time.Time          `json:"created_at"`
+	Id             openapi_types.UUID `json:"id"`
+}
+
+// AttendeeAliasCreate defines model for AttendeeAliasCreate.
+type AttendeeAliasCreate struct {
+	AliasEmail     string `json:"alias_email"`
+	CanonicalEmail string `json:"canonical_email"`
+}
+
+// AudienceSplit Time on internal vs client-facing events, from the durations of
+// counted classified events (overlapping events each count in full).
+// Absent until company domains are set.
+type AudienceSplit struct {
+	// ClientFacingHours Events with an attendee outside the company domains
+	ClientFacingHours float64 `json:"client_facing_hours"`
+
+	// ClientFacingShare Client-facing hours / both
+	ClientFacingShare float64 `json:"client_facing_share"`
+	InternalHours     float64 `json:"internal_hours"`
+}
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	Token string `json:"token"`
@@ -138,15 +417,32 @@ type AuthResponse struct {
 
 // BillingPeriod defines model for BillingPeriod.
 type BillingPeriod struct {
-	CreatedAt time.Time `json:"created_at"`
+	// BillingMode hourly, fixed (monthly fee) or retainer (monthly fee with included hours and an overage rate)
+	BillingMode string    `json:"billing_mode"`
+	CreatedAt   time.Time `json:"created_at"`
 
 	// EndsOn End date of the billing period (null means ongoing)
 	EndsOn *openapi_types.Date `json:"ends_on"`
 
-	// HourlyRate Hourly rate for this period
+	// FixedFee Monthly fee for fixed and retainer periods
+	FixedFee *float64 `json:"fixed_fee"`
+
+	// HourlyRate Hourly rate for this period; used by hourly periods only
 	HourlyRate float32            `json:"hourly_rate"`
 	Id         openapi_types.UUID `json:"id"`
-	ProjectId  openapi_types.UUID `json:"project_id"`
+
+	// IncludedHours Hours per month covered by a retainer's fee
+	IncludedHours *float64 `json:"included_hours"`
+
+	// IncrementHours Each day's hours are rounded up to a multiple of this (e.g. 0.25)
+	IncrementHours *float64 `json:"increment_hours"`
+
+	// MinDailyHours Each day with time is billed at least this many hours
+	MinDailyHours *float64 `json:"min_daily_hours"`
+
+	// OverageRate Hourly rate for retainer hours beyond the included hours
+	OverageRate *float64           `json:"overage_rate"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
 
 	// StartsOn Start date of the billing period
 	StartsOn  openapi_types.Date `json:"starts_on"`
@@ -156,10 +452,28 @@ type BillingPeriod struct {
 
 // BillingPeriodCreate defines model for BillingPeriodCreate.
 type BillingPeriodCreate struct {
+	// BillingMode Defaults to hourly. hourly, fixed (monthly fee) or retainer (monthly fee with included hours and an overage rate)
+	BillingMode *string `json:"billing_mode,omitempty"`
+
 	// EndsOn End date in YYYY-MM-DD format (omit or null for ongoing)
-	EndsOn     *openapi_types.Date `json:"ends_on"`
-	HourlyRate float32             `json:"hourly_rate"`
-	ProjectId  openapi_types.UUID  `json:"project_id"`
+	EndsOn *openapi_types.Date `json:"ends_on"`
+
+	// FixedFee Monthly fee for fixed and retainer periods
+	FixedFee   *float64 `json:"fixed_fee"`
+	HourlyRate float32  `json:"hourly_rate"`
+
+	// IncludedHours Hours per month covered by a retainer's fee
+	IncludedHours *float64 `json:"included_hours"`
+
+	// IncrementHours Each day's hours are rounded up to a multiple of this (e.g. 0.25)
+	IncrementHours *float64 `json:"increment_hours"`
+
+	// MinDailyHours Each day with time is billed at least this many hours
+	MinDailyHours *float64 `json:"min_daily_hours"`
+
+	// OverageRate Hourly rate for retainer hours beyond the included hours
+	OverageRate *float64           `json:"overage_rate"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
 
 	// StartsOn Start date in YYYY-MM-DD format
 	StartsOn openapi_types.Date `json:"starts_on"`
@@ -167,10 +481,28 @@ type BillingPeriodCreate struct {
 
 // BillingPeriodUpdate defines model for BillingPeriodUpdate.
 type BillingPeriodUpdate struct {
+	// BillingMode hourly, fixed (monthly fee) or retainer (monthly fee with included hours and an overage rate)
+	BillingMode *string `json:"billing_mode,omitempty"`
+
 	// EndsOn Set to empty string to clear (make ongoing)
-	EndsOn     *openapi_types.Date `json:"ends_on"`
-	HourlyRate *float32            `json:"hourly_rate,omitempty"`
-	StartsOn   *openapi_types.Date `json:"starts_on,omitempty"`
+	EndsOn *openapi_types.Date `json:"ends_on"`
+
+	// FixedFee Monthly fee for fixed and retainer periods
+	FixedFee   *float64 `json:"fixed_fee,omitempty"`
+	HourlyRate *float32 `json:"hourly_rate,omitempty"`
+
+	// IncludedHours Hours per month covered by a retainer's fee
+	IncludedHours *float64 `json:"included_hours,omitempty"`
+
+	// IncrementHours Each day's hours are rounded up to a multiple of this (e.g. 0.25). Set to 0 to remove.
+	IncrementHours *float64 `json:"increment_hours,omitempty"`
+
+	// MinDailyHours Each day with time is billed at least this many hours. Set to 0 to remove.
+	MinDailyHours *float64 `json:"min_daily_hours,omitempty"`
+
+	// OverageRate Hourly rate for retainer hours beyond the included hours
+	OverageRate *float64            `json:"overage_rate,omitempty"`
+	StartsOn    *openapi_types.Date `json:"starts_on,omitempty"`
 }
 
 // BulkClassifyRequest defines model for BulkClassifyRequest.
@@ -187,6 +519,9 @@ type BulkClassifyRequest struct {
 
 // BulkClassifyResponse defines model for BulkClassifyResponse.
 type BulkClassifyResponse struct {
+	// ActionId Journal entry for this change, when any events changed
+	ActionId *openapi_types.UUID `json:"action_id,omitempty"`
+
 	// ClassifiedCount Number of events classified to a project
 	ClassifiedCount int `json:"classified_count"`
 
@@ -224,6 +559,18 @@ type Calendar struct {
 	ConnectionId openapi_types.UUID `json:"connection_id"`
 	CreatedAt    time.Time          `json:"created_at"`
 
+	// DefaultProjectId Project this calendar's events default to
+	DefaultProjectId *openapi_types.UUID `json:"default_project_id"`
+
+	// DefaultProjectWeight Vote weight of the default project; null for a low-weight hint that needs review
+	DefaultProjectWeight *float64 `json:"default_project_weight"`
+
+	// DisplayColor User override of the color (hex code)
+	DisplayColor *string `json:"display_color"`
+
+	// DisplayName User override of the name
+	DisplayName *string `json:"display_name"`
+
 	// ExternalId Google Calendar ID (e.g., "primary", "user@example.com")
 	ExternalId string             `json:"external_id"`
 	Id         openapi_types.UUID `json:"id"`
@@ -236,21 +583,60 @@ type Calendar struct {
 	LastSyncedAt *time.Time `json:"last_synced_at"`
 
 	// Name Display name of the calendar
-	Name      string     `json:"name"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Name string `json:"name"`
+
+	// SyncDisabledAt When a permanent failure stopped syncing; deselect and reselect the calendar to retry
+	SyncDisabledAt *time.Time `json:"sync_disabled_at"`
+
+	// SyncError Error message of the last failed sync
+	SyncError *string `json:"sync_error"`
+
+	// SyncFailureCategory Why the last sync failed; null once a sync succeeds. `auth` needs
+	// the calendar reconnected, `permanent` (deleted or unshared
+	// calendar) disables sync, `transient` is retried up to three
+	// times and `quota` is retried when the API quota resets.
+	SyncFailureCategory *CalendarSyncFailureCategory `json:"sync_failure_category"`
+	UpdatedAt           *time.Time                   `json:"updated_at,omitempty"`
 }
 
+// CalendarSyncFailureCategory Why the last sync failed; null once a sync succeeds. `auth` needs
+// the calendar reconnected, `permanent` (deleted or unshared
+// calendar) disables sync, `transient` is retried up to three
+// times and `quota` is retried when the API quota resets.
+type CalendarSyncFailureCategory string
+
 // CalendarConnection defines model for CalendarConnection.
 type CalendarConnection struct {
-	CreatedAt    time.Time                  `json:"created_at"`
-	Id           openapi_types.UUID         `json:"id"`
-	LastSyncedAt *time.Time                 `json:"last_synced_at"`
-	Provider     CalendarConnectionProvider `json:"provider"`
-	UpdatedAt    *time.Time                 `json:"updated_at,omitempty"`
-	UserId       openapi_types.UUID         `json:"user_id"`
+	// Access Features the granted scopes cover
+	Access    *[]GoogleAccess `json:"access,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+
+	// GrantedScopes OAuth scopes the user consented to
+	GrantedScopes *[]string          `json:"granted_scopes,omitempty"`
+	Id            openapi_types.UUID `json:"id"`
+	LastSyncedAt  *time.Time         `json:"last_synced_at"`
+
+	// PausedAt When the user paused syncing; null while syncing
+	PausedAt *time.Time `json:"paused_at"`
+
+	// Provider google_workspace connections are provisioned by an organization's
+	// service account and can't be reauthorized or written back to
+	Provider CalendarConnectionProvider `json:"provider"`
+
+	// SyncFutureDays Days of future to sync; null uses the server default
+	SyncFutureDays *int `json:"sync_future_days"`
+
+	// SyncHistoryDays Days of history to sync; null uses the server default
+	SyncHistoryDays *int               `json:"sync_history_days"`
+	UpdatedAt       *time.Time         `json:"updated_at,omitempty"`
+	UserId          openapi_types.UUID `json:"user_id"`
+
+	// WriteBack How classified projects are written back to Google events
+	WriteBack *CalendarWriteBackMode `json:"write_back,omitempty"`
 }
 
-// CalendarConnectionProvider defines model for CalendarConnection.Provider.
+// CalendarConnectionProvider google_workspace connections are provisioned by an organization's
+// service account and can't be reauthorized or written back to
 type CalendarConnectionProvider string
 
 // CalendarEvent defines model for CalendarEvent.
@@ -273,12 +659,22 @@ type CalendarEvent struct {
 	ConnectionId             openapi_types.UUID                 `json:"connection_id"`
 	CreatedAt                time.Time                          `json:"created_at"`
 	Description              *string                            `json:"description"`
-	EndTime                  time.Time                          `json:"end_time"`
-	ExternalId               string                             `json:"external_id"`
-	Id                       openapi_types.UUID                 `json:"id"`
+
+	// DurationChangedAt Set when a re-sync changed the duration of this classified event
+	// materially (15 minutes or more) since its time entry was computed.
+	// Cleared when the entry is recomputed.
+	DurationChangedAt *time.Time         `json:"duration_changed_at"`
+	EndTime           time.Time          `json:"end_time"`
+	ExternalId        string             `json:"external_id"`
+	Id                openapi_types.UUID `json:"id"`
 
 	// IsAllDay Whether this is an all-day event (no specific start/end times)
-	IsAllDay    *bool `json:"is_all_day,omitempty"`
+	IsAllDay *bool `json:"is_all_day,omitempty"`
+
+	// IsInternal True when no attendee is outside the user's company domains,
+	// including events without attendees; null until company domains
+	// are set
+	IsInternal  *bool `json:"is_internal"`
 	IsOrphaned  *bool `json:"is_orphaned,omitempty"`
 	IsRecurring *bool `json:"is_recurring,omitempty"`
 
@@ -292,10 +688,13 @@ type CalendarEvent struct {
 	ProjectId      *openapi_types.UUID `json:"project_id"`
 	ResponseStatus *string             `json:"response_status"`
 	StartTime      time.Time           `json:"start_time"`
-	Title          string              `json:"title"`
-	Transparency   *string             `json:"transparency"`
-	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
-	UserId         openapi_types.UUID  `json:"user_id"`
+
+	// Tags The user's tags on this event
+	Tags         *[]string          `json:"tags,omitempty"`
+	Title        string             `json:"title"`
+	Transparency *string            `json:"transparency"`
+	UpdatedAt    *time.Time         `json:"updated_at,omitempty"`
+	UserId       openapi_types.UUID `json:"user_id"`
 }
 
 // CalendarEventClassificationSource defines model for CalendarEvent.ClassificationSource.
@@ -304,6 +703,134 @@ type CalendarEventClassificationSource string
 // CalendarEventClassificationStatus defines model for CalendarEvent.ClassificationStatus.
 type CalendarEventClassificationStatus string
 
+// CalendarEventChange defines model for CalendarEventChange.
+type CalendarEventChange struct {
+	EventId openapi_types.UUID `json:"event_id"`
+
+	// Field The changed field: title, description, start_time, end_time, is_all_day, response_status, transparency, or is_orphaned when the event was cancelled in Google or came back
+	Field    string             `json:"field"`
+	Id       openapi_types.UUID `json:"id"`
+	NewValue *string            `json:"new_value"`
+	OldValue *string            `json:"old_value"`
+
+	// SyncedAt When the sync that made the change ran
+	SyncedAt time.Time `json:"synced_at"`
+}
+
+// CalendarEventDiff defines model for CalendarEventDiff.
+type CalendarEventDiff struct {
+	Added     int                `json:"added"`
+	Cancelled int                `json:"cancelled"`
+	Changed   int                `json:"changed"`
+	EndDate   openapi_types.Date `json:"end_date"`
+
+	// Events The changed events, by start time
+	Events    []CalendarEventDiffItem `json:"events"`
+	Moved     int                     `json:"moved"`
+	Since     time.Time               `json:"since"`
+	StartDate openapi_types.Date      `json:"start_date"`
+
+	// Summary e.g. "3 events added, 1 moved, 2 cancelled since Mon Mar 10"
+	Summary string    `json:"summary"`
+	Until   time.Time `json:"until"`
+}
+
+// CalendarEventDiffItem defines model for CalendarEventDiffItem.
+type CalendarEventDiffItem struct {
+	// After An event's synced fields at one point in time. Absent when the event didn't exist yet or was cancelled.
+	After *CalendarEventSnapshot `json:"after,omitempty"`
+
+	// Before An event's synced fields at one point in time. Absent when the event didn't exist yet or was cancelled.
+	Before *CalendarEventSnapshot `json:"before,omitempty"`
+
+	// ChangedFields Fields that differ between before and after
+	ChangedFields []string                  `json:"changed_fields"`
+	EventId       openapi_types.UUID        `json:"event_id"`
+	Kind          CalendarEventDiffItemKind `json:"kind"`
+}
+
+// CalendarEventDiffItemKind defines model for CalendarEventDiffItem.Kind.
+type CalendarEventDiffItemKind string
+
+// CalendarEventSnapshot An event's synced fields at one point in time. Absent when the event didn't exist yet or was cancelled.
+type CalendarEventSnapshot struct {
+	Description    *string   `json:"description,omitempty"`
+	EndTime        time.Time `json:"end_time"`
+	IsAllDay       bool      `json:"is_all_day"`
+	ResponseStatus *string   `json:"response_status,omitempty"`
+	StartTime      time.Time `json:"start_time"`
+	Title          string    `json:"title"`
+	Transparency   *string   `json:"transparency,omitempty"`
+}
+
+// CalendarSourceOverrides defines model for CalendarSourceOverrides.
+type CalendarSourceOverrides struct {
+	DefaultProjectId *openapi_types.UUID `json:"default_project_id"`
+
+	// DefaultProjectWeight Vote weight of the default project, above 0 and below 100; requires default_project_id
+	DefaultProjectWeight *float64 `json:"default_project_weight"`
+
+	// DisplayColor Hex color such as "#3b82f6"
+	DisplayColor *string `json:"display_color"`
+	DisplayName  *string `json:"display_name"`
+}
+
+// CalendarSyncWindowInput defines model for CalendarSyncWindowInput.
+type CalendarSyncWindowInput struct {
+	// FutureDays Days of future to sync; null for the server default
+	FutureDays *int `json:"future_days"`
+
+	// HistoryDays Days of history to sync; null for the server default
+	HistoryDays *int `json:"history_days"`
+}
+
+// CalendarWriteBackInput defines model for CalendarWriteBackInput.
+type CalendarWriteBackInput struct {
+	// Mode How classified projects are written back to Google events
+	Mode CalendarWriteBackMode `json:"mode"`
+}
+
+// CalendarWriteBackMode How classified projects are written back to Google events
+type CalendarWriteBackMode string
+
+// ChangeFeedEntry defines model for ChangeFeedEntry.
+type ChangeFeedEntry struct {
+	ChangedAt time.Time `json:"changed_at"`
+
+	// Operation upsert when the resource was created or updated, delete when it was removed
+	Operation  string             `json:"operation"`
+	ResourceId openapi_types.UUID `json:"resource_id"`
+
+	// ResourceType calendar_event, time_entry or invoice
+	ResourceType string `json:"resource_type"`
+}
+
+// ChangeFeedPage defines model for ChangeFeedPage.
+type ChangeFeedPage struct {
+	Changes []ChangeFeedEntry `json:"changes"`
+
+	// HasMore Whether more changes are available right away
+	HasMore bool `json:"has_more"`
+
+	// NextCursor Cursor to pass as since on the next call; unchanged when there are no new changes
+	NextCursor string `json:"next_cursor"`
+}
+
+// ClassificationAction defines model for ClassificationAction.
+type ClassificationAction struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+
+	// EventCount Number of events the action changed
+	EventCount int                      `json:"event_count"`
+	Id         openapi_types.UUID       `json:"id"`
+	Kind       ClassificationActionKind `json:"kind"`
+	UndoneAt   *time.Time               `json:"undone_at"`
+}
+
+// ClassificationActionKind defines model for ClassificationAction.Kind.
+type ClassificationActionKind string
+
 // ClassificationExplanation defines model for ClassificationExplanation.
 type ClassificationExplanation struct {
 	Event CalendarEvent `json:"event"`
@@ -336,11 +863,56 @@ type ClassificationExplanation struct {
 	WouldBeSkipped *bool `json:"would_be_skipped,omitempty"`
 }
 
+// ClassificationJob defines model for ClassificationJob.
+type ClassificationJob struct {
+	// ActionId Journal entry for undo, once the job has changed events
+	ActionId  *openapi_types.UUID `json:"action_id,omitempty"`
+	BatchSize int                 `json:"batch_size"`
+
+	// Changed Events whose classification changed so far
+	Changed int `json:"changed"`
+
+	// Classified Events classified to a project so far
+	Classified  int                 `json:"classified"`
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	DryRun      bool                `json:"dry_run"`
+	EndDate     *openapi_types.Date `json:"end_date,omitempty"`
+	Error       *string             `json:"error,omitempty"`
+	Id          openapi_types.UUID  `json:"id"`
+
+	// Matched Events evaluated so far; for a backfill, those the rule matches
+	Matched         int `json:"matched"`
+	ProcessedEvents int `json:"processed_events"`
+
+	// RuleId The rule being backfilled, for jobs started on rule creation
+	RuleId *openapi_types.UUID `json:"rule_id,omitempty"`
+
+	// SkipApplied Events marked as skipped so far
+	SkipApplied int `json:"skip_applied"`
+
+	// Skipped Events that matched no project rules so far
+	Skipped   int                     `json:"skipped"`
+	StartDate *openapi_types.Date     `json:"start_date,omitempty"`
+	StartedAt *time.Time              `json:"started_at,omitempty"`
+	Status    ClassificationJobStatus `json:"status"`
+
+	// TotalEvents Events in range when the job started
+	TotalEvents int `json:"total_events"`
+}
+
+// ClassificationJobStatus defines model for ClassificationJob.Status.
+type ClassificationJobStatus string
+
 // ClassificationRule defines model for ClassificationRule.
 type ClassificationRule struct {
 	// Attended For attendance rules - true=attended, false=did not attend
-	Attended  *bool              `json:"attended"`
-	CreatedAt time.Time          `json:"created_at"`
+	Attended    *bool              `json:"attended"`
+	BackfillJob *ClassificationJob `json:"backfill_job,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+
+	// DeletedAt When the rule was moved to the trash
+	DeletedAt *time.Time         `json:"deleted_at"`
 	Id        openapi_types.UUID `json:"id"`
 	IsEnabled bool               `json:"is_enabled"`
 
@@ -362,6 +934,25 @@ type ClassificationRule struct {
 	Weight float32 `json:"weight"`
 }
 
+// ClassificationSnapshot defines model for ClassificationSnapshot.
+type ClassificationSnapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventCount Number of events captured
+	EventCount int                `json:"event_count"`
+	Id         openapi_types.UUID `json:"id"`
+	Label      *string            `json:"label"`
+	WeekStart  openapi_types.Date `json:"week_start"`
+}
+
+// ClassificationSnapshotCreate defines model for ClassificationSnapshotCreate.
+type ClassificationSnapshotCreate struct {
+	Label *string `json:"label,omitempty"`
+
+	// WeekStart Any date in the week; normalized to its Monday
+	WeekStart openapi_types.Date `json:"week_start"`
+}
+
 // ClassifiedEvent defines model for ClassifiedEvent.
 type ClassifiedEvent struct {
 	Confidence float32            `json:"confidence"`
@@ -386,8 +977,66 @@ type ClassifyEventRequest struct {
 
 // ClassifyEventResponse defines model for ClassifyEventResponse.
 type ClassifyEventResponse struct {
-	Event     CalendarEvent `json:"event"`
-	TimeEntry *TimeEntry    `json:"time_entry,omitempty"`
+	// ActionId Journal entry for this change; pass to POST /api/actions/{id}/undo to revert
+	ActionId  *openapi_types.UUID `json:"action_id,omitempty"`
+	Event     CalendarEvent       `json:"event"`
+	TimeEntry *TimeEntry          `json:"time_entry,omitempty"`
+}
+
+// ClientBalance defines model for ClientBalance.
+type ClientBalance struct {
+	// Client Project client (empty for projects without a client)
+	Client string `json:"client"`
+
+	// Credited Issued credit notes (negative)
+	Credited     float32 `json:"credited"`
+	InvoiceCount int     `json:"invoice_count"`
+	Invoiced     float32 `json:"invoiced"`
+	Outstanding  float32 `json:"outstanding"`
+	Paid         float32 `json:"paid"`
+}
+
+// ClientRate defines model for ClientRate.
+type ClientRate struct {
+	// Client Client name, matched against project client
+	Client    string    `json:"client"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// EndsOn Last day the rate applies (null means ongoing)
+	EndsOn     *openapi_types.Date `json:"ends_on"`
+	HourlyRate float32             `json:"hourly_rate"`
+	Id         openapi_types.UUID  `json:"id"`
+
+	// StartsOn First day the rate applies
+	StartsOn  openapi_types.Date `json:"starts_on"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	UserId    openapi_types.UUID `json:"user_id"`
+}
+
+// ClientRateCreate defines model for ClientRateCreate.
+type ClientRateCreate struct {
+	Client string `json:"client"`
+
+	// EndsOn End date in YYYY-MM-DD format (omit or null for ongoing)
+	EndsOn     *openapi_types.Date `json:"ends_on"`
+	HourlyRate float32             `json:"hourly_rate"`
+
+	// StartsOn Start date in YYYY-MM-DD format
+	StartsOn openapi_types.Date `json:"starts_on"`
+}
+
+// ClientRateUpdate defines model for ClientRateUpdate.
+type ClientRateUpdate struct {
+	// EndsOn Set to empty string to clear (make ongoing)
+	EndsOn     *openapi_types.Date `json:"ends_on"`
+	HourlyRate *float32            `json:"hourly_rate,omitempty"`
+	StartsOn   *openapi_types.Date `json:"starts_on,omitempty"`
+}
+
+// CompanyDomains defines model for CompanyDomains.
+type CompanyDomains struct {
+	// Domains Email domains of the user's own organization, lowercase
+	Domains []string `json:"domains"`
 }
 
 // ConfigExport defines model for ConfigExport.
@@ -438,15 +1087,447 @@ type ConfigImportResult struct {
 	Warnings *[]string `json:"warnings,omitempty"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	Code    string                  `json:"code"`
-	Details *map[string]interface{} `json:"details,omitempty"`
-	Message string                  `json:"message"`
+// ConflictingRule defines model for ConflictingRule.
+type ConflictingRule struct {
+	// ClassifiedShared Shared events classified to this rule's project
+	ClassifiedShared int `json:"classified_shared"`
+
+	// ConfirmedShared Of those, events classified by hand
+	ConfirmedShared int                `json:"confirmed_shared"`
+	MatchedEvents   int                `json:"matched_events"`
+	ProjectId       openapi_types.UUID `json:"project_id"`
+	Query           string             `json:"query"`
+	RuleId          openapi_types.UUID `json:"rule_id"`
+	Weight          float32            `json:"weight"`
+}
+
+// ConnectionReauth defines model for ConnectionReauth.
+type ConnectionReauth struct {
+	// Calendars Selected calendars waiting on re-consent
+	Calendars    []ReauthCalendar   `json:"calendars"`
+	ConnectionId openapi_types.UUID `json:"connection_id"`
+	Provider     string             `json:"provider"`
+}
+
+// CreditNoteCreate defines model for CreditNoteCreate.
+type CreditNoteCreate struct {
+	// InvoiceDate Credit note date (defaults to today)
+	InvoiceDate *openapi_types.Date `json:"invoice_date,omitempty"`
+
+	// Lines Amounts to credit. Omit to credit the full remaining balance.
+	Lines *[]CreditNoteLine `json:"lines,omitempty"`
+}
+
+// CreditNoteLine defines model for CreditNoteLine.
+type CreditNoteLine struct {
+	// Amount Positive amount to credit
+	Amount      float32 `json:"amount"`
+	Description string  `json:"description"`
+}
+
+// DayAnomaly defines model for DayAnomaly.
+type DayAnomaly struct {
+	// BaselineHours Average daily total the day was compared against (unusual_total only)
+	BaselineHours *float64           `json:"baseline_hours,omitempty"`
+	Date          openapi_types.Date `json:"date"`
+	DetectedAt    time.Time          `json:"detected_at"`
+	DismissedAt   *time.Time         `json:"dismissed_at,omitempty"`
+
+	// EventCount Classified meetings on the day (missing_hours only)
+	EventCount *int `json:"event_count,omitempty"`
+
+	// Hours Hours attributed on the day
+	Hours   float64            `json:"hours"`
+	Id      openapi_types.UUID `json:"id"`
+	Kind    DayAnomalyKind     `json:"kind"`
+	Message string             `json:"message"`
+}
+
+// DayAnomalyKind defines model for DayAnomaly.Kind.
+type DayAnomalyKind string
+
+// DayProjectTotal defines model for DayProjectTotal.
+type DayProjectTotal struct {
+	Color       *string            `json:"color,omitempty"`
+	Hours       float32            `json:"hours"`
+	IsBillable  bool               `json:"is_billable"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+}
+
+// DaySummary defines model for DaySummary.
+type DaySummary struct {
+	Date openapi_types.Date `json:"date"`
+
+	// Entries Materialized and ephemeral entries for the day
+	Entries []TimeEntry     `json:"entries"`
+	Events  []CalendarEvent `json:"events"`
+
+	// Locked Whether the day has entries and all of them are invoiced
+	Locked bool `json:"locked"`
+
+	// LockedEntryIds Entries locked by an invoice
+	LockedEntryIds []openapi_types.UUID `json:"locked_entry_ids"`
+
+	// PendingCount Events on this day still pending classification
+	PendingCount int       `json:"pending_count"`
+	Totals       DayTotals `json:"totals"`
+}
+
+// DayTotals defines model for DayTotals.
+type DayTotals struct {
+	BillableHours float32 `json:"billable_hours"`
+
+	// Hours Total hours, excluding projects that do not accumulate hours
+	Hours    float32           `json:"hours"`
+	Projects []DayProjectTotal `json:"projects"`
+}
+
+// DelegationProvisionInput defines model for DelegationProvisionInput.
+type DelegationProvisionInput struct {
+	// UserIds Members to provision; omit for every active member
+	UserIds *[]openapi_types.UUID `json:"user_ids,omitempty"`
+}
+
+// DelegationProvisionMember defines model for DelegationProvisionMember.
+type DelegationProvisionMember struct {
+	// Calendars Calendars found for the member
+	Calendars    *int                `json:"calendars,omitempty"`
+	ConnectionId *openapi_types.UUID `json:"connection_id"`
+	Email        string              `json:"email"`
+
+	// Error Why the member was skipped or failed
+	Error *string `json:"error"`
+
+	// Selected Calendars selected for sync
+	Selected *int                            `json:"selected,omitempty"`
+	Status   DelegationProvisionMemberStatus `json:"status"`
+	UserId   openapi_types.UUID              `json:"user_id"`
+}
+
+// DelegationProvisionMemberStatus defines model for DelegationProvisionMember.Status.
+type DelegationProvisionMemberStatus string
+
+// DelegationProvisionResult defines model for DelegationProvisionResult.
+type DelegationProvisionResult struct {
+	Members []DelegationProvisionMember `json:"members"`
+}
+
+// DescriptionSuggestion defines model for DescriptionSuggestion.
+type DescriptionSuggestion struct {
+	Activities []GitHubActivity `json:"activities"`
+
+	// CurrentDescription The time entry's current description
+	CurrentDescription *string `json:"current_description,omitempty"`
+
+	// Description Suggested description built from the activity
+	Description string `json:"description"`
+
+	// Hours Hours on that time entry
+	Hours       *float32           `json:"hours,omitempty"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+
+	// TimeEntryId The project's time entry for the day, if any
+	TimeEntryId *openapi_types.UUID `json:"time_entry_id,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code string `json:"code"`
+
+	// Details Machine-readable context for the error. For invalid_query:
+	// message, position (byte offset into the query), length and
+	// token (the offending text, absent at the end of the query),
+	// and suggestion (the nearest valid property, for an unknown one).
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Message string                  `json:"message"`
+}
+
+// EventClassificationHistoryEntry defines model for EventClassificationHistoryEntry.
+type EventClassificationHistoryEntry struct {
+	ClassifiedAt time.Time           `json:"classified_at"`
+	Confidence   *float32            `json:"confidence"`
+	EventId      openapi_types.UUID  `json:"event_id"`
+	Id           openapi_types.UUID  `json:"id"`
+	IsSkipped    bool                `json:"is_skipped"`
+	ProjectId    *openapi_types.UUID `json:"project_id"`
+
+	// RuleVotes Every rule that matched, for any project. Absent for manual classifications.
+	RuleVotes *[]EventClassificationVote            `json:"rule_votes,omitempty"`
+	Source    EventClassificationHistoryEntrySource `json:"source"`
+}
+
+// EventClassificationHistoryEntrySource defines model for EventClassificationHistoryEntry.Source.
+type EventClassificationHistoryEntrySource string
+
+// EventClassificationVote defines model for EventClassificationVote.
+type EventClassificationVote struct {
+	ProjectId string `json:"project_id"`
+
+	// RuleId The rule's ID, or a generated ID for project fingerprints
+	RuleId string                        `json:"rule_id"`
+	Source EventClassificationVoteSource `json:"source"`
+	Weight float32                       `json:"weight"`
+}
+
+// EventClassificationVoteSource defines model for EventClassificationVote.Source.
+type EventClassificationVoteSource string
+
+// EventSuppressionUpdate defines model for EventSuppressionUpdate.
+type EventSuppressionUpdate struct {
+	Suppressed bool `json:"suppressed"`
+}
+
+// Expense defines model for Expense.
+type Expense struct {
+	Amount      float64            `json:"amount"`
+	Category    string             `json:"category"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// InvoiceId Invoice the expense was billed on; set while the expense is locked
+	InvoiceId *openapi_types.UUID `json:"invoice_id"`
+
+	// IsBillable Billable expenses are put on the project's invoices
+	IsBillable bool               `json:"is_billable"`
+	ProjectId  openapi_types.UUID `json:"project_id"`
+
+	// ReceiptUrl Link to the receipt
+	ReceiptUrl *string   `json:"receipt_url"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ExpenseCreate defines model for ExpenseCreate.
+type ExpenseCreate struct {
+	// Amount Must be positive
+	Amount      float64            `json:"amount"`
+	Category    string             `json:"category"`
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description,omitempty"`
+	IsBillable  *bool              `json:"is_billable,omitempty"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ReceiptUrl  *string            `json:"receipt_url,omitempty"`
+}
+
+// ExpenseUpdate defines model for ExpenseUpdate.
+type ExpenseUpdate struct {
+	Amount   *float64            `json:"amount,omitempty"`
+	Category *string             `json:"category,omitempty"`
+	Date     *openapi_types.Date `json:"date,omitempty"`
+
+	// Description Set to empty string to clear
+	Description *string             `json:"description,omitempty"`
+	IsBillable  *bool               `json:"is_billable,omitempty"`
+	ProjectId   *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// ReceiptUrl Set to empty string to clear
+	ReceiptUrl *string `json:"receipt_url,omitempty"`
+}
+
+// FingerprintClaim defines model for FingerprintClaim.
+type FingerprintClaim struct {
+	IsArchived  bool               `json:"is_archived"`
+	Kind        FingerprintKind    `json:"kind"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+
+	// Value The claiming fingerprint
+	Value string `json:"value"`
+}
+
+// FingerprintInput defines model for FingerprintInput.
+type FingerprintInput struct {
+	Kind FingerprintKind `json:"kind"`
+
+	// Value A domain (acme.com), an email address, or a keyword
+	Value string `json:"value"`
+
+	// Weight Vote weight for this fingerprint (default 1.0)
+	Weight *float32 `json:"weight,omitempty"`
+}
+
+// FingerprintKind defines model for FingerprintKind.
+type FingerprintKind string
+
+// FocusBlock defines model for FocusBlock.
+type FocusBlock struct {
+	EndTime   time.Time `json:"end_time"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// FocusGapsInput defines model for FocusGapsInput.
+type FocusGapsInput struct {
+	// DayStart When the working day starts, HH:MM in UTC. Defaults to 09:00.
+	DayStart *string            `json:"day_start,omitempty"`
+	EndDate  openapi_types.Date `json:"end_date"`
+
+	// MinFocusMinutes Shortest gap worth recording. Defaults to 30.
+	MinFocusMinutes *int               `json:"min_focus_minutes,omitempty"`
+	ProjectId       openapi_types.UUID `json:"project_id"`
+	StartDate       openapi_types.Date `json:"start_date"`
+
+	// Title Title of the recorded sessions. Defaults to "Focus time".
+	Title *string `json:"title,omitempty"`
+}
+
+// FocusGapsResult defines model for FocusGapsResult.
+type FocusGapsResult struct {
+	// Events The recorded focus sessions, in start order
+	Events     []CalendarEvent `json:"events"`
+	FocusHours float64         `json:"focus_hours"`
+}
+
+// FocusSessionInput defines model for FocusSessionInput.
+type FocusSessionInput struct {
+	EndTime time.Time `json:"end_time"`
+
+	// ExternalId The timer's ID for the session, used to de-duplicate retries. Defaults to the start time.
+	ExternalId *string            `json:"external_id,omitempty"`
+	ProjectId  openapi_types.UUID `json:"project_id"`
+	StartTime  time.Time          `json:"start_time"`
+
+	// Title Event title. Defaults to "Focus session".
+	Title *string `json:"title,omitempty"`
+}
+
+// FocusSessionResult defines model for FocusSessionResult.
+type FocusSessionResult struct {
+	// Events The stored events; more than one when a meeting split the session
+	Events []CalendarEvent `json:"events"`
+
+	// TrimmedMinutes Minutes cut out because they overlapped meetings
+	TrimmedMinutes int `json:"trimmed_minutes"`
+}
+
+// GitHubActivity defines model for GitHubActivity.
+type GitHubActivity struct {
+	At time.Time `json:"at"`
+
+	// Kind commit or review
+	Kind string `json:"kind"`
+
+	// Number Pull request number, for reviews
+	Number *int `json:"number,omitempty"`
+
+	// Repo Repository as "owner/name"
+	Repo string `json:"repo"`
+
+	// Title First line of the commit message, or the pull request title
+	Title string `json:"title"`
+	Url   string `json:"url"`
+}
+
+// GitHubConnection defines model for GitHubConnection.
+type GitHubConnection struct {
+	ConnectedAt time.Time `json:"connected_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Username    string    `json:"username"`
+}
+
+// GitHubConnectionInput defines model for GitHubConnectionInput.
+type GitHubConnectionInput struct {
+	// Token Personal access token with read access to the repositories
+	Token string `json:"token"`
+
+	// Username GitHub login whose activity is suggested
+	Username string `json:"username"`
+}
+
+// GoalProgress defines model for GoalProgress.
+type GoalProgress struct {
+	ActualHours float64 `json:"actual_hours"`
+
+	// ExpectedHours Target pro-rated by the working-hours capacity elapsed so far
+	ExpectedHours float64  `json:"expected_hours"`
+	Goal          HourGoal `json:"goal"`
+
+	// OnTrack Whether the actual hours are at least the expected hours
+	OnTrack bool `json:"on_track"`
+
+	// Percent Actual hours / target
+	Percent     float64            `json:"percent"`
+	PeriodEnd   openapi_types.Date `json:"period_end"`
+	PeriodStart openapi_types.Date `json:"period_start"`
+	ProjectName *string            `json:"project_name"`
+
+	// RemainingHours Hours still needed to reach the target; 0 once met
+	RemainingHours float64 `json:"remaining_hours"`
+}
+
+// GoogleAccess A feature's Google permissions. `calendar` reads calendars for sync;
+// `sheets` creates the spreadsheets invoices are exported to;
+// `calendar_write` writes classified projects back to events.
+type GoogleAccess string
+
+// HourGoal defines model for HourGoal.
+type HourGoal struct {
+	// BillableOnly Count only hours on billable projects
+	BillableOnly bool               `json:"billable_only"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// Period week or month
+	Period string `json:"period"`
+
+	// ProjectId The project the goal covers; null for all projects
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	TargetHours float64             `json:"target_hours"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// HourGoalInput defines model for HourGoalInput.
+type HourGoalInput struct {
+	BillableOnly *bool `json:"billable_only,omitempty"`
+
+	// Period week or month
+	Period string `json:"period"`
+
+	// ProjectId Limit the goal to one project; omit for all projects
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	TargetHours float64             `json:"target_hours"`
+}
+
+// IntegrationConnection defines model for IntegrationConnection.
+type IntegrationConnection struct {
+	ConnectedAt time.Time `json:"connected_at"`
+
+	// ExpiresAt When the provider stops accepting the credentials, if known
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	// KeyVersion How the credentials are encrypted; older versions are re-encrypted on rotation
+	KeyVersion int        `json:"key_version"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Provider   string     `json:"provider"`
+
+	// RotatedAt When the credentials were last replaced
+	RotatedAt *time.Time `json:"rotated_at"`
+
+	// Scopes Scopes the credentials were granted
+	Scopes    []string  `json:"scopes"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IntegrationConnectionInput defines model for IntegrationConnectionInput.
+type IntegrationConnectionInput struct {
+	// Credentials Provider-specific credential fields
+	Credentials map[string]string `json:"credentials"`
+
+	// ExpiresAt When the credentials expire, if they do
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Scopes    *[]string  `json:"scopes,omitempty"`
 }
 
 // Invoice defines model for Invoice.
 type Invoice struct {
+	// AmountPaid Sum of payments recorded against this invoice
+	AmountPaid *float32 `json:"amount_paid,omitempty"`
+
+	// BalanceDue Total less issued credit notes and payments
+	BalanceDue *float32 `json:"balance_due,omitempty"`
+
 	// BillingPeriodId Primary billing period for this invoice
 	BillingPeriodId *openapi_types.UUID `json:"billing_period_id"`
 	CreatedAt       time.Time           `json:"created_at"`
@@ -458,9 +1539,15 @@ type Invoice struct {
 	// InvoiceNumber Auto-generated invoice number (PROJECT-YEAR-SEQ)
 	InvoiceNumber string `json:"invoice_number"`
 
+	// Kind Credit notes reference the invoice they correct and carry negative totals
+	Kind *InvoiceKind `json:"kind,omitempty"`
+
 	// LineItems Invoice line items (included in detail view)
 	LineItems *[]InvoiceLineItem `json:"line_items,omitempty"`
 
+	// OriginalInvoiceId Invoice corrected by this credit note
+	OriginalInvoiceId *openapi_types.UUID `json:"original_invoice_id"`
+
 	// PeriodEnd End date of invoiced period
 	PeriodEnd openapi_types.Date `json:"period_end"`
 
@@ -490,9 +1577,38 @@ type Invoice struct {
 	WorksheetId *int `json:"worksheet_id"`
 }
 
+// InvoiceKind Credit notes reference the invoice they correct and carry negative totals
+type InvoiceKind string
+
 // InvoiceStatus Invoice status
 type InvoiceStatus string
 
+// InvoiceAdjustmentCreate defines model for InvoiceAdjustmentCreate.
+type InvoiceAdjustmentCreate struct {
+	// Amount Fixed amount; negative for discounts or corrections
+	Amount float32 `json:"amount"`
+
+	// Date Date shown on the line (defaults to the invoice date)
+	Date        *openapi_types.Date `json:"date,omitempty"`
+	Description string              `json:"description"`
+}
+
+// InvoiceComment defines model for InvoiceComment.
+type InvoiceComment struct {
+	Body      string             `json:"body"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+
+	// UserId Author of the comment
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// InvoiceCommentCreate defines model for InvoiceCommentCreate.
+type InvoiceCommentCreate struct {
+	Body string `json:"body"`
+}
+
 // InvoiceCreate defines model for InvoiceCreate.
 type InvoiceCreate struct {
 	// InvoiceDate Invoice date (defaults to today if omitted)
@@ -506,6 +1622,27 @@ type InvoiceCreate struct {
 	ProjectId   openapi_types.UUID `json:"project_id"`
 }
 
+// InvoiceExport defines model for InvoiceExport.
+type InvoiceExport struct {
+	ContentType string `json:"content_type"`
+
+	// Downloadable Whether the rendered file can be fetched from the download endpoint
+	Downloadable bool      `json:"downloadable"`
+	ExportedAt   time.Time `json:"exported_at"`
+
+	// ExternalId Remote document ID (e.g. spreadsheet ID)
+	ExternalId *string `json:"external_id"`
+
+	// ExternalUrl Link to the remote document
+	ExternalUrl *string `json:"external_url"`
+	Filename    string  `json:"filename"`
+
+	// Format Export format (csv, pdf, sheets)
+	Format    string             `json:"format"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+}
+
 // InvoiceLineItem defines model for InvoiceLineItem.
 type InvoiceLineItem struct {
 	// Amount Calculated amount (hours * hourly_rate)
@@ -517,14 +1654,59 @@ type InvoiceLineItem struct {
 	// Description Work description from time entry
 	Description string `json:"description"`
 
+	// ExpenseId Source expense (set on expense lines)
+	ExpenseId *openapi_types.UUID `json:"expense_id"`
+
 	// HourlyRate Rate at time of invoice creation (snapshot)
 	HourlyRate float32 `json:"hourly_rate"`
 
 	// Hours Hours worked
-	Hours       float32            `json:"hours"`
-	Id          openapi_types.UUID `json:"id"`
-	InvoiceId   openapi_types.UUID `json:"invoice_id"`
-	TimeEntryId openapi_types.UUID `json:"time_entry_id"`
+	Hours     float32            `json:"hours"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+
+	// Kind Time lines are billed from a time entry; expenses, adjustments and
+	// fees carry a fixed amount; overage lines bill retainer hours beyond
+	// the month's included hours
+	Kind *InvoiceLineItemKind `json:"kind,omitempty"`
+
+	// RateId Billing period or client rate that was applied
+	RateId *openapi_types.UUID `json:"rate_id"`
+
+	// RateSource Which rate table supplied hourly_rate when the invoice was created
+	RateSource *InvoiceLineItemRateSource `json:"rate_source,omitempty"`
+
+	// RoundingHours Hours added to this line by the billing period's daily minimum or increment; included in hours
+	RoundingHours *float64 `json:"rounding_hours,omitempty"`
+
+	// RoundingRule The rounding rule that added rounding_hours, as it stood when the invoice was created
+	RoundingRule *string `json:"rounding_rule"`
+
+	// TimeEntryId Source time entry (null for adjustments and expenses)
+	TimeEntryId *openapi_types.UUID `json:"time_entry_id"`
+}
+
+// InvoiceLineItemKind Time lines are billed from a time entry; expenses, adjustments and
+// fees carry a fixed amount; overage lines bill retainer hours beyond
+// the month's included hours
+type InvoiceLineItemKind string
+
+// InvoiceLineItemRateSource Which rate table supplied hourly_rate when the invoice was created
+type InvoiceLineItemRateSource string
+
+// LocaleSettings defines model for LocaleSettings.
+type LocaleSettings struct {
+	// Locale Locale tag, e.g. en or de-DE
+	Locale string `json:"locale"`
+
+	// Supported Tags that can be set
+	Supported []string `json:"supported"`
+}
+
+// LocaleUpdate defines model for LocaleUpdate.
+type LocaleUpdate struct {
+	// Locale A supported tag. A bare language such as "de" selects its regional locale.
+	Locale string `json:"locale"`
 }
 
 // LoginRequest defines model for LoginRequest.
@@ -540,6 +1722,96 @@ type MatchedEvent struct {
 	Title     string             `json:"title"`
 }
 
+// MeetingCostReport defines model for MeetingCostReport.
+type MeetingCostReport struct {
+	Cost        float64            `json:"cost"`
+	EndDate     openapi_types.Date `json:"end_date"`
+	PersonHours float64            `json:"person_hours"`
+
+	// Priced False when no rates were given; costs are then 0
+	Priced    bool                 `json:"priced"`
+	Projects  []ProjectMeetingCost `json:"projects"`
+	Series    []MeetingSeriesCost  `json:"series"`
+	StartDate openapi_types.Date   `json:"start_date"`
+}
+
+// MeetingLoadDay defines model for MeetingLoadDay.
+type MeetingLoadDay struct {
+	Date        openapi_types.Date `json:"date"`
+	FocusBlocks []FocusBlock       `json:"focus_blocks"`
+	FocusHours  float64            `json:"focus_hours"`
+
+	// FragmentedHours Gaps too short to count as focus time
+	FragmentedHours float64 `json:"fragmented_hours"`
+	MeetingHours    float64 `json:"meeting_hours"`
+	MeetingShare    float64 `json:"meeting_share"`
+
+	// Meetings Meetings overlapping the working day
+	Meetings     int     `json:"meetings"`
+	WorkingHours float64 `json:"working_hours"`
+}
+
+// MeetingLoadReport defines model for MeetingLoadReport.
+type MeetingLoadReport struct {
+	// DayStart When each working day starts, HH:MM in UTC
+	DayStart string `json:"day_start"`
+
+	// Days Working days only, in date order
+	Days            []MeetingLoadDay   `json:"days"`
+	EndDate         openapi_types.Date `json:"end_date"`
+	FocusHours      float64            `json:"focus_hours"`
+	FragmentedHours float64            `json:"fragmented_hours"`
+	MeetingHours    float64            `json:"meeting_hours"`
+
+	// MeetingShare Meeting hours / working hours
+	MeetingShare    float64            `json:"meeting_share"`
+	MinFocusMinutes int                `json:"min_focus_minutes"`
+	StartDate       openapi_types.Date `json:"start_date"`
+
+	// Weeks Monday-start weeks, in date order
+	Weeks        []MeetingLoadWeek `json:"weeks"`
+	WorkingHours float64           `json:"working_hours"`
+}
+
+// MeetingLoadWeek defines model for MeetingLoadWeek.
+type MeetingLoadWeek struct {
+	FocusHours      float64            `json:"focus_hours"`
+	FragmentedHours float64            `json:"fragmented_hours"`
+	MeetingHours    float64            `json:"meeting_hours"`
+	MeetingShare    float64            `json:"meeting_share"`
+	WeekStart       openapi_types.Date `json:"week_start"`
+	WorkingHours    float64            `json:"working_hours"`
+}
+
+// MeetingSeriesCost defines model for MeetingSeriesCost.
+type MeetingSeriesCost struct {
+	AvgAttendees float64 `json:"avg_attendees"`
+	Cost         float64 `json:"cost"`
+
+	// Hours Meeting time over all occurrences
+	Hours float64 `json:"hours"`
+
+	// Key Google's recurring event ID, or the title when unknown
+	Key         string              `json:"key"`
+	LastHeld    time.Time           `json:"last_held"`
+	Occurrences int                 `json:"occurrences"`
+	PersonHours float64             `json:"person_hours"`
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	ProjectName *string             `json:"project_name"`
+
+	// Title Title of the latest occurrence
+	Title string `json:"title"`
+}
+
+// MemberDelegationInput defines model for MemberDelegationInput.
+type MemberDelegationInput struct {
+	// Calendars Null uses the organization's default
+	Calendars *MemberDelegationInputCalendars `json:"calendars"`
+}
+
+// MemberDelegationInputCalendars Null uses the organization's default
+type MemberDelegationInputCalendars string
+
 // OAuthAuthorizeResponse defines model for OAuthAuthorizeResponse.
 type OAuthAuthorizeResponse struct {
 	// State State token for CSRF protection
@@ -549,89 +1821,477 @@ type OAuthAuthorizeResponse struct {
 	Url string `json:"url"`
 }
 
-// PreviewStats defines model for PreviewStats.
-type PreviewStats struct {
-	// AlreadyCorrect Events already classified to the target project
-	AlreadyCorrect int `json:"already_correct"`
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt        time.Time              `json:"created_at"`
+	Domains          []OrganizationDomain   `json:"domains"`
+	GoogleDelegation OrganizationDelegation `json:"google_delegation"`
+	Id               openapi_types.UUID     `json:"id"`
+	Name             string                 `json:"name"`
 
-	// ManualConflicts Events with manual classifications that conflict
-	ManualConflicts int `json:"manual_conflicts"`
-	TotalMatches    int `json:"total_matches"`
+	// Role The user's role in the organization
+	Role OrganizationRole `json:"role"`
 
-	// WouldChange Events that would be reclassified
-	WouldChange int `json:"would_change"`
+	// ScimTokenPrefix Start of the current SCIM token, if one was issued
+	ScimTokenPrefix *string         `json:"scim_token_prefix"`
+	Slug            string          `json:"slug"`
+	Sso             OrganizationSSO `json:"sso"`
 }
 
-// Project defines model for Project.
-type Project struct {
-	// Client Client name for classification filtering
-	Client                 *string   `json:"client,omitempty"`
-	Color                  string    `json:"color"`
-	CreatedAt              time.Time `json:"created_at"`
-	DoesNotAccumulateHours *bool     `json:"does_not_accumulate_hours,omitempty"`
-
-	// FingerprintDomains Domain patterns for auto-classification (e.g., "acme.com")
-	FingerprintDomains *[]string `json:"fingerprint_domains,omitempty"`
+// OrganizationRole The user's role in the organization
+type OrganizationRole string
 
-	// FingerprintEmails Email addresses for auto-classification
-	FingerprintEmails *[]string `json:"fingerprint_emails,omitempty"`
+// OrganizationCreate defines model for OrganizationCreate.
+type OrganizationCreate struct {
+	Name string `json:"name"`
 
-	// FingerprintKeywords Keywords to match in event titles/descriptions
-	FingerprintKeywords *[]string          `json:"fingerprint_keywords,omitempty"`
-	Id                  openapi_types.UUID `json:"id"`
-	IsArchived          bool               `json:"is_archived"`
-	IsBillable          bool               `json:"is_billable"`
-	IsHiddenByDefault   *bool              `json:"is_hidden_by_default,omitempty"`
-	Name                string             `json:"name"`
-	ShortCode           *string            `json:"short_code,omitempty"`
-	UpdatedAt           *time.Time         `json:"updated_at,omitempty"`
-	UserId              openapi_types.UUID `json:"user_id"`
+	// Slug Lowercase letters, digits and hyphens
+	Slug string `json:"slug"`
 }
 
-// ProjectCreate defines model for ProjectCreate.
-type ProjectCreate struct {
-	Client                 *string   `json:"client,omitempty"`
-	Color                  *string   `json:"color,omitempty"`
-	DoesNotAccumulateHours *bool     `json:"does_not_accumulate_hours,omitempty"`
-	FingerprintDomains     *[]string `json:"fingerprint_domains,omitempty"`
-	FingerprintEmails      *[]string `json:"fingerprint_emails,omitempty"`
-	FingerprintKeywords    *[]string `json:"fingerprint_keywords,omitempty"`
-	IsBillable             *bool     `json:"is_billable,omitempty"`
-	IsHiddenByDefault      *bool     `json:"is_hidden_by_default,omitempty"`
-	Name                   string    `json:"name"`
-	ShortCode              *string   `json:"short_code,omitempty"`
+// OrganizationDelegation defines model for OrganizationDelegation.
+type OrganizationDelegation struct {
+	ClientEmail *string `json:"client_email"`
+
+	// ClientId Client ID to authorize for domain-wide delegation
+	ClientId *string `json:"client_id"`
+
+	// Configured Whether a service account key is stored
+	Configured bool `json:"configured"`
+
+	// DefaultCalendars Which newly found calendars of a member are selected
+	DefaultCalendars OrganizationDelegationDefaultCalendars `json:"default_calendars"`
+
+	// Scopes OAuth scopes to authorize for the client ID
+	Scopes []string `json:"scopes"`
 }
 
-// ProjectExport Project data for export/import (name is the unique identifier)
-type ProjectExport struct {
+// OrganizationDelegationDefaultCalendars Which newly found calendars of a member are selected
+type OrganizationDelegationDefaultCalendars string
+
+// OrganizationDelegationInput defines model for OrganizationDelegationInput.
+type OrganizationDelegationInput struct {
+	DefaultCalendars OrganizationDelegationInputDefaultCalendars `json:"default_calendars"`
+
+	// ServiceAccountKey The service account's JSON key. Omit to keep the stored one.
+	ServiceAccountKey *string `json:"service_account_key,omitempty"`
+}
+
+// OrganizationDelegationInputDefaultCalendars defines model for OrganizationDelegationInput.DefaultCalendars.
+type OrganizationDelegationInputDefaultCalendars string
+
+// OrganizationDomain defines model for OrganizationDomain.
+type OrganizationDomain struct {
+	AutoJoin         bool   `json:"auto_join"`
+	Domain           string `json:"domain"`
+	VerificationHost string `json:"verification_host"`
+
+	// VerificationRecord TXT record value that proves ownership
+	VerificationRecord string     `json:"verification_record"`
+	Verified           bool       `json:"verified"`
+	VerifiedAt         *time.Time `json:"verified_at"`
+}
+
+// OrganizationDomainInput defines model for OrganizationDomainInput.
+type OrganizationDomainInput struct {
+	AutoJoin bool `json:"auto_join"`
+}
+
+// OrganizationMember defines model for OrganizationMember.
+type OrganizationMember struct {
+	// Active False once the identity provider deactivated the user
+	Active bool `json:"active"`
+
+	// DelegationCalendars Calendars provisioning selects; null uses the organization's default
+	DelegationCalendars *OrganizationMemberDelegationCalendars `json:"delegation_calendars"`
+	Email               string                                 `json:"email"`
+
+	// ExternalId The identity provider's ID for the user
+	ExternalId *string                `json:"external_id"`
+	JoinedAt   time.Time              `json:"joined_at"`
+	Name       string                 `json:"name"`
+	Role       OrganizationMemberRole `json:"role"`
+	UserId     openapi_types.UUID     `json:"user_id"`
+}
+
+// OrganizationMemberDelegationCalendars Calendars provisioning selects; null uses the organization's default
+type OrganizationMemberDelegationCalendars string
+
+// OrganizationMemberRole defines model for OrganizationMember.Role.
+type OrganizationMemberRole string
+
+// OrganizationSSO defines model for OrganizationSSO.
+type OrganizationSSO struct {
+	ClientId        *string `json:"client_id"`
+	Enabled         bool    `json:"enabled"`
+	HasClientSecret bool    `json:"has_client_secret"`
+	Issuer          *string `json:"issuer"`
+
+	// RedirectUri Callback URL to register with the identity provider
+	RedirectUri string `json:"redirect_uri"`
+}
+
+// OrganizationSSOInput defines model for OrganizationSSOInput.
+type OrganizationSSOInput struct {
+	ClientId string `json:"client_id"`
+
+	// ClientSecret Omit to keep the stored secret
+	ClientSecret *string `json:"client_secret,omitempty"`
+	Enabled      bool    `json:"enabled"`
+
+	// Issuer OpenID Connect issuer URL
+	Issuer string `json:"issuer"`
+}
+
+// Payment defines model for Payment.
+type Payment struct {
+	Amount    float32            `json:"amount"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+
+	// Method How the payment was made (e.g. bank transfer, check)
+	Method *string            `json:"method"`
+	PaidOn openapi_types.Date `json:"paid_on"`
+
+	// Reference Transaction or check reference
+	Reference *string `json:"reference"`
+}
+
+// PaymentCreate defines model for PaymentCreate.
+type PaymentCreate struct {
+	Amount float32 `json:"amount"`
+	Method *string `json:"method,omitempty"`
+
+	// PaidOn Date received (defaults to today)
+	PaidOn    *openapi_types.Date `json:"paid_on,omitempty"`
+	Reference *string             `json:"reference,omitempty"`
+}
+
+// PreviewStats defines model for PreviewStats.
+type PreviewStats struct {
+	// AlreadyCorrect Events already classified to the target project
+	AlreadyCorrect int `json:"already_correct"`
+
+	// ManualConflicts Events with manual classifications that conflict
+	ManualConflicts int `json:"manual_conflicts"`
+	TotalMatches    int `json:"total_matches"`
+
+	// WouldChange Events that would be reclassified
+	WouldChange int `json:"would_change"`
+}
+
+// Project defines model for Project.
+type Project struct {
+	// ArchiveOptions What archiving the project cascades to. The options take effect while
+	// the project is archived and are undone when it is unarchived: rules
+	// disabled by the archive are re-enabled, and fingerprints match again.
+	// On update, omitted options are left unchanged.
+	ArchiveOptions *ProjectArchiveOptions `json:"archive_options,omitempty"`
+
+	// Client Client name for classification filtering
+	Client                 *string   `json:"client,omitempty"`
+	Color                  string    `json:"color"`
+	CreatedAt              time.Time `json:"created_at"`
+	DoesNotAccumulateHours *bool     `json:"does_not_accumulate_hours,omitempty"`
+
+	// FingerprintDomains Domain patterns for auto-classification (e.g., "acme.com")
+	FingerprintDomains *[]string `json:"fingerprint_domains,omitempty"`
+
+	// FingerprintEmails Email addresses for auto-classification
+	FingerprintEmails *[]string `json:"fingerprint_emails,omitempty"`
+
+	// FingerprintExcludeDomains Domains that vote against this project when an attendee matches
+	FingerprintExcludeDomains *[]string `json:"fingerprint_exclude_domains,omitempty"`
+
+	// FingerprintExcludeKeywords Keywords that vote against this project when they appear in an event
+	FingerprintExcludeKeywords *[]string `json:"fingerprint_exclude_keywords,omitempty"`
+
+	// FingerprintKeywords Keywords to match in event titles/descriptions
+	FingerprintKeywords *[]string `json:"fingerprint_keywords,omitempty"`
+
+	// FingerprintWeights Vote weight of individual fingerprints, keyed by "kind:value"
+	// (e.g. "email:ceo@acme.com"). Fingerprints not listed weigh 1.0.
+	FingerprintWeights *map[string]float32 `json:"fingerprint_weights,omitempty"`
+
+	// GithubRepos GitHub repositories ("owner/name") whose activity belongs to this project
+	GithubRepos       *[]string          `json:"github_repos,omitempty"`
+	Id                openapi_types.UUID `json:"id"`
+	IsArchived        bool               `json:"is_archived"`
+	IsBillable        bool               `json:"is_billable"`
+	IsHiddenByDefault *bool              `json:"is_hidden_by_default,omitempty"`
+	Name              string             `json:"name"`
+	ShortCode         *string            `json:"short_code,omitempty"`
+	UpdatedAt         *time.Time         `json:"updated_at,omitempty"`
+	UserId            openapi_types.UUID `json:"user_id"`
+}
+
+// ProjectArchiveOptions What archiving the project cascades to. The options take effect while
+// the project is archived and are undone when it is unarchived: rules
+// disabled by the archive are re-enabled, and fingerprints match again.
+// On update, omitted options are left unchanged.
+type ProjectArchiveOptions struct {
+	// DisableRules Disable the project's enabled rules while it is archived
+	DisableRules *bool `json:"disable_rules,omitempty"`
+
+	// HideFromPickers Leave the archived project out of active project lists (default true)
+	HideFromPickers *bool `json:"hide_from_pickers,omitempty"`
+
+	// StopFingerprints Stop matching the project's fingerprints during classification while it is archived
+	StopFingerprints *bool `json:"stop_fingerprints,omitempty"`
+}
+
+// ProjectCreate defines model for ProjectCreate.
+type ProjectCreate struct {
 	Client                 *string   `json:"client,omitempty"`
 	Color                  *string   `json:"color,omitempty"`
 	DoesNotAccumulateHours *bool     `json:"does_not_accumulate_hours,omitempty"`
 	FingerprintDomains     *[]string `json:"fingerprint_domains,omitempty"`
 	FingerprintEmails      *[]string `json:"fingerprint_emails,omitempty"`
 	FingerprintKeywords    *[]string `json:"fingerprint_keywords,omitempty"`
-	IsArchived             *bool     `json:"is_archived,omitempty"`
 	IsBillable             *bool     `json:"is_billable,omitempty"`
 	IsHiddenByDefault      *bool     `json:"is_hidden_by_default,omitempty"`
+	Name                   string    `json:"name"`
+	ShortCode              *string   `json:"short_code,omitempty"`
+}
+
+// ProjectExport Project data for export/import (name is the unique identifier)
+type ProjectExport struct {
+	Client                     *string             `json:"client,omitempty"`
+	Color                      *string             `json:"color,omitempty"`
+	DoesNotAccumulateHours     *bool               `json:"does_not_accumulate_hours,omitempty"`
+	FingerprintDomains         *[]string           `json:"fingerprint_domains,omitempty"`
+	FingerprintEmails          *[]string           `json:"fingerprint_emails,omitempty"`
+	FingerprintExcludeDomains  *[]string           `json:"fingerprint_exclude_domains,omitempty"`
+	FingerprintExcludeKeywords *[]string           `json:"fingerprint_exclude_keywords,omitempty"`
+	FingerprintKeywords        *[]string           `json:"fingerprint_keywords,omitempty"`
+	FingerprintWeights         *map[string]float32 `json:"fingerprint_weights,omitempty"`
+	GithubRepos                *[]string           `json:"github_repos,omitempty"`
+	IsArchived                 *bool               `json:"is_archived,omitempty"`
+	IsBillable                 *bool               `json:"is_billable,omitempty"`
+	IsHiddenByDefault          *bool               `json:"is_hidden_by_default,omitempty"`
 
 	// Name Project name (used as unique identifier)
 	Name      string  `json:"name"`
 	ShortCode *string `json:"short_code,omitempty"`
 }
 
+// ProjectMeetingCost defines model for ProjectMeetingCost.
+type ProjectMeetingCost struct {
+	Cost        float64 `json:"cost"`
+	PersonHours float64 `json:"person_hours"`
+
+	// ProjectId Null for unclassified meetings
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	ProjectName *string             `json:"project_name"`
+	Series      int                 `json:"series"`
+}
+
+// ProjectShare defines model for ProjectShare.
+type ProjectShare struct {
+	// BillableExpenses Portion of expenses that is billable
+	BillableExpenses *float64 `json:"billable_expenses,omitempty"`
+
+	// Expenses Total of the project's expenses dated in the report range
+	Expenses    *float64           `json:"expenses,omitempty"`
+	Hours       float64            `json:"hours"`
+	IsBillable  bool               `json:"is_billable"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+
+	// Share Project hours / total hours
+	Share float64 `json:"share"`
+}
+
+// ProjectTransfer defines model for ProjectTransfer.
+type ProjectTransfer struct {
+	BillingPeriods int                `json:"billing_periods"`
+	Expenses       int                `json:"expenses"`
+	FromUserId     openapi_types.UUID `json:"from_user_id"`
+
+	// Invoices Invoices and credit notes moved with their payments
+	Invoices  int                `json:"invoices"`
+	ProjectId openapi_types.UUID `json:"project_id"`
+
+	// RevokedConnections Calendar connections deleted from the departing member
+	RevokedConnections int `json:"revoked_connections"`
+
+	// TimeEntries Time entries moved, including trashed ones
+	TimeEntries int                `json:"time_entries"`
+	ToUserId    openapi_types.UUID `json:"to_user_id"`
+}
+
+// ProjectTransferInput defines model for ProjectTransferInput.
+type ProjectTransferInput struct {
+	// FromUserId The member who owns the project now
+	FromUserId openapi_types.UUID `json:"from_user_id"`
+	ProjectId  openapi_types.UUID `json:"project_id"`
+
+	// RevokeConnections Delete the departing member's calendar connections
+	RevokeConnections *bool `json:"revoke_connections,omitempty"`
+
+	// ToUserId The active member taking the project over
+	ToUserId openapi_types.UUID `json:"to_user_id"`
+}
+
 // ProjectUpdate defines model for ProjectUpdate.
 type ProjectUpdate struct {
-	Client                 *string   `json:"client,omitempty"`
-	Color                  *string   `json:"color,omitempty"`
-	DoesNotAccumulateHours *bool     `json:"does_not_accumulate_hours,omitempty"`
-	FingerprintDomains     *[]string `json:"fingerprint_domains,omitempty"`
-	FingerprintEmails      *[]string `json:"fingerprint_emails,omitempty"`
-	FingerprintKeywords    *[]string `json:"fingerprint_keywords,omitempty"`
-	IsArchived             *bool     `json:"is_archived,omitempty"`
-	IsBillable             *bool     `json:"is_billable,omitempty"`
-	IsHiddenByDefault      *bool     `json:"is_hidden_by_default,omitempty"`
-	Name                   *string   `json:"name,omitempty"`
-	ShortCode              *string   `json:"short_code,omitempty"`
+	// ArchiveOptions What archiving the project cascades to. The options take effect while
+	// the project is archived and are undone when it is unarchived: rules
+	// disabled by the archive are re-enabled, and fingerprints match again.
+	// On update, omitted options are left unchanged.
+	ArchiveOptions             *ProjectArchiveOptions `json:"archive_options,omitempty"`
+	Client                     *string                `json:"client,omitempty"`
+	Color                      *string                `json:"color,omitempty"`
+	DoesNotAccumulateHours     *bool                  `json:"does_not_accumulate_hours,omitempty"`
+	FingerprintDomains         *[]string              `json:"fingerprint_domains,omitempty"`
+	FingerprintEmails          *[]string              `json:"fingerprint_emails,omitempty"`
+	FingerprintExcludeDomains  *[]string              `json:"fingerprint_exclude_domains,omitempty"`
+	FingerprintExcludeKeywords *[]string              `json:"fingerprint_exclude_keywords,omitempty"`
+	FingerprintKeywords        *[]string              `json:"fingerprint_keywords,omitempty"`
+	FingerprintWeights         *map[string]float32    `json:"fingerprint_weights,omitempty"`
+	GithubRepos                *[]string              `json:"github_repos,omitempty"`
+	IsArchived                 *bool                  `json:"is_archived,omitempty"`
+	IsBillable                 *bool                  `json:"is_billable,omitempty"`
+	IsHiddenByDefault          *bool                  `json:"is_hidden_by_default,omitempty"`
+	Name                       *string                `json:"name,omitempty"`
+	ShortCode                  *string                `json:"short_code,omitempty"`
+}
+
+// ReauthCalendar defines model for ReauthCalendar.
+type ReauthCalendar struct {
+	Id openapi_types.UUID `json:"id"`
+
+	// LastError Why the last sync failed
+	LastError *string `json:"last_error"`
+	Name      string  `json:"name"`
+}
+
+// RecalculateTimeEntriesRequest defines model for RecalculateTimeEntriesRequest.
+type RecalculateTimeEntriesRequest struct {
+	// EndDate Inclusive; at most 366 days after start_date
+	EndDate openapi_types.Date `json:"end_date"`
+
+	// ProjectId Only recompute this project's entries
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	StartDate openapi_types.Date  `json:"start_date"`
+}
+
+// RecalculationJob defines model for RecalculationJob.
+type RecalculationJob struct {
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	EndDate     openapi_types.Date `json:"end_date"`
+
+	// EntriesCleared Entries whose events are gone, removed or zeroed so far
+	EntriesCleared int `json:"entries_cleared"`
+
+	// EntriesComputed Entries recomputed from their events so far
+	EntriesComputed int                 `json:"entries_computed"`
+	Error           *string             `json:"error,omitempty"`
+	Id              openapi_types.UUID  `json:"id"`
+	ProcessedDays   int                 `json:"processed_days"`
+	ProjectId       *openapi_types.UUID `json:"project_id,omitempty"`
+	StartDate       openapi_types.Date  `json:"start_date"`
+	StartedAt       *time.Time          `json:"started_at,omitempty"`
+
+	// Status One of pending, running, completed or failed
+	Status    string `json:"status"`
+	TotalDays int    `json:"total_days"`
+}
+
+// ReconcileRequest defines model for ReconcileRequest.
+type ReconcileRequest struct {
+	Resolution ReconcileRequestResolution `json:"resolution"`
+}
+
+// ReconcileRequestResolution defines model for ReconcileRequest.Resolution.
+type ReconcileRequestResolution string
+
+// ReconciliationItem defines model for ReconciliationItem.
+type ReconciliationItem struct {
+	ComputedHours float32 `json:"computed_hours"`
+
+	// Difference Recorded hours minus computed hours
+	Difference float32   `json:"difference"`
+	Entry      TimeEntry `json:"entry"`
+}
+
+// ReconciliationReport defines model for ReconciliationReport.
+type ReconciliationReport struct {
+	Items []ReconciliationItem `json:"items"`
+
+	// TotalDifference Sum of hours minus computed hours across all items
+	TotalDifference float32 `json:"total_difference"`
+}
+
+// RestoreSnapshotResponse defines model for RestoreSnapshotResponse.
+type RestoreSnapshotResponse struct {
+	// RecalculatedDates Days whose time entries were recalculated
+	RecalculatedDates []openapi_types.Date `json:"recalculated_dates"`
+
+	// RestoredCount Number of events whose classification changed
+	RestoredCount int                    `json:"restored_count"`
+	Snapshot      ClassificationSnapshot `json:"snapshot"`
+}
+
+// RetentionPreview defines model for RetentionPreview.
+type RetentionPreview struct {
+	// ArchivedEvents Archived calendar events that would be purged
+	ArchivedEvents int `json:"archived_events"`
+
+	// Cutoff Events starting before this are purged; null when events are kept forever
+	Cutoff *time.Time `json:"cutoff"`
+
+	// Events Calendar events that would be purged
+	Events int `json:"events"`
+
+	// ProtectedEvents Events before the cutoff kept because they feed invoiced time entries
+	ProtectedEvents int `json:"protected_events"`
+}
+
+// RetentionSettings defines model for RetentionSettings.
+type RetentionSettings struct {
+	// EventMonths Months of calendar events kept; null keeps them forever
+	EventMonths *int `json:"event_months"`
+}
+
+// RetentionSettingsUpdate defines model for RetentionSettingsUpdate.
+type RetentionSettingsUpdate struct {
+	EventMonths *int `json:"event_months"`
+}
+
+// ReviewDecision defines model for ReviewDecision.
+type ReviewDecision struct {
+	Decision ReviewDecisionDecision `json:"decision"`
+
+	// ProjectId Project to assign when overriding
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// Skip Skip the event when overriding
+	Skip *bool `json:"skip,omitempty"`
+}
+
+// ReviewDecisionDecision defines model for ReviewDecision.Decision.
+type ReviewDecisionDecision string
+
+// ReviewQueue defines model for ReviewQueue.
+type ReviewQueue struct {
+	Events []CalendarEvent `json:"events"`
+
+	// Total Number of events needing review in the range
+	Total int `json:"total"`
+}
+
+// ReviewResult defines model for ReviewResult.
+type ReviewResult struct {
+	// ActionId Journal entry for this change; pass to POST /api/actions/{id}/undo to revert
+	ActionId *openapi_types.UUID `json:"action_id,omitempty"`
+	Event    CalendarEvent       `json:"event"`
+	Next     *CalendarEvent      `json:"next,omitempty"`
+
+	// Remaining Number of events still needing review
+	Remaining int `json:"remaining"`
 }
 
 // RuleConflict defines model for RuleConflict.
@@ -644,8 +2304,38 @@ type RuleConflict struct {
 	ProposedProjectId *openapi_types.UUID `json:"proposed_project_id"`
 }
 
+// RuleConflictReport defines model for RuleConflictReport.
+type RuleConflictReport struct {
+	Conflicts       []RulePairConflict `json:"conflicts"`
+	EndDate         openapi_types.Date `json:"end_date"`
+	EventsEvaluated int                `json:"events_evaluated"`
+	StartDate       openapi_types.Date `json:"start_date"`
+}
+
+// RuleConflictSample defines model for RuleConflictSample.
+type RuleConflictSample struct {
+	ClassificationSource *string            `json:"classification_source,omitempty"`
+	EventId              openapi_types.UUID `json:"event_id"`
+
+	// ProjectId Current project, if classified
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	StartTime time.Time           `json:"start_time"`
+	Title     string              `json:"title"`
+}
+
 // RuleCreate defines model for RuleCreate.
 type RuleCreate struct {
+	// ApplyEndDate With apply_to_existing, only events on or before this date
+	ApplyEndDate *openapi_types.Date `json:"apply_end_date,omitempty"`
+
+	// ApplyStartDate With apply_to_existing, only events on or after this date
+	ApplyStartDate *openapi_types.Date `json:"apply_start_date,omitempty"`
+
+	// ApplyToExisting Also classify existing events the new rule matches, in a
+	// background job. Matching events are evaluated against all
+	// enabled rules, as apply_rules would. The rule must be enabled.
+	ApplyToExisting *bool `json:"apply_to_existing,omitempty"`
+
 	// Attended For attendance rules - false means "did not attend"
 	Attended  *bool `json:"attended,omitempty"`
 	IsEnabled *bool `json:"is_enabled,omitempty"`
@@ -700,6 +2390,154 @@ type RuleExport struct {
 	Weight *float32 `json:"weight,omitempty"`
 }
 
+// RuleLintIssue defines model for RuleLintIssue.
+type RuleLintIssue struct {
+	Kind RuleLintIssueKind `json:"kind"`
+
+	// Length Length of the offending text; absent for issues about the whole query
+	Length  *int   `json:"length,omitempty"`
+	Message string `json:"message"`
+
+	// Position Byte offset of the offending text in the query
+	Position *int `json:"position,omitempty"`
+
+	// RuleId Existing rule concerned, for duplicate and conflict
+	RuleId    *openapi_types.UUID `json:"rule_id,omitempty"`
+	RuleQuery *string             `json:"rule_query,omitempty"`
+
+	// Severity error when the rule can't work as written, warning when it probably isn't what was meant
+	Severity RuleLintIssueSeverity `json:"severity"`
+
+	// Suggestion Replacement for the offending text
+	Suggestion *string `json:"suggestion,omitempty"`
+}
+
+// RuleLintIssueKind defines model for RuleLintIssue.Kind.
+type RuleLintIssueKind string
+
+// RuleLintIssueSeverity error when the rule can't work as written, warning when it probably isn't what was meant
+type RuleLintIssueSeverity string
+
+// RuleLintRequest defines model for RuleLintRequest.
+type RuleLintRequest struct {
+	// Attended For attendance rules, whether matching events were attended
+	Attended *bool `json:"attended,omitempty"`
+
+	// ProjectId Project the rule would classify to
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	Query     string              `json:"query"`
+
+	// RuleId Rule being edited, left out of the comparison
+	RuleId *openapi_types.UUID `json:"rule_id,omitempty"`
+}
+
+// RuleLintResponse defines model for RuleLintResponse.
+type RuleLintResponse struct {
+	Issues []RuleLintIssue `json:"issues"`
+	Query  string          `json:"query"`
+
+	// Valid Whether the query parses
+	Valid bool `json:"valid"`
+}
+
+// RulePack A portable set of rules and fingerprints referencing projects by name
+type RulePack struct {
+	Description  *string               `json:"description,omitempty"`
+	ExportedAt   time.Time             `json:"exported_at"`
+	Fingerprints []RulePackFingerprint `json:"fingerprints"`
+
+	// FormatVersion Pack format version
+	FormatVersion string       `json:"format_version"`
+	Name          string       `json:"name"`
+	Rules         []RuleExport `json:"rules"`
+}
+
+// RulePackConflict defines model for RulePackConflict.
+type RulePackConflict struct {
+	// ExistingRuleId For duplicate_query, the rule already using the query
+	ExistingRuleId *openapi_types.UUID `json:"existing_rule_id,omitempty"`
+
+	// Kind duplicate_query, invalid_query or unknown_project
+	Kind        string  `json:"kind"`
+	Message     string  `json:"message"`
+	ProjectName *string `json:"project_name,omitempty"`
+	Query       *string `json:"query,omitempty"`
+}
+
+// RulePackExportRequest defines model for RulePackExportRequest.
+type RulePackExportRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the pack
+	Name string `json:"name"`
+
+	// ProjectNames Only include rules and fingerprints for these projects. Defaults to all.
+	ProjectNames *[]string `json:"project_names,omitempty"`
+}
+
+// RulePackFingerprint Fingerprints of one pack project
+type RulePackFingerprint struct {
+	Domains         *[]string           `json:"domains,omitempty"`
+	Emails          *[]string           `json:"emails,omitempty"`
+	ExcludeDomains  *[]string           `json:"exclude_domains,omitempty"`
+	ExcludeKeywords *[]string           `json:"exclude_keywords,omitempty"`
+	Keywords        *[]string           `json:"keywords,omitempty"`
+	ProjectName     string              `json:"project_name"`
+	Weights         *map[string]float32 `json:"weights,omitempty"`
+}
+
+// RulePackImportRequest defines model for RulePackImportRequest.
+type RulePackImportRequest struct {
+	// DryRun Report what would happen without writing anything
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Pack A portable set of rules and fingerprints referencing projects by name
+	Pack RulePack `json:"pack"`
+
+	// ProjectMapping Maps pack project names to projects in this account
+	ProjectMapping *map[string]openapi_types.UUID `json:"project_mapping,omitempty"`
+
+	// SkipUnmapped Drop rules and fingerprints of unresolved projects instead of refusing to apply
+	SkipUnmapped *bool `json:"skip_unmapped,omitempty"`
+}
+
+// RulePackImportResult defines model for RulePackImportResult.
+type RulePackImportResult struct {
+	// Applied Whether changes were written. False for dry runs and when projects are unmapped.
+	Applied   bool               `json:"applied"`
+	Conflicts []RulePackConflict `json:"conflicts"`
+
+	// FingerprintsMerged Projects whose fingerprints gained entries, or would
+	FingerprintsMerged int `json:"fingerprints_merged"`
+
+	// RulesCreated Rules created, or that would be created
+	RulesCreated int `json:"rules_created"`
+
+	// RulesSkipped Rules not imported because of a conflict
+	RulesSkipped int `json:"rules_skipped"`
+
+	// UnmappedProjects Pack project names that resolved to no project
+	UnmappedProjects []string `json:"unmapped_projects"`
+}
+
+// RulePairConflict defines model for RulePairConflict.
+type RulePairConflict struct {
+	// Overlap Shared events over the smaller rule's matches
+	Overlap float32         `json:"overlap"`
+	RuleA   ConflictingRule `json:"rule_a"`
+	RuleB   ConflictingRule `json:"rule_b"`
+
+	// Samples Up to five shared events
+	Samples      []RuleConflictSample `json:"samples"`
+	SharedEvents int                  `json:"shared_events"`
+
+	// Suggestion A weight that makes the favoured rule win its shared events
+	Suggestion *RuleWeightSuggestion `json:"suggestion,omitempty"`
+
+	// Tie The rules weigh the same, so shared events can land on either project from one run to the next
+	Tie bool `json:"tie"`
+}
+
 // RulePreviewRequest defines model for RulePreviewRequest.
 type RulePreviewRequest struct {
 	// EndDate End of date range to search
@@ -722,6 +2560,54 @@ type RulePreviewResponse struct {
 	Stats     PreviewStats   `json:"stats"`
 }
 
+// RuleTestEvent A synthetic event. Omitted fields are empty.
+type RuleTestEvent struct {
+	// Attendees Attendee email addresses
+	Attendees    *[]string  `json:"attendees,omitempty"`
+	CalendarName *string    `json:"calendar_name,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
+
+	// Id Label echoed in the result; defaults to the event's position
+	Id          *string `json:"id,omitempty"`
+	IsRecurring *bool   `json:"is_recurring,omitempty"`
+
+	// ResponseStatus accepted, declined, needsAction or tentative
+	ResponseStatus *string    `json:"response_status,omitempty"`
+	StartTime      *time.Time `json:"start_time,omitempty"`
+	Tags           *[]string  `json:"tags,omitempty"`
+	Title          *string    `json:"title,omitempty"`
+
+	// Transparency opaque or transparent
+	Transparency *string `json:"transparency,omitempty"`
+}
+
+// RuleTestRequest defines model for RuleTestRequest.
+type RuleTestRequest struct {
+	Events []RuleTestEvent `json:"events"`
+
+	// Query Query to test
+	Query string `json:"query"`
+}
+
+// RuleTestResponse defines model for RuleTestResponse.
+type RuleTestResponse struct {
+	MatchedCount int              `json:"matched_count"`
+	Query        string           `json:"query"`
+	Results      []RuleTestResult `json:"results"`
+}
+
+// RuleTestResult defines model for RuleTestResult.
+type RuleTestResult struct {
+	Id      string `json:"id"`
+	Matched bool   `json:"matched"`
+	Trace   []struct {
+		// Condition The condition in query syntax
+		Condition string `json:"condition"`
+		Matched   bool   `json:"matched"`
+	} `json:"trace"`
+}
+
 // RuleUpdate defines model for RuleUpdate.
 type RuleUpdate struct {
 	Attended  *bool               `json:"attended"`
@@ -731,6 +2617,40 @@ type RuleUpdate struct {
 	Weight    *float32            `json:"weight,omitempty"`
 }
 
+// RuleWeightSuggestion A weight that makes the favoured rule win its shared events
+type RuleWeightSuggestion struct {
+	CurrentWeight   float32            `json:"current_weight"`
+	Reason          string             `json:"reason"`
+	RuleId          openapi_types.UUID `json:"rule_id"`
+	SuggestedWeight float32            `json:"suggested_weight"`
+}
+
+// SCIMToken defines model for SCIMToken.
+type SCIMToken struct {
+	Prefix string `json:"prefix"`
+
+	// ScimUrl SCIM 2.0 base URL to configure in the identity provider
+	ScimUrl string `json:"scim_url"`
+
+	// Token Bearer token for SCIM requests; shown only once
+	Token string `json:"token"`
+}
+
+// SSOStartRequest defines model for SSOStartRequest.
+type SSOStartRequest struct {
+	// Email Finds the organization by the email's verified domain
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Organization Organization slug; takes precedence over email
+	Organization *string `json:"organization,omitempty"`
+}
+
+// SSOStartResponse defines model for SSOStartResponse.
+type SSOStartResponse struct {
+	// AuthorizationUrl Identity provider login URL to send the browser to
+	AuthorizationUrl string `json:"authorization_url"`
+}
+
 // SignupRequest defines model for SignupRequest.
 type SignupRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -738,6 +2658,38 @@ type SignupRequest struct {
 	Password string              `json:"password"`
 }
 
+// SuppressionRule defines model for SuppressionRule.
+type SuppressionRule struct {
+	CalendarId *openapi_types.UUID `json:"calendar_id"`
+	CreatedAt  time.Time           `json:"created_at"`
+	Id         openapi_types.UUID  `json:"id"`
+	IsEnabled  bool                `json:"is_enabled"`
+	Query      *string             `json:"query"`
+}
+
+// SuppressionRuleCreate defines model for SuppressionRuleCreate.
+type SuppressionRuleCreate struct {
+	CalendarId *openapi_types.UUID `json:"calendar_id,omitempty"`
+
+	// Query Query in the rule syntax; at least one of calendar_id and query is required
+	Query *string `json:"query,omitempty"`
+}
+
+// SuppressionRuleResult defines model for SuppressionRuleResult.
+type SuppressionRuleResult struct {
+	// ReleasedCount Events returned to the pending queue
+	ReleasedCount int             `json:"released_count"`
+	Rule          SuppressionRule `json:"rule"`
+
+	// SuppressedCount Pending events newly suppressed
+	SuppressedCount int `json:"suppressed_count"`
+}
+
+// SuppressionRuleUpdate defines model for SuppressionRuleUpdate.
+type SuppressionRuleUpdate struct {
+	IsEnabled bool `json:"is_enabled"`
+}
+
 // SyncResult defines model for SyncResult.
 type SyncResult struct {
 	EventsCreated  int `json:"events_created"`
@@ -745,16 +2697,71 @@ type SyncResult struct {
 	EventsUpdated  int `json:"events_updated"`
 }
 
-// TargetScore defines model for TargetScore.
-type TargetScore struct {
-	// FingerprintWeight Weight from project fingerprint matches
-	FingerprintWeight *float32 `json:"fingerprint_weight,omitempty"`
+// SyncSettings defines model for SyncSettings.
+type SyncSettings struct {
+	// IntervalMinutes How often background sync refreshes the calendars
+	IntervalMinutes int `json:"interval_minutes"`
 
-	// IsWinner Whether this project won the classification
-	IsWinner *bool `json:"is_winner,omitempty"`
+	// IsDefault True when the user hasn't chosen their own cadence
+	IsDefault bool `json:"is_default"`
 
-	// RuleWeight Weight from explicit user-defined rules
-	RuleWeight *float32 `json:"rule_weight,omitempty"`
+	// StaleAfterMinutes Age at which reading synced events refreshes them
+	StaleAfterMinutes int `json:"stale_after_minutes"`
+}
+
+// SyncSettingsUpdate defines model for SyncSettingsUpdate.
+type SyncSettingsUpdate struct {
+	IntervalMinutes   *int `json:"interval_minutes"`
+	StaleAfterMinutes *int `json:"stale_after_minutes"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EntryCount Time entries carrying the tag
+	EntryCount int `json:"entry_count"`
+
+	// EventCount Events carrying the tag
+	EventCount int                `json:"event_count"`
+	Id         openapi_types.UUID `json:"id"`
+	Name       string             `json:"name"`
+}
+
+// TagAssignment defines model for TagAssignment.
+type TagAssignment struct {
+	// Tags Tag names; replaces the existing tags
+	Tags []string `json:"tags"`
+}
+
+// TagCreate defines model for TagCreate.
+type TagCreate struct {
+	Name string `json:"name"`
+}
+
+// TagShare defines model for TagShare.
+type TagShare struct {
+	Hours float64 `json:"hours"`
+
+	// Share Tag hours / total hours. Entries with several tags count toward
+	// each, so shares can add up to more than 1.
+	Share float64 `json:"share"`
+	Tag   string  `json:"tag"`
+}
+
+// TargetScore defines model for TargetScore.
+type TargetScore struct {
+	// ExclusionWeight Negative weight from exclusion fingerprints that matched
+	ExclusionWeight *float32 `json:"exclusion_weight,omitempty"`
+
+	// FingerprintWeight Weight from project fingerprint matches
+	FingerprintWeight *float32 `json:"fingerprint_weight,omitempty"`
+
+	// IsWinner Whether this project won the classification
+	IsWinner *bool `json:"is_winner,omitempty"`
+
+	// RuleWeight Weight from explicit user-defined rules
+	RuleWeight *float32 `json:"rule_weight,omitempty"`
 
 	// TargetId Project ID
 	TargetId openapi_types.UUID `json:"target_id"`
@@ -785,8 +2792,11 @@ type TimeEntry struct {
 	CreatedAt          time.Time             `json:"created_at"`
 
 	// Date The calendar day for this entry
-	Date        openapi_types.Date `json:"date"`
-	Description *string            `json:"description,omitempty"`
+	Date openapi_types.Date `json:"date"`
+
+	// DeletedAt When the entry was moved to the trash
+	DeletedAt   *time.Time `json:"deleted_at"`
+	Description *string    `json:"description,omitempty"`
 
 	// HasUserEdits Whether user has modified this entry
 	HasUserEdits *bool              `json:"has_user_edits,omitempty"`
@@ -816,6 +2826,9 @@ type TimeEntry struct {
 	// Source How this entry was created
 	Source TimeEntrySource `json:"source"`
 
+	// Tags The user's tags on this entry; computed entries have none
+	Tags *[]string `json:"tags,omitempty"`
+
 	// Title Short title (generated from events or user-provided)
 	Title     *string            `json:"title,omitempty"`
 	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
@@ -825,6 +2838,63 @@ type TimeEntry struct {
 // TimeEntrySource How this entry was created
 type TimeEntrySource string
 
+// TimeEntryBreakdown defines model for TimeEntryBreakdown.
+type TimeEntryBreakdown struct {
+	// ComputedHours Hours computed from the events, when the entry has been computed
+	ComputedHours *float64                  `json:"computed_hours,omitempty"`
+	Date          openapi_types.Date        `json:"date"`
+	Events        []TimeEntryBreakdownEvent `json:"events"`
+
+	// FinalMinutes Minutes after rounding
+	FinalMinutes int `json:"final_minutes"`
+
+	// Hours The entry's hours, including any user edits
+	Hours     float64            `json:"hours"`
+	ProjectId openapi_types.UUID `json:"project_id"`
+
+	// RoundingApplied Adjustment made by rounding, e.g. "+10m", or "none"
+	RoundingApplied string             `json:"rounding_applied"`
+	TimeEntryId     openapi_types.UUID `json:"time_entry_id"`
+
+	// TimeRanges The events' times merged where they overlap or touch
+	TimeRanges []struct {
+		// End Local time of day (HH:MM)
+		End     string `json:"end"`
+		Minutes int    `json:"minutes"`
+
+		// Start Local time of day (HH:MM)
+		Start string `json:"start"`
+	} `json:"time_ranges"`
+
+	// UnionMinutes Total minutes of the merged ranges
+	UnionMinutes int `json:"union_minutes"`
+}
+
+// TimeEntryBreakdownEvent defines model for TimeEntryBreakdownEvent.
+type TimeEntryBreakdownEvent struct {
+	// CountedEnd End of the part of the event that counted, omitted when none did
+	CountedEnd *time.Time `json:"counted_end,omitempty"`
+
+	// CountedMinutes Minutes not already covered by an earlier event
+	CountedMinutes int `json:"counted_minutes"`
+
+	// CountedStart Start of the part of the event that counted, omitted when none did
+	CountedStart *time.Time `json:"counted_start,omitempty"`
+	End          *time.Time `json:"end,omitempty"`
+	EventId      string     `json:"event_id"`
+
+	// IsAllDay All-day events are listed but contribute no time
+	IsAllDay bool `json:"is_all_day"`
+
+	// OverlapMinutes Minutes already covered by an earlier event
+	OverlapMinutes int `json:"overlap_minutes"`
+
+	// RawMinutes The event's full length
+	RawMinutes int        `json:"raw_minutes"`
+	Start      *time.Time `json:"start,omitempty"`
+	Title      string     `json:"title"`
+}
+
 // TimeEntryCreate defines model for TimeEntryCreate.
 type TimeEntryCreate struct {
 	Date        openapi_types.Date `json:"date"`
@@ -833,6 +2903,116 @@ type TimeEntryCreate struct {
 	ProjectId   openapi_types.UUID `json:"project_id"`
 }
 
+// TimeEntryDraft defines model for TimeEntryDraft.
+type TimeEntryDraft struct {
+	// Complete Whether the draft has a duration and a project
+	Complete    bool               `json:"complete"`
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description"`
+	Entry       *TimeEntry         `json:"entry,omitempty"`
+
+	// Hours Parsed duration, 0 when none was found
+	Hours float32 `json:"hours"`
+
+	// Match How the project was matched - short_code, name, fingerprint or fuzzy
+	Match       *string             `json:"match"`
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	ProjectName *string             `json:"project_name"`
+
+	// Warnings Parts of the text that could not be understood
+	Warnings []string `json:"warnings"`
+}
+
+// TimeEntryExcludedEvent defines model for TimeEntryExcludedEvent.
+type TimeEntryExcludedEvent struct {
+	EndTime time.Time          `json:"end_time"`
+	EventId openapi_types.UUID `json:"event_id"`
+
+	// Reason skipped: skipped by hand. not_attended: skipped by an attendance
+	// rule. not_yet_counted: classified since the entry was last
+	// computed.
+	Reason    TimeEntryExcludedEventReason `json:"reason"`
+	StartTime time.Time                    `json:"start_time"`
+	Title     string                       `json:"title"`
+}
+
+// TimeEntryExcludedEventReason skipped: skipped by hand. not_attended: skipped by an attendance
+// rule. not_yet_counted: classified since the entry was last
+// computed.
+type TimeEntryExcludedEventReason string
+
+// TimeEntryExplanation defines model for TimeEntryExplanation.
+type TimeEntryExplanation struct {
+	Breakdown TimeEntryBreakdown `json:"breakdown"`
+
+	// ExcludedEvents Events on the entry's day classified to its project that don't count toward it
+	ExcludedEvents []TimeEntryExcludedEvent `json:"excluded_events"`
+
+	// Narrative The calculation in plain language, one step per item
+	Narrative  []string            `json:"narrative"`
+	Protection TimeEntryProtection `json:"protection"`
+}
+
+// TimeEntryNote defines model for TimeEntryNote.
+type TimeEntryNote struct {
+	AuthorName  string             `json:"author_name"`
+	Body        string             `json:"body"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Id          openapi_types.UUID `json:"id"`
+	TimeEntryId openapi_types.UUID `json:"time_entry_id"`
+
+	// UserId Author of the note
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// TimeEntryNoteCreate defines model for TimeEntryNoteCreate.
+type TimeEntryNoteCreate struct {
+	Body string `json:"body"`
+}
+
+// TimeEntryParseRequest defines model for TimeEntryParseRequest.
+type TimeEntryParseRequest struct {
+	// Text Free-text entry, e.g. "2h yesterday on Acme — code review"
+	Text string `json:"text"`
+
+	// Timezone IANA time zone used to resolve relative dates. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// TimeEntryProtection defines model for TimeEntryProtection.
+type TimeEntryProtection struct {
+	// Deleted The entry is in the trash
+	Deleted   bool                `json:"deleted"`
+	InvoiceId *openapi_types.UUID `json:"invoice_id,omitempty"`
+
+	// Invoiced Recalculation no longer changes the entry's hours, title or description
+	Invoiced bool `json:"invoiced"`
+
+	// Stale The entry's events changed after it was protected
+	Stale      bool `json:"stale"`
+	Suppressed bool `json:"suppressed"`
+
+	// UserEdited The entry is kept, marked stale, when its events go away
+	UserEdited bool `json:"user_edited"`
+}
+
+// TimeEntrySplit defines model for TimeEntrySplit.
+type TimeEntrySplit struct {
+	// Date Required when splitting an ephemeral entry (to materialize it)
+	Date  *openapi_types.Date  `json:"date,omitempty"`
+	Parts []TimeEntrySplitPart `json:"parts"`
+
+	// ProjectId Required when splitting an ephemeral entry (to materialize it)
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+}
+
+// TimeEntrySplitPart defines model for TimeEntrySplitPart.
+type TimeEntrySplitPart struct {
+	Description *string            `json:"description,omitempty"`
+	Hours       float32            `json:"hours"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+}
+
 // TimeEntryUpdate defines model for TimeEntryUpdate.
 type TimeEntryUpdate struct {
 	// Date Required when updating an ephemeral entry (to materialize it)
@@ -844,6 +3024,20 @@ type TimeEntryUpdate struct {
 	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
 }
 
+// Trash defines model for Trash.
+type Trash struct {
+	Rules       []ClassificationRule `json:"rules"`
+	TimeEntries []TimeEntry          `json:"time_entries"`
+}
+
+// UndoActionResponse defines model for UndoActionResponse.
+type UndoActionResponse struct {
+	Action ClassificationAction `json:"action"`
+
+	// RecalculatedDates Days whose time entries were recalculated
+	RecalculatedDates []openapi_types.Date `json:"recalculated_dates"`
+}
+
 // UpdateCalendarSourcesRequest defines model for UpdateCalendarSourcesRequest.
 type UpdateCalendarSourcesRequest struct {
 	// CalendarIds IDs of calendars to enable for syncing
@@ -858,6 +3052,121 @@ type User struct {
 	Name      string              `json:"name"`
 }
 
+// UtilizationReport defines model for UtilizationReport.
+type UtilizationReport struct {
+	// Audience Time on internal vs client-facing events, from the durations of
+	// counted classified events (overlapping events each count in full).
+	// Absent until company domains are set.
+	Audience      *AudienceSplit     `json:"audience,omitempty"`
+	BillableHours float64            `json:"billable_hours"`
+	CapacityHours float64            `json:"capacity_hours"`
+	EndDate       openapi_types.Date `json:"end_date"`
+	Projects      []ProjectShare     `json:"projects"`
+	StartDate     openapi_types.Date `json:"start_date"`
+
+	// Tags Hours on saved time entries by tag, most hours first
+	Tags       *[]TagShare `json:"tags,omitempty"`
+	TotalHours float64     `json:"total_hours"`
+
+	// Utilization Billable hours / capacity (0 when there is no capacity)
+	Utilization float64           `json:"utilization"`
+	Weeks       []UtilizationWeek `json:"weeks"`
+}
+
+// UtilizationWeek defines model for UtilizationWeek.
+type UtilizationWeek struct {
+	BillableHours float64 `json:"billable_hours"`
+
+	// CapacityHours Capacity for the days of this week inside the report range
+	CapacityHours float64            `json:"capacity_hours"`
+	TotalHours    float64            `json:"total_hours"`
+	Utilization   float64            `json:"utilization"`
+	WeekStart     openapi_types.Date `json:"week_start"`
+}
+
+// WeekGrid defines model for WeekGrid.
+type WeekGrid struct {
+	Days []WeekGridDay      `json:"days"`
+	End  openapi_types.Date `json:"end"`
+
+	// Rows One row per project with entries in the week, by name
+	Rows       []WeekGridRow      `json:"rows"`
+	Start      openapi_types.Date `json:"start"`
+	TotalHours float32            `json:"total_hours"`
+}
+
+// WeekGridCell defines model for WeekGridCell.
+type WeekGridCell struct {
+	Date openapi_types.Date `json:"date"`
+
+	// EntryId The entry behind the cell, if any (ephemeral entries have stable IDs too)
+	EntryId   *openapi_types.UUID `json:"entry_id"`
+	Hours     float32             `json:"hours"`
+	InvoiceId *openapi_types.UUID `json:"invoice_id"`
+
+	// Locked Whether the entry is locked by an invoice
+	Locked bool `json:"locked"`
+}
+
+// WeekGridDay defines model for WeekGridDay.
+type WeekGridDay struct {
+	Date  openapi_types.Date `json:"date"`
+	Hours float32            `json:"hours"`
+
+	// UnclassifiedCount Events starting this day still pending classification
+	UnclassifiedCount int `json:"unclassified_count"`
+}
+
+// WeekGridRow defines model for WeekGridRow.
+type WeekGridRow struct {
+	// Cells One cell per day, in the order of `days`
+	Cells                  []WeekGridCell     `json:"cells"`
+	Color                  *string            `json:"color,omitempty"`
+	DoesNotAccumulateHours bool               `json:"does_not_accumulate_hours"`
+	ProjectId              openapi_types.UUID `json:"project_id"`
+	ProjectName            string             `json:"project_name"`
+	TotalHours             float32            `json:"total_hours"`
+}
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	// DailyHours Hours available each weekday, Monday first
+	DailyHours []float64 `json:"daily_hours"`
+
+	// IsDefault True until the user saves a profile
+	IsDefault bool       `json:"is_default"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// WorkingHoursUpdate defines model for WorkingHoursUpdate.
+type WorkingHoursUpdate struct {
+	// DailyHours Hours available each weekday, Monday first (0-24 each)
+	DailyHours []float64 `json:"daily_hours"`
+}
+
+// ListClassificationActionsParams defines parameters for ListClassificationActions.
+type ListClassificationActionsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListAnomaliesParams defines parameters for ListAnomalies.
+type ListAnomaliesParams struct {
+	// StartDate First day to include (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day to include (YYYY-MM-DD)
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// IncludeDismissed Also return anomalies that were already dismissed
+	IncludeDismissed *bool `form:"include_dismissed,omitempty" json:"include_dismissed,omitempty"`
+}
+
+// GoogleAuthorizeParams defines parameters for GoogleAuthorize.
+type GoogleAuthorizeParams struct {
+	// Access Access to request; defaults to calendar
+	Access *GoogleAccess `form:"access,omitempty" json:"access,omitempty"`
+}
+
 // GoogleCallbackParams defines parameters for GoogleCallback.
 type GoogleCallbackParams struct {
 	// Code Authorization code from Google
@@ -867,11 +3176,22 @@ type GoogleCallbackParams struct {
 	State string `form:"state" json:"state"`
 }
 
+// PreviewRetentionParams defines parameters for PreviewRetention.
+type PreviewRetentionParams struct {
+	EventMonths *int `form:"event_months,omitempty" json:"event_months,omitempty"`
+}
+
 // ListBillingPeriodsParams defines parameters for ListBillingPeriods.
 type ListBillingPeriodsParams struct {
 	ProjectId openapi_types.UUID `form:"project_id" json:"project_id"`
 }
 
+// ListClientBalancesParams defines parameters for ListClientBalances.
+type ListClientBalancesParams struct {
+	// Client Only return the balance for this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+}
+
 // ListCalendarEventsParams defines parameters for ListCalendarEvents.
 type ListCalendarEventsParams struct {
 	// StartDate Start date (YYYY-MM-DD). Defaults to 30 days ago.
@@ -881,26 +3201,95 @@ type ListCalendarEventsParams struct {
 	EndDate              *openapi_types.Date                           `form:"end_date,omitempty" json:"end_date,omitempty"`
 	ClassificationStatus *ListCalendarEventsParamsClassificationStatus `form:"classification_status,omitempty" json:"classification_status,omitempty"`
 	ConnectionId         *openapi_types.UUID                           `form:"connection_id,omitempty" json:"connection_id,omitempty"`
+
+	// Tag Only return events carrying this tag (case-insensitive)
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
 }
 
 // ListCalendarEventsParamsClassificationStatus defines parameters for ListCalendarEvents.
 type ListCalendarEventsParamsClassificationStatus string
 
+// DiffCalendarEventsParams defines parameters for DiffCalendarEvents.
+type DiffCalendarEventsParams struct {
+	StartDate openapi_types.Date `form:"start_date" json:"start_date"`
+
+	// EndDate Last day of the range, inclusive
+	EndDate openapi_types.Date `form:"end_date" json:"end_date"`
+	Since   time.Time          `form:"since" json:"since"`
+
+	// Until Defaults to now
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetReviewQueueParams defines parameters for GetReviewQueue.
+type GetReviewQueueParams struct {
+	// StartDate Only events starting on or after this date (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Only events starting on or before this date (YYYY-MM-DD)
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+	Limit   *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // SyncCalendarParams defines parameters for SyncCalendar.
 type SyncCalendarParams struct {
-	// StartDate Start date for on-demand sync (defaults to 90 days ago)
+	// StartDate Start date for on-demand sync (defaults to the connection's sync window, 90 days ago unless configured)
 	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
 
-	// EndDate End date for on-demand sync (defaults to 30 days from now)
+	// EndDate End date for on-demand sync (defaults to the connection's sync window, 30 days from now unless configured)
 	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
 }
 
+// ListChangesParams defines parameters for ListChanges.
+type ListChangesParams struct {
+	// Since Opaque cursor returned as next_cursor by a previous call
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// ResourceType Only return changes to calendar_event, time_entry or invoice resources
+	ResourceType *string `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// Limit Maximum number of changes to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListClientRatesParams defines parameters for ListClientRates.
+type ListClientRatesParams struct {
+	// Client Only return rates for this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+}
+
 // ExportConfigParams defines parameters for ExportConfig.
 type ExportConfigParams struct {
 	// IncludeArchived Include archived projects in export
 	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
 }
 
+// ListExpensesParams defines parameters for ListExpenses.
+type ListExpensesParams struct {
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// StartDate First expense date to include (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last expense date to include (YYYY-MM-DD)
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Billable Only billable (true) or non-billable (false) expenses
+	Billable *bool `form:"billable,omitempty" json:"billable,omitempty"`
+}
+
+// ListFingerprintClaimsParams defines parameters for ListFingerprintClaims.
+type ListFingerprintClaimsParams struct {
+	// Domain Domain to look up, e.g. acme.com
+	Domain string `form:"domain" json:"domain"`
+}
+
+// GetGoalsProgressParams defines parameters for GetGoalsProgress.
+type GetGoalsProgressParams struct {
+	// Date Day to measure progress on (YYYY-MM-DD). Defaults to today.
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
 // ListInvoicesParams defines parameters for ListInvoices.
 type ListInvoicesParams struct {
 	ProjectId *openapi_types.UUID       `form:"project_id,omitempty" json:"project_id,omitempty"`
@@ -910,6 +3299,17 @@ type ListInvoicesParams struct {
 // ListInvoicesParamsStatus defines parameters for ListInvoices.
 type ListInvoicesParamsStatus string
 
+// ExportInvoiceParams defines parameters for ExportInvoice.
+type ExportInvoiceParams struct {
+	Format ExportInvoiceParamsFormat `form:"format" json:"format"`
+
+	// IncludeNotes Add each time entry's notes below its line
+	IncludeNotes *bool `form:"include_notes,omitempty" json:"include_notes,omitempty"`
+}
+
+// ExportInvoiceParamsFormat defines parameters for ExportInvoice.
+type ExportInvoiceParamsFormat string
+
 // UpdateInvoiceStatusJSONBody defines parameters for UpdateInvoiceStatus.
 type UpdateInvoiceStatusJSONBody struct {
 	Status UpdateInvoiceStatusJSONBodyStatus `json:"status"`
@@ -922,12 +3322,102 @@ type UpdateInvoiceStatusJSONBodyStatus string
 type ListProjectsParams struct {
 	// IncludeArchived Include archived/inactive projects
 	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+
+	// Archived Only archived (true) or only active (false) projects; overrides include_archived
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+
+	// Client Only projects for this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+
+	// Billable Only billable (true) or non-billable (false) projects
+	Billable *bool `form:"billable,omitempty" json:"billable,omitempty"`
+
+	// Sort Sort order: "name", "recent" (most recent time entry first) or
+	// "month_hours" (most hours logged this month first)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of projects to return; all when omitted
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of projects to skip, for paging with limit
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetMeetingCostReportParams defines parameters for GetMeetingCostReport.
+type GetMeetingCostReportParams struct {
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// DefaultRate Hourly cost of an attendee without a more specific rate
+	DefaultRate *float64 `form:"default_rate,omitempty" json:"default_rate,omitempty"`
+
+	// Rate Per-person hourly rates as email:amount or domain:amount, e.g.
+	// `ceo@acme.com:400`. An email rate beats its domain's.
+	Rate *[]string `form:"rate,omitempty" json:"rate,omitempty"`
+}
+
+// GetMeetingLoadReportParams defines parameters for GetMeetingLoadReport.
+type GetMeetingLoadReportParams struct {
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// DayStart When the working day starts, HH:MM in UTC. Defaults to 09:00.
+	DayStart *string `form:"day_start,omitempty" json:"day_start,omitempty"`
+
+	// MinFocusMinutes Shortest gap between meetings that counts as focus time. Defaults to 30.
+	MinFocusMinutes *int `form:"min_focus_minutes,omitempty" json:"min_focus_minutes,omitempty"`
+}
+
+// GetUtilizationReportParams defines parameters for GetUtilizationReport.
+type GetUtilizationReportParams struct {
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
 }
 
 // ListRulesParams defines parameters for ListRules.
 type ListRulesParams struct {
 	// IncludeDisabled Include disabled rules
 	IncludeDisabled *bool `form:"include_disabled,omitempty" json:"include_disabled,omitempty"`
+
+	// ProjectId Only rules targeting this project
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Client Only rules targeting a project of this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+
+	// Sort Sort order: "weight" (heaviest first), "recent" (most recently
+	// updated first) or "project" (by target project name)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of rules to return; all when omitted
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of rules to skip, for paging with limit
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetRuleConflictsParams defines parameters for GetRuleConflicts.
+type GetRuleConflictsParams struct {
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// MinOverlap Share of the smaller rule's matches that must be shared (default 0.5)
+	MinOverlap *float32 `form:"min_overlap,omitempty" json:"min_overlap,omitempty"`
+}
+
+// ListClassificationSnapshotsParams defines parameters for ListClassificationSnapshots.
+type ListClassificationSnapshotsParams struct {
+	// WeekStart Any date in the week; normalized to its Monday
+	WeekStart *openapi_types.Date `form:"week_start,omitempty" json:"week_start,omitempty"`
 }
 
 // ListTimeEntriesParams defines parameters for ListTimeEntries.
@@ -940,17 +3430,65 @@ type ListTimeEntriesParams struct {
 
 	// ProjectId Filter by project
 	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Tag Only return saved entries carrying this tag (case-insensitive)
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// SuggestDescriptionsParams defines parameters for SuggestDescriptions.
+type SuggestDescriptionsParams struct {
+	// Date Day to suggest descriptions for (YYYY-MM-DD)
+	Date openapi_types.Date `form:"date" json:"date"`
+}
+
+// ParseTimeEntryParams defines parameters for ParseTimeEntry.
+type ParseTimeEntryParams struct {
+	// Commit Create the time entry when the draft is complete
+	Commit *bool `form:"commit,omitempty" json:"commit,omitempty"`
+}
+
+// GetReconciliationReportParams defines parameters for GetReconciliationReport.
+type GetReconciliationReportParams struct {
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// IncludeAcknowledged Also list divergences previously resolved with keep_manual
+	IncludeAcknowledged *bool `form:"include_acknowledged,omitempty" json:"include_acknowledged,omitempty"`
 }
 
+// AnalyzeAnomaliesJSONRequestBody defines body for AnalyzeAnomalies for application/json ContentType.
+type AnalyzeAnomaliesJSONRequestBody = AnalyzeAnomaliesRequest
+
 // CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
 type CreateApiKeyJSONRequestBody = ApiKeyCreate
 
+// UpdateApiKeyJSONRequestBody defines body for UpdateApiKey for application/json ContentType.
+type UpdateApiKeyJSONRequestBody = ApiKeyUpdate
+
+// CreateAttendeeAliasJSONRequestBody defines body for CreateAttendeeAlias for application/json ContentType.
+type CreateAttendeeAliasJSONRequestBody = AttendeeAliasCreate
+
+// UpdateCompanyDomainsJSONRequestBody defines body for UpdateCompanyDomains for application/json ContentType.
+type UpdateCompanyDomainsJSONRequestBody = CompanyDomains
+
+// UpdateLocaleJSONRequestBody defines body for UpdateLocale for application/json ContentType.
+type UpdateLocaleJSONRequestBody = LocaleUpdate
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
+// UpdateRetentionSettingsJSONRequestBody defines body for UpdateRetentionSettings for application/json ContentType.
+type UpdateRetentionSettingsJSONRequestBody = RetentionSettingsUpdate
+
 // SignupJSONRequestBody defines body for Signup for application/json ContentType.
 type SignupJSONRequestBody = SignupRequest
 
+// StartSsoJSONRequestBody defines body for StartSso for application/json ContentType.
+type StartSsoJSONRequestBody = SSOStartRequest
+
+// UpdateSyncSettingsJSONRequestBody defines body for UpdateSyncSettings for application/json ContentType.
+type UpdateSyncSettingsJSONRequestBody = SyncSettingsUpdate
+
 // CreateBillingPeriodJSONRequestBody defines body for CreateBillingPeriod for application/json ContentType.
 type CreateBillingPeriodJSONRequestBody = BillingPeriodCreate
 
@@ -963,44 +3501,194 @@ type BulkClassifyEventsJSONRequestBody = BulkClassifyRequest
 // ClassifyCalendarEventJSONRequestBody defines body for ClassifyCalendarEvent for application/json ContentType.
 type ClassifyCalendarEventJSONRequestBody = ClassifyEventRequest
 
+// ReviewCalendarEventJSONRequestBody defines body for ReviewCalendarEvent for application/json ContentType.
+type ReviewCalendarEventJSONRequestBody = ReviewDecision
+
+// SetEventSuppressionJSONRequestBody defines body for SetEventSuppression for application/json ContentType.
+type SetEventSuppressionJSONRequestBody = EventSuppressionUpdate
+
+// SetEventTagsJSONRequestBody defines body for SetEventTags for application/json ContentType.
+type SetEventTagsJSONRequestBody = TagAssignment
+
 // UpdateCalendarSourcesJSONRequestBody defines body for UpdateCalendarSources for application/json ContentType.
 type UpdateCalendarSourcesJSONRequestBody = UpdateCalendarSourcesRequest
 
+// UpdateCalendarSourceJSONRequestBody defines body for UpdateCalendarSource for application/json ContentType.
+type UpdateCalendarSourceJSONRequestBody = CalendarSourceOverrides
+
+// UpdateCalendarSyncWindowJSONRequestBody defines body for UpdateCalendarSyncWindow for application/json ContentType.
+type UpdateCalendarSyncWindowJSONRequestBody = CalendarSyncWindowInput
+
+// UpdateCalendarWriteBackJSONRequestBody defines body for UpdateCalendarWriteBack for application/json ContentType.
+type UpdateCalendarWriteBackJSONRequestBody = CalendarWriteBackInput
+
+// ApplyRulesAsyncJSONRequestBody defines body for ApplyRulesAsync for application/json ContentType.
+type ApplyRulesAsyncJSONRequestBody = ApplyRulesAsyncRequest
+
+// CreateClientRateJSONRequestBody defines body for CreateClientRate for application/json ContentType.
+type CreateClientRateJSONRequestBody = ClientRateCreate
+
+// UpdateClientRateJSONRequestBody defines body for UpdateClientRate for application/json ContentType.
+type UpdateClientRateJSONRequestBody = ClientRateUpdate
+
 // ImportConfigJSONRequestBody defines body for ImportConfig for application/json ContentType.
 type ImportConfigJSONRequestBody = ConfigImport
 
+// CreateExpenseJSONRequestBody defines body for CreateExpense for application/json ContentType.
+type CreateExpenseJSONRequestBody = ExpenseCreate
+
+// UpdateExpenseJSONRequestBody defines body for UpdateExpense for application/json ContentType.
+type UpdateExpenseJSONRequestBody = ExpenseUpdate
+
+// LogFocusSessionJSONRequestBody defines body for LogFocusSession for application/json ContentType.
+type LogFocusSessionJSONRequestBody = FocusSessionInput
+
+// RecordFocusGapsJSONRequestBody defines body for RecordFocusGaps for application/json ContentType.
+type RecordFocusGapsJSONRequestBody = FocusGapsInput
+
+// CreateGoalJSONRequestBody defines body for CreateGoal for application/json ContentType.
+type CreateGoalJSONRequestBody = HourGoalInput
+
+// UpdateGoalJSONRequestBody defines body for UpdateGoal for application/json ContentType.
+type UpdateGoalJSONRequestBody = HourGoalInput
+
+// ConnectGitHubJSONRequestBody defines body for ConnectGitHub for application/json ContentType.
+type ConnectGitHubJSONRequestBody = GitHubConnectionInput
+
+// ConnectIntegrationJSONRequestBody defines body for ConnectIntegration for application/json ContentType.
+type ConnectIntegrationJSONRequestBody = IntegrationConnectionInput
+
 // CreateInvoiceJSONRequestBody defines body for CreateInvoice for application/json ContentType.
 type CreateInvoiceJSONRequestBody = InvoiceCreate
 
+// AddInvoiceAdjustmentJSONRequestBody defines body for AddInvoiceAdjustment for application/json ContentType.
+type AddInvoiceAdjustmentJSONRequestBody = InvoiceAdjustmentCreate
+
+// AddInvoiceCommentJSONRequestBody defines body for AddInvoiceComment for application/json ContentType.
+type AddInvoiceCommentJSONRequestBody = InvoiceCommentCreate
+
+// CreateCreditNoteJSONRequestBody defines body for CreateCreditNote for application/json ContentType.
+type CreateCreditNoteJSONRequestBody = CreditNoteCreate
+
+// RecordInvoicePaymentJSONRequestBody defines body for RecordInvoicePayment for application/json ContentType.
+type RecordInvoicePaymentJSONRequestBody = PaymentCreate
+
 // UpdateInvoiceStatusJSONRequestBody defines body for UpdateInvoiceStatus for application/json ContentType.
 type UpdateInvoiceStatusJSONRequestBody UpdateInvoiceStatusJSONBody
 
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = OrganizationCreate
+
+// PutOrganizationDomainJSONRequestBody defines body for PutOrganizationDomain for application/json ContentType.
+type PutOrganizationDomainJSONRequestBody = OrganizationDomainInput
+
+// UpdateOrganizationGoogleDelegationJSONRequestBody defines body for UpdateOrganizationGoogleDelegation for application/json ContentType.
+type UpdateOrganizationGoogleDelegationJSONRequestBody = OrganizationDelegationInput
+
+// ProvisionOrganizationCalendarsJSONRequestBody defines body for ProvisionOrganizationCalendars for application/json ContentType.
+type ProvisionOrganizationCalendarsJSONRequestBody = DelegationProvisionInput
+
+// UpdateOrganizationMemberDelegationJSONRequestBody defines body for UpdateOrganizationMemberDelegation for application/json ContentType.
+type UpdateOrganizationMemberDelegationJSONRequestBody = MemberDelegationInput
+
+// TransferOrganizationProjectJSONRequestBody defines body for TransferOrganizationProject for application/json ContentType.
+type TransferOrganizationProjectJSONRequestBody = ProjectTransferInput
+
+// UpdateOrganizationSsoJSONRequestBody defines body for UpdateOrganizationSso for application/json ContentType.
+type UpdateOrganizationSsoJSONRequestBody = OrganizationSSOInput
+
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody = ProjectCreate
 
 // UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
 type UpdateProjectJSONRequestBody = ProjectUpdate
 
+// AddProjectFingerprintJSONRequestBody defines body for AddProjectFingerprint for application/json ContentType.
+type AddProjectFingerprintJSONRequestBody = FingerprintInput
+
+// ExportRulePackJSONRequestBody defines body for ExportRulePack for application/json ContentType.
+type ExportRulePackJSONRequestBody = RulePackExportRequest
+
+// ImportRulePackJSONRequestBody defines body for ImportRulePack for application/json ContentType.
+type ImportRulePackJSONRequestBody = RulePackImportRequest
+
 // CreateRuleJSONRequestBody defines body for CreateRule for application/json ContentType.
 type CreateRuleJSONRequestBody = RuleCreate
 
 // ApplyRulesJSONRequestBody defines body for ApplyRules for application/json ContentType.
 type ApplyRulesJSONRequestBody = ApplyRulesRequest
 
+// LintRuleJSONRequestBody defines body for LintRule for application/json ContentType.
+type LintRuleJSONRequestBody = RuleLintRequest
+
 // PreviewRuleJSONRequestBody defines body for PreviewRule for application/json ContentType.
 type PreviewRuleJSONRequestBody = RulePreviewRequest
 
+// TestRuleJSONRequestBody defines body for TestRule for application/json ContentType.
+type TestRuleJSONRequestBody = RuleTestRequest
+
 // UpdateRuleJSONRequestBody defines body for UpdateRule for application/json ContentType.
 type UpdateRuleJSONRequestBody = RuleUpdate
 
-// CreateTimeEntryJSONRequestBody defines body for CreateTimeEntry for application/json ContentType.
-type CreateTimeEntryJSONRequestBody = TimeEntryCreate
+// CreateClassificationSnapshotJSONRequestBody defines body for CreateClassificationSnapshot for application/json ContentType.
+type CreateClassificationSnapshotJSONRequestBody = ClassificationSnapshotCreate
 
-// UpdateTimeEntryJSONRequestBody defines body for UpdateTimeEntry for application/json ContentType.
-type UpdateTimeEntryJSONRequestBody = TimeEntryUpdate
+// CreateSuppressionRuleJSONRequestBody defines body for CreateSuppressionRule for application/json ContentType.
+type CreateSuppressionRuleJSONRequestBody = SuppressionRuleCreate
+
+// UpdateSuppressionRuleJSONRequestBody defines body for UpdateSuppressionRule for application/json ContentType.
+type UpdateSuppressionRuleJSONRequestBody = SuppressionRuleUpdate
+
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = TagCreate
+
+// RenameTagJSONRequestBody defines body for RenameTag for application/json ContentType.
+type RenameTagJSONRequestBody = TagCreate
+
+// CreateTimeEntryJSONRequestBody defines body for CreateTimeEntry for application/json ContentType.
+type CreateTimeEntryJSONRequestBody = TimeEntryCreate
+
+// ParseTimeEntryJSONRequestBody defines body for ParseTimeEntry for application/json ContentType.
+type ParseTimeEntryJSONRequestBody = TimeEntryParseRequest
+
+// RecalculateTimeEntriesJSONRequestBody defines body for RecalculateTimeEntries for application/json ContentType.
+type RecalculateTimeEntriesJSONRequestBody = RecalculateTimeEntriesRequest
+
+// UpdateTimeEntryJSONRequestBody defines body for UpdateTimeEntry for application/json ContentType.
+type UpdateTimeEntryJSONRequestBody = TimeEntryUpdate
+
+// AddTimeEntryNoteJSONRequestBody defines body for AddTimeEntryNote for application/json ContentType.
+type AddTimeEntryNoteJSONRequestBody = TimeEntryNoteCreate
+
+// ReconcileTimeEntryJSONRequestBody defines body for ReconcileTimeEntry for application/json ContentType.
+type ReconcileTimeEntryJSONRequestBody = ReconcileRequest
+
+// SplitTimeEntryJSONRequestBody defines body for SplitTimeEntry for application/json ContentType.
+type SplitTimeEntryJSONRequestBody = TimeEntrySplit
+
+// SetTimeEntryTagsJSONRequestBody defines body for SetTimeEntryTags for application/json ContentType.
+type SetTimeEntryTagsJSONRequestBody = TagAssignment
+
+// UpdateWorkingHoursJSONRequestBody defines body for UpdateWorkingHours for application/json ContentType.
+type UpdateWorkingHoursJSONRequestBody = WorkingHoursUpdate
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List recent classification actions
+	// (GET /api/actions)
+	ListClassificationActions(w http.ResponseWriter, r *http.Request, params ListClassificationActionsParams)
+	// Undo a classification action
+	// (POST /api/actions/{id}/undo)
+	UndoClassificationAction(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List flagged days
+	// (GET /api/anomalies)
+	ListAnomalies(w http.ResponseWriter, r *http.Request, params ListAnomaliesParams)
+	// Run the anomaly analysis now
+	// (POST /api/anomalies/analyze)
+	AnalyzeAnomalies(w http.ResponseWriter, r *http.Request)
+	// Dismiss a flagged day
+	// (POST /api/anomalies/{id}/dismiss)
+	DismissAnomaly(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List user's API keys
 	// (GET /api/api-keys)
 	ListApiKeys(w http.ResponseWriter, r *http.Request)
@@ -1010,12 +3698,36 @@ type ServerInterface interface {
 	// Revoke an API key
 	// (DELETE /api/api-keys/{id})
 	DeleteApiKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update an API key's settings
+	// (PATCH /api/api-keys/{id})
+	UpdateApiKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List attendee aliases
+	// (GET /api/attendee-aliases)
+	ListAttendeeAliases(w http.ResponseWriter, r *http.Request)
+	// Add an attendee alias
+	// (POST /api/attendee-aliases)
+	CreateAttendeeAlias(w http.ResponseWriter, r *http.Request)
+	// Delete an attendee alias
+	// (DELETE /api/attendee-aliases/{id})
+	DeleteAttendeeAlias(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get the user's company domains
+	// (GET /api/auth/company-domains)
+	GetCompanyDomains(w http.ResponseWriter, r *http.Request)
+	// Set the user's company domains
+	// (PUT /api/auth/company-domains)
+	UpdateCompanyDomains(w http.ResponseWriter, r *http.Request)
 	// Get Google OAuth authorization URL
 	// (GET /api/auth/google/authorize)
-	GoogleAuthorize(w http.ResponseWriter, r *http.Request)
+	GoogleAuthorize(w http.ResponseWriter, r *http.Request, params GoogleAuthorizeParams)
 	// Handle Google OAuth callback
 	// (GET /api/auth/google/callback)
 	GoogleCallback(w http.ResponseWriter, r *http.Request, params GoogleCallbackParams)
+	// Get the user's locale
+	// (GET /api/auth/locale)
+	GetLocale(w http.ResponseWriter, r *http.Request)
+	// Set the user's locale
+	// (PUT /api/auth/locale)
+	UpdateLocale(w http.ResponseWriter, r *http.Request)
 	// Authenticate with email and password
 	// (POST /api/auth/login)
 	Login(w http.ResponseWriter, r *http.Request)
@@ -1025,9 +3737,27 @@ type ServerInterface interface {
 	// Get current authenticated user
 	// (GET /api/auth/me)
 	GetCurrentUser(w http.ResponseWriter, r *http.Request)
+	// Get the user's data retention
+	// (GET /api/auth/retention-settings)
+	GetRetentionSettings(w http.ResponseWriter, r *http.Request)
+	// Set the user's data retention
+	// (PUT /api/auth/retention-settings)
+	UpdateRetentionSettings(w http.ResponseWriter, r *http.Request)
+	// Dry run of the retention purge
+	// (GET /api/auth/retention-settings/preview)
+	PreviewRetention(w http.ResponseWriter, r *http.Request, params PreviewRetentionParams)
 	// Create a new user account
 	// (POST /api/auth/signup)
 	Signup(w http.ResponseWriter, r *http.Request)
+	// Start single sign-on
+	// (POST /api/auth/sso/start)
+	StartSso(w http.ResponseWriter, r *http.Request)
+	// Get the user's sync cadence
+	// (GET /api/auth/sync-settings)
+	GetSyncSettings(w http.ResponseWriter, r *http.Request)
+	// Set the user's sync cadence
+	// (PUT /api/auth/sync-settings)
+	UpdateSyncSettings(w http.ResponseWriter, r *http.Request)
 	// List billing periods for a project
 	// (GET /api/billing-periods)
 	ListBillingPeriods(w http.ResponseWriter, r *http.Request, params ListBillingPeriodsParams)
@@ -1040,39 +3770,168 @@ type ServerInterface interface {
 	// Update a billing period
 	// (PUT /api/billing-periods/{id})
 	UpdateBillingPeriod(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Outstanding balances per client
+	// (GET /api/billing/balances)
+	ListClientBalances(w http.ResponseWriter, r *http.Request, params ListClientBalancesParams)
 	// List calendar events with filters
 	// (GET /api/calendar-events)
 	ListCalendarEvents(w http.ResponseWriter, r *http.Request, params ListCalendarEventsParams)
 	// Bulk classify events matching a query
 	// (POST /api/calendar-events/bulk-classify)
 	BulkClassifyEvents(w http.ResponseWriter, r *http.Request)
+	// Compare the events in a date range between two points in time
+	// (GET /api/calendar-events/diff)
+	DiffCalendarEvents(w http.ResponseWriter, r *http.Request, params DiffCalendarEventsParams)
+	// List events flagged for review
+	// (GET /api/calendar-events/review-queue)
+	GetReviewQueue(w http.ResponseWriter, r *http.Request, params GetReviewQueueParams)
+	// List an event's change history
+	// (GET /api/calendar-events/{id}/changes)
+	ListEventChanges(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Classify a calendar event (assign to project or skip)
 	// (PUT /api/calendar-events/{id}/classify)
 	ClassifyCalendarEvent(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Explain how an event was (or would be) classified
 	// (GET /api/calendar-events/{id}/explain)
 	ExplainEventClassification(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List an event's classification history
+	// (GET /api/calendar-events/{id}/history)
+	ListEventClassificationHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Accept or override an event's suggested classification
+	// (POST /api/calendar-events/{id}/review)
+	ReviewCalendarEvent(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Suppress or unsuppress an event by hand
+	// (PUT /api/calendar-events/{id}/suppression)
+	SetEventSuppression(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Replace the tags on an event
+	// (PUT /api/calendar-events/{id}/tags)
+	SetEventTags(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List user's calendar connections
 	// (GET /api/calendars)
 	ListCalendarConnections(w http.ResponseWriter, r *http.Request)
+	// List connections that need the user to consent again
+	// (GET /api/calendars/needs-reauth)
+	ListConnectionsNeedingReauth(w http.ResponseWriter, r *http.Request)
 	// Disconnect a calendar
 	// (DELETE /api/calendars/{id})
 	DeleteCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Pause syncing a connection
+	// (POST /api/calendars/{id}/pause)
+	PauseCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Start re-consent for a connection
+	// (POST /api/calendars/{id}/reauthorize)
+	ReauthorizeCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Resume syncing a paused connection
+	// (POST /api/calendars/{id}/resume)
+	ResumeCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List available calendars for a connection
 	// (GET /api/calendars/{id}/sources)
 	ListCalendarSources(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Update which calendars are selected for sync
 	// (PUT /api/calendars/{id}/sources)
 	UpdateCalendarSources(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Set a calendar's display overrides and project mapping
+	// (PUT /api/calendars/{id}/sources/{calendar_id})
+	UpdateCalendarSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, calendarId openapi_types.UUID)
 	// Trigger sync for a calendar connection
 	// (POST /api/calendars/{id}/sync)
 	SyncCalendar(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params SyncCalendarParams)
+	// Configure how far a connection syncs
+	// (PUT /api/calendars/{id}/sync-window)
+	UpdateCalendarSyncWindow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Configure project write-back to Google events
+	// (PUT /api/calendars/{id}/write-back)
+	UpdateCalendarWriteBack(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List changes since a cursor
+	// (GET /api/changes)
+	ListChanges(w http.ResponseWriter, r *http.Request, params ListChangesParams)
+	// Apply classification rules in the background
+	// (POST /api/classification/apply-rules:async)
+	ApplyRulesAsync(w http.ResponseWriter, r *http.Request)
+	// Get a background classification job
+	// (GET /api/classification/jobs/{id})
+	GetClassificationJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List client rates
+	// (GET /api/client-rates)
+	ListClientRates(w http.ResponseWriter, r *http.Request, params ListClientRatesParams)
+	// Create a new client rate
+	// (POST /api/client-rates)
+	CreateClientRate(w http.ResponseWriter, r *http.Request)
+	// Delete a client rate
+	// (DELETE /api/client-rates/{id})
+	DeleteClientRate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update a client rate
+	// (PUT /api/client-rates/{id})
+	UpdateClientRate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Export projects and rules as JSON
 	// (GET /api/config/export)
 	ExportConfig(w http.ResponseWriter, r *http.Request, params ExportConfigParams)
 	// Import projects and rules from JSON
 	// (POST /api/config/import)
 	ImportConfig(w http.ResponseWriter, r *http.Request)
+	// Get everything the daily view needs
+	// (GET /api/day/{date})
+	GetDaySummary(w http.ResponseWriter, r *http.Request, date openapi_types.Date)
+	// List expenses
+	// (GET /api/expenses)
+	ListExpenses(w http.ResponseWriter, r *http.Request, params ListExpensesParams)
+	// Record an expense
+	// (POST /api/expenses)
+	CreateExpense(w http.ResponseWriter, r *http.Request)
+	// Delete an expense
+	// (DELETE /api/expenses/{id})
+	DeleteExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get an expense
+	// (GET /api/expenses/{id})
+	GetExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update an expense
+	// (PUT /api/expenses/{id})
+	UpdateExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the projects claiming a domain
+	// (GET /api/fingerprints/claims)
+	ListFingerprintClaims(w http.ResponseWriter, r *http.Request, params ListFingerprintClaimsParams)
+	// Log a focus session from an external timer
+	// (POST /api/focus-sessions)
+	LogFocusSession(w http.ResponseWriter, r *http.Request)
+	// Record the focus time between meetings as focus sessions
+	// (POST /api/focus-sessions/from-gaps)
+	RecordFocusGaps(w http.ResponseWriter, r *http.Request)
+	// List hour goals
+	// (GET /api/goals)
+	ListGoals(w http.ResponseWriter, r *http.Request)
+	// Create an hour goal
+	// (POST /api/goals)
+	CreateGoal(w http.ResponseWriter, r *http.Request)
+	// Progress toward hour goals
+	// (GET /api/goals/progress)
+	GetGoalsProgress(w http.ResponseWriter, r *http.Request, params GetGoalsProgressParams)
+	// Delete an hour goal
+	// (DELETE /api/goals/{id})
+	DeleteGoal(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Update an hour goal
+	// (PUT /api/goals/{id})
+	UpdateGoal(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List connected integrations
+	// (GET /api/integrations)
+	ListIntegrations(w http.ResponseWriter, r *http.Request)
+	// Disconnect GitHub
+	// (DELETE /api/integrations/github)
+	DisconnectGitHub(w http.ResponseWriter, r *http.Request)
+	// Get the GitHub connection
+	// (GET /api/integrations/github)
+	GetGitHubConnection(w http.ResponseWriter, r *http.Request)
+	// Connect a GitHub account
+	// (PUT /api/integrations/github)
+	ConnectGitHub(w http.ResponseWriter, r *http.Request)
+	// Disconnect an integration
+	// (DELETE /api/integrations/{provider})
+	DisconnectIntegration(w http.ResponseWriter, r *http.Request, provider string)
+	// Connect an integration
+	// (PUT /api/integrations/{provider})
+	ConnectIntegration(w http.ResponseWriter, r *http.Request, provider string)
+	// Download an exported file
+	// (GET /api/invoice-exports/{id}/download)
+	DownloadInvoiceExport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List invoices
 	// (GET /api/invoices)
 	ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams)
@@ -1085,15 +3944,87 @@ type ServerInterface interface {
 	// Get invoice details
 	// (GET /api/invoices/{id})
 	GetInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add an adjustment line item
+	// (POST /api/invoices/{id}/adjustments)
+	AddInvoiceAdjustment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Remove an adjustment line item
+	// (DELETE /api/invoices/{id}/adjustments/{lineItemId})
+	DeleteInvoiceAdjustment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, lineItemId openapi_types.UUID)
+	// List review comments on an invoice
+	// (GET /api/invoices/{id}/comments)
+	ListInvoiceComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Comment on an invoice
+	// (POST /api/invoices/{id}/comments)
+	AddInvoiceComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Remove a comment from an invoice
+	// (DELETE /api/invoices/{id}/comments/{commentId})
+	DeleteInvoiceComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID)
+	// Issue a credit note against an invoice
+	// (POST /api/invoices/{id}/credit-notes)
+	CreateCreditNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Export an invoice
+	// (POST /api/invoices/{id}/export)
+	ExportInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExportInvoiceParams)
 	// Export invoice as CSV
 	// (GET /api/invoices/{id}/export/csv)
 	ExportInvoiceCSV(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Export invoice to Google Sheets
 	// (POST /api/invoices/{id}/export/sheets)
 	ExportInvoiceSheets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List recorded exports of an invoice
+	// (GET /api/invoices/{id}/exports)
+	ListInvoiceExports(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List payments recorded against an invoice
+	// (GET /api/invoices/{id}/payments)
+	ListInvoicePayments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Record a payment against an invoice
+	// (POST /api/invoices/{id}/payments)
+	RecordInvoicePayment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Change invoice status
 	// (PUT /api/invoices/{id}/status)
 	UpdateInvoiceStatus(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List the user's organizations
+	// (GET /api/organizations)
+	ListOrganizations(w http.ResponseWriter, r *http.Request)
+	// Create an organization
+	// (POST /api/organizations)
+	CreateOrganization(w http.ResponseWriter, r *http.Request)
+	// Get an organization
+	// (GET /api/organizations/{id})
+	GetOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Release an email domain
+	// (DELETE /api/organizations/{id}/domains/{domain})
+	DeleteOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string)
+	// Claim an email domain
+	// (PUT /api/organizations/{id}/domains/{domain})
+	PutOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string)
+	// Verify an email domain
+	// (POST /api/organizations/{id}/domains/{domain}/verify)
+	VerifyOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string)
+	// Configure Google Workspace delegation
+	// (PUT /api/organizations/{id}/google-delegation)
+	UpdateOrganizationGoogleDelegation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Provision calendar sync for members
+	// (POST /api/organizations/{id}/google-delegation/provision)
+	ProvisionOrganizationCalendars(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List an organization's members
+	// (GET /api/organizations/{id}/members)
+	ListOrganizationMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Set a member's delegated calendar selection
+	// (PUT /api/organizations/{id}/members/{user_id}/delegation)
+	UpdateOrganizationMemberDelegation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId openapi_types.UUID)
+	// Transfer a project between members
+	// (POST /api/organizations/{id}/project-transfers)
+	TransferOrganizationProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Rotate the SCIM token
+	// (POST /api/organizations/{id}/scim-token)
+	RotateOrganizationScimToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Configure single sign-on
+	// (PUT /api/organizations/{id}/sso)
+	UpdateOrganizationSso(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Delete a payment
+	// (DELETE /api/payments/{id})
+	DeletePayment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List all projects
 	// (GET /api/projects)
 	ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams)
@@ -1109,6 +4040,27 @@ type ServerInterface interface {
 	// Update a project
 	// (PUT /api/projects/{id})
 	UpdateProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add a fingerprint to a project
+	// (POST /api/projects/{id}/fingerprints)
+	AddProjectFingerprint(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Remove a fingerprint from a project
+	// (DELETE /api/projects/{id}/fingerprints/{kind}/{value})
+	RemoveProjectFingerprint(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, kind FingerprintKind, value string)
+	// Estimated cost of recurring meetings
+	// (GET /api/reports/meeting-cost)
+	GetMeetingCostReport(w http.ResponseWriter, r *http.Request, params GetMeetingCostReportParams)
+	// Meeting load and focus time per day and week
+	// (GET /api/reports/meeting-load)
+	GetMeetingLoadReport(w http.ResponseWriter, r *http.Request, params GetMeetingLoadReportParams)
+	// Utilization and capacity report
+	// (GET /api/reports/utilization)
+	GetUtilizationReport(w http.ResponseWriter, r *http.Request, params GetUtilizationReportParams)
+	// Export rules and fingerprints as a named pack
+	// (POST /api/rule-packs/export)
+	ExportRulePack(w http.ResponseWriter, r *http.Request)
+	// Import a rule pack
+	// (POST /api/rule-packs/import)
+	ImportRulePack(w http.ResponseWriter, r *http.Request)
 	// List all classification rules
 	// (GET /api/rules)
 	ListRules(w http.ResponseWriter, r *http.Request, params ListRulesParams)
@@ -1118,9 +4070,18 @@ type ServerInterface interface {
 	// Apply classification rules to pending events
 	// (POST /api/rules/apply)
 	ApplyRules(w http.ResponseWriter, r *http.Request)
+	// Find rules that compete for the same events
+	// (GET /api/rules/conflicts)
+	GetRuleConflicts(w http.ResponseWriter, r *http.Request, params GetRuleConflictsParams)
+	// Check a query for common problems
+	// (POST /api/rules/lint)
+	LintRule(w http.ResponseWriter, r *http.Request)
 	// Preview what events a rule would match
 	// (POST /api/rules/preview)
 	PreviewRule(w http.ResponseWriter, r *http.Request)
+	// Test a query against synthetic events
+	// (POST /api/rules/test)
+	TestRule(w http.ResponseWriter, r *http.Request)
 	// Delete a rule
 	// (DELETE /api/rules/{id})
 	DeleteRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
@@ -1130,12 +4091,63 @@ type ServerInterface interface {
 	// Update a rule
 	// (PUT /api/rules/{id})
 	UpdateRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List classification snapshots
+	// (GET /api/snapshots)
+	ListClassificationSnapshots(w http.ResponseWriter, r *http.Request, params ListClassificationSnapshotsParams)
+	// Snapshot a week's classification state
+	// (POST /api/snapshots)
+	CreateClassificationSnapshot(w http.ResponseWriter, r *http.Request)
+	// Delete a classification snapshot
+	// (DELETE /api/snapshots/{id})
+	DeleteClassificationSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Restore a classification snapshot
+	// (POST /api/snapshots/{id}/restore)
+	RestoreClassificationSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List suppression rules
+	// (GET /api/suppression-rules)
+	ListSuppressionRules(w http.ResponseWriter, r *http.Request)
+	// Create a suppression rule
+	// (POST /api/suppression-rules)
+	CreateSuppressionRule(w http.ResponseWriter, r *http.Request)
+	// Delete a suppression rule
+	// (DELETE /api/suppression-rules/{id})
+	DeleteSuppressionRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Enable or disable a suppression rule
+	// (PUT /api/suppression-rules/{id})
+	UpdateSuppressionRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List tags
+	// (GET /api/tags)
+	ListTags(w http.ResponseWriter, r *http.Request)
+	// Create a tag
+	// (POST /api/tags)
+	CreateTag(w http.ResponseWriter, r *http.Request)
+	// Delete a tag
+	// (DELETE /api/tags/{id})
+	DeleteTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Rename a tag
+	// (PATCH /api/tags/{id})
+	RenameTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List time entries
 	// (GET /api/time-entries)
 	ListTimeEntries(w http.ResponseWriter, r *http.Request, params ListTimeEntriesParams)
 	// Create a new time entry
 	// (POST /api/time-entries)
 	CreateTimeEntry(w http.ResponseWriter, r *http.Request)
+	// Suggest descriptions from GitHub activity
+	// (GET /api/time-entries/description-suggestions)
+	SuggestDescriptions(w http.ResponseWriter, r *http.Request, params SuggestDescriptionsParams)
+	// Parse a time entry from text
+	// (POST /api/time-entries/parse)
+	ParseTimeEntry(w http.ResponseWriter, r *http.Request, params ParseTimeEntryParams)
+	// Recompute time entries in the background
+	// (POST /api/time-entries/recalculate)
+	RecalculateTimeEntries(w http.ResponseWriter, r *http.Request)
+	// Get a background recalculation job
+	// (GET /api/time-entries/recalculate/jobs/{id})
+	GetRecalculationJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List entries whose hours diverge from computed hours
+	// (GET /api/time-entries/reconciliation)
+	GetReconciliationReport(w http.ResponseWriter, r *http.Request, params GetReconciliationReportParams)
 	// Delete a time entry
 	// (DELETE /api/time-entries/{id})
 	DeleteTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
@@ -1145,15 +4157,87 @@ type ServerInterface interface {
 	// Update a time entry
 	// (PUT /api/time-entries/{id})
 	UpdateTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Show how a time entry's computed hours were derived
+	// (GET /api/time-entries/{id}/breakdown)
+	GetTimeEntryBreakdown(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Explain why a time entry has its hours
+	// (GET /api/time-entries/{id}/explain)
+	ExplainTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List notes on a time entry
+	// (GET /api/time-entries/{id}/notes)
+	ListTimeEntryNotes(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add a note to a time entry
+	// (POST /api/time-entries/{id}/notes)
+	AddTimeEntryNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Remove a note from a time entry
+	// (DELETE /api/time-entries/{id}/notes/{noteId})
+	DeleteTimeEntryNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, noteId openapi_types.UUID)
+	// Resolve a divergent time entry
+	// (POST /api/time-entries/{id}/reconcile)
+	ReconcileTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// Reset time entry to computed values from events
 	// (POST /api/time-entries/{id}/refresh)
 	RefreshTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Split a time entry across projects
+	// (POST /api/time-entries/{id}/split)
+	SplitTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Replace the tags on a time entry
+	// (PUT /api/time-entries/{id}/tags)
+	SetTimeEntryTags(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List deleted time entries and rules
+	// (GET /api/trash)
+	ListTrash(w http.ResponseWriter, r *http.Request)
+	// Restore a deleted rule
+	// (POST /api/trash/rules/{id}/restore)
+	RestoreRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Restore a deleted time entry
+	// (POST /api/trash/time-entries/{id}/restore)
+	RestoreTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Get a project by day hours grid for a week
+	// (GET /api/weeks/{start})
+	GetWeekGrid(w http.ResponseWriter, r *http.Request, start openapi_types.Date)
+	// Get the working-hours profile
+	// (GET /api/working-hours)
+	GetWorkingHours(w http.ResponseWriter, r *http.Request)
+	// Set the working-hours profile
+	// (PUT /api/working-hours)
+	UpdateWorkingHours(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List recent classification actions
+// (GET /api/actions)
+func (_ Unimplemented) ListClassificationActions(w http.ResponseWriter, r *http.Request, params ListClassificationActionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Undo a classification action
+// (POST /api/actions/{id}/undo)
+func (_ Unimplemented) UndoClassificationAction(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List flagged days
+// (GET /api/anomalies)
+func (_ Unimplemented) ListAnomalies(w http.ResponseWriter, r *http.Request, params ListAnomaliesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run the anomaly analysis now
+// (POST /api/anomalies/analyze)
+func (_ Unimplemented) AnalyzeAnomalies(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dismiss a flagged day
+// (POST /api/anomalies/{id}/dismiss)
+func (_ Unimplemented) DismissAnomaly(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List user's API keys
 // (GET /api/api-keys)
 func (_ Unimplemented) ListApiKeys(w http.ResponseWriter, r *http.Request) {
@@ -1172,9 +4256,45 @@ func (_ Unimplemented) DeleteApiKey(w http.ResponseWriter, r *http.Request, id o
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update an API key's settings
+// (PATCH /api/api-keys/{id})
+func (_ Unimplemented) UpdateApiKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List attendee aliases
+// (GET /api/attendee-aliases)
+func (_ Unimplemented) ListAttendeeAliases(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add an attendee alias
+// (POST /api/attendee-aliases)
+func (_ Unimplemented) CreateAttendeeAlias(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an attendee alias
+// (DELETE /api/attendee-aliases/{id})
+func (_ Unimplemented) DeleteAttendeeAlias(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the user's company domains
+// (GET /api/auth/company-domains)
+func (_ Unimplemented) GetCompanyDomains(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the user's company domains
+// (PUT /api/auth/company-domains)
+func (_ Unimplemented) UpdateCompanyDomains(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Google OAuth authorization URL
 // (GET /api/auth/google/authorize)
-func (_ Unimplemented) GoogleAuthorize(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GoogleAuthorize(w http.ResponseWriter, r *http.Request, params GoogleAuthorizeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1184,6 +4304,18 @@ func (_ Unimplemented) GoogleCallback(w http.ResponseWriter, r *http.Request, pa
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the user's locale
+// (GET /api/auth/locale)
+func (_ Unimplemented) GetLocale(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the user's locale
+// (PUT /api/auth/locale)
+func (_ Unimplemented) UpdateLocale(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Authenticate with email and password
 // (POST /api/auth/login)
 func (_ Unimplemented) Login(w http.ResponseWriter, r *http.Request) {
@@ -1202,12 +4334,48 @@ func (_ Unimplemented) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the user's data retention
+// (GET /api/auth/retention-settings)
+func (_ Unimplemented) GetRetentionSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the user's data retention
+// (PUT /api/auth/retention-settings)
+func (_ Unimplemented) UpdateRetentionSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Dry run of the retention purge
+// (GET /api/auth/retention-settings/preview)
+func (_ Unimplemented) PreviewRetention(w http.ResponseWriter, r *http.Request, params PreviewRetentionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create a new user account
 // (POST /api/auth/signup)
 func (_ Unimplemented) Signup(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Start single sign-on
+// (POST /api/auth/sso/start)
+func (_ Unimplemented) StartSso(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the user's sync cadence
+// (GET /api/auth/sync-settings)
+func (_ Unimplemented) GetSyncSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the user's sync cadence
+// (PUT /api/auth/sync-settings)
+func (_ Unimplemented) UpdateSyncSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List billing periods for a project
 // (GET /api/billing-periods)
 func (_ Unimplemented) ListBillingPeriods(w http.ResponseWriter, r *http.Request, params ListBillingPeriodsParams) {
@@ -1232,6 +4400,12 @@ func (_ Unimplemented) UpdateBillingPeriod(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Outstanding balances per client
+// (GET /api/billing/balances)
+func (_ Unimplemented) ListClientBalances(w http.ResponseWriter, r *http.Request, params ListClientBalancesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List calendar events with filters
 // (GET /api/calendar-events)
 func (_ Unimplemented) ListCalendarEvents(w http.ResponseWriter, r *http.Request, params ListCalendarEventsParams) {
@@ -1244,6 +4418,24 @@ func (_ Unimplemented) BulkClassifyEvents(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Compare the events in a date range between two points in time
+// (GET /api/calendar-events/diff)
+func (_ Unimplemented) DiffCalendarEvents(w http.ResponseWriter, r *http.Request, params DiffCalendarEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List events flagged for review
+// (GET /api/calendar-events/review-queue)
+func (_ Unimplemented) GetReviewQueue(w http.ResponseWriter, r *http.Request, params GetReviewQueueParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List an event's change history
+// (GET /api/calendar-events/{id}/changes)
+func (_ Unimplemented) ListEventChanges(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Classify a calendar event (assign to project or skip)
 // (PUT /api/calendar-events/{id}/classify)
 func (_ Unimplemented) ClassifyCalendarEvent(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -1256,18 +4448,66 @@ func (_ Unimplemented) ExplainEventClassification(w http.ResponseWriter, r *http
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List an event's classification history
+// (GET /api/calendar-events/{id}/history)
+func (_ Unimplemented) ListEventClassificationHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Accept or override an event's suggested classification
+// (POST /api/calendar-events/{id}/review)
+func (_ Unimplemented) ReviewCalendarEvent(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Suppress or unsuppress an event by hand
+// (PUT /api/calendar-events/{id}/suppression)
+func (_ Unimplemented) SetEventSuppression(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the tags on an event
+// (PUT /api/calendar-events/{id}/tags)
+func (_ Unimplemented) SetEventTags(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List user's calendar connections
 // (GET /api/calendars)
 func (_ Unimplemented) ListCalendarConnections(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List connections that need the user to consent again
+// (GET /api/calendars/needs-reauth)
+func (_ Unimplemented) ListConnectionsNeedingReauth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Disconnect a calendar
 // (DELETE /api/calendars/{id})
 func (_ Unimplemented) DeleteCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Pause syncing a connection
+// (POST /api/calendars/{id}/pause)
+func (_ Unimplemented) PauseCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start re-consent for a connection
+// (POST /api/calendars/{id}/reauthorize)
+func (_ Unimplemented) ReauthorizeCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume syncing a paused connection
+// (POST /api/calendars/{id}/resume)
+func (_ Unimplemented) ResumeCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List available calendars for a connection
 // (GET /api/calendars/{id}/sources)
 func (_ Unimplemented) ListCalendarSources(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -1280,470 +4520,763 @@ func (_ Unimplemented) UpdateCalendarSources(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Set a calendar's display overrides and project mapping
+// (PUT /api/calendars/{id}/sources/{calendar_id})
+func (_ Unimplemented) UpdateCalendarSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, calendarId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Trigger sync for a calendar connection
 // (POST /api/calendars/{id}/sync)
 func (_ Unimplemented) SyncCalendar(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params SyncCalendarParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Export projects and rules as JSON
-// (GET /api/config/export)
-func (_ Unimplemented) ExportConfig(w http.ResponseWriter, r *http.Request, params ExportConfigParams) {
+// Configure how far a connection syncs
+// (PUT /api/calendars/{id}/sync-window)
+func (_ Unimplemented) UpdateCalendarSyncWindow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Import projects and rules from JSON
-// (POST /api/config/import)
-func (_ Unimplemented) ImportConfig(w http.ResponseWriter, r *http.Request) {
+// Configure project write-back to Google events
+// (PUT /api/calendars/{id}/write-back)
+func (_ Unimplemented) UpdateCalendarWriteBack(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List invoices
-// (GET /api/invoices)
-func (_ Unimplemented) ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams) {
+// List changes since a cursor
+// (GET /api/changes)
+func (_ Unimplemented) ListChanges(w http.ResponseWriter, r *http.Request, params ListChangesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Generate a new invoice
-// (POST /api/invoices)
-func (_ Unimplemented) CreateInvoice(w http.ResponseWriter, r *http.Request) {
+// Apply classification rules in the background
+// (POST /api/classification/apply-rules:async)
+func (_ Unimplemented) ApplyRulesAsync(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a draft invoice
-// (DELETE /api/invoices/{id})
-func (_ Unimplemented) DeleteInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Get a background classification job
+// (GET /api/classification/jobs/{id})
+func (_ Unimplemented) GetClassificationJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get invoice details
-// (GET /api/invoices/{id})
-func (_ Unimplemented) GetInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// List client rates
+// (GET /api/client-rates)
+func (_ Unimplemented) ListClientRates(w http.ResponseWriter, r *http.Request, params ListClientRatesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Export invoice as CSV
-// (GET /api/invoices/{id}/export/csv)
-func (_ Unimplemented) ExportInvoiceCSV(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Create a new client rate
+// (POST /api/client-rates)
+func (_ Unimplemented) CreateClientRate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Export invoice to Google Sheets
-// (POST /api/invoices/{id}/export/sheets)
-func (_ Unimplemented) ExportInvoiceSheets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Delete a client rate
+// (DELETE /api/client-rates/{id})
+func (_ Unimplemented) DeleteClientRate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Change invoice status
-// (PUT /api/invoices/{id}/status)
-func (_ Unimplemented) UpdateInvoiceStatus(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Update a client rate
+// (PUT /api/client-rates/{id})
+func (_ Unimplemented) UpdateClientRate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all projects
-// (GET /api/projects)
-func (_ Unimplemented) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
+// Export projects and rules as JSON
+// (GET /api/config/export)
+func (_ Unimplemented) ExportConfig(w http.ResponseWriter, r *http.Request, params ExportConfigParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new project
-// (POST /api/projects)
-func (_ Unimplemented) CreateProject(w http.ResponseWriter, r *http.Request) {
+// Import projects and rules from JSON
+// (POST /api/config/import)
+func (_ Unimplemented) ImportConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a project
-// (DELETE /api/projects/{id})
-func (_ Unimplemented) DeleteProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Get everything the daily view needs
+// (GET /api/day/{date})
+func (_ Unimplemented) GetDaySummary(w http.ResponseWriter, r *http.Request, date openapi_types.Date) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get a project by ID
-// (GET /api/projects/{id})
-func (_ Unimplemented) GetProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// List expenses
+// (GET /api/expenses)
+func (_ Unimplemented) ListExpenses(w http.ResponseWriter, r *http.Request, params ListExpensesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a project
-// (PUT /api/projects/{id})
-func (_ Unimplemented) UpdateProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Record an expense
+// (POST /api/expenses)
+func (_ Unimplemented) CreateExpense(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List all classification rules
-// (GET /api/rules)
-func (_ Unimplemented) ListRules(w http.ResponseWriter, r *http.Request, params ListRulesParams) {
+// Delete an expense
+// (DELETE /api/expenses/{id})
+func (_ Unimplemented) DeleteExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new classification rule
-// (POST /api/rules)
-func (_ Unimplemented) CreateRule(w http.ResponseWriter, r *http.Request) {
+// Get an expense
+// (GET /api/expenses/{id})
+func (_ Unimplemented) GetExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Apply classification rules to pending events
-// (POST /api/rules/apply)
-func (_ Unimplemented) ApplyRules(w http.ResponseWriter, r *http.Request) {
+// Update an expense
+// (PUT /api/expenses/{id})
+func (_ Unimplemented) UpdateExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Preview what events a rule would match
-// (POST /api/rules/preview)
-func (_ Unimplemented) PreviewRule(w http.ResponseWriter, r *http.Request) {
+// List the projects claiming a domain
+// (GET /api/fingerprints/claims)
+func (_ Unimplemented) ListFingerprintClaims(w http.ResponseWriter, r *http.Request, params ListFingerprintClaimsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a rule
-// (DELETE /api/rules/{id})
-func (_ Unimplemented) DeleteRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Log a focus session from an external timer
+// (POST /api/focus-sessions)
+func (_ Unimplemented) LogFocusSession(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get a rule by ID
-// (GET /api/rules/{id})
-func (_ Unimplemented) GetRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Record the focus time between meetings as focus sessions
+// (POST /api/focus-sessions/from-gaps)
+func (_ Unimplemented) RecordFocusGaps(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a rule
-// (PUT /api/rules/{id})
-func (_ Unimplemented) UpdateRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// List hour goals
+// (GET /api/goals)
+func (_ Unimplemented) ListGoals(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List time entries
-// (GET /api/time-entries)
-func (_ Unimplemented) ListTimeEntries(w http.ResponseWriter, r *http.Request, params ListTimeEntriesParams) {
+// Create an hour goal
+// (POST /api/goals)
+func (_ Unimplemented) CreateGoal(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new time entry
-// (POST /api/time-entries)
-func (_ Unimplemented) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+// Progress toward hour goals
+// (GET /api/goals/progress)
+func (_ Unimplemented) GetGoalsProgress(w http.ResponseWriter, r *http.Request, params GetGoalsProgressParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a time entry
-// (DELETE /api/time-entries/{id})
-func (_ Unimplemented) DeleteTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Delete an hour goal
+// (DELETE /api/goals/{id})
+func (_ Unimplemented) DeleteGoal(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get a time entry by ID
-// (GET /api/time-entries/{id})
-func (_ Unimplemented) GetTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Update an hour goal
+// (PUT /api/goals/{id})
+func (_ Unimplemented) UpdateGoal(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a time entry
-// (PUT /api/time-entries/{id})
-func (_ Unimplemented) UpdateTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// List connected integrations
+// (GET /api/integrations)
+func (_ Unimplemented) ListIntegrations(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Reset time entry to computed values from events
-// (POST /api/time-entries/{id}/refresh)
-func (_ Unimplemented) RefreshTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+// Disconnect GitHub
+// (DELETE /api/integrations/github)
+func (_ Unimplemented) DisconnectGitHub(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Get the GitHub connection
+// (GET /api/integrations/github)
+func (_ Unimplemented) GetGitHubConnection(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Connect a GitHub account
+// (PUT /api/integrations/github)
+func (_ Unimplemented) ConnectGitHub(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// ListApiKeys operation middleware
-func (siw *ServerInterfaceWrapper) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+// Disconnect an integration
+// (DELETE /api/integrations/{provider})
+func (_ Unimplemented) DisconnectIntegration(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Connect an integration
+// (PUT /api/integrations/{provider})
+func (_ Unimplemented) ConnectIntegration(w http.ResponseWriter, r *http.Request, provider string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// Download an exported file
+// (GET /api/invoice-exports/{id}/download)
+func (_ Unimplemented) DownloadInvoiceExport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// List invoices
+// (GET /api/invoices)
+func (_ Unimplemented) ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListApiKeys(w, r)
-	}))
+// Generate a new invoice
+// (POST /api/invoices)
+func (_ Unimplemented) CreateInvoice(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Delete a draft invoice
+// (DELETE /api/invoices/{id})
+func (_ Unimplemented) DeleteInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Get invoice details
+// (GET /api/invoices/{id})
+func (_ Unimplemented) GetInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CreateApiKey operation middleware
-func (siw *ServerInterfaceWrapper) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+// Add an adjustment line item
+// (POST /api/invoices/{id}/adjustments)
+func (_ Unimplemented) AddInvoiceAdjustment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Remove an adjustment line item
+// (DELETE /api/invoices/{id}/adjustments/{lineItemId})
+func (_ Unimplemented) DeleteInvoiceAdjustment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, lineItemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// List review comments on an invoice
+// (GET /api/invoices/{id}/comments)
+func (_ Unimplemented) ListInvoiceComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateApiKey(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Comment on an invoice
+// (POST /api/invoices/{id}/comments)
+func (_ Unimplemented) AddInvoiceComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Remove a comment from an invoice
+// (DELETE /api/invoices/{id}/comments/{commentId})
+func (_ Unimplemented) DeleteInvoiceComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// DeleteApiKey operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiKey(w http.ResponseWriter, r *http.Request) {
+// Issue a credit note against an invoice
+// (POST /api/invoices/{id}/credit-notes)
+func (_ Unimplemented) CreateCreditNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Export an invoice
+// (POST /api/invoices/{id}/export)
+func (_ Unimplemented) ExportInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExportInvoiceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+// Export invoice as CSV
+// (GET /api/invoices/{id}/export/csv)
+func (_ Unimplemented) ExportInvoiceCSV(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// Export invoice to Google Sheets
+// (POST /api/invoices/{id}/export/sheets)
+func (_ Unimplemented) ExportInvoiceSheets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// List recorded exports of an invoice
+// (GET /api/invoices/{id}/exports)
+func (_ Unimplemented) ListInvoiceExports(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// List payments recorded against an invoice
+// (GET /api/invoices/{id}/payments)
+func (_ Unimplemented) ListInvoicePayments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// Record a payment against an invoice
+// (POST /api/invoices/{id}/payments)
+func (_ Unimplemented) RecordInvoicePayment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteApiKey(w, r, id)
-	}))
+// Change invoice status
+// (PUT /api/invoices/{id}/status)
+func (_ Unimplemented) UpdateInvoiceStatus(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// List the user's organizations
+// (GET /api/organizations)
+func (_ Unimplemented) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Create an organization
+// (POST /api/organizations)
+func (_ Unimplemented) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GoogleAuthorize operation middleware
-func (siw *ServerInterfaceWrapper) GoogleAuthorize(w http.ResponseWriter, r *http.Request) {
+// Get an organization
+// (GET /api/organizations/{id})
+func (_ Unimplemented) GetOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Release an email domain
+// (DELETE /api/organizations/{id}/domains/{domain})
+func (_ Unimplemented) DeleteOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// Claim an email domain
+// (PUT /api/organizations/{id}/domains/{domain})
+func (_ Unimplemented) PutOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// Verify an email domain
+// (POST /api/organizations/{id}/domains/{domain}/verify)
+func (_ Unimplemented) VerifyOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GoogleAuthorize(w, r)
-	}))
+// Configure Google Workspace delegation
+// (PUT /api/organizations/{id}/google-delegation)
+func (_ Unimplemented) UpdateOrganizationGoogleDelegation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Provision calendar sync for members
+// (POST /api/organizations/{id}/google-delegation/provision)
+func (_ Unimplemented) ProvisionOrganizationCalendars(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List an organization's members
+// (GET /api/organizations/{id}/members)
+func (_ Unimplemented) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GoogleCallback operation middleware
-func (siw *ServerInterfaceWrapper) GoogleCallback(w http.ResponseWriter, r *http.Request) {
+// Set a member's delegated calendar selection
+// (PUT /api/organizations/{id}/members/{user_id}/delegation)
+func (_ Unimplemented) UpdateOrganizationMemberDelegation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Transfer a project between members
+// (POST /api/organizations/{id}/project-transfers)
+func (_ Unimplemented) TransferOrganizationProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Rotate the SCIM token
+// (POST /api/organizations/{id}/scim-token)
+func (_ Unimplemented) RotateOrganizationScimToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// Configure single sign-on
+// (PUT /api/organizations/{id}/sso)
+func (_ Unimplemented) UpdateOrganizationSso(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// Delete a payment
+// (DELETE /api/payments/{id})
+func (_ Unimplemented) DeletePayment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GoogleCallbackParams
+// List all projects
+// (GET /api/projects)
+func (_ Unimplemented) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Required query parameter "code" -------------
+// Create a new project
+// (POST /api/projects)
+func (_ Unimplemented) CreateProject(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+// Delete a project
+// (DELETE /api/projects/{id})
+func (_ Unimplemented) DeleteProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
-		return
-	}
+// Get a project by ID
+// (GET /api/projects/{id})
+func (_ Unimplemented) GetProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
-		return
-	}
+// Update a project
+// (PUT /api/projects/{id})
+func (_ Unimplemented) UpdateProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Required query parameter "state" -------------
+// Add a fingerprint to a project
+// (POST /api/projects/{id}/fingerprints)
+func (_ Unimplemented) AddProjectFingerprint(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+// Remove a fingerprint from a project
+// (DELETE /api/projects/{id}/fingerprints/{kind}/{value})
+func (_ Unimplemented) RemoveProjectFingerprint(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, kind FingerprintKind, value string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
-		return
-	}
+// Estimated cost of recurring meetings
+// (GET /api/reports/meeting-cost)
+func (_ Unimplemented) GetMeetingCostReport(w http.ResponseWriter, r *http.Request, params GetMeetingCostReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
-		return
-	}
+// Meeting load and focus time per day and week
+// (GET /api/reports/meeting-load)
+func (_ Unimplemented) GetMeetingLoadReport(w http.ResponseWriter, r *http.Request, params GetMeetingLoadReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GoogleCallback(w, r, params)
-	}))
+// Utilization and capacity report
+// (GET /api/reports/utilization)
+func (_ Unimplemented) GetUtilizationReport(w http.ResponseWriter, r *http.Request, params GetUtilizationReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Export rules and fingerprints as a named pack
+// (POST /api/rule-packs/export)
+func (_ Unimplemented) ExportRulePack(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Import a rule pack
+// (POST /api/rule-packs/import)
+func (_ Unimplemented) ImportRulePack(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+// List all classification rules
+// (GET /api/rules)
+func (_ Unimplemented) ListRules(w http.ResponseWriter, r *http.Request, params ListRulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
-	}))
+// Create a new classification rule
+// (POST /api/rules)
+func (_ Unimplemented) CreateRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Apply classification rules to pending events
+// (POST /api/rules/apply)
+func (_ Unimplemented) ApplyRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Find rules that compete for the same events
+// (GET /api/rules/conflicts)
+func (_ Unimplemented) GetRuleConflicts(w http.ResponseWriter, r *http.Request, params GetRuleConflictsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+// Check a query for common problems
+// (POST /api/rules/lint)
+func (_ Unimplemented) LintRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Preview what events a rule would match
+// (POST /api/rules/preview)
+func (_ Unimplemented) PreviewRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// Test a query against synthetic events
+// (POST /api/rules/test)
+func (_ Unimplemented) TestRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// Delete a rule
+// (DELETE /api/rules/{id})
+func (_ Unimplemented) DeleteRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Logout(w, r)
-	}))
+// Get a rule by ID
+// (GET /api/rules/{id})
+func (_ Unimplemented) GetRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Update a rule
+// (PUT /api/rules/{id})
+func (_ Unimplemented) UpdateRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List classification snapshots
+// (GET /api/snapshots)
+func (_ Unimplemented) ListClassificationSnapshots(w http.ResponseWriter, r *http.Request, params ListClassificationSnapshotsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+// Snapshot a week's classification state
+// (POST /api/snapshots)
+func (_ Unimplemented) CreateClassificationSnapshot(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Delete a classification snapshot
+// (DELETE /api/snapshots/{id})
+func (_ Unimplemented) DeleteClassificationSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// Restore a classification snapshot
+// (POST /api/snapshots/{id}/restore)
+func (_ Unimplemented) RestoreClassificationSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// List suppression rules
+// (GET /api/suppression-rules)
+func (_ Unimplemented) ListSuppressionRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCurrentUser(w, r)
-	}))
+// Create a suppression rule
+// (POST /api/suppression-rules)
+func (_ Unimplemented) CreateSuppressionRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Delete a suppression rule
+// (DELETE /api/suppression-rules/{id})
+func (_ Unimplemented) DeleteSuppressionRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Enable or disable a suppression rule
+// (PUT /api/suppression-rules/{id})
+func (_ Unimplemented) UpdateSuppressionRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Signup operation middleware
-func (siw *ServerInterfaceWrapper) Signup(w http.ResponseWriter, r *http.Request) {
+// List tags
+// (GET /api/tags)
+func (_ Unimplemented) ListTags(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Signup(w, r)
-	}))
+// Create a tag
+// (POST /api/tags)
+func (_ Unimplemented) CreateTag(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Delete a tag
+// (DELETE /api/tags/{id})
+func (_ Unimplemented) DeleteTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Rename a tag
+// (PATCH /api/tags/{id})
+func (_ Unimplemented) RenameTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ListBillingPeriods operation middleware
-func (siw *ServerInterfaceWrapper) ListBillingPeriods(w http.ResponseWriter, r *http.Request) {
+// List time entries
+// (GET /api/time-entries)
+func (_ Unimplemented) ListTimeEntries(w http.ResponseWriter, r *http.Request, params ListTimeEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Create a new time entry
+// (POST /api/time-entries)
+func (_ Unimplemented) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Suggest descriptions from GitHub activity
+// (GET /api/time-entries/description-suggestions)
+func (_ Unimplemented) SuggestDescriptions(w http.ResponseWriter, r *http.Request, params SuggestDescriptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// Parse a time entry from text
+// (POST /api/time-entries/parse)
+func (_ Unimplemented) ParseTimeEntry(w http.ResponseWriter, r *http.Request, params ParseTimeEntryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// Recompute time entries in the background
+// (POST /api/time-entries/recalculate)
+func (_ Unimplemented) RecalculateTimeEntries(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListBillingPeriodsParams
+// Get a background recalculation job
+// (GET /api/time-entries/recalculate/jobs/{id})
+func (_ Unimplemented) GetRecalculationJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Required query parameter "project_id" -------------
+// List entries whose hours diverge from computed hours
+// (GET /api/time-entries/reconciliation)
+func (_ Unimplemented) GetReconciliationReport(w http.ResponseWriter, r *http.Request, params GetReconciliationReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	if paramValue := r.URL.Query().Get("project_id"); paramValue != "" {
+// Delete a time entry
+// (DELETE /api/time-entries/{id})
+func (_ Unimplemented) DeleteTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project_id"})
-		return
-	}
+// Get a time entry by ID
+// (GET /api/time-entries/{id})
+func (_ Unimplemented) GetTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "project_id", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
-		return
-	}
+// Update a time entry
+// (PUT /api/time-entries/{id})
+func (_ Unimplemented) UpdateTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListBillingPeriods(w, r, params)
-	}))
+// Show how a time entry's computed hours were derived
+// (GET /api/time-entries/{id}/breakdown)
+func (_ Unimplemented) GetTimeEntryBreakdown(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Explain why a time entry has its hours
+// (GET /api/time-entries/{id}/explain)
+func (_ Unimplemented) ExplainTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List notes on a time entry
+// (GET /api/time-entries/{id}/notes)
+func (_ Unimplemented) ListTimeEntryNotes(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CreateBillingPeriod operation middleware
-func (siw *ServerInterfaceWrapper) CreateBillingPeriod(w http.ResponseWriter, r *http.Request) {
+// Add a note to a time entry
+// (POST /api/time-entries/{id}/notes)
+func (_ Unimplemented) AddTimeEntryNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx := r.Context()
+// Remove a note from a time entry
+// (DELETE /api/time-entries/{id}/notes/{noteId})
+func (_ Unimplemented) DeleteTimeEntryNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, noteId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// Resolve a divergent time entry
+// (POST /api/time-entries/{id}/reconcile)
+func (_ Unimplemented) ReconcileTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r = r.WithContext(ctx)
+// Reset time entry to computed values from events
+// (POST /api/time-entries/{id}/refresh)
+func (_ Unimplemented) RefreshTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateBillingPeriod(w, r)
-	}))
+// Split a time entry across projects
+// (POST /api/time-entries/{id}/split)
+func (_ Unimplemented) SplitTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Replace the tags on a time entry
+// (PUT /api/time-entries/{id}/tags)
+func (_ Unimplemented) SetTimeEntryTags(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List deleted time entries and rules
+// (GET /api/trash)
+func (_ Unimplemented) ListTrash(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// DeleteBillingPeriod operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBillingPeriod(w http.ResponseWriter, r *http.Request) {
+// Restore a deleted rule
+// (POST /api/trash/rules/{id}/restore)
+func (_ Unimplemented) RestoreRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Restore a deleted time entry
+// (POST /api/trash/time-entries/{id}/restore)
+func (_ Unimplemented) RestoreTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+// Get a project by day hours grid for a week
+// (GET /api/weeks/{start})
+func (_ Unimplemented) GetWeekGrid(w http.ResponseWriter, r *http.Request, start openapi_types.Date) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// Get the working-hours profile
+// (GET /api/working-hours)
+func (_ Unimplemented) GetWorkingHours(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the working-hours profile
+// (PUT /api/working-hours)
+func (_ Unimplemented) UpdateWorkingHours(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListClassificationActions operation middleware
+func (siw *ServerInterfaceWrapper) ListClassificationActions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -1751,8 +5284,19 @@ func (siw *ServerInterfaceWrapper) DeleteBillingPeriod(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClassificationActionsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBillingPeriod(w, r, id)
+		siw.Handler.ListClassificationActions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1762,8 +5306,8 @@ func (siw *ServerInterfaceWrapper) DeleteBillingPeriod(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateBillingPeriod operation middleware
-func (siw *ServerInterfaceWrapper) UpdateBillingPeriod(w http.ResponseWriter, r *http.Request) {
+// UndoClassificationAction operation middleware
+func (siw *ServerInterfaceWrapper) UndoClassificationAction(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1783,7 +5327,7 @@ func (siw *ServerInterfaceWrapper) UpdateBillingPeriod(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateBillingPeriod(w, r, id)
+		siw.Handler.UndoClassificationAction(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1793,8 +5337,8 @@ func (siw *ServerInterfaceWrapper) UpdateBillingPeriod(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListCalendarEvents operation middleware
-func (siw *ServerInterfaceWrapper) ListCalendarEvents(w http.ResponseWriter, r *http.Request) {
+// ListAnomalies operation middleware
+func (siw *ServerInterfaceWrapper) ListAnomalies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1805,7 +5349,7 @@ func (siw *ServerInterfaceWrapper) ListCalendarEvents(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCalendarEventsParams
+	var params ListAnomaliesParams
 
 	// ------------- Optional query parameter "start_date" -------------
 
@@ -1823,24 +5367,16 @@ func (siw *ServerInterfaceWrapper) ListCalendarEvents(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Optional query parameter "classification_status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "classification_status", r.URL.Query(), &params.ClassificationStatus)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "classification_status", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "connection_id" -------------
+	// ------------- Optional query parameter "include_dismissed" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "connection_id", r.URL.Query(), &params.ConnectionId)
+	err = runtime.BindQueryParameter("form", true, false, "include_dismissed", r.URL.Query(), &params.IncludeDismissed)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connection_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_dismissed", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCalendarEvents(w, r, params)
+		siw.Handler.ListAnomalies(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1850,8 +5386,8 @@ func (siw *ServerInterfaceWrapper) ListCalendarEvents(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// BulkClassifyEvents operation middleware
-func (siw *ServerInterfaceWrapper) BulkClassifyEvents(w http.ResponseWriter, r *http.Request) {
+// AnalyzeAnomalies operation middleware
+func (siw *ServerInterfaceWrapper) AnalyzeAnomalies(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1860,7 +5396,7 @@ func (siw *ServerInterfaceWrapper) BulkClassifyEvents(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BulkClassifyEvents(w, r)
+		siw.Handler.AnalyzeAnomalies(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1870,8 +5406,8 @@ func (siw *ServerInterfaceWrapper) BulkClassifyEvents(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ClassifyCalendarEvent operation middleware
-func (siw *ServerInterfaceWrapper) ClassifyCalendarEvent(w http.ResponseWriter, r *http.Request) {
+// DismissAnomaly operation middleware
+func (siw *ServerInterfaceWrapper) DismissAnomaly(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1891,7 +5427,7 @@ func (siw *ServerInterfaceWrapper) ClassifyCalendarEvent(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ClassifyCalendarEvent(w, r, id)
+		siw.Handler.DismissAnomaly(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1901,19 +5437,8 @@ func (siw *ServerInterfaceWrapper) ClassifyCalendarEvent(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ExplainEventClassification operation middleware
-func (siw *ServerInterfaceWrapper) ExplainEventClassification(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// ListApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListApiKeys(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1922,7 +5447,7 @@ func (siw *ServerInterfaceWrapper) ExplainEventClassification(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExplainEventClassification(w, r, id)
+		siw.Handler.ListApiKeys(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1932,8 +5457,8 @@ func (siw *ServerInterfaceWrapper) ExplainEventClassification(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// ListCalendarConnections operation middleware
-func (siw *ServerInterfaceWrapper) ListCalendarConnections(w http.ResponseWriter, r *http.Request) {
+// CreateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateApiKey(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -1942,7 +5467,7 @@ func (siw *ServerInterfaceWrapper) ListCalendarConnections(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCalendarConnections(w, r)
+		siw.Handler.CreateApiKey(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1952,8 +5477,8 @@ func (siw *ServerInterfaceWrapper) ListCalendarConnections(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCalendarConnection operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCalendarConnection(w http.ResponseWriter, r *http.Request) {
+// DeleteApiKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1973,7 +5498,7 @@ func (siw *ServerInterfaceWrapper) DeleteCalendarConnection(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCalendarConnection(w, r, id)
+		siw.Handler.DeleteApiKey(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1983,8 +5508,8 @@ func (siw *ServerInterfaceWrapper) DeleteCalendarConnection(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// ListCalendarSources operation middleware
-func (siw *ServerInterfaceWrapper) ListCalendarSources(w http.ResponseWriter, r *http.Request) {
+// UpdateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApiKey(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2004,7 +5529,7 @@ func (siw *ServerInterfaceWrapper) ListCalendarSources(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCalendarSources(w, r, id)
+		siw.Handler.UpdateApiKey(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2014,20 +5539,29 @@ func (siw *ServerInterfaceWrapper) ListCalendarSources(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCalendarSources operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCalendarSources(w http.ResponseWriter, r *http.Request) {
+// ListAttendeeAliases operation middleware
+func (siw *ServerInterfaceWrapper) ListAttendeeAliases(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAttendeeAliases(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAttendeeAlias operation middleware
+func (siw *ServerInterfaceWrapper) CreateAttendeeAlias(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2035,7 +5569,7 @@ func (siw *ServerInterfaceWrapper) UpdateCalendarSources(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCalendarSources(w, r, id)
+		siw.Handler.CreateAttendeeAlias(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2045,8 +5579,8 @@ func (siw *ServerInterfaceWrapper) UpdateCalendarSources(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// SyncCalendar operation middleware
-func (siw *ServerInterfaceWrapper) SyncCalendar(w http.ResponseWriter, r *http.Request) {
+// DeleteAttendeeAlias operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAttendeeAlias(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2065,27 +5599,8 @@ func (siw *ServerInterfaceWrapper) SyncCalendar(w http.ResponseWriter, r *http.R
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SyncCalendarParams
-
-	// ------------- Optional query parameter "start_date" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "end_date" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SyncCalendar(w, r, id, params)
+		siw.Handler.DeleteAttendeeAlias(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2095,10 +5610,8 @@ func (siw *ServerInterfaceWrapper) SyncCalendar(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ExportConfig operation middleware
-func (siw *ServerInterfaceWrapper) ExportConfig(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// GetCompanyDomains operation middleware
+func (siw *ServerInterfaceWrapper) GetCompanyDomains(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2106,19 +5619,28 @@ func (siw *ServerInterfaceWrapper) ExportConfig(w http.ResponseWriter, r *http.R
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ExportConfigParams
-
-	// ------------- Optional query parameter "include_archived" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCompanyDomains(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCompanyDomains operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCompanyDomains(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExportConfig(w, r, params)
+		siw.Handler.UpdateCompanyDomains(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2128,8 +5650,10 @@ func (siw *ServerInterfaceWrapper) ExportConfig(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ImportConfig operation middleware
-func (siw *ServerInterfaceWrapper) ImportConfig(w http.ResponseWriter, r *http.Request) {
+// GoogleAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) GoogleAuthorize(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -2137,8 +5661,19 @@ func (siw *ServerInterfaceWrapper) ImportConfig(w http.ResponseWriter, r *http.R
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GoogleAuthorizeParams
+
+	// ------------- Optional query parameter "access" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "access", r.URL.Query(), &params.Access)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ImportConfig(w, r)
+		siw.Handler.GoogleAuthorize(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2148,8 +5683,8 @@ func (siw *ServerInterfaceWrapper) ImportConfig(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListInvoices operation middleware
-func (siw *ServerInterfaceWrapper) ListInvoices(w http.ResponseWriter, r *http.Request) {
+// GoogleCallback operation middleware
+func (siw *ServerInterfaceWrapper) GoogleCallback(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2160,26 +5695,40 @@ func (siw *ServerInterfaceWrapper) ListInvoices(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListInvoicesParams
+	var params GoogleCallbackParams
 
-	// ------------- Optional query parameter "project_id" -------------
+	// ------------- Required query parameter "code" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Required query parameter "state" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListInvoices(w, r, params)
+		siw.Handler.GoogleCallback(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2189,8 +5738,8 @@ func (siw *ServerInterfaceWrapper) ListInvoices(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateInvoice operation middleware
-func (siw *ServerInterfaceWrapper) CreateInvoice(w http.ResponseWriter, r *http.Request) {
+// GetLocale operation middleware
+func (siw *ServerInterfaceWrapper) GetLocale(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2199,7 +5748,7 @@ func (siw *ServerInterfaceWrapper) CreateInvoice(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateInvoice(w, r)
+		siw.Handler.GetLocale(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2209,19 +5758,8 @@ func (siw *ServerInterfaceWrapper) CreateInvoice(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteInvoice operation middleware
-func (siw *ServerInterfaceWrapper) DeleteInvoice(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// UpdateLocale operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLocale(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2230,7 +5768,7 @@ func (siw *ServerInterfaceWrapper) DeleteInvoice(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteInvoice(w, r, id)
+		siw.Handler.UpdateLocale(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2240,20 +5778,23 @@ func (siw *ServerInterfaceWrapper) DeleteInvoice(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetInvoice operation middleware
-func (siw *ServerInterfaceWrapper) GetInvoice(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2261,7 +5802,7 @@ func (siw *ServerInterfaceWrapper) GetInvoice(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInvoice(w, r, id)
+		siw.Handler.Logout(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2271,19 +5812,8 @@ func (siw *ServerInterfaceWrapper) GetInvoice(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ExportInvoiceCSV operation middleware
-func (siw *ServerInterfaceWrapper) ExportInvoiceCSV(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2292,7 +5822,7 @@ func (siw *ServerInterfaceWrapper) ExportInvoiceCSV(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExportInvoiceCSV(w, r, id)
+		siw.Handler.GetCurrentUser(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2302,19 +5832,8 @@ func (siw *ServerInterfaceWrapper) ExportInvoiceCSV(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ExportInvoiceSheets operation middleware
-func (siw *ServerInterfaceWrapper) ExportInvoiceSheets(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// GetRetentionSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetRetentionSettings(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2323,7 +5842,7 @@ func (siw *ServerInterfaceWrapper) ExportInvoiceSheets(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExportInvoiceSheets(w, r, id)
+		siw.Handler.GetRetentionSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2333,19 +5852,8 @@ func (siw *ServerInterfaceWrapper) ExportInvoiceSheets(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateInvoiceStatus operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInvoiceStatus(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// UpdateRetentionSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRetentionSettings(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2354,7 +5862,7 @@ func (siw *ServerInterfaceWrapper) UpdateInvoiceStatus(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateInvoiceStatus(w, r, id)
+		siw.Handler.UpdateRetentionSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2364,8 +5872,8 @@ func (siw *ServerInterfaceWrapper) UpdateInvoiceStatus(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjects operation middleware
-func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+// PreviewRetention operation middleware
+func (siw *ServerInterfaceWrapper) PreviewRetention(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2376,18 +5884,18 @@ func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListProjectsParams
+	var params PreviewRetentionParams
 
-	// ------------- Optional query parameter "include_archived" -------------
+	// ------------- Optional query parameter "event_months" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	err = runtime.BindQueryParameter("form", true, false, "event_months", r.URL.Query(), &params.EventMonths)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "event_months", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjects(w, r, params)
+		siw.Handler.PreviewRetention(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2397,17 +5905,11 @@ func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateProject operation middleware
-func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
+// Signup operation middleware
+func (siw *ServerInterfaceWrapper) Signup(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProject(w, r)
+		siw.Handler.Signup(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2417,28 +5919,11 @@ func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteProject operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
+// StartSso operation middleware
+func (siw *ServerInterfaceWrapper) StartSso(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProject(w, r, id)
+		siw.Handler.StartSso(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2448,19 +5933,8 @@ func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetProject operation middleware
-func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// GetSyncSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSyncSettings(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2469,7 +5943,7 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProject(w, r, id)
+		siw.Handler.GetSyncSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2479,19 +5953,8 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateProject operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// UpdateSyncSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSyncSettings(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2500,7 +5963,7 @@ func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProject(w, r, id)
+		siw.Handler.UpdateSyncSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2510,8 +5973,8 @@ func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListRules operation middleware
-func (siw *ServerInterfaceWrapper) ListRules(w http.ResponseWriter, r *http.Request) {
+// ListBillingPeriods operation middleware
+func (siw *ServerInterfaceWrapper) ListBillingPeriods(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2522,18 +5985,25 @@ func (siw *ServerInterfaceWrapper) ListRules(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRulesParams
+	var params ListBillingPeriodsParams
 
-	// ------------- Optional query parameter "include_disabled" -------------
+	// ------------- Required query parameter "project_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "include_disabled", r.URL.Query(), &params.IncludeDisabled)
+	if paramValue := r.URL.Query().Get("project_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project_id", r.URL.Query(), &params.ProjectId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_disabled", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRules(w, r, params)
+		siw.Handler.ListBillingPeriods(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2543,8 +6013,8 @@ func (siw *ServerInterfaceWrapper) ListRules(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRule operation middleware
-func (siw *ServerInterfaceWrapper) CreateRule(w http.ResponseWriter, r *http.Request) {
+// CreateBillingPeriod operation middleware
+func (siw *ServerInterfaceWrapper) CreateBillingPeriod(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -2553,7 +6023,7 @@ func (siw *ServerInterfaceWrapper) CreateRule(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRule(w, r)
+		siw.Handler.CreateBillingPeriod(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2563,8 +6033,19 @@ func (siw *ServerInterfaceWrapper) CreateRule(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ApplyRules operation middleware
-func (siw *ServerInterfaceWrapper) ApplyRules(w http.ResponseWriter, r *http.Request) {
+// DeleteBillingPeriod operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBillingPeriod(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2573,7 +6054,7 @@ func (siw *ServerInterfaceWrapper) ApplyRules(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApplyRules(w, r)
+		siw.Handler.DeleteBillingPeriod(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2583,8 +6064,19 @@ func (siw *ServerInterfaceWrapper) ApplyRules(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// PreviewRule operation middleware
-func (siw *ServerInterfaceWrapper) PreviewRule(w http.ResponseWriter, r *http.Request) {
+// UpdateBillingPeriod operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBillingPeriod(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2593,7 +6085,7 @@ func (siw *ServerInterfaceWrapper) PreviewRule(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PreviewRule(w, r)
+		siw.Handler.UpdateBillingPeriod(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2603,28 +6095,30 @@ func (siw *ServerInterfaceWrapper) PreviewRule(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteRule operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRule(w http.ResponseWriter, r *http.Request) {
+// ListClientBalances operation middleware
+func (siw *ServerInterfaceWrapper) ListClientBalances(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClientBalancesParams
+
+	// ------------- Optional query parameter "client" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "client", r.URL.Query(), &params.Client)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "client", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRule(w, r, id)
+		siw.Handler.ListClientBalances(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2634,20 +6128,74 @@ func (siw *ServerInterfaceWrapper) DeleteRule(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetRule operation middleware
-func (siw *ServerInterfaceWrapper) GetRule(w http.ResponseWriter, r *http.Request) {
+// ListCalendarEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListCalendarEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCalendarEventsParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "classification_status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "classification_status", r.URL.Query(), &params.ClassificationStatus)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "classification_status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "connection_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "connection_id", r.URL.Query(), &params.ConnectionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "connection_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCalendarEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BulkClassifyEvents operation middleware
+func (siw *ServerInterfaceWrapper) BulkClassifyEvents(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -2655,7 +6203,7 @@ func (siw *ServerInterfaceWrapper) GetRule(w http.ResponseWriter, r *http.Reques
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRule(w, r, id)
+		siw.Handler.BulkClassifyEvents(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2665,28 +6213,75 @@ func (siw *ServerInterfaceWrapper) GetRule(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateRule operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRule(w http.ResponseWriter, r *http.Request) {
+// DiffCalendarEvents operation middleware
+func (siw *ServerInterfaceWrapper) DiffCalendarEvents(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffCalendarEventsParams
+
+	// ------------- Required query parameter "start_date" -------------
+
+	if paramValue := r.URL.Query().Get("start_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start_date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start_date", r.URL.Query(), &params.StartDate)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Required query parameter "end_date" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	if paramValue := r.URL.Query().Get("end_date"); paramValue != "" {
 
-	r = r.WithContext(ctx)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end_date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "since" -------------
+
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateRule(w, r, id)
+		siw.Handler.DiffCalendarEvents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2696,8 +6291,8 @@ func (siw *ServerInterfaceWrapper) UpdateRule(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListTimeEntries operation middleware
-func (siw *ServerInterfaceWrapper) ListTimeEntries(w http.ResponseWriter, r *http.Request) {
+// GetReviewQueue operation middleware
+func (siw *ServerInterfaceWrapper) GetReviewQueue(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2708,7 +6303,7 @@ func (siw *ServerInterfaceWrapper) ListTimeEntries(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTimeEntriesParams
+	var params GetReviewQueueParams
 
 	// ------------- Optional query parameter "start_date" -------------
 
@@ -2726,16 +6321,16 @@ func (siw *ServerInterfaceWrapper) ListTimeEntries(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Optional query parameter "project_id" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTimeEntries(w, r, params)
+		siw.Handler.GetReviewQueue(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2745,8 +6340,19 @@ func (siw *ServerInterfaceWrapper) ListTimeEntries(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTimeEntry operation middleware
-func (siw *ServerInterfaceWrapper) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+// ListEventChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListEventChanges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2755,7 +6361,7 @@ func (siw *ServerInterfaceWrapper) CreateTimeEntry(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTimeEntry(w, r)
+		siw.Handler.ListEventChanges(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2765,8 +6371,8 @@ func (siw *ServerInterfaceWrapper) CreateTimeEntry(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTimeEntry operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+// ClassifyCalendarEvent operation middleware
+func (siw *ServerInterfaceWrapper) ClassifyCalendarEvent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2786,7 +6392,7 @@ func (siw *ServerInterfaceWrapper) DeleteTimeEntry(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTimeEntry(w, r, id)
+		siw.Handler.ClassifyCalendarEvent(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2796,8 +6402,8 @@ func (siw *ServerInterfaceWrapper) DeleteTimeEntry(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetTimeEntry operation middleware
-func (siw *ServerInterfaceWrapper) GetTimeEntry(w http.ResponseWriter, r *http.Request) {
+// ExplainEventClassification operation middleware
+func (siw *ServerInterfaceWrapper) ExplainEventClassification(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2817,7 +6423,7 @@ func (siw *ServerInterfaceWrapper) GetTimeEntry(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTimeEntry(w, r, id)
+		siw.Handler.ExplainEventClassification(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2827,8 +6433,8 @@ func (siw *ServerInterfaceWrapper) GetTimeEntry(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTimeEntry operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTimeEntry(w http.ResponseWriter, r *http.Request) {
+// ListEventClassificationHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListEventClassificationHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2848,7 +6454,7 @@ func (siw *ServerInterfaceWrapper) UpdateTimeEntry(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTimeEntry(w, r, id)
+		siw.Handler.ListEventClassificationHistory(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2858,8 +6464,8 @@ func (siw *ServerInterfaceWrapper) UpdateTimeEntry(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// RefreshTimeEntry operation middleware
-func (siw *ServerInterfaceWrapper) RefreshTimeEntry(w http.ResponseWriter, r *http.Request) {
+// ReviewCalendarEvent operation middleware
+func (siw *ServerInterfaceWrapper) ReviewCalendarEvent(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2879,7 +6485,7 @@ func (siw *ServerInterfaceWrapper) RefreshTimeEntry(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RefreshTimeEntry(w, r, id)
+		siw.Handler.ReviewCalendarEvent(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2889,2236 +6495,15652 @@ func (siw *ServerInterfaceWrapper) RefreshTimeEntry(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// SetEventSuppression operation middleware
+func (siw *ServerInterfaceWrapper) SetEventSuppression(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetEventSuppression(w, r, id)
+	}))
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// SetEventTags operation middleware
+func (siw *ServerInterfaceWrapper) SetEventTags(w http.ResponseWriter, r *http.Request) {
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	ctx := r.Context()
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	r = r.WithContext(ctx)
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetEventTags(w, r, id)
+	}))
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// ListCalendarConnections operation middleware
+func (siw *ServerInterfaceWrapper) ListCalendarConnections(w http.ResponseWriter, r *http.Request) {
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	ctx := r.Context()
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/api-keys", wrapper.ListApiKeys)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/api-keys", wrapper.CreateApiKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/api-keys/{id}", wrapper.DeleteApiKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/auth/google/authorize", wrapper.GoogleAuthorize)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/auth/google/callback", wrapper.GoogleCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth/login", wrapper.Login)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth/logout", wrapper.Logout)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/auth/me", wrapper.GetCurrentUser)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth/signup", wrapper.Signup)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/billing-periods", wrapper.ListBillingPeriods)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/billing-periods", wrapper.CreateBillingPeriod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/billing-periods/{id}", wrapper.DeleteBillingPeriod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/billing-periods/{id}", wrapper.UpdateBillingPeriod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/calendar-events", wrapper.ListCalendarEvents)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/calendar-events/bulk-classify", wrapper.BulkClassifyEvents)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/calendar-events/{id}/classify", wrapper.ClassifyCalendarEvent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/calendar-events/{id}/explain", wrapper.ExplainEventClassification)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/calendars", wrapper.ListCalendarConnections)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/calendars/{id}", wrapper.DeleteCalendarConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/calendars/{id}/sources", wrapper.ListCalendarSources)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/calendars/{id}/sources", wrapper.UpdateCalendarSources)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/calendars/{id}/sync", wrapper.SyncCalendar)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/config/export", wrapper.ExportConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/config/import", wrapper.ImportConfig)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/invoices", wrapper.ListInvoices)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/invoices", wrapper.CreateInvoice)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/invoices/{id}", wrapper.DeleteInvoice)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/invoices/{id}", wrapper.GetInvoice)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/invoices/{id}/export/csv", wrapper.ExportInvoiceCSV)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/invoices/{id}/export/sheets", wrapper.ExportInvoiceSheets)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/invoices/{id}/status", wrapper.UpdateInvoiceStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/projects", wrapper.ListProjects)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/projects", wrapper.CreateProject)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/projects/{id}", wrapper.DeleteProject)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/projects/{id}", wrapper.GetProject)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/projects/{id}", wrapper.UpdateProject)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/rules", wrapper.ListRules)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/rules", wrapper.CreateRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/rules/apply", wrapper.ApplyRules)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/rules/preview", wrapper.PreviewRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/rules/{id}", wrapper.DeleteRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/rules/{id}", wrapper.GetRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/rules/{id}", wrapper.UpdateRule)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/time-entries", wrapper.ListTimeEntries)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/time-entries", wrapper.CreateTimeEntry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/time-entries/{id}", wrapper.DeleteTimeEntry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/time-entries/{id}", wrapper.GetTimeEntry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/time-entries/{id}", wrapper.UpdateTimeEntry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/time-entries/{id}/refresh", wrapper.RefreshTimeEntry)
-	})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return r
-}
+	r = r.WithContext(ctx)
 
-type ListApiKeysRequestObject struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCalendarConnections(w, r)
+	}))
 
-type ListApiKeysResponseObject interface {
-	VisitListApiKeysResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListApiKeys200JSONResponse []ApiKey
+// ListConnectionsNeedingReauth operation middleware
+func (siw *ServerInterfaceWrapper) ListConnectionsNeedingReauth(w http.ResponseWriter, r *http.Request) {
 
-func (response ListApiKeys200JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListApiKeys401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ListApiKeys401JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListConnectionsNeedingReauth(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateApiKeyRequestObject struct {
-	Body *CreateApiKeyJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateApiKeyResponseObject interface {
-	VisitCreateApiKeyResponse(w http.ResponseWriter) error
-}
-
-type CreateApiKey201JSONResponse ApiKeyWithSecret
-
-func (response CreateApiKey201JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// DeleteCalendarConnection operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCalendarConnection(w http.ResponseWriter, r *http.Request) {
 
-type CreateApiKey400JSONResponse Error
+	var err error
 
-func (response CreateApiKey400JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateApiKey401JSONResponse Error
+	ctx := r.Context()
 
-func (response CreateApiKey401JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateApiKey409JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCalendarConnection(w, r, id)
+	}))
 
-func (response CreateApiKey409JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteApiKeyRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+// PauseCalendarConnection operation middleware
+func (siw *ServerInterfaceWrapper) PauseCalendarConnection(w http.ResponseWriter, r *http.Request) {
 
-type DeleteApiKeyResponseObject interface {
-	VisitDeleteApiKeyResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type DeleteApiKey204Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response DeleteApiKey204Response) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type DeleteApiKey401JSONResponse Error
+	ctx := r.Context()
 
-func (response DeleteApiKey401JSONResponse) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type DeleteApiKey404JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseCalendarConnection(w, r, id)
+	}))
 
-func (response DeleteApiKey404JSONResponse) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GoogleAuthorizeRequestObject struct {
-}
+// ReauthorizeCalendarConnection operation middleware
+func (siw *ServerInterfaceWrapper) ReauthorizeCalendarConnection(w http.ResponseWriter, r *http.Request) {
 
-type GoogleAuthorizeResponseObject interface {
-	VisitGoogleAuthorizeResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GoogleAuthorize200JSONResponse OAuthAuthorizeResponse
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response GoogleAuthorize200JSONResponse) VisitGoogleAuthorizeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GoogleAuthorize401JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GoogleAuthorize401JSONResponse) VisitGoogleAuthorizeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReauthorizeCalendarConnection(w, r, id)
+	}))
 
-type GoogleCallbackRequestObject struct {
-	Params GoogleCallbackParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GoogleCallbackResponseObject interface {
-	VisitGoogleCallbackResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GoogleCallback201JSONResponse CalendarConnection
+// ResumeCalendarConnection operation middleware
+func (siw *ServerInterfaceWrapper) ResumeCalendarConnection(w http.ResponseWriter, r *http.Request) {
 
-func (response GoogleCallback201JSONResponse) VisitGoogleCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type GoogleCallback400JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GoogleCallback400JSONResponse) VisitGoogleCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GoogleCallback401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response GoogleCallback401JSONResponse) VisitGoogleCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeCalendarConnection(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
-}
+// ListCalendarSources operation middleware
+func (siw *ServerInterfaceWrapper) ListCalendarSources(w http.ResponseWriter, r *http.Request) {
 
-type Login200JSONResponse AuthResponse
+	var err error
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type Login400JSONResponse Error
+	ctx := r.Context()
 
-func (response Login400JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type Login401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCalendarSources(w, r, id)
+	}))
 
-func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type LogoutRequestObject struct {
-}
+// UpdateCalendarSources operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCalendarSources(w http.ResponseWriter, r *http.Request) {
 
-type LogoutResponseObject interface {
-	VisitLogoutResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type Logout204Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type Logout401JSONResponse Error
+	ctx := r.Context()
 
-func (response Logout401JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetCurrentUserRequestObject struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCalendarSources(w, r, id)
+	}))
 
-type GetCurrentUserResponseObject interface {
-	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetCurrentUser200JSONResponse User
+// UpdateCalendarSource operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCalendarSource(w http.ResponseWriter, r *http.Request) {
 
-func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type GetCurrentUser401JSONResponse Error
-
-func (response GetCurrentUser401JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type SignupRequestObject struct {
-	Body *SignupJSONRequestBody
-}
+	// ------------- Path parameter "calendar_id" -------------
+	var calendarId openapi_types.UUID
 
-type SignupResponseObject interface {
-	VisitSignupResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "calendar_id", chi.URLParam(r, "calendar_id"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "calendar_id", Err: err})
+		return
+	}
 
-type Signup201JSONResponse AuthResponse
+	ctx := r.Context()
 
-func (response Signup201JSONResponse) VisitSignupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type Signup400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCalendarSource(w, r, id, calendarId)
+	}))
 
-func (response Signup400JSONResponse) VisitSignupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type Signup409JSONResponse Error
+// SyncCalendar operation middleware
+func (siw *ServerInterfaceWrapper) SyncCalendar(w http.ResponseWriter, r *http.Request) {
 
-func (response Signup409JSONResponse) VisitSignupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type ListBillingPeriodsRequestObject struct {
-	Params ListBillingPeriodsParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListBillingPeriodsResponseObject interface {
-	VisitListBillingPeriodsResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type ListBillingPeriods200JSONResponse []BillingPeriod
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListBillingPeriods200JSONResponse) VisitListBillingPeriodsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SyncCalendarParams
 
-type ListBillingPeriods400JSONResponse Error
+	// ------------- Optional query parameter "start_date" -------------
 
-func (response ListBillingPeriods400JSONResponse) VisitListBillingPeriodsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "end_date" -------------
 
-type ListBillingPeriods401JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
 
-func (response ListBillingPeriods401JSONResponse) VisitListBillingPeriodsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncCalendar(w, r, id, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateBillingPeriodRequestObject struct {
-	Body *CreateBillingPeriodJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateBillingPeriodResponseObject interface {
-	VisitCreateBillingPeriodResponse(w http.ResponseWriter) error
-}
+// UpdateCalendarSyncWindow operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCalendarSyncWindow(w http.ResponseWriter, r *http.Request) {
 
-type CreateBillingPeriod201JSONResponse BillingPeriod
+	var err error
 
-func (response CreateBillingPeriod201JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateBillingPeriod400JSONResponse Error
+	ctx := r.Context()
 
-func (response CreateBillingPeriod400JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateBillingPeriod401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCalendarSyncWindow(w, r, id)
+	}))
 
-func (response CreateBillingPeriod401JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateBillingPeriod409JSONResponse Error
+// UpdateCalendarWriteBack operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCalendarWriteBack(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateBillingPeriod409JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type DeleteBillingPeriodRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type DeleteBillingPeriodResponseObject interface {
-	VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type DeleteBillingPeriod204Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteBillingPeriod204Response) VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type DeleteBillingPeriod401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCalendarWriteBack(w, r, id)
+	}))
 
-func (response DeleteBillingPeriod401JSONResponse) VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteBillingPeriod404JSONResponse Error
+// ListChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListChanges(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteBillingPeriod404JSONResponse) VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type UpdateBillingPeriodRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateBillingPeriodJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateBillingPeriodResponseObject interface {
-	VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type UpdateBillingPeriod200JSONResponse BillingPeriod
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListChangesParams
 
-func (response UpdateBillingPeriod200JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "since" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
 
-type UpdateBillingPeriod400JSONResponse Error
+	// ------------- Optional query parameter "resource_type" -------------
 
-func (response UpdateBillingPeriod400JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "resource_type", r.URL.Query(), &params.ResourceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_type", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type UpdateBillingPeriod401JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response UpdateBillingPeriod401JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListChanges(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateBillingPeriod404JSONResponse Error
+// ApplyRulesAsync operation middleware
+func (siw *ServerInterfaceWrapper) ApplyRulesAsync(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateBillingPeriod404JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateBillingPeriod409JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response UpdateBillingPeriod409JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyRulesAsync(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListCalendarEventsRequestObject struct {
-	Params ListCalendarEventsParams
+	handler.ServeHTTP(w, r)
 }
 
-type ListCalendarEventsResponseObject interface {
-	VisitListCalendarEventsResponse(w http.ResponseWriter) error
-}
+// GetClassificationJob operation middleware
+func (siw *ServerInterfaceWrapper) GetClassificationJob(w http.ResponseWriter, r *http.Request) {
 
-type ListCalendarEvents200JSONResponse []CalendarEvent
+	var err error
 
-func (response ListCalendarEvents200JSONResponse) VisitListCalendarEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListCalendarEvents401JSONResponse Error
+	ctx := r.Context()
 
-func (response ListCalendarEvents401JSONResponse) VisitListCalendarEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type BulkClassifyEventsRequestObject struct {
-	Body *BulkClassifyEventsJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClassificationJob(w, r, id)
+	}))
 
-type BulkClassifyEventsResponseObject interface {
-	VisitBulkClassifyEventsResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type BulkClassifyEvents200JSONResponse BulkClassifyResponse
+// ListClientRates operation middleware
+func (siw *ServerInterfaceWrapper) ListClientRates(w http.ResponseWriter, r *http.Request) {
 
-func (response BulkClassifyEvents200JSONResponse) VisitBulkClassifyEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type BulkClassifyEvents400JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response BulkClassifyEvents400JSONResponse) VisitBulkClassifyEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClientRatesParams
 
-type BulkClassifyEvents401JSONResponse Error
+	// ------------- Optional query parameter "client" -------------
 
-func (response BulkClassifyEvents401JSONResponse) VisitBulkClassifyEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "client", r.URL.Query(), &params.Client)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "client", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClientRates(w, r, params)
+	}))
 
-type ClassifyCalendarEventRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *ClassifyCalendarEventJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ClassifyCalendarEventResponseObject interface {
-	VisitClassifyCalendarEventResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ClassifyCalendarEvent200JSONResponse ClassifyEventResponse
+// CreateClientRate operation middleware
+func (siw *ServerInterfaceWrapper) CreateClientRate(w http.ResponseWriter, r *http.Request) {
 
-func (response ClassifyCalendarEvent200JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ClassifyCalendarEvent400JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ClassifyCalendarEvent400JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateClientRate(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ClassifyCalendarEvent401JSONResponse Error
+// DeleteClientRate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClientRate(w http.ResponseWriter, r *http.Request) {
 
-func (response ClassifyCalendarEvent401JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type ClassifyCalendarEvent404JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response ClassifyCalendarEvent404JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ExplainEventClassificationRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	r = r.WithContext(ctx)
 
-type ExplainEventClassificationResponseObject interface {
-	VisitExplainEventClassificationResponse(w http.ResponseWriter) error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteClientRate(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ExplainEventClassification200JSONResponse ClassificationExplanation
+// UpdateClientRate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateClientRate(w http.ResponseWriter, r *http.Request) {
 
-func (response ExplainEventClassification200JSONResponse) VisitExplainEventClassificationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type ExplainEventClassification401JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response ExplainEventClassification401JSONResponse) VisitExplainEventClassificationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ExplainEventClassification404JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ExplainEventClassification404JSONResponse) VisitExplainEventClassificationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateClientRate(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListCalendarConnectionsRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type ListCalendarConnectionsResponseObject interface {
-	VisitListCalendarConnectionsResponse(w http.ResponseWriter) error
-}
+// ExportConfig operation middleware
+func (siw *ServerInterfaceWrapper) ExportConfig(w http.ResponseWriter, r *http.Request) {
 
-type ListCalendarConnections200JSONResponse []CalendarConnection
+	var err error
 
-func (response ListCalendarConnections200JSONResponse) VisitListCalendarConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListCalendarConnections401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ListCalendarConnections401JSONResponse) VisitListCalendarConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportConfigParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "include_archived" -------------
 
-type DeleteCalendarConnectionRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
 
-type DeleteCalendarConnectionResponseObject interface {
-	VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportConfig(w, r, params)
+	}))
 
-type DeleteCalendarConnection204Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteCalendarConnection204Response) VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteCalendarConnection401JSONResponse Error
-
-func (response DeleteCalendarConnection401JSONResponse) VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ImportConfig operation middleware
+func (siw *ServerInterfaceWrapper) ImportConfig(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type DeleteCalendarConnection404JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteCalendarConnection404JSONResponse) VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportConfig(w, r)
+	}))
 
-type ListCalendarSourcesRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListCalendarSourcesResponseObject interface {
-	VisitListCalendarSourcesResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListCalendarSources200JSONResponse []Calendar
+// GetDaySummary operation middleware
+func (siw *ServerInterfaceWrapper) GetDaySummary(w http.ResponseWriter, r *http.Request) {
 
-func (response ListCalendarSources200JSONResponse) VisitListCalendarSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
 
-type ListCalendarSources401JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "date", chi.URLParam(r, "date"), &date, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
 
-func (response ListCalendarSources401JSONResponse) VisitListCalendarSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListCalendarSources404JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ListCalendarSources404JSONResponse) VisitListCalendarSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDaySummary(w, r, date)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateCalendarSourcesRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateCalendarSourcesJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateCalendarSourcesResponseObject interface {
-	VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error
-}
+// ListExpenses operation middleware
+func (siw *ServerInterfaceWrapper) ListExpenses(w http.ResponseWriter, r *http.Request) {
 
-type UpdateCalendarSources200JSONResponse []Calendar
+	var err error
 
-func (response UpdateCalendarSources200JSONResponse) VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateCalendarSources401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response UpdateCalendarSources401JSONResponse) VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExpensesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "project_id" -------------
 
-type UpdateCalendarSources404JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
 
-func (response UpdateCalendarSources404JSONResponse) VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "start_date" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
 
-type SyncCalendarRequestObject struct {
-	Id     openapi_types.UUID `json:"id"`
-	Params SyncCalendarParams
-}
+	// ------------- Optional query parameter "end_date" -------------
 
-type SyncCalendarResponseObject interface {
-	VisitSyncCalendarResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
 
-type SyncCalendar200JSONResponse SyncResult
+	// ------------- Optional query parameter "billable" -------------
 
-func (response SyncCalendar200JSONResponse) VisitSyncCalendarResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "billable", r.URL.Query(), &params.Billable)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "billable", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExpenses(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type SyncCalendar401JSONResponse Error
+// CreateExpense operation middleware
+func (siw *ServerInterfaceWrapper) CreateExpense(w http.ResponseWriter, r *http.Request) {
 
-func (response SyncCalendar401JSONResponse) VisitSyncCalendarResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type SyncCalendar404JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response SyncCalendar404JSONResponse) VisitSyncCalendarResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateExpense(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ExportConfigRequestObject struct {
-	Params ExportConfigParams
+	handler.ServeHTTP(w, r)
 }
 
-type ExportConfigResponseObject interface {
-	VisitExportConfigResponse(w http.ResponseWriter) error
-}
+// DeleteExpense operation middleware
+func (siw *ServerInterfaceWrapper) DeleteExpense(w http.ResponseWriter, r *http.Request) {
 
-type ExportConfig200JSONResponse ConfigExport
+	var err error
 
-func (response ExportConfig200JSONResponse) VisitExportConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ExportConfig401JSONResponse Error
+	ctx := r.Context()
 
-func (response ExportConfig401JSONResponse) VisitExportConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type ImportConfigRequestObject struct {
-	Body *ImportConfigJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteExpense(w, r, id)
+	}))
 
-type ImportConfigResponseObject interface {
-	VisitImportConfigResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ImportConfig200JSONResponse ConfigImportResult
+// GetExpense operation middleware
+func (siw *ServerInterfaceWrapper) GetExpense(w http.ResponseWriter, r *http.Request) {
 
-func (response ImportConfig200JSONResponse) VisitImportConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type ImportConfig400JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response ImportConfig400JSONResponse) VisitImportConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ImportConfig401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ImportConfig401JSONResponse) VisitImportConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExpense(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListInvoicesRequestObject struct {
-	Params ListInvoicesParams
+	handler.ServeHTTP(w, r)
 }
 
-type ListInvoicesResponseObject interface {
-	VisitListInvoicesResponse(w http.ResponseWriter) error
-}
+// UpdateExpense operation middleware
+func (siw *ServerInterfaceWrapper) UpdateExpense(w http.ResponseWriter, r *http.Request) {
 
-type ListInvoices200JSONResponse []Invoice
+	var err error
 
-func (response ListInvoices200JSONResponse) VisitListInvoicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListInvoices401JSONResponse Error
+	ctx := r.Context()
 
-func (response ListInvoices401JSONResponse) VisitListInvoicesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateInvoiceRequestObject struct {
-	Body *CreateInvoiceJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateExpense(w, r, id)
+	}))
 
-type CreateInvoiceResponseObject interface {
-	VisitCreateInvoiceResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateInvoice201JSONResponse Invoice
+// ListFingerprintClaims operation middleware
+func (siw *ServerInterfaceWrapper) ListFingerprintClaims(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateInvoice201JSONResponse) VisitCreateInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateInvoice400JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response CreateInvoice400JSONResponse) VisitCreateInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFingerprintClaimsParams
 
-type CreateInvoice401JSONResponse Error
+	// ------------- Required query parameter "domain" -------------
 
-func (response CreateInvoice401JSONResponse) VisitCreateInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	if paramValue := r.URL.Query().Get("domain"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "domain"})
+		return
+	}
 
-type DeleteInvoiceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
-
-type DeleteInvoiceResponseObject interface {
-	VisitDeleteInvoiceResponse(w http.ResponseWriter) error
-}
-
-type DeleteInvoice204Response struct {
-}
-
-func (response DeleteInvoice204Response) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, true, "domain", r.URL.Query(), &params.Domain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		return
+	}
 
-type DeleteInvoice401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFingerprintClaims(w, r, params)
+	}))
 
-func (response DeleteInvoice401JSONResponse) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteInvoice404JSONResponse Error
+// LogFocusSession operation middleware
+func (siw *ServerInterfaceWrapper) LogFocusSession(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteInvoice404JSONResponse) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteInvoice409JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response DeleteInvoice409JSONResponse) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LogFocusSession(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetInvoiceRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetInvoiceResponseObject interface {
-	VisitGetInvoiceResponse(w http.ResponseWriter) error
-}
+// RecordFocusGaps operation middleware
+func (siw *ServerInterfaceWrapper) RecordFocusGaps(w http.ResponseWriter, r *http.Request) {
 
-type GetInvoice200JSONResponse Invoice
+	ctx := r.Context()
 
-func (response GetInvoice200JSONResponse) VisitGetInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetInvoice401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordFocusGaps(w, r)
+	}))
 
-func (response GetInvoice401JSONResponse) VisitGetInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetInvoice404JSONResponse Error
-
-func (response GetInvoice404JSONResponse) VisitGetInvoiceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ListGoals operation middleware
+func (siw *ServerInterfaceWrapper) ListGoals(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ExportInvoiceCSVRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ExportInvoiceCSVResponseObject interface {
-	VisitExportInvoiceCSVResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type ExportInvoiceCSV200TextcsvResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListGoals(w, r)
+	}))
 
-func (response ExportInvoiceCSV200TextcsvResponse) VisitExportInvoiceCSVResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/csv")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	handler.ServeHTTP(w, r)
 }
 
-type ExportInvoiceCSV401JSONResponse Error
+// CreateGoal operation middleware
+func (siw *ServerInterfaceWrapper) CreateGoal(w http.ResponseWriter, r *http.Request) {
 
-func (response ExportInvoiceCSV401JSONResponse) VisitExportInvoiceCSVResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ExportInvoiceCSV404JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ExportInvoiceCSV404JSONResponse) VisitExportInvoiceCSVResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGoal(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ExportInvoiceSheetsRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type ExportInvoiceSheetsResponseObject interface {
-	VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error
-}
+// GetGoalsProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetGoalsProgress(w http.ResponseWriter, r *http.Request) {
 
-type ExportInvoiceSheets200JSONResponse struct {
-	SpreadsheetId  *string `json:"spreadsheet_id,omitempty"`
-	SpreadsheetUrl *string `json:"spreadsheet_url,omitempty"`
-	WorksheetId    *int    `json:"worksheet_id,omitempty"`
-}
+	var err error
 
-func (response ExportInvoiceSheets200JSONResponse) VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ExportInvoiceSheets401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ExportInvoiceSheets401JSONResponse) VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGoalsProgressParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "date" -------------
 
-type ExportInvoiceSheets404JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
 
-func (response ExportInvoiceSheets404JSONResponse) VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGoalsProgress(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateInvoiceStatusRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateInvoiceStatusJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateInvoiceStatusResponseObject interface {
-	VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error
-}
+// DeleteGoal operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGoal(w http.ResponseWriter, r *http.Request) {
 
-type UpdateInvoiceStatus200JSONResponse Invoice
+	var err error
 
-func (response UpdateInvoiceStatus200JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type UpdateInvoiceStatus400JSONResponse Error
+	ctx := r.Context()
 
-func (response UpdateInvoiceStatus400JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type UpdateInvoiceStatus401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGoal(w, r, id)
+	}))
 
-func (response UpdateInvoiceStatus401JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateInvoiceStatus404JSONResponse Error
-
-func (response UpdateInvoiceStatus404JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// UpdateGoal operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGoal(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type ListProjectsRequestObject struct {
-	Params ListProjectsParams
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type ListProjectsResponseObject interface {
-	VisitListProjectsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListProjects200JSONResponse []Project
+	ctx := r.Context()
 
-func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type ListProjects401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateGoal(w, r, id)
+	}))
 
-func (response ListProjects401JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateProjectRequestObject struct {
-	Body *CreateProjectJSONRequestBody
-}
+// ListIntegrations operation middleware
+func (siw *ServerInterfaceWrapper) ListIntegrations(w http.ResponseWriter, r *http.Request) {
 
-type CreateProjectResponseObject interface {
-	VisitCreateProjectResponse(w http.ResponseWriter) error
-}
-
-type CreateProject201JSONResponse Project
+	ctx := r.Context()
 
-func (response CreateProject201JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateProject400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIntegrations(w, r)
+	}))
 
-func (response CreateProject400JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateProject401JSONResponse Error
+// DisconnectGitHub operation middleware
+func (siw *ServerInterfaceWrapper) DisconnectGitHub(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateProject401JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateProject409JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response CreateProject409JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisconnectGitHub(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteProjectRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteProjectResponseObject interface {
-	VisitDeleteProjectResponse(w http.ResponseWriter) error
-}
+// GetGitHubConnection operation middleware
+func (siw *ServerInterfaceWrapper) GetGitHubConnection(w http.ResponseWriter, r *http.Request) {
 
-type DeleteProject204Response struct {
-}
+	ctx := r.Context()
 
-func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteProject401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response DeleteProject401JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGitHubConnection(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteProject404JSONResponse Error
+// ConnectGitHub operation middleware
+func (siw *ServerInterfaceWrapper) ConnectGitHub(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteProject409JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response DeleteProject409JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConnectGitHub(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetProjectRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetProjectResponseObject interface {
-	VisitGetProjectResponse(w http.ResponseWriter) error
-}
+// DisconnectIntegration operation middleware
+func (siw *ServerInterfaceWrapper) DisconnectIntegration(w http.ResponseWriter, r *http.Request) {
 
-type GetProject200JSONResponse Project
+	var err error
 
-func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "provider" -------------
+	var provider string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
 
-type GetProject401JSONResponse Error
+	ctx := r.Context()
 
-func (response GetProject401JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type GetProject404JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisconnectIntegration(w, r, provider)
+	}))
 
-func (response GetProject404JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateProjectRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateProjectJSONRequestBody
-}
+// ConnectIntegration operation middleware
+func (siw *ServerInterfaceWrapper) ConnectIntegration(w http.ResponseWriter, r *http.Request) {
 
-type UpdateProjectResponseObject interface {
-	VisitUpdateProjectResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type UpdateProject200JSONResponse Project
+	// ------------- Path parameter "provider" -------------
+	var provider string
 
-func (response UpdateProject200JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", chi.URLParam(r, "provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type UpdateProject400JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response UpdateProject400JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConnectIntegration(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateProject401JSONResponse Error
+// DownloadInvoiceExport operation middleware
+func (siw *ServerInterfaceWrapper) DownloadInvoiceExport(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateProject401JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type UpdateProject404JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response UpdateProject404JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateProject409JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response UpdateProject409JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadInvoiceExport(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListRulesRequestObject struct {
-	Params ListRulesParams
+	handler.ServeHTTP(w, r)
 }
 
-type ListRulesResponseObject interface {
-	VisitListRulesResponse(w http.ResponseWriter) error
-}
+// ListInvoices operation middleware
+func (siw *ServerInterfaceWrapper) ListInvoices(w http.ResponseWriter, r *http.Request) {
 
-type ListRules200JSONResponse []ClassificationRule
+	var err error
 
-func (response ListRules200JSONResponse) VisitListRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListRules401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response ListRules401JSONResponse) VisitListRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInvoicesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "project_id" -------------
 
-type CreateRuleRequestObject struct {
-	Body *CreateRuleJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
 
-type CreateRuleResponseObject interface {
-	VisitCreateRuleResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "status" -------------
 
-type CreateRule201JSONResponse ClassificationRule
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-func (response CreateRule201JSONResponse) VisitCreateRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInvoices(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateRule400JSONResponse Error
+// CreateInvoice operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvoice(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateRule400JSONResponse) VisitCreateRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateRule401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response CreateRule401JSONResponse) VisitCreateRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInvoice(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ApplyRulesRequestObject struct {
-	Body *ApplyRulesJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type ApplyRulesResponseObject interface {
-	VisitApplyRulesResponse(w http.ResponseWriter) error
-}
+// DeleteInvoice operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInvoice(w http.ResponseWriter, r *http.Request) {
 
-type ApplyRules200JSONResponse ApplyRulesResponse
+	var err error
 
-func (response ApplyRules200JSONResponse) VisitApplyRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ApplyRules401JSONResponse Error
+	ctx := r.Context()
 
-func (response ApplyRules401JSONResponse) VisitApplyRulesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PreviewRuleRequestObject struct {
-	Body *PreviewRuleJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteInvoice(w, r, id)
+	}))
 
-type PreviewRuleResponseObject interface {
-	VisitPreviewRuleResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PreviewRule200JSONResponse RulePreviewResponse
+// GetInvoice operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoice(w http.ResponseWriter, r *http.Request) {
 
-func (response PreviewRule200JSONResponse) VisitPreviewRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type PreviewRule400JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response PreviewRule400JSONResponse) VisitPreviewRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PreviewRule401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response PreviewRule401JSONResponse) VisitPreviewRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInvoice(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteRuleRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteRuleResponseObject interface {
-	VisitDeleteRuleResponse(w http.ResponseWriter) error
-}
+// AddInvoiceAdjustment operation middleware
+func (siw *ServerInterfaceWrapper) AddInvoiceAdjustment(w http.ResponseWriter, r *http.Request) {
 
-type DeleteRule204Response struct {
-}
+	var err error
 
-func (response DeleteRule204Response) VisitDeleteRuleResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type DeleteRule401JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response DeleteRule401JSONResponse) VisitDeleteRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteRule404JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response DeleteRule404JSONResponse) VisitDeleteRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddInvoiceAdjustment(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetRuleRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetRuleResponseObject interface {
-	VisitGetRuleResponse(w http.ResponseWriter) error
-}
+// DeleteInvoiceAdjustment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInvoiceAdjustment(w http.ResponseWriter, r *http.Request) {
 
-type GetRule200JSONResponse ClassificationRule
+	var err error
 
-func (response GetRule200JSONResponse) VisitGetRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetRule401JSONResponse Error
+	// ------------- Path parameter "lineItemId" -------------
+	var lineItemId openapi_types.UUID
 
-func (response GetRule401JSONResponse) VisitGetRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindStyledParameterWithOptions("simple", "lineItemId", chi.URLParam(r, "lineItemId"), &lineItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lineItemId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetRule404JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetRule404JSONResponse) VisitGetRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteInvoiceAdjustment(w, r, id, lineItemId)
+	}))
 
-type UpdateRuleRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateRuleJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateRuleResponseObject interface {
-	VisitUpdateRuleResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateRule200JSONResponse ClassificationRule
+// ListInvoiceComments operation middleware
+func (siw *ServerInterfaceWrapper) ListInvoiceComments(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateRule200JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type UpdateRule400JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response UpdateRule400JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateRule401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response UpdateRule401JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInvoiceComments(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateRule404JSONResponse Error
+// AddInvoiceComment operation middleware
+func (siw *ServerInterfaceWrapper) AddInvoiceComment(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateRule404JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type ListTimeEntriesRequestObject struct {
-	Params ListTimeEntriesParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListTimeEntriesResponseObject interface {
-	VisitListTimeEntriesResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type ListTimeEntries200JSONResponse []TimeEntry
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListTimeEntries200JSONResponse) VisitListTimeEntriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddInvoiceComment(w, r, id)
+	}))
 
-type ListTimeEntries401JSONResponse Error
-
-func (response ListTimeEntries401JSONResponse) VisitListTimeEntriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateTimeEntryRequestObject struct {
-	Body *CreateTimeEntryJSONRequestBody
-}
+// DeleteInvoiceComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteInvoiceComment(w http.ResponseWriter, r *http.Request) {
 
-type CreateTimeEntryResponseObject interface {
-	VisitCreateTimeEntryResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateTimeEntry201JSONResponse TimeEntry
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response CreateTimeEntry201JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
 
-type CreateTimeEntry400JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
 
-func (response CreateTimeEntry400JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateTimeEntry401JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response CreateTimeEntry401JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteInvoiceComment(w, r, id, commentId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateTimeEntry404JSONResponse Error
+// CreateCreditNote operation middleware
+func (siw *ServerInterfaceWrapper) CreateCreditNote(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateTimeEntry404JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-type DeleteTimeEntryRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type DeleteTimeEntryResponseObject interface {
-	VisitDeleteTimeEntryResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type DeleteTimeEntry204Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteTimeEntry204Response) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type DeleteTimeEntry401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCreditNote(w, r, id)
+	}))
 
-func (response DeleteTimeEntry401JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteTimeEntry404JSONResponse Error
-
-func (response DeleteTimeEntry404JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ExportInvoice operation middleware
+func (siw *ServerInterfaceWrapper) ExportInvoice(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type DeleteTimeEntry409JSONResponse Error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response DeleteTimeEntry409JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetTimeEntryRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetTimeEntryResponseObject interface {
-	VisitGetTimeEntryResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetTimeEntry200JSONResponse TimeEntry
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportInvoiceParams
 
-func (response GetTimeEntry200JSONResponse) VisitGetTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Required query parameter "format" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
 
-type GetTimeEntry401JSONResponse Error
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
 
-func (response GetTimeEntry401JSONResponse) VisitGetTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "include_notes" -------------
 
-type GetTimeEntry404JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "include_notes", r.URL.Query(), &params.IncludeNotes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_notes", Err: err})
+		return
+	}
 
-func (response GetTimeEntry404JSONResponse) VisitGetTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportInvoice(w, r, id, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateTimeEntryRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *UpdateTimeEntryJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateTimeEntryResponseObject interface {
-	VisitUpdateTimeEntryResponse(w http.ResponseWriter) error
-}
+// ExportInvoiceCSV operation middleware
+func (siw *ServerInterfaceWrapper) ExportInvoiceCSV(w http.ResponseWriter, r *http.Request) {
 
-type UpdateTimeEntry200JSONResponse TimeEntry
+	var err error
 
-func (response UpdateTimeEntry200JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type UpdateTimeEntry400JSONResponse Error
+	ctx := r.Context()
 
-func (response UpdateTimeEntry400JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type UpdateTimeEntry401JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportInvoiceCSV(w, r, id)
+	}))
 
-func (response UpdateTimeEntry401JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateTimeEntry404JSONResponse Error
-
-func (response UpdateTimeEntry404JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ExportInvoiceSheets operation middleware
+func (siw *ServerInterfaceWrapper) ExportInvoiceSheets(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type UpdateTimeEntry409JSONResponse Error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response UpdateTimeEntry409JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type RefreshTimeEntryRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RefreshTimeEntryResponseObject interface {
-	VisitRefreshTimeEntryResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type RefreshTimeEntry200JSONResponse TimeEntry
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportInvoiceSheets(w, r, id)
+	}))
 
-func (response RefreshTimeEntry200JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type RefreshTimeEntry400JSONResponse Error
-
-func (response RefreshTimeEntry400JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ListInvoiceExports operation middleware
+func (siw *ServerInterfaceWrapper) ListInvoiceExports(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type RefreshTimeEntry401JSONResponse Error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-func (response RefreshTimeEntry401JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInvoiceExports(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListInvoicePayments operation middleware
+func (siw *ServerInterfaceWrapper) ListInvoicePayments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInvoicePayments(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RecordInvoicePayment operation middleware
+func (siw *ServerInterfaceWrapper) RecordInvoicePayment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordInvoicePayment(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateInvoiceStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInvoiceStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateInvoiceStatus(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganization(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationDomain operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationDomain(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "domain" -------------
+	var domain string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "domain", chi.URLParam(r, "domain"), &domain, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationDomain(w, r, id, domain)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutOrganizationDomain operation middleware
+func (siw *ServerInterfaceWrapper) PutOrganizationDomain(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "domain" -------------
+	var domain string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "domain", chi.URLParam(r, "domain"), &domain, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutOrganizationDomain(w, r, id, domain)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyOrganizationDomain operation middleware
+func (siw *ServerInterfaceWrapper) VerifyOrganizationDomain(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "domain" -------------
+	var domain string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "domain", chi.URLParam(r, "domain"), &domain, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyOrganizationDomain(w, r, id, domain)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationGoogleDelegation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationGoogleDelegation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationGoogleDelegation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProvisionOrganizationCalendars operation middleware
+func (siw *ServerInterfaceWrapper) ProvisionOrganizationCalendars(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProvisionOrganizationCalendars(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationMembers(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationMemberDelegation operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationMemberDelegation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", chi.URLParam(r, "user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationMemberDelegation(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransferOrganizationProject operation middleware
+func (siw *ServerInterfaceWrapper) TransferOrganizationProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransferOrganizationProject(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateOrganizationScimToken operation middleware
+func (siw *ServerInterfaceWrapper) RotateOrganizationScimToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateOrganizationScimToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationSso operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationSso(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationSso(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePayment operation middleware
+func (siw *ServerInterfaceWrapper) DeletePayment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePayment(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectsParams
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", r.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "archived", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "client" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "client", r.URL.Query(), &params.Client)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "client", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "billable" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "billable", r.URL.Query(), &params.Billable)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "billable", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjects(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProject(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProject(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProject(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProject operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProject(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddProjectFingerprint operation middleware
+func (siw *ServerInterfaceWrapper) AddProjectFingerprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddProjectFingerprint(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveProjectFingerprint operation middleware
+func (siw *ServerInterfaceWrapper) RemoveProjectFingerprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind FingerprintKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", chi.URLParam(r, "kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "value" -------------
+	var value string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "value", chi.URLParam(r, "value"), &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "value", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveProjectFingerprint(w, r, id, kind, value)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMeetingCostReport operation middleware
+func (siw *ServerInterfaceWrapper) GetMeetingCostReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeetingCostReportParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "default_rate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "default_rate", r.URL.Query(), &params.DefaultRate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "default_rate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rate", r.URL.Query(), &params.Rate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rate", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMeetingCostReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMeetingLoadReport operation middleware
+func (siw *ServerInterfaceWrapper) GetMeetingLoadReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeetingLoadReportParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "day_start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "day_start", r.URL.Query(), &params.DayStart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "day_start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_focus_minutes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_focus_minutes", r.URL.Query(), &params.MinFocusMinutes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_focus_minutes", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMeetingLoadReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUtilizationReport operation middleware
+func (siw *ServerInterfaceWrapper) GetUtilizationReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUtilizationReportParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUtilizationReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportRulePack operation middleware
+func (siw *ServerInterfaceWrapper) ExportRulePack(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportRulePack(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportRulePack operation middleware
+func (siw *ServerInterfaceWrapper) ImportRulePack(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportRulePack(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRules operation middleware
+func (siw *ServerInterfaceWrapper) ListRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRulesParams
+
+	// ------------- Optional query parameter "include_disabled" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_disabled", r.URL.Query(), &params.IncludeDisabled)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_disabled", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "client" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "client", r.URL.Query(), &params.Client)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "client", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyRules operation middleware
+func (siw *ServerInterfaceWrapper) ApplyRules(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRuleConflicts operation middleware
+func (siw *ServerInterfaceWrapper) GetRuleConflicts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRuleConflictsParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_overlap" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_overlap", r.URL.Query(), &params.MinOverlap)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_overlap", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRuleConflicts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LintRule operation middleware
+func (siw *ServerInterfaceWrapper) LintRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LintRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PreviewRule operation middleware
+func (siw *ServerInterfaceWrapper) PreviewRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TestRule operation middleware
+func (siw *ServerInterfaceWrapper) TestRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRule operation middleware
+func (siw *ServerInterfaceWrapper) GetRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClassificationSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) ListClassificationSnapshots(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClassificationSnapshotsParams
+
+	// ------------- Optional query parameter "week_start" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "week_start", r.URL.Query(), &params.WeekStart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "week_start", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClassificationSnapshots(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateClassificationSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) CreateClassificationSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateClassificationSnapshot(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteClassificationSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClassificationSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteClassificationSnapshot(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreClassificationSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) RestoreClassificationSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreClassificationSnapshot(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSuppressionRules operation middleware
+func (siw *ServerInterfaceWrapper) ListSuppressionRules(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSuppressionRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSuppressionRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateSuppressionRule(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSuppressionRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSuppressionRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSuppressionRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSuppressionRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSuppressionRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSuppressionRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSuppressionRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTag(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTag(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RenameTag operation middleware
+func (siw *ServerInterfaceWrapper) RenameTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RenameTag(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListTimeEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTimeEntriesParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTimeEntries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTimeEntry(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SuggestDescriptions operation middleware
+func (siw *ServerInterfaceWrapper) SuggestDescriptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SuggestDescriptionsParams
+
+	// ------------- Required query parameter "date" -------------
+
+	if paramValue := r.URL.Query().Get("date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuggestDescriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ParseTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) ParseTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ParseTimeEntryParams
+
+	// ------------- Optional query parameter "commit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "commit", r.URL.Query(), &params.Commit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ParseTimeEntry(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RecalculateTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) RecalculateTimeEntries(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecalculateTimeEntries(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRecalculationJob operation middleware
+func (siw *ServerInterfaceWrapper) GetRecalculationJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecalculationJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReconciliationReport operation middleware
+func (siw *ServerInterfaceWrapper) GetReconciliationReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReconciliationReportParams
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_acknowledged" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_acknowledged", r.URL.Query(), &params.IncludeAcknowledged)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_acknowledged", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReconciliationReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTimeEntryBreakdown operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeEntryBreakdown(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimeEntryBreakdown(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExplainTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) ExplainTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExplainTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTimeEntryNotes operation middleware
+func (siw *ServerInterfaceWrapper) ListTimeEntryNotes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTimeEntryNotes(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTimeEntryNote operation middleware
+func (siw *ServerInterfaceWrapper) AddTimeEntryNote(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTimeEntryNote(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTimeEntryNote operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeEntryNote(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "noteId" -------------
+	var noteId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "noteId", chi.URLParam(r, "noteId"), &noteId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "noteId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTimeEntryNote(w, r, id, noteId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReconcileTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) ReconcileTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReconcileTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RefreshTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) RefreshTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SplitTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) SplitTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SplitTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTimeEntryTags operation middleware
+func (siw *ServerInterfaceWrapper) SetTimeEntryTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTimeEntryTags(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTrash operation middleware
+func (siw *ServerInterfaceWrapper) ListTrash(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTrash(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreRule operation middleware
+func (siw *ServerInterfaceWrapper) RestoreRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreRule(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreTimeEntry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWeekGrid operation middleware
+func (siw *ServerInterfaceWrapper) GetWeekGrid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "start" -------------
+	var start openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "start", chi.URLParam(r, "start"), &start, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWeekGrid(w, r, start)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWorkingHours(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkingHours(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWorkingHours(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/actions", wrapper.ListClassificationActions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/actions/{id}/undo", wrapper.UndoClassificationAction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/anomalies", wrapper.ListAnomalies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/anomalies/analyze", wrapper.AnalyzeAnomalies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/anomalies/{id}/dismiss", wrapper.DismissAnomaly)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/api-keys", wrapper.ListApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/api-keys", wrapper.CreateApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/api-keys/{id}", wrapper.DeleteApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/api-keys/{id}", wrapper.UpdateApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/attendee-aliases", wrapper.ListAttendeeAliases)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/attendee-aliases", wrapper.CreateAttendeeAlias)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/attendee-aliases/{id}", wrapper.DeleteAttendeeAlias)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/company-domains", wrapper.GetCompanyDomains)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/auth/company-domains", wrapper.UpdateCompanyDomains)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/google/authorize", wrapper.GoogleAuthorize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/google/callback", wrapper.GoogleCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/locale", wrapper.GetLocale)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/auth/locale", wrapper.UpdateLocale)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/login", wrapper.Login)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/logout", wrapper.Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/me", wrapper.GetCurrentUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/retention-settings", wrapper.GetRetentionSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/auth/retention-settings", wrapper.UpdateRetentionSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/retention-settings/preview", wrapper.PreviewRetention)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/signup", wrapper.Signup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/sso/start", wrapper.StartSso)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/sync-settings", wrapper.GetSyncSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/auth/sync-settings", wrapper.UpdateSyncSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/billing-periods", wrapper.ListBillingPeriods)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/billing-periods", wrapper.CreateBillingPeriod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/billing-periods/{id}", wrapper.DeleteBillingPeriod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/billing-periods/{id}", wrapper.UpdateBillingPeriod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/billing/balances", wrapper.ListClientBalances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendar-events", wrapper.ListCalendarEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/calendar-events/bulk-classify", wrapper.BulkClassifyEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendar-events/diff", wrapper.DiffCalendarEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendar-events/review-queue", wrapper.GetReviewQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendar-events/{id}/changes", wrapper.ListEventChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/calendar-events/{id}/classify", wrapper.ClassifyCalendarEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendar-events/{id}/explain", wrapper.ExplainEventClassification)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendar-events/{id}/history", wrapper.ListEventClassificationHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/calendar-events/{id}/review", wrapper.ReviewCalendarEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/calendar-events/{id}/suppression", wrapper.SetEventSuppression)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/calendar-events/{id}/tags", wrapper.SetEventTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendars", wrapper.ListCalendarConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendars/needs-reauth", wrapper.ListConnectionsNeedingReauth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/calendars/{id}", wrapper.DeleteCalendarConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/calendars/{id}/pause", wrapper.PauseCalendarConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/calendars/{id}/reauthorize", wrapper.ReauthorizeCalendarConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/calendars/{id}/resume", wrapper.ResumeCalendarConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/calendars/{id}/sources", wrapper.ListCalendarSources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/calendars/{id}/sources", wrapper.UpdateCalendarSources)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/calendars/{id}/sources/{calendar_id}", wrapper.UpdateCalendarSource)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/calendars/{id}/sync", wrapper.SyncCalendar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/calendars/{id}/sync-window", wrapper.UpdateCalendarSyncWindow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/calendars/{id}/write-back", wrapper.UpdateCalendarWriteBack)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/changes", wrapper.ListChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/classification/apply-rules:async", wrapper.ApplyRulesAsync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/classification/jobs/{id}", wrapper.GetClassificationJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/client-rates", wrapper.ListClientRates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/client-rates", wrapper.CreateClientRate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/client-rates/{id}", wrapper.DeleteClientRate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/client-rates/{id}", wrapper.UpdateClientRate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/config/export", wrapper.ExportConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/config/import", wrapper.ImportConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/day/{date}", wrapper.GetDaySummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/expenses", wrapper.ListExpenses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/expenses", wrapper.CreateExpense)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/expenses/{id}", wrapper.DeleteExpense)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/expenses/{id}", wrapper.GetExpense)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/expenses/{id}", wrapper.UpdateExpense)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/fingerprints/claims", wrapper.ListFingerprintClaims)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/focus-sessions", wrapper.LogFocusSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/focus-sessions/from-gaps", wrapper.RecordFocusGaps)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/goals", wrapper.ListGoals)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/goals", wrapper.CreateGoal)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/goals/progress", wrapper.GetGoalsProgress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/goals/{id}", wrapper.DeleteGoal)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/goals/{id}", wrapper.UpdateGoal)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/integrations", wrapper.ListIntegrations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/integrations/github", wrapper.DisconnectGitHub)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/integrations/github", wrapper.GetGitHubConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/integrations/github", wrapper.ConnectGitHub)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/integrations/{provider}", wrapper.DisconnectIntegration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/integrations/{provider}", wrapper.ConnectIntegration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invoice-exports/{id}/download", wrapper.DownloadInvoiceExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invoices", wrapper.ListInvoices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invoices", wrapper.CreateInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/invoices/{id}", wrapper.DeleteInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invoices/{id}", wrapper.GetInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invoices/{id}/adjustments", wrapper.AddInvoiceAdjustment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/invoices/{id}/adjustments/{lineItemId}", wrapper.DeleteInvoiceAdjustment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invoices/{id}/comments", wrapper.ListInvoiceComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invoices/{id}/comments", wrapper.AddInvoiceComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/invoices/{id}/comments/{commentId}", wrapper.DeleteInvoiceComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invoices/{id}/credit-notes", wrapper.CreateCreditNote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invoices/{id}/export", wrapper.ExportInvoice)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invoices/{id}/export/csv", wrapper.ExportInvoiceCSV)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invoices/{id}/export/sheets", wrapper.ExportInvoiceSheets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invoices/{id}/exports", wrapper.ListInvoiceExports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/invoices/{id}/payments", wrapper.ListInvoicePayments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/invoices/{id}/payments", wrapper.RecordInvoicePayment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/invoices/{id}/status", wrapper.UpdateInvoiceStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/organizations", wrapper.ListOrganizations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/organizations", wrapper.CreateOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/organizations/{id}", wrapper.GetOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/organizations/{id}/domains/{domain}", wrapper.DeleteOrganizationDomain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/organizations/{id}/domains/{domain}", wrapper.PutOrganizationDomain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/organizations/{id}/domains/{domain}/verify", wrapper.VerifyOrganizationDomain)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/organizations/{id}/google-delegation", wrapper.UpdateOrganizationGoogleDelegation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/organizations/{id}/google-delegation/provision", wrapper.ProvisionOrganizationCalendars)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/organizations/{id}/members", wrapper.ListOrganizationMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/organizations/{id}/members/{user_id}/delegation", wrapper.UpdateOrganizationMemberDelegation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/organizations/{id}/project-transfers", wrapper.TransferOrganizationProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/organizations/{id}/scim-token", wrapper.RotateOrganizationScimToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/organizations/{id}/sso", wrapper.UpdateOrganizationSso)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/payments/{id}", wrapper.DeletePayment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/projects", wrapper.ListProjects)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/projects", wrapper.CreateProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/projects/{id}", wrapper.DeleteProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/projects/{id}", wrapper.GetProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/projects/{id}", wrapper.UpdateProject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/projects/{id}/fingerprints", wrapper.AddProjectFingerprint)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/projects/{id}/fingerprints/{kind}/{value}", wrapper.RemoveProjectFingerprint)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/reports/meeting-cost", wrapper.GetMeetingCostReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/reports/meeting-load", wrapper.GetMeetingLoadReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/reports/utilization", wrapper.GetUtilizationReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/rule-packs/export", wrapper.ExportRulePack)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/rule-packs/import", wrapper.ImportRulePack)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/rules", wrapper.ListRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/rules", wrapper.CreateRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/rules/apply", wrapper.ApplyRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/rules/conflicts", wrapper.GetRuleConflicts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/rules/lint", wrapper.LintRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/rules/preview", wrapper.PreviewRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/rules/test", wrapper.TestRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/rules/{id}", wrapper.DeleteRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/rules/{id}", wrapper.GetRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/rules/{id}", wrapper.UpdateRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/snapshots", wrapper.ListClassificationSnapshots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/snapshots", wrapper.CreateClassificationSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/snapshots/{id}", wrapper.DeleteClassificationSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/snapshots/{id}/restore", wrapper.RestoreClassificationSnapshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/suppression-rules", wrapper.ListSuppressionRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/suppression-rules", wrapper.CreateSuppressionRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/suppression-rules/{id}", wrapper.DeleteSuppressionRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/suppression-rules/{id}", wrapper.UpdateSuppressionRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/tags", wrapper.ListTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/tags", wrapper.CreateTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/tags/{id}", wrapper.DeleteTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/tags/{id}", wrapper.RenameTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries", wrapper.ListTimeEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/time-entries", wrapper.CreateTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries/description-suggestions", wrapper.SuggestDescriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/time-entries/parse", wrapper.ParseTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/time-entries/recalculate", wrapper.RecalculateTimeEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries/recalculate/jobs/{id}", wrapper.GetRecalculationJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries/reconciliation", wrapper.GetReconciliationReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/time-entries/{id}", wrapper.DeleteTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries/{id}", wrapper.GetTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/time-entries/{id}", wrapper.UpdateTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries/{id}/breakdown", wrapper.GetTimeEntryBreakdown)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries/{id}/explain", wrapper.ExplainTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/time-entries/{id}/notes", wrapper.ListTimeEntryNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/time-entries/{id}/notes", wrapper.AddTimeEntryNote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/time-entries/{id}/notes/{noteId}", wrapper.DeleteTimeEntryNote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/time-entries/{id}/reconcile", wrapper.ReconcileTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/time-entries/{id}/refresh", wrapper.RefreshTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/time-entries/{id}/split", wrapper.SplitTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/time-entries/{id}/tags", wrapper.SetTimeEntryTags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/trash", wrapper.ListTrash)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/trash/rules/{id}/restore", wrapper.RestoreRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/trash/time-entries/{id}/restore", wrapper.RestoreTimeEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/weeks/{start}", wrapper.GetWeekGrid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/working-hours", wrapper.GetWorkingHours)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/working-hours", wrapper.UpdateWorkingHours)
+	})
+
+	return r
+}
+
+type ListClassificationActionsRequestObject struct {
+	Params ListClassificationActionsParams
+}
+
+type ListClassificationActionsResponseObject interface {
+	VisitListClassificationActionsResponse(w http.ResponseWriter) error
+}
+
+type ListClassificationActions200JSONResponse []ClassificationAction
+
+func (response ListClassificationActions200JSONResponse) VisitListClassificationActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClassificationActions401JSONResponse Error
+
+func (response ListClassificationActions401JSONResponse) VisitListClassificationActionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UndoClassificationActionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type UndoClassificationActionResponseObject interface {
+	VisitUndoClassificationActionResponse(w http.ResponseWriter) error
+}
+
+type UndoClassificationAction200JSONResponse UndoActionResponse
+
+func (response UndoClassificationAction200JSONResponse) VisitUndoClassificationActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UndoClassificationAction401JSONResponse Error
+
+func (response UndoClassificationAction401JSONResponse) VisitUndoClassificationActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UndoClassificationAction404JSONResponse Error
+
+func (response UndoClassificationAction404JSONResponse) VisitUndoClassificationActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UndoClassificationAction409JSONResponse Error
+
+func (response UndoClassificationAction409JSONResponse) VisitUndoClassificationActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAnomaliesRequestObject struct {
+	Params ListAnomaliesParams
+}
+
+type ListAnomaliesResponseObject interface {
+	VisitListAnomaliesResponse(w http.ResponseWriter) error
+}
+
+type ListAnomalies200JSONResponse []DayAnomaly
+
+func (response ListAnomalies200JSONResponse) VisitListAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAnomalies401JSONResponse Error
+
+func (response ListAnomalies401JSONResponse) VisitListAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeAnomaliesRequestObject struct {
+	Body *AnalyzeAnomaliesJSONRequestBody
+}
+
+type AnalyzeAnomaliesResponseObject interface {
+	VisitAnalyzeAnomaliesResponse(w http.ResponseWriter) error
+}
+
+type AnalyzeAnomalies200JSONResponse []DayAnomaly
+
+func (response AnalyzeAnomalies200JSONResponse) VisitAnalyzeAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeAnomalies400JSONResponse Error
+
+func (response AnalyzeAnomalies400JSONResponse) VisitAnalyzeAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeAnomalies401JSONResponse Error
+
+func (response AnalyzeAnomalies401JSONResponse) VisitAnalyzeAnomaliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DismissAnomalyRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DismissAnomalyResponseObject interface {
+	VisitDismissAnomalyResponse(w http.ResponseWriter) error
+}
+
+type DismissAnomaly200JSONResponse DayAnomaly
+
+func (response DismissAnomaly200JSONResponse) VisitDismissAnomalyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DismissAnomaly401JSONResponse Error
+
+func (response DismissAnomaly401JSONResponse) VisitDismissAnomalyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DismissAnomaly404JSONResponse Error
+
+func (response DismissAnomaly404JSONResponse) VisitDismissAnomalyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeysRequestObject struct {
+}
+
+type ListApiKeysResponseObject interface {
+	VisitListApiKeysResponse(w http.ResponseWriter) error
+}
+
+type ListApiKeys200JSONResponse []ApiKey
+
+func (response ListApiKeys200JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListApiKeys401JSONResponse Error
+
+func (response ListApiKeys401JSONResponse) VisitListApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKeyRequestObject struct {
+	Body *CreateApiKeyJSONRequestBody
+}
+
+type CreateApiKeyResponseObject interface {
+	VisitCreateApiKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateApiKey201JSONResponse ApiKeyWithSecret
+
+func (response CreateApiKey201JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey400JSONResponse Error
+
+func (response CreateApiKey400JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey401JSONResponse Error
+
+func (response CreateApiKey401JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApiKey409JSONResponse Error
+
+func (response CreateApiKey409JSONResponse) VisitCreateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKeyRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteApiKeyResponseObject interface {
+	VisitDeleteApiKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiKey204Response struct {
+}
+
+func (response DeleteApiKey204Response) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiKey401JSONResponse Error
+
+func (response DeleteApiKey401JSONResponse) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKey404JSONResponse Error
+
+func (response DeleteApiKey404JSONResponse) VisitDeleteApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateApiKeyRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateApiKeyJSONRequestBody
+}
+
+type UpdateApiKeyResponseObject interface {
+	VisitUpdateApiKeyResponse(w http.ResponseWriter) error
+}
+
+type UpdateApiKey200JSONResponse ApiKey
+
+func (response UpdateApiKey200JSONResponse) VisitUpdateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateApiKey400JSONResponse Error
+
+func (response UpdateApiKey400JSONResponse) VisitUpdateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateApiKey401JSONResponse Error
+
+func (response UpdateApiKey401JSONResponse) VisitUpdateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateApiKey404JSONResponse Error
+
+func (response UpdateApiKey404JSONResponse) VisitUpdateApiKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAttendeeAliasesRequestObject struct {
+}
+
+type ListAttendeeAliasesResponseObject interface {
+	VisitListAttendeeAliasesResponse(w http.ResponseWriter) error
+}
+
+type ListAttendeeAliases200JSONResponse []AttendeeAlias
+
+func (response ListAttendeeAliases200JSONResponse) VisitListAttendeeAliasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAttendeeAliases401JSONResponse Error
+
+func (response ListAttendeeAliases401JSONResponse) VisitListAttendeeAliasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAttendeeAliasRequestObject struct {
+	Body *CreateAttendeeAliasJSONRequestBody
+}
+
+type CreateAttendeeAliasResponseObject interface {
+	VisitCreateAttendeeAliasResponse(w http.ResponseWriter) error
+}
+
+type CreateAttendeeAlias201JSONResponse AttendeeAlias
+
+func (response CreateAttendeeAlias201JSONResponse) VisitCreateAttendeeAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAttendeeAlias400JSONResponse Error
+
+func (response CreateAttendeeAlias400JSONResponse) VisitCreateAttendeeAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAttendeeAlias401JSONResponse Error
+
+func (response CreateAttendeeAlias401JSONResponse) VisitCreateAttendeeAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAttendeeAlias409JSONResponse Error
+
+func (response CreateAttendeeAlias409JSONResponse) VisitCreateAttendeeAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAttendeeAliasRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteAttendeeAliasResponseObject interface {
+	VisitDeleteAttendeeAliasResponse(w http.ResponseWriter) error
+}
+
+type DeleteAttendeeAlias204Response struct {
+}
+
+func (response DeleteAttendeeAlias204Response) VisitDeleteAttendeeAliasResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAttendeeAlias401JSONResponse Error
+
+func (response DeleteAttendeeAlias401JSONResponse) VisitDeleteAttendeeAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAttendeeAlias404JSONResponse Error
+
+func (response DeleteAttendeeAlias404JSONResponse) VisitDeleteAttendeeAliasResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyDomainsRequestObject struct {
+}
+
+type GetCompanyDomainsResponseObject interface {
+	VisitGetCompanyDomainsResponse(w http.ResponseWriter) error
+}
+
+type GetCompanyDomains200JSONResponse CompanyDomains
+
+func (response GetCompanyDomains200JSONResponse) VisitGetCompanyDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCompanyDomains401JSONResponse Error
+
+func (response GetCompanyDomains401JSONResponse) VisitGetCompanyDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCompanyDomainsRequestObject struct {
+	Body *UpdateCompanyDomainsJSONRequestBody
+}
+
+type UpdateCompanyDomainsResponseObject interface {
+	VisitUpdateCompanyDomainsResponse(w http.ResponseWriter) error
+}
+
+type UpdateCompanyDomains200JSONResponse CompanyDomains
+
+func (response UpdateCompanyDomains200JSONResponse) VisitUpdateCompanyDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCompanyDomains400JSONResponse Error
+
+func (response UpdateCompanyDomains400JSONResponse) VisitUpdateCompanyDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCompanyDomains401JSONResponse Error
+
+func (response UpdateCompanyDomains401JSONResponse) VisitUpdateCompanyDomainsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GoogleAuthorizeRequestObject struct {
+	Params GoogleAuthorizeParams
+}
+
+type GoogleAuthorizeResponseObject interface {
+	VisitGoogleAuthorizeResponse(w http.ResponseWriter) error
+}
+
+type GoogleAuthorize200JSONResponse OAuthAuthorizeResponse
+
+func (response GoogleAuthorize200JSONResponse) VisitGoogleAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GoogleAuthorize400JSONResponse Error
+
+func (response GoogleAuthorize400JSONResponse) VisitGoogleAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GoogleAuthorize401JSONResponse Error
+
+func (response GoogleAuthorize401JSONResponse) VisitGoogleAuthorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GoogleCallbackRequestObject struct {
+	Params GoogleCallbackParams
+}
+
+type GoogleCallbackResponseObject interface {
+	VisitGoogleCallbackResponse(w http.ResponseWriter) error
+}
+
+type GoogleCallback201JSONResponse CalendarConnection
+
+func (response GoogleCallback201JSONResponse) VisitGoogleCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GoogleCallback400JSONResponse Error
+
+func (response GoogleCallback400JSONResponse) VisitGoogleCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GoogleCallback401JSONResponse Error
+
+func (response GoogleCallback401JSONResponse) VisitGoogleCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocaleRequestObject struct {
+}
+
+type GetLocaleResponseObject interface {
+	VisitGetLocaleResponse(w http.ResponseWriter) error
+}
+
+type GetLocale200JSONResponse LocaleSettings
+
+func (response GetLocale200JSONResponse) VisitGetLocaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLocale401JSONResponse Error
+
+func (response GetLocale401JSONResponse) VisitGetLocaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocaleRequestObject struct {
+	Body *UpdateLocaleJSONRequestBody
+}
+
+type UpdateLocaleResponseObject interface {
+	VisitUpdateLocaleResponse(w http.ResponseWriter) error
+}
+
+type UpdateLocale200JSONResponse LocaleSettings
+
+func (response UpdateLocale200JSONResponse) VisitUpdateLocaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocale400JSONResponse Error
+
+func (response UpdateLocale400JSONResponse) VisitUpdateLocaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLocale401JSONResponse Error
+
+func (response UpdateLocale401JSONResponse) VisitUpdateLocaleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse AuthResponse
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login400JSONResponse Error
+
+func (response Login400JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login401JSONResponse Error
+
+func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutRequestObject struct {
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout204Response struct {
+}
+
+func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type Logout401JSONResponse Error
+
+func (response Logout401JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUserRequestObject struct {
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse User
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser401JSONResponse Error
+
+func (response GetCurrentUser401JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRetentionSettingsRequestObject struct {
+}
+
+type GetRetentionSettingsResponseObject interface {
+	VisitGetRetentionSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetRetentionSettings200JSONResponse RetentionSettings
+
+func (response GetRetentionSettings200JSONResponse) VisitGetRetentionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRetentionSettings401JSONResponse Error
+
+func (response GetRetentionSettings401JSONResponse) VisitGetRetentionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRetentionSettingsRequestObject struct {
+	Body *UpdateRetentionSettingsJSONRequestBody
+}
+
+type UpdateRetentionSettingsResponseObject interface {
+	VisitUpdateRetentionSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateRetentionSettings200JSONResponse RetentionSettings
+
+func (response UpdateRetentionSettings200JSONResponse) VisitUpdateRetentionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRetentionSettings400JSONResponse Error
+
+func (response UpdateRetentionSettings400JSONResponse) VisitUpdateRetentionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRetentionSettings401JSONResponse Error
+
+func (response UpdateRetentionSettings401JSONResponse) VisitUpdateRetentionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRetentionRequestObject struct {
+	Params PreviewRetentionParams
+}
+
+type PreviewRetentionResponseObject interface {
+	VisitPreviewRetentionResponse(w http.ResponseWriter) error
+}
+
+type PreviewRetention200JSONResponse RetentionPreview
+
+func (response PreviewRetention200JSONResponse) VisitPreviewRetentionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRetention400JSONResponse Error
+
+func (response PreviewRetention400JSONResponse) VisitPreviewRetentionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRetention401JSONResponse Error
+
+func (response PreviewRetention401JSONResponse) VisitPreviewRetentionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SignupRequestObject struct {
+	Body *SignupJSONRequestBody
+}
+
+type SignupResponseObject interface {
+	VisitSignupResponse(w http.ResponseWriter) error
+}
+
+type Signup201JSONResponse AuthResponse
+
+func (response Signup201JSONResponse) VisitSignupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Signup400JSONResponse Error
+
+func (response Signup400JSONResponse) VisitSignupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Signup409JSONResponse Error
+
+func (response Signup409JSONResponse) VisitSignupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartSsoRequestObject struct {
+	Body *StartSsoJSONRequestBody
+}
+
+type StartSsoResponseObject interface {
+	VisitStartSsoResponse(w http.ResponseWriter) error
+}
+
+type StartSso200JSONResponse SSOStartResponse
+
+func (response StartSso200JSONResponse) VisitStartSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartSso400JSONResponse Error
+
+func (response StartSso400JSONResponse) VisitStartSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartSso404JSONResponse Error
+
+func (response StartSso404JSONResponse) VisitStartSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSyncSettingsRequestObject struct {
+}
+
+type GetSyncSettingsResponseObject interface {
+	VisitGetSyncSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetSyncSettings200JSONResponse SyncSettings
+
+func (response GetSyncSettings200JSONResponse) VisitGetSyncSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSyncSettings401JSONResponse Error
+
+func (response GetSyncSettings401JSONResponse) VisitGetSyncSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSyncSettingsRequestObject struct {
+	Body *UpdateSyncSettingsJSONRequestBody
+}
+
+type UpdateSyncSettingsResponseObject interface {
+	VisitUpdateSyncSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateSyncSettings200JSONResponse SyncSettings
+
+func (response UpdateSyncSettings200JSONResponse) VisitUpdateSyncSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSyncSettings400JSONResponse Error
+
+func (response UpdateSyncSettings400JSONResponse) VisitUpdateSyncSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSyncSettings401JSONResponse Error
+
+func (response UpdateSyncSettings401JSONResponse) VisitUpdateSyncSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBillingPeriodsRequestObject struct {
+	Params ListBillingPeriodsParams
+}
+
+type ListBillingPeriodsResponseObject interface {
+	VisitListBillingPeriodsResponse(w http.ResponseWriter) error
+}
+
+type ListBillingPeriods200JSONResponse []BillingPeriod
+
+func (response ListBillingPeriods200JSONResponse) VisitListBillingPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBillingPeriods400JSONResponse Error
+
+func (response ListBillingPeriods400JSONResponse) VisitListBillingPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBillingPeriods401JSONResponse Error
+
+func (response ListBillingPeriods401JSONResponse) VisitListBillingPeriodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBillingPeriodRequestObject struct {
+	Body *CreateBillingPeriodJSONRequestBody
+}
+
+type CreateBillingPeriodResponseObject interface {
+	VisitCreateBillingPeriodResponse(w http.ResponseWriter) error
+}
+
+type CreateBillingPeriod201JSONResponse BillingPeriod
+
+func (response CreateBillingPeriod201JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBillingPeriod400JSONResponse Error
+
+func (response CreateBillingPeriod400JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBillingPeriod401JSONResponse Error
+
+func (response CreateBillingPeriod401JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBillingPeriod409JSONResponse Error
+
+func (response CreateBillingPeriod409JSONResponse) VisitCreateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBillingPeriodRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteBillingPeriodResponseObject interface {
+	VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error
+}
+
+type DeleteBillingPeriod204Response struct {
+}
+
+func (response DeleteBillingPeriod204Response) VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBillingPeriod401JSONResponse Error
+
+func (response DeleteBillingPeriod401JSONResponse) VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBillingPeriod404JSONResponse Error
+
+func (response DeleteBillingPeriod404JSONResponse) VisitDeleteBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBillingPeriodRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateBillingPeriodJSONRequestBody
+}
+
+type UpdateBillingPeriodResponseObject interface {
+	VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error
+}
+
+type UpdateBillingPeriod200JSONResponse BillingPeriod
+
+func (response UpdateBillingPeriod200JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBillingPeriod400JSONResponse Error
+
+func (response UpdateBillingPeriod400JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBillingPeriod401JSONResponse Error
+
+func (response UpdateBillingPeriod401JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBillingPeriod404JSONResponse Error
+
+func (response UpdateBillingPeriod404JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBillingPeriod409JSONResponse Error
+
+func (response UpdateBillingPeriod409JSONResponse) VisitUpdateBillingPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClientBalancesRequestObject struct {
+	Params ListClientBalancesParams
+}
+
+type ListClientBalancesResponseObject interface {
+	VisitListClientBalancesResponse(w http.ResponseWriter) error
+}
+
+type ListClientBalances200JSONResponse []ClientBalance
+
+func (response ListClientBalances200JSONResponse) VisitListClientBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClientBalances401JSONResponse Error
+
+func (response ListClientBalances401JSONResponse) VisitListClientBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCalendarEventsRequestObject struct {
+	Params ListCalendarEventsParams
+}
+
+type ListCalendarEventsResponseObject interface {
+	VisitListCalendarEventsResponse(w http.ResponseWriter) error
+}
+
+type ListCalendarEvents200JSONResponse []CalendarEvent
+
+func (response ListCalendarEvents200JSONResponse) VisitListCalendarEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCalendarEvents401JSONResponse Error
+
+func (response ListCalendarEvents401JSONResponse) VisitListCalendarEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkClassifyEventsRequestObject struct {
+	Body *BulkClassifyEventsJSONRequestBody
+}
+
+type BulkClassifyEventsResponseObject interface {
+	VisitBulkClassifyEventsResponse(w http.ResponseWriter) error
+}
+
+type BulkClassifyEvents200JSONResponse BulkClassifyResponse
+
+func (response BulkClassifyEvents200JSONResponse) VisitBulkClassifyEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkClassifyEvents400JSONResponse Error
+
+func (response BulkClassifyEvents400JSONResponse) VisitBulkClassifyEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkClassifyEvents401JSONResponse Error
+
+func (response BulkClassifyEvents401JSONResponse) VisitBulkClassifyEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffCalendarEventsRequestObject struct {
+	Params DiffCalendarEventsParams
+}
+
+type DiffCalendarEventsResponseObject interface {
+	VisitDiffCalendarEventsResponse(w http.ResponseWriter) error
+}
+
+type DiffCalendarEvents200JSONResponse CalendarEventDiff
+
+func (response DiffCalendarEvents200JSONResponse) VisitDiffCalendarEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffCalendarEvents400JSONResponse Error
+
+func (response DiffCalendarEvents400JSONResponse) VisitDiffCalendarEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffCalendarEvents401JSONResponse Error
+
+func (response DiffCalendarEvents401JSONResponse) VisitDiffCalendarEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReviewQueueRequestObject struct {
+	Params GetReviewQueueParams
+}
+
+type GetReviewQueueResponseObject interface {
+	VisitGetReviewQueueResponse(w http.ResponseWriter) error
+}
+
+type GetReviewQueue200JSONResponse ReviewQueue
+
+func (response GetReviewQueue200JSONResponse) VisitGetReviewQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReviewQueue400JSONResponse Error
+
+func (response GetReviewQueue400JSONResponse) VisitGetReviewQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReviewQueue401JSONResponse Error
+
+func (response GetReviewQueue401JSONResponse) VisitGetReviewQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventChangesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListEventChangesResponseObject interface {
+	VisitListEventChangesResponse(w http.ResponseWriter) error
+}
+
+type ListEventChanges200JSONResponse []CalendarEventChange
+
+func (response ListEventChanges200JSONResponse) VisitListEventChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventChanges401JSONResponse Error
+
+func (response ListEventChanges401JSONResponse) VisitListEventChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventChanges404JSONResponse Error
+
+func (response ListEventChanges404JSONResponse) VisitListEventChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyCalendarEventRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ClassifyCalendarEventJSONRequestBody
+}
+
+type ClassifyCalendarEventResponseObject interface {
+	VisitClassifyCalendarEventResponse(w http.ResponseWriter) error
+}
+
+type ClassifyCalendarEvent200JSONResponse ClassifyEventResponse
+
+func (response ClassifyCalendarEvent200JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyCalendarEvent400JSONResponse Error
+
+func (response ClassifyCalendarEvent400JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyCalendarEvent401JSONResponse Error
+
+func (response ClassifyCalendarEvent401JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClassifyCalendarEvent404JSONResponse Error
+
+func (response ClassifyCalendarEvent404JSONResponse) VisitClassifyCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExplainEventClassificationRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ExplainEventClassificationResponseObject interface {
+	VisitExplainEventClassificationResponse(w http.ResponseWriter) error
+}
+
+type ExplainEventClassification200JSONResponse ClassificationExplanation
+
+func (response ExplainEventClassification200JSONResponse) VisitExplainEventClassificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExplainEventClassification401JSONResponse Error
+
+func (response ExplainEventClassification401JSONResponse) VisitExplainEventClassificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExplainEventClassification404JSONResponse Error
+
+func (response ExplainEventClassification404JSONResponse) VisitExplainEventClassificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventClassificationHistoryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListEventClassificationHistoryResponseObject interface {
+	VisitListEventClassificationHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListEventClassificationHistory200JSONResponse []EventClassificationHistoryEntry
+
+func (response ListEventClassificationHistory200JSONResponse) VisitListEventClassificationHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventClassificationHistory401JSONResponse Error
+
+func (response ListEventClassificationHistory401JSONResponse) VisitListEventClassificationHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEventClassificationHistory404JSONResponse Error
+
+func (response ListEventClassificationHistory404JSONResponse) VisitListEventClassificationHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewCalendarEventRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ReviewCalendarEventJSONRequestBody
+}
+
+type ReviewCalendarEventResponseObject interface {
+	VisitReviewCalendarEventResponse(w http.ResponseWriter) error
+}
+
+type ReviewCalendarEvent200JSONResponse ReviewResult
+
+func (response ReviewCalendarEvent200JSONResponse) VisitReviewCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewCalendarEvent400JSONResponse Error
+
+func (response ReviewCalendarEvent400JSONResponse) VisitReviewCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewCalendarEvent401JSONResponse Error
+
+func (response ReviewCalendarEvent401JSONResponse) VisitReviewCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewCalendarEvent404JSONResponse Error
+
+func (response ReviewCalendarEvent404JSONResponse) VisitReviewCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReviewCalendarEvent409JSONResponse Error
+
+func (response ReviewCalendarEvent409JSONResponse) VisitReviewCalendarEventResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventSuppressionRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SetEventSuppressionJSONRequestBody
+}
+
+type SetEventSuppressionResponseObject interface {
+	VisitSetEventSuppressionResponse(w http.ResponseWriter) error
+}
+
+type SetEventSuppression200JSONResponse CalendarEvent
+
+func (response SetEventSuppression200JSONResponse) VisitSetEventSuppressionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventSuppression400JSONResponse Error
+
+func (response SetEventSuppression400JSONResponse) VisitSetEventSuppressionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventSuppression401JSONResponse Error
+
+func (response SetEventSuppression401JSONResponse) VisitSetEventSuppressionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventSuppression404JSONResponse Error
+
+func (response SetEventSuppression404JSONResponse) VisitSetEventSuppressionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventTagsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SetEventTagsJSONRequestBody
+}
+
+type SetEventTagsResponseObject interface {
+	VisitSetEventTagsResponse(w http.ResponseWriter) error
+}
+
+type SetEventTags200JSONResponse CalendarEvent
+
+func (response SetEventTags200JSONResponse) VisitSetEventTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventTags400JSONResponse Error
+
+func (response SetEventTags400JSONResponse) VisitSetEventTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventTags401JSONResponse Error
+
+func (response SetEventTags401JSONResponse) VisitSetEventTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetEventTags404JSONResponse Error
+
+func (response SetEventTags404JSONResponse) VisitSetEventTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCalendarConnectionsRequestObject struct {
+}
+
+type ListCalendarConnectionsResponseObject interface {
+	VisitListCalendarConnectionsResponse(w http.ResponseWriter) error
+}
+
+type ListCalendarConnections200JSONResponse []CalendarConnection
+
+func (response ListCalendarConnections200JSONResponse) VisitListCalendarConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCalendarConnections401JSONResponse Error
+
+func (response ListCalendarConnections401JSONResponse) VisitListCalendarConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListConnectionsNeedingReauthRequestObject struct {
+}
+
+type ListConnectionsNeedingReauthResponseObject interface {
+	VisitListConnectionsNeedingReauthResponse(w http.ResponseWriter) error
+}
+
+type ListConnectionsNeedingReauth200JSONResponse []ConnectionReauth
+
+func (response ListConnectionsNeedingReauth200JSONResponse) VisitListConnectionsNeedingReauthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListConnectionsNeedingReauth401JSONResponse Error
+
+func (response ListConnectionsNeedingReauth401JSONResponse) VisitListConnectionsNeedingReauthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCalendarConnectionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteCalendarConnectionResponseObject interface {
+	VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error
+}
+
+type DeleteCalendarConnection204Response struct {
+}
+
+func (response DeleteCalendarConnection204Response) VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCalendarConnection401JSONResponse Error
+
+func (response DeleteCalendarConnection401JSONResponse) VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCalendarConnection404JSONResponse Error
+
+func (response DeleteCalendarConnection404JSONResponse) VisitDeleteCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseCalendarConnectionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type PauseCalendarConnectionResponseObject interface {
+	VisitPauseCalendarConnectionResponse(w http.ResponseWriter) error
+}
+
+type PauseCalendarConnection200JSONResponse CalendarConnection
+
+func (response PauseCalendarConnection200JSONResponse) VisitPauseCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseCalendarConnection401JSONResponse Error
+
+func (response PauseCalendarConnection401JSONResponse) VisitPauseCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseCalendarConnection404JSONResponse Error
+
+func (response PauseCalendarConnection404JSONResponse) VisitPauseCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReauthorizeCalendarConnectionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ReauthorizeCalendarConnectionResponseObject interface {
+	VisitReauthorizeCalendarConnectionResponse(w http.ResponseWriter) error
+}
+
+type ReauthorizeCalendarConnection200JSONResponse OAuthAuthorizeResponse
+
+func (response ReauthorizeCalendarConnection200JSONResponse) VisitReauthorizeCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReauthorizeCalendarConnection400JSONResponse Error
+
+func (response ReauthorizeCalendarConnection400JSONResponse) VisitReauthorizeCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReauthorizeCalendarConnection401JSONResponse Error
+
+func (response ReauthorizeCalendarConnection401JSONResponse) VisitReauthorizeCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReauthorizeCalendarConnection404JSONResponse Error
+
+func (response ReauthorizeCalendarConnection404JSONResponse) VisitReauthorizeCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCalendarConnectionRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ResumeCalendarConnectionResponseObject interface {
+	VisitResumeCalendarConnectionResponse(w http.ResponseWriter) error
+}
+
+type ResumeCalendarConnection200JSONResponse CalendarConnection
+
+func (response ResumeCalendarConnection200JSONResponse) VisitResumeCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCalendarConnection401JSONResponse Error
+
+func (response ResumeCalendarConnection401JSONResponse) VisitResumeCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeCalendarConnection404JSONResponse Error
+
+func (response ResumeCalendarConnection404JSONResponse) VisitResumeCalendarConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCalendarSourcesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListCalendarSourcesResponseObject interface {
+	VisitListCalendarSourcesResponse(w http.ResponseWriter) error
+}
+
+type ListCalendarSources200JSONResponse []Calendar
+
+func (response ListCalendarSources200JSONResponse) VisitListCalendarSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCalendarSources401JSONResponse Error
+
+func (response ListCalendarSources401JSONResponse) VisitListCalendarSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCalendarSources404JSONResponse Error
+
+func (response ListCalendarSources404JSONResponse) VisitListCalendarSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSourcesRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateCalendarSourcesJSONRequestBody
+}
+
+type UpdateCalendarSourcesResponseObject interface {
+	VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error
+}
+
+type UpdateCalendarSources200JSONResponse []Calendar
+
+func (response UpdateCalendarSources200JSONResponse) VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSources401JSONResponse Error
+
+func (response UpdateCalendarSources401JSONResponse) VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSources404JSONResponse Error
+
+func (response UpdateCalendarSources404JSONResponse) VisitUpdateCalendarSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSourceRequestObject struct {
+	Id         openapi_types.UUID `json:"id"`
+	CalendarId openapi_types.UUID `json:"calendar_id"`
+	Body       *UpdateCalendarSourceJSONRequestBody
+}
+
+type UpdateCalendarSourceResponseObject interface {
+	VisitUpdateCalendarSourceResponse(w http.ResponseWriter) error
+}
+
+type UpdateCalendarSource200JSONResponse Calendar
+
+func (response UpdateCalendarSource200JSONResponse) VisitUpdateCalendarSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSource400JSONResponse Error
+
+func (response UpdateCalendarSource400JSONResponse) VisitUpdateCalendarSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSource401JSONResponse Error
+
+func (response UpdateCalendarSource401JSONResponse) VisitUpdateCalendarSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSource404JSONResponse Error
+
+func (response UpdateCalendarSource404JSONResponse) VisitUpdateCalendarSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncCalendarRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params SyncCalendarParams
+}
+
+type SyncCalendarResponseObject interface {
+	VisitSyncCalendarResponse(w http.ResponseWriter) error
+}
+
+type SyncCalendar200JSONResponse SyncResult
+
+func (response SyncCalendar200JSONResponse) VisitSyncCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncCalendar401JSONResponse Error
+
+func (response SyncCalendar401JSONResponse) VisitSyncCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncCalendar404JSONResponse Error
+
+func (response SyncCalendar404JSONResponse) VisitSyncCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncCalendar409JSONResponse Error
+
+func (response SyncCalendar409JSONResponse) VisitSyncCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSyncWindowRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateCalendarSyncWindowJSONRequestBody
+}
+
+type UpdateCalendarSyncWindowResponseObject interface {
+	VisitUpdateCalendarSyncWindowResponse(w http.ResponseWriter) error
+}
+
+type UpdateCalendarSyncWindow200JSONResponse CalendarConnection
+
+func (response UpdateCalendarSyncWindow200JSONResponse) VisitUpdateCalendarSyncWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSyncWindow400JSONResponse Error
+
+func (response UpdateCalendarSyncWindow400JSONResponse) VisitUpdateCalendarSyncWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSyncWindow401JSONResponse Error
+
+func (response UpdateCalendarSyncWindow401JSONResponse) VisitUpdateCalendarSyncWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarSyncWindow404JSONResponse Error
+
+func (response UpdateCalendarSyncWindow404JSONResponse) VisitUpdateCalendarSyncWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarWriteBackRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateCalendarWriteBackJSONRequestBody
+}
+
+type UpdateCalendarWriteBackResponseObject interface {
+	VisitUpdateCalendarWriteBackResponse(w http.ResponseWriter) error
+}
+
+type UpdateCalendarWriteBack200JSONResponse CalendarConnection
+
+func (response UpdateCalendarWriteBack200JSONResponse) VisitUpdateCalendarWriteBackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarWriteBack400JSONResponse Error
+
+func (response UpdateCalendarWriteBack400JSONResponse) VisitUpdateCalendarWriteBackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarWriteBack401JSONResponse Error
+
+func (response UpdateCalendarWriteBack401JSONResponse) VisitUpdateCalendarWriteBackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCalendarWriteBack404JSONResponse Error
+
+func (response UpdateCalendarWriteBack404JSONResponse) VisitUpdateCalendarWriteBackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListChangesRequestObject struct {
+	Params ListChangesParams
+}
+
+type ListChangesResponseObject interface {
+	VisitListChangesResponse(w http.ResponseWriter) error
+}
+
+type ListChanges200JSONResponse ChangeFeedPage
+
+func (response ListChanges200JSONResponse) VisitListChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListChanges400JSONResponse Error
+
+func (response ListChanges400JSONResponse) VisitListChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListChanges401JSONResponse Error
+
+func (response ListChanges401JSONResponse) VisitListChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRulesAsyncRequestObject struct {
+	Body *ApplyRulesAsyncJSONRequestBody
+}
+
+type ApplyRulesAsyncResponseObject interface {
+	VisitApplyRulesAsyncResponse(w http.ResponseWriter) error
+}
+
+type ApplyRulesAsync202JSONResponse ClassificationJob
+
+func (response ApplyRulesAsync202JSONResponse) VisitApplyRulesAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRulesAsync400JSONResponse Error
+
+func (response ApplyRulesAsync400JSONResponse) VisitApplyRulesAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRulesAsync401JSONResponse Error
+
+func (response ApplyRulesAsync401JSONResponse) VisitApplyRulesAsyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClassificationJobRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetClassificationJobResponseObject interface {
+	VisitGetClassificationJobResponse(w http.ResponseWriter) error
+}
+
+type GetClassificationJob200JSONResponse ClassificationJob
+
+func (response GetClassificationJob200JSONResponse) VisitGetClassificationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClassificationJob401JSONResponse Error
+
+func (response GetClassificationJob401JSONResponse) VisitGetClassificationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClassificationJob404JSONResponse Error
+
+func (response GetClassificationJob404JSONResponse) VisitGetClassificationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClientRatesRequestObject struct {
+	Params ListClientRatesParams
+}
+
+type ListClientRatesResponseObject interface {
+	VisitListClientRatesResponse(w http.ResponseWriter) error
+}
+
+type ListClientRates200JSONResponse []ClientRate
+
+func (response ListClientRates200JSONResponse) VisitListClientRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClientRates401JSONResponse Error
+
+func (response ListClientRates401JSONResponse) VisitListClientRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClientRateRequestObject struct {
+	Body *CreateClientRateJSONRequestBody
+}
+
+type CreateClientRateResponseObject interface {
+	VisitCreateClientRateResponse(w http.ResponseWriter) error
+}
+
+type CreateClientRate201JSONResponse ClientRate
+
+func (response CreateClientRate201JSONResponse) VisitCreateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClientRate400JSONResponse Error
+
+func (response CreateClientRate400JSONResponse) VisitCreateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClientRate401JSONResponse Error
+
+func (response CreateClientRate401JSONResponse) VisitCreateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClientRate409JSONResponse Error
+
+func (response CreateClientRate409JSONResponse) VisitCreateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClientRateRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteClientRateResponseObject interface {
+	VisitDeleteClientRateResponse(w http.ResponseWriter) error
+}
+
+type DeleteClientRate204Response struct {
+}
+
+func (response DeleteClientRate204Response) VisitDeleteClientRateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteClientRate401JSONResponse Error
+
+func (response DeleteClientRate401JSONResponse) VisitDeleteClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClientRate404JSONResponse Error
+
+func (response DeleteClientRate404JSONResponse) VisitDeleteClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClientRateRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateClientRateJSONRequestBody
+}
+
+type UpdateClientRateResponseObject interface {
+	VisitUpdateClientRateResponse(w http.ResponseWriter) error
+}
+
+type UpdateClientRate200JSONResponse ClientRate
+
+func (response UpdateClientRate200JSONResponse) VisitUpdateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClientRate400JSONResponse Error
+
+func (response UpdateClientRate400JSONResponse) VisitUpdateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClientRate401JSONResponse Error
+
+func (response UpdateClientRate401JSONResponse) VisitUpdateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClientRate404JSONResponse Error
+
+func (response UpdateClientRate404JSONResponse) VisitUpdateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClientRate409JSONResponse Error
+
+func (response UpdateClientRate409JSONResponse) VisitUpdateClientRateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportConfigRequestObject struct {
+	Params ExportConfigParams
+}
+
+type ExportConfigResponseObject interface {
+	VisitExportConfigResponse(w http.ResponseWriter) error
+}
+
+type ExportConfig200JSONResponse ConfigExport
+
+func (response ExportConfig200JSONResponse) VisitExportConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportConfig401JSONResponse Error
+
+func (response ExportConfig401JSONResponse) VisitExportConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportConfigRequestObject struct {
+	Body *ImportConfigJSONRequestBody
+}
+
+type ImportConfigResponseObject interface {
+	VisitImportConfigResponse(w http.ResponseWriter) error
+}
+
+type ImportConfig200JSONResponse ConfigImportResult
+
+func (response ImportConfig200JSONResponse) VisitImportConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportConfig400JSONResponse Error
+
+func (response ImportConfig400JSONResponse) VisitImportConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportConfig401JSONResponse Error
+
+func (response ImportConfig401JSONResponse) VisitImportConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDaySummaryRequestObject struct {
+	Date openapi_types.Date `json:"date"`
+}
+
+type GetDaySummaryResponseObject interface {
+	VisitGetDaySummaryResponse(w http.ResponseWriter) error
+}
+
+type GetDaySummary200JSONResponse DaySummary
+
+func (response GetDaySummary200JSONResponse) VisitGetDaySummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDaySummary401JSONResponse Error
+
+func (response GetDaySummary401JSONResponse) VisitGetDaySummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpensesRequestObject struct {
+	Params ListExpensesParams
+}
+
+type ListExpensesResponseObject interface {
+	VisitListExpensesResponse(w http.ResponseWriter) error
+}
+
+type ListExpenses200JSONResponse []Expense
+
+func (response ListExpenses200JSONResponse) VisitListExpensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenses401JSONResponse Error
+
+func (response ListExpenses401JSONResponse) VisitListExpensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenseRequestObject struct {
+	Body *CreateExpenseJSONRequestBody
+}
+
+type CreateExpenseResponseObject interface {
+	VisitCreateExpenseResponse(w http.ResponseWriter) error
+}
+
+type CreateExpense201JSONResponse Expense
+
+func (response CreateExpense201JSONResponse) VisitCreateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpense400JSONResponse Error
+
+func (response CreateExpense400JSONResponse) VisitCreateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpense401JSONResponse Error
+
+func (response CreateExpense401JSONResponse) VisitCreateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpense404JSONResponse Error
+
+func (response CreateExpense404JSONResponse) VisitCreateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExpenseRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteExpenseResponseObject interface {
+	VisitDeleteExpenseResponse(w http.ResponseWriter) error
+}
+
+type DeleteExpense204Response struct {
+}
+
+func (response DeleteExpense204Response) VisitDeleteExpenseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteExpense401JSONResponse Error
+
+func (response DeleteExpense401JSONResponse) VisitDeleteExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExpense404JSONResponse Error
+
+func (response DeleteExpense404JSONResponse) VisitDeleteExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExpense409JSONResponse Error
+
+func (response DeleteExpense409JSONResponse) VisitDeleteExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpenseRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetExpenseResponseObject interface {
+	VisitGetExpenseResponse(w http.ResponseWriter) error
+}
+
+type GetExpense200JSONResponse Expense
+
+func (response GetExpense200JSONResponse) VisitGetExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpense401JSONResponse Error
+
+func (response GetExpense401JSONResponse) VisitGetExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpense404JSONResponse Error
+
+func (response GetExpense404JSONResponse) VisitGetExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpenseRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateExpenseJSONRequestBody
+}
+
+type UpdateExpenseResponseObject interface {
+	VisitUpdateExpenseResponse(w http.ResponseWriter) error
+}
+
+type UpdateExpense200JSONResponse Expense
+
+func (response UpdateExpense200JSONResponse) VisitUpdateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpense400JSONResponse Error
+
+func (response UpdateExpense400JSONResponse) VisitUpdateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpense401JSONResponse Error
+
+func (response UpdateExpense401JSONResponse) VisitUpdateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpense404JSONResponse Error
+
+func (response UpdateExpense404JSONResponse) VisitUpdateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpense409JSONResponse Error
+
+func (response UpdateExpense409JSONResponse) VisitUpdateExpenseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFingerprintClaimsRequestObject struct {
+	Params ListFingerprintClaimsParams
+}
+
+type ListFingerprintClaimsResponseObject interface {
+	VisitListFingerprintClaimsResponse(w http.ResponseWriter) error
+}
+
+type ListFingerprintClaims200JSONResponse []FingerprintClaim
+
+func (response ListFingerprintClaims200JSONResponse) VisitListFingerprintClaimsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFingerprintClaims400JSONResponse Error
+
+func (response ListFingerprintClaims400JSONResponse) VisitListFingerprintClaimsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFingerprintClaims401JSONResponse Error
+
+func (response ListFingerprintClaims401JSONResponse) VisitListFingerprintClaimsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogFocusSessionRequestObject struct {
+	Body *LogFocusSessionJSONRequestBody
+}
+
+type LogFocusSessionResponseObject interface {
+	VisitLogFocusSessionResponse(w http.ResponseWriter) error
+}
+
+type LogFocusSession201JSONResponse FocusSessionResult
+
+func (response LogFocusSession201JSONResponse) VisitLogFocusSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogFocusSession400JSONResponse Error
+
+func (response LogFocusSession400JSONResponse) VisitLogFocusSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogFocusSession401JSONResponse Error
+
+func (response LogFocusSession401JSONResponse) VisitLogFocusSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogFocusSession404JSONResponse Error
+
+func (response LogFocusSession404JSONResponse) VisitLogFocusSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogFocusSession409JSONResponse Error
+
+func (response LogFocusSession409JSONResponse) VisitLogFocusSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordFocusGapsRequestObject struct {
+	Body *RecordFocusGapsJSONRequestBody
+}
+
+type RecordFocusGapsResponseObject interface {
+	VisitRecordFocusGapsResponse(w http.ResponseWriter) error
+}
+
+type RecordFocusGaps201JSONResponse FocusGapsResult
+
+func (response RecordFocusGaps201JSONResponse) VisitRecordFocusGapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordFocusGaps400JSONResponse Error
+
+func (response RecordFocusGaps400JSONResponse) VisitRecordFocusGapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordFocusGaps401JSONResponse Error
+
+func (response RecordFocusGaps401JSONResponse) VisitRecordFocusGapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordFocusGaps404JSONResponse Error
+
+func (response RecordFocusGaps404JSONResponse) VisitRecordFocusGapsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGoalsRequestObject struct {
+}
+
+type ListGoalsResponseObject interface {
+	VisitListGoalsResponse(w http.ResponseWriter) error
+}
+
+type ListGoals200JSONResponse []HourGoal
+
+func (response ListGoals200JSONResponse) VisitListGoalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListGoals401JSONResponse Error
+
+func (response ListGoals401JSONResponse) VisitListGoalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGoalRequestObject struct {
+	Body *CreateGoalJSONRequestBody
+}
+
+type CreateGoalResponseObject interface {
+	VisitCreateGoalResponse(w http.ResponseWriter) error
+}
+
+type CreateGoal201JSONResponse HourGoal
+
+func (response CreateGoal201JSONResponse) VisitCreateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGoal400JSONResponse Error
+
+func (response CreateGoal400JSONResponse) VisitCreateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGoal401JSONResponse Error
+
+func (response CreateGoal401JSONResponse) VisitCreateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGoal404JSONResponse Error
+
+func (response CreateGoal404JSONResponse) VisitCreateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGoalsProgressRequestObject struct {
+	Params GetGoalsProgressParams
+}
+
+type GetGoalsProgressResponseObject interface {
+	VisitGetGoalsProgressResponse(w http.ResponseWriter) error
+}
+
+type GetGoalsProgress200JSONResponse []GoalProgress
+
+func (response GetGoalsProgress200JSONResponse) VisitGetGoalsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGoalsProgress401JSONResponse Error
+
+func (response GetGoalsProgress401JSONResponse) VisitGetGoalsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGoalRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteGoalResponseObject interface {
+	VisitDeleteGoalResponse(w http.ResponseWriter) error
+}
+
+type DeleteGoal204Response struct {
+}
+
+func (response DeleteGoal204Response) VisitDeleteGoalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteGoal401JSONResponse Error
+
+func (response DeleteGoal401JSONResponse) VisitDeleteGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGoal404JSONResponse Error
+
+func (response DeleteGoal404JSONResponse) VisitDeleteGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGoalRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateGoalJSONRequestBody
+}
+
+type UpdateGoalResponseObject interface {
+	VisitUpdateGoalResponse(w http.ResponseWriter) error
+}
+
+type UpdateGoal200JSONResponse HourGoal
+
+func (response UpdateGoal200JSONResponse) VisitUpdateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGoal400JSONResponse Error
+
+func (response UpdateGoal400JSONResponse) VisitUpdateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGoal401JSONResponse Error
+
+func (response UpdateGoal401JSONResponse) VisitUpdateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGoal404JSONResponse Error
+
+func (response UpdateGoal404JSONResponse) VisitUpdateGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIntegrationsRequestObject struct {
+}
+
+type ListIntegrationsResponseObject interface {
+	VisitListIntegrationsResponse(w http.ResponseWriter) error
+}
+
+type ListIntegrations200JSONResponse []IntegrationConnection
+
+func (response ListIntegrations200JSONResponse) VisitListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIntegrations401JSONResponse Error
+
+func (response ListIntegrations401JSONResponse) VisitListIntegrationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisconnectGitHubRequestObject struct {
+}
+
+type DisconnectGitHubResponseObject interface {
+	VisitDisconnectGitHubResponse(w http.ResponseWriter) error
+}
+
+type DisconnectGitHub204Response struct {
+}
+
+func (response DisconnectGitHub204Response) VisitDisconnectGitHubResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DisconnectGitHub401JSONResponse Error
+
+func (response DisconnectGitHub401JSONResponse) VisitDisconnectGitHubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisconnectGitHub404JSONResponse Error
+
+func (response DisconnectGitHub404JSONResponse) VisitDisconnectGitHubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGitHubConnectionRequestObject struct {
+}
+
+type GetGitHubConnectionResponseObject interface {
+	VisitGetGitHubConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetGitHubConnection200JSONResponse GitHubConnection
+
+func (response GetGitHubConnection200JSONResponse) VisitGetGitHubConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGitHubConnection401JSONResponse Error
+
+func (response GetGitHubConnection401JSONResponse) VisitGetGitHubConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGitHubConnection404JSONResponse Error
+
+func (response GetGitHubConnection404JSONResponse) VisitGetGitHubConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConnectGitHubRequestObject struct {
+	Body *ConnectGitHubJSONRequestBody
+}
+
+type ConnectGitHubResponseObject interface {
+	VisitConnectGitHubResponse(w http.ResponseWriter) error
+}
+
+type ConnectGitHub200JSONResponse GitHubConnection
+
+func (response ConnectGitHub200JSONResponse) VisitConnectGitHubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConnectGitHub400JSONResponse Error
+
+func (response ConnectGitHub400JSONResponse) VisitConnectGitHubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConnectGitHub401JSONResponse Error
+
+func (response ConnectGitHub401JSONResponse) VisitConnectGitHubResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisconnectIntegrationRequestObject struct {
+	Provider string `json:"provider"`
+}
+
+type DisconnectIntegrationResponseObject interface {
+	VisitDisconnectIntegrationResponse(w http.ResponseWriter) error
+}
+
+type DisconnectIntegration204Response struct {
+}
+
+func (response DisconnectIntegration204Response) VisitDisconnectIntegrationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DisconnectIntegration401JSONResponse Error
+
+func (response DisconnectIntegration401JSONResponse) VisitDisconnectIntegrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DisconnectIntegration404JSONResponse Error
+
+func (response DisconnectIntegration404JSONResponse) VisitDisconnectIntegrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConnectIntegrationRequestObject struct {
+	Provider string `json:"provider"`
+	Body     *ConnectIntegrationJSONRequestBody
+}
+
+type ConnectIntegrationResponseObject interface {
+	VisitConnectIntegrationResponse(w http.ResponseWriter) error
+}
+
+type ConnectIntegration200JSONResponse IntegrationConnection
+
+func (response ConnectIntegration200JSONResponse) VisitConnectIntegrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConnectIntegration400JSONResponse Error
+
+func (response ConnectIntegration400JSONResponse) VisitConnectIntegrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConnectIntegration401JSONResponse Error
+
+func (response ConnectIntegration401JSONResponse) VisitConnectIntegrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadInvoiceExportRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DownloadInvoiceExportResponseObject interface {
+	VisitDownloadInvoiceExportResponse(w http.ResponseWriter) error
+}
+
+type DownloadInvoiceExport200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadInvoiceExport200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       DownloadInvoiceExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadInvoiceExport200ApplicationoctetStreamResponse) VisitDownloadInvoiceExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadInvoiceExport401JSONResponse Error
+
+func (response DownloadInvoiceExport401JSONResponse) VisitDownloadInvoiceExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadInvoiceExport404JSONResponse Error
+
+func (response DownloadInvoiceExport404JSONResponse) VisitDownloadInvoiceExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoicesRequestObject struct {
+	Params ListInvoicesParams
+}
+
+type ListInvoicesResponseObject interface {
+	VisitListInvoicesResponse(w http.ResponseWriter) error
+}
+
+type ListInvoices200JSONResponse []Invoice
+
+func (response ListInvoices200JSONResponse) VisitListInvoicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoices401JSONResponse Error
+
+func (response ListInvoices401JSONResponse) VisitListInvoicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvoiceRequestObject struct {
+	Body *CreateInvoiceJSONRequestBody
+}
+
+type CreateInvoiceResponseObject interface {
+	VisitCreateInvoiceResponse(w http.ResponseWriter) error
+}
+
+type CreateInvoice201JSONResponse Invoice
+
+func (response CreateInvoice201JSONResponse) VisitCreateInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvoice400JSONResponse Error
+
+func (response CreateInvoice400JSONResponse) VisitCreateInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvoice401JSONResponse Error
+
+func (response CreateInvoice401JSONResponse) VisitCreateInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoiceRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteInvoiceResponseObject interface {
+	VisitDeleteInvoiceResponse(w http.ResponseWriter) error
+}
+
+type DeleteInvoice204Response struct {
+}
+
+func (response DeleteInvoice204Response) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteInvoice401JSONResponse Error
+
+func (response DeleteInvoice401JSONResponse) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoice404JSONResponse Error
+
+func (response DeleteInvoice404JSONResponse) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoice409JSONResponse Error
+
+func (response DeleteInvoice409JSONResponse) VisitDeleteInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvoiceRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetInvoiceResponseObject interface {
+	VisitGetInvoiceResponse(w http.ResponseWriter) error
+}
+
+type GetInvoice200JSONResponse Invoice
+
+func (response GetInvoice200JSONResponse) VisitGetInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvoice401JSONResponse Error
+
+func (response GetInvoice401JSONResponse) VisitGetInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInvoice404JSONResponse Error
+
+func (response GetInvoice404JSONResponse) VisitGetInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceAdjustmentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *AddInvoiceAdjustmentJSONRequestBody
+}
+
+type AddInvoiceAdjustmentResponseObject interface {
+	VisitAddInvoiceAdjustmentResponse(w http.ResponseWriter) error
+}
+
+type AddInvoiceAdjustment201JSONResponse Invoice
+
+func (response AddInvoiceAdjustment201JSONResponse) VisitAddInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceAdjustment400JSONResponse Error
+
+func (response AddInvoiceAdjustment400JSONResponse) VisitAddInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceAdjustment401JSONResponse Error
+
+func (response AddInvoiceAdjustment401JSONResponse) VisitAddInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceAdjustment404JSONResponse Error
+
+func (response AddInvoiceAdjustment404JSONResponse) VisitAddInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceAdjustment409JSONResponse Error
+
+func (response AddInvoiceAdjustment409JSONResponse) VisitAddInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoiceAdjustmentRequestObject struct {
+	Id         openapi_types.UUID `json:"id"`
+	LineItemId openapi_types.UUID `json:"lineItemId"`
+}
+
+type DeleteInvoiceAdjustmentResponseObject interface {
+	VisitDeleteInvoiceAdjustmentResponse(w http.ResponseWriter) error
+}
+
+type DeleteInvoiceAdjustment200JSONResponse Invoice
+
+func (response DeleteInvoiceAdjustment200JSONResponse) VisitDeleteInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoiceAdjustment401JSONResponse Error
+
+func (response DeleteInvoiceAdjustment401JSONResponse) VisitDeleteInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoiceAdjustment404JSONResponse Error
+
+func (response DeleteInvoiceAdjustment404JSONResponse) VisitDeleteInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoiceAdjustment409JSONResponse Error
+
+func (response DeleteInvoiceAdjustment409JSONResponse) VisitDeleteInvoiceAdjustmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoiceCommentsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListInvoiceCommentsResponseObject interface {
+	VisitListInvoiceCommentsResponse(w http.ResponseWriter) error
+}
+
+type ListInvoiceComments200JSONResponse []InvoiceComment
+
+func (response ListInvoiceComments200JSONResponse) VisitListInvoiceCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoiceComments401JSONResponse Error
+
+func (response ListInvoiceComments401JSONResponse) VisitListInvoiceCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoiceComments404JSONResponse Error
+
+func (response ListInvoiceComments404JSONResponse) VisitListInvoiceCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceCommentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *AddInvoiceCommentJSONRequestBody
+}
+
+type AddInvoiceCommentResponseObject interface {
+	VisitAddInvoiceCommentResponse(w http.ResponseWriter) error
+}
+
+type AddInvoiceComment201JSONResponse InvoiceComment
+
+func (response AddInvoiceComment201JSONResponse) VisitAddInvoiceCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceComment400JSONResponse Error
+
+func (response AddInvoiceComment400JSONResponse) VisitAddInvoiceCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceComment401JSONResponse Error
+
+func (response AddInvoiceComment401JSONResponse) VisitAddInvoiceCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddInvoiceComment404JSONResponse Error
+
+func (response AddInvoiceComment404JSONResponse) VisitAddInvoiceCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoiceCommentRequestObject struct {
+	Id        openapi_types.UUID `json:"id"`
+	CommentId openapi_types.UUID `json:"commentId"`
+}
+
+type DeleteInvoiceCommentResponseObject interface {
+	VisitDeleteInvoiceCommentResponse(w http.ResponseWriter) error
+}
+
+type DeleteInvoiceComment204Response struct {
+}
+
+func (response DeleteInvoiceComment204Response) VisitDeleteInvoiceCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteInvoiceComment401JSONResponse Error
+
+func (response DeleteInvoiceComment401JSONResponse) VisitDeleteInvoiceCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInvoiceComment404JSONResponse Error
+
+func (response DeleteInvoiceComment404JSONResponse) VisitDeleteInvoiceCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCreditNoteRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *CreateCreditNoteJSONRequestBody
+}
+
+type CreateCreditNoteResponseObject interface {
+	VisitCreateCreditNoteResponse(w http.ResponseWriter) error
+}
+
+type CreateCreditNote201JSONResponse Invoice
+
+func (response CreateCreditNote201JSONResponse) VisitCreateCreditNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCreditNote400JSONResponse Error
+
+func (response CreateCreditNote400JSONResponse) VisitCreateCreditNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCreditNote401JSONResponse Error
+
+func (response CreateCreditNote401JSONResponse) VisitCreateCreditNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCreditNote404JSONResponse Error
+
+func (response CreateCreditNote404JSONResponse) VisitCreateCreditNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCreditNote409JSONResponse Error
+
+func (response CreateCreditNote409JSONResponse) VisitCreateCreditNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoiceRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params ExportInvoiceParams
+}
+
+type ExportInvoiceResponseObject interface {
+	VisitExportInvoiceResponse(w http.ResponseWriter) error
+}
+
+type ExportInvoice200JSONResponse InvoiceExport
+
+func (response ExportInvoice200JSONResponse) VisitExportInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoice400JSONResponse Error
+
+func (response ExportInvoice400JSONResponse) VisitExportInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoice401JSONResponse Error
+
+func (response ExportInvoice401JSONResponse) VisitExportInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoice404JSONResponse Error
+
+func (response ExportInvoice404JSONResponse) VisitExportInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoice502JSONResponse Error
+
+func (response ExportInvoice502JSONResponse) VisitExportInvoiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoiceCSVRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ExportInvoiceCSVResponseObject interface {
+	VisitExportInvoiceCSVResponse(w http.ResponseWriter) error
+}
+
+type ExportInvoiceCSV200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportInvoiceCSV200TextcsvResponse) VisitExportInvoiceCSVResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportInvoiceCSV401JSONResponse Error
+
+func (response ExportInvoiceCSV401JSONResponse) VisitExportInvoiceCSVResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoiceCSV404JSONResponse Error
+
+func (response ExportInvoiceCSV404JSONResponse) VisitExportInvoiceCSVResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoiceSheetsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ExportInvoiceSheetsResponseObject interface {
+	VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error
+}
+
+type ExportInvoiceSheets200JSONResponse struct {
+	SpreadsheetId  *string `json:"spreadsheet_id,omitempty"`
+	SpreadsheetUrl *string `json:"spreadsheet_url,omitempty"`
+	WorksheetId    *int    `json:"worksheet_id,omitempty"`
+}
+
+func (response ExportInvoiceSheets200JSONResponse) VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoiceSheets401JSONResponse Error
+
+func (response ExportInvoiceSheets401JSONResponse) VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportInvoiceSheets404JSONResponse Error
+
+func (response ExportInvoiceSheets404JSONResponse) VisitExportInvoiceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoiceExportsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListInvoiceExportsResponseObject interface {
+	VisitListInvoiceExportsResponse(w http.ResponseWriter) error
+}
+
+type ListInvoiceExports200JSONResponse []InvoiceExport
+
+func (response ListInvoiceExports200JSONResponse) VisitListInvoiceExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoiceExports401JSONResponse Error
+
+func (response ListInvoiceExports401JSONResponse) VisitListInvoiceExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoiceExports404JSONResponse Error
+
+func (response ListInvoiceExports404JSONResponse) VisitListInvoiceExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoicePaymentsRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListInvoicePaymentsResponseObject interface {
+	VisitListInvoicePaymentsResponse(w http.ResponseWriter) error
+}
+
+type ListInvoicePayments200JSONResponse []Payment
+
+func (response ListInvoicePayments200JSONResponse) VisitListInvoicePaymentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoicePayments401JSONResponse Error
+
+func (response ListInvoicePayments401JSONResponse) VisitListInvoicePaymentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvoicePayments404JSONResponse Error
+
+func (response ListInvoicePayments404JSONResponse) VisitListInvoicePaymentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordInvoicePaymentRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *RecordInvoicePaymentJSONRequestBody
+}
+
+type RecordInvoicePaymentResponseObject interface {
+	VisitRecordInvoicePaymentResponse(w http.ResponseWriter) error
+}
+
+type RecordInvoicePayment201JSONResponse Payment
+
+func (response RecordInvoicePayment201JSONResponse) VisitRecordInvoicePaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordInvoicePayment400JSONResponse Error
+
+func (response RecordInvoicePayment400JSONResponse) VisitRecordInvoicePaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordInvoicePayment401JSONResponse Error
+
+func (response RecordInvoicePayment401JSONResponse) VisitRecordInvoicePaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordInvoicePayment404JSONResponse Error
+
+func (response RecordInvoicePayment404JSONResponse) VisitRecordInvoicePaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecordInvoicePayment409JSONResponse Error
+
+func (response RecordInvoicePayment409JSONResponse) VisitRecordInvoicePaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInvoiceStatusRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateInvoiceStatusJSONRequestBody
+}
+
+type UpdateInvoiceStatusResponseObject interface {
+	VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateInvoiceStatus200JSONResponse Invoice
+
+func (response UpdateInvoiceStatus200JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInvoiceStatus400JSONResponse Error
+
+func (response UpdateInvoiceStatus400JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInvoiceStatus401JSONResponse Error
+
+func (response UpdateInvoiceStatus401JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInvoiceStatus404JSONResponse Error
+
+func (response UpdateInvoiceStatus404JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInvoiceStatus409JSONResponse Error
+
+func (response UpdateInvoiceStatus409JSONResponse) VisitUpdateInvoiceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationsRequestObject struct {
+}
+
+type ListOrganizationsResponseObject interface {
+	VisitListOrganizationsResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizations200JSONResponse []Organization
+
+func (response ListOrganizations200JSONResponse) VisitListOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizations401JSONResponse Error
+
+func (response ListOrganizations401JSONResponse) VisitListOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationRequestObject struct {
+	Body *CreateOrganizationJSONRequestBody
+}
+
+type CreateOrganizationResponseObject interface {
+	VisitCreateOrganizationResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganization201JSONResponse Organization
+
+func (response CreateOrganization201JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization400JSONResponse Error
+
+func (response CreateOrganization400JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization401JSONResponse Error
+
+func (response CreateOrganization401JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization409JSONResponse Error
+
+func (response CreateOrganization409JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetOrganizationResponseObject interface {
+	VisitGetOrganizationResponse(w http.ResponseWriter) error
+}
+
+type GetOrganization200JSONResponse Organization
+
+func (response GetOrganization200JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization401JSONResponse Error
+
+func (response GetOrganization401JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization404JSONResponse Error
+
+func (response GetOrganization404JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationDomainRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Domain string             `json:"domain"`
+}
+
+type DeleteOrganizationDomainResponseObject interface {
+	VisitDeleteOrganizationDomainResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrganizationDomain204Response struct {
+}
+
+func (response DeleteOrganizationDomain204Response) VisitDeleteOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOrganizationDomain401JSONResponse Error
+
+func (response DeleteOrganizationDomain401JSONResponse) VisitDeleteOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationDomain403JSONResponse Error
+
+func (response DeleteOrganizationDomain403JSONResponse) VisitDeleteOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationDomain404JSONResponse Error
+
+func (response DeleteOrganizationDomain404JSONResponse) VisitDeleteOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOrganizationDomainRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Domain string             `json:"domain"`
+	Body   *PutOrganizationDomainJSONRequestBody
+}
+
+type PutOrganizationDomainResponseObject interface {
+	VisitPutOrganizationDomainResponse(w http.ResponseWriter) error
+}
+
+type PutOrganizationDomain200JSONResponse OrganizationDomain
+
+func (response PutOrganizationDomain200JSONResponse) VisitPutOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOrganizationDomain400JSONResponse Error
+
+func (response PutOrganizationDomain400JSONResponse) VisitPutOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOrganizationDomain401JSONResponse Error
+
+func (response PutOrganizationDomain401JSONResponse) VisitPutOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOrganizationDomain403JSONResponse Error
+
+func (response PutOrganizationDomain403JSONResponse) VisitPutOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutOrganizationDomain404JSONResponse Error
+
+func (response PutOrganizationDomain404JSONResponse) VisitPutOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyOrganizationDomainRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Domain string             `json:"domain"`
+}
+
+type VerifyOrganizationDomainResponseObject interface {
+	VisitVerifyOrganizationDomainResponse(w http.ResponseWriter) error
+}
+
+type VerifyOrganizationDomain200JSONResponse OrganizationDomain
+
+func (response VerifyOrganizationDomain200JSONResponse) VisitVerifyOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyOrganizationDomain401JSONResponse Error
+
+func (response VerifyOrganizationDomain401JSONResponse) VisitVerifyOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyOrganizationDomain403JSONResponse Error
+
+func (response VerifyOrganizationDomain403JSONResponse) VisitVerifyOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyOrganizationDomain404JSONResponse Error
+
+func (response VerifyOrganizationDomain404JSONResponse) VisitVerifyOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyOrganizationDomain409JSONResponse Error
+
+func (response VerifyOrganizationDomain409JSONResponse) VisitVerifyOrganizationDomainResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGoogleDelegationRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateOrganizationGoogleDelegationJSONRequestBody
+}
+
+type UpdateOrganizationGoogleDelegationResponseObject interface {
+	VisitUpdateOrganizationGoogleDelegationResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganizationGoogleDelegation200JSONResponse Organization
+
+func (response UpdateOrganizationGoogleDelegation200JSONResponse) VisitUpdateOrganizationGoogleDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGoogleDelegation400JSONResponse Error
+
+func (response UpdateOrganizationGoogleDelegation400JSONResponse) VisitUpdateOrganizationGoogleDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGoogleDelegation401JSONResponse Error
+
+func (response UpdateOrganizationGoogleDelegation401JSONResponse) VisitUpdateOrganizationGoogleDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGoogleDelegation403JSONResponse Error
+
+func (response UpdateOrganizationGoogleDelegation403JSONResponse) VisitUpdateOrganizationGoogleDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGoogleDelegation404JSONResponse Error
+
+func (response UpdateOrganizationGoogleDelegation404JSONResponse) VisitUpdateOrganizationGoogleDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionOrganizationCalendarsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ProvisionOrganizationCalendarsJSONRequestBody
+}
+
+type ProvisionOrganizationCalendarsResponseObject interface {
+	VisitProvisionOrganizationCalendarsResponse(w http.ResponseWriter) error
+}
+
+type ProvisionOrganizationCalendars200JSONResponse DelegationProvisionResult
+
+func (response ProvisionOrganizationCalendars200JSONResponse) VisitProvisionOrganizationCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionOrganizationCalendars400JSONResponse Error
+
+func (response ProvisionOrganizationCalendars400JSONResponse) VisitProvisionOrganizationCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionOrganizationCalendars401JSONResponse Error
+
+func (response ProvisionOrganizationCalendars401JSONResponse) VisitProvisionOrganizationCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionOrganizationCalendars403JSONResponse Error
+
+func (response ProvisionOrganizationCalendars403JSONResponse) VisitProvisionOrganizationCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProvisionOrganizationCalendars404JSONResponse Error
+
+func (response ProvisionOrganizationCalendars404JSONResponse) VisitProvisionOrganizationCalendarsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembersRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListOrganizationMembersResponseObject interface {
+	VisitListOrganizationMembersResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizationMembers200JSONResponse []OrganizationMember
+
+func (response ListOrganizationMembers200JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers401JSONResponse Error
+
+func (response ListOrganizationMembers401JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers404JSONResponse Error
+
+func (response ListOrganizationMembers404JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMemberDelegationRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	UserId openapi_types.UUID `json:"user_id"`
+	Body   *UpdateOrganizationMemberDelegationJSONRequestBody
+}
+
+type UpdateOrganizationMemberDelegationResponseObject interface {
+	VisitUpdateOrganizationMemberDelegationResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganizationMemberDelegation200JSONResponse OrganizationMember
+
+func (response UpdateOrganizationMemberDelegation200JSONResponse) VisitUpdateOrganizationMemberDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMemberDelegation400JSONResponse Error
+
+func (response UpdateOrganizationMemberDelegation400JSONResponse) VisitUpdateOrganizationMemberDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMemberDelegation401JSONResponse Error
+
+func (response UpdateOrganizationMemberDelegation401JSONResponse) VisitUpdateOrganizationMemberDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMemberDelegation403JSONResponse Error
+
+func (response UpdateOrganizationMemberDelegation403JSONResponse) VisitUpdateOrganizationMemberDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMemberDelegation404JSONResponse Error
+
+func (response UpdateOrganizationMemberDelegation404JSONResponse) VisitUpdateOrganizationMemberDelegationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrganizationProjectRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *TransferOrganizationProjectJSONRequestBody
+}
+
+type TransferOrganizationProjectResponseObject interface {
+	VisitTransferOrganizationProjectResponse(w http.ResponseWriter) error
+}
+
+type TransferOrganizationProject200JSONResponse ProjectTransfer
+
+func (response TransferOrganizationProject200JSONResponse) VisitTransferOrganizationProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrganizationProject400JSONResponse Error
+
+func (response TransferOrganizationProject400JSONResponse) VisitTransferOrganizationProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrganizationProject401JSONResponse Error
+
+func (response TransferOrganizationProject401JSONResponse) VisitTransferOrganizationProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrganizationProject403JSONResponse Error
+
+func (response TransferOrganizationProject403JSONResponse) VisitTransferOrganizationProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrganizationProject404JSONResponse Error
+
+func (response TransferOrganizationProject404JSONResponse) VisitTransferOrganizationProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferOrganizationProject409JSONResponse Error
+
+func (response TransferOrganizationProject409JSONResponse) VisitTransferOrganizationProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateOrganizationScimTokenRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RotateOrganizationScimTokenResponseObject interface {
+	VisitRotateOrganizationScimTokenResponse(w http.ResponseWriter) error
+}
+
+type RotateOrganizationScimToken200JSONResponse SCIMToken
+
+func (response RotateOrganizationScimToken200JSONResponse) VisitRotateOrganizationScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateOrganizationScimToken401JSONResponse Error
+
+func (response RotateOrganizationScimToken401JSONResponse) VisitRotateOrganizationScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateOrganizationScimToken403JSONResponse Error
+
+func (response RotateOrganizationScimToken403JSONResponse) VisitRotateOrganizationScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateOrganizationScimToken404JSONResponse Error
+
+func (response RotateOrganizationScimToken404JSONResponse) VisitRotateOrganizationScimTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationSsoRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateOrganizationSsoJSONRequestBody
+}
+
+type UpdateOrganizationSsoResponseObject interface {
+	VisitUpdateOrganizationSsoResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganizationSso200JSONResponse Organization
+
+func (response UpdateOrganizationSso200JSONResponse) VisitUpdateOrganizationSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationSso400JSONResponse Error
+
+func (response UpdateOrganizationSso400JSONResponse) VisitUpdateOrganizationSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationSso401JSONResponse Error
+
+func (response UpdateOrganizationSso401JSONResponse) VisitUpdateOrganizationSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationSso403JSONResponse Error
+
+func (response UpdateOrganizationSso403JSONResponse) VisitUpdateOrganizationSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationSso404JSONResponse Error
+
+func (response UpdateOrganizationSso404JSONResponse) VisitUpdateOrganizationSsoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeletePaymentResponseObject interface {
+	VisitDeletePaymentResponse(w http.ResponseWriter) error
+}
+
+type DeletePayment204Response struct {
+}
+
+func (response DeletePayment204Response) VisitDeletePaymentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePayment401JSONResponse Error
+
+func (response DeletePayment401JSONResponse) VisitDeletePaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePayment404JSONResponse Error
+
+func (response DeletePayment404JSONResponse) VisitDeletePaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectsRequestObject struct {
+	Params ListProjectsParams
+}
+
+type ListProjectsResponseObject interface {
+	VisitListProjectsResponse(w http.ResponseWriter) error
+}
+
+type ListProjects200JSONResponse []Project
+
+func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjects400JSONResponse Error
+
+func (response ListProjects400JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjects401JSONResponse Error
+
+func (response ListProjects401JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProjectRequestObject struct {
+	Body *CreateProjectJSONRequestBody
+}
+
+type CreateProjectResponseObject interface {
+	VisitCreateProjectResponse(w http.ResponseWriter) error
+}
+
+type CreateProject201JSONResponse Project
+
+func (response CreateProject201JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject400JSONResponse Error
+
+func (response CreateProject400JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject401JSONResponse Error
+
+func (response CreateProject401JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProject409JSONResponse Error
+
+func (response CreateProject409JSONResponse) VisitCreateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteProjectResponseObject interface {
+	VisitDeleteProjectResponse(w http.ResponseWriter) error
+}
+
+type DeleteProject204Response struct {
+}
+
+func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProject401JSONResponse Error
+
+func (response DeleteProject401JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject404JSONResponse Error
+
+func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject409JSONResponse Error
+
+func (response DeleteProject409JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetProjectResponseObject interface {
+	VisitGetProjectResponse(w http.ResponseWriter) error
+}
+
+type GetProject200JSONResponse Project
+
+func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProject401JSONResponse Error
+
+func (response GetProject401JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProject404JSONResponse Error
+
+func (response GetProject404JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProjectRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateProjectJSONRequestBody
+}
+
+type UpdateProjectResponseObject interface {
+	VisitUpdateProjectResponse(w http.ResponseWriter) error
+}
+
+type UpdateProject200JSONResponse Project
+
+func (response UpdateProject200JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject400JSONResponse Error
+
+func (response UpdateProject400JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject401JSONResponse Error
+
+func (response UpdateProject401JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject404JSONResponse Error
+
+func (response UpdateProject404JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProject409JSONResponse Error
+
+func (response UpdateProject409JSONResponse) VisitUpdateProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProjectFingerprintRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *AddProjectFingerprintJSONRequestBody
+}
+
+type AddProjectFingerprintResponseObject interface {
+	VisitAddProjectFingerprintResponse(w http.ResponseWriter) error
+}
+
+type AddProjectFingerprint200JSONResponse Project
+
+func (response AddProjectFingerprint200JSONResponse) VisitAddProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProjectFingerprint400JSONResponse Error
+
+func (response AddProjectFingerprint400JSONResponse) VisitAddProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProjectFingerprint401JSONResponse Error
+
+func (response AddProjectFingerprint401JSONResponse) VisitAddProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProjectFingerprint404JSONResponse Error
+
+func (response AddProjectFingerprint404JSONResponse) VisitAddProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddProjectFingerprint409JSONResponse Error
+
+func (response AddProjectFingerprint409JSONResponse) VisitAddProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProjectFingerprintRequestObject struct {
+	Id    openapi_types.UUID `json:"id"`
+	Kind  FingerprintKind    `json:"kind"`
+	Value string             `json:"value"`
+}
+
+type RemoveProjectFingerprintResponseObject interface {
+	VisitRemoveProjectFingerprintResponse(w http.ResponseWriter) error
+}
+
+type RemoveProjectFingerprint200JSONResponse Project
+
+func (response RemoveProjectFingerprint200JSONResponse) VisitRemoveProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProjectFingerprint400JSONResponse Error
+
+func (response RemoveProjectFingerprint400JSONResponse) VisitRemoveProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProjectFingerprint401JSONResponse Error
+
+func (response RemoveProjectFingerprint401JSONResponse) VisitRemoveProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveProjectFingerprint404JSONResponse Error
+
+func (response RemoveProjectFingerprint404JSONResponse) VisitRemoveProjectFingerprintResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeetingCostReportRequestObject struct {
+	Params GetMeetingCostReportParams
+}
+
+type GetMeetingCostReportResponseObject interface {
+	VisitGetMeetingCostReportResponse(w http.ResponseWriter) error
+}
+
+type GetMeetingCostReport200JSONResponse MeetingCostReport
+
+func (response GetMeetingCostReport200JSONResponse) VisitGetMeetingCostReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeetingCostReport400JSONResponse Error
+
+func (response GetMeetingCostReport400JSONResponse) VisitGetMeetingCostReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeetingCostReport401JSONResponse Error
+
+func (response GetMeetingCostReport401JSONResponse) VisitGetMeetingCostReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeetingLoadReportRequestObject struct {
+	Params GetMeetingLoadReportParams
+}
+
+type GetMeetingLoadReportResponseObject interface {
+	VisitGetMeetingLoadReportResponse(w http.ResponseWriter) error
+}
+
+type GetMeetingLoadReport200JSONResponse MeetingLoadReport
+
+func (response GetMeetingLoadReport200JSONResponse) VisitGetMeetingLoadReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeetingLoadReport400JSONResponse Error
+
+func (response GetMeetingLoadReport400JSONResponse) VisitGetMeetingLoadReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeetingLoadReport401JSONResponse Error
+
+func (response GetMeetingLoadReport401JSONResponse) VisitGetMeetingLoadReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUtilizationReportRequestObject struct {
+	Params GetUtilizationReportParams
+}
+
+type GetUtilizationReportResponseObject interface {
+	VisitGetUtilizationReportResponse(w http.ResponseWriter) error
+}
+
+type GetUtilizationReport200JSONResponse UtilizationReport
+
+func (response GetUtilizationReport200JSONResponse) VisitGetUtilizationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUtilizationReport400JSONResponse Error
+
+func (response GetUtilizationReport400JSONResponse) VisitGetUtilizationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUtilizationReport401JSONResponse Error
+
+func (response GetUtilizationReport401JSONResponse) VisitGetUtilizationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRulePackRequestObject struct {
+	Body *ExportRulePackJSONRequestBody
+}
+
+type ExportRulePackResponseObject interface {
+	VisitExportRulePackResponse(w http.ResponseWriter) error
+}
+
+type ExportRulePack200JSONResponse RulePack
+
+func (response ExportRulePack200JSONResponse) VisitExportRulePackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRulePack400JSONResponse Error
+
+func (response ExportRulePack400JSONResponse) VisitExportRulePackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRulePack401JSONResponse Error
+
+func (response ExportRulePack401JSONResponse) VisitExportRulePackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportRulePackRequestObject struct {
+	Body *ImportRulePackJSONRequestBody
+}
+
+type ImportRulePackResponseObject interface {
+	VisitImportRulePackResponse(w http.ResponseWriter) error
+}
+
+type ImportRulePack200JSONResponse RulePackImportResult
+
+func (response ImportRulePack200JSONResponse) VisitImportRulePackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportRulePack400JSONResponse Error
+
+func (response ImportRulePack400JSONResponse) VisitImportRulePackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportRulePack401JSONResponse Error
+
+func (response ImportRulePack401JSONResponse) VisitImportRulePackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRulesRequestObject struct {
+	Params ListRulesParams
+}
+
+type ListRulesResponseObject interface {
+	VisitListRulesResponse(w http.ResponseWriter) error
+}
+
+type ListRules200JSONResponse []ClassificationRule
+
+func (response ListRules200JSONResponse) VisitListRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRules400JSONResponse Error
+
+func (response ListRules400JSONResponse) VisitListRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRules401JSONResponse Error
+
+func (response ListRules401JSONResponse) VisitListRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRuleRequestObject struct {
+	Body *CreateRuleJSONRequestBody
+}
+
+type CreateRuleResponseObject interface {
+	VisitCreateRuleResponse(w http.ResponseWriter) error
+}
+
+type CreateRule201JSONResponse ClassificationRule
+
+func (response CreateRule201JSONResponse) VisitCreateRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRule400JSONResponse Error
+
+func (response CreateRule400JSONResponse) VisitCreateRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRule401JSONResponse Error
+
+func (response CreateRule401JSONResponse) VisitCreateRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRulesRequestObject struct {
+	Body *ApplyRulesJSONRequestBody
+}
+
+type ApplyRulesResponseObject interface {
+	VisitApplyRulesResponse(w http.ResponseWriter) error
+}
+
+type ApplyRules200JSONResponse ApplyRulesResponse
+
+func (response ApplyRules200JSONResponse) VisitApplyRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRules401JSONResponse Error
+
+func (response ApplyRules401JSONResponse) VisitApplyRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuleConflictsRequestObject struct {
+	Params GetRuleConflictsParams
+}
+
+type GetRuleConflictsResponseObject interface {
+	VisitGetRuleConflictsResponse(w http.ResponseWriter) error
+}
+
+type GetRuleConflicts200JSONResponse RuleConflictReport
+
+func (response GetRuleConflicts200JSONResponse) VisitGetRuleConflictsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuleConflicts400JSONResponse Error
+
+func (response GetRuleConflicts400JSONResponse) VisitGetRuleConflictsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuleConflicts401JSONResponse Error
+
+func (response GetRuleConflicts401JSONResponse) VisitGetRuleConflictsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LintRuleRequestObject struct {
+	Body *LintRuleJSONRequestBody
+}
+
+type LintRuleResponseObject interface {
+	VisitLintRuleResponse(w http.ResponseWriter) error
+}
+
+type LintRule200JSONResponse RuleLintResponse
+
+func (response LintRule200JSONResponse) VisitLintRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LintRule400JSONResponse Error
+
+func (response LintRule400JSONResponse) VisitLintRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LintRule401JSONResponse Error
+
+func (response LintRule401JSONResponse) VisitLintRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRuleRequestObject struct {
+	Body *PreviewRuleJSONRequestBody
+}
+
+type PreviewRuleResponseObject interface {
+	VisitPreviewRuleResponse(w http.ResponseWriter) error
+}
+
+type PreviewRule200JSONResponse RulePreviewResponse
+
+func (response PreviewRule200JSONResponse) VisitPreviewRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRule400JSONResponse Error
+
+func (response PreviewRule400JSONResponse) VisitPreviewRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRule401JSONResponse Error
+
+func (response PreviewRule401JSONResponse) VisitPreviewRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestRuleRequestObject struct {
+	Body *TestRuleJSONRequestBody
+}
+
+type TestRuleResponseObject interface {
+	VisitTestRuleResponse(w http.ResponseWriter) error
+}
+
+type TestRule200JSONResponse RuleTestResponse
+
+func (response TestRule200JSONResponse) VisitTestRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestRule400JSONResponse Error
+
+func (response TestRule400JSONResponse) VisitTestRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestRule401JSONResponse Error
+
+func (response TestRule401JSONResponse) VisitTestRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRuleRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteRuleResponseObject interface {
+	VisitDeleteRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteRule204Response struct {
+}
+
+func (response DeleteRule204Response) VisitDeleteRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRule401JSONResponse Error
+
+func (response DeleteRule401JSONResponse) VisitDeleteRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRule404JSONResponse Error
+
+func (response DeleteRule404JSONResponse) VisitDeleteRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuleRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetRuleResponseObject interface {
+	VisitGetRuleResponse(w http.ResponseWriter) error
+}
+
+type GetRule200JSONResponse ClassificationRule
+
+func (response GetRule200JSONResponse) VisitGetRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRule401JSONResponse Error
+
+func (response GetRule401JSONResponse) VisitGetRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRule404JSONResponse Error
+
+func (response GetRule404JSONResponse) VisitGetRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuleRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateRuleJSONRequestBody
+}
+
+type UpdateRuleResponseObject interface {
+	VisitUpdateRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateRule200JSONResponse ClassificationRule
+
+func (response UpdateRule200JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRule400JSONResponse Error
+
+func (response UpdateRule400JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRule401JSONResponse Error
+
+func (response UpdateRule401JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRule404JSONResponse Error
+
+func (response UpdateRule404JSONResponse) VisitUpdateRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClassificationSnapshotsRequestObject struct {
+	Params ListClassificationSnapshotsParams
+}
+
+type ListClassificationSnapshotsResponseObject interface {
+	VisitListClassificationSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type ListClassificationSnapshots200JSONResponse []ClassificationSnapshot
+
+func (response ListClassificationSnapshots200JSONResponse) VisitListClassificationSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClassificationSnapshots401JSONResponse Error
+
+func (response ListClassificationSnapshots401JSONResponse) VisitListClassificationSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClassificationSnapshotRequestObject struct {
+	Body *CreateClassificationSnapshotJSONRequestBody
+}
+
+type CreateClassificationSnapshotResponseObject interface {
+	VisitCreateClassificationSnapshotResponse(w http.ResponseWriter) error
+}
+
+type CreateClassificationSnapshot201JSONResponse ClassificationSnapshot
+
+func (response CreateClassificationSnapshot201JSONResponse) VisitCreateClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClassificationSnapshot400JSONResponse Error
+
+func (response CreateClassificationSnapshot400JSONResponse) VisitCreateClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateClassificationSnapshot401JSONResponse Error
+
+func (response CreateClassificationSnapshot401JSONResponse) VisitCreateClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClassificationSnapshotRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteClassificationSnapshotResponseObject interface {
+	VisitDeleteClassificationSnapshotResponse(w http.ResponseWriter) error
+}
+
+type DeleteClassificationSnapshot204Response struct {
+}
+
+func (response DeleteClassificationSnapshot204Response) VisitDeleteClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteClassificationSnapshot401JSONResponse Error
+
+func (response DeleteClassificationSnapshot401JSONResponse) VisitDeleteClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteClassificationSnapshot404JSONResponse Error
+
+func (response DeleteClassificationSnapshot404JSONResponse) VisitDeleteClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreClassificationSnapshotRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RestoreClassificationSnapshotResponseObject interface {
+	VisitRestoreClassificationSnapshotResponse(w http.ResponseWriter) error
+}
+
+type RestoreClassificationSnapshot200JSONResponse RestoreSnapshotResponse
+
+func (response RestoreClassificationSnapshot200JSONResponse) VisitRestoreClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreClassificationSnapshot401JSONResponse Error
+
+func (response RestoreClassificationSnapshot401JSONResponse) VisitRestoreClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreClassificationSnapshot404JSONResponse Error
+
+func (response RestoreClassificationSnapshot404JSONResponse) VisitRestoreClassificationSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSuppressionRulesRequestObject struct {
+}
+
+type ListSuppressionRulesResponseObject interface {
+	VisitListSuppressionRulesResponse(w http.ResponseWriter) error
+}
+
+type ListSuppressionRules200JSONResponse []SuppressionRule
+
+func (response ListSuppressionRules200JSONResponse) VisitListSuppressionRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSuppressionRules401JSONResponse Error
+
+func (response ListSuppressionRules401JSONResponse) VisitListSuppressionRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSuppressionRuleRequestObject struct {
+	Body *CreateSuppressionRuleJSONRequestBody
+}
+
+type CreateSuppressionRuleResponseObject interface {
+	VisitCreateSuppressionRuleResponse(w http.ResponseWriter) error
+}
+
+type CreateSuppressionRule201JSONResponse SuppressionRuleResult
+
+func (response CreateSuppressionRule201JSONResponse) VisitCreateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSuppressionRule400JSONResponse Error
+
+func (response CreateSuppressionRule400JSONResponse) VisitCreateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSuppressionRule401JSONResponse Error
+
+func (response CreateSuppressionRule401JSONResponse) VisitCreateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSuppressionRule404JSONResponse Error
+
+func (response CreateSuppressionRule404JSONResponse) VisitCreateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionRuleRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteSuppressionRuleResponseObject interface {
+	VisitDeleteSuppressionRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteSuppressionRule204Response struct {
+}
+
+func (response DeleteSuppressionRule204Response) VisitDeleteSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSuppressionRule401JSONResponse Error
+
+func (response DeleteSuppressionRule401JSONResponse) VisitDeleteSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSuppressionRule404JSONResponse Error
+
+func (response DeleteSuppressionRule404JSONResponse) VisitDeleteSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSuppressionRuleRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateSuppressionRuleJSONRequestBody
+}
+
+type UpdateSuppressionRuleResponseObject interface {
+	VisitUpdateSuppressionRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateSuppressionRule200JSONResponse SuppressionRuleResult
+
+func (response UpdateSuppressionRule200JSONResponse) VisitUpdateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSuppressionRule400JSONResponse Error
+
+func (response UpdateSuppressionRule400JSONResponse) VisitUpdateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSuppressionRule401JSONResponse Error
+
+func (response UpdateSuppressionRule401JSONResponse) VisitUpdateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSuppressionRule404JSONResponse Error
+
+func (response UpdateSuppressionRule404JSONResponse) VisitUpdateSuppressionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsRequestObject struct {
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(w http.ResponseWriter) error
+}
+
+type ListTags200JSONResponse []Tag
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTags401JSONResponse Error
+
+func (response ListTags401JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTagRequestObject struct {
+	Body *CreateTagJSONRequestBody
+}
+
+type CreateTagResponseObject interface {
+	VisitCreateTagResponse(w http.ResponseWriter) error
+}
+
+type CreateTag201JSONResponse Tag
+
+func (response CreateTag201JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag400JSONResponse Error
+
+func (response CreateTag400JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag401JSONResponse Error
+
+func (response CreateTag401JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag409JSONResponse Error
+
+func (response CreateTag409JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTagRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteTag204Response struct {
+}
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTag401JSONResponse Error
+
+func (response DeleteTag401JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTag404JSONResponse Error
+
+func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTagRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *RenameTagJSONRequestBody
+}
+
+type RenameTagResponseObject interface {
+	VisitRenameTagResponse(w http.ResponseWriter) error
+}
+
+type RenameTag200JSONResponse Tag
+
+func (response RenameTag200JSONResponse) VisitRenameTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTag400JSONResponse Error
+
+func (response RenameTag400JSONResponse) VisitRenameTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTag401JSONResponse Error
+
+func (response RenameTag401JSONResponse) VisitRenameTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTag404JSONResponse Error
+
+func (response RenameTag404JSONResponse) VisitRenameTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenameTag409JSONResponse Error
+
+func (response RenameTag409JSONResponse) VisitRenameTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTimeEntriesRequestObject struct {
+	Params ListTimeEntriesParams
+}
+
+type ListTimeEntriesResponseObject interface {
+	VisitListTimeEntriesResponse(w http.ResponseWriter) error
+}
+
+type ListTimeEntries200JSONResponse []TimeEntry
+
+func (response ListTimeEntries200JSONResponse) VisitListTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTimeEntries401JSONResponse Error
+
+func (response ListTimeEntries401JSONResponse) VisitListTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTimeEntryRequestObject struct {
+	Body *CreateTimeEntryJSONRequestBody
+}
+
+type CreateTimeEntryResponseObject interface {
+	VisitCreateTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type CreateTimeEntry201JSONResponse TimeEntry
+
+func (response CreateTimeEntry201JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTimeEntry400JSONResponse Error
+
+func (response CreateTimeEntry400JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTimeEntry401JSONResponse Error
+
+func (response CreateTimeEntry401JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTimeEntry404JSONResponse Error
+
+func (response CreateTimeEntry404JSONResponse) VisitCreateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestDescriptionsRequestObject struct {
+	Params SuggestDescriptionsParams
+}
+
+type SuggestDescriptionsResponseObject interface {
+	VisitSuggestDescriptionsResponse(w http.ResponseWriter) error
+}
+
+type SuggestDescriptions200JSONResponse []DescriptionSuggestion
+
+func (response SuggestDescriptions200JSONResponse) VisitSuggestDescriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestDescriptions401JSONResponse Error
+
+func (response SuggestDescriptions401JSONResponse) VisitSuggestDescriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestDescriptions409JSONResponse Error
+
+func (response SuggestDescriptions409JSONResponse) VisitSuggestDescriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestDescriptions502JSONResponse Error
+
+func (response SuggestDescriptions502JSONResponse) VisitSuggestDescriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ParseTimeEntryRequestObject struct {
+	Params ParseTimeEntryParams
+	Body   *ParseTimeEntryJSONRequestBody
+}
+
+type ParseTimeEntryResponseObject interface {
+	VisitParseTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type ParseTimeEntry200JSONResponse TimeEntryDraft
+
+func (response ParseTimeEntry200JSONResponse) VisitParseTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ParseTimeEntry400JSONResponse Error
+
+func (response ParseTimeEntry400JSONResponse) VisitParseTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ParseTimeEntry401JSONResponse Error
+
+func (response ParseTimeEntry401JSONResponse) VisitParseTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateTimeEntriesRequestObject struct {
+	Body *RecalculateTimeEntriesJSONRequestBody
+}
+
+type RecalculateTimeEntriesResponseObject interface {
+	VisitRecalculateTimeEntriesResponse(w http.ResponseWriter) error
+}
+
+type RecalculateTimeEntries202JSONResponse RecalculationJob
+
+func (response RecalculateTimeEntries202JSONResponse) VisitRecalculateTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateTimeEntries400JSONResponse Error
+
+func (response RecalculateTimeEntries400JSONResponse) VisitRecalculateTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateTimeEntries401JSONResponse Error
+
+func (response RecalculateTimeEntries401JSONResponse) VisitRecalculateTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RecalculateTimeEntries404JSONResponse Error
+
+func (response RecalculateTimeEntries404JSONResponse) VisitRecalculateTimeEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecalculationJobRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetRecalculationJobResponseObject interface {
+	VisitGetRecalculationJobResponse(w http.ResponseWriter) error
+}
+
+type GetRecalculationJob200JSONResponse RecalculationJob
+
+func (response GetRecalculationJob200JSONResponse) VisitGetRecalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecalculationJob401JSONResponse Error
+
+func (response GetRecalculationJob401JSONResponse) VisitGetRecalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecalculationJob404JSONResponse Error
+
+func (response GetRecalculationJob404JSONResponse) VisitGetRecalculationJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReconciliationReportRequestObject struct {
+	Params GetReconciliationReportParams
+}
+
+type GetReconciliationReportResponseObject interface {
+	VisitGetReconciliationReportResponse(w http.ResponseWriter) error
+}
+
+type GetReconciliationReport200JSONResponse ReconciliationReport
+
+func (response GetReconciliationReport200JSONResponse) VisitGetReconciliationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReconciliationReport401JSONResponse Error
+
+func (response GetReconciliationReport401JSONResponse) VisitGetReconciliationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteTimeEntryResponseObject interface {
+	VisitDeleteTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type DeleteTimeEntry204Response struct {
+}
+
+func (response DeleteTimeEntry204Response) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTimeEntry401JSONResponse Error
+
+func (response DeleteTimeEntry401JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntry404JSONResponse Error
+
+func (response DeleteTimeEntry404JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntry409JSONResponse Error
+
+func (response DeleteTimeEntry409JSONResponse) VisitDeleteTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetTimeEntryResponseObject interface {
+	VisitGetTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type GetTimeEntry200JSONResponse TimeEntry
+
+func (response GetTimeEntry200JSONResponse) VisitGetTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntry401JSONResponse Error
+
+func (response GetTimeEntry401JSONResponse) VisitGetTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntry404JSONResponse Error
+
+func (response GetTimeEntry404JSONResponse) VisitGetTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTimeEntryRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *UpdateTimeEntryJSONRequestBody
+}
+
+type UpdateTimeEntryResponseObject interface {
+	VisitUpdateTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type UpdateTimeEntry200JSONResponse TimeEntry
+
+func (response UpdateTimeEntry200JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTimeEntry400JSONResponse Error
+
+func (response UpdateTimeEntry400JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTimeEntry401JSONResponse Error
+
+func (response UpdateTimeEntry401JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTimeEntry404JSONResponse Error
+
+func (response UpdateTimeEntry404JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTimeEntry409JSONResponse Error
+
+func (response UpdateTimeEntry409JSONResponse) VisitUpdateTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntryBreakdownRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type GetTimeEntryBreakdownResponseObject interface {
+	VisitGetTimeEntryBreakdownResponse(w http.ResponseWriter) error
+}
+
+type GetTimeEntryBreakdown200JSONResponse TimeEntryBreakdown
+
+func (response GetTimeEntryBreakdown200JSONResponse) VisitGetTimeEntryBreakdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntryBreakdown401JSONResponse Error
+
+func (response GetTimeEntryBreakdown401JSONResponse) VisitGetTimeEntryBreakdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTimeEntryBreakdown404JSONResponse Error
+
+func (response GetTimeEntryBreakdown404JSONResponse) VisitGetTimeEntryBreakdownResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExplainTimeEntryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ExplainTimeEntryResponseObject interface {
+	VisitExplainTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type ExplainTimeEntry200JSONResponse TimeEntryExplanation
+
+func (response ExplainTimeEntry200JSONResponse) VisitExplainTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExplainTimeEntry401JSONResponse Error
+
+func (response ExplainTimeEntry401JSONResponse) VisitExplainTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExplainTimeEntry404JSONResponse Error
+
+func (response ExplainTimeEntry404JSONResponse) VisitExplainTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTimeEntryNotesRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type ListTimeEntryNotesResponseObject interface {
+	VisitListTimeEntryNotesResponse(w http.ResponseWriter) error
+}
+
+type ListTimeEntryNotes200JSONResponse []TimeEntryNote
+
+func (response ListTimeEntryNotes200JSONResponse) VisitListTimeEntryNotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTimeEntryNotes401JSONResponse Error
+
+func (response ListTimeEntryNotes401JSONResponse) VisitListTimeEntryNotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTimeEntryNotes404JSONResponse Error
+
+func (response ListTimeEntryNotes404JSONResponse) VisitListTimeEntryNotesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTimeEntryNoteRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *AddTimeEntryNoteJSONRequestBody
+}
+
+type AddTimeEntryNoteResponseObject interface {
+	VisitAddTimeEntryNoteResponse(w http.ResponseWriter) error
+}
+
+type AddTimeEntryNote201JSONResponse TimeEntryNote
+
+func (response AddTimeEntryNote201JSONResponse) VisitAddTimeEntryNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTimeEntryNote400JSONResponse Error
+
+func (response AddTimeEntryNote400JSONResponse) VisitAddTimeEntryNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTimeEntryNote401JSONResponse Error
+
+func (response AddTimeEntryNote401JSONResponse) VisitAddTimeEntryNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddTimeEntryNote404JSONResponse Error
+
+func (response AddTimeEntryNote404JSONResponse) VisitAddTimeEntryNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntryNoteRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	NoteId openapi_types.UUID `json:"noteId"`
+}
+
+type DeleteTimeEntryNoteResponseObject interface {
+	VisitDeleteTimeEntryNoteResponse(w http.ResponseWriter) error
+}
+
+type DeleteTimeEntryNote204Response struct {
+}
+
+func (response DeleteTimeEntryNote204Response) VisitDeleteTimeEntryNoteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTimeEntryNote401JSONResponse Error
+
+func (response DeleteTimeEntryNote401JSONResponse) VisitDeleteTimeEntryNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTimeEntryNote404JSONResponse Error
+
+func (response DeleteTimeEntryNote404JSONResponse) VisitDeleteTimeEntryNoteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReconcileTimeEntryRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *ReconcileTimeEntryJSONRequestBody
+}
+
+type ReconcileTimeEntryResponseObject interface {
+	VisitReconcileTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type ReconcileTimeEntry200JSONResponse TimeEntry
+
+func (response ReconcileTimeEntry200JSONResponse) VisitReconcileTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReconcileTimeEntry400JSONResponse Error
+
+func (response ReconcileTimeEntry400JSONResponse) VisitReconcileTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReconcileTimeEntry401JSONResponse Error
+
+func (response ReconcileTimeEntry401JSONResponse) VisitReconcileTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReconcileTimeEntry404JSONResponse Error
+
+func (response ReconcileTimeEntry404JSONResponse) VisitReconcileTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReconcileTimeEntry409JSONResponse Error
+
+func (response ReconcileTimeEntry409JSONResponse) VisitReconcileTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTimeEntryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RefreshTimeEntryResponseObject interface {
+	VisitRefreshTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type RefreshTimeEntry200JSONResponse TimeEntry
+
+func (response RefreshTimeEntry200JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTimeEntry400JSONResponse Error
+
+func (response RefreshTimeEntry400JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTimeEntry401JSONResponse Error
+
+func (response RefreshTimeEntry401JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTimeEntry404JSONResponse Error
+
+func (response RefreshTimeEntry404JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTimeEntryRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SplitTimeEntryJSONRequestBody
+}
+
+type SplitTimeEntryResponseObject interface {
+	VisitSplitTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type SplitTimeEntry200JSONResponse []TimeEntry
+
+func (response SplitTimeEntry200JSONResponse) VisitSplitTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTimeEntry400JSONResponse Error
+
+func (response SplitTimeEntry400JSONResponse) VisitSplitTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTimeEntry401JSONResponse Error
+
+func (response SplitTimeEntry401JSONResponse) VisitSplitTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTimeEntry404JSONResponse Error
+
+func (response SplitTimeEntry404JSONResponse) VisitSplitTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SplitTimeEntry409JSONResponse Error
+
+func (response SplitTimeEntry409JSONResponse) VisitSplitTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTimeEntryTagsRequestObject struct {
+	Id   openapi_types.UUID `json:"id"`
+	Body *SetTimeEntryTagsJSONRequestBody
+}
+
+type SetTimeEntryTagsResponseObject interface {
+	VisitSetTimeEntryTagsResponse(w http.ResponseWriter) error
+}
+
+type SetTimeEntryTags200JSONResponse TimeEntry
+
+func (response SetTimeEntryTags200JSONResponse) VisitSetTimeEntryTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTimeEntryTags400JSONResponse Error
+
+func (response SetTimeEntryTags400JSONResponse) VisitSetTimeEntryTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTimeEntryTags401JSONResponse Error
+
+func (response SetTimeEntryTags401JSONResponse) VisitSetTimeEntryTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetTimeEntryTags404JSONResponse Error
+
+func (response SetTimeEntryTags404JSONResponse) VisitSetTimeEntryTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrashRequestObject struct {
+}
+
+type ListTrashResponseObject interface {
+	VisitListTrashResponse(w http.ResponseWriter) error
+}
+
+type ListTrash200JSONResponse Trash
+
+func (response ListTrash200JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTrash401JSONResponse Error
+
+func (response ListTrash401JSONResponse) VisitListTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreRuleRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RestoreRuleResponseObject interface {
+	VisitRestoreRuleResponse(w http.ResponseWriter) error
+}
+
+type RestoreRule200JSONResponse ClassificationRule
+
+func (response RestoreRule200JSONResponse) VisitRestoreRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreRule401JSONResponse Error
+
+func (response RestoreRule401JSONResponse) VisitRestoreRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreRule404JSONResponse Error
+
+func (response RestoreRule404JSONResponse) VisitRestoreRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTimeEntryRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type RestoreTimeEntryResponseObject interface {
+	VisitRestoreTimeEntryResponse(w http.ResponseWriter) error
+}
+
+type RestoreTimeEntry200JSONResponse TimeEntry
+
+func (response RestoreTimeEntry200JSONResponse) VisitRestoreTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTimeEntry401JSONResponse Error
+
+func (response RestoreTimeEntry401JSONResponse) VisitRestoreTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreTimeEntry404JSONResponse Error
+
+func (response RestoreTimeEntry404JSONResponse) VisitRestoreTimeEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWeekGridRequestObject struct {
+	Start openapi_types.Date `json:"start"`
+}
+
+type GetWeekGridResponseObject interface {
+	VisitGetWeekGridResponse(w http.ResponseWriter) error
+}
+
+type GetWeekGrid200JSONResponse WeekGrid
+
+func (response GetWeekGrid200JSONResponse) VisitGetWeekGridResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWeekGrid401JSONResponse Error
+
+func (response GetWeekGrid401JSONResponse) VisitGetWeekGridResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkingHoursRequestObject struct {
+}
+
+type GetWorkingHoursResponseObject interface {
+	VisitGetWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type GetWorkingHours200JSONResponse WorkingHours
+
+func (response GetWorkingHours200JSONResponse) VisitGetWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkingHours401JSONResponse Error
+
+func (response GetWorkingHours401JSONResponse) VisitGetWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkingHoursRequestObject struct {
+	Body *UpdateWorkingHoursJSONRequestBody
+}
+
+type UpdateWorkingHoursResponseObject interface {
+	VisitUpdateWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkingHours200JSONResponse WorkingHours
+
+func (response UpdateWorkingHours200JSONResponse) VisitUpdateWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkingHours400JSONResponse Error
+
+func (response UpdateWorkingHours400JSONResponse) VisitUpdateWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkingHours401JSONResponse Error
+
+func (response UpdateWorkingHours401JSONResponse) VisitUpdateWorkingHoursResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List recent classification actions
+	// (GET /api/actions)
+	ListClassificationActions(ctx context.Context, request ListClassificationActionsRequestObject) (ListClassificationActionsResponseObject, error)
+	// Undo a classification action
+	// (POST /api/actions/{id}/undo)
+	UndoClassificationAction(ctx context.Context, request UndoClassificationActionRequestObject) (UndoClassificationActionResponseObject, error)
+	// List flagged days
+	// (GET /api/anomalies)
+	ListAnomalies(ctx context.Context, request ListAnomaliesRequestObject) (ListAnomaliesResponseObject, error)
+	// Run the anomaly analysis now
+	// (POST /api/anomalies/analyze)
+	AnalyzeAnomalies(ctx context.Context, request AnalyzeAnomaliesRequestObject) (AnalyzeAnomaliesResponseObject, error)
+	// Dismiss a flagged day
+	// (POST /api/anomalies/{id}/dismiss)
+	DismissAnomaly(ctx context.Context, request DismissAnomalyRequestObject) (DismissAnomalyResponseObject, error)
+	// List user's API keys
+	// (GET /api/api-keys)
+	ListApiKeys(ctx context.Context, request ListApiKeysRequestObject) (ListApiKeysResponseObject, error)
+	// Create a new API key
+	// (POST /api/api-keys)
+	CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error)
+	// Revoke an API key
+	// (DELETE /api/api-keys/{id})
+	DeleteApiKey(ctx context.Context, request DeleteApiKeyRequestObject) (DeleteApiKeyResponseObject, error)
+	// Update an API key's settings
+	// (PATCH /api/api-keys/{id})
+	UpdateApiKey(ctx context.Context, request UpdateApiKeyRequestObject) (UpdateApiKeyResponseObject, error)
+	// List attendee aliases
+	// (GET /api/attendee-aliases)
+	ListAttendeeAliases(ctx context.Context, request ListAttendeeAliasesRequestObject) (ListAttendeeAliasesResponseObject, error)
+	// Add an attendee alias
+	// (POST /api/attendee-aliases)
+	CreateAttendeeAlias(ctx context.Context, request CreateAttendeeAliasRequestObject) (CreateAttendeeAliasResponseObject, error)
+	// Delete an attendee alias
+	// (DELETE /api/attendee-aliases/{id})
+	DeleteAttendeeAlias(ctx context.Context, request DeleteAttendeeAliasRequestObject) (DeleteAttendeeAliasResponseObject, error)
+	// Get the user's company domains
+	// (GET /api/auth/company-domains)
+	GetCompanyDomains(ctx context.Context, request GetCompanyDomainsRequestObject) (GetCompanyDomainsResponseObject, error)
+	// Set the user's company domains
+	// (PUT /api/auth/company-domains)
+	UpdateCompanyDomains(ctx context.Context, request UpdateCompanyDomainsRequestObject) (UpdateCompanyDomainsResponseObject, error)
+	// Get Google OAuth authorization URL
+	// (GET /api/auth/google/authorize)
+	GoogleAuthorize(ctx context.Context, request GoogleAuthorizeRequestObject) (GoogleAuthorizeResponseObject, error)
+	// Handle Google OAuth callback
+	// (GET /api/auth/google/callback)
+	GoogleCallback(ctx context.Context, request GoogleCallbackRequestObject) (GoogleCallbackResponseObject, error)
+	// Get the user's locale
+	// (GET /api/auth/locale)
+	GetLocale(ctx context.Context, request GetLocaleRequestObject) (GetLocaleResponseObject, error)
+	// Set the user's locale
+	// (PUT /api/auth/locale)
+	UpdateLocale(ctx context.Context, request UpdateLocaleRequestObject) (UpdateLocaleResponseObject, error)
+	// Authenticate with email and password
+	// (POST /api/auth/login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// End the current session
+	// (POST /api/auth/logout)
+	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Get current authenticated user
+	// (GET /api/auth/me)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// Get the user's data retention
+	// (GET /api/auth/retention-settings)
+	GetRetentionSettings(ctx context.Context, request GetRetentionSettingsRequestObject) (GetRetentionSettingsResponseObject, error)
+	// Set the user's data retention
+	// (PUT /api/auth/retention-settings)
+	UpdateRetentionSettings(ctx context.Context, request UpdateRetentionSettingsRequestObject) (UpdateRetentionSettingsResponseObject, error)
+	// Dry run of the retention purge
+	// (GET /api/auth/retention-settings/preview)
+	PreviewRetention(ctx context.Context, request PreviewRetentionRequestObject) (PreviewRetentionResponseObject, error)
+	// Create a new user account
+	// (POST /api/auth/signup)
+	Signup(ctx context.Context, request SignupRequestObject) (SignupResponseObject, error)
+	// Start single sign-on
+	// (POST /api/auth/sso/start)
+	StartSso(ctx context.Context, request StartSsoRequestObject) (StartSsoResponseObject, error)
+	// Get the user's sync cadence
+	// (GET /api/auth/sync-settings)
+	GetSyncSettings(ctx context.Context, request GetSyncSettingsRequestObject) (GetSyncSettingsResponseObject, error)
+	// Set the user's sync cadence
+	// (PUT /api/auth/sync-settings)
+	UpdateSyncSettings(ctx context.Context, request UpdateSyncSettingsRequestObject) (UpdateSyncSettingsResponseObject, error)
+	// List billing periods for a project
+	// (GET /api/billing-periods)
+	ListBillingPeriods(ctx context.Context, request ListBillingPeriodsRequestObject) (ListBillingPeriodsResponseObject, error)
+	// Create a new billing period
+	// (POST /api/billing-periods)
+	CreateBillingPeriod(ctx context.Context, request CreateBillingPeriodRequestObject) (CreateBillingPeriodResponseObject, error)
+	// Delete a billing period
+	// (DELETE /api/billing-periods/{id})
+	DeleteBillingPeriod(ctx context.Context, request DeleteBillingPeriodRequestObject) (DeleteBillingPeriodResponseObject, error)
+	// Update a billing period
+	// (PUT /api/billing-periods/{id})
+	UpdateBillingPeriod(ctx context.Context, request UpdateBillingPeriodRequestObject) (UpdateBillingPeriodResponseObject, error)
+	// Outstanding balances per client
+	// (GET /api/billing/balances)
+	ListClientBalances(ctx context.Context, request ListClientBalancesRequestObject) (ListClientBalancesResponseObject, error)
+	// List calendar events with filters
+	// (GET /api/calendar-events)
+	ListCalendarEvents(ctx context.Context, request ListCalendarEventsRequestObject) (ListCalendarEventsResponseObject, error)
+	// Bulk classify events matching a query
+	// (POST /api/calendar-events/bulk-classify)
+	BulkClassifyEvents(ctx context.Context, request BulkClassifyEventsRequestObject) (BulkClassifyEventsResponseObject, error)
+	// Compare the events in a date range between two points in time
+	// (GET /api/calendar-events/diff)
+	DiffCalendarEvents(ctx context.Context, request DiffCalendarEventsRequestObject) (DiffCalendarEventsResponseObject, error)
+	// List events flagged for review
+	// (GET /api/calendar-events/review-queue)
+	GetReviewQueue(ctx context.Context, request GetReviewQueueRequestObject) (GetReviewQueueResponseObject, error)
+	// List an event's change history
+	// (GET /api/calendar-events/{id}/changes)
+	ListEventChanges(ctx context.Context, request ListEventChangesRequestObject) (ListEventChangesResponseObject, error)
+	// Classify a calendar event (assign to project or skip)
+	// (PUT /api/calendar-events/{id}/classify)
+	ClassifyCalendarEvent(ctx context.Context, request ClassifyCalendarEventRequestObject) (ClassifyCalendarEventResponseObject, error)
+	// Explain how an event was (or would be) classified
+	// (GET /api/calendar-events/{id}/explain)
+	ExplainEventClassification(ctx context.Context, request ExplainEventClassificationRequestObject) (ExplainEventClassificationResponseObject, error)
+	// List an event's classification history
+	// (GET /api/calendar-events/{id}/history)
+	ListEventClassificationHistory(ctx context.Context, request ListEventClassificationHistoryRequestObject) (ListEventClassificationHistoryResponseObject, error)
+	// Accept or override an event's suggested classification
+	// (POST /api/calendar-events/{id}/review)
+	ReviewCalendarEvent(ctx context.Context, request ReviewCalendarEventRequestObject) (ReviewCalendarEventResponseObject, error)
+	// Suppress or unsuppress an event by hand
+	// (PUT /api/calendar-events/{id}/suppression)
+	SetEventSuppression(ctx context.Context, request SetEventSuppressionRequestObject) (SetEventSuppressionResponseObject, error)
+	// Replace the tags on an event
+	// (PUT /api/calendar-events/{id}/tags)
+	SetEventTags(ctx context.Context, request SetEventTagsRequestObject) (SetEventTagsResponseObject, error)
+	// List user's calendar connections
+	// (GET /api/calendars)
+	ListCalendarConnections(ctx context.Context, request ListCalendarConnectionsRequestObject) (ListCalendarConnectionsResponseObject, error)
+	// List connections that need the user to consent again
+	// (GET /api/calendars/needs-reauth)
+	ListConnectionsNeedingReauth(ctx context.Context, request ListConnectionsNeedingReauthRequestObject) (ListConnectionsNeedingReauthResponseObject, error)
+	// Disconnect a calendar
+	// (DELETE /api/calendars/{id})
+	DeleteCalendarConnection(ctx context.Context, request DeleteCalendarConnectionRequestObject) (DeleteCalendarConnectionResponseObject, error)
+	// Pause syncing a connection
+	// (POST /api/calendars/{id}/pause)
+	PauseCalendarConnection(ctx context.Context, request PauseCalendarConnectionRequestObject) (PauseCalendarConnectionResponseObject, error)
+	// Start re-consent for a connection
+	// (POST /api/calendars/{id}/reauthorize)
+	ReauthorizeCalendarConnection(ctx context.Context, request ReauthorizeCalendarConnectionRequestObject) (ReauthorizeCalendarConnectionResponseObject, error)
+	// Resume syncing a paused connection
+	// (POST /api/calendars/{id}/resume)
+	ResumeCalendarConnection(ctx context.Context, request ResumeCalendarConnectionRequestObject) (ResumeCalendarConnectionResponseObject, error)
+	// List available calendars for a connection
+	// (GET /api/calendars/{id}/sources)
+	ListCalendarSources(ctx context.Context, request ListCalendarSourcesRequestObject) (ListCalendarSourcesResponseObject, error)
+	// Update which calendars are selected for sync
+	// (PUT /api/calendars/{id}/sources)
+	UpdateCalendarSources(ctx context.Context, request UpdateCalendarSourcesRequestObject) (UpdateCalendarSourcesResponseObject, error)
+	// Set a calendar's display overrides and project mapping
+	// (PUT /api/calendars/{id}/sources/{calendar_id})
+	UpdateCalendarSource(ctx context.Context, request UpdateCalendarSourceRequestObject) (UpdateCalendarSourceResponseObject, error)
+	// Trigger sync for a calendar connection
+	// (POST /api/calendars/{id}/sync)
+	SyncCalendar(ctx context.Context, request SyncCalendarRequestObject) (SyncCalendarResponseObject, error)
+	// Configure how far a connection syncs
+	// (PUT /api/calendars/{id}/sync-window)
+	UpdateCalendarSyncWindow(ctx context.Context, request UpdateCalendarSyncWindowRequestObject) (UpdateCalendarSyncWindowResponseObject, error)
+	// Configure project write-back to Google events
+	// (PUT /api/calendars/{id}/write-back)
+	UpdateCalendarWriteBack(ctx context.Context, request UpdateCalendarWriteBackRequestObject) (UpdateCalendarWriteBackResponseObject, error)
+	// List changes since a cursor
+	// (GET /api/changes)
+	ListChanges(ctx context.Context, request ListChangesRequestObject) (ListChangesResponseObject, error)
+	// Apply classification rules in the background
+	// (POST /api/classification/apply-rules:async)
+	ApplyRulesAsync(ctx context.Context, request ApplyRulesAsyncRequestObject) (ApplyRulesAsyncResponseObject, error)
+	// Get a background classification job
+	// (GET /api/classification/jobs/{id})
+	GetClassificationJob(ctx context.Context, request GetClassificationJobRequestObject) (GetClassificationJobResponseObject, error)
+	// List client rates
+	// (GET /api/client-rates)
+	ListClientRates(ctx context.Context, request ListClientRatesRequestObject) (ListClientRatesResponseObject, error)
+	// Create a new client rate
+	// (POST /api/client-rates)
+	CreateClientRate(ctx context.Context, request CreateClientRateRequestObject) (CreateClientRateResponseObject, error)
+	// Delete a client rate
+	// (DELETE /api/client-rates/{id})
+	DeleteClientRate(ctx context.Context, request DeleteClientRateRequestObject) (DeleteClientRateResponseObject, error)
+	// Update a client rate
+	// (PUT /api/client-rates/{id})
+	UpdateClientRate(ctx context.Context, request UpdateClientRateRequestObject) (UpdateClientRateResponseObject, error)
+	// Export projects and rules as JSON
+	// (GET /api/config/export)
+	ExportConfig(ctx context.Context, request ExportConfigRequestObject) (ExportConfigResponseObject, error)
+	// Import projects and rules from JSON
+	// (POST /api/config/import)
+	ImportConfig(ctx context.Context, request ImportConfigRequestObject) (ImportConfigResponseObject, error)
+	// Get everything the daily view needs
+	// (GET /api/day/{date})
+	GetDaySummary(ctx context.Context, request GetDaySummaryRequestObject) (GetDaySummaryResponseObject, error)
+	// List expenses
+	// (GET /api/expenses)
+	ListExpenses(ctx context.Context, request ListExpensesRequestObject) (ListExpensesResponseObject, error)
+	// Record an expense
+	// (POST /api/expenses)
+	CreateExpense(ctx context.Context, request CreateExpenseRequestObject) (CreateExpenseResponseObject, error)
+	// Delete an expense
+	// (DELETE /api/expenses/{id})
+	DeleteExpense(ctx context.Context, request DeleteExpenseRequestObject) (DeleteExpenseResponseObject, error)
+	// Get an expense
+	// (GET /api/expenses/{id})
+	GetExpense(ctx context.Context, request GetExpenseRequestObject) (GetExpenseResponseObject, error)
+	// Update an expense
+	// (PUT /api/expenses/{id})
+	UpdateExpense(ctx context.Context, request UpdateExpenseRequestObject) (UpdateExpenseResponseObject, error)
+	// List the projects claiming a domain
+	// (GET /api/fingerprints/claims)
+	ListFingerprintClaims(ctx context.Context, request ListFingerprintClaimsRequestObject) (ListFingerprintClaimsResponseObject, error)
+	// Log a focus session from an external timer
+	// (POST /api/focus-sessions)
+	LogFocusSession(ctx context.Context, request LogFocusSessionRequestObject) (LogFocusSessionResponseObject, error)
+	// Record the focus time between meetings as focus sessions
+	// (POST /api/focus-sessions/from-gaps)
+	RecordFocusGaps(ctx context.Context, request RecordFocusGapsRequestObject) (RecordFocusGapsResponseObject, error)
+	// List hour goals
+	// (GET /api/goals)
+	ListGoals(ctx context.Context, request ListGoalsRequestObject) (ListGoalsResponseObject, error)
+	// Create an hour goal
+	// (POST /api/goals)
+	CreateGoal(ctx context.Context, request CreateGoalRequestObject) (CreateGoalResponseObject, error)
+	// Progress toward hour goals
+	// (GET /api/goals/progress)
+	GetGoalsProgress(ctx context.Context, request GetGoalsProgressRequestObject) (GetGoalsProgressResponseObject, error)
+	// Delete an hour goal
+	// (DELETE /api/goals/{id})
+	DeleteGoal(ctx context.Context, request DeleteGoalRequestObject) (DeleteGoalResponseObject, error)
+	// Update an hour goal
+	// (PUT /api/goals/{id})
+	UpdateGoal(ctx context.Context, request UpdateGoalRequestObject) (UpdateGoalResponseObject, error)
+	// List connected integrations
+	// (GET /api/integrations)
+	ListIntegrations(ctx context.Context, request ListIntegrationsRequestObject) (ListIntegrationsResponseObject, error)
+	// Disconnect GitHub
+	// (DELETE /api/integrations/github)
+	DisconnectGitHub(ctx context.Context, request DisconnectGitHubRequestObject) (DisconnectGitHubResponseObject, error)
+	// Get the GitHub connection
+	// (GET /api/integrations/github)
+	GetGitHubConnection(ctx context.Context, request GetGitHubConnectionRequestObject) (GetGitHubConnectionResponseObject, error)
+	// Connect a GitHub account
+	// (PUT /api/integrations/github)
+	ConnectGitHub(ctx context.Context, request ConnectGitHubRequestObject) (ConnectGitHubResponseObject, error)
+	// Disconnect an integration
+	// (DELETE /api/integrations/{provider})
+	DisconnectIntegration(ctx context.Context, request DisconnectIntegrationRequestObject) (DisconnectIntegrationResponseObject, error)
+	// Connect an integration
+	// (PUT /api/integrations/{provider})
+	ConnectIntegration(ctx context.Context, request ConnectIntegrationRequestObject) (ConnectIntegrationResponseObject, error)
+	// Download an exported file
+	// (GET /api/invoice-exports/{id}/download)
+	DownloadInvoiceExport(ctx context.Context, request DownloadInvoiceExportRequestObject) (DownloadInvoiceExportResponseObject, error)
+	// List invoices
+	// (GET /api/invoices)
+	ListInvoices(ctx context.Context, request ListInvoicesRequestObject) (ListInvoicesResponseObject, error)
+	// Generate a new invoice
+	// (POST /api/invoices)
+	CreateInvoice(ctx context.Context, request CreateInvoiceRequestObject) (CreateInvoiceResponseObject, error)
+	// Delete a draft invoice
+	// (DELETE /api/invoices/{id})
+	DeleteInvoice(ctx context.Context, request DeleteInvoiceRequestObject) (DeleteInvoiceResponseObject, error)
+	// Get invoice details
+	// (GET /api/invoices/{id})
+	GetInvoice(ctx context.Context, request GetInvoiceRequestObject) (GetInvoiceResponseObject, error)
+	// Add an adjustment line item
+	// (POST /api/invoices/{id}/adjustments)
+	AddInvoiceAdjustment(ctx context.Context, request AddInvoiceAdjustmentRequestObject) (AddInvoiceAdjustmentResponseObject, error)
+	// Remove an adjustment line item
+	// (DELETE /api/invoices/{id}/adjustments/{lineItemId})
+	DeleteInvoiceAdjustment(ctx context.Context, request DeleteInvoiceAdjustmentRequestObject) (DeleteInvoiceAdjustmentResponseObject, error)
+	// List review comments on an invoice
+	// (GET /api/invoices/{id}/comments)
+	ListInvoiceComments(ctx context.Context, request ListInvoiceCommentsRequestObject) (ListInvoiceCommentsResponseObject, error)
+	// Comment on an invoice
+	// (POST /api/invoices/{id}/comments)
+	AddInvoiceComment(ctx context.Context, request AddInvoiceCommentRequestObject) (AddInvoiceCommentResponseObject, error)
+	// Remove a comment from an invoice
+	// (DELETE /api/invoices/{id}/comments/{commentId})
+	DeleteInvoiceComment(ctx context.Context, request DeleteInvoiceCommentRequestObject) (DeleteInvoiceCommentResponseObject, error)
+	// Issue a credit note against an invoice
+	// (POST /api/invoices/{id}/credit-notes)
+	CreateCreditNote(ctx context.Context, request CreateCreditNoteRequestObject) (CreateCreditNoteResponseObject, error)
+	// Export an invoice
+	// (POST /api/invoices/{id}/export)
+	ExportInvoice(ctx context.Context, request ExportInvoiceRequestObject) (ExportInvoiceResponseObject, error)
+	// Export invoice as CSV
+	// (GET /api/invoices/{id}/export/csv)
+	ExportInvoiceCSV(ctx context.Context, request ExportInvoiceCSVRequestObject) (ExportInvoiceCSVResponseObject, error)
+	// Export invoice to Google Sheets
+	// (POST /api/invoices/{id}/export/sheets)
+	ExportInvoiceSheets(ctx context.Context, request ExportInvoiceSheetsRequestObject) (ExportInvoiceSheetsResponseObject, error)
+	// List recorded exports of an invoice
+	// (GET /api/invoices/{id}/exports)
+	ListInvoiceExports(ctx context.Context, request ListInvoiceExportsRequestObject) (ListInvoiceExportsResponseObject, error)
+	// List payments recorded against an invoice
+	// (GET /api/invoices/{id}/payments)
+	ListInvoicePayments(ctx context.Context, request ListInvoicePaymentsRequestObject) (ListInvoicePaymentsResponseObject, error)
+	// Record a payment against an invoice
+	// (POST /api/invoices/{id}/payments)
+	RecordInvoicePayment(ctx context.Context, request RecordInvoicePaymentRequestObject) (RecordInvoicePaymentResponseObject, error)
+	// Change invoice status
+	// (PUT /api/invoices/{id}/status)
+	UpdateInvoiceStatus(ctx context.Context, request UpdateInvoiceStatusRequestObject) (UpdateInvoiceStatusResponseObject, error)
+	// List the user's organizations
+	// (GET /api/organizations)
+	ListOrganizations(ctx context.Context, request ListOrganizationsRequestObject) (ListOrganizationsResponseObject, error)
+	// Create an organization
+	// (POST /api/organizations)
+	CreateOrganization(ctx context.Context, request CreateOrganizationRequestObject) (CreateOrganizationResponseObject, error)
+	// Get an organization
+	// (GET /api/organizations/{id})
+	GetOrganization(ctx context.Context, request GetOrganizationRequestObject) (GetOrganizationResponseObject, error)
+	// Release an email domain
+	// (DELETE /api/organizations/{id}/domains/{domain})
+	DeleteOrganizationDomain(ctx context.Context, request DeleteOrganizationDomainRequestObject) (DeleteOrganizationDomainResponseObject, error)
+	// Claim an email domain
+	// (PUT /api/organizations/{id}/domains/{domain})
+	PutOrganizationDomain(ctx context.Context, request PutOrganizationDomainRequestObject) (PutOrganizationDomainResponseObject, error)
+	// Verify an email domain
+	// (POST /api/organizations/{id}/domains/{domain}/verify)
+	VerifyOrganizationDomain(ctx context.Context, request VerifyOrganizationDomainRequestObject) (VerifyOrganizationDomainResponseObject, error)
+	// Configure Google Workspace delegation
+	// (PUT /api/organizations/{id}/google-delegation)
+	UpdateOrganizationGoogleDelegation(ctx context.Context, request UpdateOrganizationGoogleDelegationRequestObject) (UpdateOrganizationGoogleDelegationResponseObject, error)
+	// Provision calendar sync for members
+	// (POST /api/organizations/{id}/google-delegation/provision)
+	ProvisionOrganizationCalendars(ctx context.Context, request ProvisionOrganizationCalendarsRequestObject) (ProvisionOrganizationCalendarsResponseObject, error)
+	// List an organization's members
+	// (GET /api/organizations/{id}/members)
+	ListOrganizationMembers(ctx context.Context, request ListOrganizationMembersRequestObject) (ListOrganizationMembersResponseObject, error)
+	// Set a member's delegated calendar selection
+	// (PUT /api/organizations/{id}/members/{user_id}/delegation)
+	UpdateOrganizationMemberDelegation(ctx context.Context, request UpdateOrganizationMemberDelegationRequestObject) (UpdateOrganizationMemberDelegationResponseObject, error)
+	// Transfer a project between members
+	// (POST /api/organizations/{id}/project-transfers)
+	TransferOrganizationProject(ctx context.Context, request TransferOrganizationProjectRequestObject) (TransferOrganizationProjectResponseObject, error)
+	// Rotate the SCIM token
+	// (POST /api/organizations/{id}/scim-token)
+	RotateOrganizationScimToken(ctx context.Context, request RotateOrganizationScimTokenRequestObject) (RotateOrganizationScimTokenResponseObject, error)
+	// Configure single sign-on
+	// (PUT /api/organizations/{id}/sso)
+	UpdateOrganizationSso(ctx context.Context, request UpdateOrganizationSsoRequestObject) (UpdateOrganizationSsoResponseObject, error)
+	// Delete a payment
+	// (DELETE /api/payments/{id})
+	DeletePayment(ctx context.Context, request DeletePaymentRequestObject) (DeletePaymentResponseObject, error)
+	// List all projects
+	// (GET /api/projects)
+	ListProjects(ctx context.Context, request ListProjectsRequestObject) (ListProjectsResponseObject, error)
+	// Create a new project
+	// (POST /api/projects)
+	CreateProject(ctx context.Context, request CreateProjectRequestObject) (CreateProjectResponseObject, error)
+	// Delete a project
+	// (DELETE /api/projects/{id})
+	DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error)
+	// Get a project by ID
+	// (GET /api/projects/{id})
+	GetProject(ctx context.Context, request GetProjectRequestObject) (GetProjectResponseObject, error)
+	// Update a project
+	// (PUT /api/projects/{id})
+	UpdateProject(ctx context.Context, request UpdateProjectRequestObject) (UpdateProjectResponseObject, error)
+	// Add a fingerprint to a project
+	// (POST /api/projects/{id}/fingerprints)
+	AddProjectFingerprint(ctx context.Context, request AddProjectFingerprintRequestObject) (AddProjectFingerprintResponseObject, error)
+	// Remove a fingerprint from a project
+	// (DELETE /api/projects/{id}/fingerprints/{kind}/{value})
+	RemoveProjectFingerprint(ctx context.Context, request RemoveProjectFingerprintRequestObject) (RemoveProjectFingerprintResponseObject, error)
+	// Estimated cost of recurring meetings
+	// (GET /api/reports/meeting-cost)
+	GetMeetingCostReport(ctx context.Context, request GetMeetingCostReportRequestObject) (GetMeetingCostReportResponseObject, error)
+	// Meeting load and focus time per day and week
+	// (GET /api/reports/meeting-load)
+	GetMeetingLoadReport(ctx context.Context, request GetMeetingLoadReportRequestObject) (GetMeetingLoadReportResponseObject, error)
+	// Utilization and capacity report
+	// (GET /api/reports/utilization)
+	GetUtilizationReport(ctx context.Context, request GetUtilizationReportRequestObject) (GetUtilizationReportResponseObject, error)
+	// Export rules and fingerprints as a named pack
+	// (POST /api/rule-packs/export)
+	ExportRulePack(ctx context.Context, request ExportRulePackRequestObject) (ExportRulePackResponseObject, error)
+	// Import a rule pack
+	// (POST /api/rule-packs/import)
+	ImportRulePack(ctx context.Context, request ImportRulePackRequestObject) (ImportRulePackResponseObject, error)
+	// List all classification rules
+	// (GET /api/rules)
+	ListRules(ctx context.Context, request ListRulesRequestObject) (ListRulesResponseObject, error)
+	// Create a new classification rule
+	// (POST /api/rules)
+	CreateRule(ctx context.Context, request CreateRuleRequestObject) (CreateRuleResponseObject, error)
+	// Apply classification rules to pending events
+	// (POST /api/rules/apply)
+	ApplyRules(ctx context.Context, request ApplyRulesRequestObject) (ApplyRulesResponseObject, error)
+	// Find rules that compete for the same events
+	// (GET /api/rules/conflicts)
+	GetRuleConflicts(ctx context.Context, request GetRuleConflictsRequestObject) (GetRuleConflictsResponseObject, error)
+	// Check a query for common problems
+	// (POST /api/rules/lint)
+	LintRule(ctx context.Context, request LintRuleRequestObject) (LintRuleResponseObject, error)
+	// Preview what events a rule would match
+	// (POST /api/rules/preview)
+	PreviewRule(ctx context.Context, request PreviewRuleRequestObject) (PreviewRuleResponseObject, error)
+	// Test a query against synthetic events
+	// (POST /api/rules/test)
+	TestRule(ctx context.Context, request TestRuleRequestObject) (TestRuleResponseObject, error)
+	// Delete a rule
+	// (DELETE /api/rules/{id})
+	DeleteRule(ctx context.Context, request DeleteRuleRequestObject) (DeleteRuleResponseObject, error)
+	// Get a rule by ID
+	// (GET /api/rules/{id})
+	GetRule(ctx context.Context, request GetRuleRequestObject) (GetRuleResponseObject, error)
+	// Update a rule
+	// (PUT /api/rules/{id})
+	UpdateRule(ctx context.Context, request UpdateRuleRequestObject) (UpdateRuleResponseObject, error)
+	// List classification snapshots
+	// (GET /api/snapshots)
+	ListClassificationSnapshots(ctx context.Context, request ListClassificationSnapshotsRequestObject) (ListClassificationSnapshotsResponseObject, error)
+	// Snapshot a week's classification state
+	// (POST /api/snapshots)
+	CreateClassificationSnapshot(ctx context.Context, request CreateClassificationSnapshotRequestObject) (CreateClassificationSnapshotResponseObject, error)
+	// Delete a classification snapshot
+	// (DELETE /api/snapshots/{id})
+	DeleteClassificationSnapshot(ctx context.Context, request DeleteClassificationSnapshotRequestObject) (DeleteClassificationSnapshotResponseObject, error)
+	// Restore a classification snapshot
+	// (POST /api/snapshots/{id}/restore)
+	RestoreClassificationSnapshot(ctx context.Context, request RestoreClassificationSnapshotRequestObject) (RestoreClassificationSnapshotResponseObject, error)
+	// List suppression rules
+	// (GET /api/suppression-rules)
+	ListSuppressionRules(ctx context.Context, request ListSuppressionRulesRequestObject) (ListSuppressionRulesResponseObject, error)
+	// Create a suppression rule
+	// (POST /api/suppression-rules)
+	CreateSuppressionRule(ctx context.Context, request CreateSuppressionRuleRequestObject) (CreateSuppressionRuleResponseObject, error)
+	// Delete a suppression rule
+	// (DELETE /api/suppression-rules/{id})
+	DeleteSuppressionRule(ctx context.Context, request DeleteSuppressionRuleRequestObject) (DeleteSuppressionRuleResponseObject, error)
+	// Enable or disable a suppression rule
+	// (PUT /api/suppression-rules/{id})
+	UpdateSuppressionRule(ctx context.Context, request UpdateSuppressionRuleRequestObject) (UpdateSuppressionRuleResponseObject, error)
+	// List tags
+	// (GET /api/tags)
+	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+	// Create a tag
+	// (POST /api/tags)
+	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
+	// Delete a tag
+	// (DELETE /api/tags/{id})
+	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
+	// Rename a tag
+	// (PATCH /api/tags/{id})
+	RenameTag(ctx context.Context, request RenameTagRequestObject) (RenameTagResponseObject, error)
+	// List time entries
+	// (GET /api/time-entries)
+	ListTimeEntries(ctx context.Context, request ListTimeEntriesRequestObject) (ListTimeEntriesResponseObject, error)
+	// Create a new time entry
+	// (POST /api/time-entries)
+	CreateTimeEntry(ctx context.Context, request CreateTimeEntryRequestObject) (CreateTimeEntryResponseObject, error)
+	// Suggest descriptions from GitHub activity
+	// (GET /api/time-entries/description-suggestions)
+	SuggestDescriptions(ctx context.Context, request SuggestDescriptionsRequestObject) (SuggestDescriptionsResponseObject, error)
+	// Parse a time entry from text
+	// (POST /api/time-entries/parse)
+	ParseTimeEntry(ctx context.Context, request ParseTimeEntryRequestObject) (ParseTimeEntryResponseObject, error)
+	// Recompute time entries in the background
+	// (POST /api/time-entries/recalculate)
+	RecalculateTimeEntries(ctx context.Context, request RecalculateTimeEntriesRequestObject) (RecalculateTimeEntriesResponseObject, error)
+	// Get a background recalculation job
+	// (GET /api/time-entries/recalculate/jobs/{id})
+	GetRecalculationJob(ctx context.Context, request GetRecalculationJobRequestObject) (GetRecalculationJobResponseObject, error)
+	// List entries whose hours diverge from computed hours
+	// (GET /api/time-entries/reconciliation)
+	GetReconciliationReport(ctx context.Context, request GetReconciliationReportRequestObject) (GetReconciliationReportResponseObject, error)
+	// Delete a time entry
+	// (DELETE /api/time-entries/{id})
+	DeleteTimeEntry(ctx context.Context, request DeleteTimeEntryRequestObject) (DeleteTimeEntryResponseObject, error)
+	// Get a time entry by ID
+	// (GET /api/time-entries/{id})
+	GetTimeEntry(ctx context.Context, request GetTimeEntryRequestObject) (GetTimeEntryResponseObject, error)
+	// Update a time entry
+	// (PUT /api/time-entries/{id})
+	UpdateTimeEntry(ctx context.Context, request UpdateTimeEntryRequestObject) (UpdateTimeEntryResponseObject, error)
+	// Show how a time entry's computed hours were derived
+	// (GET /api/time-entries/{id}/breakdown)
+	GetTimeEntryBreakdown(ctx context.Context, request GetTimeEntryBreakdownRequestObject) (GetTimeEntryBreakdownResponseObject, error)
+	// Explain why a time entry has its hours
+	// (GET /api/time-entries/{id}/explain)
+	ExplainTimeEntry(ctx context.Context, request ExplainTimeEntryRequestObject) (ExplainTimeEntryResponseObject, error)
+	// List notes on a time entry
+	// (GET /api/time-entries/{id}/notes)
+	ListTimeEntryNotes(ctx context.Context, request ListTimeEntryNotesRequestObject) (ListTimeEntryNotesResponseObject, error)
+	// Add a note to a time entry
+	// (POST /api/time-entries/{id}/notes)
+	AddTimeEntryNote(ctx context.Context, request AddTimeEntryNoteRequestObject) (AddTimeEntryNoteResponseObject, error)
+	// Remove a note from a time entry
+	// (DELETE /api/time-entries/{id}/notes/{noteId})
+	DeleteTimeEntryNote(ctx context.Context, request DeleteTimeEntryNoteRequestObject) (DeleteTimeEntryNoteResponseObject, error)
+	// Resolve a divergent time entry
+	// (POST /api/time-entries/{id}/reconcile)
+	ReconcileTimeEntry(ctx context.Context, request ReconcileTimeEntryRequestObject) (ReconcileTimeEntryResponseObject, error)
+	// Reset time entry to computed values from events
+	// (POST /api/time-entries/{id}/refresh)
+	RefreshTimeEntry(ctx context.Context, request RefreshTimeEntryRequestObject) (RefreshTimeEntryResponseObject, error)
+	// Split a time entry across projects
+	// (POST /api/time-entries/{id}/split)
+	SplitTimeEntry(ctx context.Context, request SplitTimeEntryRequestObject) (SplitTimeEntryResponseObject, error)
+	// Replace the tags on a time entry
+	// (PUT /api/time-entries/{id}/tags)
+	SetTimeEntryTags(ctx context.Context, request SetTimeEntryTagsRequestObject) (SetTimeEntryTagsResponseObject, error)
+	// List deleted time entries and rules
+	// (GET /api/trash)
+	ListTrash(ctx context.Context, request ListTrashRequestObject) (ListTrashResponseObject, error)
+	// Restore a deleted rule
+	// (POST /api/trash/rules/{id}/restore)
+	RestoreRule(ctx context.Context, request RestoreRuleRequestObject) (RestoreRuleResponseObject, error)
+	// Restore a deleted time entry
+	// (POST /api/trash/time-entries/{id}/restore)
+	RestoreTimeEntry(ctx context.Context, request RestoreTimeEntryRequestObject) (RestoreTimeEntryResponseObject, error)
+	// Get a project by day hours grid for a week
+	// (GET /api/weeks/{start})
+	GetWeekGrid(ctx context.Context, request GetWeekGridRequestObject) (GetWeekGridResponseObject, error)
+	// Get the working-hours profile
+	// (GET /api/working-hours)
+	GetWorkingHours(ctx context.Context, request GetWorkingHoursRequestObject) (GetWorkingHoursResponseObject, error)
+	// Set the working-hours profile
+	// (PUT /api/working-hours)
+	UpdateWorkingHours(ctx context.Context, request UpdateWorkingHoursRequestObject) (UpdateWorkingHoursResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListClassificationActions operation middleware
+func (sh *strictHandler) ListClassificationActions(w http.ResponseWriter, r *http.Request, params ListClassificationActionsParams) {
+	var request ListClassificationActionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClassificationActions(ctx, request.(ListClassificationActionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClassificationActions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClassificationActionsResponseObject); ok {
+		if err := validResponse.VisitListClassificationActionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UndoClassificationAction operation middleware
+func (sh *strictHandler) UndoClassificationAction(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UndoClassificationActionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UndoClassificationAction(ctx, request.(UndoClassificationActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UndoClassificationAction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UndoClassificationActionResponseObject); ok {
+		if err := validResponse.VisitUndoClassificationActionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAnomalies operation middleware
+func (sh *strictHandler) ListAnomalies(w http.ResponseWriter, r *http.Request, params ListAnomaliesParams) {
+	var request ListAnomaliesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAnomalies(ctx, request.(ListAnomaliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAnomalies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAnomaliesResponseObject); ok {
+		if err := validResponse.VisitListAnomaliesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AnalyzeAnomalies operation middleware
+func (sh *strictHandler) AnalyzeAnomalies(w http.ResponseWriter, r *http.Request) {
+	var request AnalyzeAnomaliesRequestObject
+
+	var body AnalyzeAnomaliesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AnalyzeAnomalies(ctx, request.(AnalyzeAnomaliesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnalyzeAnomalies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AnalyzeAnomaliesResponseObject); ok {
+		if err := validResponse.VisitAnalyzeAnomaliesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DismissAnomaly operation middleware
+func (sh *strictHandler) DismissAnomaly(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DismissAnomalyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DismissAnomaly(ctx, request.(DismissAnomalyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DismissAnomaly")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DismissAnomalyResponseObject); ok {
+		if err := validResponse.VisitDismissAnomalyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListApiKeys operation middleware
+func (sh *strictHandler) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListApiKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApiKeys(ctx, request.(ListApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListApiKeysResponseObject); ok {
+		if err := validResponse.VisitListApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateApiKey operation middleware
+func (sh *strictHandler) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateApiKeyRequestObject
+
+	var body CreateApiKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApiKey(ctx, request.(CreateApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateApiKeyResponseObject); ok {
+		if err := validResponse.VisitCreateApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiKey operation middleware
+func (sh *strictHandler) DeleteApiKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteApiKeyRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiKey(ctx, request.(DeleteApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiKeyResponseObject); ok {
+		if err := validResponse.VisitDeleteApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateApiKey operation middleware
+func (sh *strictHandler) UpdateApiKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateApiKeyRequestObject
+
+	request.Id = id
+
+	var body UpdateApiKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateApiKey(ctx, request.(UpdateApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateApiKeyResponseObject); ok {
+		if err := validResponse.VisitUpdateApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAttendeeAliases operation middleware
+func (sh *strictHandler) ListAttendeeAliases(w http.ResponseWriter, r *http.Request) {
+	var request ListAttendeeAliasesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAttendeeAliases(ctx, request.(ListAttendeeAliasesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAttendeeAliases")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAttendeeAliasesResponseObject); ok {
+		if err := validResponse.VisitListAttendeeAliasesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAttendeeAlias operation middleware
+func (sh *strictHandler) CreateAttendeeAlias(w http.ResponseWriter, r *http.Request) {
+	var request CreateAttendeeAliasRequestObject
+
+	var body CreateAttendeeAliasJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAttendeeAlias(ctx, request.(CreateAttendeeAliasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAttendeeAlias")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAttendeeAliasResponseObject); ok {
+		if err := validResponse.VisitCreateAttendeeAliasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAttendeeAlias operation middleware
+func (sh *strictHandler) DeleteAttendeeAlias(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAttendeeAliasRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAttendeeAlias(ctx, request.(DeleteAttendeeAliasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAttendeeAlias")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAttendeeAliasResponseObject); ok {
+		if err := validResponse.VisitDeleteAttendeeAliasResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCompanyDomains operation middleware
+func (sh *strictHandler) GetCompanyDomains(w http.ResponseWriter, r *http.Request) {
+	var request GetCompanyDomainsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCompanyDomains(ctx, request.(GetCompanyDomainsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCompanyDomains")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCompanyDomainsResponseObject); ok {
+		if err := validResponse.VisitGetCompanyDomainsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCompanyDomains operation middleware
+func (sh *strictHandler) UpdateCompanyDomains(w http.ResponseWriter, r *http.Request) {
+	var request UpdateCompanyDomainsRequestObject
+
+	var body UpdateCompanyDomainsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCompanyDomains(ctx, request.(UpdateCompanyDomainsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCompanyDomains")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCompanyDomainsResponseObject); ok {
+		if err := validResponse.VisitUpdateCompanyDomainsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GoogleAuthorize operation middleware
+func (sh *strictHandler) GoogleAuthorize(w http.ResponseWriter, r *http.Request, params GoogleAuthorizeParams) {
+	var request GoogleAuthorizeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GoogleAuthorize(ctx, request.(GoogleAuthorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GoogleAuthorize")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GoogleAuthorizeResponseObject); ok {
+		if err := validResponse.VisitGoogleAuthorizeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GoogleCallback operation middleware
+func (sh *strictHandler) GoogleCallback(w http.ResponseWriter, r *http.Request, params GoogleCallbackParams) {
+	var request GoogleCallbackRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GoogleCallback(ctx, request.(GoogleCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GoogleCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GoogleCallbackResponseObject); ok {
+		if err := validResponse.VisitGoogleCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLocale operation middleware
+func (sh *strictHandler) GetLocale(w http.ResponseWriter, r *http.Request) {
+	var request GetLocaleRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLocale(ctx, request.(GetLocaleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLocale")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLocaleResponseObject); ok {
+		if err := validResponse.VisitGetLocaleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateLocale operation middleware
+func (sh *strictHandler) UpdateLocale(w http.ResponseWriter, r *http.Request) {
+	var request UpdateLocaleRequestObject
+
+	var body UpdateLocaleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateLocale(ctx, request.(UpdateLocaleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateLocale")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateLocaleResponseObject); ok {
+		if err := validResponse.VisitUpdateLocaleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Logout operation middleware
+func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var request LogoutRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentUserRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRetentionSettings operation middleware
+func (sh *strictHandler) GetRetentionSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetRetentionSettingsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRetentionSettings(ctx, request.(GetRetentionSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRetentionSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRetentionSettingsResponseObject); ok {
+		if err := validResponse.VisitGetRetentionSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateRetentionSettings operation middleware
+func (sh *strictHandler) UpdateRetentionSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateRetentionSettingsRequestObject
+
+	var body UpdateRetentionSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateRetentionSettings(ctx, request.(UpdateRetentionSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateRetentionSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateRetentionSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateRetentionSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewRetention operation middleware
+func (sh *strictHandler) PreviewRetention(w http.ResponseWriter, r *http.Request, params PreviewRetentionParams) {
+	var request PreviewRetentionRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewRetention(ctx, request.(PreviewRetentionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewRetention")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PreviewRetentionResponseObject); ok {
+		if err := validResponse.VisitPreviewRetentionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Signup operation middleware
+func (sh *strictHandler) Signup(w http.ResponseWriter, r *http.Request) {
+	var request SignupRequestObject
+
+	var body SignupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Signup(ctx, request.(SignupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Signup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SignupResponseObject); ok {
+		if err := validResponse.VisitSignupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StartSso operation middleware
+func (sh *strictHandler) StartSso(w http.ResponseWriter, r *http.Request) {
+	var request StartSsoRequestObject
+
+	var body StartSsoJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartSso(ctx, request.(StartSsoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartSso")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartSsoResponseObject); ok {
+		if err := validResponse.VisitStartSsoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSyncSettings operation middleware
+func (sh *strictHandler) GetSyncSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetSyncSettingsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSyncSettings(ctx, request.(GetSyncSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSyncSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSyncSettingsResponseObject); ok {
+		if err := validResponse.VisitGetSyncSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateSyncSettings operation middleware
+func (sh *strictHandler) UpdateSyncSettings(w http.ResponseWriter, r *http.Request) {
+	var request UpdateSyncSettingsRequestObject
+
+	var body UpdateSyncSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSyncSettings(ctx, request.(UpdateSyncSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSyncSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateSyncSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateSyncSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListBillingPeriods operation middleware
+func (sh *strictHandler) ListBillingPeriods(w http.ResponseWriter, r *http.Request, params ListBillingPeriodsParams) {
+	var request ListBillingPeriodsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBillingPeriods(ctx, request.(ListBillingPeriodsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBillingPeriods")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListBillingPeriodsResponseObject); ok {
+		if err := validResponse.VisitListBillingPeriodsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateBillingPeriod operation middleware
+func (sh *strictHandler) CreateBillingPeriod(w http.ResponseWriter, r *http.Request) {
+	var request CreateBillingPeriodRequestObject
+
+	var body CreateBillingPeriodJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateBillingPeriod(ctx, request.(CreateBillingPeriodRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateBillingPeriod")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateBillingPeriodResponseObject); ok {
+		if err := validResponse.VisitCreateBillingPeriodResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteBillingPeriod operation middleware
+func (sh *strictHandler) DeleteBillingPeriod(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteBillingPeriodRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBillingPeriod(ctx, request.(DeleteBillingPeriodRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBillingPeriod")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteBillingPeriodResponseObject); ok {
+		if err := validResponse.VisitDeleteBillingPeriodResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateBillingPeriod operation middleware
+func (sh *strictHandler) UpdateBillingPeriod(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateBillingPeriodRequestObject
+
+	request.Id = id
+
+	var body UpdateBillingPeriodJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateBillingPeriod(ctx, request.(UpdateBillingPeriodRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateBillingPeriod")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateBillingPeriodResponseObject); ok {
+		if err := validResponse.VisitUpdateBillingPeriodResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListClientBalances operation middleware
+func (sh *strictHandler) ListClientBalances(w http.ResponseWriter, r *http.Request, params ListClientBalancesParams) {
+	var request ListClientBalancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClientBalances(ctx, request.(ListClientBalancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClientBalances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClientBalancesResponseObject); ok {
+		if err := validResponse.VisitListClientBalancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCalendarEvents operation middleware
+func (sh *strictHandler) ListCalendarEvents(w http.ResponseWriter, r *http.Request, params ListCalendarEventsParams) {
+	var request ListCalendarEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCalendarEvents(ctx, request.(ListCalendarEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCalendarEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCalendarEventsResponseObject); ok {
+		if err := validResponse.VisitListCalendarEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BulkClassifyEvents operation middleware
+func (sh *strictHandler) BulkClassifyEvents(w http.ResponseWriter, r *http.Request) {
+	var request BulkClassifyEventsRequestObject
+
+	var body BulkClassifyEventsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.BulkClassifyEvents(ctx, request.(BulkClassifyEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BulkClassifyEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(BulkClassifyEventsResponseObject); ok {
+		if err := validResponse.VisitBulkClassifyEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DiffCalendarEvents operation middleware
+func (sh *strictHandler) DiffCalendarEvents(w http.ResponseWriter, r *http.Request, params DiffCalendarEventsParams) {
+	var request DiffCalendarEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffCalendarEvents(ctx, request.(DiffCalendarEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffCalendarEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffCalendarEventsResponseObject); ok {
+		if err := validResponse.VisitDiffCalendarEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReviewQueue operation middleware
+func (sh *strictHandler) GetReviewQueue(w http.ResponseWriter, r *http.Request, params GetReviewQueueParams) {
+	var request GetReviewQueueRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReviewQueue(ctx, request.(GetReviewQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReviewQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReviewQueueResponseObject); ok {
+		if err := validResponse.VisitGetReviewQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEventChanges operation middleware
+func (sh *strictHandler) ListEventChanges(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListEventChangesRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEventChanges(ctx, request.(ListEventChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEventChanges")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEventChangesResponseObject); ok {
+		if err := validResponse.VisitListEventChangesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ClassifyCalendarEvent operation middleware
+func (sh *strictHandler) ClassifyCalendarEvent(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ClassifyCalendarEventRequestObject
+
+	request.Id = id
+
+	var body ClassifyCalendarEventJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ClassifyCalendarEvent(ctx, request.(ClassifyCalendarEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClassifyCalendarEvent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ClassifyCalendarEventResponseObject); ok {
+		if err := validResponse.VisitClassifyCalendarEventResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExplainEventClassification operation middleware
+func (sh *strictHandler) ExplainEventClassification(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ExplainEventClassificationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExplainEventClassification(ctx, request.(ExplainEventClassificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExplainEventClassification")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExplainEventClassificationResponseObject); ok {
+		if err := validResponse.VisitExplainEventClassificationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEventClassificationHistory operation middleware
+func (sh *strictHandler) ListEventClassificationHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListEventClassificationHistoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEventClassificationHistory(ctx, request.(ListEventClassificationHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEventClassificationHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEventClassificationHistoryResponseObject); ok {
+		if err := validResponse.VisitListEventClassificationHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReviewCalendarEvent operation middleware
+func (sh *strictHandler) ReviewCalendarEvent(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ReviewCalendarEventRequestObject
+
+	request.Id = id
+
+	var body ReviewCalendarEventJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReviewCalendarEvent(ctx, request.(ReviewCalendarEventRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReviewCalendarEvent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReviewCalendarEventResponseObject); ok {
+		if err := validResponse.VisitReviewCalendarEventResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetEventSuppression operation middleware
+func (sh *strictHandler) SetEventSuppression(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SetEventSuppressionRequestObject
+
+	request.Id = id
+
+	var body SetEventSuppressionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetEventSuppression(ctx, request.(SetEventSuppressionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetEventSuppression")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetEventSuppressionResponseObject); ok {
+		if err := validResponse.VisitSetEventSuppressionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetEventTags operation middleware
+func (sh *strictHandler) SetEventTags(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SetEventTagsRequestObject
+
+	request.Id = id
+
+	var body SetEventTagsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetEventTags(ctx, request.(SetEventTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetEventTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetEventTagsResponseObject); ok {
+		if err := validResponse.VisitSetEventTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCalendarConnections operation middleware
+func (sh *strictHandler) ListCalendarConnections(w http.ResponseWriter, r *http.Request) {
+	var request ListCalendarConnectionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCalendarConnections(ctx, request.(ListCalendarConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCalendarConnections")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCalendarConnectionsResponseObject); ok {
+		if err := validResponse.VisitListCalendarConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListConnectionsNeedingReauth operation middleware
+func (sh *strictHandler) ListConnectionsNeedingReauth(w http.ResponseWriter, r *http.Request) {
+	var request ListConnectionsNeedingReauthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListConnectionsNeedingReauth(ctx, request.(ListConnectionsNeedingReauthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListConnectionsNeedingReauth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListConnectionsNeedingReauthResponseObject); ok {
+		if err := validResponse.VisitListConnectionsNeedingReauthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCalendarConnection operation middleware
+func (sh *strictHandler) DeleteCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteCalendarConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCalendarConnection(ctx, request.(DeleteCalendarConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCalendarConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCalendarConnectionResponseObject); ok {
+		if err := validResponse.VisitDeleteCalendarConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PauseCalendarConnection operation middleware
+func (sh *strictHandler) PauseCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PauseCalendarConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseCalendarConnection(ctx, request.(PauseCalendarConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseCalendarConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseCalendarConnectionResponseObject); ok {
+		if err := validResponse.VisitPauseCalendarConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReauthorizeCalendarConnection operation middleware
+func (sh *strictHandler) ReauthorizeCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ReauthorizeCalendarConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReauthorizeCalendarConnection(ctx, request.(ReauthorizeCalendarConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReauthorizeCalendarConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReauthorizeCalendarConnectionResponseObject); ok {
+		if err := validResponse.VisitReauthorizeCalendarConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeCalendarConnection operation middleware
+func (sh *strictHandler) ResumeCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ResumeCalendarConnectionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeCalendarConnection(ctx, request.(ResumeCalendarConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeCalendarConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeCalendarConnectionResponseObject); ok {
+		if err := validResponse.VisitResumeCalendarConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCalendarSources operation middleware
+func (sh *strictHandler) ListCalendarSources(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListCalendarSourcesRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCalendarSources(ctx, request.(ListCalendarSourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCalendarSources")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCalendarSourcesResponseObject); ok {
+		if err := validResponse.VisitListCalendarSourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCalendarSources operation middleware
+func (sh *strictHandler) UpdateCalendarSources(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateCalendarSourcesRequestObject
+
+	request.Id = id
+
+	var body UpdateCalendarSourcesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCalendarSources(ctx, request.(UpdateCalendarSourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCalendarSources")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCalendarSourcesResponseObject); ok {
+		if err := validResponse.VisitUpdateCalendarSourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCalendarSource operation middleware
+func (sh *strictHandler) UpdateCalendarSource(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, calendarId openapi_types.UUID) {
+	var request UpdateCalendarSourceRequestObject
+
+	request.Id = id
+	request.CalendarId = calendarId
+
+	var body UpdateCalendarSourceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCalendarSource(ctx, request.(UpdateCalendarSourceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCalendarSource")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCalendarSourceResponseObject); ok {
+		if err := validResponse.VisitUpdateCalendarSourceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SyncCalendar operation middleware
+func (sh *strictHandler) SyncCalendar(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params SyncCalendarParams) {
+	var request SyncCalendarRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SyncCalendar(ctx, request.(SyncCalendarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SyncCalendar")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SyncCalendarResponseObject); ok {
+		if err := validResponse.VisitSyncCalendarResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCalendarSyncWindow operation middleware
+func (sh *strictHandler) UpdateCalendarSyncWindow(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateCalendarSyncWindowRequestObject
+
+	request.Id = id
+
+	var body UpdateCalendarSyncWindowJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCalendarSyncWindow(ctx, request.(UpdateCalendarSyncWindowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCalendarSyncWindow")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCalendarSyncWindowResponseObject); ok {
+		if err := validResponse.VisitUpdateCalendarSyncWindowResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCalendarWriteBack operation middleware
+func (sh *strictHandler) UpdateCalendarWriteBack(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateCalendarWriteBackRequestObject
+
+	request.Id = id
+
+	var body UpdateCalendarWriteBackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCalendarWriteBack(ctx, request.(UpdateCalendarWriteBackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCalendarWriteBack")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCalendarWriteBackResponseObject); ok {
+		if err := validResponse.VisitUpdateCalendarWriteBackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListChanges operation middleware
+func (sh *strictHandler) ListChanges(w http.ResponseWriter, r *http.Request, params ListChangesParams) {
+	var request ListChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListChanges(ctx, request.(ListChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListChanges")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListChangesResponseObject); ok {
+		if err := validResponse.VisitListChangesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyRulesAsync operation middleware
+func (sh *strictHandler) ApplyRulesAsync(w http.ResponseWriter, r *http.Request) {
+	var request ApplyRulesAsyncRequestObject
+
+	var body ApplyRulesAsyncJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyRulesAsync(ctx, request.(ApplyRulesAsyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyRulesAsync")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyRulesAsyncResponseObject); ok {
+		if err := validResponse.VisitApplyRulesAsyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClassificationJob operation middleware
+func (sh *strictHandler) GetClassificationJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetClassificationJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClassificationJob(ctx, request.(GetClassificationJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClassificationJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClassificationJobResponseObject); ok {
+		if err := validResponse.VisitGetClassificationJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListClientRates operation middleware
+func (sh *strictHandler) ListClientRates(w http.ResponseWriter, r *http.Request, params ListClientRatesParams) {
+	var request ListClientRatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClientRates(ctx, request.(ListClientRatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClientRates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClientRatesResponseObject); ok {
+		if err := validResponse.VisitListClientRatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateClientRate operation middleware
+func (sh *strictHandler) CreateClientRate(w http.ResponseWriter, r *http.Request) {
+	var request CreateClientRateRequestObject
+
+	var body CreateClientRateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateClientRate(ctx, request.(CreateClientRateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateClientRate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateClientRateResponseObject); ok {
+		if err := validResponse.VisitCreateClientRateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteClientRate operation middleware
+func (sh *strictHandler) DeleteClientRate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteClientRateRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteClientRate(ctx, request.(DeleteClientRateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteClientRate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteClientRateResponseObject); ok {
+		if err := validResponse.VisitDeleteClientRateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateClientRate operation middleware
+func (sh *strictHandler) UpdateClientRate(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateClientRateRequestObject
+
+	request.Id = id
+
+	var body UpdateClientRateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateClientRate(ctx, request.(UpdateClientRateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateClientRate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateClientRateResponseObject); ok {
+		if err := validResponse.VisitUpdateClientRateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportConfig operation middleware
+func (sh *strictHandler) ExportConfig(w http.ResponseWriter, r *http.Request, params ExportConfigParams) {
+	var request ExportConfigRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportConfig(ctx, request.(ExportConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportConfigResponseObject); ok {
+		if err := validResponse.VisitExportConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportConfig operation middleware
+func (sh *strictHandler) ImportConfig(w http.ResponseWriter, r *http.Request) {
+	var request ImportConfigRequestObject
+
+	var body ImportConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportConfig(ctx, request.(ImportConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ImportConfigResponseObject); ok {
+		if err := validResponse.VisitImportConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDaySummary operation middleware
+func (sh *strictHandler) GetDaySummary(w http.ResponseWriter, r *http.Request, date openapi_types.Date) {
+	var request GetDaySummaryRequestObject
+
+	request.Date = date
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDaySummary(ctx, request.(GetDaySummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDaySummary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDaySummaryResponseObject); ok {
+		if err := validResponse.VisitGetDaySummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListExpenses operation middleware
+func (sh *strictHandler) ListExpenses(w http.ResponseWriter, r *http.Request, params ListExpensesParams) {
+	var request ListExpensesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListExpenses(ctx, request.(ListExpensesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListExpenses")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListExpensesResponseObject); ok {
+		if err := validResponse.VisitListExpensesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateExpense operation middleware
+func (sh *strictHandler) CreateExpense(w http.ResponseWriter, r *http.Request) {
+	var request CreateExpenseRequestObject
+
+	var body CreateExpenseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateExpense(ctx, request.(CreateExpenseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateExpense")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateExpenseResponseObject); ok {
+		if err := validResponse.VisitCreateExpenseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteExpense operation middleware
+func (sh *strictHandler) DeleteExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteExpenseRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteExpense(ctx, request.(DeleteExpenseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteExpense")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteExpenseResponseObject); ok {
+		if err := validResponse.VisitDeleteExpenseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExpense operation middleware
+func (sh *strictHandler) GetExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetExpenseRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExpense(ctx, request.(GetExpenseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExpense")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExpenseResponseObject); ok {
+		if err := validResponse.VisitGetExpenseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateExpense operation middleware
+func (sh *strictHandler) UpdateExpense(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateExpenseRequestObject
+
+	request.Id = id
+
+	var body UpdateExpenseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateExpense(ctx, request.(UpdateExpenseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateExpense")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateExpenseResponseObject); ok {
+		if err := validResponse.VisitUpdateExpenseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListFingerprintClaims operation middleware
+func (sh *strictHandler) ListFingerprintClaims(w http.ResponseWriter, r *http.Request, params ListFingerprintClaimsParams) {
+	var request ListFingerprintClaimsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFingerprintClaims(ctx, request.(ListFingerprintClaimsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFingerprintClaims")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFingerprintClaimsResponseObject); ok {
+		if err := validResponse.VisitListFingerprintClaimsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LogFocusSession operation middleware
+func (sh *strictHandler) LogFocusSession(w http.ResponseWriter, r *http.Request) {
+	var request LogFocusSessionRequestObject
+
+	var body LogFocusSessionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LogFocusSession(ctx, request.(LogFocusSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogFocusSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogFocusSessionResponseObject); ok {
+		if err := validResponse.VisitLogFocusSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RecordFocusGaps operation middleware
+func (sh *strictHandler) RecordFocusGaps(w http.ResponseWriter, r *http.Request) {
+	var request RecordFocusGapsRequestObject
+
+	var body RecordFocusGapsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RecordFocusGaps(ctx, request.(RecordFocusGapsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecordFocusGaps")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RecordFocusGapsResponseObject); ok {
+		if err := validResponse.VisitRecordFocusGapsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListGoals operation middleware
+func (sh *strictHandler) ListGoals(w http.ResponseWriter, r *http.Request) {
+	var request ListGoalsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListGoals(ctx, request.(ListGoalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListGoals")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListGoalsResponseObject); ok {
+		if err := validResponse.VisitListGoalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateGoal operation middleware
+func (sh *strictHandler) CreateGoal(w http.ResponseWriter, r *http.Request) {
+	var request CreateGoalRequestObject
+
+	var body CreateGoalJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGoal(ctx, request.(CreateGoalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGoal")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateGoalResponseObject); ok {
+		if err := validResponse.VisitCreateGoalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGoalsProgress operation middleware
+func (sh *strictHandler) GetGoalsProgress(w http.ResponseWriter, r *http.Request, params GetGoalsProgressParams) {
+	var request GetGoalsProgressRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGoalsProgress(ctx, request.(GetGoalsProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGoalsProgress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGoalsProgressResponseObject); ok {
+		if err := validResponse.VisitGetGoalsProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteGoal operation middleware
+func (sh *strictHandler) DeleteGoal(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteGoalRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGoal(ctx, request.(DeleteGoalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGoal")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteGoalResponseObject); ok {
+		if err := validResponse.VisitDeleteGoalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateGoal operation middleware
+func (sh *strictHandler) UpdateGoal(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateGoalRequestObject
+
+	request.Id = id
+
+	var body UpdateGoalJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGoal(ctx, request.(UpdateGoalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGoal")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateGoalResponseObject); ok {
+		if err := validResponse.VisitUpdateGoalResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListIntegrations operation middleware
+func (sh *strictHandler) ListIntegrations(w http.ResponseWriter, r *http.Request) {
+	var request ListIntegrationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListIntegrations(ctx, request.(ListIntegrationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListIntegrations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListIntegrationsResponseObject); ok {
+		if err := validResponse.VisitListIntegrationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DisconnectGitHub operation middleware
+func (sh *strictHandler) DisconnectGitHub(w http.ResponseWriter, r *http.Request) {
+	var request DisconnectGitHubRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DisconnectGitHub(ctx, request.(DisconnectGitHubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DisconnectGitHub")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DisconnectGitHubResponseObject); ok {
+		if err := validResponse.VisitDisconnectGitHubResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGitHubConnection operation middleware
+func (sh *strictHandler) GetGitHubConnection(w http.ResponseWriter, r *http.Request) {
+	var request GetGitHubConnectionRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGitHubConnection(ctx, request.(GetGitHubConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGitHubConnection")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGitHubConnectionResponseObject); ok {
+		if err := validResponse.VisitGetGitHubConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConnectGitHub operation middleware
+func (sh *strictHandler) ConnectGitHub(w http.ResponseWriter, r *http.Request) {
+	var request ConnectGitHubRequestObject
+
+	var body ConnectGitHubJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConnectGitHub(ctx, request.(ConnectGitHubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConnectGitHub")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConnectGitHubResponseObject); ok {
+		if err := validResponse.VisitConnectGitHubResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DisconnectIntegration operation middleware
+func (sh *strictHandler) DisconnectIntegration(w http.ResponseWriter, r *http.Request, provider string) {
+	var request DisconnectIntegrationRequestObject
+
+	request.Provider = provider
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DisconnectIntegration(ctx, request.(DisconnectIntegrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DisconnectIntegration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DisconnectIntegrationResponseObject); ok {
+		if err := validResponse.VisitDisconnectIntegrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConnectIntegration operation middleware
+func (sh *strictHandler) ConnectIntegration(w http.ResponseWriter, r *http.Request, provider string) {
+	var request ConnectIntegrationRequestObject
+
+	request.Provider = provider
+
+	var body ConnectIntegrationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConnectIntegration(ctx, request.(ConnectIntegrationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConnectIntegration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConnectIntegrationResponseObject); ok {
+		if err := validResponse.VisitConnectIntegrationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DownloadInvoiceExport operation middleware
+func (sh *strictHandler) DownloadInvoiceExport(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DownloadInvoiceExportRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadInvoiceExport(ctx, request.(DownloadInvoiceExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadInvoiceExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadInvoiceExportResponseObject); ok {
+		if err := validResponse.VisitDownloadInvoiceExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInvoices operation middleware
+func (sh *strictHandler) ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams) {
+	var request ListInvoicesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvoices(ctx, request.(ListInvoicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvoices")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInvoicesResponseObject); ok {
+		if err := validResponse.VisitListInvoicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateInvoice operation middleware
+func (sh *strictHandler) CreateInvoice(w http.ResponseWriter, r *http.Request) {
+	var request CreateInvoiceRequestObject
+
+	var body CreateInvoiceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateInvoice(ctx, request.(CreateInvoiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateInvoice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateInvoiceResponseObject); ok {
+		if err := validResponse.VisitCreateInvoiceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteInvoice operation middleware
+func (sh *strictHandler) DeleteInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteInvoiceRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteInvoice(ctx, request.(DeleteInvoiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteInvoice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteInvoiceResponseObject); ok {
+		if err := validResponse.VisitDeleteInvoiceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetInvoice operation middleware
+func (sh *strictHandler) GetInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetInvoiceRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInvoice(ctx, request.(GetInvoiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInvoice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetInvoiceResponseObject); ok {
+		if err := validResponse.VisitGetInvoiceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddInvoiceAdjustment operation middleware
+func (sh *strictHandler) AddInvoiceAdjustment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request AddInvoiceAdjustmentRequestObject
+
+	request.Id = id
+
+	var body AddInvoiceAdjustmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddInvoiceAdjustment(ctx, request.(AddInvoiceAdjustmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddInvoiceAdjustment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddInvoiceAdjustmentResponseObject); ok {
+		if err := validResponse.VisitAddInvoiceAdjustmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteInvoiceAdjustment operation middleware
+func (sh *strictHandler) DeleteInvoiceAdjustment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, lineItemId openapi_types.UUID) {
+	var request DeleteInvoiceAdjustmentRequestObject
+
+	request.Id = id
+	request.LineItemId = lineItemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteInvoiceAdjustment(ctx, request.(DeleteInvoiceAdjustmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteInvoiceAdjustment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteInvoiceAdjustmentResponseObject); ok {
+		if err := validResponse.VisitDeleteInvoiceAdjustmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInvoiceComments operation middleware
+func (sh *strictHandler) ListInvoiceComments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListInvoiceCommentsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvoiceComments(ctx, request.(ListInvoiceCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvoiceComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInvoiceCommentsResponseObject); ok {
+		if err := validResponse.VisitListInvoiceCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddInvoiceComment operation middleware
+func (sh *strictHandler) AddInvoiceComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request AddInvoiceCommentRequestObject
+
+	request.Id = id
+
+	var body AddInvoiceCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddInvoiceComment(ctx, request.(AddInvoiceCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddInvoiceComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddInvoiceCommentResponseObject); ok {
+		if err := validResponse.VisitAddInvoiceCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteInvoiceComment operation middleware
+func (sh *strictHandler) DeleteInvoiceComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID) {
+	var request DeleteInvoiceCommentRequestObject
+
+	request.Id = id
+	request.CommentId = commentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteInvoiceComment(ctx, request.(DeleteInvoiceCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteInvoiceComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteInvoiceCommentResponseObject); ok {
+		if err := validResponse.VisitDeleteInvoiceCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCreditNote operation middleware
+func (sh *strictHandler) CreateCreditNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request CreateCreditNoteRequestObject
+
+	request.Id = id
+
+	var body CreateCreditNoteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCreditNote(ctx, request.(CreateCreditNoteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCreditNote")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCreditNoteResponseObject); ok {
+		if err := validResponse.VisitCreateCreditNoteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportInvoice operation middleware
+func (sh *strictHandler) ExportInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params ExportInvoiceParams) {
+	var request ExportInvoiceRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportInvoice(ctx, request.(ExportInvoiceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportInvoice")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportInvoiceResponseObject); ok {
+		if err := validResponse.VisitExportInvoiceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportInvoiceCSV operation middleware
+func (sh *strictHandler) ExportInvoiceCSV(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ExportInvoiceCSVRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportInvoiceCSV(ctx, request.(ExportInvoiceCSVRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportInvoiceCSV")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportInvoiceCSVResponseObject); ok {
+		if err := validResponse.VisitExportInvoiceCSVResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportInvoiceSheets operation middleware
+func (sh *strictHandler) ExportInvoiceSheets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ExportInvoiceSheetsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportInvoiceSheets(ctx, request.(ExportInvoiceSheetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportInvoiceSheets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportInvoiceSheetsResponseObject); ok {
+		if err := validResponse.VisitExportInvoiceSheetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInvoiceExports operation middleware
+func (sh *strictHandler) ListInvoiceExports(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListInvoiceExportsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvoiceExports(ctx, request.(ListInvoiceExportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvoiceExports")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInvoiceExportsResponseObject); ok {
+		if err := validResponse.VisitListInvoiceExportsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInvoicePayments operation middleware
+func (sh *strictHandler) ListInvoicePayments(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListInvoicePaymentsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvoicePayments(ctx, request.(ListInvoicePaymentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvoicePayments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInvoicePaymentsResponseObject); ok {
+		if err := validResponse.VisitListInvoicePaymentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RecordInvoicePayment operation middleware
+func (sh *strictHandler) RecordInvoicePayment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RecordInvoicePaymentRequestObject
+
+	request.Id = id
+
+	var body RecordInvoicePaymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RecordInvoicePayment(ctx, request.(RecordInvoicePaymentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RecordInvoicePayment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RecordInvoicePaymentResponseObject); ok {
+		if err := validResponse.VisitRecordInvoicePaymentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateInvoiceStatus operation middleware
+func (sh *strictHandler) UpdateInvoiceStatus(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateInvoiceStatusRequestObject
+
+	request.Id = id
+
+	var body UpdateInvoiceStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateInvoiceStatus(ctx, request.(UpdateInvoiceStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateInvoiceStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateInvoiceStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateInvoiceStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizations operation middleware
+func (sh *strictHandler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	var request ListOrganizationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizations(ctx, request.(ListOrganizationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationsResponseObject); ok {
+		if err := validResponse.VisitListOrganizationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganization operation middleware
+func (sh *strictHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	var request CreateOrganizationRequestObject
+
+	var body CreateOrganizationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganization(ctx, request.(CreateOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOrganizationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOrganization operation middleware
+func (sh *strictHandler) GetOrganization(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetOrganizationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganization(ctx, request.(GetOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrganizationResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOrganizationDomain operation middleware
+func (sh *strictHandler) DeleteOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string) {
+	var request DeleteOrganizationDomainRequestObject
+
+	request.Id = id
+	request.Domain = domain
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrganizationDomain(ctx, request.(DeleteOrganizationDomainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrganizationDomain")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOrganizationDomainResponseObject); ok {
+		if err := validResponse.VisitDeleteOrganizationDomainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutOrganizationDomain operation middleware
+func (sh *strictHandler) PutOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string) {
+	var request PutOrganizationDomainRequestObject
+
+	request.Id = id
+	request.Domain = domain
+
+	var body PutOrganizationDomainJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutOrganizationDomain(ctx, request.(PutOrganizationDomainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutOrganizationDomain")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutOrganizationDomainResponseObject); ok {
+		if err := validResponse.VisitPutOrganizationDomainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyOrganizationDomain operation middleware
+func (sh *strictHandler) VerifyOrganizationDomain(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, domain string) {
+	var request VerifyOrganizationDomainRequestObject
+
+	request.Id = id
+	request.Domain = domain
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyOrganizationDomain(ctx, request.(VerifyOrganizationDomainRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyOrganizationDomain")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyOrganizationDomainResponseObject); ok {
+		if err := validResponse.VisitVerifyOrganizationDomainResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganizationGoogleDelegation operation middleware
+func (sh *strictHandler) UpdateOrganizationGoogleDelegation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateOrganizationGoogleDelegationRequestObject
+
+	request.Id = id
+
+	var body UpdateOrganizationGoogleDelegationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationGoogleDelegation(ctx, request.(UpdateOrganizationGoogleDelegationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationGoogleDelegation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationGoogleDelegationResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationGoogleDelegationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ProvisionOrganizationCalendars operation middleware
+func (sh *strictHandler) ProvisionOrganizationCalendars(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ProvisionOrganizationCalendarsRequestObject
+
+	request.Id = id
+
+	var body ProvisionOrganizationCalendarsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ProvisionOrganizationCalendars(ctx, request.(ProvisionOrganizationCalendarsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ProvisionOrganizationCalendars")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ProvisionOrganizationCalendarsResponseObject); ok {
+		if err := validResponse.VisitProvisionOrganizationCalendarsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizationMembers operation middleware
+func (sh *strictHandler) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListOrganizationMembersRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizationMembers(ctx, request.(ListOrganizationMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizationMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationMembersResponseObject); ok {
+		if err := validResponse.VisitListOrganizationMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganizationMemberDelegation operation middleware
+func (sh *strictHandler) UpdateOrganizationMemberDelegation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, userId openapi_types.UUID) {
+	var request UpdateOrganizationMemberDelegationRequestObject
+
+	request.Id = id
+	request.UserId = userId
+
+	var body UpdateOrganizationMemberDelegationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationMemberDelegation(ctx, request.(UpdateOrganizationMemberDelegationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationMemberDelegation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationMemberDelegationResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationMemberDelegationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TransferOrganizationProject operation middleware
+func (sh *strictHandler) TransferOrganizationProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request TransferOrganizationProjectRequestObject
+
+	request.Id = id
+
+	var body TransferOrganizationProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TransferOrganizationProject(ctx, request.(TransferOrganizationProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TransferOrganizationProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TransferOrganizationProjectResponseObject); ok {
+		if err := validResponse.VisitTransferOrganizationProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RotateOrganizationScimToken operation middleware
+func (sh *strictHandler) RotateOrganizationScimToken(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RotateOrganizationScimTokenRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateOrganizationScimToken(ctx, request.(RotateOrganizationScimTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateOrganizationScimToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateOrganizationScimTokenResponseObject); ok {
+		if err := validResponse.VisitRotateOrganizationScimTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganizationSso operation middleware
+func (sh *strictHandler) UpdateOrganizationSso(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateOrganizationSsoRequestObject
+
+	request.Id = id
+
+	var body UpdateOrganizationSsoJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationSso(ctx, request.(UpdateOrganizationSsoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationSso")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationSsoResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationSsoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePayment operation middleware
+func (sh *strictHandler) DeletePayment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeletePaymentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePayment(ctx, request.(DeletePaymentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePayment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePaymentResponseObject); ok {
+		if err := validResponse.VisitDeletePaymentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RefreshTimeEntry404JSONResponse Error
+// ListProjects operation middleware
+func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
+	var request ListProjectsRequestObject
 
-func (response RefreshTimeEntry404JSONResponse) VisitRefreshTimeEntryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjects")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
+		if err := validResponse.VisitListProjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List user's API keys
-	// (GET /api/api-keys)
-	ListApiKeys(ctx context.Context, request ListApiKeysRequestObject) (ListApiKeysResponseObject, error)
-	// Create a new API key
-	// (POST /api/api-keys)
-	CreateApiKey(ctx context.Context, request CreateApiKeyRequestObject) (CreateApiKeyResponseObject, error)
-	// Revoke an API key
-	// (DELETE /api/api-keys/{id})
-	DeleteApiKey(ctx context.Context, request DeleteApiKeyRequestObject) (DeleteApiKeyResponseObject, error)
-	// Get Google OAuth authorization URL
-	// (GET /api/auth/google/authorize)
-	GoogleAuthorize(ctx context.Context, request GoogleAuthorizeRequestObject) (GoogleAuthorizeResponseObject, error)
-	// Handle Google OAuth callback
-	// (GET /api/auth/google/callback)
-	GoogleCallback(ctx context.Context, request GoogleCallbackRequestObject) (GoogleCallbackResponseObject, error)
-	// Authenticate with email and password
-	// (POST /api/auth/login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
-	// End the current session
-	// (POST /api/auth/logout)
-	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
-	// Get current authenticated user
-	// (GET /api/auth/me)
-	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
-	// Create a new user account
-	// (POST /api/auth/signup)
-	Signup(ctx context.Context, request SignupRequestObject) (SignupResponseObject, error)
-	// List billing periods for a project
-	// (GET /api/billing-periods)
-	ListBillingPeriods(ctx context.Context, request ListBillingPeriodsRequestObject) (ListBillingPeriodsResponseObject, error)
-	// Create a new billing period
-	// (POST /api/billing-periods)
-	CreateBillingPeriod(ctx context.Context, request CreateBillingPeriodRequestObject) (CreateBillingPeriodResponseObject, error)
-	// Delete a billing period
-	// (DELETE /api/billing-periods/{id})
-	DeleteBillingPeriod(ctx context.Context, request DeleteBillingPeriodRequestObject) (DeleteBillingPeriodResponseObject, error)
-	// Update a billing period
-	// (PUT /api/billing-periods/{id})
-	UpdateBillingPeriod(ctx context.Context, request UpdateBillingPeriodRequestObject) (UpdateBillingPeriodResponseObject, error)
-	// List calendar events with filters
-	// (GET /api/calendar-events)
-	ListCalendarEvents(ctx context.Context, request ListCalendarEventsRequestObject) (ListCalendarEventsResponseObject, error)
-	// Bulk classify events matching a query
-	// (POST /api/calendar-events/bulk-classify)
-	BulkClassifyEvents(ctx context.Context, request BulkClassifyEventsRequestObject) (BulkClassifyEventsResponseObject, error)
-	// Classify a calendar event (assign to project or skip)
-	// (PUT /api/calendar-events/{id}/classify)
-	ClassifyCalendarEvent(ctx context.Context, request ClassifyCalendarEventRequestObject) (ClassifyCalendarEventResponseObject, error)
-	// Explain how an event was (or would be) classified
-	// (GET /api/calendar-events/{id}/explain)
-	ExplainEventClassification(ctx context.Context, request ExplainEventClassificationRequestObject) (ExplainEventClassificationResponseObject, error)
-	// List user's calendar connections
-	// (GET /api/calendars)
-	ListCalendarConnections(ctx context.Context, request ListCalendarConnectionsRequestObject) (ListCalendarConnectionsResponseObject, error)
-	// Disconnect a calendar
-	// (DELETE /api/calendars/{id})
-	DeleteCalendarConnection(ctx context.Context, request DeleteCalendarConnectionRequestObject) (DeleteCalendarConnectionResponseObject, error)
-	// List available calendars for a connection
-	// (GET /api/calendars/{id}/sources)
-	ListCalendarSources(ctx context.Context, request ListCalendarSourcesRequestObject) (ListCalendarSourcesResponseObject, error)
-	// Update which calendars are selected for sync
-	// (PUT /api/calendars/{id}/sources)
-	UpdateCalendarSources(ctx context.Context, request UpdateCalendarSourcesRequestObject) (UpdateCalendarSourcesResponseObject, error)
-	// Trigger sync for a calendar connection
-	// (POST /api/calendars/{id}/sync)
-	SyncCalendar(ctx context.Context, request SyncCalendarRequestObject) (SyncCalendarResponseObject, error)
-	// Export projects and rules as JSON
-	// (GET /api/config/export)
-	ExportConfig(ctx context.Context, request ExportConfigRequestObject) (ExportConfigResponseObject, error)
-	// Import projects and rules from JSON
-	// (POST /api/config/import)
-	ImportConfig(ctx context.Context, request ImportConfigRequestObject) (ImportConfigResponseObject, error)
-	// List invoices
-	// (GET /api/invoices)
-	ListInvoices(ctx context.Context, request ListInvoicesRequestObject) (ListInvoicesResponseObject, error)
-	// Generate a new invoice
-	// (POST /api/invoices)
-	CreateInvoice(ctx context.Context, request CreateInvoiceRequestObject) (CreateInvoiceResponseObject, error)
-	// Delete a draft invoice
-	// (DELETE /api/invoices/{id})
-	DeleteInvoice(ctx context.Context, request DeleteInvoiceRequestObject) (DeleteInvoiceResponseObject, error)
-	// Get invoice details
-	// (GET /api/invoices/{id})
-	GetInvoice(ctx context.Context, request GetInvoiceRequestObject) (GetInvoiceResponseObject, error)
-	// Export invoice as CSV
-	// (GET /api/invoices/{id}/export/csv)
-	ExportInvoiceCSV(ctx context.Context, request ExportInvoiceCSVRequestObject) (ExportInvoiceCSVResponseObject, error)
-	// Export invoice to Google Sheets
-	// (POST /api/invoices/{id}/export/sheets)
-	ExportInvoiceSheets(ctx context.Context, request ExportInvoiceSheetsRequestObject) (ExportInvoiceSheetsResponseObject, error)
-	// Change invoice status
-	// (PUT /api/invoices/{id}/status)
-	UpdateInvoiceStatus(ctx context.Context, request UpdateInvoiceStatusRequestObject) (UpdateInvoiceStatusResponseObject, error)
-	// List all projects
-	// (GET /api/projects)
-	ListProjects(ctx context.Context, request ListProjectsRequestObject) (ListProjectsResponseObject, error)
-	// Create a new project
-	// (POST /api/projects)
-	CreateProject(ctx context.Context, request CreateProjectRequestObject) (CreateProjectResponseObject, error)
-	// Delete a project
-	// (DELETE /api/projects/{id})
-	DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error)
-	// Get a project by ID
-	// (GET /api/projects/{id})
-	GetProject(ctx context.Context, request GetProjectRequestObject) (GetProjectResponseObject, error)
-	// Update a project
-	// (PUT /api/projects/{id})
-	UpdateProject(ctx context.Context, request UpdateProjectRequestObject) (UpdateProjectResponseObject, error)
-	// List all classification rules
-	// (GET /api/rules)
-	ListRules(ctx context.Context, request ListRulesRequestObject) (ListRulesResponseObject, error)
-	// Create a new classification rule
-	// (POST /api/rules)
-	CreateRule(ctx context.Context, request CreateRuleRequestObject) (CreateRuleResponseObject, error)
-	// Apply classification rules to pending events
-	// (POST /api/rules/apply)
-	ApplyRules(ctx context.Context, request ApplyRulesRequestObject) (ApplyRulesResponseObject, error)
-	// Preview what events a rule would match
-	// (POST /api/rules/preview)
-	PreviewRule(ctx context.Context, request PreviewRuleRequestObject) (PreviewRuleResponseObject, error)
-	// Delete a rule
-	// (DELETE /api/rules/{id})
-	DeleteRule(ctx context.Context, request DeleteRuleRequestObject) (DeleteRuleResponseObject, error)
-	// Get a rule by ID
-	// (GET /api/rules/{id})
-	GetRule(ctx context.Context, request GetRuleRequestObject) (GetRuleResponseObject, error)
-	// Update a rule
-	// (PUT /api/rules/{id})
-	UpdateRule(ctx context.Context, request UpdateRuleRequestObject) (UpdateRuleResponseObject, error)
-	// List time entries
-	// (GET /api/time-entries)
-	ListTimeEntries(ctx context.Context, request ListTimeEntriesRequestObject) (ListTimeEntriesResponseObject, error)
-	// Create a new time entry
-	// (POST /api/time-entries)
-	CreateTimeEntry(ctx context.Context, request CreateTimeEntryRequestObject) (CreateTimeEntryResponseObject, error)
-	// Delete a time entry
-	// (DELETE /api/time-entries/{id})
-	DeleteTimeEntry(ctx context.Context, request DeleteTimeEntryRequestObject) (DeleteTimeEntryResponseObject, error)
-	// Get a time entry by ID
-	// (GET /api/time-entries/{id})
-	GetTimeEntry(ctx context.Context, request GetTimeEntryRequestObject) (GetTimeEntryResponseObject, error)
-	// Update a time entry
-	// (PUT /api/time-entries/{id})
-	UpdateTimeEntry(ctx context.Context, request UpdateTimeEntryRequestObject) (UpdateTimeEntryResponseObject, error)
-	// Reset time entry to computed values from events
-	// (POST /api/time-entries/{id}/refresh)
-	RefreshTimeEntry(ctx context.Context, request RefreshTimeEntryRequestObject) (RefreshTimeEntryResponseObject, error)
+// CreateProject operation middleware
+func (sh *strictHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
+	var request CreateProjectRequestObject
+
+	var body CreateProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProject(ctx, request.(CreateProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProjectResponseObject); ok {
+		if err := validResponse.VisitCreateProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProject operation middleware
+func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteProjectRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProject operation middleware
+func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetProjectRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
+		if err := validResponse.VisitGetProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// UpdateProject operation middleware
+func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateProjectRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.Id = id
+
+	var body UpdateProjectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateProject(ctx, request.(UpdateProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateProject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateProjectResponseObject); ok {
+		if err := validResponse.VisitUpdateProjectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// AddProjectFingerprint operation middleware
+func (sh *strictHandler) AddProjectFingerprint(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request AddProjectFingerprintRequestObject
+
+	request.Id = id
+
+	var body AddProjectFingerprintJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddProjectFingerprint(ctx, request.(AddProjectFingerprintRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddProjectFingerprint")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddProjectFingerprintResponseObject); ok {
+		if err := validResponse.VisitAddProjectFingerprintResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// RemoveProjectFingerprint operation middleware
+func (sh *strictHandler) RemoveProjectFingerprint(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, kind FingerprintKind, value string) {
+	var request RemoveProjectFingerprintRequestObject
+
+	request.Id = id
+	request.Kind = kind
+	request.Value = value
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveProjectFingerprint(ctx, request.(RemoveProjectFingerprintRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveProjectFingerprint")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveProjectFingerprintResponseObject); ok {
+		if err := validResponse.VisitRemoveProjectFingerprintResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetMeetingCostReport operation middleware
+func (sh *strictHandler) GetMeetingCostReport(w http.ResponseWriter, r *http.Request, params GetMeetingCostReportParams) {
+	var request GetMeetingCostReportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeetingCostReport(ctx, request.(GetMeetingCostReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeetingCostReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMeetingCostReportResponseObject); ok {
+		if err := validResponse.VisitGetMeetingCostReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListApiKeys operation middleware
-func (sh *strictHandler) ListApiKeys(w http.ResponseWriter, r *http.Request) {
-	var request ListApiKeysRequestObject
+// GetMeetingLoadReport operation middleware
+func (sh *strictHandler) GetMeetingLoadReport(w http.ResponseWriter, r *http.Request, params GetMeetingLoadReportParams) {
+	var request GetMeetingLoadReportRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListApiKeys(ctx, request.(ListApiKeysRequestObject))
+		return sh.ssi.GetMeetingLoadReport(ctx, request.(GetMeetingLoadReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListApiKeys")
+		handler = middleware(handler, "GetMeetingLoadReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListApiKeysResponseObject); ok {
-		if err := validResponse.VisitListApiKeysResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMeetingLoadReportResponseObject); ok {
+		if err := validResponse.VisitGetMeetingLoadReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5126,11 +22148,37 @@ func (sh *strictHandler) ListApiKeys(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateApiKey operation middleware
-func (sh *strictHandler) CreateApiKey(w http.ResponseWriter, r *http.Request) {
-	var request CreateApiKeyRequestObject
+// GetUtilizationReport operation middleware
+func (sh *strictHandler) GetUtilizationReport(w http.ResponseWriter, r *http.Request, params GetUtilizationReportParams) {
+	var request GetUtilizationReportRequestObject
 
-	var body CreateApiKeyJSONRequestBody
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUtilizationReport(ctx, request.(GetUtilizationReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUtilizationReport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUtilizationReportResponseObject); ok {
+		if err := validResponse.VisitGetUtilizationReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportRulePack operation middleware
+func (sh *strictHandler) ExportRulePack(w http.ResponseWriter, r *http.Request) {
+	var request ExportRulePackRequestObject
+
+	var body ExportRulePackJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5138,18 +22186,18 @@ func (sh *strictHandler) CreateApiKey(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateApiKey(ctx, request.(CreateApiKeyRequestObject))
+		return sh.ssi.ExportRulePack(ctx, request.(ExportRulePackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateApiKey")
+		handler = middleware(handler, "ExportRulePack")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateApiKeyResponseObject); ok {
-		if err := validResponse.VisitCreateApiKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExportRulePackResponseObject); ok {
+		if err := validResponse.VisitExportRulePackResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5157,25 +22205,30 @@ func (sh *strictHandler) CreateApiKey(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteApiKey operation middleware
-func (sh *strictHandler) DeleteApiKey(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteApiKeyRequestObject
+// ImportRulePack operation middleware
+func (sh *strictHandler) ImportRulePack(w http.ResponseWriter, r *http.Request) {
+	var request ImportRulePackRequestObject
 
-	request.Id = id
+	var body ImportRulePackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteApiKey(ctx, request.(DeleteApiKeyRequestObject))
+		return sh.ssi.ImportRulePack(ctx, request.(ImportRulePackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteApiKey")
+		handler = middleware(handler, "ImportRulePack")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteApiKeyResponseObject); ok {
-		if err := validResponse.VisitDeleteApiKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportRulePackResponseObject); ok {
+		if err := validResponse.VisitImportRulePackResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5183,23 +22236,25 @@ func (sh *strictHandler) DeleteApiKey(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GoogleAuthorize operation middleware
-func (sh *strictHandler) GoogleAuthorize(w http.ResponseWriter, r *http.Request) {
-	var request GoogleAuthorizeRequestObject
+// ListRules operation middleware
+func (sh *strictHandler) ListRules(w http.ResponseWriter, r *http.Request, params ListRulesParams) {
+	var request ListRulesRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GoogleAuthorize(ctx, request.(GoogleAuthorizeRequestObject))
+		return sh.ssi.ListRules(ctx, request.(ListRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GoogleAuthorize")
+		handler = middleware(handler, "ListRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GoogleAuthorizeResponseObject); ok {
-		if err := validResponse.VisitGoogleAuthorizeResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListRulesResponseObject); ok {
+		if err := validResponse.VisitListRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5207,25 +22262,30 @@ func (sh *strictHandler) GoogleAuthorize(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GoogleCallback operation middleware
-func (sh *strictHandler) GoogleCallback(w http.ResponseWriter, r *http.Request, params GoogleCallbackParams) {
-	var request GoogleCallbackRequestObject
+// CreateRule operation middleware
+func (sh *strictHandler) CreateRule(w http.ResponseWriter, r *http.Request) {
+	var request CreateRuleRequestObject
 
-	request.Params = params
+	var body CreateRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GoogleCallback(ctx, request.(GoogleCallbackRequestObject))
+		return sh.ssi.CreateRule(ctx, request.(CreateRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GoogleCallback")
+		handler = middleware(handler, "CreateRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GoogleCallbackResponseObject); ok {
-		if err := validResponse.VisitGoogleCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateRuleResponseObject); ok {
+		if err := validResponse.VisitCreateRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5233,11 +22293,11 @@ func (sh *strictHandler) GoogleCallback(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
-	var request LoginRequestObject
+// ApplyRules operation middleware
+func (sh *strictHandler) ApplyRules(w http.ResponseWriter, r *http.Request) {
+	var request ApplyRulesRequestObject
 
-	var body LoginJSONRequestBody
+	var body ApplyRulesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5245,18 +22305,18 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.ApplyRules(ctx, request.(ApplyRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "ApplyRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(ApplyRulesResponseObject); ok {
+		if err := validResponse.VisitApplyRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5264,23 +22324,25 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Logout operation middleware
-func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
-	var request LogoutRequestObject
+// GetRuleConflicts operation middleware
+func (sh *strictHandler) GetRuleConflicts(w http.ResponseWriter, r *http.Request, params GetRuleConflictsParams) {
+	var request GetRuleConflictsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+		return sh.ssi.GetRuleConflicts(ctx, request.(GetRuleConflictsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Logout")
+		handler = middleware(handler, "GetRuleConflicts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LogoutResponseObject); ok {
-		if err := validResponse.VisitLogoutResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRuleConflictsResponseObject); ok {
+		if err := validResponse.VisitGetRuleConflictsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5288,23 +22350,30 @@ func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetCurrentUser operation middleware
-func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
-	var request GetCurrentUserRequestObject
+// LintRule operation middleware
+func (sh *strictHandler) LintRule(w http.ResponseWriter, r *http.Request) {
+	var request LintRuleRequestObject
+
+	var body LintRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
+		return sh.ssi.LintRule(ctx, request.(LintRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCurrentUser")
+		handler = middleware(handler, "LintRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
-		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(LintRuleResponseObject); ok {
+		if err := validResponse.VisitLintRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5312,11 +22381,11 @@ func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// Signup operation middleware
-func (sh *strictHandler) Signup(w http.ResponseWriter, r *http.Request) {
-	var request SignupRequestObject
+// PreviewRule operation middleware
+func (sh *strictHandler) PreviewRule(w http.ResponseWriter, r *http.Request) {
+	var request PreviewRuleRequestObject
 
-	var body SignupJSONRequestBody
+	var body PreviewRuleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5324,18 +22393,18 @@ func (sh *strictHandler) Signup(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Signup(ctx, request.(SignupRequestObject))
+		return sh.ssi.PreviewRule(ctx, request.(PreviewRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Signup")
+		handler = middleware(handler, "PreviewRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SignupResponseObject); ok {
-		if err := validResponse.VisitSignupResponse(w); err != nil {
+	} else if validResponse, ok := response.(PreviewRuleResponseObject); ok {
+		if err := validResponse.VisitPreviewRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5343,25 +22412,30 @@ func (sh *strictHandler) Signup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListBillingPeriods operation middleware
-func (sh *strictHandler) ListBillingPeriods(w http.ResponseWriter, r *http.Request, params ListBillingPeriodsParams) {
-	var request ListBillingPeriodsRequestObject
+// TestRule operation middleware
+func (sh *strictHandler) TestRule(w http.ResponseWriter, r *http.Request) {
+	var request TestRuleRequestObject
 
-	request.Params = params
+	var body TestRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListBillingPeriods(ctx, request.(ListBillingPeriodsRequestObject))
+		return sh.ssi.TestRule(ctx, request.(TestRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListBillingPeriods")
+		handler = middleware(handler, "TestRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListBillingPeriodsResponseObject); ok {
-		if err := validResponse.VisitListBillingPeriodsResponse(w); err != nil {
+	} else if validResponse, ok := response.(TestRuleResponseObject); ok {
+		if err := validResponse.VisitTestRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5369,30 +22443,25 @@ func (sh *strictHandler) ListBillingPeriods(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// CreateBillingPeriod operation middleware
-func (sh *strictHandler) CreateBillingPeriod(w http.ResponseWriter, r *http.Request) {
-	var request CreateBillingPeriodRequestObject
+// DeleteRule operation middleware
+func (sh *strictHandler) DeleteRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteRuleRequestObject
 
-	var body CreateBillingPeriodJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateBillingPeriod(ctx, request.(CreateBillingPeriodRequestObject))
+		return sh.ssi.DeleteRule(ctx, request.(DeleteRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateBillingPeriod")
+		handler = middleware(handler, "DeleteRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateBillingPeriodResponseObject); ok {
-		if err := validResponse.VisitCreateBillingPeriodResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5400,25 +22469,25 @@ func (sh *strictHandler) CreateBillingPeriod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeleteBillingPeriod operation middleware
-func (sh *strictHandler) DeleteBillingPeriod(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteBillingPeriodRequestObject
+// GetRule operation middleware
+func (sh *strictHandler) GetRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetRuleRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteBillingPeriod(ctx, request.(DeleteBillingPeriodRequestObject))
+		return sh.ssi.GetRule(ctx, request.(GetRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteBillingPeriod")
+		handler = middleware(handler, "GetRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteBillingPeriodResponseObject); ok {
-		if err := validResponse.VisitDeleteBillingPeriodResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRuleResponseObject); ok {
+		if err := validResponse.VisitGetRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5426,13 +22495,13 @@ func (sh *strictHandler) DeleteBillingPeriod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// UpdateBillingPeriod operation middleware
-func (sh *strictHandler) UpdateBillingPeriod(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateBillingPeriodRequestObject
+// UpdateRule operation middleware
+func (sh *strictHandler) UpdateRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateRuleRequestObject
 
 	request.Id = id
 
-	var body UpdateBillingPeriodJSONRequestBody
+	var body UpdateRuleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5440,18 +22509,18 @@ func (sh *strictHandler) UpdateBillingPeriod(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateBillingPeriod(ctx, request.(UpdateBillingPeriodRequestObject))
+		return sh.ssi.UpdateRule(ctx, request.(UpdateRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateBillingPeriod")
+		handler = middleware(handler, "UpdateRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateBillingPeriodResponseObject); ok {
-		if err := validResponse.VisitUpdateBillingPeriodResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5459,25 +22528,25 @@ func (sh *strictHandler) UpdateBillingPeriod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// ListCalendarEvents operation middleware
-func (sh *strictHandler) ListCalendarEvents(w http.ResponseWriter, r *http.Request, params ListCalendarEventsParams) {
-	var request ListCalendarEventsRequestObject
+// ListClassificationSnapshots operation middleware
+func (sh *strictHandler) ListClassificationSnapshots(w http.ResponseWriter, r *http.Request, params ListClassificationSnapshotsParams) {
+	var request ListClassificationSnapshotsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCalendarEvents(ctx, request.(ListCalendarEventsRequestObject))
+		return sh.ssi.ListClassificationSnapshots(ctx, request.(ListClassificationSnapshotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCalendarEvents")
+		handler = middleware(handler, "ListClassificationSnapshots")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCalendarEventsResponseObject); ok {
-		if err := validResponse.VisitListCalendarEventsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListClassificationSnapshotsResponseObject); ok {
+		if err := validResponse.VisitListClassificationSnapshotsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5485,11 +22554,11 @@ func (sh *strictHandler) ListCalendarEvents(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// BulkClassifyEvents operation middleware
-func (sh *strictHandler) BulkClassifyEvents(w http.ResponseWriter, r *http.Request) {
-	var request BulkClassifyEventsRequestObject
+// CreateClassificationSnapshot operation middleware
+func (sh *strictHandler) CreateClassificationSnapshot(w http.ResponseWriter, r *http.Request) {
+	var request CreateClassificationSnapshotRequestObject
 
-	var body BulkClassifyEventsJSONRequestBody
+	var body CreateClassificationSnapshotJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5497,18 +22566,18 @@ func (sh *strictHandler) BulkClassifyEvents(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.BulkClassifyEvents(ctx, request.(BulkClassifyEventsRequestObject))
+		return sh.ssi.CreateClassificationSnapshot(ctx, request.(CreateClassificationSnapshotRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BulkClassifyEvents")
+		handler = middleware(handler, "CreateClassificationSnapshot")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(BulkClassifyEventsResponseObject); ok {
-		if err := validResponse.VisitBulkClassifyEventsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateClassificationSnapshotResponseObject); ok {
+		if err := validResponse.VisitCreateClassificationSnapshotResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5516,32 +22585,25 @@ func (sh *strictHandler) BulkClassifyEvents(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ClassifyCalendarEvent operation middleware
-func (sh *strictHandler) ClassifyCalendarEvent(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request ClassifyCalendarEventRequestObject
+// DeleteClassificationSnapshot operation middleware
+func (sh *strictHandler) DeleteClassificationSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteClassificationSnapshotRequestObject
 
 	request.Id = id
 
-	var body ClassifyCalendarEventJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ClassifyCalendarEvent(ctx, request.(ClassifyCalendarEventRequestObject))
+		return sh.ssi.DeleteClassificationSnapshot(ctx, request.(DeleteClassificationSnapshotRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ClassifyCalendarEvent")
+		handler = middleware(handler, "DeleteClassificationSnapshot")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ClassifyCalendarEventResponseObject); ok {
-		if err := validResponse.VisitClassifyCalendarEventResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteClassificationSnapshotResponseObject); ok {
+		if err := validResponse.VisitDeleteClassificationSnapshotResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5549,25 +22611,25 @@ func (sh *strictHandler) ClassifyCalendarEvent(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// ExplainEventClassification operation middleware
-func (sh *strictHandler) ExplainEventClassification(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request ExplainEventClassificationRequestObject
+// RestoreClassificationSnapshot operation middleware
+func (sh *strictHandler) RestoreClassificationSnapshot(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RestoreClassificationSnapshotRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ExplainEventClassification(ctx, request.(ExplainEventClassificationRequestObject))
+		return sh.ssi.RestoreClassificationSnapshot(ctx, request.(RestoreClassificationSnapshotRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExplainEventClassification")
+		handler = middleware(handler, "RestoreClassificationSnapshot")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ExplainEventClassificationResponseObject); ok {
-		if err := validResponse.VisitExplainEventClassificationResponse(w); err != nil {
+	} else if validResponse, ok := response.(RestoreClassificationSnapshotResponseObject); ok {
+		if err := validResponse.VisitRestoreClassificationSnapshotResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5575,23 +22637,23 @@ func (sh *strictHandler) ExplainEventClassification(w http.ResponseWriter, r *ht
 	}
 }
 
-// ListCalendarConnections operation middleware
-func (sh *strictHandler) ListCalendarConnections(w http.ResponseWriter, r *http.Request) {
-	var request ListCalendarConnectionsRequestObject
+// ListSuppressionRules operation middleware
+func (sh *strictHandler) ListSuppressionRules(w http.ResponseWriter, r *http.Request) {
+	var request ListSuppressionRulesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCalendarConnections(ctx, request.(ListCalendarConnectionsRequestObject))
+		return sh.ssi.ListSuppressionRules(ctx, request.(ListSuppressionRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCalendarConnections")
+		handler = middleware(handler, "ListSuppressionRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCalendarConnectionsResponseObject); ok {
-		if err := validResponse.VisitListCalendarConnectionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSuppressionRulesResponseObject); ok {
+		if err := validResponse.VisitListSuppressionRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5599,25 +22661,30 @@ func (sh *strictHandler) ListCalendarConnections(w http.ResponseWriter, r *http.
 	}
 }
 
-// DeleteCalendarConnection operation middleware
-func (sh *strictHandler) DeleteCalendarConnection(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteCalendarConnectionRequestObject
+// CreateSuppressionRule operation middleware
+func (sh *strictHandler) CreateSuppressionRule(w http.ResponseWriter, r *http.Request) {
+	var request CreateSuppressionRuleRequestObject
 
-	request.Id = id
+	var body CreateSuppressionRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCalendarConnection(ctx, request.(DeleteCalendarConnectionRequestObject))
+		return sh.ssi.CreateSuppressionRule(ctx, request.(CreateSuppressionRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCalendarConnection")
+		handler = middleware(handler, "CreateSuppressionRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCalendarConnectionResponseObject); ok {
-		if err := validResponse.VisitDeleteCalendarConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSuppressionRuleResponseObject); ok {
+		if err := validResponse.VisitCreateSuppressionRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5625,25 +22692,25 @@ func (sh *strictHandler) DeleteCalendarConnection(w http.ResponseWriter, r *http
 	}
 }
 
-// ListCalendarSources operation middleware
-func (sh *strictHandler) ListCalendarSources(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request ListCalendarSourcesRequestObject
+// DeleteSuppressionRule operation middleware
+func (sh *strictHandler) DeleteSuppressionRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteSuppressionRuleRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCalendarSources(ctx, request.(ListCalendarSourcesRequestObject))
+		return sh.ssi.DeleteSuppressionRule(ctx, request.(DeleteSuppressionRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCalendarSources")
+		handler = middleware(handler, "DeleteSuppressionRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCalendarSourcesResponseObject); ok {
-		if err := validResponse.VisitListCalendarSourcesResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSuppressionRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteSuppressionRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5651,13 +22718,13 @@ func (sh *strictHandler) ListCalendarSources(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// UpdateCalendarSources operation middleware
-func (sh *strictHandler) UpdateCalendarSources(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateCalendarSourcesRequestObject
+// UpdateSuppressionRule operation middleware
+func (sh *strictHandler) UpdateSuppressionRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateSuppressionRuleRequestObject
 
 	request.Id = id
 
-	var body UpdateCalendarSourcesJSONRequestBody
+	var body UpdateSuppressionRuleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5665,18 +22732,18 @@ func (sh *strictHandler) UpdateCalendarSources(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCalendarSources(ctx, request.(UpdateCalendarSourcesRequestObject))
+		return sh.ssi.UpdateSuppressionRule(ctx, request.(UpdateSuppressionRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCalendarSources")
+		handler = middleware(handler, "UpdateSuppressionRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCalendarSourcesResponseObject); ok {
-		if err := validResponse.VisitUpdateCalendarSourcesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSuppressionRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateSuppressionRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5684,26 +22751,54 @@ func (sh *strictHandler) UpdateCalendarSources(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// SyncCalendar operation middleware
-func (sh *strictHandler) SyncCalendar(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params SyncCalendarParams) {
-	var request SyncCalendarRequestObject
+// ListTags operation middleware
+func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	var request ListTagsRequestObject
 
-	request.Id = id
-	request.Params = params
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListTags")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
+		if err := validResponse.VisitListTagsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateTag operation middleware
+func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var request CreateTagRequestObject
+
+	var body CreateTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SyncCalendar(ctx, request.(SyncCalendarRequestObject))
+		return sh.ssi.CreateTag(ctx, request.(CreateTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SyncCalendar")
+		handler = middleware(handler, "CreateTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SyncCalendarResponseObject); ok {
-		if err := validResponse.VisitSyncCalendarResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTagResponseObject); ok {
+		if err := validResponse.VisitCreateTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5711,25 +22806,25 @@ func (sh *strictHandler) SyncCalendar(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// ExportConfig operation middleware
-func (sh *strictHandler) ExportConfig(w http.ResponseWriter, r *http.Request, params ExportConfigParams) {
-	var request ExportConfigRequestObject
+// DeleteTag operation middleware
+func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteTagRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ExportConfig(ctx, request.(ExportConfigRequestObject))
+		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExportConfig")
+		handler = middleware(handler, "DeleteTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ExportConfigResponseObject); ok {
-		if err := validResponse.VisitExportConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
+		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5737,11 +22832,13 @@ func (sh *strictHandler) ExportConfig(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// ImportConfig operation middleware
-func (sh *strictHandler) ImportConfig(w http.ResponseWriter, r *http.Request) {
-	var request ImportConfigRequestObject
+// RenameTag operation middleware
+func (sh *strictHandler) RenameTag(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RenameTagRequestObject
 
-	var body ImportConfigJSONRequestBody
+	request.Id = id
+
+	var body RenameTagJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5749,18 +22846,18 @@ func (sh *strictHandler) ImportConfig(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportConfig(ctx, request.(ImportConfigRequestObject))
+		return sh.ssi.RenameTag(ctx, request.(RenameTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportConfig")
+		handler = middleware(handler, "RenameTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportConfigResponseObject); ok {
-		if err := validResponse.VisitImportConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(RenameTagResponseObject); ok {
+		if err := validResponse.VisitRenameTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5768,25 +22865,25 @@ func (sh *strictHandler) ImportConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListInvoices operation middleware
-func (sh *strictHandler) ListInvoices(w http.ResponseWriter, r *http.Request, params ListInvoicesParams) {
-	var request ListInvoicesRequestObject
+// ListTimeEntries operation middleware
+func (sh *strictHandler) ListTimeEntries(w http.ResponseWriter, r *http.Request, params ListTimeEntriesParams) {
+	var request ListTimeEntriesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListInvoices(ctx, request.(ListInvoicesRequestObject))
+		return sh.ssi.ListTimeEntries(ctx, request.(ListTimeEntriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListInvoices")
+		handler = middleware(handler, "ListTimeEntries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListInvoicesResponseObject); ok {
-		if err := validResponse.VisitListInvoicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTimeEntriesResponseObject); ok {
+		if err := validResponse.VisitListTimeEntriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5794,11 +22891,11 @@ func (sh *strictHandler) ListInvoices(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreateInvoice operation middleware
-func (sh *strictHandler) CreateInvoice(w http.ResponseWriter, r *http.Request) {
-	var request CreateInvoiceRequestObject
+// CreateTimeEntry operation middleware
+func (sh *strictHandler) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	var request CreateTimeEntryRequestObject
 
-	var body CreateInvoiceJSONRequestBody
+	var body CreateTimeEntryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5806,18 +22903,18 @@ func (sh *strictHandler) CreateInvoice(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateInvoice(ctx, request.(CreateInvoiceRequestObject))
+		return sh.ssi.CreateTimeEntry(ctx, request.(CreateTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateInvoice")
+		handler = middleware(handler, "CreateTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateInvoiceResponseObject); ok {
-		if err := validResponse.VisitCreateInvoiceResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTimeEntryResponseObject); ok {
+		if err := validResponse.VisitCreateTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5825,25 +22922,25 @@ func (sh *strictHandler) CreateInvoice(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteInvoice operation middleware
-func (sh *strictHandler) DeleteInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteInvoiceRequestObject
+// SuggestDescriptions operation middleware
+func (sh *strictHandler) SuggestDescriptions(w http.ResponseWriter, r *http.Request, params SuggestDescriptionsParams) {
+	var request SuggestDescriptionsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteInvoice(ctx, request.(DeleteInvoiceRequestObject))
+		return sh.ssi.SuggestDescriptions(ctx, request.(SuggestDescriptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteInvoice")
+		handler = middleware(handler, "SuggestDescriptions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteInvoiceResponseObject); ok {
-		if err := validResponse.VisitDeleteInvoiceResponse(w); err != nil {
+	} else if validResponse, ok := response.(SuggestDescriptionsResponseObject); ok {
+		if err := validResponse.VisitSuggestDescriptionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5851,25 +22948,32 @@ func (sh *strictHandler) DeleteInvoice(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetInvoice operation middleware
-func (sh *strictHandler) GetInvoice(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetInvoiceRequestObject
+// ParseTimeEntry operation middleware
+func (sh *strictHandler) ParseTimeEntry(w http.ResponseWriter, r *http.Request, params ParseTimeEntryParams) {
+	var request ParseTimeEntryRequestObject
 
-	request.Id = id
+	request.Params = params
+
+	var body ParseTimeEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInvoice(ctx, request.(GetInvoiceRequestObject))
+		return sh.ssi.ParseTimeEntry(ctx, request.(ParseTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInvoice")
+		handler = middleware(handler, "ParseTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetInvoiceResponseObject); ok {
-		if err := validResponse.VisitGetInvoiceResponse(w); err != nil {
+	} else if validResponse, ok := response.(ParseTimeEntryResponseObject); ok {
+		if err := validResponse.VisitParseTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5877,25 +22981,30 @@ func (sh *strictHandler) GetInvoice(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// ExportInvoiceCSV operation middleware
-func (sh *strictHandler) ExportInvoiceCSV(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request ExportInvoiceCSVRequestObject
+// RecalculateTimeEntries operation middleware
+func (sh *strictHandler) RecalculateTimeEntries(w http.ResponseWriter, r *http.Request) {
+	var request RecalculateTimeEntriesRequestObject
 
-	request.Id = id
+	var body RecalculateTimeEntriesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ExportInvoiceCSV(ctx, request.(ExportInvoiceCSVRequestObject))
+		return sh.ssi.RecalculateTimeEntries(ctx, request.(RecalculateTimeEntriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExportInvoiceCSV")
+		handler = middleware(handler, "RecalculateTimeEntries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ExportInvoiceCSVResponseObject); ok {
-		if err := validResponse.VisitExportInvoiceCSVResponse(w); err != nil {
+	} else if validResponse, ok := response.(RecalculateTimeEntriesResponseObject); ok {
+		if err := validResponse.VisitRecalculateTimeEntriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5903,25 +23012,25 @@ func (sh *strictHandler) ExportInvoiceCSV(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ExportInvoiceSheets operation middleware
-func (sh *strictHandler) ExportInvoiceSheets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request ExportInvoiceSheetsRequestObject
+// GetRecalculationJob operation middleware
+func (sh *strictHandler) GetRecalculationJob(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetRecalculationJobRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ExportInvoiceSheets(ctx, request.(ExportInvoiceSheetsRequestObject))
+		return sh.ssi.GetRecalculationJob(ctx, request.(GetRecalculationJobRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExportInvoiceSheets")
+		handler = middleware(handler, "GetRecalculationJob")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ExportInvoiceSheetsResponseObject); ok {
-		if err := validResponse.VisitExportInvoiceSheetsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRecalculationJobResponseObject); ok {
+		if err := validResponse.VisitGetRecalculationJobResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5929,32 +23038,25 @@ func (sh *strictHandler) ExportInvoiceSheets(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// UpdateInvoiceStatus operation middleware
-func (sh *strictHandler) UpdateInvoiceStatus(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateInvoiceStatusRequestObject
-
-	request.Id = id
+// GetReconciliationReport operation middleware
+func (sh *strictHandler) GetReconciliationReport(w http.ResponseWriter, r *http.Request, params GetReconciliationReportParams) {
+	var request GetReconciliationReportRequestObject
 
-	var body UpdateInvoiceStatusJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateInvoiceStatus(ctx, request.(UpdateInvoiceStatusRequestObject))
+		return sh.ssi.GetReconciliationReport(ctx, request.(GetReconciliationReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateInvoiceStatus")
+		handler = middleware(handler, "GetReconciliationReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateInvoiceStatusResponseObject); ok {
-		if err := validResponse.VisitUpdateInvoiceStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReconciliationReportResponseObject); ok {
+		if err := validResponse.VisitGetReconciliationReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5962,25 +23064,25 @@ func (sh *strictHandler) UpdateInvoiceStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// ListProjects operation middleware
-func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
-	var request ListProjectsRequestObject
+// DeleteTimeEntry operation middleware
+func (sh *strictHandler) DeleteTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteTimeEntryRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+		return sh.ssi.DeleteTimeEntry(ctx, request.(DeleteTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListProjects")
+		handler = middleware(handler, "DeleteTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
-		if err := validResponse.VisitListProjectsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTimeEntryResponseObject); ok {
+		if err := validResponse.VisitDeleteTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5988,30 +23090,25 @@ func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreateProject operation middleware
-func (sh *strictHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
-	var request CreateProjectRequestObject
+// GetTimeEntry operation middleware
+func (sh *strictHandler) GetTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTimeEntryRequestObject
 
-	var body CreateProjectJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateProject(ctx, request.(CreateProjectRequestObject))
+		return sh.ssi.GetTimeEntry(ctx, request.(GetTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateProject")
+		handler = middleware(handler, "GetTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateProjectResponseObject); ok {
-		if err := validResponse.VisitCreateProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTimeEntryResponseObject); ok {
+		if err := validResponse.VisitGetTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6019,25 +23116,32 @@ func (sh *strictHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteProject operation middleware
-func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteProjectRequestObject
+// UpdateTimeEntry operation middleware
+func (sh *strictHandler) UpdateTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request UpdateTimeEntryRequestObject
 
 	request.Id = id
 
+	var body UpdateTimeEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+		return sh.ssi.UpdateTimeEntry(ctx, request.(UpdateTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProject")
+		handler = middleware(handler, "UpdateTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
-		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTimeEntryResponseObject); ok {
+		if err := validResponse.VisitUpdateTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6045,25 +23149,25 @@ func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetProject operation middleware
-func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetProjectRequestObject
+// GetTimeEntryBreakdown operation middleware
+func (sh *strictHandler) GetTimeEntryBreakdown(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetTimeEntryBreakdownRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
+		return sh.ssi.GetTimeEntryBreakdown(ctx, request.(GetTimeEntryBreakdownRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProject")
+		handler = middleware(handler, "GetTimeEntryBreakdown")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
-		if err := validResponse.VisitGetProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTimeEntryBreakdownResponseObject); ok {
+		if err := validResponse.VisitGetTimeEntryBreakdownResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6071,32 +23175,25 @@ func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// UpdateProject operation middleware
-func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateProjectRequestObject
+// ExplainTimeEntry operation middleware
+func (sh *strictHandler) ExplainTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ExplainTimeEntryRequestObject
 
 	request.Id = id
 
-	var body UpdateProjectJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateProject(ctx, request.(UpdateProjectRequestObject))
+		return sh.ssi.ExplainTimeEntry(ctx, request.(ExplainTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateProject")
+		handler = middleware(handler, "ExplainTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateProjectResponseObject); ok {
-		if err := validResponse.VisitUpdateProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExplainTimeEntryResponseObject); ok {
+		if err := validResponse.VisitExplainTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6104,25 +23201,25 @@ func (sh *strictHandler) UpdateProject(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// ListRules operation middleware
-func (sh *strictHandler) ListRules(w http.ResponseWriter, r *http.Request, params ListRulesParams) {
-	var request ListRulesRequestObject
+// ListTimeEntryNotes operation middleware
+func (sh *strictHandler) ListTimeEntryNotes(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ListTimeEntryNotesRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListRules(ctx, request.(ListRulesRequestObject))
+		return sh.ssi.ListTimeEntryNotes(ctx, request.(ListTimeEntryNotesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListRules")
+		handler = middleware(handler, "ListTimeEntryNotes")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListRulesResponseObject); ok {
-		if err := validResponse.VisitListRulesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTimeEntryNotesResponseObject); ok {
+		if err := validResponse.VisitListTimeEntryNotesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6130,11 +23227,13 @@ func (sh *strictHandler) ListRules(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateRule operation middleware
-func (sh *strictHandler) CreateRule(w http.ResponseWriter, r *http.Request) {
-	var request CreateRuleRequestObject
+// AddTimeEntryNote operation middleware
+func (sh *strictHandler) AddTimeEntryNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request AddTimeEntryNoteRequestObject
 
-	var body CreateRuleJSONRequestBody
+	request.Id = id
+
+	var body AddTimeEntryNoteJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6142,18 +23241,18 @@ func (sh *strictHandler) CreateRule(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRule(ctx, request.(CreateRuleRequestObject))
+		return sh.ssi.AddTimeEntryNote(ctx, request.(AddTimeEntryNoteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRule")
+		handler = middleware(handler, "AddTimeEntryNote")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateRuleResponseObject); ok {
-		if err := validResponse.VisitCreateRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddTimeEntryNoteResponseObject); ok {
+		if err := validResponse.VisitAddTimeEntryNoteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6161,30 +23260,26 @@ func (sh *strictHandler) CreateRule(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ApplyRules operation middleware
-func (sh *strictHandler) ApplyRules(w http.ResponseWriter, r *http.Request) {
-	var request ApplyRulesRequestObject
+// DeleteTimeEntryNote operation middleware
+func (sh *strictHandler) DeleteTimeEntryNote(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, noteId openapi_types.UUID) {
+	var request DeleteTimeEntryNoteRequestObject
 
-	var body ApplyRulesJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.NoteId = noteId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ApplyRules(ctx, request.(ApplyRulesRequestObject))
+		return sh.ssi.DeleteTimeEntryNote(ctx, request.(DeleteTimeEntryNoteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApplyRules")
+		handler = middleware(handler, "DeleteTimeEntryNote")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ApplyRulesResponseObject); ok {
-		if err := validResponse.VisitApplyRulesResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTimeEntryNoteResponseObject); ok {
+		if err := validResponse.VisitDeleteTimeEntryNoteResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6192,11 +23287,13 @@ func (sh *strictHandler) ApplyRules(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PreviewRule operation middleware
-func (sh *strictHandler) PreviewRule(w http.ResponseWriter, r *http.Request) {
-	var request PreviewRuleRequestObject
+// ReconcileTimeEntry operation middleware
+func (sh *strictHandler) ReconcileTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request ReconcileTimeEntryRequestObject
 
-	var body PreviewRuleJSONRequestBody
+	request.Id = id
+
+	var body ReconcileTimeEntryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6204,18 +23301,18 @@ func (sh *strictHandler) PreviewRule(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PreviewRule(ctx, request.(PreviewRuleRequestObject))
+		return sh.ssi.ReconcileTimeEntry(ctx, request.(ReconcileTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PreviewRule")
+		handler = middleware(handler, "ReconcileTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PreviewRuleResponseObject); ok {
-		if err := validResponse.VisitPreviewRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReconcileTimeEntryResponseObject); ok {
+		if err := validResponse.VisitReconcileTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6223,25 +23320,25 @@ func (sh *strictHandler) PreviewRule(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteRule operation middleware
-func (sh *strictHandler) DeleteRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteRuleRequestObject
+// RefreshTimeEntry operation middleware
+func (sh *strictHandler) RefreshTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RefreshTimeEntryRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteRule(ctx, request.(DeleteRuleRequestObject))
+		return sh.ssi.RefreshTimeEntry(ctx, request.(RefreshTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteRule")
+		handler = middleware(handler, "RefreshTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteRuleResponseObject); ok {
-		if err := validResponse.VisitDeleteRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(RefreshTimeEntryResponseObject); ok {
+		if err := validResponse.VisitRefreshTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6249,25 +23346,32 @@ func (sh *strictHandler) DeleteRule(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// GetRule operation middleware
-func (sh *strictHandler) GetRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetRuleRequestObject
+// SplitTimeEntry operation middleware
+func (sh *strictHandler) SplitTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SplitTimeEntryRequestObject
 
 	request.Id = id
 
+	var body SplitTimeEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRule(ctx, request.(GetRuleRequestObject))
+		return sh.ssi.SplitTimeEntry(ctx, request.(SplitTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRule")
+		handler = middleware(handler, "SplitTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRuleResponseObject); ok {
-		if err := validResponse.VisitGetRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(SplitTimeEntryResponseObject); ok {
+		if err := validResponse.VisitSplitTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6275,13 +23379,13 @@ func (sh *strictHandler) GetRule(w http.ResponseWriter, r *http.Request, id open
 	}
 }
 
-// UpdateRule operation middleware
-func (sh *strictHandler) UpdateRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateRuleRequestObject
+// SetTimeEntryTags operation middleware
+func (sh *strictHandler) SetTimeEntryTags(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request SetTimeEntryTagsRequestObject
 
 	request.Id = id
 
-	var body UpdateRuleJSONRequestBody
+	var body SetTimeEntryTagsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6289,18 +23393,18 @@ func (sh *strictHandler) UpdateRule(w http.ResponseWriter, r *http.Request, id o
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateRule(ctx, request.(UpdateRuleRequestObject))
+		return sh.ssi.SetTimeEntryTags(ctx, request.(SetTimeEntryTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateRule")
+		handler = middleware(handler, "SetTimeEntryTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateRuleResponseObject); ok {
-		if err := validResponse.VisitUpdateRuleResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetTimeEntryTagsResponseObject); ok {
+		if err := validResponse.VisitSetTimeEntryTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6308,25 +23412,23 @@ func (sh *strictHandler) UpdateRule(w http.ResponseWriter, r *http.Request, id o
 	}
 }
 
-// ListTimeEntries operation middleware
-func (sh *strictHandler) ListTimeEntries(w http.ResponseWriter, r *http.Request, params ListTimeEntriesParams) {
-	var request ListTimeEntriesRequestObject
-
-	request.Params = params
+// ListTrash operation middleware
+func (sh *strictHandler) ListTrash(w http.ResponseWriter, r *http.Request) {
+	var request ListTrashRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTimeEntries(ctx, request.(ListTimeEntriesRequestObject))
+		return sh.ssi.ListTrash(ctx, request.(ListTrashRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTimeEntries")
+		handler = middleware(handler, "ListTrash")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTimeEntriesResponseObject); ok {
-		if err := validResponse.VisitListTimeEntriesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTrashResponseObject); ok {
+		if err := validResponse.VisitListTrashResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6334,30 +23436,25 @@ func (sh *strictHandler) ListTimeEntries(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateTimeEntry operation middleware
-func (sh *strictHandler) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
-	var request CreateTimeEntryRequestObject
+// RestoreRule operation middleware
+func (sh *strictHandler) RestoreRule(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RestoreRuleRequestObject
 
-	var body CreateTimeEntryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTimeEntry(ctx, request.(CreateTimeEntryRequestObject))
+		return sh.ssi.RestoreRule(ctx, request.(RestoreRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTimeEntry")
+		handler = middleware(handler, "RestoreRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTimeEntryResponseObject); ok {
-		if err := validResponse.VisitCreateTimeEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(RestoreRuleResponseObject); ok {
+		if err := validResponse.VisitRestoreRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6365,25 +23462,25 @@ func (sh *strictHandler) CreateTimeEntry(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteTimeEntry operation middleware
-func (sh *strictHandler) DeleteTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteTimeEntryRequestObject
+// RestoreTimeEntry operation middleware
+func (sh *strictHandler) RestoreTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request RestoreTimeEntryRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTimeEntry(ctx, request.(DeleteTimeEntryRequestObject))
+		return sh.ssi.RestoreTimeEntry(ctx, request.(RestoreTimeEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTimeEntry")
+		handler = middleware(handler, "RestoreTimeEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTimeEntryResponseObject); ok {
-		if err := validResponse.VisitDeleteTimeEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(RestoreTimeEntryResponseObject); ok {
+		if err := validResponse.VisitRestoreTimeEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6391,25 +23488,25 @@ func (sh *strictHandler) DeleteTimeEntry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetTimeEntry operation middleware
-func (sh *strictHandler) GetTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetTimeEntryRequestObject
+// GetWeekGrid operation middleware
+func (sh *strictHandler) GetWeekGrid(w http.ResponseWriter, r *http.Request, start openapi_types.Date) {
+	var request GetWeekGridRequestObject
 
-	request.Id = id
+	request.Start = start
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTimeEntry(ctx, request.(GetTimeEntryRequestObject))
+		return sh.ssi.GetWeekGrid(ctx, request.(GetWeekGridRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTimeEntry")
+		handler = middleware(handler, "GetWeekGrid")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTimeEntryResponseObject); ok {
-		if err := validResponse.VisitGetTimeEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWeekGridResponseObject); ok {
+		if err := validResponse.VisitGetWeekGridResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6417,32 +23514,23 @@ func (sh *strictHandler) GetTimeEntry(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// UpdateTimeEntry operation middleware
-func (sh *strictHandler) UpdateTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request UpdateTimeEntryRequestObject
-
-	request.Id = id
-
-	var body UpdateTimeEntryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetWorkingHours operation middleware
+func (sh *strictHandler) GetWorkingHours(w http.ResponseWriter, r *http.Request) {
+	var request GetWorkingHoursRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateTimeEntry(ctx, request.(UpdateTimeEntryRequestObject))
+		return sh.ssi.GetWorkingHours(ctx, request.(GetWorkingHoursRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateTimeEntry")
+		handler = middleware(handler, "GetWorkingHours")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateTimeEntryResponseObject); ok {
-		if err := validResponse.VisitUpdateTimeEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitGetWorkingHoursResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6450,25 +23538,30 @@ func (sh *strictHandler) UpdateTimeEntry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// RefreshTimeEntry operation middleware
-func (sh *strictHandler) RefreshTimeEntry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request RefreshTimeEntryRequestObject
+// UpdateWorkingHours operation middleware
+func (sh *strictHandler) UpdateWorkingHours(w http.ResponseWriter, r *http.Request) {
+	var request UpdateWorkingHoursRequestObject
 
-	request.Id = id
+	var body UpdateWorkingHoursJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RefreshTimeEntry(ctx, request.(RefreshTimeEntryRequestObject))
+		return sh.ssi.UpdateWorkingHours(ctx, request.(UpdateWorkingHoursRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RefreshTimeEntry")
+		handler = middleware(handler, "UpdateWorkingHours")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RefreshTimeEntryResponseObject); ok {
-		if err := validResponse.VisitRefreshTimeEntryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitUpdateWorkingHoursResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -0,0 +1,121 @@
+// Package archive moves old calendar events out of the hot calendar_events
+// table. Archived events stay visible through CalendarEventStore.List.
+package archive
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// DefaultInterval is how often the archiver runs
+const DefaultInterval = 24 * time.Hour
+
+// DefaultAfterMonths is how old an event must be before it is archived
+const DefaultAfterMonths = 24
+
+// Archiver periodically moves events older than a cutoff into the archive
+type Archiver struct {
+	events      *store.CalendarEventStore
+	afterMonths int
+	interval    time.Duration
+	batchSize   int
+	stopCh      chan struct{}
+	doneCh      chan struct{}
+}
+
+// NewArchiver creates an archiver for events older than afterMonths
+func NewArchiver(events *store.CalendarEventStore, afterMonths int, interval time.Duration) *Archiver {
+	return &Archiver{
+		events:      events,
+		afterMonths: afterMonths,
+		interval:    interval,
+		batchSize:   store.DefaultArchiveBatchSize,
+		stopCh:      make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+}
+
+// Cutoff returns the start time before which events are archived
+func Cutoff(now time.Time, afterMonths int) time.Time {
+	y, m, d := now.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).AddDate(0, -afterMonths, 0)
+}
+
+// Start begins the archive loop
+func (a *Archiver) Start(ctx context.Context) {
+	log.Printf("Starting event archiver (after: %d months, interval: %v)", a.afterMonths, a.interval)
+
+	go func() {
+		defer close(a.doneCh)
+
+		// Initial delay to let the server start up
+		select {
+		case <-time.After(time.Minute):
+		case <-a.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		a.run(ctx)
+
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				a.run(ctx)
+			case <-a.stopCh:
+				log.Println("Event archiver stopped")
+				return
+			case <-ctx.Done():
+				log.Println("Event archiver context cancelled")
+				return
+			}
+		}
+	}()
+}
+
+// Stop gracefully stops the archiver
+func (a *Archiver) Stop() {
+	close(a.stopCh)
+	<-a.doneCh
+}
+
+// RunOnce archives everything older than the cutoff in batches and returns
+// the number of events moved
+func (a *Archiver) RunOnce(ctx context.Context) (int64, error) {
+	cutoff := Cutoff(time.Now(), a.afterMonths)
+	var total int64
+	for {
+		select {
+		case <-a.stopCh:
+			return total, nil
+		default:
+		}
+
+		moved, err := a.events.Archive(ctx, cutoff, a.batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += moved
+		if moved < int64(a.batchSize) {
+			return total, nil
+		}
+	}
+}
+
+func (a *Archiver) run(ctx context.Context) {
+	moved, err := a.RunOnce(ctx)
+	if err != nil {
+		log.Printf("Event archive failed after moving %d events: %v", moved, err)
+		return
+	}
+	if moved > 0 {
+		log.Printf("Archived %d calendar events", moved)
+	}
+}
@@ -0,0 +1,22 @@
+package archive
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCutoff(t *testing.T) {
+	now := time.Date(2026, 3, 15, 17, 30, 0, 0, time.UTC)
+
+	got := Cutoff(now, 24)
+	want := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Cutoff(24) = %v, want %v", got, want)
+	}
+
+	got = Cutoff(now.In(time.FixedZone("PST", -8*3600)), 1)
+	want = time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Cutoff(1) in another zone = %v, want %v", got, want)
+	}
+}
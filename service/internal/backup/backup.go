@@ -0,0 +1,243 @@
+// Package backup writes and restores logical backups of one user's data or
+// the whole database. A backup is JSON lines, one per row, read from a
+// single repeatable-read snapshot so it is consistent across tables, and
+// restored in one transaction in foreign key order. Encrypted columns stay
+// encrypted, so a restore needs the same ENCRYPTION_KEY as the backup.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// restoreBatchSize bounds how many rows are sent to the database at once
+const restoreBatchSize = 500
+
+var (
+	// ErrUserNotFound is returned when backing up a user who doesn't exist
+	ErrUserNotFound = errors.New("user not found")
+	// ErrUserExists is returned when restoring a user who already exists
+	// without replacing them
+	ErrUserExists = errors.New("user already exists")
+	// ErrNotEmpty is returned when restoring a whole-database backup into a
+	// database that has users, without replacing them
+	ErrNotEmpty = errors.New("database is not empty")
+	// ErrSchemaMismatch is returned when a backup was taken at a different
+	// schema version than the database has
+	ErrSchemaMismatch = errors.New("backup schema version does not match the database")
+)
+
+// Summary describes a backup written or restored
+type Summary struct {
+	Header
+	Rows map[string]int64 // Rows per table; tables without rows are left out
+}
+
+// Dump writes a backup of one user, or of the whole database when userID
+// is nil
+func Dump(ctx context.Context, pool *pgxpool.Pool, w io.Writer, userID *uuid.UUID) (*Summary, error) {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	summary := &Summary{
+		Header: Header{CreatedAt: time.Now().UTC(), UserID: userID},
+		Rows:   make(map[string]int64),
+	}
+	if summary.SchemaVersion, err = schemaVersion(ctx, tx); err != nil {
+		return nil, err
+	}
+	if userID != nil {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)", *userID).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrUserNotFound
+		}
+	}
+
+	bw, err := NewWriter(w, summary.Header)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range Tables {
+		if userID != nil && t.UserScope == "" {
+			continue
+		}
+		n, err := dumpTable(ctx, tx, bw, t, userID)
+		if err != nil {
+			return nil, fmt.Errorf("back up %s: %w", t.Name, err)
+		}
+		if n > 0 {
+			summary.Rows[t.Name] = n
+		}
+	}
+	if err := bw.Close(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func dumpTable(ctx context.Context, tx pgx.Tx, bw *Writer, t Table, userID *uuid.UUID) (int64, error) {
+	query := "SELECT row_to_json(t)::text FROM " + t.Name + " t"
+	var args []any
+	if userID != nil {
+		query += " WHERE " + t.UserScope
+		args = append(args, *userID)
+	}
+	if t.OrderBy != "" {
+		query += " ORDER BY " + t.OrderBy
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var n int64
+	for rows.Next() {
+		var row string
+		if err := rows.Scan(&row); err != nil {
+			return n, err
+		}
+		if err := bw.WriteRow(t.Name, json.RawMessage(row)); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, rows.Err()
+}
+
+// RestoreOptions controls how a backup replaces existing data
+type RestoreOptions struct {
+	// Replace deletes the backed-up user, or every backed-up table for a
+	// whole-database backup, before restoring
+	Replace bool
+}
+
+// Restore loads a backup in one transaction. The database must be at the
+// backup's schema version; run migrations to match first. Nothing is
+// restored unless the whole backup loads.
+func Restore(ctx context.Context, pool *pgxpool.Pool, r io.Reader, opts RestoreOptions) (*Summary, error) {
+	br, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	summary := &Summary{Header: br.Header(), Rows: make(map[string]int64)}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if version != summary.SchemaVersion {
+		return nil, fmt.Errorf("%w: backup %d, database %d", ErrSchemaMismatch, summary.SchemaVersion, version)
+	}
+	if err := clearForRestore(ctx, tx, summary.UserID, opts.Replace); err != nil {
+		return nil, err
+	}
+
+	batch := &pgx.Batch{}
+	last := 0
+	for {
+		table, row, err := br.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Rows must come in restore order so references resolve, and a user
+		// backup may only hold tables scoped to the user
+		i := tableIndex(table)
+		if i < 0 || i < last || (summary.UserID != nil && Tables[i].UserScope == "") {
+			return nil, fmt.Errorf("%w: unexpected rows for table %q", ErrInvalidBackup, table)
+		}
+		last = i
+
+		batch.Queue("INSERT INTO "+table+" SELECT * FROM json_populate_record(NULL::"+table+", $1)", string(row))
+		summary.Rows[table]++
+		if batch.Len() >= restoreBatchSize {
+			if err := sendBatch(ctx, tx, batch); err != nil {
+				return nil, err
+			}
+			batch = &pgx.Batch{}
+		}
+	}
+	if err := sendBatch(ctx, tx, batch); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// clearForRestore makes room for a backup, or checks there is room
+func clearForRestore(ctx context.Context, tx pgx.Tx, userID *uuid.UUID, replace bool) error {
+	if userID != nil {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)", *userID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return nil
+		}
+		if !replace {
+			return ErrUserExists
+		}
+		// Everything the user owns cascades
+		_, err := tx.Exec(ctx, "DELETE FROM users WHERE id = $1", *userID)
+		return err
+	}
+
+	if replace {
+		names := make([]string, len(Tables))
+		for i, t := range Tables {
+			names[i] = t.Name
+		}
+		_, err := tx.Exec(ctx, "TRUNCATE "+strings.Join(names, ", ")+" CASCADE")
+		return err
+	}
+	var exists bool
+	if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM users)").Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return ErrNotEmpty
+	}
+	return nil
+}
+
+func sendBatch(ctx context.Context, tx pgx.Tx, batch *pgx.Batch) error {
+	if batch.Len() == 0 {
+		return nil
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+func schemaVersion(ctx context.Context, tx pgx.Tx) (int, error) {
+	var version int
+	err := tx.QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
+	return version, err
+}
@@ -0,0 +1,121 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+func TestWriterReader(t *testing.T) {
+	userID := uuid.New()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{SchemaVersion: 55, CreatedAt: time.Now().UTC(), UserID: &userID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.WriteRow("users", json.RawMessage(`{"id":"`+userID.String()+`"}`))
+	w.WriteRow("projects", json.RawMessage(`{"name":"Acme"}`))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	if h := r.Header(); h.Format != Format || h.SchemaVersion != 55 || h.UserID == nil || *h.UserID != userID {
+		t.Errorf("unexpected header %+v", h)
+	}
+
+	var tables []string
+	for {
+		table, _, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		tables = append(tables, table)
+	}
+	if strings.Join(tables, ",") != "users,projects" {
+		t.Errorf("expected users then projects, got %v", tables)
+	}
+}
+
+func TestReader_Truncated(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf, Header{SchemaVersion: 1})
+	w.WriteRow("users", json.RawMessage(`{}`))
+	w.WriteRow("projects", json.RawMessage(`{}`))
+	w.Close()
+
+	lines := strings.SplitAfter(buf.String(), "\n")
+	cases := map[string]string{
+		"no trailer":    strings.Join(lines[:3], ""),
+		"missing a row": lines[0] + lines[1] + lines[3],
+	}
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			r, err := NewReader(strings.NewReader(input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for err == nil {
+				_, _, err = r.Next()
+			}
+			if !errors.Is(err, ErrTruncated) {
+				t.Errorf("expected ErrTruncated, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewReader_NotABackup(t *testing.T) {
+	for _, input := range []string{"", `{"projects":[]}`, "not json"} {
+		if _, err := NewReader(strings.NewReader(input)); !errors.Is(err, ErrInvalidBackup) {
+			t.Errorf("NewReader(%q): expected ErrInvalidBackup, got %v", input, err)
+		}
+	}
+}
+
+// TestTables_CoverSchema fails when a migration adds a table that is
+// neither backed up nor deliberately skipped
+func TestTables_CoverSchema(t *testing.T) {
+	migrations, err := database.LoadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createRe := regexp.MustCompile(`(?i)CREATE TABLE (?:IF NOT EXISTS )?(\w+)`)
+	dropRe := regexp.MustCompile(`(?i)DROP TABLE (?:IF EXISTS )?(\w+)`)
+	schema := make(map[string]bool)
+	for _, m := range migrations {
+		for _, match := range createRe.FindAllStringSubmatch(m.Up, -1) {
+			schema[match[1]] = true
+		}
+		for _, match := range dropRe.FindAllStringSubmatch(m.Up, -1) {
+			delete(schema, match[1])
+		}
+	}
+	delete(schema, "schema_migrations")
+
+	for name := range schema {
+		if tableIndex(name) < 0 && Skipped[name] == "" {
+			t.Errorf("table %s is not in Tables or Skipped", name)
+		}
+	}
+	for _, table := range Tables {
+		if !schema[table.Name] {
+			t.Errorf("backed-up table %s is not in the schema", table.Name)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package backup
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Format identifies backup files, and FormatVersion the layout of their lines
+const (
+	Format        = "timesheet-backup"
+	FormatVersion = 1
+)
+
+var (
+	// ErrInvalidBackup is returned for input that isn't a backup file
+	ErrInvalidBackup = errors.New("not a timesheet backup")
+	// ErrTruncated is returned when a backup ends before its trailer
+	ErrTruncated = errors.New("backup is truncated")
+)
+
+// Header is the first line of a backup
+type Header struct {
+	Format        string     `json:"format"`
+	Version       int        `json:"version"`
+	SchemaVersion int        `json:"schema_version"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UserID        *uuid.UUID `json:"user_id,omitempty"` // Nil for a whole-database backup
+}
+
+// record is every line after the header: a row, or the trailer that closes
+// the backup
+type record struct {
+	Table string          `json:"table,omitempty"`
+	Row   json.RawMessage `json:"row,omitempty"`
+	End   *trailer        `json:"end,omitempty"`
+}
+
+type trailer struct {
+	Rows int64 `json:"rows"`
+}
+
+// Writer writes a backup as JSON lines: the header, one line per row, then
+// a trailer with the row count
+type Writer struct {
+	w    *bufio.Writer
+	enc  *json.Encoder
+	rows int64
+}
+
+// NewWriter writes the header and returns a writer for the rows
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	header.Format, header.Version = Format, FormatVersion
+	if err := enc.Encode(header); err != nil {
+		return nil, err
+	}
+	return &Writer{w: bw, enc: enc}, nil
+}
+
+// WriteRow writes one row, given as the JSON object of its columns
+func (w *Writer) WriteRow(table string, row json.RawMessage) error {
+	w.rows++
+	return w.enc.Encode(record{Table: table, Row: row})
+}
+
+// Close writes the trailer and flushes. A backup without its trailer is
+// rejected on restore.
+func (w *Writer) Close() error {
+	if err := w.enc.Encode(record{End: &trailer{Rows: w.rows}}); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// Reader reads a backup written by Writer
+type Reader struct {
+	dec    *json.Decoder
+	header Header
+	rows   int64
+	done   bool
+}
+
+// NewReader reads and checks the header
+func NewReader(r io.Reader) (*Reader, error) {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	var header Header
+	if err := dec.Decode(&header); err != nil || header.Format != Format {
+		return nil, ErrInvalidBackup
+	}
+	if header.Version > FormatVersion {
+		return nil, fmt.Errorf("backup format version %d is newer than supported (%d)", header.Version, FormatVersion)
+	}
+	return &Reader{dec: dec, header: header}, nil
+}
+
+// Header returns the backup's header
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next row. It returns io.EOF after the trailer, and
+// ErrTruncated if the input ends first or the row count doesn't match.
+func (r *Reader) Next() (string, json.RawMessage, error) {
+	if r.done {
+		return "", nil, io.EOF
+	}
+
+	var rec record
+	if err := r.dec.Decode(&rec); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return "", nil, ErrTruncated
+		}
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+	}
+
+	if rec.End != nil {
+		r.done = true
+		if rec.End.Rows != r.rows {
+			return "", nil, ErrTruncated
+		}
+		return "", nil, io.EOF
+	}
+	if rec.Table == "" || len(rec.Row) == 0 {
+		return "", nil, fmt.Errorf("%w: row %d has no table or columns", ErrInvalidBackup, r.rows+1)
+	}
+	r.rows++
+	return rec.Table, rec.Row, nil
+}
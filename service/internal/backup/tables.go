@@ -0,0 +1,86 @@
+package backup
+
+// Table is a table included in backups
+type Table struct {
+	Name string
+	// UserScope is the WHERE clause selecting one user's rows, with the user
+	// ID as $1. Tables without one are shared between users and only
+	// included in whole-database backups.
+	UserScope string
+	// OrderBy orders the rows where a table references itself
+	OrderBy string
+}
+
+// Tables lists the backed-up tables in restore order: every table comes
+// after the tables its foreign keys reference.
+var Tables = []Table{
+	{Name: "users", UserScope: "id = $1"},
+	{Name: "organizations"},
+	{Name: "organization_domains"},
+	{Name: "organization_members"},
+	{Name: "mcp_oauth_clients"},
+	{Name: "projects", UserScope: "user_id = $1"},
+	{Name: "client_rates", UserScope: "user_id = $1"},
+	{Name: "billing_periods", UserScope: "user_id = $1"},
+	{Name: "invoices", UserScope: "user_id = $1", OrderBy: "created_at, id"},
+	{Name: "invoice_exports", UserScope: "user_id = $1"},
+	{Name: "invoice_comments", UserScope: "user_id = $1"},
+	{Name: "payments", UserScope: "user_id = $1"},
+	{Name: "expenses", UserScope: "user_id = $1"},
+	{Name: "time_entries", UserScope: "user_id = $1"},
+	{Name: "invoice_line_items", UserScope: "invoice_id IN (SELECT id FROM invoices WHERE user_id = $1)"},
+	{Name: "time_entry_notes", UserScope: "user_id = $1"},
+	{Name: "calendar_connections", UserScope: "user_id = $1"},
+	{Name: "calendars", UserScope: "user_id = $1"},
+	{Name: "calendar_synced_weeks", UserScope: "calendar_id IN (SELECT id FROM calendars WHERE user_id = $1)"},
+	{Name: "classification_rules", UserScope: "user_id = $1"},
+	{Name: "suppression_rules", UserScope: "user_id = $1"},
+	{Name: "attendee_aliases", UserScope: "user_id = $1"},
+	{Name: "calendar_events", UserScope: "user_id = $1"},
+	{Name: "calendar_events_archive", UserScope: "user_id = $1"},
+	{Name: "calendar_event_changes", UserScope: "user_id = $1"},
+	{Name: "calendar_event_classifications", UserScope: "user_id = $1"},
+	{Name: "event_attendees", UserScope: "user_id = $1"},
+	{Name: "classification_overrides", UserScope: "user_id = $1"},
+	{Name: "time_entry_events", UserScope: "time_entry_id IN (SELECT id FROM time_entries WHERE user_id = $1)"},
+	{Name: "classification_actions", UserScope: "user_id = $1"},
+	{Name: "classification_action_events", UserScope: "action_id IN (SELECT id FROM classification_actions WHERE user_id = $1)"},
+	{Name: "classification_snapshots", UserScope: "user_id = $1"},
+	{Name: "classification_snapshot_events", UserScope: "snapshot_id IN (SELECT id FROM classification_snapshots WHERE user_id = $1)"},
+	{Name: "tags", UserScope: "user_id = $1"},
+	{Name: "calendar_event_tags", UserScope: "tag_id IN (SELECT id FROM tags WHERE user_id = $1)"},
+	{Name: "time_entry_tags", UserScope: "tag_id IN (SELECT id FROM tags WHERE user_id = $1)"},
+	{Name: "working_hours", UserScope: "user_id = $1"},
+	{Name: "day_anomalies", UserScope: "user_id = $1"},
+	{Name: "hour_goals", UserScope: "user_id = $1"},
+	{Name: "api_keys", UserScope: "user_id = $1"},
+	{Name: "github_connections", UserScope: "user_id = $1"},
+	{Name: "integration_credentials", UserScope: "user_id = $1"},
+	{Name: "mcp_access_tokens", UserScope: "user_id = $1"},
+	// Sessions reference shared OAuth clients, so a user restore leaves
+	// pending authorizations behind
+	{Name: "mcp_oauth_sessions"},
+}
+
+// Skipped lists the tables left out of backups, and why
+var Skipped = map[string]string{
+	"schema_migrations":         "recorded as the backup's schema version",
+	"change_feed":               "rebuilt by triggers as rows are restored",
+	"daily_project_hours":       "recomputed once restored rows mark their days stale",
+	"daily_project_hours_state": "recomputed once restored rows mark their days stale",
+	"calendar_sync_jobs":        "work queue; calendars resync on schedule",
+	"calendar_sync_leases":      "held only while a sync runs",
+	"classification_jobs":       "work queue",
+	"recalculation_jobs":        "work queue",
+	"idempotency_keys":          "short-lived request replay cache",
+}
+
+// tableIndex returns a table's position in Tables, or -1
+func tableIndex(name string) int {
+	for i, t := range Tables {
+		if t.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,70 @@
+// Package cache provides small in-process caches for per-user read models
+// that are read far more often than they are written.
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PerUser caches one value per user for a fixed TTL. Concurrent misses for
+// the same user each load independently; the last load to finish wins.
+type PerUser[T any] struct {
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[uuid.UUID]perUserEntry[T]
+	gen     map[uuid.UUID]uint64
+}
+
+type perUserEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+// NewPerUser creates a cache whose entries expire after ttl
+func NewPerUser[T any](ttl time.Duration) *PerUser[T] {
+	return &PerUser[T]{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[uuid.UUID]perUserEntry[T]),
+		gen:     make(map[uuid.UUID]uint64),
+	}
+}
+
+// Get returns the user's cached value, calling load on a miss. Errors are
+// not cached.
+func (c *PerUser[T]) Get(ctx context.Context, userID uuid.UUID, load func(ctx context.Context) (T, error)) (T, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[userID]; ok && c.now().Before(e.expires) {
+		c.mu.Unlock()
+		return e.value, nil
+	}
+	gen := c.gen[userID]
+	c.mu.Unlock()
+
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// An invalidation that raced with the load means the value may already
+	// be stale, so hand it back without caching it
+	if c.gen[userID] == gen {
+		c.entries[userID] = perUserEntry[T]{value: value, expires: c.now().Add(c.ttl)}
+	}
+	return value, nil
+}
+
+// Invalidate drops the user's cached value
+func (c *PerUser[T]) Invalidate(userID uuid.UUID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userID)
+	c.gen[userID]++
+}
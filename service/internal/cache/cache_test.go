@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPerUser_CachesUntilExpiry(t *testing.T) {
+	now := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	c := NewPerUser[int](time.Minute)
+	c.now = func() time.Time { return now }
+
+	loads := 0
+	load := func(context.Context) (int, error) {
+		loads++
+		return loads, nil
+	}
+	userID := uuid.New()
+
+	for i := 0; i < 3; i++ {
+		if v, _ := c.Get(context.Background(), userID, load); v != 1 {
+			t.Fatalf("expected cached value 1, got %d", v)
+		}
+	}
+
+	now = now.Add(time.Minute)
+	if v, _ := c.Get(context.Background(), userID, load); v != 2 {
+		t.Errorf("expected reload after TTL, got %d", v)
+	}
+
+	if v, _ := c.Get(context.Background(), uuid.New(), load); v != 3 {
+		t.Errorf("expected users to be cached separately, got %d", v)
+	}
+}
+
+func TestPerUser_Invalidate(t *testing.T) {
+	c := NewPerUser[string](time.Hour)
+	userID := uuid.New()
+
+	value := "before"
+	load := func(context.Context) (string, error) { return value, nil }
+
+	c.Get(context.Background(), userID, load)
+	value = "after"
+	c.Invalidate(userID)
+	if v, _ := c.Get(context.Background(), userID, load); v != "after" {
+		t.Errorf("expected reload after invalidation, got %q", v)
+	}
+}
+
+func TestPerUser_InvalidateDuringLoad(t *testing.T) {
+	c := NewPerUser[string](time.Hour)
+	userID := uuid.New()
+
+	// The write lands while the read is in flight, so its result is stale
+	v, _ := c.Get(context.Background(), userID, func(context.Context) (string, error) {
+		c.Invalidate(userID)
+		return "stale", nil
+	})
+	if v != "stale" {
+		t.Fatalf("expected the loaded value to be returned, got %q", v)
+	}
+
+	v, _ = c.Get(context.Background(), userID, func(context.Context) (string, error) { return "fresh", nil })
+	if v != "fresh" {
+		t.Errorf("expected the stale value not to be cached, got %q", v)
+	}
+}
+
+func TestPerUser_DoesNotCacheErrors(t *testing.T) {
+	c := NewPerUser[int](time.Hour)
+	userID := uuid.New()
+
+	_, err := c.Get(context.Background(), userID, func(context.Context) (int, error) {
+		return 0, errors.New("db down")
+	})
+	if err == nil {
+		t.Fatal("expected load error")
+	}
+
+	v, err := c.Get(context.Background(), userID, func(context.Context) (int, error) { return 7, nil })
+	if err != nil || v != 7 {
+		t.Errorf("expected retry after error, got %d, %v", v, err)
+	}
+}
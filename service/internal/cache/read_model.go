@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// DefaultTTL bounds how long a cached read model can be served. Writes made
+// through the stores invalidate immediately; the TTL only matters for
+// changes made outside this process.
+const DefaultTTL = 5 * time.Minute
+
+// ReadModel serves a user's projects and classification rules from memory.
+// Each user's full lists (including archived projects and disabled rules)
+// are cached and filtered per call. Returned values are copies and may be
+// modified by the caller.
+type ReadModel struct {
+	projectStore *store.ProjectStore
+	ruleStore    *store.ClassificationRuleStore
+	projects     *PerUser[[]*store.Project]
+	rules        *PerUser[[]*store.ClassificationRule]
+}
+
+// NewReadModel creates a read model over the given stores and registers
+// change hooks on them so writes invalidate the affected user's entries
+func NewReadModel(projects *store.ProjectStore, rules *store.ClassificationRuleStore, ttl time.Duration) *ReadModel {
+	m := &ReadModel{
+		projectStore: projects,
+		ruleStore:    rules,
+		projects:     NewPerUser[[]*store.Project](ttl),
+		rules:        NewPerUser[[]*store.ClassificationRule](ttl),
+	}
+	projects.OnChange(func(userID uuid.UUID) {
+		m.projects.Invalidate(userID)
+		// Rules carry their project's name and color
+		m.rules.Invalidate(userID)
+	})
+	rules.OnChange(m.rules.Invalidate)
+	return m
+}
+
+// Projects returns the user's projects ordered by name. Without
+// includeArchived, only archived projects still shown in pickers are kept.
+func (m *ReadModel) Projects(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*store.Project, error) {
+	all, err := m.projects.Get(ctx, userID, func(ctx context.Context) ([]*store.Project, error) {
+		return m.projectStore.List(ctx, userID, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []*store.Project
+	for _, p := range all {
+		if p.IsArchived && p.ArchiveHideFromPickers && !includeArchived {
+			continue
+		}
+		c := *p
+		projects = append(projects, &c)
+	}
+	return projects, nil
+}
+
+// QueryProjects returns the user's projects filtered, sorted and paged by
+// opts. The activity sorts depend on time entries, so queries bypass the
+// cache.
+func (m *ReadModel) QueryProjects(ctx context.Context, userID uuid.UUID, opts store.ProjectListOptions) ([]*store.Project, error) {
+	return m.projectStore.Query(ctx, userID, opts)
+}
+
+// Project returns one of the user's projects, or store.ErrProjectNotFound
+func (m *ReadModel) Project(ctx context.Context, userID, projectID uuid.UUID) (*store.Project, error) {
+	projects, err := m.Projects(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		if p.ID == projectID {
+			return p, nil
+		}
+	}
+	return nil, store.ErrProjectNotFound
+}
+
+// Rules returns the user's classification rules, highest weight first
+func (m *ReadModel) Rules(ctx context.Context, userID uuid.UUID, includeDisabled bool) ([]*store.ClassificationRule, error) {
+	return m.filterRules(ctx, userID, func(r *store.ClassificationRule) bool {
+		return r.IsEnabled || includeDisabled
+	})
+}
+
+// AttendanceRules returns the user's enabled attendance rules
+func (m *ReadModel) AttendanceRules(ctx context.Context, userID uuid.UUID) ([]*store.ClassificationRule, error) {
+	return m.filterRules(ctx, userID, func(r *store.ClassificationRule) bool {
+		return r.IsEnabled && r.Attended != nil
+	})
+}
+
+func (m *ReadModel) filterRules(ctx context.Context, userID uuid.UUID, keep func(*store.ClassificationRule) bool) ([]*store.ClassificationRule, error) {
+	all, err := m.rules.Get(ctx, userID, func(ctx context.Context) ([]*store.ClassificationRule, error) {
+		return m.ruleStore.List(ctx, userID, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*store.ClassificationRule
+	for _, r := range all {
+		if !keep(r) {
+			continue
+		}
+		c := *r
+		rules = append(rules, &c)
+	}
+	return rules, nil
+}
@@ -0,0 +1,132 @@
+package classification
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Aliases maps each aliased email, lowercase, to every email of the same
+// person. Expanding an event's attendees with it lets email: and domain:
+// conditions and fingerprints keyed to one of a person's emails match the
+// others. A nil Aliases expands nothing.
+type Aliases map[string][]string
+
+// NewAliases groups alias mappings into identities. Mappings that share an
+// email, as canonical or alias, join into one identity.
+func NewAliases(mappings []*store.AttendeeAlias) Aliases {
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	parent := make(map[string]string)
+	var find func(email string) string
+	find = func(email string) string {
+		p, ok := parent[email]
+		if !ok || p == email {
+			parent[email] = email
+			return email
+		}
+		root := find(p)
+		parent[email] = root
+		return root
+	}
+	for _, m := range mappings {
+		a, b := find(strings.ToLower(m.CanonicalEmail)), find(strings.ToLower(m.AliasEmail))
+		if a != b {
+			parent[b] = a
+		}
+	}
+
+	identities := make(map[string][]string)
+	for email := range parent {
+		root := find(email)
+		identities[root] = append(identities[root], email)
+	}
+	aliases := make(Aliases, len(parent))
+	for _, emails := range identities {
+		sort.Strings(emails)
+		for _, email := range emails {
+			aliases[email] = emails
+		}
+	}
+	return aliases
+}
+
+// Expand returns the attendees followed by the other emails of each
+// attendee's identity, without duplicates. Attendees are returned as is when
+// none has an alias.
+func (a Aliases) Expand(attendees []string) []string {
+	if len(a) == 0 {
+		return attendees
+	}
+	var extra []string
+	seen := make(map[string]bool, len(attendees))
+	for _, attendee := range attendees {
+		seen[strings.ToLower(attendee)] = true
+	}
+	for _, attendee := range attendees {
+		for _, email := range a[strings.ToLower(attendee)] {
+			if !seen[email] {
+				seen[email] = true
+				extra = append(extra, email)
+			}
+		}
+	}
+	if len(extra) == 0 {
+		return attendees
+	}
+	return append(append(make([]string, 0, len(attendees)+len(extra)), attendees...), extra...)
+}
+
+// expandFilter widens an attendee prefilter so events attended under another
+// email of a filtered person still pass: each filtered email brings its
+// identity's emails, and each filtered domain the emails of identities with
+// an address in it
+func (a Aliases) expandFilter(filter *store.AttendeeFilter) {
+	if len(a) == 0 {
+		return
+	}
+	emails := filter.Emails
+	for _, email := range filter.Emails {
+		emails = append(emails, a[strings.ToLower(email)]...)
+	}
+	for alias, identity := range a {
+		for _, domain := range filter.Domains {
+			if strings.EqualFold(extractDomain(alias), domain) {
+				emails = append(emails, identity...)
+				break
+			}
+		}
+	}
+	filter.Emails = emails
+}
+
+// aliasesFor loads the user's attendee aliases
+func (s *Service) aliasesFor(ctx context.Context, userID uuid.UUID) (Aliases, error) {
+	mappings, err := s.aliasStore.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return NewAliases(mappings), nil
+}
+
+// AttendeeAliases returns the user's alias mappings
+func (s *Service) AttendeeAliases(ctx context.Context, userID uuid.UUID) ([]*store.AttendeeAlias, error) {
+	return s.aliasStore.List(ctx, userID)
+}
+
+// AddAttendeeAlias maps aliasEmail to the person with canonicalEmail. It
+// applies from the next classification of each event.
+func (s *Service) AddAttendeeAlias(ctx context.Context, userID uuid.UUID, canonicalEmail, aliasEmail string) (*store.AttendeeAlias, error) {
+	return s.aliasStore.Create(ctx, userID, canonicalEmail, aliasEmail)
+}
+
+// DeleteAttendeeAlias removes an alias mapping
+func (s *Service) DeleteAttendeeAlias(ctx context.Context, userID, aliasID uuid.UUID) error {
+	return s.aliasStore.Delete(ctx, userID, aliasID)
+}
@@ -0,0 +1,55 @@
+package classification
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestAliases(t *testing.T) {
+	aliases := NewAliases([]*store.AttendeeAlias{
+		{CanonicalEmail: "pat@acme.com", AliasEmail: "pat@globex.com"},
+		{CanonicalEmail: "Pat.Smith@Initech.com", AliasEmail: "pat@globex.com"},
+		{CanonicalEmail: "sam@acme.com", AliasEmail: "sam.old@acme.com"},
+	})
+
+	// The two mappings through pat@globex.com join into one identity
+	got := aliases.Expand([]string{"PAT@acme.com", "lee@acme.com"})
+	want := []string{"PAT@acme.com", "lee@acme.com", "pat.smith@initech.com", "pat@globex.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expand = %v, want %v", got, want)
+	}
+	if got := aliases.Expand([]string{"lee@acme.com"}); len(got) != 1 {
+		t.Errorf("expected attendees without aliases unchanged, got %v", got)
+	}
+
+	// A rule keyed to a person's old email still matches under the new one
+	event := &store.CalendarEvent{ID: uuid.New(), Title: "Catch-up", Attendees: []string{"pat.smith@initech.com"}}
+	for _, query := range []string{"email:pat@acme.com", "domain:globex.com"} {
+		ast, err := Parse(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !Evaluate(ast, itemToProperties(eventToItem(event, aliases))) {
+			t.Errorf("%s: expected a match through the alias", query)
+		}
+		if Evaluate(ast, itemToProperties(eventToItem(event, nil))) {
+			t.Errorf("%s: expected no match without aliases", query)
+		}
+	}
+
+	filter := &store.AttendeeFilter{Domains: []string{"globex.com"}, Emails: []string{"sam@acme.com"}}
+	aliases.expandFilter(filter)
+	for _, email := range []string{"sam.old@acme.com", "pat.smith@initech.com"} {
+		found := false
+		for _, e := range filter.Emails {
+			found = found || e == email
+		}
+		if !found {
+			t.Errorf("expected %s in expanded filter %v", email, filter.Emails)
+		}
+	}
+}
@@ -37,6 +37,10 @@ type Rule struct {
 //   - "domains": list of domain strings to match against item attendee emails
 //   - "emails": list of email addresses to match against item attendees
 //   - "keywords": list of keywords to match against item title/description
+//   - "exclude_domains", "exclude_keywords": like domains and keywords, but a
+//     match counts against the target
+//   - "fingerprint_weights": optional map of "kind:value" (e.g. "email:ceo@acme.com")
+//     to the weight of that fingerprint's vote; unlisted fingerprints weigh 1.0
 //   - "_description", "_notes": context for future LLM use (ignored by rule-based matching)
 type Target struct {
 	ID         string         // Target identifier
@@ -92,28 +96,97 @@ func DefaultConfig() Config {
 //   - "domains" → domain:X queries
 //   - "emails" → email:X queries
 //   - "keywords" → title:X queries
+//   - "exclude_domains", "exclude_keywords" → the same queries with negative weight
+//
+// Fingerprint votes weigh 1.0 unless "fingerprint_weights" sets another weight.
+// An item whose "calendar_default_project" attribute names a target also
+// gets an implicit rule voting for it: its calendar's project mapping. The
+// vote weighs "calendar_default_weight" when set, so a dedicated calendar
+// classifies its own events; otherwise it is a CalendarHintWeight hint, and a
+// target supported by the hint alone always needs review.
+//
+// Negative votes lower their target's score without adding to the total, so
+// they can stop a target from winning but never make another target look
+// less certain.
 func Classify(rules []Rule, targets []Target, items []Item, config Config) []Result {
-	// Generate rules from target attributes
-	allRules, fingerprintRuleIDs := generateTargetRules(targets)
-	allRules = append(allRules, rules...)
+	return Compile(rules, targets).Classify(items, config)
+}
+
+// CompiledRule is a rule with its query parsed, so evaluating it against
+// many items parses it only once
+type CompiledRule struct {
+	Rule
+	AST    QueryNode   // Parsed query, nil if the query is invalid
+	Err    error       // Parse error of an invalid query
+	Source MatchSource // Explicit rule or generated from a target fingerprint
+}
+
+// Matches reports whether the rule matches the properties. An invalid rule
+// never matches.
+func (r CompiledRule) Matches(props *EventProperties) bool {
+	return r.AST != nil && Evaluate(r.AST, props)
+}
+
+// RuleSet holds explicit rules and the targets' fingerprint rules, compiled
+// once and evaluated against any number of items
+type RuleSet struct {
+	Rules       []CompiledRule // Fingerprint rules first, then explicit rules
+	targetIDs   map[string]bool
+	targetNames map[string]string
+}
+
+// Compile parses the rules and the fingerprint rules generated from the
+// targets' attributes. Invalid queries are kept, with their parse error, so
+// explanations can still list them.
+func Compile(rules []Rule, targets []Target) *RuleSet {
+	fingerprintRules := generateTargetRules(targets)
+
+	compiled := make([]CompiledRule, 0, len(fingerprintRules)+len(rules))
+	for _, rule := range fingerprintRules {
+		compiled = append(compiled, compileRule(rule, MatchSourceFingerprint))
+	}
+	for _, rule := range rules {
+		compiled = append(compiled, compileRule(rule, MatchSourceRule))
+	}
+
+	targetNames := make(map[string]string)
+	for _, t := range targets {
+		if name, ok := t.Attributes["name"].(string); ok {
+			targetNames[t.ID] = name
+		}
+	}
+
+	return &RuleSet{
+		Rules:       compiled,
+		targetIDs:   targetIDSet(targets),
+		targetNames: targetNames,
+	}
+}
+
+func compileRule(rule Rule, source MatchSource) CompiledRule {
+	ast, err := Parse(rule.Query)
+	return CompiledRule{Rule: rule, AST: ast, Err: err, Source: source}
+}
 
+// Classify is the package-level Classify over the compiled rules
+func (rs *RuleSet) Classify(items []Item, config Config) []Result {
 	results := make([]Result, 0, len(items))
 
 	for _, item := range items {
-		result := classifyItem(allRules, fingerprintRuleIDs, item, config)
+		result := rs.classifyItem(item, config)
 		results = append(results, result)
 	}
 
 	return results
 }
 
-// generateTargetRules creates classification rules from target attributes.
-// Returns the generated rules and a set of rule IDs that are fingerprint-based.
-func generateTargetRules(targets []Target) ([]Rule, map[string]bool) {
+// generateTargetRules creates classification rules from target attributes
+func generateTargetRules(targets []Target) []Rule {
 	var rules []Rule
-	fingerprintRuleIDs := make(map[string]bool)
 
 	for _, target := range targets {
+		weights := fingerprintWeights(target.Attributes)
+
 		// Generate rules for domain attributes
 		if domains, ok := getStringSlice(target.Attributes, "domains"); ok {
 			for _, domain := range domains {
@@ -122,9 +195,8 @@ func generateTargetRules(targets []Target) ([]Rule, map[string]bool) {
 					ID:       ruleID,
 					Query:    "domain:" + domain,
 					TargetID: target.ID,
-					Weight:   1.0,
+					Weight:   weights.of("domain", domain),
 				})
-				fingerprintRuleIDs[ruleID] = true
 			}
 		}
 
@@ -136,9 +208,8 @@ func generateTargetRules(targets []Target) ([]Rule, map[string]bool) {
 					ID:       ruleID,
 					Query:    "email:" + email,
 					TargetID: target.ID,
-					Weight:   1.0,
+					Weight:   weights.of("email", email),
 				})
-				fingerprintRuleIDs[ruleID] = true
 			}
 		}
 
@@ -150,14 +221,123 @@ func generateTargetRules(targets []Target) ([]Rule, map[string]bool) {
 					ID:       ruleID,
 					Query:    "text:" + quoteIfNeeded(keyword),
 					TargetID: target.ID,
-					Weight:   1.0,
+					Weight:   weights.of("keyword", keyword),
 				})
-				fingerprintRuleIDs[ruleID] = true
 			}
 		}
+
+		// Generate negative rules for exclusion attributes
+		if domains, ok := getStringSlice(target.Attributes, "exclude_domains"); ok {
+			for _, domain := range domains {
+				ruleID := fmt.Sprintf("fp:exclude_domain:%s:%s", target.ID, domain)
+				rules = append(rules, Rule{
+					ID:       ruleID,
+					Query:    "domain:" + domain,
+					TargetID: target.ID,
+					Weight:   -1.0,
+				})
+			}
+		}
+		if keywords, ok := getStringSlice(target.Attributes, "exclude_keywords"); ok {
+			for _, keyword := range keywords {
+				ruleID := fmt.Sprintf("fp:exclude_keyword:%s:%s", target.ID, keyword)
+				rules = append(rules, Rule{
+					ID:       ruleID,
+					Query:    "text:" + quoteIfNeeded(keyword),
+					TargetID: target.ID,
+					Weight:   -1.0,
+				})
+			}
+		}
+	}
+
+	return rules
+}
+
+// CalendarHintWeight is the vote a calendar's default project casts when the
+// mapping has no weight of its own, low enough that any matching rule or
+// fingerprint outweighs it
+const CalendarHintWeight = 0.3
+
+// targetIDSet indexes the target IDs
+func targetIDSet(targets []Target) map[string]bool {
+	ids := make(map[string]bool, len(targets))
+	for _, t := range targets {
+		ids[t.ID] = true
+	}
+	return ids
+}
+
+// calendarMapping is the implicit rule from an item's calendar to its
+// default project
+type calendarMapping struct {
+	TargetID string
+	Weight   float64
+	IsHint   bool // No weight configured: suggest only
+}
+
+// RuleID identifies the mapping's vote
+func (m calendarMapping) RuleID() string {
+	return "fp:calendar_default:" + m.TargetID
+}
+
+// supportsAlone reports whether the mapping's hint is all that made the
+// target win, so the result must be reviewed
+func (m calendarMapping) supportsAlone(winnerID string, winnerScore float64) bool {
+	return m.IsHint && winnerID == m.TargetID && winnerScore == m.Weight
+}
+
+// itemCalendarMapping returns the mapping of the item's calendar, if its
+// project is one of the targets
+func itemCalendarMapping(item Item, targetIDs map[string]bool) (calendarMapping, bool) {
+	targetID, _ := item.Attributes["calendar_default_project"].(string)
+	if targetID == "" || !targetIDs[targetID] {
+		return calendarMapping{}, false
+	}
+	if weight, ok := item.Attributes["calendar_default_weight"].(float64); ok && weight > 0 {
+		return calendarMapping{TargetID: targetID, Weight: weight}, true
+	}
+	return calendarMapping{TargetID: targetID, Weight: CalendarHintWeight, IsHint: true}, true
+}
+
+// positiveTotal sums the scores of targets still ahead after negative votes,
+// the denominator for confidence
+func positiveTotal(scores map[string]float64) float64 {
+	var total float64
+	for _, score := range scores {
+		if score > 0 {
+			total += score
+		}
+	}
+	return total
+}
+
+// fingerprintWeightMap holds per-fingerprint vote weights keyed by "kind:value"
+type fingerprintWeightMap map[string]float64
+
+// fingerprintWeights reads a target's "fingerprint_weights" attribute
+func fingerprintWeights(m map[string]any) fingerprintWeightMap {
+	switch w := m["fingerprint_weights"].(type) {
+	case map[string]float64:
+		return w
+	case map[string]any:
+		weights := make(fingerprintWeightMap, len(w))
+		for k, v := range w {
+			if f, ok := v.(float64); ok {
+				weights[k] = f
+			}
+		}
+		return weights
 	}
+	return nil
+}
 
-	return rules, fingerprintRuleIDs
+// of returns the weight for a fingerprint, defaulting to 1.0
+func (w fingerprintWeightMap) of(kind, value string) float64 {
+	if weight, ok := w[kind+":"+value]; ok && weight > 0 {
+		return weight
+	}
+	return 1.0
 }
 
 // getStringSlice extracts a string slice from a map value
@@ -181,36 +361,30 @@ func quoteIfNeeded(value string) string {
 }
 
 // classifyItem evaluates all rules against a single item
-func classifyItem(rules []Rule, fingerprintRuleIDs map[string]bool, item Item, config Config) Result {
+func (rs *RuleSet) classifyItem(item Item, config Config) Result {
 	// Convert item attributes to EventProperties for evaluation
 	props := itemToProperties(item)
 
 	// Collect votes from matching rules
 	scores := make(map[string]float64)
 	votes := make([]Vote, 0)
-	var totalWeight float64
 
 	// Track fingerprint vs rule weight per target for source determination
 	fingerprintWeight := make(map[string]float64)
 	ruleWeight := make(map[string]float64)
 
-	for _, rule := range rules {
-		ast, err := Parse(rule.Query)
-		if err != nil {
-			// Skip invalid rules
-			continue
-		}
-
-		if Evaluate(ast, props) {
+	for _, rule := range rs.Rules {
+		// Invalid rules never match
+		if rule.Matches(props) {
 			scores[rule.TargetID] += rule.Weight
-			totalWeight += rule.Weight
 
-			source := MatchSourceRule
-			if fingerprintRuleIDs[rule.ID] {
-				source = MatchSourceFingerprint
-				fingerprintWeight[rule.TargetID] += rule.Weight
-			} else {
-				ruleWeight[rule.TargetID] += rule.Weight
+			source := rule.Source
+			if rule.Weight > 0 {
+				if source == MatchSourceFingerprint {
+					fingerprintWeight[rule.TargetID] += rule.Weight
+				} else {
+					ruleWeight[rule.TargetID] += rule.Weight
+				}
 			}
 
 			votes = append(votes, Vote{
@@ -222,15 +396,16 @@ func classifyItem(rules []Rule, fingerprintRuleIDs map[string]bool, item Item, c
 		}
 	}
 
-	// No matching rules
-	if len(scores) == 0 {
-		return Result{
-			ItemID:      item.ID,
-			TargetID:    "",
-			Confidence:  0,
-			NeedsReview: false,
-			Votes:       votes,
-		}
+	mapping, hasMapping := itemCalendarMapping(item, rs.targetIDs)
+	if hasMapping {
+		scores[mapping.TargetID] += mapping.Weight
+		fingerprintWeight[mapping.TargetID] += mapping.Weight
+		votes = append(votes, Vote{
+			RuleID:   mapping.RuleID(),
+			TargetID: mapping.TargetID,
+			Weight:   mapping.Weight,
+			Source:   MatchSourceFingerprint,
+		})
 	}
 
 	// Find the winner
@@ -244,6 +419,17 @@ func classifyItem(rules []Rule, fingerprintRuleIDs map[string]bool, item Item, c
 		}
 	}
 
+	// No matching rules, or only negative votes
+	if winnerID == "" {
+		return Result{
+			ItemID:      item.ID,
+			TargetID:    "",
+			Confidence:  0,
+			NeedsReview: false,
+			Votes:       votes,
+		}
+	}
+
 	// Determine primary match source for the winner
 	matchSource := MatchSourceRule
 	if fingerprintWeight[winnerID] > ruleWeight[winnerID] {
@@ -251,13 +437,17 @@ func classifyItem(rules []Rule, fingerprintRuleIDs map[string]bool, item Item, c
 	}
 
 	// Calculate confidence
-	confidence := winnerScore / totalWeight
+	confidence := winnerScore / positiveTotal(scores)
 	if confidence > 1.0 {
 		confidence = 1.0
 	}
 
 	// Determine if review is needed based on thresholds
 	needsReview := confidence >= config.ConfidenceFloor && confidence < config.ConfidenceCeiling
+	if hasMapping && mapping.supportsAlone(winnerID, winnerScore) {
+		// Nothing but the calendar's default project points here
+		needsReview = true
+	}
 
 	// Don't classify if below floor
 	if confidence < config.ConfidenceFloor {
@@ -316,16 +506,30 @@ func itemToProperties(item Item) *EventProperties {
 		props.IsRecurring = v
 	}
 
+	if v, ok := item.Attributes["tags"].([]string); ok {
+		props.Tags = v
+	}
+
+	if v, ok := item.Attributes["is_internal"].(bool); ok {
+		props.IsInternal = &v
+	}
+
 	return props
 }
 
 // ClassifyAttendance evaluates attendance rules separately from project rules.
 // Returns whether the item was attended (true) or not (false).
 func ClassifyAttendance(rules []Rule, items []Item, config Config) []AttendanceResult {
+	return Compile(rules, nil).ClassifyAttendance(items, config)
+}
+
+// ClassifyAttendance is the package-level ClassifyAttendance over the
+// compiled rules
+func (rs *RuleSet) ClassifyAttendance(items []Item, config Config) []AttendanceResult {
 	results := make([]AttendanceResult, 0, len(items))
 
 	for _, item := range items {
-		result := classifyItemAttendance(rules, item, config)
+		result := rs.classifyItemAttendance(item, config)
 		results = append(results, result)
 	}
 
@@ -342,7 +546,7 @@ type AttendanceResult struct {
 }
 
 // classifyItemAttendance evaluates attendance rules for a single item
-func classifyItemAttendance(rules []Rule, item Item, config Config) AttendanceResult {
+func (rs *RuleSet) classifyItemAttendance(item Item, config Config) AttendanceResult {
 	props := itemToProperties(item)
 
 	// Collect votes: true = attended, false = did not attend
@@ -351,18 +555,13 @@ func classifyItemAttendance(rules []Rule, item Item, config Config) AttendanceRe
 	votes := make([]Vote, 0)
 	var totalWeight float64
 
-	for _, rule := range rules {
+	for _, rule := range rs.Rules {
 		// Only process DNA rules for attendance
 		if rule.TargetID != TargetDNA && !strings.HasPrefix(rule.TargetID, "attended:") {
 			continue
 		}
 
-		ast, err := Parse(rule.Query)
-		if err != nil {
-			continue
-		}
-
-		if Evaluate(ast, props) {
+		if rule.Matches(props) {
 			if rule.TargetID == TargetDNA {
 				didNotAttendScore += rule.Weight
 			} else {
@@ -459,6 +658,7 @@ type TargetScore struct {
 	TotalWeight      float64
 	RuleWeight       float64 // Weight from explicit rules
 	FingerprintWeight float64 // Weight from fingerprint matches
+	ExclusionWeight  float64 // Negative weight from exclusion fingerprints
 	IsWinner         bool
 }
 
@@ -468,7 +668,7 @@ type ExplainResult struct {
 	Evaluations      []RuleEvaluation // All project rules evaluated (matched and unmatched)
 	SkipEvaluations  []RuleEvaluation // All skip rules evaluated
 	TargetScores     []TargetScore    // Scores by target
-	TotalWeight      float64          // Sum of all matching rule weights
+	TotalWeight      float64          // Sum of the positive target scores
 	WinnerTargetID   string
 	WinnerConfidence float64
 	NeedsReview      bool
@@ -482,51 +682,30 @@ type ExplainResult struct {
 // detailed information about the classification decision. Unlike Classify, this
 // shows ALL rules including those that didn't match.
 func ExplainClassification(rules []Rule, targets []Target, item Item, config Config) *ExplainResult {
-	// Generate rules from target attributes
-	allRules, fingerprintRuleIDs := generateTargetRules(targets)
-	allRules = append(allRules, rules...)
+	return Compile(rules, targets).Explain(item, config)
+}
 
-	// Build target name map
-	targetNames := make(map[string]string)
-	for _, t := range targets {
-		if name, ok := t.Attributes["name"].(string); ok {
-			targetNames[t.ID] = name
-		}
-	}
+// Explain is ExplainClassification over the compiled rules, sharing them
+// with Classify
+func (rs *RuleSet) Explain(item Item, config Config) *ExplainResult {
+	targetNames := rs.targetNames
 
 	// Convert item attributes to EventProperties for evaluation
 	props := itemToProperties(item)
 
 	// Evaluate all rules
-	evaluations := make([]RuleEvaluation, 0, len(allRules))
+	evaluations := make([]RuleEvaluation, 0, len(rs.Rules))
 	scores := make(map[string]float64)
-	var totalWeight float64
 
 	// Track fingerprint vs rule weight per target
 	fingerprintWeight := make(map[string]float64)
 	ruleWeight := make(map[string]float64)
+	exclusionWeight := make(map[string]float64)
 
-	for _, rule := range allRules {
-		ast, err := Parse(rule.Query)
-		if err != nil {
-			// Include invalid rules as non-matching
-			evaluations = append(evaluations, RuleEvaluation{
-				RuleID:     rule.ID,
-				Query:      rule.Query,
-				TargetID:   rule.TargetID,
-				TargetName: targetNames[rule.TargetID],
-				Weight:     rule.Weight,
-				Source:     MatchSourceRule,
-				Matched:    false,
-			})
-			continue
-		}
-
-		matched := Evaluate(ast, props)
-		source := MatchSourceRule
-		if fingerprintRuleIDs[rule.ID] {
-			source = MatchSourceFingerprint
-		}
+	for _, rule := range rs.Rules {
+		// Invalid rules are included as non-matching
+		matched := rule.Matches(props)
+		source := rule.Source
 
 		evaluations = append(evaluations, RuleEvaluation{
 			RuleID:     rule.ID,
@@ -540,16 +719,34 @@ func ExplainClassification(rules []Rule, targets []Target, item Item, config Con
 
 		if matched {
 			scores[rule.TargetID] += rule.Weight
-			totalWeight += rule.Weight
 
-			if source == MatchSourceFingerprint {
+			switch {
+			case rule.Weight < 0:
+				exclusionWeight[rule.TargetID] += rule.Weight
+			case source == MatchSourceFingerprint:
 				fingerprintWeight[rule.TargetID] += rule.Weight
-			} else {
+			default:
 				ruleWeight[rule.TargetID] += rule.Weight
 			}
 		}
 	}
 
+	mapping, hasMapping := itemCalendarMapping(item, rs.targetIDs)
+	if hasMapping {
+		evaluations = append(evaluations, RuleEvaluation{
+			RuleID:     mapping.RuleID(),
+			Query:      "calendar default project",
+			TargetID:   mapping.TargetID,
+			TargetName: targetNames[mapping.TargetID],
+			Weight:     mapping.Weight,
+			Source:     MatchSourceFingerprint,
+			Matched:    true,
+		})
+		scores[mapping.TargetID] += mapping.Weight
+		fingerprintWeight[mapping.TargetID] += mapping.Weight
+	}
+	totalWeight := positiveTotal(scores)
+
 	// Build target scores
 	targetScores := make([]TargetScore, 0)
 	for targetID, score := range scores {
@@ -559,6 +756,7 @@ func ExplainClassification(rules []Rule, targets []Target, item Item, config Con
 			TotalWeight:      score,
 			RuleWeight:       ruleWeight[targetID],
 			FingerprintWeight: fingerprintWeight[targetID],
+			ExclusionWeight:  exclusionWeight[targetID],
 		})
 	}
 
@@ -599,9 +797,11 @@ func ExplainClassification(rules []Rule, targets []Target, item Item, config Con
 	needsReview := false
 	if len(scores) == 0 {
 		outcome = "No rules matched - event would remain unclassified"
+	} else if winnerID == "" {
+		outcome = "Only exclusions matched - event would remain unclassified"
 	} else if confidence < config.ConfidenceFloor {
 		outcome = fmt.Sprintf("Confidence %.0f%% below threshold %.0f%% - would not classify", confidence*100, config.ConfidenceFloor*100)
-	} else if confidence < config.ConfidenceCeiling {
+	} else if confidence < config.ConfidenceCeiling || (hasMapping && mapping.supportsAlone(winnerID, winnerScore)) {
 		needsReview = true
 		outcome = fmt.Sprintf("Classified to %s with %.0f%% confidence (needs review)", targetNames[winnerID], confidence*100)
 	} else {
@@ -465,6 +465,209 @@ func TestClassify_MultiWordKeywordFingerprint(t *testing.T) {
 	}
 }
 
+func TestClassify_ExclusionFingerprints(t *testing.T) {
+	// Internal meetings on a client's domain should not count for the client
+	targets := []Target{
+		{
+			ID: "client",
+			Attributes: map[string]any{
+				"domains":          []string{"acme.com"},
+				"exclude_keywords": []string{"internal"},
+			},
+		},
+		{
+			ID: "overhead",
+			Attributes: map[string]any{
+				"keywords": []string{"internal"},
+			},
+		},
+	}
+
+	items := []Item{
+		{ID: "client-call", Attributes: map[string]any{
+			"title":     "Roadmap review",
+			"attendees": []string{"pm@acme.com"},
+		}},
+		{ID: "internal-sync", Attributes: map[string]any{
+			"title":     "Internal prep",
+			"attendees": []string{"pm@acme.com"},
+		}},
+	}
+
+	results := Classify(nil, targets, items, DefaultConfig())
+
+	if results[0].TargetID != "client" || results[0].Confidence != 1.0 {
+		t.Errorf("client-call: expected client at 100%%, got %s at %v", results[0].TargetID, results[0].Confidence)
+	}
+	// The exclusion cancels the domain match and doesn't dilute the winner
+	if results[1].TargetID != "overhead" || results[1].Confidence != 1.0 {
+		t.Errorf("internal-sync: expected overhead at 100%%, got %s at %v", results[1].TargetID, results[1].Confidence)
+	}
+
+	explain := ExplainClassification(nil, targets, items[1], DefaultConfig())
+	for _, ts := range explain.TargetScores {
+		if ts.TargetID == "client" && (ts.ExclusionWeight != -1 || ts.TotalWeight != 0) {
+			t.Errorf("expected client exclusion weight -1 and total 0, got %+v", ts)
+		}
+	}
+
+	// With only the exclusion matching, nothing wins
+	alone := Classify(nil, targets[:1], items[1:], DefaultConfig())
+	if alone[0].TargetID != "" || alone[0].Confidence != 0 {
+		t.Errorf("expected no classification, got %s at %v", alone[0].TargetID, alone[0].Confidence)
+	}
+}
+
+func TestClassify_WeightedFingerprints(t *testing.T) {
+	// A specific contact outweighs a generic domain shared with another client
+	targets := []Target{
+		{
+			ID: "acme",
+			Attributes: map[string]any{
+				"emails":              []string{"ceo@partner.com"},
+				"fingerprint_weights": map[string]float64{"email:ceo@partner.com": 3},
+			},
+		},
+		{
+			ID: "partner",
+			Attributes: map[string]any{
+				"domains": []string{"partner.com"},
+			},
+		},
+	}
+	item := Item{ID: "board", Attributes: map[string]any{
+		"title":     "Board prep",
+		"attendees": []string{"ceo@partner.com"},
+	}}
+
+	results := Classify(nil, targets, []Item{item}, DefaultConfig())
+	if results[0].TargetID != "acme" || results[0].Confidence != 0.75 {
+		t.Errorf("expected acme at 75%%, got %s at %v", results[0].TargetID, results[0].Confidence)
+	}
+
+	explain := ExplainClassification(nil, targets, item, DefaultConfig())
+	for _, ts := range explain.TargetScores {
+		if ts.TargetID == "acme" && ts.FingerprintWeight != 3 {
+			t.Errorf("expected acme fingerprint weight 3, got %+v", ts)
+		}
+	}
+
+	// Weights decoded from JSON arrive as map[string]any
+	targets[0].Attributes["fingerprint_weights"] = map[string]any{"email:ceo@partner.com": 0.5}
+	results = Classify(nil, targets, []Item{item}, DefaultConfig())
+	if results[0].TargetID != "partner" {
+		t.Errorf("expected partner to win over a down-weighted email, got %s", results[0].TargetID)
+	}
+}
+
+func TestClassify_CalendarDefaultProject(t *testing.T) {
+	targets := []Target{
+		{ID: "acme", Attributes: map[string]any{"domains": []string{"acme.com"}}},
+		{ID: "internal"},
+	}
+
+	// Alone, the hint suggests its project for review
+	item := Item{ID: "standup", Attributes: map[string]any{
+		"title":                    "Standup",
+		"calendar_default_project": "internal",
+	}}
+	results := Classify(nil, targets, []Item{item}, DefaultConfig())
+	if results[0].TargetID != "internal" || !results[0].NeedsReview || results[0].MatchSource != MatchSourceFingerprint {
+		t.Errorf("expected internal for review from the hint, got %+v", results[0])
+	}
+
+	// Any fingerprint outweighs it
+	item.Attributes["attendees"] = []string{"pm@acme.com"}
+	results = Classify(nil, targets, []Item{item}, DefaultConfig())
+	if results[0].TargetID != "acme" {
+		t.Errorf("expected the acme fingerprint to win over the hint, got %s", results[0].TargetID)
+	}
+
+	explain := ExplainClassification(nil, targets, item, DefaultConfig())
+	found := false
+	for _, ev := range explain.Evaluations {
+		if ev.TargetID == "internal" && ev.Matched && ev.Weight == CalendarHintWeight {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected the hint among the explained evaluations")
+	}
+
+	// A weighted mapping classifies a dedicated calendar on its own and can
+	// outvote a fingerprint
+	item.Attributes["calendar_default_weight"] = 2.0
+	results = Classify(nil, targets, []Item{item}, DefaultConfig())
+	if results[0].TargetID != "internal" {
+		t.Errorf("expected the weighted mapping to win, got %s", results[0].TargetID)
+	}
+	delete(item.Attributes, "attendees")
+	results = Classify(nil, targets, []Item{item}, DefaultConfig())
+	if results[0].TargetID != "internal" || results[0].NeedsReview || results[0].Confidence != 1 {
+		t.Errorf("expected internal without review, got %+v", results[0])
+	}
+
+	// A hint for a project that isn't a target is ignored
+	item = Item{ID: "other", Attributes: map[string]any{"calendar_default_project": "archived"}}
+	results = Classify(nil, targets, []Item{item}, DefaultConfig())
+	if results[0].TargetID != "" {
+		t.Errorf("expected no classification, got %s", results[0].TargetID)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	rules := []Rule{
+		{ID: "standup", Query: "title:standup", TargetID: "internal", Weight: 1.0},
+		{ID: "broken", Query: "title:(standup", TargetID: "internal", Weight: 1.0},
+		{ID: "declined", Query: "response:declined", TargetID: TargetDNA, Weight: 0.5},
+	}
+	targets := []Target{
+		{ID: "acme", Attributes: map[string]any{"name": "Acme", "domains": []string{"acme.com"}}},
+		{ID: "internal", Attributes: map[string]any{"name": "Internal"}},
+	}
+	set := Compile(rules, targets)
+
+	if len(set.Rules) != 4 {
+		t.Fatalf("expected the fingerprint and 3 explicit rules, got %d", len(set.Rules))
+	}
+	if fp := set.Rules[0]; fp.ID != "fp:domain:acme:acme.com" || fp.Source != MatchSourceFingerprint || fp.AST == nil {
+		t.Errorf("expected the compiled domain fingerprint first, got %+v", fp)
+	}
+	broken := set.Rules[2]
+	if broken.Err == nil || broken.AST != nil || broken.Matches(&EventProperties{Title: "standup"}) {
+		t.Errorf("expected the invalid rule to keep its error and never match, got %+v", broken)
+	}
+
+	// The same compiled rules serve every item and every entry point
+	items := []Item{
+		{ID: "e1", Attributes: map[string]any{"title": "Planning", "attendees": []string{"pm@acme.com"}}},
+		{ID: "e2", Attributes: map[string]any{"title": "Standup", "response_status": "declined"}},
+	}
+	results := set.Classify(items, DefaultConfig())
+	direct := Classify(rules, targets, items, DefaultConfig())
+	for i := range items {
+		if results[i].TargetID != direct[i].TargetID || results[i].Confidence != direct[i].Confidence {
+			t.Errorf("item %s: compiled %+v differs from Classify %+v", items[i].ID, results[i], direct[i])
+		}
+	}
+	if results[1].TargetID != "internal" {
+		t.Errorf("expected e2 classified to internal, got %+v", results[1])
+	}
+
+	attendance := set.ClassifyAttendance(items, DefaultConfig())
+	if !attendance[0].Attended || attendance[1].Attended {
+		t.Errorf("expected only e2 not attended, got %+v", attendance)
+	}
+
+	explain := set.Explain(items[0], DefaultConfig())
+	if len(explain.Evaluations) != 4 || explain.Evaluations[2].Matched {
+		t.Errorf("expected every rule explained with the invalid one unmatched, got %+v", explain.Evaluations)
+	}
+	if explain.Evaluations[0].TargetName != "Acme" {
+		t.Errorf("expected target names from the compiled set, got %q", explain.Evaluations[0].TargetName)
+	}
+}
+
 func TestQuoteIfNeeded(t *testing.T) {
 	tests := []struct {
 		input    string
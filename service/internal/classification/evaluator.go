@@ -17,7 +17,9 @@ type EventProperties struct {
 	ResponseStatus string // accepted, declined, needsAction, tentative
 	Transparency   string // opaque, transparent
 	IsRecurring    bool
-	CalendarName   string // Name of the source calendar
+	CalendarName   string   // Name of the source calendar
+	Tags           []string // The user's tags on the event
+	IsInternal     *bool    // No attendee outside the company domains; nil when none are declared
 }
 
 // Evaluate evaluates a query against event properties
@@ -52,6 +54,54 @@ func Evaluate(node QueryNode, props *EventProperties) bool {
 	}
 }
 
+// ConditionTrace records how one condition of a query evaluated
+type ConditionTrace struct {
+	Condition string // The condition in query syntax, e.g. -title:standup
+	Matched   bool   // Result after any negation
+}
+
+// Trace evaluates a query like Evaluate and also returns the result of each
+// condition in query order. Every condition is evaluated, including ones
+// Evaluate would short-circuit past.
+func Trace(node QueryNode, props *EventProperties) (bool, []ConditionTrace) {
+	var trace []ConditionTrace
+	matched := traceNode(node, props, &trace)
+	return matched, trace
+}
+
+func traceNode(node QueryNode, props *EventProperties, trace *[]ConditionTrace) bool {
+	switch n := node.(type) {
+	case *ConditionNode:
+		result := evaluateCondition(n, props)
+		if n.Negated {
+			result = !result
+		}
+		*trace = append(*trace, ConditionTrace{Condition: n.String(), Matched: result})
+		return result
+
+	case *AndNode:
+		all := true
+		for _, child := range n.Children {
+			if !traceNode(child, props, trace) {
+				all = false
+			}
+		}
+		return all
+
+	case *OrNode:
+		matched := false
+		for _, child := range n.Children {
+			if traceNode(child, props, trace) {
+				matched = true
+			}
+		}
+		return matched
+
+	default:
+		return false
+	}
+}
+
 func evaluateCondition(cond *ConditionNode, props *EventProperties) bool {
 	switch cond.Property {
 	case "title":
@@ -125,10 +175,27 @@ func evaluateCondition(cond *ConditionNode, props *EventProperties) bool {
 		isAllDay := isAllDayEvent(props.StartTime, props.EndTime)
 		return isAllDay == wantAllDay
 
+	case "internal":
+		// internal:yes or internal:no; unknown until company domains are set
+		if props.IsInternal == nil {
+			return false
+		}
+		wantInternal := strings.EqualFold(cond.Value, "yes") || strings.EqualFold(cond.Value, "true")
+		return *props.IsInternal == wantInternal
+
 	case "calendar":
 		// Match against calendar name (word boundary)
 		return containsWordIgnoreCase(props.CalendarName, cond.Value)
 
+	case "tag":
+		// Exact tag name, ignoring case
+		for _, tag := range props.Tags {
+			if strings.EqualFold(tag, cond.Value) {
+				return true
+			}
+		}
+		return false
+
 	case "text":
 		// Text search across title, description, and calendar name
 		// Uses word boundary matching to prevent false positives (e.g., "AC" matching "APCSA")
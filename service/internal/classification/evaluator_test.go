@@ -244,3 +244,191 @@ func TestEvaluate_TitleWordBoundary(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluate_Tag(t *testing.T) {
+	props := &EventProperties{
+		Title: "Flight to Denver",
+		Tags:  []string{"travel", "Billable-Dispute"},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected bool
+	}{
+		{
+			name:     "tag matches exactly",
+			query:    "tag:travel",
+			expected: true,
+		},
+		{
+			name:     "tag matches ignoring case",
+			query:    "tag:billable-dispute",
+			expected: true,
+		},
+		{
+			name:     "tag does not match a prefix",
+			query:    "tag:trav",
+			expected: false,
+		},
+		{
+			name:     "negated tag",
+			query:    "-tag:deep-work",
+			expected: true,
+		},
+		{
+			name:     "tag combined with title",
+			query:    "tag:travel title:flight",
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ast, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.query, err)
+			}
+			result := Evaluate(ast, props)
+			if result != tt.expected {
+				t.Errorf("Evaluate(%q) = %v, want %v", tt.query, result, tt.expected)
+			}
+		})
+	}
+
+	if Evaluate(&ConditionNode{Property: "tag", Value: "travel"}, &EventProperties{}) {
+		t.Error("tag:travel matched an untagged event")
+	}
+}
+
+func TestEvaluate_Internal(t *testing.T) {
+	internal, external := true, false
+	tests := []struct {
+		query      string
+		isInternal *bool
+		expected   bool
+	}{
+		{"internal:yes", &internal, true},
+		{"internal:yes", &external, false},
+		{"internal:no", &external, true},
+		{"internal:no", &internal, false},
+		// Without company domains neither value matches
+		{"internal:yes", nil, false},
+		{"internal:no", nil, false},
+	}
+
+	for _, tt := range tests {
+		ast, err := Parse(tt.query)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", tt.query, err)
+		}
+		if result := Evaluate(ast, &EventProperties{IsInternal: tt.isInternal}); result != tt.expected {
+			t.Errorf("Evaluate(%q) with IsInternal=%v = %v, want %v", tt.query, tt.isInternal, result, tt.expected)
+		}
+	}
+}
+
+func TestTrace(t *testing.T) {
+	props := &EventProperties{
+		Title:     "Acme weekly sync",
+		Attendees: []string{"alice@acme.com"},
+	}
+
+	tests := []struct {
+		query   string
+		matched bool
+		trace   []ConditionTrace
+	}{
+		{
+			query:   "title:sync domain:acme.com",
+			matched: true,
+			trace:   []ConditionTrace{{"title:sync", true}, {"domain:acme.com", true}},
+		},
+		{
+			// Every condition is traced, even after the AND has failed
+			query:   "title:standup domain:acme.com",
+			matched: false,
+			trace:   []ConditionTrace{{"title:standup", false}, {"domain:acme.com", true}},
+		},
+		{
+			query:   "(title:standup OR -title:planning)",
+			matched: true,
+			trace:   []ConditionTrace{{"title:standup", false}, {"-title:planning", true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			ast, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.query, err)
+			}
+			matched, trace := Trace(ast, props)
+			if matched != tt.matched {
+				t.Errorf("matched = %v, want %v", matched, tt.matched)
+			}
+			if matched != Evaluate(ast, props) {
+				t.Errorf("Trace and Evaluate disagree")
+			}
+			if len(trace) != len(tt.trace) {
+				t.Fatalf("trace = %v, want %v", trace, tt.trace)
+			}
+			for i := range trace {
+				if trace[i] != tt.trace[i] {
+					t.Errorf("trace[%d] = %v, want %v", i, trace[i], tt.trace[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAttendeeFilterFor(t *testing.T) {
+	tests := []struct {
+		query   string
+		domains []string
+		emails  []string
+		none    bool
+	}{
+		{query: "domain:acme.com", domains: []string{"acme.com"}},
+		{query: "title:sync email:bob@acme.com", emails: []string{"bob@acme.com"}},
+		{query: "domain:acme.com OR email:bob@other.com", domains: []string{"acme.com"}, emails: []string{"bob@other.com"}},
+		{query: "domain:acme.com OR title:sync", none: true},
+		{query: "-domain:acme.com", none: true},
+		{query: "title:standup", none: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			ast, err := Parse(tt.query)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", tt.query, err)
+			}
+			filter := attendeeFilterFor(ast)
+			if tt.none {
+				if filter != nil {
+					t.Errorf("expected no filter, got %+v", filter)
+				}
+				return
+			}
+			if filter == nil {
+				t.Fatal("expected a filter")
+			}
+			if !equalStrings(filter.Domains, tt.domains) || !equalStrings(filter.Emails, tt.emails) {
+				t.Errorf("got domains=%v emails=%v, want domains=%v emails=%v",
+					filter.Domains, filter.Emails, tt.domains, tt.emails)
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
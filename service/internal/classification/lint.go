@@ -0,0 +1,455 @@
+package classification
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// LintKind names a problem the linter found in a query
+type LintKind string
+
+const (
+	LintSyntaxError     LintKind = "syntax_error"     // The query doesn't parse
+	LintUnknownProperty LintKind = "unknown_property" // A property the evaluator doesn't know
+	LintAlwaysFalse     LintKind = "always_false"     // A term no event can match
+	LintAlwaysTrue      LintKind = "always_true"      // A term every event matches
+	LintRedundant       LintKind = "redundant"        // A clause that changes nothing
+	LintDuplicate       LintKind = "duplicate"        // Same query as a rule with the same target
+	LintConflict        LintKind = "conflict"         // Overlaps a rule with another target
+)
+
+// LintSeverity says whether an issue breaks the rule or is likely a mistake
+type LintSeverity string
+
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+)
+
+// LintIssue is one problem found in a query. Position and Length locate it
+// in the query; Position is -1 for issues about the whole query.
+type LintIssue struct {
+	Kind       LintKind
+	Severity   LintSeverity
+	Message    string
+	Position   int
+	Length     int
+	Suggestion string // Replacement for the located text, when there is one
+	RuleID     string // Existing rule concerned, for duplicates and conflicts
+	RuleQuery  string
+}
+
+// Lint checks a rule's query for problems beyond syntax: unknown
+// properties, terms that can never or always match, redundant clauses, and
+// overlap with existing rules. Overlap is only found between queries that
+// are a single condition or an AND of conditions: one whose conditions are
+// a subset of the other's matches every event the other does. An existing
+// rule with the rule's ID is the one being edited and is ignored. Project
+// rules and attendance rules are classified in separate passes, so they
+// never conflict.
+func Lint(rule Rule, existing []Rule) []LintIssue {
+	ast, err := Parse(rule.Query)
+	if err != nil {
+		return []LintIssue{parseErrorIssue(err)}
+	}
+
+	l := &linter{}
+	l.node(ast)
+	if issue, ok := alwaysTrueRoot(ast); ok {
+		l.issues = append(l.issues, issue)
+	}
+	l.conflicts(rule, ast, existing)
+	return l.issues
+}
+
+func parseErrorIssue(err error) LintIssue {
+	issue := LintIssue{Kind: LintSyntaxError, Severity: LintError, Message: err.Error()}
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		issue.Message = parseErr.Message
+		issue.Position = parseErr.Position
+		issue.Length = len(parseErr.Token)
+		issue.Suggestion = parseErr.Suggestion
+		if strings.HasPrefix(parseErr.Message, unknownPropertyMessage) {
+			issue.Kind = LintUnknownProperty
+		}
+	}
+	return issue
+}
+
+type linter struct {
+	issues []LintIssue
+}
+
+func (l *linter) add(kind LintKind, severity LintSeverity, pos, end int, message string) *LintIssue {
+	l.issues = append(l.issues, LintIssue{
+		Kind:     kind,
+		Severity: severity,
+		Message:  message,
+		Position: pos,
+		Length:   end - pos,
+	})
+	return &l.issues[len(l.issues)-1]
+}
+
+// node checks each condition and each AND or OR group under n
+func (l *linter) node(n QueryNode) {
+	switch n := n.(type) {
+	case *ConditionNode:
+		l.condition(n)
+	case *AndNode:
+		for _, child := range n.Children {
+			l.node(child)
+		}
+		l.group(n.Children, true)
+	case *OrNode:
+		for _, child := range n.Children {
+			l.node(child)
+		}
+		l.group(n.Children, false)
+	}
+}
+
+// condition reports a condition that can never match, or, negated, always
+// matches
+func (l *linter) condition(c *ConditionNode) {
+	reason, suggestion := neverMatches(c)
+	if reason == "" {
+		return
+	}
+	kind, message := LintAlwaysFalse, fmt.Sprintf("%s never matches: %s", c, reason)
+	if c.Negated {
+		kind, message = LintAlwaysTrue, fmt.Sprintf("%s matches every event: %s", c, reason)
+	}
+	issue := l.add(kind, LintError, c.Pos, c.End, message)
+	if suggestion != "" {
+		if c.Negated {
+			suggestion = "-" + suggestion
+		}
+		issue.Suggestion = suggestion
+	}
+}
+
+var (
+	responseValues     = []string{"accepted", "declined", "needsAction", "tentative"}
+	transparencyValues = []string{"opaque", "transparent"}
+	dayValues          = []string{
+		"mon", "tue", "wed", "thu", "fri", "sat", "sun",
+		"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday",
+	}
+	timeOfDayPattern = regexp.MustCompile(`^(<|>|<=|>=)?\d{1,2}:\d{2}$`)
+)
+
+// neverMatches returns why no event can match the condition, ignoring its
+// negation, and a condition that probably says what was meant. The reason
+// is empty when the condition can match.
+func neverMatches(c *ConditionNode) (reason, suggestion string) {
+	value := c.Value
+	switch c.Property {
+	case "project", "client", "confidence", "status":
+		return c.Property + ": only applies when searching events, never to rules", ""
+
+	case "response":
+		return oneOf(c, responseValues)
+
+	case "transparency":
+		return oneOf(c, transparencyValues)
+
+	case "day-of-week":
+		return oneOf(c, dayValues)
+
+	case "time-of-day":
+		if !timeOfDayPattern.MatchString(value) {
+			return `the time must be HH:MM, optionally after <, >, <= or >=, and quoted since it contains a colon`, ""
+		}
+
+	case "domain":
+		if _, domain, ok := strings.Cut(value, "@"); ok {
+			return "domains are matched without the @", "domain:" + domain
+		}
+
+	case "email":
+		if !strings.Contains(value, "@") {
+			return "emails are matched as whole addresses", "domain:" + value
+		}
+
+	case "title", "description", "calendar", "text":
+		if strings.Contains(value, " ") {
+			return "", ""
+		}
+		words := tokenize(value)
+		if len(words) == 0 {
+			return "the value has no letters or digits to match", ""
+		}
+		if len(words) > 1 {
+			parts := make([]string, len(words))
+			for i, w := range words {
+				parts[i] = c.Property + ":" + w
+			}
+			return "single words match whole words, and this one is split at its punctuation",
+				strings.Join(parts, " ")
+		}
+
+	case "tag":
+		if value == "" {
+			return "tags are never empty", ""
+		}
+	}
+	return "", ""
+}
+
+// oneOf checks the condition's value against the values its property takes,
+// suggesting the nearest one
+func oneOf(c *ConditionNode, values []string) (reason, suggestion string) {
+	best, bestDistance := "", 3
+	for _, v := range values {
+		if strings.EqualFold(v, c.Value) {
+			return "", ""
+		}
+		if d := editDistance(strings.ToLower(c.Value), strings.ToLower(v)); d < bestDistance {
+			best, bestDistance = v, d
+		}
+	}
+	reason = fmt.Sprintf("%s is one of %s", c.Property, strings.Join(values, ", "))
+	if best != "" {
+		suggestion = c.Property + ":" + best
+	}
+	return reason, suggestion
+}
+
+// singleValued lists properties an event has exactly one value of, so two
+// different values can't both match
+var singleValued = map[string]bool{
+	"response": true, "transparency": true, "recurring": true,
+	"has-attendees": true, "is-all-day": true, "internal": true, "day-of-week": true,
+}
+
+// conditionKey identifies what a condition matches, ignoring negation
+func conditionKey(c *ConditionNode) string {
+	value := strings.ToLower(c.Value)
+	switch c.Property {
+	case "recurring", "has-attendees", "is-all-day", "internal":
+		if value == "yes" || value == "true" {
+			value = "yes"
+		} else {
+			value = "no"
+		}
+	case "day-of-week":
+		if len(value) > 3 {
+			value = value[:3]
+		}
+	}
+	return c.Property + ":" + value
+}
+
+// group checks the children of an AND (all) or an OR for duplicates,
+// contradictions and clauses absorbed by a sibling condition
+func (l *linter) group(children []QueryNode, all bool) {
+	seen := make(map[string]*ConditionNode)
+	positive := make(map[string]*ConditionNode) // single-valued property -> condition
+	for _, child := range children {
+		c, ok := child.(*ConditionNode)
+		if !ok {
+			continue
+		}
+		key := conditionKey(c)
+		if prev, ok := seen[key]; ok {
+			if prev.Negated == c.Negated {
+				l.add(LintRedundant, LintWarning, c.Pos, c.End, fmt.Sprintf("%s repeats an earlier condition", c))
+			} else if all {
+				l.add(LintAlwaysFalse, LintError, c.Pos, c.End, fmt.Sprintf("%s contradicts %s, so nothing matches", c, prev))
+			} else {
+				l.add(LintAlwaysTrue, LintError, c.Pos, c.End, fmt.Sprintf("%s OR %s matches every event", prev, c))
+			}
+			continue
+		}
+		seen[key] = c
+
+		if all && !c.Negated && singleValued[c.Property] {
+			if prev, ok := positive[c.Property]; ok {
+				l.add(LintAlwaysFalse, LintError, c.Pos, c.End,
+					fmt.Sprintf("an event can't match both %s and %s", prev, c))
+			}
+			positive[c.Property] = c
+		}
+	}
+
+	// a AND (a OR b) is a; a OR (a b) is a
+	for _, child := range children {
+		var nested []QueryNode
+		switch n := child.(type) {
+		case *OrNode:
+			if all {
+				nested = n.Children
+			}
+		case *AndNode:
+			if !all {
+				nested = n.Children
+			}
+		}
+		for _, grandchild := range nested {
+			c, ok := grandchild.(*ConditionNode)
+			if !ok {
+				continue
+			}
+			if sibling, ok := seen[conditionKey(c)]; ok && sibling.Negated == c.Negated {
+				pos, end := span(child)
+				l.add(LintRedundant, LintWarning, pos, end,
+					fmt.Sprintf("this clause is redundant next to %s", sibling))
+				break
+			}
+		}
+	}
+}
+
+// span returns where a node's conditions start and end in the query
+func span(n QueryNode) (pos, end int) {
+	pos = -1
+	var walk func(QueryNode)
+	walk = func(n QueryNode) {
+		switch n := n.(type) {
+		case *ConditionNode:
+			if pos < 0 || n.Pos < pos {
+				pos = n.Pos
+			}
+			end = max(end, n.End)
+		case *AndNode:
+			for _, child := range n.Children {
+				walk(child)
+			}
+		case *OrNode:
+			for _, child := range n.Children {
+				walk(child)
+			}
+		}
+	}
+	walk(n)
+	return max(pos, 0), end
+}
+
+// alwaysTrueRoot flags a query with no positive condition. It only
+// excludes events, so as a rule it votes for nearly every event.
+func alwaysTrueRoot(ast QueryNode) (LintIssue, bool) {
+	conditions, ok := conjunction(ast)
+	if !ok {
+		return LintIssue{}, false
+	}
+	for _, c := range conditions {
+		if !c.Negated {
+			return LintIssue{}, false
+		}
+	}
+	return LintIssue{
+		Kind:     LintAlwaysTrue,
+		Severity: LintWarning,
+		Message:  "the query only excludes events, so it matches nearly every event",
+		Position: -1,
+	}, true
+}
+
+// conjunction returns the conditions of a query that is a single condition
+// or an AND of conditions
+func conjunction(n QueryNode) ([]*ConditionNode, bool) {
+	switch n := n.(type) {
+	case *ConditionNode:
+		return []*ConditionNode{n}, true
+	case *AndNode:
+		conditions := make([]*ConditionNode, 0, len(n.Children))
+		for _, child := range n.Children {
+			c, ok := child.(*ConditionNode)
+			if !ok {
+				return nil, false
+			}
+			conditions = append(conditions, c)
+		}
+		return conditions, true
+	}
+	return nil, false
+}
+
+// conjunctionKeys returns a conjunction's distinct conditions, negation
+// included, sorted
+func conjunctionKeys(conditions []*ConditionNode) []string {
+	keys := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		key := conditionKey(c)
+		if c.Negated {
+			key = "-" + key
+		}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isAttendanceTarget reports whether a target ID is an attendance rule's
+func isAttendanceTarget(targetID string) bool {
+	return targetID == TargetDNA || strings.HasPrefix(targetID, "attended:")
+}
+
+// conflicts compares the query with each existing rule's
+func (l *linter) conflicts(rule Rule, ast QueryNode, existing []Rule) {
+	conditions, ok := conjunction(ast)
+	if !ok {
+		return
+	}
+	keys := conjunctionKeys(conditions)
+
+	for _, other := range existing {
+		if rule.ID != "" && other.ID == rule.ID {
+			continue
+		}
+		if rule.TargetID != "" && isAttendanceTarget(rule.TargetID) != isAttendanceTarget(other.TargetID) {
+			continue
+		}
+		otherAST, err := Parse(other.Query)
+		if err != nil {
+			continue
+		}
+		otherConditions, ok := conjunction(otherAST)
+		if !ok {
+			continue
+		}
+		otherKeys := conjunctionKeys(otherConditions)
+
+		sameTarget := rule.TargetID == other.TargetID
+		var issue LintIssue
+		switch {
+		case slices.Equal(keys, otherKeys):
+			if sameTarget || rule.TargetID == "" {
+				issue = LintIssue{Kind: LintDuplicate, Message: "an existing rule has the same query"}
+			} else {
+				issue = LintIssue{Kind: LintConflict, Message: "an existing rule with the same query targets something else, so they compete on every event"}
+			}
+		case sameTarget || rule.TargetID == "":
+			continue
+		case isSubset(otherKeys, keys):
+			issue = LintIssue{Kind: LintConflict, Message: "every event this query matches is also matched by an existing rule with another target"}
+		case isSubset(keys, otherKeys):
+			issue = LintIssue{Kind: LintConflict, Message: "this query matches every event of an existing rule with another target"}
+		default:
+			continue
+		}
+		issue.Severity = LintWarning
+		issue.Position = -1
+		issue.RuleID = other.ID
+		issue.RuleQuery = other.Query
+		l.issues = append(l.issues, issue)
+	}
+}
+
+// isSubset reports whether every key of a is in b; both are sorted
+func isSubset(a, b []string) bool {
+	for _, key := range a {
+		if _, found := slices.BinarySearch(b, key); !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package classification
+
+import (
+	"testing"
+)
+
+func lintKinds(issues []LintIssue) []LintKind {
+	kinds := make([]LintKind, len(issues))
+	for i, issue := range issues {
+		kinds[i] = issue.Kind
+	}
+	return kinds
+}
+
+func TestLint_Query(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		kind       LintKind // Empty for a clean query
+		located    string   // Text the issue points at
+		suggestion string
+	}{
+		{"clean", "title:standup domain:acme.com", "", "", ""},
+		{"syntax", "title:(standup", LintSyntaxError, "(", ""},
+		{"unknown property", "titel:standup", LintUnknownProperty, "titel", "title"},
+		{"invalid response", "response:decline", LintAlwaysFalse, "response:decline", "response:declined"},
+		{"negated invalid value", "title:x -transparency:busy", LintAlwaysTrue, "-transparency:busy", ""},
+		{"search-only property", "project:acme", LintAlwaysFalse, "project:acme", ""},
+		{"domain with @", "domain:@acme.com", LintAlwaysFalse, "domain:@acme.com", "domain:acme.com"},
+		{"split word", "title:q3-planning", LintAlwaysFalse, "title:q3-planning", "title:q3 title:planning"},
+		{"unquoted time", "time-of-day:>17", LintAlwaysFalse, "time-of-day:>17", ""},
+		{"quoted time", `time-of-day:">=17:00"`, "", "", ""},
+		{"contradiction", "title:sync -title:Sync", LintAlwaysFalse, "-title:Sync", ""},
+		{"two responses", "response:accepted response:declined", LintAlwaysFalse, "response:declined", ""},
+		{"tautology", "title:sync OR -title:sync", LintAlwaysTrue, "-title:sync", ""},
+		{"duplicate condition", "title:sync domain:acme.com title:SYNC", LintRedundant, "title:SYNC", ""},
+		{"absorbed OR", "title:sync (title:sync OR title:standup)", LintRedundant, "title:sync OR title:standup", ""},
+		{"absorbed AND", "title:sync OR (title:sync domain:acme.com)", LintRedundant, "title:sync domain:acme.com", ""},
+		{"only exclusions", "-title:lunch", LintAlwaysTrue, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := Lint(Rule{Query: tt.query}, nil)
+			if tt.kind == "" {
+				if len(issues) != 0 {
+					t.Fatalf("expected no issues, got %+v", issues)
+				}
+				return
+			}
+			if len(issues) != 1 || issues[0].Kind != tt.kind {
+				t.Fatalf("expected one %s issue, got %+v", tt.kind, issues)
+			}
+			issue := issues[0]
+			if tt.located == "" && issue.Position != -1 {
+				t.Errorf("expected an issue about the whole query, got position %d", issue.Position)
+			}
+			if tt.located != "" {
+				if got := tt.query[issue.Position : issue.Position+issue.Length]; got != tt.located {
+					t.Errorf("expected the issue at %q, got %q", tt.located, got)
+				}
+			}
+			if issue.Suggestion != tt.suggestion {
+				t.Errorf("expected suggestion %q, got %q", tt.suggestion, issue.Suggestion)
+			}
+		})
+	}
+}
+
+func TestLint_ExistingRules(t *testing.T) {
+	existing := []Rule{
+		{ID: "r1", Query: "domain:acme.com", TargetID: "acme"},
+		{ID: "r2", Query: "title:standup domain:acme.com", TargetID: "internal"},
+		{ID: "r3", Query: "Domain:ACME.com", TargetID: "globex"},
+		{ID: "r4", Query: "domain:acme.com", TargetID: TargetDNA},
+		{ID: "r5", Query: "title:a OR title:b", TargetID: "globex"},
+	}
+
+	issues := Lint(Rule{Query: "domain:acme.com", TargetID: "acme"}, existing)
+	byRule := make(map[string]LintKind)
+	for _, issue := range issues {
+		byRule[issue.RuleID] = issue.Kind
+	}
+	if byRule["r1"] != LintDuplicate || byRule["r2"] != LintConflict || byRule["r3"] != LintConflict || len(issues) != 3 {
+		t.Errorf("expected a duplicate of r1 and conflicts with r2 and r3 only, got %v", lintKinds(issues))
+	}
+
+	// The rule being edited isn't compared with itself
+	issues = Lint(Rule{ID: "r2", Query: "title:standup domain:acme.com", TargetID: "internal"}, existing)
+	for _, issue := range issues {
+		if issue.RuleID == "r2" {
+			t.Errorf("expected the edited rule to be ignored, got %+v", issue)
+		}
+	}
+	if len(issues) != 2 {
+		t.Errorf("expected the narrower query to conflict with r1 and r3, got %v", lintKinds(issues))
+	}
+
+	// Attendance rules are compared with attendance rules only
+	issues = Lint(Rule{Query: "domain:acme.com", TargetID: "attended:true"}, existing)
+	if len(issues) != 1 || issues[0].RuleID != "r4" || issues[0].Kind != LintConflict {
+		t.Errorf("expected a conflict with the skip rule only, got %+v", issues)
+	}
+}
@@ -2,6 +2,7 @@ package classification
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"unicode"
 )
@@ -16,6 +17,7 @@ type ConditionNode struct {
 	Property string
 	Value    string
 	Negated  bool // For future -property:value support
+	Pos, End int  // Byte offsets of the condition in the query, set by Parse
 }
 
 func (ConditionNode) isQueryNode() {}
@@ -34,14 +36,50 @@ type OrNode struct {
 
 func (OrNode) isQueryNode() {}
 
-// ParseError represents a parsing error
+// Properties lists the query properties the evaluators understand. The last
+// four only apply to searches over classified events (EvaluateExtended).
+var Properties = []string{
+	"title", "description", "attendees", "domain", "email", "response",
+	"recurring", "transparency", "day-of-week", "time-of-day", "has-attendees",
+	"is-all-day", "internal", "calendar", "tag", "text",
+	"project", "client", "confidence", "status",
+}
+
+// unknownPropertyMessage starts the parse error for an unknown property
+const unknownPropertyMessage = "unknown property: "
+
+// ParseError represents a parsing error. Position is the byte offset of the
+// offending Token in the query as given, so a UI can underline it; Token is
+// empty when the query ended too early.
 type ParseError struct {
-	Message  string
-	Position int
+	Message    string
+	Position   int
+	Token      string
+	Suggestion string // Nearest valid property, for an unknown one
 }
 
 func (e *ParseError) Error() string {
-	return fmt.Sprintf("parse error at position %d: %s", e.Position, e.Message)
+	msg := fmt.Sprintf("parse error at position %d: %s", e.Position, e.Message)
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(" (did you mean %s?)", e.Suggestion)
+	}
+	return msg
+}
+
+// Details returns the error's fields for a machine-readable error body
+func (e *ParseError) Details() map[string]any {
+	details := map[string]any{
+		"message":  e.Message,
+		"position": e.Position,
+		"length":   len(e.Token),
+	}
+	if e.Token != "" {
+		details["token"] = e.Token
+	}
+	if e.Suggestion != "" {
+		details["suggestion"] = e.Suggestion
+	}
+	return details
 }
 
 // Parser parses Gmail-style query strings
@@ -72,9 +110,11 @@ type token struct {
 	pos   int
 }
 
-// Parse parses a query string into an AST
+// Parse parses a query string into an AST. Errors are *ParseError.
 func Parse(query string) (QueryNode, error) {
-	p := &Parser{input: strings.TrimSpace(query)}
+	// Leading space is skipped by the tokenizer, keeping positions those of
+	// the query as given
+	p := &Parser{input: strings.TrimRightFunc(query, unicode.IsSpace)}
 	if err := p.tokenize(); err != nil {
 		return nil, err
 	}
@@ -116,7 +156,7 @@ func (p *Parser) tokenize() error {
 				p.pos++
 			}
 			if p.pos >= len(p.input) {
-				return &ParseError{Message: "unclosed quote", Position: start}
+				return &ParseError{Message: "unclosed quote", Position: start, Token: p.input[start:]}
 			}
 			p.tokens = append(p.tokens, token{tokenValue, p.input[valueStart:p.pos], start})
 			p.pos++ // skip closing quote
@@ -146,7 +186,15 @@ func (p *Parser) tokenize() error {
 
 func (p *Parser) parse() (QueryNode, error) {
 	p.current = 0
-	return p.parseOr()
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	// Only a stray ) stops parseOr short of the end
+	if tok := p.peek(); tok.typ != tokenEOF {
+		return nil, p.errorAt(tok, fmt.Sprintf("unexpected token: %s", tok.value))
+	}
+	return node, nil
 }
 
 func (p *Parser) parseOr() (QueryNode, error) {
@@ -193,7 +241,7 @@ func (p *Parser) parseAnd() (QueryNode, error) {
 	}
 
 	if len(children) == 0 {
-		return nil, &ParseError{Message: "empty expression", Position: p.peek().pos}
+		return nil, p.errorAt(p.peek(), "empty expression")
 	}
 
 	if len(children) == 1 {
@@ -214,7 +262,7 @@ func (p *Parser) parsePrimary() (QueryNode, error) {
 			return nil, err
 		}
 		if p.peek().typ != tokenRParen {
-			return nil, &ParseError{Message: "expected )", Position: p.peek().pos}
+			return nil, p.errorAt(p.peek(), "expected )")
 		}
 		p.advance() // consume )
 		return node, nil
@@ -225,20 +273,25 @@ func (p *Parser) parsePrimary() (QueryNode, error) {
 		if err != nil {
 			return nil, err
 		}
+		node.Pos = tok.pos
 		return node, nil
 
 	case tokenProperty:
-		return p.parseCondition(false)
+		node, err := p.parseCondition(false)
+		if err != nil {
+			return nil, err
+		}
+		return node, nil
 
 	default:
-		return nil, &ParseError{Message: fmt.Sprintf("unexpected token: %s", tok.value), Position: tok.pos}
+		return nil, p.errorAt(tok, fmt.Sprintf("unexpected token: %s", tok.value))
 	}
 }
 
-func (p *Parser) parseCondition(negated bool) (QueryNode, error) {
+func (p *Parser) parseCondition(negated bool) (*ConditionNode, error) {
 	propTok := p.peek()
 	if propTok.typ != tokenProperty {
-		return nil, &ParseError{Message: "expected property name", Position: propTok.pos}
+		return nil, p.errorAt(propTok, "expected property name")
 	}
 	p.advance()
 
@@ -249,20 +302,31 @@ func (p *Parser) parseCondition(negated bool) (QueryNode, error) {
 			Property: "text",
 			Value:    propTok.value,
 			Negated:  negated,
+			Pos:      propTok.pos,
+			End:      propTok.pos + len(propTok.value),
 		}, nil
 	}
 	p.advance()
 
+	property := strings.ToLower(propTok.value)
+	if !slices.Contains(Properties, property) {
+		err := p.errorAt(propTok, unknownPropertyMessage+propTok.value)
+		err.Suggestion = suggestProperty(property)
+		return nil, err
+	}
+
 	valueTok := p.peek()
 	if valueTok.typ != tokenProperty && valueTok.typ != tokenValue {
-		return nil, &ParseError{Message: "expected value", Position: valueTok.pos}
+		return nil, p.errorAt(valueTok, "expected value")
 	}
 	p.advance()
 
 	return &ConditionNode{
-		Property: strings.ToLower(propTok.value),
+		Property: property,
 		Value:    valueTok.value,
 		Negated:  negated,
+		Pos:      propTok.pos,
+		End:      valueTok.pos + len(p.text(valueTok)),
 	}, nil
 }
 
@@ -279,6 +343,56 @@ func (p *Parser) advance() token {
 	return tok
 }
 
+// text returns the token as it appears in the input, quotes included
+func (p *Parser) text(tok token) string {
+	if tok.typ == tokenValue {
+		return p.input[tok.pos : tok.pos+len(tok.value)+2]
+	}
+	return tok.value
+}
+
+// errorAt returns a parse error pointing at the token
+func (p *Parser) errorAt(tok token, message string) *ParseError {
+	return &ParseError{Message: message, Position: tok.pos, Token: p.text(tok)}
+}
+
+// suggestProperty returns the valid property nearest an unknown one: one it
+// abbreviates, or one within two edits. Empty when nothing is close.
+func suggestProperty(property string) string {
+	best, bestDistance := "", 3
+	for _, candidate := range Properties {
+		if len(property) >= 3 && strings.HasPrefix(candidate, property) {
+			return candidate
+		}
+		if d := editDistance(property, candidate); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
 // String returns a string representation of the query node (for debugging)
 func (n *ConditionNode) String() string {
 	prefix := ""
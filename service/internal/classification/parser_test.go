@@ -0,0 +1,58 @@
+package classification
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		message    string
+		position   int
+		token      string
+		suggestion string
+	}{
+		{"unknown property", "domain:acme.com titel:standup", "unknown property: titel", 16, "titel", "title"},
+		{"abbreviated property", "desc:roadmap", "unknown property: desc", 0, "desc", "description"},
+		{"nothing close", "color:red", "unknown property: color", 0, "color", ""},
+		{"missing value", "title:", "expected value", 6, "", ""},
+		{"unclosed paren", "(title:a OR title:b", "expected )", 19, "", ""},
+		{"stray paren", "title:a )", "unexpected token: )", 8, ")", ""},
+		{"unclosed quote", `title:"weekly sync`, "unclosed quote", 6, `"weekly sync`, ""},
+		{"leading whitespace", "  -:x", "expected property name", 3, ":", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.query)
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected a ParseError, got %v", err)
+			}
+			if parseErr.Message != tt.message || parseErr.Position != tt.position ||
+				parseErr.Token != tt.token || parseErr.Suggestion != tt.suggestion {
+				t.Errorf("got %+v", parseErr)
+			}
+		})
+	}
+}
+
+func TestParse_QuotedTokenPosition(t *testing.T) {
+	_, err := Parse(`title:"a b" (`)
+	parseErr := err.(*ParseError)
+	if parseErr.Token != "" || parseErr.Position != 13 {
+		t.Errorf("expected the error at the end of the query, got %+v", parseErr)
+	}
+
+	// A quoted value where a property belongs is underlined quotes and all
+	_, err = Parse(`title:a -"b c"`)
+	parseErr = err.(*ParseError)
+	if parseErr.Token != `"b c"` || parseErr.Position != 9 {
+		t.Errorf("expected the quoted token, got %+v", parseErr)
+	}
+	if d := parseErr.Details(); d["length"] != 5 || d["token"] != `"b c"` {
+		t.Errorf("expected details with the token's length, got %v", d)
+	}
+}
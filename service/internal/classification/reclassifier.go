@@ -0,0 +1,198 @@
+package classification
+
+import (
+	"context"
+	"log"
+	gosync "sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// DefaultReclassifyDelay is how long a user's synced changes settle before
+// their events are reclassified
+const DefaultReclassifyDelay = 5 * time.Second
+
+// reclassifyMaxWaitFactor bounds, in delays, how long a steady stream of
+// changes can postpone a user's run
+const reclassifyMaxWaitFactor = 6
+
+// TargetsFunc returns the classification targets for a user
+type TargetsFunc func(ctx context.Context, userID uuid.UUID) ([]Target, error)
+
+// Reclassifier applies rules to the events syncs create or change, instead
+// of the whole synced window. Changes are collected per user and run once
+// they have settled for the delay, so a sync touching many calendars costs
+// one run.
+type Reclassifier struct {
+	service *Service
+	targets TargetsFunc
+	delay   time.Duration
+	maxWait time.Duration
+
+	mu      gosync.Mutex
+	pending map[uuid.UUID]*pendingReclassify
+	stopped bool
+	running gosync.WaitGroup
+}
+
+// pendingReclassify is a user's changed events waiting for their run
+type pendingReclassify struct {
+	starts map[uuid.UUID]time.Time // Event ID to start time
+	// Events whose response status or transparency changed, which get their
+	// attendance re-evaluated even when classified by hand
+	attendance map[uuid.UUID]bool
+	firstAt    time.Time
+	timer      *time.Timer
+}
+
+// NewReclassifier creates a reclassifier that runs delay after a user's
+// last queued change
+func NewReclassifier(service *Service, targets TargetsFunc, delay time.Duration) *Reclassifier {
+	return &Reclassifier{
+		service: service,
+		targets: targets,
+		delay:   delay,
+		maxWait: delay * reclassifyMaxWaitFactor,
+		pending: make(map[uuid.UUID]*pendingReclassify),
+	}
+}
+
+// Queue adds a synced event to its user's next run. It has the signature
+// of a store.SyncHook.
+func (r *Reclassifier) Queue(event *store.CalendarEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped {
+		return
+	}
+
+	userID := event.UserID
+	p := r.pending[userID]
+	if p == nil {
+		p = &pendingReclassify{
+			starts:     make(map[uuid.UUID]time.Time),
+			attendance: make(map[uuid.UUID]bool),
+			firstAt:    time.Now(),
+		}
+		r.pending[userID] = p
+	}
+	p.starts[event.ID] = event.StartTime
+	if event.AttendanceChanged {
+		p.attendance[event.ID] = true
+	}
+
+	wait := r.delay
+	if remaining := r.maxWait - time.Since(p.firstAt); remaining < wait {
+		wait = max(remaining, 0)
+	}
+	if p.timer == nil {
+		p.timer = time.AfterFunc(wait, func() { r.fire(userID) })
+	} else {
+		p.timer.Reset(wait)
+	}
+}
+
+// Flush runs a user's pending changes now, for callers that read the
+// classified events right after syncing
+func (r *Reclassifier) Flush(ctx context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	p := r.take(userID)
+	r.mu.Unlock()
+	if p == nil {
+		return nil
+	}
+	return r.run(ctx, userID, p)
+}
+
+// Stop runs every pending change and stops queueing new ones
+func (r *Reclassifier) Stop() {
+	r.mu.Lock()
+	r.stopped = true
+	pending := make(map[uuid.UUID]*pendingReclassify, len(r.pending))
+	for userID := range r.pending {
+		pending[userID] = r.take(userID)
+	}
+	r.mu.Unlock()
+
+	r.running.Wait()
+	for userID, p := range pending {
+		if err := r.run(context.Background(), userID, p); err != nil {
+			log.Printf("Reclassifier: user=%s error=%v", userID, err)
+		}
+	}
+}
+
+func (r *Reclassifier) fire(userID uuid.UUID) {
+	r.mu.Lock()
+	if r.stopped {
+		r.mu.Unlock()
+		return
+	}
+	p := r.take(userID)
+	if p == nil {
+		r.mu.Unlock()
+		return
+	}
+	r.running.Add(1)
+	r.mu.Unlock()
+	defer r.running.Done()
+
+	if err := r.run(context.Background(), userID, p); err != nil {
+		log.Printf("Reclassifier: user=%s error=%v", userID, err)
+	}
+}
+
+// take removes a user's pending changes; the caller holds mu
+func (r *Reclassifier) take(userID uuid.UUID) *pendingReclassify {
+	p := r.pending[userID]
+	if p == nil {
+		return nil
+	}
+	delete(r.pending, userID)
+	p.timer.Stop()
+	return p
+}
+
+// run re-evaluates attendance where responses changed, applies rules to the
+// changed events and recalculates their days
+func (r *Reclassifier) run(ctx context.Context, userID uuid.UUID, p *pendingReclassify) error {
+	targets, err := r.targets(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	attendanceChanged := 0
+	if len(p.attendance) > 0 {
+		eventIDs := make([]uuid.UUID, 0, len(p.attendance))
+		for id := range p.attendance {
+			eventIDs = append(eventIDs, id)
+		}
+		if attendanceChanged, err = r.service.ReapplyAttendance(ctx, userID, eventIDs); err != nil {
+			return err
+		}
+	}
+
+	eventIDs := make([]uuid.UUID, 0, len(p.starts))
+	starts := make([]time.Time, 0, len(p.starts))
+	for id, start := range p.starts {
+		eventIDs = append(eventIDs, id)
+		starts = append(starts, start)
+	}
+
+	result, err := r.service.ApplyRulesInBatches(ctx, userID, targets, nil, nil, false, ApplyOptions{
+		CountOnly: true,
+		EventIDs:  eventIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := r.service.RecalculateDays(ctx, userID, starts); err != nil {
+		return err
+	}
+
+	log.Printf("[SYNC] reclassified: user=%s events=%d classified=%d changed=%d attendance_changed=%d",
+		userID, len(eventIDs), result.Progress.Classified, result.Progress.Changed, attendanceChanged)
+	return nil
+}
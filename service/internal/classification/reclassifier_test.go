@@ -0,0 +1,125 @@
+package classification
+
+import (
+	"context"
+	"errors"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// recordingTargets counts runs per user and stops each run before it
+// reaches the database
+type recordingTargets struct {
+	mu   gosync.Mutex
+	runs map[uuid.UUID]int
+}
+
+func (rt *recordingTargets) targets(ctx context.Context, userID uuid.UUID) ([]Target, error) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.runs[userID]++
+	return nil, errors.New("stop")
+}
+
+func (rt *recordingTargets) count(userID uuid.UUID) int {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return rt.runs[userID]
+}
+
+func TestReclassifier_DebouncesPerUser(t *testing.T) {
+	rt := &recordingTargets{runs: make(map[uuid.UUID]int)}
+	r := NewReclassifier(nil, rt.targets, 20*time.Millisecond)
+
+	alice, bob := uuid.New(), uuid.New()
+	event := &store.CalendarEvent{ID: uuid.New(), UserID: alice}
+	r.Queue(event)
+	r.Queue(event)
+	r.Queue(&store.CalendarEvent{ID: uuid.New(), UserID: alice})
+	r.Queue(&store.CalendarEvent{ID: uuid.New(), UserID: bob})
+
+	r.mu.Lock()
+	if n := len(r.pending[alice].starts); n != 2 {
+		t.Errorf("expected 2 distinct pending events for alice, got %d", n)
+	}
+	r.mu.Unlock()
+
+	deadline := time.Now().Add(time.Second)
+	for (rt.count(alice) == 0 || rt.count(bob) == 0) && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if rt.count(alice) != 1 || rt.count(bob) != 1 {
+		t.Errorf("expected one run per user, got alice=%d bob=%d", rt.count(alice), rt.count(bob))
+	}
+}
+
+func TestReclassifier_FlushAndStop(t *testing.T) {
+	rt := &recordingTargets{runs: make(map[uuid.UUID]int)}
+	r := NewReclassifier(nil, rt.targets, time.Hour)
+
+	alice, bob := uuid.New(), uuid.New()
+	r.Queue(&store.CalendarEvent{ID: uuid.New(), UserID: alice})
+	r.Queue(&store.CalendarEvent{ID: uuid.New(), UserID: bob})
+
+	if err := r.Flush(context.Background(), alice); err == nil {
+		t.Error("expected the flushed run's error")
+	}
+	if err := r.Flush(context.Background(), alice); err != nil {
+		t.Errorf("expected nothing left to flush, got %v", err)
+	}
+
+	r.Stop()
+	if rt.count(alice) != 1 || rt.count(bob) != 1 {
+		t.Errorf("expected stop to run bob's pending events, got alice=%d bob=%d", rt.count(alice), rt.count(bob))
+	}
+
+	r.Queue(&store.CalendarEvent{ID: uuid.New(), UserID: alice})
+	if len(r.pending) != 0 {
+		t.Error("expected no queueing after stop")
+	}
+}
+
+func TestReclassifier_TracksAttendanceChanges(t *testing.T) {
+	rt := &recordingTargets{runs: make(map[uuid.UUID]int)}
+	r := NewReclassifier(nil, rt.targets, time.Hour)
+	defer r.Stop()
+
+	alice := uuid.New()
+	declined := &store.CalendarEvent{ID: uuid.New(), UserID: alice, AttendanceChanged: true}
+	r.Queue(declined)
+	r.Queue(&store.CalendarEvent{ID: uuid.New(), UserID: alice})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p := r.pending[alice]
+	if len(p.starts) != 2 || len(p.attendance) != 1 || !p.attendance[declined.ID] {
+		t.Errorf("expected both events pending and only the declined one for attendance, got %d and %v",
+			len(p.starts), p.attendance)
+	}
+}
+
+func TestAttendanceSkip(t *testing.T) {
+	manual, rule := store.SourceManual, store.SourceRule
+	for _, tc := range []struct {
+		name       string
+		event      store.CalendarEvent
+		attended   bool
+		skip, diff bool
+	}{
+		{"declined", store.CalendarEvent{ClassificationSource: &rule}, false, true, true},
+		{"declined after a manual classification", store.CalendarEvent{ClassificationSource: &manual}, false, true, true},
+		{"accepted again", store.CalendarEvent{IsSkipped: true, ClassificationSource: &rule}, true, false, true},
+		{"manual skip is kept", store.CalendarEvent{IsSkipped: true, ClassificationSource: &manual}, true, true, false},
+		{"still attended", store.CalendarEvent{}, true, false, false},
+	} {
+		skip, change := attendanceSkip(&tc.event, tc.attended)
+		if skip != tc.skip || change != tc.diff {
+			t.Errorf("%s: got skip=%v change=%v, want skip=%v change=%v", tc.name, skip, change, tc.skip, tc.diff)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package classification
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Defaults for finding rule conflicts
+const (
+	DefaultConflictMinOverlap = 0.5 // Share of the smaller rule's matches
+	DefaultConflictMinShared  = 2   // Fewer shared events is a coincidence
+	conflictSampleSize        = 5
+)
+
+// RuleOverlap is a pair of rules with different targets that match many of
+// the same items
+type RuleOverlap struct {
+	A, B        Rule
+	MatchesA    int      // Items A matches
+	MatchesB    int      // Items B matches
+	SharedItems []string // IDs of the items both match, in item order
+	Overlap     float64  // Shared items over the smaller rule's matches
+}
+
+// FindRuleOverlaps evaluates the rules against the items and returns the
+// pairs with different targets whose shared items are at least minOverlap
+// of the smaller rule's matches and number at least minShared. Pairs are
+// ordered by shared items, most first.
+func FindRuleOverlaps(rules []Rule, items []Item, minOverlap float64, minShared int) []RuleOverlap {
+	set := Compile(rules, nil)
+
+	// matched[i] holds the indexes of the items rule i matches, ascending
+	matched := make([][]int, len(set.Rules))
+	for j, item := range items {
+		props := itemToProperties(item)
+		for i, rule := range set.Rules {
+			if rule.Matches(props) {
+				matched[i] = append(matched[i], j)
+			}
+		}
+	}
+
+	var overlaps []RuleOverlap
+	for i := range set.Rules {
+		for k := i + 1; k < len(set.Rules); k++ {
+			a, b := set.Rules[i], set.Rules[k]
+			if a.TargetID == b.TargetID || len(matched[i]) == 0 || len(matched[k]) == 0 {
+				continue
+			}
+			shared := intersect(matched[i], matched[k])
+			overlap := float64(len(shared)) / float64(min(len(matched[i]), len(matched[k])))
+			if len(shared) < minShared || overlap < minOverlap {
+				continue
+			}
+			ids := make([]string, len(shared))
+			for n, j := range shared {
+				ids[n] = items[j].ID
+			}
+			overlaps = append(overlaps, RuleOverlap{
+				A:           a.Rule,
+				B:           b.Rule,
+				MatchesA:    len(matched[i]),
+				MatchesB:    len(matched[k]),
+				SharedItems: ids,
+				Overlap:     overlap,
+			})
+		}
+	}
+
+	sort.SliceStable(overlaps, func(i, j int) bool {
+		return len(overlaps[i].SharedItems) > len(overlaps[j].SharedItems)
+	})
+	return overlaps
+}
+
+// intersect returns the values in both ascending slices
+func intersect(a, b []int) []int {
+	var out []int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// RuleConflict is a pair of enabled project rules competing for the same
+// events, with how those events are classified now
+type RuleConflict struct {
+	A, B   ConflictingRule
+	Shared int
+	// Shared events over the smaller rule's matches
+	Overlap float64
+	// Equal weights: nothing decides between the rules, so shared events
+	// can land on either project from one run to the next
+	Tie        bool
+	Samples    []*ConflictSample
+	Suggestion *WeightSuggestion // Nil when the events don't favour either rule
+}
+
+// ConflictingRule is one side of a RuleConflict
+type ConflictingRule struct {
+	RuleID    uuid.UUID
+	Query     string
+	ProjectID uuid.UUID
+	Weight    float64
+	Matches   int // Events the rule matches
+	// Shared events classified to the rule's project, and how many of
+	// those the user classified by hand
+	Classified int
+	Confirmed  int
+}
+
+// ConflictSample is a shared event and its current classification
+type ConflictSample struct {
+	EventID   uuid.UUID
+	Title     string
+	StartTime time.Time
+	ProjectID *uuid.UUID
+	Source    *store.ClassificationSource
+}
+
+// WeightSuggestion proposes a weight that makes a rule win the events it
+// shares with another
+type WeightSuggestion struct {
+	RuleID          uuid.UUID
+	CurrentWeight   float64
+	SuggestedWeight float64
+	Reason          string
+}
+
+// RuleConflicts finds pairs of enabled project rules whose matches overlap
+// heavily in the date range but target different projects. Events the user
+// classified by hand show which rule is right; failing those, the current
+// classifications do. The suggested weight is twice the other rule's, so
+// the favoured rule wins shared events with 67% confidence, above the
+// review ceiling.
+func (s *Service) RuleConflicts(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time, minOverlap float64) ([]*RuleConflict, int, error) {
+	storeRules, err := s.rules.Rules(ctx, userID, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	events, err := s.eventStore.List(ctx, userID, &startDate, &endDate, nil, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	aliases, err := s.aliasesFor(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]Item, len(events))
+	eventByID := make(map[string]*store.CalendarEvent, len(events))
+	for i, event := range events {
+		items[i] = eventToItem(event, aliases)
+		eventByID[items[i].ID] = event
+	}
+
+	overlaps := FindRuleOverlaps(storeRulesToLibraryRules(storeRules), items, minOverlap, DefaultConflictMinShared)
+	conflicts := make([]*RuleConflict, 0, len(overlaps))
+	for _, o := range overlaps {
+		c := &RuleConflict{
+			A:       conflictingRule(o.A, o.MatchesA),
+			B:       conflictingRule(o.B, o.MatchesB),
+			Shared:  len(o.SharedItems),
+			Overlap: o.Overlap,
+			Tie:     o.A.Weight == o.B.Weight,
+			Samples: make([]*ConflictSample, 0, conflictSampleSize),
+		}
+		for _, id := range o.SharedItems {
+			event := eventByID[id]
+			for _, side := range []*ConflictingRule{&c.A, &c.B} {
+				if event.ProjectID != nil && *event.ProjectID == side.ProjectID {
+					side.Classified++
+					if event.ClassificationSource != nil && *event.ClassificationSource == store.SourceManual {
+						side.Confirmed++
+					}
+				}
+			}
+			if len(c.Samples) < conflictSampleSize {
+				c.Samples = append(c.Samples, &ConflictSample{
+					EventID:   event.ID,
+					Title:     event.Title,
+					StartTime: event.StartTime,
+					ProjectID: event.ProjectID,
+					Source:    event.ClassificationSource,
+				})
+			}
+		}
+		c.Suggestion = suggestWeight(c)
+		conflicts = append(conflicts, c)
+	}
+	return conflicts, len(events), nil
+}
+
+func conflictingRule(rule Rule, matches int) ConflictingRule {
+	ruleID, _ := uuid.Parse(rule.ID)
+	projectID, _ := uuid.Parse(rule.TargetID)
+	return ConflictingRule{
+		RuleID:    ruleID,
+		Query:     rule.Query,
+		ProjectID: projectID,
+		Weight:    rule.Weight,
+		Matches:   matches,
+	}
+}
+
+// suggestWeight favours the rule the user's own classifications side with,
+// or else the one the shared events are mostly classified to
+func suggestWeight(c *RuleConflict) *WeightSuggestion {
+	favoured, other := &c.A, &c.B
+	var evidence string
+	switch {
+	case c.A.Confirmed != c.B.Confirmed:
+		if c.B.Confirmed > c.A.Confirmed {
+			favoured, other = other, favoured
+		}
+		evidence = fmt.Sprintf("%d of the shared events you classified by hand went to its project, %d to the other rule's",
+			favoured.Confirmed, other.Confirmed)
+	case c.A.Classified != c.B.Classified:
+		if c.B.Classified > c.A.Classified {
+			favoured, other = other, favoured
+		}
+		evidence = fmt.Sprintf("%d of the shared events are classified to its project, %d to the other rule's",
+			favoured.Classified, other.Classified)
+	default:
+		return nil
+	}
+
+	suggested := math.Round(other.Weight*2*10) / 10
+	if favoured.Weight >= suggested {
+		return nil
+	}
+	return &WeightSuggestion{
+		RuleID:          favoured.RuleID,
+		CurrentWeight:   favoured.Weight,
+		SuggestedWeight: suggested,
+		Reason:          evidence + "; at twice the other rule's weight it wins them with 67% confidence, without review",
+	}
+}
@@ -0,0 +1,72 @@
+package classification
+
+import (
+	"testing"
+)
+
+func TestFindRuleOverlaps(t *testing.T) {
+	rules := []Rule{
+		{ID: "sync", Query: "title:sync", TargetID: "acme", Weight: 1},
+		{ID: "acme", Query: "domain:acme.com", TargetID: "globex", Weight: 1},
+		{ID: "acme-too", Query: "domain:acme.com", TargetID: "globex", Weight: 1},
+		{ID: "lunch", Query: "title:lunch", TargetID: "internal", Weight: 1},
+	}
+	item := func(id, title string, attendees ...string) Item {
+		return Item{ID: id, Attributes: map[string]any{"title": title, "attendees": attendees}}
+	}
+	items := []Item{
+		item("e1", "Weekly sync", "pm@acme.com"),
+		item("e2", "Sync", "pm@acme.com"),
+		item("e3", "Design review", "pm@acme.com"),
+		item("e4", "Team sync"),
+		item("e5", "Lunch", "pm@acme.com"),
+	}
+
+	overlaps := FindRuleOverlaps(rules, items, 0.5, 2)
+	// sync shares e1 and e2 with both acme rules: 2 of its 3 matches. The
+	// acme rules share a target, and lunch shares a single event.
+	if len(overlaps) != 2 {
+		t.Fatalf("expected two overlapping pairs, got %+v", overlaps)
+	}
+	o := overlaps[0]
+	if o.A.ID != "sync" || o.B.ID != "acme" || o.MatchesA != 3 || o.MatchesB != 4 {
+		t.Errorf("unexpected pair %+v", o)
+	}
+	if len(o.SharedItems) != 2 || o.SharedItems[0] != "e1" || o.SharedItems[1] != "e2" {
+		t.Errorf("expected e1 and e2 shared, got %v", o.SharedItems)
+	}
+	if o.Overlap < 0.66 || o.Overlap > 0.67 {
+		t.Errorf("expected 2/3 overlap, got %f", o.Overlap)
+	}
+
+	if got := FindRuleOverlaps(rules, items, 0.7, 2); len(got) != 0 {
+		t.Errorf("expected no pairs above 70%% overlap, got %+v", got)
+	}
+}
+
+func TestSuggestWeight(t *testing.T) {
+	conflict := &RuleConflict{
+		A: ConflictingRule{Weight: 1, Classified: 5, Confirmed: 1},
+		B: ConflictingRule{Weight: 1.5, Classified: 1, Confirmed: 3},
+	}
+	s := suggestWeight(conflict)
+	if s == nil || s.CurrentWeight != 1.5 || s.SuggestedWeight != 2 {
+		t.Errorf("expected hand classifications to favour B at twice A's weight, got %+v", s)
+	}
+
+	conflict.B.Confirmed = 1
+	s = suggestWeight(conflict)
+	if s == nil || s.CurrentWeight != 1 || s.SuggestedWeight != 3 {
+		t.Errorf("expected current classifications to favour A, got %+v", s)
+	}
+
+	conflict.A.Weight = 3
+	if s := suggestWeight(conflict); s != nil {
+		t.Errorf("expected no suggestion once A already outweighs B, got %+v", s)
+	}
+
+	conflict.A.Classified = 1
+	if s := suggestWeight(conflict); s != nil {
+		t.Errorf("expected no suggestion without evidence, got %+v", s)
+	}
+}
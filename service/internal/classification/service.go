@@ -3,11 +3,15 @@ package classification
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/michaelw/timesheet-app/service/internal/cache"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 	"github.com/michaelw/timesheet-app/service/internal/timeentry"
 )
@@ -36,19 +40,25 @@ type ClassificationResult struct {
 // logic to the Classify function.
 type Service struct {
 	pool             *pgxpool.Pool
-	ruleStore        *store.ClassificationRuleStore
+	rules            *cache.ReadModel
 	eventStore       *store.CalendarEventStore
 	timeEntryStore   *store.TimeEntryStore
+	actionStore      *store.ClassificationActionStore
+	suppressionStore *store.SuppressionRuleStore
+	aliasStore       *store.AttendeeAliasStore
 	timeEntryService *timeentry.Service
 }
 
 // NewService creates a new classification service
-func NewService(pool *pgxpool.Pool, ruleStore *store.ClassificationRuleStore, eventStore *store.CalendarEventStore, timeEntryStore *store.TimeEntryStore) *Service {
+func NewService(pool *pgxpool.Pool, rules *cache.ReadModel, eventStore *store.CalendarEventStore, timeEntryStore *store.TimeEntryStore, actionStore *store.ClassificationActionStore, suppressionStore *store.SuppressionRuleStore) *Service {
 	return &Service{
 		pool:             pool,
-		ruleStore:        ruleStore,
+		rules:            rules,
 		eventStore:       eventStore,
 		timeEntryStore:   timeEntryStore,
+		actionStore:      actionStore,
+		suppressionStore: suppressionStore,
+		aliasStore:       store.NewAttendeeAliasStore(pool),
 		timeEntryService: timeentry.NewService(eventStore, timeEntryStore),
 	}
 }
@@ -57,14 +67,19 @@ func NewService(pool *pgxpool.Pool, ruleStore *store.ClassificationRuleStore, ev
 // Targets represent classification destinations (e.g., projects) with their fingerprint attributes.
 func (s *Service) ClassifyEvent(ctx context.Context, userID uuid.UUID, event *store.CalendarEvent, targets []Target) (*ClassificationResult, error) {
 	// Get all enabled rules for the user
-	storeRules, err := s.ruleStore.List(ctx, userID, false)
+	storeRules, err := s.rules.Rules(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.aliasesFor(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert to library types
 	rules := storeRulesToLibraryRules(storeRules)
-	item := eventToItem(event)
+	item := eventToItem(event, aliases)
 
 	// Use pure classifier with targets
 	results := Classify(rules, targets, []Item{item}, DefaultConfig())
@@ -85,14 +100,19 @@ func (s *Service) ClassifyEvent(ctx context.Context, userID uuid.UUID, event *st
 // EvaluateAttendance evaluates attendance rules for an event
 func (s *Service) EvaluateAttendance(ctx context.Context, userID uuid.UUID, event *store.CalendarEvent) (*ClassificationResult, error) {
 	// Get attendance rules
-	storeRules, err := s.ruleStore.ListAttendanceRules(ctx, userID)
+	storeRules, err := s.rules.AttendanceRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.aliasesFor(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert to library types
 	rules := storeRulesToAttendanceRules(storeRules)
-	item := eventToItem(event)
+	item := eventToItem(event, aliases)
 
 	// Use pure classifier for attendance
 	results := ClassifyAttendance(rules, []Item{item}, DefaultConfig())
@@ -111,6 +131,18 @@ func (s *Service) EvaluateAttendance(ctx context.Context, userID uuid.UUID, even
 	return attendanceResultToServiceResult(results[0], storeRules), nil
 }
 
+// LintRule lints a rule's query against the user's enabled rules. rule.ID
+// names the rule being edited, if any, and rule.TargetID is a project ID,
+// TargetDNA or "attended:true" as for classification, or empty.
+func (s *Service) LintRule(ctx context.Context, userID uuid.UUID, rule Rule) ([]LintIssue, error) {
+	storeRules, err := s.rules.Rules(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	existing := append(storeRulesToLibraryRules(storeRules), storeRulesToAttendanceRules(storeRules)...)
+	return Lint(rule, existing), nil
+}
+
 // PreviewRule evaluates a query against events and returns matching events with conflict info
 func (s *Service) PreviewRule(ctx context.Context, userID uuid.UUID, query string, targetProjectID *uuid.UUID, startDate, endDate *time.Time) (*RulePreview, error) {
 	// Parse query first to validate syntax
@@ -119,8 +151,20 @@ func (s *Service) PreviewRule(ctx context.Context, userID uuid.UUID, query strin
 		return nil, err
 	}
 
-	// Get events in the date range
-	events, err := s.eventStore.List(ctx, userID, startDate, endDate, nil, nil)
+	aliases, err := s.aliasesFor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get events in the date range, letting the database narrow them by
+	// attendee when the query requires a domain: or email: match
+	var events []*store.CalendarEvent
+	if filter := attendeeFilterFor(ast); filter != nil {
+		aliases.expandFilter(filter)
+		events, err = s.eventStore.ListByAttendees(ctx, userID, startDate, endDate, *filter)
+	} else {
+		events, err = s.eventStore.List(ctx, userID, startDate, endDate, nil, nil)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +177,7 @@ func (s *Service) PreviewRule(ctx context.Context, userID uuid.UUID, query strin
 	// Evaluate each event using extended properties (supports project:, client:, confidence:)
 	for _, event := range events {
 		extProps := eventToExtendedProperties(event)
+		extProps.Attendees = aliases.Expand(extProps.Attendees)
 
 		if !EvaluateExtended(ast, extProps) {
 			continue
@@ -172,6 +217,50 @@ func (s *Service) PreviewRule(ctx context.Context, userID uuid.UUID, query strin
 	return preview, nil
 }
 
+// attendeeFilterFor returns an attendee filter that every event matching
+// the query satisfies, or nil when the query can match without a domain: or
+// email: attendee. The filter is only a prefilter; events are still evaluated.
+func attendeeFilterFor(node QueryNode) *store.AttendeeFilter {
+	switch n := node.(type) {
+	case *ConditionNode:
+		if n.Negated {
+			return nil
+		}
+		switch n.Property {
+		case "domain":
+			return &store.AttendeeFilter{Domains: []string{n.Value}}
+		case "email":
+			return &store.AttendeeFilter{Emails: []string{n.Value}}
+		}
+		return nil
+
+	case *AndNode:
+		// Any one required attendee narrows the whole conjunction
+		for _, child := range n.Children {
+			if filter := attendeeFilterFor(child); filter != nil {
+				return filter
+			}
+		}
+		return nil
+
+	case *OrNode:
+		// Every branch must require an attendee for the union to be safe
+		union := &store.AttendeeFilter{}
+		for _, child := range n.Children {
+			filter := attendeeFilterFor(child)
+			if filter == nil {
+				return nil
+			}
+			union.Domains = append(union.Domains, filter.Domains...)
+			union.Emails = append(union.Emails, filter.Emails...)
+		}
+		return union
+
+	default:
+		return nil
+	}
+}
+
 // eventToExtendedProperties converts a CalendarEvent to ExtendedEventProperties
 func eventToExtendedProperties(event *store.CalendarEvent) *ExtendedEventProperties {
 	props := &ExtendedEventProperties{
@@ -181,6 +270,8 @@ func eventToExtendedProperties(event *store.CalendarEvent) *ExtendedEventPropert
 			StartTime:   event.StartTime,
 			EndTime:     event.EndTime,
 			IsRecurring: event.IsRecurring,
+			Tags:        event.Tags,
+			IsInternal:  event.IsInternal,
 		},
 		Confidence:   event.ClassificationConfidence,
 		IsClassified: event.ClassificationStatus == store.StatusClassified,
@@ -242,6 +333,29 @@ type PreviewStats struct {
 	ManualConflicts int `json:"manual_conflicts"`
 }
 
+// DefaultApplyBatchSize is how many events an apply run loads and evaluates at a time
+const DefaultApplyBatchSize = 500
+
+// ApplyProgress is the running tally of an apply run
+type ApplyProgress struct {
+	Total       int
+	Processed   int
+	Matched     int // Events evaluated, after any Query filter
+	Classified  int
+	SkipApplied int
+	Skipped     int
+	Changed     int // Events whose classification changed
+}
+
+// ApplyOptions controls how ApplyRulesInBatches runs
+type ApplyOptions struct {
+	BatchSize int                       // Events per batch; DefaultApplyBatchSize when zero
+	CountOnly bool                      // Keep only the tally, not every classified event
+	OnBatch   func(ApplyProgress) error // Called after each batch; an error stops the run
+	Query     string                    // Only evaluate events this query matches; all when empty
+	EventIDs  []uuid.UUID               // Only evaluate these events; all when nil
+}
+
 // ApplyRules runs classification on pending events and re-evaluates unlocked classified events.
 // Per the PRD, this runs two passes:
 //   1. Skip pass: Evaluate skip rules (attended=false), set is_skipped=true for matches
@@ -249,50 +363,155 @@ type PreviewStats struct {
 // Both passes always run - a skipped event still gets classified to a project.
 // Targets represent classification destinations (e.g., projects) with their fingerprint attributes.
 func (s *Service) ApplyRules(ctx context.Context, userID uuid.UUID, targets []Target, startDate, endDate *time.Time, dryRun bool) (*ApplyResult, error) {
-	// Get pending events
-	pendingStatus := store.StatusPending
-	pendingEvents, err := s.eventStore.List(ctx, userID, startDate, endDate, &pendingStatus, nil)
-	if err != nil {
-		return nil, err
+	return s.ApplyRulesInBatches(ctx, userID, targets, startDate, endDate, dryRun, ApplyOptions{})
+}
+
+// ApplyRulesInBatches is ApplyRules over pages of events, so multi-year
+// ranges never hold every event in memory. Changes made before a failure
+// are still journaled as one action, and the partial result is returned
+// with the error.
+func (s *Service) ApplyRulesInBatches(ctx context.Context, userID uuid.UUID, targets []Target, startDate, endDate *time.Time, dryRun bool, opts ApplyOptions) (*ApplyResult, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultApplyBatchSize
 	}
 
-	// Get events eligible for reclassification (classified by rule/fingerprint, not locked)
-	reclassifyEvents, err := s.eventStore.ListForReclassification(ctx, userID, startDate, endDate)
+	// Get all enabled rules
+	storeRules, err := s.rules.Rules(ctx, userID, false)
 	if err != nil {
 		return nil, err
 	}
+	// Compiled once for the run rather than per batch and event
+	skipRules := Compile(storeRulesToAttendanceRules(storeRules), nil)
+	projectRules := Compile(storeRulesToLibraryRules(storeRules), targets)
 
-	// Combine both sets of events
-	events := append(pendingEvents, reclassifyEvents...)
+	var filter QueryNode
+	if opts.Query != "" {
+		filter, err = Parse(opts.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	// Get all enabled rules
-	storeRules, err := s.ruleStore.List(ctx, userID, false)
+	total, err := s.eventStore.CountForApply(ctx, userID, startDate, endDate, opts.EventIDs)
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := s.aliasesFor(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	run := &applyRun{
+		service:   s,
+		userID:    userID,
+		dryRun:    dryRun,
+		countOnly: opts.CountOnly,
+		filter:    filter,
+		aliases:   aliases,
+		journaled: make(map[uuid.UUID]bool),
+		result: &ApplyResult{
+			Classified:  make([]*ClassifiedEvent, 0),
+			SkipApplied: make([]*SkippedEvent, 0),
+			Progress:    ApplyProgress{Total: total},
+		},
+	}
+
+	var runErr error
+	var cursor *store.EventCursor
+	for {
+		events, err := s.eventStore.ListForApply(ctx, userID, startDate, endDate, opts.EventIDs, cursor, batchSize)
+		if err != nil {
+			runErr = err
+			break
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		run.applyBatch(ctx, skipRules, projectRules, events)
+		last := events[len(events)-1]
+		cursor = &store.EventCursor{StartTime: last.StartTime, ID: last.ID}
+
+		if opts.OnBatch != nil {
+			if err := opts.OnBatch(run.result.Progress); err != nil {
+				runErr = err
+				break
+			}
+		}
+		if len(events) < batchSize {
+			break
+		}
+	}
+
+	applyResult := run.result
+	applyResult.Skipped = applyResult.Progress.Skipped
+
+	if len(run.prior) > 0 {
+		description := describeApplyRange(startDate, endDate)
+		if opts.EventIDs != nil {
+			description = fmt.Sprintf("Apply rules to %d synced events", len(opts.EventIDs))
+		}
+		action, err := s.RecordAction(ctx, userID, store.ActionKindApplyRules, description, run.prior)
+		if err != nil && runErr == nil {
+			runErr = err
+		}
+		if action != nil {
+			applyResult.ActionID = &action.ID
+		}
+	}
+
+	if runErr != nil {
+		return applyResult, runErr
+	}
+	return applyResult, nil
+}
+
+// applyRun carries the state of one apply run across batches
+type applyRun struct {
+	service   *Service
+	userID    uuid.UUID
+	dryRun    bool
+	countOnly bool
+	filter    QueryNode // Limits the run to matching events when set
+	aliases   Aliases
+	result    *ApplyResult
+
+	// Prior state of each event this run changes, for the action journal
+	prior     []store.EventClassificationState
+	journaled map[uuid.UUID]bool
+}
+
+func (r *applyRun) journal(event *store.CalendarEvent) {
+	if !r.journaled[event.ID] {
+		r.journaled[event.ID] = true
+		r.prior = append(r.prior, store.ClassificationStateOf(event))
+		r.result.Progress.Changed++
+	}
+}
+
+// applyBatch runs both passes over one batch of events
+func (r *applyRun) applyBatch(ctx context.Context, skipRules, projectRules *RuleSet, events []*store.CalendarEvent) {
+	s := r.service
+	progress := &r.result.Progress
+	progress.Processed += len(events)
+
 	// Convert events to items (shared between passes)
 	items := make([]Item, 0, len(events))
-	eventMap := make(map[string]*store.CalendarEvent)
+	eventMap := make(map[string]*store.CalendarEvent, len(events))
 	for _, event := range events {
-		item := eventToItem(event)
+		item := eventToItem(event, r.aliases)
+		if r.filter != nil && !Evaluate(r.filter, itemToProperties(item)) {
+			continue
+		}
 		items = append(items, item)
 		eventMap[item.ID] = event
 	}
-
-	applyResult := &ApplyResult{
-		Classified:  make([]*ClassifiedEvent, 0),
-		SkipApplied: make([]*SkippedEvent, 0),
-		Skipped:     0,
-	}
-
-	// Track affected dates for time entry recalculation
-	affectedDates := make(map[time.Time]bool)
+	progress.Matched += len(items)
 
 	// ========== PASS 1: Skip Rules ==========
 	// Evaluate attendance rules where attended=false (skip rules)
-	skipRules := storeRulesToAttendanceRules(storeRules)
-	skipResults := ClassifyAttendance(skipRules, items, DefaultConfig())
+	skipResults := skipRules.ClassifyAttendance(items, DefaultConfig())
 
 	for _, skipResult := range skipResults {
 		event := eventMap[skipResult.ItemID]
@@ -302,28 +521,28 @@ func (s *Service) ApplyRules(ctx context.Context, userID uuid.UUID, targets []Ta
 
 		// If the result is "not attended" (skip), mark as skipped
 		if !skipResult.Attended {
-			skippedEvent := &SkippedEvent{
-				EventID:    event.ID,
-				Confidence: skipResult.Confidence,
+			progress.SkipApplied++
+			if !r.countOnly {
+				r.result.SkipApplied = append(r.result.SkipApplied, &SkippedEvent{
+					EventID:    event.ID,
+					Confidence: skipResult.Confidence,
+				})
 			}
-			applyResult.SkipApplied = append(applyResult.SkipApplied, skippedEvent)
 
-			if !dryRun {
-				if err := s.eventStore.SetSkipped(ctx, userID, event.ID, true, store.SourceRule); err != nil {
+			if !r.dryRun {
+				if err := s.eventStore.SetSkipped(ctx, r.userID, event.ID, true, store.SourceRule); err != nil {
 					continue
 				}
-				eventDate := time.Date(event.StartTime.Year(), event.StartTime.Month(), event.StartTime.Day(), 0, 0, 0, 0, time.UTC)
-				affectedDates[eventDate] = true
+				if !event.IsSkipped {
+					r.journal(event)
+				}
 			}
 		}
 	}
 
 	// ========== PASS 2: Project Rules ==========
-	// Convert project rules to library types
-	projectRules := storeRulesToLibraryRules(storeRules)
-
 	// Use pure classifier with targets
-	projectResults := Classify(projectRules, targets, items, DefaultConfig())
+	projectResults := projectRules.Classify(items, DefaultConfig())
 
 	for _, libResult := range projectResults {
 		event := eventMap[libResult.ItemID]
@@ -332,52 +551,195 @@ func (s *Service) ApplyRules(ctx context.Context, userID uuid.UUID, targets []Ta
 		}
 
 		if libResult.TargetID == "" {
-			applyResult.Skipped++
+			progress.Skipped++
 			continue
 		}
 
 		targetID, err := uuid.Parse(libResult.TargetID)
 		if err != nil {
-			applyResult.Skipped++
+			progress.Skipped++
 			continue
 		}
 
-		classified := &ClassifiedEvent{
-			EventID:     event.ID,
-			TargetID:    targetID,
-			Confidence:  libResult.Confidence,
-			NeedsReview: libResult.NeedsReview,
+		progress.Classified++
+		if !r.countOnly {
+			r.result.Classified = append(r.result.Classified, &ClassifiedEvent{
+				EventID:     event.ID,
+				TargetID:    targetID,
+				Confidence:  libResult.Confidence,
+				NeedsReview: libResult.NeedsReview,
+			})
 		}
-		applyResult.Classified = append(applyResult.Classified, classified)
 
-		if !dryRun {
+		if !r.dryRun {
 			// Map MatchSource to store.ClassificationSource
 			source := store.SourceRule
 			if libResult.MatchSource == MatchSourceFingerprint {
 				source = store.SourceFingerprint
 			}
 
-			if err := s.eventStore.ClassifyByRule(ctx, userID, event.ID, targetID, source, libResult.Confidence, libResult.NeedsReview); err != nil {
+			if err := s.eventStore.ClassifyByRule(ctx, r.userID, event.ID, targetID, source, libResult.Confidence, libResult.NeedsReview, historyVotes(libResult.Votes)); err != nil {
 				continue
 			}
+			if classificationChanged(event, targetID, source, libResult.Confidence, libResult.NeedsReview) {
+				r.journal(event)
+			}
+		}
+	}
+}
+
+// ReapplyAttendance re-runs attendance rules on events whose response status
+// or transparency changed, including events classified by hand, and skips or
+// unskips each to match. A skip made by hand is kept. The changes are
+// journaled as one action. Returns how many events changed.
+func (s *Service) ReapplyAttendance(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) (int, error) {
+	storeRules, err := s.rules.Rules(ctx, userID, false)
+	if err != nil {
+		return 0, err
+	}
+	attendanceRules := Compile(storeRulesToAttendanceRules(storeRules), nil)
+	aliases, err := s.aliasesFor(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	var prior []store.EventClassificationState
+	for _, id := range eventIDs {
+		event, err := s.eventStore.GetByID(ctx, userID, id)
+		if errors.Is(err, store.ErrCalendarEventNotFound) {
+			continue
+		}
+		if err != nil {
+			return len(prior), err
+		}
 
-			eventDate := time.Date(event.StartTime.Year(), event.StartTime.Month(), event.StartTime.Day(), 0, 0, 0, 0, time.UTC)
-			affectedDates[eventDate] = true
+		results := attendanceRules.ClassifyAttendance([]Item{eventToItem(event, aliases)}, DefaultConfig())
+		skip, change := attendanceSkip(event, results[0].Attended)
+		if !change {
+			continue
 		}
+		if err := s.eventStore.SetSkipped(ctx, userID, event.ID, skip, store.SourceRule); err != nil {
+			return len(prior), err
+		}
+		prior = append(prior, store.ClassificationStateOf(event))
 	}
 
-	// With ephemeral time entries, we don't reactively create/update entries.
-	// Time entries are computed on-demand when ListTimeEntries is called.
-	// The affectedDates tracking was for reactive updates, now unused.
+	if len(prior) > 0 {
+		description := fmt.Sprintf("Apply attendance rules to %d events with changed responses", len(eventIDs))
+		if _, err := s.RecordAction(ctx, userID, store.ActionKindApplyRules, description, prior); err != nil {
+			return len(prior), err
+		}
+	}
+	return len(prior), nil
+}
 
-	return applyResult, nil
+// attendanceSkip decides whether an event should be skipped given the
+// attendance verdict, and whether that changes it. Events skipped by hand
+// stay skipped.
+func attendanceSkip(event *store.CalendarEvent, attended bool) (skip, change bool) {
+	if event.IsSkipped && event.ClassificationSource != nil && *event.ClassificationSource == store.SourceManual {
+		return true, false
+	}
+	return !attended, event.IsSkipped == attended
+}
+
+// historyVotes converts a result's votes for the event's classification history
+func historyVotes(votes []Vote) []store.ClassificationVote {
+	out := make([]store.ClassificationVote, len(votes))
+	for i, v := range votes {
+		source := store.SourceRule
+		if v.Source == MatchSourceFingerprint {
+			source = store.SourceFingerprint
+		}
+		out[i] = store.ClassificationVote{
+			RuleID:    v.RuleID,
+			ProjectID: v.TargetID,
+			Weight:    v.Weight,
+			Source:    source,
+		}
+	}
+	return out
+}
+
+// classificationChanged reports whether classifying an event by rule would
+// change any of its classification fields
+func classificationChanged(event *store.CalendarEvent, targetID uuid.UUID, source store.ClassificationSource, confidence float64, needsReview bool) bool {
+	return event.ProjectID == nil || *event.ProjectID != targetID ||
+		event.ClassificationStatus != store.StatusClassified ||
+		event.ClassificationSource == nil || *event.ClassificationSource != source ||
+		event.ClassificationConfidence == nil || *event.ClassificationConfidence != confidence ||
+		event.NeedsReview != needsReview
+}
+
+func describeApplyRange(startDate, endDate *time.Time) string {
+	switch {
+	case startDate != nil && endDate != nil:
+		return "Apply rules " + startDate.Format("2006-01-02") + " to " + endDate.Format("2006-01-02")
+	case startDate != nil:
+		return "Apply rules from " + startDate.Format("2006-01-02")
+	case endDate != nil:
+		return "Apply rules through " + endDate.Format("2006-01-02")
+	}
+	return "Apply rules to all events"
+}
+
+// RecordAction journals a classification change so it can be undone.
+// prior holds each affected event's state before the change.
+func (s *Service) RecordAction(ctx context.Context, userID uuid.UUID, kind, description string, prior []store.EventClassificationState) (*store.ClassificationAction, error) {
+	if s.actionStore == nil {
+		return nil, nil
+	}
+	return s.actionStore.Record(ctx, userID, kind, description, prior)
+}
+
+// ListActions returns a user's most recent journaled actions
+func (s *Service) ListActions(ctx context.Context, userID uuid.UUID, limit int) ([]*store.ClassificationAction, error) {
+	return s.actionStore.List(ctx, userID, limit)
+}
+
+// UndoAction restores the events changed by an action to their prior
+// classification and recalculates time entries on the affected days
+func (s *Service) UndoAction(ctx context.Context, userID, actionID uuid.UUID) (*store.ClassificationAction, []time.Time, error) {
+	action, starts, err := s.actionStore.Undo(ctx, userID, actionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dates, err := s.RecalculateDays(ctx, userID, starts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return action, dates, nil
+}
+
+// RecalculateDays recalculates time entries on each distinct UTC day of the
+// given event start times. Returns the days, sorted.
+func (s *Service) RecalculateDays(ctx context.Context, userID uuid.UUID, starts []time.Time) ([]time.Time, error) {
+	seen := make(map[time.Time]bool)
+	var dates []time.Time
+	for _, start := range starts {
+		date := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		if seen[date] {
+			continue
+		}
+		seen[date] = true
+		dates = append(dates, date)
+		if err := s.RecalculateTimeEntries(ctx, userID, date); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	return dates, nil
 }
 
 // ApplyResult contains the results of applying rules
 type ApplyResult struct {
 	Classified  []*ClassifiedEvent `json:"classified"`
 	SkipApplied []*SkippedEvent    `json:"skip_applied"`
-	Skipped     int                `json:"skipped"` // Events with no matching project rules
+	Skipped     int                `json:"skipped"`             // Events with no matching project rules
+	ActionID    *uuid.UUID         `json:"action_id,omitempty"` // Journal entry for undo, when events changed
+	Progress    ApplyProgress      `json:"-"`                   // Tally, complete even when CountOnly drops the lists
 }
 
 // SkippedEvent represents an event that was marked as skipped by skip rules
@@ -441,8 +803,9 @@ func storeRulesToAttendanceRules(storeRules []*store.ClassificationRule) []Rule
 	return rules
 }
 
-// eventToItem converts a CalendarEvent to a library Item
-func eventToItem(event *store.CalendarEvent) Item {
+// eventToItem converts a CalendarEvent to a library Item, adding the other
+// emails of its attendees from aliases
+func eventToItem(event *store.CalendarEvent, aliases Aliases) Item {
 	attrs := make(map[string]any)
 
 	attrs["title"] = event.Title
@@ -463,13 +826,28 @@ func eventToItem(event *store.CalendarEvent) Item {
 	}
 
 	if event.Attendees != nil {
-		attrs["attendees"] = event.Attendees
+		attrs["attendees"] = aliases.Expand(event.Attendees)
 	}
 
 	if event.CalendarName != nil {
 		attrs["calendar_name"] = *event.CalendarName
 	}
 
+	if len(event.Tags) > 0 {
+		attrs["tags"] = event.Tags
+	}
+
+	if event.IsInternal != nil {
+		attrs["is_internal"] = *event.IsInternal
+	}
+
+	if event.CalendarDefaultProjectID != nil {
+		attrs["calendar_default_project"] = event.CalendarDefaultProjectID.String()
+		if event.CalendarDefaultProjectWeight != nil {
+			attrs["calendar_default_weight"] = *event.CalendarDefaultProjectWeight
+		}
+	}
+
 	return Item{
 		ID:         event.ID.String(),
 		Attributes: attrs,
@@ -564,6 +942,11 @@ func (s *Service) RecalculateTimeEntriesForEvent(ctx context.Context, userID uui
 	return s.timeEntryService.RecalculateForEvent(ctx, userID, event)
 }
 
+// ExplainTimeEntry explains how an entry's hours were derived from its events.
+func (s *Service) ExplainTimeEntry(ctx context.Context, userID uuid.UUID, entry *store.TimeEntry) (*timeentry.Explanation, error) {
+	return s.timeEntryService.Explain(ctx, userID, entry)
+}
+
 // ExplainEventClassification evaluates all rules against an event and returns
 // detailed information showing which rules matched and how scores were calculated.
 // This is useful for debugging classification decisions.
@@ -575,21 +958,25 @@ func (s *Service) ExplainEventClassification(ctx context.Context, userID uuid.UU
 	}
 
 	// Get all enabled rules for the user
-	storeRules, err := s.ruleStore.List(ctx, userID, false)
+	storeRules, err := s.rules.Rules(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.aliasesFor(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert to library types
-	rules := storeRulesToLibraryRules(storeRules)
-	item := eventToItem(event)
+	item := eventToItem(event, aliases)
 
 	// Use pure classifier explain function for project rules
-	result := ExplainClassification(rules, targets, item, DefaultConfig())
+	result := Compile(storeRulesToLibraryRules(storeRules), targets).Explain(item, DefaultConfig())
 
 	// Also evaluate skip rules
-	skipRules := storeRulesToAttendanceRules(storeRules)
-	skipResults := ClassifyAttendance(skipRules, []Item{item}, DefaultConfig())
+	skipRules := Compile(storeRulesToAttendanceRules(storeRules), nil)
+	skipResults := skipRules.ClassifyAttendance([]Item{item}, DefaultConfig())
 
 	// Add skip rule info to result
 	if len(skipResults) > 0 && !skipResults[0].Attended {
@@ -598,22 +985,16 @@ func (s *Service) ExplainEventClassification(ctx context.Context, userID uuid.UU
 		result.SkipConfidence = skipResults[0].Confidence
 	}
 
-	// Add skip rule evaluations
-	for _, r := range storeRules {
-		// Only include attendance rules
-		if r.Attended == nil {
-			continue
-		}
-		// Parse and evaluate the rule
-		ast, err := Parse(r.Query)
-		if err != nil {
+	// Add skip rule evaluations, reusing the compiled attendance rules
+	props := itemToProperties(item)
+	for _, r := range skipRules.Rules {
+		if r.Err != nil {
 			continue
 		}
-		props := itemToProperties(item)
-		matched := Evaluate(ast, props)
+		matched := r.Matches(props)
 
 		eval := RuleEvaluation{
-			RuleID:   r.ID.String(),
+			RuleID:   r.ID,
 			Query:    r.Query,
 			TargetID: "skip",
 			Matched:  matched,
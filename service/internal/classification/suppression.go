@@ -0,0 +1,92 @@
+package classification
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// suppressionMatcher is a suppression rule with its query parsed
+type suppressionMatcher struct {
+	calendarID *uuid.UUID
+	query      QueryNode
+}
+
+// matches reports whether the rule suppresses the event. A rule without a
+// query matches every event in its calendar.
+func (m suppressionMatcher) matches(event *store.CalendarEvent) bool {
+	if m.calendarID != nil && (event.CalendarID == nil || *event.CalendarID != *m.calendarID) {
+		return false
+	}
+	if m.query == nil {
+		return true
+	}
+	return EvaluateExtended(m.query, eventToExtendedProperties(event))
+}
+
+// ApplySuppressionRules re-evaluates the user's enabled suppression rules
+// against their pending events, optionally for a single calendar. Events that
+// now match are suppressed and events that no longer match are released back
+// into the pending queue; events overridden by hand are left alone.
+func (s *Service) ApplySuppressionRules(ctx context.Context, userID uuid.UUID, calendarID *uuid.UUID) (suppressed, released int, err error) {
+	if s.suppressionStore == nil {
+		return 0, 0, nil
+	}
+
+	rules, err := s.suppressionStore.List(ctx, userID, false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	matchers := make([]suppressionMatcher, 0, len(rules))
+	for _, r := range rules {
+		m := suppressionMatcher{calendarID: r.CalendarID}
+		if r.Query != nil && *r.Query != "" {
+			node, err := Parse(*r.Query)
+			if err != nil {
+				log.Printf("Skipping suppression rule %s with invalid query: %v", r.ID, err)
+				continue
+			}
+			m.query = node
+		}
+		matchers = append(matchers, m)
+	}
+
+	events, err := s.eventStore.ListForSuppression(ctx, userID, calendarID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var toSuppress, toRelease []uuid.UUID
+	for _, event := range events {
+		match := false
+		for _, m := range matchers {
+			if m.matches(event) {
+				match = true
+				break
+			}
+		}
+		switch {
+		case match && !event.IsSuppressed:
+			toSuppress = append(toSuppress, event.ID)
+		case !match && event.IsSuppressed:
+			toRelease = append(toRelease, event.ID)
+		}
+	}
+
+	n, err := s.eventStore.SetSuppressed(ctx, userID, toSuppress, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	suppressed = int(n)
+
+	n, err = s.eventStore.SetSuppressed(ctx, userID, toRelease, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	released = int(n)
+
+	return suppressed, released, nil
+}
@@ -0,0 +1,68 @@
+package classification
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestSuppressionMatcher(t *testing.T) {
+	noisy := uuid.New()
+	other := uuid.New()
+	transparent := "transparent"
+
+	mustParse := func(q string) QueryNode {
+		node, err := Parse(q)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", q, err)
+		}
+		return node
+	}
+
+	tests := []struct {
+		name    string
+		matcher suppressionMatcher
+		event   *store.CalendarEvent
+		want    bool
+	}{
+		{
+			name:    "calendar only matches everything in the calendar",
+			matcher: suppressionMatcher{calendarID: &noisy},
+			event:   &store.CalendarEvent{CalendarID: &noisy, Title: "Anything"},
+			want:    true,
+		},
+		{
+			name:    "calendar only ignores other calendars",
+			matcher: suppressionMatcher{calendarID: &noisy},
+			event:   &store.CalendarEvent{CalendarID: &other, Title: "Anything"},
+			want:    false,
+		},
+		{
+			name:    "query scoped to calendar",
+			matcher: suppressionMatcher{calendarID: &noisy, query: mustParse("transparency:transparent")},
+			event:   &store.CalendarEvent{CalendarID: &noisy, Transparency: &transparent},
+			want:    true,
+		},
+		{
+			name:    "query scoped to calendar ignores opaque events",
+			matcher: suppressionMatcher{calendarID: &noisy, query: mustParse("transparency:transparent")},
+			event:   &store.CalendarEvent{CalendarID: &noisy},
+			want:    false,
+		},
+		{
+			name:    "query without calendar applies everywhere",
+			matcher: suppressionMatcher{query: mustParse("title:focus")},
+			event:   &store.CalendarEvent{CalendarID: &other, Title: "Focus time"},
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.matcher.matches(tt.event); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hkdf"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"io"
@@ -16,6 +18,7 @@ var (
 
 // EncryptionService provides AES-256-GCM encryption for sensitive data
 type EncryptionService struct {
+	key []byte
 	gcm cipher.AEAD
 }
 
@@ -25,7 +28,10 @@ func NewEncryptionService(keyHex string) (*EncryptionService, error) {
 	if err != nil || len(key) != 32 {
 		return nil, ErrInvalidKey
 	}
+	return newEncryptionService(key)
+}
 
+func newEncryptionService(key []byte) (*EncryptionService, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
@@ -36,7 +42,19 @@ func NewEncryptionService(keyHex string) (*EncryptionService, error) {
 		return nil, err
 	}
 
-	return &EncryptionService{gcm: gcm}, nil
+	return &EncryptionService{key: key, gcm: gcm}, nil
+}
+
+// ForScope returns a service whose key is derived from this one for the
+// scope (e.g. a user ID) with HKDF-SHA256. Data encrypted for one scope
+// cannot be decrypted with another's key, so a ciphertext copied between
+// users' rows fails to decrypt.
+func (s *EncryptionService) ForScope(scope string) (*EncryptionService, error) {
+	key, err := hkdf.Key(sha256.New, s.key, nil, "timesheet:"+scope, 32)
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptionService(key)
 }
 
 // Encrypt encrypts plaintext and returns ciphertext with nonce prepended
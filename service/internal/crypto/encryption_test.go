@@ -0,0 +1,38 @@
+package crypto
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestForScope(t *testing.T) {
+	svc, err := NewEncryptionService(strings.Repeat("ab", 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alice, err := svc.ForScope("user:alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, _ := svc.ForScope("user:bob")
+
+	ciphertext, err := alice.Encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	again, _ := svc.ForScope("user:alice")
+	plaintext, err := again.Decrypt(ciphertext)
+	if err != nil || !bytes.Equal(plaintext, []byte("secret")) {
+		t.Errorf("Decrypt with the same scope = %q, %v", plaintext, err)
+	}
+	if _, err := bob.Decrypt(ciphertext); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("Decrypt with another scope: expected ErrDecryptionFailed, got %v", err)
+	}
+	if _, err := svc.Decrypt(ciphertext); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("Decrypt with the master key: expected ErrDecryptionFailed, got %v", err)
+	}
+}
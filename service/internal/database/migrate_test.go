@@ -0,0 +1,67 @@
+package database
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadMigrations_Embedded(t *testing.T) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("expected embedded migrations")
+	}
+	if migrations[0].Name != "initial_schema" {
+		t.Errorf("expected initial_schema first, got %s", migrations[0].Name)
+	}
+}
+
+func TestParseMigrations_Validation(t *testing.T) {
+	file := func(s string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(s)} }
+
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+		match string
+	}{
+		{"bad name", fstest.MapFS{"migrations/1-init.up.sql": file("x")}, "invalid migration file name"},
+		{"missing down", fstest.MapFS{"migrations/0001_init.up.sql": file("x")}, "needs both up and down"},
+		{"gap", fstest.MapFS{
+			"migrations/0001_init.up.sql":   file("x"),
+			"migrations/0001_init.down.sql": file("x"),
+			"migrations/0003_next.up.sql":   file("x"),
+			"migrations/0003_next.down.sql": file("x"),
+		}, "contiguous"},
+		{"name mismatch", fstest.MapFS{
+			"migrations/0001_init.up.sql":    file("x"),
+			"migrations/0001_other.down.sql": file("x"),
+		}, "different names"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseMigrations(tt.files)
+			if err == nil || !strings.Contains(err.Error(), tt.match) {
+				t.Errorf("expected error containing %q, got %v", tt.match, err)
+			}
+		})
+	}
+}
+
+func TestParseMigrations_Orders(t *testing.T) {
+	files := fstest.MapFS{
+		"migrations/0002_second.up.sql":   {Data: []byte("up 2")},
+		"migrations/0002_second.down.sql": {Data: []byte("down 2")},
+		"migrations/0001_first.up.sql":    {Data: []byte("up 1")},
+		"migrations/0001_first.down.sql":  {Data: []byte("down 1")},
+	}
+	migrations, err := parseMigrations(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 || migrations[0].Name != "first" || migrations[1].Down != "down 2" {
+		t.Errorf("unexpected migrations %+v", migrations)
+	}
+}
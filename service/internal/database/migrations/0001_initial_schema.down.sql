@@ -0,0 +1,23 @@
+-- Drops the whole schema. Every user's data is lost.
+
+DROP TABLE IF EXISTS invoice_line_items;
+DROP TABLE IF EXISTS time_entry_events;
+DROP TABLE IF EXISTS time_entries;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS billing_periods;
+DROP TABLE IF EXISTS mcp_access_tokens;
+DROP TABLE IF EXISTS mcp_oauth_sessions;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS classification_overrides;
+DROP TABLE IF EXISTS calendar_events;
+DROP TABLE IF EXISTS classification_rules;
+DROP TABLE IF EXISTS calendars;
+DROP TABLE IF EXISTS calendar_connections;
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS users;
+
+DROP FUNCTION IF EXISTS check_billing_period_overlap();
+
+DROP TYPE IF EXISTS invoice_status;
+DROP TYPE IF EXISTS classification_source;
+DROP TYPE IF EXISTS classification_status;
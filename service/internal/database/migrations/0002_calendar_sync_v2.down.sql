@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_calendars_background_sync;
+ALTER TABLE calendars DROP COLUMN needs_reauth;
+ALTER TABLE calendars DROP COLUMN sync_failure_count;
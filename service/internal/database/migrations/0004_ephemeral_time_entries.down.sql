@@ -0,0 +1,2 @@
+ALTER TABLE time_entries DROP COLUMN is_suppressed;
+ALTER TABLE time_entries DROP COLUMN snapshot_computed_hours;
@@ -0,0 +1,22 @@
+-- =============================================================================
+-- EPHEMERAL TIME ENTRIES: Support for computed-on-demand time entries
+-- =============================================================================
+
+-- Snapshot of computed_hours at materialization time (for staleness detection)
+ALTER TABLE time_entries ADD COLUMN snapshot_computed_hours DECIMAL(5,2);
+
+-- Flag for user-suppressed entries (prevents re-computation from recreating)
+ALTER TABLE time_entries ADD COLUMN is_suppressed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Backfill snapshot for existing materialized entries
+UPDATE time_entries
+SET snapshot_computed_hours = computed_hours
+WHERE has_user_edits = true
+  AND snapshot_computed_hours IS NULL
+  AND computed_hours IS NOT NULL;
+
+UPDATE time_entries
+SET snapshot_computed_hours = computed_hours
+WHERE invoice_id IS NOT NULL
+  AND snapshot_computed_hours IS NULL
+  AND computed_hours IS NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE calendar_events DROP COLUMN is_all_day;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_projects_user_short_code_unique;
@@ -0,0 +1,8 @@
+-- =============================================================================
+-- UNIQUE SHORT CODES: Enforce unique project short codes per user
+-- =============================================================================
+
+-- Partial unique index: only applies to non-NULL short codes
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_user_short_code_unique
+ON projects (user_id, short_code)
+WHERE short_code IS NOT NULL;
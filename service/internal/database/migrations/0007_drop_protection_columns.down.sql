@@ -0,0 +1,5 @@
+-- The columns come back with their defaults; the original values are gone.
+
+ALTER TABLE time_entries ADD COLUMN is_pinned BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE time_entries ADD COLUMN is_locked BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE calendar_events ADD COLUMN is_locked BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,3 @@
+-- Data fix only: the entries it marked as user-edited can't be told apart
+-- from real edits, so there is nothing to undo.
+SELECT 1;
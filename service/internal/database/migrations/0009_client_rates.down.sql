@@ -0,0 +1,25 @@
+ALTER TABLE invoice_line_items DROP COLUMN rate_id;
+ALTER TABLE invoice_line_items DROP COLUMN rate_source;
+
+-- Restore the billing period check without the inclusive end dates and
+-- error code
+CREATE OR REPLACE FUNCTION check_billing_period_overlap()
+RETURNS TRIGGER AS $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM billing_periods
+		WHERE project_id = NEW.project_id
+		AND id != COALESCE(NEW.id, '00000000-0000-0000-0000-000000000000'::uuid)
+		AND (
+			(NEW.starts_on, COALESCE(NEW.ends_on, '9999-12-31'::date)) OVERLAPS
+			(starts_on, COALESCE(ends_on, '9999-12-31'::date))
+		)
+	) THEN
+		RAISE EXCEPTION 'Billing periods for project % cannot overlap', NEW.project_id;
+	END IF;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE client_rates;
+DROP FUNCTION IF EXISTS check_client_rate_overlap();
@@ -0,0 +1,82 @@
+-- =============================================================================
+-- CLIENT RATES: Effective-dated hourly rates shared by all projects of a client
+-- Project billing periods take precedence; client rates are the fallback
+-- when a project has no billing period covering a date.
+-- =============================================================================
+
+CREATE TABLE client_rates (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	client TEXT NOT NULL,
+	starts_on DATE NOT NULL,
+	ends_on DATE,
+	hourly_rate DECIMAL(10,2) NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CONSTRAINT client_rate_dates_valid CHECK (ends_on IS NULL OR ends_on >= starts_on)
+);
+
+CREATE INDEX idx_client_rates_user_client ON client_rates(user_id, client, starts_on);
+
+-- Trigger to prevent overlapping client rates
+CREATE OR REPLACE FUNCTION check_client_rate_overlap()
+RETURNS TRIGGER AS $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM client_rates
+		WHERE user_id = NEW.user_id
+		AND client = NEW.client
+		AND id != COALESCE(NEW.id, '00000000-0000-0000-0000-000000000000'::uuid)
+		AND (
+			(NEW.starts_on, COALESCE(NEW.ends_on, '9999-12-31'::date) + 1) OVERLAPS
+			(starts_on, COALESCE(ends_on, '9999-12-31'::date) + 1)
+		)
+	) THEN
+		RAISE EXCEPTION 'Client rates for % cannot overlap', NEW.client
+			USING ERRCODE = 'exclusion_violation';
+	END IF;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER client_rate_overlap_check
+BEFORE INSERT OR UPDATE ON client_rates
+FOR EACH ROW EXECUTE FUNCTION check_client_rate_overlap();
+
+-- Billing periods: raise a recognizable error code so the store can map
+-- overlaps to ErrBillingPeriodOverlap. Inclusive end dates mean a period
+-- ending on the day another starts is also an overlap.
+CREATE OR REPLACE FUNCTION check_billing_period_overlap()
+RETURNS TRIGGER AS $$
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM billing_periods
+		WHERE project_id = NEW.project_id
+		AND id != COALESCE(NEW.id, '00000000-0000-0000-0000-000000000000'::uuid)
+		AND (
+			(NEW.starts_on, COALESCE(NEW.ends_on, '9999-12-31'::date) + 1) OVERLAPS
+			(starts_on, COALESCE(ends_on, '9999-12-31'::date) + 1)
+		)
+	) THEN
+		RAISE EXCEPTION 'Billing periods for project % cannot overlap', NEW.project_id
+			USING ERRCODE = 'exclusion_violation';
+	END IF;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Record which rate record was applied to each line item. The rate itself
+-- is already snapshotted in hourly_rate; rate_id is deliberately not a
+-- foreign key so the audit trail survives edits to the rate tables.
+ALTER TABLE invoice_line_items ADD COLUMN rate_source TEXT NOT NULL DEFAULT 'none';
+ALTER TABLE invoice_line_items ADD COLUMN rate_id UUID;
+
+-- Backfill from the billing period that covers each existing line item
+UPDATE invoice_line_items ili
+SET rate_source = 'project', rate_id = bp.id
+FROM invoices i
+JOIN billing_periods bp ON bp.project_id = i.project_id
+WHERE ili.invoice_id = i.id
+  AND bp.starts_on <= ili.date
+  AND (bp.ends_on IS NULL OR bp.ends_on >= ili.date)
+  AND bp.hourly_rate = ili.hourly_rate;
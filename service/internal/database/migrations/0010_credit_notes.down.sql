@@ -0,0 +1,10 @@
+-- Fails while credit notes or adjustment line items exist; remove them first.
+
+ALTER TABLE invoice_line_items DROP CONSTRAINT invoice_line_item_kind_valid;
+ALTER TABLE invoice_line_items ALTER COLUMN time_entry_id SET NOT NULL;
+ALTER TABLE invoice_line_items DROP COLUMN kind;
+
+DROP INDEX IF EXISTS idx_invoices_original_invoice_id;
+ALTER TABLE invoices DROP CONSTRAINT invoice_kind_valid;
+ALTER TABLE invoices DROP COLUMN original_invoice_id;
+ALTER TABLE invoices DROP COLUMN kind;
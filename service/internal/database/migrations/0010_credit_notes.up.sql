@@ -0,0 +1,22 @@
+-- =============================================================================
+-- CREDIT NOTES AND ADJUSTMENTS
+-- A credit note is an invoice row (kind = 'credit_note') that references the
+-- invoice it corrects. Adjustment line items carry a fixed amount (possibly
+-- negative) and are not linked to a time entry.
+-- =============================================================================
+
+ALTER TABLE invoices ADD COLUMN kind TEXT NOT NULL DEFAULT 'invoice';
+ALTER TABLE invoices ADD COLUMN original_invoice_id UUID REFERENCES invoices(id) ON DELETE RESTRICT;
+ALTER TABLE invoices ADD CONSTRAINT invoice_kind_valid CHECK (
+	(kind = 'invoice' AND original_invoice_id IS NULL) OR
+	(kind = 'credit_note' AND original_invoice_id IS NOT NULL)
+);
+
+CREATE INDEX idx_invoices_original_invoice_id ON invoices(original_invoice_id) WHERE original_invoice_id IS NOT NULL;
+
+ALTER TABLE invoice_line_items ADD COLUMN kind TEXT NOT NULL DEFAULT 'time';
+ALTER TABLE invoice_line_items ALTER COLUMN time_entry_id DROP NOT NULL;
+ALTER TABLE invoice_line_items ADD CONSTRAINT invoice_line_item_kind_valid CHECK (
+	(kind = 'time' AND time_entry_id IS NOT NULL) OR
+	(kind = 'adjustment' AND time_entry_id IS NULL)
+);
@@ -0,0 +1,17 @@
+-- =============================================================================
+-- PAYMENTS: Partial and full payments recorded against issued invoices
+-- =============================================================================
+
+CREATE TABLE payments (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE RESTRICT,
+	amount DECIMAL(10,2) NOT NULL CHECK (amount > 0),
+	paid_on DATE NOT NULL,
+	method TEXT,
+	reference TEXT,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_payments_user_id ON payments(user_id);
+CREATE INDEX idx_payments_invoice_id ON payments(invoice_id);
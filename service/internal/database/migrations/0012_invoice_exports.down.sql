@@ -0,0 +1,15 @@
+-- Sheets exports move back onto their invoices; CSV and PDF artifacts are
+-- dropped.
+
+ALTER TABLE invoices ADD COLUMN spreadsheet_id TEXT;
+ALTER TABLE invoices ADD COLUMN spreadsheet_url TEXT;
+ALTER TABLE invoices ADD COLUMN worksheet_id INTEGER;
+
+UPDATE invoices i
+SET spreadsheet_id = e.external_id,
+    spreadsheet_url = e.external_url,
+    worksheet_id = (e.metadata->>'worksheet_id')::integer
+FROM invoice_exports e
+WHERE e.invoice_id = i.id AND e.format = 'sheets';
+
+DROP TABLE invoice_exports;
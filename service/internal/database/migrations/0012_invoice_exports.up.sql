@@ -0,0 +1,36 @@
+-- =============================================================================
+-- INVOICE EXPORTS: One artifact per invoice and format (csv, pdf, sheets)
+-- Replaces the Sheets-specific columns on invoices. File formats keep their
+-- rendered content; external formats keep the remote ID and URL.
+-- =============================================================================
+
+CREATE TABLE invoice_exports (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+	format TEXT NOT NULL,
+	content_type TEXT NOT NULL,
+	filename TEXT NOT NULL,
+	content BYTEA,
+	external_id TEXT,
+	external_url TEXT,
+	metadata JSONB NOT NULL DEFAULT '{}',
+	exported_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE(invoice_id, format)
+);
+
+CREATE INDEX idx_invoice_exports_user_id ON invoice_exports(user_id);
+
+INSERT INTO invoice_exports (user_id, invoice_id, format, content_type, filename,
+                             external_id, external_url, metadata, exported_at)
+SELECT user_id, id, 'sheets', 'application/vnd.google-apps.spreadsheet', invoice_number,
+       spreadsheet_id, spreadsheet_url,
+       CASE WHEN worksheet_id IS NULL THEN '{}'::jsonb
+            ELSE jsonb_build_object('worksheet_id', worksheet_id) END,
+       updated_at
+FROM invoices
+WHERE spreadsheet_id IS NOT NULL;
+
+ALTER TABLE invoices DROP COLUMN spreadsheet_id;
+ALTER TABLE invoices DROP COLUMN spreadsheet_url;
+ALTER TABLE invoices DROP COLUMN worksheet_id;
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS calendars_synced_notify ON calendars;
+DROP TRIGGER IF EXISTS calendar_events_classified_notify ON calendar_events;
+DROP TRIGGER IF EXISTS time_entries_notify ON time_entries;
+DROP FUNCTION IF EXISTS notify_timesheet_change();
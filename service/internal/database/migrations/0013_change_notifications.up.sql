@@ -0,0 +1,45 @@
+-- =============================================================================
+-- CHANGE NOTIFICATIONS: pg_notify on the timesheet_changes channel so the
+-- API can push updates to connected clients instead of being polled.
+-- Payload: {"type", "op", "user_id", "id"}
+-- =============================================================================
+
+CREATE OR REPLACE FUNCTION notify_timesheet_change()
+RETURNS TRIGGER AS $$
+DECLARE
+	rec RECORD;
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		rec := OLD;
+	ELSE
+		rec := NEW;
+	END IF;
+	PERFORM pg_notify('timesheet_changes', json_build_object(
+		'type', TG_ARGV[0],
+		'op', lower(TG_OP),
+		'user_id', rec.user_id,
+		'id', rec.id
+	)::text);
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER time_entries_notify
+	AFTER INSERT OR UPDATE OR DELETE ON time_entries
+	FOR EACH ROW EXECUTE FUNCTION notify_timesheet_change('time_entry');
+
+-- Only classification changes; inserts during sync are covered by sync_completed
+CREATE TRIGGER calendar_events_classified_notify
+	AFTER UPDATE ON calendar_events
+	FOR EACH ROW
+	WHEN (OLD.classification_status IS DISTINCT FROM NEW.classification_status
+	   OR OLD.project_id IS DISTINCT FROM NEW.project_id
+	   OR OLD.is_skipped IS DISTINCT FROM NEW.is_skipped
+	   OR OLD.needs_review IS DISTINCT FROM NEW.needs_review)
+	EXECUTE FUNCTION notify_timesheet_change('event_classified');
+
+CREATE TRIGGER calendars_synced_notify
+	AFTER UPDATE ON calendars
+	FOR EACH ROW
+	WHEN (OLD.last_synced_at IS DISTINCT FROM NEW.last_synced_at)
+	EXECUTE FUNCTION notify_timesheet_change('sync_completed');
@@ -0,0 +1,19 @@
+-- =============================================================================
+-- IDEMPOTENCY KEYS: Stored responses for retried create requests (24h)
+-- A row with NULL status is a request still in progress.
+-- =============================================================================
+
+CREATE TABLE idempotency_keys (
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	key TEXT NOT NULL,
+	method TEXT NOT NULL,
+	path TEXT NOT NULL,
+	request_hash TEXT NOT NULL,
+	status_code INT,
+	content_type TEXT,
+	response_body BYTEA,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys(created_at);
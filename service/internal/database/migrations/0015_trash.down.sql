@@ -0,0 +1,9 @@
+-- Trashed rows are deleted for good rather than coming back to life.
+
+DELETE FROM time_entries WHERE deleted_at IS NOT NULL;
+DELETE FROM classification_rules WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_classification_rules_deleted_at;
+DROP INDEX IF EXISTS idx_time_entries_deleted_at;
+ALTER TABLE classification_rules DROP COLUMN deleted_at;
+ALTER TABLE time_entries DROP COLUMN deleted_at;
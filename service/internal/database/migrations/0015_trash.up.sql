@@ -0,0 +1,11 @@
+-- =============================================================================
+-- TRASH: Soft deletion for user-deleted time entries and rules
+-- Trashed rows keep their unique (user, project, date) slot for time entries;
+-- creating an entry in that slot replaces the trashed one.
+-- =============================================================================
+
+ALTER TABLE time_entries ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE classification_rules ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_time_entries_deleted_at ON time_entries(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_classification_rules_deleted_at ON classification_rules(deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP TABLE classification_action_events;
+DROP TABLE classification_actions;
@@ -0,0 +1,30 @@
+-- =============================================================================
+-- CLASSIFICATION ACTIONS: Journal of classify, bulk classify and apply rules
+-- runs, with each event's prior state so an action can be undone
+-- =============================================================================
+
+CREATE TABLE classification_actions (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	kind TEXT NOT NULL CHECK (kind IN ('classify', 'bulk_classify', 'apply_rules')),
+	description TEXT NOT NULL,
+	event_count INT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	undone_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_classification_actions_user_created ON classification_actions(user_id, created_at DESC);
+
+CREATE TABLE classification_action_events (
+	action_id UUID NOT NULL REFERENCES classification_actions(id) ON DELETE CASCADE,
+	event_id UUID NOT NULL REFERENCES calendar_events(id) ON DELETE CASCADE,
+	prev_project_id UUID REFERENCES projects(id) ON DELETE SET NULL,
+	prev_status classification_status NOT NULL,
+	prev_source classification_source,
+	prev_confidence FLOAT,
+	prev_needs_review BOOLEAN NOT NULL,
+	prev_skipped BOOLEAN NOT NULL,
+	PRIMARY KEY (action_id, event_id)
+);
+
+CREATE INDEX idx_classification_action_events_event ON classification_action_events(event_id);
@@ -0,0 +1,2 @@
+DROP TABLE classification_snapshot_events;
+DROP TABLE classification_snapshots;
@@ -0,0 +1,24 @@
+-- =============================================================================
+-- CLASSIFICATION SNAPSHOTS: Week-scoped checkpoints of event classification
+-- that can be restored after bulk changes
+-- =============================================================================
+
+CREATE TABLE classification_snapshots (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	week_start DATE NOT NULL,
+	label TEXT,
+	event_count INT NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_classification_snapshots_user_week ON classification_snapshots(user_id, week_start, created_at DESC);
+
+CREATE TABLE classification_snapshot_events (
+	snapshot_id UUID NOT NULL REFERENCES classification_snapshots(id) ON DELETE CASCADE,
+	event_id UUID NOT NULL REFERENCES calendar_events(id) ON DELETE CASCADE,
+	project_id UUID REFERENCES projects(id) ON DELETE SET NULL,
+	source classification_source,
+	skipped BOOLEAN NOT NULL,
+	PRIMARY KEY (snapshot_id, event_id)
+);
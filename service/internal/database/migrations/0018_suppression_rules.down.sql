@@ -0,0 +1,2 @@
+ALTER TABLE calendar_events DROP COLUMN suppression_overridden;
+DROP TABLE suppression_rules;
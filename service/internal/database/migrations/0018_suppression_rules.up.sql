@@ -0,0 +1,21 @@
+-- =============================================================================
+-- SUPPRESSION RULES: Keep noisy calendars and matching events out of the
+-- pending queue. A rule matches by calendar, by query, or both.
+-- =============================================================================
+
+CREATE TABLE suppression_rules (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	calendar_id UUID REFERENCES calendars(id) ON DELETE CASCADE,
+	query TEXT,
+	is_enabled BOOLEAN NOT NULL DEFAULT true,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (calendar_id IS NOT NULL OR query IS NOT NULL)
+);
+
+CREATE INDEX idx_suppression_rules_user ON suppression_rules(user_id);
+
+-- Set when the user suppresses or unsuppresses an event by hand, so
+-- rules no longer change it
+ALTER TABLE calendar_events ADD COLUMN suppression_overridden BOOLEAN NOT NULL DEFAULT false;
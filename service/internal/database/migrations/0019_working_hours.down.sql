@@ -0,0 +1 @@
+DROP TABLE working_hours;
@@ -0,0 +1,10 @@
+-- =============================================================================
+-- WORKING HOURS: Per-user weekly capacity used for utilization reporting
+-- =============================================================================
+
+CREATE TABLE working_hours (
+	user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	-- Hours available each weekday, Monday first
+	daily_hours NUMERIC(4,2)[] NOT NULL CHECK (array_length(daily_hours, 1) = 7),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1 @@
+DROP TABLE day_anomalies;
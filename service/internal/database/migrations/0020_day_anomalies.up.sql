@@ -0,0 +1,25 @@
+-- =============================================================================
+-- DAY ANOMALIES: Suspicious daily totals flagged by the scheduled analysis
+-- =============================================================================
+
+CREATE TABLE day_anomalies (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	date DATE NOT NULL,
+	kind TEXT NOT NULL CHECK (kind IN ('excessive_hours', 'missing_hours', 'unusual_total')),
+	message TEXT NOT NULL,
+	hours NUMERIC(5,2) NOT NULL,
+	baseline_hours NUMERIC(5,2),
+	event_count INTEGER,
+	detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	dismissed_at TIMESTAMPTZ,
+	-- A dismissed anomaly stays dismissed when the analysis runs again
+	UNIQUE (user_id, date, kind)
+);
+
+CREATE INDEX idx_day_anomalies_user_date ON day_anomalies(user_id, date);
+
+-- Only new anomalies are pushed to clients
+CREATE TRIGGER day_anomalies_notify
+	AFTER INSERT ON day_anomalies
+	FOR EACH ROW EXECUTE FUNCTION notify_timesheet_change('anomaly');
@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS calendars_daily_hours ON calendars;
+DROP TRIGGER IF EXISTS projects_daily_hours ON projects;
+DROP TRIGGER IF EXISTS calendar_events_daily_hours_delete ON calendar_events;
+DROP TRIGGER IF EXISTS calendar_events_daily_hours_update ON calendar_events;
+DROP TRIGGER IF EXISTS calendar_events_daily_hours_insert ON calendar_events;
+DROP TRIGGER IF EXISTS time_entries_daily_hours ON time_entries;
+
+DROP FUNCTION IF EXISTS calendars_mark_daily_hours();
+DROP FUNCTION IF EXISTS projects_mark_daily_hours();
+DROP FUNCTION IF EXISTS calendar_events_mark_daily_hours();
+DROP FUNCTION IF EXISTS time_entries_mark_daily_hours();
+DROP FUNCTION IF EXISTS mark_daily_hours_stale(UUID, DATE);
+
+DROP TABLE daily_project_hours_state;
+DROP TABLE daily_project_hours;
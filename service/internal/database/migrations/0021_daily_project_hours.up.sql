@@ -0,0 +1,130 @@
+-- =============================================================================
+-- DAILY PROJECT HOURS: Precomputed per-day, per-project totals for reporting
+-- =============================================================================
+
+-- Totals match what ListTimeEntries returns: materialized entries plus the
+-- hours computed from classified events, with suppressed entries left out
+CREATE TABLE daily_project_hours (
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+	date DATE NOT NULL,
+	hours NUMERIC(6,2) NOT NULL,
+	PRIMARY KEY (user_id, date, project_id)
+);
+
+-- A day's totals are current while refreshed_generation = generation. Writes
+-- that can change a day bump its generation; the aggregate service recomputes
+-- stale days before reading them.
+CREATE TABLE daily_project_hours_state (
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	date DATE NOT NULL,
+	generation BIGINT NOT NULL DEFAULT 0,
+	refreshed_generation BIGINT,
+	refreshed_at TIMESTAMPTZ,
+	PRIMARY KEY (user_id, date)
+);
+
+CREATE OR REPLACE FUNCTION mark_daily_hours_stale(p_user_id UUID, p_date DATE)
+RETURNS VOID AS $$
+BEGIN
+	INSERT INTO daily_project_hours_state (user_id, date, generation)
+	VALUES (p_user_id, p_date, 1)
+	ON CONFLICT (user_id, date) DO UPDATE
+	SET generation = daily_project_hours_state.generation + 1;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION time_entries_mark_daily_hours()
+RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP <> 'INSERT' THEN
+		PERFORM mark_daily_hours_stale(OLD.user_id, OLD.date);
+	END IF;
+	IF TG_OP <> 'DELETE' AND (TG_OP = 'INSERT' OR NEW.date IS DISTINCT FROM OLD.date) THEN
+		PERFORM mark_daily_hours_stale(NEW.user_id, NEW.date);
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER time_entries_daily_hours
+	AFTER INSERT OR UPDATE OR DELETE ON time_entries
+	FOR EACH ROW EXECUTE FUNCTION time_entries_mark_daily_hours();
+
+CREATE OR REPLACE FUNCTION calendar_events_mark_daily_hours()
+RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP <> 'INSERT' AND OLD.classification_status = 'classified' THEN
+		PERFORM mark_daily_hours_stale(OLD.user_id, (OLD.start_time AT TIME ZONE 'UTC')::date);
+	END IF;
+	IF TG_OP <> 'DELETE' AND NEW.classification_status = 'classified' THEN
+		PERFORM mark_daily_hours_stale(NEW.user_id, (NEW.start_time AT TIME ZONE 'UTC')::date);
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Pending events don't count towards hours, so sync inserts are skipped
+CREATE TRIGGER calendar_events_daily_hours_insert
+	AFTER INSERT ON calendar_events
+	FOR EACH ROW
+	WHEN (NEW.classification_status = 'classified')
+	EXECUTE FUNCTION calendar_events_mark_daily_hours();
+
+CREATE TRIGGER calendar_events_daily_hours_update
+	AFTER UPDATE ON calendar_events
+	FOR EACH ROW
+	WHEN (OLD.classification_status IS DISTINCT FROM NEW.classification_status
+	   OR OLD.project_id IS DISTINCT FROM NEW.project_id
+	   OR OLD.is_skipped IS DISTINCT FROM NEW.is_skipped
+	   OR OLD.is_orphaned IS DISTINCT FROM NEW.is_orphaned
+	   OR OLD.start_time IS DISTINCT FROM NEW.start_time
+	   OR OLD.end_time IS DISTINCT FROM NEW.end_time
+	   OR OLD.title IS DISTINCT FROM NEW.title)
+	EXECUTE FUNCTION calendar_events_mark_daily_hours();
+
+CREATE TRIGGER calendar_events_daily_hours_delete
+	AFTER DELETE ON calendar_events
+	FOR EACH ROW
+	WHEN (OLD.classification_status = 'classified')
+	EXECUTE FUNCTION calendar_events_mark_daily_hours();
+
+-- Project and calendar settings that filter events affect every day with a
+-- classified event they cover
+CREATE OR REPLACE FUNCTION projects_mark_daily_hours()
+RETURNS TRIGGER AS $$
+BEGIN
+	PERFORM mark_daily_hours_stale(d.user_id, d.date)
+	FROM (
+		SELECT DISTINCT user_id, (start_time AT TIME ZONE 'UTC')::date AS date
+		FROM calendar_events
+		WHERE project_id = NEW.id AND classification_status = 'classified'
+	) d;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER projects_daily_hours
+	AFTER UPDATE ON projects
+	FOR EACH ROW
+	WHEN (OLD.does_not_accumulate_hours IS DISTINCT FROM NEW.does_not_accumulate_hours)
+	EXECUTE FUNCTION projects_mark_daily_hours();
+
+CREATE OR REPLACE FUNCTION calendars_mark_daily_hours()
+RETURNS TRIGGER AS $$
+BEGIN
+	PERFORM mark_daily_hours_stale(d.user_id, d.date)
+	FROM (
+		SELECT DISTINCT user_id, (start_time AT TIME ZONE 'UTC')::date AS date
+		FROM calendar_events
+		WHERE calendar_id = NEW.id AND classification_status = 'classified'
+	) d;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER calendars_daily_hours
+	AFTER UPDATE ON calendars
+	FOR EACH ROW
+	WHEN (OLD.is_selected IS DISTINCT FROM NEW.is_selected)
+	EXECUTE FUNCTION calendars_mark_daily_hours();
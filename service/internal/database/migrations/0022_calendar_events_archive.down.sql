@@ -0,0 +1,19 @@
+-- Move archived events back so nothing is lost. Events synced again since
+-- they were archived keep their live copy.
+INSERT INTO calendar_events (
+	id, connection_id, calendar_id, user_id, external_id, title, description,
+	start_time, end_time, attendees, is_recurring, is_all_day, response_status,
+	transparency, is_orphaned, is_suppressed, suppression_overridden, is_skipped,
+	classification_status, classification_source, classification_confidence,
+	classification_rule_id, needs_review, project_id, created_at, updated_at
+)
+SELECT
+	id, connection_id, calendar_id, user_id, external_id, title, description,
+	start_time, end_time, attendees, is_recurring, is_all_day, response_status,
+	transparency, is_orphaned, is_suppressed, suppression_overridden, is_skipped,
+	classification_status, classification_source, classification_confidence,
+	classification_rule_id, needs_review, project_id, created_at, updated_at
+FROM calendar_events_archive
+ON CONFLICT DO NOTHING;
+
+DROP TABLE calendar_events_archive;
@@ -0,0 +1,41 @@
+-- =============================================================================
+-- CALENDAR EVENTS ARCHIVE: Old events moved out of the live table so range
+-- queries over recent weeks stay fast. Archived events are read-only and are
+-- unioned back in by CalendarEventStore.List for historical ranges.
+-- =============================================================================
+
+CREATE TABLE calendar_events_archive (
+	id UUID PRIMARY KEY,
+	connection_id UUID NOT NULL REFERENCES calendar_connections(id) ON DELETE CASCADE,
+	calendar_id UUID REFERENCES calendars(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	external_id TEXT NOT NULL,
+	title TEXT NOT NULL,
+	description TEXT,
+	start_time TIMESTAMPTZ NOT NULL,
+	end_time TIMESTAMPTZ NOT NULL,
+	attendees JSONB DEFAULT '[]',
+	is_recurring BOOLEAN NOT NULL DEFAULT false,
+	is_all_day BOOLEAN NOT NULL DEFAULT false,
+	response_status TEXT,
+	transparency TEXT,
+	is_orphaned BOOLEAN NOT NULL DEFAULT false,
+	is_suppressed BOOLEAN NOT NULL DEFAULT false,
+	suppression_overridden BOOLEAN NOT NULL DEFAULT false,
+	is_skipped BOOLEAN NOT NULL DEFAULT false,
+	classification_status classification_status NOT NULL DEFAULT 'pending',
+	classification_source classification_source,
+	classification_confidence FLOAT,
+	classification_rule_id UUID REFERENCES classification_rules(id) ON DELETE SET NULL,
+	needs_review BOOLEAN NOT NULL DEFAULT false,
+	project_id UUID REFERENCES projects(id) ON DELETE SET NULL,
+	created_at TIMESTAMPTZ NOT NULL,
+	updated_at TIMESTAMPTZ NOT NULL,
+	archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	-- An event synced again after it was archived replaces the archived copy
+	-- the next time it is archived
+	UNIQUE(connection_id, external_id)
+);
+
+CREATE INDEX idx_calendar_events_archive_user_start ON calendar_events_archive(user_id, start_time);
+CREATE INDEX idx_calendar_events_archive_calendar_id ON calendar_events_archive(calendar_id);
@@ -0,0 +1,27 @@
+-- =============================================================================
+-- EVENT ATTENDEES: One row per attendee so domain: and email: queries can use
+-- an index instead of scanning the attendees JSON. The JSON column stays the
+-- source for display; this table is rewritten on every sync upsert.
+-- =============================================================================
+
+CREATE TABLE event_attendees (
+	event_id UUID NOT NULL REFERENCES calendar_events(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	email TEXT NOT NULL,  -- Lowercased address
+	domain TEXT NOT NULL, -- Lowercased part after @, empty if none
+	response_status TEXT, -- accepted, declined, needsAction, tentative
+	is_organizer BOOLEAN NOT NULL DEFAULT false,
+	PRIMARY KEY (event_id, email)
+);
+
+CREATE INDEX idx_event_attendees_domain ON event_attendees(user_id, domain);
+CREATE INDEX idx_event_attendees_email ON event_attendees(user_id, email);
+
+-- Backfill from the JSON column. Response and organizer details were never
+-- stored, so they fill in as events are synced again.
+INSERT INTO event_attendees (event_id, user_id, email, domain)
+SELECT DISTINCT ON (ce.id, lower(btrim(a.email)))
+	ce.id, ce.user_id, lower(btrim(a.email)), split_part(lower(btrim(a.email)), '@', 2)
+FROM calendar_events ce
+CROSS JOIN LATERAL jsonb_array_elements_text(ce.attendees) AS a(email)
+WHERE jsonb_typeof(ce.attendees) = 'array' AND btrim(a.email) <> '';
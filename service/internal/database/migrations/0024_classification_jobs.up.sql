@@ -0,0 +1,26 @@
+-- =============================================================================
+-- CLASSIFICATION JOBS: Background apply-rules runs over large date ranges,
+-- processed in batches with progress recorded after each batch
+-- =============================================================================
+
+CREATE TABLE classification_jobs (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	status TEXT NOT NULL DEFAULT 'pending', -- pending, running, completed, failed
+	start_date DATE,
+	end_date DATE,
+	dry_run BOOLEAN NOT NULL DEFAULT false,
+	batch_size INTEGER NOT NULL,
+	total_events INTEGER NOT NULL DEFAULT 0,
+	processed_events INTEGER NOT NULL DEFAULT 0,
+	classified_count INTEGER NOT NULL DEFAULT 0,
+	skip_applied_count INTEGER NOT NULL DEFAULT 0,
+	skipped_count INTEGER NOT NULL DEFAULT 0,
+	action_id UUID REFERENCES classification_actions(id) ON DELETE SET NULL,
+	error_message TEXT,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	started_at TIMESTAMPTZ,
+	completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_classification_jobs_user ON classification_jobs(user_id, created_at DESC);
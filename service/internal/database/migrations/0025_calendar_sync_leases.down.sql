@@ -0,0 +1 @@
+DROP TABLE calendar_sync_leases;
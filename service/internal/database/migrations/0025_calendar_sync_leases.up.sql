@@ -0,0 +1,12 @@
+-- =============================================================================
+-- CALENDAR SYNC LEASES: At most one sync per calendar at a time across manual
+-- syncs, on-demand fetches, background sync and the job worker. Leases expire
+-- so a crashed holder cannot block a calendar forever.
+-- =============================================================================
+
+CREATE TABLE calendar_sync_leases (
+	calendar_id UUID PRIMARY KEY REFERENCES calendars(id) ON DELETE CASCADE,
+	holder TEXT NOT NULL,
+	acquired_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,3 @@
+ALTER TABLE calendars DROP COLUMN IF EXISTS gap_detected_at;
+
+DROP TABLE calendar_synced_weeks;
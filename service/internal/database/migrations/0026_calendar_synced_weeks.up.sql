@@ -0,0 +1,23 @@
+-- =============================================================================
+-- CALENDAR SYNCED WEEKS: The weeks actually fetched for each calendar. The
+-- min/max watermarks only bound them, so an on-demand "island" sync followed
+-- by a failed gap-filling job leaves weeks inside the window that were never
+-- fetched. The gap healer compares the two and re-queues the missing weeks.
+-- =============================================================================
+
+CREATE TABLE calendar_synced_weeks (
+	calendar_id UUID NOT NULL REFERENCES calendars(id) ON DELETE CASCADE,
+	week_start DATE NOT NULL, -- Monday
+	synced_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (calendar_id, week_start)
+);
+
+-- When the healer first saw a gap that is still open; NULL when none
+ALTER TABLE calendars ADD COLUMN gap_detected_at TIMESTAMPTZ;
+
+-- Existing windows predate week tracking, so treat them as fully synced
+INSERT INTO calendar_synced_weeks (calendar_id, week_start, synced_at)
+SELECT c.id, w::date, COALESCE(c.last_synced_at, NOW())
+FROM calendars c
+CROSS JOIN LATERAL generate_series(date_trunc('week', c.min_synced_date), c.max_synced_date, interval '1 week') AS w
+WHERE c.min_synced_date IS NOT NULL AND c.max_synced_date IS NOT NULL;
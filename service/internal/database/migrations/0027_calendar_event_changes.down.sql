@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_calendar_events_duration_changed;
+ALTER TABLE calendar_events_archive DROP COLUMN IF EXISTS duration_changed_at;
+ALTER TABLE calendar_events DROP COLUMN IF EXISTS duration_changed_at;
+
+DROP TABLE calendar_event_changes;
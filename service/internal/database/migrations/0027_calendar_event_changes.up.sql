@@ -0,0 +1,24 @@
+-- =============================================================================
+-- CALENDAR EVENT CHANGES: Field-level history of what re-syncs changed on an
+-- event, so edits made in Google after classification are no longer silent.
+-- =============================================================================
+
+CREATE TABLE calendar_event_changes (
+	id UUID PRIMARY KEY,
+	event_id UUID NOT NULL REFERENCES calendar_events(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	field TEXT NOT NULL,
+	old_value TEXT,
+	new_value TEXT,
+	synced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_calendar_event_changes_event ON calendar_event_changes(event_id, synced_at);
+
+-- Set when a classified event that feeds a time entry changes duration
+-- materially; cleared when the entry's contributing events are recomputed
+ALTER TABLE calendar_events ADD COLUMN duration_changed_at TIMESTAMPTZ;
+ALTER TABLE calendar_events_archive ADD COLUMN duration_changed_at TIMESTAMPTZ;
+
+CREATE INDEX idx_calendar_events_duration_changed ON calendar_events(user_id)
+	WHERE duration_changed_at IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE projects DROP COLUMN IF EXISTS fingerprint_exclude_keywords;
+ALTER TABLE projects DROP COLUMN IF EXISTS fingerprint_exclude_domains;
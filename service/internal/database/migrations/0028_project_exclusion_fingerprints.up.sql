@@ -0,0 +1,7 @@
+-- =============================================================================
+-- PROJECT EXCLUSION FINGERPRINTS: Domains and keywords that vote against a
+-- project, e.g. a shared vendor domain that should never match it.
+-- =============================================================================
+
+ALTER TABLE projects ADD COLUMN fingerprint_exclude_domains TEXT[] DEFAULT '{}';
+ALTER TABLE projects ADD COLUMN fingerprint_exclude_keywords TEXT[] DEFAULT '{}';
@@ -0,0 +1,6 @@
+-- =============================================================================
+-- PROJECT FINGERPRINT WEIGHTS: Per-fingerprint vote weights keyed by
+-- "kind:value", so a specific contact can outweigh a shared domain.
+-- =============================================================================
+
+ALTER TABLE projects ADD COLUMN fingerprint_weights JSONB NOT NULL DEFAULT '{}';
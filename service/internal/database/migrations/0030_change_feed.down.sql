@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS invoices_change_feed_update ON invoices;
+DROP TRIGGER IF EXISTS invoices_change_feed ON invoices;
+DROP TRIGGER IF EXISTS time_entries_change_feed_update ON time_entries;
+DROP TRIGGER IF EXISTS time_entries_change_feed ON time_entries;
+DROP TRIGGER IF EXISTS calendar_events_change_feed_update ON calendar_events;
+DROP TRIGGER IF EXISTS calendar_events_change_feed ON calendar_events;
+DROP FUNCTION IF EXISTS record_change_feed();
+DROP TABLE IF EXISTS change_feed;
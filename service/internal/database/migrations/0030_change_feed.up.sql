@@ -0,0 +1,71 @@
+-- =============================================================================
+-- CHANGE FEED: Durable log of changes to events, time entries and invoices
+-- for polling clients (no-code automation tools) that cannot hold an SSE
+-- connection. Deletions, including moving a time entry to the trash, are kept
+-- as tombstones.
+--
+-- Readers page by (xid, seq) and only see rows from transactions older than
+-- the snapshot xmin, so a change committed late never lands behind a cursor
+-- a client has already passed.
+-- =============================================================================
+
+CREATE TABLE change_feed (
+	seq BIGSERIAL PRIMARY KEY,
+	xid XID8 NOT NULL DEFAULT pg_current_xact_id(),
+	-- No foreign key: rows are written while a user's data is cascade-deleted
+	user_id UUID NOT NULL,
+	resource_type TEXT NOT NULL,
+	resource_id UUID NOT NULL,
+	operation TEXT NOT NULL CHECK (operation IN ('upsert', 'delete')),
+	changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_change_feed_user_cursor ON change_feed(user_id, xid, seq);
+CREATE INDEX idx_change_feed_changed_at ON change_feed(changed_at);
+
+CREATE OR REPLACE FUNCTION record_change_feed()
+RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		INSERT INTO change_feed (user_id, resource_type, resource_id, operation)
+		VALUES (OLD.user_id, TG_ARGV[0], OLD.id, 'delete');
+	ELSE
+		INSERT INTO change_feed (user_id, resource_type, resource_id, operation)
+		VALUES (NEW.user_id, TG_ARGV[0], NEW.id,
+			CASE WHEN to_jsonb(NEW) ->> 'deleted_at' IS NOT NULL THEN 'delete' ELSE 'upsert' END);
+	END IF;
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Updates only count when something other than updated_at changed, so a
+-- re-sync that finds an event unchanged doesn't add to the feed
+CREATE TRIGGER calendar_events_change_feed
+	AFTER INSERT OR DELETE ON calendar_events
+	FOR EACH ROW EXECUTE FUNCTION record_change_feed('calendar_event');
+
+CREATE TRIGGER calendar_events_change_feed_update
+	AFTER UPDATE ON calendar_events
+	FOR EACH ROW
+	WHEN ((to_jsonb(OLD) - 'updated_at') IS DISTINCT FROM (to_jsonb(NEW) - 'updated_at'))
+	EXECUTE FUNCTION record_change_feed('calendar_event');
+
+CREATE TRIGGER time_entries_change_feed
+	AFTER INSERT OR DELETE ON time_entries
+	FOR EACH ROW EXECUTE FUNCTION record_change_feed('time_entry');
+
+CREATE TRIGGER time_entries_change_feed_update
+	AFTER UPDATE ON time_entries
+	FOR EACH ROW
+	WHEN ((to_jsonb(OLD) - 'updated_at') IS DISTINCT FROM (to_jsonb(NEW) - 'updated_at'))
+	EXECUTE FUNCTION record_change_feed('time_entry');
+
+CREATE TRIGGER invoices_change_feed
+	AFTER INSERT OR DELETE ON invoices
+	FOR EACH ROW EXECUTE FUNCTION record_change_feed('invoice');
+
+CREATE TRIGGER invoices_change_feed_update
+	AFTER UPDATE ON invoices
+	FOR EACH ROW
+	WHEN ((to_jsonb(OLD) - 'updated_at') IS DISTINCT FROM (to_jsonb(NEW) - 'updated_at'))
+	EXECUTE FUNCTION record_change_feed('invoice');
@@ -0,0 +1,2 @@
+ALTER TABLE projects DROP COLUMN IF EXISTS github_repos;
+DROP TABLE IF EXISTS github_connections;
@@ -0,0 +1,15 @@
+-- =============================================================================
+-- GITHUB INTEGRATION: Optional per-user GitHub access for suggesting time
+-- entry descriptions from the day's commits and pull request reviews.
+-- =============================================================================
+
+CREATE TABLE github_connections (
+	user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	username TEXT NOT NULL,
+	token_encrypted BYTEA NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Repositories ("owner/name") whose activity is attributed to a project
+ALTER TABLE projects ADD COLUMN github_repos TEXT[] DEFAULT '{}';
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS hour_goals;
@@ -0,0 +1,18 @@
+-- =============================================================================
+-- HOUR GOALS: Weekly or monthly hour targets, overall or for one project
+-- =============================================================================
+
+CREATE TABLE hour_goals (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	-- NULL for a goal over all projects
+	project_id UUID REFERENCES projects(id) ON DELETE CASCADE,
+	period TEXT NOT NULL CHECK (period IN ('week', 'month')),
+	target_hours NUMERIC(6,2) NOT NULL CHECK (target_hours > 0),
+	-- Count only hours on billable projects
+	billable_only BOOLEAN NOT NULL DEFAULT false,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_hour_goals_user ON hour_goals(user_id);
@@ -0,0 +1,3 @@
+ALTER TABLE calendar_connections
+	DROP COLUMN IF EXISTS sync_history_days,
+	DROP COLUMN IF EXISTS sync_future_days;
@@ -0,0 +1,8 @@
+-- =============================================================================
+-- CONNECTION SYNC WINDOW: Per-connection historical depth and future horizon
+-- =============================================================================
+
+-- NULL keeps the server defaults
+ALTER TABLE calendar_connections
+	ADD COLUMN sync_history_days INTEGER CHECK (sync_history_days > 0),
+	ADD COLUMN sync_future_days INTEGER CHECK (sync_future_days > 0);
@@ -0,0 +1,4 @@
+ALTER TABLE calendars
+	DROP COLUMN IF EXISTS display_name,
+	DROP COLUMN IF EXISTS display_color,
+	DROP COLUMN IF EXISTS default_project_id;
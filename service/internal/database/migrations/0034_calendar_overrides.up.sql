@@ -0,0 +1,10 @@
+-- =============================================================================
+-- CALENDAR OVERRIDES: User-chosen display name, color and default project
+-- =============================================================================
+
+-- NULL keeps what Google reports. The default project is a low-weight hint to
+-- classification, not an automatic assignment.
+ALTER TABLE calendars
+	ADD COLUMN display_name TEXT,
+	ADD COLUMN display_color TEXT,
+	ADD COLUMN default_project_id UUID REFERENCES projects(id) ON DELETE SET NULL;
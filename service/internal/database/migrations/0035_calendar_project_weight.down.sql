@@ -0,0 +1 @@
+ALTER TABLE calendars DROP COLUMN IF EXISTS default_project_weight;
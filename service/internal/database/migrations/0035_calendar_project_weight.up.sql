@@ -0,0 +1,9 @@
+-- =============================================================================
+-- CALENDAR PROJECT MAPPING: Weight of a calendar's default project vote
+-- =============================================================================
+
+-- NULL keeps the default project a low-weight hint that needs review. With a
+-- weight, the mapping votes like any rule, so a dedicated client calendar
+-- classifies its own events.
+ALTER TABLE calendars
+	ADD COLUMN default_project_weight NUMERIC(4,2) CHECK (default_project_weight > 0);
@@ -0,0 +1,6 @@
+ALTER TABLE classification_rules DROP COLUMN IF EXISTS disabled_by_archive;
+
+ALTER TABLE projects
+	DROP COLUMN IF EXISTS archive_hide_from_pickers,
+	DROP COLUMN IF EXISTS archive_stop_fingerprints,
+	DROP COLUMN IF EXISTS archive_disable_rules;
@@ -0,0 +1,15 @@
+-- =============================================================================
+-- PROJECT ARCHIVE OPTIONS: What archiving a project cascades to
+-- =============================================================================
+
+-- Each option takes effect while the project is archived. Archived projects
+-- stay out of pickers unless archive_hide_from_pickers is turned off.
+ALTER TABLE projects
+	ADD COLUMN archive_disable_rules BOOLEAN NOT NULL DEFAULT false,
+	ADD COLUMN archive_stop_fingerprints BOOLEAN NOT NULL DEFAULT false,
+	ADD COLUMN archive_hide_from_pickers BOOLEAN NOT NULL DEFAULT true;
+
+-- Rules disabled because their project was archived, so that unarchiving
+-- re-enables exactly those and leaves rules the user disabled alone
+ALTER TABLE classification_rules
+	ADD COLUMN disabled_by_archive BOOLEAN NOT NULL DEFAULT false;
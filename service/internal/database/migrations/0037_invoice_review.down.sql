@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS invoice_comments;
+
+-- Enum values can't be dropped; send invoices in review back to draft
+UPDATE invoices SET status = 'draft' WHERE status = 'review';
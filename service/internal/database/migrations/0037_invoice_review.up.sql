@@ -0,0 +1,17 @@
+-- =============================================================================
+-- INVOICE REVIEW: A review step between draft and sent, with comments
+-- =============================================================================
+
+-- An invoice in review is locked like a sent one but not yet issued: it has
+-- no effect on balances and can go back to draft for changes.
+ALTER TYPE invoice_status ADD VALUE IF NOT EXISTS 'review' AFTER 'draft';
+
+CREATE TABLE invoice_comments (
+	id UUID PRIMARY KEY,
+	invoice_id UUID NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	body TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_invoice_comments_invoice ON invoice_comments(invoice_id, created_at);
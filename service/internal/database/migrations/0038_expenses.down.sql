@@ -0,0 +1,10 @@
+-- Fails while expense line items exist; delete the invoices first.
+
+ALTER TABLE invoice_line_items DROP CONSTRAINT invoice_line_item_kind_valid;
+ALTER TABLE invoice_line_items ADD CONSTRAINT invoice_line_item_kind_valid CHECK (
+	(kind = 'time' AND time_entry_id IS NOT NULL) OR
+	(kind = 'adjustment' AND time_entry_id IS NULL)
+);
+ALTER TABLE invoice_line_items DROP COLUMN expense_id;
+
+DROP TABLE IF EXISTS expenses;
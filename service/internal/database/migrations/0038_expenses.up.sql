@@ -0,0 +1,31 @@
+-- =============================================================================
+-- EXPENSES: Costs recorded against a project and billed on its invoices
+-- =============================================================================
+
+CREATE TABLE expenses (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+	date DATE NOT NULL,
+	amount DECIMAL(10,2) NOT NULL CHECK (amount > 0),
+	category TEXT NOT NULL,
+	description TEXT,
+	receipt_url TEXT,
+	is_billable BOOLEAN NOT NULL DEFAULT true,
+	-- Set when a billable expense is put on an invoice, which locks it
+	invoice_id UUID REFERENCES invoices(id) ON DELETE SET NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_expenses_user_project_date ON expenses(user_id, project_id, date);
+CREATE INDEX idx_expenses_invoice_id ON expenses(invoice_id) WHERE invoice_id IS NOT NULL;
+
+-- Expense line items carry the expense amount and link back to the expense
+ALTER TABLE invoice_line_items ADD COLUMN expense_id UUID REFERENCES expenses(id) ON DELETE RESTRICT;
+ALTER TABLE invoice_line_items DROP CONSTRAINT invoice_line_item_kind_valid;
+ALTER TABLE invoice_line_items ADD CONSTRAINT invoice_line_item_kind_valid CHECK (
+	(kind = 'time' AND time_entry_id IS NOT NULL AND expense_id IS NULL) OR
+	(kind = 'expense' AND expense_id IS NOT NULL AND time_entry_id IS NULL) OR
+	(kind = 'adjustment' AND time_entry_id IS NULL AND expense_id IS NULL)
+);
@@ -0,0 +1,15 @@
+-- Fails while fee or overage line items exist; delete the invoices first.
+
+ALTER TABLE invoice_line_items DROP CONSTRAINT invoice_line_item_kind_valid;
+ALTER TABLE invoice_line_items ADD CONSTRAINT invoice_line_item_kind_valid CHECK (
+	(kind = 'time' AND time_entry_id IS NOT NULL AND expense_id IS NULL) OR
+	(kind = 'expense' AND expense_id IS NOT NULL AND time_entry_id IS NULL) OR
+	(kind = 'adjustment' AND time_entry_id IS NULL AND expense_id IS NULL)
+);
+
+ALTER TABLE billing_periods DROP CONSTRAINT billing_period_mode_valid;
+ALTER TABLE billing_periods
+	DROP COLUMN billing_mode,
+	DROP COLUMN fixed_fee,
+	DROP COLUMN included_hours,
+	DROP COLUMN overage_rate;
@@ -0,0 +1,29 @@
+-- =============================================================================
+-- BILLING MODES: Fixed monthly fees and retainers on billing periods
+-- =============================================================================
+
+-- hourly bills each entry at hourly_rate. fixed bills fixed_fee once per
+-- calendar month. retainer bills fixed_fee per month plus overage_rate for
+-- each hour tracked beyond included_hours in that month.
+ALTER TABLE billing_periods
+	ADD COLUMN billing_mode TEXT NOT NULL DEFAULT 'hourly',
+	ADD COLUMN fixed_fee DECIMAL(10,2),
+	ADD COLUMN included_hours DECIMAL(10,2),
+	ADD COLUMN overage_rate DECIMAL(10,2);
+
+ALTER TABLE billing_periods ADD CONSTRAINT billing_period_mode_valid CHECK (
+	(billing_mode = 'hourly') OR
+	(billing_mode = 'fixed' AND fixed_fee IS NOT NULL AND fixed_fee >= 0) OR
+	(billing_mode = 'retainer' AND fixed_fee IS NOT NULL AND fixed_fee >= 0
+		AND included_hours IS NOT NULL AND included_hours >= 0
+		AND overage_rate IS NOT NULL AND overage_rate >= 0)
+);
+
+-- Fee lines carry a period's monthly fee; overage lines bill hours beyond a
+-- retainer's included hours. Both point at the billing period via rate_id.
+ALTER TABLE invoice_line_items DROP CONSTRAINT invoice_line_item_kind_valid;
+ALTER TABLE invoice_line_items ADD CONSTRAINT invoice_line_item_kind_valid CHECK (
+	(kind = 'time' AND time_entry_id IS NOT NULL AND expense_id IS NULL) OR
+	(kind = 'expense' AND expense_id IS NOT NULL AND time_entry_id IS NULL) OR
+	(kind IN ('adjustment', 'fee', 'overage') AND time_entry_id IS NULL AND expense_id IS NULL)
+);
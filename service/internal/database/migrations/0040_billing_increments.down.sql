@@ -0,0 +1,7 @@
+ALTER TABLE invoice_line_items
+	DROP COLUMN rounding_hours,
+	DROP COLUMN rounding_rule;
+
+ALTER TABLE billing_periods
+	DROP COLUMN min_daily_hours,
+	DROP COLUMN increment_hours;
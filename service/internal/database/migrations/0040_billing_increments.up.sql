@@ -0,0 +1,15 @@
+-- =============================================================================
+-- BILLING INCREMENTS: Per-day minimums and round-up increments
+-- =============================================================================
+
+-- Applied when an invoice is created: each day's hours under the period are
+-- rounded up to increment_hours and raised to at least min_daily_hours
+ALTER TABLE billing_periods
+	ADD COLUMN min_daily_hours DECIMAL(5,2) CHECK (min_daily_hours > 0),
+	ADD COLUMN increment_hours DECIMAL(5,2) CHECK (increment_hours > 0);
+
+-- Hours the rule added to a time line (on the day's last entry) and the rule
+-- as it stood when the invoice was created. hours includes rounding_hours.
+ALTER TABLE invoice_line_items
+	ADD COLUMN rounding_hours DECIMAL(10,2) NOT NULL DEFAULT 0,
+	ADD COLUMN rounding_rule TEXT;
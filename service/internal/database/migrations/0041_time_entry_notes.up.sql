@@ -0,0 +1,15 @@
+-- =============================================================================
+-- TIME ENTRY NOTES: A thread of notes on each time entry
+-- =============================================================================
+
+-- Notes are context kept apart from the entry's description (which is what
+-- the client sees on invoices), and stay editable once the entry is invoiced
+CREATE TABLE time_entry_notes (
+	id UUID PRIMARY KEY,
+	time_entry_id UUID NOT NULL REFERENCES time_entries(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	body TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_time_entry_notes_entry ON time_entry_notes(time_entry_id, created_at);
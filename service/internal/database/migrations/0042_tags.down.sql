@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS time_entry_tags;
+DROP TABLE IF EXISTS calendar_event_tags;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,31 @@
+-- =============================================================================
+-- TAGS: Free-form labels on calendar events and time entries
+-- =============================================================================
+
+CREATE TABLE tags (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Tag names are matched case-insensitively, so "Travel" and "travel" are
+-- the same tag
+CREATE UNIQUE INDEX idx_tags_user_name ON tags(user_id, lower(name));
+
+-- Tagged events are kept out of the archive, like other referenced events
+CREATE TABLE calendar_event_tags (
+	event_id UUID NOT NULL REFERENCES calendar_events(id) ON DELETE CASCADE,
+	tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+	PRIMARY KEY (event_id, tag_id)
+);
+
+CREATE INDEX idx_calendar_event_tags_tag ON calendar_event_tags(tag_id);
+
+CREATE TABLE time_entry_tags (
+	time_entry_id UUID NOT NULL REFERENCES time_entries(id) ON DELETE CASCADE,
+	tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+	PRIMARY KEY (time_entry_id, tag_id)
+);
+
+CREATE INDEX idx_time_entry_tags_tag ON time_entry_tags(tag_id);
@@ -0,0 +1 @@
+DROP TABLE recalculation_jobs;
@@ -0,0 +1,24 @@
+-- =============================================================================
+-- RECALCULATION JOBS: Background recomputation of time entries over a date
+-- range, optionally limited to one project, with progress recorded per day
+-- =============================================================================
+
+CREATE TABLE recalculation_jobs (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	status TEXT NOT NULL DEFAULT 'pending', -- pending, running, completed, failed
+	start_date DATE NOT NULL,
+	end_date DATE NOT NULL,
+	project_id UUID REFERENCES projects(id) ON DELETE CASCADE,
+	total_days INTEGER NOT NULL DEFAULT 0,
+	processed_days INTEGER NOT NULL DEFAULT 0,
+	entries_computed INTEGER NOT NULL DEFAULT 0,
+	entries_cleared INTEGER NOT NULL DEFAULT 0,
+	error_message TEXT,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	started_at TIMESTAMPTZ,
+	completed_at TIMESTAMPTZ,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_recalculation_jobs_user ON recalculation_jobs(user_id, created_at DESC);
@@ -0,0 +1,4 @@
+ALTER TABLE classification_jobs
+	DROP COLUMN rule_id,
+	DROP COLUMN matched_count,
+	DROP COLUMN changed_count;
@@ -0,0 +1,11 @@
+-- =============================================================================
+-- RULE BACKFILL JOBS: Classification jobs started when a rule is created,
+-- limited to the events that rule matches
+-- =============================================================================
+
+-- rule_id is set for backfill jobs. matched_count is events evaluated after
+-- the rule's filter; changed_count is events whose classification changed.
+ALTER TABLE classification_jobs
+	ADD COLUMN rule_id UUID REFERENCES classification_rules(id) ON DELETE SET NULL,
+	ADD COLUMN matched_count INTEGER NOT NULL DEFAULT 0,
+	ADD COLUMN changed_count INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- =============================================================================
+-- USER LOCALE: Language and region used for generated text such as invoices
+-- =============================================================================
+
+ALTER TABLE users
+	ADD COLUMN locale TEXT NOT NULL DEFAULT 'en';
@@ -0,0 +1,2 @@
+ALTER TABLE api_keys
+	DROP COLUMN require_confirmation;
@@ -0,0 +1,7 @@
+-- =============================================================================
+-- API KEY CONFIRMATION: Require a preview and confirmation token before
+-- destructive MCP tools run with the key
+-- =============================================================================
+
+ALTER TABLE api_keys
+	ADD COLUMN require_confirmation BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,4 @@
+ALTER TABLE mcp_oauth_sessions
+	DROP COLUMN client_id;
+
+DROP TABLE mcp_oauth_clients;
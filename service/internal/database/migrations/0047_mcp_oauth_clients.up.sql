@@ -0,0 +1,15 @@
+-- =============================================================================
+-- MCP OAUTH CLIENTS: Clients registered through dynamic client registration
+-- (RFC 7591), so authorization requests can be checked against them
+-- =============================================================================
+
+CREATE TABLE mcp_oauth_clients (
+	client_id TEXT PRIMARY KEY,
+	client_name TEXT NOT NULL DEFAULT '',
+	redirect_uris TEXT[] NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Sessions started before clients were stored have no client
+ALTER TABLE mcp_oauth_sessions
+	ADD COLUMN client_id TEXT REFERENCES mcp_oauth_clients(client_id) ON DELETE CASCADE;
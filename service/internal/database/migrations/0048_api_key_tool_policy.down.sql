@@ -0,0 +1,3 @@
+ALTER TABLE api_keys
+	DROP COLUMN denied_tools,
+	DROP COLUMN allowed_tools;
@@ -0,0 +1,8 @@
+-- =============================================================================
+-- API KEY TOOL POLICY: Limit the MCP tools an API key may call. An empty
+-- allowlist allows every tool; the denylist is applied after it.
+-- =============================================================================
+
+ALTER TABLE api_keys
+	ADD COLUMN allowed_tools TEXT[] NOT NULL DEFAULT '{}',
+	ADD COLUMN denied_tools TEXT[] NOT NULL DEFAULT '{}';
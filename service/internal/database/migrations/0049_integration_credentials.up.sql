@@ -0,0 +1,24 @@
+-- =============================================================================
+-- INTEGRATION CREDENTIALS: One vault for the credentials of third-party
+-- integrations (Slack, Jira, QuickBooks, ...) instead of a table or columns
+-- per integration. Payloads are encrypted with a key derived for the user.
+-- =============================================================================
+
+CREATE TABLE integration_credentials (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	provider TEXT NOT NULL,
+	scopes TEXT[] NOT NULL DEFAULT '{}',
+	payload_encrypted BYTEA NOT NULL,
+
+	-- Rotation metadata
+	key_version INTEGER NOT NULL DEFAULT 1,
+	rotated_at TIMESTAMPTZ,
+	expires_at TIMESTAMPTZ,
+	last_used_at TIMESTAMPTZ,
+
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+	UNIQUE (user_id, provider)
+);
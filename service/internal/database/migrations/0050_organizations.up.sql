@@ -0,0 +1,62 @@
+-- =============================================================================
+-- ORGANIZATIONS: Groups of users that sign in through their identity
+-- provider (OIDC) and are provisioned by it over SCIM. Claimed email
+-- domains route SSO logins to the organization and can auto-join new users.
+-- =============================================================================
+
+CREATE TABLE organizations (
+	id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+	name TEXT NOT NULL,
+	slug TEXT NOT NULL UNIQUE,
+
+	-- OIDC single sign-on; disabled until an issuer and client are set
+	sso_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+	oidc_issuer TEXT,
+	oidc_client_id TEXT,
+	oidc_client_secret_encrypted BYTEA,
+
+	-- SCIM bearer token (SHA-256), shown once when rotated
+	scim_token_hash TEXT UNIQUE,
+	scim_token_prefix TEXT,
+
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE organization_domains (
+	organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+	domain TEXT NOT NULL,
+	-- Proven with a DNS TXT record before the domain routes logins
+	verification_token TEXT NOT NULL,
+	verified_at TIMESTAMPTZ,
+	-- Users of the domain signing in through SSO get an account and membership
+	auto_join BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+	PRIMARY KEY (organization_id, domain)
+);
+
+-- Any organization may claim a domain, but only one can verify it
+CREATE UNIQUE INDEX idx_organization_domains_verified
+	ON organization_domains(domain) WHERE verified_at IS NOT NULL;
+
+CREATE TABLE organization_members (
+	organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	role TEXT NOT NULL DEFAULT 'member' CHECK (role IN ('owner', 'admin', 'member')),
+
+	-- Identity provider's ID for the user, set by SCIM
+	external_id TEXT,
+	-- Set when the identity provider deactivates the user; they can no
+	-- longer sign in until reactivated
+	deactivated_at TIMESTAMPTZ,
+
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+	PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX idx_organization_members_user ON organization_members(user_id);
+CREATE UNIQUE INDEX idx_organization_members_external_id
+	ON organization_members(organization_id, external_id) WHERE external_id IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE users
+	DROP COLUMN sync_interval_minutes,
+	DROP COLUMN sync_stale_after_minutes;
@@ -0,0 +1,10 @@
+-- =============================================================================
+-- USER SYNC CADENCE: How fresh each user's calendars are kept
+-- =============================================================================
+-- NULL keeps the service default. Bounds match sync.ResolveCadence.
+
+ALTER TABLE users
+	ADD COLUMN sync_stale_after_minutes INT
+		CHECK (sync_stale_after_minutes BETWEEN 15 AND 10080),
+	ADD COLUMN sync_interval_minutes INT
+		CHECK (sync_interval_minutes BETWEEN 60 AND 10080);
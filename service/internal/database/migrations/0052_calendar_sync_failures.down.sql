@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS calendars_sync_failed_notify ON calendars;
+
+ALTER TABLE calendars
+	DROP COLUMN sync_disabled_at,
+	DROP COLUMN sync_last_error,
+	DROP COLUMN sync_failure_category;
@@ -0,0 +1,22 @@
+-- =============================================================================
+-- CALENDAR SYNC FAILURES: Why a calendar's last sync failed
+-- =============================================================================
+-- The category decides what happens next: auth failures wait for the user to
+-- reconnect, permanent ones (deleted or unshared calendar) disable sync until
+-- the calendar is reselected, transient ones are retried up to the failure
+-- limit and quota ones are retried without counting against it.
+
+ALTER TABLE calendars
+	ADD COLUMN sync_failure_category TEXT
+		CHECK (sync_failure_category IN ('auth', 'permanent', 'transient', 'quota')),
+	ADD COLUMN sync_last_error TEXT,
+	ADD COLUMN sync_disabled_at TIMESTAMPTZ;
+
+-- Tell connected clients when a calendar starts failing or is disabled
+CREATE TRIGGER calendars_sync_failed_notify
+	AFTER UPDATE ON calendars
+	FOR EACH ROW
+	WHEN (NEW.sync_failure_category IS NOT NULL
+	  AND (OLD.sync_failure_category IS DISTINCT FROM NEW.sync_failure_category
+	   OR OLD.sync_disabled_at IS DISTINCT FROM NEW.sync_disabled_at))
+	EXECUTE FUNCTION notify_timesheet_change('sync_failed');
@@ -0,0 +1,2 @@
+ALTER TABLE calendar_connections
+	DROP COLUMN granted_scopes;
@@ -0,0 +1,16 @@
+-- =============================================================================
+-- CONNECTION GRANTED SCOPES: The OAuth scopes each connection was granted
+-- =============================================================================
+-- New connections only get read-only calendar access; other scopes are added
+-- through incremental consent. Existing Google connections were granted the
+-- calendar and Drive file scopes together.
+
+ALTER TABLE calendar_connections
+	ADD COLUMN granted_scopes TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE calendar_connections
+SET granted_scopes = ARRAY[
+	'https://www.googleapis.com/auth/calendar.readonly',
+	'https://www.googleapis.com/auth/drive.file'
+]
+WHERE provider = 'google';
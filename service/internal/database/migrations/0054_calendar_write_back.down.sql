@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_calendar_events_write_back_pending;
+
+ALTER TABLE calendar_events
+	DROP COLUMN written_back_at,
+	DROP COLUMN written_project_id;
+
+ALTER TABLE calendar_connections
+	DROP COLUMN write_back;
@@ -0,0 +1,19 @@
+-- =============================================================================
+-- CALENDAR WRITE-BACK: Classified projects written back to Google events
+-- =============================================================================
+-- Each connection chooses whether events get the project stored in private
+-- extended properties, also get a matching color, or are left untouched.
+-- Events remember the project last written so only changes are sent again.
+-- Turning write-back off leaves what was already written in place.
+
+ALTER TABLE calendar_connections
+	ADD COLUMN write_back TEXT NOT NULL DEFAULT 'off'
+		CHECK (write_back IN ('off', 'properties', 'color'));
+
+ALTER TABLE calendar_events
+	ADD COLUMN written_project_id UUID,
+	ADD COLUMN written_back_at TIMESTAMPTZ;
+
+-- Events whose project has changed since it was last written back
+CREATE INDEX idx_calendar_events_write_back_pending ON calendar_events(connection_id)
+	WHERE project_id IS DISTINCT FROM written_project_id;
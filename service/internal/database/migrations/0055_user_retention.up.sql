@@ -0,0 +1,10 @@
+-- =============================================================================
+-- USER RETENTION: How long each user's raw calendar events are kept
+-- =============================================================================
+-- NULL keeps events forever. Older events are purged unless they feed an
+-- invoiced time entry; time entries themselves are always kept. Bounds match
+-- retention.MinEventMonths and retention.MaxEventMonths.
+
+ALTER TABLE users
+	ADD COLUMN retention_event_months INT
+		CHECK (retention_event_months BETWEEN 6 AND 240);
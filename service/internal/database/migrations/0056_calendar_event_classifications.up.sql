@@ -0,0 +1,20 @@
+-- =============================================================================
+-- CALENDAR EVENT CLASSIFICATIONS: History of each classification an event
+-- received, manual or by rule, with the rule votes behind it. Events only
+-- store the latest.
+-- =============================================================================
+
+CREATE TABLE calendar_event_classifications (
+	id UUID PRIMARY KEY,
+	event_id UUID NOT NULL REFERENCES calendar_events(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	project_id UUID REFERENCES projects(id) ON DELETE SET NULL,
+	source classification_source NOT NULL,
+	confidence FLOAT,
+	is_skipped BOOLEAN NOT NULL DEFAULT false,
+	-- Every rule that matched, for any project: [{rule_id, project_id, weight, source}]
+	rule_votes JSONB,
+	classified_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_calendar_event_classifications_event ON calendar_event_classifications(event_id, classified_at);
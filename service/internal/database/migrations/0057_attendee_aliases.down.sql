@@ -0,0 +1 @@
+DROP TABLE attendee_aliases;
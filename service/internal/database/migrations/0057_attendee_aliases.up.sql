@@ -0,0 +1,14 @@
+-- =============================================================================
+-- ATTENDEE ALIASES: Other emails of the same person, so email: and domain:
+-- rules and project fingerprints keyed to them survive an email change.
+-- Rows sharing an email join into one identity.
+-- =============================================================================
+
+CREATE TABLE attendee_aliases (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	canonical_email TEXT NOT NULL,
+	alias_email TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CONSTRAINT attendee_aliases_user_alias UNIQUE (user_id, alias_email)
+);
@@ -0,0 +1,9 @@
+-- =============================================================================
+-- USER COMPANY DOMAINS: The user's own organization's email domains
+-- =============================================================================
+-- An event with no attendee outside them is internal, for the internal:
+-- query property and the internal vs client-facing report split. Empty
+-- leaves every event's audience unknown. Domains are stored lowercase.
+
+ALTER TABLE users
+	ADD COLUMN company_domains TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1 @@
+DROP TRIGGER IF EXISTS calendars_reauth_needed_notify ON calendars;
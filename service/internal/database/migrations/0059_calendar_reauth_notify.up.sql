@@ -0,0 +1,10 @@
+-- =============================================================================
+-- REAUTH NOTIFICATIONS: Tell connected clients as soon as a calendar needs
+-- the user to consent again, rather than on their next manual sync
+-- =============================================================================
+
+CREATE TRIGGER calendars_reauth_needed_notify
+	AFTER UPDATE ON calendars
+	FOR EACH ROW
+	WHEN (NEW.needs_reauth AND NOT OLD.needs_reauth)
+	EXECUTE FUNCTION notify_timesheet_change('reauth_needed');
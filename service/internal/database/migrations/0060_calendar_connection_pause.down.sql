@@ -0,0 +1 @@
+ALTER TABLE calendar_connections DROP COLUMN paused_at;
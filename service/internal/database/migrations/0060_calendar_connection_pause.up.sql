@@ -0,0 +1,7 @@
+-- =============================================================================
+-- CONNECTION PAUSE: Stop all syncing of a connection without disconnecting
+-- =============================================================================
+-- While paused_at is set, background sync, on-demand fetches, sync jobs and
+-- write-back skip the connection; its calendars and events are kept.
+
+ALTER TABLE calendar_connections ADD COLUMN paused_at TIMESTAMPTZ;
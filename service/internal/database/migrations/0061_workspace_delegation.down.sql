@@ -0,0 +1,9 @@
+DELETE FROM calendar_connections WHERE provider = 'google_workspace';
+
+ALTER TABLE organization_members DROP COLUMN delegation_calendars;
+
+ALTER TABLE organizations
+	DROP COLUMN delegation_calendars,
+	DROP COLUMN google_service_account_client_id,
+	DROP COLUMN google_service_account_email,
+	DROP COLUMN google_service_account_encrypted;
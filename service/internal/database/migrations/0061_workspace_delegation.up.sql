@@ -0,0 +1,20 @@
+-- =============================================================================
+-- WORKSPACE DELEGATION: A Google Workspace service account with domain-wide
+-- delegation provisions calendar sync for an organization's members
+-- =============================================================================
+-- The key is encrypted like OIDC client secrets. Members get a connection
+-- with provider 'google_workspace' whose tokens the service account mints
+-- on their behalf. delegation_calendars picks which newly found calendars
+-- are selected: the primary one, all of them or none. A member's own
+-- setting overrides the organization's.
+
+ALTER TABLE organizations
+	ADD COLUMN google_service_account_encrypted BYTEA,
+	ADD COLUMN google_service_account_email TEXT,
+	ADD COLUMN google_service_account_client_id TEXT,
+	ADD COLUMN delegation_calendars TEXT NOT NULL DEFAULT 'primary'
+		CHECK (delegation_calendars IN ('primary', 'all', 'none'));
+
+ALTER TABLE organization_members
+	ADD COLUMN delegation_calendars TEXT
+		CHECK (delegation_calendars IN ('primary', 'all', 'none'));
@@ -0,0 +1,7 @@
+-- =============================================================================
+-- IDEMPOTENCY CLAIM LEASE: An in-progress key can be reclaimed once its
+-- claim is older than the lease, so a request whose handler died doesn't
+-- block retries until the key expires
+-- =============================================================================
+
+ALTER TABLE idempotency_keys ADD COLUMN claimed_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
@@ -0,0 +1,251 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	gosync "sync"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// GlobalQueryTag marks a statement as intentionally reading or writing
+// across tenants, such as looking up an API key by its hash before the
+// user is known. The scope audit skips statements containing it.
+const GlobalQueryTag = "/* scope:global */"
+
+// maxRecordedViolations bounds the violations an auditor keeps in memory
+const maxRecordedViolations = 1000
+
+// Scope is the tenant a request acts for. Queries on tenant tables made
+// with a scoped context must pass at least one of its IDs as an argument.
+type Scope struct {
+	UserID         uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+type scopeKey struct{}
+
+type unscopedKey struct{}
+
+// WithScope returns a context whose queries are audited against scope.
+// Setting a scope again adds to it rather than replacing it.
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	current, _ := ScopeFromContext(ctx)
+	if scope.UserID == uuid.Nil {
+		scope.UserID = current.UserID
+	}
+	if scope.OrganizationID == uuid.Nil {
+		scope.OrganizationID = current.OrganizationID
+	}
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFromContext returns the tenant scope set with WithScope
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(Scope)
+	return scope, ok
+}
+
+// Unscoped returns a context whose queries skip the scope audit, for work
+// that deliberately spans tenants such as admin tools and background jobs
+func Unscoped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unscopedKey{}, true)
+}
+
+// ScopeViolation is a query on a tenant table that didn't carry the
+// request's tenant IDs
+type ScopeViolation struct {
+	SQL    string
+	Tables []string
+	Scope  Scope
+	Reason string
+}
+
+func (v ScopeViolation) String() string {
+	return fmt.Sprintf("%s on %s for user %s: %s", v.Reason, strings.Join(v.Tables, ", "), v.Scope.UserID, compactSQL(v.SQL))
+}
+
+// ScopeAuditor is a query tracer that checks every statement made on
+// behalf of a tenant references that tenant. A statement on a tenant table
+// (one with a user_id or organization_id column) made with a scoped context
+// must filter on a tenant column and pass the scope's user or organization
+// ID as an argument. It is a safety net against cross-tenant leaks, not an
+// access control: violations are recorded and reported, never blocked.
+type ScopeAuditor struct {
+	// RequireScope also flags tenant-table queries made without any scope
+	// or Unscoped marker, for tests that drive requests end to end
+	RequireScope bool
+
+	logf func(format string, args ...any)
+
+	mu           gosync.Mutex
+	tenantTables map[string]bool
+	violations   []ScopeViolation
+}
+
+// NewScopeAuditor creates an auditor that reports violations to logf, which
+// may be nil to only record them
+func NewScopeAuditor(logf func(format string, args ...any)) *ScopeAuditor {
+	return &ScopeAuditor{logf: logf, tenantTables: make(map[string]bool)}
+}
+
+// SetTenantTables sets the tables whose queries are audited
+func (a *ScopeAuditor) SetTenantTables(tables ...string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.tenantTables = make(map[string]bool, len(tables))
+	for _, t := range tables {
+		a.tenantTables[strings.ToLower(t)] = true
+	}
+}
+
+// LoadTenantTables audits every table in the schema with a user_id or
+// organization_id column. Call it after migrations so new tables are
+// covered without being listed by hand.
+func (a *ScopeAuditor) LoadTenantTables(ctx context.Context, pool *pgxpool.Pool) error {
+	rows, err := pool.Query(ctx, `
+		SELECT DISTINCT table_name FROM information_schema.columns
+		WHERE table_schema = current_schema()
+			AND column_name IN ('user_id', 'organization_id')
+	`)
+	if err != nil {
+		return fmt.Errorf("load tenant tables: %w", err)
+	}
+	tables, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("load tenant tables: %w", err)
+	}
+	a.SetTenantTables(tables...)
+	return nil
+}
+
+// Violations returns the violations recorded since the last Reset
+func (a *ScopeAuditor) Violations() []ScopeViolation {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]ScopeViolation(nil), a.violations...)
+}
+
+// Reset forgets recorded violations
+func (a *ScopeAuditor) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.violations = nil
+}
+
+// TraceQueryStart audits the statement before it runs
+func (a *ScopeAuditor) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if v := a.check(ctx, data.SQL, data.Args); v != nil {
+		a.record(*v)
+	}
+	return ctx
+}
+
+// TraceQueryEnd is a no-op; the audit only needs the statement
+func (a *ScopeAuditor) TraceQueryEnd(context.Context, *pgx.Conn, pgx.TraceQueryEndData) {}
+
+func (a *ScopeAuditor) record(v ScopeViolation) {
+	a.mu.Lock()
+	if len(a.violations) < maxRecordedViolations {
+		a.violations = append(a.violations, v)
+	}
+	a.mu.Unlock()
+	if a.logf != nil {
+		a.logf("scope audit: %s", v)
+	}
+}
+
+var (
+	// tableRefPattern finds the tables a statement reads or writes
+	tableRefPattern = regexp.MustCompile(`(?i)\b(?:from|join|update|into)\s+(?:only\s+)?([a-z_][a-z0-9_]*)\b`)
+	// tenantColumnPattern finds a filter or value on a tenant column
+	tenantColumnPattern = regexp.MustCompile(`(?i)\b(?:user_id|organization_id)\b`)
+)
+
+// check returns the violation a statement commits, if any
+func (a *ScopeAuditor) check(ctx context.Context, sql string, args []any) *ScopeViolation {
+	if unscoped, _ := ctx.Value(unscopedKey{}).(bool); unscoped || strings.Contains(sql, GlobalQueryTag) {
+		return nil
+	}
+	tables := a.referencedTenantTables(sql)
+	if len(tables) == 0 {
+		return nil
+	}
+
+	scope, ok := ScopeFromContext(ctx)
+	if !ok {
+		if a.RequireScope {
+			return &ScopeViolation{SQL: sql, Tables: tables, Reason: "tenant query without a scope"}
+		}
+		return nil
+	}
+	if !tenantColumnPattern.MatchString(sql) {
+		return &ScopeViolation{SQL: sql, Tables: tables, Scope: scope, Reason: "no user_id or organization_id filter"}
+	}
+	if !argsContain(args, scope.UserID) && !argsContain(args, scope.OrganizationID) {
+		return &ScopeViolation{SQL: sql, Tables: tables, Scope: scope, Reason: "scope ID not among the arguments"}
+	}
+	return nil
+}
+
+func (a *ScopeAuditor) referencedTenantTables(sql string) []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var tables []string
+	seen := make(map[string]bool)
+	for _, m := range tableRefPattern.FindAllStringSubmatch(sql, -1) {
+		t := strings.ToLower(m[1])
+		if a.tenantTables[t] && !seen[t] {
+			seen[t] = true
+			tables = append(tables, t)
+		}
+	}
+	return tables
+}
+
+// argsContain reports whether id is passed as an argument, directly or in
+// a slice for = ANY($n)
+func argsContain(args []any, id uuid.UUID) bool {
+	if id == uuid.Nil {
+		return false
+	}
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case uuid.UUID:
+			if v == id {
+				return true
+			}
+		case *uuid.UUID:
+			if v != nil && *v == id {
+				return true
+			}
+		case [16]byte:
+			if v == id {
+				return true
+			}
+		case string:
+			if v == id.String() {
+				return true
+			}
+		case []uuid.UUID:
+			for _, u := range v {
+				if u == id {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func compactSQL(sql string) string {
+	sql = strings.Join(strings.Fields(sql), " ")
+	if len(sql) > 200 {
+		sql = sql[:200] + "..."
+	}
+	return sql
+}
@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestScopeAuditor_Check(t *testing.T) {
+	a := NewScopeAuditor(nil)
+	a.SetTenantTables("projects", "time_entries", "organization_members")
+
+	userID, otherID, orgID := uuid.New(), uuid.New(), uuid.New()
+	userCtx := WithScope(context.Background(), Scope{UserID: userID})
+	orgCtx := WithScope(userCtx, Scope{OrganizationID: orgID})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		sql       string
+		args      []any
+		violation bool
+	}{
+		{"scoped by user", userCtx, "SELECT * FROM projects WHERE id = $1 AND user_id = $2", []any{otherID, userID}, false},
+		{"user ID as string", userCtx, "SELECT * FROM projects WHERE user_id = $1", []any{userID.String()}, false},
+		{"user IDs in a slice", userCtx, "SELECT * FROM projects WHERE user_id = ANY($1)", []any{[]uuid.UUID{otherID, userID}}, false},
+		{"insert", userCtx, "INSERT INTO projects (id, user_id) VALUES ($1, $2)", []any{otherID, userID}, false},
+		{"non-tenant table", userCtx, "SELECT * FROM users WHERE id = $1", []any{otherID}, false},
+		{"no scope", context.Background(), "SELECT * FROM projects", nil, false},
+		{"unscoped context", Unscoped(userCtx), "SELECT * FROM projects", nil, false},
+		{"global tag", userCtx, "SELECT count(*) " + GlobalQueryTag + " FROM projects", nil, false},
+		{"organization scope", orgCtx, "SELECT * FROM organization_members WHERE organization_id = $1", []any{orgID}, false},
+		{"no tenant filter", userCtx, "SELECT * FROM projects WHERE id = $1", []any{otherID}, true},
+		{"other user's ID", userCtx, "DELETE FROM time_entries WHERE user_id = $1", []any{otherID}, true},
+		{"joined tenant table", userCtx, "SELECT * FROM users u JOIN projects p ON p.id = $1", []any{otherID}, true},
+		{"update", userCtx, "UPDATE time_entries SET hours = 1 WHERE id = $1", []any{otherID}, true},
+	}
+	for _, tt := range tests {
+		v := a.check(tt.ctx, tt.sql, tt.args)
+		if (v != nil) != tt.violation {
+			t.Errorf("%s: violation = %v, want %v", tt.name, v, tt.violation)
+		}
+	}
+}
+
+func TestScopeAuditor_RequireScope(t *testing.T) {
+	a := NewScopeAuditor(nil)
+	a.SetTenantTables("projects")
+	a.RequireScope = true
+
+	if v := a.check(context.Background(), "SELECT * FROM projects", nil); v == nil {
+		t.Error("expected a tenant query without a scope to be flagged")
+	}
+	if v := a.check(Unscoped(context.Background()), "SELECT * FROM projects", nil); v != nil {
+		t.Errorf("expected an unscoped context to pass, got %v", v)
+	}
+}
+
+func TestScopeAuditor_RecordsViolations(t *testing.T) {
+	var logged int
+	a := NewScopeAuditor(func(string, ...any) { logged++ })
+	a.SetTenantTables("projects")
+	ctx := WithScope(context.Background(), Scope{UserID: uuid.New()})
+
+	a.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: "SELECT * FROM projects"})
+	if got := a.Violations(); len(got) != 1 || got[0].Tables[0] != "projects" {
+		t.Fatalf("expected one violation on projects, got %v", got)
+	}
+	if logged != 1 {
+		t.Errorf("expected the violation to be logged once, got %d", logged)
+	}
+	a.Reset()
+	if got := a.Violations(); len(got) != 0 {
+		t.Errorf("expected no violations after reset, got %v", got)
+	}
+}
+
+func TestWithScope_Merges(t *testing.T) {
+	userID, orgID := uuid.New(), uuid.New()
+	ctx := WithScope(context.Background(), Scope{UserID: userID})
+	ctx = WithScope(ctx, Scope{OrganizationID: orgID})
+
+	scope, ok := ScopeFromContext(ctx)
+	if !ok || scope.UserID != userID || scope.OrganizationID != orgID {
+		t.Errorf("unexpected scope %+v", scope)
+	}
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Querier runs statements on the pool or inside a transaction
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+}
+
+type txKey struct{}
+
+// unit is the transaction a UnitOfWork shares through the context
+type unit struct {
+	tx          pgx.Tx
+	afterCommit []func()
+}
+
+// Conn returns the transaction carried by ctx, or pool outside a unit of
+// work. Every store runs its statements on it, so writes through any store
+// join the caller's unit of work; a Begin on the transaction opens a
+// savepoint. Only fire-and-forget writes on a fresh context use the pool
+// directly.
+func Conn(ctx context.Context, pool *pgxpool.Pool) Querier {
+	if u, ok := ctx.Value(txKey{}).(*unit); ok {
+		return u.tx
+	}
+	return pool
+}
+
+// InUnitOfWork reports whether ctx carries a transaction
+func InUnitOfWork(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*unit)
+	return ok
+}
+
+// AfterCommit runs fn once the unit of work in ctx commits, or right away
+// outside one. Use it for side effects such as cache invalidation that
+// must not be seen before the data is.
+func AfterCommit(ctx context.Context, fn func()) {
+	if u, ok := ctx.Value(txKey{}).(*unit); ok {
+		u.afterCommit = append(u.afterCommit, fn)
+		return
+	}
+	fn()
+}
+
+// UnitOfWork runs multi-step writes in one transaction, so a failure part
+// way through leaves no partial state. A nil UnitOfWork runs functions
+// without a transaction.
+type UnitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+// NewUnitOfWork creates a unit of work on the pool
+func NewUnitOfWork(pool *pgxpool.Pool) *UnitOfWork {
+	return &UnitOfWork{pool: pool}
+}
+
+// Do runs fn with a context carrying a transaction, committing if fn
+// returns nil and rolling back otherwise. Inside another unit of work fn
+// joins the outer transaction. The transaction is bound to fn's goroutine:
+// fn must not share its context with goroutines that outlive or run
+// alongside it.
+func (w *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if w == nil || InUnitOfWork(ctx) {
+		return fn(ctx)
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin unit of work: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	u := &unit{tx: tx}
+	if err := fn(context.WithValue(ctx, txKey{}, u)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit unit of work: %w", err)
+	}
+	for _, fn := range u.afterCommit {
+		fn()
+	}
+	return nil
+}
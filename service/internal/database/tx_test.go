@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestUnitOfWork_Nil(t *testing.T) {
+	var w *UnitOfWork
+	errBoom := errors.New("boom")
+
+	err := w.Do(context.Background(), func(ctx context.Context) error {
+		if InUnitOfWork(ctx) {
+			t.Error("expected no transaction without a unit of work")
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Errorf("expected fn's error, got %v", err)
+	}
+}
+
+func TestConn_OutsideUnitOfWork(t *testing.T) {
+	if q := Conn(context.Background(), nil); q == nil {
+		t.Error("expected the pool outside a unit of work")
+	}
+
+	ran := false
+	AfterCommit(context.Background(), func() { ran = true })
+	if !ran {
+		t.Error("expected AfterCommit to run right away outside a unit of work")
+	}
+}
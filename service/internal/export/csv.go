@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// CSVExporter renders invoices as CSV files
+type CSVExporter struct {
+	invoices *store.InvoiceStore
+}
+
+// NewCSVExporter creates a CSV exporter. The invoice store is used to look
+// up the original invoice number on credit notes.
+func NewCSVExporter(invoices *store.InvoiceStore) *CSVExporter {
+	return &CSVExporter{invoices: invoices}
+}
+
+// Format implements Exporter
+func (e *CSVExporter) Format() string {
+	return FormatCSV
+}
+
+// Export implements Exporter
+func (e *CSVExporter) Export(ctx context.Context, userID uuid.UUID, invoice *store.Invoice, previous *store.InvoiceExport) (*Artifact, error) {
+	loc := locale.FromContext(ctx)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	// Write header rows
+	w.Write([]string{loc.T(locale.InvoiceNumber), invoice.InvoiceNumber})
+	if invoice.Kind == store.InvoiceKindCreditNote && invoice.OriginalInvoiceID != nil && e.invoices != nil {
+		if original, err := e.invoices.GetByID(ctx, userID, *invoice.OriginalInvoiceID); err == nil {
+			w.Write([]string{loc.T(locale.CreditNoteFor), original.InvoiceNumber})
+		}
+	}
+	if invoice.Project != nil {
+		w.Write([]string{loc.T(locale.InvoiceProject), invoice.Project.Name})
+		if invoice.Project.Client != nil && *invoice.Project.Client != "" {
+			w.Write([]string{loc.T(locale.InvoiceClient), *invoice.Project.Client})
+		}
+	}
+	w.Write([]string{loc.T(locale.InvoicePeriod), fmt.Sprintf(loc.T(locale.InvoicePeriodRange), loc.FormatDate(invoice.PeriodStart), loc.FormatDate(invoice.PeriodEnd))})
+	w.Write([]string{loc.T(locale.InvoiceDate), loc.FormatDate(invoice.InvoiceDate)})
+	w.Write([]string{loc.T(locale.InvoiceStatus), invoice.Status})
+	w.Write([]string{}) // Empty row
+
+	// Write column headers
+	w.Write([]string{loc.T(locale.ColumnDate), loc.T(locale.ColumnDescription), loc.T(locale.ColumnHours), loc.T(locale.ColumnRate), loc.T(locale.ColumnAmount)})
+
+	lines, totalHours, totalAmount := exportLines(invoice)
+	for _, item := range lines {
+		if !item.HasHours() {
+			w.Write([]string{
+				loc.FormatDate(item.Date),
+				item.Description,
+				"",
+				"",
+				loc.FormatNumber(item.Amount, 2),
+			})
+			continue
+		}
+		w.Write([]string{
+			loc.FormatDate(item.Date),
+			item.Description,
+			loc.FormatNumber(item.Hours, 2),
+			loc.FormatNumber(item.HourlyRate, 2),
+			loc.FormatNumber(item.Amount, 2),
+		})
+		for _, n := range item.Notes {
+			w.Write([]string{"", noteText(n, loc), "", "", ""})
+		}
+	}
+
+	// Write totals row
+	w.Write([]string{}) // Empty row
+	w.Write([]string{
+		loc.T(locale.InvoiceTotal),
+		"",
+		loc.FormatNumber(totalHours, 2),
+		"",
+		loc.FormatNumber(totalAmount, 2),
+	})
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return &Artifact{
+		ContentType: "text/csv",
+		Filename:    invoice.InvoiceNumber + ".csv",
+		Content:     buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,182 @@
+// Package export renders invoices into external formats (CSV, PDF, Google
+// Sheets) behind a common Exporter interface, and records each export in
+// the invoice_exports table.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Export formats
+const (
+	FormatCSV    = "csv"
+	FormatPDF    = "pdf"
+	FormatSheets = "sheets"
+)
+
+var (
+	// ErrUnknownFormat is returned when no exporter is registered for a format
+	ErrUnknownFormat = errors.New("unknown export format")
+	// ErrNoConnection is returned by exporters that need a Google connection
+	ErrNoConnection = errors.New("no Google Calendar connection found")
+	// ErrConsentRequired is returned by exporters whose Google access the
+	// user hasn't granted yet
+	ErrConsentRequired = errors.New("google access not granted")
+	// ErrExportFailed wraps failures from an external export target
+	ErrExportFailed = errors.New("export failed")
+)
+
+// Artifact is the result of rendering an invoice
+type Artifact struct {
+	ContentType string
+	Filename    string
+	Content     []byte  // Rendered file (file formats)
+	ExternalID  *string // Remote document ID (external formats)
+	ExternalURL *string
+	Metadata    map[string]interface{}
+}
+
+// Exporter renders an invoice in a single format
+type Exporter interface {
+	// Format returns the format name used in the API (e.g. "csv")
+	Format() string
+	// Export renders the invoice. previous is the last export in this format,
+	// or nil, so external exporters can update a document in place.
+	Export(ctx context.Context, userID uuid.UUID, invoice *store.Invoice, previous *store.InvoiceExport) (*Artifact, error)
+}
+
+// Options controls what an export includes
+type Options struct {
+	// IncludeNotes adds each time entry's notes below its line
+	IncludeNotes bool
+}
+
+// Service dispatches exports to registered exporters and records the results
+type Service struct {
+	invoices  *store.InvoiceStore
+	exports   *store.InvoiceExportStore
+	notes     *store.TimeEntryNoteStore
+	users     *store.UserStore
+	exporters map[string]Exporter
+}
+
+// NewService creates an export service with the given exporters. Exports
+// are rendered in the user's locale when users is set.
+func NewService(invoices *store.InvoiceStore, exports *store.InvoiceExportStore, notes *store.TimeEntryNoteStore, users *store.UserStore, exporters ...Exporter) *Service {
+	s := &Service{
+		invoices:  invoices,
+		exports:   exports,
+		notes:     notes,
+		users:     users,
+		exporters: make(map[string]Exporter),
+	}
+	for _, e := range exporters {
+		s.exporters[e.Format()] = e
+	}
+	return s
+}
+
+// Formats returns the registered format names in sorted order
+func (s *Service) Formats() []string {
+	formats := make([]string, 0, len(s.exporters))
+	for f := range s.exporters {
+		formats = append(formats, f)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// Export renders an invoice in the given format and records the artifact
+func (s *Service) Export(ctx context.Context, userID, invoiceID uuid.UUID, format string, opts Options) (*store.InvoiceExport, error) {
+	exporter, ok := s.exporters[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+
+	invoice, err := s.invoices.GetByID(ctx, userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.IncludeNotes && s.notes != nil {
+		notes, err := s.notes.ListForInvoice(ctx, userID, invoiceID)
+		if err != nil {
+			return nil, err
+		}
+		for i, item := range invoice.LineItems {
+			if item.TimeEntryID != nil {
+				invoice.LineItems[i].Notes = notes[*item.TimeEntryID]
+			}
+		}
+	}
+
+	previous, err := s.exports.GetForInvoice(ctx, userID, invoiceID, format)
+	if err != nil && !errors.Is(err, store.ErrInvoiceExportNotFound) {
+		return nil, err
+	}
+
+	if s.users != nil {
+		loc, err := s.users.GetLocale(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		ctx = locale.NewContext(ctx, loc)
+	}
+
+	artifact, err := exporter.Export(ctx, userID, invoice, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.exports.Save(ctx, &store.InvoiceExport{
+		UserID:      userID,
+		InvoiceID:   invoiceID,
+		Format:      format,
+		ContentType: artifact.ContentType,
+		Filename:    artifact.Filename,
+		Content:     artifact.Content,
+		ExternalID:  artifact.ExternalID,
+		ExternalURL: artifact.ExternalURL,
+		Metadata:    artifact.Metadata,
+	})
+}
+
+// exportLines returns the line items shown on exports: entries with hours
+// (matching the UI default of hiding 0h entries), all expenses, fees and
+// adjustments. Overage hours are already counted by the time entries, so
+// only those add to the total hours.
+func exportLines(invoice *store.Invoice) (lines []store.InvoiceLineItem, totalHours, totalAmount float64) {
+	for _, item := range invoice.LineItems {
+		if !item.HasHours() {
+			lines = append(lines, item)
+			totalAmount += item.Amount
+		} else if item.Hours > 0 {
+			lines = append(lines, item)
+			if item.Kind == store.LineItemKindTime {
+				totalHours += item.Hours
+			}
+			totalAmount += item.Amount
+		}
+	}
+	return lines, totalHours, totalAmount
+}
+
+// noteText formats a time entry note for an export line
+func noteText(n *store.TimeEntryNote, loc *locale.Locale) string {
+	return fmt.Sprintf(loc.T(locale.InvoiceNote), n.AuthorName, loc.FormatDate(n.CreatedAt), n.Body)
+}
+
+// documentTitle returns "Invoice" or "Credit Note" for headings
+func documentTitle(invoice *store.Invoice, loc *locale.Locale) string {
+	if invoice.Kind == store.InvoiceKindCreditNote {
+		return loc.T(locale.CreditNoteTitle)
+	}
+	return loc.T(locale.InvoiceTitle)
+}
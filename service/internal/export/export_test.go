@@ -0,0 +1,207 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/locale"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func testInvoice() *store.Invoice {
+	client := "Acme Corp"
+	entryID := uuid.New()
+	day := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	return &store.Invoice{
+		ID:            uuid.New(),
+		InvoiceNumber: "ACME-2026-001",
+		PeriodStart:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:     time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		InvoiceDate:   time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		Status:        "draft",
+		Kind:          store.InvoiceKindInvoice,
+		Project:       &store.Project{Name: "Acme", Client: &client},
+		LineItems: []store.InvoiceLineItem{
+			{TimeEntryID: &entryID, Kind: store.LineItemKindTime, Date: day, Description: "Design review (v2)", Hours: 2, HourlyRate: 100, Amount: 200},
+			{Kind: store.LineItemKindTime, Date: day, Description: "Zero hours", Hours: 0, HourlyRate: 100, Amount: 0},
+			{Kind: store.LineItemKindAdjustment, Date: day, Description: "Discount", Amount: -50},
+		},
+	}
+}
+
+func TestCSVExporter_IncludesAdjustmentsAndSkipsZeroHours(t *testing.T) {
+	artifact, err := NewCSVExporter(nil).Export(context.Background(), uuid.New(), testInvoice(), nil)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if artifact.Filename != "ACME-2026-001.csv" {
+		t.Errorf("Filename = %q", artifact.Filename)
+	}
+
+	// Header rows have fewer fields than the table, so allow ragged rows
+	r := csv.NewReader(bytes.NewReader(artifact.Content))
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+
+	var descriptions []string
+	var total []string
+	inTable := false
+	for _, rec := range records {
+		if len(rec) == 5 && rec[0] == "Date" {
+			inTable = true
+			continue
+		}
+		if len(rec) == 5 && rec[0] == "Total" {
+			total = rec
+			break
+		}
+		if inTable && len(rec) == 5 {
+			descriptions = append(descriptions, rec[1])
+		}
+	}
+
+	if strings.Join(descriptions, "|") != "Design review (v2)|Discount" {
+		t.Errorf("line descriptions = %v, want design review and discount only", descriptions)
+	}
+	if total == nil || total[2] != "2.00" || total[4] != "150.00" {
+		t.Errorf("totals row = %v, want 2.00 hours and 150.00 amount", total)
+	}
+}
+
+func TestCSVExporter_WritesNotesBelowTheirLine(t *testing.T) {
+	invoice := testInvoice()
+	invoice.LineItems[0].Notes = []*store.TimeEntryNote{{
+		AuthorName: "Pat",
+		Body:       "Client asked to round this down",
+		CreatedAt:  time.Date(2026, 1, 20, 9, 0, 0, 0, time.UTC),
+	}}
+
+	artifact, err := NewCSVExporter(nil).Export(context.Background(), uuid.New(), invoice, nil)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	want := "Design review (v2),2.00,100.00,200.00\n,\"Note (Pat, 2026-01-20): Client asked to round this down\",,,\n"
+	if !strings.Contains(string(artifact.Content), want) {
+		t.Errorf("expected the note on the row after its line, got:\n%s", artifact.Content)
+	}
+}
+
+func TestCSVExporter_UsesContextLocale(t *testing.T) {
+	de, _ := locale.Lookup("de-DE")
+	ctx := locale.NewContext(context.Background(), de)
+
+	artifact, err := NewCSVExporter(nil).Export(ctx, uuid.New(), testInvoice(), nil)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	content := string(artifact.Content)
+	for _, want := range []string{
+		"Rechnungsnummer:,ACME-2026-001\n",
+		"Zeitraum:,01.01.2026 bis 31.01.2026\n",
+		"Datum,Beschreibung,Stunden,Satz,Betrag\n",
+		"15.01.2026,Design review (v2),\"2,00\",\"100,00\",\"200,00\"\n",
+		"Summe,,\"2,00\",,\"150,00\"\n",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in:\n%s", want, content)
+		}
+	}
+}
+
+func TestExportLines_RetainerOverageCountsAmountNotHours(t *testing.T) {
+	entryID := uuid.New()
+	day := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	invoice := &store.Invoice{
+		LineItems: []store.InvoiceLineItem{
+			{TimeEntryID: &entryID, Kind: store.LineItemKindTime, Date: day, Description: "Build", Hours: 25},
+			{Kind: store.LineItemKindFee, Date: day, Description: "Retainer, January 2026", Amount: 2000},
+			{Kind: store.LineItemKindOverage, Date: day, Description: "Overage, January 2026 (20.00h included)", Hours: 5, HourlyRate: 150, Amount: 750},
+		},
+	}
+
+	lines, totalHours, totalAmount := exportLines(invoice)
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	if totalHours != 25 {
+		t.Errorf("total hours = %v, want 25 (overage hours are not extra work)", totalHours)
+	}
+	if totalAmount != 2750 {
+		t.Errorf("total amount = %v, want 2750", totalAmount)
+	}
+}
+
+func TestPDFExporter_ProducesValidStructure(t *testing.T) {
+	artifact, err := NewPDFExporter().Export(context.Background(), uuid.New(), testInvoice(), nil)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	pdf := string(artifact.Content)
+
+	if !strings.HasPrefix(pdf, "%PDF-1.4\n") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Fatalf("missing PDF header or trailer")
+	}
+	if !strings.Contains(pdf, `Design review \(v2\)`) {
+		t.Errorf("expected escaped line item description in content stream")
+	}
+
+	// startxref must point at the xref table
+	idx := strings.LastIndex(pdf, "startxref\n")
+	var offset int
+	if _, err := fmt.Sscanf(pdf[idx+len("startxref\n"):], "%d", &offset); err != nil {
+		t.Fatalf("parsing startxref: %v", err)
+	}
+	if !strings.HasPrefix(pdf[offset:], "xref\n") {
+		t.Errorf("startxref offset %d does not point at xref table", offset)
+	}
+}
+
+func TestRenderPDF_Paginates(t *testing.T) {
+	lines := make([]pdfLine, pdfLinesPerPage*2+1)
+	for i := range lines {
+		lines[i] = pdfLine{text: fmt.Sprintf("line %d", i)}
+	}
+	pdf := string(renderPDF(lines))
+	if !strings.Contains(pdf, "/Count 3 >>") {
+		t.Errorf("expected 3 pages for %d lines", len(lines))
+	}
+}
+
+func TestPDFEscape(t *testing.T) {
+	tests := map[string]string{
+		`a(b)c`:  `a\(b\)c`,
+		`back\`:  `back\\`,
+		"café":   `caf\351`,
+		"日本":     "??",
+		"tab\tx": "tab?x",
+	}
+	for in, want := range tests {
+		if got := pdfEscape(in); got != want {
+			t.Errorf("pdfEscape(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestService_UnknownFormat(t *testing.T) {
+	svc := NewService(nil, nil, nil, nil, NewCSVExporter(nil), NewPDFExporter())
+	if got := strings.Join(svc.Formats(), ","); got != "csv,pdf" {
+		t.Errorf("Formats() = %s, want csv,pdf", got)
+	}
+	_, err := svc.Export(context.Background(), uuid.New(), uuid.New(), "xlsx", Options{})
+	if !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("expected ErrUnknownFormat, got %v", err)
+	}
+}
@@ -0,0 +1,209 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Page layout for the PDF renderer (US Letter, points)
+const (
+	pdfPageWidth    = 612
+	pdfPageHeight   = 792
+	pdfMargin       = 50
+	pdfFontSize     = 10
+	pdfLineHeight   = 14
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+)
+
+// PDFExporter renders invoices as simple text PDFs. It uses the built-in
+// Courier fonts so columns line up without embedding font metrics.
+type PDFExporter struct{}
+
+// NewPDFExporter creates a PDF exporter
+func NewPDFExporter() *PDFExporter {
+	return &PDFExporter{}
+}
+
+// Format implements Exporter
+func (e *PDFExporter) Format() string {
+	return FormatPDF
+}
+
+// Export implements Exporter
+func (e *PDFExporter) Export(ctx context.Context, userID uuid.UUID, invoice *store.Invoice, previous *store.InvoiceExport) (*Artifact, error) {
+	return &Artifact{
+		ContentType: "application/pdf",
+		Filename:    invoice.InvoiceNumber + ".pdf",
+		Content:     renderPDF(invoiceTextLines(invoice, locale.FromContext(ctx))),
+	}, nil
+}
+
+// pdfLine is one line of monospaced text
+type pdfLine struct {
+	text string
+	bold bool
+}
+
+// invoiceTextLines lays out an invoice as fixed-width text lines
+func invoiceTextLines(invoice *store.Invoice, loc *locale.Locale) []pdfLine {
+	const row = "%-10s  %-44s %7s %9s %10s"
+
+	// label pads a header label so the values line up
+	label := func(key string) string {
+		return padRight(loc.T(key), 18)
+	}
+
+	lines := []pdfLine{
+		{text: strings.ToUpper(documentTitle(invoice, loc)) + " " + invoice.InvoiceNumber, bold: true},
+		{},
+	}
+	if invoice.Project != nil {
+		lines = append(lines, pdfLine{text: label(locale.InvoiceProject) + invoice.Project.Name})
+		if invoice.Project.Client != nil && *invoice.Project.Client != "" {
+			lines = append(lines, pdfLine{text: label(locale.InvoiceClient) + *invoice.Project.Client})
+		}
+	}
+	lines = append(lines,
+		pdfLine{text: label(locale.InvoicePeriod) + fmt.Sprintf(loc.T(locale.InvoicePeriodRange), loc.FormatDate(invoice.PeriodStart), loc.FormatDate(invoice.PeriodEnd))},
+		pdfLine{text: label(locale.InvoiceDate) + loc.FormatDate(invoice.InvoiceDate)},
+		pdfLine{text: label(locale.InvoiceStatus) + invoice.Status},
+		pdfLine{},
+		pdfLine{text: fmt.Sprintf(row,
+			truncate(loc.T(locale.ColumnDate), 10), loc.T(locale.ColumnDescription),
+			truncate(loc.T(locale.ColumnHours), 7), truncate(loc.T(locale.ColumnRate), 9), truncate(loc.T(locale.ColumnAmount), 10),
+		), bold: true},
+		pdfLine{text: strings.Repeat("-", 85)},
+	)
+
+	items, totalHours, totalAmount := exportLines(invoice)
+	for _, item := range items {
+		hours, rate := "", ""
+		if item.HasHours() {
+			hours = loc.FormatNumber(item.Hours, 2)
+			rate = loc.FormatNumber(item.HourlyRate, 2)
+		}
+		lines = append(lines, pdfLine{text: fmt.Sprintf(row,
+			loc.FormatDate(item.Date),
+			truncate(item.Description, 44),
+			hours, rate,
+			loc.FormatNumber(item.Amount, 2),
+		)})
+		for _, n := range item.Notes {
+			lines = append(lines, pdfLine{text: strings.Repeat(" ", 12) + truncate(noteText(n, loc), 73)})
+		}
+	}
+
+	lines = append(lines,
+		pdfLine{text: strings.Repeat("-", 85)},
+		pdfLine{text: fmt.Sprintf(row, loc.T(locale.InvoiceTotal), "", loc.FormatNumber(totalHours, 2), "", loc.FormatNumber(totalAmount, 2)), bold: true},
+	)
+	return lines
+}
+
+// padRight pads s with spaces to n characters, counting runes rather than
+// bytes so accented labels line up
+func padRight(s string, n int) string {
+	if pad := n - utf8.RuneCountInString(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s + " "
+}
+
+// truncate shortens s to at most n characters, marking the cut with "..."
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
+
+// renderPDF writes a minimal PDF 1.4 document containing the given lines,
+// paginated at pdfLinesPerPage
+func renderPDF(lines []pdfLine) []byte {
+	var pages [][]pdfLine
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// Object numbers: 1 catalog, 2 page tree, 3-4 fonts, then a page and
+	// content stream object per page
+	var objects []string
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+	)
+
+	for i, page := range pages {
+		var stream strings.Builder
+		fmt.Fprintf(&stream, "BT\n%d TL\n%d %d Td\n", pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range page {
+			font := "F1"
+			if line.bold {
+				font = "F2"
+			}
+			fmt.Fprintf(&stream, "/%s %d Tf\n(%s) Tj\nT*\n", font, pdfFontSize, pdfEscape(line.text))
+		}
+		stream.WriteString("ET")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, 6+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", stream.Len(), stream.String()),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// pdfEscape escapes a string for use in a PDF literal. Latin-1 letters are
+// written as octal escapes, which WinAnsiEncoding maps to the same
+// characters; anything else outside printable ASCII is replaced since the
+// standard fonts have no Unicode mapping.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&b, "\\%03o", r)
+		case r < 32 || r > 126:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,179 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// SheetsExporter writes invoices to a per-project Google Sheets spreadsheet,
+// one worksheet per invoice plus an "Invoices" summary sheet
+type SheetsExporter struct {
+	sheets    *google.SheetsService
+	calendars *store.CalendarConnectionStore
+	projects  *store.ProjectStore
+	invoices  *store.InvoiceStore
+}
+
+// NewSheetsExporter creates a Google Sheets exporter
+func NewSheetsExporter(sheets *google.SheetsService, calendars *store.CalendarConnectionStore, projects *store.ProjectStore, invoices *store.InvoiceStore) *SheetsExporter {
+	return &SheetsExporter{
+		sheets:    sheets,
+		calendars: calendars,
+		projects:  projects,
+		invoices:  invoices,
+	}
+}
+
+// Format implements Exporter
+func (e *SheetsExporter) Format() string {
+	return FormatSheets
+}
+
+// Export implements Exporter
+func (e *SheetsExporter) Export(ctx context.Context, userID uuid.UUID, invoice *store.Invoice, previous *store.InvoiceExport) (*Artifact, error) {
+	// Get OAuth credentials from calendar connection
+	// First get list to find connection ID, then get full connection with credentials
+	conns, err := e.calendars.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(conns) == 0 {
+		return nil, ErrNoConnection
+	}
+	// Connecting a calendar doesn't grant Drive access; the user adds it
+	// through incremental consent before their first export
+	if !google.HasAccess(conns[0].GrantedScopes, google.AccessSheets) {
+		return nil, ErrConsentRequired
+	}
+	// Get full connection with credentials (List doesn't include credentials for security)
+	conn, err := e.calendars.GetByID(ctx, userID, conns[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create OAuth token
+	token := e.sheets.TokenFromConnection(conn)
+
+	// Prepare invoice data, filtering out 0h entries (matching UI default)
+	invoiceData := google.InvoiceData{
+		InvoiceNumber: invoice.InvoiceNumber,
+		ProjectName:   invoice.Project.Name,
+		PeriodStart:   invoice.PeriodStart,
+		PeriodEnd:     invoice.PeriodEnd,
+		InvoiceDate:   invoice.InvoiceDate,
+		Status:        invoice.Status,
+	}
+	if invoice.Project.Client != nil {
+		invoiceData.Client = *invoice.Project.Client
+	}
+	lines, totalHours, totalAmount := exportLines(invoice)
+	for _, item := range lines {
+		description := item.Description
+		for _, n := range item.Notes {
+			description += "\n" + noteText(n, locale.FromContext(ctx))
+		}
+		invoiceData.LineItems = append(invoiceData.LineItems, google.InvoiceLineItemData{
+			Date:        item.Date,
+			Description: description,
+			Hours:       item.Hours,
+			HourlyRate:  item.HourlyRate,
+			Amount:      item.Amount,
+		})
+	}
+	invoiceData.TotalHours = totalHours
+	invoiceData.TotalAmount = totalAmount
+
+	// Check if project already has a spreadsheet
+	project, err := e.projects.GetByID(ctx, userID, invoice.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var spreadsheetID string
+	var spreadsheetURL string
+
+	if project.SheetsSpreadsheetID == nil || *project.SheetsSpreadsheetID == "" {
+		// Create new spreadsheet
+		title := fmt.Sprintf("%s - Invoices", project.Name)
+		spreadsheetID, spreadsheetURL, err = e.sheets.CreateSpreadsheet(ctx, token, title)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to create spreadsheet: %s", ErrExportFailed, err.Error())
+		}
+
+		// Update project with spreadsheet info
+		updates := map[string]interface{}{
+			"sheets_spreadsheet_id":  spreadsheetID,
+			"sheets_spreadsheet_url": spreadsheetURL,
+		}
+		_, err = e.projects.Update(ctx, userID, project.ID, updates)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		spreadsheetID = *project.SheetsSpreadsheetID
+		if project.SheetsSpreadsheetURL != nil {
+			spreadsheetURL = *project.SheetsSpreadsheetURL
+		}
+	}
+
+	// Re-export into the existing worksheet if this invoice was exported
+	// to the same spreadsheet before
+	worksheetTitle := invoice.InvoiceNumber
+	var worksheetID int
+
+	if previous != nil && previous.ExternalID != nil && *previous.ExternalID == spreadsheetID && previous.Metadata["worksheet_id"] != nil {
+		worksheetID, err = e.sheets.UpdateInvoiceWorksheet(ctx, token, spreadsheetID, worksheetTitle, invoiceData)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to update worksheet: %s", ErrExportFailed, err.Error())
+		}
+	} else {
+		worksheetID, err = e.sheets.CreateInvoiceWorksheet(ctx, token, spreadsheetID, worksheetTitle, invoiceData)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to create worksheet: %s", ErrExportFailed, err.Error())
+		}
+	}
+
+	// Update the Invoices summary sheet with all exported invoices for this project
+	allInvoices, err := e.invoices.List(ctx, userID, &invoice.ProjectID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Filter to invoices that have been exported (have worksheet_id). The
+	// current invoice's export hasn't been recorded yet, so include it explicitly.
+	var summaryData []google.InvoiceSummaryData
+	for _, inv := range allInvoices {
+		if inv.WorksheetID != nil || inv.ID == invoice.ID {
+			summaryData = append(summaryData, google.InvoiceSummaryData{
+				InvoiceNumber: inv.InvoiceNumber,
+				PeriodStart:   inv.PeriodStart,
+				PeriodEnd:     inv.PeriodEnd,
+				InvoiceDate:   inv.InvoiceDate,
+				Status:        inv.Status,
+				TotalHours:    inv.TotalHours,
+				TotalAmount:   inv.TotalAmount,
+			})
+		}
+	}
+
+	err = e.sheets.UpdateInvoicesSummary(ctx, token, spreadsheetID, summaryData)
+	if err != nil {
+		// Log but don't fail the export if summary update fails
+		// The individual invoice sheet was already created successfully
+		log.Printf("Warning: failed to update Invoices summary sheet: %v", err)
+	}
+
+	return &Artifact{
+		ContentType: "application/vnd.google-apps.spreadsheet",
+		Filename:    worksheetTitle,
+		ExternalID:  &spreadsheetID,
+		ExternalURL: &spreadsheetURL,
+		Metadata:    map[string]interface{}{"worksheet_id": worksheetID},
+	}, nil
+}
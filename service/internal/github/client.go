@@ -0,0 +1,134 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL is the public GitHub REST API
+const DefaultAPIURL = "https://api.github.com"
+
+// searchPageSize is the most results a GitHub search returns per page; a
+// single page covers any realistic day of activity
+const searchPageSize = 100
+
+// ErrUnauthorized is returned when GitHub rejects the access token
+var ErrUnauthorized = errors.New("github rejected the access token")
+
+// Client fetches a user's GitHub activity
+type Client interface {
+	// Activity returns the commits authored and pull requests reviewed by
+	// username on the given UTC day
+	Activity(ctx context.Context, token, username string, day time.Time) ([]Activity, error)
+}
+
+// HTTPClient is a Client for the GitHub REST search API
+type HTTPClient struct {
+	baseURL string
+	http    *http.Client
+}
+
+// NewHTTPClient creates a client for the API at baseURL
+func NewHTTPClient(baseURL string) *HTTPClient {
+	return &HTTPClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:    &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Activity implements Client
+func (c *HTTPClient) Activity(ctx context.Context, token, username string, day time.Time) ([]Activity, error) {
+	date := day.UTC().Format("2006-01-02")
+
+	var commits struct {
+		Items []struct {
+			HTMLURL string `json:"html_url"`
+			Commit  struct {
+				Message string `json:"message"`
+				Author  struct {
+					Date time.Time `json:"date"`
+				} `json:"author"`
+			} `json:"commit"`
+			Repository struct {
+				FullName string `json:"full_name"`
+			} `json:"repository"`
+		} `json:"items"`
+	}
+	if err := c.search(ctx, token, "commits", fmt.Sprintf("author:%s author-date:%s", username, date), &commits); err != nil {
+		return nil, fmt.Errorf("search commits: %w", err)
+	}
+
+	var reviews struct {
+		Items []struct {
+			Number        int       `json:"number"`
+			Title         string    `json:"title"`
+			HTMLURL       string    `json:"html_url"`
+			RepositoryURL string    `json:"repository_url"`
+			UpdatedAt     time.Time `json:"updated_at"`
+		} `json:"items"`
+	}
+	// Search can't filter on review time, so this finds PRs the user has
+	// reviewed that changed that day
+	if err := c.search(ctx, token, "issues", fmt.Sprintf("type:pr reviewed-by:%s updated:%s -author:%s", username, date, username), &reviews); err != nil {
+		return nil, fmt.Errorf("search reviews: %w", err)
+	}
+
+	activities := make([]Activity, 0, len(commits.Items)+len(reviews.Items))
+	for _, item := range commits.Items {
+		title, _, _ := strings.Cut(strings.TrimSpace(item.Commit.Message), "\n")
+		activities = append(activities, Activity{
+			Kind:  ActivityCommit,
+			Repo:  strings.ToLower(item.Repository.FullName),
+			Title: strings.TrimSpace(title),
+			URL:   item.HTMLURL,
+			At:    item.Commit.Author.Date,
+		})
+	}
+	for _, item := range reviews.Items {
+		_, repo, _ := strings.Cut(item.RepositoryURL, "/repos/")
+		activities = append(activities, Activity{
+			Kind:   ActivityReview,
+			Repo:   strings.ToLower(repo),
+			Title:  item.Title,
+			Number: item.Number,
+			URL:    item.HTMLURL,
+			At:     item.UpdatedAt,
+		})
+	}
+	return activities, nil
+}
+
+// search runs a GitHub search query and decodes the response into out
+func (c *HTTPClient) search(ctx context.Context, token, kind, query string, out any) error {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("per_page", fmt.Sprint(searchPageSize))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/search/"+kind+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("github returned %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
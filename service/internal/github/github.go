@@ -0,0 +1,95 @@
+// Package github turns a user's GitHub activity for a day (commits and pull
+// request reviews) into suggested time entry descriptions for the projects
+// that own the repositories.
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ActivityKind identifies the type of GitHub activity
+type ActivityKind string
+
+const (
+	ActivityCommit ActivityKind = "commit"
+	ActivityReview ActivityKind = "review"
+)
+
+// Activity is a single commit or pull request review
+type Activity struct {
+	Kind   ActivityKind
+	Repo   string // "owner/name", lower-cased
+	Title  string // First line of the commit message, or the PR title
+	Number int    // Pull request number, for reviews
+	URL    string
+	At     time.Time
+}
+
+// Summary renders the activity as one description item
+func (a Activity) Summary() string {
+	if a.Kind == ActivityReview {
+		return fmt.Sprintf("Reviewed %s#%d: %s", a.Repo, a.Number, a.Title)
+	}
+	return a.Title
+}
+
+// Suggestion is the suggested description for one project's day
+type Suggestion struct {
+	ProjectID   uuid.UUID
+	Description string
+	Activities  []Activity
+}
+
+// Suggest groups activities by the project owning their repository and
+// builds a description for each, commits first and then reviews, in time
+// order. repoProjects maps lower-cased "owner/name" to a project; activity
+// in other repositories is ignored. Suggestions are ordered by project ID
+// for stable output.
+func Suggest(activities []Activity, repoProjects map[string]uuid.UUID) []Suggestion {
+	byProject := make(map[uuid.UUID][]Activity)
+	for _, a := range activities {
+		projectID, ok := repoProjects[strings.ToLower(a.Repo)]
+		if !ok {
+			continue
+		}
+		byProject[projectID] = append(byProject[projectID], a)
+	}
+
+	suggestions := make([]Suggestion, 0, len(byProject))
+	for projectID, acts := range byProject {
+		sort.SliceStable(acts, func(i, j int) bool {
+			if acts[i].Kind != acts[j].Kind {
+				return acts[i].Kind == ActivityCommit
+			}
+			return acts[i].At.Before(acts[j].At)
+		})
+
+		// The same commit can show up once per branch it was pushed to
+		seen := make(map[string]bool, len(acts))
+		var items []string
+		for _, a := range acts {
+			summary := a.Summary()
+			if summary == "" || seen[summary] {
+				continue
+			}
+			seen[summary] = true
+			items = append(items, summary)
+		}
+
+		suggestions = append(suggestions, Suggestion{
+			ProjectID:   projectID,
+			Description: strings.Join(items, "; "),
+			Activities:  acts,
+		})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		return suggestions[i].ProjectID.String() < suggestions[j].ProjectID.String()
+	})
+	return suggestions
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSuggest(t *testing.T) {
+	api := uuid.New()
+	at := func(hour int) time.Time { return time.Date(2025, 3, 3, hour, 0, 0, 0, time.UTC) }
+
+	activities := []Activity{
+		{Kind: ActivityReview, Repo: "acme/api", Title: "Add rate limits", Number: 42, At: at(9)},
+		{Kind: ActivityCommit, Repo: "acme/api", Title: "Fix login redirect", At: at(14)},
+		{Kind: ActivityCommit, Repo: "Acme/API", Title: "Add audit log", At: at(10)},
+		{Kind: ActivityCommit, Repo: "acme/api", Title: "Add audit log", At: at(11)},
+		{Kind: ActivityCommit, Repo: "someone/dotfiles", Title: "Tweak vimrc", At: at(12)},
+	}
+
+	suggestions := Suggest(activities, map[string]uuid.UUID{"acme/api": api})
+	if len(suggestions) != 1 {
+		t.Fatalf("expected one suggestion, got %d", len(suggestions))
+	}
+	s := suggestions[0]
+	if s.ProjectID != api {
+		t.Errorf("expected the api project, got %s", s.ProjectID)
+	}
+	want := "Add audit log; Fix login redirect; Reviewed acme/api#42: Add rate limits"
+	if s.Description != want {
+		t.Errorf("expected %q, got %q", want, s.Description)
+	}
+	if len(s.Activities) != 4 {
+		t.Errorf("expected the unmapped repository to be ignored, got %d activities", len(s.Activities))
+	}
+}
+
+func TestHTTPClient_Activity(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		queries = append(queries, r.URL.Query().Get("q"))
+		switch r.URL.Path {
+		case "/search/commits":
+			fmt.Fprint(w, `{"items": [{
+				"html_url": "https://github.com/acme/api/commit/abc",
+				"commit": {"message": "Add audit log\n\nLonger body", "author": {"date": "2025-03-03T10:00:00Z"}},
+				"repository": {"full_name": "Acme/API"}
+			}]}`)
+		case "/search/issues":
+			fmt.Fprint(w, `{"items": [{
+				"number": 42, "title": "Add rate limits",
+				"html_url": "https://github.com/acme/api/pull/42",
+				"repository_url": "https://api.github.com/repos/acme/api",
+				"updated_at": "2025-03-03T09:00:00Z"
+			}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(srv.URL)
+	day := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	activities, err := client.Activity(context.Background(), "secret", "octocat", day)
+	if err != nil {
+		t.Fatalf("Activity: %v", err)
+	}
+	if len(activities) != 2 {
+		t.Fatalf("expected a commit and a review, got %+v", activities)
+	}
+	if c := activities[0]; c.Kind != ActivityCommit || c.Title != "Add audit log" || c.Repo != "acme/api" {
+		t.Errorf("unexpected commit %+v", c)
+	}
+	if r := activities[1]; r.Kind != ActivityReview || r.Number != 42 || r.Repo != "acme/api" {
+		t.Errorf("unexpected review %+v", r)
+	}
+	if !strings.Contains(queries[0], "author-date:2025-03-03") || !strings.Contains(queries[1], "reviewed-by:octocat") {
+		t.Errorf("unexpected search queries %q", queries)
+	}
+
+	if _, err := client.Activity(context.Background(), "wrong", "octocat", day); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+)
+
+var (
+	// ErrNotConnected is returned when the user hasn't connected GitHub
+	ErrNotConnected = errors.New("github is not connected")
+	// ErrUnavailable wraps failures talking to GitHub
+	ErrUnavailable = errors.New("github request failed")
+)
+
+// DaySuggestion is a suggestion joined with the project and the time entry
+// it would describe
+type DaySuggestion struct {
+	Suggestion
+	ProjectName        string
+	TimeEntryID        *uuid.UUID // Nil when the project has no entry that day
+	Hours              float64
+	CurrentDescription *string
+}
+
+// Service suggests time entry descriptions from GitHub activity
+type Service struct {
+	connections  *store.GitHubConnectionStore
+	projects     *store.ProjectStore
+	timeEntrySvc *timeentry.Service
+	client       Client
+}
+
+// NewService creates a new GitHub suggestion service
+func NewService(connections *store.GitHubConnectionStore, projects *store.ProjectStore, timeEntrySvc *timeentry.Service, client Client) *Service {
+	return &Service{
+		connections:  connections,
+		projects:     projects,
+		timeEntrySvc: timeEntrySvc,
+		client:       client,
+	}
+}
+
+// SuggestDescriptions fetches the user's activity for a day and suggests a
+// description for each active project with GitHub repositories that saw
+// activity, alongside that project's time entry for the day if any.
+func (s *Service) SuggestDescriptions(ctx context.Context, userID uuid.UUID, day time.Time) ([]DaySuggestion, error) {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	conn, err := s.connections.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrGitHubConnectionNotFound) {
+			return nil, ErrNotConnected
+		}
+		return nil, err
+	}
+
+	projects, err := s.projects.List(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	repoProjects := make(map[string]uuid.UUID)
+	names := make(map[uuid.UUID]string)
+	for _, p := range projects {
+		for _, repo := range p.GitHubRepos {
+			repoProjects[strings.ToLower(repo)] = p.ID
+		}
+		names[p.ID] = p.Name
+	}
+	// Skip the GitHub calls when no project could receive a suggestion
+	if len(repoProjects) == 0 {
+		return []DaySuggestion{}, nil
+	}
+
+	activities, err := s.client.Activity(ctx, conn.Token, conn.Username, day)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+
+	entries, err := s.timeEntrySvc.ListWithEphemeral(ctx, userID, &day, &day, nil)
+	if err != nil {
+		return nil, err
+	}
+	entryByProject := make(map[uuid.UUID]*store.TimeEntry, len(entries))
+	for _, e := range entries {
+		entryByProject[e.ProjectID] = e
+	}
+
+	suggestions := Suggest(activities, repoProjects)
+	result := make([]DaySuggestion, len(suggestions))
+	for i, sg := range suggestions {
+		result[i] = DaySuggestion{Suggestion: sg, ProjectName: names[sg.ProjectID]}
+		if e, ok := entryByProject[sg.ProjectID]; ok {
+			id := e.ID
+			result[i].TimeEntryID = &id
+			result[i].Hours = e.Hours
+			result[i].CurrentDescription = e.Description
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,106 @@
+// Package goals tracks progress toward weekly and monthly hour targets.
+package goals
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Periods a goal can cover
+const (
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+// Goal is an hour target for a week or month, overall or for one project
+type Goal struct {
+	ProjectID    *uuid.UUID // Nil for all projects
+	Period       string
+	TargetHours  float64
+	BillableOnly bool
+}
+
+// Entry is one day of hours on one project
+type Entry struct {
+	ProjectID  uuid.UUID
+	IsBillable bool
+	Date       time.Time
+	Hours      float64
+}
+
+// Progress is how far a goal has come in the period containing a day
+type Progress struct {
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	ActualHours    float64
+	ExpectedHours  float64 // Target pro-rated by the capacity elapsed so far
+	RemainingHours float64 // Hours still needed; 0 once the target is met
+	Percent        float64 // Actual / target
+	OnTrack        bool    // Actual hours are at least the expected hours
+}
+
+// PeriodRange returns the first and last day of the period containing day.
+// Weeks start on Monday.
+func PeriodRange(period string, day time.Time) (time.Time, time.Time) {
+	day = truncateDay(day)
+	if period == PeriodMonth {
+		start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, -1)
+	}
+	start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	return start, start.AddDate(0, 0, 6)
+}
+
+// Compute measures the goal over the period containing asOf. dailyHours is
+// the capacity for each weekday, Monday first; the expected hours are the
+// target scaled by the share of the period's capacity up to and including
+// asOf, or by the share of days when the period has no capacity.
+func Compute(goal Goal, dailyHours [7]float64, entries []Entry, asOf time.Time) Progress {
+	asOf = truncateDay(asOf)
+	start, end := PeriodRange(goal.Period, asOf)
+	p := Progress{PeriodStart: start, PeriodEnd: end}
+
+	for _, e := range entries {
+		day := truncateDay(e.Date)
+		if day.Before(start) || day.After(end) {
+			continue
+		}
+		if goal.ProjectID != nil && e.ProjectID != *goal.ProjectID {
+			continue
+		}
+		if goal.BillableOnly && !e.IsBillable {
+			continue
+		}
+		p.ActualHours += e.Hours
+	}
+
+	var capacity, elapsedCapacity float64
+	var days, elapsedDays int
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		hours := dailyHours[(int(day.Weekday())+6)%7]
+		capacity += hours
+		days++
+		if !day.After(asOf) {
+			elapsedCapacity += hours
+			elapsedDays++
+		}
+	}
+	if capacity > 0 {
+		p.ExpectedHours = goal.TargetHours * elapsedCapacity / capacity
+	} else {
+		p.ExpectedHours = goal.TargetHours * float64(elapsedDays) / float64(days)
+	}
+
+	p.RemainingHours = max(goal.TargetHours-p.ActualHours, 0)
+	if goal.TargetHours > 0 {
+		p.Percent = p.ActualHours / goal.TargetHours
+	}
+	// Allow for rounding so a goal hit exactly counts as on track
+	p.OnTrack = p.ActualHours+1e-9 >= p.ExpectedHours
+	return p
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,73 @@
+package goals
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestPeriodRange(t *testing.T) {
+	// Wednesday 16 July 2025
+	start, end := PeriodRange(PeriodWeek, date("2025-07-16"))
+	if !start.Equal(date("2025-07-14")) || !end.Equal(date("2025-07-20")) {
+		t.Errorf("week = %s..%s, want 2025-07-14..2025-07-20", start, end)
+	}
+	start, end = PeriodRange(PeriodMonth, date("2025-02-10"))
+	if !start.Equal(date("2025-02-01")) || !end.Equal(date("2025-02-28")) {
+		t.Errorf("month = %s..%s, want 2025-02-01..2025-02-28", start, end)
+	}
+}
+
+func TestCompute_WeeklyBillableGoal(t *testing.T) {
+	billable := uuid.New()
+	internal := uuid.New()
+	profile := [7]float64{8, 8, 8, 8, 8, 0, 0}
+
+	entries := []Entry{
+		{ProjectID: billable, IsBillable: true, Date: date("2025-07-14"), Hours: 6},
+		{ProjectID: internal, Date: date("2025-07-14"), Hours: 2},
+		{ProjectID: billable, IsBillable: true, Date: date("2025-07-15"), Hours: 7},
+		// Previous week
+		{ProjectID: billable, IsBillable: true, Date: date("2025-07-11"), Hours: 8},
+	}
+
+	// Wednesday: three of five workdays have elapsed
+	p := Compute(Goal{Period: PeriodWeek, TargetHours: 30, BillableOnly: true}, profile, entries, date("2025-07-16"))
+
+	if !approx(p.ActualHours, 13) {
+		t.Errorf("actual = %v, want 13", p.ActualHours)
+	}
+	if !approx(p.ExpectedHours, 18) {
+		t.Errorf("expected = %v, want 18", p.ExpectedHours)
+	}
+	if !approx(p.RemainingHours, 17) || !approx(p.Percent, 13.0/30) {
+		t.Errorf("remaining/percent = %v/%v", p.RemainingHours, p.Percent)
+	}
+	if p.OnTrack {
+		t.Error("expected 13h of an expected 18h to be behind")
+	}
+
+	// The same hours against a goal for the internal project only
+	p = Compute(Goal{ProjectID: &internal, Period: PeriodWeek, TargetHours: 4}, profile, entries, date("2025-07-14"))
+	if !approx(p.ActualHours, 2) || !approx(p.ExpectedHours, 0.8) || !p.OnTrack {
+		t.Errorf("project goal = %+v", p)
+	}
+}
+
+func TestCompute_NoCapacityFallsBackToDays(t *testing.T) {
+	p := Compute(Goal{Period: PeriodWeek, TargetHours: 14}, [7]float64{}, nil, date("2025-07-16"))
+	if !approx(p.ExpectedHours, 6) {
+		t.Errorf("expected = %v, want 6 (3 of 7 days)", p.ExpectedHours)
+	}
+}
@@ -0,0 +1,118 @@
+package goals
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/aggregate"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Service loads goals, daily project totals and the working-hours profile to
+// report goal progress
+type Service struct {
+	goals        *store.HourGoalStore
+	aggregates   *aggregate.Service
+	projects     *store.ProjectStore
+	workingHours *store.WorkingHoursStore
+}
+
+// NewService creates a new goals service
+func NewService(goals *store.HourGoalStore, aggregates *aggregate.Service, projects *store.ProjectStore, workingHours *store.WorkingHoursStore) *Service {
+	return &Service{
+		goals:        goals,
+		aggregates:   aggregates,
+		projects:     projects,
+		workingHours: workingHours,
+	}
+}
+
+// GoalProgress is a stored goal with its progress
+type GoalProgress struct {
+	Goal        *store.HourGoal
+	ProjectName string // Empty for goals over all projects
+	Progress
+}
+
+// Progress reports every goal of the user over the period containing asOf.
+// Hours come from the daily project totals, which count both materialized
+// and computed time entries; projects that don't accumulate hours are left
+// out.
+func (s *Service) Progress(ctx context.Context, userID uuid.UUID, asOf time.Time) ([]GoalProgress, error) {
+	stored, err := s.goals.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(stored) == 0 {
+		return []GoalProgress{}, nil
+	}
+
+	profile, err := s.workingHours.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	projectMap := make(map[uuid.UUID]*store.Project, len(projects))
+	for _, p := range projects {
+		projectMap[p.ID] = p
+	}
+
+	// One load covers both the week and the month containing asOf
+	weekStart, weekEnd := PeriodRange(PeriodWeek, asOf)
+	monthStart, monthEnd := PeriodRange(PeriodMonth, asOf)
+	totals, err := s.aggregates.DailyHours(ctx, userID, earlier(weekStart, monthStart), later(weekEnd, monthEnd))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(totals))
+	for _, t := range totals {
+		p, ok := projectMap[t.ProjectID]
+		if !ok || p.DoesNotAccumulateHours {
+			continue
+		}
+		entries = append(entries, Entry{
+			ProjectID:  p.ID,
+			IsBillable: p.IsBillable,
+			Date:       t.Date,
+			Hours:      t.Hours,
+		})
+	}
+
+	result := make([]GoalProgress, len(stored))
+	for i, g := range stored {
+		goal := Goal{
+			ProjectID:    g.ProjectID,
+			Period:       g.Period,
+			TargetHours:  g.TargetHours,
+			BillableOnly: g.BillableOnly,
+		}
+		result[i] = GoalProgress{Goal: g, Progress: Compute(goal, profile.DailyHours, entries, asOf)}
+		if g.ProjectID != nil {
+			if p, ok := projectMap[*g.ProjectID]; ok {
+				result[i].ProjectName = p.Name
+			}
+		}
+	}
+	return result, nil
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -2,21 +2,22 @@ package google
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/michaelw/timesheet-app/service/internal/store"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/calendar/v3"
-	"google.golang.org/api/drive/v3"
 	"google.golang.org/api/option"
 )
 
 // CalendarClient defines the interface for Google Calendar API operations.
 // This interface enables mocking for testing.
 type CalendarClient interface {
-	// GetAuthURL returns the OAuth consent URL
-	GetAuthURL(state string) string
+	// GetAuthURL returns the OAuth consent URL for the scopes, or read-only
+	// calendar access without any. Scopes granted before are kept.
+	GetAuthURL(state string, scopes ...string) string
 
 	// ExchangeCode exchanges an authorization code for tokens
 	ExchangeCode(ctx context.Context, code string) (*store.OAuthCredentials, error)
@@ -32,6 +33,9 @@ type CalendarClient interface {
 
 	// FetchEventsIncremental fetches only changed events since the last sync
 	FetchEventsIncremental(ctx context.Context, creds *store.OAuthCredentials, calendarID string, syncToken string) (*SyncResult, error)
+
+	// WriteEventProject writes the event's project back to the calendar
+	WriteEventProject(ctx context.Context, creds *store.OAuthCredentials, calendarID, eventID string, project EventProject) error
 }
 
 // Ensure CalendarService implements CalendarClient
@@ -39,7 +43,8 @@ var _ CalendarClient = (*CalendarService)(nil)
 
 // CalendarService handles Google Calendar API interactions
 type CalendarService struct {
-	config *oauth2.Config
+	config             *oauth2.Config
+	serviceAccountKeys ServiceAccountKeys
 }
 
 // NewCalendarService creates a new Google Calendar service
@@ -49,15 +54,24 @@ func NewCalendarService(clientID, clientSecret, redirectURL string) *CalendarSer
 			ClientID:     clientID,
 			ClientSecret: clientSecret,
 			RedirectURL:  redirectURL,
-			Scopes:       []string{calendar.CalendarReadonlyScope, drive.DriveFileScope},
+			Scopes:       AccessCalendar.Scopes(),
 			Endpoint:     google.Endpoint,
 		},
 	}
 }
 
 // GetAuthURL returns the OAuth consent URL
-func (s *CalendarService) GetAuthURL(state string) string {
-	return s.config.AuthCodeURL(state, oauth2.AccessTypeOffline, oauth2.ApprovalForce)
+func (s *CalendarService) GetAuthURL(state string, scopes ...string) string {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.AccessTypeOffline,
+		oauth2.ApprovalForce,
+		// Incremental consent: the new token keeps the scopes granted before
+		oauth2.SetAuthURLParam("include_granted_scopes", "true"),
+	}
+	if len(scopes) > 0 {
+		opts = append(opts, oauth2.SetAuthURLParam("scope", strings.Join(scopes, " ")))
+	}
+	return s.config.AuthCodeURL(state, opts...)
 }
 
 // ExchangeCode exchanges an authorization code for tokens
@@ -72,11 +86,17 @@ func (s *CalendarService) ExchangeCode(ctx context.Context, code string) (*store
 		RefreshToken: token.RefreshToken,
 		TokenType:    token.TokenType,
 		Expiry:       token.Expiry,
+		Scopes:       grantedScopes(token),
 	}, nil
 }
 
-// RefreshToken refreshes an expired token
+// RefreshToken refreshes an expired token. Workspace connections get a
+// new token minted by their organization's service account.
 func (s *CalendarService) RefreshToken(ctx context.Context, creds *store.OAuthCredentials) (*store.OAuthCredentials, error) {
+	if creds.Delegation != nil {
+		return s.refreshDelegated(ctx, *creds.Delegation)
+	}
+
 	token := &oauth2.Token{
 		AccessToken:  creds.AccessToken,
 		RefreshToken: creds.RefreshToken,
@@ -0,0 +1,95 @@
+package google
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"golang.org/x/oauth2/google"
+)
+
+// errNoServiceAccountKeys is returned when refreshing a workspace
+// connection without a way to look up service account keys
+var errNoServiceAccountKeys = errors.New("service account keys are not configured")
+
+// ServiceAccountKeys looks up an organization's service account key
+type ServiceAccountKeys func(ctx context.Context, orgID uuid.UUID) ([]byte, error)
+
+// DelegatedCredentialsFunc mints credentials acting as a delegation's
+// subject with a service account key
+type DelegatedCredentialsFunc func(ctx context.Context, key []byte, d store.Delegation) (*store.OAuthCredentials, error)
+
+// DelegationScopes are the scopes workspace connections request. Admins
+// authorize the service account's client ID for them in the Google
+// Workspace admin console; write-back isn't offered, so they stay read-only.
+func DelegationScopes() []string {
+	return AccessCalendar.Scopes()
+}
+
+// ParseServiceAccountKey checks a service account's JSON key and returns
+// it with the client email and ID admins authorize for delegation
+func ParseServiceAccountKey(key []byte) (*store.ServiceAccount, error) {
+	var fields struct {
+		Type        string `json:"type"`
+		ClientEmail string `json:"client_email"`
+		ClientID    string `json:"client_id"`
+		PrivateKey  string `json:"private_key"`
+	}
+	if err := json.Unmarshal(key, &fields); err != nil {
+		return nil, fmt.Errorf("service account key is not JSON: %w", err)
+	}
+	if fields.Type != "service_account" {
+		return nil, fmt.Errorf("key type is %q, not service_account", fields.Type)
+	}
+	if fields.ClientEmail == "" || fields.ClientID == "" || fields.PrivateKey == "" {
+		return nil, errors.New("service account key needs client_email, client_id and private_key")
+	}
+	if _, err := google.JWTConfigFromJSON(key, DelegationScopes()...); err != nil {
+		return nil, err
+	}
+	return &store.ServiceAccount{Key: key, ClientEmail: fields.ClientEmail, ClientID: fields.ClientID}, nil
+}
+
+// DelegatedCredentials mints an access token for the delegation's subject
+// through domain-wide delegation. There is no refresh token: a new one is
+// minted the same way when it expires.
+func DelegatedCredentials(ctx context.Context, key []byte, d store.Delegation) (*store.OAuthCredentials, error) {
+	config, err := google.JWTConfigFromJSON(key, DelegationScopes()...)
+	if err != nil {
+		return nil, err
+	}
+	config.Subject = d.Subject
+
+	token, err := config.TokenSource(ctx).Token()
+	if err != nil {
+		return nil, err
+	}
+	return &store.OAuthCredentials{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      token.Expiry,
+		Scopes:      DelegationScopes(),
+		Delegation:  &d,
+	}, nil
+}
+
+// SetServiceAccountKeys lets RefreshToken mint tokens for workspace
+// connections with their organization's service account
+func (s *CalendarService) SetServiceAccountKeys(keys ServiceAccountKeys) {
+	s.serviceAccountKeys = keys
+}
+
+// refreshDelegated mints a new token for a workspace connection
+func (s *CalendarService) refreshDelegated(ctx context.Context, d store.Delegation) (*store.OAuthCredentials, error) {
+	if s.serviceAccountKeys == nil {
+		return nil, errNoServiceAccountKeys
+	}
+	key, err := s.serviceAccountKeys(ctx, d.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	return DelegatedCredentials(ctx, key, d)
+}
@@ -0,0 +1,327 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// fakeExpiry is when the fake's access tokens expire: far enough away that
+// a test never refreshes unless it asks to
+var fakeExpiry = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// FakeCalendarClient is an in-memory Google Calendar for end-to-end tests.
+// Where MockCalendarClient replays canned responses, the fake keeps events
+// as state and answers like Google does: full fetches return the events in
+// range, every change advances the calendar's sync token, and incremental
+// fetches return what changed since a token, deleted events included as
+// cancelled. Expired sync tokens get 410 Gone. Results are ordered by start
+// time and ID, so runs are repeatable.
+type FakeCalendarClient struct {
+	mu        sync.Mutex
+	calendars []*CalendarInfo
+	state     map[string]*fakeCalendar
+
+	// Call tracking
+	RefreshCalls     int               // number of RefreshToken calls
+	FetchCalls       []FetchCall       // calls to FetchEvents
+	IncrementalCalls []IncrementalCall // calls to FetchEventsIncremental
+	WriteCalls       []WriteCall       // calls to WriteEventProject
+}
+
+// fakeCalendar is one calendar's events and change log. seq counts changes;
+// changed holds the seq of each event's last change.
+type fakeCalendar struct {
+	events  map[string]*calendar.Event
+	changed map[string]int64
+	seq     int64
+	// Sync tokens issued before this seq have expired
+	validFrom int64
+}
+
+// NewFakeCalendarClient creates a fake with no calendars
+func NewFakeCalendarClient() *FakeCalendarClient {
+	return &FakeCalendarClient{state: make(map[string]*fakeCalendar)}
+}
+
+// Ensure FakeCalendarClient implements CalendarClient
+var _ CalendarClient = (*FakeCalendarClient)(nil)
+
+// AddCalendar adds a calendar the user has access to
+func (f *FakeCalendarClient) AddCalendar(info CalendarInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calendars = append(f.calendars, &info)
+	f.state[info.ID] = &fakeCalendar{
+		events:  make(map[string]*calendar.Event),
+		changed: make(map[string]int64),
+	}
+}
+
+// PutEvent creates or replaces an event. The event is copied, so the caller
+// may keep changing its own value.
+func (f *FakeCalendarClient) PutEvent(calendarID string, event *calendar.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ev := *event
+	if ev.Status == "" {
+		ev.Status = "confirmed"
+	}
+	f.calendar(calendarID).change(&ev)
+}
+
+// DeleteEvent deletes an event. Incremental fetches return it as cancelled.
+func (f *FakeCalendarClient) DeleteEvent(calendarID, eventID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cal := f.calendar(calendarID)
+	if ev, ok := cal.events[eventID]; ok {
+		deleted := *ev
+		deleted.Status = "cancelled"
+		cal.change(&deleted)
+	}
+}
+
+// Event returns a copy of an event as the fake holds it, or nil
+func (f *FakeCalendarClient) Event(calendarID, eventID string) *calendar.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ev, ok := f.calendar(calendarID).events[eventID]
+	if !ok {
+		return nil
+	}
+	copied := *ev
+	return &copied
+}
+
+// ExpireSyncTokens invalidates every sync token issued for the calendar so
+// far, as Google does after a while, so the next incremental fetch gets
+// 410 Gone and the caller has to fetch in full
+func (f *FakeCalendarClient) ExpireSyncTokens(calendarID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cal := f.calendar(calendarID)
+	cal.seq++
+	cal.validFrom = cal.seq
+}
+
+// GetAuthURL returns a fixed consent URL
+func (f *FakeCalendarClient) GetAuthURL(state string, scopes ...string) string {
+	return "https://accounts.google.com/fake-auth?state=" + state
+}
+
+// ExchangeCode returns credentials derived from the code
+func (f *FakeCalendarClient) ExchangeCode(ctx context.Context, code string) (*store.OAuthCredentials, error) {
+	return &store.OAuthCredentials{
+		AccessToken:  "fake-access-" + code,
+		RefreshToken: "fake-refresh-" + code,
+		TokenType:    "Bearer",
+		Expiry:       fakeExpiry,
+	}, nil
+}
+
+// RefreshToken returns the credentials with a new access token
+func (f *FakeCalendarClient) RefreshToken(ctx context.Context, creds *store.OAuthCredentials) (*store.OAuthCredentials, error) {
+	f.mu.Lock()
+	f.RefreshCalls++
+	n := f.RefreshCalls
+	f.mu.Unlock()
+
+	refreshed := *creds
+	refreshed.AccessToken = fmt.Sprintf("fake-access-refreshed-%d", n)
+	refreshed.Expiry = fakeExpiry
+	return &refreshed, nil
+}
+
+// ListCalendars returns the added calendars in the order they were added
+func (f *FakeCalendarClient) ListCalendars(ctx context.Context, creds *store.OAuthCredentials) ([]*CalendarInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calendars := make([]*CalendarInfo, len(f.calendars))
+	for i, c := range f.calendars {
+		info := *c
+		calendars[i] = &info
+	}
+	return calendars, nil
+}
+
+// FetchEvents returns the calendar's events overlapping the range and a
+// sync token for the calendar's current state
+func (f *FakeCalendarClient) FetchEvents(ctx context.Context, creds *store.OAuthCredentials, calendarID string, minTime, maxTime time.Time) (*SyncResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.FetchCalls = append(f.FetchCalls, FetchCall{CalendarID: calendarID, MinTime: minTime, MaxTime: maxTime})
+
+	cal, ok := f.state[calendarID]
+	if !ok {
+		return nil, &googleapi.Error{Code: http.StatusNotFound, Message: "Not Found"}
+	}
+
+	var events []*calendar.Event
+	for _, ev := range cal.events {
+		if ev.Status == "cancelled" {
+			continue
+		}
+		start, end := fakeEventTimes(ev)
+		if start.Before(maxTime) && end.After(minTime) {
+			copied := *ev
+			events = append(events, &copied)
+		}
+	}
+	sortFakeEvents(events)
+
+	return &SyncResult{
+		Events:        events,
+		NextSyncToken: fakeSyncToken(calendarID, cal.seq),
+		FullSync:      true,
+	}, nil
+}
+
+// FetchEventsIncremental returns the events changed since the sync token,
+// or 410 Gone if the token has expired or wasn't issued for the calendar
+func (f *FakeCalendarClient) FetchEventsIncremental(ctx context.Context, creds *store.OAuthCredentials, calendarID string, syncToken string) (*SyncResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.IncrementalCalls = append(f.IncrementalCalls, IncrementalCall{CalendarID: calendarID, SyncToken: syncToken})
+
+	cal, ok := f.state[calendarID]
+	if !ok {
+		return nil, &googleapi.Error{Code: http.StatusNotFound, Message: "Not Found"}
+	}
+	since, ok := parseFakeSyncToken(calendarID, syncToken)
+	if !ok || since < cal.validFrom || since > cal.seq {
+		return nil, &googleapi.Error{
+			Code:    http.StatusGone,
+			Message: "Sync token is no longer valid, a full sync is required.",
+			Errors:  []googleapi.ErrorItem{{Reason: "fullSyncRequired"}},
+		}
+	}
+
+	var events []*calendar.Event
+	for id, seq := range cal.changed {
+		if seq > since {
+			copied := *cal.events[id]
+			events = append(events, &copied)
+		}
+	}
+	sortFakeEvents(events)
+
+	return &SyncResult{
+		Events:        events,
+		NextSyncToken: fakeSyncToken(calendarID, cal.seq),
+		FullSync:      false,
+	}, nil
+}
+
+// WriteEventProject applies the project to the event as Google's patch
+// would. Like any change, it shows up in the next incremental fetch.
+func (f *FakeCalendarClient) WriteEventProject(ctx context.Context, creds *store.OAuthCredentials, calendarID, eventID string, project EventProject) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.WriteCalls = append(f.WriteCalls, WriteCall{CalendarID: calendarID, EventID: eventID, Project: project})
+
+	cal, ok := f.state[calendarID]
+	if !ok {
+		return &googleapi.Error{Code: http.StatusNotFound, Message: "Not Found"}
+	}
+	current, ok := cal.events[eventID]
+	if !ok || current.Status == "cancelled" {
+		return &googleapi.Error{Code: http.StatusNotFound, Message: "Not Found"}
+	}
+
+	ev := *current
+	private := make(map[string]string)
+	if ev.ExtendedProperties != nil {
+		for k, v := range ev.ExtendedProperties.Private {
+			private[k] = v
+		}
+	}
+	if project.ID != "" {
+		private[PropertyProjectID] = project.ID
+		private[PropertyProject] = project.Name
+	} else {
+		delete(private, PropertyProjectID)
+		delete(private, PropertyProject)
+	}
+	ev.ExtendedProperties = &calendar.EventExtendedProperties{Private: private}
+	if project.SetColor {
+		ev.ColorId = NearestEventColor(project.Color)
+	}
+	cal.change(&ev)
+	return nil
+}
+
+// calendar returns a calendar's state, panicking on one never added: a
+// fixture mistake rather than something to test
+func (f *FakeCalendarClient) calendar(calendarID string) *fakeCalendar {
+	cal, ok := f.state[calendarID]
+	if !ok {
+		panic("google: fake calendar " + calendarID + " was not added")
+	}
+	return cal
+}
+
+func (c *fakeCalendar) change(ev *calendar.Event) {
+	c.seq++
+	c.events[ev.Id] = ev
+	c.changed[ev.Id] = c.seq
+}
+
+func fakeSyncToken(calendarID string, seq int64) string {
+	return "fake:" + calendarID + ":" + strconv.FormatInt(seq, 10)
+}
+
+func parseFakeSyncToken(calendarID, token string) (int64, bool) {
+	rest, ok := strings.CutPrefix(token, "fake:"+calendarID+":")
+	if !ok {
+		return 0, false
+	}
+	seq, err := strconv.ParseInt(rest, 10, 64)
+	return seq, err == nil
+}
+
+// fakeEventTimes returns an event's start and end, reading all-day events
+// as UTC days
+func fakeEventTimes(ev *calendar.Event) (start, end time.Time) {
+	parse := func(dt *calendar.EventDateTime) time.Time {
+		if dt == nil {
+			return time.Time{}
+		}
+		if dt.DateTime != "" {
+			t, _ := time.Parse(time.RFC3339, dt.DateTime)
+			return t
+		}
+		t, _ := time.Parse("2006-01-02", dt.Date)
+		return t
+	}
+	return parse(ev.Start), parse(ev.End)
+}
+
+func sortFakeEvents(events []*calendar.Event) {
+	sort.Slice(events, func(i, j int) bool {
+		si, _ := fakeEventTimes(events[i])
+		sj, _ := fakeEventTimes(events[j])
+		if !si.Equal(sj) {
+			return si.Before(sj)
+		}
+		return events[i].Id < events[j].Id
+	})
+}
@@ -0,0 +1,133 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+)
+
+func fakeEvent(id string, start time.Time) *calendar.Event {
+	return &calendar.Event{
+		Id:      id,
+		Summary: "Event " + id,
+		Start:   &calendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+		End:     &calendar.EventDateTime{DateTime: start.Add(time.Hour).Format(time.RFC3339)},
+	}
+}
+
+func eventIDs(events []*calendar.Event) []string {
+	ids := make([]string, len(events))
+	for i, ev := range events {
+		ids[i] = ev.Id
+	}
+	return ids
+}
+
+func TestFakeCalendarClient_FetchEvents(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	f := NewFakeCalendarClient()
+	f.AddCalendar(CalendarInfo{ID: "primary", Name: "Work", IsPrimary: true})
+	f.PutEvent("primary", fakeEvent("b", day))
+	f.PutEvent("primary", fakeEvent("a", day))
+	f.PutEvent("primary", fakeEvent("later", day.AddDate(0, 0, 7)))
+	f.PutEvent("primary", fakeEvent("gone", day))
+	f.DeleteEvent("primary", "gone")
+
+	result, err := f.FetchEvents(ctx, nil, "primary", day.Add(-time.Hour), day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("FetchEvents: %v", err)
+	}
+	if got := eventIDs(result.Events); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("expected [a b] ordered by start then ID, got %v", got)
+	}
+	if !result.FullSync || result.NextSyncToken == "" {
+		t.Errorf("expected a full sync with a token, got %+v", result)
+	}
+
+	var apiErr *googleapi.Error
+	if _, err := f.FetchEvents(ctx, nil, "unknown", day, day); !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown calendar, got %v", err)
+	}
+}
+
+func TestFakeCalendarClient_Incremental(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	f := NewFakeCalendarClient()
+	f.AddCalendar(CalendarInfo{ID: "primary", IsPrimary: true})
+	f.PutEvent("primary", fakeEvent("kept", day))
+	f.PutEvent("primary", fakeEvent("moved", day))
+	f.PutEvent("primary", fakeEvent("deleted", day))
+
+	full, _ := f.FetchEvents(ctx, nil, "primary", day.AddDate(0, 0, -1), day.AddDate(0, 0, 1))
+
+	f.PutEvent("primary", fakeEvent("moved", day.Add(2*time.Hour)))
+	f.DeleteEvent("primary", "deleted")
+
+	inc, err := f.FetchEventsIncremental(ctx, nil, "primary", full.NextSyncToken)
+	if err != nil {
+		t.Fatalf("FetchEventsIncremental: %v", err)
+	}
+	if got := eventIDs(inc.Events); len(got) != 2 || got[0] != "deleted" || got[1] != "moved" {
+		t.Fatalf("expected the deleted and moved events, got %v", got)
+	}
+	if inc.Events[0].Status != "cancelled" {
+		t.Errorf("expected the deleted event to come back cancelled, got %q", inc.Events[0].Status)
+	}
+
+	// Nothing changed since the new token
+	again, err := f.FetchEventsIncremental(ctx, nil, "primary", inc.NextSyncToken)
+	if err != nil || len(again.Events) != 0 {
+		t.Errorf("expected no changes, got %v (%v)", eventIDs(again.Events), err)
+	}
+
+	// Expired tokens get 410 until a full fetch issues a new one
+	f.ExpireSyncTokens("primary")
+	var apiErr *googleapi.Error
+	if _, err := f.FetchEventsIncremental(ctx, nil, "primary", inc.NextSyncToken); !errors.As(err, &apiErr) || apiErr.Code != http.StatusGone {
+		t.Fatalf("expected 410 for an expired token, got %v", err)
+	}
+	refetched, _ := f.FetchEvents(ctx, nil, "primary", day.AddDate(0, 0, -1), day.AddDate(0, 0, 1))
+	if _, err := f.FetchEventsIncremental(ctx, nil, "primary", refetched.NextSyncToken); err != nil {
+		t.Errorf("expected the new token to work, got %v", err)
+	}
+	if _, err := f.FetchEventsIncremental(ctx, nil, "other", refetched.NextSyncToken); err == nil {
+		t.Error("expected another calendar's token to be rejected")
+	}
+}
+
+func TestFakeCalendarClient_WriteEventProject(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeCalendarClient()
+	f.AddCalendar(CalendarInfo{ID: "primary", IsPrimary: true})
+	f.PutEvent("primary", fakeEvent("e1", time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)))
+
+	project := EventProject{ID: "p1", Name: "Acme", Color: "#dc2127", SetColor: true}
+	if err := f.WriteEventProject(ctx, nil, "primary", "e1", project); err != nil {
+		t.Fatalf("WriteEventProject: %v", err)
+	}
+	ev := f.Event("primary", "e1")
+	if ev.ExtendedProperties.Private[PropertyProjectID] != "p1" || ev.ColorId != "11" {
+		t.Errorf("expected the project and color to be written, got %+v %q", ev.ExtendedProperties, ev.ColorId)
+	}
+
+	if err := f.WriteEventProject(ctx, nil, "primary", "e1", EventProject{SetColor: true}); err != nil {
+		t.Fatal(err)
+	}
+	ev = f.Event("primary", "e1")
+	if _, ok := ev.ExtendedProperties.Private[PropertyProjectID]; ok || ev.ColorId != "" {
+		t.Errorf("expected the project and color to be cleared, got %+v %q", ev.ExtendedProperties, ev.ColorId)
+	}
+
+	if err := f.WriteEventProject(ctx, nil, "primary", "missing", project); err == nil {
+		t.Error("expected an error writing a missing event")
+	}
+}
@@ -16,6 +16,8 @@ type MockCalendarClient struct {
 
 	// AuthURL is the URL returned by GetAuthURL
 	AuthURL string
+	// AuthScopes are the scopes passed to the last GetAuthURL call
+	AuthScopes []string
 
 	// Credentials returned by ExchangeCode
 	ExchangeCredentials *store.OAuthCredentials
@@ -37,12 +39,16 @@ type MockCalendarClient struct {
 	EventsByToken    map[string]*SyncResult
 	IncrementalError error
 
+	// Error returned by WriteEventProject
+	WriteError error
+
 	// Call tracking
 	ExchangeCalls     []string          // codes passed to ExchangeCode
 	RefreshCalls      int               // number of RefreshToken calls
 	ListCalendarCalls int               // number of ListCalendars calls
 	FetchCalls        []FetchCall       // calls to FetchEvents
 	IncrementalCalls  []IncrementalCall // calls to FetchEventsIncremental
+	WriteCalls        []WriteCall       // calls to WriteEventProject
 }
 
 // FetchCall records a call to FetchEvents
@@ -58,6 +64,13 @@ type IncrementalCall struct {
 	SyncToken  string
 }
 
+// WriteCall records a call to WriteEventProject
+type WriteCall struct {
+	CalendarID string
+	EventID    string
+	Project    EventProject
+}
+
 // NewMockCalendarClient creates a new mock with sensible defaults
 func NewMockCalendarClient() *MockCalendarClient {
 	return &MockCalendarClient{
@@ -83,7 +96,10 @@ func NewMockCalendarClient() *MockCalendarClient {
 var _ CalendarClient = (*MockCalendarClient)(nil)
 
 // GetAuthURL returns the mock auth URL
-func (m *MockCalendarClient) GetAuthURL(state string) string {
+func (m *MockCalendarClient) GetAuthURL(state string, scopes ...string) string {
+	m.mu.Lock()
+	m.AuthScopes = scopes
+	m.mu.Unlock()
 	return m.AuthURL + "?state=" + state
 }
 
@@ -183,6 +199,15 @@ func (m *MockCalendarClient) FetchEventsIncremental(ctx context.Context, creds *
 	}, nil
 }
 
+// WriteEventProject records the write and returns the configured error
+func (m *MockCalendarClient) WriteEventProject(ctx context.Context, creds *store.OAuthCredentials, calendarID, eventID string, project EventProject) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.WriteCalls = append(m.WriteCalls, WriteCall{CalendarID: calendarID, EventID: eventID, Project: project})
+	return m.WriteError
+}
+
 // SetEventsForRange configures mock events for a specific date range
 func (m *MockCalendarClient) SetEventsForRange(calendarID string, minTime, maxTime time.Time, events []*calendar.Event, nextToken string) {
 	m.mu.Lock()
@@ -219,4 +244,5 @@ func (m *MockCalendarClient) Reset() {
 	m.ListCalendarCalls = 0
 	m.FetchCalls = nil
 	m.IncrementalCalls = nil
+	m.WriteCalls = nil
 }
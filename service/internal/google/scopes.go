@@ -0,0 +1,71 @@
+package google
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/drive/v3"
+)
+
+// Access is a feature's set of Google permissions. Connecting a calendar
+// grants only AccessCalendar; other access is requested through incremental
+// consent when the user first needs it.
+type Access string
+
+const (
+	// AccessCalendar reads calendars and their events for sync
+	AccessCalendar Access = "calendar"
+	// AccessSheets creates and updates the spreadsheets invoices export to
+	AccessSheets Access = "sheets"
+	// AccessCalendarWrite writes classified projects back to events
+	AccessCalendarWrite Access = "calendar_write"
+)
+
+// accessScopes lists the OAuth scopes each access needs
+var accessScopes = map[Access][]string{
+	AccessCalendar: {calendar.CalendarReadonlyScope},
+	AccessSheets:   {drive.DriveFileScope},
+	// Patching events needs more than read-only access to the calendar
+	AccessCalendarWrite: {calendar.CalendarEventsScope},
+}
+
+// ParseAccess returns the access with the given name
+func ParseAccess(name string) (Access, bool) {
+	a := Access(name)
+	_, ok := accessScopes[a]
+	return a, ok
+}
+
+// Scopes returns the OAuth scopes the access needs
+func (a Access) Scopes() []string {
+	return accessScopes[a]
+}
+
+// GrantedAccess returns the accesses fully covered by the granted scopes
+func GrantedAccess(granted []string) []Access {
+	var result []Access
+	for _, a := range []Access{AccessCalendar, AccessSheets, AccessCalendarWrite} {
+		if HasAccess(granted, a) {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// HasAccess reports whether the granted scopes cover the access
+func HasAccess(granted []string, a Access) bool {
+	for _, scope := range accessScopes[a] {
+		if !slices.Contains(granted, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// grantedScopes returns the scopes Google reports granting with a token
+func grantedScopes(token *oauth2.Token) []string {
+	scope, _ := token.Extra("scope").(string)
+	return strings.Fields(scope)
+}
@@ -0,0 +1,76 @@
+package google
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/drive/v3"
+)
+
+func TestHasAccess(t *testing.T) {
+	granted := []string{calendar.CalendarReadonlyScope}
+
+	if !HasAccess(granted, AccessCalendar) {
+		t.Error("Expected read-only calendar scope to cover calendar access")
+	}
+	if HasAccess(granted, AccessSheets) {
+		t.Error("Expected sheets access to need the Drive file scope")
+	}
+
+	granted = append(granted, drive.DriveFileScope)
+	if got := GrantedAccess(granted); !slices.Equal(got, []Access{AccessCalendar, AccessSheets}) {
+		t.Errorf("GrantedAccess() = %v, want [calendar sheets]", got)
+	}
+}
+
+func TestParseAccess(t *testing.T) {
+	if a, ok := ParseAccess("sheets"); !ok || a != AccessSheets {
+		t.Errorf("ParseAccess(sheets) = %q, %v", a, ok)
+	}
+	if _, ok := ParseAccess("gmail"); ok {
+		t.Error("Expected unknown access to be rejected")
+	}
+}
+
+func TestGetAuthURL_Scopes(t *testing.T) {
+	svc := NewCalendarService("client", "secret", "https://example.com/callback")
+
+	parse := func(raw string) url.Values {
+		u, err := url.Parse(raw)
+		if err != nil {
+			t.Fatalf("parse auth URL: %v", err)
+		}
+		return u.Query()
+	}
+
+	q := parse(svc.GetAuthURL("state"))
+	if q.Get("scope") != calendar.CalendarReadonlyScope {
+		t.Errorf("default scope = %q, want read-only calendar", q.Get("scope"))
+	}
+	if q.Get("include_granted_scopes") != "true" {
+		t.Error("Expected incremental consent to keep granted scopes")
+	}
+
+	q = parse(svc.GetAuthURL("state", AccessSheets.Scopes()...))
+	if q.Get("scope") != drive.DriveFileScope {
+		t.Errorf("sheets scope = %q, want only the Drive file scope", q.Get("scope"))
+	}
+}
+
+func TestGrantedScopes(t *testing.T) {
+	token := (&oauth2.Token{AccessToken: "a"}).WithExtra(map[string]any{
+		"scope": strings.Join([]string{calendar.CalendarReadonlyScope, drive.DriveFileScope}, " "),
+	})
+
+	got := grantedScopes(token)
+	if !slices.Equal(got, []string{calendar.CalendarReadonlyScope, drive.DriveFileScope}) {
+		t.Errorf("grantedScopes() = %v", got)
+	}
+	if got := grantedScopes(&oauth2.Token{}); len(got) != 0 {
+		t.Errorf("grantedScopes() without scope = %v, want none", got)
+	}
+}
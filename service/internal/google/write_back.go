@@ -0,0 +1,101 @@
+package google
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"google.golang.org/api/calendar/v3"
+)
+
+// Private extended properties holding the project an event was billed to
+const (
+	PropertyProjectID = "timesheet_project_id"
+	PropertyProject   = "timesheet_project"
+)
+
+// EventProject is the project written back to a Google event. The zero value
+// clears a project written before.
+type EventProject struct {
+	ID    string
+	Name  string
+	Color string // The project's hex color
+	// SetColor changes the event's color to the one nearest Color, or back to
+	// the calendar's color when Color is empty
+	SetColor bool
+}
+
+// eventColors are Google Calendar's event colors by colorId
+var eventColors = []struct {
+	id      string
+	r, g, b int
+}{
+	{"1", 0xa4, 0xbd, 0xfc},
+	{"2", 0x7a, 0xe7, 0xbf},
+	{"3", 0xdb, 0xad, 0xff},
+	{"4", 0xff, 0x88, 0x7c},
+	{"5", 0xfb, 0xd7, 0x5b},
+	{"6", 0xff, 0xb8, 0x78},
+	{"7", 0x46, 0xd6, 0xdb},
+	{"8", 0xe1, 0xe1, 0xe1},
+	{"9", 0x54, 0x84, 0xed},
+	{"10", 0x51, 0xb7, 0x49},
+	{"11", 0xdc, 0x21, 0x27},
+}
+
+// NearestEventColor returns the colorId of the Google event color closest to
+// a hex color such as "#3b82f6", or "" if it can't be parsed. Events only take
+// one of Google's fixed colors.
+func NearestEventColor(hex string) string {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return ""
+	}
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return ""
+	}
+	r, g, b := int(rgb>>16), int(rgb>>8&0xff), int(rgb&0xff)
+
+	best, bestDist := "", -1
+	for _, c := range eventColors {
+		dist := (r-c.r)*(r-c.r) + (g-c.g)*(g-c.g) + (b-c.b)*(b-c.b)
+		if bestDist < 0 || dist < bestDist {
+			best, bestDist = c.id, dist
+		}
+	}
+	return best
+}
+
+// WriteEventProject stores the project in the event's private extended
+// properties, which only the user sees, and optionally colors the event.
+// Attendees aren't notified.
+func (s *CalendarService) WriteEventProject(ctx context.Context, creds *store.OAuthCredentials, calendarID, eventID string, project EventProject) error {
+	srv, err := s.getService(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	patch := &calendar.Event{
+		ExtendedProperties: &calendar.EventExtendedProperties{
+			Private: map[string]string{},
+		},
+	}
+	if project.ID != "" {
+		patch.ExtendedProperties.Private[PropertyProjectID] = project.ID
+		patch.ExtendedProperties.Private[PropertyProject] = project.Name
+	} else {
+		patch.ExtendedProperties.NullFields = []string{"Private." + PropertyProjectID, "Private." + PropertyProject}
+	}
+	if project.SetColor {
+		if colorID := NearestEventColor(project.Color); colorID != "" {
+			patch.ColorId = colorID
+		} else {
+			patch.NullFields = []string{"ColorId"}
+		}
+	}
+
+	_, err = srv.Events.Patch(calendarID, eventID, patch).SendUpdates("none").Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,26 @@
+package google
+
+import "testing"
+
+func TestNearestEventColor(t *testing.T) {
+	tests := []struct {
+		hex      string
+		expected string
+	}{
+		{"#dc2127", "11"}, // Exact match: tomato
+		{"#ef4444", "11"}, // Red
+		{"#22c55e", "10"}, // Green
+		{"#3b82f6", "9"},  // Blue
+		{"#d1d5db", "8"},  // Light gray
+		{"fbd75b", "5"},   // Without the leading #
+		{"#fff", ""},      // Short form isn't supported
+		{"not a color", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := NearestEventColor(tt.hex); got != tt.expected {
+			t.Errorf("NearestEventColor(%q) = %q, want %q", tt.hex, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// Request is a GraphQL request as sent over HTTP
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Response is the result of executing a request
+type Response struct {
+	Data   interface{}   `json:"data"`
+	Errors gqlerror.List `json:"errors,omitempty"`
+}
+
+// Error is a GraphQL error, with the response path for field errors and the
+// query location for validation errors
+type Error = gqlerror.Error
+
+// Execute validates and runs a request against the schema. Parse and
+// validation errors produce a response with no data; resolver errors null
+// the failing field, or its nearest nullable parent, and are reported
+// alongside the partial result.
+func Execute(ctx context.Context, schema *Schema, req Request) *Response {
+	doc, errs := gqlparser.LoadQuery(schema.schema, req.Query)
+	if len(errs) > 0 {
+		return &Response{Errors: errs}
+	}
+
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &Response{Errors: gqlerror.List{err}}
+	}
+	if op.Operation != ast.Query {
+		return &Response{Errors: gqlerror.List{gqlerror.ErrorPosf(op.Position, "%s operations are not supported; use the REST API for writes", op.Operation)}}
+	}
+	if err := checkLimits(schema, op); err != nil {
+		return &Response{Errors: gqlerror.List{err}}
+	}
+
+	vars, varErr := validator.VariableValues(schema.schema, op, req.Variables)
+	if varErr != nil {
+		return &Response{Errors: gqlerror.List{gqlerror.WrapIfUnwrapped(varErr)}}
+	}
+
+	e := &executor{ctx: ctx, schema: schema, vars: vars}
+	data := e.executeSelectionSet(schema.schema.Query, nil, op.SelectionSet, nil)
+	if data == nil {
+		return &Response{Errors: e.errors}
+	}
+	return &Response{Data: data, Errors: e.errors}
+}
+
+func selectOperation(doc *ast.QueryDocument, name string) (*ast.OperationDefinition, *gqlerror.Error) {
+	if name == "" && len(doc.Operations) > 1 {
+		return nil, gqlerror.Errorf("operationName is required when the document contains multiple operations")
+	}
+	op := doc.Operations.ForName(name)
+	if op == nil {
+		return nil, gqlerror.Errorf("unknown operation %q", name)
+	}
+	return op, nil
+}
+
+type executor struct {
+	ctx    context.Context
+	schema *Schema
+	vars   map[string]interface{}
+	errors gqlerror.List
+}
+
+func (e *executor) addError(field *ast.Field, path ast.Path, err error) {
+	gqlErr := gqlerror.WrapPath(append(ast.Path(nil), path...), err)
+	if field.Position != nil {
+		gqlErr.Locations = []gqlerror.Location{{Line: field.Position.Line, Column: field.Position.Column}}
+	}
+	e.errors = append(e.errors, gqlErr)
+}
+
+// collectFields flattens fragments into an ordered list of fields, merging
+// fields that share a response key and honouring @skip and @include.
+// visited only skips repeated spreads within one selection set; validation
+// has already rejected fragment cycles.
+func (e *executor) collectFields(obj *ast.Definition, sels ast.SelectionSet, fields *orderedFields, visited map[string]bool) {
+	for _, sel := range sels {
+		switch s := sel.(type) {
+		case *ast.Field:
+			if e.included(s.Directives) {
+				fields.add(s)
+			}
+		case *ast.InlineFragment:
+			if e.included(s.Directives) && e.typeMatches(obj, s.TypeCondition) {
+				e.collectFields(obj, s.SelectionSet, fields, visited)
+			}
+		case *ast.FragmentSpread:
+			if visited[s.Name] || !e.included(s.Directives) {
+				continue
+			}
+			visited[s.Name] = true
+			if e.typeMatches(obj, s.Definition.TypeCondition) {
+				e.collectFields(obj, s.Definition.SelectionSet, fields, visited)
+			}
+		}
+	}
+}
+
+func (e *executor) included(directives ast.DirectiveList) bool {
+	if d := directives.ForName("skip"); d != nil && d.ArgumentMap(e.vars)["if"] == true {
+		return false
+	}
+	if d := directives.ForName("include"); d != nil && d.ArgumentMap(e.vars)["if"] == false {
+		return false
+	}
+	return true
+}
+
+// typeMatches reports whether a fragment with the given type condition
+// applies to obj
+func (e *executor) typeMatches(obj *ast.Definition, condition string) bool {
+	if condition == "" || condition == obj.Name {
+		return true
+	}
+	def := e.schema.schema.Types[condition]
+	if def == nil || !def.IsAbstractType() {
+		return false
+	}
+	for _, t := range e.schema.schema.GetPossibleTypes(def) {
+		if t.Name == obj.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// executeSelectionSet resolves the selected fields of obj. It returns nil
+// when a non-null field resolved to null, so the caller nulls the object.
+func (e *executor) executeSelectionSet(obj *ast.Definition, source interface{}, sels ast.SelectionSet, path ast.Path) *OrderedMap {
+	fields := &orderedFields{byKey: map[string][]*ast.Field{}}
+	e.collectFields(obj, sels, fields, map[string]bool{})
+
+	result := &OrderedMap{}
+	for _, key := range fields.keys {
+		fieldPath := append(path[:len(path):len(path)], ast.PathName(key))
+		value, ok := e.executeField(obj, source, fields.byKey[key], fieldPath)
+		if !ok {
+			return nil
+		}
+		result.Set(key, value)
+	}
+	return result
+}
+
+// executeField resolves and completes one response key. ok is false when
+// the field is non-null but resolved to null.
+func (e *executor) executeField(obj *ast.Definition, source interface{}, group []*ast.Field, path ast.Path) (value interface{}, ok bool) {
+	field := group[0]
+	if field.Name == "__typename" {
+		return obj.Name, true
+	}
+
+	def := obj.Fields.ForName(field.Name)
+	resolve := e.schema.resolvers[obj.Name][field.Name]
+	args := field.ArgumentMap(e.vars)
+	for _, arg := range def.Arguments {
+		if v, ok := args[arg.Name]; ok {
+			args[arg.Name] = e.coerceInput(arg.Type, v)
+		}
+	}
+	resolved, err := resolve(ResolveParams{Context: e.ctx, Source: source, Args: args})
+	if err != nil {
+		e.addError(field, path, err)
+		return nil, !def.Type.NonNull
+	}
+
+	// Merge sub-selections from all fields sharing this response key
+	var sels ast.SelectionSet
+	for _, f := range group {
+		sels = append(sels, f.SelectionSet...)
+	}
+	return e.completeValue(field, def.Type, resolved, sels, path)
+}
+
+// coerceInput gives numeric input values a single Go type per scalar.
+// Literals arrive as int64 and float64 but JSON variables always as
+// float64; validation has already checked the values fit their types.
+func (e *executor) coerceInput(typ *ast.Type, v interface{}) interface{} {
+	switch {
+	case v == nil:
+		return nil
+	case typ.Elem != nil:
+		items, ok := v.([]interface{})
+		if !ok {
+			// A single value where a list is expected is a list of one
+			return []interface{}{e.coerceInput(typ.Elem, v)}
+		}
+		out := make([]interface{}, len(items))
+		for i, item := range items {
+			out[i] = e.coerceInput(typ.Elem, item)
+		}
+		return out
+	}
+
+	def := e.schema.schema.Types[typ.NamedType]
+	switch {
+	case def.Name == "Int":
+		switch n := v.(type) {
+		case float64:
+			return int64(n)
+		case json.Number:
+			i, _ := n.Int64()
+			return i
+		}
+	case def.Name == "Float":
+		switch n := v.(type) {
+		case int64:
+			return float64(n)
+		case json.Number:
+			f, _ := n.Float64()
+			return f
+		}
+	case def.Kind == ast.InputObject:
+		fields, ok := v.(map[string]interface{})
+		if !ok {
+			return v
+		}
+		out := make(map[string]interface{}, len(fields))
+		for name, value := range fields {
+			if f := def.Fields.ForName(name); f != nil {
+				value = e.coerceInput(f.Type, value)
+			}
+			out[name] = value
+		}
+		return out
+	}
+	return v
+}
+
+func (e *executor) completeValue(field *ast.Field, typ *ast.Type, value interface{}, sels ast.SelectionSet, path ast.Path) (interface{}, bool) {
+	if isNil(value) {
+		if typ.NonNull {
+			e.addError(field, path, fmt.Errorf("%s must not be null", typ))
+			return nil, false
+		}
+		return nil, true
+	}
+
+	if typ.Elem != nil {
+		rv := indirect(value)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			e.addError(field, path, fmt.Errorf("expected a list for type %s, got %T", typ, value))
+			return nil, !typ.NonNull
+		}
+		out := make([]interface{}, rv.Len())
+		for i := range out {
+			itemPath := append(path[:len(path):len(path)], ast.PathIndex(i))
+			item, ok := e.completeValue(field, typ.Elem, rv.Index(i).Interface(), sels, itemPath)
+			if !ok {
+				return nil, !typ.NonNull
+			}
+			out[i] = item
+		}
+		return out, true
+	}
+
+	def := e.schema.schema.Types[typ.NamedType]
+	if def.IsLeafType() {
+		out, err := serializeLeaf(def, indirect(value))
+		if err != nil {
+			e.addError(field, path, err)
+			return nil, !typ.NonNull
+		}
+		return out, true
+	}
+
+	// Objects reach their field resolvers as the parent resolver returned them
+	obj := e.executeSelectionSet(def, value, sels, path)
+	if obj == nil {
+		return nil, !typ.NonNull
+	}
+	return obj, true
+}
+
+// isNil reports whether v is nil or a chain of pointers ending in nil. Nil
+// slices are not nil here; they complete as empty lists.
+func isNil(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return true
+		}
+		rv = rv.Elem()
+	}
+	return !rv.IsValid()
+}
+
+// indirect dereferences pointers down to the value they point at
+func indirect(v interface{}) reflect.Value {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// serializeLeaf converts a resolved value to the JSON representation of a
+// scalar or enum, rejecting values of the wrong kind rather than letting a
+// resolver bug change the shape of the response
+func serializeLeaf(def *ast.Definition, v reflect.Value) (interface{}, error) {
+	if def.Kind == ast.Enum {
+		if v.Kind() == reflect.String && def.EnumValues.ForName(v.String()) != nil {
+			return v.String(), nil
+		}
+		return nil, fmt.Errorf("%v is not a valid %s", v.Interface(), def.Name)
+	}
+
+	switch def.Name {
+	case "Int":
+		switch {
+		case v.CanInt() && v.Int() >= math.MinInt32 && v.Int() <= math.MaxInt32:
+			return v.Int(), nil
+		case v.CanUint() && v.Uint() <= math.MaxInt32:
+			return int64(v.Uint()), nil
+		}
+	case "Float":
+		switch {
+		case v.CanFloat():
+			return v.Float(), nil
+		case v.CanInt():
+			return float64(v.Int()), nil
+		case v.CanUint():
+			return float64(v.Uint()), nil
+		}
+	case "Boolean":
+		if v.Kind() == reflect.Bool {
+			return v.Bool(), nil
+		}
+	case "String", "ID":
+		if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+			text, err := m.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			return string(text), nil
+		}
+		switch {
+		case v.Kind() == reflect.String:
+			return v.String(), nil
+		case def.Name == "ID" && v.CanInt():
+			return strconv.FormatInt(v.Int(), 10), nil
+		}
+	default:
+		// Custom scalars are serialized as the resolver returned them
+		return v.Interface(), nil
+	}
+	return nil, fmt.Errorf("cannot represent %T as %s", v.Interface(), def.Name)
+}
+
+type orderedFields struct {
+	keys  []string
+	byKey map[string][]*ast.Field
+}
+
+func (f *orderedFields) add(field *ast.Field) {
+	key := field.Alias
+	if key == "" {
+		key = field.Name
+	}
+	if _, ok := f.byKey[key]; !ok {
+		f.keys = append(f.keys, key)
+	}
+	f.byKey[key] = append(f.byKey[key], field)
+}
+
+// OrderedMap is a JSON object that preserves insertion order, so responses
+// follow the field order of the query
+type OrderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// Set adds or replaces a key
+func (m *OrderedMap) Set(key string, value interface{}) {
+	if m.values == nil {
+		m.values = make(map[string]interface{})
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Get returns the value stored under key
+func (m *OrderedMap) Get(key string) (interface{}, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// MarshalJSON encodes the map with keys in insertion order
+func (m *OrderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,345 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type testAuthor struct {
+	Name  string
+	Books []*testBook
+}
+
+type testBook struct {
+	Title string
+	Pages int
+}
+
+const testSDL = `
+type Query {
+  authors: [Author!]!
+  author(name: String!): Author
+}
+
+type Author {
+  name: String!
+  books(minPages: Int = 0): [Book!]
+}
+
+type Book {
+  title: String!
+  pages: Int!
+  broken: String
+  required: String!
+}
+`
+
+func testResolvers() Resolvers {
+	authors := []*testAuthor{
+		{Name: "Le Guin", Books: []*testBook{{"Earthsea", 180}, {"The Dispossessed", 340}}},
+	}
+	return Resolvers{
+		"Query": {
+			"authors": func(p ResolveParams) (interface{}, error) {
+				return authors, nil
+			},
+			"author": func(p ResolveParams) (interface{}, error) {
+				if p.Args["name"] == authors[0].Name {
+					return authors[0], nil
+				}
+				return (*testAuthor)(nil), nil
+			},
+		},
+		"Author": {
+			"name": func(p ResolveParams) (interface{}, error) {
+				return p.Source.(*testAuthor).Name, nil
+			},
+			"books": func(p ResolveParams) (interface{}, error) {
+				a := p.Source.(*testAuthor)
+				min := p.Args["minPages"].(int64)
+				var out []*testBook
+				for _, b := range a.Books {
+					if int64(b.Pages) >= min {
+						out = append(out, b)
+					}
+				}
+				return out, nil
+			},
+		},
+		"Book": {
+			"title": func(p ResolveParams) (interface{}, error) {
+				return p.Source.(*testBook).Title, nil
+			},
+			"pages": func(p ResolveParams) (interface{}, error) {
+				return p.Source.(*testBook).Pages, nil
+			},
+			"broken": func(p ResolveParams) (interface{}, error) {
+				return nil, errors.New("boom")
+			},
+			"required": func(p ResolveParams) (interface{}, error) {
+				return nil, errors.New("boom")
+			},
+		},
+	}
+}
+
+func testSchema(t *testing.T) *Schema {
+	t.Helper()
+	schema, err := NewSchema(testSDL, testResolvers())
+	if err != nil {
+		t.Fatalf("load schema: %v", err)
+	}
+	return schema
+}
+
+func execJSON(t *testing.T, req Request) string {
+	t.Helper()
+	resp := Execute(context.Background(), testSchema(t), req)
+	out, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	return string(out)
+}
+
+func TestNewSchema_RequiresMatchingResolvers(t *testing.T) {
+	missing := testResolvers()
+	delete(missing["Book"], "pages")
+	if _, err := NewSchema(testSDL, missing); err == nil || !strings.Contains(err.Error(), "Book.pages has no resolver") {
+		t.Errorf("expected a missing resolver error, got %v", err)
+	}
+
+	extra := testResolvers()
+	extra["Book"]["isbn"] = extra["Book"]["title"]
+	if _, err := NewSchema(testSDL, extra); err == nil || !strings.Contains(err.Error(), "Book.isbn matches no field") {
+		t.Errorf("expected an unknown field error, got %v", err)
+	}
+
+	if _, err := NewSchema(testSDL+"type Mutation { noop: Boolean }", testResolvers()); err == nil {
+		t.Error("expected a schema with mutations to be rejected")
+	}
+}
+
+func TestExecute_NestedSelectionPreservesOrder(t *testing.T) {
+	got := execJSON(t, Request{Query: `{ authors { name books { title pages } } }`})
+	want := `{"data":{"authors":[{"name":"Le Guin","books":[{"title":"Earthsea","pages":180},{"title":"The Dispossessed","pages":340}]}]}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestExecute_AliasesArgumentsAndVariables(t *testing.T) {
+	got := execJSON(t, Request{
+		Query: `query Long($min: Int = 0) {
+			author(name: "Le Guin") {
+				long: books(minPages: $min) { title }
+				all: books { title }
+			}
+		}`,
+		Variables: map[string]interface{}{"min": float64(200)},
+	})
+	want := `{"data":{"author":{"long":[{"title":"The Dispossessed"}],"all":[{"title":"Earthsea"},{"title":"The Dispossessed"}]}}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestExecute_Fragments(t *testing.T) {
+	got := execJSON(t, Request{Query: `
+		query { authors { ...AuthorFields books { ... on Book { pages } __typename } } }
+		fragment AuthorFields on Author { name }
+	`})
+	want := `{"data":{"authors":[{"name":"Le Guin","books":[{"pages":180,"__typename":"Book"},{"pages":340,"__typename":"Book"}]}]}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestExecute_SkipAndInclude(t *testing.T) {
+	got := execJSON(t, Request{
+		Query:     `query ($full: Boolean!) { authors { name @skip(if: $full) books @include(if: $full) { title } } }`,
+		Variables: map[string]interface{}{"full": false},
+	})
+	if got != `{"data":{"authors":[{"name":"Le Guin"}]}}` {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestExecute_NullObject(t *testing.T) {
+	got := execJSON(t, Request{Query: `{ author(name: "Nobody") { name } }`})
+	if got != `{"data":{"author":null}}` {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestExecute_FieldErrorsIncludePath(t *testing.T) {
+	resp := Execute(context.Background(), testSchema(t), Request{Query: `{ authors { books { broken } } }`})
+	if len(resp.Errors) != 2 {
+		t.Fatalf("expected one error per book, got %d", len(resp.Errors))
+	}
+	path, _ := json.Marshal(resp.Errors[1].Path)
+	if string(path) != `["authors",0,"books",1,"broken"]` {
+		t.Errorf("unexpected path %s", path)
+	}
+	if resp.Data == nil {
+		t.Error("expected partial data alongside field errors")
+	}
+}
+
+func TestExecute_NonNullErrorsNullTheParent(t *testing.T) {
+	resp := Execute(context.Background(), testSchema(t), Request{Query: `{ authors { name books { title required } } }`})
+	out, _ := json.Marshal(resp.Data)
+	// The failing Book! can't be null, so the nullable books list is nulled instead
+	if string(out) != `{"authors":[{"name":"Le Guin","books":null}]}` {
+		t.Errorf("got %s", out)
+	}
+	if len(resp.Errors) != 1 || resp.Errors[0].Message != "boom" {
+		t.Errorf("expected the resolver error, got %+v", resp.Errors)
+	}
+}
+
+func TestExecute_Introspection(t *testing.T) {
+	got := execJSON(t, Request{Query: `{
+		__schema { queryType { name } }
+		__type(name: "Author") {
+			kind
+			fields { name args { name defaultValue } type { kind ofType { kind ofType { name } } } }
+		}
+	}`})
+	want := `{"data":{"__schema":{"queryType":{"name":"Query"}},"__type":{"kind":"OBJECT","fields":[` +
+		`{"name":"name","args":[],"type":{"kind":"NON_NULL","ofType":{"kind":"SCALAR","ofType":null}}},` +
+		`{"name":"books","args":[{"name":"minPages","defaultValue":"0"}],"type":{"kind":"LIST","ofType":{"kind":"NON_NULL","ofType":{"name":"Book"}}}}]}}}`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+// introspectionQuery is the query GraphiQL and most client generators send
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives { name description locations args { ...InputValue } }
+  }
+}
+fragment FullType on __Type {
+  kind name description
+  fields(includeDeprecated: true) { name description args { ...InputValue } type { ...TypeRef } isDeprecated deprecationReason }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name description isDeprecated deprecationReason }
+  possibleTypes { ...TypeRef }
+}
+fragment InputValue on __InputValue { name description type { ...TypeRef } defaultValue }
+fragment TypeRef on __Type {
+  kind name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+func TestExecute_IntrospectionQueryWithinLimits(t *testing.T) {
+	resp := Execute(context.Background(), testSchema(t), Request{Query: introspectionQuery})
+	if len(resp.Errors) != 0 {
+		t.Fatalf("expected the standard introspection query to run, got %+v", resp.Errors)
+	}
+	types, _ := resp.Data.(*OrderedMap).values["__schema"].(*OrderedMap).Get("types")
+	var names []string
+	for _, typ := range types.([]interface{}) {
+		name, _ := typ.(*OrderedMap).Get("name")
+		names = append(names, name.(string))
+	}
+	if got := strings.Join(names, ","); !strings.Contains(got, "Author,Book,Boolean") || !strings.Contains(got, "Query") {
+		t.Errorf("unexpected types %s", got)
+	}
+}
+
+func TestExecute_RejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   Request
+		match string
+	}{
+		{"syntax", Request{Query: `{ authors { name `}, "Expected Name"},
+		{"unknown field", Request{Query: `{ authors { age } }`}, `Cannot query field "age" on type "Author"`},
+		{"missing subselection", Request{Query: `{ authors }`}, "must have a selection of subfields"},
+		{"scalar subselection", Request{Query: `{ authors { name { first } } }`}, "must not have a selection"},
+		{"argument type", Request{Query: `{ author(name: 3) { name } }`}, "String cannot represent"},
+		{"mutation", Request{Query: `mutation { authors { name } }`}, `does not support operation type "mutation"`},
+		{"required variable", Request{Query: `query ($n: String!) { author(name: $n) { name } }`}, "must be defined"},
+		{"ambiguous operation", Request{Query: `query A { authors { name } } query B { authors { name } }`}, "operationName is required"},
+		{"unknown operation", Request{Query: `query A { authors { name } }`, OperationName: "B"}, `unknown operation "B"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := Execute(context.Background(), testSchema(t), tt.req)
+			if resp.Data != nil || !strings.Contains(resp.Errors.Error(), tt.match) {
+				t.Errorf("expected error containing %q and no data, got %+v", tt.match, resp.Errors)
+			}
+		})
+	}
+}
+
+func TestExecute_ErrorLocations(t *testing.T) {
+	resp := Execute(context.Background(), testSchema(t), Request{Query: "{\n  author(name: ) { name }\n}"})
+	if len(resp.Errors) != 1 || len(resp.Errors[0].Locations) != 1 {
+		t.Fatalf("expected one located error, got %+v", resp.Errors)
+	}
+	if loc := resp.Errors[0].Locations[0]; loc.Line != 2 || loc.Column != 16 {
+		t.Errorf("expected error at 2:16, got %d:%d", loc.Line, loc.Column)
+	}
+}
+
+func TestExecute_RejectsUnboundedQueries(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		maxDepth      int
+		maxComplexity int
+		match         string
+	}{
+		{"self spread", `{ authors { ...A } } fragment A on Author { name ...A }`, 0, 0, `Cannot spread fragment "A" within itself`},
+		{"nested self spread", `{ authors { ...A } } fragment A on Author { books { ...B } } fragment B on Book { title ...A }`, 0, 0, "Cannot spread fragment"},
+		{"unknown fragment", `{ authors { ...Missing } }`, 0, 0, `Unknown fragment "Missing"`},
+		{"too deep", `{ authors { books { title } } }`, 2, 0, "query depth 3 exceeds the maximum of 2"},
+		// authors costs 1 + 10 × (books: 1 + 10 × 2) = 211
+		{"too complex", `{ authors { books { title pages } } }`, 0, 100, "query complexity exceeds the maximum of 100"},
+		{"aliased introspection", `{ a: __schema { types { name } } b: __schema { types { name } } }`, 0, 150, "query complexity exceeds the maximum of 150"},
+		{"nested introspection lists", `{ __type(name: "Author") { fields { type { fields { type { fields { name } } } } } } }`, 0, 0, "introspection lists nest more than 2 deep"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := testSchema(t)
+			schema.MaxDepth, schema.MaxComplexity = tt.maxDepth, tt.maxComplexity
+			resp := Execute(context.Background(), schema, Request{Query: tt.query})
+			if resp.Data != nil || !strings.Contains(resp.Errors.Error(), tt.match) {
+				t.Errorf("expected error containing %q and no data, got %+v", tt.match, resp.Errors)
+			}
+		})
+	}
+}
+
+func TestExecute_FragmentsCountTowardLimits(t *testing.T) {
+	// Each fragment doubles the fields of the one it spreads; expanded, the
+	// query selects far more than the document shows
+	query := `{ authors { ...F3 } }
+		fragment F0 on Author { name }
+		fragment F1 on Author { ...F0 a: name ...F0 }
+		fragment F2 on Author { ...F1 b: name ...F1 }
+		fragment F3 on Author { ...F2 c: name ...F2 }`
+	schema := testSchema(t)
+	schema.MaxComplexity = 100
+	resp := Execute(context.Background(), schema, Request{Query: query})
+	if len(resp.Errors) == 0 || !strings.Contains(resp.Errors[0].Message, "complexity") {
+		t.Errorf("expected the expanded fragments to exceed the complexity limit, got %+v", resp.Errors)
+	}
+
+	schema.MaxComplexity = 0
+	resp = Execute(context.Background(), schema, Request{Query: query})
+	if len(resp.Errors) != 0 {
+		t.Errorf("expected the query to run within the default limits, got %+v", resp.Errors)
+	}
+}
@@ -0,0 +1,291 @@
+package graphql
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// introspectionResolvers resolves __schema, __type and the introspection
+// types gqlparser declares in its prelude, reading everything off the
+// loaded schema
+func introspectionResolvers(schema *ast.Schema) Resolvers {
+	named := func(name string) interface{} {
+		if def := schema.Types[name]; def != nil {
+			return &typeRef{schema: schema, def: def}
+		}
+		return nil
+	}
+	ref := func(t *ast.Type) *typeRef {
+		return &typeRef{schema: schema, typ: t}
+	}
+	source := func(f func(p ResolveParams) interface{}) ResolveFunc {
+		return func(p ResolveParams) (interface{}, error) {
+			return f(p), nil
+		}
+	}
+	includeDeprecated := func(p ResolveParams) bool {
+		v, _ := p.Args["includeDeprecated"].(bool)
+		return v
+	}
+
+	return Resolvers{
+		schema.Query.Name: {
+			"__schema": source(func(p ResolveParams) interface{} { return schema }),
+			"__type":   source(func(p ResolveParams) interface{} { return named(p.Args["name"].(string)) }),
+		},
+		"__Schema": {
+			"description": source(func(p ResolveParams) interface{} { return nil }),
+			"types": source(func(p ResolveParams) interface{} {
+				names := make([]string, 0, len(schema.Types))
+				for name := range schema.Types {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				types := make([]*typeRef, len(names))
+				for i, name := range names {
+					types[i] = &typeRef{schema: schema, def: schema.Types[name]}
+				}
+				return types
+			}),
+			"queryType":        source(func(p ResolveParams) interface{} { return named(schema.Query.Name) }),
+			"mutationType":     source(func(p ResolveParams) interface{} { return nil }),
+			"subscriptionType": source(func(p ResolveParams) interface{} { return nil }),
+			"directives": source(func(p ResolveParams) interface{} {
+				names := make([]string, 0, len(schema.Directives))
+				for name := range schema.Directives {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				directives := make([]*ast.DirectiveDefinition, len(names))
+				for i, name := range names {
+					directives[i] = schema.Directives[name]
+				}
+				return directives
+			}),
+		},
+		"__Type": {
+			"kind": source(func(p ResolveParams) interface{} { return p.Source.(*typeRef).kind() }),
+			"name": source(func(p ResolveParams) interface{} {
+				if t := p.Source.(*typeRef); t.named() != nil {
+					return t.named().Name
+				}
+				return nil
+			}),
+			"description": source(func(p ResolveParams) interface{} {
+				if t := p.Source.(*typeRef); t.named() != nil {
+					return nonEmpty(t.named().Description)
+				}
+				return nil
+			}),
+			"fields": source(func(p ResolveParams) interface{} {
+				def := p.Source.(*typeRef).named()
+				if def == nil || (def.Kind != ast.Object && def.Kind != ast.Interface) {
+					return nil
+				}
+				fields := []*ast.FieldDefinition{}
+				for _, f := range def.Fields {
+					if strings.HasPrefix(f.Name, "__") {
+						continue
+					}
+					if includeDeprecated(p) || f.Directives.ForName("deprecated") == nil {
+						fields = append(fields, f)
+					}
+				}
+				return fields
+			}),
+			"interfaces": source(func(p ResolveParams) interface{} {
+				def := p.Source.(*typeRef).named()
+				if def == nil || (def.Kind != ast.Object && def.Kind != ast.Interface) {
+					return nil
+				}
+				interfaces := []*typeRef{}
+				for _, name := range def.Interfaces {
+					interfaces = append(interfaces, &typeRef{schema: schema, def: schema.Types[name]})
+				}
+				return interfaces
+			}),
+			"possibleTypes": source(func(p ResolveParams) interface{} {
+				def := p.Source.(*typeRef).named()
+				if def == nil || !def.IsAbstractType() {
+					return nil
+				}
+				types := []*typeRef{}
+				for _, t := range schema.GetPossibleTypes(def) {
+					types = append(types, &typeRef{schema: schema, def: t})
+				}
+				return types
+			}),
+			"enumValues": source(func(p ResolveParams) interface{} {
+				def := p.Source.(*typeRef).named()
+				if def == nil || def.Kind != ast.Enum {
+					return nil
+				}
+				values := []*ast.EnumValueDefinition{}
+				for _, v := range def.EnumValues {
+					if includeDeprecated(p) || v.Directives.ForName("deprecated") == nil {
+						values = append(values, v)
+					}
+				}
+				return values
+			}),
+			"inputFields": source(func(p ResolveParams) interface{} {
+				def := p.Source.(*typeRef).named()
+				if def == nil || def.Kind != ast.InputObject {
+					return nil
+				}
+				fields := make([]*inputValue, len(def.Fields))
+				for i, f := range def.Fields {
+					fields[i] = &inputValue{name: f.Name, description: f.Description, typ: f.Type, defaultValue: f.DefaultValue}
+				}
+				return fields
+			}),
+			"ofType": source(func(p ResolveParams) interface{} {
+				if of := p.Source.(*typeRef).ofType(); of != nil {
+					return of
+				}
+				return nil
+			}),
+			"specifiedByURL": source(func(p ResolveParams) interface{} {
+				def := p.Source.(*typeRef).named()
+				if def == nil {
+					return nil
+				}
+				if d := def.Directives.ForName("specifiedBy"); d != nil {
+					return d.Arguments.ForName("url").Value.Raw
+				}
+				return nil
+			}),
+		},
+		"__Field": {
+			"name":        source(func(p ResolveParams) interface{} { return p.Source.(*ast.FieldDefinition).Name }),
+			"description": source(func(p ResolveParams) interface{} { return nonEmpty(p.Source.(*ast.FieldDefinition).Description) }),
+			"args": source(func(p ResolveParams) interface{} {
+				return inputValues(p.Source.(*ast.FieldDefinition).Arguments)
+			}),
+			"type":         source(func(p ResolveParams) interface{} { return ref(p.Source.(*ast.FieldDefinition).Type) }),
+			"isDeprecated": source(func(p ResolveParams) interface{} { return isDeprecated(p.Source.(*ast.FieldDefinition).Directives) }),
+			"deprecationReason": source(func(p ResolveParams) interface{} {
+				return deprecationReason(p.Source.(*ast.FieldDefinition).Directives)
+			}),
+		},
+		"__InputValue": {
+			"name":        source(func(p ResolveParams) interface{} { return p.Source.(*inputValue).name }),
+			"description": source(func(p ResolveParams) interface{} { return nonEmpty(p.Source.(*inputValue).description) }),
+			"type":        source(func(p ResolveParams) interface{} { return ref(p.Source.(*inputValue).typ) }),
+			"defaultValue": source(func(p ResolveParams) interface{} {
+				if v := p.Source.(*inputValue).defaultValue; v != nil {
+					return v.String()
+				}
+				return nil
+			}),
+		},
+		"__EnumValue": {
+			"name":         source(func(p ResolveParams) interface{} { return p.Source.(*ast.EnumValueDefinition).Name }),
+			"description":  source(func(p ResolveParams) interface{} { return nonEmpty(p.Source.(*ast.EnumValueDefinition).Description) }),
+			"isDeprecated": source(func(p ResolveParams) interface{} { return isDeprecated(p.Source.(*ast.EnumValueDefinition).Directives) }),
+			"deprecationReason": source(func(p ResolveParams) interface{} {
+				return deprecationReason(p.Source.(*ast.EnumValueDefinition).Directives)
+			}),
+		},
+		"__Directive": {
+			"name":        source(func(p ResolveParams) interface{} { return p.Source.(*ast.DirectiveDefinition).Name }),
+			"description": source(func(p ResolveParams) interface{} { return nonEmpty(p.Source.(*ast.DirectiveDefinition).Description) }),
+			"locations": source(func(p ResolveParams) interface{} {
+				return p.Source.(*ast.DirectiveDefinition).Locations
+			}),
+			"args": source(func(p ResolveParams) interface{} {
+				return inputValues(p.Source.(*ast.DirectiveDefinition).Arguments)
+			}),
+			"isRepeatable": source(func(p ResolveParams) interface{} { return p.Source.(*ast.DirectiveDefinition).IsRepeatable }),
+		},
+	}
+}
+
+// typeRef is the source of a __Type: either a named definition or a list or
+// non-null wrapper around another type
+type typeRef struct {
+	schema *ast.Schema
+	def    *ast.Definition
+	typ    *ast.Type
+}
+
+func (t *typeRef) kind() string {
+	switch {
+	case t.typ == nil:
+		return string(t.def.Kind)
+	case t.typ.NonNull:
+		return "NON_NULL"
+	case t.typ.Elem != nil:
+		return "LIST"
+	default:
+		return string(t.named().Kind)
+	}
+}
+
+// named returns the definition of a named type, or nil for wrappers
+func (t *typeRef) named() *ast.Definition {
+	if t.typ == nil {
+		return t.def
+	}
+	if t.typ.NonNull || t.typ.Elem != nil {
+		return nil
+	}
+	return t.schema.Types[t.typ.NamedType]
+}
+
+// ofType unwraps one level of a list or non-null type
+func (t *typeRef) ofType() *typeRef {
+	switch {
+	case t.typ == nil:
+		return nil
+	case t.typ.NonNull:
+		inner := *t.typ
+		inner.NonNull = false
+		return &typeRef{schema: t.schema, typ: &inner}
+	case t.typ.Elem != nil:
+		return &typeRef{schema: t.schema, typ: t.typ.Elem}
+	default:
+		return nil
+	}
+}
+
+// inputValue is the source of an __InputValue, covering both arguments and
+// input object fields
+type inputValue struct {
+	name         string
+	description  string
+	typ          *ast.Type
+	defaultValue *ast.Value
+}
+
+func inputValues(args ast.ArgumentDefinitionList) []*inputValue {
+	values := make([]*inputValue, len(args))
+	for i, a := range args {
+		values[i] = &inputValue{name: a.Name, description: a.Description, typ: a.Type, defaultValue: a.DefaultValue}
+	}
+	return values
+}
+
+func isDeprecated(directives ast.DirectiveList) bool {
+	return directives.ForName("deprecated") != nil
+}
+
+func deprecationReason(directives ast.DirectiveList) interface{} {
+	d := directives.ForName("deprecated")
+	if d == nil {
+		return nil
+	}
+	if reason := d.Arguments.ForName("reason"); reason != nil {
+		return reason.Value.Raw
+	}
+	return "No longer supported"
+}
+
+func nonEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
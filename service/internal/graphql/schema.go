@@ -0,0 +1,111 @@
+// Package graphql executes read-only queries against a schema written in
+// SDL. Parsing, schema loading, query validation and variable coercion are
+// done by gqlparser, the front end of gqlgen; this package adds a small
+// resolver-map executor, introspection, and depth and complexity limits.
+//
+// gqlgen itself generates its executor from the schema at build time, and
+// neither the generator nor its runtime module is in the module cache this
+// service is built from offline. gqlparser is, and it is the part of gqlgen
+// that implements the spec, so the executor here only walks documents that
+// gqlparser has already validated.
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ResolveFunc resolves a field value for the given source object
+type ResolveFunc func(p ResolveParams) (interface{}, error)
+
+// ResolveParams carries the inputs for a field resolver. Args holds the
+// field's arguments coerced to their declared types, with defaults applied.
+type ResolveParams struct {
+	Context context.Context
+	Source  interface{}
+	Args    map[string]interface{}
+}
+
+// Resolvers maps object type names to resolvers for each of their fields
+type Resolvers map[string]map[string]ResolveFunc
+
+// Schema is an SDL schema with a resolver for every object field
+type Schema struct {
+	schema    *ast.Schema
+	resolvers Resolvers
+
+	// MaxDepth and MaxComplexity bound the queries Execute accepts. Zero
+	// means DefaultMaxDepth and DefaultMaxComplexity.
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// NewSchema loads sdl and binds resolvers to it. Every field of every object
+// type must have a resolver, and every resolver must belong to a field, so
+// the schema and its resolvers can't drift apart unnoticed. Only object
+// types are supported as field types; interfaces and unions are rejected
+// because the executor has no way to pick their concrete type.
+func NewSchema(sdl string, resolvers Resolvers) (*Schema, error) {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: sdl})
+	if err != nil {
+		return nil, err
+	}
+	if schema.Mutation != nil || schema.Subscription != nil {
+		return nil, fmt.Errorf("only query operations are supported")
+	}
+
+	var problems []string
+	for name, fields := range resolvers {
+		def := schema.Types[name]
+		for field := range fields {
+			if def == nil || def.Kind != ast.Object || def.Fields.ForName(field) == nil {
+				problems = append(problems, fmt.Sprintf("resolver for %s.%s matches no field in the schema", name, field))
+			}
+		}
+	}
+
+	bound := make(Resolvers, len(resolvers))
+	for _, rs := range []Resolvers{resolvers, introspectionResolvers(schema)} {
+		for name, fields := range rs {
+			if bound[name] == nil {
+				bound[name] = make(map[string]ResolveFunc, len(fields))
+			}
+			for field, resolve := range fields {
+				bound[name][field] = resolve
+			}
+		}
+	}
+
+	for name, def := range schema.Types {
+		switch def.Kind {
+		case ast.Interface, ast.Union:
+			problems = append(problems, fmt.Sprintf("type %s: interfaces and unions are not supported", name))
+		case ast.Object:
+			for _, field := range def.Fields {
+				if bound[name][field.Name] == nil {
+					problems = append(problems, fmt.Sprintf("%s.%s has no resolver", name, field.Name))
+				}
+			}
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("schema does not match its resolvers: %s", strings.Join(problems, "; "))
+	}
+	return &Schema{schema: schema, resolvers: bound}, nil
+}
+
+// MustNewSchema is like NewSchema but panics if the schema can't be loaded.
+// It is meant for schemas compiled into the binary.
+func MustNewSchema(sdl string, resolvers Resolvers) *Schema {
+	s, err := NewSchema(sdl, resolvers)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
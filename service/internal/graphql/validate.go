@@ -0,0 +1,142 @@
+package graphql
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultMaxDepth is the deepest field nesting a query may select
+	DefaultMaxDepth = 10
+
+	// DefaultMaxComplexity bounds the estimated number of fields a query
+	// resolves. Each field costs one; the selections under a list field are
+	// counted listCost times.
+	DefaultMaxComplexity = 5000
+
+	// listCost is how many items a list field is assumed to return
+	listCost = 10
+
+	// maxIntrospectionLists bounds how deeply the list fields of __Type
+	// (fields, interfaces, possibleTypes, inputFields) may nest. Each level
+	// multiplies the response by the size of the schema; the standard
+	// introspection query nests one.
+	maxIntrospectionLists = 2
+
+	// introspectionCost is the flat complexity of a __schema or __type
+	// field, so that aliasing many of them still hits the limit
+	introspectionCost = 100
+)
+
+// checkLimits rejects operations that nest too deep or would resolve too
+// many fields. It runs after gqlparser's validation, which has already
+// rejected fragment cycles and unknown fragments. Fragments are expanded
+// while measuring, so a small document can't hide a large query.
+//
+// Introspection is measured separately: its type references nest deeper
+// than any data query, but what it returns is bounded by the schema as long
+// as the lists under __Type don't nest.
+func checkLimits(schema *Schema, op *ast.OperationDefinition) *gqlerror.Error {
+	maxDepth, maxComplexity := schema.MaxDepth, schema.MaxComplexity
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if maxComplexity <= 0 {
+		maxComplexity = DefaultMaxComplexity
+	}
+
+	m := &measurer{limit: maxComplexity, fragments: map[string]measure{}}
+	got := m.selections(op.SelectionSet)
+	if got.depth > maxDepth {
+		return gqlerror.ErrorPosf(op.Position, "query depth %d exceeds the maximum of %d", got.depth, maxDepth)
+	}
+	if got.cost > maxComplexity {
+		return gqlerror.ErrorPosf(op.Position, "query complexity exceeds the maximum of %d", maxComplexity)
+	}
+	if introspectionLists(op.SelectionSet, map[string]int{}) > maxIntrospectionLists {
+		return gqlerror.ErrorPosf(op.Position, "introspection lists nest more than %d deep", maxIntrospectionLists)
+	}
+	return nil
+}
+
+// introspectionLists returns the deepest nesting of __Type list fields in
+// sels, counting each fragment once
+func introspectionLists(sels ast.SelectionSet, fragments map[string]int) int {
+	deepest := 0
+	for _, sel := range sels {
+		var n int
+		switch s := sel.(type) {
+		case *ast.Field:
+			n = introspectionLists(s.SelectionSet, fragments)
+			if s.ObjectDefinition != nil && s.ObjectDefinition.Name == "__Type" {
+				switch s.Name {
+				case "fields", "interfaces", "possibleTypes", "inputFields":
+					n++
+				}
+			}
+		case *ast.InlineFragment:
+			n = introspectionLists(s.SelectionSet, fragments)
+		case *ast.FragmentSpread:
+			var ok bool
+			if n, ok = fragments[s.Name]; !ok {
+				n = introspectionLists(s.Definition.SelectionSet, fragments)
+				fragments[s.Name] = n
+			}
+		}
+		deepest = max(deepest, n)
+	}
+	return deepest
+}
+
+type measure struct {
+	depth int
+	cost  int
+}
+
+// measurer computes the depth and cost of selections, measuring each
+// fragment once. Costs are capped just above the limit so that deeply
+// nested lists can't overflow.
+type measurer struct {
+	limit     int
+	fragments map[string]measure
+}
+
+func (m *measurer) selections(sels ast.SelectionSet) measure {
+	var total measure
+	add := func(sub measure) {
+		total.depth = max(total.depth, sub.depth)
+		total.cost = min(total.cost+sub.cost, m.limit+1)
+	}
+	for _, sel := range sels {
+		switch s := sel.(type) {
+		case *ast.Field:
+			add(m.field(s))
+		case *ast.InlineFragment:
+			add(m.selections(s.SelectionSet))
+		case *ast.FragmentSpread:
+			sub, ok := m.fragments[s.Name]
+			if !ok {
+				sub = m.selections(s.Definition.SelectionSet)
+				m.fragments[s.Name] = sub
+			}
+			add(sub)
+		}
+	}
+	return total
+}
+
+func (m *measurer) field(f *ast.Field) measure {
+	if f.Name == "__schema" || f.Name == "__type" {
+		return measure{depth: 1, cost: introspectionCost}
+	}
+	if len(f.SelectionSet) == 0 {
+		return measure{depth: 1, cost: 1}
+	}
+
+	multiplier := 1
+	if f.Definition != nil && f.Definition.Type.Elem != nil {
+		multiplier = listCost
+	}
+	sub := m.selections(f.SelectionSet)
+	return measure{depth: sub.depth + 1, cost: min(1+multiplier*sub.cost, m.limit+1)}
+}
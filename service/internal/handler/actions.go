@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// ActionRetention is how long classification actions stay in the undo history
+const ActionRetention = 30 * 24 * time.Hour
+
+// ActionHandler implements the classification action journal endpoints
+type ActionHandler struct {
+	classificationSvc *classification.Service
+}
+
+// NewActionHandler creates a new action handler
+func NewActionHandler(classificationSvc *classification.Service) *ActionHandler {
+	return &ActionHandler{classificationSvc: classificationSvc}
+}
+
+// ListClassificationActions returns recent classification actions
+func (h *ActionHandler) ListClassificationActions(ctx context.Context, req api.ListClassificationActionsRequestObject) (api.ListClassificationActionsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListClassificationActions401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	limit := 50
+	if req.Params.Limit != nil && *req.Params.Limit > 0 && *req.Params.Limit <= 200 {
+		limit = *req.Params.Limit
+	}
+
+	actions, err := h.classificationSvc.ListActions(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.ClassificationAction, len(actions))
+	for i, a := range actions {
+		result[i] = actionToAPI(a)
+	}
+	return api.ListClassificationActions200JSONResponse(result), nil
+}
+
+// UndoClassificationAction reverts a classification action
+func (h *ActionHandler) UndoClassificationAction(ctx context.Context, req api.UndoClassificationActionRequestObject) (api.UndoClassificationActionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UndoClassificationAction401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	action, dates, err := h.classificationSvc.UndoAction(ctx, userID, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrClassificationActionNotFound):
+			return api.UndoClassificationAction404JSONResponse{
+				Code:    "not_found",
+				Message: "Action not found",
+			}, nil
+		case errors.Is(err, store.ErrActionAlreadyUndone), errors.Is(err, store.ErrActionSuperseded):
+			return api.UndoClassificationAction409JSONResponse{
+				Code:    "conflict",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	recalculated := make([]openapi_types.Date, len(dates))
+	for i, d := range dates {
+		recalculated[i] = openapi_types.Date{Time: d}
+	}
+
+	return api.UndoClassificationAction200JSONResponse{
+		Action:            actionToAPI(action),
+		RecalculatedDates: recalculated,
+	}, nil
+}
+
+func actionToAPI(a *store.ClassificationAction) api.ClassificationAction {
+	return api.ClassificationAction{
+		Id:          a.ID,
+		Kind:        api.ClassificationActionKind(a.Kind),
+		Description: a.Description,
+		EventCount:  a.EventCount,
+		CreatedAt:   a.CreatedAt,
+		UndoneAt:    a.UndoneAt,
+	}
+}
@@ -0,0 +1,84 @@
+//go:build integration
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestClassificationUndo classifies a synced event through the calendar
+// handler and reverts it through the action journal
+func TestClassificationUndo(t *testing.T) {
+	s := newSyncHarness(t)
+	start := time.Now().UTC().AddDate(0, 0, -1).Truncate(time.Hour)
+	s.fake.PutEvent("primary", e2eEvent("planning", "Planning", start))
+	s.sync(nil)
+	eventID := s.eventID("planning")
+
+	project, err := store.NewProjectStore(s.pool).Create(s.ctx, s.calendar().UserID, "Acme", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	resp, err := s.handler.ClassifyCalendarEvent(s.ctx, api.ClassifyCalendarEventRequestObject{
+		Id:   eventID,
+		Body: &api.ClassifyEventRequest{ProjectId: &project.ID},
+	})
+	if err != nil {
+		t.Fatalf("ClassifyCalendarEvent: %v", err)
+	}
+	classified, ok := resp.(api.ClassifyCalendarEvent200JSONResponse)
+	if !ok || classified.ActionId == nil {
+		t.Fatalf("expected a classified event with an action, got %#v", resp)
+	}
+
+	h := NewActionHandler(s.classifier)
+	listed, err := h.ListClassificationActions(s.ctx, api.ListClassificationActionsRequestObject{})
+	if err != nil {
+		t.Fatalf("ListClassificationActions: %v", err)
+	}
+	actions, ok := listed.(api.ListClassificationActions200JSONResponse)
+	if !ok || len(actions) != 1 || actions[0].Id != *classified.ActionId || actions[0].Kind != api.Classify || actions[0].EventCount != 1 {
+		t.Fatalf("expected the classify action in the journal, got %#v", listed)
+	}
+
+	undo := func(id uuid.UUID) api.UndoClassificationActionResponseObject {
+		t.Helper()
+		resp, err := h.UndoClassificationAction(s.ctx, api.UndoClassificationActionRequestObject{Id: id})
+		if err != nil {
+			t.Fatalf("UndoClassificationAction: %v", err)
+		}
+		return resp
+	}
+
+	undone, ok := undo(*classified.ActionId).(api.UndoClassificationAction200JSONResponse)
+	if !ok || undone.Action.UndoneAt == nil {
+		t.Fatalf("expected the action undone, got %#v", undone)
+	}
+	if len(undone.RecalculatedDates) != 1 || undone.RecalculatedDates[0].Format("2006-01-02") != start.Format("2006-01-02") {
+		t.Errorf("expected the event's day to be recalculated, got %v", undone.RecalculatedDates)
+	}
+
+	var status string
+	var projectID *uuid.UUID
+	if err := s.pool.QueryRow(s.ctx, "SELECT classification_status, project_id FROM calendar_events WHERE id = $1", eventID).Scan(&status, &projectID); err != nil {
+		t.Fatal(err)
+	}
+	if status != string(store.StatusPending) || projectID != nil {
+		t.Errorf("expected the event pending again, got %s on %v", status, projectID)
+	}
+
+	again := undo(*classified.ActionId)
+	if _, ok := again.(api.UndoClassificationAction409JSONResponse); !ok {
+		t.Errorf("expected 409 undoing twice, got %T", again)
+	}
+	if _, ok := undo(uuid.New()).(api.UndoClassificationAction404JSONResponse); !ok {
+		t.Errorf("expected 404 for an unknown action")
+	}
+}
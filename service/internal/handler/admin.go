@@ -0,0 +1,531 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"runtime"
+	"strconv"
+	gosync "sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/michaelw/timesheet-app/service/internal/aggregate"
+	"github.com/michaelw/timesheet-app/service/internal/backup"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/sync"
+)
+
+// AdminTokenHeader carries the operator token. It is separate from the
+// Authorization header so an admin request is never mistaken for a user one.
+const AdminTokenHeader = "X-Admin-Token"
+
+// Defaults for the stuck-job listing
+const (
+	defaultStuckRunningFor = 30 * time.Minute
+	defaultStuckPendingFor = 2 * time.Hour
+)
+
+// resyncJobPriority puts operator-requested resyncs ahead of user-initiated jobs
+const resyncJobPriority = 20
+
+// AdminHandler serves the operator endpoints under /admin. They cover all
+// users, so they are guarded by a shared admin token rather than user auth,
+// and are disabled entirely when no token is configured.
+type AdminHandler struct {
+	token      string
+	pool       *pgxpool.Pool
+	admin      *store.AdminStore
+	calendars  *store.CalendarStore
+	syncJobs   *store.SyncJobStore
+	aggregates *aggregate.Service
+	startedAt  time.Time
+
+	backfillMu      gosync.Mutex
+	backfillRunning bool
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(
+	token string,
+	pool *pgxpool.Pool,
+	admin *store.AdminStore,
+	calendars *store.CalendarStore,
+	syncJobs *store.SyncJobStore,
+	aggregates *aggregate.Service,
+) *AdminHandler {
+	return &AdminHandler{
+		token:      token,
+		pool:       pool,
+		admin:      admin,
+		calendars:  calendars,
+		syncJobs:   syncJobs,
+		aggregates: aggregates,
+		startedAt:  time.Now().UTC(),
+	}
+}
+
+// Routes returns the admin router, to be mounted at /admin
+func (h *AdminHandler) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Use(h.requireToken)
+	r.Get("/health", h.Health)
+	r.Get("/sync-stats", h.SyncStats)
+	r.Get("/sync-jobs/stuck", h.StuckJobs)
+	r.Get("/sync-gaps", h.SyncGaps)
+	r.Post("/calendars/{id}/resync", h.ResyncCalendar)
+	r.Post("/calendars/{id}/clear-sync-token", h.ClearSyncToken)
+	r.Post("/aggregates/backfill", h.BackfillAggregates)
+	r.Get("/backup", h.Backup)
+	r.Post("/restore", h.Restore)
+	return r
+}
+
+func (h *AdminHandler) requireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.token == "" {
+			http.NotFound(w, r)
+			return
+		}
+		given := r.Header.Get(AdminTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(given), []byte(h.token)) != 1 {
+			writeAdminError(w, http.StatusUnauthorized, "unauthorized", "Valid admin token required")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// AdminHealth is the response for the health endpoint
+type AdminHealth struct {
+	Status        string         `json:"status"`
+	Timestamp     time.Time      `json:"timestamp"`
+	Uptime        string         `json:"uptime"`
+	GoVersion     string         `json:"go_version"`
+	Goroutines    int            `json:"goroutines"`
+	Database      AdminDatabase  `json:"database"`
+	SyncJobCounts map[string]int `json:"sync_job_counts"`
+}
+
+// AdminDatabase describes database connectivity and pool usage
+type AdminDatabase struct {
+	Reachable     bool   `json:"reachable"`
+	Error         string `json:"error,omitempty"`
+	PingMs        int64  `json:"ping_ms"`
+	SchemaVersion int    `json:"schema_version"`
+	TotalConns    int32  `json:"total_conns"`
+	IdleConns     int32  `json:"idle_conns"`
+	MaxConns      int32  `json:"max_conns"`
+}
+
+// Health reports process, database and job queue details. The status is
+// "degraded" with a 503 when the database can't be reached.
+func (h *AdminHandler) Health(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	resp := AdminHealth{
+		Status:        "ok",
+		Timestamp:     time.Now().UTC(),
+		Uptime:        time.Since(h.startedAt).Round(time.Second).String(),
+		GoVersion:     runtime.Version(),
+		Goroutines:    runtime.NumGoroutine(),
+		SyncJobCounts: map[string]int{},
+	}
+
+	start := time.Now()
+	if err := h.pool.Ping(ctx); err != nil {
+		resp.Status = "degraded"
+		resp.Database.Error = err.Error()
+		writeAdminJSON(w, http.StatusServiceUnavailable, resp)
+		return
+	}
+	resp.Database.Reachable = true
+	resp.Database.PingMs = time.Since(start).Milliseconds()
+
+	stat := h.pool.Stat()
+	resp.Database.TotalConns = stat.TotalConns()
+	resp.Database.IdleConns = stat.IdleConns()
+	resp.Database.MaxConns = stat.MaxConns()
+
+	if version, err := h.admin.SchemaVersion(ctx); err == nil {
+		resp.Database.SchemaVersion = version
+	}
+	if counts, err := h.admin.SyncJobCounts(ctx); err == nil {
+		for status, n := range counts {
+			resp.SyncJobCounts[string(status)] = n
+		}
+	}
+
+	writeAdminJSON(w, http.StatusOK, resp)
+}
+
+// AdminUserSyncStats is one user's row in the sync stats listing
+type AdminUserSyncStats struct {
+	UserID            uuid.UUID  `json:"user_id"`
+	Email             string     `json:"email"`
+	Connections       int        `json:"connections"`
+	SelectedCalendars int        `json:"selected_calendars"`
+	NeedsReauth       int        `json:"needs_reauth"`
+	FailingCalendars  int        `json:"failing_calendars"`
+	DisabledCalendars int        `json:"disabled_calendars"`
+	OldestSyncedAt    *time.Time `json:"oldest_synced_at"`
+	IsStale           bool       `json:"is_stale"`
+	PendingJobs       int        `json:"pending_jobs"`
+	FailedJobs        int        `json:"failed_jobs"`
+}
+
+// SyncStats lists per-user calendar sync health, users with problems first
+func (h *AdminHandler) SyncStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.admin.ListUserSyncStats(r.Context())
+	if err != nil {
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	resp := make([]AdminUserSyncStats, 0, len(stats))
+	for _, st := range stats {
+		resp = append(resp, AdminUserSyncStats{
+			UserID:            st.UserID,
+			Email:             st.Email,
+			Connections:       st.Connections,
+			SelectedCalendars: st.SelectedCalendars,
+			NeedsReauth:       st.NeedsReauth,
+			FailingCalendars:  st.FailingCalendars,
+			DisabledCalendars: st.DisabledCalendars,
+			OldestSyncedAt:    st.OldestSyncedAt,
+			IsStale:           st.SelectedCalendars > 0 && sync.IsStale(st.OldestSyncedAt),
+			PendingJobs:       st.PendingJobs,
+			FailedJobs:        st.FailedJobs,
+		})
+	}
+	writeAdminJSON(w, http.StatusOK, resp)
+}
+
+// AdminSyncJob is a sync job in the stuck-job listing
+type AdminSyncJob struct {
+	ID            uuid.UUID  `json:"id"`
+	CalendarID    uuid.UUID  `json:"calendar_id"`
+	JobType       string     `json:"job_type"`
+	Status        string     `json:"status"`
+	Priority      int        `json:"priority"`
+	TargetMinDate string     `json:"target_min_date"`
+	TargetMaxDate string     `json:"target_max_date"`
+	CreatedAt     time.Time  `json:"created_at"`
+	ClaimedAt     *time.Time `json:"claimed_at,omitempty"`
+	ClaimedBy     *string    `json:"claimed_by,omitempty"`
+}
+
+// StuckJobs lists jobs running or pending for too long. The thresholds can
+// be set with the running_for and pending_for query parameters, as Go
+// durations such as "45m".
+func (h *AdminHandler) StuckJobs(w http.ResponseWriter, r *http.Request) {
+	runningFor, err := durationParam(r, "running_for", defaultStuckRunningFor)
+	if err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+	pendingFor, err := durationParam(r, "pending_for", defaultStuckPendingFor)
+	if err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	jobs, err := h.syncJobs.ListStuck(r.Context(), runningFor, pendingFor)
+	if err != nil {
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	resp := make([]AdminSyncJob, 0, len(jobs))
+	for _, job := range jobs {
+		resp = append(resp, AdminSyncJob{
+			ID:            job.ID,
+			CalendarID:    job.CalendarID,
+			JobType:       string(job.JobType),
+			Status:        string(job.Status),
+			Priority:      job.Priority,
+			TargetMinDate: job.TargetMinDate.Format("2006-01-02"),
+			TargetMaxDate: job.TargetMaxDate.Format("2006-01-02"),
+			CreatedAt:     job.CreatedAt,
+			ClaimedAt:     job.ClaimedAt,
+			ClaimedBy:     job.ClaimedBy,
+		})
+	}
+	writeAdminJSON(w, http.StatusOK, resp)
+}
+
+// AdminSyncGap is a calendar in the persistent-gap listing
+type AdminSyncGap struct {
+	CalendarID    uuid.UUID `json:"calendar_id"`
+	UserID        uuid.UUID `json:"user_id"`
+	Name          string    `json:"name"`
+	MissingWeeks  int       `json:"missing_weeks"`
+	GapDetectedAt time.Time `json:"gap_detected_at"`
+}
+
+// SyncGaps lists calendars whose synced window has had unfetched weeks for
+// too long, meaning the gap healer's jobs keep failing. The threshold can be
+// set with the open_for query parameter, as a Go duration.
+func (h *AdminHandler) SyncGaps(w http.ResponseWriter, r *http.Request) {
+	openFor, err := durationParam(r, "open_for", sync.PersistentGapAge)
+	if err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	gaps, err := h.calendars.ListPersistentGaps(r.Context(), openFor)
+	if err != nil {
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	resp := make([]AdminSyncGap, 0, len(gaps))
+	for _, gap := range gaps {
+		resp = append(resp, AdminSyncGap{
+			CalendarID:    gap.CalendarID,
+			UserID:        gap.UserID,
+			Name:          gap.Name,
+			MissingWeeks:  gap.MissingWeeks,
+			GapDetectedAt: gap.GapDetectedAt,
+		})
+	}
+	writeAdminJSON(w, http.StatusOK, resp)
+}
+
+// AdminResyncResult is the response for a forced resync
+type AdminResyncResult struct {
+	CalendarID    uuid.UUID `json:"calendar_id"`
+	JobID         uuid.UUID `json:"job_id"`
+	TargetMinDate string    `json:"target_min_date"`
+	TargetMaxDate string    `json:"target_max_date"`
+}
+
+// ResyncCalendar forces a full resync of a calendar: the sync token and
+// failure count are cleared, re-enabling a calendar disabled by a permanent
+// failure, and a high-priority job is queued for the whole synced window
+// (or the default initial window if nothing was synced yet).
+// A calendar that needs re-authentication still has to be reconnected by
+// its owner.
+func (h *AdminHandler) ResyncCalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cal, ok := h.calendarParam(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.calendars.ClearSyncToken(ctx, cal.ID); err != nil {
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+	if err := h.calendars.ResetSyncFailureCount(ctx, cal.ID); err != nil {
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	minDate, maxDate := sync.DefaultInitialWindow()
+	if cal.MinSyncedDate != nil && cal.MaxSyncedDate != nil {
+		minDate, maxDate = *cal.MinSyncedDate, *cal.MaxSyncedDate
+	}
+	job, err := h.syncJobs.Create(ctx, &store.SyncJob{
+		CalendarID:    cal.ID,
+		JobType:       store.SyncJobTypeInitialSync,
+		TargetMinDate: minDate,
+		TargetMaxDate: maxDate,
+		Priority:      resyncJobPriority,
+	})
+	if err != nil {
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	writeAdminJSON(w, http.StatusAccepted, AdminResyncResult{
+		CalendarID:    cal.ID,
+		JobID:         job.ID,
+		TargetMinDate: minDate.Format("2006-01-02"),
+		TargetMaxDate: maxDate.Format("2006-01-02"),
+	})
+}
+
+// ClearSyncToken drops a calendar's incremental sync token so its next sync
+// is a full fetch
+func (h *AdminHandler) ClearSyncToken(w http.ResponseWriter, r *http.Request) {
+	cal, ok := h.calendarParam(w, r)
+	if !ok {
+		return
+	}
+	if err := h.calendars.ClearSyncToken(r.Context(), cal.ID); err != nil {
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AdminBackfill is the response for a started aggregate backfill
+type AdminBackfill struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// BackfillAggregates starts computing the daily project hours aggregate for
+// all users over the last N days (the days query parameter, default 365).
+// It runs in the background and logs its result; only one backfill runs at
+// a time.
+func (h *AdminHandler) BackfillAggregates(w http.ResponseWriter, r *http.Request) {
+	days := aggregate.DefaultBackfillDays
+	if v := r.URL.Query().Get("days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			writeAdminError(w, http.StatusBadRequest, "invalid_request", "days must be a positive integer")
+			return
+		}
+		days = n
+	}
+
+	h.backfillMu.Lock()
+	if h.backfillRunning {
+		h.backfillMu.Unlock()
+		writeAdminError(w, http.StatusConflict, "conflict", "A backfill is already running")
+		return
+	}
+	h.backfillRunning = true
+	h.backfillMu.Unlock()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	start := today.AddDate(0, 0, -days)
+
+	go func() {
+		defer func() {
+			h.backfillMu.Lock()
+			h.backfillRunning = false
+			h.backfillMu.Unlock()
+		}()
+		began := time.Now()
+		result, err := h.aggregates.Backfill(context.Background(), start, today)
+		if err != nil {
+			log.Printf("[AGGREGATE] backfill stopped after %d users: %v", result.Users, err)
+			return
+		}
+		log.Printf("[AGGREGATE] backfill complete: users=%d days=%d duration=%v",
+			result.Users, result.Days, time.Since(began).Round(time.Second))
+	}()
+
+	writeAdminJSON(w, http.StatusAccepted, AdminBackfill{
+		StartDate: start.Format("2006-01-02"),
+		EndDate:   today.Format("2006-01-02"),
+	})
+}
+
+// Backup streams a backup of the user named by the user_id query
+// parameter, or of the whole database without one
+func (h *AdminHandler) Backup(w http.ResponseWriter, r *http.Request) {
+	var userID *uuid.UUID
+	if v := r.URL.Query().Get("user_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			writeAdminError(w, http.StatusBadRequest, "invalid_request", "Invalid user ID")
+			return
+		}
+		userID = &id
+	}
+
+	// Nothing is written before the snapshot is taken and the user found, so
+	// those errors still get a status; later ones can only cut the stream
+	// short, which restore detects from the missing trailer
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", `attachment; filename="timesheet-backup.jsonl"`)
+	summary, err := backup.Dump(r.Context(), h.pool, w, userID)
+	if err != nil {
+		if errors.Is(err, backup.ErrUserNotFound) {
+			writeAdminError(w, http.StatusNotFound, "not_found", "User not found")
+			return
+		}
+		log.Printf("[BACKUP] backup failed: %v", err)
+		return
+	}
+	log.Printf("[BACKUP] backup written: user=%v schema_version=%d tables=%d",
+		summary.UserID, summary.SchemaVersion, len(summary.Rows))
+}
+
+// AdminRestore is the response for a restored backup
+type AdminRestore struct {
+	UserID        *uuid.UUID       `json:"user_id,omitempty"`
+	SchemaVersion int              `json:"schema_version"`
+	CreatedAt     time.Time        `json:"created_at"`
+	Rows          map[string]int64 `json:"rows"`
+}
+
+// Restore loads a backup from the request body. With replace=true the
+// backed-up user, or the whole database, is replaced; otherwise restoring
+// over existing data is a conflict.
+func (h *AdminHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	opts := backup.RestoreOptions{Replace: r.URL.Query().Get("replace") == "true"}
+	summary, err := backup.Restore(r.Context(), h.pool, r.Body, opts)
+	if err != nil {
+		switch {
+		case errors.Is(err, backup.ErrUserExists), errors.Is(err, backup.ErrNotEmpty):
+			writeAdminError(w, http.StatusConflict, "conflict", err.Error()+"; pass replace=true to overwrite")
+		case errors.Is(err, backup.ErrInvalidBackup), errors.Is(err, backup.ErrTruncated), errors.Is(err, backup.ErrSchemaMismatch):
+			writeAdminError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		default:
+			writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		}
+		return
+	}
+
+	writeAdminJSON(w, http.StatusOK, AdminRestore{
+		UserID:        summary.UserID,
+		SchemaVersion: summary.SchemaVersion,
+		CreatedAt:     summary.CreatedAt,
+		Rows:          summary.Rows,
+	})
+}
+
+// calendarParam loads the calendar named in the path, writing the error
+// response itself when it can't
+func (h *AdminHandler) calendarParam(w http.ResponseWriter, r *http.Request) (*store.Calendar, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeAdminError(w, http.StatusBadRequest, "invalid_request", "Invalid calendar ID")
+		return nil, false
+	}
+	cal, err := h.calendars.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarNotFound) {
+			writeAdminError(w, http.StatusNotFound, "not_found", "Calendar not found")
+			return nil, false
+		}
+		writeAdminError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return nil, false
+	}
+	return cal, true
+}
+
+func durationParam(r *http.Request, name string, def time.Duration) (time.Duration, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, errors.New(name + " must be a positive duration such as 30m")
+	}
+	return d, nil
+}
+
+func writeAdminJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeAdminError(w http.ResponseWriter, status int, code, message string) {
+	writeAdminJSON(w, status, map[string]string{
+		"code":    code,
+		"message": message,
+	})
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAdminHandler_RequiresToken(t *testing.T) {
+	do := func(h *AdminHandler, method, path, token string) int {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set(AdminTokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		h.Routes().ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	disabled := NewAdminHandler("", nil, nil, nil, nil, nil)
+	if code := do(disabled, http.MethodGet, "/health", "anything"); code != http.StatusNotFound {
+		t.Errorf("expected 404 when no admin token is configured, got %d", code)
+	}
+
+	h := NewAdminHandler("s3cret", nil, nil, nil, nil, nil)
+	if code := do(h, http.MethodGet, "/health", ""); code != http.StatusUnauthorized {
+		t.Errorf("expected 401 without a token, got %d", code)
+	}
+	if code := do(h, http.MethodGet, "/sync-stats", "wrong"); code != http.StatusUnauthorized {
+		t.Errorf("expected 401 with the wrong token, got %d", code)
+	}
+	if code := do(h, http.MethodPost, "/calendars/not-a-uuid/resync", "s3cret"); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid calendar ID, got %d", code)
+	}
+	if code := do(h, http.MethodGet, "/sync-jobs/stuck?running_for=soon", "s3cret"); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid duration, got %d", code)
+	}
+	if code := do(h, http.MethodGet, "/sync-gaps?open_for=-1h", "s3cret"); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for a negative gap age, got %d", code)
+	}
+	if code := do(h, http.MethodPost, "/aggregates/backfill?days=-1", "s3cret"); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid backfill window, got %d", code)
+	}
+	if code := do(h, http.MethodGet, "/backup?user_id=nobody", "s3cret"); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid backup user ID, got %d", code)
+	}
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/anomaly"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// maxAnalyzeDays bounds an on-demand anomaly analysis
+const maxAnalyzeDays = 92
+
+// AnomalyHandler implements the anomaly review endpoints
+type AnomalyHandler struct {
+	anomalies  *store.DayAnomalyStore
+	anomalySvc *anomaly.Service
+}
+
+// NewAnomalyHandler creates a new anomaly handler
+func NewAnomalyHandler(anomalies *store.DayAnomalyStore, anomalySvc *anomaly.Service) *AnomalyHandler {
+	return &AnomalyHandler{
+		anomalies:  anomalies,
+		anomalySvc: anomalySvc,
+	}
+}
+
+// ListAnomalies returns flagged days
+func (h *AnomalyHandler) ListAnomalies(ctx context.Context, req api.ListAnomaliesRequestObject) (api.ListAnomaliesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListAnomalies401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var startDate, endDate *time.Time
+	if req.Params.StartDate != nil {
+		startDate = &req.Params.StartDate.Time
+	}
+	if req.Params.EndDate != nil {
+		endDate = &req.Params.EndDate.Time
+	}
+	includeDismissed := req.Params.IncludeDismissed != nil && *req.Params.IncludeDismissed
+
+	anomalies, err := h.anomalies.List(ctx, userID, startDate, endDate, includeDismissed)
+	if err != nil {
+		return nil, err
+	}
+	return api.ListAnomalies200JSONResponse(anomaliesToAPI(anomalies)), nil
+}
+
+// AnalyzeAnomalies runs the analysis for a date range immediately
+func (h *AnomalyHandler) AnalyzeAnomalies(ctx context.Context, req api.AnalyzeAnomaliesRequestObject) (api.AnalyzeAnomaliesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.AnalyzeAnomalies401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	now := time.Now().UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	if req.Body != nil && req.Body.EndDate != nil {
+		end = req.Body.EndDate.Time
+	}
+	start := end.AddDate(0, 0, -(anomaly.ScheduledWindowDays - 1))
+	if req.Body != nil && req.Body.StartDate != nil {
+		start = req.Body.StartDate.Time
+	}
+
+	if end.Before(start) {
+		return api.AnalyzeAnomalies400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_date must not be before start_date",
+		}, nil
+	}
+	if end.Sub(start) > maxAnalyzeDays*24*time.Hour {
+		return api.AnalyzeAnomalies400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Date range is too long",
+		}, nil
+	}
+
+	anomalies, err := h.anomalySvc.Analyze(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return api.AnalyzeAnomalies200JSONResponse(anomaliesToAPI(anomalies)), nil
+}
+
+// DismissAnomaly marks a flagged day as reviewed
+func (h *AnomalyHandler) DismissAnomaly(ctx context.Context, req api.DismissAnomalyRequestObject) (api.DismissAnomalyResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DismissAnomaly401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	a, err := h.anomalies.Dismiss(ctx, userID, uuid.UUID(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrDayAnomalyNotFound) {
+			return api.DismissAnomaly404JSONResponse{
+				Code:    "not_found",
+				Message: "Anomaly not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DismissAnomaly200JSONResponse(anomalyToAPI(a)), nil
+}
+
+func anomalyToAPI(a *store.DayAnomaly) api.DayAnomaly {
+	return api.DayAnomaly{
+		Id:            a.ID,
+		Date:          openapi_types.Date{Time: a.Date},
+		Kind:          api.DayAnomalyKind(a.Kind),
+		Message:       a.Message,
+		Hours:         a.Hours,
+		BaselineHours: a.BaselineHours,
+		EventCount:    a.EventCount,
+		DetectedAt:    a.DetectedAt,
+		DismissedAt:   a.DismissedAt,
+	}
+}
+
+func anomaliesToAPI(anomalies []*store.DayAnomaly) []api.DayAnomaly {
+	result := make([]api.DayAnomaly, len(anomalies))
+	for i, a := range anomalies {
+		result[i] = anomalyToAPI(a)
+	}
+	return result
+}
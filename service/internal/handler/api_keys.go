@@ -3,15 +3,19 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 )
 
 // APIKeyHandler implements the API key management endpoints
 type APIKeyHandler struct {
-	apiKeys *store.APIKeyStore
+	apiKeys           *store.APIKeyStore
+	toolPolicyChanged func(userID, keyID uuid.UUID)
 }
 
 // NewAPIKeyHandler creates a new API key handler
@@ -21,6 +25,12 @@ func NewAPIKeyHandler(apiKeys *store.APIKeyStore) *APIKeyHandler {
 	}
 }
 
+// OnToolPolicyChange registers a function called when a key's MCP tool
+// policy changes, so open MCP sessions can refresh their tool list
+func (h *APIKeyHandler) OnToolPolicyChange(fn func(userID, keyID uuid.UUID)) {
+	h.toolPolicyChanged = fn
+}
+
 // ListApiKeys returns all API keys for the authenticated user
 func (h *APIKeyHandler) ListApiKeys(ctx context.Context, req api.ListApiKeysRequestObject) (api.ListApiKeysResponseObject, error) {
 	userID, ok := UserIDFromContext(ctx)
@@ -37,17 +47,8 @@ func (h *APIKeyHandler) ListApiKeys(ctx context.Context, req api.ListApiKeysRequ
 	}
 
 	result := make([]api.ApiKey, len(keys))
-	for i, k := range keys {
-		result[i] = api.ApiKey{
-			Id:        k.ID,
-			UserId:    k.UserID,
-			Name:      k.Name,
-			KeyPrefix: k.KeyPrefix,
-			CreatedAt: k.CreatedAt,
-		}
-		if k.LastUsedAt != nil {
-			result[i].LastUsedAt = k.LastUsedAt
-		}
+	for i := range keys {
+		result[i] = apiKeyToAPI(&keys[i])
 	}
 
 	return api.ListApiKeys200JSONResponse(result), nil
@@ -85,7 +86,23 @@ func (h *APIKeyHandler) CreateApiKey(ctx context.Context, req api.CreateApiKeyRe
 		}, nil
 	}
 
-	key, err := h.apiKeys.Create(ctx, userID, name)
+	settings := store.APIKeySettings{
+		RequireConfirmation: boolValue(req.Body.RequireConfirmation),
+	}
+	if req.Body.AllowedTools != nil {
+		settings.AllowedTools = *req.Body.AllowedTools
+	}
+	if req.Body.DeniedTools != nil {
+		settings.DeniedTools = *req.Body.DeniedTools
+	}
+	if err := validateToolNames(settings.AllowedTools, settings.DeniedTools); err != nil {
+		return api.CreateApiKey400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	key, err := h.apiKeys.Create(ctx, userID, name, settings)
 	if err != nil {
 		if errors.Is(err, store.ErrAPIKeyNameTaken) {
 			return api.CreateApiKey409JSONResponse{
@@ -97,12 +114,15 @@ func (h *APIKeyHandler) CreateApiKey(ctx context.Context, req api.CreateApiKeyRe
 	}
 
 	return api.CreateApiKey201JSONResponse{
-		Id:        key.ID,
-		UserId:    key.UserID,
-		Name:      key.Name,
-		KeyPrefix: key.KeyPrefix,
-		Key:       key.Key,
-		CreatedAt: key.CreatedAt,
+		Id:                  key.ID,
+		UserId:              key.UserID,
+		Name:                key.Name,
+		KeyPrefix:           key.KeyPrefix,
+		Key:                 key.Key,
+		RequireConfirmation: key.RequireConfirmation,
+		AllowedTools:        key.AllowedTools,
+		DeniedTools:         key.DeniedTools,
+		CreatedAt:           key.CreatedAt,
 	}, nil
 }
 
@@ -129,3 +149,90 @@ func (h *APIKeyHandler) DeleteApiKey(ctx context.Context, req api.DeleteApiKeyRe
 
 	return api.DeleteApiKey204Response{}, nil
 }
+
+// UpdateApiKey changes an API key's settings
+func (h *APIKeyHandler) UpdateApiKey(ctx context.Context, req api.UpdateApiKeyRequestObject) (api.UpdateApiKeyResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateApiKey401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateApiKey400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	updates := make(map[string]interface{})
+	if req.Body.RequireConfirmation != nil {
+		updates["require_confirmation"] = *req.Body.RequireConfirmation
+	}
+	var allowed, denied []string
+	if req.Body.AllowedTools != nil {
+		allowed = *req.Body.AllowedTools
+		updates["allowed_tools"] = allowed
+	}
+	if req.Body.DeniedTools != nil {
+		denied = *req.Body.DeniedTools
+		updates["denied_tools"] = denied
+	}
+	if err := validateToolNames(allowed, denied); err != nil {
+		return api.UpdateApiKey400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	key, err := h.apiKeys.Update(ctx, userID, req.Id, updates)
+	if err != nil {
+		if errors.Is(err, store.ErrAPIKeyNotFound) {
+			return api.UpdateApiKey404JSONResponse{
+				Code:    "not_found",
+				Message: "API key not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	if (req.Body.AllowedTools != nil || req.Body.DeniedTools != nil) && h.toolPolicyChanged != nil {
+		h.toolPolicyChanged(userID, key.ID)
+	}
+
+	return api.UpdateApiKey200JSONResponse(apiKeyToAPI(key)), nil
+}
+
+// apiKeyToAPI converts a store.APIKey to api.ApiKey
+func apiKeyToAPI(k *store.APIKey) api.ApiKey {
+	return api.ApiKey{
+		Id:                  k.ID,
+		UserId:              k.UserID,
+		Name:                k.Name,
+		KeyPrefix:           k.KeyPrefix,
+		RequireConfirmation: k.RequireConfirmation,
+		AllowedTools:        k.AllowedTools,
+		DeniedTools:         k.DeniedTools,
+		LastUsedAt:          k.LastUsedAt,
+		CreatedAt:           k.CreatedAt,
+	}
+}
+
+// validateToolNames rejects tool policies naming tools the MCP server does
+// not have, so a typo cannot silently leave a tool allowed
+func validateToolNames(lists ...[]string) error {
+	known := make(map[string]bool)
+	for _, t := range mcp.GetTools() {
+		known[t.Name] = true
+	}
+	for _, list := range lists {
+		for _, name := range list {
+			if !known[name] {
+				return fmt.Errorf("unknown MCP tool %q", name)
+			}
+		}
+	}
+	return nil
+}
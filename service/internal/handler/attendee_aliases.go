@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// ListAttendeeAliases returns the user's attendee alias mappings
+func (h *RulesHandler) ListAttendeeAliases(ctx context.Context, req api.ListAttendeeAliasesRequestObject) (api.ListAttendeeAliasesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListAttendeeAliases401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	aliases, err := h.classificationSvc.AttendeeAliases(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(api.ListAttendeeAliases200JSONResponse, len(aliases))
+	for i, a := range aliases {
+		result[i] = attendeeAliasToAPI(a)
+	}
+	return result, nil
+}
+
+// CreateAttendeeAlias maps another email to a person
+func (h *RulesHandler) CreateAttendeeAlias(ctx context.Context, req api.CreateAttendeeAliasRequestObject) (api.CreateAttendeeAliasResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateAttendeeAlias401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	canonical, err := normalizeFingerprint(api.Email, req.Body.CanonicalEmail)
+	if err != nil {
+		return api.CreateAttendeeAlias400JSONResponse{
+			Code:    "invalid_request",
+			Message: "canonical_email: " + err.Error(),
+		}, nil
+	}
+	alias, err := normalizeFingerprint(api.Email, req.Body.AliasEmail)
+	if err != nil {
+		return api.CreateAttendeeAlias400JSONResponse{
+			Code:    "invalid_request",
+			Message: "alias_email: " + err.Error(),
+		}, nil
+	}
+	if alias == canonical {
+		return api.CreateAttendeeAlias400JSONResponse{
+			Code:    "invalid_request",
+			Message: "alias_email must differ from canonical_email",
+		}, nil
+	}
+
+	created, err := h.classificationSvc.AddAttendeeAlias(ctx, userID, canonical, alias)
+	if err != nil {
+		if errors.Is(err, store.ErrAttendeeAliasExists) {
+			return api.CreateAttendeeAlias409JSONResponse{
+				Code:    "alias_exists",
+				Message: "This email is already an alias",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.CreateAttendeeAlias201JSONResponse(attendeeAliasToAPI(created)), nil
+}
+
+// DeleteAttendeeAlias removes an alias mapping
+func (h *RulesHandler) DeleteAttendeeAlias(ctx context.Context, req api.DeleteAttendeeAliasRequestObject) (api.DeleteAttendeeAliasResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteAttendeeAlias401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.classificationSvc.DeleteAttendeeAlias(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrAttendeeAliasNotFound) {
+			return api.DeleteAttendeeAlias404JSONResponse{
+				Code:    "not_found",
+				Message: "Attendee alias not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DeleteAttendeeAlias204Response{}, nil
+}
+
+func attendeeAliasToAPI(a *store.AttendeeAlias) api.AttendeeAlias {
+	return api.AttendeeAlias{
+		Id:             a.ID,
+		CanonicalEmail: a.CanonicalEmail,
+		AliasEmail:     a.AliasEmail,
+		CreatedAt:      a.CreatedAt,
+	}
+}
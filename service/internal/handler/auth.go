@@ -14,6 +14,9 @@ type contextKey string
 
 const userIDKey contextKey = "userID"
 
+// apiKeyKey holds the *store.APIKey a request authenticated with, if any
+const apiKeyKey contextKey = "apiKey"
+
 // AuthHandler implements the auth endpoints
 type AuthHandler struct {
 	users *store.UserStore
@@ -107,6 +110,12 @@ func (h *AuthHandler) Login(ctx context.Context, req api.LoginRequestObject) (ap
 				Message: "Email or password is incorrect",
 			}, nil
 		}
+		if errors.Is(err, store.ErrUserDeactivated) {
+			return api.Login401JSONResponse{
+				Code:    "account_deactivated",
+				Message: "Your organization has deactivated this account",
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/michaelw/timesheet-app/service/internal/api"
@@ -10,14 +11,15 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// BillingHandler implements the billing period endpoints
+// BillingHandler implements the billing period and client rate endpoints
 type BillingHandler struct {
-	periods *store.BillingPeriodStore
+	periods     *store.BillingPeriodStore
+	clientRates *store.ClientRateStore
 }
 
 // NewBillingHandler creates a new billing handler
-func NewBillingHandler(periods *store.BillingPeriodStore) *BillingHandler {
-	return &BillingHandler{periods: periods}
+func NewBillingHandler(periods *store.BillingPeriodStore, clientRates *store.ClientRateStore) *BillingHandler {
+	return &BillingHandler{periods: periods, clientRates: clientRates}
 }
 
 // ListBillingPeriods returns all billing periods for a project
@@ -68,14 +70,44 @@ func (h *BillingHandler) CreateBillingPeriod(ctx context.Context, req api.Create
 		endsOn = &t
 	}
 
-	period, err := h.periods.Create(ctx, userID, req.Body.ProjectId, startsOn, endsOn, float64(req.Body.HourlyRate))
+	terms := store.BillingTerms{
+		BillingMode:    store.BillingModeHourly,
+		FixedFee:       req.Body.FixedFee,
+		IncludedHours:  req.Body.IncludedHours,
+		OverageRate:    req.Body.OverageRate,
+		MinDailyHours:  positiveOrNil(req.Body.MinDailyHours),
+		IncrementHours: positiveOrNil(req.Body.IncrementHours),
+	}
+	if req.Body.BillingMode != nil {
+		terms.BillingMode = *req.Body.BillingMode
+	}
+	if msg := validateBillingTerms(terms); msg != "" {
+		return api.CreateBillingPeriod400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+
+	period, err := h.periods.Create(ctx, userID, req.Body.ProjectId, startsOn, endsOn, float64(req.Body.HourlyRate), terms)
 	if err != nil {
+		if errors.Is(err, store.ErrInvalidBillingTerms) {
+			return api.CreateBillingPeriod400JSONResponse{
+				Code:    "invalid_billing_terms",
+				Message: billingTermsMessage,
+			}, nil
+		}
 		if errors.Is(err, store.ErrBillingPeriodOverlap) {
 			return api.CreateBillingPeriod409JSONResponse{
 				Code:    "overlap",
 				Message: "Billing period overlaps with existing period",
 			}, nil
 		}
+		if errors.Is(err, store.ErrInvalidRateDates) {
+			return api.CreateBillingPeriod400JSONResponse{
+				Code:    "invalid_dates",
+				Message: "End date must be on or after start date",
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -118,6 +150,41 @@ func (h *BillingHandler) UpdateBillingPeriod(ctx context.Context, req api.Update
 		updates["hourly_rate"] = float64(*req.Body.HourlyRate)
 	}
 
+	terms := store.BillingTerms{
+		FixedFee:       req.Body.FixedFee,
+		IncludedHours:  req.Body.IncludedHours,
+		OverageRate:    req.Body.OverageRate,
+		MinDailyHours:  req.Body.MinDailyHours,
+		IncrementHours: req.Body.IncrementHours,
+	}
+	if req.Body.BillingMode != nil {
+		terms.BillingMode = *req.Body.BillingMode
+	}
+	if msg := validateBillingTerms(terms); msg != "" {
+		return api.UpdateBillingPeriod400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+	if req.Body.BillingMode != nil {
+		updates["billing_mode"] = *req.Body.BillingMode
+	}
+	if req.Body.FixedFee != nil {
+		updates["fixed_fee"] = *req.Body.FixedFee
+	}
+	if req.Body.IncludedHours != nil {
+		updates["included_hours"] = *req.Body.IncludedHours
+	}
+	if req.Body.OverageRate != nil {
+		updates["overage_rate"] = *req.Body.OverageRate
+	}
+	if req.Body.MinDailyHours != nil {
+		updates["min_daily_hours"] = positiveOrNil(req.Body.MinDailyHours)
+	}
+	if req.Body.IncrementHours != nil {
+		updates["increment_hours"] = positiveOrNil(req.Body.IncrementHours)
+	}
+
 	if len(updates) == 0 {
 		return api.UpdateBillingPeriod400JSONResponse{
 			Code:    "invalid_request",
@@ -133,12 +200,24 @@ func (h *BillingHandler) UpdateBillingPeriod(ctx context.Context, req api.Update
 				Message: "Billing period not found",
 			}, nil
 		}
+		if errors.Is(err, store.ErrInvalidBillingTerms) {
+			return api.UpdateBillingPeriod400JSONResponse{
+				Code:    "invalid_billing_terms",
+				Message: billingTermsMessage,
+			}, nil
+		}
 		if errors.Is(err, store.ErrBillingPeriodOverlap) {
 			return api.UpdateBillingPeriod409JSONResponse{
 				Code:    "overlap",
 				Message: "Updated period would overlap with existing period",
 			}, nil
 		}
+		if errors.Is(err, store.ErrInvalidRateDates) {
+			return api.UpdateBillingPeriod400JSONResponse{
+				Code:    "invalid_dates",
+				Message: "End date must be on or after start date",
+			}, nil
+		}
 		return nil, err
 	}
 
@@ -169,15 +248,235 @@ func (h *BillingHandler) DeleteBillingPeriod(ctx context.Context, req api.Delete
 	return api.DeleteBillingPeriod204Response{}, nil
 }
 
+// ListClientRates returns client rates, optionally filtered by client
+func (h *BillingHandler) ListClientRates(ctx context.Context, req api.ListClientRatesRequestObject) (api.ListClientRatesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListClientRates401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	rates, err := h.clientRates.List(ctx, userID, req.Params.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.ClientRate, len(rates))
+	for i, r := range rates {
+		result[i] = clientRateToAPI(r)
+	}
+
+	return api.ListClientRates200JSONResponse(result), nil
+}
+
+// CreateClientRate creates a new client rate
+func (h *BillingHandler) CreateClientRate(ctx context.Context, req api.CreateClientRateRequestObject) (api.CreateClientRateResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateClientRate401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.CreateClientRate400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body required",
+		}, nil
+	}
+
+	client := strings.TrimSpace(req.Body.Client)
+	if client == "" {
+		return api.CreateClientRate400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Client is required",
+		}, nil
+	}
+	if req.Body.HourlyRate < 0 {
+		return api.CreateClientRate400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Hourly rate cannot be negative",
+		}, nil
+	}
+
+	var endsOn *time.Time
+	if req.Body.EndsOn != nil {
+		t := req.Body.EndsOn.Time
+		endsOn = &t
+	}
+
+	rate, err := h.clientRates.Create(ctx, userID, client, req.Body.StartsOn.Time, endsOn, float64(req.Body.HourlyRate))
+	if err != nil {
+		if errors.Is(err, store.ErrClientRateOverlap) {
+			return api.CreateClientRate409JSONResponse{
+				Code:    "overlap",
+				Message: "Client rate overlaps with existing rate",
+			}, nil
+		}
+		if errors.Is(err, store.ErrInvalidRateDates) {
+			return api.CreateClientRate400JSONResponse{
+				Code:    "invalid_dates",
+				Message: "End date must be on or after start date",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.CreateClientRate201JSONResponse(clientRateToAPI(rate)), nil
+}
+
+// UpdateClientRate updates an existing client rate
+func (h *BillingHandler) UpdateClientRate(ctx context.Context, req api.UpdateClientRateRequestObject) (api.UpdateClientRateResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateClientRate401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateClientRate400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body required",
+		}, nil
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Body.StartsOn != nil {
+		updates["starts_on"] = req.Body.StartsOn.Time
+	}
+
+	if req.Body.EndsOn != nil {
+		// Empty Date means clear the end date (make it ongoing)
+		if req.Body.EndsOn.IsZero() {
+			updates["ends_on"] = nil
+		} else {
+			updates["ends_on"] = req.Body.EndsOn.Time
+		}
+	}
+
+	if req.Body.HourlyRate != nil {
+		if *req.Body.HourlyRate < 0 {
+			return api.UpdateClientRate400JSONResponse{
+				Code:    "invalid_request",
+				Message: "Hourly rate cannot be negative",
+			}, nil
+		}
+		updates["hourly_rate"] = float64(*req.Body.HourlyRate)
+	}
+
+	if len(updates) == 0 {
+		return api.UpdateClientRate400JSONResponse{
+			Code:    "invalid_request",
+			Message: "No updates provided",
+		}, nil
+	}
+
+	rate, err := h.clientRates.Update(ctx, userID, req.Id, updates)
+	if err != nil {
+		if errors.Is(err, store.ErrClientRateNotFound) {
+			return api.UpdateClientRate404JSONResponse{
+				Code:    "not_found",
+				Message: "Client rate not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrClientRateOverlap) {
+			return api.UpdateClientRate409JSONResponse{
+				Code:    "overlap",
+				Message: "Updated rate would overlap with existing rate",
+			}, nil
+		}
+		if errors.Is(err, store.ErrInvalidRateDates) {
+			return api.UpdateClientRate400JSONResponse{
+				Code:    "invalid_dates",
+				Message: "End date must be on or after start date",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.UpdateClientRate200JSONResponse(clientRateToAPI(rate)), nil
+}
+
+// DeleteClientRate deletes a client rate
+func (h *BillingHandler) DeleteClientRate(ctx context.Context, req api.DeleteClientRateRequestObject) (api.DeleteClientRateResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteClientRate401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	err := h.clientRates.Delete(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrClientRateNotFound) {
+			return api.DeleteClientRate404JSONResponse{
+				Code:    "not_found",
+				Message: "Client rate not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteClientRate204Response{}, nil
+}
+
+// billingTermsMessage explains a billing period whose mode lacks the amounts
+// it needs
+const billingTermsMessage = "Fixed periods need fixed_fee; retainers need fixed_fee, included_hours and overage_rate"
+
+// validateBillingTerms returns a message describing what is wrong with the
+// terms, or "" when they are valid. An empty mode leaves the mode unchanged.
+// Whether the mode has its amounts is checked by the store, since an update
+// may only supply some of them.
+func validateBillingTerms(t store.BillingTerms) string {
+	switch t.BillingMode {
+	case "", store.BillingModeHourly, store.BillingModeFixed, store.BillingModeRetainer:
+	default:
+		return "billing_mode must be hourly, fixed or retainer"
+	}
+	for _, v := range []*float64{t.FixedFee, t.IncludedHours, t.OverageRate} {
+		if v != nil && *v < 0 {
+			return "fixed_fee, included_hours and overage_rate cannot be negative"
+		}
+	}
+	for _, v := range []*float64{t.MinDailyHours, t.IncrementHours} {
+		if v != nil && (*v < 0 || *v > 24) {
+			return "min_daily_hours and increment_hours must be between 0 and 24"
+		}
+	}
+	return ""
+}
+
+// positiveOrNil treats a zero rounding setting as not set, so that 0 clears it
+func positiveOrNil(v *float64) *float64 {
+	if v == nil || *v <= 0 {
+		return nil
+	}
+	return v
+}
+
 // billingPeriodToAPI converts a store BillingPeriod to an API BillingPeriod
 func billingPeriodToAPI(p *store.BillingPeriod) api.BillingPeriod {
 	period := api.BillingPeriod{
-		Id:         p.ID,
-		UserId:     p.UserID,
-		ProjectId:  p.ProjectID,
-		StartsOn:   openapi_types.Date{Time: p.StartsOn},
-		HourlyRate: float32(p.HourlyRate),
-		CreatedAt:  p.CreatedAt,
+		Id:             p.ID,
+		UserId:         p.UserID,
+		ProjectId:      p.ProjectID,
+		StartsOn:       openapi_types.Date{Time: p.StartsOn},
+		HourlyRate:     float32(p.HourlyRate),
+		BillingMode:    p.BillingMode,
+		FixedFee:       p.FixedFee,
+		IncludedHours:  p.IncludedHours,
+		OverageRate:    p.OverageRate,
+		MinDailyHours:  p.MinDailyHours,
+		IncrementHours: p.IncrementHours,
+		CreatedAt:      p.CreatedAt,
 	}
 	if p.EndsOn != nil {
 		period.EndsOn = &openapi_types.Date{Time: *p.EndsOn}
@@ -186,3 +485,21 @@ func billingPeriodToAPI(p *store.BillingPeriod) api.BillingPeriod {
 	period.UpdatedAt = &updatedAt
 	return period
 }
+
+// clientRateToAPI converts a store ClientRate to an API ClientRate
+func clientRateToAPI(r *store.ClientRate) api.ClientRate {
+	rate := api.ClientRate{
+		Id:         r.ID,
+		UserId:     r.UserID,
+		Client:     r.Client,
+		StartsOn:   openapi_types.Date{Time: r.StartsOn},
+		HourlyRate: float32(r.HourlyRate),
+		CreatedAt:  r.CreatedAt,
+	}
+	if r.EndsOn != nil {
+		rate.EndsOn = &openapi_types.Date{Time: *r.EndsOn}
+	}
+	updatedAt := r.UpdatedAt
+	rate.UpdatedAt = &updatedAt
+	return rate
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// The client rate checks below run before the store is touched, so the
+// handler is built without one
+func TestBillingHandler_CreateClientRateValidation(t *testing.T) {
+	h := NewBillingHandler(nil, nil)
+	ctx := authedContext(uuid.New())
+	startsOn := openapi_types.Date{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name string
+		body *api.ClientRateCreate
+	}{
+		{"no body", nil},
+		{"no client", &api.ClientRateCreate{Client: "", StartsOn: startsOn, HourlyRate: 100}},
+		{"blank client", &api.ClientRateCreate{Client: "   ", StartsOn: startsOn, HourlyRate: 100}},
+		{"negative rate", &api.ClientRateCreate{Client: "Acme", StartsOn: startsOn, HourlyRate: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.CreateClientRate(ctx, api.CreateClientRateRequestObject{Body: tt.body})
+			if err != nil {
+				t.Fatalf("CreateClientRate: %v", err)
+			}
+			if _, ok := resp.(api.CreateClientRate400JSONResponse); !ok {
+				t.Errorf("expected 400, got %T", resp)
+			}
+		})
+	}
+}
+
+func TestBillingHandler_UpdateClientRateValidation(t *testing.T) {
+	h := NewBillingHandler(nil, nil)
+	ctx := authedContext(uuid.New())
+	negative := float32(-5)
+
+	tests := []struct {
+		name string
+		body *api.ClientRateUpdate
+	}{
+		{"no body", nil},
+		{"no updates", &api.ClientRateUpdate{}},
+		{"negative rate", &api.ClientRateUpdate{HourlyRate: &negative}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.UpdateClientRate(ctx, api.UpdateClientRateRequestObject{Id: uuid.New(), Body: tt.body})
+			if err != nil {
+				t.Fatalf("UpdateClientRate: %v", err)
+			}
+			if _, ok := resp.(api.UpdateClientRate400JSONResponse); !ok {
+				t.Errorf("expected 400, got %T", resp)
+			}
+		})
+	}
+}
+
+func TestBillingHandler_ClientRatesRequireAuth(t *testing.T) {
+	h := NewBillingHandler(nil, nil)
+
+	resp, err := h.ListClientRates(context.Background(), api.ListClientRatesRequestObject{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := resp.(api.ListClientRates401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// DiffCalendarEvents reports the events in a date range added, moved,
+// cancelled or changed between two points in time
+func (h *CalendarHandler) DiffCalendarEvents(ctx context.Context, req api.DiffCalendarEventsRequestObject) (api.DiffCalendarEventsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DiffCalendarEvents401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	startDate, endDate := req.Params.StartDate.Time, req.Params.EndDate.Time
+	if endDate.Before(startDate) {
+		return api.DiffCalendarEvents400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_date must not be before start_date",
+		}, nil
+	}
+	since, until := req.Params.Since, time.Now().UTC()
+	if req.Params.Until != nil {
+		until = *req.Params.Until
+	}
+	if !since.Before(until) {
+		return api.DiffCalendarEvents400JSONResponse{
+			Code:    "invalid_request",
+			Message: "since must be before until",
+		}, nil
+	}
+
+	diffs, err := h.events.DiffRange(ctx, userID, startDate, endDate.AddDate(0, 0, 1), since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := api.DiffCalendarEvents200JSONResponse{
+		StartDate: openapi_types.Date{Time: startDate},
+		EndDate:   openapi_types.Date{Time: endDate},
+		Since:     since,
+		Until:     until,
+		Events:    make([]api.CalendarEventDiffItem, len(diffs)),
+	}
+	for i, d := range diffs {
+		switch d.Kind {
+		case store.EventAdded:
+			resp.Added++
+		case store.EventMoved:
+			resp.Moved++
+		case store.EventCancelled:
+			resp.Cancelled++
+		case store.EventChanged:
+			resp.Changed++
+		}
+		resp.Events[i] = api.CalendarEventDiffItem{
+			EventId:       d.EventID,
+			Kind:          api.CalendarEventDiffItemKind(d.Kind),
+			Before:        eventSnapshotToAPI(d.Before),
+			After:         eventSnapshotToAPI(d.After),
+			ChangedFields: d.Fields,
+		}
+		if d.Fields == nil {
+			resp.Events[i].ChangedFields = []string{}
+		}
+	}
+	resp.Summary = diffSummary(resp.Added, resp.Moved, resp.Cancelled, resp.Changed, since)
+
+	return resp, nil
+}
+
+func eventSnapshotToAPI(s *store.EventSnapshot) *api.CalendarEventSnapshot {
+	if s == nil {
+		return nil
+	}
+	return &api.CalendarEventSnapshot{
+		Title:          s.Title,
+		Description:    s.Description,
+		StartTime:      s.StartTime,
+		EndTime:        s.EndTime,
+		IsAllDay:       s.IsAllDay,
+		ResponseStatus: s.ResponseStatus,
+		Transparency:   s.Transparency,
+	}
+}
+
+// diffSummary describes the counts in a sentence, e.g. "3 events added, 1
+// moved, 2 cancelled since Mon Mar 10 09:00 UTC"
+func diffSummary(added, moved, cancelled, changed int, since time.Time) string {
+	var parts []string
+	for _, c := range []struct {
+		n    int
+		verb string
+	}{{added, "added"}, {moved, "moved"}, {cancelled, "cancelled"}, {changed, "changed"}} {
+		if c.n == 0 {
+			continue
+		}
+		if len(parts) == 0 {
+			noun := "events"
+			if c.n == 1 {
+				noun = "event"
+			}
+			parts = append(parts, fmt.Sprintf("%d %s %s", c.n, noun, c.verb))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", c.n, c.verb))
+	}
+	when := since.UTC().Format("Mon Jan 2 15:04 UTC")
+	if len(parts) == 0 {
+		return "No changes since " + when
+	}
+	return strings.Join(parts, ", ") + " since " + when
+}
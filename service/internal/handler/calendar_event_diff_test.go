@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+)
+
+func TestDiffCalendarEventsValidation(t *testing.T) {
+	h := &CalendarHandler{}
+	ctx := authedContext(uuid.New())
+	day := func(d int) openapi_types.Date {
+		return openapi_types.Date{Time: time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC)}
+	}
+	since := time.Date(2025, 3, 12, 9, 0, 0, 0, time.UTC)
+	earlier := since.Add(-time.Hour)
+
+	for name, params := range map[string]api.DiffCalendarEventsParams{
+		"reversed range":     {StartDate: day(16), EndDate: day(10), Since: since},
+		"until before since": {StartDate: day(10), EndDate: day(16), Since: since, Until: &earlier},
+	} {
+		resp, err := h.DiffCalendarEvents(ctx, api.DiffCalendarEventsRequestObject{Params: params})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, ok := resp.(api.DiffCalendarEvents400JSONResponse); !ok {
+			t.Errorf("%s: expected 400, got %T", name, resp)
+		}
+	}
+}
+
+func TestDiffSummary(t *testing.T) {
+	since := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		added, moved, cancelled, changed int
+		want                             string
+	}{
+		{3, 1, 2, 0, "3 events added, 1 moved, 2 cancelled since Mon Mar 10 09:00 UTC"},
+		{0, 1, 0, 4, "1 event moved, 4 changed since Mon Mar 10 09:00 UTC"},
+		{0, 0, 0, 0, "No changes since Mon Mar 10 09:00 UTC"},
+	} {
+		if got := diffSummary(tc.added, tc.moved, tc.cancelled, tc.changed, since); got != tc.want {
+			t.Errorf("diffSummary(%d, %d, %d, %d) = %q, want %q", tc.added, tc.moved, tc.cancelled, tc.changed, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+)
+
+func TestListEventClassificationHistory_RequiresAuth(t *testing.T) {
+	h := &CalendarHandler{}
+
+	resp, err := h.ListEventClassificationHistory(context.Background(), api.ListEventClassificationHistoryRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := resp.(api.ListEventClassificationHistory401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// PauseCalendarConnection stops all syncing of a connection, keeping its
+// calendars and events
+func (h *CalendarHandler) PauseCalendarConnection(ctx context.Context, req api.PauseCalendarConnectionRequestObject) (api.PauseCalendarConnectionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.PauseCalendarConnection401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	conn, err := h.connections.SetPaused(ctx, userID, req.Id, true)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarConnectionNotFound) {
+			return api.PauseCalendarConnection404JSONResponse{
+				Code:    "not_found",
+				Message: "Calendar connection not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.PauseCalendarConnection200JSONResponse(calendarConnectionToAPI(conn)), nil
+}
+
+// ResumeCalendarConnection lets a paused connection sync again
+func (h *CalendarHandler) ResumeCalendarConnection(ctx context.Context, req api.ResumeCalendarConnectionRequestObject) (api.ResumeCalendarConnectionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ResumeCalendarConnection401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	conn, err := h.connections.SetPaused(ctx, userID, req.Id, false)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarConnectionNotFound) {
+			return api.ResumeCalendarConnection404JSONResponse{
+				Code:    "not_found",
+				Message: "Calendar connection not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.ResumeCalendarConnection200JSONResponse(calendarConnectionToAPI(conn)), nil
+}
@@ -0,0 +1,61 @@
+//go:build integration
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+)
+
+func TestCalendarConnectionPause(t *testing.T) {
+	s := newSyncHarness(t)
+	s.sync(nil)
+	s.makeStale()
+
+	pauseResp, err := s.handler.PauseCalendarConnection(s.ctx, api.PauseCalendarConnectionRequestObject{Id: s.connID})
+	if err != nil {
+		t.Fatalf("PauseCalendarConnection: %v", err)
+	}
+	paused, ok := pauseResp.(api.PauseCalendarConnection200JSONResponse)
+	if !ok || paused.PausedAt == nil {
+		t.Fatalf("expected a paused connection, got %#v", pauseResp)
+	}
+
+	syncResp, err := s.handler.SyncCalendar(s.ctx, api.SyncCalendarRequestObject{Id: s.connID})
+	if err != nil {
+		t.Fatalf("SyncCalendar: %v", err)
+	}
+	if _, ok := syncResp.(api.SyncCalendar409JSONResponse); !ok {
+		t.Errorf("expected a manual sync of a paused connection to be refused, got %#v", syncResp)
+	}
+
+	fetches := len(s.fake.FetchCalls) + len(s.fake.IncrementalCalls)
+	week := time.Now().AddDate(0, 0, -200)
+	if err := s.handler.ensureEventsInRange(s.ctx, s.calendar().UserID, week, week.AddDate(0, 0, 6)); err != nil {
+		t.Fatalf("ensureEventsInRange: %v", err)
+	}
+	if got := len(s.fake.FetchCalls) + len(s.fake.IncrementalCalls); got != fetches {
+		t.Errorf("expected no on-demand fetch while paused, got %d more", got-fetches)
+	}
+
+	due, err := s.calendars.ListNeedingSync(s.ctx, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cal := range due {
+		if cal.ConnectionID == s.connID {
+			t.Errorf("expected background sync to skip the paused connection")
+		}
+	}
+
+	resumeResp, err := s.handler.ResumeCalendarConnection(s.ctx, api.ResumeCalendarConnectionRequestObject{Id: s.connID})
+	if err != nil {
+		t.Fatalf("ResumeCalendarConnection: %v", err)
+	}
+	if resumed, ok := resumeResp.(api.ResumeCalendarConnection200JSONResponse); !ok || resumed.PausedAt != nil {
+		t.Fatalf("expected a resumed connection, got %#v", resumeResp)
+	}
+	s.sync(nil)
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// ListConnectionsNeedingReauth returns the connections whose selected
+// calendars stopped syncing because their grant was revoked or expired
+func (h *CalendarHandler) ListConnectionsNeedingReauth(ctx context.Context, req api.ListConnectionsNeedingReauthRequestObject) (api.ListConnectionsNeedingReauthResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListConnectionsNeedingReauth401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	calendars, err := h.calendars.ListNeedingReauth(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	connections, err := h.connections.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	providers := make(map[uuid.UUID]string, len(connections))
+	for _, c := range connections {
+		providers[c.ID] = c.Provider
+	}
+
+	result := []api.ConnectionReauth{}
+	index := make(map[uuid.UUID]int)
+	for _, cal := range calendars {
+		i, ok := index[cal.ConnectionID]
+		if !ok {
+			i = len(result)
+			index[cal.ConnectionID] = i
+			result = append(result, api.ConnectionReauth{
+				ConnectionId: cal.ConnectionID,
+				Provider:     providers[cal.ConnectionID],
+				Calendars:    []api.ReauthCalendar{},
+			})
+		}
+		name := cal.Name
+		if cal.DisplayName != nil {
+			name = *cal.DisplayName
+		}
+		result[i].Calendars = append(result[i].Calendars, api.ReauthCalendar{
+			Id:        cal.ID,
+			Name:      name,
+			LastError: cal.SyncLastError,
+		})
+	}
+
+	return api.ListConnectionsNeedingReauth200JSONResponse(result), nil
+}
+
+// ReauthorizeCalendarConnection starts a consent that replaces the grant of
+// an existing connection, keeping its calendars and events
+func (h *CalendarHandler) ReauthorizeCalendarConnection(ctx context.Context, req api.ReauthorizeCalendarConnectionRequestObject) (api.ReauthorizeCalendarConnectionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ReauthorizeCalendarConnection401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if h.google == nil {
+		return api.ReauthorizeCalendarConnection401JSONResponse{
+			Code:    "not_configured",
+			Message: "Google Calendar integration is not configured",
+		}, nil
+	}
+
+	conn, err := h.connections.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarConnectionNotFound) {
+			return api.ReauthorizeCalendarConnection404JSONResponse{
+				Code:    "not_found",
+				Message: "Connection not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if conn.Provider != "google" {
+		return api.ReauthorizeCalendarConnection400JSONResponse{
+			Code:    "invalid_request",
+			Message: "The connection has no OAuth grant to renew",
+		}, nil
+	}
+
+	// Ask again for everything the connection had, so no feature loses access
+	scopes := conn.GrantedScopes
+	if len(scopes) == 0 {
+		scopes = google.AccessCalendar.Scopes()
+	}
+	state := h.putOAuthState(oauthState{userID: userID, connectionID: &conn.ID})
+
+	return api.ReauthorizeCalendarConnection200JSONResponse{
+		Url:   h.google.GetAuthURL(state, scopes...),
+		State: state,
+	}, nil
+}
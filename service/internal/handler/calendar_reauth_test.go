@@ -0,0 +1,61 @@
+//go:build integration
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+)
+
+func TestCalendarReauth(t *testing.T) {
+	s := newSyncHarness(t)
+	s.sync(nil)
+	cal := s.calendar()
+	if err := s.calendars.MarkNeedsReauth(s.ctx, cal.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	listResp, err := s.handler.ListConnectionsNeedingReauth(s.ctx, api.ListConnectionsNeedingReauthRequestObject{})
+	if err != nil {
+		t.Fatalf("ListConnectionsNeedingReauth: %v", err)
+	}
+	list := listResp.(api.ListConnectionsNeedingReauth200JSONResponse)
+	if len(list) != 1 || list[0].ConnectionId != s.connID || len(list[0].Calendars) != 1 || list[0].Calendars[0].Id != cal.ID {
+		t.Fatalf("expected the connection's calendar to need reauth, got %+v", list)
+	}
+
+	authResp, err := s.handler.ReauthorizeCalendarConnection(s.ctx, api.ReauthorizeCalendarConnectionRequestObject{Id: s.connID})
+	if err != nil {
+		t.Fatalf("ReauthorizeCalendarConnection: %v", err)
+	}
+	auth, ok := authResp.(api.ReauthorizeCalendarConnection200JSONResponse)
+	if !ok {
+		t.Fatalf("ReauthorizeCalendarConnection: unexpected response %#v", authResp)
+	}
+
+	cbResp, err := s.handler.GoogleCallback(s.ctx, api.GoogleCallbackRequestObject{
+		Params: api.GoogleCallbackParams{Code: "reconsent", State: auth.State},
+	})
+	if err != nil {
+		t.Fatalf("GoogleCallback: %v", err)
+	}
+	conn, ok := cbResp.(api.GoogleCallback201JSONResponse)
+	if !ok {
+		t.Fatalf("GoogleCallback: unexpected response %#v", cbResp)
+	}
+	if conn.Id != s.connID {
+		t.Errorf("expected the existing connection to be kept, got %s", conn.Id)
+	}
+	if after := s.calendar(); after.ID != cal.ID || after.NeedsReauth {
+		t.Errorf("expected the same calendar with reauth cleared, got %+v", after)
+	}
+
+	listResp, err = s.handler.ListConnectionsNeedingReauth(s.ctx, api.ListConnectionsNeedingReauthRequestObject{})
+	if err != nil {
+		t.Fatalf("ListConnectionsNeedingReauth: %v", err)
+	}
+	if list := listResp.(api.ListConnectionsNeedingReauth200JSONResponse); len(list) != 0 {
+		t.Errorf("expected nothing to need reauth, got %+v", list)
+	}
+}
@@ -0,0 +1,305 @@
+//go:build integration
+
+package handler
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	gcal "google.golang.org/api/calendar/v3"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/cache"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/crypto"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/sync"
+	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+)
+
+// syncHarness runs CalendarHandler syncs against a real database and the
+// fake Google client, for one user with one Google connection
+type syncHarness struct {
+	t          *testing.T
+	ctx        context.Context // Carries the user ID
+	pool       *pgxpool.Pool
+	fake       *google.FakeCalendarClient
+	handler    *CalendarHandler
+	calendars  *store.CalendarStore
+	classifier *classification.Service
+	connID     uuid.UUID
+}
+
+func newSyncHarness(t *testing.T) *syncHarness {
+	t.Helper()
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	t.Cleanup(db.Close)
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	enc, err := crypto.NewEncryptionService("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := store.NewUserStore(db.Pool)
+	connections := store.NewCalendarConnectionStore(db.Pool, enc)
+	calendars := store.NewCalendarStore(db.Pool)
+	events := store.NewCalendarEventStore(db.Pool)
+	entries := store.NewTimeEntryStore(db.Pool)
+	readModel := cache.NewReadModel(store.NewProjectStore(db.Pool), store.NewClassificationRuleStore(db.Pool), cache.DefaultTTL)
+	classifier := classification.NewService(db.Pool, readModel, events, entries,
+		store.NewClassificationActionStore(db.Pool), store.NewSuppressionRuleStore(db.Pool))
+
+	user, err := users.Create(ctx, "sync-e2e-"+uuid.New().String()[:8]+"@test.com", "Sync Test", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Pool.Exec(context.Background(), "DELETE FROM users WHERE id = $1", user.ID)
+	})
+
+	fake := google.NewFakeCalendarClient()
+	fake.AddCalendar(google.CalendarInfo{ID: "primary", Name: "Work", IsPrimary: true})
+	creds, _ := fake.ExchangeCode(ctx, "e2e")
+	conn, err := connections.Create(ctx, user.ID, "google", *creds)
+	if err != nil {
+		t.Fatalf("Failed to create connection: %v", err)
+	}
+
+	h := NewCalendarHandler(connections, calendars, events, entries, readModel,
+		store.NewSyncJobStore(db.Pool), fake, classifier, timeentry.NewService(events, entries), users)
+	h.SetUnitOfWork(database.NewUnitOfWork(db.Pool))
+
+	return &syncHarness{
+		t:          t,
+		ctx:        context.WithValue(ctx, userIDKey, user.ID),
+		pool:       db.Pool,
+		fake:       fake,
+		handler:    h,
+		calendars:  calendars,
+		classifier: classifier,
+		connID:     conn.ID,
+	}
+}
+
+// sync runs a manual sync, from startDate when given
+func (s *syncHarness) sync(startDate *time.Time) api.SyncCalendar200JSONResponse {
+	s.t.Helper()
+	req := api.SyncCalendarRequestObject{Id: s.connID}
+	if startDate != nil {
+		req.Params.StartDate = &openapi_types.Date{Time: *startDate}
+	}
+	resp, err := s.handler.SyncCalendar(s.ctx, req)
+	if err != nil {
+		s.t.Fatalf("SyncCalendar: %v", err)
+	}
+	ok, isOK := resp.(api.SyncCalendar200JSONResponse)
+	if !isOK {
+		s.t.Fatalf("SyncCalendar: unexpected response %#v", resp)
+	}
+	return ok
+}
+
+// calendar returns the synced primary calendar
+func (s *syncHarness) calendar() *store.Calendar {
+	s.t.Helper()
+	selected, err := s.calendars.ListSelectedByConnection(s.ctx, s.connID)
+	if err != nil || len(selected) != 1 {
+		s.t.Fatalf("expected the primary calendar to be selected, got %d (%v)", len(selected), err)
+	}
+	return selected[0]
+}
+
+// event returns a synced event's title and whether it is orphaned, failing
+// the test if it wasn't synced
+func (s *syncHarness) event(externalID string) (string, bool) {
+	s.t.Helper()
+	var title string
+	var orphaned bool
+	err := s.pool.QueryRow(s.ctx, `
+		SELECT title, is_orphaned FROM calendar_events
+		WHERE calendar_id = $1 AND external_id = $2
+	`, s.calendar().ID, externalID).Scan(&title, &orphaned)
+	if err != nil {
+		s.t.Fatalf("event %s was not synced: %v", externalID, err)
+	}
+	return title, orphaned
+}
+
+// eventID returns a synced event's ID, failing the test if it wasn't synced
+func (s *syncHarness) eventID(externalID string) uuid.UUID {
+	s.t.Helper()
+	var id uuid.UUID
+	err := s.pool.QueryRow(s.ctx, `
+		SELECT id FROM calendar_events
+		WHERE calendar_id = $1 AND external_id = $2
+	`, s.calendar().ID, externalID).Scan(&id)
+	if err != nil {
+		s.t.Fatalf("event %s was not synced: %v", externalID, err)
+	}
+	return id
+}
+
+// makeStale backdates the calendar's last sync past the staleness threshold
+func (s *syncHarness) makeStale() {
+	s.t.Helper()
+	_, err := s.pool.Exec(s.ctx, "UPDATE calendars SET last_synced_at = $2 WHERE id = $1",
+		s.calendar().ID, time.Now().Add(-2*sync.StalenessThreshold))
+	if err != nil {
+		s.t.Fatal(err)
+	}
+}
+
+func e2eEvent(id, title string, start time.Time) *gcal.Event {
+	return &gcal.Event{
+		Id:      id,
+		Summary: title,
+		Start:   &gcal.EventDateTime{DateTime: start.Format(time.RFC3339)},
+		End:     &gcal.EventDateTime{DateTime: start.Add(30 * time.Minute).Format(time.RFC3339)},
+	}
+}
+
+func TestCalendarSyncE2E(t *testing.T) {
+	s := newSyncHarness(t)
+	week := sync.NormalizeToWeekStart(time.Now())
+	at := func(weeksAgo, day, hour int) time.Time {
+		return week.AddDate(0, 0, -7*weeksAgo+day).Add(time.Duration(hour) * time.Hour)
+	}
+
+	s.fake.PutEvent("primary", e2eEvent("standup", "Standup", at(0, 1, 9)))
+	s.fake.PutEvent("primary", e2eEvent("review", "Design review", at(1, 2, 14)))
+	s.fake.PutEvent("primary", e2eEvent("retro", "Retro", at(2, 3, 10)))
+	// Outside the manual sync window of 90 days
+	s.fake.PutEvent("primary", e2eEvent("kickoff", "Kickoff", at(20, 1, 9)))
+
+	// First sync selects the primary calendar and fetches the manual window
+	resp := s.sync(nil)
+	if resp.EventsCreated != 3 {
+		t.Errorf("first sync: expected 3 events created, got %d", resp.EventsCreated)
+	}
+	cal := s.calendar()
+	if cal.SyncToken == nil || cal.MinSyncedDate == nil || cal.MaxSyncedDate == nil {
+		t.Fatalf("first sync: expected a sync token and watermarks, got %+v", cal)
+	}
+	if len(s.fake.FetchCalls) != 1 || len(s.fake.IncrementalCalls) != 0 {
+		t.Errorf("first sync: expected one full fetch, got %d full and %d incremental",
+			len(s.fake.FetchCalls), len(s.fake.IncrementalCalls))
+	}
+
+	since := time.Now()
+
+	t.Run("fresh data is not fetched again", func(t *testing.T) {
+		s.sync(nil)
+		if len(s.fake.FetchCalls) != 1 || len(s.fake.IncrementalCalls) != 0 {
+			t.Errorf("expected no fetches, got %d full and %d incremental",
+				len(s.fake.FetchCalls), len(s.fake.IncrementalCalls))
+		}
+	})
+
+	t.Run("stale data refreshes incrementally", func(t *testing.T) {
+		s.fake.PutEvent("primary", e2eEvent("standup", "Standup (moved)", at(0, 1, 10)))
+		s.fake.DeleteEvent("primary", "review")
+		s.makeStale()
+
+		resp := s.sync(nil)
+		if len(s.fake.IncrementalCalls) != 1 || s.fake.IncrementalCalls[0].SyncToken != *cal.SyncToken {
+			t.Fatalf("expected one incremental fetch from the stored token, got %+v", s.fake.IncrementalCalls)
+		}
+		if resp.EventsUpdated != 1 || resp.EventsOrphaned != 1 {
+			t.Errorf("expected 1 updated and 1 orphaned, got %+v", resp)
+		}
+		if title, _ := s.event("standup"); title != "Standup (moved)" {
+			t.Errorf("expected the change to be synced, got %q", title)
+		}
+		if _, orphaned := s.event("review"); !orphaned {
+			t.Error("expected the deleted event to be orphaned")
+		}
+	})
+
+	t.Run("diff reports the move and the cancellation", func(t *testing.T) {
+		resp, err := s.handler.DiffCalendarEvents(s.ctx, api.DiffCalendarEventsRequestObject{
+			Params: api.DiffCalendarEventsParams{
+				StartDate: openapi_types.Date{Time: at(1, 0, 0)},
+				EndDate:   openapi_types.Date{Time: at(0, 6, 0)},
+				Since:     since,
+			},
+		})
+		if err != nil {
+			t.Fatalf("DiffCalendarEvents: %v", err)
+		}
+		diff, ok := resp.(api.DiffCalendarEvents200JSONResponse)
+		if !ok {
+			t.Fatalf("DiffCalendarEvents: unexpected response %#v", resp)
+		}
+		if diff.Added != 0 || diff.Moved != 1 || diff.Cancelled != 1 || diff.Changed != 0 {
+			t.Errorf("expected 1 moved and 1 cancelled, got %s: %+v", diff.Summary, diff.Events)
+		}
+		for _, e := range diff.Events {
+			if e.Kind == api.Moved && (e.Before == nil || e.After == nil || e.Before.Title != "Standup" || !e.After.StartTime.Equal(at(0, 1, 10))) {
+				t.Errorf("expected the standup's move with before and after, got %+v", e)
+			}
+		}
+	})
+
+	t.Run("expired sync token falls back to a full fetch", func(t *testing.T) {
+		s.fake.DeleteEvent("primary", "retro")
+		s.fake.ExpireSyncTokens("primary")
+		s.makeStale()
+		before := *s.calendar().SyncToken
+		fetches := len(s.fake.FetchCalls)
+
+		s.sync(nil)
+		if len(s.fake.FetchCalls) != fetches+1 {
+			t.Fatalf("expected a full fetch after 410 Gone, got %d", len(s.fake.FetchCalls)-fetches)
+		}
+		if token := s.calendar().SyncToken; token == nil || *token == before {
+			t.Errorf("expected a new sync token, got %v", token)
+		}
+		// Absent from the full fetch, so orphaned even without a cancellation
+		if _, orphaned := s.event("retro"); !orphaned {
+			t.Error("expected the event missing from the full fetch to be orphaned")
+		}
+		if _, orphaned := s.event("standup"); orphaned {
+			t.Error("expected the remaining event to stay")
+		}
+	})
+
+	t.Run("earlier weeks expand the watermark", func(t *testing.T) {
+		minBefore := *s.calendar().MinSyncedDate
+		start := at(21, 0, 0)
+
+		resp := s.sync(&start)
+		if resp.EventsCreated != 1 {
+			t.Errorf("expected the older event to be created, got %d", resp.EventsCreated)
+		}
+		if title, orphaned := s.event("kickoff"); title != "Kickoff" || orphaned {
+			t.Errorf("expected the older event to be synced, got %q orphaned=%v", title, orphaned)
+		}
+
+		last := s.fake.FetchCalls[len(s.fake.FetchCalls)-1]
+		if !last.MinTime.Equal(start) || !last.MaxTime.Before(minBefore) {
+			t.Errorf("expected only the missing weeks %v to %v to be fetched, got %v to %v",
+				start, minBefore, last.MinTime, last.MaxTime)
+		}
+		if low := s.calendar().MinSyncedDate; low == nil || low.After(start) {
+			t.Errorf("expected the low watermark to move to %v, got %v", start, low)
+		}
+	})
+}
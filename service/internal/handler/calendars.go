@@ -5,14 +5,20 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	gosync "sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/cache"
 	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/redact"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 	"github.com/michaelw/timesheet-app/service/internal/sync"
 	"github.com/michaelw/timesheet-app/service/internal/timeentry"
@@ -26,63 +32,120 @@ type CalendarHandler struct {
 	calendars         *store.CalendarStore
 	events            *store.CalendarEventStore
 	entries           *store.TimeEntryStore
-	projects          *store.ProjectStore
+	readModel         *cache.ReadModel
 	syncJobs          *store.SyncJobStore
 	google            google.CalendarClient
 	classificationSvc *classification.Service
 	timeEntryService  *timeentry.Service
+	users             *store.UserStore
+	syncWindowLimit   sync.Window // Zero fields are uncapped
+	uow               *database.UnitOfWork
+	reclassifier      *classification.Reclassifier
 	stateMu           gosync.RWMutex
-	stateStore        map[string]uuid.UUID // In production, use Redis
+	stateStore        map[string]oauthState // In production, use Redis
 }
 
+// oauthState is a Google consent the user started, waiting for the callback
+type oauthState struct {
+	userID uuid.UUID
+	// connectionID is the connection an incremental consent or re-consent
+	// updates
+	connectionID *uuid.UUID
+}
+
+// errOAuthExchange is returned when Google rejects the authorization code
+var errOAuthExchange = errors.New("failed to exchange authorization code")
+
 // NewCalendarHandler creates a new calendar handler
 func NewCalendarHandler(
 	connections *store.CalendarConnectionStore,
 	calendars *store.CalendarStore,
 	events *store.CalendarEventStore,
 	entries *store.TimeEntryStore,
-	projects *store.ProjectStore,
+	readModel *cache.ReadModel,
 	syncJobs *store.SyncJobStore,
 	googleSvc google.CalendarClient,
 	classificationSvc *classification.Service,
 	timeEntryService *timeentry.Service,
+	users *store.UserStore,
 ) *CalendarHandler {
 	return &CalendarHandler{
 		connections:       connections,
 		calendars:         calendars,
 		events:            events,
 		entries:           entries,
-		projects:          projects,
+		readModel:         readModel,
 		syncJobs:          syncJobs,
 		google:            googleSvc,
 		classificationSvc: classificationSvc,
 		timeEntryService:  timeEntryService,
-		stateStore:        make(map[string]uuid.UUID),
+		users:             users,
+		stateStore:        make(map[string]oauthState),
+	}
+}
+
+// SetSyncWindowLimit caps how far any connection may sync. Zero fields are
+// uncapped.
+func (h *CalendarHandler) SetSyncWindowLimit(limit sync.Window) {
+	h.syncWindowLimit = limit
+}
+
+// SetUnitOfWork makes classification and sync writes transactional. Without
+// one each statement commits on its own.
+func (h *CalendarHandler) SetUnitOfWork(uow *database.UnitOfWork) {
+	h.uow = uow
+}
+
+// SetReclassifier sets where synced changes are reclassified, so the
+// on-demand sync can run the user's pending changes before responding
+func (h *CalendarHandler) SetReclassifier(r *classification.Reclassifier) {
+	h.reclassifier = r
+}
+
+// ClassificationTargets returns the user's projects, archived included, as
+// classification targets
+func (h *CalendarHandler) ClassificationTargets(ctx context.Context, userID uuid.UUID) ([]classification.Target, error) {
+	projects, err := h.readModel.Projects(ctx, userID, true)
+	if err != nil {
+		return nil, err
 	}
+	return projectsToTargets(projects), nil
+}
+
+// cadence returns how fresh the user wants their calendars kept, or the
+// default if their settings can't be read
+func (h *CalendarHandler) cadence(ctx context.Context, userID uuid.UUID) sync.Cadence {
+	if h.users == nil {
+		return sync.DefaultCadence
+	}
+	c, err := h.users.GetSyncCadence(ctx, userID)
+	if err != nil {
+		log.Printf("[SYNC] failed to load sync cadence for user %s: %v", userID, err)
+		return sync.DefaultCadence
+	}
+	return sync.ResolveCadence(c.StaleAfterMinutes, c.IntervalMinutes)
+}
+
+// initialWindow returns the range fetched for a calendar of the connection
+// that has no sync token yet
+func (h *CalendarHandler) initialWindow(conn *store.CalendarConnection) (time.Time, time.Time) {
+	return sync.InitialWindow.Resolve(conn.SyncHistoryDays, conn.SyncFutureDays, h.syncWindowLimit).Range(time.Now())
+}
+
+// syncBounds returns the furthest the connection may sync
+func (h *CalendarHandler) syncBounds(conn *store.CalendarConnection) sync.Window {
+	return sync.Bounds(conn.SyncHistoryDays, conn.SyncFutureDays, h.syncWindowLimit)
 }
 
 // HandleOAuthCallback processes the OAuth callback and returns an error message if failed
 func (h *CalendarHandler) HandleOAuthCallback(ctx context.Context, code, state string) error {
 	// Get user ID from state parameter
-	h.stateMu.Lock()
-	userID, exists := h.stateStore[state]
-	if exists {
-		delete(h.stateStore, state)
-	}
-	h.stateMu.Unlock()
-
+	st, exists := h.takeOAuthState(state)
 	if !exists {
 		return errors.New("invalid or expired state parameter")
 	}
 
-	// Exchange code for tokens
-	creds, err := h.google.ExchangeCode(ctx, code)
-	if err != nil {
-		return errors.New("failed to exchange authorization code")
-	}
-
-	// Create connection
-	_, err = h.connections.Create(ctx, userID, "google", *creds)
+	_, err := h.completeGoogleConsent(ctx, st, code)
 	if err != nil {
 		if errors.Is(err, store.ErrCalendarAlreadyConnected) {
 			return errors.New("Google Calendar is already connected")
@@ -110,16 +173,33 @@ func (h *CalendarHandler) GoogleAuthorize(ctx context.Context, req api.GoogleAut
 		}, nil
 	}
 
-	// Generate state token
-	stateBytes := make([]byte, 16)
-	rand.Read(stateBytes)
-	state := hex.EncodeToString(stateBytes)
+	access := google.AccessCalendar
+	if req.Params.Access != nil {
+		if access, ok = google.ParseAccess(string(*req.Params.Access)); !ok {
+			return api.GoogleAuthorize400JSONResponse{
+				Code:    "invalid_request",
+				Message: fmt.Sprintf("Unknown access %q", *req.Params.Access),
+			}, nil
+		}
+	}
 
-	h.stateMu.Lock()
-	h.stateStore[state] = userID
-	h.stateMu.Unlock()
+	st := oauthState{userID: userID}
+	scopes := access.Scopes()
+	if access != google.AccessCalendar {
+		conn, err := h.googleConnection(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if conn != nil {
+			st.connectionID = &conn.ID
+		} else {
+			// Nothing connected yet: connect the calendar in the same consent
+			scopes = append(google.AccessCalendar.Scopes(), scopes...)
+		}
+	}
 
-	url := h.google.GetAuthURL(state)
+	state := h.putOAuthState(st)
+	url := h.google.GetAuthURL(state, scopes...)
 
 	return api.GoogleAuthorize200JSONResponse{
 		Url:   url,
@@ -130,13 +210,7 @@ func (h *CalendarHandler) GoogleAuthorize(ctx context.Context, req api.GoogleAut
 // GoogleCallback handles OAuth callback
 func (h *CalendarHandler) GoogleCallback(ctx context.Context, req api.GoogleCallbackRequestObject) (api.GoogleCallbackResponseObject, error) {
 	// Get user ID from state parameter (not JWT - this is a browser redirect from Google)
-	h.stateMu.Lock()
-	userID, exists := h.stateStore[req.Params.State]
-	if exists {
-		delete(h.stateStore, req.Params.State)
-	}
-	h.stateMu.Unlock()
-
+	st, exists := h.takeOAuthState(req.Params.State)
 	if !exists {
 		return api.GoogleCallback400JSONResponse{
 			Code:    "invalid_state",
@@ -144,18 +218,14 @@ func (h *CalendarHandler) GoogleCallback(ctx context.Context, req api.GoogleCall
 		}, nil
 	}
 
-	// Exchange code for tokens
-	creds, err := h.google.ExchangeCode(ctx, req.Params.Code)
-	if err != nil {
-		return api.GoogleCallback400JSONResponse{
-			Code:    "oauth_error",
-			Message: "Failed to exchange authorization code",
-		}, nil
-	}
-
-	// Create connection
-	conn, err := h.connections.Create(ctx, userID, "google", *creds)
+	conn, err := h.completeGoogleConsent(ctx, st, req.Params.Code)
 	if err != nil {
+		if errors.Is(err, errOAuthExchange) {
+			return api.GoogleCallback400JSONResponse{
+				Code:    "oauth_error",
+				Message: "Failed to exchange authorization code",
+			}, nil
+		}
 		if errors.Is(err, store.ErrCalendarAlreadyConnected) {
 			return api.GoogleCallback400JSONResponse{
 				Code:    "already_connected",
@@ -168,6 +238,79 @@ func (h *CalendarHandler) GoogleCallback(ctx context.Context, req api.GoogleCall
 	return api.GoogleCallback201JSONResponse(calendarConnectionToAPI(conn)), nil
 }
 
+// putOAuthState stores a pending consent under a new state token
+func (h *CalendarHandler) putOAuthState(st oauthState) string {
+	stateBytes := make([]byte, 16)
+	rand.Read(stateBytes)
+	state := hex.EncodeToString(stateBytes)
+
+	h.stateMu.Lock()
+	h.stateStore[state] = st
+	h.stateMu.Unlock()
+	return state
+}
+
+// takeOAuthState removes and returns a pending consent
+func (h *CalendarHandler) takeOAuthState(state string) (oauthState, bool) {
+	h.stateMu.Lock()
+	defer h.stateMu.Unlock()
+	st, exists := h.stateStore[state]
+	if exists {
+		delete(h.stateStore, state)
+	}
+	return st, exists
+}
+
+// completeGoogleConsent exchanges the code and saves the grant, creating a
+// connection or replacing the existing one's grant, to add access or
+// recover from a failed refresh
+func (h *CalendarHandler) completeGoogleConsent(ctx context.Context, st oauthState, code string) (*store.CalendarConnection, error) {
+	creds, err := h.google.ExchangeCode(ctx, code)
+	if err != nil {
+		return nil, errOAuthExchange
+	}
+
+	if st.connectionID == nil {
+		return h.connections.Create(ctx, st.userID, "google", *creds)
+	}
+
+	existing, err := h.connections.GetByID(ctx, st.userID, *st.connectionID)
+	if err != nil {
+		return nil, err
+	}
+	// Google may leave out what didn't change with an incremental consent
+	if creds.RefreshToken == "" {
+		creds.RefreshToken = existing.Credentials.RefreshToken
+	}
+	if len(creds.Scopes) == 0 {
+		creds.Scopes = existing.GrantedScopes
+	}
+	conn, err := h.connections.UpdateGrant(ctx, st.userID, existing.ID, *creds)
+	if err != nil {
+		return nil, err
+	}
+	// A fresh grant fixes a revoked or expired one
+	if err := h.calendars.ClearConnectionNeedsReauth(ctx, conn.ID); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// googleConnection returns the user's Google connection, or nil if they
+// haven't connected one
+func (h *CalendarHandler) googleConnection(ctx context.Context, userID uuid.UUID) (*store.CalendarConnection, error) {
+	connections, err := h.connections.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range connections {
+		if c.Provider == "google" {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
 // ListCalendarConnections returns all connections for the user
 func (h *CalendarHandler) ListCalendarConnections(ctx context.Context, req api.ListCalendarConnectionsRequestObject) (api.ListCalendarConnectionsResponseObject, error) {
 	userID, ok := UserIDFromContext(ctx)
@@ -215,6 +358,125 @@ func (h *CalendarHandler) DeleteCalendarConnection(ctx context.Context, req api.
 	return api.DeleteCalendarConnection204Response{}, nil
 }
 
+// UpdateCalendarSyncWindow sets how far a connection syncs
+func (h *CalendarHandler) UpdateCalendarSyncWindow(ctx context.Context, req api.UpdateCalendarSyncWindowRequestObject) (api.UpdateCalendarSyncWindowResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateCalendarSyncWindow401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateCalendarSyncWindow400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if msg := validateSyncWindowDays("history_days", req.Body.HistoryDays, h.syncWindowLimit.HistoryDays); msg != "" {
+		return api.UpdateCalendarSyncWindow400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+	if msg := validateSyncWindowDays("future_days", req.Body.FutureDays, h.syncWindowLimit.FutureDays); msg != "" {
+		return api.UpdateCalendarSyncWindow400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+
+	conn, err := h.connections.UpdateSyncWindow(ctx, userID, req.Id, req.Body.HistoryDays, req.Body.FutureDays)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarConnectionNotFound) {
+			return api.UpdateCalendarSyncWindow404JSONResponse{
+				Code:    "not_found",
+				Message: "Calendar connection not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.UpdateCalendarSyncWindow200JSONResponse(calendarConnectionToAPI(conn)), nil
+}
+
+// UpdateCalendarWriteBack sets how classified projects are written back to
+// the connection's Google events
+func (h *CalendarHandler) UpdateCalendarWriteBack(ctx context.Context, req api.UpdateCalendarWriteBackRequestObject) (api.UpdateCalendarWriteBackResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateCalendarWriteBack401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateCalendarWriteBack400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	mode := store.WriteBackMode(req.Body.Mode)
+	switch mode {
+	case store.WriteBackOff, store.WriteBackProperties, store.WriteBackColor:
+	default:
+		return api.UpdateCalendarWriteBack400JSONResponse{
+			Code:    "invalid_request",
+			Message: "mode must be off, properties or color",
+		}, nil
+	}
+
+	conn, err := h.connections.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarConnectionNotFound) {
+			return api.UpdateCalendarWriteBack404JSONResponse{
+				Code:    "not_found",
+				Message: "Calendar connection not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if mode != store.WriteBackOff && !google.HasAccess(conn.GrantedScopes, google.AccessCalendarWrite) {
+		return api.UpdateCalendarWriteBack400JSONResponse{
+			Code:    "consent_required",
+			Message: "Calendar write access hasn't been granted. Authorize it with access=calendar_write first.",
+		}, nil
+	}
+
+	conn, err = h.connections.UpdateWriteBack(ctx, userID, req.Id, mode)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarConnectionNotFound) {
+			return api.UpdateCalendarWriteBack404JSONResponse{
+				Code:    "not_found",
+				Message: "Calendar connection not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.UpdateCalendarWriteBack200JSONResponse(calendarConnectionToAPI(conn)), nil
+}
+
+// maxSyncWindowDays bounds a connection's window when the server sets no limit
+const maxSyncWindowDays = 10 * 365
+
+// validateSyncWindowDays returns a message describing what is wrong with an
+// optional window setting, or "" when it is valid
+func validateSyncWindowDays(field string, days *int, limit int) string {
+	if days == nil {
+		return ""
+	}
+	if limit <= 0 {
+		limit = maxSyncWindowDays
+	}
+	if *days < 1 || *days > limit {
+		return fmt.Sprintf("%s must be between 1 and %d", field, limit)
+	}
+	return ""
+}
+
 // SyncCalendar triggers a sync for a connection (syncs all selected calendars)
 // Uses smart sync decision based on water marks and staleness:
 // - If requested week is within synced window AND data is fresh (<24h): skip sync
@@ -241,13 +503,23 @@ func (h *CalendarHandler) SyncCalendar(ctx context.Context, req api.SyncCalendar
 		return nil, err
 	}
 
+	if conn.PausedAt != nil {
+		return api.SyncCalendar409JSONResponse{
+			Code:    "connection_paused",
+			Message: "The calendar connection is paused. Resume it to sync.",
+		}, nil
+	}
+
 	// Refresh token if needed
 	creds := &conn.Credentials
 	if time.Now().After(creds.Expiry.Add(-5 * time.Minute)) {
 		newCreds, err := h.google.RefreshToken(ctx, creds)
 		if err != nil {
-			// Mark calendars as needing reauth if token refresh fails
 			log.Printf("Token refresh failed for connection %s: %v", conn.ID, err)
+			if sync.ClassifyError(err) != store.SyncFailureAuth {
+				return nil, fmt.Errorf("refresh token: %w", err)
+			}
+			// Mark calendars as needing reauth if the grant was revoked or expired
 			h.markConnectionNeedsReauth(ctx, conn.ID)
 			// Return 401 to indicate auth is needed
 			return api.SyncCalendar401JSONResponse{
@@ -310,23 +582,23 @@ func (h *CalendarHandler) SyncCalendar(ctx context.Context, req api.SyncCalendar
 
 	// Determine target sync window from request params or defaults
 	// If explicit dates provided, this is an "on-demand" sync (fetch only requested range as island)
-	var targetStart, targetEnd time.Time
 	isOnDemandSync := req.Params.StartDate != nil && req.Params.EndDate != nil
 
+	now := time.Now()
+	targetStart, targetEnd := sync.ManualWindow.Resolve(conn.SyncHistoryDays, conn.SyncFutureDays, h.syncWindowLimit).Range(now)
 	if req.Params.StartDate != nil {
-		targetStart = req.Params.StartDate.Time
-	} else {
-		targetStart = time.Now().AddDate(0, 0, -90)
+		targetStart = sync.NormalizeToWeekStart(req.Params.StartDate.Time)
 	}
 	if req.Params.EndDate != nil {
-		targetEnd = req.Params.EndDate.Time
-	} else {
-		targetEnd = time.Now().AddDate(0, 0, 30)
+		targetEnd = sync.NormalizeToWeekEnd(req.Params.EndDate.Time)
 	}
 
-	// Normalize to week boundaries
-	targetStart = sync.NormalizeToWeekStart(targetStart)
-	targetEnd = sync.NormalizeToWeekEnd(targetEnd)
+	// Stay within the connection's sync window
+	targetStart, targetEnd, inWindow := h.syncBounds(conn).Clamp(targetStart, targetEnd, now)
+	if !inWindow {
+		log.Printf("[SYNC] skip: connection=%s reason=outside_sync_window", conn.ID)
+		return api.SyncCalendar200JSONResponse{}, nil
+	}
 
 	var totalCreated, totalUpdated, totalOrphaned int
 	var syncSkipped bool
@@ -334,61 +606,31 @@ func (h *CalendarHandler) SyncCalendar(ctx context.Context, req api.SyncCalendar
 	// Sync each selected calendar
 	for _, cal := range selectedCalendars {
 		var created, updated, orphaned int
-		var syncErr error
-
-		if isOnDemandSync {
-			// On-demand sync: fetch only the requested range as an "island"
-			// Don't fill gaps - background sync will catch up later
-			log.Printf("[SYNC] on-demand: calendar=%s range=%s to %s",
-				cal.Name, targetStart.Format("2006-01-02"), targetEnd.Format("2006-01-02"))
-			created, updated, orphaned, syncErr = h.syncSingleCalendar(ctx, creds, conn, cal, userID, &targetStart, &targetEnd)
-		} else {
-			// Regular sync: use smart decision logic to determine what to fetch
-			decision := sync.DecideSync(cal.MinSyncedDate, cal.MaxSyncedDate, cal.LastSyncedAt, targetStart, targetEnd)
+		var skipped bool
 
-			if !decision.NeedsSync {
-				log.Printf("[SYNC] skip: calendar=%s reason=%s", cal.Name, decision.Reason)
-				syncSkipped = true
-				continue
-			}
+		// A permanent failure disabled the calendar until it is reselected
+		if cal.SyncDisabledAt != nil {
+			continue
+		}
 
-			log.Printf("[SYNC] start: calendar=%s reason=%s stale=%v missing_weeks=%d",
-				cal.Name, decision.Reason, decision.IsStaleRefresh, len(decision.MissingWeeks))
-
-			if decision.IsStaleRefresh {
-				// Case A': Use incremental sync to refresh stale data
-				created, updated, orphaned, syncErr = h.syncCalendarIncremental(ctx, creds, conn, cal, userID)
-			} else if len(decision.MissingWeeks) > 0 {
-				// Case B/C: Batch contiguous missing weeks into single API calls
-				batches := batchContiguousWeeks(decision.MissingWeeks)
-				log.Printf("[SYNC] batching: calendar=%s weeks=%d batches=%d", cal.Name, len(decision.MissingWeeks), len(batches))
-
-				for _, batch := range batches {
-					batchStart := batch[0]
-					batchEnd := sync.NormalizeToWeekEnd(batch[len(batch)-1])
-					log.Printf("[SYNC] batch_fetch: calendar=%s range=%s to %s weeks=%d",
-						cal.Name, batchStart.Format("2006-01-02"), batchEnd.Format("2006-01-02"), len(batch))
-
-					c, u, o, err := h.syncSingleCalendar(ctx, creds, conn, cal, userID, &batchStart, &batchEnd)
-					if err != nil {
-						log.Printf("[SYNC] batch_failed: calendar=%s range=%s to %s error=%v",
-							cal.Name, batchStart.Format("2006-01-02"), batchEnd.Format("2006-01-02"), err)
-						syncErr = err
-						continue
-					}
-					created += c
-					updated += u
-					orphaned += o
-				}
-			} else {
-				// First sync or full range sync
-				created, updated, orphaned, syncErr = h.syncSingleCalendar(ctx, creds, conn, cal, userID, &targetStart, &targetEnd)
-			}
+		syncErr := sync.WithCalendarLease(ctx, h.calendars, cal.ID, func() error {
+			var err error
+			created, updated, orphaned, skipped, err = h.syncSelectedCalendar(ctx, creds, conn, cal, userID, targetStart, targetEnd, isOnDemandSync)
+			return err
+		})
+		if errors.Is(syncErr, sync.ErrSyncInProgress) {
+			log.Printf("[SYNC] skip: calendar=%s reason=sync_in_progress", redact.Text(cal.Name))
+			syncSkipped = true
+			continue
+		}
+		if skipped {
+			syncSkipped = true
+			continue
 		}
 
 		if syncErr != nil {
-			log.Printf("[SYNC] calendar_failed: calendar=%s error=%v", cal.Name, syncErr)
-			h.calendars.IncrementSyncFailureCount(ctx, cal.ID)
+			category := sync.RecordFailure(ctx, h.calendars, cal, syncErr)
+			log.Printf("[SYNC] calendar_failed: calendar=%s category=%s error=%v", redact.Text(cal.Name), category, syncErr)
 			continue
 		}
 
@@ -404,23 +646,8 @@ func (h *CalendarHandler) SyncCalendar(ctx context.Context, req api.SyncCalendar
 		h.connections.UpdateLastSynced(ctx, conn.ID)
 	}
 
-	// Auto-apply classification rules to newly synced events
-	if h.classificationSvc != nil && (totalCreated > 0 || totalUpdated > 0) {
-		// Fetch projects and convert to targets for classification
-		projects, err := h.projects.List(ctx, userID, true) // Include archived
-		if err != nil {
-			log.Printf("Failed to fetch projects for classification: %v", err)
-		} else {
-			targets := projectsToTargets(projects)
-			result, err := h.classificationSvc.ApplyRules(ctx, userID, targets, nil, nil, false)
-			if err != nil {
-				log.Printf("Failed to apply classification rules after sync: %v", err)
-				// Don't fail the sync, just log the error
-			} else if len(result.Classified) > 0 {
-				log.Printf("[SYNC] auto_classified: events=%d", len(result.Classified))
-			}
-		}
-	}
+	// Events the sync created or changed are queued with the reclassifier,
+	// which applies rules to just those once the user's syncs settle
 
 	log.Printf("[SYNC] complete: connection=%s created=%d updated=%d orphaned=%d skipped=%v",
 		conn.ID, totalCreated, totalUpdated, totalOrphaned, syncSkipped)
@@ -432,6 +659,63 @@ func (h *CalendarHandler) SyncCalendar(ctx context.Context, req api.SyncCalendar
 	}, nil
 }
 
+// syncSelectedCalendar runs one calendar's part of a manual sync. On-demand
+// syncs fetch the requested range as an island; regular syncs fetch only what
+// the calendar's watermarks say is missing or stale, and report skipped when
+// nothing is.
+func (h *CalendarHandler) syncSelectedCalendar(ctx context.Context, creds *store.OAuthCredentials, conn *store.CalendarConnection, cal *store.Calendar, userID uuid.UUID, targetStart, targetEnd time.Time, isOnDemandSync bool) (created, updated, orphaned int, skipped bool, err error) {
+	if isOnDemandSync {
+		// On-demand sync: fetch only the requested range as an "island"
+		// Don't fill gaps - background sync will catch up later
+		log.Printf("[SYNC] on-demand: calendar=%s range=%s to %s",
+			redact.Text(cal.Name), targetStart.Format("2006-01-02"), targetEnd.Format("2006-01-02"))
+		created, updated, orphaned, err = h.syncSingleCalendar(ctx, creds, conn, cal, userID, &targetStart, &targetEnd)
+	} else {
+		// Regular sync: use smart decision logic to determine what to fetch
+		decision := h.cadence(ctx, userID).DecideSync(cal.MinSyncedDate, cal.MaxSyncedDate, cal.LastSyncedAt, targetStart, targetEnd)
+
+		if !decision.NeedsSync {
+			log.Printf("[SYNC] skip: calendar=%s reason=%s", redact.Text(cal.Name), decision.Reason)
+			return 0, 0, 0, true, nil
+		}
+
+		log.Printf("[SYNC] start: calendar=%s reason=%s stale=%v missing_weeks=%d",
+			redact.Text(cal.Name), decision.Reason, decision.IsStaleRefresh, len(decision.MissingWeeks))
+
+		if decision.IsStaleRefresh {
+			// Case A': Use incremental sync to refresh stale data
+			created, updated, orphaned, err = h.syncCalendarIncremental(ctx, creds, conn, cal, userID)
+		} else if len(decision.MissingWeeks) > 0 {
+			// Case B/C: Batch contiguous missing weeks into single API calls
+			batches := sync.BatchContiguousWeeks(decision.MissingWeeks)
+			log.Printf("[SYNC] batching: calendar=%s weeks=%d batches=%d", redact.Text(cal.Name), len(decision.MissingWeeks), len(batches))
+
+			for _, batch := range batches {
+				batchStart := batch[0]
+				batchEnd := sync.NormalizeToWeekEnd(batch[len(batch)-1])
+				log.Printf("[SYNC] batch_fetch: calendar=%s range=%s to %s weeks=%d",
+					redact.Text(cal.Name), batchStart.Format("2006-01-02"), batchEnd.Format("2006-01-02"), len(batch))
+
+				c, u, o, batchErr := h.syncSingleCalendar(ctx, creds, conn, cal, userID, &batchStart, &batchEnd)
+				if batchErr != nil {
+					log.Printf("[SYNC] batch_failed: calendar=%s range=%s to %s error=%v",
+						redact.Text(cal.Name), batchStart.Format("2006-01-02"), batchEnd.Format("2006-01-02"), batchErr)
+					err = batchErr
+					continue
+				}
+				created += c
+				updated += u
+				orphaned += o
+			}
+		} else {
+			// First sync or full range sync
+			created, updated, orphaned, err = h.syncSingleCalendar(ctx, creds, conn, cal, userID, &targetStart, &targetEnd)
+		}
+	}
+
+	return created, updated, orphaned, false, err
+}
+
 // markConnectionNeedsReauth marks all calendars in a connection as needing re-authentication
 func (h *CalendarHandler) markConnectionNeedsReauth(ctx context.Context, connectionID uuid.UUID) {
 	calendars, err := h.calendars.ListByConnection(ctx, connectionID)
@@ -446,52 +730,61 @@ func (h *CalendarHandler) markConnectionNeedsReauth(ctx context.Context, connect
 // syncCalendarIncremental performs incremental sync using sync token (for stale data refresh)
 func (h *CalendarHandler) syncCalendarIncremental(ctx context.Context, creds *store.OAuthCredentials, conn *store.CalendarConnection, cal *store.Calendar, userID uuid.UUID) (created, updated, orphaned int, err error) {
 	if cal.SyncToken == nil || *cal.SyncToken == "" {
-		// No sync token, fall back to default window sync
-		start, end := sync.DefaultInitialWindow()
+		// No sync token, fall back to initial window sync
+		start, end := h.initialWindow(conn)
 		return h.syncSingleCalendar(ctx, creds, conn, cal, userID, &start, &end)
 	}
 
 	syncResult, err := h.google.FetchEventsIncremental(ctx, creds, cal.ExternalID, *cal.SyncToken)
 	if err != nil {
 		// Sync token expired (410 Gone), clear it and do full sync
-		log.Printf("[SYNC] incremental_failed: calendar=%s fallback=full_sync error=%v", cal.Name, err)
+		log.Printf("[SYNC] incremental_failed: calendar=%s fallback=full_sync error=%v", redact.Text(cal.Name), err)
 		h.calendars.ClearSyncToken(ctx, cal.ID)
-		start, end := sync.DefaultInitialWindow()
+		start, end := h.initialWindow(conn)
 		return h.syncSingleCalendar(ctx, creds, conn, cal, userID, &start, &end)
 	}
 
-	// Process events
-	for _, ge := range syncResult.Events {
-		if ge.Status == "cancelled" {
-			markErr := h.events.MarkOrphanedByExternalIDAndCalendar(ctx, cal.ID, ge.Id)
-			if markErr != nil {
-				log.Printf("Failed to mark event as orphaned: %v", markErr)
+	// Apply the changes and advance the sync token together, so a failure
+	// leaves the token where the next sync can replay them
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		updated, orphaned = 0, 0
+		for _, ge := range syncResult.Events {
+			if ge.Status == "cancelled" {
+				if err := h.events.MarkOrphanedByExternalIDAndCalendar(ctx, cal.ID, ge.Id); err != nil {
+					return err
+				}
+				orphaned++
+				continue
 			}
-			orphaned++
-			continue
-		}
 
-		// Skip working location events - these indicate where someone is working
-		// (office, home, etc.) rather than actual meetings or work items
-		if ge.EventType == "workingLocation" {
-			continue
+			// Skip working location events - these indicate where someone is working
+			// (office, home, etc.) rather than actual meetings or work items
+			if ge.EventType == "workingLocation" {
+				continue
+			}
+
+			event := googleEventToStore(ge, conn.ID, cal.ID, userID)
+			if _, err := h.events.Upsert(ctx, event); err != nil {
+				return err
+			}
+			updated++
 		}
 
-		event := googleEventToStore(ge, conn.ID, cal.ID, userID)
-		_, upsertErr := h.events.Upsert(ctx, event)
-		if upsertErr != nil {
-			return created, updated, orphaned, upsertErr
+		// Save the new sync token
+		if syncResult.NextSyncToken != "" {
+			if err := h.calendars.UpdateSyncToken(ctx, cal.ID, syncResult.NextSyncToken); err != nil {
+				return err
+			}
 		}
-		updated++
-	}
 
-	// Save the new sync token
-	if syncResult.NextSyncToken != "" {
-		h.calendars.UpdateSyncToken(ctx, cal.ID, syncResult.NextSyncToken)
+		// Update calendar last synced
+		return h.calendars.UpdateLastSynced(ctx, cal.ID)
+	})
+	if err != nil {
+		return 0, 0, 0, err
 	}
 
-	// Update calendar last synced
-	h.calendars.UpdateLastSynced(ctx, cal.ID)
+	h.applySuppressionRules(ctx, userID, cal)
 
 	return created, updated, orphaned, nil
 }
@@ -502,14 +795,14 @@ func (h *CalendarHandler) syncCalendarWeek(ctx context.Context, creds *store.OAu
 }
 
 // RunBackgroundSync implements sync.BackgroundSyncRunner for periodic background sync
-func (h *CalendarHandler) RunBackgroundSync(ctx context.Context) error {
+func (h *CalendarHandler) RunBackgroundSync(ctx context.Context, gate *sync.Gate) error {
 	if h.google == nil {
 		log.Println("Background sync: Google Calendar not configured, skipping")
 		return nil
 	}
 
 	// Find all calendars that need sync
-	calendars, err := h.calendars.ListNeedingSync(ctx, sync.StalenessThreshold)
+	calendars, err := h.calendars.ListNeedingSync(ctx, sync.DefaultCadence.Interval)
 	if err != nil {
 		return err
 	}
@@ -529,7 +822,21 @@ func (h *CalendarHandler) RunBackgroundSync(ctx context.Context) error {
 		default:
 		}
 
-		h.syncCalendarBackground(ctx, cal)
+		// Stop between calendars when shutting down
+		if !gate.Enter() {
+			log.Println("Background sync: shutting down, remaining calendars deferred to next run")
+			return nil
+		}
+		err := sync.WithCalendarLease(ctx, h.calendars, cal.ID, func() error {
+			h.syncCalendarBackground(ctx, cal)
+			return nil
+		})
+		if errors.Is(err, sync.ErrSyncInProgress) {
+			log.Printf("[SYNC] background: skipping calendar=%s, sync in progress", redact.Text(cal.Name))
+		} else if err != nil {
+			log.Printf("[SYNC] background: lease failed for calendar=%s error=%v", redact.Text(cal.Name), err)
+		}
+		gate.Leave()
 	}
 
 	return nil
@@ -537,13 +844,17 @@ func (h *CalendarHandler) RunBackgroundSync(ctx context.Context) error {
 
 // syncCalendarBackground syncs a single calendar during background sync
 func (h *CalendarHandler) syncCalendarBackground(ctx context.Context, cal *store.Calendar) {
-	log.Printf("[SYNC] background: syncing calendar=%s id=%s", cal.Name, cal.ID)
+	log.Printf("[SYNC] background: syncing calendar=%s id=%s", redact.Text(cal.Name), cal.ID)
 
 	// Get connection with credentials
 	conn, err := h.connections.GetByIDForSync(ctx, cal.ConnectionID)
 	if err != nil {
-		log.Printf("[SYNC] background_failed: calendar=%s error=%v", cal.Name, err)
-		h.calendars.IncrementSyncFailureCount(ctx, cal.ID)
+		log.Printf("[SYNC] background_failed: calendar=%s error=%v", redact.Text(cal.Name), err)
+		sync.RecordFailure(ctx, h.calendars, cal, err)
+		return
+	}
+	// Paused after the calendar was listed for sync
+	if conn.PausedAt != nil {
 		return
 	}
 
@@ -552,8 +863,8 @@ func (h *CalendarHandler) syncCalendarBackground(ctx context.Context, cal *store
 	if time.Now().After(creds.Expiry.Add(-5 * time.Minute)) {
 		newCreds, err := h.google.RefreshToken(ctx, creds)
 		if err != nil {
-			log.Printf("[SYNC] background_token_failed: calendar=%s error=%v", cal.Name, err)
-			h.calendars.MarkNeedsReauth(ctx, cal.ID)
+			log.Printf("[SYNC] background_token_failed: calendar=%s error=%v", redact.Text(cal.Name), err)
+			sync.RecordFailure(ctx, h.calendars, cal, err)
 			return
 		}
 		creds = newCreds
@@ -568,23 +879,29 @@ func (h *CalendarHandler) syncCalendarBackground(ctx context.Context, cal *store
 		created, updated, orphaned, syncErr = h.syncCalendarIncremental(ctx, creds, conn, cal, cal.UserID)
 	} else {
 		// No sync token, do initial window sync
-		start, end := sync.DefaultInitialWindow()
+		start, end := h.initialWindow(conn)
 		created, updated, orphaned, syncErr = h.syncSingleCalendar(ctx, creds, conn, cal, cal.UserID, &start, &end)
 	}
 
 	if syncErr != nil {
-		log.Printf("[SYNC] background_sync_failed: calendar=%s error=%v", cal.Name, syncErr)
-		h.calendars.IncrementSyncFailureCount(ctx, cal.ID)
+		// Cancelled by shutdown: not the calendar's fault, and the previous
+		// watermarks and sync token are still in place for the next run
+		if ctx.Err() != nil {
+			log.Printf("[SYNC] background_interrupted: calendar=%s", redact.Text(cal.Name))
+			return
+		}
+		category := sync.RecordFailure(ctx, h.calendars, cal, syncErr)
+		log.Printf("[SYNC] background_sync_failed: calendar=%s category=%s error=%v", redact.Text(cal.Name), category, syncErr)
 		return
 	}
 
 	// Reset failure count on success
 	h.calendars.ResetSyncFailureCount(ctx, cal.ID)
-	log.Printf("[SYNC] background_complete: calendar=%s created=%d updated=%d orphaned=%d", cal.Name, created, updated, orphaned)
+	log.Printf("[SYNC] background_complete: calendar=%s created=%d updated=%d orphaned=%d", redact.Text(cal.Name), created, updated, orphaned)
 }
 
 // syncSingleCalendar syncs events from a single calendar
-// If minTime/maxTime are nil, uses default range (-366 to +32 days) and incremental sync when available
+// If minTime/maxTime are nil, uses the connection's initial window and incremental sync when available
 // The calendar's synced window is expanded to track which date ranges have been synced
 func (h *CalendarHandler) syncSingleCalendar(ctx context.Context, creds *store.OAuthCredentials, conn *store.CalendarConnection, cal *store.Calendar, userID uuid.UUID, minTime, maxTime *time.Time) (created, updated, orphaned int, err error) {
 	var syncResult *google.SyncResult
@@ -593,13 +910,15 @@ func (h *CalendarHandler) syncSingleCalendar(ctx context.Context, creds *store.O
 	// Determine if this is a default range sync or on-demand
 	isDefaultRangeSync := minTime == nil && maxTime == nil
 
-	// Try incremental sync if we have a sync token
-	// Incremental sync works regardless of date range - it returns all changes since last sync
-	if cal.SyncToken != nil && *cal.SyncToken != "" {
+	// Try incremental sync if we have a sync token and no range was asked
+	// for. An incremental result only holds changes, so it can't fill in
+	// weeks outside the synced window; a range always gets a full fetch, as
+	// in the sync job worker.
+	if isDefaultRangeSync && cal.SyncToken != nil && *cal.SyncToken != "" {
 		syncResult, err = h.google.FetchEventsIncremental(ctx, creds, cal.ExternalID, *cal.SyncToken)
 		if err != nil {
 			// Sync token expired or invalid (410 Gone), clear it and do full sync
-			log.Printf("[SYNC] incremental_failed: calendar=%s fallback=full_sync error=%v", cal.Name, err)
+			log.Printf("[SYNC] incremental_failed: calendar=%s fallback=full_sync error=%v", redact.Text(cal.Name), err)
 			h.calendars.ClearSyncToken(ctx, cal.ID)
 			syncResult = nil
 			err = nil
@@ -608,19 +927,17 @@ func (h *CalendarHandler) syncSingleCalendar(ctx context.Context, creds *store.O
 
 	// Full sync if no token or incremental failed
 	if syncResult == nil {
-		// Use provided dates or default initial window (-4 weeks to +1 week per PRD)
+		// Use provided dates or the connection's initial window (-4 weeks to
+		// +1 week per PRD unless configured)
+		syncMinTime, syncMaxTime = h.initialWindow(conn)
 		if minTime != nil {
 			syncMinTime = *minTime
-		} else {
-			syncMinTime, _ = sync.DefaultInitialWindow()
 		}
 		if maxTime != nil {
 			syncMaxTime = *maxTime
-		} else {
-			_, syncMaxTime = sync.DefaultInitialWindow()
 		}
 
-		log.Printf("[SYNC] fetch: calendar=%s range=%s to %s", cal.Name,
+		log.Printf("[SYNC] fetch: calendar=%s range=%s to %s", redact.Text(cal.Name),
 			syncMinTime.Format("2006-01-02"), syncMaxTime.Format("2006-01-02"))
 
 		syncResult, err = h.google.FetchEvents(ctx, creds, cal.ExternalID, syncMinTime, syncMaxTime)
@@ -629,16 +946,33 @@ func (h *CalendarHandler) syncSingleCalendar(ctx context.Context, creds *store.O
 		}
 	}
 
-	// Process events
+	// Write the fetched events, water marks and sync token together, so a
+	// failure part way through leaves the calendar as it was
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		var applyErr error
+		created, orphaned, applyErr = h.applySyncResult(ctx, syncResult, conn, cal, userID, isDefaultRangeSync, syncMinTime, syncMaxTime)
+		return applyErr
+	})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	h.applySuppressionRules(ctx, userID, cal)
+
+	return created, 0, orphaned, nil
+}
+
+// applySyncResult writes a fetched sync result, counting created and
+// orphaned events
+func (h *CalendarHandler) applySyncResult(ctx context.Context, syncResult *google.SyncResult, conn *store.CalendarConnection, cal *store.Calendar, userID uuid.UUID, isDefaultRangeSync bool, syncMinTime, syncMaxTime time.Time) (created, orphaned int, err error) {
 	externalIDs := make([]string, 0, len(syncResult.Events))
 
 	for _, ge := range syncResult.Events {
 		// Check if event was cancelled/deleted (only in incremental sync)
 		if ge.Status == "cancelled" {
 			// Mark as orphaned
-			markErr := h.events.MarkOrphanedByExternalIDAndCalendar(ctx, cal.ID, ge.Id)
-			if markErr != nil {
-				log.Printf("Failed to mark event as orphaned: %v", markErr)
+			if err := h.events.MarkOrphanedByExternalIDAndCalendar(ctx, cal.ID, ge.Id); err != nil {
+				return created, orphaned, err
 			}
 			orphaned++
 			continue
@@ -653,9 +987,8 @@ func (h *CalendarHandler) syncSingleCalendar(ctx context.Context, creds *store.O
 		externalIDs = append(externalIDs, ge.Id)
 
 		event := googleEventToStore(ge, conn.ID, cal.ID, userID)
-		_, upsertErr := h.events.Upsert(ctx, event)
-		if upsertErr != nil {
-			return created, updated, orphaned, upsertErr
+		if _, err := h.events.Upsert(ctx, event); err != nil {
+			return created, orphaned, err
 		}
 		created++
 	}
@@ -679,16 +1012,16 @@ func (h *CalendarHandler) syncSingleCalendar(ctx context.Context, creds *store.O
 		// For default range sync, orphan within the full tracked window
 		// For on-demand sync, only orphan within the requested range
 		if isDefaultRangeSync {
-			orphanCount, markErr := h.events.MarkOrphanedInRangeExceptByCalendar(ctx, cal.ID, externalIDs, orphanMinTime, orphanMaxTime)
-			if markErr != nil {
-				return created, updated, orphaned, markErr
+			orphanCount, err := h.events.MarkOrphanedInRangeExceptByCalendar(ctx, cal.ID, externalIDs, orphanMinTime, orphanMaxTime)
+			if err != nil {
+				return created, orphaned, err
 			}
 			orphaned += int(orphanCount)
 		} else {
 			// On-demand sync: only orphan within the specific requested range
-			orphanCount, markErr := h.events.MarkOrphanedInRangeExceptByCalendar(ctx, cal.ID, externalIDs, syncMinTime, syncMaxTime)
-			if markErr != nil {
-				return created, updated, orphaned, markErr
+			orphanCount, err := h.events.MarkOrphanedInRangeExceptByCalendar(ctx, cal.ID, externalIDs, syncMinTime, syncMaxTime)
+			if err != nil {
+				return created, orphaned, err
 			}
 			orphaned += int(orphanCount)
 		}
@@ -696,48 +1029,33 @@ func (h *CalendarHandler) syncSingleCalendar(ctx context.Context, creds *store.O
 
 	// Expand the tracked sync window
 	if syncResult.FullSync {
-		h.calendars.ExpandSyncedWindow(ctx, cal.ID, syncMinTime, syncMaxTime)
+		if err := h.calendars.ExpandSyncedWindow(ctx, cal.ID, syncMinTime, syncMaxTime); err != nil {
+			return created, orphaned, err
+		}
 	}
 
 	// Save the new sync token
 	if syncResult.NextSyncToken != "" {
-		h.calendars.UpdateSyncToken(ctx, cal.ID, syncResult.NextSyncToken)
+		if err := h.calendars.UpdateSyncToken(ctx, cal.ID, syncResult.NextSyncToken); err != nil {
+			return created, orphaned, err
+		}
 	}
 
 	// Update calendar last synced
-	h.calendars.UpdateLastSynced(ctx, cal.ID)
-
-	return created, updated, orphaned, nil
+	return created, orphaned, h.calendars.UpdateLastSynced(ctx, cal.ID)
 }
 
-// batchContiguousWeeks groups contiguous weeks into batches for efficient fetching.
-// This reduces the number of Google Calendar API calls when syncing multiple weeks.
-// For example, weeks [Jan 6, Jan 13, Jan 20, Feb 10, Feb 17] becomes:
-// [[Jan 6, Jan 13, Jan 20], [Feb 10, Feb 17]]
-func batchContiguousWeeks(weeks []time.Time) [][]time.Time {
-	if len(weeks) == 0 {
-		return nil
+// applySuppressionRules hides newly synced events matched by the user's
+// suppression rules. Failures are logged; sync still succeeds.
+func (h *CalendarHandler) applySuppressionRules(ctx context.Context, userID uuid.UUID, cal *store.Calendar) {
+	suppressed, released, err := h.classificationSvc.ApplySuppressionRules(ctx, userID, &cal.ID)
+	if err != nil {
+		log.Printf("[SYNC] suppression_failed: calendar=%s error=%v", redact.Text(cal.Name), err)
+		return
 	}
-
-	var batches [][]time.Time
-	currentBatch := []time.Time{weeks[0]}
-
-	for i := 1; i < len(weeks); i++ {
-		// Weeks are contiguous if they're exactly 7 days apart
-		expected := currentBatch[len(currentBatch)-1].AddDate(0, 0, 7)
-		if weeks[i].Equal(expected) {
-			currentBatch = append(currentBatch, weeks[i])
-		} else {
-			// Non-contiguous, start a new batch
-			batches = append(batches, currentBatch)
-			currentBatch = []time.Time{weeks[i]}
-		}
+	if suppressed > 0 || released > 0 {
+		log.Printf("[SYNC] suppression: calendar=%s suppressed=%d released=%d", redact.Text(cal.Name), suppressed, released)
 	}
-
-	// Don't forget the last batch
-	batches = append(batches, currentBatch)
-
-	return batches
 }
 
 // ListCalendarSources returns all available calendars for a connection
@@ -851,6 +1169,104 @@ func (h *CalendarHandler) UpdateCalendarSources(ctx context.Context, req api.Upd
 	return api.UpdateCalendarSources200JSONResponse(result), nil
 }
 
+// calendarColorPattern matches the hex colors accepted as overrides
+var calendarColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// maxCalendarDisplayName bounds a calendar's display name override
+const maxCalendarDisplayName = 200
+
+// maxCalendarProjectWeight bounds a calendar's project mapping weight, as
+// stored in NUMERIC(4,2)
+const maxCalendarProjectWeight = 100
+
+// UpdateCalendarSource replaces a calendar's display overrides
+func (h *CalendarHandler) UpdateCalendarSource(ctx context.Context, req api.UpdateCalendarSourceRequestObject) (api.UpdateCalendarSourceResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateCalendarSource401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateCalendarSource400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	displayName := req.Body.DisplayName
+	if displayName != nil {
+		trimmed := strings.TrimSpace(*displayName)
+		if len(trimmed) > maxCalendarDisplayName {
+			return api.UpdateCalendarSource400JSONResponse{
+				Code:    "invalid_request",
+				Message: "display_name must be at most 200 characters",
+			}, nil
+		}
+		// A blank name clears the override
+		displayName = nil
+		if trimmed != "" {
+			displayName = &trimmed
+		}
+	}
+	if req.Body.DisplayColor != nil && !calendarColorPattern.MatchString(*req.Body.DisplayColor) {
+		return api.UpdateCalendarSource400JSONResponse{
+			Code:    "invalid_request",
+			Message: "display_color must be a hex color such as #3b82f6",
+		}, nil
+	}
+
+	if w := req.Body.DefaultProjectWeight; w != nil {
+		if req.Body.DefaultProjectId == nil {
+			return api.UpdateCalendarSource400JSONResponse{
+				Code:    "invalid_request",
+				Message: "default_project_weight requires default_project_id",
+			}, nil
+		}
+		if *w <= 0 || *w >= maxCalendarProjectWeight {
+			return api.UpdateCalendarSource400JSONResponse{
+				Code:    "invalid_request",
+				Message: "default_project_weight must be greater than 0 and less than 100",
+			}, nil
+		}
+	}
+
+	cal, err := h.calendars.GetByID(ctx, req.CalendarId)
+	if err != nil && !errors.Is(err, store.ErrCalendarNotFound) {
+		return nil, err
+	}
+	if err != nil || cal.UserID != userID || cal.ConnectionID != req.Id {
+		return api.UpdateCalendarSource404JSONResponse{
+			Code:    "not_found",
+			Message: "Calendar not found",
+		}, nil
+	}
+
+	if req.Body.DefaultProjectId != nil {
+		if _, err := h.readModel.Project(ctx, userID, *req.Body.DefaultProjectId); err != nil {
+			if errors.Is(err, store.ErrProjectNotFound) {
+				return api.UpdateCalendarSource404JSONResponse{
+					Code:    "not_found",
+					Message: "Project not found",
+				}, nil
+			}
+			return nil, err
+		}
+	}
+
+	if err := h.calendars.UpdateOverrides(ctx, userID, cal.ID, displayName, req.Body.DisplayColor, req.Body.DefaultProjectId, req.Body.DefaultProjectWeight); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.calendars.GetByID(ctx, cal.ID)
+	if err != nil {
+		return nil, err
+	}
+	return api.UpdateCalendarSource200JSONResponse(calendarToAPI(updated)), nil
+}
+
 // ListCalendarEvents returns events with filters.
 // This endpoint transparently handles on-demand sync when the requested date range
 // is outside the current water marks. The client never needs to know about water marks.
@@ -892,9 +1308,12 @@ func (h *CalendarHandler) ListCalendarEvents(ctx context.Context, req api.ListCa
 		return nil, err
 	}
 
-	result := make([]api.CalendarEvent, len(events))
-	for i, e := range events {
-		result[i] = calendarEventToAPI(e)
+	result := make([]api.CalendarEvent, 0, len(events))
+	for _, e := range events {
+		if req.Params.Tag != nil && !store.HasTag(e.Tags, *req.Params.Tag) {
+			continue
+		}
+		result = append(result, calendarEventToAPI(e))
 	}
 
 	return api.ListCalendarEvents200JSONResponse(result), nil
@@ -911,10 +1330,23 @@ func (h *CalendarHandler) ensureEventsInRange(ctx context.Context, userID uuid.U
 	}
 
 	// Normalize to week boundaries for consistent water mark handling
-	targetStart := sync.NormalizeToWeekStart(startDate)
-	targetEnd := sync.NormalizeToWeekEnd(endDate)
+	rangeStart := sync.NormalizeToWeekStart(startDate)
+	rangeEnd := sync.NormalizeToWeekEnd(endDate)
+	now := time.Now()
+	cadence := h.cadence(ctx, userID)
 
 	for _, conn := range connections {
+		// A paused connection serves the events it already has
+		if conn.PausedAt != nil {
+			continue
+		}
+
+		// Never fetch beyond the connection's sync window
+		targetStart, targetEnd, inWindow := h.syncBounds(conn).Clamp(rangeStart, rangeEnd, now)
+		if !inWindow {
+			continue
+		}
+
 		// Get selected calendars for this connection
 		calendars, err := h.calendars.ListSelectedByConnection(ctx, conn.ID)
 		if err != nil {
@@ -925,13 +1357,13 @@ func (h *CalendarHandler) ensureEventsInRange(ctx context.Context, userID uuid.U
 		// Check if any calendars need sync before fetching credentials
 		var calendarsNeedingSync []*store.Calendar
 		for _, cal := range calendars {
-			// Skip calendars that need re-auth or have too many failures
-			if cal.NeedsReauth || cal.SyncFailureCount >= 3 {
+			// Skip calendars that need re-auth, were disabled or have too many failures
+			if cal.NeedsReauth || cal.SyncDisabledAt != nil || cal.SyncFailureCount >= 3 {
 				continue
 			}
 
 			// Check if requested range is outside water marks
-			decision := sync.DecideSync(cal.MinSyncedDate, cal.MaxSyncedDate, cal.LastSyncedAt, targetStart, targetEnd)
+			decision := cadence.DecideSync(cal.MinSyncedDate, cal.MaxSyncedDate, cal.LastSyncedAt, targetStart, targetEnd)
 			if decision.NeedsSync {
 				calendarsNeedingSync = append(calendarsNeedingSync, cal)
 			}
@@ -950,18 +1382,18 @@ func (h *CalendarHandler) ensureEventsInRange(ctx context.Context, userID uuid.U
 		}
 
 		for _, cal := range calendarsNeedingSync {
-			decision := sync.DecideSync(cal.MinSyncedDate, cal.MaxSyncedDate, cal.LastSyncedAt, targetStart, targetEnd)
+			decision := cadence.DecideSync(cal.MinSyncedDate, cal.MaxSyncedDate, cal.LastSyncedAt, targetStart, targetEnd)
 
 			log.Printf("[SYNC] on-demand fetch needed: calendar=%s reason=%s range=%s to %s",
-				cal.Name, decision.Reason, targetStart.Format("2006-01-02"), targetEnd.Format("2006-01-02"))
+				redact.Text(cal.Name), decision.Reason, targetStart.Format("2006-01-02"), targetEnd.Format("2006-01-02"))
 
 			// Refresh token if needed
 			creds := &fullConn.Credentials
 			if time.Now().After(creds.Expiry.Add(-5 * time.Minute)) {
 				newCreds, err := h.google.RefreshToken(ctx, creds)
 				if err != nil {
-					log.Printf("[SYNC] token refresh failed for calendar %s: %v", cal.Name, err)
-					h.calendars.MarkNeedsReauth(ctx, cal.ID)
+					log.Printf("[SYNC] token refresh failed for calendar %s: %v", redact.Text(cal.Name), err)
+					sync.RecordFailure(ctx, h.calendars, cal, err)
 					continue
 				}
 				creds = newCreds
@@ -969,10 +1401,18 @@ func (h *CalendarHandler) ensureEventsInRange(ctx context.Context, userID uuid.U
 			}
 
 			// Fetch events synchronously for the requested range
-			_, _, _, err = h.syncSingleCalendar(ctx, creds, fullConn, cal, userID, &targetStart, &targetEnd)
+			err = sync.WithCalendarLease(ctx, h.calendars, cal.ID, func() error {
+				_, _, _, err := h.syncSingleCalendar(ctx, creds, fullConn, cal, userID, &targetStart, &targetEnd)
+				return err
+			})
+			if errors.Is(err, sync.ErrSyncInProgress) {
+				// The sync already running will bring the calendar up to date
+				log.Printf("[SYNC] on-demand fetch skipped for calendar %s: sync in progress", redact.Text(cal.Name))
+				continue
+			}
 			if err != nil {
-				log.Printf("[SYNC] on-demand fetch failed for calendar %s: %v", cal.Name, err)
-				h.calendars.IncrementSyncFailureCount(ctx, cal.ID)
+				category := sync.RecordFailure(ctx, h.calendars, cal, err)
+				log.Printf("[SYNC] on-demand fetch failed for calendar %s: category=%s error=%v", redact.Text(cal.Name), category, err)
 				continue
 			}
 
@@ -988,7 +1428,7 @@ func (h *CalendarHandler) ensureEventsInRange(ctx context.Context, userID uuid.U
 				jobMaxDate := decision.MissingWeeks[len(decision.MissingWeeks)-1].AddDate(0, 0, 6) // End of last week (Sunday)
 
 				log.Printf("[SYNC] queuing background job to fill gap: calendar=%s range=%s to %s (%d weeks)",
-					cal.Name, jobMinDate.Format("2006-01-02"), jobMaxDate.Format("2006-01-02"), len(decision.MissingWeeks))
+					redact.Text(cal.Name), jobMinDate.Format("2006-01-02"), jobMaxDate.Format("2006-01-02"), len(decision.MissingWeeks))
 
 				job := &store.SyncJob{
 					CalendarID:    cal.ID,
@@ -998,26 +1438,17 @@ func (h *CalendarHandler) ensureEventsInRange(ctx context.Context, userID uuid.U
 					Priority:      10, // High priority for user-initiated
 				}
 				if _, err := h.syncJobs.Create(ctx, job); err != nil {
-					log.Printf("[SYNC] failed to queue background job for calendar %s: %v", cal.Name, err)
+					log.Printf("[SYNC] failed to queue background job for calendar %s: %v", redact.Text(cal.Name), err)
 				}
 			}
 		}
 	}
 
-	// Auto-apply classification rules to newly synced events in the requested range
-	// This ensures events fetched by on-demand sync get classified like regular sync
-	if h.classificationSvc != nil {
-		projects, err := h.projects.List(ctx, userID, false)
-		if err != nil {
-			log.Printf("[SYNC] on-demand: failed to fetch projects for classification: %v", err)
-		} else if len(projects) > 0 {
-			targets := projectsToTargetsWithNames(projects)
-			result, err := h.classificationSvc.ApplyRules(ctx, userID, targets, &startDate, &endDate, false)
-			if err != nil {
-				log.Printf("[SYNC] on-demand: failed to apply classification rules: %v", err)
-			} else if len(result.Classified) > 0 {
-				log.Printf("[SYNC] on-demand: auto_classified=%d events", len(result.Classified))
-			}
+	// Classify the events this sync fetched before the caller lists them,
+	// rather than waiting for the reclassifier's delay
+	if h.reclassifier != nil {
+		if err := h.reclassifier.Flush(ctx, userID); err != nil {
+			log.Printf("[SYNC] on-demand: failed to apply classification rules: %v", err)
 		}
 	}
 
@@ -1065,16 +1496,30 @@ func (h *CalendarHandler) ClassifyCalendarEvent(ctx context.Context, req api.Cla
 		}, nil
 	}
 
-	// Update the event's classification
-	updatedEvent, err := h.events.Classify(ctx, userID, req.Id, projectID, isSkip)
+	// Update the event's classification and journal the change so it can
+	// be undone, together: a change without its undo record is unrecoverable
+	var response api.ClassifyCalendarEvent200JSONResponse
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		updatedEvent, err := h.events.Classify(ctx, userID, req.Id, projectID, isSkip)
+		if err != nil {
+			return err
+		}
+		response.Event = calendarEventToAPI(updatedEvent)
+
+		action, err := h.classificationSvc.RecordAction(ctx, userID, store.ActionKindClassify,
+			fmt.Sprintf("Classify %q", event.Title), []store.EventClassificationState{store.ClassificationStateOf(event)})
+		if err != nil {
+			return err
+		}
+		if action != nil {
+			response.ActionId = &action.ID
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := api.ClassifyCalendarEvent200JSONResponse{
-		Event: calendarEventToAPI(updatedEvent),
-	}
-
 	// With ephemeral time entries, we don't reactively create/update entries.
 	// Instead, compute the current entry value for this project/date.
 	if !isSkip && projectID != nil {
@@ -1147,51 +1592,66 @@ func (h *CalendarHandler) BulkClassifyEvents(ctx context.Context, req api.BulkCl
 		return api.BulkClassifyEvents400JSONResponse{
 			Code:    "invalid_query",
 			Message: err.Error(),
+			Details: queryErrorDetails(err),
 		}, nil
 	}
 
-	var classifiedCount, skippedCount int
-	affectedDates := make(map[time.Time]bool)
+	// With ephemeral time entries, we don't reactively create/update entries.
+	// Time entries are computed on-demand when ListTimeEntries is called.
+	var response api.BulkClassifyEvents200JSONResponse
+
+	// Classify every match and journal the batch in one transaction, so a
+	// failure can't leave some events changed with no way to undo them
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		response = api.BulkClassifyEvents200JSONResponse{}
+		var prior []store.EventClassificationState
+
+		// Process each matching event
+		for _, match := range preview.Matches {
+			// Get the full event to record its prior state
+			event, err := h.events.GetByID(ctx, userID, match.EventID)
+			if errors.Is(err, store.ErrCalendarEventNotFound) {
+				continue // Deleted since the preview
+			} else if err != nil {
+				return err
+			}
 
-	// Process each matching event
-	for _, match := range preview.Matches {
-		// Get the full event for duration calculation
-		event, err := h.events.GetByID(ctx, userID, match.EventID)
-		if err != nil {
-			continue // Skip events we can't fetch
-		}
+			// Skip manually classified events - we don't override those
+			if event.ClassificationSource != nil && *event.ClassificationSource == store.SourceManual {
+				continue
+			}
 
-		// Skip manually classified events - we don't override those
-		if event.ClassificationSource != nil && *event.ClassificationSource == store.SourceManual {
-			continue
+			// Classify the event
+			_, err = h.events.Classify(ctx, userID, match.EventID, req.Body.ProjectId, isSkip)
+			if errors.Is(err, store.ErrCalendarEventNotFound) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			prior = append(prior, store.ClassificationStateOf(event))
+
+			if isSkip {
+				response.SkippedCount++
+			} else {
+				response.ClassifiedCount++
+			}
 		}
 
-		// Classify the event
-		_, err = h.events.Classify(ctx, userID, match.EventID, req.Body.ProjectId, isSkip)
+		action, err := h.classificationSvc.RecordAction(ctx, userID, store.ActionKindBulkClassify,
+			fmt.Sprintf("Bulk classify %q", req.Body.Query), prior)
 		if err != nil {
-			continue
+			return err
 		}
-
-		// Track affected date for recalculation
-		eventDate := time.Date(event.StartTime.Year(), event.StartTime.Month(), event.StartTime.Day(), 0, 0, 0, 0, time.UTC)
-		affectedDates[eventDate] = true
-
-		if isSkip {
-			skippedCount++
-		} else {
-			classifiedCount++
+		if action != nil {
+			response.ActionId = &action.ID
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// With ephemeral time entries, we don't reactively create/update entries.
-	// Time entries are computed on-demand when ListTimeEntries is called.
-	// The affectedDates tracking is no longer needed for time entry creation.
-
-	return api.BulkClassifyEvents200JSONResponse{
-		ClassifiedCount: classifiedCount,
-		SkippedCount:    skippedCount,
-		// TimeEntriesCreated is no longer relevant with ephemeral model
-	}, nil
+	return response, nil
 }
 
 // ExplainEventClassification explains how an event was or would be classified
@@ -1217,7 +1677,7 @@ func (h *CalendarHandler) ExplainEventClassification(ctx context.Context, req ap
 	}
 
 	// Get projects to build targets (including name for display)
-	projects, err := h.projects.List(ctx, userID, false)
+	projects, err := h.readModel.Projects(ctx, userID, false)
 	if err != nil {
 		return nil, err
 	}
@@ -1230,11 +1690,7 @@ func (h *CalendarHandler) ExplainEventClassification(ctx context.Context, req ap
 		return nil, err
 	}
 
-	// Build project name map for lookups
-	projectNames := make(map[string]string)
-	for _, p := range projects {
-		projectNames[p.ID.String()] = p.Name
-	}
+	projectNames := projectNamesByID(projects)
 
 	// Convert result to API response
 	response := api.ClassificationExplanation{
@@ -1281,6 +1737,10 @@ func (h *CalendarHandler) ExplainEventClassification(ctx context.Context, req ap
 		score.RuleWeight = &ruleWeight
 		fpWeight := float32(ts.FingerprintWeight)
 		score.FingerprintWeight = &fpWeight
+		if ts.ExclusionWeight != 0 {
+			exclusionWeight := float32(ts.ExclusionWeight)
+			score.ExclusionWeight = &exclusionWeight
+		}
 		score.IsWinner = &ts.IsWinner
 		response.TargetScores = append(response.TargetScores, score)
 	}
@@ -1328,20 +1788,120 @@ func (h *CalendarHandler) ExplainEventClassification(ctx context.Context, req ap
 	return api.ExplainEventClassification200JSONResponse(response), nil
 }
 
+// ListEventChanges returns the fields re-syncs changed on an event, newest first
+func (h *CalendarHandler) ListEventChanges(ctx context.Context, req api.ListEventChangesRequestObject) (api.ListEventChangesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListEventChanges401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	changes, err := h.events.ListChanges(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarEventNotFound) {
+			return api.ListEventChanges404JSONResponse{
+				Code:    "not_found",
+				Message: "Event not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]api.CalendarEventChange, len(changes))
+	for i, c := range changes {
+		result[i] = api.CalendarEventChange{
+			Id:       c.ID,
+			EventId:  c.EventID,
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+			SyncedAt: c.SyncedAt,
+		}
+	}
+
+	return api.ListEventChanges200JSONResponse(result), nil
+}
+
+// ListEventClassificationHistory returns every classification an event
+// received, newest first
+func (h *CalendarHandler) ListEventClassificationHistory(ctx context.Context, req api.ListEventClassificationHistoryRequestObject) (api.ListEventClassificationHistoryResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListEventClassificationHistory401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	entries, err := h.events.ListClassificationHistory(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarEventNotFound) {
+			return api.ListEventClassificationHistory404JSONResponse{
+				Code:    "not_found",
+				Message: "Event not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]api.EventClassificationHistoryEntry, len(entries))
+	for i, e := range entries {
+		result[i] = api.EventClassificationHistoryEntry{
+			Id:           e.ID,
+			EventId:      e.EventID,
+			ProjectId:    e.ProjectID,
+			Source:       api.EventClassificationHistoryEntrySource(e.Source),
+			IsSkipped:    e.IsSkipped,
+			ClassifiedAt: e.ClassifiedAt,
+		}
+		if e.Confidence != nil {
+			confidence := float32(*e.Confidence)
+			result[i].Confidence = &confidence
+		}
+		if e.Votes != nil {
+			votes := make([]api.EventClassificationVote, len(e.Votes))
+			for j, v := range e.Votes {
+				votes[j] = api.EventClassificationVote{
+					RuleId:    v.RuleID,
+					ProjectId: v.ProjectID,
+					Weight:    float32(v.Weight),
+					Source:    api.EventClassificationVoteSource(v.Source),
+				}
+			}
+			result[i].RuleVotes = &votes
+		}
+	}
+
+	return api.ListEventClassificationHistory200JSONResponse(result), nil
+}
+
 // projectsToTargetsWithNames creates classification targets with project names included
 func projectsToTargetsWithNames(projects []*store.Project) []classification.Target {
 	targets := make([]classification.Target, len(projects))
 	for i, p := range projects {
 		attrs := make(map[string]any)
 		attrs["name"] = p.Name
-		if len(p.FingerprintDomains) > 0 {
-			attrs["domains"] = p.FingerprintDomains
-		}
-		if len(p.FingerprintEmails) > 0 {
-			attrs["emails"] = p.FingerprintEmails
-		}
-		if len(p.FingerprintKeywords) > 0 {
-			attrs["keywords"] = p.FingerprintKeywords
+		if p.FingerprintsActive() {
+			if len(p.FingerprintDomains) > 0 {
+				attrs["domains"] = p.FingerprintDomains
+			}
+			if len(p.FingerprintEmails) > 0 {
+				attrs["emails"] = p.FingerprintEmails
+			}
+			if len(p.FingerprintKeywords) > 0 {
+				attrs["keywords"] = p.FingerprintKeywords
+			}
+			if len(p.FingerprintExcludeDomains) > 0 {
+				attrs["exclude_domains"] = p.FingerprintExcludeDomains
+			}
+			if len(p.FingerprintExcludeKeywords) > 0 {
+				attrs["exclude_keywords"] = p.FingerprintExcludeKeywords
+			}
+			if len(p.FingerprintWeights) > 0 {
+				attrs["fingerprint_weights"] = p.FingerprintWeights
+			}
 		}
 		targets[i] = classification.Target{
 			ID:         p.ID.String(),
@@ -1351,6 +1911,16 @@ func projectsToTargetsWithNames(projects []*store.Project) []classification.Targ
 	return targets
 }
 
+// projectNamesByID indexes project names by project ID string, so results
+// that reference many targets can be rendered without a lookup per target
+func projectNamesByID(projects []*store.Project) map[string]string {
+	names := make(map[string]string, len(projects))
+	for _, p := range projects {
+		names[p.ID.String()] = p.Name
+	}
+	return names
+}
+
 // calendarConnectionToAPI converts store model to API model
 func calendarConnectionToAPI(c *store.CalendarConnection) api.CalendarConnection {
 	conn := api.CalendarConnection{
@@ -1363,6 +1933,20 @@ func calendarConnectionToAPI(c *store.CalendarConnection) api.CalendarConnection
 	if c.LastSyncedAt != nil {
 		conn.LastSyncedAt = c.LastSyncedAt
 	}
+	conn.SyncHistoryDays = c.SyncHistoryDays
+	conn.SyncFutureDays = c.SyncFutureDays
+	scopes := append([]string{}, c.GrantedScopes...)
+	conn.GrantedScopes = &scopes
+	access := []api.GoogleAccess{}
+	for _, a := range google.GrantedAccess(c.GrantedScopes) {
+		access = append(access, api.GoogleAccess(a))
+	}
+	conn.Access = &access
+	if c.WriteBack != "" {
+		writeBack := api.CalendarWriteBackMode(c.WriteBack)
+		conn.WriteBack = &writeBack
+	}
+	conn.PausedAt = c.PausedAt
 	return conn
 }
 
@@ -1387,12 +1971,14 @@ func calendarEventToAPI(e *store.CalendarEvent) api.CalendarEvent {
 		ClassificationStatus: api.CalendarEventClassificationStatus(e.ClassificationStatus),
 		IsSkipped:            &e.IsSkipped,
 		NeedsReview:          &e.NeedsReview,
+		DurationChangedAt:    e.DurationChangedAt,
 		ProjectId:            e.ProjectID,
 		CreatedAt:            e.CreatedAt,
 		UpdatedAt:            &e.UpdatedAt,
 		CalendarId:           e.CalendarExternalID,
 		CalendarName:         e.CalendarName,
 		CalendarColor:        e.CalendarColor,
+		IsInternal:           e.IsInternal,
 	}
 	if e.ClassificationSource != nil {
 		src := api.CalendarEventClassificationSource(*e.ClassificationSource)
@@ -1406,6 +1992,9 @@ func calendarEventToAPI(e *store.CalendarEvent) api.CalendarEvent {
 		proj := projectToAPI(e.Project)
 		event.Project = &proj
 	}
+	if len(e.Tags) > 0 {
+		event.Tags = &e.Tags
+	}
 	return event
 }
 
@@ -1426,6 +2015,16 @@ func calendarToAPI(c *store.Calendar) api.Calendar {
 	if c.LastSyncedAt != nil {
 		cal.LastSyncedAt = c.LastSyncedAt
 	}
+	cal.DisplayName = c.DisplayName
+	cal.DisplayColor = c.DisplayColor
+	cal.DefaultProjectId = c.DefaultProjectID
+	cal.DefaultProjectWeight = c.DefaultProjectWeight
+	if c.SyncFailureCategory != nil {
+		category := api.CalendarSyncFailureCategory(*c.SyncFailureCategory)
+		cal.SyncFailureCategory = &category
+	}
+	cal.SyncError = c.SyncLastError
+	cal.SyncDisabledAt = c.SyncDisabledAt
 	cal.UpdatedAt = &c.UpdatedAt
 	return cal
 }
@@ -1467,6 +2066,7 @@ func googleEventToStore(ge *gcal.Event, connID, calID uuid.UUID, userID uuid.UUI
 	attendeeSet := make(map[string]bool)
 	for _, a := range ge.Attendees {
 		event.Attendees = append(event.Attendees, a.Email)
+		event.AttendeeDetails = append(event.AttendeeDetails, attendeeDetail(a))
 		attendeeSet[a.Email] = true
 		// If this is the current user (Self=true), capture their response status
 		if a.Self && a.ResponseStatus != "" {
@@ -1478,6 +2078,9 @@ func googleEventToStore(ge *gcal.Event, connID, calID uuid.UUID, userID uuid.UUI
 	// Google Calendar doesn't always include the organizer in the attendees list
 	if ge.Organizer != nil && ge.Organizer.Email != "" && !attendeeSet[ge.Organizer.Email] {
 		event.Attendees = append(event.Attendees, ge.Organizer.Email)
+		organizer := store.NewEventAttendee(ge.Organizer.Email)
+		organizer.IsOrganizer = true
+		event.AttendeeDetails = append(event.AttendeeDetails, organizer)
 	}
 
 	event.IsRecurring = ge.RecurringEventId != ""
@@ -1488,3 +2091,14 @@ func googleEventToStore(ge *gcal.Event, connID, calID uuid.UUID, userID uuid.UUI
 
 	return event
 }
+
+// attendeeDetail converts a Google attendee to a normalized attendee row
+func attendeeDetail(a *gcal.EventAttendee) store.EventAttendee {
+	detail := store.NewEventAttendee(a.Email)
+	if a.ResponseStatus != "" {
+		response := a.ResponseStatus
+		detail.ResponseStatus = &response
+	}
+	detail.IsOrganizer = a.Organizer
+	return detail
+}
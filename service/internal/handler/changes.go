@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// ChangeFeedRetention is how long changes stay in the polling feed. A client
+// whose cursor is older than this misses the pruned changes.
+const ChangeFeedRetention = 30 * 24 * time.Hour
+
+// changeFeedResourceTypes are the accepted resource_type filters
+var changeFeedResourceTypes = map[string]bool{
+	store.ChangeResourceCalendarEvent: true,
+	store.ChangeResourceTimeEntry:     true,
+	store.ChangeResourceInvoice:       true,
+}
+
+// ChangeFeedHandler implements the polling change feed
+type ChangeFeedHandler struct {
+	changes *store.ChangeFeedStore
+}
+
+// NewChangeFeedHandler creates a new change feed handler
+func NewChangeFeedHandler(changes *store.ChangeFeedStore) *ChangeFeedHandler {
+	return &ChangeFeedHandler{changes: changes}
+}
+
+// ListChanges returns the changes after a cursor
+func (h *ChangeFeedHandler) ListChanges(ctx context.Context, req api.ListChangesRequestObject) (api.ListChangesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListChanges401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var since store.ChangeCursor
+	if req.Params.Since != nil && *req.Params.Since != "" {
+		cursor, err := store.ParseChangeCursor(*req.Params.Since)
+		if err != nil {
+			return api.ListChanges400JSONResponse{
+				Code:    "invalid_request",
+				Message: "since is not a valid cursor",
+			}, nil
+		}
+		since = cursor
+	}
+
+	resourceType := ""
+	if req.Params.ResourceType != nil {
+		resourceType = *req.Params.ResourceType
+		if !changeFeedResourceTypes[resourceType] {
+			return api.ListChanges400JSONResponse{
+				Code:    "invalid_request",
+				Message: "resource_type must be calendar_event, time_entry or invoice",
+			}, nil
+		}
+	}
+
+	limit := 100
+	if req.Params.Limit != nil && *req.Params.Limit > 0 && *req.Params.Limit <= 500 {
+		limit = *req.Params.Limit
+	}
+
+	entries, hasMore, err := h.changes.List(ctx, userID, since, resourceType, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := api.ChangeFeedPage{
+		Changes:    make([]api.ChangeFeedEntry, len(entries)),
+		NextCursor: since.String(),
+		HasMore:    hasMore,
+	}
+	for i, e := range entries {
+		page.Changes[i] = api.ChangeFeedEntry{
+			ResourceType: e.ResourceType,
+			ResourceId:   e.ResourceID,
+			Operation:    e.Operation,
+			ChangedAt:    e.ChangedAt,
+		}
+	}
+	if len(entries) > 0 {
+		page.NextCursor = entries[len(entries)-1].Cursor.String()
+	}
+
+	return api.ListChanges200JSONResponse(page), nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestChangeFeedHandler_Validation(t *testing.T) {
+	h := NewChangeFeedHandler(nil)
+
+	resp, err := h.ListChanges(context.Background(), api.ListChangesRequestObject{})
+	if err != nil {
+		t.Fatalf("ListChanges: %v", err)
+	}
+	if _, ok := resp.(api.ListChanges401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+
+	ctx := authedContext(uuid.New())
+	str := func(s string) *string { return &s }
+	for _, params := range []api.ListChangesParams{
+		{Since: str("not-a-cursor")},
+		{Since: str("12.-1")},
+		{ResourceType: str("project")},
+	} {
+		resp, err := h.ListChanges(ctx, api.ListChangesRequestObject{Params: params})
+		if err != nil {
+			t.Fatalf("ListChanges: %v", err)
+		}
+		if _, ok := resp.(api.ListChanges400JSONResponse); !ok {
+			t.Errorf("expected 400 for %+v, got %T", params, resp)
+		}
+	}
+}
+
+func TestChangeCursor_RoundTrip(t *testing.T) {
+	cursor := store.ChangeCursor{XID: 74211, Seq: 908}
+	parsed, err := store.ParseChangeCursor(cursor.String())
+	if err != nil {
+		t.Fatalf("ParseChangeCursor: %v", err)
+	}
+	if parsed != cursor {
+		t.Errorf("expected %+v, got %+v", cursor, parsed)
+	}
+}
@@ -0,0 +1,58 @@
+//go:build integration
+
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestEventClassificationHistory classifies a synced event through the
+// calendar handler and reads its history back
+func TestEventClassificationHistory(t *testing.T) {
+	s := newSyncHarness(t)
+	start := time.Now().UTC().AddDate(0, 0, -1).Truncate(time.Hour)
+	s.fake.PutEvent("primary", e2eEvent("planning", "Planning", start))
+	s.sync(nil)
+	eventID := s.eventID("planning")
+
+	project, err := store.NewProjectStore(s.pool).Create(s.ctx, s.calendar().UserID, "Acme", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	if _, err := s.handler.ClassifyCalendarEvent(s.ctx, api.ClassifyCalendarEventRequestObject{
+		Id:   eventID,
+		Body: &api.ClassifyEventRequest{ProjectId: &project.ID},
+	}); err != nil {
+		t.Fatalf("ClassifyCalendarEvent: %v", err)
+	}
+
+	resp, err := s.handler.ListEventClassificationHistory(s.ctx, api.ListEventClassificationHistoryRequestObject{Id: eventID})
+	if err != nil {
+		t.Fatalf("ListEventClassificationHistory: %v", err)
+	}
+	history, ok := resp.(api.ListEventClassificationHistory200JSONResponse)
+	if !ok || len(history) != 1 {
+		t.Fatalf("expected one history entry, got %#v", resp)
+	}
+	entry := history[0]
+	if entry.EventId != eventID || entry.ProjectId == nil || *entry.ProjectId != project.ID || entry.Source != api.EventClassificationHistoryEntrySource(store.SourceManual) {
+		t.Errorf("unexpected history entry: %#v", entry)
+	}
+	if entry.Confidence == nil || *entry.Confidence != 1 || entry.RuleVotes != nil {
+		t.Errorf("expected a manual entry at full confidence without votes, got %v, %v", entry.Confidence, entry.RuleVotes)
+	}
+
+	missing, err := s.handler.ListEventClassificationHistory(s.ctx, api.ListEventClassificationHistoryRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("ListEventClassificationHistory: %v", err)
+	}
+	if _, ok := missing.(api.ListEventClassificationHistory404JSONResponse); !ok {
+		t.Errorf("expected 404 for an unknown event, got %T", missing)
+	}
+}
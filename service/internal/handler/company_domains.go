@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+)
+
+// maxCompanyDomains bounds how many domains a user can declare
+const maxCompanyDomains = 50
+
+// GetCompanyDomains returns the email domains of the user's own organization
+func (h *AuthHandler) GetCompanyDomains(ctx context.Context, req api.GetCompanyDomainsRequestObject) (api.GetCompanyDomainsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetCompanyDomains401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	domains, err := h.users.GetCompanyDomains(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if domains == nil {
+		domains = []string{}
+	}
+	return api.GetCompanyDomains200JSONResponse{Domains: domains}, nil
+}
+
+// UpdateCompanyDomains replaces the email domains of the user's own
+// organization
+func (h *AuthHandler) UpdateCompanyDomains(ctx context.Context, req api.UpdateCompanyDomainsRequestObject) (api.UpdateCompanyDomainsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateCompanyDomains401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateCompanyDomains400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	domains, err := normalizeCompanyDomains(req.Body.Domains)
+	if err != nil {
+		return api.UpdateCompanyDomains400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := h.users.SetCompanyDomains(ctx, userID, domains); err != nil {
+		return nil, err
+	}
+	return api.UpdateCompanyDomains200JSONResponse{Domains: domains}, nil
+}
+
+// normalizeCompanyDomains lower-cases and validates domains, dropping
+// duplicates and any leading @
+func normalizeCompanyDomains(values []string) ([]string, error) {
+	if len(values) > maxCompanyDomains {
+		return nil, fmt.Errorf("at most %d domains are allowed", maxCompanyDomains)
+	}
+	domains := make([]string, 0, len(values))
+	for _, v := range values {
+		domain := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(v)), "@")
+		if !fingerprintDomainPattern.MatchString(domain) {
+			return nil, fmt.Errorf("%q is not a valid domain", v)
+		}
+		if !slices.Contains(domains, domain) {
+			domains = append(domains, domain)
+		}
+	}
+	return domains, nil
+}
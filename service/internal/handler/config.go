@@ -322,6 +322,19 @@ func projectToExport(p *store.Project) api.ProjectExport {
 	if len(p.FingerprintKeywords) > 0 {
 		export.FingerprintKeywords = &p.FingerprintKeywords
 	}
+	if len(p.FingerprintExcludeDomains) > 0 {
+		export.FingerprintExcludeDomains = &p.FingerprintExcludeDomains
+	}
+	if len(p.FingerprintExcludeKeywords) > 0 {
+		export.FingerprintExcludeKeywords = &p.FingerprintExcludeKeywords
+	}
+	if len(p.FingerprintWeights) > 0 {
+		weights := fingerprintWeightsToAPI(p.FingerprintWeights)
+		export.FingerprintWeights = &weights
+	}
+	if len(p.GitHubRepos) > 0 {
+		export.GithubRepos = &p.GitHubRepos
+	}
 
 	return export
 }
@@ -382,6 +395,22 @@ func projectExportToUpdates(p *api.ProjectExport) map[string]interface{} {
 	if p.FingerprintKeywords != nil {
 		updates["fingerprint_keywords"] = *p.FingerprintKeywords
 	}
+	if p.FingerprintExcludeDomains != nil {
+		updates["fingerprint_exclude_domains"] = *p.FingerprintExcludeDomains
+	}
+	if p.FingerprintExcludeKeywords != nil {
+		updates["fingerprint_exclude_keywords"] = *p.FingerprintExcludeKeywords
+	}
+	if p.FingerprintWeights != nil {
+		weights := make(map[string]float64, len(*p.FingerprintWeights))
+		for k, w := range *p.FingerprintWeights {
+			weights[k] = float64(w)
+		}
+		updates["fingerprint_weights"] = weights
+	}
+	if p.GithubRepos != nil {
+		updates["github_repos"] = *p.GithubRepos
+	}
 
 	return updates
 }
@@ -390,3 +419,8 @@ func projectExportToUpdates(p *api.ProjectExport) map[string]interface{} {
 func ptrFloat32(f float32) *float32 {
 	return &f
 }
+
+// ptrFloat64 returns a pointer to the given float64
+func ptrFloat64(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+)
+
+// DayHandler serves the single-call summaries behind the daily view and the
+// weekly timesheet grid
+type DayHandler struct {
+	calendar         *CalendarHandler
+	events           *store.CalendarEventStore
+	projects         ProjectStore
+	timeEntryService *timeentry.Service
+}
+
+// NewDayHandler creates a new day handler. The calendar handler is used to
+// sync events outside the synced range, as listing events does.
+func NewDayHandler(calendar *CalendarHandler, events *store.CalendarEventStore, projects ProjectStore, timeEntryService *timeentry.Service) *DayHandler {
+	return &DayHandler{
+		calendar:         calendar,
+		events:           events,
+		projects:         projects,
+		timeEntryService: timeEntryService,
+	}
+}
+
+// GetDaySummary returns a day's events, entries, totals, locks and pending
+// count in one payload
+func (h *DayHandler) GetDaySummary(ctx context.Context, req api.GetDaySummaryRequestObject) (api.GetDaySummaryResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetDaySummary401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	day := req.Date.Time
+
+	h.syncRange(ctx, userID, day, day)
+
+	events, err := h.events.List(ctx, userID, &day, &day, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := h.timeEntryService.ListWithEphemeral(ctx, userID, &day, &day, nil)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := h.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetDaySummary200JSONResponse(buildDaySummary(req.Date, events, entries, projects)), nil
+}
+
+// syncRange fetches events outside the synced range before they are read.
+// Failures are logged and whatever is cached is served, as listing events does.
+func (h *DayHandler) syncRange(ctx context.Context, userID uuid.UUID, start, end time.Time) {
+	if h.calendar.google == nil {
+		return
+	}
+	if err := h.calendar.ensureEventsInRange(ctx, userID, start, end); err != nil {
+		log.Printf("[SYNC] ensureEventsInRange failed: %v", err)
+	}
+}
+
+func buildDaySummary(day openapi_types.Date, events []*store.CalendarEvent, entries []*store.TimeEntry, projects []*store.Project) api.DaySummary {
+	summary := api.DaySummary{
+		Date:           day,
+		Events:         make([]api.CalendarEvent, len(events)),
+		Entries:        make([]api.TimeEntry, len(entries)),
+		LockedEntryIds: []openapi_types.UUID{},
+		Totals:         api.DayTotals{Projects: []api.DayProjectTotal{}},
+	}
+
+	for i, e := range events {
+		summary.Events[i] = calendarEventToAPI(e)
+		// Suppressed events never enter the pending queue
+		if e.ClassificationStatus == store.StatusPending && !e.IsSuppressed {
+			summary.PendingCount++
+		}
+	}
+
+	byID := make(map[uuid.UUID]*store.Project, len(projects))
+	for _, p := range projects {
+		byID[p.ID] = p
+	}
+	projectTotals := make(map[uuid.UUID]int)
+	for i, e := range entries {
+		summary.Entries[i] = timeEntryToAPI(e)
+		if e.InvoiceID != nil {
+			summary.LockedEntryIds = append(summary.LockedEntryIds, e.ID)
+		}
+
+		p := byID[e.ProjectID]
+		if p == nil || p.DoesNotAccumulateHours {
+			continue
+		}
+		hours := float32(e.Hours)
+		summary.Totals.Hours += hours
+		if p.IsBillable {
+			summary.Totals.BillableHours += hours
+		}
+		idx, ok := projectTotals[p.ID]
+		if !ok {
+			idx = len(summary.Totals.Projects)
+			projectTotals[p.ID] = idx
+			color := p.Color
+			summary.Totals.Projects = append(summary.Totals.Projects, api.DayProjectTotal{
+				ProjectId:   p.ID,
+				ProjectName: p.Name,
+				Color:       &color,
+				IsBillable:  p.IsBillable,
+			})
+		}
+		summary.Totals.Projects[idx].Hours += hours
+	}
+	sort.SliceStable(summary.Totals.Projects, func(i, j int) bool {
+		return summary.Totals.Projects[i].Hours > summary.Totals.Projects[j].Hours
+	})
+
+	summary.Locked = len(entries) > 0 && len(summary.LockedEntryIds) == len(entries)
+	return summary
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestBuildDaySummary(t *testing.T) {
+	day := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	billable := &store.Project{ID: uuid.New(), Name: "Acme", Color: "#ff0000", IsBillable: true}
+	internal := &store.Project{ID: uuid.New(), Name: "Internal", Color: "#00ff00"}
+	travel := &store.Project{ID: uuid.New(), Name: "Travel", DoesNotAccumulateHours: true}
+	invoiceID := uuid.New()
+
+	events := []*store.CalendarEvent{
+		{ID: uuid.New(), ClassificationStatus: store.StatusPending},
+		{ID: uuid.New(), ClassificationStatus: store.StatusPending, IsSuppressed: true},
+		{ID: uuid.New(), ClassificationStatus: store.StatusClassified, ProjectID: &billable.ID},
+	}
+	entries := []*store.TimeEntry{
+		{ID: uuid.New(), ProjectID: internal.ID, Date: day, Hours: 1},
+		{ID: uuid.New(), ProjectID: billable.ID, Date: day, Hours: 2.5, InvoiceID: &invoiceID},
+		{ID: uuid.New(), ProjectID: travel.ID, Date: day, Hours: 3},
+	}
+
+	summary := buildDaySummary(openapi_types.Date{Time: day}, events, entries,
+		[]*store.Project{billable, internal, travel})
+
+	if len(summary.Events) != 3 || len(summary.Entries) != 3 {
+		t.Fatalf("expected all events and entries, got %d and %d", len(summary.Events), len(summary.Entries))
+	}
+	if summary.PendingCount != 1 {
+		t.Errorf("expected suppressed events not to count as pending, got %d", summary.PendingCount)
+	}
+	if summary.Totals.Hours != 3.5 || summary.Totals.BillableHours != 2.5 {
+		t.Errorf("expected 3.5h with 2.5h billable, got %+v", summary.Totals)
+	}
+	if len(summary.Totals.Projects) != 2 || summary.Totals.Projects[0].ProjectId != billable.ID {
+		t.Errorf("expected project totals by hours, excluding non-accumulating projects, got %+v", summary.Totals.Projects)
+	}
+	if len(summary.LockedEntryIds) != 1 || summary.LockedEntryIds[0] != entries[1].ID || summary.Locked {
+		t.Errorf("expected one locked entry and an unlocked day, got %v locked=%v", summary.LockedEntryIds, summary.Locked)
+	}
+}
@@ -44,6 +44,9 @@ type CalendarSyncStatus struct {
 	SyncTokenSet   bool       `json:"sync_token_set"`
 	NeedsReauth    bool       `json:"needs_reauth"`
 	SyncFailures   int        `json:"sync_failure_count"`
+	LastFailure    *string    `json:"sync_failure_category"`
+	SyncError      *string    `json:"sync_error"`
+	DisabledAt     *time.Time `json:"sync_disabled_at"`
 	IsStale        bool       `json:"is_stale"`
 	SyncedWeeks    int        `json:"synced_weeks"`
 	CreatedAt      time.Time  `json:"created_at"`
@@ -136,6 +139,9 @@ func (h *DebugHandler) SyncStatus(w http.ResponseWriter, r *http.Request) {
 				SyncTokenSet:  cal.SyncToken != nil && *cal.SyncToken != "",
 				NeedsReauth:   cal.NeedsReauth,
 				SyncFailures:  cal.SyncFailureCount,
+				LastFailure:   (*string)(cal.SyncFailureCategory),
+				SyncError:     cal.SyncLastError,
+				DisabledAt:    cal.SyncDisabledAt,
 				IsStale:       sync.IsStale(cal.LastSyncedAt),
 				SyncedWeeks:   syncedWeeks,
 				CreatedAt:     cal.CreatedAt,
@@ -240,8 +246,8 @@ func (h *DebugHandler) SyncStatusPage(w http.ResponseWriter, r *http.Request) {
                 html += '<div class="section"><h2>Calendars (' + calendars.length + ')</h2>';
                 if (calendars.length > 0) {
                     calendars.forEach(cal => {
-                        let statusClass = cal.needs_reauth ? 'error' : (cal.is_stale || cal.sync_failure_count > 0) ? 'warn' : 'ok';
-                        let statusText = cal.needs_reauth ? 'NEEDS REAUTH' : cal.sync_failure_count > 0 ? cal.sync_failure_count + ' failures' : cal.is_stale ? 'Stale' : 'OK';
+                        let statusClass = (cal.needs_reauth || cal.sync_disabled_at) ? 'error' : (cal.is_stale || cal.sync_failure_category) ? 'warn' : 'ok';
+                        let statusText = cal.needs_reauth ? 'NEEDS REAUTH' : cal.sync_disabled_at ? 'DISABLED' : cal.sync_failure_category ? cal.sync_failure_category + ' failure (' + cal.sync_failure_count + ')' : cal.is_stale ? 'Stale' : 'OK';
                         let lastSync = cal.last_synced_at ? new Date(cal.last_synced_at).toLocaleString() : 'never';
                         let watermarks = (cal.min_synced_date && cal.max_synced_date) ?
                             cal.min_synced_date.slice(0,10) + ' to ' + cal.max_synced_date.slice(0,10) : 'Not set';
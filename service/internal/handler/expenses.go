@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// ExpenseHandler implements the expense endpoints
+type ExpenseHandler struct {
+	expenses *store.ExpenseStore
+	projects ProjectStore
+}
+
+// NewExpenseHandler creates a new expense handler
+func NewExpenseHandler(expenses *store.ExpenseStore, projects ProjectStore) *ExpenseHandler {
+	return &ExpenseHandler{
+		expenses: expenses,
+		projects: projects,
+	}
+}
+
+// ListExpenses returns the user's expenses
+func (h *ExpenseHandler) ListExpenses(ctx context.Context, req api.ListExpensesRequestObject) (api.ListExpensesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListExpenses401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	filter := store.ExpenseFilter{
+		ProjectID: req.Params.ProjectId,
+		Billable:  req.Params.Billable,
+	}
+	if req.Params.StartDate != nil {
+		filter.StartDate = &req.Params.StartDate.Time
+	}
+	if req.Params.EndDate != nil {
+		filter.EndDate = &req.Params.EndDate.Time
+	}
+
+	expenses, err := h.expenses.List(ctx, userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.Expense, len(expenses))
+	for i, e := range expenses {
+		result[i] = expenseToAPI(e)
+	}
+	return api.ListExpenses200JSONResponse(result), nil
+}
+
+// CreateExpense records a new expense
+func (h *ExpenseHandler) CreateExpense(ctx context.Context, req api.CreateExpenseRequestObject) (api.CreateExpenseResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateExpense401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.CreateExpense400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if req.Body.Amount <= 0 {
+		return api.CreateExpense400JSONResponse{
+			Code:    "invalid_request",
+			Message: "amount must be greater than 0",
+		}, nil
+	}
+	category := strings.TrimSpace(req.Body.Category)
+	if category == "" {
+		return api.CreateExpense400JSONResponse{
+			Code:    "invalid_request",
+			Message: "category is required",
+		}, nil
+	}
+
+	if _, err := h.projects.GetByID(ctx, userID, req.Body.ProjectId); err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return api.CreateExpense404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	expense := &store.Expense{
+		UserID:      userID,
+		ProjectID:   req.Body.ProjectId,
+		Date:        req.Body.Date.Time,
+		Amount:      req.Body.Amount,
+		Category:    category,
+		Description: req.Body.Description,
+		ReceiptURL:  req.Body.ReceiptUrl,
+		IsBillable:  true,
+	}
+	if req.Body.IsBillable != nil {
+		expense.IsBillable = *req.Body.IsBillable
+	}
+
+	created, err := h.expenses.Create(ctx, expense)
+	if err != nil {
+		return nil, err
+	}
+	return api.CreateExpense201JSONResponse(expenseToAPI(created)), nil
+}
+
+// GetExpense returns a single expense
+func (h *ExpenseHandler) GetExpense(ctx context.Context, req api.GetExpenseRequestObject) (api.GetExpenseResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetExpense401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	expense, err := h.expenses.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrExpenseNotFound) {
+			return api.GetExpense404JSONResponse{
+				Code:    "not_found",
+				Message: "Expense not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.GetExpense200JSONResponse(expenseToAPI(expense)), nil
+}
+
+// UpdateExpense modifies an expense that hasn't been invoiced
+func (h *ExpenseHandler) UpdateExpense(ctx context.Context, req api.UpdateExpenseRequestObject) (api.UpdateExpenseResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateExpense401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateExpense400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	updates := make(map[string]interface{})
+	if req.Body.ProjectId != nil {
+		if _, err := h.projects.GetByID(ctx, userID, *req.Body.ProjectId); err != nil {
+			if errors.Is(err, store.ErrProjectNotFound) {
+				return api.UpdateExpense404JSONResponse{
+					Code:    "not_found",
+					Message: "Project not found",
+				}, nil
+			}
+			return nil, err
+		}
+		updates["project_id"] = *req.Body.ProjectId
+	}
+	if req.Body.Date != nil {
+		updates["date"] = req.Body.Date.Time
+	}
+	if req.Body.Amount != nil {
+		if *req.Body.Amount <= 0 {
+			return api.UpdateExpense400JSONResponse{
+				Code:    "invalid_request",
+				Message: "amount must be greater than 0",
+			}, nil
+		}
+		updates["amount"] = *req.Body.Amount
+	}
+	if req.Body.Category != nil {
+		category := strings.TrimSpace(*req.Body.Category)
+		if category == "" {
+			return api.UpdateExpense400JSONResponse{
+				Code:    "invalid_request",
+				Message: "category cannot be empty",
+			}, nil
+		}
+		updates["category"] = category
+	}
+	if req.Body.Description != nil {
+		updates["description"] = emptyToNil(*req.Body.Description)
+	}
+	if req.Body.ReceiptUrl != nil {
+		updates["receipt_url"] = emptyToNil(*req.Body.ReceiptUrl)
+	}
+	if req.Body.IsBillable != nil {
+		updates["is_billable"] = *req.Body.IsBillable
+	}
+
+	expense, err := h.expenses.Update(ctx, userID, req.Id, updates)
+	if err != nil {
+		if errors.Is(err, store.ErrExpenseNotFound) {
+			return api.UpdateExpense404JSONResponse{
+				Code:    "not_found",
+				Message: "Expense not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrExpenseInvoiced) {
+			return api.UpdateExpense409JSONResponse{
+				Code:    "expense_invoiced",
+				Message: "Expense is on an invoice; delete the invoice to change it",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.UpdateExpense200JSONResponse(expenseToAPI(expense)), nil
+}
+
+// DeleteExpense removes an expense that hasn't been invoiced
+func (h *ExpenseHandler) DeleteExpense(ctx context.Context, req api.DeleteExpenseRequestObject) (api.DeleteExpenseResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteExpense401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.expenses.Delete(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrExpenseNotFound) {
+			return api.DeleteExpense404JSONResponse{
+				Code:    "not_found",
+				Message: "Expense not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrExpenseInvoiced) {
+			return api.DeleteExpense409JSONResponse{
+				Code:    "expense_invoiced",
+				Message: "Expense is on an invoice; delete the invoice to remove it",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DeleteExpense204Response{}, nil
+}
+
+// emptyToNil maps a cleared optional text field to NULL
+func emptyToNil(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func expenseToAPI(e *store.Expense) api.Expense {
+	return api.Expense{
+		Id:          e.ID,
+		ProjectId:   e.ProjectID,
+		Date:        openapi_types.Date{Time: e.Date},
+		Amount:      e.Amount,
+		Category:    e.Category,
+		Description: e.Description,
+		ReceiptUrl:  e.ReceiptURL,
+		IsBillable:  e.IsBillable,
+		InvoiceId:   e.InvoiceID,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
@@ -0,0 +1,347 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// maxFingerprintKeywordLength bounds keyword fingerprints
+const maxFingerprintKeywordLength = 100
+
+var fingerprintDomainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// fingerprintColumns maps fingerprint kinds to their project columns
+var fingerprintColumns = map[api.FingerprintKind]string{
+	api.Domain:  "fingerprint_domains",
+	api.Email:   "fingerprint_emails",
+	api.Keyword: "fingerprint_keywords",
+}
+
+// exclusionColumns maps the kinds that support exclusions to their columns
+var exclusionColumns = map[api.FingerprintKind]string{
+	api.Domain:  "fingerprint_exclude_domains",
+	api.Keyword: "fingerprint_exclude_keywords",
+}
+
+// normalizeFingerprint lower-cases and validates a fingerprint value
+func normalizeFingerprint(kind api.FingerprintKind, value string) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch kind {
+	case api.Domain:
+		value = strings.TrimPrefix(value, "@")
+		if !fingerprintDomainPattern.MatchString(value) {
+			return "", fmt.Errorf("%q is not a valid domain", value)
+		}
+	case api.Email:
+		addr, err := mail.ParseAddress(value)
+		if err != nil || addr.Address != value {
+			return "", fmt.Errorf("%q is not a valid email address", value)
+		}
+	case api.Keyword:
+		if value == "" || len(value) > maxFingerprintKeywordLength {
+			return "", fmt.Errorf("keywords must be 1 to %d characters", maxFingerprintKeywordLength)
+		}
+	default:
+		return "", fmt.Errorf("unknown fingerprint kind %q", kind)
+	}
+	return value, nil
+}
+
+// normalizeFingerprints validates a whole list, dropping duplicates
+func normalizeFingerprints(kind api.FingerprintKind, values []string) ([]string, error) {
+	normalized := make([]string, 0, len(values))
+	for _, v := range values {
+		n, err := normalizeFingerprint(kind, v)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(normalized, n) {
+			normalized = append(normalized, n)
+		}
+	}
+	return normalized, nil
+}
+
+// fingerprintWeightKey is the "kind:value" key of a fingerprint's weight
+func fingerprintWeightKey(kind api.FingerprintKind, value string) string {
+	return string(kind) + ":" + value
+}
+
+// normalizeFingerprintWeights validates weight keys against the fingerprint
+// rules and normalizes their values
+func normalizeFingerprintWeights(weights map[string]float32) (map[string]float64, error) {
+	normalized := make(map[string]float64, len(weights))
+	for key, weight := range weights {
+		kind, value, ok := strings.Cut(key, ":")
+		if !ok {
+			return nil, fmt.Errorf("weight key %q must be kind:value", key)
+		}
+		if _, known := fingerprintColumns[api.FingerprintKind(kind)]; !known {
+			return nil, fmt.Errorf("unknown fingerprint kind %q", kind)
+		}
+		value, err := normalizeFingerprint(api.FingerprintKind(kind), value)
+		if err != nil {
+			return nil, err
+		}
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight for %q must be positive", key)
+		}
+		normalized[fingerprintWeightKey(api.FingerprintKind(kind), value)] = float64(weight)
+	}
+	return normalized, nil
+}
+
+// projectFingerprints returns a project's fingerprints of one kind
+func projectFingerprints(p *store.Project, kind api.FingerprintKind) []string {
+	switch kind {
+	case api.Domain:
+		return p.FingerprintDomains
+	case api.Email:
+		return p.FingerprintEmails
+	case api.Keyword:
+		return p.FingerprintKeywords
+	}
+	return nil
+}
+
+// fingerprintOwner returns the other active project that already claims one
+// of the values, or nil. A fingerprint belongs to at most one project so
+// fingerprint matches never split an event's score.
+func (h *ProjectHandler) fingerprintOwner(ctx context.Context, userID, projectID uuid.UUID, kind api.FingerprintKind, values []string) (*store.Project, string, error) {
+	projects, err := h.projects.List(ctx, userID, false)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, p := range projects {
+		if p.ID == projectID {
+			continue
+		}
+		for _, v := range values {
+			if slices.Contains(projectFingerprints(p, kind), v) {
+				return p, v, nil
+			}
+		}
+	}
+	return nil, "", nil
+}
+
+// AddProjectFingerprint adds a single fingerprint to a project
+func (h *ProjectHandler) AddProjectFingerprint(ctx context.Context, req api.AddProjectFingerprintRequestObject) (api.AddProjectFingerprintResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.AddProjectFingerprint401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.AddProjectFingerprint400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	value, err := normalizeFingerprint(req.Body.Kind, req.Body.Value)
+	if err != nil {
+		return api.AddProjectFingerprint400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	project, err := h.projects.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return api.AddProjectFingerprint404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	if req.Body.Weight != nil && *req.Body.Weight <= 0 {
+		return api.AddProjectFingerprint400JSONResponse{
+			Code:    "invalid_request",
+			Message: "weight must be positive",
+		}, nil
+	}
+
+	updates := map[string]interface{}{}
+	existing := projectFingerprints(project, req.Body.Kind)
+	if !slices.Contains(existing, value) {
+		owner, _, err := h.fingerprintOwner(ctx, userID, project.ID, req.Body.Kind, []string{value})
+		if err != nil {
+			return nil, err
+		}
+		if owner != nil {
+			return api.AddProjectFingerprint409JSONResponse{
+				Code:    "fingerprint_conflict",
+				Message: fmt.Sprintf("%q is already a fingerprint of project %q", value, owner.Name),
+			}, nil
+		}
+		updates[fingerprintColumns[req.Body.Kind]] = append(slices.Clone(existing), value)
+	}
+
+	// Re-adding an existing fingerprint only changes its weight; a weight of
+	// 1.0 is the default and needs no entry
+	key := fingerprintWeightKey(req.Body.Kind, value)
+	if req.Body.Weight != nil {
+		weight := float64(*req.Body.Weight)
+		if current, ok := project.FingerprintWeights[key]; (ok && current != weight) || (!ok && weight != 1) {
+			weights := maps.Clone(project.FingerprintWeights)
+			if weights == nil {
+				weights = map[string]float64{}
+			}
+			if weight == 1 {
+				delete(weights, key)
+			} else {
+				weights[key] = weight
+			}
+			updates["fingerprint_weights"] = weights
+		}
+	}
+	if len(updates) == 0 {
+		return api.AddProjectFingerprint200JSONResponse(projectToAPI(project)), nil
+	}
+
+	updated, err := h.projects.Update(ctx, userID, project.ID, updates)
+	if err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return api.AddProjectFingerprint404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.AddProjectFingerprint200JSONResponse(projectToAPI(updated)), nil
+}
+
+// RemoveProjectFingerprint removes a single fingerprint from a project
+func (h *ProjectHandler) RemoveProjectFingerprint(ctx context.Context, req api.RemoveProjectFingerprintRequestObject) (api.RemoveProjectFingerprintResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RemoveProjectFingerprint401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	column, ok := fingerprintColumns[req.Kind]
+	if !ok {
+		return api.RemoveProjectFingerprint400JSONResponse{
+			Code:    "invalid_request",
+			Message: "kind must be domain, email or keyword",
+		}, nil
+	}
+
+	project, err := h.projects.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return api.RemoveProjectFingerprint404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	// Match the way values are stored, but still allow removing entries
+	// saved before validation existed
+	value := strings.ToLower(strings.TrimSpace(req.Value))
+	if req.Kind == api.Domain {
+		value = strings.TrimPrefix(value, "@")
+	}
+	existing := projectFingerprints(project, req.Kind)
+	remaining := slices.DeleteFunc(slices.Clone(existing), func(v string) bool {
+		return strings.EqualFold(v, value)
+	})
+	if len(remaining) == len(existing) {
+		return api.RemoveProjectFingerprint404JSONResponse{
+			Code:    "not_found",
+			Message: "Fingerprint not found on this project",
+		}, nil
+	}
+
+	updates := map[string]interface{}{column: remaining}
+	if _, ok := project.FingerprintWeights[fingerprintWeightKey(req.Kind, value)]; ok {
+		weights := maps.Clone(project.FingerprintWeights)
+		delete(weights, fingerprintWeightKey(req.Kind, value))
+		updates["fingerprint_weights"] = weights
+	}
+
+	updated, err := h.projects.Update(ctx, userID, project.ID, updates)
+	if err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return api.RemoveProjectFingerprint404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.RemoveProjectFingerprint200JSONResponse(projectToAPI(updated)), nil
+}
+
+// ListFingerprintClaims lists the fingerprints, across all projects, that
+// claim a domain
+func (h *ProjectHandler) ListFingerprintClaims(ctx context.Context, req api.ListFingerprintClaimsRequestObject) (api.ListFingerprintClaimsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListFingerprintClaims401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	domain, err := normalizeFingerprint(api.Domain, req.Params.Domain)
+	if err != nil {
+		return api.ListFingerprintClaims400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	projects, err := h.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := []api.FingerprintClaim{}
+	claim := func(p *store.Project, kind api.FingerprintKind, value string) {
+		claims = append(claims, api.FingerprintClaim{
+			ProjectId:   p.ID,
+			ProjectName: p.Name,
+			IsArchived:  p.IsArchived,
+			Kind:        kind,
+			Value:       value,
+		})
+	}
+	for _, p := range projects {
+		for _, d := range p.FingerprintDomains {
+			if strings.EqualFold(d, domain) {
+				claim(p, api.Domain, d)
+			}
+		}
+		for _, e := range p.FingerprintEmails {
+			if at := strings.LastIndex(e, "@"); at >= 0 && strings.EqualFold(e[at+1:], domain) {
+				claim(p, api.Email, e)
+			}
+		}
+	}
+
+	return api.ListFingerprintClaims200JSONResponse(claims), nil
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/meetingload"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+const (
+	// maxFocusSession bounds a single logged session
+	maxFocusSession = 12 * time.Hour
+	// minFocusSegment is the shortest piece of a session worth storing once
+	// meetings are cut out
+	minFocusSegment = time.Minute
+)
+
+// FocusSessionHandler logs focus sessions from external timers and records
+// the focus time between meetings
+type FocusSessionHandler struct {
+	focus             *store.FocusSessionStore
+	events            *store.CalendarEventStore
+	projects          ProjectStore
+	classificationSvc *classification.Service
+	meetingLoadSvc    *meetingload.Service
+}
+
+// NewFocusSessionHandler creates a new focus session handler
+func NewFocusSessionHandler(focus *store.FocusSessionStore, events *store.CalendarEventStore, projects ProjectStore, workingHours *store.WorkingHoursStore, classificationSvc *classification.Service) *FocusSessionHandler {
+	return &FocusSessionHandler{
+		focus:             focus,
+		events:            events,
+		projects:          projects,
+		classificationSvc: classificationSvc,
+		meetingLoadSvc:    meetingload.NewService(events, workingHours.Get),
+	}
+}
+
+// LogFocusSession stores a focus session, minus any time spent in meetings
+func (h *FocusSessionHandler) LogFocusSession(ctx context.Context, req api.LogFocusSessionRequestObject) (api.LogFocusSessionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.LogFocusSession401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.LogFocusSession400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	start, end := req.Body.StartTime.UTC(), req.Body.EndTime.UTC()
+	if end.Sub(start) < minFocusSegment {
+		return api.LogFocusSession400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_time must be at least a minute after start_time",
+		}, nil
+	}
+	if end.Sub(start) > maxFocusSession {
+		return api.LogFocusSession400JSONResponse{
+			Code:    "invalid_request",
+			Message: "A focus session can be at most 12 hours",
+		}, nil
+	}
+
+	if _, err := h.projects.GetByID(ctx, userID, req.Body.ProjectId); err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return api.LogFocusSession404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	focusConnectionID, _, err := h.focus.Calendar(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	busy, err := h.meetings(ctx, userID, focusConnectionID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	segments := freeSegments(focusInterval{start, end}, busy)
+	if len(segments) == 0 {
+		return api.LogFocusSession409JSONResponse{
+			Code:    "overlaps_meetings",
+			Message: "The session is entirely covered by calendar meetings",
+		}, nil
+	}
+
+	title := "Focus session"
+	if req.Body.Title != nil && strings.TrimSpace(*req.Body.Title) != "" {
+		title = strings.TrimSpace(*req.Body.Title)
+	}
+	externalID := start.Format(time.RFC3339)
+	if req.Body.ExternalId != nil && strings.TrimSpace(*req.Body.ExternalId) != "" {
+		externalID = strings.TrimSpace(*req.Body.ExternalId)
+	}
+
+	result := api.FocusSessionResult{Events: make([]api.CalendarEvent, len(segments))}
+	kept := time.Duration(0)
+	for i, seg := range segments {
+		event, err := h.focus.Record(ctx, userID, store.FocusSession{
+			ExternalID: fmt.Sprintf("focus:%s#%d", externalID, i+1),
+			ProjectID:  req.Body.ProjectId,
+			Title:      title,
+			StartTime:  seg.start,
+			EndTime:    seg.end,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := h.classificationSvc.RecalculateTimeEntriesForEvent(ctx, userID, event); err != nil {
+			return nil, err
+		}
+		result.Events[i] = calendarEventToAPI(event)
+		kept += seg.end.Sub(seg.start)
+	}
+	result.TrimmedMinutes = int((end.Sub(start) - kept).Minutes())
+
+	return api.LogFocusSession201JSONResponse(result), nil
+}
+
+// meetings returns the busy calendar time overlapping [start, end): events
+// the user attends and didn't skip, excluding all-day events, events marked
+// free and earlier focus sessions
+func (h *FocusSessionHandler) meetings(ctx context.Context, userID, focusConnectionID uuid.UUID, start, end time.Time) ([]focusInterval, error) {
+	firstDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	// Events that started the day before can still run into the session
+	firstDay = firstDay.AddDate(0, 0, -1)
+	lastDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	events, err := h.events.List(ctx, userID, &firstDay, &lastDay, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var busy []focusInterval
+	for _, e := range events {
+		if e.ConnectionID == focusConnectionID || !meetingload.IsBusy(e) {
+			continue
+		}
+		if e.StartTime.Before(end) && e.EndTime.After(start) {
+			busy = append(busy, focusInterval{e.StartTime.UTC(), e.EndTime.UTC()})
+		}
+	}
+	return busy, nil
+}
+
+type focusInterval struct {
+	start, end time.Time
+}
+
+// freeSegments cuts the busy intervals out of session and returns what is
+// left, in order, dropping pieces shorter than minFocusSegment
+func freeSegments(session focusInterval, busy []focusInterval) []focusInterval {
+	sort.Slice(busy, func(i, j int) bool { return busy[i].start.Before(busy[j].start) })
+
+	var free []focusInterval
+	cursor := session.start
+	for _, b := range busy {
+		if !b.end.After(cursor) {
+			continue
+		}
+		if b.start.After(cursor) {
+			free = append(free, focusInterval{cursor, minTime(b.start, session.end)})
+		}
+		cursor = b.end
+		if !cursor.Before(session.end) {
+			break
+		}
+	}
+	if cursor.Before(session.end) {
+		free = append(free, focusInterval{cursor, session.end})
+	}
+
+	segments := free[:0]
+	for _, f := range free {
+		if f.end.Sub(f.start) >= minFocusSegment {
+			segments = append(segments, f)
+		}
+	}
+	return segments
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
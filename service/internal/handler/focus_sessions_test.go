@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFreeSegments(t *testing.T) {
+	at := func(h, m int) time.Time {
+		return time.Date(2025, 7, 14, h, m, 0, 0, time.UTC)
+	}
+	session := focusInterval{at(9, 0), at(12, 0)}
+
+	tests := []struct {
+		name string
+		busy []focusInterval
+		want []focusInterval
+	}{
+		{
+			name: "no meetings",
+			want: []focusInterval{session},
+		},
+		{
+			name: "meeting in the middle splits the session",
+			busy: []focusInterval{{at(10, 0), at(10, 30)}},
+			want: []focusInterval{{at(9, 0), at(10, 0)}, {at(10, 30), at(12, 0)}},
+		},
+		{
+			name: "overlapping meetings out of order",
+			busy: []focusInterval{{at(11, 0), at(13, 0)}, {at(8, 0), at(9, 30)}, {at(9, 15), at(10, 0)}},
+			want: []focusInterval{{at(10, 0), at(11, 0)}},
+		},
+		{
+			name: "slivers under a minute are dropped",
+			busy: []focusInterval{{at(9, 0), at(10, 0)}, {at(10, 0).Add(30 * time.Second), at(12, 0)}},
+			want: nil,
+		},
+		{
+			name: "fully covered",
+			busy: []focusInterval{{at(8, 0), at(13, 0)}},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := freeSegments(session, tt.busy)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d segments %v, want %v", len(got), got, tt.want)
+			}
+			for i := range got {
+				if !got[i].start.Equal(tt.want[i].start) || !got[i].end.Equal(tt.want[i].end) {
+					t.Errorf("segment %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/github"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+var (
+	githubUsernamePattern = regexp.MustCompile(`^[a-z0-9](-?[a-z0-9]){0,38}$`)
+	githubRepoPattern     = regexp.MustCompile(`^[a-z0-9](-?[a-z0-9]){0,38}/[a-z0-9._-]{1,100}$`)
+)
+
+// normalizeGitHubRepos lower-cases and validates "owner/name" repositories,
+// dropping duplicates
+func normalizeGitHubRepos(repos []string) ([]string, error) {
+	normalized := make([]string, 0, len(repos))
+	seen := make(map[string]bool, len(repos))
+	for _, r := range repos {
+		repo := strings.ToLower(strings.TrimSpace(r))
+		if !githubRepoPattern.MatchString(repo) {
+			return nil, fmt.Errorf("%q is not a GitHub repository (owner/name)", r)
+		}
+		if !seen[repo] {
+			seen[repo] = true
+			normalized = append(normalized, repo)
+		}
+	}
+	return normalized, nil
+}
+
+// GitHubHandler implements the GitHub integration endpoints. The store and
+// service are nil when encryption is not configured, since tokens are only
+// stored encrypted.
+type GitHubHandler struct {
+	connections *store.GitHubConnectionStore
+	githubSvc   *github.Service
+}
+
+// NewGitHubHandler creates a new GitHub handler
+func NewGitHubHandler(connections *store.GitHubConnectionStore, githubSvc *github.Service) *GitHubHandler {
+	return &GitHubHandler{
+		connections: connections,
+		githubSvc:   githubSvc,
+	}
+}
+
+// GetGitHubConnection returns the connected GitHub account
+func (h *GitHubHandler) GetGitHubConnection(ctx context.Context, req api.GetGitHubConnectionRequestObject) (api.GetGitHubConnectionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetGitHubConnection401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if h.connections == nil {
+		return api.GetGitHubConnection404JSONResponse{
+			Code:    "not_configured",
+			Message: "GitHub integration is not configured",
+		}, nil
+	}
+
+	conn, err := h.connections.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrGitHubConnectionNotFound) {
+			return api.GetGitHubConnection404JSONResponse{
+				Code:    "not_found",
+				Message: "GitHub is not connected",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.GetGitHubConnection200JSONResponse(githubConnectionToAPI(conn)), nil
+}
+
+// ConnectGitHub stores the user's GitHub account and token
+func (h *GitHubHandler) ConnectGitHub(ctx context.Context, req api.ConnectGitHubRequestObject) (api.ConnectGitHubResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ConnectGitHub401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if h.connections == nil {
+		return api.ConnectGitHub400JSONResponse{
+			Code:    "not_configured",
+			Message: "GitHub integration is not configured",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.ConnectGitHub400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	username := strings.ToLower(strings.TrimSpace(req.Body.Username))
+	if !githubUsernamePattern.MatchString(username) {
+		return api.ConnectGitHub400JSONResponse{
+			Code:    "invalid_request",
+			Message: "username is not a valid GitHub login",
+		}, nil
+	}
+	token := strings.TrimSpace(req.Body.Token)
+	if token == "" {
+		return api.ConnectGitHub400JSONResponse{
+			Code:    "invalid_request",
+			Message: "token is required",
+		}, nil
+	}
+
+	conn, err := h.connections.Upsert(ctx, userID, username, token)
+	if err != nil {
+		return nil, err
+	}
+	return api.ConnectGitHub200JSONResponse(githubConnectionToAPI(conn)), nil
+}
+
+// DisconnectGitHub removes the user's GitHub connection
+func (h *GitHubHandler) DisconnectGitHub(ctx context.Context, req api.DisconnectGitHubRequestObject) (api.DisconnectGitHubResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DisconnectGitHub401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if h.connections == nil {
+		return api.DisconnectGitHub404JSONResponse{
+			Code:    "not_configured",
+			Message: "GitHub integration is not configured",
+		}, nil
+	}
+
+	if err := h.connections.Delete(ctx, userID); err != nil {
+		if errors.Is(err, store.ErrGitHubConnectionNotFound) {
+			return api.DisconnectGitHub404JSONResponse{
+				Code:    "not_found",
+				Message: "GitHub is not connected",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DisconnectGitHub204Response{}, nil
+}
+
+// SuggestDescriptions suggests time entry descriptions from GitHub activity
+func (h *GitHubHandler) SuggestDescriptions(ctx context.Context, req api.SuggestDescriptionsRequestObject) (api.SuggestDescriptionsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.SuggestDescriptions401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if h.connections == nil {
+		return api.SuggestDescriptions409JSONResponse{
+			Code:    "not_configured",
+			Message: "GitHub integration is not configured",
+		}, nil
+	}
+
+	suggestions, err := h.githubSvc.SuggestDescriptions(ctx, userID, req.Params.Date.Time)
+	if err != nil {
+		if errors.Is(err, github.ErrNotConnected) {
+			return api.SuggestDescriptions409JSONResponse{
+				Code:    "github_not_connected",
+				Message: "Connect GitHub to get description suggestions",
+			}, nil
+		}
+		if errors.Is(err, github.ErrUnavailable) {
+			return api.SuggestDescriptions502JSONResponse{
+				Code:    "github_unavailable",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]api.DescriptionSuggestion, len(suggestions))
+	for i, s := range suggestions {
+		result[i] = descriptionSuggestionToAPI(s)
+	}
+	return api.SuggestDescriptions200JSONResponse(result), nil
+}
+
+func githubConnectionToAPI(c *store.GitHubConnection) api.GitHubConnection {
+	return api.GitHubConnection{
+		Username:    c.Username,
+		ConnectedAt: c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+func descriptionSuggestionToAPI(s github.DaySuggestion) api.DescriptionSuggestion {
+	out := api.DescriptionSuggestion{
+		ProjectId:          s.ProjectID,
+		ProjectName:        s.ProjectName,
+		Description:        s.Description,
+		TimeEntryId:        s.TimeEntryID,
+		CurrentDescription: s.CurrentDescription,
+		Activities:         make([]api.GitHubActivity, len(s.Activities)),
+	}
+	if s.TimeEntryID != nil {
+		hours := float32(s.Hours)
+		out.Hours = &hours
+	}
+	for i, a := range s.Activities {
+		out.Activities[i] = api.GitHubActivity{
+			Kind:  string(a.Kind),
+			Repo:  a.Repo,
+			Title: a.Title,
+			Url:   a.URL,
+			At:    a.At,
+		}
+		if a.Kind == github.ActivityReview {
+			number := a.Number
+			out.Activities[i].Number = &number
+		}
+	}
+	return out
+}
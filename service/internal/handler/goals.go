@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/goals"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// maxGoalHours bounds a goal's target: every hour of a 31-day month
+const maxGoalHours = 31 * 24
+
+// GoalsHandler implements the hour goal endpoints
+type GoalsHandler struct {
+	goals    *store.HourGoalStore
+	projects ProjectStore
+	goalsSvc *goals.Service
+}
+
+// NewGoalsHandler creates a new goals handler
+func NewGoalsHandler(goalStore *store.HourGoalStore, projects ProjectStore, goalsSvc *goals.Service) *GoalsHandler {
+	return &GoalsHandler{
+		goals:    goalStore,
+		projects: projects,
+		goalsSvc: goalsSvc,
+	}
+}
+
+// ListGoals returns the user's hour goals
+func (h *GoalsHandler) ListGoals(ctx context.Context, req api.ListGoalsRequestObject) (api.ListGoalsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListGoals401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	list, err := h.goals.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.HourGoal, len(list))
+	for i, g := range list {
+		result[i] = hourGoalToAPI(g)
+	}
+	return api.ListGoals200JSONResponse(result), nil
+}
+
+// CreateGoal adds an hour goal
+func (h *GoalsHandler) CreateGoal(ctx context.Context, req api.CreateGoalRequestObject) (api.CreateGoalResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateGoal401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.CreateGoal400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if msg := validateGoalInput(req.Body); msg != "" {
+		return api.CreateGoal400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+	if found, err := h.projectExists(ctx, userID, req.Body.ProjectId); err != nil {
+		return nil, err
+	} else if !found {
+		return api.CreateGoal404JSONResponse{
+			Code:    "not_found",
+			Message: "Project not found",
+		}, nil
+	}
+
+	g, err := h.goals.Create(ctx, userID, req.Body.ProjectId, req.Body.Period, req.Body.TargetHours, boolValue(req.Body.BillableOnly))
+	if err != nil {
+		return nil, err
+	}
+	return api.CreateGoal201JSONResponse(hourGoalToAPI(g)), nil
+}
+
+// UpdateGoal replaces an hour goal's settings
+func (h *GoalsHandler) UpdateGoal(ctx context.Context, req api.UpdateGoalRequestObject) (api.UpdateGoalResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateGoal401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateGoal400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if msg := validateGoalInput(req.Body); msg != "" {
+		return api.UpdateGoal400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+	if found, err := h.projectExists(ctx, userID, req.Body.ProjectId); err != nil {
+		return nil, err
+	} else if !found {
+		return api.UpdateGoal404JSONResponse{
+			Code:    "not_found",
+			Message: "Project not found",
+		}, nil
+	}
+
+	g, err := h.goals.Update(ctx, userID, req.Id, req.Body.ProjectId, req.Body.Period, req.Body.TargetHours, boolValue(req.Body.BillableOnly))
+	if err != nil {
+		if errors.Is(err, store.ErrHourGoalNotFound) {
+			return api.UpdateGoal404JSONResponse{
+				Code:    "not_found",
+				Message: "Goal not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.UpdateGoal200JSONResponse(hourGoalToAPI(g)), nil
+}
+
+// DeleteGoal removes an hour goal
+func (h *GoalsHandler) DeleteGoal(ctx context.Context, req api.DeleteGoalRequestObject) (api.DeleteGoalResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteGoal401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.goals.Delete(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrHourGoalNotFound) {
+			return api.DeleteGoal404JSONResponse{
+				Code:    "not_found",
+				Message: "Goal not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DeleteGoal204Response{}, nil
+}
+
+// GetGoalsProgress reports progress toward each goal
+func (h *GoalsHandler) GetGoalsProgress(ctx context.Context, req api.GetGoalsProgressRequestObject) (api.GetGoalsProgressResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetGoalsProgress401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	asOf := time.Now().UTC()
+	if req.Params.Date != nil {
+		asOf = req.Params.Date.Time
+	}
+
+	progress, err := h.goalsSvc.Progress(ctx, userID, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.GoalProgress, len(progress))
+	for i, p := range progress {
+		result[i] = goalProgressToAPI(p)
+	}
+	return api.GetGoalsProgress200JSONResponse(result), nil
+}
+
+// validateGoalInput returns a message describing what is wrong with the
+// input, or "" when it is valid
+func validateGoalInput(in *api.HourGoalInput) string {
+	if in.Period != goals.PeriodWeek && in.Period != goals.PeriodMonth {
+		return "period must be week or month"
+	}
+	if in.TargetHours <= 0 || in.TargetHours > maxGoalHours {
+		return "target_hours must be greater than 0 and at most 744"
+	}
+	return ""
+}
+
+// projectExists reports whether the optional project belongs to the user
+func (h *GoalsHandler) projectExists(ctx context.Context, userID uuid.UUID, projectID *uuid.UUID) (bool, error) {
+	if projectID == nil {
+		return true, nil
+	}
+	if _, err := h.projects.GetByID(ctx, userID, *projectID); err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+func hourGoalToAPI(g *store.HourGoal) api.HourGoal {
+	return api.HourGoal{
+		Id:           g.ID,
+		ProjectId:    g.ProjectID,
+		Period:       g.Period,
+		TargetHours:  g.TargetHours,
+		BillableOnly: g.BillableOnly,
+		CreatedAt:    g.CreatedAt,
+		UpdatedAt:    g.UpdatedAt,
+	}
+}
+
+func goalProgressToAPI(p goals.GoalProgress) api.GoalProgress {
+	out := api.GoalProgress{
+		Goal:           hourGoalToAPI(p.Goal),
+		PeriodStart:    openapi_types.Date{Time: p.PeriodStart},
+		PeriodEnd:      openapi_types.Date{Time: p.PeriodEnd},
+		ActualHours:    p.ActualHours,
+		ExpectedHours:  p.ExpectedHours,
+		RemainingHours: p.RemainingHours,
+		Percent:        p.Percent,
+		OnTrack:        p.OnTrack,
+	}
+	if p.ProjectName != "" {
+		name := p.ProjectName
+		out.ProjectName = &name
+	}
+	return out
+}
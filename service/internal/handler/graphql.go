@@ -0,0 +1,654 @@
+package handler
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/graphql"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// graphQLSchema is the SDL the resolvers in buildSchema implement
+//
+//go:embed graphql_schema.graphql
+var graphQLSchema string
+
+// GraphQLHandler serves read-only nested queries over projects, time entries,
+// calendar events and invoices, so clients can fetch a dashboard's worth of
+// data in one round trip instead of chaining REST calls.
+type GraphQLHandler struct {
+	projects       ProjectStore
+	entries        TimeEntryReader
+	calendarEvents CalendarEventReader
+	invoices       InvoiceReader
+	schema         *graphql.Schema
+}
+
+// NewGraphQLHandler creates a new GraphQL handler
+func NewGraphQLHandler(
+	projects ProjectStore,
+	entries TimeEntryReader,
+	calendarEvents CalendarEventReader,
+	invoices InvoiceReader,
+) *GraphQLHandler {
+	h := &GraphQLHandler{
+		projects:       projects,
+		entries:        entries,
+		calendarEvents: calendarEvents,
+		invoices:       invoices,
+	}
+	h.schema = h.buildSchema()
+	return h
+}
+
+// ServeHTTP handles GET and POST requests following GraphQL-over-HTTP
+func (h *GraphQLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID, ok := UserIDFromContext(r.Context())
+	if !ok {
+		writeGraphQLError(w, http.StatusUnauthorized, "Authentication required")
+		return
+	}
+
+	var req graphql.Request
+	switch r.Method {
+	case "GET":
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				writeGraphQLError(w, http.StatusBadRequest, "variables must be a JSON object")
+				return
+			}
+		}
+	case "POST":
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeGraphQLError(w, http.StatusBadRequest, "Failed to parse request body")
+			return
+		}
+	case "OPTIONS":
+		w.Header().Set("Allow", "GET, POST, OPTIONS")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if req.Query == "" {
+		writeGraphQLError(w, http.StatusBadRequest, "query is required")
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), graphQLLoaderKey, newGraphQLLoader(h, userID))
+	resp := graphql.Execute(ctx, h.schema, req)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func writeGraphQLError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&graphql.Response{Errors: []*graphql.Error{{Message: message}}})
+}
+
+// graphQLLoader caches lookups for the lifetime of a single request so that
+// nested selections don't refetch the same project for every child, and
+// batches per-parent lists so that selecting them under every parent costs
+// one query rather than one per parent.
+//
+// The executor resolves a list before any of its items' fields, so list
+// resolvers hand their results to the loader; the first item that needs its
+// children then loads the children of every remembered sibling at once.
+type graphQLLoader struct {
+	h      *GraphQLHandler
+	userID uuid.UUID
+
+	mu       sync.Mutex
+	projects map[uuid.UUID]*store.Project
+	entries  map[string]map[uuid.UUID][]*store.TimeEntry
+	invoices map[string]map[uuid.UUID][]*store.Invoice
+
+	// Time entries, events and invoices referenced so far whose contributing
+	// events, details or line items haven't been loaded yet
+	pendingEntries  map[uuid.UUID]bool
+	pendingEvents   map[uuid.UUID]bool
+	pendingInvoices map[uuid.UUID]bool
+	eventIDs        map[uuid.UUID][]uuid.UUID
+	events          map[uuid.UUID]*store.CalendarEvent
+	lineItems       map[uuid.UUID][]store.InvoiceLineItem
+}
+
+type graphQLContextKey struct{}
+
+var graphQLLoaderKey = graphQLContextKey{}
+
+func newGraphQLLoader(h *GraphQLHandler, userID uuid.UUID) *graphQLLoader {
+	return &graphQLLoader{
+		h:        h,
+		userID:   userID,
+		projects: make(map[uuid.UUID]*store.Project),
+		entries:  make(map[string]map[uuid.UUID][]*store.TimeEntry),
+		invoices: make(map[string]map[uuid.UUID][]*store.Invoice),
+
+		pendingEntries:  make(map[uuid.UUID]bool),
+		pendingEvents:   make(map[uuid.UUID]bool),
+		pendingInvoices: make(map[uuid.UUID]bool),
+		eventIDs:        make(map[uuid.UUID][]uuid.UUID),
+		events:          make(map[uuid.UUID]*store.CalendarEvent),
+		lineItems:       make(map[uuid.UUID][]store.InvoiceLineItem),
+	}
+}
+
+func loaderFromContext(ctx context.Context) *graphQLLoader {
+	return ctx.Value(graphQLLoaderKey).(*graphQLLoader)
+}
+
+func (l *graphQLLoader) project(ctx context.Context, id uuid.UUID) (*store.Project, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if p, ok := l.projects[id]; ok {
+		return p, nil
+	}
+	p, err := l.h.projects.GetByID(ctx, l.userID, id)
+	if err != nil {
+		return nil, err
+	}
+	l.projects[id] = p
+	return p, nil
+}
+
+func (l *graphQLLoader) remember(projects ...*store.Project) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, p := range projects {
+		l.projects[p.ID] = p
+	}
+}
+
+// projectEntries returns a project's time entries in a date range. The
+// first call for a range loads the entries of every project in one query.
+func (l *graphQLLoader) projectEntries(ctx context.Context, projectID uuid.UUID, start, end *time.Time) ([]*store.TimeEntry, error) {
+	key := fmt.Sprint(formatDatePtr(start), "..", formatDatePtr(end))
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	byProject, ok := l.entries[key]
+	if !ok {
+		entries, err := l.h.entries.List(ctx, l.userID, start, end, nil)
+		if err != nil {
+			return nil, err
+		}
+		byProject = make(map[uuid.UUID][]*store.TimeEntry)
+		for _, e := range entries {
+			byProject[e.ProjectID] = append(byProject[e.ProjectID], e)
+		}
+		l.entries[key] = byProject
+		l.rememberEntriesLocked(entries)
+	}
+	return byProject[projectID], nil
+}
+
+// projectInvoices returns a project's invoices, optionally with a status,
+// loading the invoices of every project in one query
+func (l *graphQLLoader) projectInvoices(ctx context.Context, projectID uuid.UUID, status *string) ([]*store.Invoice, error) {
+	var key string
+	if status != nil {
+		key = *status
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	byProject, ok := l.invoices[key]
+	if !ok {
+		invoices, err := l.h.invoices.List(ctx, l.userID, nil, status)
+		if err != nil {
+			return nil, err
+		}
+		byProject = make(map[uuid.UUID][]*store.Invoice)
+		for _, i := range invoices {
+			byProject[i.ProjectID] = append(byProject[i.ProjectID], i)
+		}
+		l.invoices[key] = byProject
+		l.rememberInvoicesLocked(invoices)
+	}
+	return byProject[projectID], nil
+}
+
+// rememberEntries queues entries whose contributing events are not yet
+// known, so they are loaded together
+func (l *graphQLLoader) rememberEntries(entries []*store.TimeEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rememberEntriesLocked(entries)
+}
+
+func (l *graphQLLoader) rememberEntriesLocked(entries []*store.TimeEntry) {
+	for _, e := range entries {
+		if _, ok := l.eventIDs[e.ID]; ok {
+			continue
+		}
+		if e.ContributingEvents != nil {
+			l.setEventIDsLocked(e.ID, e.ContributingEvents)
+		} else {
+			l.pendingEntries[e.ID] = true
+		}
+	}
+}
+
+func (l *graphQLLoader) setEventIDsLocked(entryID uuid.UUID, eventIDs []uuid.UUID) {
+	l.eventIDs[entryID] = eventIDs
+	delete(l.pendingEntries, entryID)
+	for _, id := range eventIDs {
+		if _, ok := l.events[id]; !ok {
+			l.pendingEvents[id] = true
+		}
+	}
+}
+
+// contributingEvents returns the events behind a time entry. The first call
+// loads the event IDs of every pending entry in one query, then every event
+// not yet cached in another.
+func (l *graphQLLoader) contributingEvents(ctx context.Context, entry *store.TimeEntry) ([]*store.CalendarEvent, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rememberEntriesLocked([]*store.TimeEntry{entry})
+
+	if len(l.pendingEntries) > 0 {
+		ids := make([]uuid.UUID, 0, len(l.pendingEntries))
+		for id := range l.pendingEntries {
+			ids = append(ids, id)
+		}
+		byEntry, err := l.h.entries.ListContributingEvents(ctx, l.userID, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			l.setEventIDsLocked(id, byEntry[id])
+		}
+	}
+
+	if len(l.pendingEvents) > 0 {
+		ids := make([]uuid.UUID, 0, len(l.pendingEvents))
+		for id := range l.pendingEvents {
+			ids = append(ids, id)
+		}
+		events, err := l.h.calendarEvents.GetByIDs(ctx, l.userID, ids)
+		if err != nil {
+			return nil, err
+		}
+		// Events that no longer exist are cached as nil and skipped
+		for _, id := range ids {
+			l.events[id] = nil
+			delete(l.pendingEvents, id)
+		}
+		for _, e := range events {
+			l.events[e.ID] = e
+		}
+	}
+
+	events := make([]*store.CalendarEvent, 0, len(l.eventIDs[entry.ID]))
+	for _, id := range l.eventIDs[entry.ID] {
+		if e := l.events[id]; e != nil {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+// rememberInvoices queues invoices listed without their line items, so
+// they are loaded together
+func (l *graphQLLoader) rememberInvoices(invoices []*store.Invoice) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rememberInvoicesLocked(invoices)
+}
+
+func (l *graphQLLoader) rememberInvoicesLocked(invoices []*store.Invoice) {
+	for _, i := range invoices {
+		if _, ok := l.lineItems[i.ID]; !ok && i.LineItems == nil {
+			l.pendingInvoices[i.ID] = true
+		}
+	}
+}
+
+// invoiceLineItems returns an invoice's line items. List doesn't load line
+// items, so the first call loads those of every pending invoice in one
+// query.
+func (l *graphQLLoader) invoiceLineItems(ctx context.Context, invoice *store.Invoice) ([]store.InvoiceLineItem, error) {
+	if invoice.LineItems != nil {
+		return invoice.LineItems, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rememberInvoicesLocked([]*store.Invoice{invoice})
+
+	if len(l.pendingInvoices) > 0 {
+		ids := make([]uuid.UUID, 0, len(l.pendingInvoices))
+		for id := range l.pendingInvoices {
+			ids = append(ids, id)
+		}
+		byInvoice, err := l.h.invoices.ListLineItems(ctx, l.userID, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			l.lineItems[id] = byInvoice[id]
+			delete(l.pendingInvoices, id)
+		}
+	}
+	return l.lineItems[invoice.ID], nil
+}
+
+// scalar builds a field that reads a value off the source object
+func scalar[T any](get func(T) interface{}) graphql.ResolveFunc {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		return get(p.Source.(T)), nil
+	}
+}
+
+func formatDate(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+func formatDatePtr(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return formatDate(*t)
+}
+
+func stringArg(args map[string]interface{}, name string) (*string, error) {
+	v, ok := args[name]
+	if !ok || v == nil {
+		return nil, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("argument %q must be a string", name)
+	}
+	return &s, nil
+}
+
+func boolArg(args map[string]interface{}, name string) (bool, error) {
+	v, ok := args[name]
+	if !ok || v == nil {
+		return false, nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("argument %q must be a boolean", name)
+	}
+	return b, nil
+}
+
+func idArg(args map[string]interface{}, name string) (*uuid.UUID, error) {
+	s, err := stringArg(args, name)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(*s)
+	if err != nil {
+		return nil, fmt.Errorf("argument %q must be a valid ID", name)
+	}
+	return &id, nil
+}
+
+func dateArg(args map[string]interface{}, name string) (*time.Time, error) {
+	s, err := stringArg(args, name)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	t, err := time.Parse("2006-01-02", *s)
+	if err != nil {
+		return nil, fmt.Errorf("argument %q must be a date in YYYY-MM-DD format", name)
+	}
+	return &t, nil
+}
+
+func requireIDArg(args map[string]interface{}) (uuid.UUID, error) {
+	id, err := idArg(args, "id")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if id == nil {
+		return uuid.Nil, fmt.Errorf("argument \"id\" is required")
+	}
+	return *id, nil
+}
+
+func (h *GraphQLHandler) buildSchema() *graphql.Schema {
+	userOf := func(ctx context.Context) uuid.UUID {
+		return loaderFromContext(ctx).userID
+	}
+
+	project := map[string]graphql.ResolveFunc{
+		"id":                     scalar(func(p *store.Project) interface{} { return p.ID }),
+		"name":                   scalar(func(p *store.Project) interface{} { return p.Name }),
+		"shortCode":              scalar(func(p *store.Project) interface{} { return p.ShortCode }),
+		"client":                 scalar(func(p *store.Project) interface{} { return p.Client }),
+		"color":                  scalar(func(p *store.Project) interface{} { return p.Color }),
+		"isBillable":             scalar(func(p *store.Project) interface{} { return p.IsBillable }),
+		"isArchived":             scalar(func(p *store.Project) interface{} { return p.IsArchived }),
+		"isHiddenByDefault":      scalar(func(p *store.Project) interface{} { return p.IsHiddenByDefault }),
+		"doesNotAccumulateHours": scalar(func(p *store.Project) interface{} { return p.DoesNotAccumulateHours }),
+		"timeEntries": func(p graphql.ResolveParams) (interface{}, error) {
+			start, err := dateArg(p.Args, "startDate")
+			if err != nil {
+				return nil, err
+			}
+			end, err := dateArg(p.Args, "endDate")
+			if err != nil {
+				return nil, err
+			}
+			proj := p.Source.(*store.Project)
+			return loaderFromContext(p.Context).projectEntries(p.Context, proj.ID, start, end)
+		},
+		"invoices": func(p graphql.ResolveParams) (interface{}, error) {
+			status, err := stringArg(p.Args, "status")
+			if err != nil {
+				return nil, err
+			}
+			proj := p.Source.(*store.Project)
+			return loaderFromContext(p.Context).projectInvoices(p.Context, proj.ID, status)
+		},
+	}
+
+	timeEntry := map[string]graphql.ResolveFunc{
+		"id":            scalar(func(e *store.TimeEntry) interface{} { return e.ID }),
+		"projectId":     scalar(func(e *store.TimeEntry) interface{} { return e.ProjectID }),
+		"date":          scalar(func(e *store.TimeEntry) interface{} { return formatDate(e.Date) }),
+		"hours":         scalar(func(e *store.TimeEntry) interface{} { return e.Hours }),
+		"title":         scalar(func(e *store.TimeEntry) interface{} { return e.Title }),
+		"description":   scalar(func(e *store.TimeEntry) interface{} { return e.Description }),
+		"source":        scalar(func(e *store.TimeEntry) interface{} { return e.Source }),
+		"invoiceId":     scalar(func(e *store.TimeEntry) interface{} { return e.InvoiceID }),
+		"hasUserEdits":  scalar(func(e *store.TimeEntry) interface{} { return e.HasUserEdits }),
+		"isStale":       scalar(func(e *store.TimeEntry) interface{} { return e.IsStale }),
+		"isSuppressed":  scalar(func(e *store.TimeEntry) interface{} { return e.IsSuppressed }),
+		"computedHours": scalar(func(e *store.TimeEntry) interface{} { return e.ComputedHours }),
+		"tags":          scalar(func(e *store.TimeEntry) interface{} { return e.Tags }),
+		"project": func(p graphql.ResolveParams) (interface{}, error) {
+			e := p.Source.(*store.TimeEntry)
+			return loaderFromContext(p.Context).project(p.Context, e.ProjectID)
+		},
+		"contributingEvents": func(p graphql.ResolveParams) (interface{}, error) {
+			e := p.Source.(*store.TimeEntry)
+			return loaderFromContext(p.Context).contributingEvents(p.Context, e)
+		},
+	}
+
+	calendarEvent := map[string]graphql.ResolveFunc{
+		"id":                       scalar(func(e *store.CalendarEvent) interface{} { return e.ID }),
+		"title":                    scalar(func(e *store.CalendarEvent) interface{} { return e.Title }),
+		"description":              scalar(func(e *store.CalendarEvent) interface{} { return e.Description }),
+		"startTime":                scalar(func(e *store.CalendarEvent) interface{} { return e.StartTime }),
+		"endTime":                  scalar(func(e *store.CalendarEvent) interface{} { return e.EndTime }),
+		"attendees":                scalar(func(e *store.CalendarEvent) interface{} { return e.Attendees }),
+		"isRecurring":              scalar(func(e *store.CalendarEvent) interface{} { return e.IsRecurring }),
+		"isAllDay":                 scalar(func(e *store.CalendarEvent) interface{} { return e.IsAllDay }),
+		"responseStatus":           scalar(func(e *store.CalendarEvent) interface{} { return e.ResponseStatus }),
+		"isSkipped":                scalar(func(e *store.CalendarEvent) interface{} { return e.IsSkipped }),
+		"classificationStatus":     scalar(func(e *store.CalendarEvent) interface{} { return e.ClassificationStatus }),
+		"classificationSource":     scalar(func(e *store.CalendarEvent) interface{} { return e.ClassificationSource }),
+		"classificationConfidence": scalar(func(e *store.CalendarEvent) interface{} { return e.ClassificationConfidence }),
+		"needsReview":              scalar(func(e *store.CalendarEvent) interface{} { return e.NeedsReview }),
+		"calendarName":             scalar(func(e *store.CalendarEvent) interface{} { return e.CalendarName }),
+		"projectId":                scalar(func(e *store.CalendarEvent) interface{} { return e.ProjectID }),
+		"tags":                     scalar(func(e *store.CalendarEvent) interface{} { return e.Tags }),
+		"project": func(p graphql.ResolveParams) (interface{}, error) {
+			e := p.Source.(*store.CalendarEvent)
+			if e.ProjectID == nil {
+				return nil, nil
+			}
+			return loaderFromContext(p.Context).project(p.Context, *e.ProjectID)
+		},
+	}
+
+	lineItem := map[string]graphql.ResolveFunc{
+		"id":            scalar(func(li store.InvoiceLineItem) interface{} { return li.ID }),
+		"kind":          scalar(func(li store.InvoiceLineItem) interface{} { return li.Kind }),
+		"timeEntryId":   scalar(func(li store.InvoiceLineItem) interface{} { return li.TimeEntryID }),
+		"expenseId":     scalar(func(li store.InvoiceLineItem) interface{} { return li.ExpenseID }),
+		"date":          scalar(func(li store.InvoiceLineItem) interface{} { return formatDate(li.Date) }),
+		"description":   scalar(func(li store.InvoiceLineItem) interface{} { return li.Description }),
+		"hours":         scalar(func(li store.InvoiceLineItem) interface{} { return li.Hours }),
+		"hourlyRate":    scalar(func(li store.InvoiceLineItem) interface{} { return li.HourlyRate }),
+		"roundingHours": scalar(func(li store.InvoiceLineItem) interface{} { return li.RoundingHours }),
+		"amount":        scalar(func(li store.InvoiceLineItem) interface{} { return li.Amount }),
+		"rateSource":    scalar(func(li store.InvoiceLineItem) interface{} { return li.RateSource }),
+	}
+
+	invoice := map[string]graphql.ResolveFunc{
+		"id":                scalar(func(i *store.Invoice) interface{} { return i.ID }),
+		"projectId":         scalar(func(i *store.Invoice) interface{} { return i.ProjectID }),
+		"invoiceNumber":     scalar(func(i *store.Invoice) interface{} { return i.InvoiceNumber }),
+		"kind":              scalar(func(i *store.Invoice) interface{} { return i.Kind }),
+		"originalInvoiceId": scalar(func(i *store.Invoice) interface{} { return i.OriginalInvoiceID }),
+		"status":            scalar(func(i *store.Invoice) interface{} { return i.Status }),
+		"periodStart":       scalar(func(i *store.Invoice) interface{} { return formatDate(i.PeriodStart) }),
+		"periodEnd":         scalar(func(i *store.Invoice) interface{} { return formatDate(i.PeriodEnd) }),
+		"invoiceDate":       scalar(func(i *store.Invoice) interface{} { return formatDate(i.InvoiceDate) }),
+		"totalHours":        scalar(func(i *store.Invoice) interface{} { return i.TotalHours }),
+		"totalAmount":       scalar(func(i *store.Invoice) interface{} { return i.TotalAmount }),
+		"amountPaid":        scalar(func(i *store.Invoice) interface{} { return i.AmountPaid }),
+		"balanceDue":        scalar(func(i *store.Invoice) interface{} { return i.BalanceDue }),
+		"project": func(p graphql.ResolveParams) (interface{}, error) {
+			i := p.Source.(*store.Invoice)
+			return loaderFromContext(p.Context).project(p.Context, i.ProjectID)
+		},
+		"lineItems": func(p graphql.ResolveParams) (interface{}, error) {
+			i := p.Source.(*store.Invoice)
+			return loaderFromContext(p.Context).invoiceLineItems(p.Context, i)
+		},
+	}
+
+	query := map[string]graphql.ResolveFunc{
+		"projects": func(p graphql.ResolveParams) (interface{}, error) {
+			includeArchived, err := boolArg(p.Args, "includeArchived")
+			if err != nil {
+				return nil, err
+			}
+			projects, err := h.projects.List(p.Context, userOf(p.Context), includeArchived)
+			if err != nil {
+				return nil, err
+			}
+			loaderFromContext(p.Context).remember(projects...)
+			return projects, nil
+		},
+		"project": func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := requireIDArg(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			proj, err := loaderFromContext(p.Context).project(p.Context, id)
+			if err == store.ErrProjectNotFound {
+				return nil, nil
+			}
+			return proj, err
+		},
+		"timeEntries": func(p graphql.ResolveParams) (interface{}, error) {
+			start, err := dateArg(p.Args, "startDate")
+			if err != nil {
+				return nil, err
+			}
+			end, err := dateArg(p.Args, "endDate")
+			if err != nil {
+				return nil, err
+			}
+			projectID, err := idArg(p.Args, "projectId")
+			if err != nil {
+				return nil, err
+			}
+			entries, err := h.entries.List(p.Context, userOf(p.Context), start, end, projectID)
+			if err != nil {
+				return nil, err
+			}
+			loaderFromContext(p.Context).rememberEntries(entries)
+			return entries, nil
+		},
+		"calendarEvents": func(p graphql.ResolveParams) (interface{}, error) {
+			start, err := dateArg(p.Args, "startDate")
+			if err != nil {
+				return nil, err
+			}
+			end, err := dateArg(p.Args, "endDate")
+			if err != nil {
+				return nil, err
+			}
+			statusArg, err := stringArg(p.Args, "classificationStatus")
+			if err != nil {
+				return nil, err
+			}
+			var status *store.ClassificationStatus
+			if statusArg != nil {
+				s := store.ClassificationStatus(*statusArg)
+				status = &s
+			}
+			return h.calendarEvents.List(p.Context, userOf(p.Context), start, end, status, nil)
+		},
+		"invoices": func(p graphql.ResolveParams) (interface{}, error) {
+			projectID, err := idArg(p.Args, "projectId")
+			if err != nil {
+				return nil, err
+			}
+			status, err := stringArg(p.Args, "status")
+			if err != nil {
+				return nil, err
+			}
+			invoices, err := h.invoices.List(p.Context, userOf(p.Context), projectID, status)
+			if err != nil {
+				return nil, err
+			}
+			loaderFromContext(p.Context).rememberInvoices(invoices)
+			return invoices, nil
+		},
+		"invoice": func(p graphql.ResolveParams) (interface{}, error) {
+			id, err := requireIDArg(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			inv, err := h.invoices.GetByID(p.Context, userOf(p.Context), id)
+			if err == store.ErrInvoiceNotFound {
+				return nil, nil
+			}
+			return inv, err
+		},
+	}
+
+	return graphql.MustNewSchema(graphQLSchema, graphql.Resolvers{
+		"Query":           query,
+		"Project":         project,
+		"TimeEntry":       timeEntry,
+		"CalendarEvent":   calendarEvent,
+		"InvoiceLineItem": lineItem,
+		"Invoice":         invoice,
+	})
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func serveGraphQL(t *testing.T, h *GraphQLHandler, userID uuid.UUID, query string) map[string]interface{} {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest("POST", "/api/graphql", strings.NewReader(string(body)))
+	if userID != uuid.Nil {
+		req = req.WithContext(authedContext(userID))
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	var resp map[string]interface{}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	resp["status"] = w.Code
+	return resp
+}
+
+func TestGraphQLHandler_RequiresAuth(t *testing.T) {
+	h := NewGraphQLHandler(nil, nil, nil, nil)
+	resp := serveGraphQL(t, h, uuid.Nil, `{ projects { id } }`)
+	if resp["status"] != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %v", resp["status"])
+	}
+}
+
+func TestGraphQLHandler_SchemaIntrospection(t *testing.T) {
+	// NewGraphQLHandler panics if the SDL and the resolvers disagree
+	h := NewGraphQLHandler(nil, nil, nil, nil)
+	resp := serveGraphQL(t, h, uuid.New(), `{ __type(name: "TimeEntry") { fields { name } } }`)
+	if resp["errors"] != nil {
+		t.Fatalf("unexpected errors: %v", resp["errors"])
+	}
+	out, _ := json.Marshal(resp["data"])
+	for _, field := range []string{"contributingEvents", "project", "hours"} {
+		if !strings.Contains(string(out), `"name":"`+field+`"`) {
+			t.Errorf("expected TimeEntry.%s in %s", field, out)
+		}
+	}
+}
+
+// graphQLFixture serves fixed data to GraphQLHandler and counts the store
+// calls it makes
+type graphQLFixture struct {
+	calls     map[string]int
+	projects  []*store.Project
+	entries   []*store.TimeEntry
+	eventIDs  map[uuid.UUID][]uuid.UUID
+	events    map[uuid.UUID]*store.CalendarEvent
+	invoices  []*store.Invoice
+	lineItems map[uuid.UUID][]store.InvoiceLineItem
+}
+
+type fixtureProjects struct {
+	ProjectStore
+	f *graphQLFixture
+}
+
+func (s fixtureProjects) GetByID(ctx context.Context, userID, projectID uuid.UUID) (*store.Project, error) {
+	s.f.calls["projects.GetByID"]++
+	for _, p := range s.f.projects {
+		if p.ID == projectID {
+			return p, nil
+		}
+	}
+	return nil, store.ErrProjectNotFound
+}
+
+func (s fixtureProjects) List(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*store.Project, error) {
+	s.f.calls["projects.List"]++
+	return s.f.projects, nil
+}
+
+type fixtureEntries struct{ f *graphQLFixture }
+
+func (s fixtureEntries) List(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, projectID *uuid.UUID) ([]*store.TimeEntry, error) {
+	s.f.calls["entries.List"]++
+	return s.f.entries, nil
+}
+
+func (s fixtureEntries) ListContributingEvents(ctx context.Context, userID uuid.UUID, entryIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	s.f.calls["entries.ListContributingEvents"]++
+	out := make(map[uuid.UUID][]uuid.UUID)
+	for _, id := range entryIDs {
+		out[id] = s.f.eventIDs[id]
+	}
+	return out, nil
+}
+
+type fixtureEvents struct{ f *graphQLFixture }
+
+func (s fixtureEvents) List(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, status *store.ClassificationStatus, connectionID *uuid.UUID) ([]*store.CalendarEvent, error) {
+	s.f.calls["events.List"]++
+	return nil, nil
+}
+
+func (s fixtureEvents) GetByIDs(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) ([]*store.CalendarEvent, error) {
+	s.f.calls["events.GetByIDs"]++
+	var out []*store.CalendarEvent
+	for _, id := range eventIDs {
+		if e, ok := s.f.events[id]; ok {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+type fixtureInvoices struct{ f *graphQLFixture }
+
+func (s fixtureInvoices) GetByID(ctx context.Context, userID, invoiceID uuid.UUID) (*store.Invoice, error) {
+	s.f.calls["invoices.GetByID"]++
+	return nil, store.ErrInvoiceNotFound
+}
+
+func (s fixtureInvoices) List(ctx context.Context, userID uuid.UUID, projectID *uuid.UUID, status *string) ([]*store.Invoice, error) {
+	s.f.calls["invoices.List"]++
+	return s.f.invoices, nil
+}
+
+func (s fixtureInvoices) ListLineItems(ctx context.Context, userID uuid.UUID, invoiceIDs []uuid.UUID) (map[uuid.UUID][]store.InvoiceLineItem, error) {
+	s.f.calls["invoices.ListLineItems"]++
+	out := make(map[uuid.UUID][]store.InvoiceLineItem)
+	for _, id := range invoiceIDs {
+		out[id] = s.f.lineItems[id]
+	}
+	return out, nil
+}
+
+// newGraphQLFixture creates two projects, each with two time entries backed
+// by calendar events and two invoices with line items. One entry refers to
+// an event that has since been deleted.
+func newGraphQLFixture() *graphQLFixture {
+	f := &graphQLFixture{
+		calls:     make(map[string]int),
+		eventIDs:  make(map[uuid.UUID][]uuid.UUID),
+		events:    make(map[uuid.UUID]*store.CalendarEvent),
+		lineItems: make(map[uuid.UUID][]store.InvoiceLineItem),
+	}
+	shared := &store.CalendarEvent{ID: uuid.New(), Title: "Standup"}
+	f.events[shared.ID] = shared
+	for p := 0; p < 2; p++ {
+		project := &store.Project{ID: uuid.New(), Name: fmt.Sprintf("Project %d", p)}
+		f.projects = append(f.projects, project)
+		for e := 0; e < 2; e++ {
+			entry := &store.TimeEntry{ID: uuid.New(), ProjectID: project.ID}
+			own := &store.CalendarEvent{ID: uuid.New(), Title: fmt.Sprintf("Event %d.%d", p, e)}
+			f.events[own.ID] = own
+			f.eventIDs[entry.ID] = []uuid.UUID{own.ID, shared.ID}
+			f.entries = append(f.entries, entry)
+		}
+		for i := 0; i < 2; i++ {
+			invoice := &store.Invoice{ID: uuid.New(), ProjectID: project.ID}
+			f.lineItems[invoice.ID] = []store.InvoiceLineItem{{ID: uuid.New(), Amount: 100}, {ID: uuid.New(), Amount: 50}}
+			f.invoices = append(f.invoices, invoice)
+		}
+	}
+	f.eventIDs[f.entries[0].ID] = append(f.eventIDs[f.entries[0].ID], uuid.New())
+	return f
+}
+
+func TestGraphQLHandler_BatchesNestedLookups(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  map[string]int
+	}{
+		{
+			name:  "top-level lists",
+			query: `{ timeEntries { contributingEvents { title } } invoices { lineItems { amount } } }`,
+			want: map[string]int{
+				"entries.List": 1, "entries.ListContributingEvents": 1, "events.GetByIDs": 1,
+				"invoices.List": 1, "invoices.ListLineItems": 1,
+			},
+		},
+		{
+			name:  "under every project",
+			query: `{ projects { timeEntries { contributingEvents { title } } invoices { lineItems { amount } } } }`,
+			want: map[string]int{
+				"projects.List": 1, "entries.List": 1, "entries.ListContributingEvents": 1, "events.GetByIDs": 1,
+				"invoices.List": 1, "invoices.ListLineItems": 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newGraphQLFixture()
+			h := NewGraphQLHandler(fixtureProjects{f: f}, fixtureEntries{f}, fixtureEvents{f}, fixtureInvoices{f})
+			resp := serveGraphQL(t, h, uuid.New(), tt.query)
+			if resp["errors"] != nil {
+				t.Fatalf("unexpected errors: %v", resp["errors"])
+			}
+
+			for name, n := range tt.want {
+				if f.calls[name] != n {
+					t.Errorf("expected %d %s calls, got %d", n, name, f.calls[name])
+				}
+			}
+			for name, n := range f.calls {
+				if _, ok := tt.want[name]; !ok {
+					t.Errorf("unexpected %d %s calls", n, name)
+				}
+			}
+
+			out, _ := json.Marshal(resp["data"])
+			if got := strings.Count(string(out), `"title":"Standup"`); got != 4 {
+				t.Errorf("expected the shared event under all 4 entries, got %d in %s", got, out)
+			}
+			if got := strings.Count(string(out), `"title":`); got != 8 {
+				t.Errorf("expected the deleted event to be skipped, got %d events in %s", got, out)
+			}
+			if got := strings.Count(string(out), `"amount":100`); got != 4 {
+				t.Errorf("expected line items for all 4 invoices, got %d in %s", got, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// IdempotencyKeyHeader is the request header clients set to make a create
+// request safe to retry
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength bounds client-supplied keys
+const maxIdempotencyKeyLength = 255
+
+// IdempotentRoute is a method and path pattern covered by idempotency keys.
+// Path segments in braces match any single segment, e.g.
+// "/api/calendar-events/{id}/classify".
+type IdempotentRoute struct {
+	Method  string
+	Pattern string
+}
+
+func (rt IdempotentRoute) matches(method, path string) bool {
+	return method == rt.Method && matchPathPattern(rt.Pattern, path)
+}
+
+// matchPathPattern reports whether path matches a pattern whose segments in
+// braces match any single non-empty segment
+func matchPathPattern(pattern, path string) bool {
+	want := strings.Split(pattern, "/")
+	got := strings.Split(path, "/")
+	if len(want) != len(got) {
+		return false
+	}
+	for i, seg := range want {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			if got[i] == "" {
+				return false
+			}
+			continue
+		}
+		if seg != got[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IdempotencyMiddleware replays the stored response when an authenticated
+// request on one of the routes repeats an Idempotency-Key seen in the last
+// 24 hours. Reusing a key for a different request body is rejected, as is a
+// retry that arrives while the original is still running. Server errors are
+// not stored, so the client can retry them with the same key, and neither
+// is a request whose handler panicked.
+func IdempotencyMiddleware(keys IdempotencyKeyStore, routes ...IdempotentRoute) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" || !idempotentRoute(routes, r.Method, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			userID, ok := UserIDFromContext(r.Context())
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				writeIdempotencyError(w, http.StatusBadRequest, "invalid_idempotency_key", "Idempotency-Key must be at most 255 characters")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeIdempotencyError(w, http.StatusBadRequest, "invalid_request", "Failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			sum := sha256.Sum256(body)
+			requestHash := hex.EncodeToString(sum[:])
+
+			stored, claimedAt, err := keys.Claim(r.Context(), userID, key, r.Method, r.URL.Path, requestHash)
+			switch {
+			case errors.Is(err, store.ErrIdempotencyKeyInProgress):
+				writeIdempotencyError(w, http.StatusConflict, "idempotency_conflict", err.Error())
+				return
+			case errors.Is(err, store.ErrIdempotencyKeyMismatch):
+				writeIdempotencyError(w, http.StatusUnprocessableEntity, "idempotency_mismatch", err.Error())
+				return
+			case err != nil:
+				log.Printf("Idempotency key lookup failed: %v", err)
+				writeIdempotencyError(w, http.StatusInternalServerError, "internal_error", "Failed to check idempotency key")
+				return
+			}
+
+			if stored != nil {
+				if stored.ContentType != "" {
+					w.Header().Set("Content-Type", stored.ContentType)
+				}
+				w.Header().Set("Idempotent-Replayed", "true")
+				w.WriteHeader(stored.StatusCode)
+				w.Write(stored.Body)
+				return
+			}
+
+			// Persist independently of the client connection so a dropped
+			// connection still leaves a replayable result
+			ctx := context.WithoutCancel(r.Context())
+			release := func() {
+				if err := keys.Release(ctx, userID, key, claimedAt); err != nil {
+					log.Printf("Failed to release idempotency key: %v", err)
+				}
+			}
+
+			// If the handler panics the claim is released before the panic
+			// carries on to the recoverer, so a retry isn't refused as in
+			// progress
+			finished := false
+			defer func() {
+				if !finished {
+					release()
+				}
+			}()
+
+			rec := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			finished = true
+
+			if rec.status >= http.StatusInternalServerError {
+				release()
+			} else {
+				resp := &store.IdempotentResponse{
+					StatusCode:  rec.status,
+					ContentType: rec.header.Get("Content-Type"),
+					Body:        rec.body.Bytes(),
+				}
+				if err := keys.Complete(ctx, userID, key, claimedAt, resp); err != nil {
+					log.Printf("Failed to store idempotent response: %v", err)
+				}
+			}
+
+			for k, v := range rec.header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(rec.status)
+			w.Write(rec.body.Bytes())
+		})
+	}
+}
+
+func idempotentRoute(routes []IdempotentRoute, method, path string) bool {
+	for _, rt := range routes {
+		if rt.matches(method, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeIdempotencyError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"code":    code,
+		"message": message,
+	})
+}
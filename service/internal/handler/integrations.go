@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// integrationProviders lists the providers whose credentials the vault
+// accepts, with the credential fields each needs
+var integrationProviders = map[string][]string{
+	"slack":      {"bot_token"},
+	"jira":       {"site_url", "email", "api_token"},
+	"quickbooks": {"realm_id", "access_token", "refresh_token"},
+}
+
+// validateIntegrationCredentials checks a provider is known and its
+// required credential fields are present, returning the trimmed fields
+func validateIntegrationCredentials(provider string, credentials map[string]string) (map[string]string, error) {
+	required, ok := integrationProviders[provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown integration provider %q", provider)
+	}
+	trimmed := make(map[string]string, len(credentials))
+	for k, v := range credentials {
+		if v = strings.TrimSpace(v); v != "" {
+			trimmed[k] = v
+		}
+	}
+	for _, field := range required {
+		if trimmed[field] == "" {
+			return nil, fmt.Errorf("%s credentials need %s", provider, field)
+		}
+	}
+	return trimmed, nil
+}
+
+// IntegrationHandler implements the generic integration endpoints backed by
+// the credentials vault. The store is nil when encryption is not
+// configured, since credentials are only stored encrypted.
+type IntegrationHandler struct {
+	credentials *store.IntegrationCredentialStore
+}
+
+// NewIntegrationHandler creates a new integration handler
+func NewIntegrationHandler(credentials *store.IntegrationCredentialStore) *IntegrationHandler {
+	return &IntegrationHandler{credentials: credentials}
+}
+
+// ListIntegrations returns the user's connected integrations
+func (h *IntegrationHandler) ListIntegrations(ctx context.Context, req api.ListIntegrationsRequestObject) (api.ListIntegrationsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListIntegrations401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	result := []api.IntegrationConnection{}
+	if h.credentials == nil {
+		return api.ListIntegrations200JSONResponse(result), nil
+	}
+
+	creds, err := h.credentials.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range creds {
+		result = append(result, integrationConnectionToAPI(c))
+	}
+	return api.ListIntegrations200JSONResponse(result), nil
+}
+
+// ConnectIntegration stores or rotates the credentials for a provider
+func (h *IntegrationHandler) ConnectIntegration(ctx context.Context, req api.ConnectIntegrationRequestObject) (api.ConnectIntegrationResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ConnectIntegration401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if h.credentials == nil {
+		return api.ConnectIntegration400JSONResponse{
+			Code:    "not_configured",
+			Message: "Integrations need encryption to be configured",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.ConnectIntegration400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	credentials, err := validateIntegrationCredentials(req.Provider, req.Body.Credentials)
+	if err != nil {
+		return api.ConnectIntegration400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	payload, err := json.Marshal(credentials)
+	if err != nil {
+		return nil, err
+	}
+	var scopes []string
+	if req.Body.Scopes != nil {
+		scopes = *req.Body.Scopes
+	}
+
+	cred, err := h.credentials.Put(ctx, userID, req.Provider, scopes, payload, req.Body.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return api.ConnectIntegration200JSONResponse(integrationConnectionToAPI(cred)), nil
+}
+
+// DisconnectIntegration deletes the credentials for a provider
+func (h *IntegrationHandler) DisconnectIntegration(ctx context.Context, req api.DisconnectIntegrationRequestObject) (api.DisconnectIntegrationResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DisconnectIntegration401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if h.credentials == nil {
+		return api.DisconnectIntegration404JSONResponse{
+			Code:    "not_configured",
+			Message: "Integrations need encryption to be configured",
+		}, nil
+	}
+
+	if err := h.credentials.Delete(ctx, userID, req.Provider); err != nil {
+		if errors.Is(err, store.ErrIntegrationNotConnected) {
+			return api.DisconnectIntegration404JSONResponse{
+				Code:    "not_found",
+				Message: fmt.Sprintf("%s is not connected", req.Provider),
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DisconnectIntegration204Response{}, nil
+}
+
+func integrationConnectionToAPI(c *store.IntegrationCredential) api.IntegrationConnection {
+	return api.IntegrationConnection{
+		Provider:    c.Provider,
+		Scopes:      c.Scopes,
+		KeyVersion:  c.KeyVersion,
+		ConnectedAt: c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+		RotatedAt:   c.RotatedAt,
+		ExpiresAt:   c.ExpiresAt,
+		LastUsedAt:  c.LastUsedAt,
+	}
+}
@@ -0,0 +1,28 @@
+package handler
+
+import "testing"
+
+func TestValidateIntegrationCredentials(t *testing.T) {
+	tests := []struct {
+		provider    string
+		credentials map[string]string
+		wantErr     bool
+	}{
+		{"slack", map[string]string{"bot_token": " xoxb-1 "}, false},
+		{"slack", map[string]string{"bot_token": "  "}, true},
+		{"jira", map[string]string{"site_url": "https://x.atlassian.net", "email": "me@example.com"}, true},
+		{"jira", map[string]string{"site_url": "https://x.atlassian.net", "email": "me@example.com", "api_token": "t"}, false},
+		{"github", map[string]string{"token": "t"}, true},
+		{"trello", map[string]string{}, true},
+	}
+	for _, tt := range tests {
+		got, err := validateIntegrationCredentials(tt.provider, tt.credentials)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateIntegrationCredentials(%s, %v) error = %v, wantErr %v", tt.provider, tt.credentials, err, tt.wantErr)
+			continue
+		}
+		if err == nil && tt.provider == "slack" && got["bot_token"] != "xoxb-1" {
+			t.Errorf("expected trimmed bot_token, got %q", got["bot_token"])
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// InvoiceCommentHandler implements the invoice review comment endpoints
+type InvoiceCommentHandler struct {
+	comments *store.InvoiceCommentStore
+	invoices *store.InvoiceStore
+}
+
+// NewInvoiceCommentHandler creates a new invoice comment handler
+func NewInvoiceCommentHandler(comments *store.InvoiceCommentStore, invoices *store.InvoiceStore) *InvoiceCommentHandler {
+	return &InvoiceCommentHandler{
+		comments: comments,
+		invoices: invoices,
+	}
+}
+
+// ListInvoiceComments returns the review comments on an invoice
+func (h *InvoiceCommentHandler) ListInvoiceComments(ctx context.Context, req api.ListInvoiceCommentsRequestObject) (api.ListInvoiceCommentsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListInvoiceComments401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the invoice exists so an unknown ID isn't reported as "no comments"
+	if _, err := h.invoices.GetByID(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.ListInvoiceComments404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	comments, err := h.comments.List(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.InvoiceComment, len(comments))
+	for i, c := range comments {
+		result[i] = invoiceCommentToAPI(c)
+	}
+
+	return api.ListInvoiceComments200JSONResponse(result), nil
+}
+
+// AddInvoiceComment leaves a review comment on an invoice
+func (h *InvoiceCommentHandler) AddInvoiceComment(ctx context.Context, req api.AddInvoiceCommentRequestObject) (api.AddInvoiceCommentResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.AddInvoiceComment401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil || strings.TrimSpace(req.Body.Body) == "" {
+		return api.AddInvoiceComment400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Comment body is required",
+		}, nil
+	}
+
+	comment, err := h.comments.Add(ctx, userID, req.Id, strings.TrimSpace(req.Body.Body))
+	if err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.AddInvoiceComment404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.AddInvoiceComment201JSONResponse(invoiceCommentToAPI(comment)), nil
+}
+
+// DeleteInvoiceComment removes a review comment from an invoice
+func (h *InvoiceCommentHandler) DeleteInvoiceComment(ctx context.Context, req api.DeleteInvoiceCommentRequestObject) (api.DeleteInvoiceCommentResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteInvoiceComment401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.comments.Delete(ctx, userID, req.Id, req.CommentId); err != nil {
+		if errors.Is(err, store.ErrInvoiceCommentNotFound) {
+			return api.DeleteInvoiceComment404JSONResponse{
+				Code:    "not_found",
+				Message: "Comment not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteInvoiceComment204Response{}, nil
+}
+
+func invoiceCommentToAPI(c *store.InvoiceComment) api.InvoiceComment {
+	return api.InvoiceComment{
+		Id:        c.ID,
+		InvoiceId: c.InvoiceID,
+		UserId:    c.UserID,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestInvoiceCommentHandler_RequiresAuth(t *testing.T) {
+	h := NewInvoiceCommentHandler(nil, nil)
+	ctx := context.Background()
+
+	list, err := h.ListInvoiceComments(ctx, api.ListInvoiceCommentsRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := list.(api.ListInvoiceComments401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", list)
+	}
+
+	del, err := h.DeleteInvoiceComment(ctx, api.DeleteInvoiceCommentRequestObject{Id: uuid.New(), CommentId: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := del.(api.DeleteInvoiceComment401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", del)
+	}
+}
+
+// The body check runs before the store is touched, so the handler is built
+// without one
+func TestInvoiceCommentHandler_AddRequiresBody(t *testing.T) {
+	h := NewInvoiceCommentHandler(nil, nil)
+	ctx := authedContext(uuid.New())
+
+	tests := []struct {
+		name string
+		body *api.InvoiceCommentCreate
+	}{
+		{"no body", nil},
+		{"empty", &api.InvoiceCommentCreate{}},
+		{"blank", &api.InvoiceCommentCreate{Body: "  \n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.AddInvoiceComment(ctx, api.AddInvoiceCommentRequestObject{Id: uuid.New(), Body: tt.body})
+			if err != nil {
+				t.Fatalf("AddInvoiceComment: %v", err)
+			}
+			if _, ok := resp.(api.AddInvoiceComment400JSONResponse); !ok {
+				t.Errorf("expected 400, got %T", resp)
+			}
+		})
+	}
+}
+
+func TestInvoiceCommentToAPI(t *testing.T) {
+	c := &store.InvoiceComment{
+		ID:        uuid.New(),
+		InvoiceID: uuid.New(),
+		UserID:    uuid.New(),
+		Body:      "Check the hours",
+		CreatedAt: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC),
+	}
+
+	got := invoiceCommentToAPI(c)
+	if got.Id != c.ID || got.InvoiceId != c.InvoiceID || got.UserId != c.UserID || got.Body != c.Body || !got.CreatedAt.Equal(c.CreatedAt) {
+		t.Errorf("unexpected conversion: %+v", got)
+	}
+}
@@ -3,14 +3,13 @@ package handler
 import (
 	"bytes"
 	"context"
-	"encoding/csv"
 	"errors"
 	"fmt"
-	"io"
+	"strings"
 	"time"
 
 	"github.com/michaelw/timesheet-app/service/internal/api"
-	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/export"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 	"github.com/michaelw/timesheet-app/service/internal/timeentry"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -20,18 +19,18 @@ import (
 type InvoiceHandler struct {
 	invoices         *store.InvoiceStore
 	projects         *store.ProjectStore
-	sheets           *google.SheetsService
-	calendars        *store.CalendarConnectionStore
+	exports          *export.Service
+	exportStore      *store.InvoiceExportStore
 	timeEntryService *timeentry.Service
 }
 
 // NewInvoiceHandler creates a new invoice handler
-func NewInvoiceHandler(invoices *store.InvoiceStore, projects *store.ProjectStore, sheets *google.SheetsService, calendars *store.CalendarConnectionStore, timeEntrySvc *timeentry.Service) *InvoiceHandler {
+func NewInvoiceHandler(invoices *store.InvoiceStore, projects *store.ProjectStore, exports *export.Service, exportStore *store.InvoiceExportStore, timeEntrySvc *timeentry.Service) *InvoiceHandler {
 	return &InvoiceHandler{
 		invoices:         invoices,
 		projects:         projects,
-		sheets:           sheets,
-		calendars:        calendars,
+		exports:          exports,
+		exportStore:      exportStore,
 		timeEntryService: timeEntrySvc,
 	}
 }
@@ -210,26 +209,57 @@ func (h *InvoiceHandler) UpdateInvoiceStatus(ctx context.Context, req api.Update
 				Message: "Invalid status value",
 			}, nil
 		}
+		if errors.Is(err, store.ErrStatusTransition) {
+			return api.UpdateInvoiceStatus409JSONResponse{
+				Code:    "invalid_transition",
+				Message: "Invoice cannot move from its current status to " + string(req.Body.Status),
+			}, nil
+		}
+		if errors.Is(err, store.ErrHasCreditNotes) {
+			return api.UpdateInvoiceStatus409JSONResponse{
+				Code:    "has_credit_notes",
+				Message: "Invoices with credit notes cannot return to draft",
+			}, nil
+		}
+		if errors.Is(err, store.ErrHasPayments) {
+			return api.UpdateInvoiceStatus409JSONResponse{
+				Code:    "has_payments",
+				Message: "Invoices with payments cannot return to draft",
+			}, nil
+		}
 		return nil, err
 	}
 
 	return api.UpdateInvoiceStatus200JSONResponse(invoiceToAPI(invoice)), nil
 }
 
-// ExportInvoiceCSV generates and returns a CSV export of an invoice
-func (h *InvoiceHandler) ExportInvoiceCSV(ctx context.Context, req api.ExportInvoiceCSVRequestObject) (api.ExportInvoiceCSVResponseObject, error) {
+// AddInvoiceAdjustment adds a fixed-amount line item to a draft invoice
+func (h *InvoiceHandler) AddInvoiceAdjustment(ctx context.Context, req api.AddInvoiceAdjustmentRequestObject) (api.AddInvoiceAdjustmentResponseObject, error) {
 	userID, ok := UserIDFromContext(ctx)
 	if !ok {
-		return api.ExportInvoiceCSV401JSONResponse{
+		return api.AddInvoiceAdjustment401JSONResponse{
 			Code:    "unauthorized",
 			Message: "Authentication required",
 		}, nil
 	}
 
+	if req.Body == nil || req.Body.Description == "" {
+		return api.AddInvoiceAdjustment400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Description and amount are required",
+		}, nil
+	}
+	if req.Body.Amount == 0 {
+		return api.AddInvoiceAdjustment400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Adjustment amount cannot be zero",
+		}, nil
+	}
+
 	invoice, err := h.invoices.GetByID(ctx, userID, req.Id)
 	if err != nil {
 		if errors.Is(err, store.ErrInvoiceNotFound) {
-			return api.ExportInvoiceCSV404JSONResponse{
+			return api.AddInvoiceAdjustment404JSONResponse{
 				Code:    "not_found",
 				Message: "Invoice not found",
 			}, nil
@@ -237,255 +267,384 @@ func (h *InvoiceHandler) ExportInvoiceCSV(ctx context.Context, req api.ExportInv
 		return nil, err
 	}
 
-	// Generate CSV
-	var buf bytes.Buffer
-	w := csv.NewWriter(&buf)
-
-	// Write header rows
-	w.Write([]string{"Invoice Number:", invoice.InvoiceNumber})
-	if invoice.Project != nil {
-		w.Write([]string{"Project:", invoice.Project.Name})
-		if invoice.Project.Client != nil && *invoice.Project.Client != "" {
-			w.Write([]string{"Client:", *invoice.Project.Client})
-		}
-	}
-	w.Write([]string{"Period:", fmt.Sprintf("%s to %s", invoice.PeriodStart.Format("2006-01-02"), invoice.PeriodEnd.Format("2006-01-02"))})
-	w.Write([]string{"Invoice Date:", invoice.InvoiceDate.Format("2006-01-02")})
-	w.Write([]string{"Status:", invoice.Status})
-	w.Write([]string{}) // Empty row
-
-	// Write column headers
-	w.Write([]string{"Date", "Description", "Hours", "Rate", "Amount"})
-
-	// Write line items, filtering out 0h entries (matching UI default)
-	var totalHours, totalAmount float64
-	for _, item := range invoice.LineItems {
-		if item.Hours > 0 {
-			w.Write([]string{
-				item.Date.Format("2006-01-02"),
-				item.Description,
-				fmt.Sprintf("%.2f", item.Hours),
-				fmt.Sprintf("%.2f", item.HourlyRate),
-				fmt.Sprintf("%.2f", item.Amount),
-			})
-			totalHours += item.Hours
-			totalAmount += item.Amount
-		}
-	}
-
-	// Write totals row
-	w.Write([]string{}) // Empty row
-	w.Write([]string{
-		"Total",
-		"",
-		fmt.Sprintf("%.2f", totalHours),
-		"",
-		fmt.Sprintf("%.2f", totalAmount),
-	})
+	date := invoice.InvoiceDate
+	if req.Body.Date != nil {
+		date = req.Body.Date.Time
+	}
 
-	w.Flush()
-	if err := w.Error(); err != nil {
+	invoice, err = h.invoices.AddAdjustment(ctx, userID, req.Id, date, req.Body.Description, float64(req.Body.Amount))
+	if err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.AddInvoiceAdjustment404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrInvoiceNotDraft) {
+			return api.AddInvoiceAdjustment409JSONResponse{
+				Code:    "not_draft",
+				Message: "Adjustments can only be added to draft invoices; issue a credit note instead",
+			}, nil
+		}
+		if errors.Is(err, store.ErrCreditExceedsTotal) {
+			return api.AddInvoiceAdjustment409JSONResponse{
+				Code:    "credit_exceeds_total",
+				Message: "Credits would exceed the original invoice total",
+			}, nil
+		}
 		return nil, err
 	}
 
-	// Return as CSV response
-	return api.ExportInvoiceCSV200TextcsvResponse{
-		Body: io.NopCloser(&buf),
-	}, nil
+	return api.AddInvoiceAdjustment201JSONResponse(invoiceToAPI(invoice)), nil
 }
 
-// ExportInvoiceSheets exports an invoice to Google Sheets
-func (h *InvoiceHandler) ExportInvoiceSheets(ctx context.Context, req api.ExportInvoiceSheetsRequestObject) (api.ExportInvoiceSheetsResponseObject, error) {
+// DeleteInvoiceAdjustment removes an adjustment line item from a draft invoice
+func (h *InvoiceHandler) DeleteInvoiceAdjustment(ctx context.Context, req api.DeleteInvoiceAdjustmentRequestObject) (api.DeleteInvoiceAdjustmentResponseObject, error) {
 	userID, ok := UserIDFromContext(ctx)
 	if !ok {
-		return api.ExportInvoiceSheets401JSONResponse{
+		return api.DeleteInvoiceAdjustment401JSONResponse{
 			Code:    "unauthorized",
 			Message: "Authentication required",
 		}, nil
 	}
 
-	// Get invoice
-	invoice, err := h.invoices.GetByID(ctx, userID, req.Id)
+	invoice, err := h.invoices.RemoveAdjustment(ctx, userID, req.Id, req.LineItemId)
 	if err != nil {
 		if errors.Is(err, store.ErrInvoiceNotFound) {
-			return api.ExportInvoiceSheets404JSONResponse{
+			return api.DeleteInvoiceAdjustment404JSONResponse{
 				Code:    "not_found",
 				Message: "Invoice not found",
 			}, nil
 		}
+		if errors.Is(err, store.ErrLineItemNotFound) {
+			return api.DeleteInvoiceAdjustment404JSONResponse{
+				Code:    "not_found",
+				Message: "Line item not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrInvoiceNotDraft) {
+			return api.DeleteInvoiceAdjustment409JSONResponse{
+				Code:    "not_draft",
+				Message: "Adjustments can only be removed from draft invoices",
+			}, nil
+		}
+		if errors.Is(err, store.ErrNotAdjustment) {
+			return api.DeleteInvoiceAdjustment409JSONResponse{
+				Code:    "not_adjustment",
+				Message: "Only adjustment line items can be removed",
+			}, nil
+		}
+		if errors.Is(err, store.ErrCreditExceedsTotal) {
+			return api.DeleteInvoiceAdjustment409JSONResponse{
+				Code:    "credit_exceeds_total",
+				Message: "Credit note total must remain a credit",
+			}, nil
+		}
 		return nil, err
 	}
 
-	// Get OAuth credentials from calendar connection
-	// First get list to find connection ID, then get full connection with credentials
-	conns, err := h.calendars.List(ctx, userID)
-	if err != nil {
-		return nil, err
-	}
-	if len(conns) == 0 {
-		return api.ExportInvoiceSheets401JSONResponse{
-			Code:    "no_connection",
-			Message: "No Google Calendar connection found. Please connect your calendar first.",
+	return api.DeleteInvoiceAdjustment200JSONResponse(invoiceToAPI(invoice)), nil
+}
+
+// CreateCreditNote issues a credit note against a sent or paid invoice
+func (h *InvoiceHandler) CreateCreditNote(ctx context.Context, req api.CreateCreditNoteRequestObject) (api.CreateCreditNoteResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateCreditNote401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
 		}, nil
 	}
-	// Get full connection with credentials (List doesn't include credentials for security)
-	conn, err := h.calendars.GetByID(ctx, userID, conns[0].ID)
+
+	invoiceDate := time.Now().UTC()
+	var lines []store.CreditNoteLine
+	if req.Body != nil {
+		if req.Body.InvoiceDate != nil {
+			invoiceDate = req.Body.InvoiceDate.Time
+		}
+		if req.Body.Lines != nil {
+			for _, line := range *req.Body.Lines {
+				if line.Description == "" || line.Amount <= 0 {
+					return api.CreateCreditNote400JSONResponse{
+						Code:    "invalid_request",
+						Message: "Each credit line needs a description and a positive amount",
+					}, nil
+				}
+				lines = append(lines, store.CreditNoteLine{
+					Description: line.Description,
+					Amount:      float64(line.Amount),
+				})
+			}
+		}
+	}
+
+	creditNote, err := h.invoices.CreateCreditNote(ctx, userID, req.Id, invoiceDate, lines)
 	if err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.CreateCreditNote404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrCreditNoteTarget) {
+			return api.CreateCreditNote409JSONResponse{
+				Code:    "invalid_target",
+				Message: "Credit notes can only be issued against sent or paid invoices",
+			}, nil
+		}
+		if errors.Is(err, store.ErrCreditExceedsTotal) {
+			return api.CreateCreditNote409JSONResponse{
+				Code:    "credit_exceeds_total",
+				Message: "Credits would exceed the original invoice total",
+			}, nil
+		}
 		return nil, err
 	}
 
-	// Create OAuth token
-	token := h.sheets.TokenFromConnection(conn)
+	return api.CreateCreditNote201JSONResponse(invoiceToAPI(creditNote)), nil
+}
 
-	// Prepare invoice data, filtering out 0h entries (matching UI default)
-	invoiceData := google.InvoiceData{
-		InvoiceNumber: invoice.InvoiceNumber,
-		ProjectName:   invoice.Project.Name,
-		PeriodStart:   invoice.PeriodStart,
-		PeriodEnd:     invoice.PeriodEnd,
-		InvoiceDate:   invoice.InvoiceDate,
-		Status:        invoice.Status,
-	}
-	if invoice.Project.Client != nil {
-		invoiceData.Client = *invoice.Project.Client
-	}
-	// Filter to only include entries with hours > 0
-	for _, item := range invoice.LineItems {
-		if item.Hours > 0 {
-			invoiceData.LineItems = append(invoiceData.LineItems, google.InvoiceLineItemData{
-				Date:        item.Date,
-				Description: item.Description,
-				Hours:       item.Hours,
-				HourlyRate:  item.HourlyRate,
-				Amount:      item.Amount,
-			})
-			invoiceData.TotalHours += item.Hours
-			invoiceData.TotalAmount += item.Amount
-		}
+// ExportInvoiceCSV generates and returns a CSV export of an invoice
+func (h *InvoiceHandler) ExportInvoiceCSV(ctx context.Context, req api.ExportInvoiceCSVRequestObject) (api.ExportInvoiceCSVResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ExportInvoiceCSV401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
 	}
 
-	// Check if project already has a spreadsheet
-	project, err := h.projects.GetByID(ctx, userID, invoice.ProjectID)
+	exported, err := h.exports.Export(ctx, userID, req.Id, export.FormatCSV, export.Options{})
 	if err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.ExportInvoiceCSV404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
 		return nil, err
 	}
 
-	var spreadsheetID string
-	var spreadsheetURL string
+	// Return as CSV response
+	return api.ExportInvoiceCSV200TextcsvResponse{
+		Body:          bytes.NewReader(exported.Content),
+		ContentLength: int64(len(exported.Content)),
+	}, nil
+}
+
+// ExportInvoiceSheets exports an invoice to Google Sheets
+func (h *InvoiceHandler) ExportInvoiceSheets(ctx context.Context, req api.ExportInvoiceSheetsRequestObject) (api.ExportInvoiceSheetsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ExportInvoiceSheets401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
 
-	if project.SheetsSpreadsheetID == nil || *project.SheetsSpreadsheetID == "" {
-		// Create new spreadsheet
-		title := fmt.Sprintf("%s - Invoices", project.Name)
-		spreadsheetID, spreadsheetURL, err = h.sheets.CreateSpreadsheet(ctx, token, title)
-		if err != nil {
+	exported, err := h.exports.Export(ctx, userID, req.Id, export.FormatSheets, export.Options{})
+	if err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
 			return api.ExportInvoiceSheets404JSONResponse{
-				Code:    "sheets_error",
-				Message: fmt.Sprintf("Failed to create spreadsheet: %s", err.Error()),
+				Code:    "not_found",
+				Message: "Invoice not found",
 			}, nil
 		}
-
-		// Update project with spreadsheet info
-		updates := map[string]interface{}{
-			"sheets_spreadsheet_id":  spreadsheetID,
-			"sheets_spreadsheet_url": spreadsheetURL,
+		if errors.Is(err, export.ErrNoConnection) {
+			return api.ExportInvoiceSheets401JSONResponse{
+				Code:    "no_connection",
+				Message: "No Google Calendar connection found. Please connect your calendar first.",
+			}, nil
 		}
-		_, err = h.projects.Update(ctx, userID, project.ID, updates)
-		if err != nil {
-			return nil, err
+		if errors.Is(err, export.ErrConsentRequired) {
+			return api.ExportInvoiceSheets401JSONResponse{
+				Code:    "consent_required",
+				Message: "Google Sheets access hasn't been granted. Authorize it with access=sheets first.",
+			}, nil
 		}
-	} else {
-		spreadsheetID = *project.SheetsSpreadsheetID
-		if project.SheetsSpreadsheetURL != nil {
-			spreadsheetURL = *project.SheetsSpreadsheetURL
+		if errors.Is(err, export.ErrExportFailed) {
+			return api.ExportInvoiceSheets404JSONResponse{
+				Code:    "sheets_error",
+				Message: err.Error(),
+			}, nil
 		}
+		return nil, err
 	}
 
-	// Check if invoice already has a worksheet (re-export)
-	worksheetTitle := invoice.InvoiceNumber
-	var worksheetID int
+	var worksheetID *int
+	if id, ok := exported.Metadata["worksheet_id"].(int); ok {
+		worksheetID = &id
+	}
 
-	if invoice.WorksheetID != nil {
-		// Update existing worksheet
-		worksheetID, err = h.sheets.UpdateInvoiceWorksheet(ctx, token, spreadsheetID, worksheetTitle, invoiceData)
-		if err != nil {
-			return api.ExportInvoiceSheets404JSONResponse{
-				Code:    "sheets_error",
-				Message: fmt.Sprintf("Failed to update worksheet: %s", err.Error()),
+	return api.ExportInvoiceSheets200JSONResponse{
+		SpreadsheetId:  exported.ExternalID,
+		SpreadsheetUrl: exported.ExternalURL,
+		WorksheetId:    worksheetID,
+	}, nil
+}
+
+// ExportInvoice renders an invoice in any registered format
+func (h *InvoiceHandler) ExportInvoice(ctx context.Context, req api.ExportInvoiceRequestObject) (api.ExportInvoiceResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ExportInvoice401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	exported, err := h.exports.Export(ctx, userID, req.Id, string(req.Params.Format), export.Options{
+		IncludeNotes: req.Params.IncludeNotes != nil && *req.Params.IncludeNotes,
+	})
+	if err != nil {
+		if errors.Is(err, export.ErrUnknownFormat) {
+			return api.ExportInvoice400JSONResponse{
+				Code:    "unknown_format",
+				Message: fmt.Sprintf("Unsupported export format; available formats: %s", strings.Join(h.exports.Formats(), ", ")),
 			}, nil
 		}
-	} else {
-		// Create new worksheet
-		worksheetID, err = h.sheets.CreateInvoiceWorksheet(ctx, token, spreadsheetID, worksheetTitle, invoiceData)
-		if err != nil {
-			return api.ExportInvoiceSheets404JSONResponse{
-				Code:    "sheets_error",
-				Message: fmt.Sprintf("Failed to create worksheet: %s", err.Error()),
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.ExportInvoice404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
 			}, nil
 		}
+		if errors.Is(err, export.ErrNoConnection) {
+			return api.ExportInvoice400JSONResponse{
+				Code:    "no_connection",
+				Message: "No Google Calendar connection found. Please connect your calendar first.",
+			}, nil
+		}
+		if errors.Is(err, export.ErrConsentRequired) {
+			return api.ExportInvoice400JSONResponse{
+				Code:    "consent_required",
+				Message: "Google Sheets access hasn't been granted. Authorize it with access=sheets first.",
+			}, nil
+		}
+		if errors.Is(err, export.ErrExportFailed) {
+			return api.ExportInvoice502JSONResponse{
+				Code:    "export_failed",
+				Message: err.Error(),
+			}, nil
+		}
+		return nil, err
 	}
 
-	// Update invoice with spreadsheet info
-	err = h.invoices.UpdateSpreadsheetInfo(ctx, userID, invoice.ID, spreadsheetID, spreadsheetURL, worksheetID)
-	if err != nil {
+	return api.ExportInvoice200JSONResponse(invoiceExportToAPI(exported)), nil
+}
+
+// ListInvoiceExports returns the latest export of an invoice in each format
+func (h *InvoiceHandler) ListInvoiceExports(ctx context.Context, req api.ListInvoiceExportsRequestObject) (api.ListInvoiceExportsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListInvoiceExports401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if _, err := h.invoices.GetByID(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.ListInvoiceExports404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
 		return nil, err
 	}
 
-	// Update the Invoices summary sheet with all exported invoices for this project
-	allInvoices, err := h.invoices.List(ctx, userID, &invoice.ProjectID, nil)
+	exports, err := h.exportStore.ListByInvoice(ctx, userID, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter to invoices that have been exported (have worksheet_id)
-	var summaryData []google.InvoiceSummaryData
-	for _, inv := range allInvoices {
-		if inv.WorksheetID != nil {
-			summaryData = append(summaryData, google.InvoiceSummaryData{
-				InvoiceNumber: inv.InvoiceNumber,
-				PeriodStart:   inv.PeriodStart,
-				PeriodEnd:     inv.PeriodEnd,
-				InvoiceDate:   inv.InvoiceDate,
-				Status:        inv.Status,
-				TotalHours:    inv.TotalHours,
-				TotalAmount:   inv.TotalAmount,
-			})
-		}
+	result := make([]api.InvoiceExport, len(exports))
+	for i, e := range exports {
+		result[i] = invoiceExportToAPI(e)
 	}
 
-	err = h.sheets.UpdateInvoicesSummary(ctx, token, spreadsheetID, summaryData)
+	return api.ListInvoiceExports200JSONResponse(result), nil
+}
+
+// DownloadInvoiceExport returns the rendered file of a file-format export
+func (h *InvoiceHandler) DownloadInvoiceExport(ctx context.Context, req api.DownloadInvoiceExportRequestObject) (api.DownloadInvoiceExportResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DownloadInvoiceExport401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	exported, err := h.exportStore.GetByID(ctx, userID, req.Id)
 	if err != nil {
-		// Log but don't fail the export if summary update fails
-		// The individual invoice sheet was already created successfully
-		fmt.Printf("Warning: failed to update Invoices summary sheet: %v\n", err)
+		if errors.Is(err, store.ErrInvoiceExportNotFound) {
+			return api.DownloadInvoiceExport404JSONResponse{
+				Code:    "not_found",
+				Message: "Export not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if exported.Content == nil {
+		return api.DownloadInvoiceExport404JSONResponse{
+			Code:    "not_downloadable",
+			Message: "This export has no downloadable file",
+		}, nil
 	}
 
-	return api.ExportInvoiceSheets200JSONResponse{
-		SpreadsheetId:  &spreadsheetID,
-		SpreadsheetUrl: &spreadsheetURL,
-		WorksheetId:    &worksheetID,
+	return api.DownloadInvoiceExport200ApplicationoctetStreamResponse{
+		Body:          bytes.NewReader(exported.Content),
+		ContentLength: int64(len(exported.Content)),
+		Headers: api.DownloadInvoiceExport200ResponseHeaders{
+			ContentDisposition: fmt.Sprintf("attachment; filename=%q", exported.Filename),
+		},
 	}, nil
 }
 
+// invoiceExportToAPI converts a store InvoiceExport to an API InvoiceExport
+func invoiceExportToAPI(e *store.InvoiceExport) api.InvoiceExport {
+	return api.InvoiceExport{
+		Id:           e.ID,
+		InvoiceId:    e.InvoiceID,
+		Format:       e.Format,
+		ContentType:  e.ContentType,
+		Filename:     e.Filename,
+		Downloadable: e.Format != export.FormatSheets,
+		ExternalId:   e.ExternalID,
+		ExternalUrl:  e.ExternalURL,
+		ExportedAt:   e.ExportedAt,
+	}
+}
+
 // invoiceToAPI converts a store Invoice to an API Invoice
 func invoiceToAPI(inv *store.Invoice) api.Invoice {
 	invoice := api.Invoice{
-		Id:            inv.ID,
-		UserId:        inv.UserID,
-		ProjectId:     inv.ProjectID,
-		InvoiceNumber: inv.InvoiceNumber,
-		PeriodStart:   openapi_types.Date{Time: inv.PeriodStart},
-		PeriodEnd:     openapi_types.Date{Time: inv.PeriodEnd},
-		InvoiceDate:   openapi_types.Date{Time: inv.InvoiceDate},
-		Status:        api.InvoiceStatus(inv.Status),
-		TotalHours:    float32(inv.TotalHours),
-		TotalAmount:   float32(inv.TotalAmount),
-		CreatedAt:     inv.CreatedAt,
+		Id:                inv.ID,
+		UserId:            inv.UserID,
+		ProjectId:         inv.ProjectID,
+		InvoiceNumber:     inv.InvoiceNumber,
+		PeriodStart:       openapi_types.Date{Time: inv.PeriodStart},
+		PeriodEnd:         openapi_types.Date{Time: inv.PeriodEnd},
+		InvoiceDate:       openapi_types.Date{Time: inv.InvoiceDate},
+		Status:            api.InvoiceStatus(inv.Status),
+		OriginalInvoiceId: inv.OriginalInvoiceID,
+		TotalHours:        float32(inv.TotalHours),
+		TotalAmount:       float32(inv.TotalAmount),
+		AmountPaid:        ptrFloat32(float32(inv.AmountPaid)),
+		BalanceDue:        ptrFloat32(float32(inv.BalanceDue)),
+		CreatedAt:         inv.CreatedAt,
 	}
 
 	if inv.BillingPeriodID != nil {
 		invoice.BillingPeriodId = inv.BillingPeriodID
 	}
 
+	if inv.Kind != "" {
+		kind := api.InvoiceKind(inv.Kind)
+		invoice.Kind = &kind
+	}
+
 	if inv.Project != nil {
 		project := projectToAPI(inv.Project)
 		invoice.Project = &project
@@ -498,11 +657,25 @@ func invoiceToAPI(inv *store.Invoice) api.Invoice {
 				Id:          item.ID,
 				InvoiceId:   item.InvoiceID,
 				TimeEntryId: item.TimeEntryID,
+				ExpenseId:   item.ExpenseID,
 				Date:        openapi_types.Date{Time: item.Date},
 				Description: item.Description,
 				Hours:       float32(item.Hours),
 				HourlyRate:  float32(item.HourlyRate),
 				Amount:      float32(item.Amount),
+				RateId:      item.RateID,
+			}
+			if item.RoundingHours != 0 {
+				lineItems[i].RoundingHours = ptrFloat64(item.RoundingHours)
+				lineItems[i].RoundingRule = item.RoundingRule
+			}
+			if item.Kind != "" {
+				kind := api.InvoiceLineItemKind(item.Kind)
+				lineItems[i].Kind = &kind
+			}
+			if item.RateSource != "" {
+				source := api.InvoiceLineItemRateSource(item.RateSource)
+				lineItems[i].RateSource = &source
 			}
 		}
 		invoice.LineItems = &lineItems
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// The checks below run before the store is touched, so the handler is
+// built without one
+func TestInvoiceHandler_AddAdjustmentValidation(t *testing.T) {
+	h := NewInvoiceHandler(nil, nil, nil, nil, nil)
+	ctx := authedContext(uuid.New())
+
+	tests := []struct {
+		name string
+		body *api.InvoiceAdjustmentCreate
+	}{
+		{"no body", nil},
+		{"no description", &api.InvoiceAdjustmentCreate{Amount: 10}},
+		{"zero amount", &api.InvoiceAdjustmentCreate{Description: "Discount"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.AddInvoiceAdjustment(ctx, api.AddInvoiceAdjustmentRequestObject{Id: uuid.New(), Body: tt.body})
+			if err != nil {
+				t.Fatalf("AddInvoiceAdjustment: %v", err)
+			}
+			if _, ok := resp.(api.AddInvoiceAdjustment400JSONResponse); !ok {
+				t.Errorf("expected 400, got %T", resp)
+			}
+		})
+	}
+}
+
+func TestInvoiceHandler_CreateCreditNoteValidation(t *testing.T) {
+	h := NewInvoiceHandler(nil, nil, nil, nil, nil)
+	ctx := authedContext(uuid.New())
+
+	tests := []struct {
+		name string
+		line api.CreditNoteLine
+	}{
+		{"no description", api.CreditNoteLine{Amount: 10}},
+		{"zero amount", api.CreditNoteLine{Description: "Refund"}},
+		{"negative amount", api.CreditNoteLine{Description: "Refund", Amount: -10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := []api.CreditNoteLine{{Description: "Valid", Amount: 5}, tt.line}
+			resp, err := h.CreateCreditNote(ctx, api.CreateCreditNoteRequestObject{
+				Id:   uuid.New(),
+				Body: &api.CreditNoteCreate{Lines: &lines},
+			})
+			if err != nil {
+				t.Fatalf("CreateCreditNote: %v", err)
+			}
+			if _, ok := resp.(api.CreateCreditNote400JSONResponse); !ok {
+				t.Errorf("expected 400, got %T", resp)
+			}
+		})
+	}
+}
+
+func TestInvoiceToAPI_CreditNote(t *testing.T) {
+	originalID := uuid.New()
+	note := &store.Invoice{
+		ID:                uuid.New(),
+		InvoiceNumber:     "ACME-2024-001-CN1",
+		InvoiceDate:       time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		Status:            "draft",
+		Kind:              store.InvoiceKindCreditNote,
+		OriginalInvoiceID: &originalID,
+		TotalAmount:       -200,
+		LineItems: []store.InvoiceLineItem{{
+			ID:          uuid.New(),
+			Kind:        store.LineItemKindAdjustment,
+			Description: "Refund",
+			Amount:      -200,
+			RateSource:  store.RateSourceNone,
+		}},
+	}
+
+	got := invoiceToAPI(note)
+	if got.Kind == nil || *got.Kind != api.InvoiceKindCreditNote {
+		t.Errorf("expected kind credit_note, got %v", got.Kind)
+	}
+	if got.OriginalInvoiceId == nil || *got.OriginalInvoiceId != originalID {
+		t.Errorf("expected original invoice %s, got %v", originalID, got.OriginalInvoiceId)
+	}
+	if got.TotalAmount != -200 {
+		t.Errorf("expected total -200, got %v", got.TotalAmount)
+	}
+	if got.LineItems == nil || len(*got.LineItems) != 1 {
+		t.Fatalf("expected one line item, got %v", got.LineItems)
+	}
+	line := (*got.LineItems)[0]
+	if line.Kind == nil || *line.Kind != api.InvoiceLineItemKindAdjustment || line.Amount != -200 {
+		t.Errorf("expected a -200 adjustment line, got %v %v", line.Kind, line.Amount)
+	}
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
+)
+
+// GetLocale returns the user's locale
+func (h *AuthHandler) GetLocale(ctx context.Context, req api.GetLocaleRequestObject) (api.GetLocaleResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetLocale401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	loc, err := h.users.GetLocale(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetLocale200JSONResponse(localeSettings(loc)), nil
+}
+
+// UpdateLocale sets the user's locale
+func (h *AuthHandler) UpdateLocale(ctx context.Context, req api.UpdateLocaleRequestObject) (api.UpdateLocaleResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateLocale401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateLocale400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	loc, ok := locale.Lookup(req.Body.Locale)
+	if !ok {
+		return api.UpdateLocale400JSONResponse{
+			Code:    "invalid_request",
+			Message: fmt.Sprintf("Unsupported locale %q", req.Body.Locale),
+		}, nil
+	}
+
+	if err := h.users.SetLocale(ctx, userID, loc); err != nil {
+		return nil, err
+	}
+
+	return api.UpdateLocale200JSONResponse(localeSettings(loc)), nil
+}
+
+// localeSettings converts a locale to api.LocaleSettings
+func localeSettings(loc *locale.Locale) api.LocaleSettings {
+	return api.LocaleSettings{
+		Locale:    loc.Tag,
+		Supported: locale.Supported(),
+	}
+}
@@ -1,34 +1,68 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/aggregate"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/cache"
 	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/github"
+	"github.com/michaelw/timesheet-app/service/internal/goals"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
 	"github.com/michaelw/timesheet-app/service/internal/mcp"
+	"github.com/michaelw/timesheet-app/service/internal/meetingcost"
+	"github.com/michaelw/timesheet-app/service/internal/meetingload"
 	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/sync"
+	"github.com/michaelw/timesheet-app/service/internal/utilization"
 )
 
 // MCPHandler handles MCP protocol requests over HTTP
 type MCPHandler struct {
-	projects          *store.ProjectStore
-	entries           *store.TimeEntryStore
-	calendarEvents    *store.CalendarEventStore
-	rules             *store.ClassificationRuleStore
-	apiKeys           *store.APIKeyStore
-	mcpOAuth          *store.MCPOAuthStore
-	classificationSvc *classification.Service
-	jwt               *JWTService
-	baseURL           string
-	tools             []mcpTool
-	resources         []mcpResource
+	readModel          *cache.ReadModel
+	entries            *store.TimeEntryStore
+	calendarEvents     *store.CalendarEventStore
+	rules              *store.ClassificationRuleStore
+	snapshots          *store.ClassificationSnapshotStore
+	classificationJobs *store.ClassificationJobStore
+	anomalies          *store.DayAnomalyStore
+	apiKeys            *store.APIKeyStore
+	mcpOAuth           *store.MCPOAuthStore
+	users              *store.UserStore
+	classificationSvc  *classification.Service
+	utilizationSvc     *utilization.Service
+	aggregateSvc       *aggregate.Service
+	githubSvc          *github.Service
+	goalsSvc           *goals.Service
+	meetingCostSvc     *meetingcost.Service
+	meetingLoadSvc     *meetingload.Service
+	jwt                *JWTService
+	uow                *database.UnitOfWork
+	baseURL            string
+	tools              []mcpTool
+	toolFuncs          map[string]mcp.ToolFunc
+	confirmations      *confirmations
+	toolLimiter        *userLimiter
+	sessions           *mcpSessions
+	resources          []mcpResource
 }
 
+// MCPHandler must implement every tool in the spec
+var _ mcp.ToolHandler = (*MCPHandler)(nil)
+
 type mcpResource struct {
 	URI         string `json:"uri"`
 	Name        string `json:"name"`
@@ -36,6 +70,20 @@ type mcpResource struct {
 	MimeType    string `json:"mimeType,omitempty"`
 }
 
+type mcpResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+var timeEntryBreakdownTemplate = mcpResourceTemplate{
+	URITemplate: "timesheet://time-entries/{id}/breakdown",
+	Name:        "Time Entry Breakdown",
+	Description: "How a time entry's hours were computed: each contributing event, the part of it that counted, overlap with other events, and rounding",
+	MimeType:    "text/markdown",
+}
+
 type mcpTool struct {
 	Name        string         `json:"name"`
 	Description string         `json:"description,omitempty"`
@@ -44,29 +92,53 @@ type mcpTool struct {
 
 // NewMCPHandler creates a new MCP handler
 func NewMCPHandler(
-	projects *store.ProjectStore,
+	readModel *cache.ReadModel,
 	entries *store.TimeEntryStore,
 	calendarEvents *store.CalendarEventStore,
 	rules *store.ClassificationRuleStore,
+	snapshots *store.ClassificationSnapshotStore,
+	classificationJobs *store.ClassificationJobStore,
+	anomalies *store.DayAnomalyStore,
 	apiKeys *store.APIKeyStore,
 	mcpOAuth *store.MCPOAuthStore,
+	users *store.UserStore,
 	classificationSvc *classification.Service,
+	utilizationSvc *utilization.Service,
+	aggregateSvc *aggregate.Service,
+	githubSvc *github.Service,
+	goalsSvc *goals.Service,
 	jwt *JWTService,
+	uow *database.UnitOfWork,
 	baseURL string,
 ) *MCPHandler {
 	h := &MCPHandler{
-		projects:          projects,
-		entries:           entries,
-		calendarEvents:    calendarEvents,
-		rules:             rules,
-		apiKeys:           apiKeys,
-		mcpOAuth:          mcpOAuth,
-		classificationSvc: classificationSvc,
-		jwt:               jwt,
-		baseURL:           strings.TrimSuffix(baseURL, "/"),
+		readModel:          readModel,
+		entries:            entries,
+		calendarEvents:     calendarEvents,
+		rules:              rules,
+		snapshots:          snapshots,
+		classificationJobs: classificationJobs,
+		anomalies:          anomalies,
+		apiKeys:            apiKeys,
+		mcpOAuth:           mcpOAuth,
+		users:              users,
+		classificationSvc:  classificationSvc,
+		utilizationSvc:     utilizationSvc,
+		aggregateSvc:       aggregateSvc,
+		githubSvc:          githubSvc,
+		goalsSvc:           goalsSvc,
+		meetingCostSvc:     meetingcost.NewService(calendarEvents),
+		meetingLoadSvc:     meetingload.NewService(calendarEvents, utilizationSvc.WorkingHours),
+		jwt:                jwt,
+		uow:                uow,
+		baseURL:            strings.TrimSuffix(baseURL, "/"),
+		confirmations:      newConfirmations(),
+		toolLimiter:        newUserLimiter(maxConcurrentToolCalls),
+		sessions:           newMCPSessions(),
 	}
 	h.initTools()
 	h.initResources()
+	h.toolFuncs = mcp.RegisterTools(h)
 	return h
 }
 
@@ -97,54 +169,126 @@ func (h *MCPHandler) initResources() {
 	}
 }
 
-func formatHours(hours float64) string {
-	h := int(hours)
-	m := int((hours - float64(h)) * 60)
-	if m == 0 {
-		return fmt.Sprintf("%dh", h)
+// formatHours renders hours in the locale carried by ctx
+func formatHours(ctx context.Context, hours float64) string {
+	return locale.FromContext(ctx).FormatHours(hours)
+}
+
+// withUserLocale returns ctx carrying the user's locale. Failing to load it
+// only costs the formatting, so the default locale is used instead.
+func (h *MCPHandler) withUserLocale(ctx context.Context, userID uuid.UUID) context.Context {
+	if h.users == nil {
+		return ctx
+	}
+	loc, err := h.users.GetLocale(ctx, userID)
+	if err != nil {
+		log.Printf("[MCP] failed to load locale for user %s: %v", userID, err)
+		return ctx
+	}
+	return locale.NewContext(ctx, loc)
+}
+
+// toolResult builds a tool result from markdown text. When structured is not
+// nil it is also returned as structuredContent, using the field names of the
+// REST API, so clients can read IDs without parsing the markdown.
+func toolResult(text string, structured map[string]any) map[string]any {
+	result := map[string]any{
+		"content": []map[string]any{
+			{"type": "text", "text": text},
+		},
+	}
+	if structured != nil {
+		result["structuredContent"] = structured
+	}
+	return result
+}
+
+// stringValue returns the string p points to, or "" if p is nil
+func stringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+// parseDateArg parses an optional YYYY-MM-DD argument, returning nil when
+// it is omitted or empty
+func parseDateArg(name string, v *string) (*time.Time, error) {
+	if v == nil || *v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", *v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &t, nil
+}
+
+// parseUUIDArg parses an optional UUID argument, returning nil when it is
+// omitted or empty
+func parseUUIDArg(name string, v *string) (*uuid.UUID, error) {
+	if v == nil || *v == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(*v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return &id, nil
+}
+
+// allowedTools returns the tools the request's API key may call
+func (h *MCPHandler) allowedTools(ctx context.Context) []mcpTool {
+	key, ok := APIKeyFromContext(ctx)
+	if !ok {
+		return h.tools
 	}
-	return fmt.Sprintf("%dh %dm", h, m)
+	tools := make([]mcpTool, 0, len(h.tools))
+	for _, t := range h.tools {
+		if key.ToolAllowed(t.Name) {
+			tools = append(tools, t)
+		}
+	}
+	return tools
 }
 
 // Tool handlers
 func (h *MCPHandler) callTool(ctx context.Context, userID uuid.UUID, name string, args map[string]any) (any, error) {
-	switch name {
-	case "list_projects":
-		return h.listProjects(ctx, userID, args)
-	case "get_time_summary":
-		return h.getTimeSummary(ctx, userID, args)
-	case "list_pending_events":
-		return h.listPendingEvents(ctx, userID, args)
-	case "classify_event":
-		return h.classifyEvent(ctx, userID, args)
-	case "create_time_entry":
-		return h.createTimeEntry(ctx, userID, args)
-	case "search_events":
-		return h.searchEvents(ctx, userID, args)
-	case "list_rules":
-		return h.listRules(ctx, userID, args)
-	case "create_rule":
-		return h.createRule(ctx, userID, args)
-	case "preview_rule":
-		return h.previewRule(ctx, userID, args)
-	case "bulk_classify":
-		return h.bulkClassify(ctx, userID, args)
-	case "apply_rules":
-		return h.applyRules(ctx, userID, args)
-	case "explain_classification":
-		return h.explainClassification(ctx, userID, args)
-	default:
+	call, ok := h.toolFuncs[name]
+	if !ok {
 		return nil, fmt.Errorf("unknown tool: %s", name)
 	}
+	if key, ok := APIKeyFromContext(ctx); ok && !key.ToolAllowed(name) {
+		return nil, fmt.Errorf("tool %s is not allowed for API key %q", name, key.Name)
+	}
+	return call(ctx, userID, args)
 }
 
-func (h *MCPHandler) listProjects(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	includeArchived := false
-	if v, ok := args["include_archived"].(bool); ok {
-		includeArchived = v
+// ListProjects implements the list_projects tool
+func (h *MCPHandler) ListProjects(ctx context.Context, userID uuid.UUID, args mcp.ListProjectsArgs) (any, error) {
+	opts := store.ProjectListOptions{
+		IncludeArchived: boolValue(args.IncludeArchived),
+		Archived:        args.Archived,
+		Billable:        args.Billable,
+		Sort:            stringValue(args.Sort),
+	}
+	if args.Client != nil && *args.Client != "" {
+		opts.Client = args.Client
+	}
+	if args.Limit != nil && *args.Limit > 0 {
+		opts.Limit = *args.Limit
+	}
+	if args.Offset != nil && *args.Offset > 0 {
+		opts.Offset = *args.Offset
 	}
 
-	projects, err := h.projects.List(ctx, userID, includeArchived)
+	var projects []*store.Project
+	var err error
+	if opts == (store.ProjectListOptions{IncludeArchived: opts.IncludeArchived}) {
+		projects, err = h.readModel.Projects(ctx, userID, opts.IncludeArchived)
+	} else {
+		projects, err = h.readModel.QueryProjects(ctx, userID, opts)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
@@ -165,77 +309,110 @@ func (h *MCPHandler) listProjects(ctx context.Context, userID uuid.UUID, args ma
 		}
 	}
 
-	return map[string]any{
-		"content": []map[string]any{
-			{"type": "text", "text": sb.String()},
-		},
-	}, nil
+	apiProjects := make([]api.Project, len(projects))
+	for i, p := range projects {
+		apiProjects[i] = projectToAPI(p)
+	}
+
+	return toolResult(sb.String(), map[string]any{"projects": apiProjects}), nil
 }
 
-func (h *MCPHandler) getTimeSummary(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
+// GetTimeSummary implements the get_time_summary tool
+func (h *MCPHandler) GetTimeSummary(ctx context.Context, userID uuid.UUID, args mcp.GetTimeSummaryArgs) (any, error) {
 	endDate := time.Now()
 	startDate := endDate.AddDate(0, 0, -7)
 
-	if v, ok := args["start_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			startDate = t
-		}
+	if t, err := parseDateArg("start_date", args.StartDate); err == nil && t != nil {
+		startDate = *t
 	}
-	if v, ok := args["end_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			endDate = t
-		}
+	if t, err := parseDateArg("end_date", args.EndDate); err == nil && t != nil {
+		endDate = *t
 	}
 
 	groupBy := "project"
-	if v, ok := args["group_by"].(string); ok {
-		groupBy = v
+	if args.GroupBy != nil {
+		groupBy = *args.GroupBy
 	}
 
-	entries, err := h.entries.List(ctx, userID, &startDate, &endDate, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list entries: %w", err)
+	var entries []*store.DailyProjectHours
+	if tag := stringValue(args.Tag); tag != "" {
+		// Only saved entries carry tags, so sum those instead of the aggregate
+		saved, err := h.entries.List(ctx, userID, &startDate, &endDate, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list time entries: %w", err)
+		}
+		for _, e := range saved {
+			if e.IsSuppressed || !store.HasTag(e.Tags, tag) {
+				continue
+			}
+			entries = append(entries, &store.DailyProjectHours{UserID: userID, ProjectID: e.ProjectID, Date: e.Date, Hours: e.Hours})
+		}
+	} else {
+		var err error
+		entries, err = h.aggregateSvc.DailyHours(ctx, userID, startDate, endDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load daily hours: %w", err)
+		}
+	}
+
+	summary := map[string]any{
+		"start_date": startDate.Format("2006-01-02"),
+		"end_date":   endDate.Format("2006-01-02"),
+		"group_by":   groupBy,
 	}
 
 	if len(entries) == 0 {
-		return map[string]any{
-			"content": []map[string]any{
-				{"type": "text", "text": fmt.Sprintf("No time entries found between %s and %s.", startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))},
-			},
-		}, nil
+		summary["total_hours"] = 0.0
+		return toolResult(fmt.Sprintf("No time entries found between %s and %s.", startDate.Format("2006-01-02"), endDate.Format("2006-01-02")), summary), nil
 	}
 
 	var totalHours float64
 	for _, e := range entries {
 		totalHours += e.Hours
 	}
+	summary["total_hours"] = totalHours
 
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf("# Time Summary (%s to %s)\n\n", startDate.Format("2006-01-02"), endDate.Format("2006-01-02")))
-	sb.WriteString(fmt.Sprintf("**Total: %s**\n\n", formatHours(totalHours)))
+	sb.WriteString(fmt.Sprintf("**Total: %s**\n\n", formatHours(ctx, totalHours)))
 
 	if groupBy == "project" {
+		projects, err := h.readModel.Projects(ctx, userID, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		projectNames := projectNamesByID(projects)
+		projectsByID := make(map[uuid.UUID]*store.Project, len(projects))
+		for _, p := range projects {
+			projectsByID[p.ID] = p
+		}
+
 		byProject := make(map[uuid.UUID]float64)
-		projectNames := make(map[uuid.UUID]string)
 		for _, e := range entries {
 			byProject[e.ProjectID] += e.Hours
-			if e.Project != nil {
-				projectNames[e.ProjectID] = e.Project.Name
-			}
 		}
 
+		var totals []api.DayProjectTotal
 		sb.WriteString("## By Project\n\n")
 		for pid, hours := range byProject {
-			name := projectNames[pid]
+			name := projectNames[pid.String()]
 			if name == "" {
 				name = pid.String()
 			}
+			total := api.DayProjectTotal{ProjectId: pid, ProjectName: name, Hours: float32(hours)}
+			if p := projectsByID[pid]; p != nil {
+				total.IsBillable = p.IsBillable
+				total.Color = &p.Color
+			}
+			totals = append(totals, total)
 			pct := 0.0
 			if totalHours > 0 {
 				pct = hours / totalHours * 100
 			}
-			sb.WriteString(fmt.Sprintf("- %s: %s (%.0f%%)\n", name, formatHours(hours), pct))
+			sb.WriteString(fmt.Sprintf("- %s: %s (%.0f%%)\n", name, formatHours(ctx, hours), pct))
 		}
+		sort.Slice(totals, func(i, j int) bool { return totals[i].Hours > totals[j].Hours })
+		summary["projects"] = totals
 	} else {
 		byDate := make(map[string]float64)
 		for _, e := range entries {
@@ -243,37 +420,38 @@ func (h *MCPHandler) getTimeSummary(ctx context.Context, userID uuid.UUID, args
 			byDate[dateStr] += e.Hours
 		}
 
+		type dateHours struct {
+			Date  string  `json:"date"`
+			Hours float64 `json:"hours"`
+		}
+		var days []dateHours
 		sb.WriteString("## By Date\n\n")
 		for date, hours := range byDate {
-			sb.WriteString(fmt.Sprintf("- %s: %s\n", date, formatHours(hours)))
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", date, formatHours(ctx, hours)))
+			days = append(days, dateHours{Date: date, Hours: hours})
 		}
+		sort.Slice(days, func(i, j int) bool { return days[i].Date < days[j].Date })
+		summary["dates"] = days
 	}
 
-	return map[string]any{
-		"content": []map[string]any{
-			{"type": "text", "text": sb.String()},
-		},
-	}, nil
+	return toolResult(sb.String(), summary), nil
 }
 
-func (h *MCPHandler) listPendingEvents(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
+// ListPendingEvents implements the list_pending_events tool
+func (h *MCPHandler) ListPendingEvents(ctx context.Context, userID uuid.UUID, args mcp.ListPendingEventsArgs) (any, error) {
 	endDate := time.Now()
 	startDate := endDate.AddDate(0, 0, -30)
 
-	if v, ok := args["start_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			startDate = t
-		}
+	if t, err := parseDateArg("start_date", args.StartDate); err == nil && t != nil {
+		startDate = *t
 	}
-	if v, ok := args["end_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			endDate = t
-		}
+	if t, err := parseDateArg("end_date", args.EndDate); err == nil && t != nil {
+		endDate = *t
 	}
 
 	limit := 20
-	if v, ok := args["limit"].(float64); ok {
-		limit = int(v)
+	if args.Limit != nil {
+		limit = *args.Limit
 	}
 
 	status := store.StatusPending
@@ -281,13 +459,10 @@ func (h *MCPHandler) listPendingEvents(ctx context.Context, userID uuid.UUID, ar
 	if err != nil {
 		return nil, fmt.Errorf("failed to list events: %w", err)
 	}
+	events = filterEventsByTag(events, stringValue(args.Tag))
 
 	if len(events) == 0 {
-		return map[string]any{
-			"content": []map[string]any{
-				{"type": "text", "text": "No pending events. All caught up!"},
-			},
-		}, nil
+		return toolResult("No pending events. All caught up!", map[string]any{"events": []api.CalendarEvent{}}), nil
 	}
 
 	if len(events) > limit {
@@ -302,7 +477,7 @@ func (h *MCPHandler) listPendingEvents(ctx context.Context, userID uuid.UUID, ar
 		sb.WriteString(fmt.Sprintf("## %s\n", e.Title))
 		sb.WriteString(fmt.Sprintf("- **ID**: `%s`\n", e.ID))
 		sb.WriteString(fmt.Sprintf("- **Date**: %s\n", e.StartTime.Format("2006-01-02 15:04")))
-		sb.WriteString(fmt.Sprintf("- **Duration**: %s\n", formatHours(duration)))
+		sb.WriteString(fmt.Sprintf("- **Duration**: %s\n", formatHours(ctx, duration)))
 		if len(e.Attendees) > 0 {
 			attendees := e.Attendees
 			if len(attendees) > 5 {
@@ -313,52 +488,88 @@ func (h *MCPHandler) listPendingEvents(ctx context.Context, userID uuid.UUID, ar
 		sb.WriteString("\n")
 	}
 
-	return map[string]any{
-		"content": []map[string]any{
-			{"type": "text", "text": sb.String()},
-		},
-	}, nil
+	return toolResult(sb.String(), map[string]any{"events": eventsToAPI(events)}), nil
 }
 
-func (h *MCPHandler) classifyEvent(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	eventIDStr, ok := args["event_id"].(string)
-	if !ok || eventIDStr == "" {
+// eventsToAPI converts events for structured tool results
+func eventsToAPI(events []*store.CalendarEvent) []api.CalendarEvent {
+	result := make([]api.CalendarEvent, len(events))
+	for i, e := range events {
+		result[i] = calendarEventToAPI(e)
+	}
+	return result
+}
+
+// filterEventsByTag keeps the events carrying the tag, if one is given
+func filterEventsByTag(events []*store.CalendarEvent, tag string) []*store.CalendarEvent {
+	if tag == "" {
+		return events
+	}
+	var result []*store.CalendarEvent
+	for _, e := range events {
+		if store.HasTag(e.Tags, tag) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// ClassifyEvent implements the classify_event tool
+func (h *MCPHandler) ClassifyEvent(ctx context.Context, userID uuid.UUID, args mcp.ClassifyEventArgs) (any, error) {
+	if args.EventID == "" {
 		return nil, fmt.Errorf("event_id is required")
 	}
 
-	eventID, err := uuid.Parse(eventIDStr)
+	eventID, err := uuid.Parse(args.EventID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid event_id: %w", err)
 	}
 
-	skip := false
-	if v, ok := args["skip"].(bool); ok {
-		skip = v
-	}
+	skip := boolValue(args.Skip)
 
-	var projectID *uuid.UUID
-	if pidStr, ok := args["project_id"].(string); ok && pidStr != "" {
-		pid, err := uuid.Parse(pidStr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid project_id: %w", err)
-		}
-		projectID = &pid
+	projectID, err := parseUUIDArg("project_id", args.ProjectID)
+	if err != nil {
+		return nil, err
 	}
 
 	if projectID == nil && !skip {
 		return nil, fmt.Errorf("must provide either project_id or skip=true")
 	}
 
-	// Classify the event
-	event, err := h.calendarEvents.Classify(ctx, userID, eventID, projectID, skip)
+	prev, err := h.calendarEvents.GetByID(ctx, userID, eventID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to classify event: %w", err)
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+
+	// Classify the event and journal the change together, so it can
+	// always be undone
+	var event *store.CalendarEvent
+	var action *store.ClassificationAction
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		event, err = h.calendarEvents.Classify(ctx, userID, eventID, projectID, skip)
+		if err != nil {
+			return fmt.Errorf("failed to classify event: %w", err)
+		}
+		action, err = h.classificationSvc.RecordAction(ctx, userID, store.ActionKindClassify,
+			fmt.Sprintf("Classify %q", prev.Title), []store.EventClassificationState{store.ClassificationStateOf(prev)})
+		if err != nil {
+			return fmt.Errorf("failed to record action: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	undoHint := ""
+	if action != nil {
+		undoHint = fmt.Sprintf("\n- Action ID: `%s`", action.ID)
 	}
 
 	if skip {
 		return map[string]any{
 			"content": []map[string]any{
-				{"type": "text", "text": fmt.Sprintf("Skipped event: **%s**", event.Title)},
+				{"type": "text", "text": fmt.Sprintf("Skipped event: **%s**%s", event.Title, undoHint)},
 			},
 		}, nil
 	}
@@ -367,10 +578,10 @@ func (h *MCPHandler) classifyEvent(ctx context.Context, userID uuid.UUID, args m
 	duration := event.EndTime.Sub(event.StartTime).Hours()
 	_, err = h.entries.Create(ctx, userID, *projectID, event.StartTime, duration, nil)
 	if err != nil {
-		fmt.Printf("Warning: failed to create time entry: %v\n", err)
+		log.Printf("[MCP] classify_event: failed to create time entry for event %s: %v", eventID, err)
 	}
 
-	project, _ := h.projects.GetByID(ctx, *projectID, userID)
+	project, _ := h.readModel.Project(ctx, userID, *projectID)
 	projectName := projectID.String()
 	if project != nil {
 		projectName = project.Name
@@ -378,38 +589,37 @@ func (h *MCPHandler) classifyEvent(ctx context.Context, userID uuid.UUID, args m
 
 	return map[string]any{
 		"content": []map[string]any{
-			{"type": "text", "text": fmt.Sprintf("Classified event: **%s**\n- Project: %s\n- Hours: %s", event.Title, projectName, formatHours(duration))},
+			{"type": "text", "text": fmt.Sprintf("Classified event: **%s**\n- Project: %s\n- Hours: %s%s", event.Title, projectName, formatHours(ctx, duration), undoHint)},
 		},
 	}, nil
 }
 
-func (h *MCPHandler) createTimeEntry(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	projectIDStr, ok := args["project_id"].(string)
-	if !ok || projectIDStr == "" {
+// CreateTimeEntry implements the create_time_entry tool
+func (h *MCPHandler) CreateTimeEntry(ctx context.Context, userID uuid.UUID, args mcp.CreateTimeEntryArgs) (any, error) {
+	if args.ProjectID == "" {
 		return nil, fmt.Errorf("project_id is required")
 	}
-	projectID, err := uuid.Parse(projectIDStr)
+	projectID, err := uuid.Parse(args.ProjectID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid project_id: %w", err)
 	}
 
-	dateStr, ok := args["date"].(string)
-	if !ok || dateStr == "" {
+	if args.Date == "" {
 		return nil, fmt.Errorf("date is required")
 	}
-	date, err := time.Parse("2006-01-02", dateStr)
+	date, err := time.Parse("2006-01-02", args.Date)
 	if err != nil {
 		return nil, fmt.Errorf("invalid date format: %w", err)
 	}
 
-	hours, ok := args["hours"].(float64)
-	if !ok || hours <= 0 {
+	hours := args.Hours
+	if hours <= 0 {
 		return nil, fmt.Errorf("hours must be a positive number")
 	}
 
 	var description *string
-	if desc, ok := args["description"].(string); ok && desc != "" {
-		description = &desc
+	if args.Description != nil && *args.Description != "" {
+		description = args.Description
 	}
 
 	entry, err := h.entries.Create(ctx, userID, projectID, date, hours, description)
@@ -417,13 +627,13 @@ func (h *MCPHandler) createTimeEntry(ctx context.Context, userID uuid.UUID, args
 		return nil, fmt.Errorf("failed to create entry: %w", err)
 	}
 
-	project, _ := h.projects.GetByID(ctx, projectID, userID)
-	projectName := projectIDStr
+	project, _ := h.readModel.Project(ctx, userID, projectID)
+	projectName := args.ProjectID
 	if project != nil {
 		projectName = project.Name
 	}
 
-	result := fmt.Sprintf("Created time entry:\n- Project: %s\n- Date: %s\n- Hours: %s", projectName, entry.Date.Format("2006-01-02"), formatHours(entry.Hours))
+	result := fmt.Sprintf("Created time entry:\n- Project: %s\n- Date: %s\n- Hours: %s", projectName, entry.Date.Format("2006-01-02"), formatHours(ctx, entry.Hours))
 	if description != nil {
 		result += fmt.Sprintf("\n- Description: %s", *description)
 	}
@@ -435,33 +645,31 @@ func (h *MCPHandler) createTimeEntry(ctx context.Context, userID uuid.UUID, args
 	}, nil
 }
 
-func (h *MCPHandler) searchEvents(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
+// SearchEvents implements the search_events tool
+func (h *MCPHandler) SearchEvents(ctx context.Context, userID uuid.UUID, args mcp.SearchEventsArgs) (any, error) {
 	endDate := time.Now()
 	startDate := endDate.AddDate(0, 0, -30)
 
-	if v, ok := args["start_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			startDate = t
-		}
+	if t, err := parseDateArg("start_date", args.StartDate); err == nil && t != nil {
+		startDate = *t
 	}
-	if v, ok := args["end_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			endDate = t
-		}
+	if t, err := parseDateArg("end_date", args.EndDate); err == nil && t != nil {
+		endDate = *t
 	}
 
 	limit := 50
-	if v, ok := args["limit"].(float64); ok {
-		limit = int(v)
+	if args.Limit != nil {
+		limit = *args.Limit
 	}
 
-	query, _ := args["query"].(string)
+	query := stringValue(args.Query)
 
 	// Get all events in range
 	events, err := h.calendarEvents.List(ctx, userID, &startDate, &endDate, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list events: %w", err)
 	}
+	events = filterEventsByTag(events, stringValue(args.Tag))
 
 	// If query provided, filter events using classifier
 	var matchedEvents []*store.CalendarEvent
@@ -489,11 +697,7 @@ func (h *MCPHandler) searchEvents(ctx context.Context, userID uuid.UUID, args ma
 	}
 
 	if len(matchedEvents) == 0 {
-		return map[string]any{
-			"content": []map[string]any{
-				{"type": "text", "text": "No events found matching the query."},
-			},
-		}, nil
+		return toolResult("No events found matching the query.", map[string]any{"events": []api.CalendarEvent{}}), nil
 	}
 
 	if len(matchedEvents) > limit {
@@ -514,7 +718,7 @@ func (h *MCPHandler) searchEvents(ctx context.Context, userID uuid.UUID, args ma
 		sb.WriteString(fmt.Sprintf("## %s\n", e.Title))
 		sb.WriteString(fmt.Sprintf("- **ID**: `%s`\n", e.ID))
 		sb.WriteString(fmt.Sprintf("- **Date**: %s\n", e.StartTime.Format("2006-01-02 15:04")))
-		sb.WriteString(fmt.Sprintf("- **Duration**: %s\n", formatHours(duration)))
+		sb.WriteString(fmt.Sprintf("- **Duration**: %s\n", formatHours(ctx, duration)))
 		sb.WriteString(fmt.Sprintf("- **Status**: %s%s\n", status, projectInfo))
 		if len(e.Attendees) > 0 {
 			attendees := e.Attendees
@@ -526,20 +730,36 @@ func (h *MCPHandler) searchEvents(ctx context.Context, userID uuid.UUID, args ma
 		sb.WriteString("\n")
 	}
 
-	return map[string]any{
-		"content": []map[string]any{
-			{"type": "text", "text": sb.String()},
-		},
-	}, nil
+	return toolResult(sb.String(), map[string]any{"events": eventsToAPI(matchedEvents)}), nil
 }
 
-func (h *MCPHandler) listRules(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	includeDisabled := false
-	if v, ok := args["include_disabled"].(bool); ok {
-		includeDisabled = v
+// ListRules implements the list_rules tool
+func (h *MCPHandler) ListRules(ctx context.Context, userID uuid.UUID, args mcp.ListRulesArgs) (any, error) {
+	projectID, err := parseUUIDArg("project_id", args.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	opts := store.RuleListOptions{
+		IncludeDisabled: boolValue(args.IncludeDisabled),
+		ProjectID:       projectID,
+		Sort:            stringValue(args.Sort),
+	}
+	if args.Client != nil && *args.Client != "" {
+		opts.Client = args.Client
+	}
+	if args.Limit != nil && *args.Limit > 0 {
+		opts.Limit = *args.Limit
+	}
+	if args.Offset != nil && *args.Offset > 0 {
+		opts.Offset = *args.Offset
 	}
 
-	rules, err := h.rules.List(ctx, userID, includeDisabled)
+	var rules []*store.ClassificationRule
+	if opts == (store.RuleListOptions{IncludeDisabled: opts.IncludeDisabled}) {
+		rules, err = h.readModel.Rules(ctx, userID, opts.IncludeDisabled)
+	} else {
+		rules, err = h.rules.Query(ctx, userID, opts)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to list rules: %w", err)
 	}
@@ -562,13 +782,10 @@ func (h *MCPHandler) listRules(ctx context.Context, userID uuid.UUID, args map[s
 		}
 
 		projectName := "skip"
-		if r.ProjectID != nil {
-			// Look up project name
-			if project, err := h.projects.GetByID(ctx, *r.ProjectID, userID); err == nil {
-				projectName = project.Name
-			} else {
-				projectName = r.ProjectID.String()
-			}
+		if r.ProjectName != nil {
+			projectName = *r.ProjectName
+		} else if r.ProjectID != nil {
+			projectName = r.ProjectID.String()
 		}
 
 		sb.WriteString(fmt.Sprintf("## Rule: `%s`%s\n", r.Query, status))
@@ -578,32 +795,26 @@ func (h *MCPHandler) listRules(ctx context.Context, userID uuid.UUID, args map[s
 		sb.WriteString("\n")
 	}
 
-	return map[string]any{
-		"content": []map[string]any{
-			{"type": "text", "text": sb.String()},
-		},
-	}, nil
+	apiRules := make([]api.ClassificationRule, len(rules))
+	for i, r := range rules {
+		apiRules[i] = ruleToAPI(r)
+	}
+
+	return toolResult(sb.String(), map[string]any{"rules": apiRules}), nil
 }
 
-func (h *MCPHandler) createRule(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	query, ok := args["query"].(string)
-	if !ok || query == "" {
+// CreateRule implements the create_rule tool
+func (h *MCPHandler) CreateRule(ctx context.Context, userID uuid.UUID, args mcp.CreateRuleArgs) (any, error) {
+	query := args.Query
+	if query == "" {
 		return nil, fmt.Errorf("query is required")
 	}
 
-	skip := false
-	if v, ok := args["skip"].(bool); ok {
-		skip = v
-	}
+	skip := boolValue(args.Skip)
 
-	var projectID *uuid.UUID
-	projectIDStr, hasProjectID := args["project_id"].(string)
-	if hasProjectID && projectIDStr != "" {
-		pid, err := uuid.Parse(projectIDStr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid project_id: %w", err)
-		}
-		projectID = &pid
+	projectID, err := parseUUIDArg("project_id", args.ProjectID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Must specify either project_id or skip
@@ -612,8 +823,21 @@ func (h *MCPHandler) createRule(ctx context.Context, userID uuid.UUID, args map[
 	}
 
 	weight := 1.0
-	if v, ok := args["weight"].(float64); ok {
-		weight = v
+	if args.Weight != nil {
+		weight = *args.Weight
+	}
+
+	backfill := boolValue(args.ApplyToExisting)
+	backfillStart, err := parseDateArg("apply_start_date", args.ApplyStartDate)
+	if err != nil {
+		return nil, err
+	}
+	backfillEnd, err := parseDateArg("apply_end_date", args.ApplyEndDate)
+	if err != nil {
+		return nil, err
+	}
+	if backfillStart != nil && backfillEnd != nil && backfillEnd.Before(*backfillStart) {
+		return nil, fmt.Errorf("apply_end_date must not be before apply_start_date")
 	}
 
 	// Validate query by trying to parse it
@@ -624,7 +848,7 @@ func (h *MCPHandler) createRule(ctx context.Context, userID uuid.UUID, args map[
 	var projectName string
 	if projectID != nil {
 		// Verify project exists
-		project, err := h.projects.GetByID(ctx, *projectID, userID)
+		project, err := h.readModel.Project(ctx, userID, *projectID)
 		if err != nil {
 			return nil, fmt.Errorf("project not found: %w", err)
 		}
@@ -651,46 +875,53 @@ func (h *MCPHandler) createRule(ctx context.Context, userID uuid.UUID, args map[
 		return nil, fmt.Errorf("failed to create rule: %w", err)
 	}
 
+	next := "Use apply_rules to run this rule against pending events."
+	if backfill {
+		projects, err := h.readModel.Projects(ctx, userID, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		job, err := h.classificationJobs.Create(ctx, userID, backfillStart, backfillEnd, false, classification.DefaultApplyBatchSize, &created.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start backfill: %w", err)
+		}
+		go runClassificationJob(h.classificationJobs, h.classificationSvc, job, projectsToTargets(projects), created.Query)
+		next = fmt.Sprintf("Applying it to existing events in background job `%s`.", job.ID)
+	}
+
 	if skip {
 		return map[string]any{
 			"content": []map[string]any{
-				{"type": "text", "text": fmt.Sprintf("Created skip rule:\n- **Query**: `%s`\n- **ID**: `%s`\n\nUse apply_rules to run this rule against pending events.", created.Query, created.ID)},
+				{"type": "text", "text": fmt.Sprintf("Created skip rule:\n- **Query**: `%s`\n- **ID**: `%s`\n\n%s", created.Query, created.ID, next)},
 			},
 		}, nil
 	}
 
 	return map[string]any{
 		"content": []map[string]any{
-			{"type": "text", "text": fmt.Sprintf("Created rule:\n- **Query**: `%s`\n- **Project**: %s\n- **ID**: `%s`\n\nUse apply_rules to run this rule against pending events.", created.Query, projectName, created.ID)},
+			{"type": "text", "text": fmt.Sprintf("Created rule:\n- **Query**: `%s`\n- **Project**: %s\n- **ID**: `%s`\n\n%s", created.Query, projectName, created.ID, next)},
 		},
 	}, nil
 }
 
-func (h *MCPHandler) previewRule(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	query, ok := args["query"].(string)
-	if !ok || query == "" {
+// PreviewRule implements the preview_rule tool
+func (h *MCPHandler) PreviewRule(ctx context.Context, userID uuid.UUID, args mcp.PreviewRuleArgs) (any, error) {
+	query := args.Query
+	if query == "" {
 		return nil, fmt.Errorf("query is required")
 	}
 
-	var projectID *uuid.UUID
-	if pidStr, ok := args["project_id"].(string); ok && pidStr != "" {
-		pid, err := uuid.Parse(pidStr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid project_id: %w", err)
-		}
-		projectID = &pid
+	projectID, err := parseUUIDArg("project_id", args.ProjectID)
+	if err != nil {
+		return nil, err
 	}
 
 	var startDate, endDate *time.Time
-	if v, ok := args["start_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			startDate = &t
-		}
+	if t, err := parseDateArg("start_date", args.StartDate); err == nil && t != nil {
+		startDate = t
 	}
-	if v, ok := args["end_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			endDate = &t
-		}
+	if t, err := parseDateArg("end_date", args.EndDate); err == nil && t != nil {
+		endDate = t
 	}
 
 	preview, err := h.classificationSvc.PreviewRule(ctx, userID, query, projectID, startDate, endDate)
@@ -733,24 +964,18 @@ func (h *MCPHandler) previewRule(ctx context.Context, userID uuid.UUID, args map
 	}, nil
 }
 
-func (h *MCPHandler) bulkClassify(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	query, ok := args["query"].(string)
-	if !ok || query == "" {
+// BulkClassify implements the bulk_classify tool
+func (h *MCPHandler) BulkClassify(ctx context.Context, userID uuid.UUID, args mcp.BulkClassifyArgs) (any, error) {
+	query := args.Query
+	if query == "" {
 		return nil, fmt.Errorf("query is required")
 	}
 
-	skip := false
-	if v, ok := args["skip"].(bool); ok {
-		skip = v
-	}
+	skip := boolValue(args.Skip)
 
-	var projectID *uuid.UUID
-	if pidStr, ok := args["project_id"].(string); ok && pidStr != "" {
-		pid, err := uuid.Parse(pidStr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid project_id: %w", err)
-		}
-		projectID = &pid
+	projectID, err := parseUUIDArg("project_id", args.ProjectID)
+	if err != nil {
+		return nil, err
 	}
 
 	if projectID == nil && !skip {
@@ -763,36 +988,67 @@ func (h *MCPHandler) bulkClassify(ctx context.Context, userID uuid.UUID, args ma
 		return nil, fmt.Errorf("invalid query: %w", err)
 	}
 
+	if requiresConfirmation(ctx) {
+		token := stringValue(args.ConfirmationToken)
+		args.ConfirmationToken = nil
+		if token == "" {
+			return h.previewBulkClassify(userID, args, preview)
+		}
+		if err := h.confirmations.redeem(userID, "bulk_classify", token, args); err != nil {
+			return nil, err
+		}
+	}
+
 	var classifiedCount, skippedCount int
-	affectedDates := make(map[time.Time]bool)
+	var failures []string
+	var action *store.ClassificationAction
+
+	// Classify every match and journal the batch in one transaction, so a
+	// failure can't leave some events changed with no way to undo them.
+	// Events that can't be classified are reported rather than dropped.
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		classifiedCount, skippedCount, failures = 0, 0, nil
+		var prior []store.EventClassificationState
+
+		for i, match := range preview.Matches {
+			if i%progressInterval == 0 {
+				reportProgress(ctx, float64(i), float64(len(preview.Matches)), "Classifying events")
+			}
+			event, err := h.calendarEvents.GetByID(ctx, userID, match.EventID)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s (%s): %v", match.Title, match.EventID, err))
+				continue
+			}
 
-	// Process each matching event
-	for _, match := range preview.Matches {
-		event, err := h.calendarEvents.GetByID(ctx, userID, match.EventID)
-		if err != nil {
-			continue
-		}
+			// Skip manually classified events
+			if event.ClassificationSource != nil && *event.ClassificationSource == store.SourceManual {
+				continue
+			}
 
-		// Skip manually classified events
-		if event.ClassificationSource != nil && *event.ClassificationSource == store.SourceManual {
-			continue
-		}
+			if _, err := h.calendarEvents.Classify(ctx, userID, match.EventID, projectID, skip); err != nil {
+				failures = append(failures, fmt.Sprintf("%s (%s): %v", event.Title, match.EventID, err))
+				continue
+			}
+			prior = append(prior, store.ClassificationStateOf(event))
 
-		// Classify the event
-		_, err = h.calendarEvents.Classify(ctx, userID, match.EventID, projectID, skip)
-		if err != nil {
-			continue
+			if skip {
+				skippedCount++
+			} else {
+				classifiedCount++
+			}
 		}
+		reportProgress(ctx, float64(len(preview.Matches)), float64(len(preview.Matches)), "Classified events")
 
-		// Track affected date for recalculation
-		eventDate := time.Date(event.StartTime.Year(), event.StartTime.Month(), event.StartTime.Day(), 0, 0, 0, 0, time.UTC)
-		affectedDates[eventDate] = true
-
-		if skip {
-			skippedCount++
-		} else {
-			classifiedCount++
+		var err error
+		action, err = h.classificationSvc.RecordAction(ctx, userID, store.ActionKindBulkClassify,
+			fmt.Sprintf("Bulk classify %q", query), prior)
+		if err != nil {
+			return fmt.Errorf("failed to record action: %w", err)
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// With ephemeral time entries, we don't reactively create/update entries.
@@ -800,7 +1056,7 @@ func (h *MCPHandler) bulkClassify(ctx context.Context, userID uuid.UUID, args ma
 
 	projectName := ""
 	if projectID != nil {
-		if project, err := h.projects.GetByID(ctx, *projectID, userID); err == nil {
+		if project, err := h.readModel.Project(ctx, userID, *projectID); err == nil {
 			projectName = project.Name
 		}
 	}
@@ -811,6 +1067,15 @@ func (h *MCPHandler) bulkClassify(ctx context.Context, userID uuid.UUID, args ma
 	} else {
 		result = fmt.Sprintf("Bulk classification complete:\n- Query: `%s`\n- Project: %s\n- Events classified: %d\n- Time entries will be computed on demand", query, projectName, classifiedCount)
 	}
+	if len(failures) > 0 {
+		result += fmt.Sprintf("\n- Events that could not be changed: %d", len(failures))
+		for _, f := range failures {
+			result += "\n  - " + f
+		}
+	}
+	if action != nil {
+		result += fmt.Sprintf("\n- Action ID: `%s` (use undo_classification_action to revert)", action.ID)
+	}
 
 	return map[string]any{
 		"content": []map[string]any{
@@ -819,57 +1084,99 @@ func (h *MCPHandler) bulkClassify(ctx context.Context, userID uuid.UUID, args ma
 	}, nil
 }
 
-func (h *MCPHandler) applyRules(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
+// previewBulkClassify describes a bulk_classify and issues the token that runs it
+func (h *MCPHandler) previewBulkClassify(userID uuid.UUID, args mcp.BulkClassifyArgs, preview *classification.RulePreview) (any, error) {
+	token, expires, err := h.confirmations.issue(userID, "bulk_classify", args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue confirmation token: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Bulk Classify Preview: `%s`\n\n", args.Query))
+	sb.WriteString(fmt.Sprintf("- **Total matches**: %d\n", preview.Stats.TotalMatches))
+	sb.WriteString(fmt.Sprintf("- **Would change**: %d\n", preview.Stats.WouldChange))
+	if preview.Stats.ManualConflicts > 0 {
+		sb.WriteString(fmt.Sprintf("- **Manually classified** (won't change): %d\n", preview.Stats.ManualConflicts))
+	}
+	if len(preview.Matches) > 0 {
+		sb.WriteString("\n## Matching Events (first 10)\n\n")
+		for i, m := range preview.Matches {
+			if i >= 10 {
+				sb.WriteString(fmt.Sprintf("\n... and %d more\n", len(preview.Matches)-10))
+				break
+			}
+			sb.WriteString(fmt.Sprintf("- %s (%s)\n", m.Title, m.StartTime.Format("2006-01-02")))
+		}
+	}
+	sb.WriteString(confirmationPrompt("bulk_classify", token, expires))
+
+	return toolResult(sb.String(), map[string]any{
+		"confirmation_token": token,
+		"expires_at":         expires.UTC(),
+		"total_matches":      preview.Stats.TotalMatches,
+		"would_change":       preview.Stats.WouldChange,
+		"manual_conflicts":   preview.Stats.ManualConflicts,
+	}), nil
+}
+
+// ApplyRules implements the apply_rules tool
+func (h *MCPHandler) ApplyRules(ctx context.Context, userID uuid.UUID, args mcp.ApplyRulesArgs) (any, error) {
 	var startDate, endDate *time.Time
 	now := time.Now()
 	defaultStart := now.AddDate(0, 0, -30)
 	startDate = &defaultStart
 	endDate = &now
 
-	if v, ok := args["start_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			startDate = &t
-		}
+	if t, err := parseDateArg("start_date", args.StartDate); err == nil && t != nil {
+		startDate = t
 	}
-	if v, ok := args["end_date"].(string); ok && v != "" {
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			endDate = &t
-		}
+	if t, err := parseDateArg("end_date", args.EndDate); err == nil && t != nil {
+		endDate = t
 	}
 
-	dryRun := false
-	if v, ok := args["dry_run"].(bool); ok {
-		dryRun = v
+	dryRun := boolValue(args.DryRun)
+
+	// Without a token, a key that requires confirmation gets a dry run and
+	// the token to execute it
+	var confirmToken string
+	var confirmExpires time.Time
+	if !dryRun && requiresConfirmation(ctx) {
+		token := stringValue(args.ConfirmationToken)
+		args.ConfirmationToken = nil
+		if token == "" {
+			var err error
+			confirmToken, confirmExpires, err = h.confirmations.issue(userID, "apply_rules", args)
+			if err != nil {
+				return nil, fmt.Errorf("failed to issue confirmation token: %w", err)
+			}
+			dryRun = true
+		} else if err := h.confirmations.redeem(userID, "apply_rules", token, args); err != nil {
+			return nil, err
+		}
 	}
 
 	// Get projects to build targets
-	projects, err := h.projects.List(ctx, userID, false)
+	projects, err := h.readModel.Projects(ctx, userID, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	// Build targets from projects
-	targets := make([]classification.Target, 0, len(projects))
-	for _, p := range projects {
-		attrs := make(map[string]any)
-		attrs["name"] = p.Name
-		if p.FingerprintDomains != nil {
-			attrs["domains"] = p.FingerprintDomains
-		}
-		if p.FingerprintEmails != nil {
-			attrs["emails"] = p.FingerprintEmails
-		}
-		if p.FingerprintKeywords != nil {
-			attrs["keywords"] = p.FingerprintKeywords
-		}
-		target := classification.Target{
-			ID:         p.ID.String(),
-			Attributes: attrs,
-		}
-		targets = append(targets, target)
-	}
+	targets := projectsToTargetsWithNames(projects)
 
-	result, err := h.classificationSvc.ApplyRules(ctx, userID, targets, startDate, endDate, dryRun)
+	// Apply the rules and journal the run together, so a failure part way
+	// through leaves no changes that can't be undone
+	var result *classification.ApplyResult
+	err = h.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = h.classificationSvc.ApplyRulesInBatches(ctx, userID, targets, startDate, endDate, dryRun, classification.ApplyOptions{
+			OnBatch: func(p classification.ApplyProgress) error {
+				reportProgress(ctx, float64(p.Processed), float64(p.Total),
+					fmt.Sprintf("%d classified, %d skipped", p.Classified, p.SkipApplied))
+				return nil
+			},
+		})
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to apply rules: %w", err)
 	}
@@ -896,10 +1203,11 @@ func (h *MCPHandler) applyRules(ctx context.Context, userID uuid.UUID, args map[
 
 	if len(result.Classified) > 0 && len(result.Classified) <= 10 {
 		sb.WriteString("\n## Classified Events\n\n")
+		projectNames := projectNamesByID(projects)
 		for _, c := range result.Classified {
-			projectName := c.TargetID.String()
-			if project, err := h.projects.GetByID(ctx, c.TargetID, userID); err == nil {
-				projectName = project.Name
+			projectName, ok := projectNames[c.TargetID.String()]
+			if !ok {
+				projectName = c.TargetID.String()
 			}
 			review := ""
 			if c.NeedsReview {
@@ -911,6 +1219,51 @@ func (h *MCPHandler) applyRules(ctx context.Context, userID uuid.UUID, args map[
 		sb.WriteString(fmt.Sprintf("\n*%d events classified (too many to show)*\n", len(result.Classified)))
 	}
 
+	if result.ActionID != nil {
+		sb.WriteString(fmt.Sprintf("\nAction ID: `%s` (use undo_classification_action to revert)\n", *result.ActionID))
+	}
+
+	if confirmToken != "" {
+		sb.WriteString(confirmationPrompt("apply_rules", confirmToken, confirmExpires))
+		return toolResult(sb.String(), map[string]any{
+			"confirmation_token": confirmToken,
+			"expires_at":         confirmExpires.UTC(),
+			"skipped":            len(result.SkipApplied),
+			"classified":         len(result.Classified),
+		}), nil
+	}
+
+	return toolResult(sb.String(), nil), nil
+}
+
+// UndoClassificationAction implements the undo_classification_action tool
+func (h *MCPHandler) UndoClassificationAction(ctx context.Context, userID uuid.UUID, args mcp.UndoClassificationActionArgs) (any, error) {
+	actionIDStr := stringValue(args.ActionID)
+	if actionIDStr == "" {
+		return nil, fmt.Errorf("action_id is required")
+	}
+
+	actionID, err := uuid.Parse(actionIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid action_id: %w", err)
+	}
+
+	action, dates, err := h.classificationSvc.UndoAction(ctx, userID, actionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to undo action: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Undid action: **%s**\n", action.Description))
+	sb.WriteString(fmt.Sprintf("- Events restored: %d\n", action.EventCount))
+	if len(dates) > 0 {
+		days := make([]string, len(dates))
+		for i, d := range dates {
+			days[i] = d.Format("2006-01-02")
+		}
+		sb.WriteString(fmt.Sprintf("- Time entries recalculated for: %s\n", strings.Join(days, ", ")))
+	}
+
 	return map[string]any{
 		"content": []map[string]any{
 			{"type": "text", "text": sb.String()},
@@ -918,13 +1271,350 @@ func (h *MCPHandler) applyRules(ctx context.Context, userID uuid.UUID, args map[
 	}, nil
 }
 
-func (h *MCPHandler) explainClassification(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
-	eventIDStr, ok := args["event_id"].(string)
-	if !ok || eventIDStr == "" {
+// CreateClassificationSnapshot implements the create_classification_snapshot tool
+func (h *MCPHandler) CreateClassificationSnapshot(ctx context.Context, userID uuid.UUID, args mcp.CreateClassificationSnapshotArgs) (any, error) {
+	if args.WeekStart == "" {
+		return nil, fmt.Errorf("week_start is required")
+	}
+
+	day, err := time.Parse("2006-01-02", args.WeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid week_start: %w", err)
+	}
+
+	var label *string
+	if trimmed := strings.TrimSpace(stringValue(args.Label)); trimmed != "" {
+		label = &trimmed
+	}
+
+	weekStart := sync.NormalizeToWeekStart(day)
+	snapshot, err := h.snapshots.Create(ctx, userID, weekStart, label)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot: %w", err)
+	}
+
+	return map[string]any{
+		"content": []map[string]any{
+			{"type": "text", "text": fmt.Sprintf("Created snapshot for week of %s:\n- Events captured: %d\n- ID: `%s`",
+				weekStart.Format("2006-01-02"), snapshot.EventCount, snapshot.ID)},
+		},
+	}, nil
+}
+
+// GetUtilization implements the get_utilization tool
+func (h *MCPHandler) GetUtilization(ctx context.Context, userID uuid.UUID, args mcp.GetUtilizationArgs) (any, error) {
+	now := time.Now().UTC()
+	endDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	t, err := parseDateArg("end_date", args.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		endDate = *t
+	}
+	startDate := sync.NormalizeToWeekStart(endDate).AddDate(0, 0, -7*11)
+	if t, err = parseDateArg("start_date", args.StartDate); err != nil {
+		return nil, err
+	}
+	if t != nil {
+		startDate = *t
+	}
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("end_date must not be before start_date")
+	}
+	if endDate.Sub(startDate) > maxReportDays*24*time.Hour {
+		return nil, fmt.Errorf("date range must be at most two years")
+	}
+
+	report, err := h.utilizationSvc.Report(ctx, userID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute utilization: %w", err)
+	}
+	if err := addAudience(ctx, h.calendarEvents, userID, report); err != nil {
+		return nil, fmt.Errorf("failed to compute utilization: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Utilization: %s to %s\n\n", startDate.Format("2006-01-02"), endDate.Format("2006-01-02")))
+	sb.WriteString(fmt.Sprintf("- **Utilization**: %.0f%%\n", report.Utilization*100))
+	sb.WriteString(fmt.Sprintf("- **Billable**: %s of %s capacity\n", formatHours(ctx, report.BillableHours), formatHours(ctx, report.CapacityHours)))
+	sb.WriteString(fmt.Sprintf("- **Total tracked**: %s\n", formatHours(ctx, report.TotalHours)))
+
+	if len(report.Weeks) > 0 {
+		sb.WriteString("\n## Weekly Trend\n\n")
+		sb.WriteString("| Week of | Billable | Capacity | Utilization |\n")
+		sb.WriteString("|---------|----------|----------|-------------|\n")
+		for _, w := range report.Weeks {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %.0f%% |\n",
+				w.WeekStart.Format("2006-01-02"), formatHours(ctx, w.BillableHours), formatHours(ctx, w.CapacityHours), w.Utilization*100))
+		}
+	}
+
+	if len(report.Projects) > 0 {
+		sb.WriteString("\n## By Project\n\n")
+		for _, p := range report.Projects {
+			billable := ""
+			if !p.IsBillable {
+				billable = " (non-billable)"
+			}
+			expenses := ""
+			if p.Expenses > 0 {
+				expenses = fmt.Sprintf(", expenses $%.2f ($%.2f billable)", p.Expenses, p.BillableExpenses)
+			}
+			sb.WriteString(fmt.Sprintf("- **%s**%s: %s (%.0f%%)%s\n", p.ProjectName, billable, formatHours(ctx, p.Hours), p.Share*100, expenses))
+		}
+	}
+
+	if len(report.Tags) > 0 {
+		sb.WriteString("\n## By Tag\n\n")
+		for _, t := range report.Tags {
+			sb.WriteString(fmt.Sprintf("- **%s**: %s (%.0f%%)\n", t.Tag, formatHours(ctx, t.Hours), t.Share*100))
+		}
+	}
+
+	if a := report.Audience; a != nil {
+		sb.WriteString("\n## Internal vs Client-Facing\n\n")
+		sb.WriteString(fmt.Sprintf("- **Client-facing**: %s (%.0f%%)\n", formatHours(ctx, a.ClientFacingHours), a.ClientFacingShare*100))
+		sb.WriteString(fmt.Sprintf("- **Internal**: %s\n", formatHours(ctx, a.InternalHours)))
+	}
+
+	return toolResult(sb.String(), map[string]any{"report": utilizationReportToAPI(report)}), nil
+}
+
+// GetGoalsProgress implements the get_goals_progress tool
+func (h *MCPHandler) GetGoalsProgress(ctx context.Context, userID uuid.UUID, args mcp.GetGoalsProgressArgs) (any, error) {
+	now := time.Now().UTC()
+	asOf := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	t, err := parseDateArg("date", args.Date)
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		asOf = *t
+	}
+
+	progress, err := h.goalsSvc.Progress(ctx, userID, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute goal progress: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Goal Progress as of %s\n\n", asOf.Format("2006-01-02")))
+	if len(progress) == 0 {
+		sb.WriteString("No hour goals are set.\n")
+	}
+	for _, p := range progress {
+		scope := "All projects"
+		if p.ProjectName != "" {
+			scope = p.ProjectName
+		}
+		kind := "hours"
+		if p.Goal.BillableOnly {
+			kind = "billable hours"
+		}
+		status := "on track"
+		if !p.OnTrack {
+			status = fmt.Sprintf("behind by %s", formatHours(ctx, p.ExpectedHours-p.ActualHours))
+		}
+		if p.RemainingHours == 0 {
+			status = "target reached"
+		}
+
+		sb.WriteString(fmt.Sprintf("## %s: %s %s per %s\n\n", scope, formatHours(ctx, p.Goal.TargetHours), kind, p.Goal.Period))
+		sb.WriteString(fmt.Sprintf("- **Period**: %s to %s\n", p.PeriodStart.Format("2006-01-02"), p.PeriodEnd.Format("2006-01-02")))
+		sb.WriteString(fmt.Sprintf("- **So far**: %s (%.0f%%)\n", formatHours(ctx, p.ActualHours), p.Percent*100))
+		sb.WriteString(fmt.Sprintf("- **Expected by now**: %s\n", formatHours(ctx, p.ExpectedHours)))
+		sb.WriteString(fmt.Sprintf("- **Remaining**: %s\n", formatHours(ctx, p.RemainingHours)))
+		sb.WriteString(fmt.Sprintf("- **Status**: %s\n\n", status))
+	}
+
+	apiProgress := make([]api.GoalProgress, len(progress))
+	for i, p := range progress {
+		apiProgress[i] = goalProgressToAPI(p)
+	}
+
+	return toolResult(sb.String(), map[string]any{"goals": apiProgress}), nil
+}
+
+// ListAnomalies implements the list_anomalies tool
+func (h *MCPHandler) ListAnomalies(ctx context.Context, userID uuid.UUID, args mcp.ListAnomaliesArgs) (any, error) {
+	startDate, err := parseDateArg("start_date", args.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDateArg("end_date", args.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	includeDismissed := boolValue(args.IncludeDismissed)
+
+	anomalies, err := h.anomalies.List(ctx, userID, startDate, endDate, includeDismissed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list anomalies: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# Flagged Days\n\n")
+	if len(anomalies) == 0 {
+		sb.WriteString("No suspicious days found.\n")
+	}
+	for _, a := range anomalies {
+		dismissed := ""
+		if a.DismissedAt != nil {
+			dismissed = " (dismissed)"
+		}
+		sb.WriteString(fmt.Sprintf("- **%s** %s%s: %s\n",
+			a.Date.Format("2006-01-02 Mon"), a.Kind, dismissed, a.Message))
+	}
+
+	return toolResult(sb.String(), map[string]any{"anomalies": anomaliesToAPI(anomalies)}), nil
+}
+
+// SuggestDescriptions implements the suggest_descriptions tool
+func (h *MCPHandler) SuggestDescriptions(ctx context.Context, userID uuid.UUID, args mcp.SuggestDescriptionsArgs) (any, error) {
+	if h.githubSvc == nil {
+		return nil, fmt.Errorf("GitHub integration is not configured")
+	}
+	date, err := time.Parse("2006-01-02", args.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date: %w", err)
+	}
+
+	suggestions, err := h.githubSvc.SuggestDescriptions(ctx, userID, date)
+	if err != nil {
+		if errors.Is(err, github.ErrNotConnected) {
+			return nil, fmt.Errorf("GitHub is not connected; connect it in settings first")
+		}
+		return nil, fmt.Errorf("failed to suggest descriptions: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Suggested Descriptions for %s\n\n", date.Format("2006-01-02 Mon")))
+	if len(suggestions) == 0 {
+		sb.WriteString("No GitHub activity in repositories linked to a project.\n")
+	}
+	for _, s := range suggestions {
+		sb.WriteString(fmt.Sprintf("## %s\n\n", s.ProjectName))
+		if s.TimeEntryID != nil {
+			current := "(none)"
+			if s.CurrentDescription != nil && *s.CurrentDescription != "" {
+				current = *s.CurrentDescription
+			}
+			sb.WriteString(fmt.Sprintf("Time entry `%s` (%.2fh), current description: %s\n\n", s.TimeEntryID, s.Hours, current))
+		} else {
+			sb.WriteString("No time entry for this project on this day.\n\n")
+		}
+		sb.WriteString(fmt.Sprintf("**Suggested**: %s\n\n", s.Description))
+		for _, a := range s.Activities {
+			sb.WriteString(fmt.Sprintf("- %s: %s (%s)\n", a.Kind, a.Summary(), a.URL))
+		}
+		sb.WriteString("\n")
+	}
+
+	return map[string]any{
+		"content": []map[string]any{
+			{"type": "text", "text": sb.String()},
+		},
+	}, nil
+}
+
+// ReviewNextEvent applies an optional decision on the current review item,
+// then shows the next event in the review queue
+func (h *MCPHandler) ReviewNextEvent(ctx context.Context, userID uuid.UUID, args mcp.ReviewNextEventArgs) (any, error) {
+	startDate, err := parseDateArg("start_date", args.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDateArg("end_date", args.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	if decision := stringValue(args.Decision); decision != "" {
+		if decision != string(api.Accept) && decision != string(api.Override) {
+			return nil, fmt.Errorf("decision must be accept or override")
+		}
+		eventIDStr := stringValue(args.EventID)
+		if eventIDStr == "" {
+			return nil, fmt.Errorf("event_id is required with a decision")
+		}
+		eventID, err := uuid.Parse(eventIDStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid event_id: %w", err)
+		}
+		projectID, err := parseUUIDArg("project_id", args.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		skip := boolValue(args.Skip)
+
+		event, action, err := reviewEvent(ctx, h.calendarEvents, h.classificationSvc, userID, eventID,
+			api.ReviewDecisionDecision(decision), projectID, skip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to review event: %w", err)
+		}
+		verb := "Accepted"
+		if decision == string(api.Override) {
+			verb = "Reclassified"
+		}
+		sb.WriteString(fmt.Sprintf("%s **%s**", verb, event.Title))
+		if action != nil {
+			sb.WriteString(fmt.Sprintf(" (action ID: `%s`)", action.ID))
+		}
+		sb.WriteString("\n\n")
+	}
+
+	next, err := h.calendarEvents.ListNeedsReview(ctx, userID, startDate, endDate, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review queue: %w", err)
+	}
+	remaining, err := h.calendarEvents.CountNeedsReview(ctx, userID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count review queue: %w", err)
+	}
+
+	if len(next) == 0 {
+		sb.WriteString("Review queue is empty. All caught up!")
+	} else {
+		e := next[0]
+		sb.WriteString(fmt.Sprintf("# Next Event to Review (%d remaining)\n\n", remaining))
+		sb.WriteString(fmt.Sprintf("## %s\n", e.Title))
+		sb.WriteString(fmt.Sprintf("- **ID**: `%s`\n", e.ID))
+		sb.WriteString(fmt.Sprintf("- **Date**: %s\n", e.StartTime.Format("2006-01-02 15:04")))
+		sb.WriteString(fmt.Sprintf("- **Duration**: %s\n", formatHours(ctx, e.EndTime.Sub(e.StartTime).Hours())))
+		if len(e.Attendees) > 0 {
+			attendees := e.Attendees
+			if len(attendees) > 5 {
+				attendees = attendees[:5]
+			}
+			sb.WriteString(fmt.Sprintf("- **Attendees**: %s\n", strings.Join(attendees, ", ")))
+		}
+		switch {
+		case e.IsSkipped:
+			sb.WriteString("- **Suggested**: skip\n")
+		case e.Project != nil:
+			sb.WriteString(fmt.Sprintf("- **Suggested project**: %s (`%s`)\n", e.Project.Name, e.Project.ID))
+		}
+		if e.ClassificationConfidence != nil {
+			sb.WriteString(fmt.Sprintf("- **Confidence**: %.0f%%\n", *e.ClassificationConfidence*100))
+		}
+	}
+
+	return map[string]any{
+		"content": []map[string]any{
+			{"type": "text", "text": sb.String()},
+		},
+	}, nil
+}
+
+// ExplainClassification implements the explain_classification tool
+func (h *MCPHandler) ExplainClassification(ctx context.Context, userID uuid.UUID, args mcp.ExplainClassificationArgs) (any, error) {
+	if args.EventID == "" {
 		return nil, fmt.Errorf("event_id is required")
 	}
 
-	eventID, err := uuid.Parse(eventIDStr)
+	eventID, err := uuid.Parse(args.EventID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid event_id: %w", err)
 	}
@@ -936,33 +1626,14 @@ func (h *MCPHandler) explainClassification(ctx context.Context, userID uuid.UUID
 	}
 
 	// Get projects to build targets with names
-	projects, err := h.projects.List(ctx, userID, false)
+	projects, err := h.readModel.Projects(ctx, userID, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list projects: %w", err)
 	}
 
 	// Build targets from projects (including fingerprints and names)
-	projectNames := make(map[string]string)
-	targets := make([]classification.Target, 0, len(projects))
-	for _, p := range projects {
-		attrs := make(map[string]any)
-		attrs["name"] = p.Name
-		projectNames[p.ID.String()] = p.Name
-		if p.FingerprintDomains != nil {
-			attrs["domains"] = p.FingerprintDomains
-		}
-		if p.FingerprintEmails != nil {
-			attrs["emails"] = p.FingerprintEmails
-		}
-		if p.FingerprintKeywords != nil {
-			attrs["keywords"] = p.FingerprintKeywords
-		}
-		target := classification.Target{
-			ID:         p.ID.String(),
-			Attributes: attrs,
-		}
-		targets = append(targets, target)
-	}
+	projectNames := projectNamesByID(projects)
+	targets := projectsToTargetsWithNames(projects)
 
 	// Get explain result
 	result, err := h.classificationSvc.ExplainEventClassification(ctx, userID, eventID, targets)
@@ -1046,8 +1717,8 @@ func (h *MCPHandler) explainClassification(ctx context.Context, userID uuid.UUID
 	// Target scores (projects that received votes)
 	if len(result.TargetScores) > 0 {
 		sb.WriteString("## Score by Project\n\n")
-		sb.WriteString("| Project | Total | Rules | Fingerprints | Winner |\n")
-		sb.WriteString("|---------|-------|-------|--------------|--------|\n")
+		sb.WriteString("| Project | Total | Rules | Fingerprints | Exclusions | Winner |\n")
+		sb.WriteString("|---------|-------|-------|--------------|------------|--------|\n")
 		for _, ts := range result.TargetScores {
 			name := projectNames[ts.TargetID]
 			if name == "" {
@@ -1057,8 +1728,8 @@ func (h *MCPHandler) explainClassification(ctx context.Context, userID uuid.UUID
 			if ts.IsWinner {
 				winner = "✓"
 			}
-			sb.WriteString(fmt.Sprintf("| %s | %.1f | %.1f | %.1f | %s |\n",
-				name, ts.TotalWeight, ts.RuleWeight, ts.FingerprintWeight, winner))
+			sb.WriteString(fmt.Sprintf("| %s | %.1f | %.1f | %.1f | %.1f | %s |\n",
+				name, ts.TotalWeight, ts.RuleWeight, ts.FingerprintWeight, ts.ExclusionWeight, winner))
 		}
 		sb.WriteString(fmt.Sprintf("\n**Total weight**: %.1f | **Confidence**: %.0f%%\n\n",
 			result.TotalWeight, result.WinnerConfidence*100))
@@ -1146,12 +1817,14 @@ title:meeting domain:client.com  # Both conditions (implicit AND)
 | ` + "`domain`" + ` | string | Attendee email domain (exact match) |
 | ` + "`email`" + ` | string | Attendee email (exact match) |
 | ` + "`calendar`" + ` | string | Calendar name (contains) |
+| ` + "`tag`" + ` | string | One of the user's tags on the event (exact, case-insensitive) |
 | ` + "`text`" + ` | string | Searches title, description, and attendees |
 | ` + "`response`" + ` | enum | User's response: accepted, declined, needsAction, tentative |
 | ` + "`recurring`" + ` | boolean | yes/no - Is this a recurring event? |
 | ` + "`transparency`" + ` | enum | opaque (busy) or transparent (free) |
 | ` + "`is-all-day`" + ` | boolean | yes/no |
 | ` + "`has-attendees`" + ` | boolean | yes/no |
+| ` + "`internal`" + ` | boolean | yes/no - No attendee outside your company domains; never matches until they are set |
 | ` + "`day-of-week`" + ` | enum | mon, tue, wed, thu, fri, sat, sun |
 | ` + "`time-of-day`" + ` | time | HH:MM with operators: >, >=, <, <=, = |
 | ` + "`status`" + ` | enum | pending, classified, skipped |
@@ -1250,9 +1923,10 @@ func (h *MCPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			// Check for API key (ts_ prefix)
 			if !ok && strings.HasPrefix(token, "ts_") {
 				if h.apiKeys != nil {
-					if uid, err := h.apiKeys.ValidateAndGetUserID(r.Context(), token); err == nil {
-						userID = uid
+					if key, err := h.apiKeys.Validate(r.Context(), token); err == nil {
+						userID = key.UserID
 						ok = true
+						r = r.WithContext(context.WithValue(r.Context(), apiKeyKey, key))
 					}
 				}
 			}
@@ -1274,48 +1948,79 @@ func (h *MCPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	switch r.Method {
 	case "GET":
-		h.handleSSE(w, r)
+		h.handleStream(w, r, userID)
 	case "POST":
 		h.handleJSONRPC(w, r, userID)
+	case "DELETE":
+		if !h.sessions.remove(r.Header.Get(mcpSessionHeader), userID) {
+			http.Error(w, "Session not found", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
 	case "OPTIONS":
-		w.Header().Set("Allow", "GET, POST, OPTIONS")
+		w.Header().Set("Allow", "GET, POST, DELETE, OPTIONS")
 		w.WriteHeader(http.StatusNoContent)
 	default:
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
 }
 
-func (h *MCPHandler) handleSSE(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.WriteHeader(http.StatusOK)
-
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+func (h *MCPHandler) handleJSONRPC(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.sendJSONRPCError(w, nil, -32700, "Parse error", err.Error())
 		return
 	}
 
-	fmt.Fprintf(w, "event: endpoint\ndata: %s\n\n", r.URL.Path)
-	flusher.Flush()
-
-	<-r.Context().Done()
-}
+	// Requests after initialize carry the session they belong to
+	ctx := r.Context()
+	if sessionID := r.Header.Get(mcpSessionHeader); sessionID != "" {
+		session, ok := h.sessions.get(sessionID, userID)
+		if !ok {
+			http.Error(w, "Session not found", http.StatusNotFound)
+			return
+		}
+		ctx = context.WithValue(ctx, mcpSessionKey, session)
+	}
 
-func (h *MCPHandler) handleJSONRPC(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
-	var req struct {
-		JSONRPC string          `json:"jsonrpc"`
-		ID      any             `json:"id"`
-		Method  string          `json:"method"`
-		Params  json.RawMessage `json:"params,omitempty"`
+	if trimmed := bytes.TrimLeft(body, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '[' {
+		h.handleBatch(ctx, w, userID, trimmed)
+		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	var req jsonrpcRequest
+	if err := json.Unmarshal(body, &req); err != nil {
 		h.sendJSONRPCError(w, nil, -32700, "Parse error", err.Error())
 		return
 	}
 
+	if req.Method == "initialize" {
+		var apiKeyID *uuid.UUID
+		if key, ok := APIKeyFromContext(ctx); ok {
+			apiKeyID = &key.ID
+		}
+		session, err := h.sessions.create(userID, apiKeyID)
+		if err != nil {
+			h.sendJSONRPCError(w, req.ID, -32603, "Internal error", err.Error())
+			return
+		}
+		w.Header().Set(mcpSessionHeader, session.id)
+		ctx = context.WithValue(ctx, mcpSessionKey, session)
+	}
+
+	resp := h.dispatch(ctx, userID, req)
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// dispatch handles one JSON-RPC request. It returns nil for notifications,
+// which get no response.
+func (h *MCPHandler) dispatch(ctx context.Context, userID uuid.UUID, req jsonrpcRequest) *jsonrpcResponse {
 	var result any
 
 	switch req.Method {
@@ -1324,7 +2029,7 @@ func (h *MCPHandler) handleJSONRPC(w http.ResponseWriter, r *http.Request, userI
 		result = map[string]any{
 			"protocolVersion": "2024-11-05",
 			"capabilities": map[string]any{
-				"tools":     map[string]any{},
+				"tools":     map[string]any{"listChanged": true},
 				"resources": map[string]any{},
 			},
 			"serverInfo": map[string]any{
@@ -1335,8 +2040,7 @@ func (h *MCPHandler) handleJSONRPC(w http.ResponseWriter, r *http.Request, userI
 		}
 
 	case "initialized", "notifications/initialized":
-		w.WriteHeader(http.StatusNoContent)
-		return
+		return nil
 
 	case "resources/list":
 		result = map[string]any{
@@ -1348,8 +2052,7 @@ func (h *MCPHandler) handleJSONRPC(w http.ResponseWriter, r *http.Request, userI
 			URI string `json:"uri"`
 		}
 		if err := json.Unmarshal(req.Params, &params); err != nil {
-			h.sendJSONRPCError(w, req.ID, -32602, "Invalid params", err.Error())
-			return
+			return rpcError(req.ID, -32602, "Invalid params", err.Error())
 		}
 
 		// Handle known resources
@@ -1365,54 +2068,66 @@ func (h *MCPHandler) handleJSONRPC(w http.ResponseWriter, r *http.Request, userI
 				},
 			}
 		default:
-			h.sendJSONRPCError(w, req.ID, -32002, "Resource not found", params.URI)
-			return
+			text, ok, err := h.readTimeEntryBreakdown(ctx, userID, params.URI)
+			if err != nil {
+				return rpcError(req.ID, -32000, "Resource error", err.Error())
+			}
+			if !ok {
+				return rpcError(req.ID, -32002, "Resource not found", params.URI)
+			}
+			result = map[string]any{
+				"contents": []map[string]any{
+					{
+						"uri":      params.URI,
+						"mimeType": "text/markdown",
+						"text":     text,
+					},
+				},
+			}
+		}
+
+	case "resources/templates/list":
+		result = map[string]any{
+			"resourceTemplates": []mcpResourceTemplate{timeEntryBreakdownTemplate},
 		}
 
 	case "tools/list":
 		result = map[string]any{
-			"tools": h.tools,
+			"tools": h.allowedTools(ctx),
 		}
 
 	case "tools/call":
 		var params struct {
 			Name      string         `json:"name"`
 			Arguments map[string]any `json:"arguments"`
+			Meta      struct {
+				ProgressToken any `json:"progressToken"`
+			} `json:"_meta"`
 		}
 		if err := json.Unmarshal(req.Params, &params); err != nil {
-			h.sendJSONRPCError(w, req.ID, -32602, "Invalid params", err.Error())
-			return
+			return rpcError(req.ID, -32602, "Invalid params", err.Error())
 		}
 
-		toolResult, err := h.callTool(r.Context(), userID, params.Name, params.Arguments)
+		release, err := h.toolLimiter.acquire(ctx, userID)
 		if err != nil {
-			h.sendJSONRPCError(w, req.ID, -32000, "Tool error", err.Error())
-			return
+			return rpcError(req.ID, -32000, "Tool error", err.Error())
+		}
+		toolCtx := withProgress(h.withUserLocale(ctx, userID), params.Meta.ProgressToken)
+		toolResult, err := h.callTool(toolCtx, userID, params.Name, params.Arguments)
+		release()
+		if err != nil {
+			return toolError(req.ID, err)
 		}
 		result = toolResult
 
 	default:
-		h.sendJSONRPCError(w, req.ID, -32601, "Method not found", req.Method)
-		return
+		return rpcError(req.ID, -32601, "Method not found", req.Method)
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]any{
-		"jsonrpc": "2.0",
-		"id":      req.ID,
-		"result":  result,
-	})
+	return &jsonrpcResponse{JSONRPC: "2.0", ID: req.ID, Result: result}
 }
 
-func (h *MCPHandler) sendJSONRPCError(w http.ResponseWriter, id any, code int, message, data string) {
+func (h *MCPHandler) sendJSONRPCError(w http.ResponseWriter, id json.RawMessage, code int, message, data string) {
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]any{
-		"jsonrpc": "2.0",
-		"id":      id,
-		"error": map[string]any{
-			"code":    code,
-			"message": message,
-			"data":    data,
-		},
-	})
+	json.NewEncoder(w).Encode(rpcError(id, code, message, data))
 }
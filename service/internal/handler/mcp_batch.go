@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+)
+
+const (
+	// maxBatchSize bounds the requests in one JSON-RPC batch
+	maxBatchSize = 50
+
+	// maxConcurrentToolCalls is how many tool calls one user can have
+	// running at once, across batches and single requests
+	maxConcurrentToolCalls = 4
+
+	// userLimiterIdle is how long a user's tool call slots are kept after
+	// they were last used
+	userLimiterIdle = 10 * time.Minute
+)
+
+type jsonrpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type jsonrpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+func rpcError(id json.RawMessage, code int, message, data string) *jsonrpcResponse {
+	rpcErr := &jsonrpcError{Code: code, Message: message}
+	if data != "" {
+		rpcErr.Data = data
+	}
+	return &jsonrpcResponse{JSONRPC: "2.0", ID: id, Error: rpcErr}
+}
+
+// toolError reports a failed tool call. When a query failed to parse, the
+// data is an object with the error and where the query went wrong, so
+// clients can point at the offending token.
+func toolError(id json.RawMessage, err error) *jsonrpcResponse {
+	resp := rpcError(id, -32000, "Tool error", err.Error())
+	var parseErr *classification.ParseError
+	if errors.As(err, &parseErr) {
+		data := parseErr.Details()
+		data["error"] = err.Error()
+		resp.Error.Data = data
+	}
+	return resp
+}
+
+// handleBatch runs a JSON-RPC batch. Requests run in parallel, with tool
+// calls limited by the user's concurrency limit, and responses are returned
+// in request order.
+func (h *MCPHandler) handleBatch(ctx context.Context, w http.ResponseWriter, userID uuid.UUID, body []byte) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		h.sendJSONRPCError(w, nil, -32700, "Parse error", err.Error())
+		return
+	}
+	if len(raw) == 0 {
+		h.sendJSONRPCError(w, nil, -32600, "Invalid Request", "batch is empty")
+		return
+	}
+	if len(raw) > maxBatchSize {
+		h.sendJSONRPCError(w, nil, -32600, "Invalid Request",
+			fmt.Sprintf("batch has %d requests; the limit is %d", len(raw), maxBatchSize))
+		return
+	}
+
+	responses := make([]*jsonrpcResponse, len(raw))
+	var wg sync.WaitGroup
+	for i, msg := range raw {
+		var req jsonrpcRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			responses[i] = rpcError(nil, -32600, "Invalid Request", err.Error())
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i] = h.dispatch(ctx, userID, req)
+		}()
+	}
+	wg.Wait()
+
+	// Notifications get no response
+	results := make([]*jsonrpcResponse, 0, len(responses))
+	for _, resp := range responses {
+		if resp != nil {
+			results = append(results, resp)
+		}
+	}
+	if len(results) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(results)
+}
+
+// userLimiter bounds the concurrent work each user can run. A user's slots
+// are dropped once they have sat unused for userLimiterIdle.
+type userLimiter struct {
+	limit int
+	mu    sync.Mutex
+	sems  map[uuid.UUID]*userSlots
+}
+
+// userSlots is a user's semaphore and when it was last acquired or released
+type userSlots struct {
+	sem      chan struct{}
+	lastUsed time.Time
+}
+
+func newUserLimiter(limit int) *userLimiter {
+	return &userLimiter{
+		limit: limit,
+		sems:  make(map[uuid.UUID]*userSlots),
+	}
+}
+
+// acquire waits for one of the user's slots, returning a function that
+// releases it, or fails if ctx ends first
+func (l *userLimiter) acquire(ctx context.Context, userID uuid.UUID) (func(), error) {
+	l.mu.Lock()
+	now := time.Now()
+	for id, slots := range l.sems {
+		if len(slots.sem) == 0 && now.Sub(slots.lastUsed) > userLimiterIdle {
+			delete(l.sems, id)
+		}
+	}
+	slots, ok := l.sems[userID]
+	if !ok {
+		slots = &userSlots{sem: make(chan struct{}, l.limit)}
+		l.sems[userID] = slots
+	}
+	slots.lastUsed = now
+	l.mu.Unlock()
+
+	select {
+	case slots.sem <- struct{}{}:
+		return func() {
+			l.mu.Lock()
+			slots.lastUsed = time.Now()
+			l.mu.Unlock()
+			<-slots.sem
+		}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
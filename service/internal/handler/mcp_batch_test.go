@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
+)
+
+func TestHandleJSONRPCBatch(t *testing.T) {
+	var running, peak atomic.Int32
+	slow := func(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return args["n"], nil
+	}
+	h := &MCPHandler{
+		toolFuncs:   map[string]mcp.ToolFunc{"slow": slow},
+		toolLimiter: newUserLimiter(2),
+	}
+
+	var batch []string
+	for i := 0; i < 6; i++ {
+		batch = append(batch, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"tools/call","params":{"name":"slow","arguments":{"n":%d}}}`, i, i))
+	}
+	batch = append(batch,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":"x","method":"nope"}`,
+		`42`,
+	)
+	body := "[" + strings.Join(batch, ",") + "]"
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(body))
+	h.handleJSONRPC(w, r, uuid.New())
+
+	var resp []struct {
+		ID     any `json:"id"`
+		Result any `json:"result"`
+		Error  *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode %s: %v", w.Body.String(), err)
+	}
+	if len(resp) != 8 {
+		t.Fatalf("got %d responses, want 8 (notification excluded): %s", len(resp), w.Body.String())
+	}
+	for i := 0; i < 6; i++ {
+		if resp[i].ID != float64(i) || resp[i].Result != float64(i) {
+			t.Errorf("response %d = %+v, want results in request order", i, resp[i])
+		}
+	}
+	if resp[6].Error == nil || resp[6].Error.Code != -32601 {
+		t.Errorf("unknown method response = %+v", resp[6])
+	}
+	if resp[7].Error == nil || resp[7].Error.Code != -32600 || resp[7].ID != nil {
+		t.Errorf("invalid request response = %+v", resp[7])
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("%d tool calls ran at once, limit is 2", p)
+	}
+}
+
+func TestHandleJSONRPCBatchLimits(t *testing.T) {
+	h := &MCPHandler{toolLimiter: newUserLimiter(1)}
+
+	for _, body := range []string{"[]", "[" + strings.Repeat(`{"jsonrpc":"2.0","id":1,"method":"ping"},`, maxBatchSize) + `{"jsonrpc":"2.0","id":1,"method":"ping"}]`} {
+		w := httptest.NewRecorder()
+		h.handleJSONRPC(w, httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(body)), uuid.New())
+		if !strings.Contains(w.Body.String(), `"code":-32600`) {
+			t.Errorf("expected invalid request for %.20s..., got %s", body, w.Body.String())
+		}
+	}
+
+	w := httptest.NewRecorder()
+	h.handleJSONRPC(w, httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(`[{"jsonrpc":"2.0","method":"notifications/initialized"}]`)), uuid.New())
+	if w.Code != http.StatusNoContent {
+		t.Errorf("all-notification batch status = %d, want 204", w.Code)
+	}
+}
+
+func TestUserLimiter(t *testing.T) {
+	l := newUserLimiter(1)
+	user := uuid.New()
+
+	release, err := l.acquire(context.Background(), user)
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	// Another user is not affected
+	other, err := l.acquire(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("acquire for another user: %v", err)
+	}
+	other()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx, user); err == nil {
+		t.Error("expected acquire to wait for the user's slot")
+	}
+
+	release()
+	again, err := l.acquire(context.Background(), user)
+	if err != nil {
+		t.Fatalf("acquire after release: %v", err)
+	}
+	again()
+}
+
+func TestUserLimiterEvictsIdleUsers(t *testing.T) {
+	l := newUserLimiter(1)
+	idle, busy := uuid.New(), uuid.New()
+
+	release, err := l.acquire(context.Background(), idle)
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	release()
+	if _, err := l.acquire(context.Background(), busy); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	// Both were last used long ago, but busy still holds its slot
+	l.mu.Lock()
+	for _, slots := range l.sems {
+		slots.lastUsed = time.Now().Add(-userLimiterIdle - time.Minute)
+	}
+	l.mu.Unlock()
+
+	next, err := l.acquire(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+	next()
+
+	if _, ok := l.sems[idle]; ok {
+		t.Error("expected the idle user's slots to be dropped")
+	}
+	if _, ok := l.sems[busy]; !ok {
+		t.Error("expected the slots of a user with a call running to be kept")
+	}
+}
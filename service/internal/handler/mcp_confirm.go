@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// confirmationTTL is how long the token from a destructive tool's preview
+// can be used to execute it
+const confirmationTTL = 10 * time.Minute
+
+var errConfirmationInvalid = errors.New("confirmation token is invalid, expired or for different arguments")
+
+// pendingConfirmation is a previewed destructive tool call awaiting the
+// call that executes it
+type pendingConfirmation struct {
+	userID  uuid.UUID
+	digest  string
+	expires time.Time
+}
+
+// confirmations holds the tokens handed out with destructive tool previews.
+// A token is single use and only executes the call it previewed: the same
+// user, tool and arguments.
+type confirmations struct {
+	mu      sync.Mutex
+	pending map[string]pendingConfirmation
+	now     func() time.Time
+}
+
+func newConfirmations() *confirmations {
+	return &confirmations{
+		pending: make(map[string]pendingConfirmation),
+		now:     time.Now,
+	}
+}
+
+// issue returns a token confirming the tool call. args must not include the
+// token itself.
+func (c *confirmations) issue(userID uuid.UUID, tool string, args any) (string, time.Time, error) {
+	digest, err := confirmationDigest(tool, args)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+	token := "confirm_" + hex.EncodeToString(b)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for t, p := range c.pending {
+		if now.After(p.expires) {
+			delete(c.pending, t)
+		}
+	}
+	expires := now.Add(confirmationTTL)
+	c.pending[token] = pendingConfirmation{userID: userID, digest: digest, expires: expires}
+	return token, expires, nil
+}
+
+// redeem consumes a token, failing unless it was issued for this call
+func (c *confirmations) redeem(userID uuid.UUID, tool, token string, args any) error {
+	digest, err := confirmationDigest(tool, args)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.pending[token]
+	if !ok || p.userID != userID || p.digest != digest || c.now().After(p.expires) {
+		return errConfirmationInvalid
+	}
+	delete(c.pending, token)
+	return nil
+}
+
+// confirmationDigest identifies a tool call by its name and arguments
+func confirmationDigest(tool string, args any) (string, error) {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(tool+"\x00"), data...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// requiresConfirmation reports whether destructive tools must be previewed
+// and confirmed, which the API key the request authenticated with decides
+func requiresConfirmation(ctx context.Context) bool {
+	key, ok := APIKeyFromContext(ctx)
+	return ok && key.RequireConfirmation
+}
+
+// confirmationPrompt tells the model how to execute a previewed call
+func confirmationPrompt(tool, token string, expires time.Time) string {
+	return fmt.Sprintf("\n**Nothing was changed.** This API key requires confirmation for %s. "+
+		"To apply these changes, call %s again with the same arguments and `confirmation_token: %s` before %s.\n",
+		tool, tool, token, expires.UTC().Format(time.RFC3339))
+}
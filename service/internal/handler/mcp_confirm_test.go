@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestConfirmations(t *testing.T) {
+	c := newConfirmations()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	user := uuid.New()
+	skip := true
+	args := mcp.BulkClassifyArgs{Query: "title:standup", Skip: &skip}
+
+	token, expires, err := c.issue(user, "bulk_classify", args)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if !expires.Equal(now.Add(confirmationTTL)) {
+		t.Errorf("expires = %v", expires)
+	}
+
+	other := args
+	other.Query = "title:retro"
+	if err := c.redeem(user, "bulk_classify", token, other); err == nil {
+		t.Error("expected token to be rejected for different arguments")
+	}
+	if err := c.redeem(uuid.New(), "bulk_classify", token, args); err == nil {
+		t.Error("expected token to be rejected for another user")
+	}
+	if err := c.redeem(user, "apply_rules", token, args); err == nil {
+		t.Error("expected token to be rejected for another tool")
+	}
+	if err := c.redeem(user, "bulk_classify", token, args); err != nil {
+		t.Errorf("redeem: %v", err)
+	}
+	if err := c.redeem(user, "bulk_classify", token, args); err == nil {
+		t.Error("expected token to be single use")
+	}
+
+	token, _, _ = c.issue(user, "bulk_classify", args)
+	now = now.Add(confirmationTTL + time.Second)
+	if err := c.redeem(user, "bulk_classify", token, args); err == nil {
+		t.Error("expected expired token to be rejected")
+	}
+}
+
+func TestRequiresConfirmation(t *testing.T) {
+	if requiresConfirmation(context.Background()) {
+		t.Error("expected no confirmation without an API key")
+	}
+	ctx := context.WithValue(context.Background(), apiKeyKey, &store.APIKey{RequireConfirmation: true})
+	if !requiresConfirmation(ctx) {
+		t.Error("expected confirmation for a key that requires it")
+	}
+}
@@ -2,13 +2,15 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 )
 
@@ -79,20 +81,150 @@ func (h *MCPOAuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Generate a simple client ID (for public clients using PKCE, no secret needed)
-	clientID := fmt.Sprintf("mcp_%d", time.Now().UnixNano())
+	for _, uri := range req.RedirectURIs {
+		if err := validateRedirectURI(uri); err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{
+				"error":             "invalid_redirect_uri",
+				"error_description": err.Error(),
+			})
+			return
+		}
+	}
+
+	// Clients are public and authenticate with PKCE, so no secret is issued
+	client, err := h.oauthStore.RegisterClient(r.Context(), req.ClientName, req.RedirectURIs)
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{
+			"error":             "server_error",
+			"error_description": "Failed to register client",
+		})
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(map[string]any{
-		"client_id":                  clientID,
-		"client_id_issued_at":        time.Now().Unix(),
-		"redirect_uris":              req.RedirectURIs,
-		"client_name":                req.ClientName,
+		"client_id":                  client.ClientID,
+		"client_id_issued_at":        client.CreatedAt.Unix(),
+		"redirect_uris":              client.RedirectURIs,
+		"client_name":                client.ClientName,
+		"grant_types":                []string{"authorization_code"},
+		"response_types":             []string{"code"},
 		"token_endpoint_auth_method": "none",
 	})
 }
 
+// PluginManifest returns a plugin manifest for agent platforms that onboard
+// tools from /.well-known/ai-plugin.json. It points them at the OAuth
+// endpoints above, so no API key has to be exchanged by hand.
+// GET /.well-known/ai-plugin.json
+func (h *MCPOAuthHandler) PluginManifest(w http.ResponseWriter, r *http.Request) {
+	info := mcp.GetServerInfo()
+	manifest := map[string]any{
+		"schema_version":        "v1",
+		"name_for_human":        "Timesheet",
+		"name_for_model":        info.Name,
+		"description_for_human": "Track time, classify calendar events and review your timesheet.",
+		"description_for_model": info.Instructions,
+		"auth": map[string]any{
+			"type":                       "oauth",
+			"client_url":                 h.baseURL + "/mcp/authorize",
+			"authorization_url":          h.baseURL + "/mcp/token",
+			"authorization_content_type": "application/x-www-form-urlencoded",
+			"scope":                      "",
+		},
+		"api": map[string]any{
+			"type": "openapi",
+			"url":  h.baseURL + "/api/openapi.yaml",
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(manifest)
+}
+
+// validateRedirectURI checks a redirect URI a client registers. It must be
+// absolute and have no fragment (RFC 6749 section 3.1.2). Plain http is only
+// allowed for loopback addresses used by native clients (RFC 8252).
+func validateRedirectURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" {
+		return fmt.Errorf("redirect_uri %q must be an absolute URI", uri)
+	}
+	if u.Fragment != "" {
+		return fmt.Errorf("redirect_uri %q must not include a fragment", uri)
+	}
+	if u.Scheme == "http" && !isLoopbackHost(u.Hostname()) {
+		return fmt.Errorf("redirect_uri %q must use https unless it is a loopback address", uri)
+	}
+	if (u.Scheme == "http" || u.Scheme == "https") && u.Host == "" {
+		return fmt.Errorf("redirect_uri %q has no host", uri)
+	}
+	return nil
+}
+
+// redirectURIAllowed reports whether uri is one of the client's registered
+// redirect URIs. Loopback URIs match on any port, since native clients pick
+// an ephemeral port when they start listening (RFC 8252 section 7.3).
+func redirectURIAllowed(registered []string, uri string) bool {
+	requested, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	for _, r := range registered {
+		if r == uri {
+			return true
+		}
+		allowed, err := url.Parse(r)
+		if err != nil || allowed.Scheme != "http" || !isLoopbackHost(allowed.Hostname()) {
+			continue
+		}
+		if requested.Scheme == allowed.Scheme &&
+			requested.Hostname() == allowed.Hostname() &&
+			requested.Path == allowed.Path &&
+			requested.RawQuery == allowed.RawQuery {
+			return true
+		}
+	}
+	return false
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// lookupClient finds a registered client and checks that it may use
+// redirectURI. Failures are not redirected, since the redirect URI cannot
+// be trusted until the client is known.
+func (h *MCPOAuthHandler) lookupClient(w http.ResponseWriter, r *http.Request, clientID, redirectURI string) (*store.MCPOAuthClient, bool) {
+	if clientID == "" {
+		http.Error(w, "client_id is required", http.StatusBadRequest)
+		return nil, false
+	}
+	client, err := h.oauthStore.GetClient(r.Context(), clientID)
+	if err != nil {
+		if errors.Is(err, store.ErrOAuthClientNotFound) {
+			http.Error(w, "Unknown client_id. Register the client at /mcp/register first.", http.StatusBadRequest)
+			return nil, false
+		}
+		http.Error(w, "Failed to look up client", http.StatusInternalServerError)
+		return nil, false
+	}
+	if !redirectURIAllowed(client.RedirectURIs, redirectURI) {
+		http.Error(w, "redirect_uri is not registered for this client", http.StatusBadRequest)
+		return nil, false
+	}
+	return client, true
+}
+
 // ResourceMetadata returns OAuth 2.0 Protected Resource Metadata
 // GET /.well-known/oauth-protected-resource or via WWW-Authenticate header
 func (h *MCPOAuthHandler) ResourceMetadata(w http.ResponseWriter, r *http.Request) {
@@ -111,19 +243,25 @@ func (h *MCPOAuthHandler) ResourceMetadata(w http.ResponseWriter, r *http.Reques
 func (h *MCPOAuthHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 	// Parse OAuth parameters
 	responseType := r.URL.Query().Get("response_type")
+	clientID := r.URL.Query().Get("client_id")
 	redirectURI := r.URL.Query().Get("redirect_uri")
 	codeChallenge := r.URL.Query().Get("code_challenge")
 	codeChallengeMethod := r.URL.Query().Get("code_challenge_method")
 	state := r.URL.Query().Get("state") // Client's state parameter
 
-	// Validate required parameters
-	if responseType != "code" {
-		h.oauthErrorRedirect(w, r, redirectURI, "unsupported_response_type", "Only 'code' response type is supported", state)
+	// The client and redirect URI are checked first: until then errors
+	// cannot safely be redirected
+	if redirectURI == "" {
+		http.Error(w, "redirect_uri is required", http.StatusBadRequest)
+		return
+	}
+	if _, ok := h.lookupClient(w, r, clientID, redirectURI); !ok {
 		return
 	}
 
-	if redirectURI == "" {
-		http.Error(w, "redirect_uri is required", http.StatusBadRequest)
+	// Validate required parameters
+	if responseType != "code" {
+		h.oauthErrorRedirect(w, r, redirectURI, "unsupported_response_type", "Only 'code' response type is supported", state)
 		return
 	}
 
@@ -138,7 +276,7 @@ func (h *MCPOAuthHandler) Authorize(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create OAuth session
-	session, err := h.oauthStore.CreateSession(r.Context(), codeChallenge, codeChallengeMethod, redirectURI)
+	session, err := h.oauthStore.CreateSession(r.Context(), &clientID, codeChallenge, codeChallengeMethod, redirectURI)
 	if err != nil {
 		h.oauthErrorRedirect(w, r, redirectURI, "server_error", "Failed to create session", state)
 		return
@@ -200,7 +338,9 @@ func (h *MCPOAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Complete the OAuth authorization
-	authCode, _, err := h.oauthStore.CompleteAuthorization(r.Context(), oauthState, user.ID)
+	// The code goes to the redirect URI validated when the session started,
+	// not the one posted back with the form
+	authCode, sessionRedirectURI, err := h.oauthStore.CompleteAuthorization(r.Context(), oauthState, user.ID)
 	if err != nil {
 		if err == store.ErrOAuthSessionNotFound || err == store.ErrOAuthSessionExpired {
 			http.Error(w, "Session expired. Please try again.", http.StatusBadRequest)
@@ -211,7 +351,7 @@ func (h *MCPOAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Redirect back to MCP client with auth code
-	redirectURL, err := url.Parse(redirectURI)
+	redirectURL, err := url.Parse(sessionRedirectURI)
 	if err != nil {
 		http.Error(w, "Invalid redirect URI", http.StatusBadRequest)
 		return
@@ -232,6 +372,7 @@ func (h *MCPOAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 func (h *MCPOAuthHandler) AuthorizeWithToken(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		Token               string `json:"token"`
+		ClientID            string `json:"client_id"`
 		CodeChallenge       string `json:"code_challenge"`
 		CodeChallengeMethod string `json:"code_challenge_method"`
 		RedirectURI         string `json:"redirect_uri"`
@@ -255,8 +396,17 @@ func (h *MCPOAuthHandler) AuthorizeWithToken(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// A client_id is optional here, but when given the code is bound to it
+	var clientID *string
+	if req.ClientID != "" {
+		if _, ok := h.lookupClient(w, r, req.ClientID, req.RedirectURI); !ok {
+			return
+		}
+		clientID = &req.ClientID
+	}
+
 	// Create session and complete authorization
-	session, err := h.oauthStore.CreateSession(r.Context(), req.CodeChallenge, req.CodeChallengeMethod, req.RedirectURI)
+	session, err := h.oauthStore.CreateSession(r.Context(), clientID, req.CodeChallenge, req.CodeChallengeMethod, req.RedirectURI)
 	if err != nil {
 		http.Error(w, "Failed to create session", http.StatusInternalServerError)
 		return
@@ -285,6 +435,7 @@ func (h *MCPOAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
 	}
 
 	grantType := r.FormValue("grant_type")
+	clientID := r.FormValue("client_id")
 	code := r.FormValue("code")
 	codeVerifier := r.FormValue("code_verifier")
 
@@ -304,7 +455,7 @@ func (h *MCPOAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Exchange auth code for access token
-	token, err := h.oauthStore.ExchangeAuthCode(r.Context(), code, codeVerifier)
+	token, err := h.oauthStore.ExchangeAuthCode(r.Context(), clientID, code, codeVerifier)
 	if err != nil {
 		switch err {
 		case store.ErrInvalidAuthCode:
@@ -313,6 +464,8 @@ func (h *MCPOAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
 			h.tokenError(w, "invalid_grant", "Authorization code expired")
 		case store.ErrCodeChallengeInvalid:
 			h.tokenError(w, "invalid_grant", "Code verifier does not match challenge")
+		case store.ErrOAuthClientMismatch:
+			h.tokenError(w, "invalid_grant", "Authorization code was issued to another client")
 		default:
 			h.tokenError(w, "server_error", "Failed to exchange code")
 		}
@@ -0,0 +1,50 @@
+package handler
+
+import "testing"
+
+func TestValidateRedirectURI(t *testing.T) {
+	tests := []struct {
+		uri   string
+		valid bool
+	}{
+		{"https://claude.ai/api/mcp/auth_callback", true},
+		{"http://localhost:3118/callback", true},
+		{"http://127.0.0.1/callback", true},
+		{"http://[::1]:8000/cb", true},
+		{"cursor://anysphere.cursor-mcp/oauth/callback", true},
+		{"http://example.com/callback", false},
+		{"https://example.com/callback#frag", false},
+		{"/callback", false},
+		{"https:///callback", false},
+	}
+	for _, tt := range tests {
+		if err := validateRedirectURI(tt.uri); (err == nil) != tt.valid {
+			t.Errorf("validateRedirectURI(%q) = %v, want valid=%v", tt.uri, err, tt.valid)
+		}
+	}
+}
+
+func TestRedirectURIAllowed(t *testing.T) {
+	registered := []string{
+		"https://claude.ai/api/mcp/auth_callback",
+		"http://localhost:3118/callback",
+	}
+	tests := []struct {
+		uri     string
+		allowed bool
+	}{
+		{"https://claude.ai/api/mcp/auth_callback", true},
+		{"https://claude.ai/api/mcp/auth_callback?x=1", false},
+		{"https://claude.ai:8443/api/mcp/auth_callback", false},
+		{"http://localhost:3118/callback", true},
+		{"http://localhost:51234/callback", true},
+		{"http://localhost:51234/other", false},
+		{"http://127.0.0.1:3118/callback", false},
+		{"https://evil.example/callback", false},
+	}
+	for _, tt := range tests {
+		if got := redirectURIAllowed(registered, tt.uri); got != tt.allowed {
+			t.Errorf("redirectURIAllowed(%q) = %v, want %v", tt.uri, got, tt.allowed)
+		}
+	}
+}
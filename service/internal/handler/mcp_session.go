@@ -0,0 +1,330 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// mcpSessionHeader carries the session ID of the Streamable HTTP
+	// transport, issued in the response to initialize
+	mcpSessionHeader = "Mcp-Session-Id"
+
+	// mcpSessionIdleTTL is how long a session without requests or an open
+	// stream is kept
+	mcpSessionIdleTTL = time.Hour
+
+	// mcpEventBacklog is how many server-to-client messages a session keeps
+	// for clients resuming a stream with Last-Event-ID
+	mcpEventBacklog = 200
+
+	// progressInterval is how many items a long tool call processes between
+	// progress notifications
+	progressInterval = 25
+)
+
+// mcpSessionKey holds the *mcpSession a request belongs to, if any
+const mcpSessionKey contextKey = "mcpSession"
+
+// mcpEvent is a server-to-client message on a session's stream
+type mcpEvent struct {
+	id   uint64
+	data []byte
+}
+
+// mcpSession is a client's MCP session. Messages sent to it are numbered,
+// delivered to its open streams, and kept so a reconnecting stream can
+// resume after the last event it saw.
+type mcpSession struct {
+	id     string
+	userID uuid.UUID
+	// apiKeyID is the key the session was started with, if any
+	apiKeyID *uuid.UUID
+
+	mu          sync.Mutex
+	nextEventID uint64
+	backlog     []mcpEvent
+	streams     map[chan mcpEvent]struct{}
+	lastSeen    time.Time
+}
+
+// send queues a JSON-RPC message for the session's streams
+func (s *mcpSession) send(msg any) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextEventID++
+	event := mcpEvent{id: s.nextEventID, data: data}
+	s.backlog = append(s.backlog, event)
+	if len(s.backlog) > mcpEventBacklog {
+		s.backlog = s.backlog[len(s.backlog)-mcpEventBacklog:]
+	}
+	for ch := range s.streams {
+		select {
+		case ch <- event:
+		default:
+			// A stream that can't keep up catches up from the backlog when
+			// it reconnects
+		}
+	}
+}
+
+// notify sends a JSON-RPC notification
+func (s *mcpSession) notify(method string, params any) {
+	s.send(map[string]any{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+	})
+}
+
+// subscribe opens a stream, returning the events after lastEventID that it
+// missed and a channel of new ones
+func (s *mcpSession) subscribe(lastEventID uint64) ([]mcpEvent, chan mcpEvent, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var missed []mcpEvent
+	for _, e := range s.backlog {
+		if e.id > lastEventID {
+			missed = append(missed, e)
+		}
+	}
+	ch := make(chan mcpEvent, 32)
+	s.streams[ch] = struct{}{}
+	return missed, ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.streams, ch)
+		s.lastSeen = time.Now()
+	}
+}
+
+func (s *mcpSession) touch(now time.Time) {
+	s.mu.Lock()
+	s.lastSeen = now
+	s.mu.Unlock()
+}
+
+// idle reports whether the session has no open stream and no recent requests
+func (s *mcpSession) idle(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.streams) == 0 && now.Sub(s.lastSeen) > mcpSessionIdleTTL
+}
+
+// mcpSessions tracks the open sessions of the MCP endpoint. Sessions live in
+// memory, so a restart ends them and clients re-initialize.
+type mcpSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*mcpSession
+	now      func() time.Time
+}
+
+func newMCPSessions() *mcpSessions {
+	return &mcpSessions{
+		sessions: make(map[string]*mcpSession),
+		now:      time.Now,
+	}
+}
+
+// create starts a session, dropping idle ones
+func (m *mcpSessions) create(userID uuid.UUID, apiKeyID *uuid.UUID) (*mcpSession, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	now := m.now()
+	s := &mcpSession{
+		id:       hex.EncodeToString(b),
+		userID:   userID,
+		apiKeyID: apiKeyID,
+		streams:  make(map[chan mcpEvent]struct{}),
+		lastSeen: now,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, other := range m.sessions {
+		if other.idle(now) {
+			delete(m.sessions, id)
+		}
+	}
+	m.sessions[s.id] = s
+	return s, nil
+}
+
+// get returns the user's session with the ID
+func (m *mcpSessions) get(id string, userID uuid.UUID) (*mcpSession, bool) {
+	m.mu.Lock()
+	s, ok := m.sessions[id]
+	m.mu.Unlock()
+	if !ok || s.userID != userID {
+		return nil, false
+	}
+	s.touch(m.now())
+	return s, true
+}
+
+// remove ends the user's session with the ID
+func (m *mcpSessions) remove(id string, userID uuid.UUID) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.sessions[id]
+	if !ok || s.userID != userID {
+		return false
+	}
+	delete(m.sessions, id)
+	return true
+}
+
+// forUser returns the user's sessions
+func (m *mcpSessions) forUser(userID uuid.UUID) []*mcpSession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var sessions []*mcpSession
+	for _, s := range m.sessions {
+		if s.userID == userID {
+			sessions = append(sessions, s)
+		}
+	}
+	return sessions
+}
+
+func mcpSessionFromContext(ctx context.Context) (*mcpSession, bool) {
+	s, ok := ctx.Value(mcpSessionKey).(*mcpSession)
+	return s, ok
+}
+
+// NotifyToolListChanged tells the MCP sessions started with an API key to
+// fetch tools/list again, after the key's tool policy changed
+func (h *MCPHandler) NotifyToolListChanged(userID, apiKeyID uuid.UUID) {
+	for _, s := range h.sessions.forUser(userID) {
+		if s.apiKeyID != nil && *s.apiKeyID == apiKeyID {
+			s.notify("notifications/tools/list_changed", map[string]any{})
+		}
+	}
+}
+
+// progressKey holds the progressReporter of a tool call
+const progressKey contextKey = "mcpProgress"
+
+type progressReporter struct {
+	session *mcpSession
+	token   any
+}
+
+// withProgress lets a tool call report progress when the client asked for
+// it with a progress token and has a session to receive it
+func withProgress(ctx context.Context, token any) context.Context {
+	session, ok := mcpSessionFromContext(ctx)
+	if !ok || token == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, progressKey, &progressReporter{session: session, token: token})
+}
+
+// reportProgress sends a notifications/progress message for the tool call,
+// if the client asked for progress
+func reportProgress(ctx context.Context, progress, total float64, message string) {
+	p, ok := ctx.Value(progressKey).(*progressReporter)
+	if !ok {
+		return
+	}
+	params := map[string]any{
+		"progressToken": p.token,
+		"progress":      progress,
+	}
+	if total > 0 {
+		params["total"] = total
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	p.session.notify("notifications/progress", params)
+}
+
+// handleStream serves GET /mcp: the session's stream of server-to-client
+// messages. A client reconnecting with Last-Event-ID first receives the
+// messages it missed.
+func (h *MCPHandler) handleStream(w http.ResponseWriter, r *http.Request, userID uuid.UUID) {
+	sessionID := r.Header.Get(mcpSessionHeader)
+	if sessionID == "" {
+		http.Error(w, mcpSessionHeader+" header is required", http.StatusBadRequest)
+		return
+	}
+	session, ok := h.sessions.get(sessionID, userID)
+	if !ok {
+		http.Error(w, "Session not found", http.StatusNotFound)
+		return
+	}
+
+	var lastEventID uint64
+	if v := r.Header.Get("Last-Event-ID"); v != "" {
+		id, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid Last-Event-ID", http.StatusBadRequest)
+			return
+		}
+		lastEventID = id
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	missed, events, unsubscribe := session.subscribe(lastEventID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.Header().Set(mcpSessionHeader, session.id)
+	w.WriteHeader(http.StatusOK)
+
+	writeEvent := func(e mcpEvent) {
+		fmt.Fprintf(w, "id: %d\nevent: message\ndata: %s\n\n", e.id, e.data)
+	}
+	for _, e := range missed {
+		writeEvent(e)
+		lastEventID = e.id
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprintf(w, ": ping\n\n")
+			flusher.Flush()
+		case e := <-events:
+			// Events sent while the backlog was replayed are already written
+			if e.id <= lastEventID {
+				continue
+			}
+			writeEvent(e)
+			lastEventID = e.id
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestMCPSessionLifecycle(t *testing.T) {
+	h := &MCPHandler{sessions: newMCPSessions(), toolLimiter: newUserLimiter(1)}
+	user := uuid.New()
+
+	post := func(sessionID, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(body))
+		if sessionID != "" {
+			r.Header.Set(mcpSessionHeader, sessionID)
+		}
+		w := httptest.NewRecorder()
+		h.handleJSONRPC(w, r, user)
+		return w
+	}
+
+	w := post("", `{"jsonrpc":"2.0","id":1,"method":"initialize"}`)
+	sessionID := w.Header().Get(mcpSessionHeader)
+	if sessionID == "" {
+		t.Fatalf("initialize returned no %s header", mcpSessionHeader)
+	}
+	if !strings.Contains(w.Body.String(), `"listChanged":true`) {
+		t.Errorf("initialize should advertise tools.listChanged: %s", w.Body.String())
+	}
+
+	if w := post(sessionID, `{"jsonrpc":"2.0","id":2,"method":"resources/templates/list"}`); w.Code != http.StatusOK {
+		t.Errorf("request with session = %d", w.Code)
+	}
+	if w := post("unknown", `{"jsonrpc":"2.0","id":2,"method":"resources/templates/list"}`); w.Code != http.StatusNotFound {
+		t.Errorf("request with unknown session = %d, want 404", w.Code)
+	}
+	if _, ok := h.sessions.get(sessionID, uuid.New()); ok {
+		t.Error("another user must not see the session")
+	}
+
+	r := httptest.NewRequest(http.MethodDelete, "/mcp", nil)
+	r.Header.Set(mcpSessionHeader, sessionID)
+	r = r.WithContext(context.WithValue(r.Context(), userIDKey, user))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusNoContent {
+		t.Errorf("DELETE = %d, want 204", w.Code)
+	}
+	if w := post(sessionID, `{"jsonrpc":"2.0","id":3,"method":"resources/templates/list"}`); w.Code != http.StatusNotFound {
+		t.Errorf("request after DELETE = %d, want 404", w.Code)
+	}
+}
+
+func TestMCPStreamResume(t *testing.T) {
+	h := &MCPHandler{sessions: newMCPSessions()}
+	user := uuid.New()
+	session, err := h.sessions.create(user, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := withProgress(context.WithValue(context.Background(), mcpSessionKey, session), "tok")
+	for i := 1; i <= 3; i++ {
+		reportProgress(ctx, float64(i), 3, "")
+	}
+
+	// Resume after the first event: the second and third are replayed
+	reqCtx, cancel := context.WithCancel(context.Background())
+	r := httptest.NewRequest(http.MethodGet, "/mcp", nil).WithContext(reqCtx)
+	r.Header.Set(mcpSessionHeader, session.id)
+	r.Header.Set("Last-Event-ID", "1")
+	w := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		h.handleStream(w, r, user)
+		close(done)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	<-done
+
+	body := w.Body.String()
+	if strings.Contains(body, "id: 1\n") || !strings.Contains(body, "id: 2\n") || !strings.Contains(body, "id: 3\n") {
+		t.Errorf("expected events 2 and 3 to be replayed, got:\n%s", body)
+	}
+	if !strings.Contains(body, `"method":"notifications/progress"`) || !strings.Contains(body, `"progressToken":"tok"`) {
+		t.Errorf("expected progress notifications, got:\n%s", body)
+	}
+	if w.Header().Get("Content-Type") != "text/event-stream" {
+		t.Errorf("Content-Type = %q", w.Header().Get("Content-Type"))
+	}
+}
+
+func TestNotifyToolListChanged(t *testing.T) {
+	h := &MCPHandler{sessions: newMCPSessions()}
+	user, keyID := uuid.New(), uuid.New()
+	withKey, _ := h.sessions.create(user, &keyID)
+	withoutKey, _ := h.sessions.create(user, nil)
+
+	h.NotifyToolListChanged(user, keyID)
+
+	if len(withKey.backlog) != 1 || !strings.Contains(string(withKey.backlog[0].data), "notifications/tools/list_changed") {
+		t.Errorf("expected list_changed for the key's session, got %v", withKey.backlog)
+	}
+	if len(withoutKey.backlog) != 0 {
+		t.Errorf("expected no notification for a session without the key")
+	}
+}
+
+func TestReportProgressWithoutRequest(t *testing.T) {
+	h := &MCPHandler{sessions: newMCPSessions()}
+	session, _ := h.sessions.create(uuid.New(), nil)
+	ctx := context.WithValue(context.Background(), mcpSessionKey, session)
+
+	// No progress token: the client did not ask for progress
+	reportProgress(withProgress(ctx, nil), 1, 2, "")
+	// No session: nowhere to send it
+	reportProgress(withProgress(context.Background(), "tok"), 1, 2, "")
+
+	if len(session.backlog) != 0 {
+		t.Errorf("expected no progress notifications, got %d", len(session.backlog))
+	}
+
+	reportProgress(withProgress(ctx, 7), 5, 0, "")
+	if got := string(session.backlog[0].data); strings.Contains(got, `"total"`) || !strings.Contains(got, `"progressToken":7`) {
+		t.Errorf("unexpected notification %s", got)
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestCallToolPolicy(t *testing.T) {
+	called := ""
+	call := func(name string) mcp.ToolFunc {
+		return func(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error) {
+			called = name
+			return "ok", nil
+		}
+	}
+	h := &MCPHandler{
+		tools: []mcpTool{{Name: "get_time_summary"}, {Name: "search_events"}, {Name: "classify_event"}},
+		toolFuncs: map[string]mcp.ToolFunc{
+			"get_time_summary": call("get_time_summary"),
+			"search_events":    call("search_events"),
+			"classify_event":   call("classify_event"),
+		},
+	}
+
+	// Without an API key (OAuth or JWT) every tool is available
+	if _, err := h.callTool(context.Background(), uuid.New(), "classify_event", nil); err != nil || called != "classify_event" {
+		t.Fatalf("classify_event without key = %v (called %q)", err, called)
+	}
+
+	key := &store.APIKey{
+		Name:         "reporting",
+		AllowedTools: []string{"get_time_summary", "search_events", "classify_event"},
+		DeniedTools:  []string{"classify_event"},
+	}
+	ctx := context.WithValue(context.Background(), apiKeyKey, key)
+
+	called = ""
+	_, err := h.callTool(ctx, uuid.New(), "classify_event", nil)
+	if err == nil || !strings.Contains(err.Error(), "not allowed") || called != "" {
+		t.Errorf("expected classify_event to be denied, got %v (called %q)", err, called)
+	}
+	if _, err := h.callTool(ctx, uuid.New(), "search_events", nil); err != nil || called != "search_events" {
+		t.Errorf("search_events = %v (called %q)", err, called)
+	}
+
+	var names []string
+	for _, tool := range h.allowedTools(ctx) {
+		names = append(names, tool.Name)
+	}
+	if strings.Join(names, ",") != "get_time_summary,search_events" {
+		t.Errorf("allowedTools = %v", names)
+	}
+
+	key.AllowedTools = []string{"get_time_summary"}
+	key.DeniedTools = nil
+	if _, err := h.callTool(ctx, uuid.New(), "search_events", nil); err == nil {
+		t.Error("expected search_events outside the allowlist to be denied")
+	}
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
+	"github.com/michaelw/timesheet-app/service/internal/meetingcost"
+)
+
+// defaultMeetingCostDays is the range of a meeting cost report without dates
+const defaultMeetingCostDays = 28
+
+// GetMeetingCostReport estimates the cost of the user's recurring meetings
+func (h *ReportsHandler) GetMeetingCostReport(ctx context.Context, req api.GetMeetingCostReportRequestObject) (api.GetMeetingCostReportResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetMeetingCostReport401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var startDate, endDate *time.Time
+	if req.Params.StartDate != nil {
+		startDate = &req.Params.StartDate.Time
+	}
+	if req.Params.EndDate != nil {
+		endDate = &req.Params.EndDate.Time
+	}
+	var specs []string
+	if req.Params.Rate != nil {
+		specs = *req.Params.Rate
+	}
+	start, end, rates, err := meetingCostRequest(startDate, endDate, req.Params.DefaultRate, specs)
+	if err != nil {
+		return api.GetMeetingCostReport400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	report, err := h.meetingCostSvc.Report(ctx, userID, start, end, rates)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetMeetingCostReport200JSONResponse(meetingCostReportToAPI(report, start, end)), nil
+}
+
+// reportDateRange applies the default range to a report request, the last
+// defaultDays days to today, and validates it
+func reportDateRange(startDate, endDate *time.Time, defaultDays int) (time.Time, time.Time, error) {
+	now := time.Now().UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if endDate != nil {
+		end = *endDate
+	}
+	start := end.AddDate(0, 0, -(defaultDays - 1))
+	if startDate != nil {
+		start = *startDate
+	}
+	if end.Before(start) {
+		return start, end, fmt.Errorf("end_date must not be before start_date")
+	}
+	if end.Sub(start) > maxReportDays*24*time.Hour {
+		return start, end, fmt.Errorf("date range must be at most two years")
+	}
+	return start, end, nil
+}
+
+// meetingCostRequest applies the defaults to a meeting cost request and
+// validates it: the last 4 weeks to today, and no rates
+func meetingCostRequest(startDate, endDate *time.Time, defaultRate *float64, specs []string) (time.Time, time.Time, meetingcost.Rates, error) {
+	start, end, err := reportDateRange(startDate, endDate, defaultMeetingCostDays)
+	if err != nil {
+		return start, end, meetingcost.Rates{}, err
+	}
+
+	var rate float64
+	if defaultRate != nil {
+		rate = *defaultRate
+	}
+	rates, err := meetingcost.ParseRates(rate, specs)
+	return start, end, rates, err
+}
+
+func meetingCostReportToAPI(r *meetingcost.Report, start, end time.Time) api.MeetingCostReport {
+	out := api.MeetingCostReport{
+		StartDate:   openapi_types.Date{Time: start},
+		EndDate:     openapi_types.Date{Time: end},
+		Priced:      r.Priced,
+		PersonHours: r.PersonHours,
+		Cost:        r.Cost,
+		Series:      make([]api.MeetingSeriesCost, len(r.Series)),
+		Projects:    make([]api.ProjectMeetingCost, len(r.Projects)),
+	}
+	for i, s := range r.Series {
+		out.Series[i] = api.MeetingSeriesCost{
+			Key:          s.Key,
+			Title:        s.Title,
+			ProjectId:    s.ProjectID,
+			ProjectName:  projectNameOrNil(s.ProjectName),
+			Occurrences:  s.Occurrences,
+			Hours:        s.Hours,
+			AvgAttendees: s.AvgAttendees,
+			PersonHours:  s.PersonHours,
+			Cost:         s.Cost,
+			LastHeld:     s.LastHeld,
+		}
+	}
+	for i, p := range r.Projects {
+		out.Projects[i] = api.ProjectMeetingCost{
+			ProjectId:   p.ProjectID,
+			ProjectName: projectNameOrNil(p.ProjectName),
+			Series:      p.Series,
+			PersonHours: p.PersonHours,
+			Cost:        p.Cost,
+		}
+	}
+	return out
+}
+
+// projectNameOrNil returns nil for the empty name of unclassified meetings
+func projectNameOrNil(name string) *string {
+	if name == "" {
+		return nil
+	}
+	return &name
+}
+
+// GetMeetingCost implements the get_meeting_cost tool
+func (h *MCPHandler) GetMeetingCost(ctx context.Context, userID uuid.UUID, args mcp.GetMeetingCostArgs) (any, error) {
+	startDate, err := parseDateArg("start_date", args.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDateArg("end_date", args.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	start, end, rates, err := meetingCostRequest(startDate, endDate, args.DefaultRate, args.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.meetingCostSvc.Report(ctx, userID, start, end, rates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute meeting cost: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Recurring Meeting Cost: %s to %s\n\n", start.Format("2006-01-02"), end.Format("2006-01-02")))
+	if len(report.Series) == 0 {
+		sb.WriteString("No recurring meetings with other attendees in this period.\n")
+		return toolResult(sb.String(), map[string]any{"report": meetingCostReportToAPI(report, start, end)}), nil
+	}
+	if report.Priced {
+		sb.WriteString(fmt.Sprintf("- **Estimated cost**: $%.2f\n", report.Cost))
+	} else {
+		sb.WriteString("- No rates given: showing person-hours only. Pass default_rate to estimate cost.\n")
+	}
+	sb.WriteString(fmt.Sprintf("- **Person-hours**: %.1f over %d series\n", report.PersonHours, len(report.Series)))
+
+	sb.WriteString("\n## By Series\n\n")
+	sb.WriteString("| Meeting | Project | Held | Avg attendees | Person-hours | Cost |\n")
+	sb.WriteString("|---------|---------|------|---------------|--------------|------|\n")
+	for _, s := range report.Series {
+		project := s.ProjectName
+		if project == "" {
+			project = "Unclassified"
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %d | %.1f | %.1f | $%.2f |\n",
+			s.Title, project, s.Occurrences, s.AvgAttendees, s.PersonHours, s.Cost))
+	}
+
+	sb.WriteString("\n## By Project\n\n")
+	for _, p := range report.Projects {
+		project := p.ProjectName
+		if project == "" {
+			project = "Unclassified"
+		}
+		sb.WriteString(fmt.Sprintf("- **%s**: %d series, %.1f person-hours, $%.2f\n", project, p.Series, p.PersonHours, p.Cost))
+	}
+
+	return toolResult(sb.String(), map[string]any{"report": meetingCostReportToAPI(report, start, end)}), nil
+}
@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
+	"github.com/michaelw/timesheet-app/service/internal/meetingload"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+const (
+	// defaultMeetingLoadDays is the range of a meeting load report without dates
+	defaultMeetingLoadDays = 28
+	// maxFocusGapDays bounds the range recorded as focus sessions at once
+	maxFocusGapDays = 31
+)
+
+// GetMeetingLoadReport splits the user's working days into meeting, focus
+// and fragmented time
+func (h *ReportsHandler) GetMeetingLoadReport(ctx context.Context, req api.GetMeetingLoadReportRequestObject) (api.GetMeetingLoadReportResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetMeetingLoadReport401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var startDate, endDate *time.Time
+	if req.Params.StartDate != nil {
+		startDate = &req.Params.StartDate.Time
+	}
+	if req.Params.EndDate != nil {
+		endDate = &req.Params.EndDate.Time
+	}
+	start, end, err := reportDateRange(startDate, endDate, defaultMeetingLoadDays)
+	if err != nil {
+		return api.GetMeetingLoadReport400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+	opts, err := meetingLoadOptions(req.Params.DayStart, req.Params.MinFocusMinutes)
+	if err != nil {
+		return api.GetMeetingLoadReport400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	report, err := h.meetingLoadSvc.Report(ctx, userID, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetMeetingLoadReport200JSONResponse(meetingLoadReportToAPI(report, start, end, opts)), nil
+}
+
+// RecordFocusGaps records the focus time between meetings in a date range
+// as focus sessions on a project
+func (h *FocusSessionHandler) RecordFocusGaps(ctx context.Context, req api.RecordFocusGapsRequestObject) (api.RecordFocusGapsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RecordFocusGaps401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.RecordFocusGaps400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	start, end := req.Body.StartDate.Time, req.Body.EndDate.Time
+	if end.Before(start) {
+		return api.RecordFocusGaps400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_date must not be before start_date",
+		}, nil
+	}
+	if end.Sub(start) >= maxFocusGapDays*24*time.Hour {
+		return api.RecordFocusGaps400JSONResponse{
+			Code:    "invalid_request",
+			Message: fmt.Sprintf("At most %d days can be recorded at once", maxFocusGapDays),
+		}, nil
+	}
+	opts, err := meetingLoadOptions(req.Body.DayStart, req.Body.MinFocusMinutes)
+	if err != nil {
+		return api.RecordFocusGaps400JSONResponse{
+			Code:    "invalid_request",
+			Message: err.Error(),
+		}, nil
+	}
+
+	if _, err := h.projects.GetByID(ctx, userID, req.Body.ProjectId); err != nil {
+		if errors.Is(err, store.ErrProjectNotFound) {
+			return api.RecordFocusGaps404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	gaps, err := h.meetingLoadSvc.FocusGaps(ctx, userID, start, end, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	title := "Focus time"
+	if req.Body.Title != nil && strings.TrimSpace(*req.Body.Title) != "" {
+		title = strings.TrimSpace(*req.Body.Title)
+	}
+
+	result := api.FocusGapsResult{Events: make([]api.CalendarEvent, len(gaps))}
+	for i, gap := range gaps {
+		event, err := h.focus.Record(ctx, userID, store.FocusSession{
+			ExternalID: meetingload.GapExternalIDPrefix + gap.Start.Format(time.RFC3339),
+			ProjectID:  req.Body.ProjectId,
+			Title:      title,
+			StartTime:  gap.Start,
+			EndTime:    gap.End,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := h.classificationSvc.RecalculateTimeEntriesForEvent(ctx, userID, event); err != nil {
+			return nil, err
+		}
+		result.Events[i] = calendarEventToAPI(event)
+		result.FocusHours += gap.End.Sub(gap.Start).Hours()
+	}
+
+	return api.RecordFocusGaps201JSONResponse(result), nil
+}
+
+// meetingLoadOptions parses the working day of a meeting load request,
+// defaulting to a 09:00 start and 30-minute focus blocks
+func meetingLoadOptions(dayStart *string, minFocusMinutes *int) (meetingload.Options, error) {
+	opts := meetingload.Options{
+		DayStart: meetingload.DefaultDayStart,
+		MinFocus: meetingload.DefaultMinFocus,
+	}
+	if dayStart != nil && *dayStart != "" {
+		t, err := time.Parse("15:04", *dayStart)
+		if err != nil {
+			return opts, fmt.Errorf("day_start must be HH:MM")
+		}
+		opts.DayStart = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	if minFocusMinutes != nil {
+		if *minFocusMinutes < 5 || *minFocusMinutes > 480 {
+			return opts, fmt.Errorf("min_focus_minutes must be between 5 and 480")
+		}
+		opts.MinFocus = time.Duration(*minFocusMinutes) * time.Minute
+	}
+	return opts, nil
+}
+
+// formatDayStart formats a day start offset as HH:MM
+func formatDayStart(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+func meetingLoadReportToAPI(r *meetingload.Report, start, end time.Time, opts meetingload.Options) api.MeetingLoadReport {
+	out := api.MeetingLoadReport{
+		StartDate:       openapi_types.Date{Time: start},
+		EndDate:         openapi_types.Date{Time: end},
+		DayStart:        formatDayStart(opts.DayStart),
+		MinFocusMinutes: int(opts.MinFocus.Minutes()),
+		WorkingHours:    r.WorkingHours,
+		MeetingHours:    r.MeetingHours,
+		FocusHours:      r.FocusHours,
+		FragmentedHours: r.FragmentedHours,
+		MeetingShare:    r.MeetingShare,
+		Days:            make([]api.MeetingLoadDay, len(r.Days)),
+		Weeks:           make([]api.MeetingLoadWeek, len(r.Weeks)),
+	}
+	for i, d := range r.Days {
+		blocks := make([]api.FocusBlock, len(d.FocusBlocks))
+		for j, b := range d.FocusBlocks {
+			blocks[j] = api.FocusBlock{StartTime: b.Start, EndTime: b.End}
+		}
+		out.Days[i] = api.MeetingLoadDay{
+			Date:            openapi_types.Date{Time: d.Date},
+			WorkingHours:    d.WorkingHours,
+			Meetings:        d.Meetings,
+			MeetingHours:    d.MeetingHours,
+			FocusHours:      d.FocusHours,
+			FragmentedHours: d.FragmentedHours,
+			MeetingShare:    d.MeetingShare,
+			FocusBlocks:     blocks,
+		}
+	}
+	for i, w := range r.Weeks {
+		out.Weeks[i] = api.MeetingLoadWeek{
+			WeekStart:       openapi_types.Date{Time: w.WeekStart},
+			WorkingHours:    w.WorkingHours,
+			MeetingHours:    w.MeetingHours,
+			FocusHours:      w.FocusHours,
+			FragmentedHours: w.FragmentedHours,
+			MeetingShare:    w.MeetingShare,
+		}
+	}
+	return out
+}
+
+// GetMeetingLoad implements the get_meeting_load tool
+func (h *MCPHandler) GetMeetingLoad(ctx context.Context, userID uuid.UUID, args mcp.GetMeetingLoadArgs) (any, error) {
+	startDate, err := parseDateArg("start_date", args.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDateArg("end_date", args.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := reportDateRange(startDate, endDate, defaultMeetingLoadDays)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := meetingLoadOptions(args.DayStart, args.MinFocusMinutes)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.meetingLoadSvc.Report(ctx, userID, start, end, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute meeting load: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Meeting Load: %s to %s\n\n", start.Format("2006-01-02"), end.Format("2006-01-02")))
+	if len(report.Days) == 0 {
+		sb.WriteString("No working days in this period.\n")
+		return toolResult(sb.String(), map[string]any{"report": meetingLoadReportToAPI(report, start, end, opts)}), nil
+	}
+	sb.WriteString(fmt.Sprintf("- **Meetings**: %.1fh of %.1fh working time (%.0f%%)\n", report.MeetingHours, report.WorkingHours, report.MeetingShare*100))
+	sb.WriteString(fmt.Sprintf("- **Focus time**: %.1fh in gaps of %dm or more\n", report.FocusHours, int(opts.MinFocus.Minutes())))
+	sb.WriteString(fmt.Sprintf("- **Fragmented**: %.1fh in shorter gaps\n", report.FragmentedHours))
+
+	sb.WriteString("\n## By Week\n\n")
+	sb.WriteString("| Week of | Meetings | Focus | Fragmented | Meeting share |\n")
+	sb.WriteString("|---------|----------|-------|------------|---------------|\n")
+	for _, w := range report.Weeks {
+		sb.WriteString(fmt.Sprintf("| %s | %.1fh | %.1fh | %.1fh | %.0f%% |\n",
+			w.WeekStart.Format("2006-01-02"), w.MeetingHours, w.FocusHours, w.FragmentedHours, w.MeetingShare*100))
+	}
+
+	sb.WriteString(fmt.Sprintf("\n## By Day (working day from %s UTC)\n\n", formatDayStart(opts.DayStart)))
+	for _, d := range report.Days {
+		sb.WriteString(fmt.Sprintf("- **%s**: %d meetings, %.1fh meetings, %.1fh focus",
+			d.Date.Format("Mon 2006-01-02"), d.Meetings, d.MeetingHours, d.FocusHours))
+		if len(d.FocusBlocks) > 0 {
+			blocks := make([]string, len(d.FocusBlocks))
+			for i, b := range d.FocusBlocks {
+				blocks[i] = b.Start.Format("15:04") + "-" + b.End.Format("15:04")
+			}
+			sb.WriteString(" (" + strings.Join(blocks, ", ") + ")")
+		}
+		sb.WriteString("\n")
+	}
+
+	return toolResult(sb.String(), map[string]any{"report": meetingLoadReportToAPI(report, start, end, opts)}), nil
+}
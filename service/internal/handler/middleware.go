@@ -1,11 +1,15 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 )
 
@@ -15,6 +19,12 @@ func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
 	return userID, ok
 }
 
+// APIKeyFromContext returns the API key the request authenticated with
+func APIKeyFromContext(ctx context.Context) (*store.APIKey, bool) {
+	key, ok := ctx.Value(apiKeyKey).(*store.APIKey)
+	return key, ok
+}
+
 // AuthMiddleware validates JWT tokens or API keys and adds user ID to context
 func AuthMiddleware(jwt *JWTService, apiKeys *store.APIKeyStore) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -35,11 +45,15 @@ func AuthMiddleware(jwt *JWTService, apiKeys *store.APIKeyStore) func(http.Handl
 
 			token := parts[1]
 			var userID uuid.UUID
+			var apiKey *store.APIKey
 			var err error
 
 			// Check if it's an API key (starts with "ts_")
 			if strings.HasPrefix(token, "ts_") && apiKeys != nil {
-				userID, err = apiKeys.ValidateAndGetUserID(r.Context(), token)
+				apiKey, err = apiKeys.Validate(r.Context(), token)
+				if err == nil {
+					userID = apiKey.UserID
+				}
 			} else {
 				// Try JWT validation
 				userID, err = jwt.ValidateToken(token)
@@ -52,7 +66,92 @@ func AuthMiddleware(jwt *JWTService, apiKeys *store.APIKeyStore) func(http.Handl
 
 			// Add user ID to context
 			ctx := context.WithValue(r.Context(), userIDKey, userID)
+			ctx = database.WithScope(ctx, database.Scope{UserID: userID})
+			if apiKey != nil {
+				ctx = context.WithValue(ctx, apiKeyKey, apiKey)
+			}
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// ConditionalGetMiddleware adds content-hash ETags to GET responses for the
+// given paths and answers If-None-Match with 304 Not Modified. The response is
+// still computed, but unchanged data isn't re-sent. Hashing the body rather
+// than using max(updated_at) means deletions also change the tag.
+func ConditionalGetMiddleware(paths ...string) func(http.Handler) http.Handler {
+	cacheable := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		cacheable[p] = true
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if (r.Method != http.MethodGet && r.Method != http.MethodHead) || !cacheable[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			for k, v := range rec.header {
+				w.Header()[k] = v
+			}
+			if rec.status != http.StatusOK {
+				w.WriteHeader(rec.status)
+				w.Write(rec.body.Bytes())
+				return
+			}
+
+			sum := sha256.Sum256(rec.body.Bytes())
+			etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+			w.Header().Set("ETag", etag)
+			// Responses are per-user, so shared caches must not store them
+			w.Header().Set("Cache-Control", "private, no-cache")
+			w.Header().Add("Vary", "Authorization")
+
+			if etagMatches(r.Header.Get("If-None-Match"), etag) {
+				w.Header().Del("Content-Type")
+				w.Header().Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write(rec.body.Bytes())
+		})
+	}
+}
+
+// etagMatches reports whether an If-None-Match header matches etag, using
+// weak comparison as RFC 9110 requires for If-None-Match
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponse captures a handler's response so it can be hashed
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
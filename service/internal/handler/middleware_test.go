@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestConditionalGetMiddleware(t *testing.T) {
+	body := `[{"id":"1"}]`
+	h := ConditionalGetMiddleware("/api/projects")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+
+	get := func(path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := get("/api/projects", "")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || first.Body.String() != body {
+		t.Fatalf("expected 200 with ETag, got %d etag=%q", first.Code, etag)
+	}
+
+	if rec := get("/api/projects", etag); rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("expected 304 with empty body for matching ETag, got %d", rec.Code)
+	}
+	if rec := get("/api/projects", `"other", W/`+etag); rec.Code != http.StatusNotModified {
+		t.Errorf("expected weak match in list to return 304, got %d", rec.Code)
+	}
+
+	body = `[]`
+	if rec := get("/api/projects", etag); rec.Code != http.StatusOK || rec.Header().Get("ETag") == etag {
+		t.Errorf("expected new ETag after content change, got %d", rec.Code)
+	}
+
+	if rec := get("/api/rules", ""); rec.Header().Get("ETag") != "" {
+		t.Error("expected paths not registered to pass through untouched")
+	}
+}
+
+func TestIdempotentRouteMatches(t *testing.T) {
+	classify := IdempotentRoute{Method: "PUT", Pattern: "/api/calendar-events/{id}/classify"}
+	tests := []struct {
+		method, path string
+		want         bool
+	}{
+		{"PUT", "/api/calendar-events/123/classify", true},
+		{"POST", "/api/calendar-events/123/classify", false},
+		{"PUT", "/api/calendar-events//classify", false},
+		{"PUT", "/api/calendar-events/123/classify/extra", false},
+		{"PUT", "/api/calendar-events/bulk-classify", false},
+	}
+	for _, tt := range tests {
+		if got := classify.matches(tt.method, tt.path); got != tt.want {
+			t.Errorf("matches(%s %s) = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+// memIdempotencyKeys is an in-memory IdempotencyKeyStore. A key with a nil
+// response is claimed and in progress, under the claim time in claims.
+type memIdempotencyKeys struct {
+	mu       sync.Mutex
+	keys     map[string]*store.IdempotentResponse
+	claims   map[string]time.Time
+	released int
+}
+
+func (m *memIdempotencyKeys) Claim(ctx context.Context, userID uuid.UUID, key, method, path, requestHash string) (*store.IdempotentResponse, time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	resp, ok := m.keys[key]
+	if !ok {
+		m.keys[key] = nil
+		m.claims[key] = time.Now()
+		return nil, m.claims[key], nil
+	}
+	if resp == nil {
+		return nil, time.Time{}, store.ErrIdempotencyKeyInProgress
+	}
+	return resp, time.Time{}, nil
+}
+
+func (m *memIdempotencyKeys) Complete(ctx context.Context, userID uuid.UUID, key string, claimedAt time.Time, resp *store.IdempotentResponse) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.keys[key] == nil && m.claims[key].Equal(claimedAt) {
+		m.keys[key] = resp
+	}
+	return nil
+}
+
+func (m *memIdempotencyKeys) Release(ctx context.Context, userID uuid.UUID, key string, claimedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.keys[key]; ok && m.keys[key] == nil && m.claims[key].Equal(claimedAt) {
+		delete(m.keys, key)
+		delete(m.claims, key)
+		m.released++
+	}
+	return nil
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	keys := &memIdempotencyKeys{keys: make(map[string]*store.IdempotentResponse), claims: make(map[string]time.Time)}
+	calls := 0
+	status := http.StatusCreated
+	h := IdempotencyMiddleware(keys, IdempotentRoute{Method: "POST", Pattern: "/api/time-entries"})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			switch status {
+			case 0:
+				panic("handler died")
+			case http.StatusAccepted:
+				// The lease ran out and a retry reclaimed the key
+				keys.mu.Lock()
+				keys.claims["e"] = time.Now().Add(time.Minute)
+				keys.mu.Unlock()
+				w.WriteHeader(http.StatusCreated)
+			case http.StatusCreated:
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				w.Write([]byte(`{"id":"1"}`))
+			default:
+				w.WriteHeader(status)
+			}
+		}))
+
+	userID := uuid.New()
+	post := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/time-entries", strings.NewReader(`{"hours":1}`))
+		req = req.WithContext(authedContext(userID))
+		req.Header.Set(IdempotencyKeyHeader, key)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// A repeated key replays the stored response without running the handler
+	post("a")
+	replay := post("a")
+	if calls != 1 || replay.Code != http.StatusCreated || replay.Header().Get("Idempotent-Replayed") != "true" || replay.Body.String() != `{"id":"1"}` {
+		t.Errorf("expected the response to be replayed, got %d %q after %d calls", replay.Code, replay.Body.String(), calls)
+	}
+
+	// Server errors release the key so the retry runs
+	status = http.StatusInternalServerError
+	post("b")
+	status = http.StatusCreated
+	if rec := post("b"); rec.Code != http.StatusCreated || calls != 3 {
+		t.Errorf("expected the retry after a 500 to run, got %d after %d calls", rec.Code, calls)
+	}
+
+	// So does a panic, which still reaches the caller
+	status = 0
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected the panic to propagate")
+			}
+		}()
+		post("c")
+	}()
+	if keys.released != 2 {
+		t.Errorf("expected the panicking request to release its key, released %d", keys.released)
+	}
+	status = http.StatusCreated
+	if rec := post("c"); rec.Code != http.StatusCreated {
+		t.Errorf("expected the retry after a panic to run, got %d", rec.Code)
+	}
+
+	// A key still in progress is refused
+	keys.keys["d"] = nil
+	if rec := post("d"); rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 for a key in progress, got %d", rec.Code)
+	}
+
+	// A request that outlived its lease leaves the reclaimed key to the retry
+	status = http.StatusAccepted
+	post("e")
+	if resp, ok := keys.keys["e"]; !ok || resp != nil {
+		t.Errorf("expected the retry's claim to stay in progress, got %v", resp)
+	}
+}
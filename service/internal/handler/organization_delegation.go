@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// UpdateOrganizationGoogleDelegation sets the Google Workspace service
+// account that provisions members' calendar sync
+func (h *OrganizationHandler) UpdateOrganizationGoogleDelegation(ctx context.Context, req api.UpdateOrganizationGoogleDelegationRequestObject) (api.UpdateOrganizationGoogleDelegationResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateOrganizationGoogleDelegation401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.UpdateOrganizationGoogleDelegation404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.UpdateOrganizationGoogleDelegation403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can configure delegation",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateOrganizationGoogleDelegation400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	settings := store.DelegationSettings{Calendars: string(req.Body.DefaultCalendars)}
+	if !validDelegationCalendars(settings.Calendars) {
+		return api.UpdateOrganizationGoogleDelegation400JSONResponse{
+			Code:    "invalid_request",
+			Message: "default_calendars must be primary, all or none",
+		}, nil
+	}
+	if req.Body.ServiceAccountKey != nil && *req.Body.ServiceAccountKey != "" {
+		if !h.orgs.CanStoreSecrets() {
+			return api.UpdateOrganizationGoogleDelegation400JSONResponse{
+				Code:    "not_configured",
+				Message: "Delegation needs encryption to be configured",
+			}, nil
+		}
+		sa, err := google.ParseServiceAccountKey([]byte(*req.Body.ServiceAccountKey))
+		if err != nil {
+			return api.UpdateOrganizationGoogleDelegation400JSONResponse{
+				Code:    "invalid_service_account_key",
+				Message: err.Error(),
+			}, nil
+		}
+		settings.ServiceAccount = sa
+	}
+
+	org, err := h.orgs.UpdateDelegation(ctx, req.Id, settings)
+	if err != nil {
+		return nil, err
+	}
+	return api.UpdateOrganizationGoogleDelegation200JSONResponse(h.organizationToAPI(org, m)), nil
+}
+
+// UpdateOrganizationMemberDelegation sets which of a member's calendars
+// provisioning selects
+func (h *OrganizationHandler) UpdateOrganizationMemberDelegation(ctx context.Context, req api.UpdateOrganizationMemberDelegationRequestObject) (api.UpdateOrganizationMemberDelegationResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateOrganizationMemberDelegation401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.UpdateOrganizationMemberDelegation404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.UpdateOrganizationMemberDelegation403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can configure delegation",
+		}, nil
+	}
+
+	var calendars *string
+	if req.Body != nil && req.Body.Calendars != nil {
+		c := string(*req.Body.Calendars)
+		if !validDelegationCalendars(c) {
+			return api.UpdateOrganizationMemberDelegation400JSONResponse{
+				Code:    "invalid_request",
+				Message: "calendars must be primary, all, none or null",
+			}, nil
+		}
+		calendars = &c
+	}
+	member, err := h.orgs.SetMemberDelegationCalendars(ctx, req.Id, req.UserId, calendars)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.UpdateOrganizationMemberDelegation404JSONResponse{
+				Code:    "not_found",
+				Message: "Member not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.UpdateOrganizationMemberDelegation200JSONResponse(organizationMemberToAPI(member)), nil
+}
+
+// ProvisionOrganizationCalendars connects members' Google calendars through
+// the organization's service account
+func (h *OrganizationHandler) ProvisionOrganizationCalendars(ctx context.Context, req api.ProvisionOrganizationCalendarsRequestObject) (api.ProvisionOrganizationCalendarsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ProvisionOrganizationCalendars401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.ProvisionOrganizationCalendars404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.ProvisionOrganizationCalendars403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can provision calendars",
+		}, nil
+	}
+	if h.google == nil {
+		return api.ProvisionOrganizationCalendars400JSONResponse{
+			Code:    "not_configured",
+			Message: "Google Calendar integration is not configured",
+		}, nil
+	}
+
+	key, err := h.orgs.GoogleServiceAccountKey(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrServiceAccountNotConfigured) || errors.Is(err, store.ErrSSOSecretsUnavailable) {
+			return api.ProvisionOrganizationCalendars400JSONResponse{
+				Code:    "not_configured",
+				Message: "The organization has no Google service account",
+			}, nil
+		}
+		return nil, err
+	}
+	org, err := h.orgs.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := h.orgs.ListMembers(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var only map[uuid.UUID]bool
+	if req.Body != nil && req.Body.UserIds != nil {
+		only = make(map[uuid.UUID]bool, len(*req.Body.UserIds))
+		for _, id := range *req.Body.UserIds {
+			only[id] = true
+		}
+	}
+
+	// Provisioning writes connections for every member
+	ctx = database.Unscoped(ctx)
+	result := api.DelegationProvisionResult{Members: []api.DelegationProvisionMember{}}
+	for _, member := range members {
+		if only != nil && !only[member.UserID] {
+			continue
+		}
+		result.Members = append(result.Members, h.provisionMember(ctx, org, key, member))
+	}
+	return api.ProvisionOrganizationCalendars200JSONResponse(result), nil
+}
+
+// provisionMember connects one member's calendars, selecting newly found
+// ones by the member's delegation setting. Failures are reported per member
+// so one member doesn't stop the rest.
+func (h *OrganizationHandler) provisionMember(ctx context.Context, org *store.Organization, key []byte, m *store.OrganizationMember) api.DelegationProvisionMember {
+	result := api.DelegationProvisionMember{UserId: m.UserID, Email: m.Email}
+	fail := func(status api.DelegationProvisionMemberStatus, msg string) api.DelegationProvisionMember {
+		result.Status = status
+		result.Error = &msg
+		return result
+	}
+
+	if !m.Active() {
+		return fail(api.DelegationProvisionMemberStatusSkipped, "Member is deactivated")
+	}
+	if !domainVerified(org, emailDomain(m.Email)) {
+		return fail(api.DelegationProvisionMemberStatusSkipped, "Email domain isn't verified by the organization")
+	}
+
+	creds, err := h.delegate(ctx, key, store.Delegation{OrganizationID: org.ID, Subject: m.Email})
+	if err != nil {
+		return fail(api.DelegationProvisionMemberStatusFailed, err.Error())
+	}
+	conn, err := h.workspaceConnection(ctx, m.UserID, creds)
+	if err != nil {
+		return fail(api.DelegationProvisionMemberStatusFailed, err.Error())
+	}
+	result.ConnectionId = &conn.ID
+
+	googleCals, err := h.google.ListCalendars(ctx, creds)
+	if err != nil {
+		return fail(api.DelegationProvisionMemberStatusFailed, err.Error())
+	}
+	mode := org.DelegationCalendars
+	if m.DelegationCalendars != nil {
+		mode = *m.DelegationCalendars
+	}
+	for _, gc := range googleCals {
+		cal := &store.Calendar{
+			ConnectionID: conn.ID,
+			UserID:       m.UserID,
+			ExternalID:   gc.ID,
+			Name:         gc.Name,
+			IsPrimary:    gc.IsPrimary,
+			// Only applies to calendars found for the first time
+			IsSelected: mode == store.DelegationCalendarsAll || (mode == store.DelegationCalendarsPrimary && gc.IsPrimary),
+		}
+		if gc.Color != "" {
+			cal.Color = &gc.Color
+		}
+		if _, err := h.calendars.Upsert(ctx, cal); err != nil {
+			return fail(api.DelegationProvisionMemberStatusFailed, err.Error())
+		}
+	}
+	selected, err := h.calendars.ListSelectedByConnection(ctx, conn.ID)
+	if err != nil {
+		return fail(api.DelegationProvisionMemberStatusFailed, err.Error())
+	}
+
+	found, selectedCount := len(googleCals), len(selected)
+	result.Status = api.DelegationProvisionMemberStatusProvisioned
+	result.Calendars = &found
+	result.Selected = &selectedCount
+	return result
+}
+
+// workspaceConnection saves freshly minted credentials on the member's
+// workspace connection, creating it on first provisioning
+func (h *OrganizationHandler) workspaceConnection(ctx context.Context, userID uuid.UUID, creds *store.OAuthCredentials) (*store.CalendarConnection, error) {
+	connections, err := h.connections.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range connections {
+		if c.Provider != store.ProviderGoogleWorkspace {
+			continue
+		}
+		conn, err := h.connections.UpdateGrant(ctx, userID, c.ID, *creds)
+		if err != nil {
+			return nil, err
+		}
+		// A working delegation fixes calendars that failed to authorize
+		if err := h.calendars.ClearConnectionNeedsReauth(ctx, conn.ID); err != nil {
+			return nil, err
+		}
+		return conn, nil
+	}
+	return h.connections.Create(ctx, userID, store.ProviderGoogleWorkspace, *creds)
+}
+
+func validDelegationCalendars(c string) bool {
+	switch c {
+	case store.DelegationCalendarsPrimary, store.DelegationCalendarsAll, store.DelegationCalendarsNone:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+//go:build integration
+
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/crypto"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestProvisionOrganizationCalendars(t *testing.T) {
+	s := newSyncHarness(t)
+	s.fake.AddCalendar(google.CalendarInfo{ID: "team", Name: "Team"})
+
+	domain := "acme-" + uuid.New().String()[:8] + ".example"
+	users := store.NewUserStore(s.pool)
+	newUser := func(email string) uuid.UUID {
+		u, err := users.Create(s.ctx, email, "Member", "password123")
+		if err != nil {
+			t.Fatalf("Failed to create user: %v", err)
+		}
+		t.Cleanup(func() {
+			s.pool.Exec(context.Background(), "DELETE FROM users WHERE id = $1", u.ID)
+		})
+		return u.ID
+	}
+	admin := newUser("admin@" + domain)
+	ann := newUser("ann@" + domain)
+	outsider := newUser("bob-" + uuid.New().String()[:8] + "@elsewhere.example")
+
+	enc, err := crypto.NewEncryptionService("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	orgs := store.NewOrganizationStore(s.pool, enc)
+	org, err := orgs.Create(s.ctx, admin, "Delegation Test", "delegation-"+uuid.New().String()[:8])
+	if err != nil {
+		t.Fatalf("Failed to create organization: %v", err)
+	}
+	t.Cleanup(func() {
+		s.pool.Exec(context.Background(), "DELETE FROM organizations WHERE id = $1", org.ID)
+	})
+	if _, err := orgs.PutDomain(s.ctx, org.ID, domain, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := orgs.MarkDomainVerified(s.ctx, org.ID, domain); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []uuid.UUID{ann, outsider} {
+		if _, err := orgs.AddMember(s.ctx, org.ID, id, store.OrganizationRoleMember, nil, true); err != nil {
+			t.Fatalf("Failed to add member: %v", err)
+		}
+	}
+	all := store.DelegationCalendarsAll
+	if _, err := orgs.SetMemberDelegationCalendars(s.ctx, org.ID, ann, &all); err != nil {
+		t.Fatal(err)
+	}
+	_, err = orgs.UpdateDelegation(s.ctx, org.ID, store.DelegationSettings{
+		ServiceAccount: &store.ServiceAccount{Key: []byte(`{"type":"service_account"}`), ClientEmail: "sync@acme.iam.gserviceaccount.com", ClientID: "42"},
+		Calendars:      store.DelegationCalendarsPrimary,
+	})
+	if err != nil {
+		t.Fatalf("UpdateDelegation: %v", err)
+	}
+
+	h := NewOrganizationHandler(orgs, s.handler.connections, s.calendars, s.fake, nil, "")
+	var subjects []string
+	h.delegate = func(ctx context.Context, key []byte, d store.Delegation) (*store.OAuthCredentials, error) {
+		subjects = append(subjects, d.Subject)
+		return &store.OAuthCredentials{AccessToken: "minted", Scopes: google.DelegationScopes(), Delegation: &d}, nil
+	}
+
+	ctx := context.WithValue(s.ctx, userIDKey, admin)
+	resp, err := h.ProvisionOrganizationCalendars(ctx, api.ProvisionOrganizationCalendarsRequestObject{Id: org.ID})
+	if err != nil {
+		t.Fatalf("ProvisionOrganizationCalendars: %v", err)
+	}
+	result, ok := resp.(api.ProvisionOrganizationCalendars200JSONResponse)
+	if !ok {
+		t.Fatalf("expected provisioning results, got %#v", resp)
+	}
+
+	statuses := make(map[uuid.UUID]api.DelegationProvisionMember)
+	for _, m := range result.Members {
+		statuses[m.UserId] = m
+	}
+	if got := statuses[outsider].Status; got != api.DelegationProvisionMemberStatusSkipped {
+		t.Errorf("expected the member outside the verified domain to be skipped, got %q", got)
+	}
+	for _, id := range []uuid.UUID{admin, ann} {
+		if got := statuses[id].Status; got != api.DelegationProvisionMemberStatusProvisioned {
+			t.Errorf("expected %s to be provisioned, got %q (%v)", id, got, statuses[id].Error)
+		}
+	}
+	// The admin gets the organization's default; Ann's own setting selects all
+	if m := statuses[admin]; m.Selected == nil || *m.Selected != 1 {
+		t.Errorf("expected only the admin's primary calendar selected, got %+v", m)
+	}
+	if m := statuses[ann]; m.Selected == nil || *m.Selected != 2 {
+		t.Errorf("expected all of Ann's calendars selected, got %+v", m)
+	}
+	if len(subjects) != 2 {
+		t.Errorf("expected tokens minted for 2 members, got %v", subjects)
+	}
+
+	conn, err := s.handler.connections.GetByID(s.ctx, ann, *statuses[ann].ConnectionId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.Provider != store.ProviderGoogleWorkspace || conn.Credentials.Delegation == nil || conn.Credentials.Delegation.Subject != "ann@"+domain {
+		t.Errorf("expected a workspace connection delegated to Ann, got %+v", conn)
+	}
+
+	// Provisioning again keeps the connection
+	resp, err = h.ProvisionOrganizationCalendars(ctx, api.ProvisionOrganizationCalendarsRequestObject{
+		Id:   org.ID,
+		Body: &api.ProvisionOrganizationCalendarsJSONRequestBody{UserIds: &[]uuid.UUID{ann}},
+	})
+	if err != nil {
+		t.Fatalf("ProvisionOrganizationCalendars: %v", err)
+	}
+	again := resp.(api.ProvisionOrganizationCalendars200JSONResponse)
+	if len(again.Members) != 1 || *again.Members[0].ConnectionId != conn.ID {
+		t.Errorf("expected Ann's connection to be reused, got %+v", again.Members)
+	}
+}
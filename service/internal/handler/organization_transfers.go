@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TransferOrganizationProject hands a project and its history from one
+// member to another, such as when a contractor leaves and someone takes
+// over their role
+func (h *OrganizationHandler) TransferOrganizationProject(ctx context.Context, req api.TransferOrganizationProjectRequestObject) (api.TransferOrganizationProjectResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.TransferOrganizationProject401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.TransferOrganizationProject404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.TransferOrganizationProject403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can transfer projects",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.TransferOrganizationProject400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if req.Body.FromUserId == req.Body.ToUserId {
+		return api.TransferOrganizationProject400JSONResponse{
+			Code:    "invalid_request",
+			Message: "from_user_id and to_user_id must differ",
+		}, nil
+	}
+	transfer := store.ProjectTransfer{
+		ProjectID:         req.Body.ProjectId,
+		FromUserID:        req.Body.FromUserId,
+		ToUserID:          req.Body.ToUserId,
+		RevokeConnections: req.Body.RevokeConnections == nil || *req.Body.RevokeConnections,
+	}
+
+	// The transfer writes both members' data, which the transaction checks
+	// belong to the organization
+	result, err := h.orgs.TransferProject(database.Unscoped(ctx), req.Id, transfer)
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrOrganizationMemberNotFound):
+			return api.TransferOrganizationProject404JSONResponse{
+				Code:    "not_found",
+				Message: "Both users must be members of the organization, and the receiving one active",
+			}, nil
+		case errors.Is(err, store.ErrProjectNotFound):
+			return api.TransferOrganizationProject404JSONResponse{
+				Code:    "not_found",
+				Message: "Project not found",
+			}, nil
+		case errors.Is(err, store.ErrDuplicateShortCode):
+			return api.TransferOrganizationProject409JSONResponse{
+				Code:    "short_code_taken",
+				Message: "The receiving member already has a project with this short code",
+			}, nil
+		case errors.Is(err, store.ErrTransferInvoiceNumberTaken):
+			return api.TransferOrganizationProject409JSONResponse{
+				Code:    "invoice_number_taken",
+				Message: "The receiving member already uses one of the project's invoice numbers",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.TransferOrganizationProject200JSONResponse{
+		ProjectId:          transfer.ProjectID,
+		FromUserId:         transfer.FromUserID,
+		ToUserId:           transfer.ToUserID,
+		TimeEntries:        result.TimeEntries,
+		Invoices:           result.Invoices,
+		Expenses:           result.Expenses,
+		BillingPeriods:     result.BillingPeriods,
+		RevokedConnections: result.RevokedConnections,
+	}, nil
+}
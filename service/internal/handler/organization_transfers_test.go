@@ -0,0 +1,157 @@
+//go:build integration
+
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestTransferOrganizationProject(t *testing.T) {
+	s := newSyncHarness(t)
+	s.sync(nil)
+	from := s.calendar().UserID
+
+	users := store.NewUserStore(s.pool)
+	newUser := func(prefix string) uuid.UUID {
+		u, err := users.Create(s.ctx, prefix+"-"+uuid.New().String()[:8]+"@test.com", prefix, "password123")
+		if err != nil {
+			t.Fatalf("Failed to create user: %v", err)
+		}
+		t.Cleanup(func() {
+			s.pool.Exec(context.Background(), "DELETE FROM users WHERE id = $1", u.ID)
+		})
+		return u.ID
+	}
+	admin, to := newUser("admin"), newUser("successor")
+
+	orgs := store.NewOrganizationStore(s.pool, nil)
+	org, err := orgs.Create(s.ctx, admin, "Transfer Test", "transfer-"+uuid.New().String()[:8])
+	if err != nil {
+		t.Fatalf("Failed to create organization: %v", err)
+	}
+	t.Cleanup(func() {
+		s.pool.Exec(context.Background(), "DELETE FROM organizations WHERE id = $1", org.ID)
+	})
+	for _, id := range []uuid.UUID{from, to} {
+		if _, err := orgs.AddMember(s.ctx, org.ID, id, store.OrganizationRoleMember, nil, true); err != nil {
+			t.Fatalf("Failed to add member: %v", err)
+		}
+	}
+
+	project, err := store.NewProjectStore(s.pool).Create(s.ctx, from, "Client Work", nil, nil, "#123456", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	entries := store.NewTimeEntryStore(s.pool)
+	var tagged uuid.UUID
+	for _, day := range []int{1, 2} {
+		entry, err := entries.Create(s.ctx, from, project.ID, time.Date(2025, 3, day, 0, 0, 0, 0, time.UTC), 2, nil)
+		if err != nil {
+			t.Fatalf("Failed to create time entry: %v", err)
+		}
+		tagged = entry.ID
+	}
+
+	// The successor already has one of the tags, under different case
+	tags := store.NewTagStore(s.pool)
+	if _, err := tags.SetEntryTags(s.ctx, from, tagged, []string{"Design", "Urgent"}); err != nil {
+		t.Fatalf("Failed to tag time entry: %v", err)
+	}
+	existing, err := tags.Create(s.ctx, to, "design")
+	if err != nil {
+		t.Fatalf("Failed to create tag: %v", err)
+	}
+
+	h := NewOrganizationHandler(orgs, nil, nil, nil, nil, "")
+	body := &api.ProjectTransferInput{ProjectId: project.ID, FromUserId: from, ToUserId: to}
+
+	// Only owners and admins may transfer
+	memberCtx := context.WithValue(s.ctx, userIDKey, to)
+	resp, err := h.TransferOrganizationProject(memberCtx, api.TransferOrganizationProjectRequestObject{Id: org.ID, Body: body})
+	if err != nil {
+		t.Fatalf("TransferOrganizationProject: %v", err)
+	}
+	if _, ok := resp.(api.TransferOrganizationProject403JSONResponse); !ok {
+		t.Fatalf("expected a member to be refused, got %#v", resp)
+	}
+
+	adminCtx := context.WithValue(s.ctx, userIDKey, admin)
+	resp, err = h.TransferOrganizationProject(adminCtx, api.TransferOrganizationProjectRequestObject{Id: org.ID, Body: body})
+	if err != nil {
+		t.Fatalf("TransferOrganizationProject: %v", err)
+	}
+	result, ok := resp.(api.TransferOrganizationProject200JSONResponse)
+	if !ok {
+		t.Fatalf("expected a transfer, got %#v", resp)
+	}
+	if result.TimeEntries != 2 || result.RevokedConnections != 1 {
+		t.Errorf("expected 2 entries moved and 1 connection revoked, got %+v", result)
+	}
+
+	if _, err := store.NewProjectStore(s.pool).GetByID(s.ctx, to, project.ID); err != nil {
+		t.Errorf("expected the successor to own the project: %v", err)
+	}
+	var remaining int
+	if err := s.pool.QueryRow(s.ctx, "SELECT COUNT(*) FROM time_entries WHERE user_id = $1", from).Scan(&remaining); err != nil {
+		t.Fatal(err)
+	}
+	if remaining != 0 {
+		t.Errorf("expected no entries left with the departing user, got %d", remaining)
+	}
+	rows, err := s.pool.Query(s.ctx, `
+		SELECT tg.id, tg.user_id, tg.name FROM time_entry_tags tt
+		JOIN tags tg ON tg.id = tt.tag_id
+		WHERE tt.time_entry_id = $1 ORDER BY lower(tg.name)
+	`, tagged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var relinked []store.Tag
+	for rows.Next() {
+		var tag store.Tag
+		if err := rows.Scan(&tag.ID, &tag.UserID, &tag.Name); err != nil {
+			t.Fatal(err)
+		}
+		relinked = append(relinked, tag)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(relinked) != 2 {
+		t.Fatalf("expected the moved entry to keep 2 tags, got %+v", relinked)
+	}
+	for _, tag := range relinked {
+		if tag.UserID != to {
+			t.Errorf("expected tag %q to belong to the successor", tag.Name)
+		}
+	}
+	if relinked[0].ID != existing.ID || relinked[1].Name != "Urgent" {
+		t.Errorf("expected the successor's design tag and a new Urgent tag, got %+v", relinked)
+	}
+
+	conns, err := store.NewCalendarConnectionStore(s.pool, nil).List(s.ctx, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range conns {
+		if c.Provider != store.ProviderFocus {
+			t.Errorf("expected the departing user's %s connection to be revoked", c.Provider)
+		}
+	}
+
+	// The project is gone from the departing user now
+	resp, err = h.TransferOrganizationProject(adminCtx, api.TransferOrganizationProjectRequestObject{Id: org.ID, Body: body})
+	if err != nil {
+		t.Fatalf("TransferOrganizationProject: %v", err)
+	}
+	if _, ok := resp.(api.TransferOrganizationProject404JSONResponse); !ok {
+		t.Errorf("expected a second transfer to find no project, got %#v", resp)
+	}
+}
@@ -0,0 +1,565 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/sso"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// domainVerificationPrefix names the TXT record that proves an
+// organization owns an email domain
+const domainVerificationPrefix = "_timesheet-verification."
+
+var (
+	organizationSlugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,38}[a-z0-9])?$`)
+	domainLabelPattern      = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+)
+
+// normalizeDomain lowercases an email domain and checks it is a valid
+// host name with at least two labels
+func normalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	labels := strings.Split(domain, ".")
+	if len(domain) > 253 || len(labels) < 2 {
+		return "", fmt.Errorf("%q is not a domain", domain)
+	}
+	for _, label := range labels {
+		if !domainLabelPattern.MatchString(label) {
+			return "", fmt.Errorf("%q is not a domain", domain)
+		}
+	}
+	return domain, nil
+}
+
+// emailDomain returns the lowercased domain of an email address
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
+
+// validateIssuer checks an OIDC issuer URL. Like redirect URIs, plain
+// http is only allowed for loopback addresses, for local identity providers.
+func validateIssuer(issuer string) error {
+	u, err := url.Parse(issuer)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return fmt.Errorf("issuer %q must be an absolute URL", issuer)
+	}
+	if u.Scheme == "http" && !isLoopbackHost(u.Hostname()) {
+		return fmt.Errorf("issuer %q must use https", issuer)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("issuer %q must not have a query or fragment", issuer)
+	}
+	return nil
+}
+
+// OrganizationHandler implements the organization, SSO settings, domain,
+// SCIM token and Google Workspace delegation endpoints
+type OrganizationHandler struct {
+	orgs        *store.OrganizationStore
+	connections *store.CalendarConnectionStore
+	calendars   *store.CalendarStore
+	google      google.CalendarClient
+	ssoClient   *sso.Client
+	baseURL     string
+	lookupTXT   func(ctx context.Context, name string) ([]string, error)
+	delegate    google.DelegatedCredentialsFunc
+}
+
+// NewOrganizationHandler creates a new organization handler
+func NewOrganizationHandler(orgs *store.OrganizationStore, connections *store.CalendarConnectionStore, calendars *store.CalendarStore, googleSvc google.CalendarClient, ssoClient *sso.Client, baseURL string) *OrganizationHandler {
+	return &OrganizationHandler{
+		orgs:        orgs,
+		connections: connections,
+		calendars:   calendars,
+		google:      googleSvc,
+		ssoClient:   ssoClient,
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		lookupTXT:   net.DefaultResolver.LookupTXT,
+		delegate:    google.DelegatedCredentials,
+	}
+}
+
+// membership returns the user's active membership of an organization, or
+// store.ErrOrganizationMemberNotFound so non-members can't tell the
+// organization exists
+func (h *OrganizationHandler) membership(ctx context.Context, orgID, userID uuid.UUID) (context.Context, *store.OrganizationMember, error) {
+	m, err := h.orgs.GetMember(ctx, orgID, userID)
+	if err != nil {
+		return ctx, nil, err
+	}
+	if !m.Active() {
+		return ctx, nil, store.ErrOrganizationMemberNotFound
+	}
+	// Members act for the organization from here on
+	return database.WithScope(ctx, database.Scope{OrganizationID: orgID}), m, nil
+}
+
+// ListOrganizations returns the organizations the user belongs to
+func (h *OrganizationHandler) ListOrganizations(ctx context.Context, req api.ListOrganizationsRequestObject) (api.ListOrganizationsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListOrganizations401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	orgs, memberships, err := h.orgs.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := []api.Organization{}
+	for i, o := range orgs {
+		if memberships[i].Active() {
+			result = append(result, h.organizationToAPI(o, memberships[i]))
+		}
+	}
+	return api.ListOrganizations200JSONResponse(result), nil
+}
+
+// CreateOrganization creates an organization owned by the user
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req api.CreateOrganizationRequestObject) (api.CreateOrganizationResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateOrganization401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.CreateOrganization400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	name := strings.TrimSpace(req.Body.Name)
+	if name == "" {
+		return api.CreateOrganization400JSONResponse{
+			Code:    "invalid_name",
+			Message: "Name is required",
+		}, nil
+	}
+	slug := strings.ToLower(strings.TrimSpace(req.Body.Slug))
+	if !organizationSlugPattern.MatchString(slug) {
+		return api.CreateOrganization400JSONResponse{
+			Code:    "invalid_slug",
+			Message: "Slug must be up to 40 lowercase letters, digits and hyphens",
+		}, nil
+	}
+
+	org, err := h.orgs.Create(ctx, userID, name, slug)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationSlugTaken) {
+			return api.CreateOrganization409JSONResponse{
+				Code:    "slug_taken",
+				Message: fmt.Sprintf("The slug %q is taken", slug),
+			}, nil
+		}
+		return nil, err
+	}
+	owner := &store.OrganizationMember{Role: store.OrganizationRoleOwner}
+	return api.CreateOrganization201JSONResponse(h.organizationToAPI(org, owner)), nil
+}
+
+// GetOrganization returns one of the user's organizations
+func (h *OrganizationHandler) GetOrganization(ctx context.Context, req api.GetOrganizationRequestObject) (api.GetOrganizationResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetOrganization401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.GetOrganization404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	org, err := h.orgs.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetOrganization200JSONResponse(h.organizationToAPI(org, m)), nil
+}
+
+// UpdateOrganizationSso saves the organization's OIDC client
+func (h *OrganizationHandler) UpdateOrganizationSso(ctx context.Context, req api.UpdateOrganizationSsoRequestObject) (api.UpdateOrganizationSsoResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateOrganizationSso401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.UpdateOrganizationSso404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.UpdateOrganizationSso403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can configure single sign-on",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateOrganizationSso400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	issuer := strings.TrimSuffix(strings.TrimSpace(req.Body.Issuer), "/")
+	if err := validateIssuer(issuer); err != nil {
+		return api.UpdateOrganizationSso400JSONResponse{
+			Code:    "invalid_issuer",
+			Message: err.Error(),
+		}, nil
+	}
+	clientID := strings.TrimSpace(req.Body.ClientId)
+	if clientID == "" {
+		return api.UpdateOrganizationSso400JSONResponse{
+			Code:    "invalid_request",
+			Message: "client_id is required",
+		}, nil
+	}
+
+	settings := store.SSOSettings{
+		Enabled:  req.Body.Enabled,
+		Issuer:   issuer,
+		ClientID: clientID,
+	}
+	if req.Body.ClientSecret != nil && *req.Body.ClientSecret != "" {
+		if !h.orgs.CanStoreSecrets() {
+			return api.UpdateOrganizationSso400JSONResponse{
+				Code:    "not_configured",
+				Message: "Single sign-on needs encryption to be configured",
+			}, nil
+		}
+		settings.ClientSecret = req.Body.ClientSecret
+	}
+
+	current, err := h.orgs.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if settings.Enabled && settings.ClientSecret == nil && !current.HasOIDCClientSecret {
+		return api.UpdateOrganizationSso400JSONResponse{
+			Code:    "invalid_request",
+			Message: "client_secret is required to enable single sign-on",
+		}, nil
+	}
+
+	org, err := h.orgs.UpdateSSO(ctx, req.Id, settings)
+	if err != nil {
+		return nil, err
+	}
+	return api.UpdateOrganizationSso200JSONResponse(h.organizationToAPI(org, m)), nil
+}
+
+// PutOrganizationDomain claims an email domain or changes its auto-join
+// setting
+func (h *OrganizationHandler) PutOrganizationDomain(ctx context.Context, req api.PutOrganizationDomainRequestObject) (api.PutOrganizationDomainResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.PutOrganizationDomain401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.PutOrganizationDomain404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.PutOrganizationDomain403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can manage domains",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.PutOrganizationDomain400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	domain, err := normalizeDomain(req.Domain)
+	if err != nil {
+		return api.PutOrganizationDomain400JSONResponse{
+			Code:    "invalid_domain",
+			Message: err.Error(),
+		}, nil
+	}
+
+	d, err := h.orgs.PutDomain(ctx, req.Id, domain, req.Body.AutoJoin)
+	if err != nil {
+		return nil, err
+	}
+	return api.PutOrganizationDomain200JSONResponse(organizationDomainToAPI(d)), nil
+}
+
+// DeleteOrganizationDomain releases an email domain
+func (h *OrganizationHandler) DeleteOrganizationDomain(ctx context.Context, req api.DeleteOrganizationDomainRequestObject) (api.DeleteOrganizationDomainResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteOrganizationDomain401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.DeleteOrganizationDomain404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.DeleteOrganizationDomain403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can manage domains",
+		}, nil
+	}
+
+	domain := strings.ToLower(strings.TrimSpace(req.Domain))
+	if err := h.orgs.DeleteDomain(ctx, req.Id, domain); err != nil {
+		if errors.Is(err, store.ErrDomainNotFound) {
+			return api.DeleteOrganizationDomain404JSONResponse{
+				Code:    "not_found",
+				Message: fmt.Sprintf("%s is not claimed by the organization", domain),
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DeleteOrganizationDomain204Response{}, nil
+}
+
+// VerifyOrganizationDomain checks the domain's verification TXT record
+func (h *OrganizationHandler) VerifyOrganizationDomain(ctx context.Context, req api.VerifyOrganizationDomainRequestObject) (api.VerifyOrganizationDomainResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.VerifyOrganizationDomain401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.VerifyOrganizationDomain404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.VerifyOrganizationDomain403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can manage domains",
+		}, nil
+	}
+
+	domain := strings.ToLower(strings.TrimSpace(req.Domain))
+	d, err := h.orgs.GetDomain(ctx, req.Id, domain)
+	if err != nil {
+		if errors.Is(err, store.ErrDomainNotFound) {
+			return api.VerifyOrganizationDomain404JSONResponse{
+				Code:    "not_found",
+				Message: fmt.Sprintf("%s is not claimed by the organization", domain),
+			}, nil
+		}
+		return nil, err
+	}
+
+	if d.VerifiedAt == nil {
+		records, err := h.lookupTXT(ctx, domainVerificationPrefix+domain)
+		if err != nil || !slices.Contains(records, domainVerificationRecord(d)) {
+			return api.VerifyOrganizationDomain409JSONResponse{
+				Code:    "verification_failed",
+				Message: fmt.Sprintf("No TXT record %q found at %s", domainVerificationRecord(d), domainVerificationPrefix+domain),
+			}, nil
+		}
+		d, err = h.orgs.MarkDomainVerified(ctx, req.Id, domain)
+		if err != nil {
+			if errors.Is(err, store.ErrDomainVerifiedElsewhere) {
+				return api.VerifyOrganizationDomain409JSONResponse{
+					Code:    "domain_taken",
+					Message: fmt.Sprintf("%s is verified by another organization", domain),
+				}, nil
+			}
+			return nil, err
+		}
+	}
+	return api.VerifyOrganizationDomain200JSONResponse(organizationDomainToAPI(d)), nil
+}
+
+// RotateOrganizationScimToken issues a new SCIM token
+func (h *OrganizationHandler) RotateOrganizationScimToken(ctx context.Context, req api.RotateOrganizationScimTokenRequestObject) (api.RotateOrganizationScimTokenResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RotateOrganizationScimToken401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, m, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.RotateOrganizationScimToken404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+	if !m.CanManage() {
+		return api.RotateOrganizationScimToken403JSONResponse{
+			Code:    "forbidden",
+			Message: "Only owners and admins can manage SCIM provisioning",
+		}, nil
+	}
+
+	token, err := h.orgs.RotateSCIMToken(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return api.RotateOrganizationScimToken200JSONResponse{
+		Token:   token,
+		Prefix:  token[:13],
+		ScimUrl: h.baseURL + SCIMBasePath,
+	}, nil
+}
+
+// ListOrganizationMembers returns the organization's members
+func (h *OrganizationHandler) ListOrganizationMembers(ctx context.Context, req api.ListOrganizationMembersRequestObject) (api.ListOrganizationMembersResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListOrganizationMembers401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	ctx, _, err := h.membership(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return api.ListOrganizationMembers404JSONResponse{
+				Code:    "not_found",
+				Message: "Organization not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	members, err := h.orgs.ListMembers(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]api.OrganizationMember, len(members))
+	for i, m := range members {
+		result[i] = organizationMemberToAPI(m)
+	}
+	return api.ListOrganizationMembers200JSONResponse(result), nil
+}
+
+func organizationMemberToAPI(m *store.OrganizationMember) api.OrganizationMember {
+	return api.OrganizationMember{
+		UserId:              m.UserID,
+		Email:               m.Email,
+		Name:                m.Name,
+		Role:                api.OrganizationMemberRole(m.Role),
+		ExternalId:          m.ExternalID,
+		Active:              m.Active(),
+		DelegationCalendars: (*api.OrganizationMemberDelegationCalendars)(m.DelegationCalendars),
+		JoinedAt:            m.CreatedAt,
+	}
+}
+
+func domainVerificationRecord(d *store.OrganizationDomain) string {
+	return "timesheet-verification=" + d.VerificationToken
+}
+
+func organizationDomainToAPI(d *store.OrganizationDomain) api.OrganizationDomain {
+	return api.OrganizationDomain{
+		Domain:             d.Domain,
+		AutoJoin:           d.AutoJoin,
+		Verified:           d.VerifiedAt != nil,
+		VerifiedAt:         d.VerifiedAt,
+		VerificationHost:   domainVerificationPrefix + d.Domain,
+		VerificationRecord: domainVerificationRecord(d),
+	}
+}
+
+func (h *OrganizationHandler) organizationToAPI(o *store.Organization, m *store.OrganizationMember) api.Organization {
+	domains := make([]api.OrganizationDomain, len(o.Domains))
+	for i := range o.Domains {
+		domains[i] = organizationDomainToAPI(&o.Domains[i])
+	}
+	return api.Organization{
+		Id:   o.ID,
+		Name: o.Name,
+		Slug: o.Slug,
+		Role: api.OrganizationRole(m.Role),
+		Sso: api.OrganizationSSO{
+			Enabled:         o.SSOEnabled,
+			Issuer:          o.OIDCIssuer,
+			ClientId:        o.OIDCClientID,
+			HasClientSecret: o.HasOIDCClientSecret,
+			RedirectUri:     h.ssoClient.RedirectURL(),
+		},
+		Domains:         domains,
+		ScimTokenPrefix: o.SCIMTokenPrefix,
+		GoogleDelegation: api.OrganizationDelegation{
+			Configured:       o.GoogleServiceAccountEmail != nil,
+			ClientEmail:      o.GoogleServiceAccountEmail,
+			ClientId:         o.GoogleServiceAccountClientID,
+			Scopes:           google.DelegationScopes(),
+			DefaultCalendars: api.OrganizationDelegationDefaultCalendars(o.DelegationCalendars),
+		},
+		CreatedAt: o.CreatedAt,
+	}
+}
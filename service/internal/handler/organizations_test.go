@@ -0,0 +1,53 @@
+package handler
+
+import "testing"
+
+func TestNormalizeDomain(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{" Example.COM. ", "example.com", false},
+		{"mail.example.co.uk", "mail.example.co.uk", false},
+		{"localhost", "", true},
+		{"-bad.example.com", "", true},
+		{"exa mple.com", "", true},
+		{"example..com", "", true},
+		{"user@example.com", "", true},
+	}
+	for _, tt := range tests {
+		got, err := normalizeDomain(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("normalizeDomain(%q) = %q, %v; want %q, wantErr %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateIssuer(t *testing.T) {
+	tests := []struct {
+		issuer  string
+		wantErr bool
+	}{
+		{"https://acme.okta.com", false},
+		{"https://login.microsoftonline.com/tenant/v2.0", false},
+		{"http://localhost:8081/realms/acme", false},
+		{"http://idp.example.com", true},
+		{"acme.okta.com", true},
+		{"https://idp.example.com?tenant=1", true},
+	}
+	for _, tt := range tests {
+		if err := validateIssuer(tt.issuer); (err != nil) != tt.wantErr {
+			t.Errorf("validateIssuer(%q) error = %v, wantErr %v", tt.issuer, err, tt.wantErr)
+		}
+	}
+}
+
+func TestEmailDomain(t *testing.T) {
+	if got := emailDomain("Ada@Example.com"); got != "example.com" {
+		t.Errorf("expected example.com, got %q", got)
+	}
+	if got := emailDomain("no-at-sign"); got != "" {
+		t.Errorf("expected no domain, got %q", got)
+	}
+}
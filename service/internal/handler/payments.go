@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// PaymentHandler implements the payment and balance endpoints
+type PaymentHandler struct {
+	payments *store.PaymentStore
+	invoices *store.InvoiceStore
+}
+
+// NewPaymentHandler creates a new payment handler
+func NewPaymentHandler(payments *store.PaymentStore, invoices *store.InvoiceStore) *PaymentHandler {
+	return &PaymentHandler{
+		payments: payments,
+		invoices: invoices,
+	}
+}
+
+// ListInvoicePayments returns the payments recorded against an invoice
+func (h *PaymentHandler) ListInvoicePayments(ctx context.Context, req api.ListInvoicePaymentsRequestObject) (api.ListInvoicePaymentsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListInvoicePayments401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the invoice exists so an unknown ID isn't reported as "no payments"
+	if _, err := h.invoices.GetByID(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.ListInvoicePayments404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	payments, err := h.payments.ListByInvoice(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.Payment, len(payments))
+	for i, p := range payments {
+		result[i] = paymentToAPI(p)
+	}
+
+	return api.ListInvoicePayments200JSONResponse(result), nil
+}
+
+// RecordInvoicePayment records a partial or full payment against an invoice
+func (h *PaymentHandler) RecordInvoicePayment(ctx context.Context, req api.RecordInvoicePaymentRequestObject) (api.RecordInvoicePaymentResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RecordInvoicePayment401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.RecordInvoicePayment400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body required",
+		}, nil
+	}
+
+	// Default payment date to today
+	paidOn := time.Now().UTC()
+	if req.Body.PaidOn != nil {
+		paidOn = req.Body.PaidOn.Time
+	}
+
+	payment, err := h.payments.Record(ctx, userID, req.Id, float64(req.Body.Amount), paidOn, req.Body.Method, req.Body.Reference)
+	if err != nil {
+		if errors.Is(err, store.ErrInvalidPaymentSize) {
+			return api.RecordInvoicePayment400JSONResponse{
+				Code:    "invalid_amount",
+				Message: "Payment amount must be positive",
+			}, nil
+		}
+		if errors.Is(err, store.ErrInvoiceNotFound) {
+			return api.RecordInvoicePayment404JSONResponse{
+				Code:    "not_found",
+				Message: "Invoice not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrInvoiceNotPayable) {
+			return api.RecordInvoicePayment409JSONResponse{
+				Code:    "not_payable",
+				Message: "Payments can only be recorded against sent or paid invoices",
+			}, nil
+		}
+		if errors.Is(err, store.ErrPaymentExceedsDue) {
+			return api.RecordInvoicePayment409JSONResponse{
+				Code:    "exceeds_balance",
+				Message: "Payment exceeds the outstanding balance",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.RecordInvoicePayment201JSONResponse(paymentToAPI(payment)), nil
+}
+
+// DeletePayment removes a payment
+func (h *PaymentHandler) DeletePayment(ctx context.Context, req api.DeletePaymentRequestObject) (api.DeletePaymentResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeletePayment401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	err := h.payments.Delete(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrPaymentNotFound) {
+			return api.DeletePayment404JSONResponse{
+				Code:    "not_found",
+				Message: "Payment not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeletePayment204Response{}, nil
+}
+
+// ListClientBalances returns outstanding balances grouped by client
+func (h *PaymentHandler) ListClientBalances(ctx context.Context, req api.ListClientBalancesRequestObject) (api.ListClientBalancesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListClientBalances401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	balances, err := h.payments.ClientBalances(ctx, userID, req.Params.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.ClientBalance, len(balances))
+	for i, b := range balances {
+		result[i] = api.ClientBalance{
+			Client:       b.Client,
+			InvoiceCount: b.InvoiceCount,
+			Invoiced:     float32(b.Invoiced),
+			Credited:     float32(b.Credited),
+			Paid:         float32(b.Paid),
+			Outstanding:  float32(b.Outstanding),
+		}
+	}
+
+	return api.ListClientBalances200JSONResponse(result), nil
+}
+
+// paymentToAPI converts a store Payment to an API Payment
+func paymentToAPI(p *store.Payment) api.Payment {
+	return api.Payment{
+		Id:        p.ID,
+		InvoiceId: p.InvoiceID,
+		Amount:    float32(p.Amount),
+		PaidOn:    openapi_types.Date{Time: p.PaidOn},
+		Method:    p.Method,
+		Reference: p.Reference,
+		CreatedAt: p.CreatedAt,
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestPaymentHandler_RequiresAuth(t *testing.T) {
+	h := NewPaymentHandler(nil, nil)
+
+	resp, err := h.RecordInvoicePayment(context.Background(), api.RecordInvoicePaymentRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := resp.(api.RecordInvoicePayment401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+
+	balances, err := h.ListClientBalances(context.Background(), api.ListClientBalancesRequestObject{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := balances.(api.ListClientBalances401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", balances)
+	}
+}
+
+func TestPaymentHandler_RecordRequiresBody(t *testing.T) {
+	h := NewPaymentHandler(nil, nil)
+
+	resp, err := h.RecordInvoicePayment(authedContext(uuid.New()), api.RecordInvoicePaymentRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("RecordInvoicePayment: %v", err)
+	}
+	if _, ok := resp.(api.RecordInvoicePayment400JSONResponse); !ok {
+		t.Errorf("expected 400, got %T", resp)
+	}
+}
+
+func TestPaymentToAPI(t *testing.T) {
+	method := "card"
+	p := &store.Payment{
+		ID:        uuid.New(),
+		InvoiceID: uuid.New(),
+		Amount:    125.5,
+		PaidOn:    time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC),
+		Method:    &method,
+	}
+
+	got := paymentToAPI(p)
+	if got.Id != p.ID || got.InvoiceId != p.InvoiceID || got.Amount != 125.5 {
+		t.Errorf("unexpected payment %+v", got)
+	}
+	if got.PaidOn.Format("2006-01-02") != "2024-02-03" {
+		t.Errorf("expected paid on 2024-02-03, got %s", got.PaidOn)
+	}
+	if got.Method == nil || *got.Method != "card" || got.Reference != nil {
+		t.Errorf("expected method card and no reference, got %v and %v", got.Method, got.Reference)
+	}
+}
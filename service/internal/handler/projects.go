@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/michaelw/timesheet-app/service/internal/api"
 	"github.com/michaelw/timesheet-app/service/internal/store"
@@ -10,11 +11,11 @@ import (
 
 // ProjectHandler implements the project endpoints
 type ProjectHandler struct {
-	projects *store.ProjectStore
+	projects ProjectStore
 }
 
 // NewProjectHandler creates a new project handler
-func NewProjectHandler(projects *store.ProjectStore) *ProjectHandler {
+func NewProjectHandler(projects ProjectStore) *ProjectHandler {
 	return &ProjectHandler{projects: projects}
 }
 
@@ -28,12 +29,33 @@ func (h *ProjectHandler) ListProjects(ctx context.Context, req api.ListProjectsR
 		}, nil
 	}
 
-	includeArchived := false
+	opts := store.ProjectListOptions{
+		Archived: req.Params.Archived,
+		Client:   req.Params.Client,
+		Billable: req.Params.Billable,
+	}
 	if req.Params.IncludeArchived != nil {
-		includeArchived = *req.Params.IncludeArchived
+		opts.IncludeArchived = *req.Params.IncludeArchived
+	}
+	if req.Params.Sort != nil {
+		switch *req.Params.Sort {
+		case store.ProjectSortName, store.ProjectSortRecent, store.ProjectSortMonthHours:
+			opts.Sort = *req.Params.Sort
+		default:
+			return api.ListProjects400JSONResponse{
+				Code:    "invalid_request",
+				Message: "sort must be one of name, recent or month_hours",
+			}, nil
+		}
+	}
+	if req.Params.Limit != nil && *req.Params.Limit > 0 && *req.Params.Limit <= 500 {
+		opts.Limit = *req.Params.Limit
+	}
+	if req.Params.Offset != nil && *req.Params.Offset > 0 {
+		opts.Offset = *req.Params.Offset
 	}
 
-	projects, err := h.projects.List(ctx, userID, includeArchived)
+	projects, err := h.projects.Query(ctx, userID, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -160,18 +182,87 @@ func (h *ProjectHandler) UpdateProject(ctx context.Context, req api.UpdateProjec
 	if req.Body.DoesNotAccumulateHours != nil {
 		updates["does_not_accumulate_hours"] = *req.Body.DoesNotAccumulateHours
 	}
-	if req.Body.FingerprintDomains != nil {
-		updates["fingerprint_domains"] = *req.Body.FingerprintDomains
+	fingerprints := map[api.FingerprintKind]*[]string{
+		api.Domain:  req.Body.FingerprintDomains,
+		api.Email:   req.Body.FingerprintEmails,
+		api.Keyword: req.Body.FingerprintKeywords,
 	}
-	if req.Body.FingerprintEmails != nil {
-		updates["fingerprint_emails"] = *req.Body.FingerprintEmails
+	for kind, values := range fingerprints {
+		if values == nil {
+			continue
+		}
+		normalized, err := normalizeFingerprints(kind, *values)
+		if err != nil {
+			return api.UpdateProject400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
+		owner, value, err := h.fingerprintOwner(ctx, userID, req.Id, kind, normalized)
+		if err != nil {
+			return nil, err
+		}
+		if owner != nil {
+			return api.UpdateProject409JSONResponse{
+				Code:    "fingerprint_conflict",
+				Message: fmt.Sprintf("%q is already a fingerprint of project %q", value, owner.Name),
+			}, nil
+		}
+		updates[fingerprintColumns[kind]] = normalized
 	}
-	if req.Body.FingerprintKeywords != nil {
-		updates["fingerprint_keywords"] = *req.Body.FingerprintKeywords
+	// Exclusions only vote against this project, so they may overlap with
+	// fingerprints claimed elsewhere
+	exclusions := map[api.FingerprintKind]*[]string{
+		api.Domain:  req.Body.FingerprintExcludeDomains,
+		api.Keyword: req.Body.FingerprintExcludeKeywords,
+	}
+	for kind, values := range exclusions {
+		if values == nil {
+			continue
+		}
+		normalized, err := normalizeFingerprints(kind, *values)
+		if err != nil {
+			return api.UpdateProject400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
+		updates[exclusionColumns[kind]] = normalized
+	}
+	if req.Body.FingerprintWeights != nil {
+		weights, err := normalizeFingerprintWeights(*req.Body.FingerprintWeights)
+		if err != nil {
+			return api.UpdateProject400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
+		updates["fingerprint_weights"] = weights
+	}
+	if req.Body.GithubRepos != nil {
+		repos, err := normalizeGitHubRepos(*req.Body.GithubRepos)
+		if err != nil {
+			return api.UpdateProject400JSONResponse{
+				Code:    "invalid_request",
+				Message: err.Error(),
+			}, nil
+		}
+		updates["github_repos"] = repos
 	}
 	if req.Body.Client != nil {
 		updates["client"] = *req.Body.Client
 	}
+	if opts := req.Body.ArchiveOptions; opts != nil {
+		if opts.DisableRules != nil {
+			updates["archive_disable_rules"] = *opts.DisableRules
+		}
+		if opts.StopFingerprints != nil {
+			updates["archive_stop_fingerprints"] = *opts.StopFingerprints
+		}
+		if opts.HideFromPickers != nil {
+			updates["archive_hide_from_pickers"] = *opts.HideFromPickers
+		}
+	}
 
 	project, err := h.projects.Update(ctx, userID, req.Id, updates)
 	if err != nil {
@@ -238,6 +329,11 @@ func projectToAPI(p *store.Project) api.Project {
 		IsHiddenByDefault:      &p.IsHiddenByDefault,
 		DoesNotAccumulateHours: &p.DoesNotAccumulateHours,
 		UpdatedAt:              &p.UpdatedAt,
+		ArchiveOptions: &api.ProjectArchiveOptions{
+			DisableRules:     &p.ArchiveDisableRules,
+			StopFingerprints: &p.ArchiveStopFingerprints,
+			HideFromPickers:  &p.ArchiveHideFromPickers,
+		},
 	}
 	if len(p.FingerprintDomains) > 0 {
 		proj.FingerprintDomains = &p.FingerprintDomains
@@ -248,5 +344,27 @@ func projectToAPI(p *store.Project) api.Project {
 	if len(p.FingerprintKeywords) > 0 {
 		proj.FingerprintKeywords = &p.FingerprintKeywords
 	}
+	if len(p.FingerprintExcludeDomains) > 0 {
+		proj.FingerprintExcludeDomains = &p.FingerprintExcludeDomains
+	}
+	if len(p.FingerprintExcludeKeywords) > 0 {
+		proj.FingerprintExcludeKeywords = &p.FingerprintExcludeKeywords
+	}
+	if len(p.FingerprintWeights) > 0 {
+		weights := fingerprintWeightsToAPI(p.FingerprintWeights)
+		proj.FingerprintWeights = &weights
+	}
+	if len(p.GitHubRepos) > 0 {
+		proj.GithubRepos = &p.GitHubRepos
+	}
 	return proj
 }
+
+// fingerprintWeightsToAPI converts stored fingerprint weights to the API type
+func fingerprintWeightsToAPI(weights map[string]float64) map[string]float32 {
+	out := make(map[string]float32, len(weights))
+	for k, w := range weights {
+		out[k] = float32(w)
+	}
+	return out
+}
@@ -0,0 +1,477 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/store/memstore"
+)
+
+var (
+	_ ProjectStore   = (*memstore.ProjectStore)(nil)
+	_ TimeEntryStore = (*memstore.TimeEntryStore)(nil)
+)
+
+// failingProjectStore is a ProjectStore whose List fails with err
+type failingProjectStore struct {
+	ProjectStore
+	err error
+}
+
+func (s failingProjectStore) List(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*store.Project, error) {
+	return nil, s.err
+}
+
+func (s failingProjectStore) Query(ctx context.Context, userID uuid.UUID, opts store.ProjectListOptions) ([]*store.Project, error) {
+	return nil, s.err
+}
+
+func authedContext(userID uuid.UUID) context.Context {
+	return context.WithValue(context.Background(), userIDKey, userID)
+}
+
+func TestProjectHandler_RequiresAuth(t *testing.T) {
+	h := NewProjectHandler(memstore.New().Projects)
+
+	resp, err := h.ListProjects(context.Background(), api.ListProjectsRequestObject{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := resp.(api.ListProjects401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+}
+
+func TestProjectHandler_CRUD(t *testing.T) {
+	h := NewProjectHandler(memstore.New().Projects)
+	ctx := authedContext(uuid.New())
+
+	code := "ACME"
+	created, err := h.CreateProject(ctx, api.CreateProjectRequestObject{
+		Body: &api.ProjectCreate{Name: "Acme", ShortCode: &code},
+	})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	project, ok := created.(api.CreateProject201JSONResponse)
+	if !ok {
+		t.Fatalf("expected 201, got %T", created)
+	}
+	if project.Color != "#6B7280" || !project.IsBillable {
+		t.Errorf("expected default color and billable, got %q/%v", project.Color, project.IsBillable)
+	}
+
+	dup, err := h.CreateProject(ctx, api.CreateProjectRequestObject{
+		Body: &api.ProjectCreate{Name: "Acme Again", ShortCode: &code},
+	})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	if _, ok := dup.(api.CreateProject409JSONResponse); !ok {
+		t.Errorf("expected 409 for a duplicate short code, got %T", dup)
+	}
+
+	archived := true
+	updated, err := h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id:   project.Id,
+		Body: &api.ProjectUpdate{IsArchived: &archived},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	if p, ok := updated.(api.UpdateProject200JSONResponse); !ok || !p.IsArchived {
+		t.Fatalf("expected archived project, got %#v", updated)
+	}
+
+	listed, err := h.ListProjects(ctx, api.ListProjectsRequestObject{})
+	if err != nil {
+		t.Fatalf("ListProjects: %v", err)
+	}
+	if got := len(listed.(api.ListProjects200JSONResponse)); got != 0 {
+		t.Errorf("expected archived project to be hidden, got %d projects", got)
+	}
+
+	deleted, err := h.DeleteProject(ctx, api.DeleteProjectRequestObject{Id: project.Id})
+	if err != nil {
+		t.Fatalf("DeleteProject: %v", err)
+	}
+	if _, ok := deleted.(api.DeleteProject204Response); !ok {
+		t.Errorf("expected 204, got %T", deleted)
+	}
+
+	missing, err := h.GetProject(ctx, api.GetProjectRequestObject{Id: project.Id})
+	if err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if _, ok := missing.(api.GetProject404JSONResponse); !ok {
+		t.Errorf("expected 404 after delete, got %T", missing)
+	}
+}
+
+func TestProjectHandler_IsolatesUsers(t *testing.T) {
+	h := NewProjectHandler(memstore.New().Projects)
+
+	created, err := h.CreateProject(authedContext(uuid.New()), api.CreateProjectRequestObject{
+		Body: &api.ProjectCreate{Name: "Private"},
+	})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	id := created.(api.CreateProject201JSONResponse).Id
+
+	resp, err := h.GetProject(authedContext(uuid.New()), api.GetProjectRequestObject{Id: id})
+	if err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if _, ok := resp.(api.GetProject404JSONResponse); !ok {
+		t.Errorf("expected another user's project to be hidden, got %T", resp)
+	}
+}
+
+func TestProjectHandler_DeleteWithEntries(t *testing.T) {
+	mem := memstore.New()
+	h := NewProjectHandler(mem.Projects)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	project, _ := mem.Projects.Create(ctx, userID, "Busy", nil, nil, "#000000", true, false, false)
+	if _, err := mem.TimeEntries.Create(ctx, userID, project.ID, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), 2, nil); err != nil {
+		t.Fatalf("Create entry: %v", err)
+	}
+
+	resp, err := h.DeleteProject(ctx, api.DeleteProjectRequestObject{Id: project.ID})
+	if err != nil {
+		t.Fatalf("DeleteProject: %v", err)
+	}
+	if _, ok := resp.(api.DeleteProject409JSONResponse); !ok {
+		t.Errorf("expected 409 for a project with entries, got %T", resp)
+	}
+}
+
+func TestProjectHandler_ListFiltersSortsAndPages(t *testing.T) {
+	mem := memstore.New()
+	h := NewProjectHandler(mem.Projects)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	acme := "Acme"
+	alpha, _ := mem.Projects.Create(ctx, userID, "Alpha", nil, &acme, "#000000", true, false, false)
+	beta, _ := mem.Projects.Create(ctx, userID, "Beta", nil, &acme, "#000000", false, false, false)
+	gamma, _ := mem.Projects.Create(ctx, userID, "Gamma", nil, nil, "#000000", true, false, false)
+	if _, err := mem.Projects.Update(ctx, userID, gamma.ID, map[string]interface{}{"is_archived": true}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if _, err := mem.TimeEntries.Create(ctx, userID, beta.ID, today, 1, nil); err != nil {
+		t.Fatalf("Create entry: %v", err)
+	}
+	if _, err := mem.TimeEntries.Create(ctx, userID, alpha.ID, today.AddDate(-1, 0, 0), 8, nil); err != nil {
+		t.Fatalf("Create entry: %v", err)
+	}
+
+	list := func(params api.ListProjectsParams) []string {
+		t.Helper()
+		resp, err := h.ListProjects(ctx, api.ListProjectsRequestObject{Params: params})
+		if err != nil {
+			t.Fatalf("ListProjects: %v", err)
+		}
+		projects, ok := resp.(api.ListProjects200JSONResponse)
+		if !ok {
+			t.Fatalf("expected 200, got %T", resp)
+		}
+		var names []string
+		for _, p := range projects {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+	strPtr := func(s string) *string { return &s }
+	boolPtr := func(b bool) *bool { return &b }
+	intPtr := func(n int) *int { return &n }
+
+	tests := []struct {
+		name   string
+		params api.ListProjectsParams
+		want   []string
+	}{
+		{"defaults", api.ListProjectsParams{}, []string{"Alpha", "Beta"}},
+		{"archived only", api.ListProjectsParams{Archived: boolPtr(true)}, []string{"Gamma"}},
+		{"client", api.ListProjectsParams{Client: strPtr("Acme"), Billable: boolPtr(false)}, []string{"Beta"}},
+		{"recent activity", api.ListProjectsParams{IncludeArchived: boolPtr(true), Sort: strPtr("recent")}, []string{"Beta", "Alpha", "Gamma"}},
+		{"hours this month", api.ListProjectsParams{Sort: strPtr("month_hours")}, []string{"Beta", "Alpha"}},
+		{"paged", api.ListProjectsParams{IncludeArchived: boolPtr(true), Limit: intPtr(1), Offset: intPtr(1)}, []string{"Beta"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := list(tt.params)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	resp, err := h.ListProjects(ctx, api.ListProjectsRequestObject{Params: api.ListProjectsParams{Sort: strPtr("size")}})
+	if err != nil {
+		t.Fatalf("ListProjects: %v", err)
+	}
+	if _, ok := resp.(api.ListProjects400JSONResponse); !ok {
+		t.Errorf("expected 400 for an unknown sort, got %T", resp)
+	}
+}
+
+func TestProjectHandler_StoreError(t *testing.T) {
+	storeErr := errors.New("connection reset")
+	h := NewProjectHandler(failingProjectStore{err: storeErr})
+
+	_, err := h.ListProjects(authedContext(uuid.New()), api.ListProjectsRequestObject{})
+	if !errors.Is(err, storeErr) {
+		t.Errorf("expected store error to propagate, got %v", err)
+	}
+}
+
+func TestProjectHandler_Fingerprints(t *testing.T) {
+	mem := memstore.New()
+	h := NewProjectHandler(mem.Projects)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	acme, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+	globex, _ := mem.Projects.Create(ctx, userID, "Globex", nil, nil, "#000000", true, false, false)
+
+	add := func(projectID uuid.UUID, kind api.FingerprintKind, value string) api.AddProjectFingerprintResponseObject {
+		t.Helper()
+		resp, err := h.AddProjectFingerprint(ctx, api.AddProjectFingerprintRequestObject{
+			Id:   projectID,
+			Body: &api.FingerprintInput{Kind: kind, Value: value},
+		})
+		if err != nil {
+			t.Fatalf("AddProjectFingerprint: %v", err)
+		}
+		return resp
+	}
+
+	added, ok := add(acme.ID, api.Domain, " @Acme.COM ").(api.AddProjectFingerprint200JSONResponse)
+	if !ok || added.FingerprintDomains == nil || (*added.FingerprintDomains)[0] != "acme.com" {
+		t.Fatalf("expected normalized domain, got %#v", added)
+	}
+	add(acme.ID, api.Email, "ceo@acme.com")
+
+	if _, ok := add(acme.ID, api.Domain, "not a domain").(api.AddProjectFingerprint400JSONResponse); !ok {
+		t.Error("expected 400 for an invalid domain")
+	}
+	if _, ok := add(globex.ID, api.Domain, "acme.com").(api.AddProjectFingerprint409JSONResponse); !ok {
+		t.Error("expected 409 for a domain claimed by another project")
+	}
+	if again, ok := add(acme.ID, api.Domain, "acme.com").(api.AddProjectFingerprint200JSONResponse); !ok || len(*again.FingerprintDomains) != 1 {
+		t.Errorf("expected re-adding a fingerprint to be a no-op, got %#v", again)
+	}
+
+	claims, err := h.ListFingerprintClaims(ctx, api.ListFingerprintClaimsRequestObject{
+		Params: api.ListFingerprintClaimsParams{Domain: "acme.com"},
+	})
+	if err != nil {
+		t.Fatalf("ListFingerprintClaims: %v", err)
+	}
+	if got := claims.(api.ListFingerprintClaims200JSONResponse); len(got) != 2 || got[0].ProjectId != acme.ID {
+		t.Errorf("expected the domain and email claims from Acme, got %+v", got)
+	}
+
+	removed, err := h.RemoveProjectFingerprint(ctx, api.RemoveProjectFingerprintRequestObject{
+		Id: acme.ID, Kind: api.Domain, Value: "ACME.com",
+	})
+	if err != nil {
+		t.Fatalf("RemoveProjectFingerprint: %v", err)
+	}
+	if p, ok := removed.(api.RemoveProjectFingerprint200JSONResponse); !ok || p.FingerprintDomains != nil {
+		t.Errorf("expected the domain to be removed, got %#v", removed)
+	}
+	missing, _ := h.RemoveProjectFingerprint(ctx, api.RemoveProjectFingerprintRequestObject{
+		Id: acme.ID, Kind: api.Domain, Value: "acme.com",
+	})
+	if _, ok := missing.(api.RemoveProjectFingerprint404JSONResponse); !ok {
+		t.Errorf("expected 404 for a fingerprint the project lacks, got %T", missing)
+	}
+}
+
+func TestProjectHandler_ExclusionFingerprints(t *testing.T) {
+	mem := memstore.New()
+	h := NewProjectHandler(mem.Projects)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	acme, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+	globex, _ := mem.Projects.Create(ctx, userID, "Globex", nil, nil, "#000000", true, false, false)
+	mem.Projects.Update(ctx, userID, acme.ID, map[string]interface{}{"fingerprint_domains": []string{"acme.com"}})
+
+	// Excluding a domain another project claims is allowed
+	domains := []string{"ACME.com", "acme.com"}
+	keywords := []string{" Acme Offsite "}
+	resp, err := h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id:   globex.ID,
+		Body: &api.ProjectUpdate{FingerprintExcludeDomains: &domains, FingerprintExcludeKeywords: &keywords},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	p, ok := resp.(api.UpdateProject200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200, got %T", resp)
+	}
+	if p.FingerprintExcludeDomains == nil || len(*p.FingerprintExcludeDomains) != 1 || (*p.FingerprintExcludeDomains)[0] != "acme.com" {
+		t.Errorf("expected one normalized exclusion domain, got %v", p.FingerprintExcludeDomains)
+	}
+	if p.FingerprintExcludeKeywords == nil || (*p.FingerprintExcludeKeywords)[0] != "acme offsite" {
+		t.Errorf("expected normalized exclusion keyword, got %v", p.FingerprintExcludeKeywords)
+	}
+
+	invalid := []string{"not a domain"}
+	resp, err = h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id:   globex.ID,
+		Body: &api.ProjectUpdate{FingerprintExcludeDomains: &invalid},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	if _, ok := resp.(api.UpdateProject400JSONResponse); !ok {
+		t.Errorf("expected 400 for an invalid exclusion domain, got %T", resp)
+	}
+}
+
+func TestProjectHandler_FingerprintWeights(t *testing.T) {
+	mem := memstore.New()
+	h := NewProjectHandler(mem.Projects)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	acme, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+
+	weight := float32(3)
+	resp, err := h.AddProjectFingerprint(ctx, api.AddProjectFingerprintRequestObject{
+		Id:   acme.ID,
+		Body: &api.FingerprintInput{Kind: api.Email, Value: "CEO@acme.com", Weight: &weight},
+	})
+	if err != nil {
+		t.Fatalf("AddProjectFingerprint: %v", err)
+	}
+	p, ok := resp.(api.AddProjectFingerprint200JSONResponse)
+	if !ok || p.FingerprintWeights == nil || (*p.FingerprintWeights)["email:ceo@acme.com"] != 3 {
+		t.Fatalf("expected the email weight to be stored, got %#v", resp)
+	}
+
+	invalid := map[string]float32{"phone:555": 2}
+	update, err := h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id:   acme.ID,
+		Body: &api.ProjectUpdate{FingerprintWeights: &invalid},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	if _, ok := update.(api.UpdateProject400JSONResponse); !ok {
+		t.Errorf("expected 400 for an unknown fingerprint kind, got %T", update)
+	}
+
+	removed, err := h.RemoveProjectFingerprint(ctx, api.RemoveProjectFingerprintRequestObject{
+		Id: acme.ID, Kind: api.Email, Value: "ceo@acme.com",
+	})
+	if err != nil {
+		t.Fatalf("RemoveProjectFingerprint: %v", err)
+	}
+	if p, ok := removed.(api.RemoveProjectFingerprint200JSONResponse); !ok || p.FingerprintWeights != nil {
+		t.Errorf("expected the weight to go with the fingerprint, got %#v", removed)
+	}
+}
+
+func TestProjectHandler_GitHubRepos(t *testing.T) {
+	mem := memstore.New()
+	h := NewProjectHandler(mem.Projects)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	acme, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+
+	repos := []string{" Acme/API ", "acme/api", "acme/web.site"}
+	resp, err := h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id:   acme.ID,
+		Body: &api.ProjectUpdate{GithubRepos: &repos},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	p, ok := resp.(api.UpdateProject200JSONResponse)
+	if !ok || p.GithubRepos == nil || len(*p.GithubRepos) != 2 || (*p.GithubRepos)[0] != "acme/api" {
+		t.Fatalf("expected two normalized repositories, got %#v", resp)
+	}
+
+	invalid := []string{"https://github.com/acme/api"}
+	resp, err = h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id:   acme.ID,
+		Body: &api.ProjectUpdate{GithubRepos: &invalid},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	if _, ok := resp.(api.UpdateProject400JSONResponse); !ok {
+		t.Errorf("expected 400 for a repository URL, got %T", resp)
+	}
+}
+
+func TestProjectHandler_ArchiveOptions(t *testing.T) {
+	mem := memstore.New()
+	h := NewProjectHandler(mem.Projects)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	acme, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+	if _, err := mem.Projects.Update(ctx, userID, acme.ID, map[string]interface{}{"fingerprint_domains": []string{"acme.com"}}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	archived, stop, hide := true, true, false
+	resp, err := h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id: acme.ID,
+		Body: &api.ProjectUpdate{
+			IsArchived:     &archived,
+			ArchiveOptions: &api.ProjectArchiveOptions{StopFingerprints: &stop, HideFromPickers: &hide},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	p, ok := resp.(api.UpdateProject200JSONResponse)
+	if !ok || p.ArchiveOptions == nil || !*p.ArchiveOptions.StopFingerprints || *p.ArchiveOptions.HideFromPickers || *p.ArchiveOptions.DisableRules {
+		t.Fatalf("expected the archive options to be stored, got %#v", resp)
+	}
+
+	// Still offered in pickers, but its fingerprints no longer match
+	listed, err := h.ListProjects(ctx, api.ListProjectsRequestObject{})
+	if err != nil {
+		t.Fatalf("ListProjects: %v", err)
+	}
+	if projects := listed.(api.ListProjects200JSONResponse); len(projects) != 1 {
+		t.Errorf("expected the archived project to stay in pickers, got %d projects", len(projects))
+	}
+	stored, _ := mem.Projects.GetByID(ctx, userID, acme.ID)
+	if targets := projectsToTargets([]*store.Project{stored}); len(targets[0].Attributes) != 0 {
+		t.Errorf("expected no fingerprints while archived, got %v", targets[0].Attributes)
+	}
+
+	// Unarchiving restores them
+	archived = false
+	if _, err := h.UpdateProject(ctx, api.UpdateProjectRequestObject{
+		Id:   acme.ID,
+		Body: &api.ProjectUpdate{IsArchived: &archived},
+	}); err != nil {
+		t.Fatalf("UpdateProject: %v", err)
+	}
+	stored, _ = mem.Projects.GetByID(ctx, userID, acme.ID)
+	if targets := projectsToTargets([]*store.Project{stored}); targets[0].Attributes["domains"] == nil {
+		t.Errorf("expected fingerprints to match again after unarchiving, got %v", targets[0].Attributes)
+	}
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+)
+
+// maxRecalculateDays bounds the range of a single recalculation job
+const maxRecalculateDays = 366
+
+// RecalculationHandler implements the time entry recalculation endpoints
+type RecalculationHandler struct {
+	jobs             *store.RecalculationJobStore
+	projects         ProjectStore
+	timeEntryService *timeentry.Service
+}
+
+// NewRecalculationHandler creates a new recalculation handler
+func NewRecalculationHandler(jobs *store.RecalculationJobStore, projects ProjectStore, timeEntryService *timeentry.Service) *RecalculationHandler {
+	return &RecalculationHandler{
+		jobs:             jobs,
+		projects:         projects,
+		timeEntryService: timeEntryService,
+	}
+}
+
+// RecalculateTimeEntries starts a background job recomputing time entries
+// over a date range, or joins the unfinished job with the same scope
+func (h *RecalculationHandler) RecalculateTimeEntries(ctx context.Context, req api.RecalculateTimeEntriesRequestObject) (api.RecalculateTimeEntriesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RecalculateTimeEntries401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.RecalculateTimeEntries400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	startDate := req.Body.StartDate.Time
+	endDate := req.Body.EndDate.Time
+	if endDate.Before(startDate) {
+		return api.RecalculateTimeEntries400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_date must not be before start_date",
+		}, nil
+	}
+	if endDate.After(startDate.AddDate(0, 0, maxRecalculateDays)) {
+		return api.RecalculateTimeEntries400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Date range must be at most 366 days",
+		}, nil
+	}
+
+	projectID := req.Body.ProjectId
+	if projectID != nil {
+		if _, err := h.projects.GetByID(ctx, userID, *projectID); err != nil {
+			if errors.Is(err, store.ErrProjectNotFound) {
+				return api.RecalculateTimeEntries404JSONResponse{
+					Code:    "not_found",
+					Message: "Project not found",
+				}, nil
+			}
+			return nil, err
+		}
+	}
+
+	job, err := h.jobs.FindActive(ctx, userID, startDate, endDate, projectID)
+	if err == nil {
+		return api.RecalculateTimeEntries202JSONResponse(recalculationJobToAPI(job)), nil
+	}
+	if !errors.Is(err, store.ErrRecalculationJobNotFound) {
+		return nil, err
+	}
+
+	job, err = h.jobs.Create(ctx, userID, startDate, endDate, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	go h.runRecalculationJob(job)
+
+	return api.RecalculateTimeEntries202JSONResponse(recalculationJobToAPI(job)), nil
+}
+
+// runRecalculationJob runs a recalculation job to completion, recording
+// progress after every day. It outlives the request that started it.
+func (h *RecalculationHandler) runRecalculationJob(job *store.RecalculationJob) {
+	ctx := context.Background()
+
+	progress := func(p timeentry.RecalculateProgress) error {
+		return h.jobs.UpdateProgress(ctx, job.ID, store.RecalculationJobProgress{
+			TotalDays:       p.TotalDays,
+			ProcessedDays:   p.ProcessedDays,
+			EntriesComputed: p.EntriesComputed,
+			EntriesCleared:  p.EntriesCleared,
+		})
+	}
+
+	if err := h.jobs.Start(ctx, job.ID); err != nil {
+		log.Printf("[RECALCULATE] job %s failed to start: %v", job.ID, err)
+		return
+	}
+
+	result, err := h.timeEntryService.RecalculateRange(ctx, job.UserID, job.StartDate, job.EndDate, timeentry.RecalculateOptions{
+		ProjectID: job.ProjectID,
+		OnDay:     progress,
+	})
+	if err != nil {
+		// Record how far the job got before it failed
+		if perr := progress(result); perr != nil {
+			log.Printf("[RECALCULATE] job %s: failed to record progress: %v", job.ID, perr)
+		}
+		log.Printf("[RECALCULATE] job %s failed: %v", job.ID, err)
+		if ferr := h.jobs.Fail(ctx, job.ID, err.Error()); ferr != nil {
+			log.Printf("[RECALCULATE] job %s: failed to record failure: %v", job.ID, ferr)
+		}
+		return
+	}
+
+	if err := h.jobs.Complete(ctx, job.ID); err != nil {
+		log.Printf("[RECALCULATE] job %s: failed to record completion: %v", job.ID, err)
+	}
+}
+
+// GetRecalculationJob returns the status and progress of a recalculation job
+func (h *RecalculationHandler) GetRecalculationJob(ctx context.Context, req api.GetRecalculationJobRequestObject) (api.GetRecalculationJobResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetRecalculationJob401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	job, err := h.jobs.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrRecalculationJobNotFound) {
+			return api.GetRecalculationJob404JSONResponse{
+				Code:    "not_found",
+				Message: "Recalculation job not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.GetRecalculationJob200JSONResponse(recalculationJobToAPI(job)), nil
+}
+
+// recalculationJobToAPI converts a store.RecalculationJob to an api.RecalculationJob
+func recalculationJobToAPI(j *store.RecalculationJob) api.RecalculationJob {
+	return api.RecalculationJob{
+		Id:              j.ID,
+		Status:          string(j.Status),
+		StartDate:       openapi_types.Date{Time: j.StartDate},
+		EndDate:         openapi_types.Date{Time: j.EndDate},
+		ProjectId:       j.ProjectID,
+		TotalDays:       j.TotalDays,
+		ProcessedDays:   j.ProcessedDays,
+		EntriesComputed: j.EntriesComputed,
+		EntriesCleared:  j.EntriesCleared,
+		Error:           j.ErrorMessage,
+		CreatedAt:       j.CreatedAt,
+		StartedAt:       j.StartedAt,
+		CompletedAt:     j.CompletedAt,
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store/memstore"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestRecalculationHandler_Validation(t *testing.T) {
+	mem := memstore.New()
+	h := NewRecalculationHandler(nil, mem.Projects, nil)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	recalculate := func(body *api.RecalculateTimeEntriesRequest) api.RecalculateTimeEntriesResponseObject {
+		t.Helper()
+		resp, err := h.RecalculateTimeEntries(ctx, api.RecalculateTimeEntriesRequestObject{Body: body})
+		if err != nil {
+			t.Fatalf("RecalculateTimeEntries: %v", err)
+		}
+		return resp
+	}
+
+	if _, ok := recalculate(nil).(api.RecalculateTimeEntries400JSONResponse); !ok {
+		t.Error("expected 400 without a body")
+	}
+
+	if _, ok := recalculate(&api.RecalculateTimeEntriesRequest{
+		StartDate: openapi_types.Date{Time: start},
+		EndDate:   openapi_types.Date{Time: start.AddDate(0, 0, -1)},
+	}).(api.RecalculateTimeEntries400JSONResponse); !ok {
+		t.Error("expected 400 when the range ends before it starts")
+	}
+
+	if _, ok := recalculate(&api.RecalculateTimeEntriesRequest{
+		StartDate: openapi_types.Date{Time: start},
+		EndDate:   openapi_types.Date{Time: start.AddDate(0, 0, maxRecalculateDays+1)},
+	}).(api.RecalculateTimeEntries400JSONResponse); !ok {
+		t.Error("expected 400 for a range over the limit")
+	}
+
+	unknown := uuid.New()
+	if _, ok := recalculate(&api.RecalculateTimeEntriesRequest{
+		StartDate: openapi_types.Date{Time: start},
+		EndDate:   openapi_types.Date{Time: start.AddDate(0, 0, 6)},
+		ProjectId: &unknown,
+	}).(api.RecalculateTimeEntries404JSONResponse); !ok {
+		t.Error("expected 404 for an unknown project")
+	}
+}
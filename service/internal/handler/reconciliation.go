@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// GetReconciliationReport lists materialized entries whose hours diverge from
+// the hours computed from their events
+func (h *TimeEntryHandler) GetReconciliationReport(ctx context.Context, req api.GetReconciliationReportRequestObject) (api.GetReconciliationReportResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetReconciliationReport401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var startDate, endDate *time.Time
+	if req.Params.StartDate != nil {
+		t := req.Params.StartDate.Time
+		startDate = &t
+	}
+	if req.Params.EndDate != nil {
+		t := req.Params.EndDate.Time
+		endDate = &t
+	}
+	includeAcknowledged := req.Params.IncludeAcknowledged != nil && *req.Params.IncludeAcknowledged
+
+	entries, err := h.entries.ListDivergent(ctx, userID, startDate, endDate, includeAcknowledged)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]api.ReconciliationItem, len(entries))
+	var total float64
+	for i, e := range entries {
+		diff := e.Hours - *e.ComputedHours
+		total += diff
+		items[i] = api.ReconciliationItem{
+			Entry:         timeEntryToAPI(e),
+			ComputedHours: float32(*e.ComputedHours),
+			Difference:    float32(diff),
+		}
+	}
+
+	return api.GetReconciliationReport200JSONResponse{
+		Items:           items,
+		TotalDifference: float32(total),
+	}, nil
+}
+
+// ReconcileTimeEntry resolves a divergent entry by accepting the computed
+// values or keeping the recorded ones
+func (h *TimeEntryHandler) ReconcileTimeEntry(ctx context.Context, req api.ReconcileTimeEntryRequestObject) (api.ReconcileTimeEntryResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ReconcileTimeEntry401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.ReconcileTimeEntry400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	var entry *store.TimeEntry
+	var err error
+	switch req.Body.Resolution {
+	case api.AcceptComputed:
+		entry, err = h.entries.AcceptComputed(ctx, userID, req.Id)
+	case api.KeepManual:
+		entry, err = h.entries.KeepManual(ctx, userID, req.Id)
+	default:
+		return api.ReconcileTimeEntry400JSONResponse{
+			Code:    "invalid_request",
+			Message: "resolution must be accept_computed or keep_manual",
+		}, nil
+	}
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.ReconcileTimeEntry404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrTimeEntryInvoiced) {
+			return api.ReconcileTimeEntry409JSONResponse{
+				Code:    "conflict",
+				Message: "Cannot accept computed values for an invoiced time entry",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.ReconcileTimeEntry200JSONResponse(timeEntryToAPI(entry)), nil
+}
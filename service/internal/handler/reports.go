@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/meetingcost"
+	"github.com/michaelw/timesheet-app/service/internal/meetingload"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/sync"
+	"github.com/michaelw/timesheet-app/service/internal/utilization"
+)
+
+// maxReportDays bounds the range of a utilization report
+const maxReportDays = 731
+
+// ReportsHandler implements the working-hours, utilization, meeting cost
+// and meeting load endpoints
+type ReportsHandler struct {
+	workingHours   *store.WorkingHoursStore
+	utilizationSvc *utilization.Service
+	events         *store.CalendarEventStore
+	meetingCostSvc *meetingcost.Service
+	meetingLoadSvc *meetingload.Service
+}
+
+// NewReportsHandler creates a new reports handler
+func NewReportsHandler(workingHours *store.WorkingHoursStore, utilizationSvc *utilization.Service, events *store.CalendarEventStore) *ReportsHandler {
+	return &ReportsHandler{
+		workingHours:   workingHours,
+		utilizationSvc: utilizationSvc,
+		events:         events,
+		meetingCostSvc: meetingcost.NewService(events),
+		meetingLoadSvc: meetingload.NewService(events, workingHours.Get),
+	}
+}
+
+// GetWorkingHours returns the user's working-hours profile
+func (h *ReportsHandler) GetWorkingHours(ctx context.Context, req api.GetWorkingHoursRequestObject) (api.GetWorkingHoursResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetWorkingHours401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	wh, err := h.workingHours.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetWorkingHours200JSONResponse(workingHoursToAPI(wh)), nil
+}
+
+// UpdateWorkingHours saves the user's working-hours profile
+func (h *ReportsHandler) UpdateWorkingHours(ctx context.Context, req api.UpdateWorkingHoursRequestObject) (api.UpdateWorkingHoursResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateWorkingHours401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateWorkingHours400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	if len(req.Body.DailyHours) != 7 {
+		return api.UpdateWorkingHours400JSONResponse{
+			Code:    "invalid_request",
+			Message: "daily_hours must have 7 values, Monday first",
+		}, nil
+	}
+
+	var daily [7]float64
+	for i, hours := range req.Body.DailyHours {
+		if hours < 0 || hours > 24 {
+			return api.UpdateWorkingHours400JSONResponse{
+				Code:    "invalid_request",
+				Message: "daily_hours values must be between 0 and 24",
+			}, nil
+		}
+		daily[i] = hours
+	}
+
+	wh, err := h.workingHours.Set(ctx, userID, daily)
+	if err != nil {
+		return nil, err
+	}
+	return api.UpdateWorkingHours200JSONResponse(workingHoursToAPI(wh)), nil
+}
+
+// GetUtilizationReport returns utilization against capacity for a date range
+func (h *ReportsHandler) GetUtilizationReport(ctx context.Context, req api.GetUtilizationReportRequestObject) (api.GetUtilizationReportResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetUtilizationReport401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	now := time.Now().UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if req.Params.EndDate != nil {
+		end = req.Params.EndDate.Time
+	}
+	// Default to the last 12 weeks, ending with the current one
+	start := sync.NormalizeToWeekStart(end).AddDate(0, 0, -7*11)
+	if req.Params.StartDate != nil {
+		start = req.Params.StartDate.Time
+	}
+
+	if end.Before(start) {
+		return api.GetUtilizationReport400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_date must not be before start_date",
+		}, nil
+	}
+	if end.Sub(start) > maxReportDays*24*time.Hour {
+		return api.GetUtilizationReport400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Date range must be at most two years",
+		}, nil
+	}
+
+	report, err := h.utilizationSvc.Report(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if err := addAudience(ctx, h.events, userID, report); err != nil {
+		return nil, err
+	}
+
+	return api.GetUtilizationReport200JSONResponse(utilizationReportToAPI(report)), nil
+}
+
+// addAudience adds the internal vs client-facing split of the report's
+// event time, when the user has declared company domains
+func addAudience(ctx context.Context, events *store.CalendarEventStore, userID uuid.UUID, report *utilization.Report) error {
+	hours, err := events.HoursByAudience(ctx, userID, report.StartDate, report.EndDate.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+	if hours != nil {
+		report.AddAudience(hours.Internal, hours.ClientFacing)
+	}
+	return nil
+}
+
+func workingHoursToAPI(wh *store.WorkingHours) api.WorkingHours {
+	return api.WorkingHours{
+		DailyHours: wh.DailyHours[:],
+		IsDefault:  wh.IsDefault,
+		UpdatedAt:  wh.UpdatedAt,
+	}
+}
+
+func utilizationReportToAPI(r *utilization.Report) api.UtilizationReport {
+	weeks := make([]api.UtilizationWeek, len(r.Weeks))
+	for i, w := range r.Weeks {
+		weeks[i] = api.UtilizationWeek{
+			WeekStart:     openapi_types.Date{Time: w.WeekStart},
+			CapacityHours: w.CapacityHours,
+			BillableHours: w.BillableHours,
+			TotalHours:    w.TotalHours,
+			Utilization:   w.Utilization,
+		}
+	}
+
+	projects := make([]api.ProjectShare, len(r.Projects))
+	for i, p := range r.Projects {
+		projects[i] = api.ProjectShare{
+			ProjectId:   p.ProjectID,
+			ProjectName: p.ProjectName,
+			IsBillable:  p.IsBillable,
+			Hours:       p.Hours,
+			Share:       p.Share,
+		}
+		if p.Expenses > 0 {
+			projects[i].Expenses = ptrFloat64(p.Expenses)
+			projects[i].BillableExpenses = ptrFloat64(p.BillableExpenses)
+		}
+	}
+
+	var tags *[]api.TagShare
+	if len(r.Tags) > 0 {
+		shares := make([]api.TagShare, len(r.Tags))
+		for i, t := range r.Tags {
+			shares[i] = api.TagShare{
+				Tag:   t.Tag,
+				Hours: t.Hours,
+				Share: t.Share,
+			}
+		}
+		tags = &shares
+	}
+
+	var audience *api.AudienceSplit
+	if r.Audience != nil {
+		audience = &api.AudienceSplit{
+			InternalHours:     r.Audience.InternalHours,
+			ClientFacingHours: r.Audience.ClientFacingHours,
+			ClientFacingShare: r.Audience.ClientFacingShare,
+		}
+	}
+
+	return api.UtilizationReport{
+		StartDate:     openapi_types.Date{Time: r.StartDate},
+		EndDate:       openapi_types.Date{Time: r.EndDate},
+		CapacityHours: r.CapacityHours,
+		BillableHours: r.BillableHours,
+		TotalHours:    r.TotalHours,
+		Utilization:   r.Utilization,
+		Weeks:         weeks,
+		Projects:      projects,
+		Tags:          tags,
+		Audience:      audience,
+	}
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/retention"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// RetentionHandler implements the data retention endpoints
+type RetentionHandler struct {
+	users  *store.UserStore
+	events *store.CalendarEventStore
+}
+
+// NewRetentionHandler creates a new retention handler
+func NewRetentionHandler(users *store.UserStore, events *store.CalendarEventStore) *RetentionHandler {
+	return &RetentionHandler{users: users, events: events}
+}
+
+// GetRetentionSettings returns how long the user's calendar events are kept
+func (h *RetentionHandler) GetRetentionSettings(ctx context.Context, req api.GetRetentionSettingsRequestObject) (api.GetRetentionSettingsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetRetentionSettings401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	months, err := h.users.GetRetention(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetRetentionSettings200JSONResponse{EventMonths: months}, nil
+}
+
+// UpdateRetentionSettings sets how long the user's calendar events are kept
+func (h *RetentionHandler) UpdateRetentionSettings(ctx context.Context, req api.UpdateRetentionSettingsRequestObject) (api.UpdateRetentionSettingsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateRetentionSettings401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateRetentionSettings400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if msg := checkEventMonths(req.Body.EventMonths); msg != "" {
+		return api.UpdateRetentionSettings400JSONResponse{Code: "invalid_request", Message: msg}, nil
+	}
+
+	if err := h.users.SetRetention(ctx, userID, req.Body.EventMonths); err != nil {
+		return nil, err
+	}
+
+	return api.UpdateRetentionSettings200JSONResponse{EventMonths: req.Body.EventMonths}, nil
+}
+
+// PreviewRetention reports what the next purge would remove for the saved
+// or given retention period
+func (h *RetentionHandler) PreviewRetention(ctx context.Context, req api.PreviewRetentionRequestObject) (api.PreviewRetentionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.PreviewRetention401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	months := req.Params.EventMonths
+	if months != nil {
+		if msg := checkEventMonths(months); msg != "" {
+			return api.PreviewRetention400JSONResponse{Code: "invalid_request", Message: msg}, nil
+		}
+	} else {
+		var err error
+		if months, err = h.users.GetRetention(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	if months == nil {
+		// Events are kept forever
+		return api.PreviewRetention200JSONResponse{}, nil
+	}
+
+	report, err := retention.Preview(ctx, h.events, userID, *months, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PreviewRetention200JSONResponse{
+		Cutoff:          &report.Cutoff,
+		Events:          int(report.Events),
+		ArchivedEvents:  int(report.ArchivedEvents),
+		ProtectedEvents: int(report.ProtectedEvents),
+	}, nil
+}
+
+// checkEventMonths returns why an optional retention period is out of
+// bounds, or an empty string
+func checkEventMonths(months *int) string {
+	if months == nil {
+		return ""
+	}
+	if *months < retention.MinEventMonths || *months > retention.MaxEventMonths {
+		return fmt.Sprintf("event_months must be between %d and %d", retention.MinEventMonths, retention.MaxEventMonths)
+	}
+	return ""
+}
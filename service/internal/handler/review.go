@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// errReviewOverrideTarget is returned when an override names neither a
+// project nor a skip
+var errReviewOverrideTarget = errors.New("override requires project_id or skip=true")
+
+// GetReviewQueue lists events flagged for review, least confident first
+func (h *CalendarHandler) GetReviewQueue(ctx context.Context, req api.GetReviewQueueRequestObject) (api.GetReviewQueueResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetReviewQueue401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var startDate, endDate *time.Time
+	if req.Params.StartDate != nil {
+		startDate = &req.Params.StartDate.Time
+	}
+	if req.Params.EndDate != nil {
+		endDate = &req.Params.EndDate.Time
+	}
+	if startDate != nil && endDate != nil && endDate.Before(*startDate) {
+		return api.GetReviewQueue400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_date must not be before start_date",
+		}, nil
+	}
+
+	limit := 50
+	if req.Params.Limit != nil && *req.Params.Limit > 0 && *req.Params.Limit <= 200 {
+		limit = *req.Params.Limit
+	}
+
+	events, err := h.events.ListNeedsReview(ctx, userID, startDate, endDate, limit)
+	if err != nil {
+		return nil, err
+	}
+	total, err := h.events.CountNeedsReview(ctx, userID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.CalendarEvent, len(events))
+	for i, e := range events {
+		result[i] = calendarEventToAPI(e)
+	}
+	return api.GetReviewQueue200JSONResponse{Events: result, Total: total}, nil
+}
+
+// ReviewCalendarEvent accepts or overrides an event's suggested
+// classification and returns the next event in the queue
+func (h *CalendarHandler) ReviewCalendarEvent(ctx context.Context, req api.ReviewCalendarEventRequestObject) (api.ReviewCalendarEventResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ReviewCalendarEvent401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.ReviewCalendarEvent400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if req.Body.Decision != api.Accept && req.Body.Decision != api.Override {
+		return api.ReviewCalendarEvent400JSONResponse{
+			Code:    "invalid_request",
+			Message: "decision must be accept or override",
+		}, nil
+	}
+
+	skip := req.Body.Skip != nil && *req.Body.Skip
+	event, action, err := reviewEvent(ctx, h.events, h.classificationSvc, userID, req.Id, req.Body.Decision, req.Body.ProjectId, skip)
+	if err != nil {
+		switch {
+		case errors.Is(err, errReviewOverrideTarget):
+			return api.ReviewCalendarEvent400JSONResponse{
+				Code:    "invalid_request",
+				Message: "Override requires project_id or skip set to true",
+			}, nil
+		case errors.Is(err, store.ErrCalendarEventNotFound):
+			return api.ReviewCalendarEvent404JSONResponse{
+				Code:    "not_found",
+				Message: "Calendar event not found",
+			}, nil
+		case errors.Is(err, store.ErrCalendarEventNotInReview):
+			return api.ReviewCalendarEvent409JSONResponse{
+				Code:    "not_in_review",
+				Message: "Event does not need review",
+			}, nil
+		}
+		return nil, err
+	}
+
+	response := api.ReviewCalendarEvent200JSONResponse{Event: calendarEventToAPI(event)}
+	if action != nil {
+		response.ActionId = &action.ID
+	}
+
+	next, err := h.events.ListNeedsReview(ctx, userID, nil, nil, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(next) > 0 {
+		n := calendarEventToAPI(next[0])
+		response.Next = &n
+	}
+	if response.Remaining, err = h.events.CountNeedsReview(ctx, userID, nil, nil); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// reviewEvent applies a review decision to an event flagged for review and
+// journals it so it can be undone. Accepting keeps the automatic
+// classification; overriding classifies the event by hand.
+func reviewEvent(
+	ctx context.Context,
+	events *store.CalendarEventStore,
+	classificationSvc *classification.Service,
+	userID, eventID uuid.UUID,
+	decision api.ReviewDecisionDecision,
+	projectID *uuid.UUID,
+	skip bool,
+) (*store.CalendarEvent, *store.ClassificationAction, error) {
+	prev, err := events.GetByID(ctx, userID, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !prev.NeedsReview {
+		return nil, nil, store.ErrCalendarEventNotInReview
+	}
+
+	var event *store.CalendarEvent
+	var description string
+	switch decision {
+	case api.Accept:
+		event, err = events.AcceptReview(ctx, userID, eventID)
+		description = fmt.Sprintf("Accept review of %q", prev.Title)
+	case api.Override:
+		if skip {
+			projectID = nil
+		} else if projectID == nil {
+			return nil, nil, errReviewOverrideTarget
+		}
+		event, err = events.Classify(ctx, userID, eventID, projectID, skip)
+		description = fmt.Sprintf("Override review of %q", prev.Title)
+	default:
+		return nil, nil, fmt.Errorf("unknown review decision: %s", decision)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	action, err := classificationSvc.RecordAction(ctx, userID, store.ActionKindClassify,
+		description, []store.EventClassificationState{store.ClassificationStateOf(prev)})
+	if err != nil {
+		return nil, nil, err
+	}
+	return event, action, nil
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestCalendarHandler_ReviewValidation(t *testing.T) {
+	h := NewCalendarHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	ctx := authedContext(uuid.New())
+
+	resp, err := h.ReviewCalendarEvent(context.Background(), api.ReviewCalendarEventRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := resp.(api.ReviewCalendarEvent401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+
+	for _, body := range []*api.ReviewDecision{nil, {Decision: "maybe"}} {
+		resp, err := h.ReviewCalendarEvent(ctx, api.ReviewCalendarEventRequestObject{Id: uuid.New(), Body: body})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := resp.(api.ReviewCalendarEvent400JSONResponse); !ok {
+			t.Errorf("body %+v: expected 400, got %T", body, resp)
+		}
+	}
+
+	start := openapi_types.Date{Time: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)}
+	end := openapi_types.Date{Time: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)}
+	queue, err := h.GetReviewQueue(ctx, api.GetReviewQueueRequestObject{
+		Params: api.GetReviewQueueParams{StartDate: &start, EndDate: &end},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := queue.(api.GetReviewQueue400JSONResponse); !ok {
+		t.Errorf("expected 400 for an inverted range, got %T", queue)
+	}
+}
@@ -0,0 +1,430 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+const rulePackFormatVersion = "1"
+
+// ExportRulePack bundles rules and project fingerprints into a named pack
+func (h *ConfigHandler) ExportRulePack(ctx context.Context, req api.ExportRulePackRequestObject) (api.ExportRulePackResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ExportRulePack401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil || strings.TrimSpace(req.Body.Name) == "" {
+		return api.ExportRulePack400JSONResponse{
+			Code:    "invalid_request",
+			Message: "name is required",
+		}, nil
+	}
+
+	projects, err := h.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a selection the pack covers every active project
+	included := make(map[uuid.UUID]*store.Project)
+	if req.Body.ProjectNames != nil {
+		byName := make(map[string]*store.Project, len(projects))
+		for _, p := range projects {
+			byName[p.Name] = p
+		}
+		for _, name := range *req.Body.ProjectNames {
+			p, ok := byName[name]
+			if !ok {
+				return api.ExportRulePack400JSONResponse{
+					Code:    "invalid_request",
+					Message: fmt.Sprintf("Unknown project %q", name),
+				}, nil
+			}
+			included[p.ID] = p
+		}
+	} else {
+		for _, p := range projects {
+			if !p.IsArchived {
+				included[p.ID] = p
+			}
+		}
+	}
+
+	rules, err := h.rules.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	projectNames := make(map[string]string, len(included))
+	for _, p := range included {
+		projectNames[p.ID.String()] = p.Name
+	}
+
+	pack := api.RulePack{
+		FormatVersion: rulePackFormatVersion,
+		Name:          strings.TrimSpace(req.Body.Name),
+		Description:   req.Body.Description,
+		ExportedAt:    time.Now().UTC(),
+		Rules:         []api.RuleExport{},
+		Fingerprints:  []api.RulePackFingerprint{},
+	}
+
+	for _, r := range rules {
+		isSkipRule := r.Attended != nil && !*r.Attended
+		if !isSkipRule && (r.ProjectID == nil || included[*r.ProjectID] == nil) {
+			continue
+		}
+		pack.Rules = append(pack.Rules, ruleToExport(r, projectNames))
+	}
+
+	// Keep the account's project order
+	for _, p := range projects {
+		if included[p.ID] == nil {
+			continue
+		}
+		if fp, ok := projectToPackFingerprint(p); ok {
+			pack.Fingerprints = append(pack.Fingerprints, fp)
+		}
+	}
+
+	return api.ExportRulePack200JSONResponse(pack), nil
+}
+
+// ImportRulePack imports a rule pack, creating its rules and merging its
+// fingerprints once every pack project resolves to one of the user's
+func (h *ConfigHandler) ImportRulePack(ctx context.Context, req api.ImportRulePackRequestObject) (api.ImportRulePackResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ImportRulePack401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.ImportRulePack400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body required",
+		}, nil
+	}
+	if req.Body.Pack.FormatVersion != rulePackFormatVersion {
+		return api.ImportRulePack400JSONResponse{
+			Code:    "invalid_request",
+			Message: fmt.Sprintf("Unsupported pack format version %q", req.Body.Pack.FormatVersion),
+		}, nil
+	}
+
+	projects, err := h.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := make(map[string]uuid.UUID)
+	if req.Body.ProjectMapping != nil {
+		known := make(map[uuid.UUID]bool, len(projects))
+		for _, p := range projects {
+			known[p.ID] = true
+		}
+		for name, projectID := range *req.Body.ProjectMapping {
+			if !known[projectID] {
+				return api.ImportRulePack400JSONResponse{
+					Code:    "invalid_request",
+					Message: fmt.Sprintf("Project mapping for %q names an unknown project", name),
+				}, nil
+			}
+			mapping[name] = projectID
+		}
+	}
+
+	existingRules, err := h.rules.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := planRulePackImport(userID, req.Body.Pack, projects, existingRules, mapping)
+
+	result := api.RulePackImportResult{
+		RulesCreated:       len(plan.rules),
+		RulesSkipped:       plan.rulesSkipped,
+		FingerprintsMerged: len(plan.fingerprints),
+		UnmappedProjects:   plan.unmapped,
+		Conflicts:          plan.conflicts,
+	}
+
+	dryRun := req.Body.DryRun != nil && *req.Body.DryRun
+	skipUnmapped := req.Body.SkipUnmapped != nil && *req.Body.SkipUnmapped
+	if dryRun || (len(plan.unmapped) > 0 && !skipUnmapped) {
+		return api.ImportRulePack200JSONResponse(result), nil
+	}
+
+	for _, rule := range plan.rules {
+		if _, err := h.rules.Create(ctx, rule); err != nil {
+			return nil, err
+		}
+	}
+	for projectID, updates := range plan.fingerprints {
+		if _, err := h.projects.Update(ctx, userID, projectID, updates); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Applied = true
+	return api.ImportRulePack200JSONResponse(result), nil
+}
+
+// rulePackPlan is what importing a pack would change
+type rulePackPlan struct {
+	rules        []*store.ClassificationRule
+	fingerprints map[uuid.UUID]map[string]interface{} // project ID -> updates
+	unmapped     []string
+	conflicts    []api.RulePackConflict
+	rulesSkipped int
+}
+
+// planRulePackImport works out the rules to create and the fingerprint
+// updates for importing a pack, without touching storage. Pack projects
+// resolve through mapping, then by exact name.
+func planRulePackImport(userID uuid.UUID, pack api.RulePack, projects []*store.Project, existingRules []*store.ClassificationRule, mapping map[string]uuid.UUID) *rulePackPlan {
+	plan := &rulePackPlan{
+		fingerprints: make(map[uuid.UUID]map[string]interface{}),
+		unmapped:     []string{},
+		conflicts:    []api.RulePackConflict{},
+	}
+
+	projectsByID := make(map[uuid.UUID]*store.Project, len(projects))
+	projectsByName := make(map[string]*store.Project, len(projects))
+	for _, p := range projects {
+		projectsByID[p.ID] = p
+		projectsByName[p.Name] = p
+	}
+
+	// resolve reports the project a pack project name maps to, recording
+	// each unresolved name once
+	unresolved := make(map[string]bool)
+	resolve := func(name string) *store.Project {
+		if id, ok := mapping[name]; ok {
+			return projectsByID[id]
+		}
+		if p, ok := projectsByName[name]; ok {
+			return p
+		}
+		if !unresolved[name] {
+			unresolved[name] = true
+			plan.unmapped = append(plan.unmapped, name)
+			plan.conflicts = append(plan.conflicts, api.RulePackConflict{
+				Kind:        "unknown_project",
+				ProjectName: &name,
+				Message:     fmt.Sprintf("Project %q does not exist in this account and is not mapped", name),
+			})
+		}
+		return nil
+	}
+
+	existingByQuery := make(map[string]*store.ClassificationRule, len(existingRules))
+	for _, r := range existingRules {
+		existingByQuery[strings.TrimSpace(r.Query)] = r
+	}
+	seen := make(map[string]bool)
+
+	for _, rExport := range pack.Rules {
+		query := strings.TrimSpace(rExport.Query)
+
+		if _, err := classification.Parse(query); err != nil {
+			plan.conflicts = append(plan.conflicts, api.RulePackConflict{
+				Kind:    "invalid_query",
+				Query:   &rExport.Query,
+				Message: fmt.Sprintf("Invalid query: %v", err),
+			})
+			plan.rulesSkipped++
+			continue
+		}
+
+		isSkipRule := rExport.Skip != nil && *rExport.Skip
+		var project *store.Project
+		if !isSkipRule {
+			if rExport.ProjectName == nil || *rExport.ProjectName == "" {
+				plan.conflicts = append(plan.conflicts, api.RulePackConflict{
+					Kind:    "invalid_query",
+					Query:   &rExport.Query,
+					Message: "Rule has no project_name and is not a skip rule",
+				})
+				plan.rulesSkipped++
+				continue
+			}
+			project = resolve(*rExport.ProjectName)
+			if project == nil {
+				plan.rulesSkipped++
+				continue
+			}
+		}
+
+		if existing, ok := existingByQuery[query]; ok {
+			existingID := existing.ID
+			plan.conflicts = append(plan.conflicts, api.RulePackConflict{
+				Kind:           "duplicate_query",
+				Query:          &rExport.Query,
+				ExistingRuleId: &existingID,
+				Message:        "A rule with this query already exists",
+			})
+			plan.rulesSkipped++
+			continue
+		}
+		if seen[query] {
+			plan.conflicts = append(plan.conflicts, api.RulePackConflict{
+				Kind:    "duplicate_query",
+				Query:   &rExport.Query,
+				Message: "The pack contains this query more than once",
+			})
+			plan.rulesSkipped++
+			continue
+		}
+		seen[query] = true
+
+		rule := &store.ClassificationRule{
+			UserID:    userID,
+			Query:     query,
+			Weight:    1.0,
+			IsEnabled: true,
+		}
+		if rExport.Weight != nil {
+			rule.Weight = float64(*rExport.Weight)
+		}
+		if rExport.IsEnabled != nil {
+			rule.IsEnabled = *rExport.IsEnabled
+		}
+		if isSkipRule {
+			attended := false
+			rule.Attended = &attended
+		} else {
+			projectID := project.ID
+			rule.ProjectID = &projectID
+		}
+		plan.rules = append(plan.rules, rule)
+	}
+
+	// Merge onto copies so several pack projects mapped to one project
+	// accumulate
+	merged := make(map[uuid.UUID]*store.Project)
+	for _, fp := range pack.Fingerprints {
+		project := resolve(fp.ProjectName)
+		if project == nil {
+			continue
+		}
+		target, ok := merged[project.ID]
+		if !ok {
+			copied := *project
+			target = &copied
+			merged[project.ID] = target
+		}
+
+		updates := plan.fingerprints[project.ID]
+		if updates == nil {
+			updates = make(map[string]interface{})
+		}
+		mergeList := func(key string, current *[]string, add *[]string) {
+			if add == nil {
+				return
+			}
+			if out, changed := mergeFingerprintList(*current, *add); changed {
+				*current = out
+				updates[key] = out
+			}
+		}
+		mergeList("fingerprint_domains", &target.FingerprintDomains, fp.Domains)
+		mergeList("fingerprint_emails", &target.FingerprintEmails, fp.Emails)
+		mergeList("fingerprint_keywords", &target.FingerprintKeywords, fp.Keywords)
+		mergeList("fingerprint_exclude_domains", &target.FingerprintExcludeDomains, fp.ExcludeDomains)
+		mergeList("fingerprint_exclude_keywords", &target.FingerprintExcludeKeywords, fp.ExcludeKeywords)
+
+		if fp.Weights != nil {
+			weights := make(map[string]float64, len(target.FingerprintWeights)+len(*fp.Weights))
+			for k, w := range target.FingerprintWeights {
+				weights[k] = w
+			}
+			changed := false
+			for k, w := range *fp.Weights {
+				if _, ok := weights[k]; !ok {
+					weights[k] = float64(w)
+					changed = true
+				}
+			}
+			if changed {
+				target.FingerprintWeights = weights
+				updates["fingerprint_weights"] = weights
+			}
+		}
+
+		if len(updates) > 0 {
+			plan.fingerprints[project.ID] = updates
+		}
+	}
+
+	return plan
+}
+
+// mergeFingerprintList appends the entries of add missing from current,
+// compared case-insensitively, reporting whether any were added
+func mergeFingerprintList(current, add []string) ([]string, bool) {
+	have := make(map[string]bool, len(current))
+	for _, v := range current {
+		have[strings.ToLower(strings.TrimSpace(v))] = true
+	}
+
+	out := append([]string{}, current...)
+	for _, v := range add {
+		key := strings.ToLower(strings.TrimSpace(v))
+		if key == "" || have[key] {
+			continue
+		}
+		have[key] = true
+		out = append(out, strings.TrimSpace(v))
+	}
+	return out, len(out) > len(current)
+}
+
+// projectToPackFingerprint extracts a project's fingerprints for a pack,
+// reporting false when it has none
+func projectToPackFingerprint(p *store.Project) (api.RulePackFingerprint, bool) {
+	fp := api.RulePackFingerprint{ProjectName: p.Name}
+	has := false
+
+	if len(p.FingerprintDomains) > 0 {
+		fp.Domains = &p.FingerprintDomains
+		has = true
+	}
+	if len(p.FingerprintEmails) > 0 {
+		fp.Emails = &p.FingerprintEmails
+		has = true
+	}
+	if len(p.FingerprintKeywords) > 0 {
+		fp.Keywords = &p.FingerprintKeywords
+		has = true
+	}
+	if len(p.FingerprintExcludeDomains) > 0 {
+		fp.ExcludeDomains = &p.FingerprintExcludeDomains
+		has = true
+	}
+	if len(p.FingerprintExcludeKeywords) > 0 {
+		fp.ExcludeKeywords = &p.FingerprintExcludeKeywords
+		has = true
+	}
+	if len(p.FingerprintWeights) > 0 {
+		weights := fingerprintWeightsToAPI(p.FingerprintWeights)
+		fp.Weights = &weights
+		has = true
+	}
+
+	return fp, has
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestPlanRulePackImport(t *testing.T) {
+	userID := uuid.New()
+	acme := &store.Project{ID: uuid.New(), Name: "Acme", FingerprintDomains: []string{"acme.com"}}
+	internal := &store.Project{ID: uuid.New(), Name: "Internal"}
+	existing := &store.ClassificationRule{ID: uuid.New(), Query: "title:standup"}
+
+	acmeName, globexName := "Acme", "Globex"
+	skip := true
+	pack := api.RulePack{
+		FormatVersion: rulePackFormatVersion,
+		Name:          "consulting",
+		Rules: []api.RuleExport{
+			{Query: "domain:acme.com", ProjectName: &acmeName},
+			{Query: "title:standup", ProjectName: &acmeName},
+			{Query: "domain:globex.com", ProjectName: &globexName},
+			{Query: "title:lunch", Skip: &skip},
+			{Query: "title:lunch", Skip: &skip},
+			{Query: "title:(", Skip: &skip},
+		},
+		Fingerprints: []api.RulePackFingerprint{
+			{ProjectName: "Acme", Domains: &[]string{"ACME.com", "acme.io"}},
+			{ProjectName: "Globex", Domains: &[]string{"globex.com"}},
+		},
+	}
+	projects := []*store.Project{acme, internal}
+	rules := []*store.ClassificationRule{existing}
+
+	plan := planRulePackImport(userID, pack, projects, rules, nil)
+
+	if len(plan.rules) != 2 {
+		t.Fatalf("expected 2 rules to create, got %d", len(plan.rules))
+	}
+	if plan.rules[0].ProjectID == nil || *plan.rules[0].ProjectID != acme.ID {
+		t.Errorf("expected first rule to target Acme")
+	}
+	if plan.rules[1].Attended == nil || *plan.rules[1].Attended {
+		t.Errorf("expected second rule to be a skip rule")
+	}
+	if plan.rulesSkipped != 4 {
+		t.Errorf("expected 4 skipped rules, got %d", plan.rulesSkipped)
+	}
+	if !reflect.DeepEqual(plan.unmapped, []string{"Globex"}) {
+		t.Errorf("expected Globex unmapped, got %v", plan.unmapped)
+	}
+
+	kinds := map[string]int{}
+	for _, c := range plan.conflicts {
+		kinds[c.Kind]++
+		if c.Kind == "duplicate_query" && c.ExistingRuleId != nil && *c.ExistingRuleId != existing.ID {
+			t.Errorf("expected duplicate to name the existing rule")
+		}
+	}
+	if kinds["unknown_project"] != 1 || kinds["duplicate_query"] != 2 || kinds["invalid_query"] != 1 {
+		t.Errorf("unexpected conflicts: %v", kinds)
+	}
+
+	updates, ok := plan.fingerprints[acme.ID]
+	if !ok {
+		t.Fatalf("expected fingerprint updates for Acme")
+	}
+	if got := updates["fingerprint_domains"]; !reflect.DeepEqual(got, []string{"acme.com", "acme.io"}) {
+		t.Errorf("expected merged domains, got %v", got)
+	}
+
+	// Mapping the unknown project resolves it
+	plan = planRulePackImport(userID, pack, projects, rules, map[string]uuid.UUID{"Globex": internal.ID})
+	if len(plan.unmapped) != 0 {
+		t.Errorf("expected no unmapped projects, got %v", plan.unmapped)
+	}
+	if len(plan.rules) != 3 {
+		t.Errorf("expected 3 rules to create, got %d", len(plan.rules))
+	}
+	if _, ok := plan.fingerprints[internal.ID]; !ok {
+		t.Errorf("expected fingerprint updates for the mapped project")
+	}
+}
@@ -3,19 +3,34 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/michaelw/timesheet-app/service/internal/api"
 	"github.com/michaelw/timesheet-app/service/internal/classification"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 )
 
+// queryErrorDetails returns where a query failed to parse, for the details
+// of an invalid_query error, so UIs can underline the offending token
+func queryErrorDetails(err error) *map[string]interface{} {
+	var parseErr *classification.ParseError
+	if !errors.As(err, &parseErr) {
+		return nil
+	}
+	details := parseErr.Details()
+	return &details
+}
+
 // RulesHandler implements the classification rules endpoints
 type RulesHandler struct {
 	rules             *store.ClassificationRuleStore
 	projects          *store.ProjectStore
+	jobs              *store.ClassificationJobStore
 	classificationSvc *classification.Service
 }
 
@@ -23,11 +38,13 @@ type RulesHandler struct {
 func NewRulesHandler(
 	rules *store.ClassificationRuleStore,
 	projects *store.ProjectStore,
+	jobs *store.ClassificationJobStore,
 	classificationSvc *classification.Service,
 ) *RulesHandler {
 	return &RulesHandler{
 		rules:             rules,
 		projects:          projects,
+		jobs:              jobs,
 		classificationSvc: classificationSvc,
 	}
 }
@@ -37,14 +54,25 @@ func projectsToTargets(projects []*store.Project) []classification.Target {
 	targets := make([]classification.Target, len(projects))
 	for i, p := range projects {
 		attrs := make(map[string]any)
-		if len(p.FingerprintDomains) > 0 {
-			attrs["domains"] = p.FingerprintDomains
-		}
-		if len(p.FingerprintEmails) > 0 {
-			attrs["emails"] = p.FingerprintEmails
-		}
-		if len(p.FingerprintKeywords) > 0 {
-			attrs["keywords"] = p.FingerprintKeywords
+		if p.FingerprintsActive() {
+			if len(p.FingerprintDomains) > 0 {
+				attrs["domains"] = p.FingerprintDomains
+			}
+			if len(p.FingerprintEmails) > 0 {
+				attrs["emails"] = p.FingerprintEmails
+			}
+			if len(p.FingerprintKeywords) > 0 {
+				attrs["keywords"] = p.FingerprintKeywords
+			}
+			if len(p.FingerprintExcludeDomains) > 0 {
+				attrs["exclude_domains"] = p.FingerprintExcludeDomains
+			}
+			if len(p.FingerprintExcludeKeywords) > 0 {
+				attrs["exclude_keywords"] = p.FingerprintExcludeKeywords
+			}
+			if len(p.FingerprintWeights) > 0 {
+				attrs["fingerprint_weights"] = p.FingerprintWeights
+			}
 		}
 		targets[i] = classification.Target{
 			ID:         p.ID.String(),
@@ -64,12 +92,32 @@ func (h *RulesHandler) ListRules(ctx context.Context, req api.ListRulesRequestOb
 		}, nil
 	}
 
-	includeDisabled := false
+	opts := store.RuleListOptions{
+		ProjectID: req.Params.ProjectId,
+		Client:    req.Params.Client,
+	}
 	if req.Params.IncludeDisabled != nil {
-		includeDisabled = *req.Params.IncludeDisabled
+		opts.IncludeDisabled = *req.Params.IncludeDisabled
+	}
+	if req.Params.Sort != nil {
+		switch *req.Params.Sort {
+		case store.RuleSortWeight, store.RuleSortRecent, store.RuleSortProject:
+			opts.Sort = *req.Params.Sort
+		default:
+			return api.ListRules400JSONResponse{
+				Code:    "invalid_request",
+				Message: "sort must be one of weight, recent or project",
+			}, nil
+		}
+	}
+	if req.Params.Limit != nil && *req.Params.Limit > 0 && *req.Params.Limit <= 500 {
+		opts.Limit = *req.Params.Limit
+	}
+	if req.Params.Offset != nil && *req.Params.Offset > 0 {
+		opts.Offset = *req.Params.Offset
 	}
 
-	rules, err := h.rules.List(ctx, userID, includeDisabled)
+	rules, err := h.rules.Query(ctx, userID, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +152,7 @@ func (h *RulesHandler) CreateRule(ctx context.Context, req api.CreateRuleRequest
 		return api.CreateRule400JSONResponse{
 			Code:    "invalid_query",
 			Message: "Invalid query syntax: " + err.Error(),
+			Details: queryErrorDetails(err),
 		}, nil
 	}
 
@@ -131,6 +180,31 @@ func (h *RulesHandler) CreateRule(ctx context.Context, req api.CreateRuleRequest
 		projectID = &id
 	}
 
+	backfill := req.Body.ApplyToExisting != nil && *req.Body.ApplyToExisting
+	var backfillStart, backfillEnd *time.Time
+	if backfill {
+		if !isEnabled {
+			return api.CreateRule400JSONResponse{
+				Code:    "invalid_request",
+				Message: "apply_to_existing requires an enabled rule",
+			}, nil
+		}
+		if req.Body.ApplyStartDate != nil {
+			t := req.Body.ApplyStartDate.Time
+			backfillStart = &t
+		}
+		if req.Body.ApplyEndDate != nil {
+			t := req.Body.ApplyEndDate.Time
+			backfillEnd = &t
+		}
+		if backfillStart != nil && backfillEnd != nil && backfillEnd.Before(*backfillStart) {
+			return api.CreateRule400JSONResponse{
+				Code:    "invalid_request",
+				Message: "apply_end_date must not be before apply_start_date",
+			}, nil
+		}
+	}
+
 	rule := &store.ClassificationRule{
 		UserID:    userID,
 		Query:     req.Body.Query,
@@ -145,7 +219,36 @@ func (h *RulesHandler) CreateRule(ctx context.Context, req api.CreateRuleRequest
 		return nil, err
 	}
 
-	return api.CreateRule201JSONResponse(ruleToAPI(created)), nil
+	result := ruleToAPI(created)
+	if backfill {
+		job, err := h.startBackfill(ctx, userID, created, backfillStart, backfillEnd)
+		if err != nil {
+			return nil, err
+		}
+		apiJob := classificationJobToAPI(job)
+		result.BackfillJob = &apiJob
+	}
+
+	return api.CreateRule201JSONResponse(result), nil
+}
+
+// startBackfill queues a background job classifying the existing events a
+// new rule matches
+func (h *RulesHandler) startBackfill(ctx context.Context, userID uuid.UUID, rule *store.ClassificationRule, startDate, endDate *time.Time) (*store.ClassificationJob, error) {
+	projects, err := h.projects.List(ctx, userID, true) // Include archived
+	if err != nil {
+		return nil, err
+	}
+	targets := projectsToTargets(projects)
+
+	job, err := h.jobs.Create(ctx, userID, startDate, endDate, false, classification.DefaultApplyBatchSize, &rule.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	go runClassificationJob(h.jobs, h.classificationSvc, job, targets, rule.Query)
+
+	return job, nil
 }
 
 // GetRule returns a rule by ID
@@ -208,6 +311,7 @@ func (h *RulesHandler) UpdateRule(ctx context.Context, req api.UpdateRuleRequest
 			return api.UpdateRule400JSONResponse{
 				Code:    "invalid_query",
 				Message: "Invalid query syntax: " + err.Error(),
+				Details: queryErrorDetails(err),
 			}, nil
 		}
 		existing.Query = *req.Body.Query
@@ -244,7 +348,7 @@ func (h *RulesHandler) UpdateRule(ctx context.Context, req api.UpdateRuleRequest
 	return api.UpdateRule200JSONResponse(ruleToAPI(updated)), nil
 }
 
-// DeleteRule deletes a rule
+// DeleteRule moves a rule to the trash
 func (h *RulesHandler) DeleteRule(ctx context.Context, req api.DeleteRuleRequestObject) (api.DeleteRuleResponseObject, error) {
 	userID, ok := UserIDFromContext(ctx)
 	if !ok {
@@ -254,7 +358,7 @@ func (h *RulesHandler) DeleteRule(ctx context.Context, req api.DeleteRuleRequest
 		}, nil
 	}
 
-	err := h.rules.Delete(ctx, userID, req.Id)
+	err := h.rules.Trash(ctx, userID, req.Id)
 	if err != nil {
 		if errors.Is(err, store.ErrClassificationRuleNotFound) {
 			return api.DeleteRule404JSONResponse{
@@ -290,6 +394,7 @@ func (h *RulesHandler) PreviewRule(ctx context.Context, req api.PreviewRuleReque
 		return api.PreviewRule400JSONResponse{
 			Code:    "invalid_query",
 			Message: "Invalid query syntax: " + err.Error(),
+			Details: queryErrorDetails(err),
 		}, nil
 	}
 
@@ -399,9 +504,167 @@ func (h *RulesHandler) ApplyRules(ctx context.Context, req api.ApplyRulesRequest
 	return api.ApplyRules200JSONResponse{
 		Classified: classified,
 		Skipped:    result.Skipped,
+		ActionId:   result.ActionID,
 	}, nil
 }
 
+// maxApplyBatchSize bounds the batch size a client may request for an async run
+const maxApplyBatchSize = 5000
+
+// ApplyRulesAsync starts an apply-rules run as a background job that
+// processes events in batches and records its progress
+func (h *RulesHandler) ApplyRulesAsync(ctx context.Context, req api.ApplyRulesAsyncRequestObject) (api.ApplyRulesAsyncResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ApplyRulesAsync401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var startDate, endDate *time.Time
+	dryRun := false
+	batchSize := classification.DefaultApplyBatchSize
+	if req.Body != nil {
+		if req.Body.StartDate != nil {
+			t := req.Body.StartDate.Time
+			startDate = &t
+		}
+		if req.Body.EndDate != nil {
+			t := req.Body.EndDate.Time
+			endDate = &t
+		}
+		if req.Body.DryRun != nil {
+			dryRun = *req.Body.DryRun
+		}
+		if req.Body.BatchSize != nil {
+			batchSize = *req.Body.BatchSize
+		}
+	}
+	if batchSize < 1 || batchSize > maxApplyBatchSize {
+		return api.ApplyRulesAsync400JSONResponse{
+			Code:    "invalid_request",
+			Message: fmt.Sprintf("batch_size must be between 1 and %d", maxApplyBatchSize),
+		}, nil
+	}
+
+	projects, err := h.projects.List(ctx, userID, true) // Include archived
+	if err != nil {
+		return nil, err
+	}
+	targets := projectsToTargets(projects)
+
+	job, err := h.jobs.Create(ctx, userID, startDate, endDate, dryRun, batchSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	go runClassificationJob(h.jobs, h.classificationSvc, job, targets, "")
+
+	return api.ApplyRulesAsync202JSONResponse(classificationJobToAPI(job)), nil
+}
+
+// runClassificationJob runs an async apply job to completion, recording
+// progress after every batch. A non-empty query limits the run to the events
+// it matches. It outlives the request that started it.
+func runClassificationJob(jobs *store.ClassificationJobStore, classificationSvc *classification.Service, job *store.ClassificationJob, targets []classification.Target, query string) {
+	ctx := context.Background()
+
+	progress := func(p classification.ApplyProgress) error {
+		return jobs.UpdateProgress(ctx, job.ID, store.ClassificationJobProgress{
+			TotalEvents:      p.Total,
+			ProcessedEvents:  p.Processed,
+			MatchedCount:     p.Matched,
+			ClassifiedCount:  p.Classified,
+			SkipAppliedCount: p.SkipApplied,
+			SkippedCount:     p.Skipped,
+			ChangedCount:     p.Changed,
+		})
+	}
+
+	if err := jobs.Start(ctx, job.ID); err != nil {
+		log.Printf("[CLASSIFY] job %s failed to start: %v", job.ID, err)
+		return
+	}
+
+	result, err := classificationSvc.ApplyRulesInBatches(ctx, job.UserID, targets, job.StartDate, job.EndDate, job.DryRun, classification.ApplyOptions{
+		BatchSize: job.BatchSize,
+		CountOnly: true,
+		OnBatch:   progress,
+		Query:     query,
+	})
+	if result != nil {
+		if perr := progress(result.Progress); perr != nil && err == nil {
+			err = perr
+		}
+	}
+	if err != nil {
+		log.Printf("[CLASSIFY] job %s failed: %v", job.ID, err)
+		if ferr := jobs.Fail(ctx, job.ID, err.Error()); ferr != nil {
+			log.Printf("[CLASSIFY] job %s: failed to record failure: %v", job.ID, ferr)
+		}
+		return
+	}
+
+	if err := jobs.Complete(ctx, job.ID, result.ActionID); err != nil {
+		log.Printf("[CLASSIFY] job %s: failed to record completion: %v", job.ID, err)
+	}
+}
+
+// GetClassificationJob returns the status and progress of an async apply job
+func (h *RulesHandler) GetClassificationJob(ctx context.Context, req api.GetClassificationJobRequestObject) (api.GetClassificationJobResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetClassificationJob401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	job, err := h.jobs.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrClassificationJobNotFound) {
+			return api.GetClassificationJob404JSONResponse{
+				Code:    "not_found",
+				Message: "Classification job not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.GetClassificationJob200JSONResponse(classificationJobToAPI(job)), nil
+}
+
+// classificationJobToAPI converts a store.ClassificationJob to an api.ClassificationJob
+func classificationJobToAPI(j *store.ClassificationJob) api.ClassificationJob {
+	job := api.ClassificationJob{
+		Id:              j.ID,
+		Status:          api.ClassificationJobStatus(j.Status),
+		DryRun:          j.DryRun,
+		BatchSize:       j.BatchSize,
+		RuleId:          j.RuleID,
+		TotalEvents:     j.TotalEvents,
+		ProcessedEvents: j.ProcessedEvents,
+		Matched:         j.MatchedCount,
+		Classified:      j.ClassifiedCount,
+		SkipApplied:     j.SkipAppliedCount,
+		Skipped:         j.SkippedCount,
+		Changed:         j.ChangedCount,
+		ActionId:        j.ActionID,
+		Error:           j.ErrorMessage,
+		CreatedAt:       j.CreatedAt,
+		StartedAt:       j.StartedAt,
+		CompletedAt:     j.CompletedAt,
+	}
+	if j.StartDate != nil {
+		job.StartDate = &openapi_types.Date{Time: *j.StartDate}
+	}
+	if j.EndDate != nil {
+		job.EndDate = &openapi_types.Date{Time: *j.EndDate}
+	}
+	return job
+}
+
 // ruleToAPI converts a store.ClassificationRule to an api.ClassificationRule
 func ruleToAPI(r *store.ClassificationRule) api.ClassificationRule {
 	rule := api.ClassificationRule{
@@ -412,6 +675,7 @@ func ruleToAPI(r *store.ClassificationRule) api.ClassificationRule {
 		IsEnabled: r.IsEnabled,
 		CreatedAt: r.CreatedAt,
 		UpdatedAt: &r.UpdatedAt,
+		DeletedAt: r.DeletedAt,
 	}
 
 	if r.ProjectID != nil {
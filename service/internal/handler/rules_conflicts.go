@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+)
+
+// ruleConflictDefaultDays is the range checked for rule conflicts when the
+// request gives no start date
+const ruleConflictDefaultDays = 90
+
+// GetRuleConflicts reports pairs of enabled rules that compete for the
+// same events
+func (h *RulesHandler) GetRuleConflicts(ctx context.Context, req api.GetRuleConflictsRequestObject) (api.GetRuleConflictsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetRuleConflicts401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	now := time.Now().UTC()
+	endDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if req.Params.EndDate != nil {
+		endDate = req.Params.EndDate.Time
+	}
+	startDate := endDate.AddDate(0, 0, -ruleConflictDefaultDays)
+	if req.Params.StartDate != nil {
+		startDate = req.Params.StartDate.Time
+	}
+	if endDate.Before(startDate) {
+		return api.GetRuleConflicts400JSONResponse{
+			Code:    "invalid_request",
+			Message: "end_date must not be before start_date",
+		}, nil
+	}
+
+	minOverlap := classification.DefaultConflictMinOverlap
+	if req.Params.MinOverlap != nil {
+		minOverlap = float64(*req.Params.MinOverlap)
+		if minOverlap < 0 || minOverlap > 1 {
+			return api.GetRuleConflicts400JSONResponse{
+				Code:    "invalid_request",
+				Message: "min_overlap must be between 0 and 1",
+			}, nil
+		}
+	}
+
+	conflicts, evaluated, err := h.classificationSvc.RuleConflicts(ctx, userID, startDate, endDate, minOverlap)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := api.GetRuleConflicts200JSONResponse{
+		StartDate:       openapi_types.Date{Time: startDate},
+		EndDate:         openapi_types.Date{Time: endDate},
+		EventsEvaluated: evaluated,
+		Conflicts:       make([]api.RulePairConflict, len(conflicts)),
+	}
+	for i, c := range conflicts {
+		out := api.RulePairConflict{
+			RuleA:        conflictingRuleToAPI(c.A),
+			RuleB:        conflictingRuleToAPI(c.B),
+			SharedEvents: c.Shared,
+			Overlap:      float32(c.Overlap),
+			Tie:          c.Tie,
+			Samples:      make([]api.RuleConflictSample, len(c.Samples)),
+		}
+		for j, sample := range c.Samples {
+			out.Samples[j] = api.RuleConflictSample{
+				EventId:   sample.EventID,
+				Title:     sample.Title,
+				StartTime: sample.StartTime,
+				ProjectId: sample.ProjectID,
+			}
+			if sample.Source != nil {
+				source := string(*sample.Source)
+				out.Samples[j].ClassificationSource = &source
+			}
+		}
+		if s := c.Suggestion; s != nil {
+			out.Suggestion = &api.RuleWeightSuggestion{
+				RuleId:          s.RuleID,
+				CurrentWeight:   float32(s.CurrentWeight),
+				SuggestedWeight: float32(s.SuggestedWeight),
+				Reason:          s.Reason,
+			}
+		}
+		resp.Conflicts[i] = out
+	}
+	return resp, nil
+}
+
+func conflictingRuleToAPI(r classification.ConflictingRule) api.ConflictingRule {
+	return api.ConflictingRule{
+		RuleId:           r.RuleID,
+		Query:            r.Query,
+		ProjectId:        r.ProjectID,
+		Weight:           float32(r.Weight),
+		MatchedEvents:    r.Matches,
+		ClassifiedShared: r.Classified,
+		ConfirmedShared:  r.Confirmed,
+	}
+}
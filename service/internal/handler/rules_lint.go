@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+)
+
+// LintRule checks a query for problems beyond syntax and for overlap with
+// the user's enabled rules
+func (h *RulesHandler) LintRule(ctx context.Context, req api.LintRuleRequestObject) (api.LintRuleResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.LintRule401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil || req.Body.Query == "" {
+		return api.LintRule400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Query is required",
+		}, nil
+	}
+
+	rule := classification.Rule{Query: req.Body.Query}
+	if req.Body.RuleId != nil {
+		rule.ID = req.Body.RuleId.String()
+	}
+	switch {
+	case req.Body.ProjectId != nil:
+		rule.TargetID = req.Body.ProjectId.String()
+	case req.Body.Attended != nil && *req.Body.Attended:
+		rule.TargetID = "attended:true"
+	case req.Body.Attended != nil:
+		rule.TargetID = classification.TargetDNA
+	}
+
+	issues, err := h.classificationSvc.LintRule(ctx, userID, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := api.LintRule200JSONResponse{
+		Query:  req.Body.Query,
+		Valid:  true,
+		Issues: make([]api.RuleLintIssue, len(issues)),
+	}
+	for i, issue := range issues {
+		if issue.Kind == classification.LintSyntaxError || issue.Kind == classification.LintUnknownProperty {
+			resp.Valid = false
+		}
+		out := api.RuleLintIssue{
+			Kind:     api.RuleLintIssueKind(issue.Kind),
+			Severity: api.RuleLintIssueSeverity(issue.Severity),
+			Message:  issue.Message,
+		}
+		if issue.Position >= 0 {
+			out.Position = &issue.Position
+			out.Length = &issue.Length
+		}
+		if issue.Suggestion != "" {
+			out.Suggestion = &issue.Suggestion
+		}
+		if id, err := uuid.Parse(issue.RuleID); err == nil {
+			out.RuleId = &id
+			out.RuleQuery = &issue.RuleQuery
+		}
+		resp.Issues[i] = out
+	}
+	return resp, nil
+}
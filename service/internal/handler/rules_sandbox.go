@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+)
+
+// maxRuleTestEvents bounds the synthetic events in one rule test
+const maxRuleTestEvents = 1000
+
+// TestRule evaluates a query against synthetic events supplied in the
+// request, tracing each condition. It touches no stored data.
+func (h *RulesHandler) TestRule(ctx context.Context, req api.TestRuleRequestObject) (api.TestRuleResponseObject, error) {
+	if _, ok := UserIDFromContext(ctx); !ok {
+		return api.TestRule401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil || req.Body.Query == "" {
+		return api.TestRule400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Query is required",
+		}, nil
+	}
+	if len(req.Body.Events) > maxRuleTestEvents {
+		return api.TestRule400JSONResponse{
+			Code:    "invalid_request",
+			Message: fmt.Sprintf("At most %d events can be tested at once", maxRuleTestEvents),
+		}, nil
+	}
+
+	ast, err := classification.Parse(req.Body.Query)
+	if err != nil {
+		return api.TestRule400JSONResponse{
+			Code:    "invalid_query",
+			Message: "Invalid query syntax: " + err.Error(),
+			Details: queryErrorDetails(err),
+		}, nil
+	}
+
+	results := make([]api.RuleTestResult, len(req.Body.Events))
+	matchedCount := 0
+	for i, e := range req.Body.Events {
+		matched, trace := classification.Trace(ast, ruleTestEventProperties(e))
+		if matched {
+			matchedCount++
+		}
+
+		id := strconv.Itoa(i)
+		if e.Id != nil {
+			id = *e.Id
+		}
+		results[i] = api.RuleTestResult{
+			Id:      id,
+			Matched: matched,
+		}
+		results[i].Trace = make([]struct {
+			Condition string `json:"condition"`
+			Matched   bool   `json:"matched"`
+		}, len(trace))
+		for j, c := range trace {
+			results[i].Trace[j].Condition = c.Condition
+			results[i].Trace[j].Matched = c.Matched
+		}
+	}
+
+	return api.TestRule200JSONResponse{
+		Query:        req.Body.Query,
+		MatchedCount: matchedCount,
+		Results:      results,
+	}, nil
+}
+
+// ruleTestEventProperties converts a synthetic event to the properties
+// rules are evaluated against
+func ruleTestEventProperties(e api.RuleTestEvent) *classification.EventProperties {
+	props := &classification.EventProperties{}
+	if e.Title != nil {
+		props.Title = *e.Title
+	}
+	if e.Description != nil {
+		props.Description = *e.Description
+	}
+	if e.Attendees != nil {
+		props.Attendees = *e.Attendees
+	}
+	if e.StartTime != nil {
+		props.StartTime = *e.StartTime
+	}
+	if e.EndTime != nil {
+		props.EndTime = *e.EndTime
+	}
+	if e.ResponseStatus != nil {
+		props.ResponseStatus = *e.ResponseStatus
+	}
+	if e.Transparency != nil {
+		props.Transparency = *e.Transparency
+	}
+	if e.IsRecurring != nil {
+		props.IsRecurring = *e.IsRecurring
+	}
+	if e.CalendarName != nil {
+		props.CalendarName = *e.CalendarName
+	}
+	if e.Tags != nil {
+		props.Tags = *e.Tags
+	}
+	return props
+}
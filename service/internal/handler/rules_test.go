@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestRulesHandler_ApplyRulesAsyncValidation(t *testing.T) {
+	h := NewRulesHandler(nil, nil, nil, nil)
+
+	resp, err := h.ApplyRulesAsync(context.Background(), api.ApplyRulesAsyncRequestObject{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := resp.(api.ApplyRulesAsync401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+
+	for _, size := range []int{0, maxApplyBatchSize + 1} {
+		resp, err := h.ApplyRulesAsync(authedContext(uuid.New()), api.ApplyRulesAsyncRequestObject{
+			Body: &api.ApplyRulesAsyncRequest{BatchSize: &size},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := resp.(api.ApplyRulesAsync400JSONResponse); !ok {
+			t.Errorf("batch_size %d: expected 400, got %T", size, resp)
+		}
+	}
+}
+
+func TestRulesHandler_CreateRuleBackfillValidation(t *testing.T) {
+	h := NewRulesHandler(nil, nil, nil, nil)
+	ctx := authedContext(uuid.New())
+	projectID := uuid.New()
+	yes, no := true, false
+	start := openapi_types.Date{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}
+	end := openapi_types.Date{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name string
+		body api.RuleCreate
+	}{
+		{"disabled rule", api.RuleCreate{Query: "title:standup", ProjectId: &projectID, IsEnabled: &no, ApplyToExisting: &yes}},
+		{"reversed range", api.RuleCreate{Query: "title:standup", ProjectId: &projectID, ApplyToExisting: &yes, ApplyStartDate: &start, ApplyEndDate: &end}},
+	}
+	for _, tt := range tests {
+		body := tt.body
+		resp, err := h.CreateRule(ctx, api.CreateRuleRequestObject{Body: &body})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if _, ok := resp.(api.CreateRule400JSONResponse); !ok {
+			t.Errorf("%s: expected 400, got %T", tt.name, resp)
+		}
+	}
+}
+
+func TestRulesHandler_TestRule(t *testing.T) {
+	h := NewRulesHandler(nil, nil, nil, nil)
+	ctx := authedContext(uuid.New())
+
+	label := "acme-sync"
+	title := "Weekly sync"
+	attendees := []string{"alice@acme.com"}
+	standup := "Standup"
+	resp, err := h.TestRule(ctx, api.TestRuleRequestObject{Body: &api.RuleTestRequest{
+		Query: "title:sync domain:acme.com",
+		Events: []api.RuleTestEvent{
+			{Id: &label, Title: &title, Attendees: &attendees},
+			{Title: &standup, Attendees: &attendees},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result, ok := resp.(api.TestRule200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200, got %T", resp)
+	}
+	if result.MatchedCount != 1 {
+		t.Errorf("expected 1 match, got %d", result.MatchedCount)
+	}
+	if result.Results[0].Id != "acme-sync" || !result.Results[0].Matched {
+		t.Errorf("expected the labelled event to match, got %+v", result.Results[0])
+	}
+	second := result.Results[1]
+	if second.Id != "1" || second.Matched {
+		t.Errorf("expected event 1 not to match, got %+v", second)
+	}
+	if len(second.Trace) != 2 || second.Trace[0].Matched || !second.Trace[1].Matched {
+		t.Errorf("expected title to fail and domain to match, got %+v", second.Trace)
+	}
+
+	resp, _ = h.TestRule(ctx, api.TestRuleRequestObject{Body: &api.RuleTestRequest{Query: "title:(", Events: []api.RuleTestEvent{}}})
+	if r, ok := resp.(api.TestRule400JSONResponse); !ok || r.Code != "invalid_query" {
+		t.Errorf("expected invalid_query, got %+v", resp)
+	}
+
+	resp, _ = h.TestRule(ctx, api.TestRuleRequestObject{Body: &api.RuleTestRequest{Query: "titel:standup", Events: []api.RuleTestEvent{}}})
+	r, ok := resp.(api.TestRule400JSONResponse)
+	if !ok || r.Details == nil {
+		t.Fatalf("expected invalid_query with details, got %+v", resp)
+	}
+	if d := *r.Details; d["position"] != 0 || d["token"] != "titel" || d["suggestion"] != "title" {
+		t.Errorf("expected the unknown property located with a suggestion, got %v", d)
+	}
+}
+
+func TestRulesHandler_LintRuleValidation(t *testing.T) {
+	h := NewRulesHandler(nil, nil, nil, nil)
+
+	resp, _ := h.LintRule(context.Background(), api.LintRuleRequestObject{Body: &api.RuleLintRequest{Query: "title:x"}})
+	if _, ok := resp.(api.LintRule401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+
+	resp, _ = h.LintRule(authedContext(uuid.New()), api.LintRuleRequestObject{Body: &api.RuleLintRequest{}})
+	if _, ok := resp.(api.LintRule400JSONResponse); !ok {
+		t.Errorf("expected 400 without a query, got %T", resp)
+	}
+}
+
+func TestRulesHandler_GetRuleConflictsValidation(t *testing.T) {
+	h := NewRulesHandler(nil, nil, nil, nil)
+	ctx := authedContext(uuid.New())
+
+	start := openapi_types.Date{Time: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}
+	end := openapi_types.Date{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)}
+	overlap := float32(1.5)
+	for name, params := range map[string]api.GetRuleConflictsParams{
+		"reversed range":  {StartDate: &start, EndDate: &end},
+		"overlap above 1": {MinOverlap: &overlap},
+	} {
+		resp, err := h.GetRuleConflicts(ctx, api.GetRuleConflictsRequestObject{Params: params})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if _, ok := resp.(api.GetRuleConflicts400JSONResponse); !ok {
+			t.Errorf("%s: expected 400, got %T", name, resp)
+		}
+	}
+}
@@ -0,0 +1,514 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// SCIMBasePath is where the SCIM 2.0 endpoints are mounted
+const SCIMBasePath = "/scim/v2"
+
+// SCIM schema URNs (RFC 7643, RFC 7644)
+const (
+	scimUserSchema          = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimListSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema         = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimServiceConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// scimMaxPageSize caps the count parameter of user listings
+const scimMaxPageSize = 100
+
+// scimFilterPattern matches the only filters identity providers send when
+// looking up a user before provisioning: userName or externalId equality
+var scimFilterPattern = regexp.MustCompile(`(?i)^\s*(userName|externalId)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+type scimOrgKey struct{}
+
+// SCIMHandler serves SCIM 2.0 user provisioning for organizations. Each
+// organization's identity provider authenticates with its SCIM token and
+// only sees that organization's members. Users are the accounts of
+// members; deleting one removes the membership but keeps the account.
+type SCIMHandler struct {
+	orgs  *store.OrganizationStore
+	users *store.UserStore
+}
+
+// NewSCIMHandler creates a new SCIM handler
+func NewSCIMHandler(orgs *store.OrganizationStore, users *store.UserStore) *SCIMHandler {
+	return &SCIMHandler{orgs: orgs, users: users}
+}
+
+// Routes returns the SCIM router, to be mounted at SCIMBasePath
+func (h *SCIMHandler) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Use(h.requireToken)
+	r.Get("/ServiceProviderConfig", h.ServiceProviderConfig)
+	r.Get("/Users", h.ListUsers)
+	r.Post("/Users", h.CreateUser)
+	r.Get("/Users/{id}", h.GetUser)
+	r.Put("/Users/{id}", h.ReplaceUser)
+	r.Patch("/Users/{id}", h.PatchUser)
+	r.Delete("/Users/{id}", h.DeleteUser)
+	return r
+}
+
+func (h *SCIMHandler) requireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || !strings.HasPrefix(token, "scim_") {
+			writeSCIMError(w, http.StatusUnauthorized, "", "SCIM token required")
+			return
+		}
+		org, err := h.orgs.ValidateSCIMToken(r.Context(), token)
+		if err != nil {
+			if errors.Is(err, store.ErrInvalidSCIMToken) {
+				writeSCIMError(w, http.StatusUnauthorized, "", "Invalid SCIM token")
+				return
+			}
+			writeSCIMInternalError(w, err)
+			return
+		}
+		ctx := database.WithScope(r.Context(), database.Scope{OrganizationID: org.ID})
+		next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, scimOrgKey{}, org)))
+	})
+}
+
+func scimOrg(r *http.Request) *store.Organization {
+	return r.Context().Value(scimOrgKey{}).(*store.Organization)
+}
+
+// scimUser is the SCIM representation of an organization member
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	ExternalID  *string     `json:"externalId,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *scimName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []scimEmail `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Meta        *scimMeta   `json:"meta,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// displayName picks the user's name from whichever attributes the identity
+// provider filled in
+func (u *scimUser) displayName() string {
+	if u.Name != nil {
+		if name := strings.TrimSpace(u.Name.Formatted); name != "" {
+			return name
+		}
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return strings.TrimSpace(u.DisplayName)
+}
+
+func memberToSCIM(m *store.OrganizationMember) scimUser {
+	active := m.Active()
+	return scimUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          m.UserID.String(),
+		ExternalID:  m.ExternalID,
+		UserName:    m.Email,
+		Name:        &scimName{Formatted: m.Name},
+		DisplayName: m.Name,
+		Emails:      []scimEmail{{Value: m.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      m.CreatedAt,
+			LastModified: m.UpdatedAt,
+			Location:     SCIMBasePath + "/Users/" + m.UserID.String(),
+		},
+	}
+}
+
+// ServiceProviderConfig describes the supported SCIM features
+func (h *SCIMHandler) ServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	unsupported := map[string]bool{"supported": false}
+	writeSCIMJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{scimServiceConfigSchema},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": scimMaxPageSize},
+		"changePassword": unsupported,
+		"sort":           unsupported,
+		"etag":           unsupported,
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "The organization's SCIM token",
+			"primary":     true,
+		}},
+	})
+}
+
+// ListUsers lists the organization's members, optionally filtered by
+// userName or externalId
+func (h *SCIMHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	org := scimOrg(r)
+	q := r.URL.Query()
+
+	var filterAttr, filterValue string
+	if filter := q.Get("filter"); filter != "" {
+		match := scimFilterPattern.FindStringSubmatch(filter)
+		if match == nil {
+			writeSCIMError(w, http.StatusBadRequest, "invalidFilter", "Only userName eq and externalId eq filters are supported")
+			return
+		}
+		filterAttr = strings.ToLower(match[1])
+		filterValue = strings.ReplaceAll(match[2], `\"`, `"`)
+	}
+	startIndex, count := 1, scimMaxPageSize
+	if v := q.Get("startIndex"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 1 {
+			startIndex = n
+		}
+	}
+	if v := q.Get("count"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 && n < scimMaxPageSize {
+			count = n
+		}
+	}
+
+	members, err := h.orgs.ListMembers(r.Context(), org.ID)
+	if err != nil {
+		writeSCIMInternalError(w, err)
+		return
+	}
+	var matched []*store.OrganizationMember
+	for _, m := range members {
+		switch filterAttr {
+		case "username":
+			if !strings.EqualFold(m.Email, filterValue) {
+				continue
+			}
+		case "externalid":
+			if m.ExternalID == nil || *m.ExternalID != filterValue {
+				continue
+			}
+		}
+		matched = append(matched, m)
+	}
+
+	resources := []scimUser{}
+	for i := startIndex - 1; i < len(matched) && len(resources) < count; i++ {
+		resources = append(resources, memberToSCIM(matched[i]))
+	}
+	writeSCIMJSON(w, http.StatusOK, map[string]any{
+		"schemas":      []string{scimListSchema},
+		"totalResults": len(matched),
+		"startIndex":   startIndex,
+		"itemsPerPage": len(resources),
+		"Resources":    resources,
+	})
+}
+
+// GetUser returns one member
+func (h *SCIMHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	m, ok := h.member(w, r)
+	if !ok {
+		return
+	}
+	writeSCIMJSON(w, http.StatusOK, memberToSCIM(m))
+}
+
+// CreateUser provisions a member. An existing account with the same email
+// joins the organization; otherwise an account is created. The email must
+// be on one of the organization's verified domains.
+func (h *SCIMHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	org := scimOrg(r)
+	var body scimUser
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "Invalid JSON body")
+		return
+	}
+
+	email := strings.ToLower(strings.TrimSpace(body.UserName))
+	if email == "" {
+		writeSCIMError(w, http.StatusBadRequest, "invalidValue", "userName is required")
+		return
+	}
+	if !domainVerified(org, emailDomain(email)) {
+		writeSCIMError(w, http.StatusBadRequest, "invalidValue",
+			fmt.Sprintf("%s is not on a verified domain of the organization", email))
+		return
+	}
+	active := body.Active == nil || *body.Active
+
+	ctx := r.Context()
+	user, err := h.users.GetByEmail(ctx, email)
+	if errors.Is(err, store.ErrUserNotFound) {
+		name := body.displayName()
+		if name == "" {
+			name = email[:strings.Index(email, "@")]
+		}
+		user, err = h.users.CreateExternal(ctx, email, name)
+	}
+	if err != nil {
+		writeSCIMInternalError(w, err)
+		return
+	}
+
+	m, err := h.orgs.AddMember(ctx, org.ID, user.ID, store.OrganizationRoleMember, body.ExternalID, active)
+	if err != nil {
+		if errors.Is(err, store.ErrAlreadyOrganizationMember) || errors.Is(err, store.ErrExternalIDTaken) {
+			writeSCIMError(w, http.StatusConflict, "uniqueness", err.Error())
+			return
+		}
+		writeSCIMInternalError(w, err)
+		return
+	}
+	w.Header().Set("Location", SCIMBasePath+"/Users/"+m.UserID.String())
+	writeSCIMJSON(w, http.StatusCreated, memberToSCIM(m))
+}
+
+// ReplaceUser updates a member from a full user resource. The userName
+// can't change, since it is the account's email.
+func (h *SCIMHandler) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	m, ok := h.member(w, r)
+	if !ok {
+		return
+	}
+	var body scimUser
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "Invalid JSON body")
+		return
+	}
+	if body.UserName != "" && !strings.EqualFold(strings.TrimSpace(body.UserName), m.Email) {
+		writeSCIMError(w, http.StatusBadRequest, "mutability", "userName cannot be changed")
+		return
+	}
+
+	active := body.Active == nil || *body.Active
+	update := store.OrganizationMemberUpdate{ExternalID: body.ExternalID, Active: &active}
+	h.applyUpdate(w, r, m, update, body.displayName())
+}
+
+// scimPatchOp is one operation of a PATCH request (RFC 7644 section 3.5.2)
+type scimPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// PatchUser applies replace and add operations to active, externalId and
+// the name. Identity providers deactivate users with a patch of active.
+func (h *SCIMHandler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	m, ok := h.member(w, r)
+	if !ok {
+		return
+	}
+	var body struct {
+		Schemas    []string      `json:"schemas"`
+		Operations []scimPatchOp `json:"Operations"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidSyntax", "Invalid JSON body")
+		return
+	}
+
+	update, name, err := parseSCIMPatch(body.Operations)
+	if err != nil {
+		writeSCIMError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+	h.applyUpdate(w, r, m, update, name)
+}
+
+// parseSCIMPatch turns patch operations into a membership update and a new
+// name. Operations without a path carry attributes in a value object.
+func parseSCIMPatch(ops []scimPatchOp) (store.OrganizationMemberUpdate, string, error) {
+	var update store.OrganizationMemberUpdate
+	var name string
+
+	set := func(path string, value json.RawMessage) error {
+		switch strings.ToLower(path) {
+		case "active":
+			active, err := scimBool(value)
+			if err != nil {
+				return err
+			}
+			update.Active = &active
+		case "externalid":
+			var id string
+			if err := json.Unmarshal(value, &id); err != nil {
+				return fmt.Errorf("externalId must be a string")
+			}
+			update.ExternalID = &id
+		case "displayname", "name.formatted":
+			if err := json.Unmarshal(value, &name); err != nil {
+				return fmt.Errorf("%s must be a string", path)
+			}
+		case "name":
+			var n scimName
+			if err := json.Unmarshal(value, &n); err != nil {
+				return fmt.Errorf("name must be an object")
+			}
+			name = (&scimUser{Name: &n}).displayName()
+		case "username", "emails", `emails[type eq "work"].value`:
+			return fmt.Errorf("%s cannot be changed", path)
+		default:
+			// Attributes the service doesn't store are ignored
+		}
+		return nil
+	}
+
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "replace", "add":
+		default:
+			return update, "", fmt.Errorf("unsupported patch op %q", op.Op)
+		}
+		if op.Path != "" {
+			if err := set(op.Path, op.Value); err != nil {
+				return update, "", err
+			}
+			continue
+		}
+		var attrs map[string]json.RawMessage
+		if err := json.Unmarshal(op.Value, &attrs); err != nil {
+			return update, "", fmt.Errorf("patch value without a path must be an object")
+		}
+		for path, value := range attrs {
+			if err := set(path, value); err != nil {
+				return update, "", err
+			}
+		}
+	}
+	return update, strings.TrimSpace(name), nil
+}
+
+// scimBool reads a boolean that some identity providers send as a string
+func scimBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("active must be a boolean")
+}
+
+// DeleteUser removes the member from the organization
+func (h *SCIMHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	m, ok := h.member(w, r)
+	if !ok {
+		return
+	}
+	if err := h.orgs.RemoveMember(r.Context(), m.OrganizationID, m.UserID); err != nil {
+		writeSCIMInternalError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SCIMHandler) applyUpdate(w http.ResponseWriter, r *http.Request, m *store.OrganizationMember, update store.OrganizationMemberUpdate, name string) {
+	ctx := r.Context()
+	if name != "" && name != m.Name {
+		if err := h.users.UpdateName(ctx, m.UserID, name); err != nil {
+			writeSCIMInternalError(w, err)
+			return
+		}
+	}
+	updated, err := h.orgs.UpdateMember(ctx, m.OrganizationID, m.UserID, update)
+	if err != nil {
+		if errors.Is(err, store.ErrExternalIDTaken) {
+			writeSCIMError(w, http.StatusConflict, "uniqueness", err.Error())
+			return
+		}
+		writeSCIMInternalError(w, err)
+		return
+	}
+	writeSCIMJSON(w, http.StatusOK, memberToSCIM(updated))
+}
+
+// member loads the member named by the id path parameter, writing a 404 if
+// there is none in the token's organization
+func (h *SCIMHandler) member(w http.ResponseWriter, r *http.Request) (*store.OrganizationMember, bool) {
+	userID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeSCIMError(w, http.StatusNotFound, "", "User not found")
+		return nil, false
+	}
+	m, err := h.orgs.GetMember(r.Context(), scimOrg(r).ID, userID)
+	if err != nil {
+		if errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			writeSCIMError(w, http.StatusNotFound, "", "User not found")
+			return nil, false
+		}
+		writeSCIMInternalError(w, err)
+		return nil, false
+	}
+	return m, true
+}
+
+func domainVerified(org *store.Organization, domain string) bool {
+	for _, d := range org.Domains {
+		if d.Domain == domain && d.VerifiedAt != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func writeSCIMJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/scim+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeSCIMInternalError(w http.ResponseWriter, err error) {
+	log.Printf("SCIM request failed: %v", err)
+	writeSCIMError(w, http.StatusInternalServerError, "", "Internal error")
+}
+
+func writeSCIMError(w http.ResponseWriter, status int, scimType, detail string) {
+	body := map[string]any{
+		"schemas": []string{scimErrorSchema},
+		"status":  strconv.Itoa(status),
+		"detail":  detail,
+	}
+	if scimType != "" {
+		body["scimType"] = scimType
+	}
+	writeSCIMJSON(w, status, body)
+}
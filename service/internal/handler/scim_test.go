@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseSCIMPatch(t *testing.T) {
+	decode := func(s string) []scimPatchOp {
+		var ops []scimPatchOp
+		if err := json.Unmarshal([]byte(s), &ops); err != nil {
+			t.Fatal(err)
+		}
+		return ops
+	}
+
+	// Okta deactivates with a path
+	update, _, err := parseSCIMPatch(decode(`[{"op": "replace", "path": "active", "value": false}]`))
+	if err != nil || update.Active == nil || *update.Active {
+		t.Errorf("expected deactivation, got %+v, %v", update, err)
+	}
+
+	// Entra ID sends a capitalized op, no path, and a string boolean
+	update, name, err := parseSCIMPatch(decode(`[{"op": "Replace", "value": {"active": "True", "externalId": "e-1", "displayName": "Ada L"}}]`))
+	if err != nil || update.Active == nil || !*update.Active {
+		t.Fatalf("expected activation, got %+v, %v", update, err)
+	}
+	if update.ExternalID == nil || *update.ExternalID != "e-1" || name != "Ada L" {
+		t.Errorf("expected externalId and name, got %+v, %q", update, name)
+	}
+
+	update, name, err = parseSCIMPatch(decode(`[{"op": "add", "path": "name", "value": {"givenName": "Ada", "familyName": "Lovelace"}}, {"op": "replace", "path": "title", "value": "CTO"}]`))
+	if err != nil || name != "Ada Lovelace" || update.Active != nil {
+		t.Errorf("expected only a name change, got %+v, %q, %v", update, name, err)
+	}
+
+	for _, ops := range []string{
+		`[{"op": "remove", "path": "externalId"}]`,
+		`[{"op": "replace", "path": "userName", "value": "other@example.com"}]`,
+		`[{"op": "replace", "path": "active", "value": "maybe"}]`,
+	} {
+		if _, _, err := parseSCIMPatch(decode(ops)); err == nil {
+			t.Errorf("expected %s to be rejected", ops)
+		}
+	}
+}
+
+func TestSCIMFilterPattern(t *testing.T) {
+	tests := []struct {
+		filter    string
+		wantAttr  string
+		wantValue string
+	}{
+		{`userName eq "ada@example.com"`, "userName", "ada@example.com"},
+		{`externalId EQ "00u1"`, "externalId", "00u1"},
+		{`userName sw "ada"`, "", ""},
+		{`userName eq "a" or userName eq "b"`, "", ""},
+	}
+	for _, tt := range tests {
+		match := scimFilterPattern.FindStringSubmatch(tt.filter)
+		if tt.wantAttr == "" {
+			if match != nil {
+				t.Errorf("expected %q not to match", tt.filter)
+			}
+			continue
+		}
+		if match == nil || match[1] != tt.wantAttr || match[2] != tt.wantValue {
+			t.Errorf("filter %q matched %v", tt.filter, match)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+//go:build integration
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/pkg/client"
+)
+
+// TestSDK drives the project endpoints through the public Go SDK, over
+// HTTP with an API key, as a third-party script would
+func TestSDK(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	t.Cleanup(db.Close)
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "sdk-"+uuid.New().String()[:8]+"@test.com", "SDK Test", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Pool.Exec(context.Background(), "DELETE FROM users WHERE id = $1", user.ID)
+	})
+	apiKeys := store.NewAPIKeyStore(db.Pool)
+	key, err := apiKeys.Create(ctx, user.ID, "sdk", store.APIKeySettings{})
+	if err != nil {
+		t.Fatalf("Failed to create API key: %v", err)
+	}
+
+	// Only the handlers this test calls are wired up
+	server := &Server{ProjectHandler: NewProjectHandler(store.NewProjectStore(db.Pool))}
+	r := chi.NewRouter()
+	r.Use(AuthMiddleware(NewJWTService("test-secret", 0), apiKeys))
+	api.HandlerFromMux(api.NewStrictHandler(server, nil), r)
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(srv.URL, key.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Alpha", "Bravo", "Charlie"}
+	for _, name := range want {
+		res, err := c.CreateProjectWithResponse(ctx, client.ProjectCreate{Name: name}, client.IdempotencyKey(uuid.NewString()))
+		if err != nil {
+			t.Fatalf("CreateProject: %v", err)
+		}
+		if _, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON201); err != nil {
+			t.Fatalf("CreateProject %s: %v", name, err)
+		}
+	}
+
+	// Page through them two at a time
+	limit := 2
+	var got []string
+	for p, err := range c.AllProjects(ctx, &client.ListProjectsParams{Limit: &limit}) {
+		if err != nil {
+			t.Fatalf("AllProjects: %v", err)
+		}
+		got = append(got, p.Name)
+	}
+	sort.Strings(got)
+	if len(got) != len(want) || got[0] != want[0] || got[2] != want[2] {
+		t.Errorf("expected projects %v, got %v", want, got)
+	}
+
+	// A revoked key is refused with the API's error
+	if err := apiKeys.Delete(ctx, user.ID, key.ID); err != nil {
+		t.Fatalf("Failed to delete API key: %v", err)
+	}
+	res, err := c.ListProjectsWithResponse(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected a 401 API error after revoking the key, got %v", err)
+	}
+}
@@ -1,11 +1,18 @@
 package handler
 
 import (
+	"github.com/michaelw/timesheet-app/service/internal/anomaly"
 	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/cache"
 	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/export"
+	"github.com/michaelw/timesheet-app/service/internal/github"
+	"github.com/michaelw/timesheet-app/service/internal/goals"
 	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/sso"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+	"github.com/michaelw/timesheet-app/service/internal/utilization"
 )
 
 // Server implements the full StrictServerInterface
@@ -13,12 +20,33 @@ type Server struct {
 	*AuthHandler
 	*ProjectHandler
 	*TimeEntryHandler
+	*TimeEntryNoteHandler
+	*TagHandler
 	*CalendarHandler
 	*RulesHandler
+	*RecalculationHandler
 	*APIKeyHandler
 	*BillingHandler
 	*InvoiceHandler
+	*PaymentHandler
+	*InvoiceCommentHandler
+	*ExpenseHandler
 	*ConfigHandler
+	*TrashHandler
+	*ActionHandler
+	*SnapshotHandler
+	*SuppressionHandler
+	*ReportsHandler
+	*AnomalyHandler
+	*ChangeFeedHandler
+	*GitHubHandler
+	*IntegrationHandler
+	*OrganizationHandler
+	*SSOHandler
+	*DayHandler
+	*GoalsHandler
+	*FocusSessionHandler
+	*RetentionHandler
 }
 
 // NewServer creates a new server handler
@@ -26,30 +54,83 @@ func NewServer(
 	users *store.UserStore,
 	projects *store.ProjectStore,
 	entries *store.TimeEntryStore,
+	timeEntryNotes *store.TimeEntryNoteStore,
+	tags *store.TagStore,
 	calendarConns *store.CalendarConnectionStore,
 	calendars *store.CalendarStore,
 	calendarEvents *store.CalendarEventStore,
 	classificationRules *store.ClassificationRuleStore,
 	apiKeys *store.APIKeyStore,
 	billingPeriods *store.BillingPeriodStore,
+	clientRates *store.ClientRateStore,
 	invoices *store.InvoiceStore,
+	payments *store.PaymentStore,
+	invoiceComments *store.InvoiceCommentStore,
+	expenses *store.ExpenseStore,
+	invoiceExports *store.InvoiceExportStore,
 	syncJobs *store.SyncJobStore,
+	classificationSnapshots *store.ClassificationSnapshotStore,
+	classificationJobs *store.ClassificationJobStore,
+	recalculationJobs *store.RecalculationJobStore,
+	suppressionRules *store.SuppressionRuleStore,
+	workingHours *store.WorkingHoursStore,
+	dayAnomalies *store.DayAnomalyStore,
+	changeFeed *store.ChangeFeedStore,
+	githubConnections *store.GitHubConnectionStore,
+	integrationCredentials *store.IntegrationCredentialStore,
+	organizations *store.OrganizationStore,
+	hourGoals *store.HourGoalStore,
+	focusSessions *store.FocusSessionStore,
+	readModel *cache.ReadModel,
 	jwt *JWTService,
 	googleSvc google.CalendarClient,
-	sheetsSvc *google.SheetsService,
+	ssoClient *sso.Client,
+	baseURL string,
+	exportSvc *export.Service,
 	classificationSvc *classification.Service,
 	timeEntrySvc *timeentry.Service,
+	utilizationSvc *utilization.Service,
+	anomalySvc *anomaly.Service,
+	githubSvc *github.Service,
+	goalsSvc *goals.Service,
 ) *Server {
+	calendarHandler := NewCalendarHandler(calendarConns, calendars, calendarEvents, entries, readModel, syncJobs, googleSvc, classificationSvc, timeEntrySvc, users)
+	// Workspace connections mint their tokens with the organization's
+	// service account, wherever the service refreshes them
+	if gs, ok := googleSvc.(*google.CalendarService); ok && gs != nil {
+		gs.SetServiceAccountKeys(organizations.GoogleServiceAccountKey)
+	}
 	return &Server{
-		AuthHandler:      NewAuthHandler(users, jwt),
-		ProjectHandler:   NewProjectHandler(projects),
-		TimeEntryHandler: NewTimeEntryHandler(entries, projects, timeEntrySvc),
-		CalendarHandler:  NewCalendarHandler(calendarConns, calendars, calendarEvents, entries, projects, syncJobs, googleSvc, classificationSvc, timeEntrySvc),
-		RulesHandler:     NewRulesHandler(classificationRules, projects, classificationSvc),
-		APIKeyHandler:    NewAPIKeyHandler(apiKeys),
-		BillingHandler:   NewBillingHandler(billingPeriods),
-		InvoiceHandler:   NewInvoiceHandler(invoices, projects, sheetsSvc, calendarConns, timeEntrySvc),
-		ConfigHandler:    NewConfigHandler(projects, classificationRules),
+		AuthHandler:           NewAuthHandler(users, jwt),
+		ProjectHandler:        NewProjectHandler(projects),
+		TimeEntryHandler:      NewTimeEntryHandler(entries, projects, timeEntrySvc),
+		TimeEntryNoteHandler:  NewTimeEntryNoteHandler(timeEntryNotes, entries),
+		TagHandler:            NewTagHandler(tags, calendarEvents, entries),
+		CalendarHandler:       calendarHandler,
+		RulesHandler:          NewRulesHandler(classificationRules, projects, classificationJobs, classificationSvc),
+		RecalculationHandler:  NewRecalculationHandler(recalculationJobs, projects, timeEntrySvc),
+		APIKeyHandler:         NewAPIKeyHandler(apiKeys),
+		BillingHandler:        NewBillingHandler(billingPeriods, clientRates),
+		InvoiceHandler:        NewInvoiceHandler(invoices, projects, exportSvc, invoiceExports, timeEntrySvc),
+		PaymentHandler:        NewPaymentHandler(payments, invoices),
+		InvoiceCommentHandler: NewInvoiceCommentHandler(invoiceComments, invoices),
+		ExpenseHandler:        NewExpenseHandler(expenses, projects),
+		ConfigHandler:         NewConfigHandler(projects, classificationRules),
+		TrashHandler:          NewTrashHandler(entries, classificationRules),
+		ActionHandler:         NewActionHandler(classificationSvc),
+		SnapshotHandler:       NewSnapshotHandler(classificationSnapshots, classificationSvc),
+		SuppressionHandler:    NewSuppressionHandler(suppressionRules, calendars, calendarEvents, classificationSvc),
+		ReportsHandler:        NewReportsHandler(workingHours, utilizationSvc, calendarEvents),
+		AnomalyHandler:        NewAnomalyHandler(dayAnomalies, anomalySvc),
+		ChangeFeedHandler:     NewChangeFeedHandler(changeFeed),
+		GitHubHandler:         NewGitHubHandler(githubConnections, githubSvc),
+		IntegrationHandler:    NewIntegrationHandler(integrationCredentials),
+		OrganizationHandler:   NewOrganizationHandler(organizations, calendarConns, calendars, googleSvc, ssoClient, baseURL),
+		SSOHandler:            NewSSOHandler(organizations, users, jwt, ssoClient),
+		DayHandler:            NewDayHandler(calendarHandler, calendarEvents, projects, timeEntrySvc),
+		GoalsHandler:          NewGoalsHandler(hourGoals, projects, goalsSvc),
+		FocusSessionHandler:   NewFocusSessionHandler(focusSessions, calendarEvents, projects, workingHours, classificationSvc),
+		RetentionHandler:      NewRetentionHandler(users, calendarEvents),
 	}
 }
 
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/sync"
+)
+
+// SnapshotHandler implements the classification snapshot endpoints
+type SnapshotHandler struct {
+	snapshots         *store.ClassificationSnapshotStore
+	classificationSvc *classification.Service
+}
+
+// NewSnapshotHandler creates a new snapshot handler
+func NewSnapshotHandler(snapshots *store.ClassificationSnapshotStore, classificationSvc *classification.Service) *SnapshotHandler {
+	return &SnapshotHandler{
+		snapshots:         snapshots,
+		classificationSvc: classificationSvc,
+	}
+}
+
+// ListClassificationSnapshots returns the user's snapshots
+func (h *SnapshotHandler) ListClassificationSnapshots(ctx context.Context, req api.ListClassificationSnapshotsRequestObject) (api.ListClassificationSnapshotsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListClassificationSnapshots401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	var weekStart *time.Time
+	if req.Params.WeekStart != nil {
+		ws := sync.NormalizeToWeekStart(req.Params.WeekStart.Time)
+		weekStart = &ws
+	}
+
+	snapshots, err := h.snapshots.List(ctx, userID, weekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.ClassificationSnapshot, len(snapshots))
+	for i, s := range snapshots {
+		result[i] = snapshotToAPI(s)
+	}
+	return api.ListClassificationSnapshots200JSONResponse(result), nil
+}
+
+// CreateClassificationSnapshot records the classification state of a week
+func (h *SnapshotHandler) CreateClassificationSnapshot(ctx context.Context, req api.CreateClassificationSnapshotRequestObject) (api.CreateClassificationSnapshotResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateClassificationSnapshot401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.CreateClassificationSnapshot400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	var label *string
+	if req.Body.Label != nil && strings.TrimSpace(*req.Body.Label) != "" {
+		trimmed := strings.TrimSpace(*req.Body.Label)
+		label = &trimmed
+	}
+
+	weekStart := sync.NormalizeToWeekStart(req.Body.WeekStart.Time)
+	snapshot, err := h.snapshots.Create(ctx, userID, weekStart, label)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.CreateClassificationSnapshot201JSONResponse(snapshotToAPI(snapshot)), nil
+}
+
+// DeleteClassificationSnapshot removes a snapshot
+func (h *SnapshotHandler) DeleteClassificationSnapshot(ctx context.Context, req api.DeleteClassificationSnapshotRequestObject) (api.DeleteClassificationSnapshotResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteClassificationSnapshot401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.snapshots.Delete(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrClassificationSnapshotNotFound) {
+			return api.DeleteClassificationSnapshot404JSONResponse{
+				Code:    "not_found",
+				Message: "Snapshot not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteClassificationSnapshot204Response{}, nil
+}
+
+// RestoreClassificationSnapshot puts the week's events back to the snapshot
+// state and recalculates the affected time entries
+func (h *SnapshotHandler) RestoreClassificationSnapshot(ctx context.Context, req api.RestoreClassificationSnapshotRequestObject) (api.RestoreClassificationSnapshotResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RestoreClassificationSnapshot401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	snapshot, starts, err := h.snapshots.Restore(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrClassificationSnapshotNotFound) {
+			return api.RestoreClassificationSnapshot404JSONResponse{
+				Code:    "not_found",
+				Message: "Snapshot not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	dates, err := h.classificationSvc.RecalculateDays(ctx, userID, starts)
+	if err != nil {
+		return nil, err
+	}
+
+	recalculated := make([]openapi_types.Date, len(dates))
+	for i, d := range dates {
+		recalculated[i] = openapi_types.Date{Time: d}
+	}
+
+	return api.RestoreClassificationSnapshot200JSONResponse{
+		Snapshot:          snapshotToAPI(snapshot),
+		RestoredCount:     len(starts),
+		RecalculatedDates: recalculated,
+	}, nil
+}
+
+func snapshotToAPI(s *store.ClassificationSnapshot) api.ClassificationSnapshot {
+	return api.ClassificationSnapshot{
+		Id:         s.ID,
+		WeekStart:  openapi_types.Date{Time: s.WeekStart},
+		Label:      s.Label,
+		EventCount: s.EventCount,
+		CreatedAt:  s.CreatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+)
+
+func TestSnapshotHandler_RequiresAuth(t *testing.T) {
+	h := NewSnapshotHandler(nil, nil)
+
+	resp, err := h.RestoreClassificationSnapshot(context.Background(), api.RestoreClassificationSnapshotRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := resp.(api.RestoreClassificationSnapshot401JSONResponse); !ok {
+		t.Errorf("expected 401, got %T", resp)
+	}
+}
+
+func TestSnapshotHandler_CreateRequiresBody(t *testing.T) {
+	h := NewSnapshotHandler(nil, nil)
+
+	resp, err := h.CreateClassificationSnapshot(authedContext(uuid.New()), api.CreateClassificationSnapshotRequestObject{})
+	if err != nil {
+		t.Fatalf("CreateClassificationSnapshot: %v", err)
+	}
+	if _, ok := resp.(api.CreateClassificationSnapshot400JSONResponse); !ok {
+		t.Errorf("expected 400, got %T", resp)
+	}
+}
@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	gosync "sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/sso"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// SSOCallbackPath is where identity providers send the browser back to
+const SSOCallbackPath = "/api/auth/sso/callback"
+
+// ssoLoginPage is the web page that finishes an SSO login, with the session
+// token in the fragment or an error code in the query
+const ssoLoginPage = "/login/sso"
+
+// ssoStateTTL is how long a user has to sign in at their identity provider
+const ssoStateTTL = 10 * time.Minute
+
+// ssoLogin is a login attempt waiting for the identity provider's callback
+type ssoLogin struct {
+	orgID     uuid.UUID
+	nonce     string
+	expiresAt time.Time
+}
+
+// SSOHandler signs users in through their organization's identity
+// provider. Only emails on the organization's verified domains can sign
+// in, and only once SCIM provisioned them or if their domain auto-joins.
+type SSOHandler struct {
+	orgs      *store.OrganizationStore
+	users     *store.UserStore
+	jwt       *JWTService
+	ssoClient *sso.Client
+
+	stateMu gosync.Mutex
+	states  map[string]ssoLogin
+}
+
+// NewSSOHandler creates a new SSO handler
+func NewSSOHandler(orgs *store.OrganizationStore, users *store.UserStore, jwt *JWTService, ssoClient *sso.Client) *SSOHandler {
+	return &SSOHandler{
+		orgs:      orgs,
+		users:     users,
+		jwt:       jwt,
+		ssoClient: ssoClient,
+		states:    make(map[string]ssoLogin),
+	}
+}
+
+// StartSso finds the user's organization and returns its login URL
+func (h *SSOHandler) StartSso(ctx context.Context, req api.StartSsoRequestObject) (api.StartSsoResponseObject, error) {
+	if req.Body == nil || (req.Body.Organization == nil && req.Body.Email == nil) {
+		return api.StartSso400JSONResponse{
+			Code:    "invalid_request",
+			Message: "email or organization is required",
+		}, nil
+	}
+
+	var org *store.Organization
+	var err error
+	if req.Body.Organization != nil {
+		org, err = h.orgs.GetBySlug(ctx, strings.ToLower(strings.TrimSpace(*req.Body.Organization)))
+	} else {
+		org, _, err = h.orgs.GetByVerifiedDomain(ctx, emailDomain(string(*req.Body.Email)))
+	}
+	if err != nil && !errors.Is(err, store.ErrOrganizationNotFound) {
+		return nil, err
+	}
+	if org == nil || !org.SSOConfigured() {
+		return api.StartSso404JSONResponse{
+			Code:    "sso_not_available",
+			Message: "No organization with single sign-on matches",
+		}, nil
+	}
+
+	provider, err := h.provider(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	state, nonce := randomToken(), randomToken()
+	authURL, err := h.ssoClient.AuthURL(ctx, provider, state, nonce)
+	if err != nil {
+		log.Printf("SSO discovery failed for organization %s: %v", org.Slug, err)
+		return api.StartSso404JSONResponse{
+			Code:    "sso_unavailable",
+			Message: "The organization's identity provider could not be reached",
+		}, nil
+	}
+
+	h.stateMu.Lock()
+	now := time.Now()
+	for s, login := range h.states {
+		if now.After(login.expiresAt) {
+			delete(h.states, s)
+		}
+	}
+	h.states[state] = ssoLogin{orgID: org.ID, nonce: nonce, expiresAt: now.Add(ssoStateTTL)}
+	h.stateMu.Unlock()
+
+	return api.StartSso200JSONResponse{AuthorizationUrl: authURL}, nil
+}
+
+// Callback completes a login when the identity provider redirects back. It
+// redirects the browser to the login page with a session token, or with an
+// error code the page can explain.
+func (h *SSOHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("error") != "" {
+		redirectSSOError(w, r, "idp_error")
+		return
+	}
+
+	h.stateMu.Lock()
+	login, ok := h.states[q.Get("state")]
+	delete(h.states, q.Get("state"))
+	h.stateMu.Unlock()
+	if !ok || time.Now().After(login.expiresAt) || q.Get("code") == "" {
+		redirectSSOError(w, r, "invalid_state")
+		return
+	}
+
+	user, code, err := h.completeLogin(r.Context(), login, q.Get("code"))
+	if err != nil {
+		log.Printf("SSO login failed for organization %s: %v", login.orgID, err)
+		redirectSSOError(w, r, "sso_failed")
+		return
+	}
+	if code != "" {
+		redirectSSOError(w, r, code)
+		return
+	}
+
+	token, err := h.jwt.GenerateToken(user.ID)
+	if err != nil {
+		log.Printf("SSO token generation failed: %v", err)
+		redirectSSOError(w, r, "sso_failed")
+		return
+	}
+	// The fragment keeps the token out of server logs and Referer headers
+	http.Redirect(w, r, ssoLoginPage+"#token="+url.QueryEscape(token), http.StatusFound)
+}
+
+// completeLogin exchanges the code and finds or provisions the user. A
+// non-empty code explains why a valid identity may not sign in.
+func (h *SSOHandler) completeLogin(ctx context.Context, login ssoLogin, authCode string) (*store.User, string, error) {
+	org, err := h.orgs.Get(ctx, login.orgID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !org.SSOConfigured() {
+		return nil, "sso_disabled", nil
+	}
+	provider, err := h.provider(ctx, org)
+	if err != nil {
+		return nil, "", err
+	}
+	identity, err := h.ssoClient.Exchange(ctx, provider, authCode, login.nonce)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The organization may only vouch for emails on domains it verified,
+	// or its identity provider could sign in as any user
+	var domain *store.OrganizationDomain
+	for i, d := range org.Domains {
+		if d.Domain == emailDomain(identity.Email) && d.VerifiedAt != nil {
+			domain = &org.Domains[i]
+		}
+	}
+	if domain == nil {
+		return nil, "not_provisioned", nil
+	}
+
+	user, err := h.users.GetByEmail(ctx, identity.Email)
+	if err != nil && !errors.Is(err, store.ErrUserNotFound) {
+		return nil, "", err
+	}
+	if user != nil {
+		m, err := h.orgs.GetMember(ctx, org.ID, user.ID)
+		if err == nil {
+			if !m.Active() {
+				return nil, "account_deactivated", nil
+			}
+			return user, "", nil
+		}
+		if !errors.Is(err, store.ErrOrganizationMemberNotFound) {
+			return nil, "", err
+		}
+	}
+
+	// Not a member: without auto-join the user must be provisioned over SCIM
+	if !domain.AutoJoin {
+		return nil, "not_provisioned", nil
+	}
+
+	if user == nil {
+		name := identity.Name
+		if name == "" {
+			name = identity.Email[:strings.Index(identity.Email, "@")]
+		}
+		if user, err = h.users.CreateExternal(ctx, identity.Email, name); err != nil {
+			return nil, "", err
+		}
+	}
+	if _, err := h.orgs.AddMember(ctx, org.ID, user.ID, store.OrganizationRoleMember, nil, true); err != nil && !errors.Is(err, store.ErrAlreadyOrganizationMember) {
+		return nil, "", err
+	}
+	return user, "", nil
+}
+
+func (h *SSOHandler) provider(ctx context.Context, org *store.Organization) (sso.Provider, error) {
+	secret, err := h.orgs.SSOClientSecret(ctx, org.ID)
+	if err != nil {
+		return sso.Provider{}, err
+	}
+	return sso.Provider{
+		Issuer:       *org.OIDCIssuer,
+		ClientID:     *org.OIDCClientID,
+		ClientSecret: secret,
+	}, nil
+}
+
+func redirectSSOError(w http.ResponseWriter, r *http.Request, code string) {
+	http.Redirect(w, r, ssoLoginPage+"?error="+url.QueryEscape(code), http.StatusFound)
+}
+
+func randomToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
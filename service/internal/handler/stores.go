@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// ProjectStore defines the project storage operations used by the handlers.
+// It is satisfied by *store.ProjectStore and by memstore.ProjectStore.
+type ProjectStore interface {
+	Create(ctx context.Context, userID uuid.UUID, name string, shortCode, client *string, color string, isBillable, isHiddenByDefault, doesNotAccumulateHours bool) (*store.Project, error)
+	GetByID(ctx context.Context, userID, projectID uuid.UUID) (*store.Project, error)
+	List(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*store.Project, error)
+	Query(ctx context.Context, userID uuid.UUID, opts store.ProjectListOptions) ([]*store.Project, error)
+	Update(ctx context.Context, userID, projectID uuid.UUID, updates map[string]interface{}) (*store.Project, error)
+	Delete(ctx context.Context, userID, projectID uuid.UUID) error
+}
+
+// TimeEntryStore defines the time entry storage operations used by the
+// handlers. It is satisfied by *store.TimeEntryStore and by
+// memstore.TimeEntryStore.
+type TimeEntryStore interface {
+	Create(ctx context.Context, userID, projectID uuid.UUID, date time.Time, hours float64, description *string) (*store.TimeEntry, error)
+	GetByID(ctx context.Context, userID, entryID uuid.UUID) (*store.TimeEntry, error)
+	Update(ctx context.Context, userID, entryID uuid.UUID, hours *float64, description *string) (*store.TimeEntry, error)
+	Trash(ctx context.Context, userID, entryID uuid.UUID) error
+	RefreshComputedValues(ctx context.Context, userID, entryID uuid.UUID, computedHours float64) error
+	ResetToComputed(ctx context.Context, userID, entryID uuid.UUID, hours float64, title, description string, details []byte, eventIDs []uuid.UUID) (*store.TimeEntry, error)
+	UpsertFromComputed(ctx context.Context, userID, projectID uuid.UUID, date time.Time, hours float64, title, description string, details []byte, eventIDs []uuid.UUID) (*store.TimeEntry, error)
+	ListDivergent(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, includeAcknowledged bool) ([]*store.TimeEntry, error)
+	AcceptComputed(ctx context.Context, userID, entryID uuid.UUID) (*store.TimeEntry, error)
+	KeepManual(ctx context.Context, userID, entryID uuid.UUID) (*store.TimeEntry, error)
+	Split(ctx context.Context, userID, entryID uuid.UUID, parts []store.SplitPart) ([]*store.TimeEntry, error)
+}
+
+// TimeEntryReader defines the time entry lookups used by GraphQLHandler. It
+// is satisfied by *store.TimeEntryStore.
+type TimeEntryReader interface {
+	List(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, projectID *uuid.UUID) ([]*store.TimeEntry, error)
+	ListContributingEvents(ctx context.Context, userID uuid.UUID, entryIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
+}
+
+// CalendarEventReader defines the calendar event lookups used by
+// GraphQLHandler. It is satisfied by *store.CalendarEventStore.
+type CalendarEventReader interface {
+	List(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, status *store.ClassificationStatus, connectionID *uuid.UUID) ([]*store.CalendarEvent, error)
+	GetByIDs(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) ([]*store.CalendarEvent, error)
+}
+
+// InvoiceReader defines the invoice lookups used by GraphQLHandler. It is
+// satisfied by *store.InvoiceStore.
+type InvoiceReader interface {
+	GetByID(ctx context.Context, userID, invoiceID uuid.UUID) (*store.Invoice, error)
+	List(ctx context.Context, userID uuid.UUID, projectID *uuid.UUID, status *string) ([]*store.Invoice, error)
+	ListLineItems(ctx context.Context, userID uuid.UUID, invoiceIDs []uuid.UUID) (map[uuid.UUID][]store.InvoiceLineItem, error)
+}
+
+// IdempotencyKeyStore defines the idempotency key operations used by
+// IdempotencyMiddleware. It is satisfied by *store.IdempotencyKeyStore.
+type IdempotencyKeyStore interface {
+	Claim(ctx context.Context, userID uuid.UUID, key, method, path, requestHash string) (*store.IdempotentResponse, time.Time, error)
+	Complete(ctx context.Context, userID uuid.UUID, key string, claimedAt time.Time, resp *store.IdempotentResponse) error
+	Release(ctx context.Context, userID uuid.UUID, key string, claimedAt time.Time) error
+}
+
+var (
+	_ ProjectStore        = (*store.ProjectStore)(nil)
+	_ TimeEntryStore      = (*store.TimeEntryStore)(nil)
+	_ IdempotencyKeyStore = (*store.IdempotencyKeyStore)(nil)
+	_ TimeEntryReader     = (*store.TimeEntryStore)(nil)
+	_ CalendarEventReader = (*store.CalendarEventStore)(nil)
+	_ InvoiceReader       = (*store.InvoiceStore)(nil)
+)
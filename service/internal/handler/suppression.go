@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/classification"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// SuppressionHandler implements the suppression rule endpoints
+type SuppressionHandler struct {
+	rules             *store.SuppressionRuleStore
+	calendars         *store.CalendarStore
+	events            *store.CalendarEventStore
+	classificationSvc *classification.Service
+}
+
+// NewSuppressionHandler creates a new suppression handler
+func NewSuppressionHandler(rules *store.SuppressionRuleStore, calendars *store.CalendarStore, events *store.CalendarEventStore, classificationSvc *classification.Service) *SuppressionHandler {
+	return &SuppressionHandler{
+		rules:             rules,
+		calendars:         calendars,
+		events:            events,
+		classificationSvc: classificationSvc,
+	}
+}
+
+// ListSuppressionRules returns all suppression rules for the user
+func (h *SuppressionHandler) ListSuppressionRules(ctx context.Context, req api.ListSuppressionRulesRequestObject) (api.ListSuppressionRulesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListSuppressionRules401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	rules, err := h.rules.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.SuppressionRule, len(rules))
+	for i, r := range rules {
+		result[i] = suppressionRuleToAPI(r)
+	}
+	return api.ListSuppressionRules200JSONResponse(result), nil
+}
+
+// CreateSuppressionRule creates a rule and applies it to pending events
+func (h *SuppressionHandler) CreateSuppressionRule(ctx context.Context, req api.CreateSuppressionRuleRequestObject) (api.CreateSuppressionRuleResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateSuppressionRule401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.CreateSuppressionRule400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	var query *string
+	if req.Body.Query != nil && strings.TrimSpace(*req.Body.Query) != "" {
+		trimmed := strings.TrimSpace(*req.Body.Query)
+		if _, err := classification.Parse(trimmed); err != nil {
+			return api.CreateSuppressionRule400JSONResponse{
+				Code:    "invalid_query",
+				Message: err.Error(),
+				Details: queryErrorDetails(err),
+			}, nil
+		}
+		query = &trimmed
+	}
+
+	if req.Body.CalendarId == nil && query == nil {
+		return api.CreateSuppressionRule400JSONResponse{
+			Code:    "invalid_request",
+			Message: "calendar_id or query is required",
+		}, nil
+	}
+
+	if req.Body.CalendarId != nil {
+		cal, err := h.calendars.GetByID(ctx, *req.Body.CalendarId)
+		if err != nil && !errors.Is(err, store.ErrCalendarNotFound) {
+			return nil, err
+		}
+		if err != nil || cal.UserID != userID {
+			return api.CreateSuppressionRule404JSONResponse{
+				Code:    "not_found",
+				Message: "Calendar not found",
+			}, nil
+		}
+	}
+
+	rule, err := h.rules.Create(ctx, userID, req.Body.CalendarId, query)
+	if err != nil {
+		return nil, err
+	}
+
+	suppressed, released, err := h.classificationSvc.ApplySuppressionRules(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.CreateSuppressionRule201JSONResponse{
+		Rule:            suppressionRuleToAPI(rule),
+		SuppressedCount: suppressed,
+		ReleasedCount:   released,
+	}, nil
+}
+
+// UpdateSuppressionRule enables or disables a rule and re-applies the rules
+func (h *SuppressionHandler) UpdateSuppressionRule(ctx context.Context, req api.UpdateSuppressionRuleRequestObject) (api.UpdateSuppressionRuleResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateSuppressionRule401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateSuppressionRule400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	rule, err := h.rules.SetEnabled(ctx, userID, req.Id, req.Body.IsEnabled)
+	if err != nil {
+		if errors.Is(err, store.ErrSuppressionRuleNotFound) {
+			return api.UpdateSuppressionRule404JSONResponse{
+				Code:    "not_found",
+				Message: "Suppression rule not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	suppressed, released, err := h.classificationSvc.ApplySuppressionRules(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.UpdateSuppressionRule200JSONResponse{
+		Rule:            suppressionRuleToAPI(rule),
+		SuppressedCount: suppressed,
+		ReleasedCount:   released,
+	}, nil
+}
+
+// DeleteSuppressionRule removes a rule and releases the events it suppressed
+func (h *SuppressionHandler) DeleteSuppressionRule(ctx context.Context, req api.DeleteSuppressionRuleRequestObject) (api.DeleteSuppressionRuleResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteSuppressionRule401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.rules.Delete(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrSuppressionRuleNotFound) {
+			return api.DeleteSuppressionRule404JSONResponse{
+				Code:    "not_found",
+				Message: "Suppression rule not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	if _, _, err := h.classificationSvc.ApplySuppressionRules(ctx, userID, nil); err != nil {
+		return nil, err
+	}
+
+	return api.DeleteSuppressionRule204Response{}, nil
+}
+
+// SetEventSuppression overrides suppression rules for a single event
+func (h *SuppressionHandler) SetEventSuppression(ctx context.Context, req api.SetEventSuppressionRequestObject) (api.SetEventSuppressionResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.SetEventSuppression401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.SetEventSuppression400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	event, err := h.events.OverrideSuppression(ctx, userID, req.Id, req.Body.Suppressed)
+	if err != nil {
+		if errors.Is(err, store.ErrCalendarEventNotFound) {
+			return api.SetEventSuppression404JSONResponse{
+				Code:    "not_found",
+				Message: "Event not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.SetEventSuppression200JSONResponse(calendarEventToAPI(event)), nil
+}
+
+func suppressionRuleToAPI(r *store.SuppressionRule) api.SuppressionRule {
+	return api.SuppressionRule{
+		Id:         r.ID,
+		CalendarId: r.CalendarID,
+		Query:      r.Query,
+		IsEnabled:  r.IsEnabled,
+		CreatedAt:  r.CreatedAt,
+	}
+}
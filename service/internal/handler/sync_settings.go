@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/sync"
+)
+
+// GetSyncSettings returns the user's sync cadence
+func (h *AuthHandler) GetSyncSettings(ctx context.Context, req api.GetSyncSettingsRequestObject) (api.GetSyncSettingsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetSyncSettings401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	c, err := h.users.GetSyncCadence(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetSyncSettings200JSONResponse(syncSettings(c)), nil
+}
+
+// UpdateSyncSettings sets the user's sync cadence
+func (h *AuthHandler) UpdateSyncSettings(ctx context.Context, req api.UpdateSyncSettingsRequestObject) (api.UpdateSyncSettingsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.UpdateSyncSettings401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.UpdateSyncSettings400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+
+	if msg := checkMinutes("stale_after_minutes", req.Body.StaleAfterMinutes, sync.MinStaleAfter, sync.MaxStaleAfter); msg != "" {
+		return api.UpdateSyncSettings400JSONResponse{Code: "invalid_request", Message: msg}, nil
+	}
+	if msg := checkMinutes("interval_minutes", req.Body.IntervalMinutes, sync.MinSyncInterval, sync.MaxSyncInterval); msg != "" {
+		return api.UpdateSyncSettings400JSONResponse{Code: "invalid_request", Message: msg}, nil
+	}
+
+	c := store.SyncCadence{
+		StaleAfterMinutes: req.Body.StaleAfterMinutes,
+		IntervalMinutes:   req.Body.IntervalMinutes,
+	}
+	if err := h.users.SetSyncCadence(ctx, userID, c); err != nil {
+		return nil, err
+	}
+
+	return api.UpdateSyncSettings200JSONResponse(syncSettings(&c)), nil
+}
+
+// checkMinutes returns why an optional setting in minutes is out of bounds,
+// or an empty string
+func checkMinutes(field string, minutes *int, lo, hi time.Duration) string {
+	if minutes == nil {
+		return ""
+	}
+	if d := time.Duration(*minutes) * time.Minute; d < lo || d > hi {
+		return fmt.Sprintf("%s must be between %d and %d", field, int(lo.Minutes()), int(hi.Minutes()))
+	}
+	return ""
+}
+
+// syncSettings converts a user's stored cadence to api.SyncSettings
+func syncSettings(c *store.SyncCadence) api.SyncSettings {
+	resolved := sync.ResolveCadence(c.StaleAfterMinutes, c.IntervalMinutes)
+	return api.SyncSettings{
+		StaleAfterMinutes: int(resolved.StaleAfter.Minutes()),
+		IntervalMinutes:   int(resolved.Interval.Minutes()),
+		IsDefault:         c.StaleAfterMinutes == nil && c.IntervalMinutes == nil,
+	}
+}
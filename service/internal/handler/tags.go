@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// maxTagLength bounds tag names so they stay usable as labels
+const maxTagLength = 50
+
+// TagHandler implements the tag endpoints
+type TagHandler struct {
+	tags    *store.TagStore
+	events  *store.CalendarEventStore
+	entries TimeEntryStore
+}
+
+// NewTagHandler creates a new tag handler
+func NewTagHandler(tags *store.TagStore, events *store.CalendarEventStore, entries TimeEntryStore) *TagHandler {
+	return &TagHandler{
+		tags:    tags,
+		events:  events,
+		entries: entries,
+	}
+}
+
+// ListTags returns the user's tags
+func (h *TagHandler) ListTags(ctx context.Context, req api.ListTagsRequestObject) (api.ListTagsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListTags401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	tags, err := h.tags.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.Tag, len(tags))
+	for i, t := range tags {
+		result[i] = tagToAPI(t)
+	}
+	return api.ListTags200JSONResponse(result), nil
+}
+
+// CreateTag adds a tag
+func (h *TagHandler) CreateTag(ctx context.Context, req api.CreateTagRequestObject) (api.CreateTagResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.CreateTag401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.CreateTag400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	name, msg := validateTagName(req.Body.Name)
+	if msg != "" {
+		return api.CreateTag400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+
+	tag, err := h.tags.Create(ctx, userID, name)
+	if err != nil {
+		if errors.Is(err, store.ErrTagExists) {
+			return api.CreateTag409JSONResponse{
+				Code:    "tag_exists",
+				Message: "A tag with this name already exists",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.CreateTag201JSONResponse(tagToAPI(tag)), nil
+}
+
+// RenameTag changes a tag's name
+func (h *TagHandler) RenameTag(ctx context.Context, req api.RenameTagRequestObject) (api.RenameTagResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RenameTag401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.RenameTag400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	name, msg := validateTagName(req.Body.Name)
+	if msg != "" {
+		return api.RenameTag400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+
+	tag, err := h.tags.Rename(ctx, userID, req.Id, name)
+	if err != nil {
+		if errors.Is(err, store.ErrTagNotFound) {
+			return api.RenameTag404JSONResponse{
+				Code:    "not_found",
+				Message: "Tag not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrTagExists) {
+			return api.RenameTag409JSONResponse{
+				Code:    "tag_exists",
+				Message: "A tag with this name already exists",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.RenameTag200JSONResponse(tagToAPI(tag)), nil
+}
+
+// DeleteTag removes a tag from everything carrying it
+func (h *TagHandler) DeleteTag(ctx context.Context, req api.DeleteTagRequestObject) (api.DeleteTagResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteTag401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.tags.Delete(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrTagNotFound) {
+			return api.DeleteTag404JSONResponse{
+				Code:    "not_found",
+				Message: "Tag not found",
+			}, nil
+		}
+		return nil, err
+	}
+	return api.DeleteTag204Response{}, nil
+}
+
+// SetEventTags replaces the tags on an event
+func (h *TagHandler) SetEventTags(ctx context.Context, req api.SetEventTagsRequestObject) (api.SetEventTagsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.SetEventTags401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.SetEventTags400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if msg := validateTagNames(req.Body.Tags); msg != "" {
+		return api.SetEventTags400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+
+	if _, err := h.tags.SetEventTags(ctx, userID, req.Id, req.Body.Tags); err != nil {
+		if errors.Is(err, store.ErrCalendarEventNotFound) {
+			return api.SetEventTags404JSONResponse{
+				Code:    "not_found",
+				Message: "Event not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	event, err := h.events.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return api.SetEventTags200JSONResponse(calendarEventToAPI(event)), nil
+}
+
+// SetTimeEntryTags replaces the tags on a time entry
+func (h *TagHandler) SetTimeEntryTags(ctx context.Context, req api.SetTimeEntryTagsRequestObject) (api.SetTimeEntryTagsResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.SetTimeEntryTags401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.SetTimeEntryTags400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if msg := validateTagNames(req.Body.Tags); msg != "" {
+		return api.SetTimeEntryTags400JSONResponse{
+			Code:    "invalid_request",
+			Message: msg,
+		}, nil
+	}
+
+	if _, err := h.tags.SetEntryTags(ctx, userID, req.Id, req.Body.Tags); err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.SetTimeEntryTags404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	entry, err := h.entries.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return api.SetTimeEntryTags200JSONResponse(timeEntryToAPI(entry)), nil
+}
+
+// validateTagName trims a tag name and returns it, or a message explaining
+// why it can't be used
+func validateTagName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", "name is required"
+	}
+	if len(name) > maxTagLength {
+		return "", "Tag names must be at most 50 characters"
+	}
+	return name, ""
+}
+
+// validateTagNames checks each name in a tag assignment. Blank names are
+// dropped by the store, so only overlong ones are rejected.
+func validateTagNames(names []string) string {
+	for _, name := range names {
+		if len(strings.TrimSpace(name)) > maxTagLength {
+			return "Tag names must be at most 50 characters"
+		}
+	}
+	return ""
+}
+
+func tagToAPI(t *store.Tag) api.Tag {
+	return api.Tag{
+		Id:         t.ID,
+		Name:       t.Name,
+		EventCount: t.EventCount,
+		EntryCount: t.EntryCount,
+		CreatedAt:  t.CreatedAt,
+	}
+}
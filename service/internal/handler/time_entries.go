@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/michaelw/timesheet-app/service/internal/api"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 	"github.com/michaelw/timesheet-app/service/internal/timeentry"
@@ -14,13 +17,13 @@ import (
 
 // TimeEntryHandler implements the time entry endpoints
 type TimeEntryHandler struct {
-	entries        *store.TimeEntryStore
-	projects       *store.ProjectStore
+	entries        TimeEntryStore
+	projects       ProjectStore
 	timeEntryService *timeentry.Service
 }
 
 // NewTimeEntryHandler creates a new time entry handler
-func NewTimeEntryHandler(entries *store.TimeEntryStore, projects *store.ProjectStore, timeEntryService *timeentry.Service) *TimeEntryHandler {
+func NewTimeEntryHandler(entries TimeEntryStore, projects ProjectStore, timeEntryService *timeentry.Service) *TimeEntryHandler {
 	return &TimeEntryHandler{
 		entries:        entries,
 		projects:       projects,
@@ -56,9 +59,12 @@ func (h *TimeEntryHandler) ListTimeEntries(ctx context.Context, req api.ListTime
 		return nil, err
 	}
 
-	result := make([]api.TimeEntry, len(entries))
-	for i, e := range entries {
-		result[i] = timeEntryToAPI(e)
+	result := make([]api.TimeEntry, 0, len(entries))
+	for _, e := range entries {
+		if req.Params.Tag != nil && !store.HasTag(e.Tags, *req.Params.Tag) {
+			continue
+		}
+		result = append(result, timeEntryToAPI(e))
 	}
 
 	return api.ListTimeEntries200JSONResponse(result), nil
@@ -229,7 +235,7 @@ func (h *TimeEntryHandler) UpdateTimeEntry(ctx context.Context, req api.UpdateTi
 	return api.UpdateTimeEntry200JSONResponse(timeEntryToAPI(entry)), nil
 }
 
-// DeleteTimeEntry deletes a time entry
+// DeleteTimeEntry moves a time entry to the trash
 func (h *TimeEntryHandler) DeleteTimeEntry(ctx context.Context, req api.DeleteTimeEntryRequestObject) (api.DeleteTimeEntryResponseObject, error) {
 	userID, ok := UserIDFromContext(ctx)
 	if !ok {
@@ -239,7 +245,7 @@ func (h *TimeEntryHandler) DeleteTimeEntry(ctx context.Context, req api.DeleteTi
 		}, nil
 	}
 
-	err := h.entries.Delete(ctx, userID, req.Id)
+	err := h.entries.Trash(ctx, userID, req.Id)
 	if err != nil {
 		if errors.Is(err, store.ErrTimeEntryNotFound) {
 			return api.DeleteTimeEntry404JSONResponse{
@@ -333,6 +339,127 @@ func (h *TimeEntryHandler) RefreshTimeEntry(ctx context.Context, req api.Refresh
 	return api.RefreshTimeEntry200JSONResponse(timeEntryToAPI(refreshed)), nil
 }
 
+// SplitTimeEntry divides a time entry's hours into manual entries on other
+// projects, suppressing the original unless a part stays on its project
+func (h *TimeEntryHandler) SplitTimeEntry(ctx context.Context, req api.SplitTimeEntryRequestObject) (api.SplitTimeEntryResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.SplitTimeEntry401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil {
+		return api.SplitTimeEntry400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Request body is required",
+		}, nil
+	}
+	if len(req.Body.Parts) < 2 {
+		return api.SplitTimeEntry400JSONResponse{
+			Code:    "invalid_request",
+			Message: "At least two parts are required",
+		}, nil
+	}
+
+	parts := make([]store.SplitPart, len(req.Body.Parts))
+	projects := make(map[uuid.UUID]bool, len(req.Body.Parts))
+	var total float64
+	for i, p := range req.Body.Parts {
+		if p.Hours <= 0 {
+			return api.SplitTimeEntry400JSONResponse{
+				Code:    "invalid_request",
+				Message: "Each part must have hours greater than 0",
+			}, nil
+		}
+		if projects[p.ProjectId] {
+			return api.SplitTimeEntry400JSONResponse{
+				Code:    "invalid_request",
+				Message: "Each part must be on a different project",
+			}, nil
+		}
+		projects[p.ProjectId] = true
+
+		if _, err := h.projects.GetByID(ctx, userID, p.ProjectId); err != nil {
+			if errors.Is(err, store.ErrProjectNotFound) {
+				return api.SplitTimeEntry404JSONResponse{
+					Code:    "not_found",
+					Message: "Project not found",
+				}, nil
+			}
+			return nil, err
+		}
+
+		parts[i] = store.SplitPart{
+			ProjectID:   p.ProjectId,
+			Hours:       float64(p.Hours),
+			Description: p.Description,
+		}
+		total += float64(p.Hours)
+	}
+
+	existing, err := h.entries.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if !errors.Is(err, store.ErrTimeEntryNotFound) {
+			return nil, err
+		}
+		// An ephemeral entry is materialized first, as in UpdateTimeEntry
+		if req.Body.ProjectId == nil || req.Body.Date == nil {
+			return api.SplitTimeEntry404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		existing, err = h.materializeEphemeralEntry(ctx, userID, *req.Body.ProjectId, req.Body.Date.Time)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return api.SplitTimeEntry404JSONResponse{
+				Code:    "not_found",
+				Message: "No events found for this project and date",
+			}, nil
+		}
+	}
+
+	if math.Abs(total-existing.Hours) > 0.01 {
+		return api.SplitTimeEntry400JSONResponse{
+			Code:    "invalid_request",
+			Message: fmt.Sprintf("Parts must add up to the entry's %.2f hours", existing.Hours),
+		}, nil
+	}
+
+	entries, err := h.entries.Split(ctx, userID, existing.ID, parts)
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.SplitTimeEntry404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		if errors.Is(err, store.ErrTimeEntryInvoiced) {
+			return api.SplitTimeEntry409JSONResponse{
+				Code:    "conflict",
+				Message: "Cannot split invoiced time entry",
+			}, nil
+		}
+		if errors.Is(err, store.ErrTimeEntrySlotTaken) {
+			return api.SplitTimeEntry409JSONResponse{
+				Code:    "time_entry_exists",
+				Message: "A part's project already has an entry for this day",
+			}, nil
+		}
+		return nil, err
+	}
+
+	result := make([]api.TimeEntry, len(entries))
+	for i, e := range entries {
+		result[i] = timeEntryToAPI(e)
+	}
+	return api.SplitTimeEntry200JSONResponse(result), nil
+}
+
 // materializeEphemeralEntry creates a time entry in the database for an ephemeral entry.
 // This is called when updating an ephemeral entry that doesn't exist in the DB yet.
 func (h *TimeEntryHandler) materializeEphemeralEntry(ctx context.Context, userID, projectID openapi_types.UUID, date time.Time) (*store.TimeEntry, error) {
@@ -419,6 +546,7 @@ func timeEntryToAPI(e *store.TimeEntry) api.TimeEntry {
 		// Protection model fields
 		IsStale:      &isStale, // Computed, not from DB
 		IsSuppressed: &e.IsSuppressed,
+		DeletedAt:    e.DeletedAt,
 	}
 
 	// Computed fields
@@ -445,6 +573,9 @@ func timeEntryToAPI(e *store.TimeEntry) api.TimeEntry {
 	if len(e.ContributingEvents) > 0 {
 		entry.ContributingEvents = &e.ContributingEvents
 	}
+	if len(e.Tags) > 0 {
+		entry.Tags = &e.Tags
+	}
 
 	if e.Project != nil {
 		proj := projectToAPI(e.Project)
@@ -0,0 +1,340 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/analyzer"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/store/memstore"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestTimeEntryHandler_CreateAccumulates(t *testing.T) {
+	mem := memstore.New()
+	h := NewTimeEntryHandler(mem.TimeEntries, mem.Projects, nil)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+	project, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+	date := openapi_types.Date{Time: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)}
+
+	var entry api.TimeEntry
+	for _, hours := range []float32{1.5, 2} {
+		resp, err := h.CreateTimeEntry(ctx, api.CreateTimeEntryRequestObject{
+			Body: &api.TimeEntryCreate{ProjectId: project.ID, Date: date, Hours: hours},
+		})
+		if err != nil {
+			t.Fatalf("CreateTimeEntry: %v", err)
+		}
+		created, ok := resp.(api.CreateTimeEntry201JSONResponse)
+		if !ok {
+			t.Fatalf("expected 201, got %T", resp)
+		}
+		entry = api.TimeEntry(created)
+	}
+	if entry.Hours != 3.5 {
+		t.Errorf("expected hours to accumulate to 3.5, got %v", entry.Hours)
+	}
+
+	missing, err := h.CreateTimeEntry(ctx, api.CreateTimeEntryRequestObject{
+		Body: &api.TimeEntryCreate{ProjectId: uuid.New(), Date: date, Hours: 1},
+	})
+	if err != nil {
+		t.Fatalf("CreateTimeEntry: %v", err)
+	}
+	if _, ok := missing.(api.CreateTimeEntry404JSONResponse); !ok {
+		t.Errorf("expected 404 for an unknown project, got %T", missing)
+	}
+}
+
+func TestTimeEntryHandler_DeleteInvoiced(t *testing.T) {
+	mem := memstore.New()
+	h := NewTimeEntryHandler(mem.TimeEntries, mem.Projects, nil)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+
+	invoiceID := uuid.New()
+	invoiced := &store.TimeEntry{UserID: userID, ProjectID: uuid.New(), Hours: 2, InvoiceID: &invoiceID}
+	mem.TimeEntries.Put(invoiced)
+
+	resp, err := h.DeleteTimeEntry(ctx, api.DeleteTimeEntryRequestObject{Id: invoiced.ID})
+	if err != nil {
+		t.Fatalf("DeleteTimeEntry: %v", err)
+	}
+	if _, ok := resp.(api.DeleteTimeEntry409JSONResponse); !ok {
+		t.Errorf("expected 409 for an invoiced entry, got %T", resp)
+	}
+
+	plain := &store.TimeEntry{UserID: userID, ProjectID: uuid.New(), Hours: 1}
+	mem.TimeEntries.Put(plain)
+	resp, err = h.DeleteTimeEntry(ctx, api.DeleteTimeEntryRequestObject{Id: plain.ID})
+	if err != nil {
+		t.Fatalf("DeleteTimeEntry: %v", err)
+	}
+	if _, ok := resp.(api.DeleteTimeEntry204Response); !ok {
+		t.Fatalf("expected 204, got %T", resp)
+	}
+
+	got, err := h.GetTimeEntry(ctx, api.GetTimeEntryRequestObject{Id: plain.ID})
+	if err != nil {
+		t.Fatalf("GetTimeEntry: %v", err)
+	}
+	if _, ok := got.(api.GetTimeEntry404JSONResponse); !ok {
+		t.Errorf("expected trashed entry to be hidden, got %T", got)
+	}
+}
+
+func TestTimeEntryHandler_Reconciliation(t *testing.T) {
+	mem := memstore.New()
+	h := NewTimeEntryHandler(mem.TimeEntries, mem.Projects, nil)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+	project, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+
+	hours := func(v float64) *float64 { return &v }
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+	over := &store.TimeEntry{UserID: userID, ProjectID: project.ID, Date: day(3), Hours: 5, ComputedHours: hours(4)}
+	under := &store.TimeEntry{UserID: userID, ProjectID: project.ID, Date: day(4), Hours: 1, ComputedHours: hours(2)}
+	matching := &store.TimeEntry{UserID: userID, ProjectID: project.ID, Date: day(5), Hours: 3, ComputedHours: hours(3)}
+	for _, e := range []*store.TimeEntry{over, under, matching} {
+		mem.TimeEntries.Put(e)
+	}
+
+	report := func(includeAcknowledged bool) api.GetReconciliationReport200JSONResponse {
+		t.Helper()
+		resp, err := h.GetReconciliationReport(ctx, api.GetReconciliationReportRequestObject{
+			Params: api.GetReconciliationReportParams{IncludeAcknowledged: &includeAcknowledged},
+		})
+		if err != nil {
+			t.Fatalf("GetReconciliationReport: %v", err)
+		}
+		return resp.(api.GetReconciliationReport200JSONResponse)
+	}
+
+	r := report(false)
+	if len(r.Items) != 2 || r.Items[0].Entry.Id != over.ID {
+		t.Fatalf("expected the two divergent entries oldest first, got %+v", r.Items)
+	}
+	if r.TotalDifference != 0 {
+		t.Errorf("expected +1h and -1h to cancel out, got %v", r.TotalDifference)
+	}
+
+	resp, err := h.ReconcileTimeEntry(ctx, api.ReconcileTimeEntryRequestObject{
+		Id:   over.ID,
+		Body: &api.ReconcileRequest{Resolution: api.AcceptComputed},
+	})
+	if err != nil {
+		t.Fatalf("ReconcileTimeEntry: %v", err)
+	}
+	if e, ok := resp.(api.ReconcileTimeEntry200JSONResponse); !ok || e.Hours != 4 {
+		t.Fatalf("expected accepted entry with 4h, got %#v", resp)
+	}
+
+	if _, err := h.ReconcileTimeEntry(ctx, api.ReconcileTimeEntryRequestObject{
+		Id:   under.ID,
+		Body: &api.ReconcileRequest{Resolution: api.KeepManual},
+	}); err != nil {
+		t.Fatalf("ReconcileTimeEntry: %v", err)
+	}
+
+	if r := report(false); len(r.Items) != 0 {
+		t.Errorf("expected no unacknowledged divergence, got %d items", len(r.Items))
+	}
+	if r := report(true); len(r.Items) != 1 || r.Items[0].Entry.Id != under.ID {
+		t.Errorf("expected the kept entry when including acknowledged, got %+v", r.Items)
+	}
+}
+
+func TestTimeEntryHandler_ParseTimeEntry(t *testing.T) {
+	mem := memstore.New()
+	h := NewTimeEntryHandler(mem.TimeEntries, mem.Projects, nil)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+	project, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+	commit := true
+
+	resp, err := h.ParseTimeEntry(ctx, api.ParseTimeEntryRequestObject{
+		Body: &api.TimeEntryParseRequest{Text: "2h yesterday on Acme — code review"},
+	})
+	if err != nil {
+		t.Fatalf("ParseTimeEntry: %v", err)
+	}
+	draft, ok := resp.(api.ParseTimeEntry200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200, got %T", resp)
+	}
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+	if !draft.Complete || draft.Hours != 2 || draft.Date.String() != yesterday || *draft.ProjectId != project.ID {
+		t.Errorf("unexpected draft %+v", draft)
+	}
+	if draft.Entry != nil {
+		t.Error("expected no entry without commit")
+	}
+
+	resp, err = h.ParseTimeEntry(ctx, api.ParseTimeEntryRequestObject{
+		Params: api.ParseTimeEntryParams{Commit: &commit},
+		Body:   &api.TimeEntryParseRequest{Text: "90m acme: planning"},
+	})
+	if err != nil {
+		t.Fatalf("ParseTimeEntry: %v", err)
+	}
+	committed, ok := resp.(api.ParseTimeEntry200JSONResponse)
+	if !ok || committed.Entry == nil {
+		t.Fatalf("expected a committed entry, got %+v", resp)
+	}
+	if committed.Entry.Hours != 1.5 || *committed.Entry.Description != "planning" {
+		t.Errorf("unexpected entry %+v", committed.Entry)
+	}
+
+	resp, err = h.ParseTimeEntry(ctx, api.ParseTimeEntryRequestObject{
+		Params: api.ParseTimeEntryParams{Commit: &commit},
+		Body:   &api.TimeEntryParseRequest{Text: "3h on Initech"},
+	})
+	if err != nil {
+		t.Fatalf("ParseTimeEntry: %v", err)
+	}
+	if _, ok := resp.(api.ParseTimeEntry400JSONResponse); !ok {
+		t.Errorf("expected 400 for an incomplete draft, got %T", resp)
+	}
+}
+
+func TestTimeEntryHandler_SplitTimeEntry(t *testing.T) {
+	mem := memstore.New()
+	h := NewTimeEntryHandler(mem.TimeEntries, mem.Projects, nil)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+	acme, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+	initech, _ := mem.Projects.Create(ctx, userID, "Initech", nil, nil, "#000000", true, false, false)
+	globex, _ := mem.Projects.Create(ctx, userID, "Globex", nil, nil, "#000000", true, false, false)
+	date := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	eventID := uuid.New()
+	original := &store.TimeEntry{UserID: userID, ProjectID: acme.ID, Date: date, Hours: 4, Source: "calendar", ContributingEvents: []uuid.UUID{eventID}}
+	mem.TimeEntries.Put(original)
+
+	split := func(parts ...api.TimeEntrySplitPart) api.SplitTimeEntryResponseObject {
+		t.Helper()
+		resp, err := h.SplitTimeEntry(ctx, api.SplitTimeEntryRequestObject{
+			Id:   original.ID,
+			Body: &api.TimeEntrySplit{Parts: parts},
+		})
+		if err != nil {
+			t.Fatalf("SplitTimeEntry: %v", err)
+		}
+		return resp
+	}
+
+	if _, ok := split(
+		api.TimeEntrySplitPart{ProjectId: acme.ID, Hours: 1},
+		api.TimeEntrySplitPart{ProjectId: initech.ID, Hours: 2},
+	).(api.SplitTimeEntry400JSONResponse); !ok {
+		t.Error("expected 400 when the parts don't add up to the entry's hours")
+	}
+	if _, ok := split(
+		api.TimeEntrySplitPart{ProjectId: initech.ID, Hours: 2},
+		api.TimeEntrySplitPart{ProjectId: initech.ID, Hours: 2},
+	).(api.SplitTimeEntry400JSONResponse); !ok {
+		t.Error("expected 400 for two parts on the same project")
+	}
+
+	// Keeping part of the time on the original project updates the entry in place
+	resp := split(
+		api.TimeEntrySplitPart{ProjectId: acme.ID, Hours: 1.5},
+		api.TimeEntrySplitPart{ProjectId: initech.ID, Hours: 2.5},
+	)
+	parts, ok := resp.(api.SplitTimeEntry200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200, got %T", resp)
+	}
+	if parts[0].Id != original.ID || parts[0].Hours != 1.5 || *parts[0].IsSuppressed {
+		t.Errorf("expected the original to keep 1.5h, got %+v", parts[0])
+	}
+	if parts[0].ContributingEvents == nil || (*parts[0].ContributingEvents)[0] != eventID {
+		t.Error("expected the original to keep its contributing events")
+	}
+	if parts[1].ProjectId != initech.ID || parts[1].Hours != 2.5 || parts[1].Source != api.TimeEntrySourceManual || parts[1].ContributingEvents != nil {
+		t.Errorf("expected a manual 2.5h Initech entry without events, got %+v", parts[1])
+	}
+
+	// Moving all of the time elsewhere suppresses the original
+	if _, ok := split(
+		api.TimeEntrySplitPart{ProjectId: globex.ID, Hours: 1},
+		api.TimeEntrySplitPart{ProjectId: initech.ID, Hours: 0.5},
+	).(api.SplitTimeEntry409JSONResponse); !ok {
+		t.Error("expected 409 when a part's project already has an entry that day")
+	}
+	trashed, _ := mem.TimeEntries.GetByID(ctx, userID, parts[1].Id)
+	if err := mem.TimeEntries.Trash(ctx, userID, trashed.ID); err != nil {
+		t.Fatalf("Trash: %v", err)
+	}
+	resp = split(
+		api.TimeEntrySplitPart{ProjectId: globex.ID, Hours: 1},
+		api.TimeEntrySplitPart{ProjectId: initech.ID, Hours: 0.5},
+	)
+	if parts, ok = resp.(api.SplitTimeEntry200JSONResponse); !ok {
+		t.Fatalf("expected 200, got %T", resp)
+	}
+	if parts[1].Id != trashed.ID || parts[1].DeletedAt != nil {
+		t.Errorf("expected the trashed Initech entry to be reused, got %+v", parts[1])
+	}
+	got, _ := mem.TimeEntries.GetByID(ctx, userID, original.ID)
+	if !got.IsSuppressed {
+		t.Error("expected the original to be suppressed")
+	}
+}
+
+func TestTimeEntryHandler_GetTimeEntryBreakdown(t *testing.T) {
+	mem := memstore.New()
+	h := NewTimeEntryHandler(mem.TimeEntries, mem.Projects, nil)
+	userID := uuid.New()
+	ctx := authedContext(userID)
+	acme, _ := mem.Projects.Create(ctx, userID, "Acme", nil, nil, "#000000", true, false, false)
+	date := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	events := []analyzer.Event{
+		{ID: uuid.New(), ProjectID: acme.ID, Title: "Planning", StartTime: date.Add(9 * time.Hour), EndTime: date.Add(10 * time.Hour)},
+		{ID: uuid.New(), ProjectID: acme.ID, Title: "Review", StartTime: date.Add(9*time.Hour + 30*time.Minute), EndTime: date.Add(10*time.Hour + 20*time.Minute)},
+	}
+	computed := analyzer.Compute(date, events, analyzer.DefaultRoundingConfig())[0]
+	details, _ := json.Marshal(computed.CalculationDetails)
+	entry, err := mem.TimeEntries.UpsertFromComputed(ctx, userID, acme.ID, date, computed.Hours, computed.Title, computed.Description, details, computed.ContributingEvents)
+	if err != nil {
+		t.Fatalf("UpsertFromComputed: %v", err)
+	}
+
+	resp, err := h.GetTimeEntryBreakdown(ctx, api.GetTimeEntryBreakdownRequestObject{Id: entry.ID})
+	if err != nil {
+		t.Fatalf("GetTimeEntryBreakdown: %v", err)
+	}
+	b, ok := resp.(api.GetTimeEntryBreakdown200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200, got %T", resp)
+	}
+	if b.UnionMinutes != 80 || b.FinalMinutes != 90 || b.RoundingApplied != "+10m" {
+		t.Errorf("expected 80m rounded +10m to 90m, got %dm %s %dm", b.UnionMinutes, b.RoundingApplied, b.FinalMinutes)
+	}
+	if len(b.Events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(b.Events))
+	}
+	review := b.Events[1]
+	if review.CountedMinutes != 20 || review.OverlapMinutes != 30 {
+		t.Errorf("expected Review to count 20m with 30m overlap, got %dm and %dm", review.CountedMinutes, review.OverlapMinutes)
+	}
+	if review.CountedStart == nil || !review.CountedStart.Equal(date.Add(10*time.Hour)) {
+		t.Errorf("expected Review to count from 10:00, got %v", review.CountedStart)
+	}
+
+	manual, _ := mem.TimeEntries.Create(ctx, userID, acme.ID, date.AddDate(0, 0, 1), 2, nil)
+	resp, _ = h.GetTimeEntryBreakdown(ctx, api.GetTimeEntryBreakdownRequestObject{Id: manual.ID})
+	if b, ok := resp.(api.GetTimeEntryBreakdown200JSONResponse); !ok || len(b.Events) != 0 || b.RoundingApplied != "none" {
+		t.Errorf("expected an empty breakdown for a manual entry, got %+v", resp)
+	}
+
+	resp, _ = h.GetTimeEntryBreakdown(ctx, api.GetTimeEntryBreakdownRequestObject{Id: uuid.New()})
+	if _, ok := resp.(api.GetTimeEntryBreakdown404JSONResponse); !ok {
+		t.Errorf("expected 404 for an unknown entry, got %T", resp)
+	}
+}
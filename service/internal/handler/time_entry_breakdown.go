@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/analyzer"
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// GetTimeEntryBreakdown shows how an entry's computed hours were derived
+// from its events
+func (h *TimeEntryHandler) GetTimeEntryBreakdown(ctx context.Context, req api.GetTimeEntryBreakdownRequestObject) (api.GetTimeEntryBreakdownResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetTimeEntryBreakdown401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	entry, err := h.entries.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.GetTimeEntryBreakdown404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.GetTimeEntryBreakdown200JSONResponse(timeEntryBreakdown(entry)), nil
+}
+
+// timeEntryBreakdown builds the breakdown of an entry from its stored
+// calculation. Entries without one, such as manual entries, get an empty
+// breakdown.
+func timeEntryBreakdown(entry *store.TimeEntry) api.TimeEntryBreakdown {
+	var details analyzer.CalculationDetails
+	if len(entry.CalculationDetails) > 0 {
+		if err := json.Unmarshal(entry.CalculationDetails, &details); err != nil {
+			details = analyzer.CalculationDetails{}
+		}
+	}
+	if details.RoundingApplied == "" {
+		details.RoundingApplied = "none"
+	}
+
+	b := api.TimeEntryBreakdown{
+		TimeEntryId:     entry.ID,
+		ProjectId:       entry.ProjectID,
+		Date:            openapi_types.Date{Time: entry.Date},
+		Hours:           entry.Hours,
+		ComputedHours:   entry.ComputedHours,
+		Events:          []api.TimeEntryBreakdownEvent{},
+		UnionMinutes:    details.UnionMinutes,
+		RoundingApplied: details.RoundingApplied,
+		FinalMinutes:    details.FinalMinutes,
+	}
+
+	for _, c := range analyzer.Contributions(details) {
+		e := api.TimeEntryBreakdownEvent{
+			EventId:        c.ID,
+			Title:          c.Title,
+			IsAllDay:       c.IsAllDay,
+			RawMinutes:     c.RawMinutes,
+			CountedMinutes: c.CountedMinutes,
+			OverlapMinutes: c.OverlapMinutes,
+		}
+		if !c.Start.IsZero() {
+			start := c.Start
+			e.Start = &start
+		}
+		if !c.End.IsZero() {
+			end := c.End
+			e.End = &end
+		}
+		if !c.CountedStart.IsZero() {
+			countedStart, countedEnd := c.CountedStart, c.CountedEnd
+			e.CountedStart = &countedStart
+			e.CountedEnd = &countedEnd
+		}
+		b.Events = append(b.Events, e)
+	}
+
+	b.TimeRanges = make([]struct {
+		End     string `json:"end"`
+		Minutes int    `json:"minutes"`
+		Start   string `json:"start"`
+	}, len(details.TimeRanges))
+	for i, r := range details.TimeRanges {
+		b.TimeRanges[i].Start = r.Start
+		b.TimeRanges[i].End = r.End
+		b.TimeRanges[i].Minutes = r.Minutes
+	}
+
+	return b
+}
+
+// formatTimeEntryBreakdown renders a breakdown as markdown
+func formatTimeEntryBreakdown(b api.TimeEntryBreakdown, projectName string) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# %s on %s\n\n", projectName, b.Date.Format("2006-01-02")))
+	sb.WriteString(fmt.Sprintf("**Hours:** %.2f", b.Hours))
+	if b.ComputedHours != nil && *b.ComputedHours != b.Hours {
+		sb.WriteString(fmt.Sprintf(" (computed %.2f)", *b.ComputedHours))
+	}
+	sb.WriteString("\n\n")
+
+	if len(b.Events) == 0 {
+		sb.WriteString("No contributing events. The entry was not computed from the calendar.\n")
+		return sb.String()
+	}
+
+	sb.WriteString("## Events\n\n")
+	sb.WriteString("| Event | Time | Counted | Overlap |\n")
+	sb.WriteString("|-------|------|---------|---------|\n")
+	for _, e := range b.Events {
+		timeStr := "all day"
+		counted := "-"
+		if !e.IsAllDay {
+			timeStr = "?"
+			if e.Start != nil && e.End != nil {
+				timeStr = fmt.Sprintf("%s-%s (%dm)", e.Start.Format("15:04"), e.End.Format("15:04"), e.RawMinutes)
+			}
+			counted = "0m"
+			if e.CountedStart != nil && e.CountedEnd != nil {
+				counted = fmt.Sprintf("%s-%s (%dm)", e.CountedStart.Format("15:04"), e.CountedEnd.Format("15:04"), e.CountedMinutes)
+			}
+		}
+		overlap := "-"
+		if e.OverlapMinutes > 0 {
+			overlap = fmt.Sprintf("%dm", e.OverlapMinutes)
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n", e.Title, timeStr, counted, overlap))
+	}
+
+	if len(b.TimeRanges) > 0 {
+		sb.WriteString("\n## Merged Time\n\n")
+		for _, r := range b.TimeRanges {
+			sb.WriteString(fmt.Sprintf("- %s-%s (%dm)\n", r.Start, r.End, r.Minutes))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\n**Union:** %dm, **Rounding:** %s, **Final:** %dm\n", b.UnionMinutes, b.RoundingApplied, b.FinalMinutes))
+
+	return sb.String()
+}
+
+// readTimeEntryBreakdown renders the breakdown resource for a
+// timesheet://time-entries/{id}/breakdown URI. It reports false when the URI
+// isn't one, or names an entry the user doesn't have.
+func (h *MCPHandler) readTimeEntryBreakdown(ctx context.Context, userID uuid.UUID, uri string) (string, bool, error) {
+	rest, ok := strings.CutPrefix(uri, "timesheet://time-entries/")
+	if !ok {
+		return "", false, nil
+	}
+	idStr, ok := strings.CutSuffix(rest, "/breakdown")
+	if !ok {
+		return "", false, nil
+	}
+	entryID, err := uuid.Parse(idStr)
+	if err != nil {
+		return "", false, nil
+	}
+
+	entry, err := h.entries.GetByID(ctx, userID, entryID)
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	projectName := entry.ProjectID.String()
+	if project, _ := h.readModel.Project(ctx, userID, entry.ProjectID); project != nil {
+		projectName = project.Name
+	}
+	return formatTimeEntryBreakdown(timeEntryBreakdown(entry), projectName), true, nil
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/mcp"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"github.com/michaelw/timesheet-app/service/internal/timeentry"
+)
+
+// ExplainTimeEntry explains why an entry has its hours: the calculation,
+// the events left out and how the entry is protected
+func (h *TimeEntryHandler) ExplainTimeEntry(ctx context.Context, req api.ExplainTimeEntryRequestObject) (api.ExplainTimeEntryResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ExplainTimeEntry401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	entry, err := h.entries.GetByID(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.ExplainTimeEntry404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	x, err := h.timeEntryService.Explain(ctx, userID, entry)
+	if err != nil {
+		return nil, err
+	}
+	return api.ExplainTimeEntry200JSONResponse(timeEntryExplanation(x)), nil
+}
+
+// timeEntryExplanation converts an explanation to its API form
+func timeEntryExplanation(x *timeentry.Explanation) api.TimeEntryExplanation {
+	entry := x.Entry
+	out := api.TimeEntryExplanation{
+		Breakdown:      timeEntryBreakdown(entry),
+		ExcludedEvents: make([]api.TimeEntryExcludedEvent, len(x.Excluded)),
+		Protection: api.TimeEntryProtection{
+			Invoiced:   entry.InvoiceID != nil,
+			InvoiceId:  entry.InvoiceID,
+			UserEdited: entry.HasUserEdits,
+			Stale:      entry.IsStale,
+			Suppressed: entry.IsSuppressed,
+			Deleted:    entry.DeletedAt != nil,
+		},
+		Narrative: x.Narrative,
+	}
+	for i, e := range x.Excluded {
+		out.ExcludedEvents[i] = api.TimeEntryExcludedEvent{
+			EventId:   e.ID,
+			Title:     e.Title,
+			StartTime: e.StartTime,
+			EndTime:   e.EndTime,
+			Reason:    api.TimeEntryExcludedEventReason(e.Reason),
+		}
+	}
+	if out.Narrative == nil {
+		out.Narrative = []string{}
+	}
+	return out
+}
+
+// ExplainTimeEntry implements the explain_time_entry tool
+func (h *MCPHandler) ExplainTimeEntry(ctx context.Context, userID uuid.UUID, args mcp.ExplainTimeEntryArgs) (any, error) {
+	var entry *store.TimeEntry
+	var err error
+	switch {
+	case stringValue(args.TimeEntryID) != "":
+		entryID, parseErr := uuid.Parse(*args.TimeEntryID)
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid time_entry_id: %w", parseErr)
+		}
+		entry, err = h.entries.GetByID(ctx, userID, entryID)
+	case stringValue(args.ProjectID) != "" && stringValue(args.Date) != "":
+		projectID, parseErr := uuid.Parse(*args.ProjectID)
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid project_id: %w", parseErr)
+		}
+		date, parseErr := time.Parse("2006-01-02", *args.Date)
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid date (use YYYY-MM-DD): %w", parseErr)
+		}
+		entry, err = h.entries.GetByProjectAndDate(ctx, userID, projectID, date)
+	default:
+		return nil, fmt.Errorf("time_entry_id, or project_id and date, are required")
+	}
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return nil, fmt.Errorf("time entry not found")
+		}
+		return nil, fmt.Errorf("failed to get time entry: %w", err)
+	}
+
+	x, err := h.classificationSvc.ExplainTimeEntry(ctx, userID, entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain time entry: %w", err)
+	}
+
+	projectName := entry.ProjectID.String()
+	if project, _ := h.readModel.Project(ctx, userID, entry.ProjectID); project != nil {
+		projectName = project.Name
+	}
+
+	var sb strings.Builder
+	sb.WriteString(formatTimeEntryBreakdown(timeEntryBreakdown(entry), projectName))
+	sb.WriteString("\n## Why\n\n")
+	for _, step := range x.Narrative {
+		sb.WriteString("- " + step + "\n")
+	}
+
+	return toolResult(sb.String(), map[string]any{"explanation": timeEntryExplanation(x)}), nil
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TimeEntryNoteHandler implements the time entry note endpoints
+type TimeEntryNoteHandler struct {
+	notes   *store.TimeEntryNoteStore
+	entries TimeEntryStore
+}
+
+// NewTimeEntryNoteHandler creates a new time entry note handler
+func NewTimeEntryNoteHandler(notes *store.TimeEntryNoteStore, entries TimeEntryStore) *TimeEntryNoteHandler {
+	return &TimeEntryNoteHandler{
+		notes:   notes,
+		entries: entries,
+	}
+}
+
+// ListTimeEntryNotes returns the notes on a time entry
+func (h *TimeEntryNoteHandler) ListTimeEntryNotes(ctx context.Context, req api.ListTimeEntryNotesRequestObject) (api.ListTimeEntryNotesResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListTimeEntryNotes401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	// Verify the entry exists so an unknown ID isn't reported as "no notes"
+	if _, err := h.entries.GetByID(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.ListTimeEntryNotes404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	notes, err := h.notes.List(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.TimeEntryNote, len(notes))
+	for i, n := range notes {
+		result[i] = timeEntryNoteToAPI(n)
+	}
+
+	return api.ListTimeEntryNotes200JSONResponse(result), nil
+}
+
+// AddTimeEntryNote appends a note to a time entry
+func (h *TimeEntryNoteHandler) AddTimeEntryNote(ctx context.Context, req api.AddTimeEntryNoteRequestObject) (api.AddTimeEntryNoteResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.AddTimeEntryNote401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil || strings.TrimSpace(req.Body.Body) == "" {
+		return api.AddTimeEntryNote400JSONResponse{
+			Code:    "invalid_request",
+			Message: "Note body is required",
+		}, nil
+	}
+
+	note, err := h.notes.Add(ctx, userID, req.Id, strings.TrimSpace(req.Body.Body))
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.AddTimeEntryNote404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.AddTimeEntryNote201JSONResponse(timeEntryNoteToAPI(note)), nil
+}
+
+// DeleteTimeEntryNote removes a note from a time entry
+func (h *TimeEntryNoteHandler) DeleteTimeEntryNote(ctx context.Context, req api.DeleteTimeEntryNoteRequestObject) (api.DeleteTimeEntryNoteResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.DeleteTimeEntryNote401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if err := h.notes.Delete(ctx, userID, req.Id, req.NoteId); err != nil {
+		if errors.Is(err, store.ErrTimeEntryNoteNotFound) {
+			return api.DeleteTimeEntryNote404JSONResponse{
+				Code:    "not_found",
+				Message: "Note not found",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.DeleteTimeEntryNote204Response{}, nil
+}
+
+func timeEntryNoteToAPI(n *store.TimeEntryNote) api.TimeEntryNote {
+	return api.TimeEntryNote{
+		Id:          n.ID,
+		TimeEntryId: n.TimeEntryID,
+		UserId:      n.UserID,
+		AuthorName:  n.AuthorName,
+		Body:        n.Body,
+		CreatedAt:   n.CreatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/quickentry"
+)
+
+// ParseTimeEntry parses a free-text time entry into a draft, creating the
+// entry when commit is requested and the draft is complete
+func (h *TimeEntryHandler) ParseTimeEntry(ctx context.Context, req api.ParseTimeEntryRequestObject) (api.ParseTimeEntryResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ParseTimeEntry401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	if req.Body == nil || strings.TrimSpace(req.Body.Text) == "" {
+		return api.ParseTimeEntry400JSONResponse{
+			Code:    "invalid_request",
+			Message: "text is required",
+		}, nil
+	}
+
+	loc := time.UTC
+	if req.Body.Timezone != nil && *req.Body.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(*req.Body.Timezone)
+		if err != nil {
+			return api.ParseTimeEntry400JSONResponse{
+				Code:    "invalid_request",
+				Message: "Unknown timezone: " + *req.Body.Timezone,
+			}, nil
+		}
+	}
+
+	projects, err := h.projects.List(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]quickentry.Project, len(projects))
+	for i, p := range projects {
+		candidates[i] = quickentry.Project{
+			ID:       p.ID,
+			Name:     p.Name,
+			Keywords: p.FingerprintKeywords,
+			Domains:  p.FingerprintDomains,
+		}
+		if p.ShortCode != nil {
+			candidates[i].ShortCode = *p.ShortCode
+		}
+	}
+
+	draft := quickentry.Parse(req.Body.Text, time.Now().In(loc), candidates)
+	result := timeEntryDraftToAPI(draft)
+
+	if req.Params.Commit != nil && *req.Params.Commit {
+		if !draft.Complete() {
+			return api.ParseTimeEntry400JSONResponse{
+				Code:    "incomplete_draft",
+				Message: "Cannot create the entry: " + strings.Join(draft.Warnings, "; "),
+			}, nil
+		}
+		var description *string
+		if draft.Description != "" {
+			description = &draft.Description
+		}
+		entry, err := h.entries.Create(ctx, userID, *draft.ProjectID, draft.Date, draft.Hours, description)
+		if err != nil {
+			return nil, err
+		}
+		apiEntry := timeEntryToAPI(entry)
+		result.Entry = &apiEntry
+	}
+
+	return api.ParseTimeEntry200JSONResponse(result), nil
+}
+
+func timeEntryDraftToAPI(d quickentry.Draft) api.TimeEntryDraft {
+	out := api.TimeEntryDraft{
+		Hours:     float32(d.Hours),
+		Date:      openapi_types.Date{Time: d.Date},
+		ProjectId: d.ProjectID,
+		Warnings:  d.Warnings,
+		Complete:  d.Complete(),
+	}
+	if out.Warnings == nil {
+		out.Warnings = []string{}
+	}
+	if d.ProjectID != nil {
+		name := d.ProjectName
+		match := string(d.Match)
+		out.ProjectName = &name
+		out.Match = &match
+	}
+	if d.Description != "" {
+		description := d.Description
+		out.Description = &description
+	}
+	return out
+}
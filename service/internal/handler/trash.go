@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TrashRetention is how long deleted time entries and rules can be restored
+// before the purge job removes them
+const TrashRetention = 30 * 24 * time.Hour
+
+// TrashHandler implements the trash endpoints
+type TrashHandler struct {
+	entries *store.TimeEntryStore
+	rules   *store.ClassificationRuleStore
+}
+
+// NewTrashHandler creates a new trash handler
+func NewTrashHandler(entries *store.TimeEntryStore, rules *store.ClassificationRuleStore) *TrashHandler {
+	return &TrashHandler{
+		entries: entries,
+		rules:   rules,
+	}
+}
+
+// ListTrash returns the user's deleted time entries and rules
+func (h *TrashHandler) ListTrash(ctx context.Context, req api.ListTrashRequestObject) (api.ListTrashResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.ListTrash401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	entries, err := h.entries.ListTrashed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := h.rules.ListTrashed(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := api.Trash{
+		TimeEntries: make([]api.TimeEntry, len(entries)),
+		Rules:       make([]api.ClassificationRule, len(rules)),
+	}
+	for i, e := range entries {
+		result.TimeEntries[i] = timeEntryToAPI(e)
+	}
+	for i, r := range rules {
+		result.Rules[i] = ruleToAPI(r)
+	}
+
+	return api.ListTrash200JSONResponse(result), nil
+}
+
+// RestoreTimeEntry takes a time entry out of the trash
+func (h *TrashHandler) RestoreTimeEntry(ctx context.Context, req api.RestoreTimeEntryRequestObject) (api.RestoreTimeEntryResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RestoreTimeEntry401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	entry, err := h.entries.Restore(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrTimeEntryNotFound) {
+			return api.RestoreTimeEntry404JSONResponse{
+				Code:    "not_found",
+				Message: "Time entry not found in trash",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.RestoreTimeEntry200JSONResponse(timeEntryToAPI(entry)), nil
+}
+
+// RestoreRule takes a rule out of the trash
+func (h *TrashHandler) RestoreRule(ctx context.Context, req api.RestoreRuleRequestObject) (api.RestoreRuleResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.RestoreRule401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	rule, err := h.rules.Restore(ctx, userID, req.Id)
+	if err != nil {
+		if errors.Is(err, store.ErrClassificationRuleNotFound) {
+			return api.RestoreRule404JSONResponse{
+				Code:    "not_found",
+				Message: "Rule not found in trash",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return api.RestoreRule200JSONResponse(ruleToAPI(rule)), nil
+}
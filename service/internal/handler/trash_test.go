@@ -0,0 +1,116 @@
+//go:build integration
+
+package handler
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestTrashRestore deletes an entry and a rule through their handlers and
+// brings them back from the trash
+func TestTrashRestore(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	t.Cleanup(db.Close)
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "trash-"+uuid.New().String()[:8]+"@test.com", "Trash Test", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Pool.Exec(context.Background(), "DELETE FROM users WHERE id = $1", user.ID)
+	})
+	ctx = authedContext(user.ID)
+
+	projects := store.NewProjectStore(db.Pool)
+	entries := store.NewTimeEntryStore(db.Pool)
+	rules := store.NewClassificationRuleStore(db.Pool)
+	h := NewTrashHandler(entries, rules)
+
+	project, err := projects.Create(ctx, user.ID, "Acme", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	entry, err := entries.Create(ctx, user.ID, project.ID, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), 1.5, nil)
+	if err != nil {
+		t.Fatalf("Failed to create time entry: %v", err)
+	}
+	rule, err := rules.Create(ctx, &store.ClassificationRule{UserID: user.ID, Query: "title:acme", ProjectID: &project.ID, IsEnabled: true})
+	if err != nil {
+		t.Fatalf("Failed to create rule: %v", err)
+	}
+
+	if resp, err := NewTimeEntryHandler(entries, projects, nil).DeleteTimeEntry(ctx, api.DeleteTimeEntryRequestObject{Id: entry.ID}); err != nil {
+		t.Fatalf("DeleteTimeEntry: %v", err)
+	} else if _, ok := resp.(api.DeleteTimeEntry204Response); !ok {
+		t.Fatalf("expected 204, got %T", resp)
+	}
+	if err := rules.Trash(ctx, user.ID, rule.ID); err != nil {
+		t.Fatalf("Failed to trash rule: %v", err)
+	}
+
+	listed, err := h.ListTrash(ctx, api.ListTrashRequestObject{})
+	if err != nil {
+		t.Fatalf("ListTrash: %v", err)
+	}
+	trash, ok := listed.(api.ListTrash200JSONResponse)
+	if !ok {
+		t.Fatalf("expected 200, got %T", listed)
+	}
+	if len(trash.TimeEntries) != 1 || trash.TimeEntries[0].Id != entry.ID {
+		t.Errorf("expected the deleted entry in the trash, got %+v", trash.TimeEntries)
+	}
+	if len(trash.Rules) != 1 || trash.Rules[0].Id != rule.ID {
+		t.Errorf("expected the deleted rule in the trash, got %+v", trash.Rules)
+	}
+
+	restored, err := h.RestoreTimeEntry(ctx, api.RestoreTimeEntryRequestObject{Id: entry.ID})
+	if err != nil {
+		t.Fatalf("RestoreTimeEntry: %v", err)
+	}
+	if e, ok := restored.(api.RestoreTimeEntry200JSONResponse); !ok || e.Hours != 1.5 {
+		t.Errorf("expected the entry back with 1.5h, got %#v", restored)
+	}
+	again, err := h.RestoreTimeEntry(ctx, api.RestoreTimeEntryRequestObject{Id: entry.ID})
+	if err != nil {
+		t.Fatalf("RestoreTimeEntry: %v", err)
+	}
+	if _, ok := again.(api.RestoreTimeEntry404JSONResponse); !ok {
+		t.Errorf("expected 404 for an entry no longer in the trash, got %T", again)
+	}
+
+	restoredRule, err := h.RestoreRule(ctx, api.RestoreRuleRequestObject{Id: rule.ID})
+	if err != nil {
+		t.Fatalf("RestoreRule: %v", err)
+	}
+	if r, ok := restoredRule.(api.RestoreRule200JSONResponse); !ok || r.Query != rule.Query {
+		t.Errorf("expected the rule back, got %#v", restoredRule)
+	}
+	missing, err := h.RestoreRule(ctx, api.RestoreRuleRequestObject{Id: uuid.New()})
+	if err != nil {
+		t.Fatalf("RestoreRule: %v", err)
+	}
+	if _, ok := missing.(api.RestoreRule404JSONResponse); !ok {
+		t.Errorf("expected 404 for an unknown rule, got %T", missing)
+	}
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIVersionHeader lets clients of unversioned /api paths pick a version,
+// and reports the version that served each API response
+const APIVersionHeader = "API-Version"
+
+// APIVersion is a supported API version and its deprecation schedule.
+// The handlers implement the newest contract; older versions list the shims
+// that turn its responses back into theirs.
+type APIVersion struct {
+	// Name is the path segment, e.g. "v2"
+	Name string
+	// Deprecated is when the version was deprecated. Responses carry a
+	// Deprecation header once set, even before the date.
+	Deprecated *time.Time
+	// Sunset is when the version stops being served, announced in a Sunset
+	// header. Afterwards its requests get 410 Gone.
+	Sunset *time.Time
+	// Successor is the version clients should move to, linked from
+	// responses of a deprecated version
+	Successor string
+	Shims     []APIShim
+}
+
+// APIShim adapts the responses of one route to an older version's contract.
+// Path segments in braces match any single segment, as for IdempotentRoute.
+// Transform is given successful JSON responses only.
+type APIShim struct {
+	Method    string
+	Pattern   string
+	Transform func(body []byte) ([]byte, error)
+}
+
+// APIVersioning configures APIVersionMiddleware
+type APIVersioning struct {
+	Versions []APIVersion
+	// Default serves unversioned /api paths without an API-Version header,
+	// which is how existing API key users call the API
+	Default string
+}
+
+// DefaultAPIVersioning serves v2, the current contract, at /api/v2 and at
+// the unversioned paths. v3 is where breaking changes such as cursor
+// pagination and the client entity land; until then it matches v2.
+var DefaultAPIVersioning = APIVersioning{
+	Versions: []APIVersion{
+		{Name: "v2"},
+		{Name: "v3"},
+	},
+	Default: "v2",
+}
+
+type apiVersionKey struct{}
+
+// APIVersionFromContext returns the API version serving the request
+func APIVersionFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(apiVersionKey{}).(string)
+	return v, ok
+}
+
+var versionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// APIVersionMiddleware routes /api/{version}/... to the unversioned routes,
+// recording the version in the request context. Unversioned /api paths use
+// the API-Version header or the default version. Responses of deprecated
+// versions carry Deprecation, Sunset and Link headers, and the version's
+// shims rewrite the responses of their routes.
+func APIVersionMiddleware(cfg APIVersioning) func(http.Handler) http.Handler {
+	versions := make(map[string]*APIVersion, len(cfg.Versions))
+	for i := range cfg.Versions {
+		versions[cfg.Versions[i].Name] = &cfg.Versions[i]
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rest, ok := strings.CutPrefix(r.URL.Path, "/api/")
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			name := cfg.Default
+			if segment, tail, _ := strings.Cut(rest, "/"); versionSegment.MatchString(segment) {
+				name = segment
+				r = withPath(r, "/api/"+tail)
+			} else if header := r.Header.Get(APIVersionHeader); header != "" {
+				name = header
+			}
+
+			version, ok := versions[name]
+			if !ok {
+				writeAPIVersionError(w, http.StatusNotFound, "unsupported_api_version", fmt.Sprintf("API version %q is not supported", name))
+				return
+			}
+
+			setDeprecationHeaders(w.Header(), version, r.URL.Path)
+			w.Header().Set(APIVersionHeader, version.Name)
+			if version.Sunset != nil && time.Now().After(*version.Sunset) {
+				writeAPIVersionError(w, http.StatusGone, "api_version_sunset", fmt.Sprintf("API version %s is no longer served", version.Name))
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), apiVersionKey{}, version.Name))
+
+			shim := findShim(version.Shims, r.Method, r.URL.Path)
+			if shim == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			body := rec.body.Bytes()
+			if rec.status >= 200 && rec.status < 300 && strings.HasPrefix(rec.header.Get("Content-Type"), "application/json") {
+				shimmed, err := shim.Transform(body)
+				if err != nil {
+					log.Printf("API %s shim for %s %s failed: %v", version.Name, shim.Method, shim.Pattern, err)
+					writeAPIVersionError(w, http.StatusInternalServerError, "internal_error", "Failed to adapt response to API version "+version.Name)
+					return
+				}
+				body = shimmed
+			}
+
+			for k, v := range rec.header {
+				w.Header()[k] = v
+			}
+			w.Header().Del("Content-Length")
+			w.WriteHeader(rec.status)
+			w.Write(body)
+		})
+	}
+}
+
+// withPath returns a copy of r for a different path
+func withPath(r *http.Request, path string) *http.Request {
+	r2 := r.Clone(r.Context())
+	r2.URL.Path = path
+	r2.URL.RawPath = ""
+	r2.RequestURI = r2.URL.RequestURI()
+	return r2
+}
+
+// setDeprecationHeaders announces a version's deprecation and sunset, using
+// the formats of RFC 9745 and RFC 8594. The successor link points at the
+// same unversioned path under the successor version.
+func setDeprecationHeaders(h http.Header, v *APIVersion, path string) {
+	if v.Deprecated != nil {
+		h.Set("Deprecation", "@"+strconv.FormatInt(v.Deprecated.Unix(), 10))
+		if v.Successor != "" {
+			h.Add("Link", fmt.Sprintf(`</api/%s/%s>; rel="successor-version"`, v.Successor, strings.TrimPrefix(path, "/api/")))
+		}
+	}
+	if v.Sunset != nil {
+		h.Set("Sunset", v.Sunset.UTC().Format(http.TimeFormat))
+	}
+}
+
+// findShim returns the shim for a route, if the version has one
+func findShim(shims []APIShim, method, path string) *APIShim {
+	for i := range shims {
+		if shims[i].Method == method && matchPathPattern(shims[i].Pattern, path) {
+			return &shims[i]
+		}
+	}
+	return nil
+}
+
+func writeAPIVersionError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{
+		"code":    code,
+		"message": message,
+	})
+}
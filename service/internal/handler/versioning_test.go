@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIVersionMiddleware(t *testing.T) {
+	deprecated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Now().Add(30 * 24 * time.Hour)
+	gone := time.Now().Add(-time.Hour)
+
+	cfg := APIVersioning{
+		Versions: []APIVersion{
+			{Name: "v1", Deprecated: &deprecated, Sunset: &gone},
+			{Name: "v2", Deprecated: &deprecated, Sunset: &sunset, Successor: "v3", Shims: []APIShim{{
+				Method:  http.MethodGet,
+				Pattern: "/api/projects/{id}",
+				Transform: func(body []byte) ([]byte, error) {
+					return []byte(strings.Replace(string(body), `"client_id"`, `"client"`, 1)), nil
+				},
+			}}},
+			{Name: "v3"},
+		},
+		Default: "v2",
+	}
+
+	var gotPath, gotVersion string
+	h := APIVersionMiddleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotVersion, _ = APIVersionFromContext(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"client_id":"acme"}`))
+	}))
+
+	serve := func(path, header string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if header != "" {
+			req.Header.Set(APIVersionHeader, header)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve("/api/v3/projects/123", "")
+	if gotPath != "/api/projects/123" || gotVersion != "v3" {
+		t.Errorf("expected v3 routed to /api/projects/123, got %s %s", gotVersion, gotPath)
+	}
+	if rec.Header().Get(APIVersionHeader) != "v3" || rec.Header().Get("Deprecation") != "" {
+		t.Errorf("expected current version without deprecation headers, got %v", rec.Header())
+	}
+	if rec.Body.String() != `{"client_id":"acme"}` {
+		t.Errorf("expected v3 body untouched, got %s", rec.Body.String())
+	}
+
+	// Unversioned paths default to v2, which is deprecated and shimmed
+	rec = serve("/api/projects/123", "")
+	if gotPath != "/api/projects/123" || gotVersion != "v2" {
+		t.Errorf("expected default version v2, got %s %s", gotVersion, gotPath)
+	}
+	if got := rec.Header().Get("Deprecation"); got != "@1767225600" {
+		t.Errorf("Deprecation = %q", got)
+	}
+	if got := rec.Header().Get("Sunset"); got != sunset.UTC().Format(http.TimeFormat) {
+		t.Errorf("Sunset = %q", got)
+	}
+	if got := rec.Header().Get("Link"); got != `</api/v3/projects/123>; rel="successor-version"` {
+		t.Errorf("Link = %q", got)
+	}
+	if rec.Body.String() != `{"client":"acme"}` {
+		t.Errorf("expected v2 shim to rewrite body, got %s", rec.Body.String())
+	}
+
+	serve("/api/projects", "v3")
+	if gotVersion != "v3" {
+		t.Errorf("expected API-Version header to select v3, got %s", gotVersion)
+	}
+
+	if rec := serve("/api/v9/projects", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for unknown version, got %d", rec.Code)
+	}
+	if rec := serve("/api/v1/projects", ""); rec.Code != http.StatusGone {
+		t.Errorf("expected 410 after sunset, got %d", rec.Code)
+	}
+
+	gotPath = ""
+	serve("/health", "v9")
+	if gotPath != "/health" {
+		t.Errorf("expected non-API paths to pass through, got %q", gotPath)
+	}
+}
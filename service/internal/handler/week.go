@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/michaelw/timesheet-app/service/internal/api"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// GetWeekGrid returns a project × day hours matrix for the seven days
+// starting at the requested date
+func (h *DayHandler) GetWeekGrid(ctx context.Context, req api.GetWeekGridRequestObject) (api.GetWeekGridResponseObject, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return api.GetWeekGrid401JSONResponse{
+			Code:    "unauthorized",
+			Message: "Authentication required",
+		}, nil
+	}
+
+	start := req.Start.Time
+	end := start.AddDate(0, 0, 6)
+
+	h.syncRange(ctx, userID, start, end)
+
+	pending := store.StatusPending
+	events, err := h.events.List(ctx, userID, &start, &end, &pending, nil)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := h.timeEntryService.ListWithEphemeral(ctx, userID, &start, &end, nil)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := h.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetWeekGrid200JSONResponse(buildWeekGrid(start, events, entries, projects)), nil
+}
+
+// buildWeekGrid lays entries out by project and day. events are the week's
+// pending events.
+func buildWeekGrid(start time.Time, events []*store.CalendarEvent, entries []*store.TimeEntry, projects []*store.Project) api.WeekGrid {
+	grid := api.WeekGrid{
+		Start: openapi_types.Date{Time: start},
+		End:   openapi_types.Date{Time: start.AddDate(0, 0, 6)},
+		Days:  make([]api.WeekGridDay, 7),
+		Rows:  []api.WeekGridRow{},
+	}
+	for i := range grid.Days {
+		grid.Days[i].Date = openapi_types.Date{Time: start.AddDate(0, 0, i)}
+	}
+	dayIndex := func(t time.Time) int {
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return int(day.Sub(start).Hours() / 24)
+	}
+
+	for _, e := range events {
+		if i := dayIndex(e.StartTime.UTC()); i >= 0 && i < 7 {
+			grid.Days[i].UnclassifiedCount++
+		}
+	}
+
+	byID := make(map[uuid.UUID]*store.Project, len(projects))
+	for _, p := range projects {
+		byID[p.ID] = p
+	}
+	rows := make(map[uuid.UUID]*api.WeekGridRow)
+	for _, e := range entries {
+		p := byID[e.ProjectID]
+		i := dayIndex(e.Date)
+		if p == nil || i < 0 || i >= 7 {
+			continue
+		}
+		row, ok := rows[p.ID]
+		if !ok {
+			color := p.Color
+			row = &api.WeekGridRow{
+				ProjectId:              p.ID,
+				ProjectName:            p.Name,
+				Color:                  &color,
+				DoesNotAccumulateHours: p.DoesNotAccumulateHours,
+				Cells:                  make([]api.WeekGridCell, 7),
+			}
+			for d := range row.Cells {
+				row.Cells[d].Date = grid.Days[d].Date
+			}
+			rows[p.ID] = row
+		}
+
+		id := e.ID
+		hours := float32(e.Hours)
+		cell := &row.Cells[i]
+		cell.Hours += hours
+		cell.EntryId = &id
+		cell.InvoiceId = e.InvoiceID
+		cell.Locked = e.InvoiceID != nil
+		row.TotalHours += hours
+		if !p.DoesNotAccumulateHours {
+			grid.Days[i].Hours += hours
+			grid.TotalHours += hours
+		}
+	}
+
+	for _, row := range rows {
+		grid.Rows = append(grid.Rows, *row)
+	}
+	sort.Slice(grid.Rows, func(i, j int) bool {
+		return strings.ToLower(grid.Rows[i].ProjectName) < strings.ToLower(grid.Rows[j].ProjectName)
+	})
+	return grid
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestBuildWeekGrid(t *testing.T) {
+	start := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return start.AddDate(0, 0, offset) }
+	acme := &store.Project{ID: uuid.New(), Name: "acme"}
+	travel := &store.Project{ID: uuid.New(), Name: "Travel", DoesNotAccumulateHours: true}
+	invoiceID := uuid.New()
+
+	events := []*store.CalendarEvent{
+		{ID: uuid.New(), StartTime: day(0).Add(9 * time.Hour)},
+		{ID: uuid.New(), StartTime: day(0).Add(15 * time.Hour)},
+		{ID: uuid.New(), StartTime: day(4).Add(10 * time.Hour)},
+	}
+	entries := []*store.TimeEntry{
+		{ID: uuid.New(), ProjectID: acme.ID, Date: day(0), Hours: 2, InvoiceID: &invoiceID},
+		{ID: uuid.New(), ProjectID: acme.ID, Date: day(2), Hours: 1.5},
+		{ID: uuid.New(), ProjectID: travel.ID, Date: day(2), Hours: 4},
+	}
+
+	grid := buildWeekGrid(start, events, entries, []*store.Project{acme, travel})
+
+	if len(grid.Days) != 7 || !grid.End.Time.Equal(day(6)) {
+		t.Fatalf("expected seven days ending %s, got %d ending %s", day(6), len(grid.Days), grid.End)
+	}
+	if grid.Days[0].UnclassifiedCount != 2 || grid.Days[4].UnclassifiedCount != 1 {
+		t.Errorf("unexpected unclassified counts %+v", grid.Days)
+	}
+	if grid.Days[2].Hours != 1.5 || grid.TotalHours != 3.5 {
+		t.Errorf("expected non-accumulating hours left out of totals, got day %v total %v", grid.Days[2].Hours, grid.TotalHours)
+	}
+	if len(grid.Rows) != 2 || grid.Rows[0].ProjectId != acme.ID {
+		t.Fatalf("expected rows sorted by name, got %+v", grid.Rows)
+	}
+	cells := grid.Rows[0].Cells
+	if !cells[0].Locked || *cells[0].InvoiceId != invoiceID || cells[2].Locked || cells[2].Hours != 1.5 {
+		t.Errorf("unexpected cells %+v", cells)
+	}
+	if cells[1].EntryId != nil || cells[1].Hours != 0 {
+		t.Errorf("expected an empty cell, got %+v", cells[1])
+	}
+	if grid.Rows[1].TotalHours != 4 {
+		t.Errorf("expected the travel row to keep its hours, got %v", grid.Rows[1].TotalHours)
+	}
+}
@@ -0,0 +1,176 @@
+// Package locale formats hours, dates and numbers for a user's locale and
+// translates the fixed text of generated documents such as invoices.
+//
+// The default locale keeps the formats used before locales existed: ISO
+// dates, a decimal point and no digit grouping.
+package locale
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultTag is the locale used until a user picks one
+const DefaultTag = "en"
+
+// Locale holds the formatting conventions and translations for one language
+// and region
+type Locale struct {
+	Tag        string
+	dateLayout string
+	decimal    string
+	grouping   string // Thousands separator, empty for none
+	hourUnit   string
+	minuteUnit string
+	unitSpace  bool // Whether a space separates a number from its unit
+	messages   map[string]string
+}
+
+var locales = map[string]*Locale{
+	"en": {
+		Tag: "en", dateLayout: "2006-01-02", decimal: ".",
+		hourUnit: "h", minuteUnit: "m",
+	},
+	"en-US": {
+		Tag: "en-US", dateLayout: "01/02/2006", decimal: ".", grouping: ",",
+		hourUnit: "h", minuteUnit: "m",
+	},
+	"en-GB": {
+		Tag: "en-GB", dateLayout: "02/01/2006", decimal: ".", grouping: ",",
+		hourUnit: "h", minuteUnit: "m",
+	},
+	"de-DE": {
+		Tag: "de-DE", dateLayout: "02.01.2006", decimal: ",", grouping: ".",
+		hourUnit: "Std.", minuteUnit: "Min.", unitSpace: true,
+		messages: messagesDE,
+	},
+	"fr-FR": {
+		Tag: "fr-FR", dateLayout: "02/01/2006", decimal: ",", grouping: "\u00a0",
+		hourUnit: "h", minuteUnit: "min", unitSpace: true,
+		messages: messagesFR,
+	},
+	"es-ES": {
+		Tag: "es-ES", dateLayout: "02/01/2006", decimal: ",", grouping: ".",
+		hourUnit: "h", minuteUnit: "min", unitSpace: true,
+		messages: messagesES,
+	},
+}
+
+// Default returns the default locale
+func Default() *Locale {
+	return locales[DefaultTag]
+}
+
+// Lookup finds a supported locale by tag, ignoring case and accepting "_"
+// for "-". A bare language such as "de" matches its only regional locale.
+func Lookup(tag string) (*Locale, bool) {
+	tag = strings.ReplaceAll(strings.TrimSpace(tag), "_", "-")
+	for t, l := range locales {
+		if strings.EqualFold(t, tag) {
+			return l, true
+		}
+	}
+
+	var match *Locale
+	for t, l := range locales {
+		if lang, _, ok := strings.Cut(t, "-"); ok && strings.EqualFold(lang, tag) {
+			if match != nil {
+				return nil, false
+			}
+			match = l
+		}
+	}
+	return match, match != nil
+}
+
+// Supported returns the tags of all supported locales in sorted order
+func Supported() []string {
+	tags := make([]string, 0, len(locales))
+	for t := range locales {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// FormatHours renders a duration in hours as whole hours and minutes,
+// e.g. "1h 30m" or "1 Std. 30 Min."
+func (l *Locale) FormatHours(hours float64) string {
+	h := int(hours)
+	m := int((hours - float64(h)) * 60)
+
+	sep := ""
+	if l.unitSpace {
+		sep = " "
+	}
+	if m == 0 {
+		return fmt.Sprintf("%d%s%s", h, sep, l.hourUnit)
+	}
+	return fmt.Sprintf("%d%s%s %d%s%s", h, sep, l.hourUnit, m, sep, l.minuteUnit)
+}
+
+// FormatDate renders a calendar date
+func (l *Locale) FormatDate(t time.Time) string {
+	return t.Format(l.dateLayout)
+}
+
+// FormatNumber renders a number with the given number of decimals using the
+// locale's separators
+func (l *Locale) FormatNumber(f float64, decimals int) string {
+	s := fmt.Sprintf("%.*f", decimals, math.Abs(f))
+	intPart, fracPart, _ := strings.Cut(s, ".")
+
+	if l.grouping != "" && len(intPart) > 3 {
+		var b strings.Builder
+		lead := len(intPart) % 3
+		if lead > 0 {
+			b.WriteString(intPart[:lead])
+		}
+		for i := lead; i < len(intPart); i += 3 {
+			if b.Len() > 0 {
+				b.WriteString(l.grouping)
+			}
+			b.WriteString(intPart[i : i+3])
+		}
+		intPart = b.String()
+	}
+
+	out := intPart
+	if fracPart != "" {
+		out += l.decimal + fracPart
+	}
+	if f < 0 && strings.Trim(s, "0.") != "" {
+		out = "-" + out
+	}
+	return out
+}
+
+// T returns the translation of a message key, falling back to English
+func (l *Locale) T(key string) string {
+	if msg, ok := l.messages[key]; ok {
+		return msg
+	}
+	if msg, ok := messagesEN[key]; ok {
+		return msg
+	}
+	return key
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying the locale
+func NewContext(ctx context.Context, l *Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the context's locale, or the default locale
+func FromContext(ctx context.Context) *Locale {
+	if l, ok := ctx.Value(contextKey{}).(*Locale); ok && l != nil {
+		return l
+	}
+	return Default()
+}
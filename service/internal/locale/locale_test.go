@@ -0,0 +1,90 @@
+package locale
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLookup(t *testing.T) {
+	tests := map[string]string{
+		"en":    "en",
+		"de_de": "de-DE",
+		"DE":    "de-DE",
+		"fr":    "fr-FR",
+	}
+	for in, want := range tests {
+		l, ok := Lookup(in)
+		if !ok || l.Tag != want {
+			t.Errorf("Lookup(%q) = %v, %v, want %s", in, l, ok, want)
+		}
+	}
+
+	for _, in := range []string{"xx", "pt-BR", ""} {
+		if _, ok := Lookup(in); ok {
+			t.Errorf("Lookup(%q) should fail", in)
+		}
+	}
+}
+
+func TestFormatting(t *testing.T) {
+	date := time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		tag    string
+		hours  string
+		date   string
+		number string
+	}{
+		{"en", "1h 30m", "2025-03-07", "1234567.50"},
+		{"en-US", "1h 30m", "03/07/2025", "1,234,567.50"},
+		{"de-DE", "1 Std. 30 Min.", "07.03.2025", "1.234.567,50"},
+		{"fr-FR", "1 h 30 min", "07/03/2025", "1\u00a0234\u00a0567,50"},
+	}
+	for _, tt := range tests {
+		l, _ := Lookup(tt.tag)
+		if got := l.FormatHours(1.5); got != tt.hours {
+			t.Errorf("%s FormatHours = %q, want %q", tt.tag, got, tt.hours)
+		}
+		if got := l.FormatDate(date); got != tt.date {
+			t.Errorf("%s FormatDate = %q, want %q", tt.tag, got, tt.date)
+		}
+		if got := l.FormatNumber(1234567.5, 2); got != tt.number {
+			t.Errorf("%s FormatNumber = %q, want %q", tt.tag, got, tt.number)
+		}
+	}
+
+	de, _ := Lookup("de-DE")
+	if got := de.FormatNumber(-999.5, 2); got != "-999,50" {
+		t.Errorf("FormatNumber(-999.5) = %q", got)
+	}
+	if got := de.FormatNumber(-0.001, 2); got != "0,00" {
+		t.Errorf("FormatNumber(-0.001) = %q", got)
+	}
+	if got := Default().FormatHours(2); got != "2h" {
+		t.Errorf("FormatHours(2) = %q", got)
+	}
+}
+
+func TestT_FallsBackToEnglish(t *testing.T) {
+	de, _ := Lookup("de")
+	if got := de.T(InvoiceTitle); got != "Rechnung" {
+		t.Errorf("T(InvoiceTitle) = %q", got)
+	}
+	gb, _ := Lookup("en-GB")
+	if got := gb.T(InvoiceTitle); got != "Invoice" {
+		t.Errorf("T(InvoiceTitle) = %q", got)
+	}
+	if got := gb.T("unknown.key"); got != "unknown.key" {
+		t.Errorf("T(unknown) = %q", got)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if got := FromContext(context.Background()); got.Tag != DefaultTag {
+		t.Errorf("expected default locale, got %s", got.Tag)
+	}
+	de, _ := Lookup("de-DE")
+	if got := FromContext(NewContext(context.Background(), de)); got.Tag != "de-DE" {
+		t.Errorf("expected de-DE, got %s", got.Tag)
+	}
+}
@@ -0,0 +1,109 @@
+package locale
+
+// Message keys for generated invoice text
+const (
+	InvoiceTitle       = "invoice.title"
+	CreditNoteTitle    = "invoice.credit_note_title"
+	InvoiceNumber      = "invoice.number"
+	CreditNoteFor      = "invoice.credit_note_for"
+	InvoiceProject     = "invoice.project"
+	InvoiceClient      = "invoice.client"
+	InvoicePeriod      = "invoice.period"
+	InvoicePeriodRange = "invoice.period_range"
+	InvoiceDate        = "invoice.date"
+	InvoiceStatus      = "invoice.status"
+	ColumnDate         = "invoice.column.date"
+	ColumnDescription  = "invoice.column.description"
+	ColumnHours        = "invoice.column.hours"
+	ColumnRate         = "invoice.column.rate"
+	ColumnAmount       = "invoice.column.amount"
+	InvoiceTotal       = "invoice.total"
+	InvoiceNote        = "invoice.note"
+	TimeEntryLine      = "invoice.time_entry_line"
+)
+
+// messagesEN is the complete catalog; other locales fall back to it for
+// missing keys. InvoicePeriodRange and InvoiceNote are format strings.
+var messagesEN = map[string]string{
+	InvoiceTitle:       "Invoice",
+	CreditNoteTitle:    "Credit Note",
+	InvoiceNumber:      "Invoice Number:",
+	CreditNoteFor:      "Credit Note For:",
+	InvoiceProject:     "Project:",
+	InvoiceClient:      "Client:",
+	InvoicePeriod:      "Period:",
+	InvoicePeriodRange: "%s to %s",
+	InvoiceDate:        "Invoice Date:",
+	InvoiceStatus:      "Status:",
+	ColumnDate:         "Date",
+	ColumnDescription:  "Description",
+	ColumnHours:        "Hours",
+	ColumnRate:         "Rate",
+	ColumnAmount:       "Amount",
+	InvoiceTotal:       "Total",
+	InvoiceNote:        "Note (%s, %s): %s",
+	TimeEntryLine:      "Time entry",
+}
+
+var messagesDE = map[string]string{
+	InvoiceTitle:       "Rechnung",
+	CreditNoteTitle:    "Gutschrift",
+	InvoiceNumber:      "Rechnungsnummer:",
+	CreditNoteFor:      "Gutschrift zu:",
+	InvoiceProject:     "Projekt:",
+	InvoiceClient:      "Kunde:",
+	InvoicePeriod:      "Zeitraum:",
+	InvoicePeriodRange: "%s bis %s",
+	InvoiceDate:        "Rechnungsdatum:",
+	InvoiceStatus:      "Status:",
+	ColumnDate:         "Datum",
+	ColumnDescription:  "Beschreibung",
+	ColumnHours:        "Stunden",
+	ColumnRate:         "Satz",
+	ColumnAmount:       "Betrag",
+	InvoiceTotal:       "Summe",
+	InvoiceNote:        "Notiz (%s, %s): %s",
+	TimeEntryLine:      "Zeiteintrag",
+}
+
+var messagesFR = map[string]string{
+	InvoiceTitle:       "Facture",
+	CreditNoteTitle:    "Avoir",
+	InvoiceNumber:      "Numéro de facture :",
+	CreditNoteFor:      "Avoir sur :",
+	InvoiceProject:     "Projet :",
+	InvoiceClient:      "Client :",
+	InvoicePeriod:      "Période :",
+	InvoicePeriodRange: "du %s au %s",
+	InvoiceDate:        "Date de facture :",
+	InvoiceStatus:      "Statut :",
+	ColumnDate:         "Date",
+	ColumnDescription:  "Description",
+	ColumnHours:        "Heures",
+	ColumnRate:         "Taux",
+	ColumnAmount:       "Montant",
+	InvoiceTotal:       "Total",
+	InvoiceNote:        "Note (%s, %s) : %s",
+	TimeEntryLine:      "Saisie de temps",
+}
+
+var messagesES = map[string]string{
+	InvoiceTitle:       "Factura",
+	CreditNoteTitle:    "Nota de crédito",
+	InvoiceNumber:      "Número de factura:",
+	CreditNoteFor:      "Nota de crédito de:",
+	InvoiceProject:     "Proyecto:",
+	InvoiceClient:      "Cliente:",
+	InvoicePeriod:      "Periodo:",
+	InvoicePeriodRange: "%s a %s",
+	InvoiceDate:        "Fecha de factura:",
+	InvoiceStatus:      "Estado:",
+	ColumnDate:         "Fecha",
+	ColumnDescription:  "Descripción",
+	ColumnHours:        "Horas",
+	ColumnRate:         "Tarifa",
+	ColumnAmount:       "Importe",
+	InvoiceTotal:       "Total",
+	InvoiceNote:        "Nota (%s, %s): %s",
+	TimeEntryLine:      "Registro de tiempo",
+}
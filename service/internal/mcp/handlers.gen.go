@@ -0,0 +1,490 @@
+// Code generated by mcp-codegen from api-spec.yaml. DO NOT EDIT.
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ApplyRulesArgs are the arguments of the apply_rules tool
+type ApplyRulesArgs struct {
+	// ConfirmationToken Token from the preview returned by a first call, when the API key requires confirmation. Pass it with the same arguments to execute.
+	ConfirmationToken *string `json:"confirmation_token,omitempty"`
+	// DryRun If true, return what would be classified without making changes
+	DryRun    *bool   `json:"dry_run,omitempty"`
+	EndDate   *string `json:"end_date,omitempty"`
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// BulkClassifyArgs are the arguments of the bulk_classify tool
+type BulkClassifyArgs struct {
+	// ConfirmationToken Token from the preview returned by a first call, when the API key requires confirmation. Pass it with the same arguments to execute.
+	ConfirmationToken *string `json:"confirmation_token,omitempty"`
+	// ProjectID Project to assign matching events to. Omit to skip events.
+	ProjectID *string `json:"project_id,omitempty"`
+	// Query Gmail-style query to match events (e.g., "domain:acme.com title:sync")
+	Query string `json:"query"`
+	// Skip If true, mark matching events as skipped (did not attend)
+	Skip *bool `json:"skip,omitempty"`
+}
+
+// ClassifyEventArgs are the arguments of the classify_event tool
+type ClassifyEventArgs struct {
+	// EventID The calendar event ID to classify
+	EventID string `json:"event_id"`
+	// ProjectID Project to assign this event to.
+	ProjectID *string `json:"project_id,omitempty"`
+	// Skip Set to true to skip, or false to unskip (reset to pending state).
+	Skip *bool `json:"skip,omitempty"`
+}
+
+// CreateClassificationSnapshotArgs are the arguments of the create_classification_snapshot tool
+type CreateClassificationSnapshotArgs struct {
+	// Label Optional note describing why the snapshot was taken
+	Label *string `json:"label,omitempty"`
+	// WeekStart Any date in the week to snapshot (YYYY-MM-DD); normalized to Monday
+	WeekStart string `json:"week_start"`
+}
+
+// CreateRuleArgs are the arguments of the create_rule tool
+type CreateRuleArgs struct {
+	// ApplyEndDate With apply_to_existing, only events on or before this date (YYYY-MM-DD)
+	ApplyEndDate *string `json:"apply_end_date,omitempty"`
+	// ApplyStartDate With apply_to_existing, only events on or after this date (YYYY-MM-DD)
+	ApplyStartDate *string `json:"apply_start_date,omitempty"`
+	// ApplyToExisting Also classify existing events the new rule matches, in a background job. Matching events are evaluated against all enabled rules, as apply_rules would. The rule must be enabled.
+	ApplyToExisting *bool `json:"apply_to_existing,omitempty"`
+	// Attended For attendance rules - false means "did not attend"
+	Attended  *bool `json:"attended,omitempty"`
+	IsEnabled *bool `json:"is_enabled,omitempty"`
+	// ProjectID Target project (required unless attended is set)
+	ProjectID *string `json:"project_id,omitempty"`
+	// Query Gmail-style query string
+	Query string `json:"query"`
+	// Skip Create a skip rule, marking matching events as did not attend, instead of targeting a project
+	Skip   *bool    `json:"skip,omitempty"`
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// CreateTimeEntryArgs are the arguments of the create_time_entry tool
+type CreateTimeEntryArgs struct {
+	Date        string  `json:"date"`
+	Description *string `json:"description,omitempty"`
+	Hours       float64 `json:"hours"`
+	ProjectID   string  `json:"project_id"`
+}
+
+// ExplainClassificationArgs are the arguments of the explain_classification tool
+type ExplainClassificationArgs struct {
+	// EventID The calendar event ID to explain classification for
+	EventID string `json:"event_id"`
+}
+
+// ExplainTimeEntryArgs are the arguments of the explain_time_entry tool
+type ExplainTimeEntryArgs struct {
+	// Date Date of the entry (YYYY-MM-DD), with project_id
+	Date *string `json:"date,omitempty"`
+	// ProjectID Project of the entry, with date, when the ID isn't known
+	ProjectID *string `json:"project_id,omitempty"`
+	// TimeEntryID ID of the time entry to explain
+	TimeEntryID *string `json:"time_entry_id,omitempty"`
+}
+
+// GetGoalsProgressArgs are the arguments of the get_goals_progress tool
+type GetGoalsProgressArgs struct {
+	// Date Day to measure progress on (YYYY-MM-DD). Defaults to today.
+	Date *string `json:"date,omitempty"`
+}
+
+// GetMeetingCostArgs are the arguments of the get_meeting_cost tool
+type GetMeetingCostArgs struct {
+	// DefaultRate Hourly cost of an attendee without a more specific rate
+	DefaultRate *float64 `json:"default_rate,omitempty"`
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *string `json:"end_date,omitempty"`
+	// Rate Per-person hourly rates as email:amount or domain:amount, e.g. `ceo@acme.com:400`. An email rate beats its domain's.
+	Rate []string `json:"rate,omitempty"`
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// GetMeetingLoadArgs are the arguments of the get_meeting_load tool
+type GetMeetingLoadArgs struct {
+	// DayStart When the working day starts, HH:MM in UTC. Defaults to 09:00.
+	DayStart *string `json:"day_start,omitempty"`
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *string `json:"end_date,omitempty"`
+	// MinFocusMinutes Shortest gap between meetings that counts as focus time. Defaults to 30.
+	MinFocusMinutes *int `json:"min_focus_minutes,omitempty"`
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// GetTimeSummaryArgs are the arguments of the get_time_summary tool
+type GetTimeSummaryArgs struct {
+	// EndDate End date (YYYY-MM-DD). Defaults to today.
+	EndDate *string `json:"end_date,omitempty"`
+	// GroupBy How to group: 'project' or 'date'
+	GroupBy *string `json:"group_by,omitempty"`
+	// ProjectID Filter by project
+	ProjectID *string `json:"project_id,omitempty"`
+	// StartDate Start date (YYYY-MM-DD). Defaults to 7 days ago.
+	StartDate *string `json:"start_date,omitempty"`
+	// Tag Only return saved entries carrying this tag (case-insensitive)
+	Tag *string `json:"tag,omitempty"`
+}
+
+// GetUtilizationArgs are the arguments of the get_utilization tool
+type GetUtilizationArgs struct {
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *string `json:"end_date,omitempty"`
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// ListAnomaliesArgs are the arguments of the list_anomalies tool
+type ListAnomaliesArgs struct {
+	// EndDate Last day to include (YYYY-MM-DD)
+	EndDate *string `json:"end_date,omitempty"`
+	// IncludeDismissed Also return anomalies that were already dismissed
+	IncludeDismissed *bool `json:"include_dismissed,omitempty"`
+	// StartDate First day to include (YYYY-MM-DD)
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// ListPendingEventsArgs are the arguments of the list_pending_events tool
+type ListPendingEventsArgs struct {
+	ClassificationStatus *string `json:"classification_status,omitempty"`
+	ConnectionID         *string `json:"connection_id,omitempty"`
+	// EndDate End date (YYYY-MM-DD). Defaults to today.
+	EndDate *string `json:"end_date,omitempty"`
+	// Limit Maximum events to return
+	Limit *int `json:"limit,omitempty"`
+	// StartDate Start date (YYYY-MM-DD). Defaults to 30 days ago.
+	StartDate *string `json:"start_date,omitempty"`
+	// Tag Only return events carrying this tag (case-insensitive)
+	Tag *string `json:"tag,omitempty"`
+}
+
+// ListProjectsArgs are the arguments of the list_projects tool
+type ListProjectsArgs struct {
+	// Archived Only archived (true) or only active (false) projects; overrides include_archived
+	Archived *bool `json:"archived,omitempty"`
+	// Billable Only billable (true) or non-billable (false) projects
+	Billable *bool `json:"billable,omitempty"`
+	// Client Only projects for this client
+	Client *string `json:"client,omitempty"`
+	// IncludeArchived Include archived/inactive projects
+	IncludeArchived *bool `json:"include_archived,omitempty"`
+	// Limit Maximum number of projects to return; all when omitted
+	Limit *int `json:"limit,omitempty"`
+	// Offset Number of projects to skip, for paging with limit
+	Offset *int `json:"offset,omitempty"`
+	// Sort Sort order: "name", "recent" (most recent time entry first) or "month_hours" (most hours logged this month first)
+	Sort *string `json:"sort,omitempty"`
+}
+
+// ListRulesArgs are the arguments of the list_rules tool
+type ListRulesArgs struct {
+	// Client Only rules targeting a project of this client
+	Client *string `json:"client,omitempty"`
+	// IncludeDisabled Include disabled rules
+	IncludeDisabled *bool `json:"include_disabled,omitempty"`
+	// Limit Maximum number of rules to return; all when omitted
+	Limit *int `json:"limit,omitempty"`
+	// Offset Number of rules to skip, for paging with limit
+	Offset *int `json:"offset,omitempty"`
+	// ProjectID Only rules targeting this project
+	ProjectID *string `json:"project_id,omitempty"`
+	// Sort Sort order: "weight" (heaviest first), "recent" (most recently updated first) or "project" (by target project name)
+	Sort *string `json:"sort,omitempty"`
+}
+
+// PreviewRuleArgs are the arguments of the preview_rule tool
+type PreviewRuleArgs struct {
+	// EndDate End of date range to search (YYYY-MM-DD)
+	EndDate *string `json:"end_date,omitempty"`
+	// ProjectID Target project (for conflict detection)
+	ProjectID *string `json:"project_id,omitempty"`
+	// Query Query to preview
+	Query string `json:"query"`
+	// StartDate Start of date range to search (YYYY-MM-DD)
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// ReviewNextEventArgs are the arguments of the review_next_event tool
+type ReviewNextEventArgs struct {
+	// Decision 'accept' to keep the suggested classification, 'override' to reclassify
+	Decision *string `json:"decision,omitempty"`
+	// EndDate Only events starting on or before this date (YYYY-MM-DD)
+	EndDate *string `json:"end_date,omitempty"`
+	// EventID Event to decide on (from the previous call)
+	EventID *string `json:"event_id,omitempty"`
+	Limit   *int    `json:"limit,omitempty"`
+	// ProjectID Project to assign when overriding
+	ProjectID *string `json:"project_id,omitempty"`
+	// Skip Skip the event instead when overriding
+	Skip *bool `json:"skip,omitempty"`
+	// StartDate Only events starting on or after this date (YYYY-MM-DD)
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// SearchEventsArgs are the arguments of the search_events tool
+type SearchEventsArgs struct {
+	ClassificationStatus *string `json:"classification_status,omitempty"`
+	ConnectionID         *string `json:"connection_id,omitempty"`
+	// EndDate End date (YYYY-MM-DD). Defaults to today.
+	EndDate *string `json:"end_date,omitempty"`
+	// Limit Maximum events to return (default 50)
+	Limit *int `json:"limit,omitempty"`
+	// Query Query string using the search syntax (e.g., 'status:pending', 'domain:acme.com', 'title:standup'). See timesheet://docs/query-syntax resource.
+	Query *string `json:"query,omitempty"`
+	// StartDate Start date (YYYY-MM-DD). Defaults to 30 days ago.
+	StartDate *string `json:"start_date,omitempty"`
+	// Tag Only return events carrying this tag (case-insensitive)
+	Tag *string `json:"tag,omitempty"`
+}
+
+// SuggestDescriptionsArgs are the arguments of the suggest_descriptions tool
+type SuggestDescriptionsArgs struct {
+	// Date Day to suggest descriptions for (YYYY-MM-DD)
+	Date string `json:"date"`
+}
+
+// UndoClassificationActionArgs are the arguments of the undo_classification_action tool
+type UndoClassificationActionArgs struct {
+	// ActionID ID of the action to undo
+	ActionID *string `json:"action_id,omitempty"`
+}
+
+// ToolHandler implements the MCP tools
+type ToolHandler interface {
+	// ApplyRules implements the apply_rules tool
+	ApplyRules(ctx context.Context, userID uuid.UUID, args ApplyRulesArgs) (any, error)
+	// BulkClassify implements the bulk_classify tool
+	BulkClassify(ctx context.Context, userID uuid.UUID, args BulkClassifyArgs) (any, error)
+	// ClassifyEvent implements the classify_event tool
+	ClassifyEvent(ctx context.Context, userID uuid.UUID, args ClassifyEventArgs) (any, error)
+	// CreateClassificationSnapshot implements the create_classification_snapshot tool
+	CreateClassificationSnapshot(ctx context.Context, userID uuid.UUID, args CreateClassificationSnapshotArgs) (any, error)
+	// CreateRule implements the create_rule tool
+	CreateRule(ctx context.Context, userID uuid.UUID, args CreateRuleArgs) (any, error)
+	// CreateTimeEntry implements the create_time_entry tool
+	CreateTimeEntry(ctx context.Context, userID uuid.UUID, args CreateTimeEntryArgs) (any, error)
+	// ExplainClassification implements the explain_classification tool
+	ExplainClassification(ctx context.Context, userID uuid.UUID, args ExplainClassificationArgs) (any, error)
+	// ExplainTimeEntry implements the explain_time_entry tool
+	ExplainTimeEntry(ctx context.Context, userID uuid.UUID, args ExplainTimeEntryArgs) (any, error)
+	// GetGoalsProgress implements the get_goals_progress tool
+	GetGoalsProgress(ctx context.Context, userID uuid.UUID, args GetGoalsProgressArgs) (any, error)
+	// GetMeetingCost implements the get_meeting_cost tool
+	GetMeetingCost(ctx context.Context, userID uuid.UUID, args GetMeetingCostArgs) (any, error)
+	// GetMeetingLoad implements the get_meeting_load tool
+	GetMeetingLoad(ctx context.Context, userID uuid.UUID, args GetMeetingLoadArgs) (any, error)
+	// GetTimeSummary implements the get_time_summary tool
+	GetTimeSummary(ctx context.Context, userID uuid.UUID, args GetTimeSummaryArgs) (any, error)
+	// GetUtilization implements the get_utilization tool
+	GetUtilization(ctx context.Context, userID uuid.UUID, args GetUtilizationArgs) (any, error)
+	// ListAnomalies implements the list_anomalies tool
+	ListAnomalies(ctx context.Context, userID uuid.UUID, args ListAnomaliesArgs) (any, error)
+	// ListPendingEvents implements the list_pending_events tool
+	ListPendingEvents(ctx context.Context, userID uuid.UUID, args ListPendingEventsArgs) (any, error)
+	// ListProjects implements the list_projects tool
+	ListProjects(ctx context.Context, userID uuid.UUID, args ListProjectsArgs) (any, error)
+	// ListRules implements the list_rules tool
+	ListRules(ctx context.Context, userID uuid.UUID, args ListRulesArgs) (any, error)
+	// PreviewRule implements the preview_rule tool
+	PreviewRule(ctx context.Context, userID uuid.UUID, args PreviewRuleArgs) (any, error)
+	// ReviewNextEvent implements the review_next_event tool
+	ReviewNextEvent(ctx context.Context, userID uuid.UUID, args ReviewNextEventArgs) (any, error)
+	// SearchEvents implements the search_events tool
+	SearchEvents(ctx context.Context, userID uuid.UUID, args SearchEventsArgs) (any, error)
+	// SuggestDescriptions implements the suggest_descriptions tool
+	SuggestDescriptions(ctx context.Context, userID uuid.UUID, args SuggestDescriptionsArgs) (any, error)
+	// UndoClassificationAction implements the undo_classification_action tool
+	UndoClassificationAction(ctx context.Context, userID uuid.UUID, args UndoClassificationActionArgs) (any, error)
+}
+
+// ToolFunc runs a tool with the arguments of a tools/call request
+type ToolFunc func(ctx context.Context, userID uuid.UUID, args map[string]any) (any, error)
+
+// RegisterTools returns the function for each tool name, which checks the
+// required arguments and decodes them into the tool's typed struct before
+// calling the handler
+func RegisterTools(h ToolHandler) map[string]ToolFunc {
+	return map[string]ToolFunc{
+		"apply_rules": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ApplyRulesArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for apply_rules: %w", err)
+			}
+			return h.ApplyRules(ctx, userID, args)
+		},
+		"bulk_classify": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args BulkClassifyArgs
+			if err := decodeArgs(raw, &args, "query"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for bulk_classify: %w", err)
+			}
+			return h.BulkClassify(ctx, userID, args)
+		},
+		"classify_event": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ClassifyEventArgs
+			if err := decodeArgs(raw, &args, "event_id"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for classify_event: %w", err)
+			}
+			return h.ClassifyEvent(ctx, userID, args)
+		},
+		"create_classification_snapshot": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args CreateClassificationSnapshotArgs
+			if err := decodeArgs(raw, &args, "week_start"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for create_classification_snapshot: %w", err)
+			}
+			return h.CreateClassificationSnapshot(ctx, userID, args)
+		},
+		"create_rule": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args CreateRuleArgs
+			if err := decodeArgs(raw, &args, "query"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for create_rule: %w", err)
+			}
+			return h.CreateRule(ctx, userID, args)
+		},
+		"create_time_entry": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args CreateTimeEntryArgs
+			if err := decodeArgs(raw, &args, "date", "hours", "project_id"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for create_time_entry: %w", err)
+			}
+			return h.CreateTimeEntry(ctx, userID, args)
+		},
+		"explain_classification": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ExplainClassificationArgs
+			if err := decodeArgs(raw, &args, "event_id"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for explain_classification: %w", err)
+			}
+			return h.ExplainClassification(ctx, userID, args)
+		},
+		"explain_time_entry": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ExplainTimeEntryArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for explain_time_entry: %w", err)
+			}
+			return h.ExplainTimeEntry(ctx, userID, args)
+		},
+		"get_goals_progress": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args GetGoalsProgressArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for get_goals_progress: %w", err)
+			}
+			return h.GetGoalsProgress(ctx, userID, args)
+		},
+		"get_meeting_cost": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args GetMeetingCostArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for get_meeting_cost: %w", err)
+			}
+			return h.GetMeetingCost(ctx, userID, args)
+		},
+		"get_meeting_load": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args GetMeetingLoadArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for get_meeting_load: %w", err)
+			}
+			return h.GetMeetingLoad(ctx, userID, args)
+		},
+		"get_time_summary": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args GetTimeSummaryArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for get_time_summary: %w", err)
+			}
+			return h.GetTimeSummary(ctx, userID, args)
+		},
+		"get_utilization": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args GetUtilizationArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for get_utilization: %w", err)
+			}
+			return h.GetUtilization(ctx, userID, args)
+		},
+		"list_anomalies": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ListAnomaliesArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for list_anomalies: %w", err)
+			}
+			return h.ListAnomalies(ctx, userID, args)
+		},
+		"list_pending_events": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ListPendingEventsArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for list_pending_events: %w", err)
+			}
+			return h.ListPendingEvents(ctx, userID, args)
+		},
+		"list_projects": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ListProjectsArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for list_projects: %w", err)
+			}
+			return h.ListProjects(ctx, userID, args)
+		},
+		"list_rules": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ListRulesArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for list_rules: %w", err)
+			}
+			return h.ListRules(ctx, userID, args)
+		},
+		"preview_rule": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args PreviewRuleArgs
+			if err := decodeArgs(raw, &args, "query"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for preview_rule: %w", err)
+			}
+			return h.PreviewRule(ctx, userID, args)
+		},
+		"review_next_event": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args ReviewNextEventArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for review_next_event: %w", err)
+			}
+			return h.ReviewNextEvent(ctx, userID, args)
+		},
+		"search_events": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args SearchEventsArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for search_events: %w", err)
+			}
+			return h.SearchEvents(ctx, userID, args)
+		},
+		"suggest_descriptions": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args SuggestDescriptionsArgs
+			if err := decodeArgs(raw, &args, "date"); err != nil {
+				return nil, fmt.Errorf("invalid arguments for suggest_descriptions: %w", err)
+			}
+			return h.SuggestDescriptions(ctx, userID, args)
+		},
+		"undo_classification_action": func(ctx context.Context, userID uuid.UUID, raw map[string]any) (any, error) {
+			var args UndoClassificationActionArgs
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for undo_classification_action: %w", err)
+			}
+			return h.UndoClassificationAction(ctx, userID, args)
+		},
+	}
+}
+
+// decodeArgs checks that the required arguments are present and decodes
+// the arguments into v
+func decodeArgs(raw map[string]any, v any, required ...string) error {
+	for _, name := range required {
+		if val, ok := raw[name]; !ok || val == nil {
+			return fmt.Errorf("%s is required", name)
+		}
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
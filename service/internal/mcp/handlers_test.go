@@ -0,0 +1,52 @@
+package mcp
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// classifyOnly implements classify_event; the other tools panic if called
+type classifyOnly struct {
+	ToolHandler
+	got ClassifyEventArgs
+}
+
+func (c *classifyOnly) ClassifyEvent(ctx context.Context, userID uuid.UUID, args ClassifyEventArgs) (any, error) {
+	c.got = args
+	return "ok", nil
+}
+
+func TestRegisterTools(t *testing.T) {
+	h := &classifyOnly{}
+	tools := RegisterTools(h)
+
+	for _, tool := range GetTools() {
+		if tools[tool.Name] == nil {
+			t.Errorf("no handler registered for tool %s", tool.Name)
+		}
+	}
+
+	result, err := tools["classify_event"](context.Background(), uuid.New(), map[string]any{
+		"event_id": "e1",
+		"skip":     true,
+	})
+	if err != nil || result != "ok" {
+		t.Fatalf("classify_event = %v, %v", result, err)
+	}
+	if h.got.EventID != "e1" || h.got.Skip == nil || !*h.got.Skip || h.got.ProjectID != nil {
+		t.Errorf("unexpected decoded arguments: %+v", h.got)
+	}
+
+	_, err = tools["classify_event"](context.Background(), uuid.New(), map[string]any{"skip": true})
+	if err == nil || !strings.Contains(err.Error(), "event_id is required") {
+		t.Errorf("expected missing event_id error, got %v", err)
+	}
+
+	_, err = tools["classify_event"](context.Background(), uuid.New(), map[string]any{"event_id": "e1", "skip": "yes"})
+	if err == nil || !strings.Contains(err.Error(), "invalid arguments for classify_event") {
+		t.Errorf("expected type error, got %v", err)
+	}
+}
@@ -16,7 +16,7 @@ func GetServerInfo() ServerInfo {
 	return ServerInfo{
 		Name:         "timesheet",
 		Version:      "1.0.0",
-		Instructions: "You are an AI assistant helping manage a timesheet application.\n\nThe user tracks their time across different projects. Calendar events are synced from\nGoogle Calendar and need to be classified (assigned to projects or marked as skipped).\n\nIMPORTANT: Before using search_events, create_rule, or preview_rule tools, first read the\ntimesheet://docs/query-syntax resource to understand the query language.\n\nWhen helping the user:\n1. Read timesheet://docs/query-syntax to learn the search syntax\n2. List projects to understand available classification targets\n3. Search for pending events to see what needs attention\n4. Use preview_rule to test classification patterns\n5. Create rules or use bulk_classify to classify events\n",
+		Instructions: "You are an AI assistant helping manage a timesheet application.\n\nThe user tracks their time across different projects. Calendar events are synced from\nGoogle Calendar and need to be classified (assigned to projects or marked as skipped).\n\nIMPORTANT: Before using search_events, create_rule, or preview_rule tools, first read the\ntimesheet://docs/query-syntax resource to understand the query language.\n\nWhen helping the user:\n1. Read timesheet://docs/query-syntax to learn the search syntax\n2. List projects to understand available classification targets\n3. Search for pending events to see what needs attention\n4. Use preview_rule to test classification patterns\n5. Create rules or use bulk_classify to classify events\n\nListing tools (list_projects, list_rules, list_pending_events, search_events,\nget_time_summary, get_utilization, get_goals_progress, list_anomalies) also return\nstructuredContent with the same field names as the REST API; use its IDs rather than\nparsing them out of the markdown.\n",
 	}
 }
 
@@ -55,6 +55,10 @@ func GetTools() []Tool {
 			Description: "Run all enabled classification rules against pending events. This applies rules to unclassified events and creates time entries.",
 			InputSchema: parseSchema(`{
 				"properties": {
+					"confirmation_token": {
+						"description": "Token from the preview returned by a first call, when the API key requires confirmation. Pass it with the same arguments to execute.",
+						"type": "string"
+					},
 					"dry_run": {
 						"default": false,
 						"description": "If true, return what would be classified without making changes",
@@ -75,6 +79,10 @@ func GetTools() []Tool {
 			Description: "Classify multiple events matching a query to a project (or skip them). More efficient than classifying one by one.",
 			InputSchema: parseSchema(`{
 				"properties": {
+					"confirmation_token": {
+						"description": "Token from the preview returned by a first call, when the API key requires confirmation. Pass it with the same arguments to execute.",
+						"type": "string"
+					},
 					"project_id": {
 						"description": "Project to assign matching events to. Omit to skip events.",
 						"type": "string"
@@ -105,11 +113,11 @@ func GetTools() []Tool {
 						"type": "string"
 					},
 					"project_id": {
-						"description": "Project to assign this event to. Omit or null to skip the event.",
+						"description": "Project to assign this event to.",
 						"type": "string"
 					},
 					"skip": {
-						"description": "Set to true to mark as \"did not attend\" (skipped)",
+						"description": "Set to true to skip, or false to unskip (reset to pending state).",
 						"type": "boolean"
 					}
 				},
@@ -119,11 +127,44 @@ func GetTools() []Tool {
 				"type": "object"
 			}`),
 		},
+		{
+			Name:        "create_classification_snapshot",
+			Description: "Save a checkpoint of a week's event classifications before making bulk changes (e.g. apply_rules over past weeks). The snapshot can be restored from the web UI or API.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"label": {
+						"description": "Optional note describing why the snapshot was taken",
+						"type": "string"
+					},
+					"week_start": {
+						"description": "Any date in the week to snapshot (YYYY-MM-DD); normalized to Monday",
+						"type": "string"
+					}
+				},
+				"required": [
+					"week_start"
+				],
+				"type": "object"
+			}`),
+		},
 		{
 			Name:        "create_rule",
 			Description: "Create a new classification rule. The rule will automatically classify matching events to the specified project. Read timesheet://docs/query-syntax first to understand query syntax.",
 			InputSchema: parseSchema(`{
 				"properties": {
+					"apply_end_date": {
+						"description": "With apply_to_existing, only events on or before this date (YYYY-MM-DD)",
+						"type": "string"
+					},
+					"apply_start_date": {
+						"description": "With apply_to_existing, only events on or after this date (YYYY-MM-DD)",
+						"type": "string"
+					},
+					"apply_to_existing": {
+						"default": false,
+						"description": "Also classify existing events the new rule matches, in a\nbackground job. Matching events are evaluated against all\nenabled rules, as apply_rules would. The rule must be enabled.\n",
+						"type": "boolean"
+					},
 					"attended": {
 						"description": "For attendance rules - false means \"did not attend\"",
 						"type": "boolean"
@@ -140,6 +181,10 @@ func GetTools() []Tool {
 						"description": "Gmail-style query string",
 						"type": "string"
 					},
+					"skip": {
+						"description": "Create a skip rule, marking matching events as did not attend, instead of targeting a project",
+						"type": "boolean"
+					},
 					"weight": {
 						"default": 1,
 						"type": "number"
@@ -181,7 +226,102 @@ func GetTools() []Tool {
 			Name:        "explain_classification",
 			Description: "Explain how an event was (or would be) classified. Shows all rules evaluated, which matched, score breakdown by project, and the final decision. Useful for debugging why an event was classified to a particular project.",
 			InputSchema: parseSchema(`{
-				"properties": {},
+				"properties": {
+					"event_id": {
+						"description": "The calendar event ID to explain classification for",
+						"type": "string"
+					}
+				},
+				"required": [
+					"event_id"
+				],
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "explain_time_entry",
+			Description: "Explain why a time entry has its hours: the events that counted, how overlaps were merged, the rounding applied, events that were left out and why (skipped, not attended, not yet counted), and whether the entry is invoiced or edited. Pass time_entry_id, or project_id and date.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"date": {
+						"description": "Date of the entry (YYYY-MM-DD), with project_id",
+						"type": "string"
+					},
+					"project_id": {
+						"description": "Project of the entry, with date, when the ID isn't known",
+						"type": "string"
+					},
+					"time_entry_id": {
+						"description": "ID of the time entry to explain",
+						"type": "string"
+					}
+				},
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "get_goals_progress",
+			Description: "Check progress toward weekly and monthly hour targets, e.g. 'am I on track for 30 billable hours this week?'. Shows hours so far, hours expected by now and hours remaining for each goal.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"date": {
+						"description": "Day to measure progress on (YYYY-MM-DD). Defaults to today.",
+						"type": "string"
+					}
+				},
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "get_meeting_cost",
+			Description: "Estimate what recurring meetings cost, per series and per project, from attendee counts and hourly rates. Useful for deciding which standing meetings to prune. Pass default_rate (per person-hour) and optionally per-person rates like 'ceo@acme.com:400' or 'acme.com:150'.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"default_rate": {
+						"description": "Hourly cost of an attendee without a more specific rate",
+						"type": "number"
+					},
+					"end_date": {
+						"description": "Last day of the report (YYYY-MM-DD). Defaults to today.",
+						"type": "string"
+					},
+					"rate": {
+						"description": "Per-person hourly rates as email:amount or domain:amount, e.g.\n` + "`" + `ceo@acme.com:400` + "`" + `. An email rate beats its domain's.\n",
+						"items": {
+							"type": "string"
+						},
+						"type": "array"
+					},
+					"start_date": {
+						"description": "First day of the report (YYYY-MM-DD)",
+						"type": "string"
+					}
+				},
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "get_meeting_load",
+			Description: "Show how much of each working day goes to meetings vs unscheduled focus time, per day and week, with the focus blocks between meetings. Useful for spotting meeting-heavy days and protecting deep work.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"day_start": {
+						"description": "When the working day starts, HH:MM in UTC. Defaults to 09:00.",
+						"type": "string"
+					},
+					"end_date": {
+						"description": "Last day of the report (YYYY-MM-DD). Defaults to today.",
+						"type": "string"
+					},
+					"min_focus_minutes": {
+						"description": "Shortest gap between meetings that counts as focus time. Defaults to 30.",
+						"type": "integer"
+					},
+					"start_date": {
+						"description": "First day of the report (YYYY-MM-DD)",
+						"type": "string"
+					}
+				},
 				"type": "object"
 			}`),
 		},
@@ -210,6 +350,49 @@ func GetTools() []Tool {
 					"start_date": {
 						"description": "Start date (YYYY-MM-DD). Defaults to 7 days ago.",
 						"type": "string"
+					},
+					"tag": {
+						"description": "Only return saved entries carrying this tag (case-insensitive)",
+						"type": "string"
+					}
+				},
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "get_utilization",
+			Description: "Get billable utilization (billable hours / working-hours capacity) for a date range, with a weekly trend and per-project share. For a quarter, pass its first and last day (e.g. Q3 2025: 2025-07-01 to 2025-09-30).",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"end_date": {
+						"description": "Last day of the report (YYYY-MM-DD). Defaults to today.",
+						"type": "string"
+					},
+					"start_date": {
+						"description": "First day of the report (YYYY-MM-DD)",
+						"type": "string"
+					}
+				},
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "list_anomalies",
+			Description: "List days with suspicious time totals (over 12h, zero hours on a busy workday, or far from the usual daily total) so they can be reviewed and corrected.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"end_date": {
+						"description": "Last day to include (YYYY-MM-DD)",
+						"type": "string"
+					},
+					"include_dismissed": {
+						"default": false,
+						"description": "Also return anomalies that were already dismissed",
+						"type": "boolean"
+					},
+					"start_date": {
+						"description": "First day to include (YYYY-MM-DD)",
+						"type": "string"
 					}
 				},
 				"type": "object"
@@ -243,6 +426,10 @@ func GetTools() []Tool {
 					"start_date": {
 						"description": "Start date (YYYY-MM-DD). Defaults to 30 days ago.",
 						"type": "string"
+					},
+					"tag": {
+						"description": "Only return events carrying this tag (case-insensitive)",
+						"type": "string"
 					}
 				},
 				"type": "object"
@@ -253,10 +440,36 @@ func GetTools() []Tool {
 			Description: "List all projects. Use this first to understand available options for classification.",
 			InputSchema: parseSchema(`{
 				"properties": {
+					"archived": {
+						"description": "Only archived (true) or only active (false) projects; overrides include_archived",
+						"type": "boolean"
+					},
+					"billable": {
+						"description": "Only billable (true) or non-billable (false) projects",
+						"type": "boolean"
+					},
+					"client": {
+						"description": "Only projects for this client",
+						"type": "string"
+					},
 					"include_archived": {
 						"default": false,
 						"description": "Include archived/inactive projects",
 						"type": "boolean"
+					},
+					"limit": {
+						"description": "Maximum number of projects to return; all when omitted",
+						"type": "integer"
+					},
+					"offset": {
+						"default": 0,
+						"description": "Number of projects to skip, for paging with limit",
+						"type": "integer"
+					},
+					"sort": {
+						"default": "name",
+						"description": "Sort order: \"name\", \"recent\" (most recent time entry first) or\n\"month_hours\" (most hours logged this month first)\n",
+						"type": "string"
 					}
 				},
 				"type": "object"
@@ -267,10 +480,32 @@ func GetTools() []Tool {
 			Description: "List all classification rules. Rules automatically assign events to projects based on query patterns.",
 			InputSchema: parseSchema(`{
 				"properties": {
+					"client": {
+						"description": "Only rules targeting a project of this client",
+						"type": "string"
+					},
 					"include_disabled": {
 						"default": false,
 						"description": "Include disabled rules",
 						"type": "boolean"
+					},
+					"limit": {
+						"description": "Maximum number of rules to return; all when omitted",
+						"type": "integer"
+					},
+					"offset": {
+						"default": 0,
+						"description": "Number of rules to skip, for paging with limit",
+						"type": "integer"
+					},
+					"project_id": {
+						"description": "Only rules targeting this project",
+						"type": "string"
+					},
+					"sort": {
+						"default": "weight",
+						"description": "Sort order: \"weight\" (heaviest first), \"recent\" (most recently\nupdated first) or \"project\" (by target project name)\n",
+						"type": "string"
 					}
 				},
 				"type": "object"
@@ -304,6 +539,43 @@ func GetTools() []Tool {
 				"type": "object"
 			}`),
 		},
+		{
+			Name:        "review_next_event",
+			Description: "Drive a triage session over auto-classified events that need review, least confident first. Call with no arguments to see the next event and its suggested project. Then call with event_id and decision 'accept' to keep the suggestion, or 'override' with project_id or skip=true to reclassify; the response shows the next event.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"decision": {
+						"description": "'accept' to keep the suggested classification, 'override' to reclassify",
+						"type": "string"
+					},
+					"end_date": {
+						"description": "Only events starting on or before this date (YYYY-MM-DD)",
+						"type": "string"
+					},
+					"event_id": {
+						"description": "Event to decide on (from the previous call)",
+						"type": "string"
+					},
+					"limit": {
+						"default": 50,
+						"type": "integer"
+					},
+					"project_id": {
+						"description": "Project to assign when overriding",
+						"type": "string"
+					},
+					"skip": {
+						"description": "Skip the event instead when overriding",
+						"type": "boolean"
+					},
+					"start_date": {
+						"description": "Only events starting on or after this date (YYYY-MM-DD)",
+						"type": "string"
+					}
+				},
+				"type": "object"
+			}`),
+		},
 		{
 			Name:        "search_events",
 			Description: "Search calendar events using query syntax. Read the timesheet://docs/query-syntax resource first to understand the query language. Use this to find events by status, project, attendees, title, etc.",
@@ -336,6 +608,39 @@ func GetTools() []Tool {
 					"start_date": {
 						"description": "Start date (YYYY-MM-DD). Defaults to 30 days ago.",
 						"type": "string"
+					},
+					"tag": {
+						"description": "Only return events carrying this tag (case-insensitive)",
+						"type": "string"
+					}
+				},
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "suggest_descriptions",
+			Description: "Suggest time entry descriptions for a day from the user's GitHub commits and pull request reviews, grouped by the project that owns each repository.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"date": {
+						"description": "Day to suggest descriptions for (YYYY-MM-DD)",
+						"type": "string"
+					}
+				},
+				"required": [
+					"date"
+				],
+				"type": "object"
+			}`),
+		},
+		{
+			Name:        "undo_classification_action",
+			Description: "Undo a classification action (classify, bulk classify or apply rules), restoring the affected events' previous classification. Pass the action_id returned by the original call.",
+			InputSchema: parseSchema(`{
+				"properties": {
+					"action_id": {
+						"description": "ID of the action to undo",
+						"type": "string"
 					}
 				},
 				"type": "object"
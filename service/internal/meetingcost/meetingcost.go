@@ -0,0 +1,198 @@
+// Package meetingcost estimates what recurring meetings cost from their
+// attendee counts and per-person hourly rates.
+package meetingcost
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Meeting is one occurrence of a calendar event
+type Meeting struct {
+	ExternalID  string
+	Title       string
+	ProjectID   *uuid.UUID
+	ProjectName string
+	StartTime   time.Time
+	EndTime     time.Time
+	Attendees   []string
+}
+
+// Rates prices an hour of an attendee's time: their email's rate, else their
+// domain's, else Default. Zero rates are unpriced.
+type Rates struct {
+	Default  float64
+	ByEmail  map[string]float64
+	ByDomain map[string]float64
+}
+
+// ParseRates reads per-person rates given as "email:amount" or
+// "domain:amount", e.g. "ceo@acme.com:400" or "acme.com:150"
+func ParseRates(defaultRate float64, specs []string) (Rates, error) {
+	rates := Rates{Default: defaultRate, ByEmail: map[string]float64{}, ByDomain: map[string]float64{}}
+	if defaultRate < 0 {
+		return rates, fmt.Errorf("default rate must not be negative")
+	}
+	for _, spec := range specs {
+		i := strings.LastIndex(spec, ":")
+		if i <= 0 {
+			return rates, fmt.Errorf("%q: expected email:amount or domain:amount", spec)
+		}
+		key := strings.ToLower(strings.TrimSpace(spec[:i]))
+		amount, err := strconv.ParseFloat(strings.TrimSpace(spec[i+1:]), 64)
+		if err != nil || amount < 0 {
+			return rates, fmt.Errorf("%q: amount must be a non-negative number", spec)
+		}
+		if domain, ok := strings.CutPrefix(key, "@"); ok || !strings.Contains(key, "@") {
+			rates.ByDomain[domain] = amount
+		} else {
+			rates.ByEmail[key] = amount
+		}
+	}
+	return rates, nil
+}
+
+// Priced reports whether any rate is set
+func (r Rates) Priced() bool {
+	return r.Default > 0 || len(r.ByEmail) > 0 || len(r.ByDomain) > 0
+}
+
+// Rate returns the hourly rate of an attendee
+func (r Rates) Rate(attendee string) float64 {
+	email := strings.ToLower(attendee)
+	if rate, ok := r.ByEmail[email]; ok {
+		return rate
+	}
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		if rate, ok := r.ByDomain[email[i+1:]]; ok {
+			return rate
+		}
+	}
+	return r.Default
+}
+
+// Series is a recurring meeting's occurrences in the report range
+type Series struct {
+	Key          string // Google's recurring event ID, or the title when unknown
+	Title        string // Title of the latest occurrence
+	ProjectID    *uuid.UUID
+	ProjectName  string
+	Occurrences  int
+	Hours        float64 // Meeting time
+	AvgAttendees float64
+	PersonHours  float64 // Meeting time times attendees
+	Cost         float64
+	LastHeld     time.Time
+}
+
+// ProjectCost totals the series of one project; ProjectID is nil for
+// unclassified meetings
+type ProjectCost struct {
+	ProjectID   *uuid.UUID
+	ProjectName string
+	Series      int
+	PersonHours float64
+	Cost        float64
+}
+
+// Report is the estimated cost of recurring meetings over a period
+type Report struct {
+	Priced      bool // False when no rates were given; costs are then 0
+	PersonHours float64
+	Cost        float64
+	Series      []Series      // Most costly first, by person-hours when unpriced
+	Projects    []ProjectCost // Same order
+}
+
+// Compute groups meetings into series and prices each occurrence as its
+// duration times the sum of its attendees' rates
+func Compute(meetings []Meeting, rates Rates) *Report {
+	report := &Report{Priced: rates.Priced()}
+	byKey := make(map[string]*Series)
+	var keys []string
+	attendeeTotal := make(map[string]int)
+
+	for _, m := range meetings {
+		key := SeriesKey(m.ExternalID, m.Title)
+		s, ok := byKey[key]
+		if !ok {
+			s = &Series{Key: key}
+			byKey[key] = s
+			keys = append(keys, key)
+		}
+		// The latest occurrence names the series
+		if !m.StartTime.Before(s.LastHeld) {
+			s.LastHeld = m.StartTime
+			s.Title = m.Title
+			s.ProjectID = m.ProjectID
+			s.ProjectName = m.ProjectName
+		}
+
+		hours := m.EndTime.Sub(m.StartTime).Hours()
+		var hourlyCost float64
+		for _, a := range m.Attendees {
+			hourlyCost += rates.Rate(a)
+		}
+		s.Occurrences++
+		s.Hours += hours
+		s.PersonHours += hours * float64(len(m.Attendees))
+		s.Cost += hours * hourlyCost
+		attendeeTotal[key] += len(m.Attendees)
+	}
+
+	projects := make(map[string]*ProjectCost)
+	var projectKeys []string
+	for _, key := range keys {
+		s := byKey[key]
+		s.AvgAttendees = float64(attendeeTotal[key]) / float64(s.Occurrences)
+		report.Series = append(report.Series, *s)
+		report.PersonHours += s.PersonHours
+		report.Cost += s.Cost
+
+		projectKey := ""
+		if s.ProjectID != nil {
+			projectKey = s.ProjectID.String()
+		}
+		p, ok := projects[projectKey]
+		if !ok {
+			p = &ProjectCost{ProjectID: s.ProjectID, ProjectName: s.ProjectName}
+			projects[projectKey] = p
+			projectKeys = append(projectKeys, projectKey)
+		}
+		p.Series++
+		p.PersonHours += s.PersonHours
+		p.Cost += s.Cost
+	}
+	for _, key := range projectKeys {
+		report.Projects = append(report.Projects, *projects[key])
+	}
+
+	weight := func(cost, personHours float64) float64 {
+		if report.Priced {
+			return cost
+		}
+		return personHours
+	}
+	sort.SliceStable(report.Series, func(i, j int) bool {
+		return weight(report.Series[i].Cost, report.Series[i].PersonHours) > weight(report.Series[j].Cost, report.Series[j].PersonHours)
+	})
+	sort.SliceStable(report.Projects, func(i, j int) bool {
+		return weight(report.Projects[i].Cost, report.Projects[i].PersonHours) > weight(report.Projects[j].Cost, report.Projects[j].PersonHours)
+	})
+	return report
+}
+
+// SeriesKey identifies the series of a recurring event occurrence. Google
+// names occurrences "<recurring event ID>_<start>", so the ID is the part
+// before the last underscore; events without one group by title.
+func SeriesKey(externalID, title string) string {
+	if i := strings.LastIndex(externalID, "_"); i > 0 {
+		return externalID[:i]
+	}
+	return "title:" + strings.ToLower(strings.TrimSpace(title))
+}
@@ -0,0 +1,89 @@
+package meetingcost
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestParseRates(t *testing.T) {
+	rates, err := ParseRates(100, []string{"CEO@acme.com:400", "@contractor.io:60"})
+	if err != nil {
+		t.Fatalf("ParseRates: %v", err)
+	}
+	for attendee, want := range map[string]float64{
+		"ceo@acme.com":      400,
+		"dev@contractor.io": 60,
+		"pm@acme.com":       100,
+	} {
+		if got := rates.Rate(attendee); got != want {
+			t.Errorf("Rate(%s) = %v, want %v", attendee, got, want)
+		}
+	}
+
+	for _, bad := range []string{"acme.com", "acme.com:-5", ":10", "acme.com:lots"} {
+		if _, err := ParseRates(0, []string{bad}); err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	acme := uuid.New()
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+	meeting := func(id, title string, project *uuid.UUID, day int, minutes int, attendees ...string) Meeting {
+		return Meeting{
+			ExternalID:  id,
+			Title:       title,
+			ProjectID:   project,
+			ProjectName: "Acme",
+			StartTime:   at(day, 9),
+			EndTime:     at(day, 9).Add(time.Duration(minutes) * time.Minute),
+			Attendees:   attendees,
+		}
+	}
+	team := []string{"me@acme.com", "ceo@acme.com", "dev@acme.com", "pm@acme.com"}
+	meetings := []Meeting{
+		meeting("standup_20250310T090000Z", "Standup", &acme, 10, 15, team...),
+		meeting("standup_20250311T090000Z", "Daily standup", &acme, 11, 15, team...),
+		meeting("sync_20250312T090000Z", "1:1", nil, 12, 60, "me@acme.com", "pm@acme.com"),
+	}
+
+	unpriced := Compute(meetings, Rates{})
+	if unpriced.Priced || unpriced.Cost != 0 {
+		t.Errorf("expected an unpriced report, got %+v", unpriced)
+	}
+	// The 1:1 takes 2 person-hours, the standups 4 x 0.5
+	if len(unpriced.Series) != 2 || unpriced.PersonHours != 4 {
+		t.Fatalf("expected two series totalling 4 person-hours, got %+v", unpriced)
+	}
+
+	rates, _ := ParseRates(100, []string{"ceo@acme.com:400"})
+	r := Compute(meetings, rates)
+	standup := r.Series[0]
+	if standup.Key != "standup" || standup.Title != "Daily standup" || standup.Occurrences != 2 {
+		t.Errorf("unexpected standup series %+v", standup)
+	}
+	// Two 15 minute standups at 100+400+100+100 an hour
+	if standup.Cost != 350 || standup.AvgAttendees != 4 {
+		t.Errorf("standup cost = %v with %v attendees, want 350 with 4", standup.Cost, standup.AvgAttendees)
+	}
+	if r.Cost != 550 || len(r.Projects) != 2 || r.Projects[0].ProjectID == nil || *r.Projects[0].ProjectID != acme {
+		t.Errorf("unexpected totals %+v", r)
+	}
+	if r.Projects[1].ProjectID != nil || r.Projects[1].Cost != 200 {
+		t.Errorf("expected the unclassified 1:1 to cost 200, got %+v", r.Projects[1])
+	}
+}
+
+func TestSeriesKey(t *testing.T) {
+	if got := SeriesKey("abc123_20250310T090000Z", "Standup"); got != "abc123" {
+		t.Errorf("SeriesKey = %q, want abc123", got)
+	}
+	if got := SeriesKey("abc123", " Standup "); got != "title:standup" {
+		t.Errorf("SeriesKey = %q, want title:standup", got)
+	}
+}
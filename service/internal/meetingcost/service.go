@@ -0,0 +1,53 @@
+package meetingcost
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Service loads the recurring meetings in a period to estimate their cost
+type Service struct {
+	events *store.CalendarEventStore
+}
+
+// NewService creates a new meeting cost service
+func NewService(events *store.CalendarEventStore) *Service {
+	return &Service{events: events}
+}
+
+// Report estimates the cost of the recurring meetings in the inclusive date
+// range: timed events with at least two attendees that the user didn't
+// decline or skip
+func (s *Service) Report(ctx context.Context, userID uuid.UUID, start, end time.Time, rates Rates) (*Report, error) {
+	events, err := s.events.List(ctx, userID, &start, &end, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var meetings []Meeting
+	for _, e := range events {
+		if !e.IsRecurring || e.IsAllDay || e.IsSkipped || len(e.Attendees) < 2 {
+			continue
+		}
+		if e.ResponseStatus != nil && *e.ResponseStatus == "declined" {
+			continue
+		}
+		m := Meeting{
+			ExternalID: e.ExternalID,
+			Title:      e.Title,
+			ProjectID:  e.ProjectID,
+			StartTime:  e.StartTime,
+			EndTime:    e.EndTime,
+			Attendees:  e.Attendees,
+		}
+		if e.Project != nil {
+			m.ProjectName = e.Project.Name
+		}
+		meetings = append(meetings, m)
+	}
+	return Compute(meetings, rates), nil
+}
@@ -0,0 +1,213 @@
+// Package meetingload measures how much of each working day goes to
+// meetings and finds the gaps between them long enough for focused work.
+package meetingload
+
+import (
+	"sort"
+	"time"
+)
+
+// Defaults for a meeting load report
+const (
+	DefaultDayStart = 9 * time.Hour    // Working days start at 09:00
+	DefaultMinFocus = 30 * time.Minute // Shorter gaps are fragmented time
+)
+
+// Interval is a span of time
+type Interval struct {
+	Start, End time.Time
+}
+
+func (i Interval) hours() float64 {
+	return i.End.Sub(i.Start).Hours()
+}
+
+// Options shape the working day. Days are UTC, like the rest of the
+// timesheet.
+type Options struct {
+	DayStart time.Duration // Offset from midnight the working day starts at
+	MinFocus time.Duration // Shortest gap that counts as focus time
+}
+
+// Day is one working day's meeting load. The working window starts at
+// Options.DayStart and lasts the day's working-hours capacity.
+type Day struct {
+	Date            time.Time
+	Window          Interval
+	WorkingHours    float64
+	Meetings        int     // Meetings overlapping the window
+	MeetingHours    float64 // Window time in meetings, overlaps merged
+	FocusHours      float64 // Gaps of at least MinFocus
+	FragmentedHours float64 // Shorter gaps
+	MeetingShare    float64 // Meeting hours / working hours
+	FocusBlocks     []Interval
+}
+
+// Week totals the working days of one Monday-start week in the range
+type Week struct {
+	WeekStart       time.Time
+	WorkingHours    float64
+	MeetingHours    float64
+	FocusHours      float64
+	FragmentedHours float64
+	MeetingShare    float64
+}
+
+// Report is the meeting load over a date range
+type Report struct {
+	Days            []Day // Working days only
+	Weeks           []Week
+	WorkingHours    float64
+	MeetingHours    float64
+	FocusHours      float64
+	FragmentedHours float64
+	MeetingShare    float64
+}
+
+// Compute builds a report for the inclusive date range. dailyHours is the
+// working-hours capacity for each weekday, Monday first; days without
+// capacity are left out. Meetings may be in any order and may overlap.
+func Compute(dailyHours [7]float64, meetings []Interval, start, end time.Time, opts Options) *Report {
+	sort.Slice(meetings, func(i, j int) bool { return meetings[i].Start.Before(meetings[j].Start) })
+
+	report := &Report{}
+	weekIndex := make(map[time.Time]int)
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		weekday := (int(date.Weekday()) + 6) % 7
+		capacity := dailyHours[weekday]
+		if capacity <= 0 {
+			continue
+		}
+		windowStart := date.Add(opts.DayStart)
+		window := Interval{windowStart, windowStart.Add(time.Duration(capacity * float64(time.Hour)))}
+
+		day := Day{Date: date, Window: window, WorkingHours: capacity}
+		var busy []Interval
+		for _, m := range meetings {
+			if !m.Start.Before(window.End) {
+				break
+			}
+			if m.End.After(window.Start) {
+				day.Meetings++
+				busy = append(busy, Interval{maxTime(m.Start, window.Start), minTime(m.End, window.End)})
+			}
+		}
+		for _, b := range merge(busy) {
+			day.MeetingHours += b.hours()
+		}
+		for _, gap := range Subtract([]Interval{window}, busy, 0) {
+			if gap.End.Sub(gap.Start) >= opts.MinFocus {
+				day.FocusBlocks = append(day.FocusBlocks, gap)
+				day.FocusHours += gap.hours()
+			} else {
+				day.FragmentedHours += gap.hours()
+			}
+		}
+		day.MeetingShare = ratio(day.MeetingHours, day.WorkingHours)
+		report.Days = append(report.Days, day)
+
+		weekStart := date.AddDate(0, 0, -weekday)
+		i, ok := weekIndex[weekStart]
+		if !ok {
+			i = len(report.Weeks)
+			weekIndex[weekStart] = i
+			report.Weeks = append(report.Weeks, Week{WeekStart: weekStart})
+		}
+		w := &report.Weeks[i]
+		w.WorkingHours += day.WorkingHours
+		w.MeetingHours += day.MeetingHours
+		w.FocusHours += day.FocusHours
+		w.FragmentedHours += day.FragmentedHours
+
+		report.WorkingHours += day.WorkingHours
+		report.MeetingHours += day.MeetingHours
+		report.FocusHours += day.FocusHours
+		report.FragmentedHours += day.FragmentedHours
+	}
+
+	for i := range report.Weeks {
+		report.Weeks[i].MeetingShare = ratio(report.Weeks[i].MeetingHours, report.Weeks[i].WorkingHours)
+	}
+	report.MeetingShare = ratio(report.MeetingHours, report.WorkingHours)
+	return report
+}
+
+// FocusBlocks returns every day's focus blocks, in order
+func (r *Report) FocusBlocks() []Interval {
+	var blocks []Interval
+	for _, d := range r.Days {
+		blocks = append(blocks, d.FocusBlocks...)
+	}
+	return blocks
+}
+
+// Subtract cuts the busy intervals out of each span and returns what is
+// left, in order, dropping pieces shorter than min
+func Subtract(spans, busy []Interval, min time.Duration) []Interval {
+	busy = merge(busy)
+	var free []Interval
+	for _, span := range spans {
+		cursor := span.Start
+		for _, b := range busy {
+			if !b.End.After(cursor) {
+				continue
+			}
+			if !b.Start.Before(span.End) {
+				break
+			}
+			if b.Start.After(cursor) {
+				free = append(free, Interval{cursor, b.Start})
+			}
+			cursor = b.End
+		}
+		if cursor.Before(span.End) {
+			free = append(free, Interval{cursor, span.End})
+		}
+	}
+
+	kept := free[:0]
+	for _, f := range free {
+		if f.End.Sub(f.Start) >= min && f.End.After(f.Start) {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// merge sorts intervals and joins those that overlap or touch
+func merge(intervals []Interval) []Interval {
+	sorted := append([]Interval(nil), intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+	var merged []Interval
+	for _, in := range sorted {
+		if n := len(merged); n > 0 && !in.Start.After(merged[n-1].End) {
+			merged[n-1].End = maxTime(merged[n-1].End, in.End)
+			continue
+		}
+		merged = append(merged, in)
+	}
+	return merged
+}
+
+func ratio(num, den float64) float64 {
+	if den <= 0 {
+		return 0
+	}
+	return num / den
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,80 @@
+package meetingload
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCompute(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 3, day, hour, minute, 0, 0, time.UTC)
+	}
+	// Mon Mar 10 to Sun Mar 16, 8h days Monday to Friday
+	daily := [7]float64{8, 8, 8, 8, 8, 0, 0}
+	meetings := []Interval{
+		{at(10, 10, 0), at(10, 11, 0)},
+		{at(10, 10, 30), at(10, 11, 30)}, // Overlaps the first
+		{at(10, 11, 45), at(10, 12, 30)}, // Leaves a 15m gap
+		{at(10, 16, 0), at(10, 18, 0)},   // Runs past the working day
+		{at(11, 7, 0), at(11, 8, 0)},     // Before the working day
+		{at(15, 10, 0), at(15, 11, 0)},   // Saturday
+	}
+
+	r := Compute(daily, meetings, at(10, 0, 0), at(16, 0, 0), Options{DayStart: 9 * time.Hour, MinFocus: 30 * time.Minute})
+
+	if len(r.Days) != 5 {
+		t.Fatalf("expected 5 working days, got %d", len(r.Days))
+	}
+	mon := r.Days[0]
+	if mon.Meetings != 4 {
+		t.Errorf("expected 4 meetings on Monday, got %d", mon.Meetings)
+	}
+	// 10:00-11:30, 11:45-12:30 and 16:00-17:00 within 09:00-17:00
+	assertHours(t, "Monday meetings", mon.MeetingHours, 3.25)
+	// 09:00-10:00 and 12:30-16:00
+	assertHours(t, "Monday focus", mon.FocusHours, 4.5)
+	assertHours(t, "Monday fragmented", mon.FragmentedHours, 0.25)
+	if len(mon.FocusBlocks) != 2 || !mon.FocusBlocks[1].Start.Equal(at(10, 12, 30)) || !mon.FocusBlocks[1].End.Equal(at(10, 16, 0)) {
+		t.Errorf("unexpected Monday focus blocks: %+v", mon.FocusBlocks)
+	}
+
+	tue := r.Days[1]
+	if tue.Meetings != 0 || tue.FocusHours != 8 {
+		t.Errorf("expected an unscheduled Tuesday, got %+v", tue)
+	}
+
+	if len(r.Weeks) != 1 || !r.Weeks[0].WeekStart.Equal(at(10, 0, 0)) {
+		t.Fatalf("expected one week starting Monday, got %+v", r.Weeks)
+	}
+	assertHours(t, "working", r.WorkingHours, 40)
+	assertHours(t, "meetings", r.MeetingHours, 3.25)
+	assertHours(t, "meeting share", r.MeetingShare, 3.25/40)
+	assertHours(t, "week focus", r.Weeks[0].FocusHours, 36.5)
+}
+
+func TestSubtract(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 3, 10, hour, minute, 0, 0, time.UTC)
+	}
+	spans := []Interval{{at(9, 0), at(12, 0)}, {at(14, 0), at(15, 0)}}
+	busy := []Interval{{at(9, 30), at(10, 0)}, {at(11, 50), at(14, 30)}}
+
+	free := Subtract(spans, busy, 15*time.Minute)
+	want := []Interval{{at(9, 0), at(9, 30)}, {at(10, 0), at(11, 50)}, {at(14, 30), at(15, 0)}}
+	if len(free) != len(want) {
+		t.Fatalf("expected %d pieces, got %+v", len(want), free)
+	}
+	for i := range want {
+		if !free[i].Start.Equal(want[i].Start) || !free[i].End.Equal(want[i].End) {
+			t.Errorf("piece %d: expected %v-%v, got %v-%v", i, want[i].Start, want[i].End, free[i].Start, free[i].End)
+		}
+	}
+}
+
+func assertHours(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("%s: expected %.4f, got %.4f", name, want, got)
+	}
+}
@@ -0,0 +1,97 @@
+package meetingload
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// GapExternalIDPrefix marks the focus sessions recorded from meeting gaps,
+// so re-recording a range updates them rather than adding more
+const GapExternalIDPrefix = "focus:gap:"
+
+// WorkingHours looks up a user's working-hours profile
+type WorkingHours func(ctx context.Context, userID uuid.UUID) (*store.WorkingHours, error)
+
+// Service loads calendar time and working hours for meeting load reports
+type Service struct {
+	events       *store.CalendarEventStore
+	workingHours WorkingHours
+}
+
+// NewService creates a new meeting load service
+func NewService(events *store.CalendarEventStore, workingHours WorkingHours) *Service {
+	return &Service{events: events, workingHours: workingHours}
+}
+
+// Report measures the meeting load of each working day in the inclusive
+// date range. Logged focus sessions aren't meetings, so they fall within the
+// gaps.
+func (s *Service) Report(ctx context.Context, userID uuid.UUID, start, end time.Time, opts Options) (*Report, error) {
+	wh, err := s.workingHours(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	meetings, _, err := s.load(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return Compute(wh.DailyHours, meetings, start, end, opts), nil
+}
+
+// FocusGaps returns the report's focus blocks less the time already covered
+// by focus sessions logged from timers, dropping pieces shorter than
+// opts.MinFocus
+func (s *Service) FocusGaps(ctx context.Context, userID uuid.UUID, start, end time.Time, opts Options) ([]Interval, error) {
+	wh, err := s.workingHours(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	meetings, logged, err := s.load(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	report := Compute(wh.DailyHours, meetings, start, end, opts)
+	return Subtract(report.FocusBlocks(), logged, opts.MinFocus), nil
+}
+
+// load returns the busy meeting time around the date range, and the focus
+// sessions logged from timers rather than recorded from gaps
+func (s *Service) load(ctx context.Context, userID uuid.UUID, start, end time.Time) (meetings, logged []Interval, err error) {
+	// Events that started the day before can run into the first working
+	// day, and a late working day can run past midnight
+	first, last := start.AddDate(0, 0, -1), end.AddDate(0, 0, 1)
+	events, err := s.events.List(ctx, userID, &first, &last, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, e := range events {
+		if !IsBusy(e) {
+			continue
+		}
+		in := Interval{e.StartTime.UTC(), e.EndTime.UTC()}
+		switch {
+		case !store.IsFocusSession(e):
+			meetings = append(meetings, in)
+		case !strings.HasPrefix(e.ExternalID, GapExternalIDPrefix):
+			logged = append(logged, in)
+		}
+	}
+	return meetings, logged, nil
+}
+
+// IsBusy reports whether an event takes up the user's time: a timed event
+// they attend and didn't skip, not marked free
+func IsBusy(e *store.CalendarEvent) bool {
+	if e.IsAllDay || e.IsSkipped || e.IsSuppressed {
+		return false
+	}
+	if e.Transparency != nil && *e.Transparency == "transparent" {
+		return false
+	}
+	return e.ResponseStatus == nil || *e.ResponseStatus != "declined"
+}
@@ -0,0 +1,278 @@
+// Package quickentry parses short free-text time entries such as
+// "2h yesterday on Acme — code review" into a draft: hours, date, project
+// and description.
+package quickentry
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MatchKind says how the project was identified
+type MatchKind string
+
+const (
+	MatchShortCode   MatchKind = "short_code"
+	MatchName        MatchKind = "name"
+	MatchFingerprint MatchKind = "fingerprint"
+	MatchFuzzy       MatchKind = "fuzzy"
+)
+
+// maxFuzzyDistance is the largest edit distance, relative to the length of
+// the project name, accepted as a fuzzy match
+const maxFuzzyDistance = 0.34
+
+// Project is a candidate project for matching
+type Project struct {
+	ID        uuid.UUID
+	Name      string
+	ShortCode string
+	Keywords  []string
+	Domains   []string
+}
+
+// Draft is the parsed entry. Fields that could not be parsed are left zero
+// and explained in Warnings.
+type Draft struct {
+	Hours       float64
+	Date        time.Time
+	ProjectID   *uuid.UUID
+	ProjectName string
+	Match       MatchKind
+	Description string
+	Warnings    []string
+}
+
+// Complete reports whether the draft has everything needed to create an entry
+func (d Draft) Complete() bool {
+	return d.Hours > 0 && d.ProjectID != nil && !d.Date.IsZero()
+}
+
+var (
+	// Separates the entry from its description: "… — code review"
+	descriptionSeparator = regexp.MustCompile(`\s+[—–-]\s+|:\s+`)
+
+	hoursPattern   = regexp.MustCompile(`(?i)\b(\d+(?:\.\d+)?)\s*(?:h|hrs?|hours?)(?:(\d{1,2})m?)?\b`)
+	minutesPattern = regexp.MustCompile(`(?i)\b(\d+)\s*(?:m|min|mins|minute|minutes)\b`)
+	clockPattern   = regexp.MustCompile(`\b(\d{1,2}):([0-5]\d)\b`)
+
+	isoDatePattern = regexp.MustCompile(`\b(\d{4}-\d{2}-\d{2})\b`)
+	daysAgoPattern = regexp.MustCompile(`(?i)\b(\d+)\s+days?\s+ago\b`)
+	weekdayPattern = regexp.MustCompile(`(?i)\b(last\s+)?(monday|tuesday|wednesday|thursday|friday|saturday|sunday)\b`)
+	relativeDay    = regexp.MustCompile(`(?i)\b(today|yesterday)\b`)
+
+	// Filler words dropped from the project phrase
+	fillerWords = map[string]bool{
+		"on": true, "for": true, "worked": true, "spent": true, "at": true,
+		"with": true, "and": true, "of": true, "in": true,
+	}
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday,
+	"wednesday": time.Wednesday, "thursday": time.Thursday, "friday": time.Friday,
+	"saturday": time.Saturday,
+}
+
+// Parse reads text relative to today (a date in the user's time zone) and
+// matches the project against projects
+func Parse(text string, today time.Time, projects []Project) Draft {
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	var d Draft
+
+	main := strings.TrimSpace(text)
+	if loc := descriptionSeparator.FindStringIndex(main); loc != nil {
+		d.Description = strings.TrimSpace(main[loc[1]:])
+		main = main[:loc[0]]
+	}
+
+	main, d.Hours = extractHours(main)
+	if d.Hours <= 0 {
+		d.Warnings = append(d.Warnings, "no duration found (e.g. 2h, 90m or 1:30)")
+	}
+
+	var dateFound bool
+	main, d.Date, dateFound = extractDate(main, today)
+	if !dateFound {
+		d.Date = today
+	}
+
+	query := projectQuery(main)
+	if query == "" {
+		d.Warnings = append(d.Warnings, "no project named")
+		return d
+	}
+	project, match, candidates := matchProject(query, projects)
+	switch {
+	case project != nil:
+		id := project.ID
+		d.ProjectID = &id
+		d.ProjectName = project.Name
+		d.Match = match
+	case len(candidates) > 1:
+		d.Warnings = append(d.Warnings, fmt.Sprintf("%q matches several projects: %s", query, strings.Join(candidates, ", ")))
+	default:
+		d.Warnings = append(d.Warnings, fmt.Sprintf("no project matches %q", query))
+	}
+	return d
+}
+
+// extractHours removes every duration from s and returns their total
+func extractHours(s string) (string, float64) {
+	var hours float64
+	s = clockPattern.ReplaceAllStringFunc(s, func(m string) string {
+		parts := clockPattern.FindStringSubmatch(m)
+		h, _ := strconv.Atoi(parts[1])
+		min, _ := strconv.Atoi(parts[2])
+		hours += float64(h) + float64(min)/60
+		return " "
+	})
+	s = hoursPattern.ReplaceAllStringFunc(s, func(m string) string {
+		parts := hoursPattern.FindStringSubmatch(m)
+		v, _ := strconv.ParseFloat(parts[1], 64)
+		hours += v
+		if parts[2] != "" {
+			min, _ := strconv.Atoi(parts[2])
+			hours += float64(min) / 60
+		}
+		return " "
+	})
+	s = minutesPattern.ReplaceAllStringFunc(s, func(m string) string {
+		v, _ := strconv.Atoi(minutesPattern.FindStringSubmatch(m)[1])
+		hours += float64(v) / 60
+		return " "
+	})
+	return s, round2(hours)
+}
+
+// extractDate removes the first date expression from s and resolves it.
+// Weekdays mean the most recent such day, today included; "last monday"
+// said on a Monday means a week ago.
+func extractDate(s string, today time.Time) (string, time.Time, bool) {
+	if m := isoDatePattern.FindStringSubmatchIndex(s); m != nil {
+		if date, err := time.Parse("2006-01-02", s[m[2]:m[3]]); err == nil {
+			return s[:m[0]] + " " + s[m[1]:], date, true
+		}
+	}
+	if m := relativeDay.FindStringSubmatchIndex(s); m != nil {
+		date := today
+		if strings.EqualFold(s[m[2]:m[3]], "yesterday") {
+			date = today.AddDate(0, 0, -1)
+		}
+		return s[:m[0]] + " " + s[m[1]:], date, true
+	}
+	if m := daysAgoPattern.FindStringSubmatchIndex(s); m != nil {
+		n, _ := strconv.Atoi(s[m[2]:m[3]])
+		return s[:m[0]] + " " + s[m[1]:], today.AddDate(0, 0, -n), true
+	}
+	if m := weekdayPattern.FindStringSubmatchIndex(s); m != nil {
+		day := weekdays[strings.ToLower(s[m[4]:m[5]])]
+		back := (int(today.Weekday()) - int(day) + 7) % 7
+		if m[2] >= 0 && back == 0 {
+			back = 7
+		}
+		return s[:m[0]] + " " + s[m[1]:], today.AddDate(0, 0, -back), true
+	}
+	return s, time.Time{}, false
+}
+
+// projectQuery is what remains of the entry once durations, dates and
+// filler words are gone
+func projectQuery(s string) string {
+	var words []string
+	for _, w := range strings.Fields(s) {
+		w = strings.Trim(w, ",.;")
+		if w == "" || fillerWords[strings.ToLower(w)] {
+			continue
+		}
+		words = append(words, w)
+	}
+	return strings.Join(words, " ")
+}
+
+// matchProject finds the project named by query, trying short codes, names
+// and fingerprints before fuzzy matching. When fuzzy matching is ambiguous
+// no project is returned and the candidates are listed.
+func matchProject(query string, projects []Project) (*Project, MatchKind, []string) {
+	q := strings.ToLower(query)
+
+	for i, p := range projects {
+		if p.ShortCode != "" && strings.EqualFold(p.ShortCode, q) {
+			return &projects[i], MatchShortCode, nil
+		}
+	}
+	for i, p := range projects {
+		if strings.EqualFold(p.Name, q) {
+			return &projects[i], MatchName, nil
+		}
+	}
+	for i, p := range projects {
+		for _, k := range p.Keywords {
+			if strings.EqualFold(k, q) {
+				return &projects[i], MatchFingerprint, nil
+			}
+		}
+		for _, domain := range p.Domains {
+			label, _, _ := strings.Cut(strings.ToLower(domain), ".")
+			if strings.EqualFold(domain, q) || label == q {
+				return &projects[i], MatchFingerprint, nil
+			}
+		}
+	}
+
+	var best []int
+	bestScore := maxFuzzyDistance
+	for i, p := range projects {
+		name := strings.ToLower(p.Name)
+		score := float64(levenshtein(q, name)) / float64(max(len(name), 1))
+		if strings.Contains(name, q) || strings.Contains(q, name) {
+			score = 0
+		}
+		switch {
+		case score < bestScore:
+			bestScore = score
+			best = []int{i}
+		case score == bestScore && best != nil:
+			best = append(best, i)
+		}
+	}
+	if len(best) == 1 {
+		return &projects[best[0]], MatchFuzzy, nil
+	}
+	names := make([]string, len(best))
+	for i, idx := range best {
+		names[i] = projects[idx].Name
+	}
+	return nil, "", names
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+func round2(v float64) float64 {
+	return float64(int64(v*100+0.5)) / 100
+}
@@ -0,0 +1,74 @@
+package quickentry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestParse(t *testing.T) {
+	// A Wednesday
+	today := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	acme := Project{ID: uuid.New(), Name: "Acme Corp", ShortCode: "ACME", Domains: []string{"acme.com"}}
+	globex := Project{ID: uuid.New(), Name: "Globex", Keywords: []string{"hank"}}
+	internal := Project{ID: uuid.New(), Name: "Internal Tools"}
+	projects := []Project{acme, globex, internal}
+
+	tests := []struct {
+		text        string
+		hours       float64
+		date        time.Time
+		project     *Project
+		match       MatchKind
+		description string
+	}{
+		{"2h yesterday on Acme — code review", 2, day(4), &acme, MatchShortCode, "code review"},
+		{"45m today acme.com", 0.75, today, &acme, MatchFingerprint, ""},
+		{"1.5 hours for ACME: planning", 1.5, today, &acme, MatchShortCode, "planning"},
+		{"90m globex", 1.5, today, &globex, MatchName, ""},
+		{"2h30m monday Internal - dashboards", 2.5, day(3), &internal, MatchFuzzy, "dashboards"},
+		{"last wednesday 1:15 hank", 1.25, time.Date(2025, 2, 26, 0, 0, 0, 0, time.UTC), &globex, MatchFingerprint, ""},
+		{"3h 2025-02-14 Globx", 3, time.Date(2025, 2, 14, 0, 0, 0, 0, time.UTC), &globex, MatchFuzzy, ""},
+		{"4h 2 days ago acme corp", 4, day(3), &acme, MatchName, ""},
+	}
+
+	for _, tt := range tests {
+		d := Parse(tt.text, today, projects)
+		if d.Hours != tt.hours {
+			t.Errorf("%q: expected %vh, got %v", tt.text, tt.hours, d.Hours)
+		}
+		if !d.Date.Equal(tt.date) {
+			t.Errorf("%q: expected %s, got %s", tt.text, tt.date.Format("2006-01-02"), d.Date.Format("2006-01-02"))
+		}
+		if d.ProjectID == nil || *d.ProjectID != tt.project.ID || d.Match != tt.match {
+			t.Errorf("%q: expected %s by %s, got %q by %s (warnings %v)", tt.text, tt.project.Name, tt.match, d.ProjectName, d.Match, d.Warnings)
+		}
+		if d.Description != tt.description {
+			t.Errorf("%q: expected description %q, got %q", tt.text, tt.description, d.Description)
+		}
+		if !d.Complete() {
+			t.Errorf("%q: expected a complete draft", tt.text)
+		}
+	}
+}
+
+func TestParse_Incomplete(t *testing.T) {
+	today := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	projects := []Project{
+		{ID: uuid.New(), Name: "Acme Web"},
+		{ID: uuid.New(), Name: "Acme Mobile"},
+	}
+
+	d := Parse("2h on acme", today, projects)
+	if d.ProjectID != nil || len(d.Warnings) != 1 {
+		t.Errorf("expected an ambiguous match to leave the project unset, got %+v", d)
+	}
+
+	d = Parse("yesterday on Acme Web", today, projects)
+	if d.Hours != 0 || d.Complete() || len(d.Warnings) != 1 {
+		t.Errorf("expected a missing duration to be reported, got %+v", d)
+	}
+}
@@ -0,0 +1,128 @@
+// Package redact keeps personal data and credentials out of log output.
+//
+// Values known to be personal, such as event titles and calendar names, are
+// wrapped with Text where they are logged. Everything written through Writer
+// is also scanned for email addresses and tokens, which turn up inside error
+// messages and request URLs the code doesn't control.
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"sync/atomic"
+)
+
+// Mode is how personal data appears in logs
+type Mode string
+
+const (
+	// ModeOff logs values as they are
+	ModeOff Mode = "off"
+	// ModeHash replaces values with a short stable hash, so lines about the
+	// same calendar or attendee can still be correlated
+	ModeHash Mode = "hash"
+	// ModeRedact replaces values with a placeholder
+	ModeRedact Mode = "redact"
+)
+
+// Placeholder replaces redacted values and, in every mode but off, tokens
+const Placeholder = "[redacted]"
+
+var mode atomic.Value
+
+func init() {
+	mode.Store(ModeOff)
+}
+
+// ParseMode parses a PRIVACY_MODE setting; empty is off
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(s); m {
+	case "":
+		return ModeOff, nil
+	case ModeOff, ModeHash, ModeRedact:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown privacy mode %q (want off, hash or redact)", s)
+	}
+}
+
+// SetMode sets how personal data is logged process-wide
+func SetMode(m Mode) {
+	mode.Store(m)
+}
+
+// CurrentMode returns the process-wide mode
+func CurrentMode() Mode {
+	return mode.Load().(Mode)
+}
+
+// Text returns a personal value, such as an event title or calendar name, as
+// it may be logged
+func Text(s string) string {
+	switch CurrentMode() {
+	case ModeHash:
+		if s == "" {
+			return s
+		}
+		sum := sha256.Sum256([]byte(s))
+		return "h:" + hex.EncodeToString(sum[:4])
+	case ModeRedact:
+		return Placeholder
+	default:
+		return s
+	}
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+	// tokenPatterns match credentials: bearer headers, Google access and
+	// refresh tokens, JWTs and this service's own API, MCP and SCIM tokens
+	tokenPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/=-]+`),
+		regexp.MustCompile(`\bya29\.[A-Za-z0-9._-]+`),
+		regexp.MustCompile(`\b1//[A-Za-z0-9._-]+`),
+		regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+		regexp.MustCompile(`\b(?:ts|mcp|scim|confirm)_[0-9a-f]{16,}\b`),
+	}
+
+	// tokenParamPattern matches credentials passed as URL or JSON fields,
+	// keeping the field name: ?code=..., "refresh_token":"..."
+	tokenParamPattern = regexp.MustCompile(`([?&](?:code|state)=|\b(?:access_token|refresh_token|id_token|client_secret|api_key|password)"?\s*[=:]\s*"?)([^&\s",}]+)`)
+)
+
+// Line sanitizes a line of log output: tokens are replaced and email
+// addresses pass through Text. Lines are unchanged when the mode is off.
+func Line(s string) string {
+	if CurrentMode() == ModeOff {
+		return s
+	}
+	s = tokenParamPattern.ReplaceAllString(s, "${1}"+Placeholder)
+	for _, p := range tokenPatterns {
+		s = p.ReplaceAllString(s, Placeholder)
+	}
+	return emailPattern.ReplaceAllStringFunc(s, Text)
+}
+
+// Writer sanitizes everything written to it with Line. The log package makes
+// one Write per entry, so entries are sanitized whole.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter wraps w so log output written to it is sanitized
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write sanitizes p and writes it to the underlying writer. It reports the
+// length of p, since callers don't know the sanitized length.
+func (w *Writer) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.w, Line(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,126 @@
+package redact
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+)
+
+func withMode(t *testing.T, m Mode) {
+	t.Helper()
+	previous := CurrentMode()
+	SetMode(m)
+	t.Cleanup(func() { SetMode(previous) })
+}
+
+func TestText(t *testing.T) {
+	withMode(t, ModeOff)
+	if got := Text("Team standup"); got != "Team standup" {
+		t.Errorf("off: Text() = %q", got)
+	}
+
+	SetMode(ModeHash)
+	hashed := Text("Team standup")
+	if hashed == "Team standup" || !strings.HasPrefix(hashed, "h:") {
+		t.Errorf("hash: Text() = %q, want a hash", hashed)
+	}
+	if Text("Team standup") != hashed {
+		t.Error("Expected the same value to hash the same way")
+	}
+	if Text("Design review") == hashed {
+		t.Error("Expected different values to hash differently")
+	}
+
+	SetMode(ModeRedact)
+	if got := Text("Team standup"); got != Placeholder {
+		t.Errorf("redact: Text() = %q", got)
+	}
+}
+
+func TestLine(t *testing.T) {
+	withMode(t, ModeRedact)
+
+	tests := []struct {
+		name     string
+		line     string
+		expected string
+	}{
+		{
+			"email in a calendar ID",
+			"[SYNC] fetch failed: calendar=alice@example.com error=notFound",
+			"[SYNC] fetch failed: calendar=[redacted] error=notFound",
+		},
+		{
+			"bearer header",
+			"request failed: Authorization: Bearer abc.def-123",
+			"request failed: Authorization: [redacted]",
+		},
+		{
+			"Google access token",
+			"token=ya29.a0AfH6SMBx expired",
+			"token=[redacted] expired",
+		},
+		{
+			"OAuth callback URL",
+			`"GET /api/auth/google/callback?state=xyz&code=4/0AX4 HTTP/1.1"`,
+			`"GET /api/auth/google/callback?state=[redacted]&code=[redacted] HTTP/1.1"`,
+		},
+		{
+			"token endpoint response",
+			`oauth2: cannot fetch token: {"error":"invalid_grant","refresh_token":"1//0gabc"}`,
+			`oauth2: cannot fetch token: {"error":"invalid_grant","refresh_token":"[redacted]"}`,
+		},
+		{
+			"API key",
+			"invalid key ts_0123456789abcdef0123 used",
+			"invalid key [redacted] used",
+		},
+		{
+			"nothing personal",
+			"Job worker: job 42 completed successfully",
+			"Job worker: job 42 completed successfully",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Line(tt.line); got != tt.expected {
+				t.Errorf("Line() = %q\n want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLine_Off(t *testing.T) {
+	withMode(t, ModeOff)
+	line := "calendar=alice@example.com token=ya29.abc"
+	if got := Line(line); got != line {
+		t.Errorf("Line() = %q, want it unchanged", got)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	withMode(t, ModeHash)
+
+	var buf bytes.Buffer
+	logger := log.New(NewWriter(&buf), "", 0)
+	logger.Printf("[SYNC] start: calendar=%s", "alice@example.com")
+
+	want := "[SYNC] start: calendar=" + Text("alice@example.com") + "\n"
+	if buf.String() != want {
+		t.Errorf("logged %q, want %q", buf.String(), want)
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	if m, err := ParseMode(""); err != nil || m != ModeOff {
+		t.Errorf("ParseMode(\"\") = %q, %v", m, err)
+	}
+	if m, err := ParseMode("hash"); err != nil || m != ModeHash {
+		t.Errorf("ParseMode(hash) = %q, %v", m, err)
+	}
+	if _, err := ParseMode("on"); err == nil {
+		t.Error("Expected an unknown mode to be rejected")
+	}
+}
@@ -0,0 +1,261 @@
+// Package retention purges calendar events older than each user's retention
+// period. Time entries are always kept: those computed from purged events are
+// stored and protected first, and events behind invoiced time entries are
+// never purged.
+package retention
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/archive"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// DefaultInterval is how often the purger runs
+const DefaultInterval = 24 * time.Hour
+
+// Bounds on a user's event retention, in months
+const (
+	MinEventMonths = 6
+	MaxEventMonths = 240
+)
+
+// Users lists the users who set a retention period
+type Users interface {
+	ListRetention(ctx context.Context) ([]store.UserRetention, error)
+}
+
+// Events counts and deletes old calendar events
+type Events interface {
+	CountPurgeable(ctx context.Context, userID uuid.UUID, before time.Time) (*store.PurgeCounts, error)
+	OldestPurgeable(ctx context.Context, userID uuid.UUID, before time.Time) (*time.Time, error)
+	Purge(ctx context.Context, userID uuid.UUID, before time.Time, limit int) (int64, error)
+	PurgeArchived(ctx context.Context, userID uuid.UUID, before time.Time, limit int) (int64, error)
+}
+
+// TimeEntries keeps the time entries computed from events about to be purged
+type TimeEntries interface {
+	Preserve(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) (int64, error)
+}
+
+// Report is what a purge removed for one user, or would remove in a dry run
+type Report struct {
+	UserID uuid.UUID
+	Cutoff time.Time
+	store.PurgeCounts
+	// Time entries stored and protected so they outlive their events
+	PreservedEntries int64
+	DryRun           bool
+}
+
+// Cutoff returns the start time before which a user's events are purged
+func Cutoff(now time.Time, eventMonths int) time.Time {
+	return archive.Cutoff(now, eventMonths)
+}
+
+// Preview reports what purging the user's events older than eventMonths
+// would remove, without removing anything
+func Preview(ctx context.Context, events Events, userID uuid.UUID, eventMonths int, now time.Time) (*Report, error) {
+	report := &Report{UserID: userID, Cutoff: Cutoff(now, eventMonths), DryRun: true}
+	counts, err := events.CountPurgeable(ctx, userID, report.Cutoff)
+	if err != nil {
+		return nil, err
+	}
+	report.PurgeCounts = *counts
+	return report, nil
+}
+
+// Purger periodically deletes events older than each user's retention period
+type Purger struct {
+	users     Users
+	events    Events
+	entries   TimeEntries
+	interval  time.Duration
+	dryRun    bool
+	batchSize int
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// NewPurger creates a purger. In a dry run it only logs what it would remove.
+func NewPurger(users Users, events Events, entries TimeEntries, interval time.Duration, dryRun bool) *Purger {
+	return &Purger{
+		users:     users,
+		events:    events,
+		entries:   entries,
+		interval:  interval,
+		dryRun:    dryRun,
+		batchSize: store.DefaultPurgeBatchSize,
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// Start begins the purge loop
+func (p *Purger) Start(ctx context.Context) {
+	log.Printf("Starting retention purger (interval: %v, dry run: %v)", p.interval, p.dryRun)
+
+	go func() {
+		defer close(p.doneCh)
+
+		// Initial delay to let the server start up
+		select {
+		case <-time.After(time.Minute):
+		case <-p.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		p.run(ctx)
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.run(ctx)
+			case <-p.stopCh:
+				log.Println("Retention purger stopped")
+				return
+			case <-ctx.Done():
+				log.Println("Retention purger context cancelled")
+				return
+			}
+		}
+	}()
+}
+
+// Stop gracefully stops the purger
+func (p *Purger) Stop() {
+	close(p.stopCh)
+	<-p.doneCh
+}
+
+func (p *Purger) run(ctx context.Context) {
+	reports, err := p.RunOnce(ctx)
+	if err != nil {
+		log.Printf("[RETENTION] purge failed: %v", err)
+	}
+	for _, r := range reports {
+		if r.Events == 0 && r.ArchivedEvents == 0 {
+			continue
+		}
+		verb := "purged"
+		if r.DryRun {
+			verb = "would purge"
+		}
+		log.Printf("[RETENTION] %s: user=%s before=%s events=%d archived=%d kept_for_invoices=%d preserved_entries=%d",
+			verb, r.UserID, r.Cutoff.Format("2006-01-02"), r.Events, r.ArchivedEvents, r.ProtectedEvents, r.PreservedEntries)
+	}
+}
+
+// RunOnce purges every user with a retention period once. A user whose purge
+// fails is logged and skipped.
+func (p *Purger) RunOnce(ctx context.Context) ([]*Report, error) {
+	users, err := p.users.ListRetention(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var reports []*Report
+	for _, u := range users {
+		if p.stopped(ctx) {
+			break
+		}
+
+		var report *Report
+		if p.dryRun {
+			report, err = Preview(ctx, p.events, u.UserID, u.EventMonths, now)
+		} else {
+			report, err = p.PurgeUser(ctx, u.UserID, u.EventMonths, now)
+		}
+		if err != nil {
+			log.Printf("[RETENTION] purge failed: user=%s error=%v", u.UserID, err)
+		}
+		if report != nil {
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+// PurgeUser deletes the user's events older than eventMonths, a month at a
+// time from the oldest, preserving each month's time entries before its
+// events go. It returns what was removed so far, even on error.
+func (p *Purger) PurgeUser(ctx context.Context, userID uuid.UUID, eventMonths int, now time.Time) (*Report, error) {
+	report := &Report{UserID: userID, Cutoff: Cutoff(now, eventMonths)}
+
+	oldest, err := p.events.OldestPurgeable(ctx, userID, report.Cutoff)
+	if err != nil || oldest == nil {
+		return report, err
+	}
+
+	y, m, _ := oldest.UTC().Date()
+	for start := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC); start.Before(report.Cutoff); {
+		end := start.AddDate(0, 1, 0)
+		if end.After(report.Cutoff) {
+			end = report.Cutoff
+		}
+
+		preserved, err := p.entries.Preserve(ctx, userID, start, end.AddDate(0, 0, -1))
+		if err != nil {
+			return report, err
+		}
+		report.PreservedEntries += preserved
+
+		purged, err := p.purgeAll(ctx, p.events.Purge, userID, end)
+		report.Events += purged
+		if err != nil {
+			return report, err
+		}
+		purged, err = p.purgeAll(ctx, p.events.PurgeArchived, userID, end)
+		report.ArchivedEvents += purged
+		if err != nil {
+			return report, err
+		}
+		if p.stopped(ctx) {
+			return report, nil
+		}
+		start = end
+	}
+
+	counts, err := p.events.CountPurgeable(ctx, userID, report.Cutoff)
+	if err != nil {
+		return report, err
+	}
+	report.ProtectedEvents = counts.ProtectedEvents
+	return report, nil
+}
+
+// purgeAll runs a purge in batches until nothing before the cutoff is left
+// and returns how many events it deleted
+func (p *Purger) purgeAll(ctx context.Context, purge func(context.Context, uuid.UUID, time.Time, int) (int64, error), userID uuid.UUID, before time.Time) (int64, error) {
+	var total int64
+	for !p.stopped(ctx) {
+		n, err := purge(ctx, userID, before, p.batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if n < int64(p.batchSize) {
+			break
+		}
+	}
+	return total, nil
+}
+
+// stopped reports whether the purger was stopped or its context cancelled
+func (p *Purger) stopped(ctx context.Context) bool {
+	select {
+	case <-p.stopCh:
+		return true
+	default:
+		return ctx.Err() != nil
+	}
+}
@@ -0,0 +1,143 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// fakeRetention is an in-memory Users, Events and TimeEntries. Events are
+// start times; invoiced events are never purged.
+type fakeRetention struct {
+	users     []store.UserRetention
+	events    []time.Time
+	archived  []time.Time
+	invoiced  int
+	preserved [][2]time.Time
+}
+
+func (f *fakeRetention) ListRetention(ctx context.Context) ([]store.UserRetention, error) {
+	return f.users, nil
+}
+
+func countBefore(times []time.Time, before time.Time) int64 {
+	var n int64
+	for _, t := range times {
+		if t.Before(before) {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeRetention) CountPurgeable(ctx context.Context, userID uuid.UUID, before time.Time) (*store.PurgeCounts, error) {
+	return &store.PurgeCounts{
+		Events:          countBefore(f.events, before),
+		ArchivedEvents:  countBefore(f.archived, before),
+		ProtectedEvents: int64(f.invoiced),
+	}, nil
+}
+
+func (f *fakeRetention) OldestPurgeable(ctx context.Context, userID uuid.UUID, before time.Time) (*time.Time, error) {
+	var oldest *time.Time
+	for _, t := range append(append([]time.Time{}, f.events...), f.archived...) {
+		if t.Before(before) && (oldest == nil || t.Before(*oldest)) {
+			oldest = &t
+		}
+	}
+	return oldest, nil
+}
+
+func purgeBefore(times *[]time.Time, before time.Time, limit int) int64 {
+	var kept []time.Time
+	var n int64
+	for _, t := range *times {
+		if t.Before(before) && n < int64(limit) {
+			n++
+			continue
+		}
+		kept = append(kept, t)
+	}
+	*times = kept
+	return n
+}
+
+func (f *fakeRetention) Purge(ctx context.Context, userID uuid.UUID, before time.Time, limit int) (int64, error) {
+	return purgeBefore(&f.events, before, limit), nil
+}
+
+func (f *fakeRetention) PurgeArchived(ctx context.Context, userID uuid.UUID, before time.Time, limit int) (int64, error) {
+	return purgeBefore(&f.archived, before, limit), nil
+}
+
+func (f *fakeRetention) Preserve(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) (int64, error) {
+	f.preserved = append(f.preserved, [2]time.Time{startDate, endDate})
+	return 1, nil
+}
+
+func TestPurger_PurgeUser(t *testing.T) {
+	now := time.Date(2025, 6, 15, 10, 0, 0, 0, time.UTC)
+	cutoff := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	day := func(month time.Month, d int) time.Time { return time.Date(2024, month, d, 9, 0, 0, 0, time.UTC) }
+
+	f := &fakeRetention{
+		events:   []time.Time{day(4, 20), day(5, 2), day(6, 14), day(6, 15), day(7, 1)},
+		archived: []time.Time{day(4, 3)},
+		invoiced: 1,
+	}
+	p := NewPurger(f, f, f, DefaultInterval, false)
+	p.batchSize = 2
+
+	report, err := p.PurgeUser(context.Background(), uuid.New(), 12, now)
+	if err != nil {
+		t.Fatalf("PurgeUser: %v", err)
+	}
+	if !report.Cutoff.Equal(cutoff) {
+		t.Errorf("expected cutoff %v, got %v", cutoff, report.Cutoff)
+	}
+	if report.Events != 3 || report.ArchivedEvents != 1 || report.ProtectedEvents != 1 {
+		t.Errorf("expected 3 events and 1 archived purged, 1 kept; got %+v", report)
+	}
+	if len(f.events) != 2 || len(f.archived) != 0 {
+		t.Errorf("expected the events from the cutoff on to stay, got %v", f.events)
+	}
+
+	// Time entries are preserved a month at a time, up to the day before the cutoff
+	want := [][2]time.Time{
+		{time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 14, 0, 0, 0, 0, time.UTC)},
+	}
+	if len(f.preserved) != len(want) {
+		t.Fatalf("expected %d preserved ranges, got %v", len(want), f.preserved)
+	}
+	for i, r := range want {
+		if !f.preserved[i][0].Equal(r[0]) || !f.preserved[i][1].Equal(r[1]) {
+			t.Errorf("range %d: expected %v, got %v", i, r, f.preserved[i])
+		}
+	}
+	if report.PreservedEntries != 3 {
+		t.Errorf("expected 3 preserved entries, got %d", report.PreservedEntries)
+	}
+}
+
+func TestPurger_DryRun(t *testing.T) {
+	f := &fakeRetention{
+		users:  []store.UserRetention{{UserID: uuid.New(), EventMonths: 6}},
+		events: []time.Time{time.Now().AddDate(-1, 0, 0), time.Now()},
+	}
+
+	reports, err := NewPurger(f, f, f, DefaultInterval, true).RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	if len(reports) != 1 || !reports[0].DryRun || reports[0].Events != 1 {
+		t.Fatalf("expected a dry run report of 1 event, got %+v", reports)
+	}
+	if len(f.events) != 2 || len(f.preserved) != 0 {
+		t.Error("Expected a dry run to change nothing")
+	}
+}
@@ -0,0 +1,126 @@
+package sso
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func signIDToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("idp-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestClient_Flow(t *testing.T) {
+	var issuer string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{
+				"issuer":                 issuer,
+				"authorization_endpoint": issuer + "/authorize",
+				"token_endpoint":         issuer + "/token",
+			})
+		case "/token":
+			r.ParseForm()
+			if r.Form.Get("code") != "the-code" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "invalid_grant"}`)
+				return
+			}
+			idToken := signIDToken(t, jwt.MapClaims{
+				"iss":   issuer,
+				"aud":   "client-1",
+				"sub":   "user-42",
+				"exp":   time.Now().Add(time.Hour).Unix(),
+				"nonce": "n-1",
+				"email": "Ada@Example.com",
+				"name":  "Ada Lovelace",
+			})
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"access_token": "at", "token_type": "Bearer", "id_token": %q}`, idToken)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	issuer = srv.URL
+
+	c := NewClient("https://timesheet.example.com/api/auth/sso/callback")
+	p := Provider{Issuer: issuer, ClientID: "client-1", ClientSecret: "secret"}
+
+	authURL, err := c.AuthURL(context.Background(), p, "state-1", "n-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(authURL)
+	q := u.Query()
+	if u.Path != "/authorize" || q.Get("state") != "state-1" || q.Get("nonce") != "n-1" || q.Get("client_id") != "client-1" {
+		t.Errorf("unexpected auth URL %s", authURL)
+	}
+
+	id, err := c.Exchange(context.Background(), p, "the-code", "n-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Subject != "user-42" || id.Email != "ada@example.com" || id.Name != "Ada Lovelace" {
+		t.Errorf("unexpected identity %+v", id)
+	}
+
+	if _, err := c.Exchange(context.Background(), p, "the-code", "n-2"); !errors.Is(err, ErrInvalidIDToken) {
+		t.Errorf("expected a nonce mismatch to be rejected, got %v", err)
+	}
+	if _, err := c.Exchange(context.Background(), p, "bad-code", "n-1"); err == nil {
+		t.Error("expected a rejected code to fail")
+	}
+}
+
+func TestParseIDToken(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   "https://idp.example.com/",
+			"aud":   []string{"other", "client-1"},
+			"sub":   "u1",
+			"exp":   now.Add(time.Minute).Unix(),
+			"nonce": "n",
+			"email": "ada@example.com",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		change  func(jwt.MapClaims)
+		wantErr bool
+	}{
+		{"valid", func(jwt.MapClaims) {}, false},
+		{"verified email", func(c jwt.MapClaims) { c["email_verified"] = true }, false},
+		{"other issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }, true},
+		{"other audience", func(c jwt.MapClaims) { c["aud"] = "client-2" }, true},
+		{"expired", func(c jwt.MapClaims) { c["exp"] = now.Add(-time.Minute).Unix() }, true},
+		{"no expiry", func(c jwt.MapClaims) { delete(c, "exp") }, true},
+		{"nonce mismatch", func(c jwt.MapClaims) { c["nonce"] = "other" }, true},
+		{"no email", func(c jwt.MapClaims) { delete(c, "email") }, true},
+		{"unverified email", func(c jwt.MapClaims) { c["email_verified"] = false }, true},
+	}
+	for _, tt := range tests {
+		claims := valid()
+		tt.change(claims)
+		_, err := parseIDToken(signIDToken(t, claims), "https://idp.example.com", "client-1", "n", now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+// UserSyncStats summarizes calendar sync health for one user
+type UserSyncStats struct {
+	UserID            uuid.UUID
+	Email             string
+	Connections       int
+	SelectedCalendars int
+	NeedsReauth       int        // Selected calendars whose OAuth refresh failed
+	FailingCalendars  int        // Selected calendars with consecutive sync failures
+	DisabledCalendars int        // Selected calendars disabled by a permanent failure
+	OldestSyncedAt    *time.Time // Least recent last_synced_at across selected calendars
+	PendingJobs       int
+	FailedJobs        int
+}
+
+// AdminStore provides cross-user queries for operators. None of its methods
+// are scoped to a user, so it must only be reachable from the admin surface.
+type AdminStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewAdminStore creates a new admin store
+func NewAdminStore(pool *pgxpool.Pool) *AdminStore {
+	return &AdminStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *AdminStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// SchemaVersion returns the highest applied migration
+func (s *AdminStore) SchemaVersion(ctx context.Context) (int, error) {
+	var version int
+	err := s.db(ctx).QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
+	return version, err
+}
+
+// SyncJobCounts returns the number of sync jobs in each status
+func (s *AdminStore) SyncJobCounts(ctx context.Context) (map[SyncJobStatus]int, error) {
+	rows, err := s.db(ctx).Query(ctx, "SELECT status, COUNT(*) FROM calendar_sync_jobs GROUP BY status")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[SyncJobStatus]int)
+	for rows.Next() {
+		var status SyncJobStatus
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			return nil, err
+		}
+		counts[status] = n
+	}
+	return counts, rows.Err()
+}
+
+// ListUserSyncStats returns sync stats for every user with a calendar
+// connection, users with problems first
+func (s *AdminStore) ListUserSyncStats(ctx context.Context) ([]*UserSyncStats, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT u.id, u.email,
+		       (SELECT COUNT(*) FROM calendar_connections cc WHERE cc.user_id = u.id),
+		       COUNT(c.id) FILTER (WHERE c.is_selected),
+		       COUNT(c.id) FILTER (WHERE c.is_selected AND c.needs_reauth),
+		       COUNT(c.id) FILTER (WHERE c.is_selected AND c.sync_failure_count > 0),
+		       COUNT(c.id) FILTER (WHERE c.is_selected AND c.sync_disabled_at IS NOT NULL),
+		       MIN(c.last_synced_at) FILTER (WHERE c.is_selected),
+		       (SELECT COUNT(*) FROM calendar_sync_jobs j JOIN calendars jc ON jc.id = j.calendar_id
+		        WHERE jc.user_id = u.id AND j.status = 'pending'),
+		       (SELECT COUNT(*) FROM calendar_sync_jobs j JOIN calendars jc ON jc.id = j.calendar_id
+		        WHERE jc.user_id = u.id AND j.status = 'failed')
+		FROM users u
+		JOIN calendars c ON c.user_id = u.id
+		GROUP BY u.id, u.email
+		ORDER BY COUNT(c.id) FILTER (WHERE c.is_selected AND (c.needs_reauth OR c.sync_failure_count > 0 OR c.sync_disabled_at IS NOT NULL)) DESC,
+		         u.email
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []*UserSyncStats
+	for rows.Next() {
+		st := &UserSyncStats{}
+		if err := rows.Scan(
+			&st.UserID, &st.Email, &st.Connections, &st.SelectedCalendars, &st.NeedsReauth,
+			&st.FailingCalendars, &st.DisabledCalendars, &st.OldestSyncedAt, &st.PendingJobs, &st.FailedJobs,
+		); err != nil {
+			return nil, err
+		}
+		stats = append(stats, st)
+	}
+	return stats, rows.Err()
+}
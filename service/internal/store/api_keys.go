@@ -6,11 +6,14 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var (
@@ -21,12 +24,26 @@ var (
 
 // APIKey represents a stored API key (without the actual key value)
 type APIKey struct {
-	ID         uuid.UUID
-	UserID     uuid.UUID
-	Name       string
-	KeyPrefix  string // First 8 chars for display
-	LastUsedAt *time.Time
-	CreatedAt  time.Time
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	KeyPrefix string // First 8 chars for display
+	// RequireConfirmation makes destructive MCP tools preview before executing
+	RequireConfirmation bool
+	// AllowedTools limits the MCP tools the key may call; empty allows all
+	AllowedTools []string
+	// DeniedTools are MCP tools the key may never call
+	DeniedTools []string
+	LastUsedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+// ToolAllowed reports whether the key's tool policy permits an MCP tool
+func (k *APIKey) ToolAllowed(tool string) bool {
+	if slices.Contains(k.DeniedTools, tool) {
+		return false
+	}
+	return len(k.AllowedTools) == 0 || slices.Contains(k.AllowedTools, tool)
 }
 
 // APIKeyWithSecret is returned only on creation, includes the raw key
@@ -35,6 +52,25 @@ type APIKeyWithSecret struct {
 	Key string // The actual API key (only available at creation)
 }
 
+// APIKeySettings are the options chosen when a key is created
+type APIKeySettings struct {
+	RequireConfirmation bool
+	AllowedTools        []string
+	DeniedTools         []string
+}
+
+const apiKeyColumns = `id, user_id, name, key_prefix, require_confirmation, allowed_tools, denied_tools, last_used_at, created_at`
+
+func scanAPIKey(row pgx.Row) (*APIKey, error) {
+	var k APIKey
+	err := row.Scan(&k.ID, &k.UserID, &k.Name, &k.KeyPrefix, &k.RequireConfirmation,
+		&k.AllowedTools, &k.DeniedTools, &k.LastUsedAt, &k.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
 // APIKeyStore provides PostgreSQL-backed API key storage
 type APIKeyStore struct {
 	pool *pgxpool.Pool
@@ -45,6 +81,11 @@ func NewAPIKeyStore(pool *pgxpool.Pool) *APIKeyStore {
 	return &APIKeyStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *APIKeyStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // generateKey creates a new random API key with prefix
 // Format: ts_<32 random hex chars> (total 35 chars)
 func generateKey() (key string, prefix string, hash string, err error) {
@@ -72,7 +113,7 @@ func hashKey(key string) string {
 }
 
 // Create generates a new API key for a user
-func (s *APIKeyStore) Create(ctx context.Context, userID uuid.UUID, name string) (*APIKeyWithSecret, error) {
+func (s *APIKeyStore) Create(ctx context.Context, userID uuid.UUID, name string, settings APIKeySettings) (*APIKeyWithSecret, error) {
 	key, prefix, hash, err := generateKey()
 	if err != nil {
 		return nil, err
@@ -80,19 +121,22 @@ func (s *APIKeyStore) Create(ctx context.Context, userID uuid.UUID, name string)
 
 	apiKey := &APIKeyWithSecret{
 		APIKey: APIKey{
-			ID:        uuid.New(),
-			UserID:    userID,
-			Name:      name,
-			KeyPrefix: prefix,
-			CreatedAt: time.Now().UTC(),
+			ID:                  uuid.New(),
+			UserID:              userID,
+			Name:                name,
+			KeyPrefix:           prefix,
+			RequireConfirmation: settings.RequireConfirmation,
+			AllowedTools:        nonNilStrings(settings.AllowedTools),
+			DeniedTools:         nonNilStrings(settings.DeniedTools),
+			CreatedAt:           time.Now().UTC(),
 		},
 		Key: key,
 	}
 
-	_, err = s.pool.Exec(ctx, `
-		INSERT INTO api_keys (id, user_id, name, key_hash, key_prefix, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-	`, apiKey.ID, userID, name, hash, prefix, apiKey.CreatedAt)
+	_, err = s.db(ctx).Exec(ctx, `
+		INSERT INTO api_keys (id, user_id, name, key_hash, key_prefix, require_confirmation, allowed_tools, denied_tools, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, apiKey.ID, userID, name, hash, prefix, apiKey.RequireConfirmation, apiKey.AllowedTools, apiKey.DeniedTools, apiKey.CreatedAt)
 
 	if err != nil {
 		if isDuplicateKeyError(err) {
@@ -106,8 +150,8 @@ func (s *APIKeyStore) Create(ctx context.Context, userID uuid.UUID, name string)
 
 // List returns all API keys for a user (without the actual key values)
 func (s *APIKeyStore) List(ctx context.Context, userID uuid.UUID) ([]APIKey, error) {
-	rows, err := s.pool.Query(ctx, `
-		SELECT id, user_id, name, key_prefix, last_used_at, created_at
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+apiKeyColumns+`
 		FROM api_keys
 		WHERE user_id = $1
 		ORDER BY created_at DESC
@@ -119,19 +163,34 @@ func (s *APIKeyStore) List(ctx context.Context, userID uuid.UUID) ([]APIKey, err
 
 	var keys []APIKey
 	for rows.Next() {
-		var k APIKey
-		if err := rows.Scan(&k.ID, &k.UserID, &k.Name, &k.KeyPrefix, &k.LastUsedAt, &k.CreatedAt); err != nil {
+		k, err := scanAPIKey(rows)
+		if err != nil {
 			return nil, err
 		}
-		keys = append(keys, k)
+		keys = append(keys, *k)
 	}
 
 	return keys, rows.Err()
 }
 
+// Get returns one of a user's API keys
+func (s *APIKeyStore) Get(ctx context.Context, userID uuid.UUID, keyID uuid.UUID) (*APIKey, error) {
+	k, err := scanAPIKey(s.db(ctx).QueryRow(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys WHERE id = $1 AND user_id = $2
+	`, keyID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return k, nil
+}
+
 // Delete removes an API key
 func (s *APIKeyStore) Delete(ctx context.Context, userID uuid.UUID, keyID uuid.UUID) error {
-	result, err := s.pool.Exec(ctx, `
+	result, err := s.db(ctx).Exec(ctx, `
 		DELETE FROM api_keys WHERE id = $1 AND user_id = $2
 	`, keyID, userID)
 	if err != nil {
@@ -145,22 +204,64 @@ func (s *APIKeyStore) Delete(ctx context.Context, userID uuid.UUID, keyID uuid.U
 	return nil
 }
 
+// Update changes an API key's settings. updates maps columns
+// (require_confirmation, allowed_tools, denied_tools) to their new values.
+func (s *APIKeyStore) Update(ctx context.Context, userID uuid.UUID, keyID uuid.UUID, updates map[string]interface{}) (*APIKey, error) {
+	if len(updates) == 0 {
+		return s.Get(ctx, userID, keyID)
+	}
+
+	// Build dynamic update query
+	setClauses := ""
+	args := []interface{}{keyID, userID}
+	argNum := 3
+
+	for key, value := range updates {
+		if setClauses != "" {
+			setClauses += ", "
+		}
+		setClauses += fmt.Sprintf("%s = $%d", key, argNum)
+		args = append(args, value)
+		argNum++
+	}
+
+	query := "UPDATE api_keys SET " + setClauses + " WHERE id = $1 AND user_id = $2 RETURNING " + apiKeyColumns
+
+	k, err := scanAPIKey(s.db(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return k, nil
+}
+
 // ValidateAndGetUserID checks if an API key is valid and returns the associated user ID
 func (s *APIKeyStore) ValidateAndGetUserID(ctx context.Context, key string) (uuid.UUID, error) {
+	k, err := s.Validate(ctx, key)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return k.UserID, nil
+}
+
+// Validate checks if an API key is valid and returns it
+func (s *APIKeyStore) Validate(ctx context.Context, key string) (*APIKey, error) {
 	hash := hashKey(key)
 
-	var userID uuid.UUID
-	var keyID uuid.UUID
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, user_id FROM api_keys WHERE key_hash = $1
-	`, hash).Scan(&keyID, &userID)
+	k, err := scanAPIKey(s.db(ctx).QueryRow(ctx, `
+		SELECT `+apiKeyColumns+`
+		FROM api_keys WHERE key_hash = $1
+	`, hash))
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return uuid.Nil, ErrInvalidAPIKey
+			return nil, ErrInvalidAPIKey
 		}
-		return uuid.Nil, err
+		return nil, err
 	}
+	keyID := k.ID
 
 	// Update last_used_at asynchronously (fire and forget)
 	go func() {
@@ -171,5 +272,13 @@ func (s *APIKeyStore) ValidateAndGetUserID(ctx context.Context, key string) (uui
 		`, keyID)
 	}()
 
-	return userID, nil
+	return k, nil
+}
+
+// nonNilStrings stores an unset tool list as an empty array
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
 }
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var (
+	ErrAttendeeAliasNotFound = errors.New("attendee alias not found")
+	ErrAttendeeAliasExists   = errors.New("alias email is already mapped")
+)
+
+// AttendeeAlias records that AliasEmail belongs to the same person as
+// CanonicalEmail. Emails are stored lowercase.
+type AttendeeAlias struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	CanonicalEmail string
+	AliasEmail     string
+	CreatedAt      time.Time
+}
+
+// AttendeeAliasStore provides PostgreSQL-backed attendee alias storage
+type AttendeeAliasStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewAttendeeAliasStore creates a new attendee alias store
+func NewAttendeeAliasStore(pool *pgxpool.Pool) *AttendeeAliasStore {
+	return &AttendeeAliasStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *AttendeeAliasStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+const attendeeAliasColumns = `id, user_id, canonical_email, alias_email, created_at`
+
+func scanAttendeeAlias(row pgx.Row) (*AttendeeAlias, error) {
+	a := &AttendeeAlias{}
+	err := row.Scan(&a.ID, &a.UserID, &a.CanonicalEmail, &a.AliasEmail, &a.CreatedAt)
+	return a, err
+}
+
+// Create maps aliasEmail to canonicalEmail, returning ErrAttendeeAliasExists
+// when aliasEmail is already mapped
+func (s *AttendeeAliasStore) Create(ctx context.Context, userID uuid.UUID, canonicalEmail, aliasEmail string) (*AttendeeAlias, error) {
+	a, err := scanAttendeeAlias(s.db(ctx).QueryRow(ctx, `
+		INSERT INTO attendee_aliases (id, user_id, canonical_email, alias_email)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+attendeeAliasColumns,
+		uuid.New(), userID, strings.ToLower(canonicalEmail), strings.ToLower(aliasEmail),
+	))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "attendee_aliases_user_alias" {
+			return nil, ErrAttendeeAliasExists
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+// List returns a user's aliases ordered by canonical email
+func (s *AttendeeAliasStore) List(ctx context.Context, userID uuid.UUID) ([]*AttendeeAlias, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+attendeeAliasColumns+`
+		FROM attendee_aliases
+		WHERE user_id = $1
+		ORDER BY canonical_email, alias_email
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var aliases []*AttendeeAlias
+	for rows.Next() {
+		a, err := scanAttendeeAlias(rows)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, a)
+	}
+	return aliases, rows.Err()
+}
+
+// Delete removes an alias
+func (s *AttendeeAliasStore) Delete(ctx context.Context, userID, aliasID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM attendee_aliases WHERE id = $1 AND user_id = $2",
+		aliasID, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrAttendeeAliasNotFound
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestBatchLookupsAreScopedToUser(t *testing.T) {
+	f := newInvoiceFixture(t)
+	ctx := context.Background()
+	stranger := uuid.New()
+
+	t.Run("line items", func(t *testing.T) {
+		full, err := f.invoices.GetByID(ctx, f.userID, f.invoice.ID)
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		byInvoice, err := f.invoices.ListLineItems(ctx, f.userID, []uuid.UUID{f.invoice.ID, uuid.New()})
+		if err != nil {
+			t.Fatalf("ListLineItems failed: %v", err)
+		}
+		if len(byInvoice) != 1 || len(byInvoice[f.invoice.ID]) != len(full.LineItems) || len(full.LineItems) == 0 {
+			t.Errorf("expected the %d line items GetByID returns, got %v", len(full.LineItems), byInvoice)
+		}
+
+		byInvoice, err = f.invoices.ListLineItems(ctx, stranger, []uuid.UUID{f.invoice.ID})
+		if err != nil {
+			t.Fatalf("ListLineItems failed: %v", err)
+		}
+		if len(byInvoice) != 0 {
+			t.Errorf("expected another user to see no line items, got %v", byInvoice)
+		}
+	})
+
+	start := time.Date(2024, 2, 5, 9, 0, 0, 0, time.UTC)
+	first := createTestEvent(t, f.pool, f.userID, "First", start)
+	second := createTestEvent(t, f.pool, f.userID, "Second", start.Add(time.Hour))
+	ids := []uuid.UUID{second.ID, first.ID, uuid.New()}
+
+	t.Run("events", func(t *testing.T) {
+		events, err := store.NewCalendarEventStore(f.pool).GetByIDs(ctx, f.userID, ids)
+		if err != nil {
+			t.Fatalf("GetByIDs failed: %v", err)
+		}
+		if len(events) != 2 || events[0].ID != first.ID || events[1].ID != second.ID {
+			t.Errorf("expected both events in start order, got %+v", events)
+		}
+
+		events, err = store.NewCalendarEventStore(f.pool).GetByIDs(ctx, stranger, ids)
+		if err != nil {
+			t.Fatalf("GetByIDs failed: %v", err)
+		}
+		if len(events) != 0 {
+			t.Errorf("expected another user to see no events, got %d", len(events))
+		}
+	})
+
+	t.Run("contributing events", func(t *testing.T) {
+		entries := store.NewTimeEntryStore(f.pool)
+		entry, err := entries.UpsertFromComputed(ctx, f.userID, f.invoice.ProjectID, start, 1.5, "Meetings", "", nil, []uuid.UUID{first.ID, second.ID})
+		if err != nil {
+			t.Fatalf("UpsertFromComputed failed: %v", err)
+		}
+
+		byEntry, err := entries.ListContributingEvents(ctx, f.userID, []uuid.UUID{entry.ID})
+		if err != nil {
+			t.Fatalf("ListContributingEvents failed: %v", err)
+		}
+		if len(byEntry[entry.ID]) != 2 {
+			t.Errorf("expected both contributing events, got %v", byEntry)
+		}
+
+		byEntry, err = entries.ListContributingEvents(ctx, stranger, []uuid.UUID{entry.ID})
+		if err != nil {
+			t.Fatalf("ListContributingEvents failed: %v", err)
+		}
+		if len(byEntry) != 0 {
+			t.Errorf("expected another user to see no contributing events, got %v", byEntry)
+		}
+	})
+}
@@ -8,14 +8,64 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var (
 	ErrBillingPeriodNotFound = errors.New("billing period not found")
 	ErrBillingPeriodOverlap  = errors.New("billing period overlaps with existing period")
+	ErrInvalidRateDates      = errors.New("rate end date is before start date")
+	ErrInvalidBillingTerms   = errors.New("billing mode is missing its fee, included hours or overage rate")
 )
 
+// Billing modes
+const (
+	BillingModeHourly   = "hourly"
+	BillingModeFixed    = "fixed"
+	BillingModeRetainer = "retainer"
+)
+
+// isOverlapViolation reports whether err was raised by one of the rate overlap
+// triggers (check_billing_period_overlap, check_client_rate_overlap)
+func isOverlapViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23P01"
+}
+
+// isBillingTermsViolation reports whether err was raised by the
+// billing_period_mode_valid CHECK constraint
+func isBillingTermsViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23514" && pgErr.ConstraintName == "billing_period_mode_valid"
+}
+
+// isDateRangeViolation reports whether err was raised by a starts_on/ends_on CHECK constraint
+func isDateRangeViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23514"
+}
+
+// BillingTerms describes how time in a billing period is charged. Hourly
+// periods only use the period's hourly rate; fixed and retainer periods
+// charge FixedFee per calendar month, and retainers add OverageRate for each
+// hour beyond IncludedHours in the month. MinDailyHours and IncrementHours
+// round each day's hours up when an invoice is created.
+type BillingTerms struct {
+	BillingMode    string
+	FixedFee       *float64
+	IncludedHours  *float64
+	OverageRate    *float64
+	MinDailyHours  *float64
+	IncrementHours *float64
+}
+
+// IsHourly reports whether entries are billed at the hourly rate
+func (t BillingTerms) IsHourly() bool {
+	return t.BillingMode == "" || t.BillingMode == BillingModeHourly
+}
+
 // BillingPeriod represents a stored billing period
 type BillingPeriod struct {
 	ID         uuid.UUID
@@ -24,8 +74,23 @@ type BillingPeriod struct {
 	StartsOn   time.Time
 	EndsOn     *time.Time
 	HourlyRate float64
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	BillingTerms
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+const billingPeriodColumns = `id, user_id, project_id, starts_on, ends_on, hourly_rate,
+		       billing_mode, fixed_fee, included_hours, overage_rate,
+		       min_daily_hours, increment_hours, created_at, updated_at`
+
+func scanBillingPeriod(row pgx.Row) (*BillingPeriod, error) {
+	p := &BillingPeriod{}
+	err := row.Scan(
+		&p.ID, &p.UserID, &p.ProjectID, &p.StartsOn, &p.EndsOn, &p.HourlyRate,
+		&p.BillingMode, &p.FixedFee, &p.IncludedHours, &p.OverageRate,
+		&p.MinDailyHours, &p.IncrementHours, &p.CreatedAt, &p.UpdatedAt,
+	)
+	return p, err
 }
 
 // BillingPeriodStore provides PostgreSQL-backed billing period storage
@@ -38,29 +103,49 @@ func NewBillingPeriodStore(pool *pgxpool.Pool) *BillingPeriodStore {
 	return &BillingPeriodStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *BillingPeriodStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // Create adds a new billing period
-func (s *BillingPeriodStore) Create(ctx context.Context, userID, projectID uuid.UUID, startsOn time.Time, endsOn *time.Time, hourlyRate float64) (*BillingPeriod, error) {
+func (s *BillingPeriodStore) Create(ctx context.Context, userID, projectID uuid.UUID, startsOn time.Time, endsOn *time.Time, hourlyRate float64, terms BillingTerms) (*BillingPeriod, error) {
+	if terms.BillingMode == "" {
+		terms.BillingMode = BillingModeHourly
+	}
+
 	period := &BillingPeriod{
-		ID:         uuid.New(),
-		UserID:     userID,
-		ProjectID:  projectID,
-		StartsOn:   startsOn,
-		EndsOn:     endsOn,
-		HourlyRate: hourlyRate,
-		CreatedAt:  time.Now().UTC(),
-		UpdatedAt:  time.Now().UTC(),
+		ID:           uuid.New(),
+		UserID:       userID,
+		ProjectID:    projectID,
+		StartsOn:     startsOn,
+		EndsOn:       endsOn,
+		HourlyRate:   hourlyRate,
+		BillingTerms: terms,
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
 	}
 
-	_, err := s.pool.Exec(ctx, `
-		INSERT INTO billing_periods (id, user_id, project_id, starts_on, ends_on, hourly_rate, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-	`, period.ID, period.UserID, period.ProjectID, period.StartsOn, period.EndsOn, period.HourlyRate, period.CreatedAt, period.UpdatedAt)
+	_, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO billing_periods (id, user_id, project_id, starts_on, ends_on, hourly_rate,
+		                             billing_mode, fixed_fee, included_hours, overage_rate,
+		                             min_daily_hours, increment_hours, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`, period.ID, period.UserID, period.ProjectID, period.StartsOn, period.EndsOn, period.HourlyRate,
+		terms.BillingMode, terms.FixedFee, terms.IncludedHours, terms.OverageRate,
+		terms.MinDailyHours, terms.IncrementHours, period.CreatedAt, period.UpdatedAt)
 
 	if err != nil {
+		if isBillingTermsViolation(err) {
+			return nil, ErrInvalidBillingTerms
+		}
 		// Check if it's an overlap constraint violation
-		if err.Error() == "Billing periods for project cannot overlap" {
+		if isOverlapViolation(err) {
 			return nil, ErrBillingPeriodOverlap
 		}
+		if isDateRangeViolation(err) {
+			return nil, ErrInvalidRateDates
+		}
 		return nil, err
 	}
 
@@ -69,14 +154,10 @@ func (s *BillingPeriodStore) Create(ctx context.Context, userID, projectID uuid.
 
 // GetByID retrieves a billing period by ID
 func (s *BillingPeriodStore) GetByID(ctx context.Context, userID, periodID uuid.UUID) (*BillingPeriod, error) {
-	period := &BillingPeriod{}
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, user_id, project_id, starts_on, ends_on, hourly_rate, created_at, updated_at
+	period, err := scanBillingPeriod(s.db(ctx).QueryRow(ctx, `
+		SELECT `+billingPeriodColumns+`
 		FROM billing_periods WHERE id = $1 AND user_id = $2
-	`, periodID, userID).Scan(
-		&period.ID, &period.UserID, &period.ProjectID, &period.StartsOn, &period.EndsOn,
-		&period.HourlyRate, &period.CreatedAt, &period.UpdatedAt,
-	)
+	`, periodID, userID))
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -89,8 +170,8 @@ func (s *BillingPeriodStore) GetByID(ctx context.Context, userID, periodID uuid.
 
 // ListByProject retrieves all billing periods for a project
 func (s *BillingPeriodStore) ListByProject(ctx context.Context, userID, projectID uuid.UUID) ([]*BillingPeriod, error) {
-	rows, err := s.pool.Query(ctx, `
-		SELECT id, user_id, project_id, starts_on, ends_on, hourly_rate, created_at, updated_at
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+billingPeriodColumns+`
 		FROM billing_periods
 		WHERE user_id = $1 AND project_id = $2
 		ORDER BY starts_on DESC
@@ -102,8 +183,7 @@ func (s *BillingPeriodStore) ListByProject(ctx context.Context, userID, projectI
 
 	var periods []*BillingPeriod
 	for rows.Next() {
-		p := &BillingPeriod{}
-		err := rows.Scan(&p.ID, &p.UserID, &p.ProjectID, &p.StartsOn, &p.EndsOn, &p.HourlyRate, &p.CreatedAt, &p.UpdatedAt)
+		p, err := scanBillingPeriod(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -115,18 +195,14 @@ func (s *BillingPeriodStore) ListByProject(ctx context.Context, userID, projectI
 
 // FindPeriodForDate finds the billing period that covers a specific date
 func (s *BillingPeriodStore) FindPeriodForDate(ctx context.Context, userID, projectID uuid.UUID, date time.Time) (*BillingPeriod, error) {
-	period := &BillingPeriod{}
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, user_id, project_id, starts_on, ends_on, hourly_rate, created_at, updated_at
+	period, err := scanBillingPeriod(s.db(ctx).QueryRow(ctx, `
+		SELECT `+billingPeriodColumns+`
 		FROM billing_periods
 		WHERE user_id = $1 AND project_id = $2
 		AND starts_on <= $3
 		AND (ends_on IS NULL OR ends_on >= $3)
 		LIMIT 1
-	`, userID, projectID, date).Scan(
-		&period.ID, &period.UserID, &period.ProjectID, &period.StartsOn, &period.EndsOn,
-		&period.HourlyRate, &period.CreatedAt, &period.UpdatedAt,
-	)
+	`, userID, projectID, date))
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -155,22 +231,24 @@ func (s *BillingPeriodStore) Update(ctx context.Context, userID, periodID uuid.U
 		argNum++
 	}
 
-	query := "UPDATE billing_periods SET " + setClauses + " WHERE id = $1 AND user_id = $2 RETURNING id, user_id, project_id, starts_on, ends_on, hourly_rate, created_at, updated_at"
+	query := "UPDATE billing_periods SET " + setClauses + " WHERE id = $1 AND user_id = $2 RETURNING " + billingPeriodColumns
 
-	period := &BillingPeriod{}
-	err := s.pool.QueryRow(ctx, query, args...).Scan(
-		&period.ID, &period.UserID, &period.ProjectID, &period.StartsOn, &period.EndsOn,
-		&period.HourlyRate, &period.CreatedAt, &period.UpdatedAt,
-	)
+	period, err := scanBillingPeriod(s.db(ctx).QueryRow(ctx, query, args...))
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrBillingPeriodNotFound
 		}
 		// Check for overlap error
-		if err.Error() == "Billing periods for project cannot overlap" {
+		if isOverlapViolation(err) {
 			return nil, ErrBillingPeriodOverlap
 		}
+		if isBillingTermsViolation(err) {
+			return nil, ErrInvalidBillingTerms
+		}
+		if isDateRangeViolation(err) {
+			return nil, ErrInvalidRateDates
+		}
 		return nil, err
 	}
 
@@ -179,7 +257,7 @@ func (s *BillingPeriodStore) Update(ctx context.Context, userID, periodID uuid.U
 
 // Delete removes a billing period
 func (s *BillingPeriodStore) Delete(ctx context.Context, userID, periodID uuid.UUID) error {
-	result, err := s.pool.Exec(ctx,
+	result, err := s.db(ctx).Exec(ctx,
 		"DELETE FROM billing_periods WHERE id = $1 AND user_id = $2",
 		periodID, userID,
 	)
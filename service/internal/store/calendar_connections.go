@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/michaelw/timesheet-app/service/internal/crypto"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var (
@@ -17,12 +18,38 @@ var (
 	ErrCalendarAlreadyConnected   = errors.New("calendar already connected")
 )
 
+// WriteBackMode is how a connection writes classified projects back to its
+// calendar events
+type WriteBackMode string
+
+const (
+	// WriteBackOff leaves events in the calendar untouched
+	WriteBackOff WriteBackMode = "off"
+	// WriteBackProperties stores the project in private extended properties
+	WriteBackProperties WriteBackMode = "properties"
+	// WriteBackColor also colors events to match their project
+	WriteBackColor WriteBackMode = "color"
+)
+
 // OAuthCredentials stores OAuth token data
 type OAuthCredentials struct {
 	AccessToken  string    `json:"access_token"`
 	RefreshToken string    `json:"refresh_token"`
 	TokenType    string    `json:"token_type"`
 	Expiry       time.Time `json:"expiry"`
+	// Scopes granted with the token; kept in their own column so they can
+	// be listed without decrypting the credentials
+	Scopes []string `json:"-"`
+	// Set for workspace connections, whose tokens an organization's
+	// service account mints instead of being refreshed
+	Delegation *Delegation `json:"delegation,omitempty"`
+}
+
+// Delegation identifies the service account and user a workspace
+// connection's tokens are minted for
+type Delegation struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Subject        string    `json:"subject"` // Email of the impersonated user
 }
 
 // CalendarConnection represents a linked calendar
@@ -33,8 +60,16 @@ type CalendarConnection struct {
 	Credentials  OAuthCredentials // Decrypted
 	SyncToken    *string          // Google Calendar sync token for incremental sync
 	LastSyncedAt *time.Time
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	// Days of history and future to sync; nil uses the server defaults
+	SyncHistoryDays *int
+	SyncFutureDays  *int
+	GrantedScopes   []string      // OAuth scopes the user consented to
+	WriteBack       WriteBackMode // How classified projects are written back to events
+	// Set while the user has paused the connection: nothing syncs or is
+	// written back, and its events are kept
+	PausedAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // CalendarConnectionStore provides PostgreSQL-backed calendar connection storage
@@ -48,6 +83,11 @@ func NewCalendarConnectionStore(pool *pgxpool.Pool, cryptoSvc *crypto.Encryption
 	return &CalendarConnectionStore{pool: pool, crypto: cryptoSvc}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *CalendarConnectionStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // Create adds a new calendar connection with encrypted credentials
 func (s *CalendarConnectionStore) Create(ctx context.Context, userID uuid.UUID, provider string, creds OAuthCredentials) (*CalendarConnection, error) {
 	// Serialize and encrypt credentials
@@ -62,18 +102,20 @@ func (s *CalendarConnectionStore) Create(ctx context.Context, userID uuid.UUID,
 	}
 
 	conn := &CalendarConnection{
-		ID:          uuid.New(),
-		UserID:      userID,
-		Provider:    provider,
-		Credentials: creds,
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
+		ID:            uuid.New(),
+		UserID:        userID,
+		Provider:      provider,
+		Credentials:   creds,
+		GrantedScopes: creds.Scopes,
+		WriteBack:     WriteBackOff,
+		CreatedAt:     time.Now().UTC(),
+		UpdatedAt:     time.Now().UTC(),
 	}
 
-	_, err = s.pool.Exec(ctx, `
-		INSERT INTO calendar_connections (id, user_id, provider, credentials_encrypted, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
-	`, conn.ID, conn.UserID, provider, encrypted, conn.CreatedAt, conn.UpdatedAt)
+	_, err = s.db(ctx).Exec(ctx, `
+		INSERT INTO calendar_connections (id, user_id, provider, credentials_encrypted, granted_scopes, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, COALESCE($5::text[], '{}'), $6, $7)
+	`, conn.ID, conn.UserID, provider, encrypted, creds.Scopes, conn.CreatedAt, conn.UpdatedAt)
 
 	if err != nil {
 		if isDuplicateKeyError(err) {
@@ -90,12 +132,14 @@ func (s *CalendarConnectionStore) GetByID(ctx context.Context, userID, connID uu
 	var encrypted []byte
 	conn := &CalendarConnection{}
 
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, user_id, provider, credentials_encrypted, sync_token, last_synced_at, created_at, updated_at
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, user_id, provider, credentials_encrypted, sync_token, last_synced_at,
+		       sync_history_days, sync_future_days, granted_scopes, write_back, paused_at, created_at, updated_at
 		FROM calendar_connections WHERE id = $1 AND user_id = $2
 	`, connID, userID).Scan(
 		&conn.ID, &conn.UserID, &conn.Provider, &encrypted,
-		&conn.SyncToken, &conn.LastSyncedAt, &conn.CreatedAt, &conn.UpdatedAt,
+		&conn.SyncToken, &conn.LastSyncedAt,
+		&conn.SyncHistoryDays, &conn.SyncFutureDays, &conn.GrantedScopes, &conn.WriteBack, &conn.PausedAt, &conn.CreatedAt, &conn.UpdatedAt,
 	)
 
 	if err != nil {
@@ -118,13 +162,15 @@ func (s *CalendarConnectionStore) GetByID(ctx context.Context, userID, connID uu
 	return conn, nil
 }
 
-// List returns all connections for a user (without credentials for safety)
+// List returns all connections for a user (without credentials for safety).
+// The focus session connection is internal and left out.
 func (s *CalendarConnectionStore) List(ctx context.Context, userID uuid.UUID) ([]*CalendarConnection, error) {
-	rows, err := s.pool.Query(ctx, `
-		SELECT id, user_id, provider, last_synced_at, created_at, updated_at
-		FROM calendar_connections WHERE user_id = $1
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, user_id, provider, last_synced_at, sync_history_days, sync_future_days, granted_scopes, write_back, paused_at,
+		       created_at, updated_at
+		FROM calendar_connections WHERE user_id = $1 AND provider <> $2
 		ORDER BY created_at DESC
-	`, userID)
+	`, userID, ProviderFocus)
 	if err != nil {
 		return nil, err
 	}
@@ -134,8 +180,8 @@ func (s *CalendarConnectionStore) List(ctx context.Context, userID uuid.UUID) ([
 	for rows.Next() {
 		conn := &CalendarConnection{}
 		err := rows.Scan(
-			&conn.ID, &conn.UserID, &conn.Provider,
-			&conn.LastSyncedAt, &conn.CreatedAt, &conn.UpdatedAt,
+			&conn.ID, &conn.UserID, &conn.Provider, &conn.LastSyncedAt,
+			&conn.SyncHistoryDays, &conn.SyncFutureDays, &conn.GrantedScopes, &conn.WriteBack, &conn.PausedAt, &conn.CreatedAt, &conn.UpdatedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -158,7 +204,7 @@ func (s *CalendarConnectionStore) UpdateCredentials(ctx context.Context, connID
 		return err
 	}
 
-	_, err = s.pool.Exec(ctx, `
+	_, err = s.db(ctx).Exec(ctx, `
 		UPDATE calendar_connections
 		SET credentials_encrypted = $2, updated_at = $3
 		WHERE id = $1
@@ -167,10 +213,43 @@ func (s *CalendarConnectionStore) UpdateCredentials(ctx context.Context, connID
 	return err
 }
 
+// UpdateGrant saves the credentials and scopes of a new consent for an
+// existing connection, such as one adding access through incremental consent
+func (s *CalendarConnectionStore) UpdateGrant(ctx context.Context, userID, connID uuid.UUID, creds OAuthCredentials) (*CalendarConnection, error) {
+	credsJSON, err := json.Marshal(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := s.crypto.Encrypt(credsJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &CalendarConnection{Credentials: creds}
+	err = s.db(ctx).QueryRow(ctx, `
+		UPDATE calendar_connections
+		SET credentials_encrypted = $3, granted_scopes = COALESCE($4::text[], '{}'), updated_at = $5
+		WHERE id = $1 AND user_id = $2
+		RETURNING id, user_id, provider, last_synced_at, sync_history_days, sync_future_days, granted_scopes, write_back, paused_at,
+		          created_at, updated_at
+	`, connID, userID, encrypted, creds.Scopes, time.Now().UTC()).Scan(
+		&conn.ID, &conn.UserID, &conn.Provider, &conn.LastSyncedAt,
+		&conn.SyncHistoryDays, &conn.SyncFutureDays, &conn.GrantedScopes, &conn.WriteBack, &conn.PausedAt, &conn.CreatedAt, &conn.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCalendarConnectionNotFound
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
 // UpdateLastSynced updates the last_synced_at timestamp
 func (s *CalendarConnectionStore) UpdateLastSynced(ctx context.Context, connID uuid.UUID) error {
 	now := time.Now().UTC()
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendar_connections
 		SET last_synced_at = $2, updated_at = $2
 		WHERE id = $1
@@ -180,7 +259,7 @@ func (s *CalendarConnectionStore) UpdateLastSynced(ctx context.Context, connID u
 
 // UpdateSyncToken updates the sync token for incremental sync
 func (s *CalendarConnectionStore) UpdateSyncToken(ctx context.Context, connID uuid.UUID, token string) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendar_connections
 		SET sync_token = $2, updated_at = $3
 		WHERE id = $1
@@ -190,7 +269,7 @@ func (s *CalendarConnectionStore) UpdateSyncToken(ctx context.Context, connID uu
 
 // ClearSyncToken clears the sync token (forces full re-sync)
 func (s *CalendarConnectionStore) ClearSyncToken(ctx context.Context, connID uuid.UUID) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendar_connections
 		SET sync_token = NULL, updated_at = $2
 		WHERE id = $1
@@ -198,9 +277,111 @@ func (s *CalendarConnectionStore) ClearSyncToken(ctx context.Context, connID uui
 	return err
 }
 
+// UpdateSyncWindow sets how many days of history and future the connection
+// syncs. Nil values restore the server defaults.
+func (s *CalendarConnectionStore) UpdateSyncWindow(ctx context.Context, userID, connID uuid.UUID, historyDays, futureDays *int) (*CalendarConnection, error) {
+	conn := &CalendarConnection{}
+	err := s.db(ctx).QueryRow(ctx, `
+		UPDATE calendar_connections
+		SET sync_history_days = $3, sync_future_days = $4, updated_at = $5
+		WHERE id = $1 AND user_id = $2 AND provider <> $6
+		RETURNING id, user_id, provider, last_synced_at, sync_history_days, sync_future_days, granted_scopes, write_back, paused_at,
+		          created_at, updated_at
+	`, connID, userID, historyDays, futureDays, time.Now().UTC(), ProviderFocus).Scan(
+		&conn.ID, &conn.UserID, &conn.Provider, &conn.LastSyncedAt,
+		&conn.SyncHistoryDays, &conn.SyncFutureDays, &conn.GrantedScopes, &conn.WriteBack, &conn.PausedAt, &conn.CreatedAt, &conn.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCalendarConnectionNotFound
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// UpdateWriteBack sets how the connection writes classified projects back to
+// its calendar events. Switching to a different mode writes every classified
+// event again in the new mode.
+func (s *CalendarConnectionStore) UpdateWriteBack(ctx context.Context, userID, connID uuid.UUID, mode WriteBackMode) (*CalendarConnection, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var previous WriteBackMode
+	err = tx.QueryRow(ctx, `
+		SELECT write_back FROM calendar_connections
+		WHERE id = $1 AND user_id = $2 AND provider <> $3
+		FOR UPDATE
+	`, connID, userID, ProviderFocus).Scan(&previous)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCalendarConnectionNotFound
+		}
+		return nil, err
+	}
+
+	conn := &CalendarConnection{}
+	err = tx.QueryRow(ctx, `
+		UPDATE calendar_connections
+		SET write_back = $2, updated_at = $3
+		WHERE id = $1
+		RETURNING id, user_id, provider, last_synced_at, sync_history_days, sync_future_days, granted_scopes, write_back, paused_at,
+		          created_at, updated_at
+	`, connID, mode, time.Now().UTC()).Scan(
+		&conn.ID, &conn.UserID, &conn.Provider, &conn.LastSyncedAt,
+		&conn.SyncHistoryDays, &conn.SyncFutureDays, &conn.GrantedScopes, &conn.WriteBack, &conn.PausedAt, &conn.CreatedAt, &conn.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode != previous && mode != WriteBackOff {
+		_, err = tx.Exec(ctx, `
+			UPDATE calendar_events
+			SET written_project_id = NULL, written_back_at = NULL
+			WHERE connection_id = $1 AND written_back_at IS NOT NULL
+		`, connID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// SetPaused pauses or resumes a connection. Pausing an already paused
+// connection keeps when it was first paused.
+func (s *CalendarConnectionStore) SetPaused(ctx context.Context, userID, connID uuid.UUID, paused bool) (*CalendarConnection, error) {
+	now := time.Now().UTC()
+	conn := &CalendarConnection{}
+	err := s.db(ctx).QueryRow(ctx, `
+		UPDATE calendar_connections
+		SET paused_at = CASE WHEN $3 THEN COALESCE(paused_at, $4) END, updated_at = $4
+		WHERE id = $1 AND user_id = $2 AND provider <> $5
+		RETURNING id, user_id, provider, last_synced_at, sync_history_days, sync_future_days, granted_scopes, write_back, paused_at,
+		          created_at, updated_at
+	`, connID, userID, paused, now, ProviderFocus).Scan(
+		&conn.ID, &conn.UserID, &conn.Provider, &conn.LastSyncedAt,
+		&conn.SyncHistoryDays, &conn.SyncFutureDays, &conn.GrantedScopes, &conn.WriteBack, &conn.PausedAt, &conn.CreatedAt, &conn.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCalendarConnectionNotFound
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
 // Delete removes a calendar connection
 func (s *CalendarConnectionStore) Delete(ctx context.Context, userID, connID uuid.UUID) error {
-	result, err := s.pool.Exec(ctx,
+	result, err := s.db(ctx).Exec(ctx,
 		"DELETE FROM calendar_connections WHERE id = $1 AND user_id = $2",
 		connID, userID,
 	)
@@ -221,12 +402,14 @@ func (s *CalendarConnectionStore) GetByIDForSync(ctx context.Context, connID uui
 	var encrypted []byte
 	conn := &CalendarConnection{}
 
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, user_id, provider, credentials_encrypted, sync_token, last_synced_at, created_at, updated_at
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, user_id, provider, credentials_encrypted, sync_token, last_synced_at,
+		       sync_history_days, sync_future_days, granted_scopes, write_back, paused_at, created_at, updated_at
 		FROM calendar_connections WHERE id = $1
 	`, connID).Scan(
 		&conn.ID, &conn.UserID, &conn.Provider, &encrypted,
-		&conn.SyncToken, &conn.LastSyncedAt, &conn.CreatedAt, &conn.UpdatedAt,
+		&conn.SyncToken, &conn.LastSyncedAt,
+		&conn.SyncHistoryDays, &conn.SyncFutureDays, &conn.GrantedScopes, &conn.WriteBack, &conn.PausedAt, &conn.CreatedAt, &conn.UpdatedAt,
 	)
 
 	if err != nil {
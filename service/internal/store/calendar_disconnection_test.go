@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/michaelw/timesheet-app/service/internal/crypto"
 	"github.com/michaelw/timesheet-app/service/internal/database"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 )
@@ -40,10 +41,9 @@ func TestCalendarDeselection(t *testing.T) {
 	// Create stores
 	userStore := store.NewUserStore(db.Pool)
 	projectStore := store.NewProjectStore(db.Pool)
-	calendarConnStore := store.NewCalendarConnectionStore(db.Pool)
+	calendarConnStore := store.NewCalendarConnectionStore(db.Pool, testEncryption(t))
 	calendarStore := store.NewCalendarStore(db.Pool)
 	eventStore := store.NewCalendarEventStore(db.Pool)
-	timeEntryStore := store.NewTimeEntryStore(db.Pool)
 
 	// Create test user
 	testEmail := "calendar-test-" + uuid.New().String()[:8] + "@test.com"
@@ -54,13 +54,13 @@ func TestCalendarDeselection(t *testing.T) {
 	defer cleanupTestUser(t, db.Pool, user.ID)
 
 	// Create test project
-	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, nil, nil, false, false, nil, nil, nil)
+	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, "#000000", false, false, false)
 	if err != nil {
 		t.Fatalf("Failed to create test project: %v", err)
 	}
 
 	// Create calendar connection with dummy credentials
-	dummyCreds := store.CalendarCredentials{
+	dummyCreds := store.OAuthCredentials{
 		AccessToken:  "test-token",
 		RefreshToken: "test-refresh",
 		TokenType:    "Bearer",
@@ -87,6 +87,7 @@ func TestCalendarDeselection(t *testing.T) {
 	// Create a calendar event
 	eventStart := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
 	eventEnd := time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)
+	listEnd := eventEnd.Add(24 * time.Hour)
 	event := &store.CalendarEvent{
 		ID:           uuid.New(),
 		ConnectionID: conn.ID,
@@ -97,20 +98,20 @@ func TestCalendarDeselection(t *testing.T) {
 		StartTime:    eventStart,
 		EndTime:      eventEnd,
 	}
-	err = eventStore.Upsert(ctx, event)
+	event, err = eventStore.Upsert(ctx, event)
 	if err != nil {
 		t.Fatalf("Failed to create calendar event: %v", err)
 	}
 
 	// Classify the event to a project
-	err = eventStore.UpdateClassification(ctx, user.ID, event.ID, &project.ID, "manual", nil, nil, 1.0)
+	_, err = eventStore.Classify(ctx, user.ID, event.ID, &project.ID, false)
 	if err != nil {
 		t.Fatalf("Failed to classify event: %v", err)
 	}
 
 	// Test: Events from selected calendar are visible
 	t.Run("events visible when calendar selected", func(t *testing.T) {
-		events, err := eventStore.List(ctx, user.ID, eventStart, eventEnd.Add(24*time.Hour), nil, nil)
+		events, err := eventStore.List(ctx, user.ID, &eventStart, &listEnd, nil, nil)
 		if err != nil {
 			t.Fatalf("Failed to list events: %v", err)
 		}
@@ -127,7 +128,7 @@ func TestCalendarDeselection(t *testing.T) {
 
 	// Test: Events from deselected calendar are filtered out
 	t.Run("events filtered when calendar deselected", func(t *testing.T) {
-		events, err := eventStore.List(ctx, user.ID, eventStart, eventEnd.Add(24*time.Hour), nil, nil)
+		events, err := eventStore.List(ctx, user.ID, &eventStart, &listEnd, nil, nil)
 		if err != nil {
 			t.Fatalf("Failed to list events: %v", err)
 		}
@@ -144,7 +145,7 @@ func TestCalendarDeselection(t *testing.T) {
 
 	// Test: Events reappear when calendar re-selected
 	t.Run("events reappear when calendar re-selected", func(t *testing.T) {
-		events, err := eventStore.List(ctx, user.ID, eventStart, eventEnd.Add(24*time.Hour), nil, nil)
+		events, err := eventStore.List(ctx, user.ID, &eventStart, &listEnd, nil, nil)
 		if err != nil {
 			t.Fatalf("Failed to list events: %v", err)
 		}
@@ -179,12 +180,11 @@ func TestMaterializedEntryPreservation(t *testing.T) {
 	// Create stores
 	userStore := store.NewUserStore(db.Pool)
 	projectStore := store.NewProjectStore(db.Pool)
-	calendarConnStore := store.NewCalendarConnectionStore(db.Pool)
+	calendarConnStore := store.NewCalendarConnectionStore(db.Pool, testEncryption(t))
 	calendarStore := store.NewCalendarStore(db.Pool)
 	eventStore := store.NewCalendarEventStore(db.Pool)
 	timeEntryStore := store.NewTimeEntryStore(db.Pool)
 	billingPeriodStore := store.NewBillingPeriodStore(db.Pool)
-	invoiceStore := store.NewInvoiceStore(db.Pool)
 
 	// Create test user
 	testEmail := "preserved-test-" + uuid.New().String()[:8] + "@test.com"
@@ -195,20 +195,20 @@ func TestMaterializedEntryPreservation(t *testing.T) {
 	defer cleanupTestUser(t, db.Pool, user.ID)
 
 	// Create test project
-	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, nil, nil, false, false, nil, nil, nil)
+	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, "#000000", false, false, false)
 	if err != nil {
 		t.Fatalf("Failed to create test project: %v", err)
 	}
 
 	// Create billing period for invoicing
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-	_, err = billingPeriodStore.Create(ctx, user.ID, project.ID, startDate, nil, 100.00)
+	_, err = billingPeriodStore.Create(ctx, user.ID, project.ID, startDate, nil, 100.00, store.BillingTerms{})
 	if err != nil {
 		t.Fatalf("Failed to create billing period: %v", err)
 	}
 
 	// Create calendar connection with dummy credentials
-	dummyCreds := store.CalendarCredentials{
+	dummyCreds := store.OAuthCredentials{
 		AccessToken:  "test-token",
 		RefreshToken: "test-refresh",
 		TokenType:    "Bearer",
@@ -245,13 +245,13 @@ func TestMaterializedEntryPreservation(t *testing.T) {
 		StartTime:    eventStart,
 		EndTime:      eventEnd,
 	}
-	err = eventStore.Upsert(ctx, event)
+	event, err = eventStore.Upsert(ctx, event)
 	if err != nil {
 		t.Fatalf("Failed to create calendar event: %v", err)
 	}
 
 	// Classify event to project
-	err = eventStore.UpdateClassification(ctx, user.ID, event.ID, &project.ID, "manual", nil, nil, 1.0)
+	_, err = eventStore.Classify(ctx, user.ID, event.ID, &project.ID, false)
 	if err != nil {
 		t.Fatalf("Failed to classify event: %v", err)
 	}
@@ -332,12 +332,12 @@ func TestInvoicedEntryPreservation(t *testing.T) {
 	// Create stores
 	userStore := store.NewUserStore(db.Pool)
 	projectStore := store.NewProjectStore(db.Pool)
-	calendarConnStore := store.NewCalendarConnectionStore(db.Pool)
+	calendarConnStore := store.NewCalendarConnectionStore(db.Pool, testEncryption(t))
 	calendarStore := store.NewCalendarStore(db.Pool)
 	eventStore := store.NewCalendarEventStore(db.Pool)
 	timeEntryStore := store.NewTimeEntryStore(db.Pool)
 	billingPeriodStore := store.NewBillingPeriodStore(db.Pool)
-	invoiceStore := store.NewInvoiceStore(db.Pool)
+	invoiceStore := store.NewInvoiceStore(db.Pool, timeEntryStore, billingPeriodStore, store.NewClientRateStore(db.Pool), projectStore)
 
 	// Create test user
 	testEmail := "invoiced-test-" + uuid.New().String()[:8] + "@test.com"
@@ -348,20 +348,20 @@ func TestInvoicedEntryPreservation(t *testing.T) {
 	defer cleanupTestUser(t, db.Pool, user.ID)
 
 	// Create test project
-	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, nil, nil, false, false, nil, nil, nil)
+	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, "#000000", false, false, false)
 	if err != nil {
 		t.Fatalf("Failed to create test project: %v", err)
 	}
 
 	// Create billing period
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
-	_, err = billingPeriodStore.Create(ctx, user.ID, project.ID, startDate, nil, 100.00)
+	_, err = billingPeriodStore.Create(ctx, user.ID, project.ID, startDate, nil, 100.00, store.BillingTerms{})
 	if err != nil {
 		t.Fatalf("Failed to create billing period: %v", err)
 	}
 
 	// Create calendar connection
-	dummyCreds := store.CalendarCredentials{
+	dummyCreds := store.OAuthCredentials{
 		AccessToken:  "test-token",
 		RefreshToken: "test-refresh",
 		TokenType:    "Bearer",
@@ -398,13 +398,13 @@ func TestInvoicedEntryPreservation(t *testing.T) {
 		StartTime:    eventStart,
 		EndTime:      eventEnd,
 	}
-	err = eventStore.Upsert(ctx, event)
+	event, err = eventStore.Upsert(ctx, event)
 	if err != nil {
 		t.Fatalf("Failed to create calendar event: %v", err)
 	}
 
 	// Classify event
-	err = eventStore.UpdateClassification(ctx, user.ID, event.ID, &project.ID, "manual", nil, nil, 1.0)
+	_, err = eventStore.Classify(ctx, user.ID, event.ID, &project.ID, false)
 	if err != nil {
 		t.Fatalf("Failed to classify event: %v", err)
 	}
@@ -424,7 +424,7 @@ func TestInvoicedEntryPreservation(t *testing.T) {
 
 	// Create invoice (which materializes the entry via invoice_id)
 	periodEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
-	_, err = invoiceStore.Create(ctx, user.ID, project.ID, nil, startDate, periodEnd)
+	_, err = invoiceStore.Create(ctx, user.ID, project.ID, startDate, periodEnd, periodEnd)
 	if err != nil {
 		t.Fatalf("Failed to create invoice: %v", err)
 	}
@@ -496,7 +496,7 @@ func TestOrphanedEntryMigration(t *testing.T) {
 	defer cleanupTestUser(t, db.Pool, user.ID)
 
 	// Create test project
-	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, nil, nil, false, false, nil, nil, nil)
+	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, "#000000", false, false, false)
 	if err != nil {
 		t.Fatalf("Failed to create test project: %v", err)
 	}
@@ -561,3 +561,12 @@ func cleanupTestUser(t *testing.T, pool *pgxpool.Pool, userID uuid.UUID) {
 		t.Logf("Warning: failed to cleanup test user: %v", err)
 	}
 }
+
+// testEncryption returns an encryption service with a fixed test key
+func testEncryption(t *testing.T) *crypto.EncryptionService {
+	svc, err := crypto.NewEncryptionService("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	if err != nil {
+		t.Fatalf("Failed to create encryption service: %v", err)
+	}
+	return svc
+}
@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultArchiveBatchSize bounds how many events one Archive call moves
+const DefaultArchiveBatchSize = 1000
+
+// calendarEventArchiveColumns are the columns shared by calendar_events and
+// calendar_events_archive
+const calendarEventArchiveColumns = `id, connection_id, calendar_id, user_id, external_id, title, description,
+	start_time, end_time, attendees, is_recurring, is_all_day, response_status,
+	transparency, is_orphaned, is_suppressed, suppression_overridden, is_skipped,
+	classification_status, classification_source, classification_confidence,
+	classification_rule_id, needs_review, duration_changed_at, project_id, created_at, updated_at`
+
+// liveAndArchivedEvents is a drop-in source for calendar_events that also
+// yields archived events. An archived event that has since been synced again
+// is shadowed by its live copy.
+const liveAndArchivedEvents = `(
+	SELECT ` + calendarEventArchiveColumns + ` FROM calendar_events
+	UNION ALL
+	SELECT ` + calendarEventArchiveColumns + ` FROM calendar_events_archive a
+	WHERE NOT EXISTS (
+		SELECT 1 FROM calendar_events live
+		WHERE live.connection_id = a.connection_id AND live.external_id = a.external_id
+	)
+)`
+
+// Archive moves up to limit events that started before the cutoff into the
+// archive table and returns how many were moved. Events still referenced by
+// time entries, undo history, snapshots, overrides, tags, change history or
+// classification history stay live, since moving them would cascade-delete
+// those references.
+func (s *CalendarEventStore) Archive(ctx context.Context, before time.Time, limit int) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		WITH moved AS (
+			DELETE FROM calendar_events
+			WHERE id IN (
+				SELECT ce.id FROM calendar_events ce
+				WHERE ce.start_time < $1
+				  AND NOT EXISTS (SELECT 1 FROM time_entry_events WHERE calendar_event_id = ce.id)
+				  AND NOT EXISTS (SELECT 1 FROM classification_action_events WHERE event_id = ce.id)
+				  AND NOT EXISTS (SELECT 1 FROM classification_snapshot_events WHERE event_id = ce.id)
+				  AND NOT EXISTS (SELECT 1 FROM classification_overrides WHERE event_id = ce.id)
+				  AND NOT EXISTS (SELECT 1 FROM calendar_event_tags WHERE event_id = ce.id)
+				  AND NOT EXISTS (SELECT 1 FROM calendar_event_changes WHERE event_id = ce.id)
+				  AND NOT EXISTS (SELECT 1 FROM calendar_event_classifications WHERE event_id = ce.id)
+				ORDER BY ce.start_time
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING `+calendarEventArchiveColumns+`
+		)
+		INSERT INTO calendar_events_archive (`+calendarEventArchiveColumns+`)
+		SELECT `+calendarEventArchiveColumns+` FROM moved
+		ON CONFLICT (connection_id, external_id) DO UPDATE SET
+			id = EXCLUDED.id,
+			calendar_id = EXCLUDED.calendar_id,
+			title = EXCLUDED.title,
+			description = EXCLUDED.description,
+			start_time = EXCLUDED.start_time,
+			end_time = EXCLUDED.end_time,
+			attendees = EXCLUDED.attendees,
+			is_recurring = EXCLUDED.is_recurring,
+			is_all_day = EXCLUDED.is_all_day,
+			response_status = EXCLUDED.response_status,
+			transparency = EXCLUDED.transparency,
+			is_orphaned = EXCLUDED.is_orphaned,
+			is_suppressed = EXCLUDED.is_suppressed,
+			suppression_overridden = EXCLUDED.suppression_overridden,
+			is_skipped = EXCLUDED.is_skipped,
+			classification_status = EXCLUDED.classification_status,
+			classification_source = EXCLUDED.classification_source,
+			classification_confidence = EXCLUDED.classification_confidence,
+			classification_rule_id = EXCLUDED.classification_rule_id,
+			needs_review = EXCLUDED.needs_review,
+			duration_changed_at = EXCLUDED.duration_changed_at,
+			project_id = EXCLUDED.project_id,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at,
+			archived_at = NOW()
+	`, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// hasArchivedEvents reports whether the user has archived events starting
+// in [start, end). Nil bounds are open.
+func (s *CalendarEventStore) hasArchivedEvents(ctx context.Context, userID uuid.UUID, start, end *time.Time) (bool, error) {
+	var exists bool
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM calendar_events_archive
+			WHERE user_id = $1
+			  AND ($2::timestamptz IS NULL OR start_time >= $2)
+			  AND ($3::timestamptz IS NULL OR start_time < $3)
+		)
+	`, userID, start, end).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,96 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestCalendarEventChangeHistory checks that a re-sync records what changed
+// and flags a classified event feeding a time entry whose duration moved
+func TestCalendarEventChangeHistory(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "changes-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	project, err := store.NewProjectStore(db.Pool).Create(ctx, user.ID, "Alpha", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	eventStore := store.NewCalendarEventStore(db.Pool)
+	timeEntryStore := store.NewTimeEntryStore(db.Pool)
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	event := createTestEvent(t, db.Pool, user.ID, "Planning", start)
+
+	if _, err := eventStore.Classify(ctx, user.ID, event.ID, &project.ID, false); err != nil {
+		t.Fatalf("Classify: %v", err)
+	}
+	if _, err := timeEntryStore.UpsertFromComputed(ctx, user.ID, project.ID, start.Truncate(24*time.Hour), 0.5, "Planning", "", nil, []uuid.UUID{event.ID}); err != nil {
+		t.Fatalf("UpsertFromComputed: %v", err)
+	}
+
+	// Google renames the event and stretches it by half an hour
+	resynced := *event
+	resynced.Title = "Quarterly planning"
+	resynced.EndTime = event.EndTime.Add(30 * time.Minute)
+	if _, err := eventStore.Upsert(ctx, &resynced); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	changes, err := eventStore.ListChanges(ctx, user.ID, event.ID)
+	if err != nil {
+		t.Fatalf("ListChanges: %v", err)
+	}
+	fields := map[string]*store.CalendarEventChange{}
+	for _, c := range changes {
+		fields[c.Field] = c
+	}
+	if len(changes) != 2 || fields["title"] == nil || fields["end_time"] == nil {
+		t.Fatalf("expected title and end_time changes, got %d changes", len(changes))
+	}
+	if title := fields["title"]; title.OldValue == nil || *title.OldValue != "Planning" || title.NewValue == nil || *title.NewValue != "Quarterly planning" {
+		t.Errorf("unexpected title change: %v -> %v", title.OldValue, title.NewValue)
+	}
+
+	got, err := eventStore.GetByID(ctx, user.ID, event.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.DurationChangedAt == nil {
+		t.Error("expected a material duration change to be flagged")
+	}
+
+	// Syncing the same copy again records nothing new
+	if _, err := eventStore.Upsert(ctx, &resynced); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	if again, err := eventStore.ListChanges(ctx, user.ID, event.ID); err != nil || len(again) != 2 {
+		t.Errorf("expected an unchanged re-sync to add nothing, got %d changes, %v", len(again), err)
+	}
+}
@@ -0,0 +1,167 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// MaterialDurationChange is how far an event's duration must move before a
+// classified event is flagged as out of step with its time entry
+const MaterialDurationChange = 15 * time.Minute
+
+// CalendarEventChange is one field a re-sync changed on an event. Events that
+// drop out of Google and come back are recorded as changes to is_orphaned.
+type CalendarEventChange struct {
+	ID       uuid.UUID
+	EventID  uuid.UUID
+	Field    string
+	OldValue *string
+	NewValue *string
+	SyncedAt time.Time
+}
+
+// ListChanges returns an event's change history, newest first. It returns
+// ErrCalendarEventNotFound when the event isn't the user's.
+func (s *CalendarEventStore) ListChanges(ctx context.Context, userID, eventID uuid.UUID) ([]*CalendarEventChange, error) {
+	var exists bool
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM calendar_events WHERE id = $1 AND user_id = $2)
+	`, eventID, userID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrCalendarEventNotFound
+	}
+
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, event_id, field, old_value, new_value, synced_at
+		FROM calendar_event_changes
+		WHERE event_id = $1 AND user_id = $2
+		ORDER BY synced_at DESC, field
+	`, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*CalendarEventChange
+	for rows.Next() {
+		c := &CalendarEventChange{}
+		if err := rows.Scan(&c.ID, &c.EventID, &c.Field, &c.OldValue, &c.NewValue, &c.SyncedAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+// priorEvent is the stored state of an event about to be re-synced
+type priorEvent struct {
+	event *CalendarEvent
+	// feedsEntry is set when the event is classified, not skipped, and
+	// contributes to a time entry
+	feedsEntry bool
+	// resynced is set when the event was orphaned or its attendees or
+	// calendar differ from the incoming copy, which rules also match on
+	resynced bool
+}
+
+// lockPriorEvent loads and locks the stored copy of an event, or returns nil
+// when the event is new
+func lockPriorEvent(ctx context.Context, tx pgx.Tx, connectionID uuid.UUID, externalID string, attendeesJSON []byte, calendarID *uuid.UUID) (*priorEvent, error) {
+	prior := &priorEvent{event: &CalendarEvent{}}
+	e := prior.event
+	err := tx.QueryRow(ctx, `
+		SELECT ce.title, ce.description, ce.start_time, ce.end_time, ce.is_all_day,
+		       ce.response_status, ce.transparency, ce.is_orphaned,
+		       ce.classification_status = 'classified' AND NOT ce.is_skipped
+		       AND EXISTS (SELECT 1 FROM time_entry_events tee WHERE tee.calendar_event_id = ce.id),
+		       ce.is_orphaned OR ce.attendees IS DISTINCT FROM $3::jsonb
+		       OR ce.calendar_id IS DISTINCT FROM $4::uuid
+		FROM calendar_events ce
+		WHERE ce.connection_id = $1 AND ce.external_id = $2
+		FOR UPDATE OF ce
+	`, connectionID, externalID, attendeesJSON, calendarID).Scan(
+		&e.Title, &e.Description, &e.StartTime, &e.EndTime, &e.IsAllDay,
+		&e.ResponseStatus, &e.Transparency, &e.IsOrphaned, &prior.feedsEntry, &prior.resynced,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return prior, nil
+}
+
+// diffEvent lists the synced fields that differ between the stored and the
+// incoming copy of an event
+func diffEvent(old, next *CalendarEvent) []CalendarEventChange {
+	var changes []CalendarEventChange
+	add := func(field string, oldValue, newValue *string) {
+		if oldValue == nil && newValue == nil {
+			return
+		}
+		if oldValue != nil && newValue != nil && *oldValue == *newValue {
+			return
+		}
+		changes = append(changes, CalendarEventChange{Field: field, OldValue: oldValue, NewValue: newValue})
+	}
+	text := func(v string) *string { return &v }
+	timestamp := func(t time.Time) *string { return text(t.UTC().Format(time.RFC3339)) }
+
+	add("title", text(old.Title), text(next.Title))
+	add("description", old.Description, next.Description)
+	add("start_time", timestamp(old.StartTime), timestamp(next.StartTime))
+	add("end_time", timestamp(old.EndTime), timestamp(next.EndTime))
+	add("is_all_day", text(strconv.FormatBool(old.IsAllDay)), text(strconv.FormatBool(next.IsAllDay)))
+	add("response_status", old.ResponseStatus, next.ResponseStatus)
+	add("transparency", old.Transparency, next.Transparency)
+	add("is_orphaned", text(strconv.FormatBool(old.IsOrphaned)), text(strconv.FormatBool(next.IsOrphaned)))
+	return changes
+}
+
+// durationChangedMaterially reports whether the event's duration moved by at
+// least MaterialDurationChange
+func durationChangedMaterially(old, next *CalendarEvent) bool {
+	delta := next.EndTime.Sub(next.StartTime) - old.EndTime.Sub(old.StartTime)
+	if delta < 0 {
+		delta = -delta
+	}
+	return delta >= MaterialDurationChange
+}
+
+// recordEventChanges writes the changes a re-sync made to an event and flags
+// it when a duration change leaves its time entry out of date. Reports
+// whether anything rules match on changed, and sets AttendanceChanged on the
+// event when its response status or transparency did.
+func recordEventChanges(ctx context.Context, tx pgx.Tx, prior *priorEvent, event *CalendarEvent, syncedAt time.Time) (bool, error) {
+	changes := diffEvent(prior.event, event)
+	if len(changes) == 0 {
+		return prior.resynced, nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, c := range changes {
+		if c.Field == "response_status" || c.Field == "transparency" {
+			event.AttendanceChanged = true
+		}
+		batch.Queue(`
+			INSERT INTO calendar_event_changes (id, event_id, user_id, field, old_value, new_value, synced_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, uuid.New(), event.ID, event.UserID, c.Field, c.OldValue, c.NewValue, syncedAt)
+	}
+	if prior.feedsEntry && durationChangedMaterially(prior.event, event) {
+		batch.Queue(`
+			UPDATE calendar_events SET duration_changed_at = $2 WHERE id = $1
+		`, event.ID, syncedAt)
+	}
+	return true, tx.SendBatch(ctx, batch).Close()
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffEvent(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	base := func() *CalendarEvent {
+		return &CalendarEvent{
+			Title:     "Standup",
+			StartTime: start,
+			EndTime:   start.Add(30 * time.Minute),
+			Attendees: []string{"a@example.com"},
+		}
+	}
+	text := func(v string) *string { return &v }
+
+	tests := []struct {
+		name   string
+		change func(e *CalendarEvent)
+		want   []CalendarEventChange
+	}{
+		{"no change", func(e *CalendarEvent) {}, nil},
+		{"title", func(e *CalendarEvent) { e.Title = "Daily sync" },
+			[]CalendarEventChange{{Field: "title", OldValue: text("Standup"), NewValue: text("Daily sync")}}},
+		{"moved", func(e *CalendarEvent) {
+			e.StartTime = e.StartTime.Add(time.Hour)
+			e.EndTime = e.EndTime.Add(time.Hour)
+		}, []CalendarEventChange{
+			{Field: "start_time", OldValue: text("2025-03-10T09:00:00Z"), NewValue: text("2025-03-10T10:00:00Z")},
+			{Field: "end_time", OldValue: text("2025-03-10T09:30:00Z"), NewValue: text("2025-03-10T10:30:00Z")},
+		}},
+		{"same instant in another zone", func(e *CalendarEvent) {
+			e.StartTime = e.StartTime.In(time.FixedZone("CET", 3600))
+		}, nil},
+		{"description added", func(e *CalendarEvent) { e.Description = text("Agenda") },
+			[]CalendarEventChange{{Field: "description", OldValue: nil, NewValue: text("Agenda")}}},
+		// Attendees aren't a recorded field: rules re-match on them instead
+		{"attendees only", func(e *CalendarEvent) { e.Attendees = []string{"a@example.com", "b@example.com"} }, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := base()
+			tt.change(next)
+			got := diffEvent(base(), next)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d changes, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, c := range got {
+				w := tt.want[i]
+				if c.Field != w.Field || textValue(c.OldValue) != textValue(w.OldValue) || textValue(c.NewValue) != textValue(w.NewValue) ||
+					(c.OldValue == nil) != (w.OldValue == nil) {
+					t.Errorf("change %d = %s %v -> %v, want %s %v -> %v",
+						i, c.Field, textValue(c.OldValue), textValue(c.NewValue), w.Field, textValue(w.OldValue), textValue(w.NewValue))
+				}
+			}
+		})
+	}
+}
+
+func TestDurationChangedMaterially(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	old := &CalendarEvent{StartTime: start, EndTime: start.Add(time.Hour)}
+
+	tests := []struct {
+		name  string
+		delta time.Duration
+		want  bool
+	}{
+		{"unchanged", 0, false},
+		{"just under longer", MaterialDurationChange - time.Second, false},
+		{"exactly longer", MaterialDurationChange, true},
+		{"just under shorter", -(MaterialDurationChange - time.Second), false},
+		{"exactly shorter", -MaterialDurationChange, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &CalendarEvent{StartTime: start, EndTime: old.EndTime.Add(tt.delta)}
+			if got := durationChangedMaterially(old, next); got != tt.want {
+				t.Errorf("durationChangedMaterially(%v) = %v, want %v", tt.delta, got, tt.want)
+			}
+		})
+	}
+
+	// Moving an event without resizing it is not material
+	moved := &CalendarEvent{StartTime: start.Add(2 * time.Hour), EndTime: start.Add(3 * time.Hour)}
+	if durationChangedMaterially(old, moved) {
+		t.Error("expected a moved event of the same length not to be material")
+	}
+}
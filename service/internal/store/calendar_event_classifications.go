@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ClassificationVote is one rule's vote behind a rule classification
+type ClassificationVote struct {
+	RuleID    string               `json:"rule_id"`
+	ProjectID string               `json:"project_id"`
+	Weight    float64              `json:"weight"`
+	Source    ClassificationSource `json:"source"`
+}
+
+// ClassificationHistoryEntry is one classification an event received
+type ClassificationHistoryEntry struct {
+	ID           uuid.UUID
+	EventID      uuid.UUID
+	ProjectID    *uuid.UUID
+	Source       ClassificationSource
+	Confidence   *float64
+	IsSkipped    bool
+	Votes        []ClassificationVote // Nil for manual classifications
+	ClassifiedAt time.Time
+}
+
+// ListClassificationHistory returns every classification an event received,
+// newest first. It returns ErrCalendarEventNotFound when the event isn't the
+// user's.
+func (s *CalendarEventStore) ListClassificationHistory(ctx context.Context, userID, eventID uuid.UUID) ([]*ClassificationHistoryEntry, error) {
+	var exists bool
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM calendar_events WHERE id = $1 AND user_id = $2)
+	`, eventID, userID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrCalendarEventNotFound
+	}
+
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, event_id, project_id, source, confidence, is_skipped, rule_votes, classified_at
+		FROM calendar_event_classifications
+		WHERE event_id = $1 AND user_id = $2
+		ORDER BY classified_at DESC, id
+	`, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*ClassificationHistoryEntry
+	for rows.Next() {
+		h := &ClassificationHistoryEntry{}
+		var votesJSON []byte
+		if err := rows.Scan(
+			&h.ID, &h.EventID, &h.ProjectID, &h.Source, &h.Confidence, &h.IsSkipped, &votesJSON, &h.ClassifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		if votesJSON != nil {
+			if err := json.Unmarshal(votesJSON, &h.Votes); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, h)
+	}
+
+	return entries, rows.Err()
+}
+
+// recordClassification appends a classification to an event's history
+func recordClassification(ctx context.Context, tx pgx.Tx, userID uuid.UUID, h *ClassificationHistoryEntry) error {
+	var votesJSON []byte
+	if h.Votes != nil {
+		var err error
+		if votesJSON, err = json.Marshal(h.Votes); err != nil {
+			return err
+		}
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO calendar_event_classifications (
+			id, event_id, user_id, project_id, source, confidence, is_skipped, rule_votes, classified_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, uuid.New(), h.EventID, userID, h.ProjectID, h.Source, h.Confidence, h.IsSkipped, votesJSON, h.ClassifiedAt)
+	return err
+}
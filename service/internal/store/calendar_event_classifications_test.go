@@ -0,0 +1,153 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestClassificationHistory checks that manual and rule classifications are
+// recorded with their votes, and that re-applying a rule result is not
+func TestClassificationHistory(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "history-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	project, err := store.NewProjectStore(db.Pool).Create(ctx, user.ID, "Alpha", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	eventStore := store.NewCalendarEventStore(db.Pool)
+	event := createTestEvent(t, db.Pool, user.ID, "Planning", time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC))
+
+	history := func() []*store.ClassificationHistoryEntry {
+		t.Helper()
+		entries, err := eventStore.ListClassificationHistory(ctx, user.ID, event.ID)
+		if err != nil {
+			t.Fatalf("ListClassificationHistory: %v", err)
+		}
+		return entries
+	}
+	if entries := history(); len(entries) != 0 {
+		t.Errorf("expected no history for a pending event, got %d entries", len(entries))
+	}
+
+	votes := []store.ClassificationVote{
+		{RuleID: uuid.New().String(), ProjectID: project.ID.String(), Weight: 2, Source: store.SourceRule},
+		{RuleID: uuid.New().String(), ProjectID: project.ID.String(), Weight: 1, Source: store.SourceFingerprint},
+	}
+	for i := 0; i < 2; i++ {
+		if err := eventStore.ClassifyByRule(ctx, user.ID, event.ID, project.ID, store.SourceRule, 0.9, false, votes); err != nil {
+			t.Fatalf("ClassifyByRule: %v", err)
+		}
+	}
+	entries := history()
+	if len(entries) != 1 {
+		t.Fatalf("expected re-applying the same result to add nothing, got %d entries", len(entries))
+	}
+	rule := entries[0]
+	if rule.Source != store.SourceRule || rule.ProjectID == nil || *rule.ProjectID != project.ID || rule.Confidence == nil || *rule.Confidence != 0.9 {
+		t.Errorf("unexpected rule entry: %+v", rule)
+	}
+	if len(rule.Votes) != 2 || rule.Votes[0] != votes[0] || rule.Votes[1] != votes[1] {
+		t.Errorf("expected the votes to round-trip, got %+v", rule.Votes)
+	}
+
+	if _, err := eventStore.Classify(ctx, user.ID, event.ID, nil, true); err != nil {
+		t.Fatalf("Classify: %v", err)
+	}
+	entries = history()
+	if len(entries) != 2 || entries[1].ID != rule.ID {
+		t.Fatalf("expected the manual entry ahead of the rule entry, got %d entries", len(entries))
+	}
+	if manual := entries[0]; manual.Source != store.SourceManual || !manual.IsSkipped || manual.Votes != nil {
+		t.Errorf("unexpected manual entry: %+v", manual)
+	}
+
+	if _, err := eventStore.ListClassificationHistory(ctx, uuid.New(), event.ID); !errors.Is(err, store.ErrCalendarEventNotFound) {
+		t.Errorf("expected another user's event to be not found, got %v", err)
+	}
+	if err := eventStore.ClassifyByRule(ctx, user.ID, uuid.New(), project.ID, store.SourceRule, 0.9, false, nil); !errors.Is(err, store.ErrCalendarEventNotFound) {
+		t.Errorf("expected an unknown event to be not found, got %v", err)
+	}
+}
+
+// TestArchiveKeepsClassificationHistory checks that retention leaves
+// classified events live, so their history isn't cascade-deleted
+func TestArchiveKeepsClassificationHistory(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "archive-history-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	project, err := store.NewProjectStore(db.Pool).Create(ctx, user.ID, "Alpha", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	// Archive is global, so the events predate anything other tests create
+	eventStore := store.NewCalendarEventStore(db.Pool)
+	start := time.Date(2001, 1, 15, 10, 0, 0, 0, time.UTC)
+	classified := createTestEvent(t, db.Pool, user.ID, "Planning", start)
+	untouched := createTestEvent(t, db.Pool, user.ID, "Lunch", start)
+	if err := eventStore.ClassifyByRule(ctx, user.ID, classified.ID, project.ID, store.SourceRule, 0.9, false, nil); err != nil {
+		t.Fatalf("ClassifyByRule: %v", err)
+	}
+
+	if _, err := eventStore.Archive(ctx, start.AddDate(0, 0, 1), store.DefaultArchiveBatchSize); err != nil {
+		t.Fatalf("Archive: %v", err)
+	}
+	if _, err := eventStore.GetByID(ctx, user.ID, untouched.ID); !errors.Is(err, store.ErrCalendarEventNotFound) {
+		t.Errorf("expected the unclassified event to be archived, got %v", err)
+	}
+	history, err := eventStore.ListClassificationHistory(ctx, user.ID, classified.ID)
+	if err != nil {
+		t.Fatalf("ListClassificationHistory: %v", err)
+	}
+	if len(history) != 1 {
+		t.Errorf("expected the classification history to survive archiving, got %d entries", len(history))
+	}
+}
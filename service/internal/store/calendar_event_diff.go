@@ -0,0 +1,221 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventDiffKind is how an event changed between two points in time
+type EventDiffKind string
+
+const (
+	EventAdded     EventDiffKind = "added"
+	EventMoved     EventDiffKind = "moved"
+	EventCancelled EventDiffKind = "cancelled"
+	EventChanged   EventDiffKind = "changed"
+)
+
+// EventSnapshot is an event's synced fields at a point in time
+type EventSnapshot struct {
+	Title          string
+	Description    *string
+	StartTime      time.Time
+	EndTime        time.Time
+	IsAllDay       bool
+	ResponseStatus *string
+	Transparency   *string
+	IsOrphaned     bool
+}
+
+// EventDiff is an event that changed between two points in time
+type EventDiff struct {
+	EventID uuid.UUID
+	Kind    EventDiffKind
+	// Before and After are nil when the event didn't exist yet or had been
+	// cancelled. A moved event may start outside the range at one of them.
+	Before *EventSnapshot
+	After  *EventSnapshot
+	Fields []string // Synced fields that differ between the two
+}
+
+// DiffRange compares the events starting in [start, end) as of since with
+// those as of until. The event set at each point is rebuilt from the change
+// history, so events that changed before the history was kept show only
+// later changes.
+func (s *CalendarEventStore) DiffRange(ctx context.Context, userID uuid.UUID, start, end, since, until time.Time) ([]*EventDiff, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT ce.id, ce.title, ce.description, ce.start_time, ce.end_time, ce.is_all_day,
+		       ce.response_status, ce.transparency, ce.is_orphaned, ce.created_at
+		FROM calendar_events ce
+		JOIN calendars c ON c.id = ce.calendar_id AND c.is_selected = true
+		WHERE ce.user_id = $1
+		  AND (ce.created_at > $2 OR ce.id IN (
+			SELECT event_id FROM calendar_event_changes WHERE user_id = $1 AND synced_at > $2
+		  ))
+	`, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	var events []*CalendarEvent
+	for rows.Next() {
+		e := &CalendarEvent{}
+		if err := rows.Scan(
+			&e.ID, &e.Title, &e.Description, &e.StartTime, &e.EndTime, &e.IsAllDay,
+			&e.ResponseStatus, &e.Transparency, &e.IsOrphaned, &e.CreatedAt,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = s.db(ctx).Query(ctx, `
+		SELECT id, event_id, field, old_value, new_value, synced_at
+		FROM calendar_event_changes
+		WHERE user_id = $1 AND synced_at > $2
+		ORDER BY synced_at
+	`, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	changes := make(map[uuid.UUID][]*CalendarEventChange)
+	for rows.Next() {
+		c := &CalendarEventChange{}
+		if err := rows.Scan(&c.ID, &c.EventID, &c.Field, &c.OldValue, &c.NewValue, &c.SyncedAt); err != nil {
+			return nil, err
+		}
+		changes[c.EventID] = append(changes[c.EventID], c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return diffEvents(events, changes, start, end, since, until), nil
+}
+
+// diffEvents compares each event as of since and until within [start, end).
+// Changes are per event, oldest first.
+func diffEvents(events []*CalendarEvent, changes map[uuid.UUID][]*CalendarEventChange, start, end, since, until time.Time) []*EventDiff {
+	inRange := func(snap *EventSnapshot) bool {
+		return snap != nil && !snap.StartTime.Before(start) && snap.StartTime.Before(end)
+	}
+
+	var diffs []*EventDiff
+	for _, e := range events {
+		before := snapshotAt(e, changes[e.ID], since)
+		after := snapshotAt(e, changes[e.ID], until)
+		if !inRange(before) && !inRange(after) {
+			continue
+		}
+
+		d := &EventDiff{EventID: e.ID, Before: before, After: after}
+		if before != nil && after != nil {
+			d.Fields = changedFields(before, after)
+		}
+		switch {
+		case before == nil:
+			d.Kind = EventAdded
+		case after == nil:
+			d.Kind = EventCancelled
+		case !inRange(before) || !inRange(after) ||
+			!before.StartTime.Equal(after.StartTime) || !before.EndTime.Equal(after.EndTime):
+			d.Kind = EventMoved
+		case len(d.Fields) > 0:
+			d.Kind = EventChanged
+		default:
+			continue
+		}
+		diffs = append(diffs, d)
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffStart(diffs[i]).Before(diffStart(diffs[j]))
+	})
+	return diffs
+}
+
+// diffStart is when a diffed event starts as of the later snapshot, or the
+// earlier one for cancelled events
+func diffStart(d *EventDiff) time.Time {
+	if d.After != nil {
+		return d.After.StartTime
+	}
+	return d.Before.StartTime
+}
+
+// snapshotAt rewinds an event to time t by undoing the changes synced after
+// it. Returns nil when the event didn't exist or was orphaned at t.
+func snapshotAt(e *CalendarEvent, changes []*CalendarEventChange, t time.Time) *EventSnapshot {
+	if e.CreatedAt.After(t) {
+		return nil
+	}
+	snap := &EventSnapshot{
+		Title:          e.Title,
+		Description:    e.Description,
+		StartTime:      e.StartTime,
+		EndTime:        e.EndTime,
+		IsAllDay:       e.IsAllDay,
+		ResponseStatus: e.ResponseStatus,
+		Transparency:   e.Transparency,
+		IsOrphaned:     e.IsOrphaned,
+	}
+	for i := len(changes) - 1; i >= 0 && changes[i].SyncedAt.After(t); i-- {
+		c := changes[i]
+		switch c.Field {
+		case "title":
+			snap.Title = textValue(c.OldValue)
+		case "description":
+			snap.Description = c.OldValue
+		case "start_time":
+			snap.StartTime, _ = time.Parse(time.RFC3339, textValue(c.OldValue))
+		case "end_time":
+			snap.EndTime, _ = time.Parse(time.RFC3339, textValue(c.OldValue))
+		case "is_all_day":
+			snap.IsAllDay, _ = strconv.ParseBool(textValue(c.OldValue))
+		case "response_status":
+			snap.ResponseStatus = c.OldValue
+		case "transparency":
+			snap.Transparency = c.OldValue
+		case "is_orphaned":
+			snap.IsOrphaned, _ = strconv.ParseBool(textValue(c.OldValue))
+		}
+	}
+	if snap.IsOrphaned {
+		return nil
+	}
+	return snap
+}
+
+// changedFields lists the synced fields that differ between two snapshots
+func changedFields(a, b *EventSnapshot) []string {
+	var fields []string
+	add := func(field string, differ bool) {
+		if differ {
+			fields = append(fields, field)
+		}
+	}
+	add("title", a.Title != b.Title)
+	add("description", textValue(a.Description) != textValue(b.Description))
+	add("start_time", !a.StartTime.Equal(b.StartTime))
+	add("end_time", !a.EndTime.Equal(b.EndTime))
+	add("is_all_day", a.IsAllDay != b.IsAllDay)
+	add("response_status", textValue(a.ResponseStatus) != textValue(b.ResponseStatus))
+	add("transparency", textValue(a.Transparency) != textValue(b.Transparency))
+	return fields
+}
+
+func textValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPurgeBatchSize bounds how many events one purge call deletes
+const DefaultPurgeBatchSize = 1000
+
+// invoicedEvent matches a live event ce that feeds an invoiced time entry.
+// Those events are kept as the record behind the invoice.
+const invoicedEvent = `EXISTS (
+	SELECT 1 FROM time_entry_events tee
+	JOIN time_entries te ON te.id = tee.time_entry_id
+	WHERE tee.calendar_event_id = ce.id AND te.invoice_id IS NOT NULL
+)`
+
+// PurgeCounts is what a retention purge removes, or would remove
+type PurgeCounts struct {
+	Events         int64 // Live events
+	ArchivedEvents int64
+	// Events kept because they feed invoiced time entries
+	ProtectedEvents int64
+}
+
+// CountPurgeable counts the user's events starting before the cutoff that
+// a purge would delete, and those it would keep for invoices
+func (s *CalendarEventStore) CountPurgeable(ctx context.Context, userID uuid.UUID, before time.Time) (*PurgeCounts, error) {
+	counts := &PurgeCounts{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM calendar_events ce
+			 WHERE ce.user_id = $1 AND ce.start_time < $2 AND NOT `+invoicedEvent+`),
+			(SELECT COUNT(*) FROM calendar_events_archive
+			 WHERE user_id = $1 AND start_time < $2),
+			(SELECT COUNT(*) FROM calendar_events ce
+			 WHERE ce.user_id = $1 AND ce.start_time < $2 AND `+invoicedEvent+`)
+	`, userID, before).Scan(&counts.Events, &counts.ArchivedEvents, &counts.ProtectedEvents)
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// OldestPurgeable returns the start of the user's oldest event before the
+// cutoff that a purge would delete, or nil if there is none
+func (s *CalendarEventStore) OldestPurgeable(ctx context.Context, userID uuid.UUID, before time.Time) (*time.Time, error) {
+	var oldest *time.Time
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT MIN(start_time) FROM (
+			SELECT MIN(ce.start_time) AS start_time FROM calendar_events ce
+			WHERE ce.user_id = $1 AND ce.start_time < $2 AND NOT `+invoicedEvent+`
+			UNION ALL
+			SELECT MIN(start_time) FROM calendar_events_archive
+			WHERE user_id = $1 AND start_time < $2
+		) oldest
+	`, userID, before).Scan(&oldest)
+	return oldest, err
+}
+
+// Purge deletes up to limit of the user's live events starting before the
+// cutoff and returns how many were deleted. Events feeding invoiced time
+// entries are kept; the links from other time entries, undo history,
+// snapshots and tags are deleted with the events, so callers preserve the
+// time entries first.
+func (s *CalendarEventStore) Purge(ctx context.Context, userID uuid.UUID, before time.Time, limit int) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM calendar_events
+		WHERE id IN (
+			SELECT ce.id FROM calendar_events ce
+			WHERE ce.user_id = $1 AND ce.start_time < $2 AND NOT `+invoicedEvent+`
+			ORDER BY ce.start_time
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+	`, userID, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// PurgeArchived deletes up to limit of the user's archived events starting
+// before the cutoff and returns how many were deleted
+func (s *CalendarEventStore) PurgeArchived(ctx context.Context, userID uuid.UUID, before time.Time, limit int) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM calendar_events_archive
+		WHERE id IN (
+			SELECT id FROM calendar_events_archive
+			WHERE user_id = $1 AND start_time < $2
+			ORDER BY start_time
+			LIMIT $3
+		)
+	`, userID, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
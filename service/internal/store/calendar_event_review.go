@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrCalendarEventNotInReview = errors.New("calendar event does not need review")
+
+// reviewQueue selects the auto-classified events flagged for review
+const reviewQueue = `
+		WHERE ce.user_id = $1
+		  AND ce.needs_review = true
+		  AND ce.is_orphaned = false
+		  AND c.is_selected = true
+		  AND ($2::timestamptz IS NULL OR ce.start_time >= $2)
+		  AND ($3::timestamptz IS NULL OR ce.start_time < $3)
+`
+
+// ListNeedsReview returns up to limit events flagged for review, least
+// confident first
+func (s *CalendarEventStore) ListNeedsReview(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, limit int) ([]*CalendarEvent, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+listedEventColumns+`
+		FROM calendar_events ce
+		LEFT JOIN projects p ON ce.project_id = p.id
+		LEFT JOIN calendars c ON ce.calendar_id = c.id
+		`+reviewQueue+`
+		ORDER BY ce.classification_confidence ASC NULLS FIRST, ce.start_time, ce.id
+		LIMIT $4
+	`, userID, startDate, nextDayOf(endDate), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanListedEvents(rows)
+}
+
+// CountNeedsReview returns how many events ListNeedsReview draws from
+func (s *CalendarEventStore) CountNeedsReview(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time) (int, error) {
+	var count int
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM calendar_events ce
+		LEFT JOIN calendars c ON ce.calendar_id = c.id
+		`+reviewQueue, userID, startDate, nextDayOf(endDate)).Scan(&count)
+	return count, err
+}
+
+// AcceptReview confirms an event's automatic classification and takes it
+// off the review queue. It returns ErrCalendarEventNotInReview when the
+// event isn't flagged for review.
+func (s *CalendarEventStore) AcceptReview(ctx context.Context, userID, eventID uuid.UUID) (*CalendarEvent, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendar_events
+		SET needs_review = false, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND needs_review = true
+	`, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		if _, err := s.GetByID(ctx, userID, eventID); err != nil {
+			return nil, err
+		}
+		return nil, ErrCalendarEventNotInReview
+	}
+	return s.GetByID(ctx, userID, eventID)
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PendingWriteBack is an event whose project has changed since it was last
+// written back to its calendar
+type PendingWriteBack struct {
+	EventID            uuid.UUID
+	ConnectionID       uuid.UUID
+	ExternalID         string // Google event ID
+	CalendarExternalID string // Google calendar ID
+	Mode               WriteBackMode
+	ProjectID          *uuid.UUID // Nil clears what was written before
+	ProjectName        *string
+	ProjectColor       *string
+}
+
+// ListPendingWriteBack returns up to limit events starting at or after since
+// whose project needs writing back, grouped by connection. Events on
+// calendars waiting for re-authentication or disabled, or of paused
+// connections, are left out.
+func (s *CalendarEventStore) ListPendingWriteBack(ctx context.Context, since time.Time, limit int) ([]*PendingWriteBack, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT ce.id, ce.connection_id, ce.external_id, c.external_id, cc.write_back,
+		       ce.project_id, p.name, p.color
+		FROM calendar_events ce
+		JOIN calendar_connections cc ON cc.id = ce.connection_id
+		JOIN calendars c ON c.id = ce.calendar_id
+		LEFT JOIN projects p ON p.id = ce.project_id
+		WHERE cc.write_back <> $1
+		  AND cc.paused_at IS NULL
+		  AND ce.project_id IS DISTINCT FROM ce.written_project_id
+		  AND ce.is_orphaned = false
+		  AND ce.start_time >= $2
+		  AND c.needs_reauth = false
+		  AND c.sync_disabled_at IS NULL
+		ORDER BY ce.connection_id, ce.start_time
+		LIMIT $3
+	`, WriteBackOff, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pending []*PendingWriteBack
+	for rows.Next() {
+		p := &PendingWriteBack{}
+		err := rows.Scan(
+			&p.EventID, &p.ConnectionID, &p.ExternalID, &p.CalendarExternalID, &p.Mode,
+			&p.ProjectID, &p.ProjectName, &p.ProjectColor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, p)
+	}
+
+	return pending, rows.Err()
+}
+
+// MarkWrittenBack records the project written back to an event's calendar
+func (s *CalendarEventStore) MarkWrittenBack(ctx context.Context, eventID uuid.UUID, projectID *uuid.UUID) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendar_events
+		SET written_project_id = $2, written_back_at = $3
+		WHERE id = $1
+	`, eventID, projectID, time.Now().UTC())
+	return err
+}
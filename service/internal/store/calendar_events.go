@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var ErrCalendarEventNotFound = errors.New("calendar event not found")
@@ -39,6 +41,7 @@ type CalendarEvent struct {
 	StartTime                time.Time
 	EndTime                  time.Time
 	Attendees                []string
+	AttendeeDetails          []EventAttendee // Set by sync; written to event_attendees on upsert
 	IsRecurring              bool
 	IsAllDay                 bool
 	ResponseStatus           *string
@@ -50,33 +53,83 @@ type CalendarEvent struct {
 	ClassificationSource     *ClassificationSource
 	ClassificationConfidence *float64
 	NeedsReview              bool
+	DurationChangedAt        *time.Time // Duration changed since its time entry was computed
+	// Set on the copy sync hooks see when a re-sync changed the response
+	// status or transparency, which attendance rules match on
+	AttendanceChanged bool
 	ProjectID                *uuid.UUID
 	CreatedAt                time.Time
 	UpdatedAt                time.Time
 	// Joined data
 	Project            *Project
 	CalendarExternalID *string // Google Calendar ID (typically email)
-	CalendarName       *string
-	CalendarColor      *string
+	CalendarName       *string // As reported by Google, which rules match against
+	CalendarColor      *string // The user's override, if any
+	// The calendar's default project mapping, a classification vote
+	CalendarDefaultProjectID     *uuid.UUID
+	CalendarDefaultProjectWeight *float64
+	Tags                         []string // Names from calendar_event_tags
+	// No attendee outside the user's company domains; nil when none are declared
+	IsInternal *bool
 }
 
 // CalendarEventStore provides PostgreSQL-backed event storage
 type CalendarEventStore struct {
 	pool *pgxpool.Pool
+
+	syncHooksMu sync.RWMutex
+	syncHooks   []SyncHook
 }
 
+// SyncHook is called with each event a sync creates or changes, once the
+// write commits. Hooks run on the writer's goroutine and must not block.
+type SyncHook func(event *CalendarEvent)
+
 // NewCalendarEventStore creates a new store
 func NewCalendarEventStore(pool *pgxpool.Pool) *CalendarEventStore {
 	return &CalendarEventStore{pool: pool}
 }
 
-// Upsert creates or updates an event by external_id
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *CalendarEventStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// OnSyncChange registers a hook for events a sync creates or changes
+func (s *CalendarEventStore) OnSyncChange(fn SyncHook) {
+	s.syncHooksMu.Lock()
+	defer s.syncHooksMu.Unlock()
+	s.syncHooks = append(s.syncHooks, fn)
+}
+
+func (s *CalendarEventStore) notifySynced(event *CalendarEvent) {
+	s.syncHooksMu.RLock()
+	defer s.syncHooksMu.RUnlock()
+	for _, fn := range s.syncHooks {
+		fn(event)
+	}
+}
+
+// Upsert creates or updates an event by external_id and rewrites its
+// attendee rows. Fields a re-sync changes are recorded in the event's
+// change history, and sync hooks see the event if it is new or changed.
 func (s *CalendarEventStore) Upsert(ctx context.Context, event *CalendarEvent) (*CalendarEvent, error) {
 	attendeesJSON, _ := json.Marshal(event.Attendees)
 	now := time.Now().UTC()
 	newID := uuid.New()
 
-	err := s.pool.QueryRow(ctx, `
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	prior, err := lockPriorEvent(ctx, tx, event.ConnectionID, event.ExternalID, attendeesJSON, event.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, `
 		INSERT INTO calendar_events (
 			id, connection_id, calendar_id, user_id, external_id, title, description,
 			start_time, end_time, attendees, is_recurring, is_all_day, response_status,
@@ -109,17 +162,42 @@ func (s *CalendarEventStore) Upsert(ctx context.Context, event *CalendarEvent) (
 		return nil, err
 	}
 
+	if err := replaceAttendees(ctx, tx, event.ID, event.UserID, eventAttendees(event)); err != nil {
+		return nil, err
+	}
+
+	changed := prior == nil
+	if prior != nil {
+		if changed, err = recordEventChanges(ctx, tx, prior, event, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	if changed {
+		synced := *event
+		database.AfterCommit(ctx, func() { s.notifySynced(&synced) })
+	}
 	return event, nil
 }
 
 // MarkOrphanedExcept marks events as orphaned if not in the given external IDs (legacy, uses connection_id)
 func (s *CalendarEventStore) MarkOrphanedExcept(ctx context.Context, connectionID uuid.UUID, externalIDs []string) (int64, error) {
-	result, err := s.pool.Exec(ctx, `
-		UPDATE calendar_events
-		SET is_orphaned = true, updated_at = $3
-		WHERE connection_id = $1
-		AND external_id != ALL($2)
-		AND is_orphaned = false
+	result, err := s.db(ctx).Exec(ctx, `
+		WITH orphaned AS (
+			UPDATE calendar_events
+			SET is_orphaned = true, updated_at = $3
+			WHERE connection_id = $1
+			AND external_id != ALL($2)
+			AND is_orphaned = false
+			RETURNING id, user_id
+		)
+		INSERT INTO calendar_event_changes (id, event_id, user_id, field, old_value, new_value, synced_at)
+		SELECT gen_random_uuid(), id, user_id, 'is_orphaned', 'false', 'true', $3
+		FROM orphaned
 	`, connectionID, externalIDs, time.Now().UTC())
 
 	if err != nil {
@@ -131,12 +209,18 @@ func (s *CalendarEventStore) MarkOrphanedExcept(ctx context.Context, connectionI
 
 // MarkOrphanedExceptByCalendar marks events as orphaned if not in the given external IDs for a specific calendar
 func (s *CalendarEventStore) MarkOrphanedExceptByCalendar(ctx context.Context, calendarID uuid.UUID, externalIDs []string) (int64, error) {
-	result, err := s.pool.Exec(ctx, `
-		UPDATE calendar_events
-		SET is_orphaned = true, updated_at = $3
-		WHERE calendar_id = $1
-		AND external_id != ALL($2)
-		AND is_orphaned = false
+	result, err := s.db(ctx).Exec(ctx, `
+		WITH orphaned AS (
+			UPDATE calendar_events
+			SET is_orphaned = true, updated_at = $3
+			WHERE calendar_id = $1
+			AND external_id != ALL($2)
+			AND is_orphaned = false
+			RETURNING id, user_id
+		)
+		INSERT INTO calendar_event_changes (id, event_id, user_id, field, old_value, new_value, synced_at)
+		SELECT gen_random_uuid(), id, user_id, 'is_orphaned', 'false', 'true', $3
+		FROM orphaned
 	`, calendarID, externalIDs, time.Now().UTC())
 
 	if err != nil {
@@ -149,14 +233,20 @@ func (s *CalendarEventStore) MarkOrphanedExceptByCalendar(ctx context.Context, c
 // MarkOrphanedInRangeExceptByCalendar marks events as orphaned if not in the given external IDs,
 // but only for events within the specified date range. Events outside the range are not affected.
 func (s *CalendarEventStore) MarkOrphanedInRangeExceptByCalendar(ctx context.Context, calendarID uuid.UUID, externalIDs []string, minDate, maxDate time.Time) (int64, error) {
-	result, err := s.pool.Exec(ctx, `
-		UPDATE calendar_events
-		SET is_orphaned = true, updated_at = $5
-		WHERE calendar_id = $1
-		AND external_id != ALL($2)
-		AND is_orphaned = false
-		AND start_time >= $3
-		AND start_time < $4
+	result, err := s.db(ctx).Exec(ctx, `
+		WITH orphaned AS (
+			UPDATE calendar_events
+			SET is_orphaned = true, updated_at = $5
+			WHERE calendar_id = $1
+			AND external_id != ALL($2)
+			AND is_orphaned = false
+			AND start_time >= $3
+			AND start_time < $4
+			RETURNING id, user_id
+		)
+		INSERT INTO calendar_event_changes (id, event_id, user_id, field, old_value, new_value, synced_at)
+		SELECT gen_random_uuid(), id, user_id, 'is_orphaned', 'false', 'true', $5
+		FROM orphaned
 	`, calendarID, externalIDs, minDate, maxDate, time.Now().UTC())
 
 	if err != nil {
@@ -168,29 +258,43 @@ func (s *CalendarEventStore) MarkOrphanedInRangeExceptByCalendar(ctx context.Con
 
 // MarkOrphanedByExternalID marks a specific event as orphaned by its external ID (legacy, uses connection_id)
 func (s *CalendarEventStore) MarkOrphanedByExternalID(ctx context.Context, connectionID uuid.UUID, externalID string) error {
-	_, err := s.pool.Exec(ctx, `
-		UPDATE calendar_events
-		SET is_orphaned = true, updated_at = $3
-		WHERE connection_id = $1
-		AND external_id = $2
+	_, err := s.db(ctx).Exec(ctx, `
+		WITH orphaned AS (
+			UPDATE calendar_events
+			SET is_orphaned = true, updated_at = $3
+			WHERE connection_id = $1
+			AND external_id = $2
+			AND is_orphaned = false
+			RETURNING id, user_id
+		)
+		INSERT INTO calendar_event_changes (id, event_id, user_id, field, old_value, new_value, synced_at)
+		SELECT gen_random_uuid(), id, user_id, 'is_orphaned', 'false', 'true', $3
+		FROM orphaned
 	`, connectionID, externalID, time.Now().UTC())
 	return err
 }
 
 // MarkOrphanedByExternalIDAndCalendar marks a specific event as orphaned by its external ID and calendar
 func (s *CalendarEventStore) MarkOrphanedByExternalIDAndCalendar(ctx context.Context, calendarID uuid.UUID, externalID string) error {
-	_, err := s.pool.Exec(ctx, `
-		UPDATE calendar_events
-		SET is_orphaned = true, updated_at = $3
-		WHERE calendar_id = $1
-		AND external_id = $2
+	_, err := s.db(ctx).Exec(ctx, `
+		WITH orphaned AS (
+			UPDATE calendar_events
+			SET is_orphaned = true, updated_at = $3
+			WHERE calendar_id = $1
+			AND external_id = $2
+			AND is_orphaned = false
+			RETURNING id, user_id
+		)
+		INSERT INTO calendar_event_changes (id, event_id, user_id, field, old_value, new_value, synced_at)
+		SELECT gen_random_uuid(), id, user_id, 'is_orphaned', 'false', 'true', $3
+		FROM orphaned
 	`, calendarID, externalID, time.Now().UTC())
 	return err
 }
 
 // GetExternalIDsForConnection returns all external IDs for a connection
 func (s *CalendarEventStore) GetExternalIDsForConnection(ctx context.Context, connectionID uuid.UUID) ([]string, error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT external_id FROM calendar_events WHERE connection_id = $1
 	`, connectionID)
 	if err != nil {
@@ -209,18 +313,33 @@ func (s *CalendarEventStore) GetExternalIDsForConnection(ctx context.Context, co
 	return ids, rows.Err()
 }
 
-// List returns events for a user with optional filters
+// List returns events for a user with optional filters. Archived events are
+// included when the range reaches back into the archive.
 func (s *CalendarEventStore) List(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, status *ClassificationStatus, connectionID *uuid.UUID) ([]*CalendarEvent, error) {
+	return s.list(ctx, userID, startDate, endDate, status, connectionID, nil)
+}
+
+// ListByAttendees returns events in the range with at least one attendee
+// matching the filter, using the event_attendees indexes. Archived events
+// have no attendee rows, so ranges that reach the archive are returned
+// unfiltered and callers must still check attendees themselves.
+func (s *CalendarEventStore) ListByAttendees(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, filter AttendeeFilter) ([]*CalendarEvent, error) {
+	return s.list(ctx, userID, startDate, endDate, nil, nil, &filter)
+}
+
+func (s *CalendarEventStore) list(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, status *ClassificationStatus, connectionID *uuid.UUID, attendees *AttendeeFilter) ([]*CalendarEvent, error) {
+	source := "calendar_events"
+	archived, err := s.hasArchivedEvents(ctx, userID, startDate, nextDayOf(endDate))
+	if err != nil {
+		return nil, err
+	}
+	if archived {
+		source = liveAndArchivedEvents
+	}
+
 	query := `
-		SELECT ce.id, ce.connection_id, ce.calendar_id, ce.user_id, ce.external_id, ce.title, ce.description,
-		       ce.start_time, ce.end_time, ce.attendees, ce.is_recurring, ce.is_all_day, ce.response_status,
-		       ce.transparency, ce.is_orphaned, ce.is_suppressed, ce.is_skipped,
-		       ce.classification_status, ce.classification_source, ce.classification_confidence, ce.needs_review,
-		       ce.project_id, ce.created_at, ce.updated_at,
-		       p.id, p.user_id, p.name, p.short_code, p.client, p.color, p.is_billable, p.is_archived,
-		       p.is_hidden_by_default, p.does_not_accumulate_hours, p.created_at, p.updated_at,
-		       c.external_id, c.name, c.color
-		FROM calendar_events ce
+		SELECT ` + listedEventColumns + `
+		FROM ` + source + ` ce
 		LEFT JOIN projects p ON ce.project_id = p.id
 		LEFT JOIN calendars c ON ce.calendar_id = c.id
 		WHERE ce.user_id = $1 AND ce.is_orphaned = false AND c.is_selected = true
@@ -245,20 +364,50 @@ func (s *CalendarEventStore) List(ctx context.Context, userID uuid.UUID, startDa
 		query += fmt.Sprintf(" AND ce.classification_status = $%d", argNum)
 		args = append(args, *status)
 		argNum++
+		// Suppressed events never enter the pending queue
+		if *status == StatusPending {
+			query += " AND ce.is_suppressed = false"
+		}
 	}
 	if connectionID != nil {
 		query += fmt.Sprintf(" AND ce.connection_id = $%d", argNum)
 		args = append(args, *connectionID)
+		argNum++
+	}
+	if attendees != nil && !archived {
+		query += fmt.Sprintf(` AND ce.id IN (
+			SELECT event_id FROM event_attendees
+			WHERE user_id = $1 AND (domain = ANY($%d) OR email = ANY($%d))
+		)`, argNum, argNum+1)
+		args = append(args, lowerAll(attendees.Domains), lowerAll(attendees.Emails))
 	}
 
 	query += " ORDER BY ce.start_time ASC"
 
-	rows, err := s.pool.Query(ctx, query, args...)
+	rows, err := s.db(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	return scanListedEvents(rows)
+}
+
+// listedEventColumns are the columns scanned by scanListedEvents, for
+// queries over calendar_events ce joined to projects p and calendars c
+const listedEventColumns = `ce.id, ce.connection_id, ce.calendar_id, ce.user_id, ce.external_id, ce.title, ce.description,
+		       ce.start_time, ce.end_time, ce.attendees, ce.is_recurring, ce.is_all_day, ce.response_status,
+		       ce.transparency, ce.is_orphaned, ce.is_suppressed, ce.is_skipped,
+		       ce.classification_status, ce.classification_source, ce.classification_confidence, ce.needs_review,
+		       ce.duration_changed_at, ce.project_id, ce.created_at, ce.updated_at,
+		       p.id, p.user_id, p.name, p.short_code, p.client, p.color, p.is_billable, p.is_archived,
+		       p.is_hidden_by_default, p.does_not_accumulate_hours, p.created_at, p.updated_at,
+		       c.external_id, c.name, COALESCE(c.display_color, c.color),
+		       c.default_project_id, c.default_project_weight,
+		       ` + eventTagsColumn + `, ` + eventInternalColumn
+
+// scanListedEvents scans rows selected with listedEventColumns
+func scanListedEvents(rows pgx.Rows) ([]*CalendarEvent, error) {
 	var events []*CalendarEvent
 	for rows.Next() {
 		e := &CalendarEvent{}
@@ -273,10 +422,12 @@ func (s *CalendarEventStore) List(ctx context.Context, userID uuid.UUID, startDa
 			&e.StartTime, &e.EndTime, &attendeesJSON, &e.IsRecurring, &e.IsAllDay, &e.ResponseStatus,
 			&e.Transparency, &e.IsOrphaned, &e.IsSuppressed, &e.IsSkipped,
 			&e.ClassificationStatus, &e.ClassificationSource, &e.ClassificationConfidence, &e.NeedsReview,
-			&e.ProjectID, &e.CreatedAt, &e.UpdatedAt,
+			&e.DurationChangedAt, &e.ProjectID, &e.CreatedAt, &e.UpdatedAt,
 			&pID, &pUserID, &pName, &pShortCode, &pClient, &pColor, &pIsBillable, &pIsArchived,
 			&pIsHidden, &pNoAccum, &pCreatedAt, &pUpdatedAt,
 			&e.CalendarExternalID, &e.CalendarName, &e.CalendarColor,
+			&e.CalendarDefaultProjectID, &e.CalendarDefaultProjectWeight,
+			&e.Tags, &e.IsInternal,
 		)
 		if err != nil {
 			return nil, err
@@ -309,10 +460,11 @@ func (s *CalendarEventStore) List(ctx context.Context, userID uuid.UUID, startDa
 
 // CountByStatus returns counts of events by classification status and skip state
 func (s *CalendarEventStore) CountByStatus(ctx context.Context, connectionID uuid.UUID) (pending, classified, skipped int, err error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT classification_status, is_skipped, COUNT(*)
 		FROM calendar_events
 		WHERE connection_id = $1 AND is_orphaned = false
+		  AND NOT (is_suppressed AND classification_status = 'pending')
 		GROUP BY classification_status, is_skipped
 	`, connectionID)
 	if err != nil {
@@ -351,10 +503,11 @@ func (s *CalendarEventStore) ListForReclassification(ctx context.Context, userID
 		       ce.start_time, ce.end_time, ce.attendees, ce.is_recurring, ce.is_all_day, ce.response_status,
 		       ce.transparency, ce.is_orphaned, ce.is_suppressed, ce.is_skipped,
 		       ce.classification_status, ce.classification_source, ce.classification_confidence, ce.needs_review,
-		       ce.project_id, ce.created_at, ce.updated_at,
+		       ce.duration_changed_at, ce.project_id, ce.created_at, ce.updated_at,
 		       p.id, p.user_id, p.name, p.short_code, p.client, p.color, p.is_billable, p.is_archived,
 		       p.is_hidden_by_default, p.does_not_accumulate_hours, p.created_at, p.updated_at,
-		       c.external_id, c.name, c.color
+		       c.external_id, c.name, c.color, c.default_project_id, c.default_project_weight,
+		       ` + eventTagsColumn + `, ` + eventInternalColumn + `
 		FROM calendar_events ce
 		LEFT JOIN projects p ON ce.project_id = p.id
 		LEFT JOIN calendars c ON ce.calendar_id = c.id
@@ -380,7 +533,7 @@ func (s *CalendarEventStore) ListForReclassification(ctx context.Context, userID
 
 	query += " ORDER BY ce.start_time ASC"
 
-	rows, err := s.pool.Query(ctx, query, args...)
+	rows, err := s.db(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -402,11 +555,12 @@ func (s *CalendarEventStore) ListForReclassification(ctx context.Context, userID
 			&e.StartTime, &e.EndTime, &attendeesJSON, &e.IsRecurring, &e.IsAllDay, &e.ResponseStatus,
 			&e.Transparency, &e.IsOrphaned, &e.IsSuppressed, &e.IsSkipped,
 			&e.ClassificationStatus, &e.ClassificationSource, &e.ClassificationConfidence, &e.NeedsReview,
-			&e.ProjectID, &e.CreatedAt, &e.UpdatedAt,
+			&e.DurationChangedAt, &e.ProjectID, &e.CreatedAt, &e.UpdatedAt,
 			&projectID, &projectUserID, &projectName, &projectShortCode, &projectClient, &projectColor,
 			&projectIsBillable, &projectIsArchived, &projectIsHiddenByDefault, &projectDoesNotAccumulateHours,
 			&projectCreatedAt, &projectUpdatedAt,
-			&calExternalID, &calName, &calColor,
+			&calExternalID, &calName, &calColor, &e.CalendarDefaultProjectID, &e.CalendarDefaultProjectWeight,
+			&e.Tags, &e.IsInternal,
 		)
 		if err != nil {
 			return nil, err
@@ -464,25 +618,99 @@ func (s *CalendarEventStore) ListForReclassification(ctx context.Context, userID
 	return events, rows.Err()
 }
 
+// EventCursor is the position after the last event of a page, in
+// (start_time, id) order
+type EventCursor struct {
+	StartTime time.Time
+	ID        uuid.UUID
+}
+
+// applyCandidates selects the events apply-rules evaluates: unsuppressed
+// pending events and events classified by rule or fingerprint
+const applyCandidates = `
+		WHERE ce.user_id = $1
+		  AND ce.is_orphaned = false
+		  AND c.is_selected = true
+		  AND ((ce.classification_status = 'pending' AND ce.is_suppressed = false)
+		    OR (ce.classification_status = 'classified' AND ce.classification_source IN ('rule', 'fingerprint')))
+		  AND ($2::timestamptz IS NULL OR ce.start_time >= $2)
+		  AND ($3::timestamptz IS NULL OR ce.start_time < $3)
+		  AND ($4::uuid[] IS NULL OR ce.id = ANY($4))
+`
+
+// ListForApply returns up to limit events that apply-rules evaluates, in
+// start time order after the cursor. Classifying a page does not move its
+// events ahead of the cursor, so a run can page through the whole range
+// while it writes. A non-nil eventIDs limits the run to those events.
+func (s *CalendarEventStore) ListForApply(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, eventIDs []uuid.UUID, after *EventCursor, limit int) ([]*CalendarEvent, error) {
+	var afterTime *time.Time
+	var afterID *uuid.UUID
+	if after != nil {
+		afterTime, afterID = &after.StartTime, &after.ID
+	}
+
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+listedEventColumns+`
+		FROM calendar_events ce
+		LEFT JOIN projects p ON ce.project_id = p.id
+		LEFT JOIN calendars c ON ce.calendar_id = c.id
+		`+applyCandidates+`
+		  AND ($5::timestamptz IS NULL OR (ce.start_time, ce.id) > ($5, $6::uuid))
+		ORDER BY ce.start_time, ce.id
+		LIMIT $7
+	`, userID, startDate, nextDayOf(endDate), eventIDs, afterTime, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanListedEvents(rows)
+}
+
+// CountForApply returns how many events ListForApply pages through
+func (s *CalendarEventStore) CountForApply(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, eventIDs []uuid.UUID) (int, error) {
+	var count int
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM calendar_events ce
+		LEFT JOIN calendars c ON ce.calendar_id = c.id
+		`+applyCandidates, userID, startDate, nextDayOf(endDate), eventIDs).Scan(&count)
+	return count, err
+}
+
+// nextDayOf returns midnight after an inclusive end date, or nil
+func nextDayOf(endDate *time.Time) *time.Time {
+	if endDate == nil {
+		return nil
+	}
+	nextDay := endDate.AddDate(0, 0, 1)
+	return &nextDay
+}
+
 // GetByID retrieves an event by ID
 func (s *CalendarEventStore) GetByID(ctx context.Context, userID, eventID uuid.UUID) (*CalendarEvent, error) {
 	e := &CalendarEvent{}
 	var attendeesJSON []byte
 
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, connection_id, user_id, external_id, title, description,
-		       start_time, end_time, attendees, is_recurring, is_all_day, response_status,
-		       transparency, is_orphaned, is_suppressed, is_skipped,
-		       classification_status, classification_source, classification_confidence, needs_review,
-		       project_id, created_at, updated_at
-		FROM calendar_events
-		WHERE id = $1 AND user_id = $2
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT ce.id, ce.connection_id, ce.user_id, ce.external_id, ce.title, ce.description,
+		       ce.start_time, ce.end_time, ce.attendees, ce.is_recurring, ce.is_all_day, ce.response_status,
+		       ce.transparency, ce.is_orphaned, ce.is_suppressed, ce.is_skipped,
+		       ce.classification_status, ce.classification_source, ce.classification_confidence, ce.needs_review,
+		       ce.duration_changed_at, ce.project_id, ce.created_at, ce.updated_at,
+		       c.default_project_id, c.default_project_weight,
+		       `+eventTagsColumn+`, `+eventInternalColumn+`
+		FROM calendar_events ce
+		LEFT JOIN calendars c ON c.id = ce.calendar_id
+		WHERE ce.id = $1 AND ce.user_id = $2
 	`, eventID, userID).Scan(
 		&e.ID, &e.ConnectionID, &e.UserID, &e.ExternalID, &e.Title, &e.Description,
 		&e.StartTime, &e.EndTime, &attendeesJSON, &e.IsRecurring, &e.IsAllDay, &e.ResponseStatus,
 		&e.Transparency, &e.IsOrphaned, &e.IsSuppressed, &e.IsSkipped,
 		&e.ClassificationStatus, &e.ClassificationSource, &e.ClassificationConfidence, &e.NeedsReview,
-		&e.ProjectID, &e.CreatedAt, &e.UpdatedAt,
+		&e.DurationChangedAt, &e.ProjectID, &e.CreatedAt, &e.UpdatedAt,
+		&e.CalendarDefaultProjectID, &e.CalendarDefaultProjectWeight,
+		&e.Tags, &e.IsInternal,
 	)
 
 	if err != nil {
@@ -496,7 +724,107 @@ func (s *CalendarEventStore) GetByID(ctx context.Context, userID, eventID uuid.U
 	return e, nil
 }
 
+// GetByIDs retrieves the user's events with the given IDs in one query,
+// ordered by start time. IDs that don't exist or belong to another user are
+// skipped.
+func (s *CalendarEventStore) GetByIDs(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID) ([]*CalendarEvent, error) {
+	if len(eventIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+listedEventColumns+`
+		FROM calendar_events ce
+		LEFT JOIN projects p ON ce.project_id = p.id
+		LEFT JOIN calendars c ON ce.calendar_id = c.id
+		WHERE ce.id = ANY($2) AND ce.user_id = $1
+		ORDER BY ce.start_time ASC
+	`, userID, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanListedEvents(rows)
+}
+
+// --- Suppression ---
+
+// ListForSuppression returns the pending events that suppression rules may
+// change: not orphaned and not overridden by hand, optionally for one calendar
+func (s *CalendarEventStore) ListForSuppression(ctx context.Context, userID uuid.UUID, calendarID *uuid.UUID) ([]*CalendarEvent, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT ce.id, ce.connection_id, ce.calendar_id, ce.user_id, ce.external_id, ce.title, ce.description,
+		       ce.start_time, ce.end_time, ce.attendees, ce.is_recurring, ce.is_all_day, ce.response_status,
+		       ce.transparency, ce.is_suppressed, ce.classification_status,
+		       c.name, `+eventTagsColumn+`, `+eventInternalColumn+`
+		FROM calendar_events ce
+		LEFT JOIN calendars c ON ce.calendar_id = c.id
+		WHERE ce.user_id = $1
+		  AND ce.is_orphaned = false
+		  AND ce.suppression_overridden = false
+		  AND ce.classification_status = 'pending'
+		  AND ($2::uuid IS NULL OR ce.calendar_id = $2)
+	`, userID, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*CalendarEvent
+	for rows.Next() {
+		e := &CalendarEvent{}
+		var attendeesJSON []byte
+		if err := rows.Scan(
+			&e.ID, &e.ConnectionID, &e.CalendarID, &e.UserID, &e.ExternalID, &e.Title, &e.Description,
+			&e.StartTime, &e.EndTime, &attendeesJSON, &e.IsRecurring, &e.IsAllDay, &e.ResponseStatus,
+			&e.Transparency, &e.IsSuppressed, &e.ClassificationStatus,
+			&e.CalendarName, &e.Tags, &e.IsInternal,
+		); err != nil {
+			return nil, err
+		}
+		json.Unmarshal(attendeesJSON, &e.Attendees)
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// SetSuppressed sets is_suppressed on the given events, leaving events
+// whose suppression was overridden by hand untouched
+func (s *CalendarEventStore) SetSuppressed(ctx context.Context, userID uuid.UUID, eventIDs []uuid.UUID, suppressed bool) (int64, error) {
+	if len(eventIDs) == 0 {
+		return 0, nil
+	}
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendar_events
+		SET is_suppressed = $3, updated_at = NOW()
+		WHERE user_id = $1 AND id = ANY($2) AND suppression_overridden = false
+	`, userID, eventIDs, suppressed)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// OverrideSuppression suppresses or unsuppresses an event by hand. The
+// override sticks: suppression rules no longer change the event.
+func (s *CalendarEventStore) OverrideSuppression(ctx context.Context, userID, eventID uuid.UUID, suppressed bool) (*CalendarEvent, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendar_events
+		SET is_suppressed = $3, suppression_overridden = true, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+	`, eventID, userID, suppressed)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrCalendarEventNotFound
+	}
+	return s.GetByID(ctx, userID, eventID)
+}
+
 // Classify updates an event's classification status and project assignment
+// and appends it to the event's classification history
 func (s *CalendarEventStore) Classify(ctx context.Context, userID, eventID uuid.UUID, projectID *uuid.UUID, skip bool) (*CalendarEvent, error) {
 	now := time.Now().UTC()
 	source := SourceManual
@@ -507,8 +835,14 @@ func (s *CalendarEventStore) Classify(ctx context.Context, userID, eventID uuid.
 		status = StatusClassified
 	}
 
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
 	// Manual classification clears needs_review and sets confidence to 1.0
-	result, err := s.pool.Exec(ctx, `
+	result, err := tx.Exec(ctx, `
 		UPDATE calendar_events
 		SET classification_status = $3,
 		    classification_source = $4,
@@ -528,6 +862,22 @@ func (s *CalendarEventStore) Classify(ctx context.Context, userID, eventID uuid.
 		return nil, ErrCalendarEventNotFound
 	}
 
+	confidence := 1.0
+	if err := recordClassification(ctx, tx, userID, &ClassificationHistoryEntry{
+		EventID:      eventID,
+		ProjectID:    projectID,
+		Source:       source,
+		Confidence:   &confidence,
+		IsSkipped:    skip,
+		ClassifiedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
 	return s.GetByID(ctx, userID, eventID)
 }
 
@@ -536,7 +886,7 @@ func (s *CalendarEventStore) Classify(ctx context.Context, userID, eventID uuid.
 func (s *CalendarEventStore) SetSkipped(ctx context.Context, userID, eventID uuid.UUID, skip bool, source ClassificationSource) error {
 	now := time.Now().UTC()
 
-	result, err := s.pool.Exec(ctx, `
+	result, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendar_events
 		SET is_skipped = $3,
 		    classification_source = COALESCE(classification_source, $4),
@@ -557,10 +907,37 @@ func (s *CalendarEventStore) SetSkipped(ctx context.Context, userID, eventID uui
 
 // ClassifyByRule updates an event's classification from a rule or fingerprint.
 // Unlike Classify (which is for manual classification), this sets the specified source.
-func (s *CalendarEventStore) ClassifyByRule(ctx context.Context, userID, eventID uuid.UUID, projectID uuid.UUID, source ClassificationSource, confidence float64, needsReview bool) error {
+// A classification that changes anything is appended to the event's history
+// along with the rule votes behind it; re-applying the same result is not.
+func (s *CalendarEventStore) ClassifyByRule(ctx context.Context, userID, eventID uuid.UUID, projectID uuid.UUID, source ClassificationSource, confidence float64, needsReview bool, votes []ClassificationVote) error {
 	now := time.Now().UTC()
 
-	result, err := s.pool.Exec(ctx, `
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var unchanged, skipped bool
+	err = tx.QueryRow(ctx, `
+		SELECT classification_status = 'classified'
+		       AND project_id IS NOT DISTINCT FROM $3
+		       AND classification_source IS NOT DISTINCT FROM $4
+		       AND classification_confidence IS NOT DISTINCT FROM $5
+		       AND needs_review = $6,
+		       is_skipped
+		FROM calendar_events
+		WHERE id = $1 AND user_id = $2
+		FOR UPDATE
+	`, eventID, userID, projectID, source, confidence, needsReview).Scan(&unchanged, &skipped)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrCalendarEventNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
 		UPDATE calendar_events
 		SET classification_status = 'classified',
 		    classification_source = $3,
@@ -575,9 +952,19 @@ func (s *CalendarEventStore) ClassifyByRule(ctx context.Context, userID, eventID
 		return err
 	}
 
-	if result.RowsAffected() == 0 {
-		return ErrCalendarEventNotFound
+	if !unchanged {
+		if err := recordClassification(ctx, tx, userID, &ClassificationHistoryEntry{
+			EventID:      eventID,
+			ProjectID:    &projectID,
+			Source:       source,
+			Confidence:   &confidence,
+			IsSkipped:    skipped,
+			Votes:        votes,
+			ClassifiedAt: now,
+		}); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return tx.Commit(ctx)
 }
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// TryAcquireSyncLease takes the calendar's sync lease for holder unless
+// another holder has an unexpired lease. It reports whether the lease was
+// acquired.
+func (s *CalendarStore) TryAcquireSyncLease(ctx context.Context, calendarID uuid.UUID, holder string, ttl time.Duration) (bool, error) {
+	var acquired string
+	err := s.db(ctx).QueryRow(ctx, `
+		INSERT INTO calendar_sync_leases (calendar_id, holder, acquired_at, expires_at)
+		VALUES ($1, $2, NOW(), NOW() + make_interval(secs => $3))
+		ON CONFLICT (calendar_id) DO UPDATE SET
+			holder = EXCLUDED.holder,
+			acquired_at = EXCLUDED.acquired_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE calendar_sync_leases.expires_at < NOW()
+		RETURNING holder
+	`, calendarID, holder, ttl.Seconds()).Scan(&acquired)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReleaseSyncLease gives up the calendar's sync lease if holder still has it
+func (s *CalendarStore) ReleaseSyncLease(ctx context.Context, calendarID uuid.UUID, holder string) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM calendar_sync_leases
+		WHERE calendar_id = $1 AND holder = $2
+	`, calendarID, holder)
+	return err
+}
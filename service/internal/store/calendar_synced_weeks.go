@@ -0,0 +1,134 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CalendarGap is a calendar whose synced window has weeks that were never
+// fetched
+type CalendarGap struct {
+	CalendarID    uuid.UUID
+	UserID        uuid.UUID
+	Name          string
+	GapDetectedAt time.Time
+	MissingWeeks  int
+}
+
+// missingWeeksOf lists the Mondays inside calendar c's watermarks that have
+// no calendar_synced_weeks row
+const missingWeeksOf = `
+	SELECT w::date AS week_start
+	FROM generate_series(date_trunc('week', c.min_synced_date), c.max_synced_date, interval '1 week') AS w
+	WHERE NOT EXISTS (
+		SELECT 1 FROM calendar_synced_weeks sw
+		WHERE sw.calendar_id = c.id AND sw.week_start = w::date
+	)
+`
+
+// ListForGapCheck returns selected calendars with a synced window that can
+// still be synced
+func (s *CalendarStore) ListForGapCheck(ctx context.Context) ([]*Calendar, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, connection_id, user_id, external_id, name, color,
+		       is_primary, is_selected, sync_token, last_synced_at,
+		       min_synced_date, max_synced_date, sync_failure_count, needs_reauth,
+		       created_at, updated_at
+		FROM calendars
+		WHERE is_selected = true
+		  AND needs_reauth = false
+		  AND sync_disabled_at IS NULL
+		  AND min_synced_date IS NOT NULL
+		  AND max_synced_date IS NOT NULL
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var calendars []*Calendar
+	for rows.Next() {
+		cal := &Calendar{}
+		err := rows.Scan(
+			&cal.ID, &cal.ConnectionID, &cal.UserID, &cal.ExternalID, &cal.Name, &cal.Color,
+			&cal.IsPrimary, &cal.IsSelected, &cal.SyncToken, &cal.LastSyncedAt,
+			&cal.MinSyncedDate, &cal.MaxSyncedDate, &cal.SyncFailureCount, &cal.NeedsReauth,
+			&cal.CreatedAt, &cal.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, cal)
+	}
+
+	return calendars, rows.Err()
+}
+
+// MissingSyncedWeeks returns the week starts inside the calendar's synced
+// window that were never fetched, in order
+func (s *CalendarStore) MissingSyncedWeeks(ctx context.Context, calendarID uuid.UUID) ([]time.Time, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT m.week_start
+		FROM calendars c
+		CROSS JOIN LATERAL (`+missingWeeksOf+`) AS m
+		WHERE c.id = $1
+		ORDER BY m.week_start
+	`, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var weeks []time.Time
+	for rows.Next() {
+		var week time.Time
+		if err := rows.Scan(&week); err != nil {
+			return nil, err
+		}
+		weeks = append(weeks, week)
+	}
+
+	return weeks, rows.Err()
+}
+
+// SetGapDetected records whether the calendar currently has gaps. The
+// detection time is kept while the gap stays open so persistent gaps can be
+// told apart from ones a queued job is about to fill.
+func (s *CalendarStore) SetGapDetected(ctx context.Context, calendarID uuid.UUID, hasGaps bool) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendars
+		SET gap_detected_at = CASE WHEN $2 THEN COALESCE(gap_detected_at, NOW()) END
+		WHERE id = $1
+	`, calendarID, hasGaps)
+	return err
+}
+
+// ListPersistentGaps returns calendars whose gaps have stayed open for at
+// least the given duration, oldest first
+func (s *CalendarStore) ListPersistentGaps(ctx context.Context, openFor time.Duration) ([]*CalendarGap, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT c.id, c.user_id, c.name, c.gap_detected_at,
+		       (SELECT COUNT(*) FROM (`+missingWeeksOf+`) AS m)
+		FROM calendars c
+		WHERE c.gap_detected_at < $1
+		ORDER BY c.gap_detected_at ASC
+	`, time.Now().UTC().Add(-openFor))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var gaps []*CalendarGap
+	for rows.Next() {
+		g := &CalendarGap{}
+		if err := rows.Scan(&g.CalendarID, &g.UserID, &g.Name, &g.GapDetectedAt, &g.MissingWeeks); err != nil {
+			return nil, err
+		}
+		gaps = append(gaps, g)
+	}
+
+	return gaps, rows.Err()
+}
@@ -8,10 +8,28 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var ErrCalendarNotFound = errors.New("calendar not found")
 
+// SyncFailureCategory says whether retrying a failed sync can help
+type SyncFailureCategory string
+
+const (
+	// SyncFailureAuth needs the user to reconnect the calendar
+	SyncFailureAuth SyncFailureCategory = "auth"
+	// SyncFailurePermanent won't clear on retry, e.g. a deleted calendar
+	SyncFailurePermanent SyncFailureCategory = "permanent"
+	// SyncFailureTransient may clear on retry, e.g. a network error
+	SyncFailureTransient SyncFailureCategory = "transient"
+	// SyncFailureQuota clears once the API quota resets
+	SyncFailureQuota SyncFailureCategory = "quota"
+)
+
+// maxSyncErrorLength bounds the error message kept for a failed sync
+const maxSyncErrorLength = 500
+
 // Calendar represents a calendar within a connection (e.g., one of multiple Google calendars)
 type Calendar struct {
 	ID               uuid.UUID
@@ -28,8 +46,18 @@ type Calendar struct {
 	MaxSyncedDate    *time.Time // Latest date that has been fully synced (high water mark)
 	SyncFailureCount int        // Consecutive sync failures (stop retrying after 3)
 	NeedsReauth      bool       // True if OAuth token refresh failed
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	// Why the last sync failed; nil after a successful sync
+	SyncFailureCategory *SyncFailureCategory
+	SyncLastError       *string
+	SyncDisabledAt      *time.Time // Set by a permanent failure; sync skips the calendar
+	// User overrides; nil keeps what Google reports
+	DisplayName      *string
+	DisplayColor     *string
+	DefaultProjectID *uuid.UUID // Classification vote for the calendar's events
+	// Weight of that vote; nil makes it a low-weight hint that needs review
+	DefaultProjectWeight *float64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
 }
 
 // CalendarStore provides PostgreSQL-backed calendar storage
@@ -42,12 +70,17 @@ func NewCalendarStore(pool *pgxpool.Pool) *CalendarStore {
 	return &CalendarStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *CalendarStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // Upsert creates or updates a calendar by external_id
 func (s *CalendarStore) Upsert(ctx context.Context, cal *Calendar) (*Calendar, error) {
 	now := time.Now().UTC()
 	newID := uuid.New()
 
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		INSERT INTO calendars (
 			id, connection_id, user_id, external_id, name, color,
 			is_primary, is_selected, sync_token, last_synced_at, created_at, updated_at
@@ -72,10 +105,12 @@ func (s *CalendarStore) Upsert(ctx context.Context, cal *Calendar) (*Calendar, e
 
 // ListByConnection returns all calendars for a connection
 func (s *CalendarStore) ListByConnection(ctx context.Context, connectionID uuid.UUID) ([]*Calendar, error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT id, connection_id, user_id, external_id, name, color,
 		       is_primary, is_selected, sync_token, last_synced_at,
 		       min_synced_date, max_synced_date, sync_failure_count, needs_reauth,
+		       sync_failure_category, sync_last_error, sync_disabled_at,
+		       display_name, display_color, default_project_id, default_project_weight,
 		       created_at, updated_at
 		FROM calendars
 		WHERE connection_id = $1
@@ -93,6 +128,8 @@ func (s *CalendarStore) ListByConnection(ctx context.Context, connectionID uuid.
 			&cal.ID, &cal.ConnectionID, &cal.UserID, &cal.ExternalID, &cal.Name, &cal.Color,
 			&cal.IsPrimary, &cal.IsSelected, &cal.SyncToken, &cal.LastSyncedAt,
 			&cal.MinSyncedDate, &cal.MaxSyncedDate, &cal.SyncFailureCount, &cal.NeedsReauth,
+			&cal.SyncFailureCategory, &cal.SyncLastError, &cal.SyncDisabledAt,
+			&cal.DisplayName, &cal.DisplayColor, &cal.DefaultProjectID, &cal.DefaultProjectWeight,
 			&cal.CreatedAt, &cal.UpdatedAt,
 		)
 		if err != nil {
@@ -106,10 +143,12 @@ func (s *CalendarStore) ListByConnection(ctx context.Context, connectionID uuid.
 
 // ListSelectedByConnection returns only selected calendars for a connection
 func (s *CalendarStore) ListSelectedByConnection(ctx context.Context, connectionID uuid.UUID) ([]*Calendar, error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT id, connection_id, user_id, external_id, name, color,
 		       is_primary, is_selected, sync_token, last_synced_at,
 		       min_synced_date, max_synced_date, sync_failure_count, needs_reauth,
+		       sync_failure_category, sync_last_error, sync_disabled_at,
+		       display_name, display_color, default_project_id, default_project_weight,
 		       created_at, updated_at
 		FROM calendars
 		WHERE connection_id = $1 AND is_selected = true
@@ -127,6 +166,8 @@ func (s *CalendarStore) ListSelectedByConnection(ctx context.Context, connection
 			&cal.ID, &cal.ConnectionID, &cal.UserID, &cal.ExternalID, &cal.Name, &cal.Color,
 			&cal.IsPrimary, &cal.IsSelected, &cal.SyncToken, &cal.LastSyncedAt,
 			&cal.MinSyncedDate, &cal.MaxSyncedDate, &cal.SyncFailureCount, &cal.NeedsReauth,
+			&cal.SyncFailureCategory, &cal.SyncLastError, &cal.SyncDisabledAt,
+			&cal.DisplayName, &cal.DisplayColor, &cal.DefaultProjectID, &cal.DefaultProjectWeight,
 			&cal.CreatedAt, &cal.UpdatedAt,
 		)
 		if err != nil {
@@ -141,10 +182,12 @@ func (s *CalendarStore) ListSelectedByConnection(ctx context.Context, connection
 // GetByID retrieves a calendar by ID
 func (s *CalendarStore) GetByID(ctx context.Context, calendarID uuid.UUID) (*Calendar, error) {
 	cal := &Calendar{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, connection_id, user_id, external_id, name, color,
 		       is_primary, is_selected, sync_token, last_synced_at,
 		       min_synced_date, max_synced_date, sync_failure_count, needs_reauth,
+		       sync_failure_category, sync_last_error, sync_disabled_at,
+		       display_name, display_color, default_project_id, default_project_weight,
 		       created_at, updated_at
 		FROM calendars
 		WHERE id = $1
@@ -152,6 +195,8 @@ func (s *CalendarStore) GetByID(ctx context.Context, calendarID uuid.UUID) (*Cal
 		&cal.ID, &cal.ConnectionID, &cal.UserID, &cal.ExternalID, &cal.Name, &cal.Color,
 		&cal.IsPrimary, &cal.IsSelected, &cal.SyncToken, &cal.LastSyncedAt,
 		&cal.MinSyncedDate, &cal.MaxSyncedDate, &cal.SyncFailureCount, &cal.NeedsReauth,
+		&cal.SyncFailureCategory, &cal.SyncLastError, &cal.SyncDisabledAt,
+		&cal.DisplayName, &cal.DisplayColor, &cal.DefaultProjectID, &cal.DefaultProjectWeight,
 		&cal.CreatedAt, &cal.UpdatedAt,
 	)
 
@@ -165,25 +210,46 @@ func (s *CalendarStore) GetByID(ctx context.Context, calendarID uuid.UUID) (*Cal
 	return cal, nil
 }
 
+// UpdateOverrides replaces the user's display name, color and default
+// project mapping for a calendar. Nil values clear the override.
+func (s *CalendarStore) UpdateOverrides(ctx context.Context, userID, calendarID uuid.UUID, displayName, displayColor *string, defaultProjectID *uuid.UUID, defaultProjectWeight *float64) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendars
+		SET display_name = $3, display_color = $4, default_project_id = $5, default_project_weight = $6,
+		    updated_at = $7
+		WHERE id = $1 AND user_id = $2
+	`, calendarID, userID, displayName, displayColor, defaultProjectID, defaultProjectWeight, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrCalendarNotFound
+	}
+	return nil
+}
+
 // UpdateSelection updates the is_selected status for multiple calendars
 func (s *CalendarStore) UpdateSelection(ctx context.Context, connectionID uuid.UUID, selectedIDs []uuid.UUID) error {
 	now := time.Now().UTC()
 
-	// First, deselect all calendars for this connection
-	_, err := s.pool.Exec(ctx, `
+	// First, deselect the calendars no longer selected
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
-		SET is_selected = false, updated_at = $2
-		WHERE connection_id = $1
-	`, connectionID, now)
+		SET is_selected = false, updated_at = $3
+		WHERE connection_id = $1 AND id <> ALL(COALESCE($2::uuid[], '{}'))
+	`, connectionID, selectedIDs, now)
 	if err != nil {
 		return err
 	}
 
-	// Then select the specified ones
+	// Then select the specified ones. Reselecting a calendar disabled by a
+	// permanent failure retries it.
 	if len(selectedIDs) > 0 {
-		_, err = s.pool.Exec(ctx, `
+		_, err = s.db(ctx).Exec(ctx, `
 			UPDATE calendars
-			SET is_selected = true, updated_at = $3
+			SET is_selected = true,
+			    sync_disabled_at = CASE WHEN is_selected THEN sync_disabled_at END,
+			    updated_at = $3
 			WHERE connection_id = $1 AND id = ANY($2)
 		`, connectionID, selectedIDs, now)
 		if err != nil {
@@ -196,7 +262,7 @@ func (s *CalendarStore) UpdateSelection(ctx context.Context, connectionID uuid.U
 
 // UpdateSyncToken updates the sync token for a calendar
 func (s *CalendarStore) UpdateSyncToken(ctx context.Context, calendarID uuid.UUID, token string) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
 		SET sync_token = $2, updated_at = $3
 		WHERE id = $1
@@ -206,7 +272,7 @@ func (s *CalendarStore) UpdateSyncToken(ctx context.Context, calendarID uuid.UUI
 
 // ClearSyncToken clears the sync token (forces full re-sync)
 func (s *CalendarStore) ClearSyncToken(ctx context.Context, calendarID uuid.UUID) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
 		SET sync_token = NULL, updated_at = $2
 		WHERE id = $1
@@ -217,7 +283,7 @@ func (s *CalendarStore) ClearSyncToken(ctx context.Context, calendarID uuid.UUID
 // UpdateLastSynced updates the last_synced_at timestamp
 func (s *CalendarStore) UpdateLastSynced(ctx context.Context, calendarID uuid.UUID) error {
 	now := time.Now().UTC()
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
 		SET last_synced_at = $2, updated_at = $2
 		WHERE id = $1
@@ -226,10 +292,17 @@ func (s *CalendarStore) UpdateLastSynced(ctx context.Context, calendarID uuid.UU
 }
 
 // ExpandSyncedWindow expands the min/max synced date window for a calendar
-// The window is expanded to include the new min/max dates, never shrunk
+// The window is expanded to include the new min/max dates, never shrunk.
+// The weeks in the range are recorded as synced for gap detection.
 func (s *CalendarStore) ExpandSyncedWindow(ctx context.Context, calendarID uuid.UUID, minDate, maxDate time.Time) error {
 	now := time.Now().UTC()
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
+		WITH synced AS (
+			INSERT INTO calendar_synced_weeks (calendar_id, week_start, synced_at)
+			SELECT $1, w::date, $4
+			FROM generate_series(date_trunc('week', $2::date), $3::date, interval '1 week') AS w
+			ON CONFLICT (calendar_id, week_start) DO UPDATE SET synced_at = EXCLUDED.synced_at
+		)
 		UPDATE calendars
 		SET
 			min_synced_date = CASE
@@ -250,27 +323,40 @@ func (s *CalendarStore) ExpandSyncedWindow(ctx context.Context, calendarID uuid.
 
 // DeleteByConnection deletes all calendars for a connection
 func (s *CalendarStore) DeleteByConnection(ctx context.Context, connectionID uuid.UUID) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		DELETE FROM calendars WHERE connection_id = $1
 	`, connectionID)
 	return err
 }
 
-// IncrementSyncFailureCount increments the sync failure counter
-func (s *CalendarStore) IncrementSyncFailureCount(ctx context.Context, calendarID uuid.UUID) error {
-	_, err := s.pool.Exec(ctx, `
+// RecordSyncFailure records why a sync failed. Auth failures mark the
+// calendar as needing re-authentication and permanent ones disable its sync.
+// Quota failures don't count toward the consecutive failure limit, since
+// they say nothing about the calendar.
+func (s *CalendarStore) RecordSyncFailure(ctx context.Context, calendarID uuid.UUID, category SyncFailureCategory, message string) error {
+	if len(message) > maxSyncErrorLength {
+		message = message[:maxSyncErrorLength]
+	}
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
-		SET sync_failure_count = sync_failure_count + 1, updated_at = $2
+		SET sync_failure_count = sync_failure_count + CASE WHEN $2 = 'quota' THEN 0 ELSE 1 END,
+		    sync_failure_category = $2,
+		    sync_last_error = $3,
+		    needs_reauth = needs_reauth OR $2 = 'auth',
+		    sync_disabled_at = CASE WHEN $2 = 'permanent' THEN COALESCE(sync_disabled_at, $4) ELSE sync_disabled_at END,
+		    updated_at = $4
 		WHERE id = $1
-	`, calendarID, time.Now().UTC())
+	`, calendarID, string(category), message, time.Now().UTC())
 	return err
 }
 
-// ResetSyncFailureCount resets the sync failure counter to zero
+// ResetSyncFailureCount resets the sync failure counter to zero and clears
+// the last failure, re-enabling a calendar disabled by a permanent failure
 func (s *CalendarStore) ResetSyncFailureCount(ctx context.Context, calendarID uuid.UUID) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
-		SET sync_failure_count = 0, updated_at = $2
+		SET sync_failure_count = 0, sync_failure_category = NULL, sync_last_error = NULL,
+		    sync_disabled_at = NULL, updated_at = $2
 		WHERE id = $1
 	`, calendarID, time.Now().UTC())
 	return err
@@ -278,7 +364,7 @@ func (s *CalendarStore) ResetSyncFailureCount(ctx context.Context, calendarID uu
 
 // MarkNeedsReauth marks a calendar as needing re-authentication
 func (s *CalendarStore) MarkNeedsReauth(ctx context.Context, calendarID uuid.UUID) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
 		SET needs_reauth = true, updated_at = $2
 		WHERE id = $1
@@ -288,34 +374,92 @@ func (s *CalendarStore) MarkNeedsReauth(ctx context.Context, calendarID uuid.UUI
 
 // ClearNeedsReauth clears the needs_reauth flag (after successful re-auth)
 func (s *CalendarStore) ClearNeedsReauth(ctx context.Context, calendarID uuid.UUID) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendars
-		SET needs_reauth = false, sync_failure_count = 0, updated_at = $2
+		SET needs_reauth = false, sync_failure_count = 0,
+		    sync_failure_category = NULL, sync_last_error = NULL, updated_at = $2
 		WHERE id = $1
 	`, calendarID, time.Now().UTC())
 	return err
 }
 
+// ClearConnectionNeedsReauth clears the needs_reauth flag of every calendar
+// in a connection once the user has consented again
+func (s *CalendarStore) ClearConnectionNeedsReauth(ctx context.Context, connectionID uuid.UUID) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendars
+		SET needs_reauth = false, sync_failure_count = 0,
+		    sync_failure_category = NULL, sync_last_error = NULL, updated_at = $2
+		WHERE connection_id = $1 AND needs_reauth
+	`, connectionID, time.Now().UTC())
+	return err
+}
+
+// ListNeedingReauth returns the user's selected calendars that need
+// re-authentication, grouped by connection
+func (s *CalendarStore) ListNeedingReauth(ctx context.Context, userID uuid.UUID) ([]*Calendar, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, connection_id, user_id, external_id, name, color,
+		       is_primary, is_selected, sync_token, last_synced_at,
+		       min_synced_date, max_synced_date, sync_failure_count, needs_reauth,
+		       sync_failure_category, sync_last_error, sync_disabled_at,
+		       display_name, display_color, default_project_id, default_project_weight,
+		       created_at, updated_at
+		FROM calendars
+		WHERE user_id = $1 AND is_selected AND needs_reauth
+		ORDER BY connection_id, is_primary DESC, name ASC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var calendars []*Calendar
+	for rows.Next() {
+		cal := &Calendar{}
+		err := rows.Scan(
+			&cal.ID, &cal.ConnectionID, &cal.UserID, &cal.ExternalID, &cal.Name, &cal.Color,
+			&cal.IsPrimary, &cal.IsSelected, &cal.SyncToken, &cal.LastSyncedAt,
+			&cal.MinSyncedDate, &cal.MaxSyncedDate, &cal.SyncFailureCount, &cal.NeedsReauth,
+			&cal.SyncFailureCategory, &cal.SyncLastError, &cal.SyncDisabledAt,
+			&cal.DisplayName, &cal.DisplayColor, &cal.DefaultProjectID, &cal.DefaultProjectWeight,
+			&cal.CreatedAt, &cal.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, cal)
+	}
+
+	return calendars, rows.Err()
+}
+
 // ListNeedingSync returns calendars that need background sync
 // These are calendars that:
-// - Haven't synced within the staleness threshold
+// - Haven't synced within their user's sync interval, or defaultInterval
 // - Don't need re-auth
 // - Haven't failed too many times (< 3 consecutive failures)
-func (s *CalendarStore) ListNeedingSync(ctx context.Context, stalenessThreshold time.Duration) ([]*Calendar, error) {
-	cutoff := time.Now().Add(-stalenessThreshold)
-
-	rows, err := s.pool.Query(ctx, `
+// - Weren't disabled by a permanent failure
+// - Aren't focus session calendars, which have nothing to sync
+// - Aren't in a paused connection
+func (s *CalendarStore) ListNeedingSync(ctx context.Context, defaultInterval time.Duration) ([]*Calendar, error) {
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT id, connection_id, user_id, external_id, name, color,
 		       is_primary, is_selected, sync_token, last_synced_at,
 		       min_synced_date, max_synced_date, sync_failure_count, needs_reauth,
+		       sync_failure_category, sync_last_error, sync_disabled_at,
+		       display_name, display_color, default_project_id, default_project_weight,
 		       created_at, updated_at
 		FROM calendars
 		WHERE is_selected = true
 		  AND needs_reauth = false
 		  AND sync_failure_count < 3
-		  AND (last_synced_at IS NULL OR last_synced_at < $1)
+		  AND sync_disabled_at IS NULL
+		  AND (last_synced_at IS NULL OR last_synced_at < NOW() - make_interval(mins => COALESCE(
+		    (SELECT u.sync_interval_minutes FROM users u WHERE u.id = calendars.user_id), $1)))
+		  AND connection_id NOT IN (SELECT id FROM calendar_connections WHERE provider = $2 OR paused_at IS NOT NULL)
 		ORDER BY last_synced_at ASC NULLS FIRST
-	`, cutoff)
+	`, int(defaultInterval/time.Minute), ProviderFocus)
 	if err != nil {
 		return nil, err
 	}
@@ -328,6 +472,8 @@ func (s *CalendarStore) ListNeedingSync(ctx context.Context, stalenessThreshold
 			&cal.ID, &cal.ConnectionID, &cal.UserID, &cal.ExternalID, &cal.Name, &cal.Color,
 			&cal.IsPrimary, &cal.IsSelected, &cal.SyncToken, &cal.LastSyncedAt,
 			&cal.MinSyncedDate, &cal.MaxSyncedDate, &cal.SyncFailureCount, &cal.NeedsReauth,
+			&cal.SyncFailureCategory, &cal.SyncLastError, &cal.SyncDisabledAt,
+			&cal.DisplayName, &cal.DisplayColor, &cal.DefaultProjectID, &cal.DefaultProjectWeight,
 			&cal.CreatedAt, &cal.UpdatedAt,
 		)
 		if err != nil {
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrInvalidChangeCursor = errors.New("invalid change cursor")
+
+// Change feed resource types, as written by the record_change_feed trigger
+const (
+	ChangeResourceCalendarEvent = "calendar_event"
+	ChangeResourceTimeEntry     = "time_entry"
+	ChangeResourceInvoice       = "invoice"
+)
+
+// ChangeFeedEntry is one recorded change to a user's resource
+type ChangeFeedEntry struct {
+	Cursor       ChangeCursor
+	ResourceType string
+	ResourceID   uuid.UUID
+	Operation    string
+	ChangedAt    time.Time
+}
+
+// ChangeCursor is a position in the change feed. Entries are ordered by the
+// writing transaction first, so the zero cursor is the start of the feed.
+type ChangeCursor struct {
+	XID int64
+	Seq int64
+}
+
+// String encodes the cursor for clients
+func (c ChangeCursor) String() string {
+	return fmt.Sprintf("%d.%d", c.XID, c.Seq)
+}
+
+// ParseChangeCursor decodes a cursor produced by ChangeCursor.String
+func ParseChangeCursor(s string) (ChangeCursor, error) {
+	xid, seq, ok := strings.Cut(s, ".")
+	if !ok {
+		return ChangeCursor{}, ErrInvalidChangeCursor
+	}
+	var c ChangeCursor
+	var err error
+	if c.XID, err = strconv.ParseInt(xid, 10, 64); err != nil || c.XID < 0 {
+		return ChangeCursor{}, ErrInvalidChangeCursor
+	}
+	if c.Seq, err = strconv.ParseInt(seq, 10, 64); err != nil || c.Seq < 0 {
+		return ChangeCursor{}, ErrInvalidChangeCursor
+	}
+	return c, nil
+}
+
+// ChangeFeedStore reads the change feed written by database triggers
+type ChangeFeedStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewChangeFeedStore creates a new change feed store
+func NewChangeFeedStore(pool *pgxpool.Pool) *ChangeFeedStore {
+	return &ChangeFeedStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ChangeFeedStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// List returns up to limit changes after the cursor, oldest first, and
+// whether more are available. An empty resourceType returns every type.
+//
+// Only changes from transactions older than every transaction still running
+// are returned: their rows can no longer appear behind the cursor. A long
+// transaction therefore delays the feed but never makes it skip a change.
+func (s *ChangeFeedStore) List(ctx context.Context, userID uuid.UUID, since ChangeCursor, resourceType string, limit int) ([]*ChangeFeedEntry, bool, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT xid::text::bigint, seq, resource_type, resource_id, operation, changed_at
+		FROM change_feed
+		WHERE user_id = $1
+		  AND xid < pg_snapshot_xmin(pg_current_snapshot())
+		  AND (xid, seq) > ($2::text::xid8, $3)
+		  AND ($4 = '' OR resource_type = $4)
+		ORDER BY xid, seq
+		LIMIT $5
+	`, userID, strconv.FormatInt(since.XID, 10), since.Seq, resourceType, limit+1)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	var entries []*ChangeFeedEntry
+	for rows.Next() {
+		e := &ChangeFeedEntry{}
+		if err := rows.Scan(
+			&e.Cursor.XID, &e.Cursor.Seq, &e.ResourceType, &e.ResourceID, &e.Operation, &e.ChangedAt,
+		); err != nil {
+			return nil, false, err
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(entries) > limit
+	if hasMore {
+		entries = entries[:limit]
+	}
+	return entries, hasMore, nil
+}
+
+// DeleteOlderThan prunes changes recorded before the cutoff
+func (s *ChangeFeedStore) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM change_feed WHERE changed_at < $1",
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
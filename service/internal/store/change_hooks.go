@@ -0,0 +1,36 @@
+package store
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+// ChangeHook is called with the owning user after a store writes their data,
+// once the write commits. Hooks run synchronously on the writer's goroutine
+// and must not block.
+type ChangeHook func(userID uuid.UUID)
+
+// changeHooks holds the hooks registered on a store
+type changeHooks struct {
+	mu    sync.RWMutex
+	hooks []ChangeHook
+}
+
+func (h *changeHooks) add(fn ChangeHook) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hooks = append(h.hooks, fn)
+}
+
+func (h *changeHooks) notify(ctx context.Context, userID uuid.UUID) {
+	database.AfterCommit(ctx, func() {
+		h.mu.RLock()
+		defer h.mu.RUnlock()
+		for _, fn := range h.hooks {
+			fn(userID)
+		}
+	})
+}
@@ -0,0 +1,280 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var (
+	ErrClassificationActionNotFound = errors.New("classification action not found")
+	ErrActionAlreadyUndone          = errors.New("action has already been undone")
+	ErrActionSuperseded             = errors.New("events in this action were changed by a later action; undo that first")
+)
+
+// Classification action kinds
+const (
+	ActionKindClassify     = "classify"
+	ActionKindBulkClassify = "bulk_classify"
+	ActionKindApplyRules   = "apply_rules"
+)
+
+// EventClassificationState is the classification of one event at a point in time
+type EventClassificationState struct {
+	EventID     uuid.UUID
+	ProjectID   *uuid.UUID
+	Status      ClassificationStatus
+	Source      *ClassificationSource
+	Confidence  *float64
+	NeedsReview bool
+	IsSkipped   bool
+}
+
+// ClassificationStateOf captures an event's current classification
+func ClassificationStateOf(e *CalendarEvent) EventClassificationState {
+	return EventClassificationState{
+		EventID:     e.ID,
+		ProjectID:   e.ProjectID,
+		Status:      e.ClassificationStatus,
+		Source:      e.ClassificationSource,
+		Confidence:  e.ClassificationConfidence,
+		NeedsReview: e.NeedsReview,
+		IsSkipped:   e.IsSkipped,
+	}
+}
+
+// ClassificationAction is a journaled classification change
+type ClassificationAction struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Kind        string
+	Description string
+	EventCount  int
+	CreatedAt   time.Time
+	UndoneAt    *time.Time
+}
+
+// ClassificationActionStore provides PostgreSQL-backed storage for the
+// classification action journal
+type ClassificationActionStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewClassificationActionStore creates a new classification action store
+func NewClassificationActionStore(pool *pgxpool.Pool) *ClassificationActionStore {
+	return &ClassificationActionStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ClassificationActionStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Record journals an action along with the state of each affected event
+// before the change. Returns nil without recording when no events changed.
+func (s *ClassificationActionStore) Record(ctx context.Context, userID uuid.UUID, kind, description string, prior []EventClassificationState) (*ClassificationAction, error) {
+	if len(prior) == 0 {
+		return nil, nil
+	}
+
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	action := &ClassificationAction{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Kind:        kind,
+		Description: description,
+		EventCount:  len(prior),
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO classification_actions (id, user_id, kind, description, event_count, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, action.ID, action.UserID, action.Kind, action.Description, action.EventCount, action.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &pgx.Batch{}
+	for _, st := range prior {
+		batch.Queue(`
+			INSERT INTO classification_action_events (
+				action_id, event_id, prev_project_id, prev_status, prev_source,
+				prev_confidence, prev_needs_review, prev_skipped
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`, action.ID, st.EventID, st.ProjectID, st.Status, st.Source,
+			st.Confidence, st.NeedsReview, st.IsSkipped)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// GetByID retrieves an action
+func (s *ClassificationActionStore) GetByID(ctx context.Context, userID, actionID uuid.UUID) (*ClassificationAction, error) {
+	a := &ClassificationAction{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, user_id, kind, description, event_count, created_at, undone_at
+		FROM classification_actions
+		WHERE id = $1 AND user_id = $2
+	`, actionID, userID).Scan(
+		&a.ID, &a.UserID, &a.Kind, &a.Description, &a.EventCount, &a.CreatedAt, &a.UndoneAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClassificationActionNotFound
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+// List returns a user's most recent actions, newest first
+func (s *ClassificationActionStore) List(ctx context.Context, userID uuid.UUID, limit int) ([]*ClassificationAction, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, user_id, kind, description, event_count, created_at, undone_at
+		FROM classification_actions
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var actions []*ClassificationAction
+	for rows.Next() {
+		a := &ClassificationAction{}
+		if err := rows.Scan(
+			&a.ID, &a.UserID, &a.Kind, &a.Description, &a.EventCount, &a.CreatedAt, &a.UndoneAt,
+		); err != nil {
+			return nil, err
+		}
+		actions = append(actions, a)
+	}
+	return actions, rows.Err()
+}
+
+// Undo restores each event's classification from before the action and
+// marks the action undone. Actions whose events were since changed by a later
+// action that hasn't been undone are rejected, so undo always unwinds in
+// order. Returns the start times of the restored events.
+func (s *ClassificationActionStore) Undo(ctx context.Context, userID, actionID uuid.UUID) (*ClassificationAction, []time.Time, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	a := &ClassificationAction{}
+	err = tx.QueryRow(ctx, `
+		SELECT id, user_id, kind, description, event_count, created_at, undone_at
+		FROM classification_actions
+		WHERE id = $1 AND user_id = $2
+		FOR UPDATE
+	`, actionID, userID).Scan(
+		&a.ID, &a.UserID, &a.Kind, &a.Description, &a.EventCount, &a.CreatedAt, &a.UndoneAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrClassificationActionNotFound
+		}
+		return nil, nil, err
+	}
+	if a.UndoneAt != nil {
+		return nil, nil, ErrActionAlreadyUndone
+	}
+
+	var superseded bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1
+			FROM classification_action_events mine
+			JOIN classification_action_events later ON later.event_id = mine.event_id
+			JOIN classification_actions la ON la.id = later.action_id
+			WHERE mine.action_id = $1
+			  AND la.user_id = $2
+			  AND la.id != $1
+			  AND la.created_at > $3
+			  AND la.undone_at IS NULL
+		)
+	`, a.ID, userID, a.CreatedAt).Scan(&superseded)
+	if err != nil {
+		return nil, nil, err
+	}
+	if superseded {
+		return nil, nil, ErrActionSuperseded
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE calendar_events ce
+		SET project_id = cae.prev_project_id,
+		    classification_status = cae.prev_status,
+		    classification_source = cae.prev_source,
+		    classification_confidence = cae.prev_confidence,
+		    needs_review = cae.prev_needs_review,
+		    is_skipped = cae.prev_skipped,
+		    updated_at = NOW()
+		FROM classification_action_events cae
+		WHERE cae.action_id = $1 AND ce.id = cae.event_id AND ce.user_id = $2
+		RETURNING ce.start_time
+	`, a.ID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var starts []time.Time
+	for rows.Next() {
+		var t time.Time
+		if err := rows.Scan(&t); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		starts = append(starts, t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now().UTC()
+	if _, err := tx.Exec(ctx,
+		"UPDATE classification_actions SET undone_at = $2 WHERE id = $1",
+		a.ID, now,
+	); err != nil {
+		return nil, nil, err
+	}
+	a.UndoneAt = &now
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+	return a, starts, nil
+}
+
+// DeleteOlderThan prunes journal entries created before the cutoff
+func (s *ClassificationActionStore) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM classification_actions WHERE created_at < $1",
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
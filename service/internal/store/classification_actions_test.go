@@ -0,0 +1,174 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestClassificationActionUndo checks that undo restores each event's prior
+// classification, only in reverse order, and only once
+func TestClassificationActionUndo(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "actions-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	projectStore := store.NewProjectStore(db.Pool)
+	alpha, err := projectStore.Create(ctx, user.ID, "Alpha", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	bravo, err := projectStore.Create(ctx, user.ID, "Bravo", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	eventStore := store.NewCalendarEventStore(db.Pool)
+	actions := store.NewClassificationActionStore(db.Pool)
+	event := createTestEvent(t, db.Pool, user.ID, "Planning", time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC))
+
+	if a, err := actions.Record(ctx, user.ID, store.ActionKindClassify, "Nothing", nil); a != nil || err != nil {
+		t.Errorf("expected no action without changed events, got %v, %v", a, err)
+	}
+
+	// classify moves the event and journals where it was
+	classify := func(projectID uuid.UUID, description string) *store.ClassificationAction {
+		t.Helper()
+		before, err := eventStore.GetByID(ctx, user.ID, event.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if _, err := eventStore.Classify(ctx, user.ID, event.ID, &projectID, false); err != nil {
+			t.Fatalf("Classify: %v", err)
+		}
+		a, err := actions.Record(ctx, user.ID, store.ActionKindClassify, description,
+			[]store.EventClassificationState{store.ClassificationStateOf(before)})
+		if err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+		return a
+	}
+	expectProject := func(t *testing.T, want *uuid.UUID, status store.ClassificationStatus) {
+		t.Helper()
+		got, err := eventStore.GetByID(ctx, user.ID, event.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if (got.ProjectID == nil) != (want == nil) || (want != nil && *got.ProjectID != *want) || got.ClassificationStatus != status {
+			t.Errorf("expected project %v (%s), got %v (%s)", want, status, got.ProjectID, got.ClassificationStatus)
+		}
+	}
+
+	first := classify(alpha.ID, "Classify to Alpha")
+	second := classify(bravo.ID, "Classify to Bravo")
+	if first.EventCount != 1 || first.Kind != store.ActionKindClassify {
+		t.Errorf("unexpected action %+v", first)
+	}
+
+	if _, _, err := actions.Undo(ctx, user.ID, first.ID); !errors.Is(err, store.ErrActionSuperseded) {
+		t.Errorf("expected undoing out of order to be refused, got %v", err)
+	}
+	if _, _, err := actions.Undo(ctx, uuid.New(), second.ID); !errors.Is(err, store.ErrClassificationActionNotFound) {
+		t.Errorf("expected another user's undo to be not found, got %v", err)
+	}
+
+	undone, starts, err := actions.Undo(ctx, user.ID, second.ID)
+	if err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if undone.UndoneAt == nil || len(starts) != 1 || !starts[0].Equal(event.StartTime) {
+		t.Errorf("expected the action undone with the event's start, got %v and %v", undone.UndoneAt, starts)
+	}
+	expectProject(t, &alpha.ID, store.StatusClassified)
+	if _, _, err := actions.Undo(ctx, user.ID, second.ID); !errors.Is(err, store.ErrActionAlreadyUndone) {
+		t.Errorf("expected undoing twice to be refused, got %v", err)
+	}
+
+	// With the later action undone, the earlier one can go too
+	if _, _, err := actions.Undo(ctx, user.ID, first.ID); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	expectProject(t, nil, store.StatusPending)
+
+	list, err := actions.List(ctx, user.ID, 10)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != second.ID || list[1].UndoneAt == nil {
+		t.Errorf("expected both actions newest first and undone, got %v", list)
+	}
+
+	if _, err := actions.DeleteOlderThan(ctx, time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("DeleteOlderThan: %v", err)
+	}
+	if _, err := actions.GetByID(ctx, user.ID, first.ID); !errors.Is(err, store.ErrClassificationActionNotFound) {
+		t.Errorf("expected pruned actions to be gone, got %v", err)
+	}
+}
+
+// createTestEvent adds a pending half-hour event on a selected calendar of
+// a new Google connection
+func createTestEvent(t *testing.T, pool *pgxpool.Pool, userID uuid.UUID, title string, start time.Time) *store.CalendarEvent {
+	t.Helper()
+	ctx := context.Background()
+
+	conn, err := store.NewCalendarConnectionStore(pool, testEncryption(t)).Create(ctx, userID, "google", store.OAuthCredentials{
+		AccessToken: "test-token",
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create calendar connection: %v", err)
+	}
+	calendar, err := store.NewCalendarStore(pool).Upsert(ctx, &store.Calendar{
+		ConnectionID: conn.ID,
+		UserID:       userID,
+		ExternalID:   "primary-" + uuid.NewString()[:8],
+		Name:         "Work",
+		IsSelected:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create calendar: %v", err)
+	}
+	event, err := store.NewCalendarEventStore(pool).Upsert(ctx, &store.CalendarEvent{
+		ConnectionID:         conn.ID,
+		CalendarID:           &calendar.ID,
+		UserID:               userID,
+		ExternalID:           "event-" + uuid.NewString()[:8],
+		Title:                title,
+		StartTime:            start,
+		EndTime:              start.Add(30 * time.Minute),
+		ClassificationStatus: store.StatusPending,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create calendar event: %v", err)
+	}
+	return event
+}
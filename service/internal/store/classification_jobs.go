@@ -0,0 +1,156 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrClassificationJobNotFound = errors.New("classification job not found")
+
+// ClassificationJobStatus defines the status of a classification job
+type ClassificationJobStatus string
+
+const (
+	ClassificationJobPending   ClassificationJobStatus = "pending"
+	ClassificationJobRunning   ClassificationJobStatus = "running"
+	ClassificationJobCompleted ClassificationJobStatus = "completed"
+	ClassificationJobFailed    ClassificationJobStatus = "failed"
+)
+
+// ClassificationJob is a background apply-rules run
+type ClassificationJob struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	Status           ClassificationJobStatus
+	StartDate        *time.Time
+	EndDate          *time.Time
+	DryRun           bool
+	BatchSize        int
+	RuleID           *uuid.UUID // Set when the job backfills a new rule
+	TotalEvents      int
+	ProcessedEvents  int
+	MatchedCount     int
+	ClassifiedCount  int
+	SkipAppliedCount int
+	SkippedCount     int
+	ChangedCount     int
+	ActionID         *uuid.UUID
+	ErrorMessage     *string
+	CreatedAt        time.Time
+	StartedAt        *time.Time
+	CompletedAt      *time.Time
+}
+
+// ClassificationJobProgress is the running tally recorded after each batch
+type ClassificationJobProgress struct {
+	TotalEvents      int
+	ProcessedEvents  int
+	MatchedCount     int
+	ClassifiedCount  int
+	SkipAppliedCount int
+	SkippedCount     int
+	ChangedCount     int
+}
+
+// ClassificationJobStore provides PostgreSQL-backed classification job storage
+type ClassificationJobStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewClassificationJobStore creates a new store
+func NewClassificationJobStore(pool *pgxpool.Pool) *ClassificationJobStore {
+	return &ClassificationJobStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ClassificationJobStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+const classificationJobColumns = `id, user_id, status, start_date, end_date, dry_run, batch_size, rule_id,
+	total_events, processed_events, matched_count, classified_count, skip_applied_count, skipped_count, changed_count,
+	action_id, error_message, created_at, started_at, completed_at`
+
+func scanClassificationJob(row pgx.Row) (*ClassificationJob, error) {
+	job := &ClassificationJob{}
+	err := row.Scan(
+		&job.ID, &job.UserID, &job.Status, &job.StartDate, &job.EndDate, &job.DryRun, &job.BatchSize, &job.RuleID,
+		&job.TotalEvents, &job.ProcessedEvents, &job.MatchedCount, &job.ClassifiedCount, &job.SkipAppliedCount, &job.SkippedCount, &job.ChangedCount,
+		&job.ActionID, &job.ErrorMessage, &job.CreatedAt, &job.StartedAt, &job.CompletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClassificationJobNotFound
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// Create records a new pending job. ruleID is set for a job backfilling
+// a newly created rule.
+func (s *ClassificationJobStore) Create(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, dryRun bool, batchSize int, ruleID *uuid.UUID) (*ClassificationJob, error) {
+	return scanClassificationJob(s.db(ctx).QueryRow(ctx, `
+		INSERT INTO classification_jobs (user_id, start_date, end_date, dry_run, batch_size, rule_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+classificationJobColumns,
+		userID, startDate, endDate, dryRun, batchSize, ruleID,
+	))
+}
+
+// GetByID retrieves a job owned by the user
+func (s *ClassificationJobStore) GetByID(ctx context.Context, userID, jobID uuid.UUID) (*ClassificationJob, error) {
+	return scanClassificationJob(s.db(ctx).QueryRow(ctx, `
+		SELECT `+classificationJobColumns+`
+		FROM classification_jobs
+		WHERE id = $1 AND user_id = $2
+	`, jobID, userID))
+}
+
+// Start marks a job as running
+func (s *ClassificationJobStore) Start(ctx context.Context, jobID uuid.UUID) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE classification_jobs
+		SET status = 'running', started_at = NOW()
+		WHERE id = $1
+	`, jobID)
+	return err
+}
+
+// UpdateProgress records the tally after a batch
+func (s *ClassificationJobStore) UpdateProgress(ctx context.Context, jobID uuid.UUID, progress ClassificationJobProgress) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE classification_jobs
+		SET total_events = $2, processed_events = $3, classified_count = $4,
+		    skip_applied_count = $5, skipped_count = $6, matched_count = $7, changed_count = $8
+		WHERE id = $1
+	`, jobID, progress.TotalEvents, progress.ProcessedEvents, progress.ClassifiedCount,
+		progress.SkipAppliedCount, progress.SkippedCount, progress.MatchedCount, progress.ChangedCount)
+	return err
+}
+
+// Complete marks a job as finished, linking the journal entry for undo
+func (s *ClassificationJobStore) Complete(ctx context.Context, jobID uuid.UUID, actionID *uuid.UUID) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE classification_jobs
+		SET status = 'completed', action_id = $2, completed_at = NOW()
+		WHERE id = $1
+	`, jobID, actionID)
+	return err
+}
+
+// Fail marks a job as failed with an error message
+func (s *ClassificationJobStore) Fail(ctx context.Context, jobID uuid.UUID, errMsg string) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE classification_jobs
+		SET status = 'failed', error_message = $2, completed_at = NOW()
+		WHERE id = $1
+	`, jobID, errMsg)
+	return err
+}
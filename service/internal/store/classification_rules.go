@@ -3,11 +3,13 @@ package store
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var ErrClassificationRuleNotFound = errors.New("classification rule not found")
@@ -23,6 +25,7 @@ type ClassificationRule struct {
 	IsEnabled bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time // Set while the rule is in the trash
 	// Joined data
 	ProjectName  *string
 	ProjectColor *string
@@ -42,7 +45,8 @@ type ClassificationOverride struct {
 
 // ClassificationRuleStore provides PostgreSQL-backed rule storage
 type ClassificationRuleStore struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	changes changeHooks
 }
 
 // NewClassificationRuleStore creates a new store
@@ -50,6 +54,17 @@ func NewClassificationRuleStore(pool *pgxpool.Pool) *ClassificationRuleStore {
 	return &ClassificationRuleStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ClassificationRuleStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// OnChange registers a hook that runs after a user's rules are created,
+// updated, deleted, trashed or restored
+func (s *ClassificationRuleStore) OnChange(fn ChangeHook) {
+	s.changes.add(fn)
+}
+
 // Create creates a new classification rule
 func (s *ClassificationRuleStore) Create(ctx context.Context, rule *ClassificationRule) (*ClassificationRule, error) {
 	rule.ID = uuid.New()
@@ -61,7 +76,7 @@ func (s *ClassificationRuleStore) Create(ctx context.Context, rule *Classificati
 		rule.Weight = 1.0
 	}
 
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		INSERT INTO classification_rules (id, user_id, query, project_id, attended, weight, is_enabled, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id
@@ -74,6 +89,7 @@ func (s *ClassificationRuleStore) Create(ctx context.Context, rule *Classificati
 		return nil, err
 	}
 
+	s.changes.notify(ctx, rule.UserID)
 	return rule, nil
 }
 
@@ -81,12 +97,12 @@ func (s *ClassificationRuleStore) Create(ctx context.Context, rule *Classificati
 func (s *ClassificationRuleStore) GetByID(ctx context.Context, userID, ruleID uuid.UUID) (*ClassificationRule, error) {
 	rule := &ClassificationRule{}
 
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT r.id, r.user_id, r.query, r.project_id, r.attended, r.weight, r.is_enabled,
 		       r.created_at, r.updated_at, p.name, p.color
 		FROM classification_rules r
 		LEFT JOIN projects p ON r.project_id = p.id
-		WHERE r.id = $1 AND r.user_id = $2
+		WHERE r.id = $1 AND r.user_id = $2 AND r.deleted_at IS NULL
 	`, ruleID, userID).Scan(
 		&rule.ID, &rule.UserID, &rule.Query, &rule.ProjectID, &rule.Attended,
 		&rule.Weight, &rule.IsEnabled, &rule.CreatedAt, &rule.UpdatedAt,
@@ -103,23 +119,77 @@ func (s *ClassificationRuleStore) GetByID(ctx context.Context, userID, ruleID uu
 	return rule, nil
 }
 
+// Rule list sort orders
+const (
+	RuleSortWeight  = "weight"
+	RuleSortRecent  = "recent"
+	RuleSortProject = "project"
+)
+
+// RuleListOptions filters, sorts and pages a rule listing. Nil filters match
+// everything; a zero Limit returns every match.
+type RuleListOptions struct {
+	IncludeDisabled bool
+	ProjectID       *uuid.UUID
+	// Client keeps rules targeting a project of that client
+	Client *string
+	// Sort is one of the RuleSort constants; empty sorts by weight
+	Sort   string
+	Limit  int
+	Offset int
+}
+
 // List returns all rules for a user
 func (s *ClassificationRuleStore) List(ctx context.Context, userID uuid.UUID, includeDisabled bool) ([]*ClassificationRule, error) {
+	return s.Query(ctx, userID, RuleListOptions{IncludeDisabled: includeDisabled})
+}
+
+// Query retrieves a user's rules matching opts
+func (s *ClassificationRuleStore) Query(ctx context.Context, userID uuid.UUID, opts RuleListOptions) ([]*ClassificationRule, error) {
 	query := `
 		SELECT r.id, r.user_id, r.query, r.project_id, r.attended, r.weight, r.is_enabled,
 		       r.created_at, r.updated_at, p.name, p.color
 		FROM classification_rules r
 		LEFT JOIN projects p ON r.project_id = p.id
-		WHERE r.user_id = $1
+		WHERE r.user_id = $1 AND r.deleted_at IS NULL
 	`
+	args := []interface{}{userID}
+	argNum := 2
 
-	if !includeDisabled {
+	if !opts.IncludeDisabled {
 		query += " AND r.is_enabled = true"
 	}
+	if opts.ProjectID != nil {
+		query += fmt.Sprintf(" AND r.project_id = $%d", argNum)
+		args = append(args, *opts.ProjectID)
+		argNum++
+	}
+	if opts.Client != nil {
+		query += fmt.Sprintf(" AND p.client = $%d", argNum)
+		args = append(args, *opts.Client)
+		argNum++
+	}
+
+	switch opts.Sort {
+	case RuleSortRecent:
+		query += " ORDER BY r.updated_at DESC, r.created_at ASC"
+	case RuleSortProject:
+		query += " ORDER BY p.name ASC NULLS LAST, r.weight DESC, r.created_at ASC"
+	default:
+		query += " ORDER BY r.weight DESC, r.created_at ASC"
+	}
 
-	query += " ORDER BY r.weight DESC, r.created_at ASC"
+	if opts.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argNum)
+		args = append(args, opts.Limit)
+		argNum++
+	}
+	if opts.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", argNum)
+		args = append(args, opts.Offset)
+	}
 
-	rows, err := s.pool.Query(ctx, query, userID)
+	rows, err := s.db(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -144,12 +214,12 @@ func (s *ClassificationRuleStore) List(ctx context.Context, userID uuid.UUID, in
 
 // ListByProject returns all rules targeting a specific project
 func (s *ClassificationRuleStore) ListByProject(ctx context.Context, userID, projectID uuid.UUID) ([]*ClassificationRule, error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT r.id, r.user_id, r.query, r.project_id, r.attended, r.weight, r.is_enabled,
 		       r.created_at, r.updated_at, p.name, p.color
 		FROM classification_rules r
 		LEFT JOIN projects p ON r.project_id = p.id
-		WHERE r.user_id = $1 AND r.project_id = $2
+		WHERE r.user_id = $1 AND r.project_id = $2 AND r.deleted_at IS NULL
 		ORDER BY r.weight DESC, r.created_at ASC
 	`, userID, projectID)
 	if err != nil {
@@ -176,11 +246,11 @@ func (s *ClassificationRuleStore) ListByProject(ctx context.Context, userID, pro
 
 // ListAttendanceRules returns all rules targeting attendance (did not attend)
 func (s *ClassificationRuleStore) ListAttendanceRules(ctx context.Context, userID uuid.UUID) ([]*ClassificationRule, error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT id, user_id, query, project_id, attended, weight, is_enabled,
 		       created_at, updated_at, NULL, NULL
 		FROM classification_rules
-		WHERE user_id = $1 AND attended IS NOT NULL AND is_enabled = true
+		WHERE user_id = $1 AND attended IS NOT NULL AND is_enabled = true AND deleted_at IS NULL
 		ORDER BY weight DESC, created_at ASC
 	`, userID)
 	if err != nil {
@@ -209,10 +279,11 @@ func (s *ClassificationRuleStore) ListAttendanceRules(ctx context.Context, userI
 func (s *ClassificationRuleStore) Update(ctx context.Context, rule *ClassificationRule) (*ClassificationRule, error) {
 	rule.UpdatedAt = time.Now().UTC()
 
-	result, err := s.pool.Exec(ctx, `
+	result, err := s.db(ctx).Exec(ctx, `
 		UPDATE classification_rules
-		SET query = $3, project_id = $4, attended = $5, weight = $6, is_enabled = $7, updated_at = $8
-		WHERE id = $1 AND user_id = $2
+		SET query = $3, project_id = $4, attended = $5, weight = $6, is_enabled = $7, updated_at = $8,
+		    disabled_by_archive = disabled_by_archive AND NOT $7
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 	`,
 		rule.ID, rule.UserID, rule.Query, rule.ProjectID, rule.Attended,
 		rule.Weight, rule.IsEnabled, rule.UpdatedAt,
@@ -226,12 +297,13 @@ func (s *ClassificationRuleStore) Update(ctx context.Context, rule *Classificati
 		return nil, ErrClassificationRuleNotFound
 	}
 
+	s.changes.notify(ctx, rule.UserID)
 	return s.GetByID(ctx, rule.UserID, rule.ID)
 }
 
 // Delete removes a classification rule
 func (s *ClassificationRuleStore) Delete(ctx context.Context, userID, ruleID uuid.UUID) error {
-	result, err := s.pool.Exec(ctx, `
+	result, err := s.db(ctx).Exec(ctx, `
 		DELETE FROM classification_rules WHERE id = $1 AND user_id = $2
 	`, ruleID, userID)
 
@@ -243,15 +315,93 @@ func (s *ClassificationRuleStore) Delete(ctx context.Context, userID, ruleID uui
 		return ErrClassificationRuleNotFound
 	}
 
+	s.changes.notify(ctx, userID)
 	return nil
 }
 
+// Trash soft-deletes a rule. Trashed rules are ignored by classification and
+// can be restored until they are purged.
+func (s *ClassificationRuleStore) Trash(ctx context.Context, userID, ruleID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE classification_rules SET deleted_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`, ruleID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrClassificationRuleNotFound
+	}
+	s.changes.notify(ctx, userID)
+	return nil
+}
+
+// ListTrashed returns a user's trashed rules, most recently deleted first
+func (s *ClassificationRuleStore) ListTrashed(ctx context.Context, userID uuid.UUID) ([]*ClassificationRule, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT r.id, r.user_id, r.query, r.project_id, r.attended, r.weight, r.is_enabled,
+		       r.created_at, r.updated_at, r.deleted_at, p.name, p.color
+		FROM classification_rules r
+		LEFT JOIN projects p ON r.project_id = p.id
+		WHERE r.user_id = $1 AND r.deleted_at IS NOT NULL
+		ORDER BY r.deleted_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*ClassificationRule
+	for rows.Next() {
+		rule := &ClassificationRule{}
+		err := rows.Scan(
+			&rule.ID, &rule.UserID, &rule.Query, &rule.ProjectID, &rule.Attended,
+			&rule.Weight, &rule.IsEnabled, &rule.CreatedAt, &rule.UpdatedAt, &rule.DeletedAt,
+			&rule.ProjectName, &rule.ProjectColor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// Restore takes a rule out of the trash
+func (s *ClassificationRuleStore) Restore(ctx context.Context, userID, ruleID uuid.UUID) (*ClassificationRule, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE classification_rules SET deleted_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL
+	`, ruleID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrClassificationRuleNotFound
+	}
+	s.changes.notify(ctx, userID)
+	return s.GetByID(ctx, userID, ruleID)
+}
+
+// PurgeTrashed permanently deletes rules trashed before the cutoff
+func (s *ClassificationRuleStore) PurgeTrashed(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM classification_rules WHERE deleted_at < $1",
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 // RecordOverride records a classification override for feedback
 func (s *ClassificationRuleStore) RecordOverride(ctx context.Context, override *ClassificationOverride) error {
 	override.ID = uuid.New()
 	override.CreatedAt = time.Now().UTC()
 
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		INSERT INTO classification_overrides (id, event_id, user_id, from_project_id, to_project_id, from_source, reason, created_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`,
@@ -264,7 +414,7 @@ func (s *ClassificationRuleStore) RecordOverride(ctx context.Context, override *
 
 // GetRecentOverrides gets recent classification overrides for LLM training
 func (s *ClassificationRuleStore) GetRecentOverrides(ctx context.Context, userID uuid.UUID, since time.Time) ([]*ClassificationOverride, error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT id, event_id, user_id, from_project_id, to_project_id, from_source, reason, created_at
 		FROM classification_overrides
 		WHERE user_id = $1 AND created_at >= $2
@@ -0,0 +1,198 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrClassificationSnapshotNotFound = errors.New("classification snapshot not found")
+
+// ClassificationSnapshot is a saved checkpoint of one week's event
+// classifications
+type ClassificationSnapshot struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	WeekStart  time.Time
+	Label      *string
+	EventCount int
+	CreatedAt  time.Time
+}
+
+// ClassificationSnapshotStore provides PostgreSQL-backed storage for
+// classification snapshots
+type ClassificationSnapshotStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewClassificationSnapshotStore creates a new classification snapshot store
+func NewClassificationSnapshotStore(pool *pgxpool.Pool) *ClassificationSnapshotStore {
+	return &ClassificationSnapshotStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ClassificationSnapshotStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Create snapshots the project, source and skip state of every event starting
+// in the week beginning at weekStart (a Monday, UTC)
+func (s *ClassificationSnapshotStore) Create(ctx context.Context, userID uuid.UUID, weekStart time.Time, label *string) (*ClassificationSnapshot, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	snap := &ClassificationSnapshot{
+		ID:        uuid.New(),
+		UserID:    userID,
+		WeekStart: weekStart,
+		Label:     label,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO classification_snapshots (id, user_id, week_start, label, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, snap.ID, snap.UserID, snap.WeekStart, snap.Label, snap.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO classification_snapshot_events (snapshot_id, event_id, project_id, source, skipped)
+		SELECT $1, id, project_id, classification_source, is_skipped
+		FROM calendar_events
+		WHERE user_id = $2 AND start_time >= $3 AND start_time < $4
+	`, snap.ID, userID, weekStart, weekStart.AddDate(0, 0, 7))
+	if err != nil {
+		return nil, err
+	}
+	snap.EventCount = int(result.RowsAffected())
+
+	if _, err := tx.Exec(ctx,
+		"UPDATE classification_snapshots SET event_count = $2 WHERE id = $1",
+		snap.ID, snap.EventCount,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+// GetByID retrieves a snapshot
+func (s *ClassificationSnapshotStore) GetByID(ctx context.Context, userID, snapshotID uuid.UUID) (*ClassificationSnapshot, error) {
+	snap := &ClassificationSnapshot{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, user_id, week_start, label, event_count, created_at
+		FROM classification_snapshots
+		WHERE id = $1 AND user_id = $2
+	`, snapshotID, userID).Scan(
+		&snap.ID, &snap.UserID, &snap.WeekStart, &snap.Label, &snap.EventCount, &snap.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClassificationSnapshotNotFound
+		}
+		return nil, err
+	}
+	return snap, nil
+}
+
+// List returns a user's snapshots, newest first, optionally for one week
+func (s *ClassificationSnapshotStore) List(ctx context.Context, userID uuid.UUID, weekStart *time.Time) ([]*ClassificationSnapshot, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, user_id, week_start, label, event_count, created_at
+		FROM classification_snapshots
+		WHERE user_id = $1 AND ($2::date IS NULL OR week_start = $2)
+		ORDER BY created_at DESC
+	`, userID, weekStart)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snapshots []*ClassificationSnapshot
+	for rows.Next() {
+		snap := &ClassificationSnapshot{}
+		if err := rows.Scan(
+			&snap.ID, &snap.UserID, &snap.WeekStart, &snap.Label, &snap.EventCount, &snap.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snap)
+	}
+	return snapshots, rows.Err()
+}
+
+// Restore puts each event in the snapshot back to its recorded state. Only
+// events whose classification differs are updated; their confidence is reset
+// (1.0 for manual, unset otherwise) and needs_review cleared. Returns the
+// start times of the updated events.
+func (s *ClassificationSnapshotStore) Restore(ctx context.Context, userID, snapshotID uuid.UUID) (*ClassificationSnapshot, []time.Time, error) {
+	snap, err := s.GetByID(ctx, userID, snapshotID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := s.db(ctx).Query(ctx, `
+		UPDATE calendar_events ce
+		SET project_id = cse.project_id,
+		    classification_status = CASE WHEN cse.project_id IS NULL
+		        THEN 'pending'::classification_status
+		        ELSE 'classified'::classification_status END,
+		    classification_source = cse.source,
+		    classification_confidence = CASE WHEN cse.source = 'manual' THEN 1.0 END,
+		    needs_review = false,
+		    is_skipped = cse.skipped,
+		    updated_at = NOW()
+		FROM classification_snapshot_events cse
+		WHERE cse.snapshot_id = $1
+		  AND ce.id = cse.event_id
+		  AND ce.user_id = $2
+		  AND (ce.project_id, ce.classification_source, ce.is_skipped)
+		      IS DISTINCT FROM (cse.project_id, cse.source, cse.skipped)
+		RETURNING ce.start_time
+	`, snap.ID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var starts []time.Time
+	for rows.Next() {
+		var t time.Time
+		if err := rows.Scan(&t); err != nil {
+			return nil, nil, err
+		}
+		starts = append(starts, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return snap, starts, nil
+}
+
+// Delete removes a snapshot
+func (s *ClassificationSnapshotStore) Delete(ctx context.Context, userID, snapshotID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM classification_snapshots WHERE id = $1 AND user_id = $2",
+		snapshotID, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrClassificationSnapshotNotFound
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestClassificationSnapshots checks that a snapshot covers only its week
+// and that restoring it touches only events that changed since
+func TestClassificationSnapshots(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "snapshots-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	projectStore := store.NewProjectStore(db.Pool)
+	alpha, err := projectStore.Create(ctx, user.ID, "Alpha", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	bravo, err := projectStore.Create(ctx, user.ID, "Bravo", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+
+	eventStore := store.NewCalendarEventStore(db.Pool)
+	snapshots := store.NewClassificationSnapshotStore(db.Pool)
+
+	weekStart := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC) // Monday
+	inWeek := createTestEvent(t, db.Pool, user.ID, "Planning", weekStart.AddDate(0, 0, 2).Add(10*time.Hour))
+	nextWeek := createTestEvent(t, db.Pool, user.ID, "Retro", weekStart.AddDate(0, 0, 8).Add(10*time.Hour))
+	classify := func(eventID uuid.UUID, projectID *uuid.UUID, skip bool) {
+		t.Helper()
+		if _, err := eventStore.Classify(ctx, user.ID, eventID, projectID, skip); err != nil {
+			t.Fatalf("Classify: %v", err)
+		}
+	}
+	classify(inWeek.ID, &alpha.ID, false)
+
+	label := "Before cleanup"
+	snap, err := snapshots.Create(ctx, user.ID, weekStart, &label)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if snap.EventCount != 1 {
+		t.Errorf("expected only the week's event in the snapshot, got %d", snap.EventCount)
+	}
+
+	classify(inWeek.ID, &bravo.ID, false)
+	classify(nextWeek.ID, nil, true)
+
+	restored, starts, err := snapshots.Restore(ctx, user.ID, snap.ID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if restored.ID != snap.ID || len(starts) != 1 || !starts[0].Equal(inWeek.StartTime) {
+		t.Errorf("expected the week's event restored, got %v", starts)
+	}
+	got, err := eventStore.GetByID(ctx, user.ID, inWeek.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.ProjectID == nil || *got.ProjectID != alpha.ID || got.ClassificationStatus != store.StatusClassified {
+		t.Errorf("expected the event back on Alpha, got %v (%s)", got.ProjectID, got.ClassificationStatus)
+	}
+	other, err := eventStore.GetByID(ctx, user.ID, nextWeek.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if !other.IsSkipped {
+		t.Errorf("expected the next week's event to be left skipped")
+	}
+
+	// Nothing differs any more, so nothing is touched
+	if _, starts, err := snapshots.Restore(ctx, user.ID, snap.ID); err != nil || len(starts) != 0 {
+		t.Errorf("expected an unchanged week to restore nothing, got %v, %v", starts, err)
+	}
+
+	all, err := snapshots.List(ctx, user.ID, nil)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 1 || all[0].Label == nil || *all[0].Label != label {
+		t.Errorf("expected the labelled snapshot, got %v", all)
+	}
+	later := weekStart.AddDate(0, 0, 7)
+	if none, err := snapshots.List(ctx, user.ID, &later); err != nil || len(none) != 0 {
+		t.Errorf("expected no snapshots for the next week, got %v, %v", none, err)
+	}
+
+	if _, _, err := snapshots.Restore(ctx, uuid.New(), snap.ID); !errors.Is(err, store.ErrClassificationSnapshotNotFound) {
+		t.Errorf("expected another user's restore to be not found, got %v", err)
+	}
+	if err := snapshots.Delete(ctx, user.ID, snap.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := snapshots.Delete(ctx, user.ID, snap.ID); !errors.Is(err, store.ErrClassificationSnapshotNotFound) {
+		t.Errorf("expected deleting twice to be not found, got %v", err)
+	}
+}
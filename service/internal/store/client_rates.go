@@ -0,0 +1,190 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var (
+	ErrClientRateNotFound = errors.New("client rate not found")
+	ErrClientRateOverlap  = errors.New("client rate overlaps with existing rate")
+)
+
+// ClientRate represents an effective-dated hourly rate for a client.
+// It applies to every project whose client matches, unless the project has
+// its own billing period covering the date.
+type ClientRate struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Client     string
+	StartsOn   time.Time
+	EndsOn     *time.Time
+	HourlyRate float64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Covers reports whether the rate is in effect on the given date
+func (r *ClientRate) Covers(date time.Time) bool {
+	return !r.StartsOn.After(date) && (r.EndsOn == nil || !r.EndsOn.Before(date))
+}
+
+// ClientRateStore provides PostgreSQL-backed client rate storage
+type ClientRateStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewClientRateStore creates a new PostgreSQL client rate store
+func NewClientRateStore(pool *pgxpool.Pool) *ClientRateStore {
+	return &ClientRateStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ClientRateStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+const clientRateColumns = "id, user_id, client, starts_on, ends_on, hourly_rate, created_at, updated_at"
+
+func scanClientRate(row pgx.Row) (*ClientRate, error) {
+	r := &ClientRate{}
+	err := row.Scan(&r.ID, &r.UserID, &r.Client, &r.StartsOn, &r.EndsOn, &r.HourlyRate, &r.CreatedAt, &r.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Create adds a new client rate
+func (s *ClientRateStore) Create(ctx context.Context, userID uuid.UUID, client string, startsOn time.Time, endsOn *time.Time, hourlyRate float64) (*ClientRate, error) {
+	rate := &ClientRate{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Client:     client,
+		StartsOn:   startsOn,
+		EndsOn:     endsOn,
+		HourlyRate: hourlyRate,
+		CreatedAt:  time.Now().UTC(),
+		UpdatedAt:  time.Now().UTC(),
+	}
+
+	_, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO client_rates (id, user_id, client, starts_on, ends_on, hourly_rate, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, rate.ID, rate.UserID, rate.Client, rate.StartsOn, rate.EndsOn, rate.HourlyRate, rate.CreatedAt, rate.UpdatedAt)
+	if err != nil {
+		if isOverlapViolation(err) {
+			return nil, ErrClientRateOverlap
+		}
+		if isDateRangeViolation(err) {
+			return nil, ErrInvalidRateDates
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+// GetByID retrieves a client rate by ID
+func (s *ClientRateStore) GetByID(ctx context.Context, userID, rateID uuid.UUID) (*ClientRate, error) {
+	rate, err := scanClientRate(s.db(ctx).QueryRow(ctx, `
+		SELECT `+clientRateColumns+`
+		FROM client_rates WHERE id = $1 AND user_id = $2
+	`, rateID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientRateNotFound
+		}
+		return nil, err
+	}
+	return rate, nil
+}
+
+// List retrieves client rates, optionally filtered to a single client
+func (s *ClientRateStore) List(ctx context.Context, userID uuid.UUID, client *string) ([]*ClientRate, error) {
+	query := `SELECT ` + clientRateColumns + ` FROM client_rates WHERE user_id = $1`
+	args := []interface{}{userID}
+	if client != nil {
+		query += " AND client = $2"
+		args = append(args, *client)
+	}
+	query += " ORDER BY client, starts_on DESC"
+
+	rows, err := s.db(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rates []*ClientRate
+	for rows.Next() {
+		r, err := scanClientRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, r)
+	}
+
+	return rates, rows.Err()
+}
+
+// Update modifies an existing client rate
+func (s *ClientRateStore) Update(ctx context.Context, userID, rateID uuid.UUID, updates map[string]interface{}) (*ClientRate, error) {
+	updates["updated_at"] = time.Now().UTC()
+
+	// Build dynamic update query
+	setClauses := ""
+	args := []interface{}{rateID, userID}
+	argNum := 3
+
+	for key, value := range updates {
+		if setClauses != "" {
+			setClauses += ", "
+		}
+		setClauses += fmt.Sprintf("%s = $%d", key, argNum)
+		args = append(args, value)
+		argNum++
+	}
+
+	query := "UPDATE client_rates SET " + setClauses + " WHERE id = $1 AND user_id = $2 RETURNING " + clientRateColumns
+
+	rate, err := scanClientRate(s.db(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientRateNotFound
+		}
+		if isOverlapViolation(err) {
+			return nil, ErrClientRateOverlap
+		}
+		if isDateRangeViolation(err) {
+			return nil, ErrInvalidRateDates
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+// Delete removes a client rate. Invoices keep the rate they snapshotted.
+func (s *ClientRateStore) Delete(ctx context.Context, userID, rateID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM client_rates WHERE id = $1 AND user_id = $2",
+		rateID, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrClientRateNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestClientRates checks that client rates can't overlap and that invoices
+// fall back to them where no project billing period applies, recording
+// which rate each line used
+func TestClientRates(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	projectStore := store.NewProjectStore(db.Pool)
+	timeEntryStore := store.NewTimeEntryStore(db.Pool)
+	billingPeriodStore := store.NewBillingPeriodStore(db.Pool)
+	clientRateStore := store.NewClientRateStore(db.Pool)
+	invoiceStore := store.NewInvoiceStore(db.Pool, timeEntryStore, billingPeriodStore, clientRateStore, projectStore)
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "client-rate-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	client := "Acme"
+	project, err := projectStore.Create(ctx, user.ID, "Acme Website", nil, &client, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2024, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	janEnd := day(time.January, 31)
+	january, err := clientRateStore.Create(ctx, user.ID, client, day(time.January, 1), &janEnd, 100)
+	if err != nil {
+		t.Fatalf("Failed to create January rate: %v", err)
+	}
+	february, err := clientRateStore.Create(ctx, user.ID, client, day(time.February, 1), nil, 120)
+	if err != nil {
+		t.Fatalf("Failed to create ongoing rate: %v", err)
+	}
+
+	t.Run("overlapping rates are rejected", func(t *testing.T) {
+		if _, err := clientRateStore.Create(ctx, user.ID, client, day(time.January, 31), nil, 90); !errors.Is(err, store.ErrClientRateOverlap) {
+			t.Errorf("expected ErrClientRateOverlap, got %v", err)
+		}
+		// Another client's rates don't conflict
+		other, err := clientRateStore.Create(ctx, user.ID, "Globex", day(time.January, 1), nil, 90)
+		if err != nil {
+			t.Fatalf("expected a rate for another client to be allowed, got %v", err)
+		}
+		if err := clientRateStore.Delete(ctx, user.ID, other.ID); err != nil {
+			t.Fatalf("Failed to delete rate: %v", err)
+		}
+		if _, err := clientRateStore.Update(ctx, user.ID, january.ID, map[string]interface{}{"ends_on": day(time.February, 15)}); !errors.Is(err, store.ErrClientRateOverlap) {
+			t.Errorf("expected extending into February to overlap, got %v", err)
+		}
+	})
+
+	t.Run("end before start is rejected", func(t *testing.T) {
+		end := day(time.March, 1)
+		if _, err := clientRateStore.Create(ctx, user.ID, "Initech", day(time.March, 2), &end, 90); !errors.Is(err, store.ErrInvalidRateDates) {
+			t.Errorf("expected ErrInvalidRateDates, got %v", err)
+		}
+	})
+
+	// The project's own billing period takes over from March
+	period, err := billingPeriodStore.Create(ctx, user.ID, project.ID, day(time.March, 1), nil, 150, store.BillingTerms{})
+	if err != nil {
+		t.Fatalf("Failed to create billing period: %v", err)
+	}
+
+	for _, date := range []time.Time{day(time.January, 10), day(time.February, 10), day(time.March, 10)} {
+		if _, err := timeEntryStore.Create(ctx, user.ID, project.ID, date, 2, nil); err != nil {
+			t.Fatalf("Failed to create time entry: %v", err)
+		}
+	}
+
+	invoice, err := invoiceStore.Create(ctx, user.ID, project.ID, day(time.January, 1), day(time.March, 31), day(time.April, 1))
+	if err != nil {
+		t.Fatalf("Failed to create invoice: %v", err)
+	}
+
+	want := []struct {
+		rate   float64
+		source string
+		rateID uuid.UUID
+	}{
+		{100, store.RateSourceClient, january.ID},
+		{120, store.RateSourceClient, february.ID},
+		{150, store.RateSourceProject, period.ID},
+	}
+	check := func(t *testing.T, invoice *store.Invoice) {
+		t.Helper()
+		if len(invoice.LineItems) != len(want) {
+			t.Fatalf("expected %d line items, got %d", len(want), len(invoice.LineItems))
+		}
+		for i, item := range invoice.LineItems {
+			if item.HourlyRate != want[i].rate || item.RateSource != want[i].source || item.RateID == nil || *item.RateID != want[i].rateID {
+				t.Errorf("line %d: rate %v from %s %v, want %v from %s %v",
+					i, item.HourlyRate, item.RateSource, item.RateID, want[i].rate, want[i].source, want[i].rateID)
+			}
+		}
+		if invoice.TotalAmount != 2*(100+120+150) {
+			t.Errorf("expected total 740, got %v", invoice.TotalAmount)
+		}
+	}
+	check(t, invoice)
+
+	// Invoices keep the rate they were created with
+	if _, err := clientRateStore.Update(ctx, user.ID, february.ID, map[string]interface{}{"hourly_rate": 200.0}); err != nil {
+		t.Fatalf("Failed to update rate: %v", err)
+	}
+	if err := clientRateStore.Delete(ctx, user.ID, january.ID); err != nil {
+		t.Fatalf("Failed to delete rate: %v", err)
+	}
+	invoice, err = invoiceStore.GetByID(ctx, user.ID, invoice.ID)
+	if err != nil {
+		t.Fatalf("Failed to get invoice: %v", err)
+	}
+	check(t, invoice)
+
+	if err := clientRateStore.Delete(ctx, user.ID, january.ID); !errors.Is(err, store.ErrClientRateNotFound) {
+		t.Errorf("expected deleting twice to be not found, got %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// eventInternalColumn selects whether an event is internal, for queries over
+// calendar_events ce: true when no attendee's domain is outside the user's
+// company domains, so events without attendees are internal, and NULL while
+// the user has declared none. Attendees may be "Name <address>" strings.
+const eventInternalColumn = `(
+		           SELECT CASE WHEN cardinality(u.company_domains) = 0 THEN NULL
+		                  ELSE NOT EXISTS (
+		                      SELECT 1 FROM jsonb_array_elements_text(
+		                          CASE WHEN jsonb_typeof(ce.attendees) = 'array' THEN ce.attendees ELSE '[]'::jsonb END
+		                      ) a
+		                      WHERE NOT rtrim(lower(split_part(a, '@', 2)), '> ') = ANY(u.company_domains)
+		                  ) END
+		           FROM users u WHERE u.id = ce.user_id
+		       )`
+
+// AudienceHours splits event time between internal and client-facing events
+type AudienceHours struct {
+	Internal     float64
+	ClientFacing float64
+}
+
+// HoursByAudience sums the durations of the user's counted events starting in
+// [start, end): classified to a project that accumulates hours, not skipped
+// and not all-day. Overlapping events each count in full. Returns nil when
+// the user has declared no company domains or has no such events.
+func (s *CalendarEventStore) HoursByAudience(ctx context.Context, userID uuid.UUID, start, end time.Time) (*AudienceHours, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT is_internal, SUM(hours)::float8
+		FROM (
+			SELECT `+eventInternalColumn+` AS is_internal,
+			       EXTRACT(EPOCH FROM ce.end_time - ce.start_time) / 3600 AS hours
+			FROM calendar_events ce
+			JOIN calendars c ON c.id = ce.calendar_id AND c.is_selected = true
+			JOIN projects p ON p.id = ce.project_id AND p.does_not_accumulate_hours = false
+			WHERE ce.user_id = $1
+			  AND ce.start_time >= $2 AND ce.start_time < $3
+			  AND ce.classification_status = 'classified'
+			  AND ce.is_skipped = false
+			  AND ce.is_all_day = false
+			  AND ce.is_orphaned = false
+			  AND ce.is_suppressed = false
+		) e
+		GROUP BY is_internal
+	`, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hours *AudienceHours
+	for rows.Next() {
+		var internal *bool
+		var h float64
+		if err := rows.Scan(&internal, &h); err != nil {
+			return nil, err
+		}
+		if internal == nil {
+			continue
+		}
+		if hours == nil {
+			hours = &AudienceHours{}
+		}
+		if *internal {
+			hours.Internal = h
+		} else {
+			hours.ClientFacing = h
+		}
+	}
+	return hours, rows.Err()
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestStoresUseConn keeps every store in units of work: statements must run
+// on s.db(ctx), which is the caller's transaction when there is one. Only
+// fire-and-forget goroutines, which run on a fresh context, use the pool.
+func TestStoresUseConn(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatalf("parse %s: %v", name, err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			if _, ok := n.(*ast.GoStmt); ok {
+				return false
+			}
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			inner, ok := sel.X.(*ast.SelectorExpr)
+			if !ok || inner.Sel.Name != "pool" {
+				return true
+			}
+			if recv, ok := inner.X.(*ast.Ident); ok && recv.Name == "s" {
+				t.Errorf("%s: s.pool.%s bypasses the unit of work, use s.db(ctx)", fset.Position(sel.Pos()), sel.Sel.Name)
+			}
+			return true
+		})
+	}
+}
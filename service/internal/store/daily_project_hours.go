@@ -0,0 +1,164 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+// DailyProjectHours is the precomputed total for one project on one day
+type DailyProjectHours struct {
+	UserID    uuid.UUID
+	ProjectID uuid.UUID
+	Date      time.Time
+	Hours     float64
+}
+
+// StaleDay is a day whose aggregate needs recomputing. Generation is the
+// day's change counter when it was found stale; a day that has never been
+// computed has generation 0.
+type StaleDay struct {
+	Date       time.Time
+	Generation int64
+}
+
+// DailyProjectHoursStore provides PostgreSQL-backed storage for the daily
+// per-project aggregate and its staleness tracking
+type DailyProjectHoursStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewDailyProjectHoursStore creates a new daily project hours store
+func NewDailyProjectHoursStore(pool *pgxpool.Pool) *DailyProjectHoursStore {
+	return &DailyProjectHoursStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *DailyProjectHoursStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// StaleDays returns the days in the inclusive range that have never been
+// computed or have changed since they were last computed, oldest first
+func (s *DailyProjectHoursStore) StaleDays(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]StaleDay, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT d::date, COALESCE(st.generation, 0)
+		FROM generate_series($2::date, $3::date, interval '1 day') AS d
+		LEFT JOIN daily_project_hours_state st
+		       ON st.user_id = $1 AND st.date = d::date
+		WHERE st.refreshed_generation IS NULL
+		   OR st.refreshed_generation < st.generation
+		ORDER BY d
+	`, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []StaleDay
+	for rows.Next() {
+		var d StaleDay
+		if err := rows.Scan(&d.Date, &d.Generation); err != nil {
+			return nil, err
+		}
+		days = append(days, d)
+	}
+	return days, rows.Err()
+}
+
+// Replace stores freshly computed totals for the given days, replacing their
+// previous totals, and marks each day refreshed as of the generation it was
+// computed from. A day that changed again while it was being computed stays
+// stale.
+func (s *DailyProjectHoursStore) Replace(ctx context.Context, userID uuid.UUID, days []StaleDay, totals []*DailyProjectHours) error {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	dates := make([]time.Time, len(days))
+	for i, d := range days {
+		dates[i] = d.Date
+	}
+	_, err = tx.Exec(ctx,
+		"DELETE FROM daily_project_hours WHERE user_id = $1 AND date = ANY($2::date[])",
+		userID, dates,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range totals {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO daily_project_hours (user_id, project_id, date, hours)
+			VALUES ($1, $2, $3, $4)
+		`, userID, t.ProjectID, t.Date, t.Hours)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, d := range days {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO daily_project_hours_state (user_id, date, generation, refreshed_generation, refreshed_at)
+			VALUES ($1, $2, $3, $3, NOW())
+			ON CONFLICT (user_id, date) DO UPDATE SET
+				refreshed_generation = GREATEST(COALESCE(daily_project_hours_state.refreshed_generation, 0), EXCLUDED.refreshed_generation),
+				refreshed_at = EXCLUDED.refreshed_at
+		`, userID, d.Date, d.Generation)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// List returns the stored totals for the inclusive date range, optionally
+// for one project, ordered by date
+func (s *DailyProjectHoursStore) List(ctx context.Context, userID uuid.UUID, start, end time.Time, projectID *uuid.UUID) ([]*DailyProjectHours, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT user_id, project_id, date, hours
+		FROM daily_project_hours
+		WHERE user_id = $1 AND date >= $2 AND date <= $3
+		  AND ($4::uuid IS NULL OR project_id = $4)
+		ORDER BY date, project_id
+	`, userID, start, end, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []*DailyProjectHours
+	for rows.Next() {
+		t := &DailyProjectHours{}
+		if err := rows.Scan(&t.UserID, &t.ProjectID, &t.Date, &t.Hours); err != nil {
+			return nil, err
+		}
+		totals = append(totals, t)
+	}
+	return totals, rows.Err()
+}
+
+// ListUserIDs returns every user, the candidates for a backfill
+func (s *DailyProjectHoursStore) ListUserIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := s.db(ctx).Query(ctx, "SELECT id FROM users ORDER BY created_at")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrDayAnomalyNotFound = errors.New("anomaly not found")
+
+// DayAnomaly is a day flagged by the anomaly analysis
+type DayAnomaly struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	Date          time.Time
+	Kind          string
+	Message       string
+	Hours         float64
+	BaselineHours *float64
+	EventCount    *int
+	DetectedAt    time.Time
+	DismissedAt   *time.Time
+}
+
+// DayAnomalyStore provides PostgreSQL-backed anomaly storage
+type DayAnomalyStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewDayAnomalyStore creates a new day anomaly store
+func NewDayAnomalyStore(pool *pgxpool.Pool) *DayAnomalyStore {
+	return &DayAnomalyStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *DayAnomalyStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+const dayAnomalyColumns = `id, user_id, date, kind, message, hours, baseline_hours, event_count, detected_at, dismissed_at`
+
+func scanDayAnomaly(row pgx.Row) (*DayAnomaly, error) {
+	a := &DayAnomaly{}
+	err := row.Scan(
+		&a.ID, &a.UserID, &a.Date, &a.Kind, &a.Message, &a.Hours,
+		&a.BaselineHours, &a.EventCount, &a.DetectedAt, &a.DismissedAt,
+	)
+	return a, err
+}
+
+// Replace records the anomalies found for the inclusive date range. New
+// anomalies are inserted, existing ones keep their dismissal but get the
+// latest details, and open anomalies in the range that were not found again
+// are removed.
+func (s *DayAnomalyStore) Replace(ctx context.Context, userID uuid.UUID, start, end time.Time, anomalies []*DayAnomaly) error {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	keep := make([]uuid.UUID, 0, len(anomalies))
+	for _, a := range anomalies {
+		var id uuid.UUID
+		err := tx.QueryRow(ctx, `
+			INSERT INTO day_anomalies (id, user_id, date, kind, message, hours, baseline_hours, event_count)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (user_id, date, kind) DO UPDATE SET
+				message = EXCLUDED.message,
+				hours = EXCLUDED.hours,
+				baseline_hours = EXCLUDED.baseline_hours,
+				event_count = EXCLUDED.event_count
+			RETURNING id
+		`, uuid.New(), userID, a.Date, a.Kind, a.Message, a.Hours, a.BaselineHours, a.EventCount).Scan(&id)
+		if err != nil {
+			return err
+		}
+		keep = append(keep, id)
+	}
+
+	_, err = tx.Exec(ctx, `
+		DELETE FROM day_anomalies
+		WHERE user_id = $1 AND date >= $2 AND date <= $3
+		  AND dismissed_at IS NULL AND NOT (id = ANY($4))
+	`, userID, start, end, keep)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// List returns a user's anomalies, newest day first, optionally limited to a
+// date range and including dismissed ones
+func (s *DayAnomalyStore) List(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, includeDismissed bool) ([]*DayAnomaly, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+dayAnomalyColumns+`
+		FROM day_anomalies
+		WHERE user_id = $1
+		  AND ($2::date IS NULL OR date >= $2)
+		  AND ($3::date IS NULL OR date <= $3)
+		  AND ($4 OR dismissed_at IS NULL)
+		ORDER BY date DESC, kind
+	`, userID, startDate, endDate, includeDismissed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var anomalies []*DayAnomaly
+	for rows.Next() {
+		a, err := scanDayAnomaly(rows)
+		if err != nil {
+			return nil, err
+		}
+		anomalies = append(anomalies, a)
+	}
+	return anomalies, rows.Err()
+}
+
+// Dismiss marks an anomaly as reviewed so it drops out of the default list
+func (s *DayAnomalyStore) Dismiss(ctx context.Context, userID, anomalyID uuid.UUID) (*DayAnomaly, error) {
+	a, err := scanDayAnomaly(s.db(ctx).QueryRow(ctx, `
+		UPDATE day_anomalies
+		SET dismissed_at = COALESCE(dismissed_at, NOW())
+		WHERE id = $1 AND user_id = $2
+		RETURNING `+dayAnomalyColumns,
+		anomalyID, userID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDayAnomalyNotFound
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+// ListActiveUserIDs returns users with time entries or calendar events on
+// or after since, the candidates for the scheduled analysis
+func (s *DayAnomalyStore) ListActiveUserIDs(ctx context.Context, since time.Time) ([]uuid.UUID, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT user_id FROM time_entries WHERE date >= $1
+		UNION
+		SELECT user_id FROM calendar_events WHERE start_time >= $1
+	`, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
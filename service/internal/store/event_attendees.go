@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// EventAttendee is one normalized attendee of a calendar event
+type EventAttendee struct {
+	Email          string // Lowercased address
+	Domain         string // Lowercased part after @, empty if none
+	ResponseStatus *string
+	IsOrganizer    bool
+}
+
+// NewEventAttendee normalizes a raw attendee string, which may be a bare
+// address or a "Name <address>" form. Matching is case-insensitive, the same
+// as the domain: and email: query conditions.
+func NewEventAttendee(raw string) EventAttendee {
+	email := strings.TrimSpace(raw)
+	if addr, err := mail.ParseAddress(email); err == nil {
+		email = addr.Address
+	}
+	email = strings.ToLower(email)
+
+	var domain string
+	if parts := strings.Split(email, "@"); len(parts) == 2 {
+		domain = parts[1]
+	}
+	return EventAttendee{Email: email, Domain: domain}
+}
+
+// AttendeeFilter narrows an event listing to events with at least one
+// attendee in Domains or Emails. Values are matched case-insensitively.
+type AttendeeFilter struct {
+	Domains []string
+	Emails  []string
+}
+
+// eventAttendees returns the attendee rows to store for an event, falling
+// back to the plain attendee list when sync did not supply details
+func eventAttendees(event *CalendarEvent) []EventAttendee {
+	if event.AttendeeDetails != nil {
+		return event.AttendeeDetails
+	}
+	attendees := make([]EventAttendee, 0, len(event.Attendees))
+	for _, raw := range event.Attendees {
+		attendees = append(attendees, NewEventAttendee(raw))
+	}
+	return attendees
+}
+
+// replaceAttendees rewrites the attendee rows for an event
+func replaceAttendees(ctx context.Context, tx pgx.Tx, eventID, userID uuid.UUID, attendees []EventAttendee) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM event_attendees WHERE event_id = $1`, eventID); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(attendees))
+	batch := &pgx.Batch{}
+	for _, a := range attendees {
+		if a.Email == "" || seen[a.Email] {
+			continue
+		}
+		seen[a.Email] = true
+		batch.Queue(`
+			INSERT INTO event_attendees (event_id, user_id, email, domain, response_status, is_organizer)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`, eventID, userID, a.Email, a.Domain, a.ResponseStatus, a.IsOrganizer)
+	}
+	if batch.Len() == 0 {
+		return nil
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// lowerAll lowercases filter values to match the stored attendee rows
+func lowerAll(values []string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strings.ToLower(strings.TrimSpace(v))
+	}
+	return out
+}
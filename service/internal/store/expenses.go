@@ -0,0 +1,206 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var (
+	ErrExpenseNotFound = errors.New("expense not found")
+	ErrExpenseInvoiced = errors.New("expense is on an invoice")
+)
+
+// Expense is a cost recorded against a project. Billable expenses are put on
+// the project's next invoice covering their date, which locks them.
+type Expense struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	ProjectID   uuid.UUID
+	Date        time.Time
+	Amount      float64
+	Category    string
+	Description *string
+	ReceiptURL  *string
+	IsBillable  bool
+	InvoiceID   *uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ExpenseFilter narrows an expense listing. Nil fields match everything.
+type ExpenseFilter struct {
+	ProjectID *uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+	Billable  *bool
+}
+
+// ExpenseStore provides PostgreSQL-backed expense storage
+type ExpenseStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewExpenseStore creates a new PostgreSQL expense store
+func NewExpenseStore(pool *pgxpool.Pool) *ExpenseStore {
+	return &ExpenseStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ExpenseStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+const expenseColumns = `id, user_id, project_id, date, amount, category, description,
+		       receipt_url, is_billable, invoice_id, created_at, updated_at`
+
+func scanExpense(row pgx.Row) (*Expense, error) {
+	e := &Expense{}
+	err := row.Scan(
+		&e.ID, &e.UserID, &e.ProjectID, &e.Date, &e.Amount, &e.Category, &e.Description,
+		&e.ReceiptURL, &e.IsBillable, &e.InvoiceID, &e.CreatedAt, &e.UpdatedAt,
+	)
+	return e, err
+}
+
+// Create records a new expense
+func (s *ExpenseStore) Create(ctx context.Context, expense *Expense) (*Expense, error) {
+	expense.ID = uuid.New()
+	expense.CreatedAt = time.Now().UTC()
+	expense.UpdatedAt = expense.CreatedAt
+
+	_, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO expenses (id, user_id, project_id, date, amount, category, description,
+		                      receipt_url, is_billable, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, expense.ID, expense.UserID, expense.ProjectID, expense.Date, expense.Amount,
+		expense.Category, expense.Description, expense.ReceiptURL, expense.IsBillable,
+		expense.CreatedAt, expense.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
+// GetByID retrieves one of the user's expenses
+func (s *ExpenseStore) GetByID(ctx context.Context, userID, expenseID uuid.UUID) (*Expense, error) {
+	expense, err := scanExpense(s.db(ctx).QueryRow(ctx, `
+		SELECT `+expenseColumns+`
+		FROM expenses WHERE id = $1 AND user_id = $2
+	`, expenseID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrExpenseNotFound
+		}
+		return nil, err
+	}
+	return expense, nil
+}
+
+// List returns the user's expenses matching filter, oldest first
+func (s *ExpenseStore) List(ctx context.Context, userID uuid.UUID, filter ExpenseFilter) ([]*Expense, error) {
+	query := `
+		SELECT ` + expenseColumns + `
+		FROM expenses WHERE user_id = $1
+	`
+	args := []interface{}{userID}
+	argNum := 2
+
+	if filter.ProjectID != nil {
+		query += fmt.Sprintf(" AND project_id = $%d", argNum)
+		args = append(args, *filter.ProjectID)
+		argNum++
+	}
+	if filter.StartDate != nil {
+		query += fmt.Sprintf(" AND date >= $%d", argNum)
+		args = append(args, *filter.StartDate)
+		argNum++
+	}
+	if filter.EndDate != nil {
+		query += fmt.Sprintf(" AND date <= $%d", argNum)
+		args = append(args, *filter.EndDate)
+		argNum++
+	}
+	if filter.Billable != nil {
+		query += fmt.Sprintf(" AND is_billable = $%d", argNum)
+		args = append(args, *filter.Billable)
+	}
+	query += " ORDER BY date ASC, created_at ASC"
+
+	rows, err := s.db(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expenses []*Expense
+	for rows.Next() {
+		e, err := scanExpense(rows)
+		if err != nil {
+			return nil, err
+		}
+		expenses = append(expenses, e)
+	}
+
+	return expenses, rows.Err()
+}
+
+// Update modifies an expense that isn't on an invoice
+func (s *ExpenseStore) Update(ctx context.Context, userID, expenseID uuid.UUID, updates map[string]interface{}) (*Expense, error) {
+	updates["updated_at"] = time.Now().UTC()
+
+	// Build dynamic update query
+	setClauses := ""
+	args := []interface{}{expenseID, userID}
+	argNum := 3
+
+	for key, value := range updates {
+		if setClauses != "" {
+			setClauses += ", "
+		}
+		setClauses += fmt.Sprintf("%s = $%d", key, argNum)
+		args = append(args, value)
+		argNum++
+	}
+
+	query := "UPDATE expenses SET " + setClauses + " WHERE id = $1 AND user_id = $2 AND invoice_id IS NULL RETURNING " + expenseColumns
+
+	expense, err := scanExpense(s.db(ctx).QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, s.lockedOrMissing(ctx, userID, expenseID)
+		}
+		return nil, err
+	}
+	return expense, nil
+}
+
+// Delete removes an expense that isn't on an invoice
+func (s *ExpenseStore) Delete(ctx context.Context, userID, expenseID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM expenses WHERE id = $1 AND user_id = $2 AND invoice_id IS NULL",
+		expenseID, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return s.lockedOrMissing(ctx, userID, expenseID)
+	}
+	return nil
+}
+
+// lockedOrMissing explains why an expense couldn't be changed
+func (s *ExpenseStore) lockedOrMissing(ctx context.Context, userID, expenseID uuid.UUID) error {
+	if _, err := s.GetByID(ctx, userID, expenseID); err != nil {
+		return err
+	}
+	return ErrExpenseInvoiced
+}
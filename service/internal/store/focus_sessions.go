@@ -0,0 +1,108 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+// ProviderFocus is the provider of the per-user connection that holds focus
+// sessions logged from external timers. It has no credentials, is hidden from
+// the connection list and is never synced.
+const ProviderFocus = "focus"
+
+// focusCalendarExternalID identifies the single calendar of a focus connection
+const focusCalendarExternalID = "focus"
+
+// FocusSession is a block of focused work on a project
+type FocusSession struct {
+	ExternalID string // Timer-supplied ID; re-logging the same ID updates the session
+	ProjectID  uuid.UUID
+	Title      string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// FocusSessionStore records focus sessions as manually classified calendar
+// events on a per-user focus calendar, so they feed the analyzer like any
+// other event
+type FocusSessionStore struct {
+	pool   *pgxpool.Pool
+	events *CalendarEventStore
+}
+
+// NewFocusSessionStore creates a new focus session store
+func NewFocusSessionStore(pool *pgxpool.Pool, events *CalendarEventStore) *FocusSessionStore {
+	return &FocusSessionStore{pool: pool, events: events}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *FocusSessionStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Calendar returns the user's focus connection and calendar, creating them
+// on first use
+func (s *FocusSessionStore) Calendar(ctx context.Context, userID uuid.UUID) (connectionID, calendarID uuid.UUID, err error) {
+	// The no-op update makes RETURNING yield the existing row
+	err = s.db(ctx).QueryRow(ctx, `
+		INSERT INTO calendar_connections (id, user_id, provider, credentials_encrypted)
+		VALUES ($1, $2, $3, ''::bytea)
+		ON CONFLICT (user_id, provider) DO UPDATE SET provider = EXCLUDED.provider
+		RETURNING id
+	`, uuid.New(), userID, ProviderFocus).Scan(&connectionID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	err = s.db(ctx).QueryRow(ctx, `
+		INSERT INTO calendars (id, connection_id, user_id, external_id, name, is_selected)
+		VALUES ($1, $2, $3, $4, 'Focus sessions', true)
+		ON CONFLICT (connection_id, external_id) DO UPDATE SET is_selected = true
+		RETURNING id
+	`, uuid.New(), connectionID, userID, focusCalendarExternalID).Scan(&calendarID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return connectionID, calendarID, nil
+}
+
+// IsFocusSession reports whether an event is on a focus calendar. Only
+// events listed with their calendar can be told apart.
+func IsFocusSession(e *CalendarEvent) bool {
+	return e.CalendarExternalID != nil && *e.CalendarExternalID == focusCalendarExternalID
+}
+
+// Record stores a session as an event classified to its project
+func (s *FocusSessionStore) Record(ctx context.Context, userID uuid.UUID, session FocusSession) (*CalendarEvent, error) {
+	connectionID, calendarID, err := s.Calendar(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	opaque := "opaque"
+	source := SourceManual
+	event, err := s.events.Upsert(ctx, &CalendarEvent{
+		ConnectionID:         connectionID,
+		CalendarID:           &calendarID,
+		UserID:               userID,
+		ExternalID:           session.ExternalID,
+		Title:                session.Title,
+		StartTime:            session.StartTime,
+		EndTime:              session.EndTime,
+		Transparency:         &opaque,
+		ClassificationStatus: StatusClassified,
+		ClassificationSource: &source,
+		ProjectID:            &session.ProjectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Upsert keeps the classification of an existing event; a re-logged
+	// session may have moved to another project
+	return s.events.Classify(ctx, userID, event.ID, &session.ProjectID, false)
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/crypto"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrGitHubConnectionNotFound = errors.New("github connection not found")
+
+// GitHubConnection is a user's GitHub account and access token
+type GitHubConnection struct {
+	UserID    uuid.UUID
+	Username  string
+	Token     string // Decrypted
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// GitHubConnectionStore provides PostgreSQL-backed GitHub connection storage
+type GitHubConnectionStore struct {
+	pool   *pgxpool.Pool
+	crypto *crypto.EncryptionService
+}
+
+// NewGitHubConnectionStore creates a new store
+func NewGitHubConnectionStore(pool *pgxpool.Pool, cryptoSvc *crypto.EncryptionService) *GitHubConnectionStore {
+	return &GitHubConnectionStore{pool: pool, crypto: cryptoSvc}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *GitHubConnectionStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Upsert saves the user's GitHub account, replacing any earlier one
+func (s *GitHubConnectionStore) Upsert(ctx context.Context, userID uuid.UUID, username, token string) (*GitHubConnection, error) {
+	encrypted, err := s.crypto.Encrypt([]byte(token))
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &GitHubConnection{UserID: userID, Username: username, Token: token}
+	now := time.Now().UTC()
+	err = s.db(ctx).QueryRow(ctx, `
+		INSERT INTO github_connections (user_id, username, token_encrypted, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (user_id) DO UPDATE SET
+			username = EXCLUDED.username,
+			token_encrypted = EXCLUDED.token_encrypted,
+			updated_at = EXCLUDED.updated_at
+		RETURNING created_at, updated_at
+	`, userID, username, encrypted, now).Scan(&conn.CreatedAt, &conn.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Get returns the user's GitHub connection with its decrypted token
+func (s *GitHubConnectionStore) Get(ctx context.Context, userID uuid.UUID) (*GitHubConnection, error) {
+	var encrypted []byte
+	conn := &GitHubConnection{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT user_id, username, token_encrypted, created_at, updated_at
+		FROM github_connections WHERE user_id = $1
+	`, userID).Scan(&conn.UserID, &conn.Username, &encrypted, &conn.CreatedAt, &conn.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrGitHubConnectionNotFound
+		}
+		return nil, err
+	}
+
+	decrypted, err := s.crypto.Decrypt(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	conn.Token = string(decrypted)
+	return conn, nil
+}
+
+// Delete removes the user's GitHub connection
+func (s *GitHubConnectionStore) Delete(ctx context.Context, userID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx, "DELETE FROM github_connections WHERE user_id = $1", userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrGitHubConnectionNotFound
+	}
+	return nil
+}
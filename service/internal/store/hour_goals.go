@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrHourGoalNotFound = errors.New("goal not found")
+
+// HourGoal is a weekly or monthly hour target, overall or for one project
+type HourGoal struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	ProjectID    *uuid.UUID // Nil for a goal over all projects
+	Period       string     // week or month
+	TargetHours  float64
+	BillableOnly bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// HourGoalStore provides PostgreSQL-backed hour goal storage
+type HourGoalStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewHourGoalStore creates a new hour goal store
+func NewHourGoalStore(pool *pgxpool.Pool) *HourGoalStore {
+	return &HourGoalStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *HourGoalStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+const hourGoalColumns = `id, user_id, project_id, period, target_hours, billable_only, created_at, updated_at`
+
+func scanHourGoal(row pgx.Row) (*HourGoal, error) {
+	g := &HourGoal{}
+	err := row.Scan(
+		&g.ID, &g.UserID, &g.ProjectID, &g.Period, &g.TargetHours,
+		&g.BillableOnly, &g.CreatedAt, &g.UpdatedAt,
+	)
+	return g, err
+}
+
+// Create adds a goal
+func (s *HourGoalStore) Create(ctx context.Context, userID uuid.UUID, projectID *uuid.UUID, period string, targetHours float64, billableOnly bool) (*HourGoal, error) {
+	return scanHourGoal(s.db(ctx).QueryRow(ctx, `
+		INSERT INTO hour_goals (id, user_id, project_id, period, target_hours, billable_only)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+hourGoalColumns,
+		uuid.New(), userID, projectID, period, targetHours, billableOnly,
+	))
+}
+
+// List returns a user's goals, weekly before monthly and overall goals first
+func (s *HourGoalStore) List(ctx context.Context, userID uuid.UUID) ([]*HourGoal, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+hourGoalColumns+`
+		FROM hour_goals
+		WHERE user_id = $1
+		ORDER BY period DESC, project_id NULLS FIRST, created_at
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var goals []*HourGoal
+	for rows.Next() {
+		g, err := scanHourGoal(rows)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, g)
+	}
+	return goals, rows.Err()
+}
+
+// Update replaces a goal's project, period and target
+func (s *HourGoalStore) Update(ctx context.Context, userID, goalID uuid.UUID, projectID *uuid.UUID, period string, targetHours float64, billableOnly bool) (*HourGoal, error) {
+	g, err := scanHourGoal(s.db(ctx).QueryRow(ctx, `
+		UPDATE hour_goals
+		SET project_id = $3, period = $4, target_hours = $5, billable_only = $6, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+		RETURNING `+hourGoalColumns,
+		goalID, userID, projectID, period, targetHours, billableOnly,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrHourGoalNotFound
+		}
+		return nil, err
+	}
+	return g, nil
+}
+
+// Delete removes a goal
+func (s *HourGoalStore) Delete(ctx context.Context, userID, goalID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM hour_goals WHERE id = $1 AND user_id = $2",
+		goalID, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrHourGoalNotFound
+	}
+	return nil
+}
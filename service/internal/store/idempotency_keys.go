@@ -0,0 +1,145 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+// IdempotencyKeyTTL is how long a stored response can be replayed
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// IdempotencyClaimLease is how long a claimed key without a response blocks
+// retries. After that the request is assumed to have died without releasing
+// the key, and a retry may claim it.
+const IdempotencyClaimLease = 5 * time.Minute
+
+var (
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+	ErrIdempotencyKeyMismatch   = errors.New("idempotency key was used with a different request")
+)
+
+// IdempotentResponse is a stored response for an idempotency key
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyKeyStore provides PostgreSQL-backed idempotency key storage
+type IdempotencyKeyStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewIdempotencyKeyStore creates a new PostgreSQL idempotency key store
+func NewIdempotencyKeyStore(pool *pgxpool.Pool) *IdempotencyKeyStore {
+	return &IdempotencyKeyStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *IdempotencyKeyStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Claim reserves a key for a request. When the caller now owns the key and
+// should run the request, it returns the time of the claim, which identifies
+// it to Complete and Release. When the same request already completed it
+// returns the stored response. Expired keys, and claims older than the
+// lease that never completed, are reclaimed.
+func (s *IdempotencyKeyStore) Claim(ctx context.Context, userID uuid.UUID, key, method, path, requestHash string) (*IdempotentResponse, time.Time, error) {
+	var claimedAt time.Time
+	err := s.db(ctx).QueryRow(ctx, `
+		INSERT INTO idempotency_keys (user_id, key, method, path, request_hash)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, key) DO UPDATE SET
+			method = EXCLUDED.method,
+			path = EXCLUDED.path,
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_at = NOW(),
+			claimed_at = NOW()
+		WHERE idempotency_keys.created_at < NOW() - make_interval(secs => $6)
+			OR (idempotency_keys.status_code IS NULL
+				AND idempotency_keys.claimed_at < NOW() - make_interval(secs => $7))
+		RETURNING claimed_at
+	`, userID, key, method, path, requestHash, IdempotencyKeyTTL.Seconds(), IdempotencyClaimLease.Seconds()).Scan(&claimedAt)
+	if err == nil {
+		return nil, claimedAt, nil
+	}
+	if err != pgx.ErrNoRows {
+		return nil, time.Time{}, err
+	}
+
+	// Key exists and hasn't expired
+	var storedMethod, storedPath, storedHash string
+	var status *int
+	var contentType *string
+	var body []byte
+	err = s.db(ctx).QueryRow(ctx, `
+		SELECT method, path, request_hash, status_code, content_type, response_body
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2
+	`, userID, key).Scan(&storedMethod, &storedPath, &storedHash, &status, &contentType, &body)
+	if err == pgx.ErrNoRows {
+		// Released between the insert and the read; let the caller retry
+		return nil, time.Time{}, ErrIdempotencyKeyInProgress
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if storedMethod != method || storedPath != path || storedHash != requestHash {
+		return nil, time.Time{}, ErrIdempotencyKeyMismatch
+	}
+	if status == nil {
+		return nil, time.Time{}, ErrIdempotencyKeyInProgress
+	}
+
+	resp := &IdempotentResponse{StatusCode: *status, Body: body}
+	if contentType != nil {
+		resp.ContentType = *contentType
+	}
+	return resp, time.Time{}, nil
+}
+
+// Complete stores the response for the claim made at claimedAt. If the
+// claim outlived its lease and a retry reclaimed the key, the key is left
+// to the retry.
+func (s *IdempotencyKeyStore) Complete(ctx context.Context, userID uuid.UUID, key string, claimedAt time.Time, resp *IdempotentResponse) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE idempotency_keys
+		SET status_code = $4, content_type = $5, response_body = $6
+		WHERE user_id = $1 AND key = $2 AND claimed_at = $3 AND status_code IS NULL
+	`, userID, key, claimedAt, resp.StatusCode, resp.ContentType, resp.Body)
+	return err
+}
+
+// Release drops the claim made at claimedAt so the request can be retried,
+// used when the request failed in a way that shouldn't be replayed. A key
+// reclaimed by a retry is left alone.
+func (s *IdempotencyKeyStore) Release(ctx context.Context, userID uuid.UUID, key string, claimedAt time.Time) error {
+	_, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND claimed_at = $3 AND status_code IS NULL",
+		userID, key, claimedAt,
+	)
+	return err
+}
+
+// DeleteExpired removes keys older than the TTL
+func (s *IdempotencyKeyStore) DeleteExpired(ctx context.Context) (int64, error) {
+	tag, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM idempotency_keys WHERE created_at < NOW() - make_interval(secs => $1)",
+		IdempotencyKeyTTL.Seconds(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,93 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestIdempotencyKeyClaimLease checks that a claim which never completed
+// blocks retries only until its lease runs out
+func TestIdempotencyKeyClaimLease(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "idempotency-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	keys := store.NewIdempotencyKeyStore(db.Pool)
+	claim := func() (*store.IdempotentResponse, time.Time, error) {
+		return keys.Claim(ctx, user.ID, "key-1", "POST", "/api/time-entries", "hash")
+	}
+
+	resp, first, err := claim()
+	if err != nil || resp != nil || first.IsZero() {
+		t.Fatalf("expected the first claim to succeed, got %v, %v", resp, err)
+	}
+	if _, _, err := claim(); !errors.Is(err, store.ErrIdempotencyKeyInProgress) {
+		t.Fatalf("expected a retry within the lease to be in progress, got %v", err)
+	}
+
+	// The handler died without releasing the key; once the lease is over a
+	// retry takes it over
+	if _, err := db.Pool.Exec(ctx, `
+		UPDATE idempotency_keys SET claimed_at = NOW() - make_interval(secs => $2)
+		WHERE user_id = $1
+	`, user.ID, store.IdempotencyClaimLease.Seconds()+1); err != nil {
+		t.Fatalf("Failed to age the claim: %v", err)
+	}
+	resp, retry, err := claim()
+	if err != nil || resp != nil || retry.IsZero() {
+		t.Fatalf("expected a retry after the lease to reclaim the key, got %v, %v", resp, err)
+	}
+
+	// The original request finishing late can't touch the retry's claim
+	if err := keys.Complete(ctx, user.ID, "key-1", first, &store.IdempotentResponse{StatusCode: 409}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if err := keys.Release(ctx, user.ID, "key-1", first); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if _, _, err := claim(); !errors.Is(err, store.ErrIdempotencyKeyInProgress) {
+		t.Fatalf("expected the retry's claim to stay in progress, got %v", err)
+	}
+
+	// A completed key is replayed however old its claim is, until it expires
+	if err := keys.Complete(ctx, user.ID, "key-1", retry, &store.IdempotentResponse{StatusCode: 201, Body: []byte("{}")}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if _, err := db.Pool.Exec(ctx, `
+		UPDATE idempotency_keys SET claimed_at = NOW() - make_interval(secs => $2)
+		WHERE user_id = $1
+	`, user.ID, store.IdempotencyClaimLease.Seconds()+1); err != nil {
+		t.Fatalf("Failed to age the claim: %v", err)
+	}
+	resp, _, err = claim()
+	if err != nil || resp == nil || resp.StatusCode != 201 {
+		t.Errorf("expected the stored response to be replayed, got %v, %v", resp, err)
+	}
+}
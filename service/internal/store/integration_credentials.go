@@ -0,0 +1,173 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/crypto"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrIntegrationNotConnected = errors.New("integration not connected")
+
+// credentialKeyVersion identifies how payloads are encrypted: version 1 uses
+// a key derived from the encryption key for the user. A new derivation gets
+// a new version, so older rows can be found and re-encrypted.
+const credentialKeyVersion = 1
+
+// IntegrationCredential is a user's credentials for a third-party
+// integration. Payload is only set when read with Get.
+type IntegrationCredential struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Provider   string
+	Scopes     []string
+	Payload    []byte // Decrypted
+	KeyVersion int
+	RotatedAt  *time.Time // When the payload was last replaced
+	ExpiresAt  *time.Time // When the provider stops accepting it, if known
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// IntegrationCredentialStore is the credentials vault for integrations.
+// Each user's payloads are encrypted with their own derived key.
+type IntegrationCredentialStore struct {
+	pool   *pgxpool.Pool
+	crypto *crypto.EncryptionService
+}
+
+// NewIntegrationCredentialStore creates a new store
+func NewIntegrationCredentialStore(pool *pgxpool.Pool, cryptoSvc *crypto.EncryptionService) *IntegrationCredentialStore {
+	return &IntegrationCredentialStore{pool: pool, crypto: cryptoSvc}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *IntegrationCredentialStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+func (s *IntegrationCredentialStore) userCrypto(userID uuid.UUID) (*crypto.EncryptionService, error) {
+	return s.crypto.ForScope("user:" + userID.String())
+}
+
+const integrationCredentialColumns = `id, user_id, provider, scopes, key_version, rotated_at, expires_at, last_used_at, created_at, updated_at`
+
+func scanIntegrationCredential(row pgx.Row, extra ...any) (*IntegrationCredential, error) {
+	c := &IntegrationCredential{}
+	dest := append([]any{&c.ID, &c.UserID, &c.Provider, &c.Scopes, &c.KeyVersion,
+		&c.RotatedAt, &c.ExpiresAt, &c.LastUsedAt, &c.CreatedAt, &c.UpdatedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Put stores the user's credentials for a provider. Replacing existing
+// credentials records the rotation.
+func (s *IntegrationCredentialStore) Put(ctx context.Context, userID uuid.UUID, provider string, scopes []string, payload []byte, expiresAt *time.Time) (*IntegrationCredential, error) {
+	userCrypto, err := s.userCrypto(userID)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := userCrypto.Encrypt(payload)
+	if err != nil {
+		return nil, err
+	}
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	now := time.Now().UTC()
+	c, err := scanIntegrationCredential(s.db(ctx).QueryRow(ctx, `
+		INSERT INTO integration_credentials (user_id, provider, scopes, payload_encrypted, key_version, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		ON CONFLICT (user_id, provider) DO UPDATE SET
+			scopes = EXCLUDED.scopes,
+			payload_encrypted = EXCLUDED.payload_encrypted,
+			key_version = EXCLUDED.key_version,
+			expires_at = EXCLUDED.expires_at,
+			rotated_at = EXCLUDED.updated_at,
+			updated_at = EXCLUDED.updated_at
+		RETURNING `+integrationCredentialColumns+`
+	`, userID, provider, scopes, encrypted, credentialKeyVersion, expiresAt, now))
+	if err != nil {
+		return nil, err
+	}
+	c.Payload = payload
+	return c, nil
+}
+
+// Get returns the user's credentials for a provider with the decrypted
+// payload, and records that they were used
+func (s *IntegrationCredentialStore) Get(ctx context.Context, userID uuid.UUID, provider string) (*IntegrationCredential, error) {
+	var encrypted []byte
+	c, err := scanIntegrationCredential(s.db(ctx).QueryRow(ctx, `
+		SELECT `+integrationCredentialColumns+`, payload_encrypted
+		FROM integration_credentials
+		WHERE user_id = $1 AND provider = $2
+	`, userID, provider), &encrypted)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIntegrationNotConnected
+		}
+		return nil, err
+	}
+
+	userCrypto, err := s.userCrypto(userID)
+	if err != nil {
+		return nil, err
+	}
+	c.Payload, err = userCrypto.Decrypt(encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = s.db(ctx).Exec(ctx, `
+		UPDATE integration_credentials SET last_used_at = NOW() WHERE id = $1
+	`, c.ID)
+	return c, nil
+}
+
+// List returns the user's connected integrations, without payloads
+func (s *IntegrationCredentialStore) List(ctx context.Context, userID uuid.UUID) ([]*IntegrationCredential, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+integrationCredentialColumns+`
+		FROM integration_credentials
+		WHERE user_id = $1
+		ORDER BY provider
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var creds []*IntegrationCredential
+	for rows.Next() {
+		c, err := scanIntegrationCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, c)
+	}
+	return creds, rows.Err()
+}
+
+// Delete removes the user's credentials for a provider
+func (s *IntegrationCredentialStore) Delete(ctx context.Context, userID uuid.UUID, provider string) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM integration_credentials WHERE user_id = $1 AND provider = $2
+	`, userID, provider)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrIntegrationNotConnected
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// periodMonth identifies one calendar month of a billing period
+type periodMonth struct {
+	PeriodID uuid.UUID
+	Month    time.Time
+}
+
+// monthStart returns the first day of the month containing t
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// findPeriodByID returns the billing period with the given ID, or nil
+func findPeriodByID(periods []*BillingPeriod, id *uuid.UUID) *BillingPeriod {
+	if id == nil {
+		return nil
+	}
+	for _, p := range periods {
+		if p.ID == *id {
+			return p
+		}
+	}
+	return nil
+}
+
+// RoundingRule describes the daily minimum and increment, or "" when the
+// terms have neither
+func (t BillingTerms) RoundingRule() string {
+	var rule string
+	if t.MinDailyHours != nil {
+		rule = fmt.Sprintf("minimum %.2fh per day", *t.MinDailyHours)
+	}
+	if t.IncrementHours != nil {
+		if rule != "" {
+			rule += ", "
+		}
+		rule += fmt.Sprintf("rounded up to %.2fh", *t.IncrementHours)
+	}
+	return rule
+}
+
+// roundDailyHours applies the terms' increment and daily minimum to a day's
+// hours. Days without hours stay at zero.
+func (t BillingTerms) roundDailyHours(hours float64) float64 {
+	if hours <= 0 {
+		return hours
+	}
+	if t.IncrementHours != nil && *t.IncrementHours > 0 {
+		// Tolerate float noise so 1.5 with a 0.25 increment stays 1.5
+		hours = math.Ceil(hours / *t.IncrementHours - 1e-9) * *t.IncrementHours
+	}
+	if t.MinDailyHours != nil && hours < *t.MinDailyHours {
+		hours = *t.MinDailyHours
+	}
+	return math.Round(hours*100) / 100
+}
+
+// applyDailyRounding rounds each day's time lines under a billing period
+// with a minimum or increment. The hours added go on the day's last line,
+// which records them with the rule; its amount is recomputed at its rate.
+func applyDailyRounding(items []InvoiceLineItem, periods []*BillingPeriod) {
+	type periodDay struct {
+		PeriodID uuid.UUID
+		Date     time.Time
+	}
+	days := make(map[periodDay][]int)
+	var order []periodDay
+	for i, item := range items {
+		if item.Kind != LineItemKindTime || item.RateSource != RateSourceProject {
+			continue
+		}
+		period := findPeriodByID(periods, item.RateID)
+		if period == nil || period.RoundingRule() == "" {
+			continue
+		}
+		key := periodDay{period.ID, item.Date}
+		if _, ok := days[key]; !ok {
+			order = append(order, key)
+		}
+		days[key] = append(days[key], i)
+	}
+
+	for _, key := range order {
+		period := findPeriodByID(periods, &key.PeriodID)
+		var tracked float64
+		for _, i := range days[key] {
+			tracked += items[i].Hours
+		}
+		added := period.roundDailyHours(tracked) - tracked
+		if added < 0.005 {
+			continue
+		}
+
+		last := &items[days[key][len(days[key])-1]]
+		rule := period.RoundingRule()
+		last.RoundingHours = math.Round(added*100) / 100
+		last.RoundingRule = &rule
+		last.Hours += last.RoundingHours
+		last.Amount = last.Hours * last.HourlyRate
+	}
+}
+
+// periodCharges builds the fee and overage line items for the fixed-fee and
+// retainer periods overlapping [rangeStart, rangeEnd]. hours holds the hours
+// this invoice bills under each period month.
+//
+// A month's fee is charged once across all invoices, on the first invoice
+// touching that month. Retainer overage is incremental: hours already
+// invoiced in the month count against the included hours first, so that
+// splitting a month over several invoices bills the same overage as one.
+func periodCharges(ctx context.Context, tx pgx.Tx, userID, invoiceID uuid.UUID, periods []*BillingPeriod, hours map[periodMonth]float64, rangeStart, rangeEnd time.Time) ([]InvoiceLineItem, error) {
+	var feePeriods []*BillingPeriod
+	for _, p := range periods {
+		if p.IsHourly() || p.StartsOn.After(rangeEnd) || (p.EndsOn != nil && p.EndsOn.Before(rangeStart)) {
+			continue
+		}
+		feePeriods = append(feePeriods, p)
+	}
+	if len(feePeriods) == 0 {
+		return nil, nil
+	}
+
+	priorFees, priorHours, err := priorPeriodBilling(ctx, tx, userID, feePeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []InvoiceLineItem
+	for _, p := range feePeriods {
+		first, last := rangeStart, rangeEnd
+		if p.StartsOn.After(first) {
+			first = p.StartsOn
+		}
+		if p.EndsOn != nil && p.EndsOn.Before(last) {
+			last = *p.EndsOn
+		}
+
+		for month := monthStart(first); !month.After(last); month = month.AddDate(0, 1, 0) {
+			key := periodMonth{p.ID, month}
+			label := month.Format("January 2006")
+
+			if !priorFees[key] && p.FixedFee != nil {
+				date := month
+				if first.After(date) {
+					date = first
+				}
+				desc := "Monthly fee, " + label
+				if p.BillingMode == BillingModeRetainer {
+					desc = "Retainer, " + label
+				}
+				items = append(items, InvoiceLineItem{
+					ID:          uuid.New(),
+					InvoiceID:   invoiceID,
+					Kind:        LineItemKindFee,
+					Date:        date,
+					Description: desc,
+					Amount:      *p.FixedFee,
+					RateSource:  RateSourceProject,
+					RateID:      &p.ID,
+				})
+			}
+
+			if p.BillingMode != BillingModeRetainer || p.IncludedHours == nil || p.OverageRate == nil {
+				continue
+			}
+			included := *p.IncludedHours
+			overage := excessHours(priorHours[key]+hours[key], included) - excessHours(priorHours[key], included)
+			if overage <= 0 {
+				continue
+			}
+			date := month.AddDate(0, 1, -1)
+			if last.Before(date) {
+				date = last
+			}
+			items = append(items, InvoiceLineItem{
+				ID:          uuid.New(),
+				InvoiceID:   invoiceID,
+				Kind:        LineItemKindOverage,
+				Date:        date,
+				Description: fmt.Sprintf("Overage, %s (%.2fh included)", label, included),
+				Hours:       overage,
+				HourlyRate:  *p.OverageRate,
+				Amount:      overage * *p.OverageRate,
+				RateSource:  RateSourceProject,
+				RateID:      &p.ID,
+			})
+		}
+	}
+	return items, nil
+}
+
+// excessHours returns how far hours goes beyond included, or 0
+func excessHours(hours, included float64) float64 {
+	if hours <= included {
+		return 0
+	}
+	return hours - included
+}
+
+// priorPeriodBilling reports, per period month, whether a fee has already
+// been invoiced and how many hours have been invoiced under the period.
+// Credit notes are ignored; deleting an invoice releases its month.
+func priorPeriodBilling(ctx context.Context, tx pgx.Tx, userID uuid.UUID, periods []*BillingPeriod) (map[periodMonth]bool, map[periodMonth]float64, error) {
+	ids := make([]uuid.UUID, len(periods))
+	for i, p := range periods {
+		ids[i] = p.ID
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT ili.rate_id, ili.kind, ili.date, ili.hours
+		FROM invoice_line_items ili
+		JOIN invoices i ON i.id = ili.invoice_id
+		WHERE i.user_id = $1
+		  AND i.kind = 'invoice'
+		  AND ili.rate_id = ANY($2)
+		  AND ili.kind IN ('time', 'fee')
+	`, userID, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	fees := make(map[periodMonth]bool)
+	hours := make(map[periodMonth]float64)
+	for rows.Next() {
+		var periodID uuid.UUID
+		var kind string
+		var date time.Time
+		var h float64
+		if err := rows.Scan(&periodID, &kind, &date, &h); err != nil {
+			return nil, nil, err
+		}
+		key := periodMonth{periodID, monthStart(date)}
+		if kind == LineItemKindFee {
+			fees[key] = true
+		} else {
+			hours[key] += h
+		}
+	}
+	return fees, hours, rows.Err()
+}
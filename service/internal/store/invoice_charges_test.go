@@ -0,0 +1,148 @@
+package store
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func hoursPtr(h float64) *float64 {
+	return &h
+}
+
+func TestRoundDailyHours(t *testing.T) {
+	minimum := BillingTerms{MinDailyHours: hoursPtr(2)}
+	quarter := BillingTerms{IncrementHours: hoursPtr(0.25)}
+	tenth := BillingTerms{IncrementHours: hoursPtr(0.1)}
+	both := BillingTerms{MinDailyHours: hoursPtr(1), IncrementHours: hoursPtr(0.5)}
+
+	tests := []struct {
+		name  string
+		terms BillingTerms
+		hours float64
+		want  float64
+	}{
+		{"no rule", BillingTerms{}, 1.234, 1.23},
+		{"no rule, zero day", BillingTerms{}, 0, 0},
+
+		{"minimum, below", minimum, 0.5, 2},
+		{"minimum, exactly", minimum, 2, 2},
+		{"minimum, above", minimum, 3.3, 3.3},
+		{"minimum, zero day", minimum, 0, 0},
+
+		{"increment, on a boundary", quarter, 1.5, 1.5},
+		{"increment, just past a boundary", quarter, 1.51, 1.75},
+		{"increment, just below a boundary", quarter, 1.74, 1.75},
+		{"increment, under one increment", quarter, 0.05, 0.25},
+		{"increment, zero day", quarter, 0, 0},
+		{"increment, float noise", tenth, 0.1 + 0.2, 0.3},
+		{"increment of zero is ignored", BillingTerms{IncrementHours: hoursPtr(0)}, 1.3, 1.3},
+
+		{"both, under the minimum after rounding", both, 0.2, 1},
+		{"both, rounded past the minimum", both, 1.2, 1.5},
+		{"both, on a boundary", both, 2, 2},
+		{"both, zero day", both, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.terms.roundDailyHours(tt.hours); got != tt.want {
+				t.Errorf("roundDailyHours(%v) = %v, want %v", tt.hours, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyDailyRounding(t *testing.T) {
+	rounded := &BillingPeriod{ID: uuid.New(), HourlyRate: 100, BillingTerms: BillingTerms{MinDailyHours: hoursPtr(2), IncrementHours: hoursPtr(0.5)}}
+	plain := &BillingPeriod{ID: uuid.New(), HourlyRate: 80}
+	periods := []*BillingPeriod{rounded, plain}
+
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+	line := func(period *BillingPeriod, date time.Time, hours float64) InvoiceLineItem {
+		return InvoiceLineItem{
+			Kind:       LineItemKindTime,
+			Date:       date,
+			Hours:      hours,
+			HourlyRate: period.HourlyRate,
+			Amount:     hours * period.HourlyRate,
+			RateSource: RateSourceProject,
+			RateID:     &period.ID,
+		}
+	}
+
+	tests := []struct {
+		name  string
+		items []InvoiceLineItem
+		// Hours and rounding each line ends up with
+		wantHours    []float64
+		wantRounding []float64
+	}{
+		{
+			name:         "one line under the minimum",
+			items:        []InvoiceLineItem{line(rounded, monday, 0.5)},
+			wantHours:    []float64{2},
+			wantRounding: []float64{1.5},
+		},
+		{
+			name:         "several lines on a day round their total onto the last",
+			items:        []InvoiceLineItem{line(rounded, monday, 1.2), line(rounded, monday, 1.1)},
+			wantHours:    []float64{1.2, 1.3},
+			wantRounding: []float64{0, 0.2},
+		},
+		{
+			name:         "days are rounded separately",
+			items:        []InvoiceLineItem{line(rounded, monday, 1), line(rounded, tuesday, 2.25)},
+			wantHours:    []float64{2, 2.5},
+			wantRounding: []float64{1, 0.25},
+		},
+		{
+			name:         "a day already on a boundary is left alone",
+			items:        []InvoiceLineItem{line(rounded, monday, 1.5), line(rounded, monday, 1)},
+			wantHours:    []float64{1.5, 1},
+			wantRounding: []float64{0, 0},
+		},
+		{
+			name:         "a day without hours gets no minimum",
+			items:        []InvoiceLineItem{line(rounded, monday, 0)},
+			wantHours:    []float64{0},
+			wantRounding: []float64{0},
+		},
+		{
+			name:         "lines under a period without a rule are left alone",
+			items:        []InvoiceLineItem{line(plain, monday, 0.1), line(rounded, monday, 0.1)},
+			wantHours:    []float64{0.1, 2},
+			wantRounding: []float64{0, 1.9},
+		},
+		{
+			name: "only project-rated time lines count",
+			items: func() []InvoiceLineItem {
+				expense := line(rounded, monday, 0)
+				expense.Kind = LineItemKindExpense
+				client := line(rounded, monday, 0.5)
+				client.RateSource = RateSourceClient
+				return []InvoiceLineItem{expense, client}
+			}(),
+			wantHours:    []float64{0, 0.5},
+			wantRounding: []float64{0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyDailyRounding(tt.items, periods)
+			for i, item := range tt.items {
+				if math.Abs(item.Hours-tt.wantHours[i]) > 1e-9 || item.RoundingHours != tt.wantRounding[i] {
+					t.Errorf("line %d: hours %v rounding %v, want %v and %v", i, item.Hours, item.RoundingHours, tt.wantHours[i], tt.wantRounding[i])
+				}
+				if math.Abs(item.Amount-item.Hours*item.HourlyRate) > 1e-9 {
+					t.Errorf("line %d: amount %v doesn't match %v hours at %v", i, item.Amount, item.Hours, item.HourlyRate)
+				}
+				if (item.RoundingHours > 0) != (item.RoundingRule != nil) {
+					t.Errorf("line %d: rounding %v with rule %v", i, item.RoundingHours, item.RoundingRule)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrInvoiceCommentNotFound = errors.New("invoice comment not found")
+
+// InvoiceComment is a review note left on an invoice
+type InvoiceComment struct {
+	ID        uuid.UUID
+	InvoiceID uuid.UUID
+	UserID    uuid.UUID
+	Body      string
+	CreatedAt time.Time
+}
+
+// InvoiceCommentStore provides PostgreSQL-backed invoice comment storage
+type InvoiceCommentStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewInvoiceCommentStore creates a new PostgreSQL invoice comment store
+func NewInvoiceCommentStore(pool *pgxpool.Pool) *InvoiceCommentStore {
+	return &InvoiceCommentStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *InvoiceCommentStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Add leaves a comment on one of the user's invoices
+func (s *InvoiceCommentStore) Add(ctx context.Context, userID, invoiceID uuid.UUID, body string) (*InvoiceComment, error) {
+	comment := &InvoiceComment{
+		ID:        uuid.New(),
+		InvoiceID: invoiceID,
+		UserID:    userID,
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	result, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO invoice_comments (id, invoice_id, user_id, body, created_at)
+		SELECT $1, i.id, $3, $4, $5
+		FROM invoices i
+		WHERE i.id = $2 AND i.user_id = $3
+	`, comment.ID, comment.InvoiceID, comment.UserID, comment.Body, comment.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrInvoiceNotFound
+	}
+	return comment, nil
+}
+
+// List returns the comments on one of the user's invoices, oldest first
+func (s *InvoiceCommentStore) List(ctx context.Context, userID, invoiceID uuid.UUID) ([]*InvoiceComment, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT c.id, c.invoice_id, c.user_id, c.body, c.created_at
+		FROM invoice_comments c
+		JOIN invoices i ON c.invoice_id = i.id
+		WHERE i.id = $1 AND i.user_id = $2
+		ORDER BY c.created_at ASC
+	`, invoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*InvoiceComment
+	for rows.Next() {
+		c := &InvoiceComment{}
+		if err := rows.Scan(&c.ID, &c.InvoiceID, &c.UserID, &c.Body, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+// Delete removes a comment the user left on one of their invoices
+func (s *InvoiceCommentStore) Delete(ctx context.Context, userID, invoiceID, commentID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM invoice_comments
+		WHERE id = $1 AND invoice_id = $2 AND user_id = $3
+	`, commentID, invoiceID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrInvoiceCommentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrInvoiceExportNotFound = errors.New("invoice export not found")
+
+// InvoiceExport records the most recent export of an invoice in one format
+type InvoiceExport struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	InvoiceID   uuid.UUID
+	Format      string
+	ContentType string
+	Filename    string
+	Content     []byte  // Rendered file, for file formats
+	ExternalID  *string // Remote document ID, for external formats
+	ExternalURL *string
+	Metadata    map[string]interface{}
+	ExportedAt  time.Time
+}
+
+// InvoiceExportStore provides PostgreSQL-backed invoice export storage
+type InvoiceExportStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewInvoiceExportStore creates a new PostgreSQL invoice export store
+func NewInvoiceExportStore(pool *pgxpool.Pool) *InvoiceExportStore {
+	return &InvoiceExportStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *InvoiceExportStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Save records an export, replacing any earlier export of the same invoice and format
+func (s *InvoiceExportStore) Save(ctx context.Context, export *InvoiceExport) (*InvoiceExport, error) {
+	if export.Metadata == nil {
+		export.Metadata = map[string]interface{}{}
+	}
+	export.ExportedAt = time.Now().UTC()
+
+	err := s.db(ctx).QueryRow(ctx, `
+		INSERT INTO invoice_exports (
+			id, user_id, invoice_id, format, content_type, filename,
+			content, external_id, external_url, metadata, exported_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (invoice_id, format) DO UPDATE SET
+			content_type = EXCLUDED.content_type,
+			filename = EXCLUDED.filename,
+			content = EXCLUDED.content,
+			external_id = EXCLUDED.external_id,
+			external_url = EXCLUDED.external_url,
+			metadata = EXCLUDED.metadata,
+			exported_at = EXCLUDED.exported_at
+		RETURNING id
+	`, uuid.New(), export.UserID, export.InvoiceID, export.Format, export.ContentType,
+		export.Filename, export.Content, export.ExternalID, export.ExternalURL,
+		export.Metadata, export.ExportedAt).Scan(&export.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// GetByID retrieves an export including its content
+func (s *InvoiceExportStore) GetByID(ctx context.Context, userID, exportID uuid.UUID) (*InvoiceExport, error) {
+	e := &InvoiceExport{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, user_id, invoice_id, format, content_type, filename,
+		       content, external_id, external_url, metadata, exported_at
+		FROM invoice_exports
+		WHERE id = $1 AND user_id = $2
+	`, exportID, userID).Scan(&e.ID, &e.UserID, &e.InvoiceID, &e.Format, &e.ContentType,
+		&e.Filename, &e.Content, &e.ExternalID, &e.ExternalURL, &e.Metadata, &e.ExportedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvoiceExportNotFound
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// GetForInvoice retrieves the latest export of an invoice in a format (without content)
+func (s *InvoiceExportStore) GetForInvoice(ctx context.Context, userID, invoiceID uuid.UUID, format string) (*InvoiceExport, error) {
+	e := &InvoiceExport{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, user_id, invoice_id, format, content_type, filename,
+		       external_id, external_url, metadata, exported_at
+		FROM invoice_exports
+		WHERE invoice_id = $1 AND user_id = $2 AND format = $3
+	`, invoiceID, userID, format).Scan(&e.ID, &e.UserID, &e.InvoiceID, &e.Format, &e.ContentType,
+		&e.Filename, &e.ExternalID, &e.ExternalURL, &e.Metadata, &e.ExportedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvoiceExportNotFound
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+// ListByInvoice retrieves all exports of an invoice (without content)
+func (s *InvoiceExportStore) ListByInvoice(ctx context.Context, userID, invoiceID uuid.UUID) ([]*InvoiceExport, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, user_id, invoice_id, format, content_type, filename,
+		       external_id, external_url, metadata, exported_at
+		FROM invoice_exports
+		WHERE invoice_id = $1 AND user_id = $2
+		ORDER BY format
+	`, invoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var exports []*InvoiceExport
+	for rows.Next() {
+		e := &InvoiceExport{}
+		if err := rows.Scan(&e.ID, &e.UserID, &e.InvoiceID, &e.Format, &e.ContentType,
+			&e.Filename, &e.ExternalID, &e.ExternalURL, &e.Metadata, &e.ExportedAt); err != nil {
+			return nil, err
+		}
+		exports = append(exports, e)
+	}
+
+	return exports, rows.Err()
+}
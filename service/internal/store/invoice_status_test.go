@@ -0,0 +1,39 @@
+package store
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"draft", "review", true},
+		{"draft", "sent", true},
+		{"draft", "paid", false},
+		{"review", "draft", true},
+		{"review", "sent", true},
+		{"review", "paid", false},
+		{"sent", "draft", true},
+		{"sent", "paid", true},
+		{"sent", "review", false},
+		{"paid", "draft", true},
+		{"paid", "sent", true},
+		{"paid", "review", false},
+		{"void", "draft", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			if got := canTransition(tt.from, tt.to); got != tt.want {
+				t.Errorf("canTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsIssued(t *testing.T) {
+	for status, want := range map[string]bool{"draft": false, "review": false, "sent": true, "paid": true} {
+		if got := isIssued(status); got != want {
+			t.Errorf("isIssued(%q) = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
 )
 
 var (
@@ -18,8 +21,60 @@ var (
 	ErrInvoiceNotDraft     = errors.New("invoice is not a draft")
 	ErrNoUnbilledEntries   = errors.New("no unbilled entries found in date range")
 	ErrInvalidStatusChange = errors.New("invalid status change")
+	ErrLineItemNotFound    = errors.New("invoice line item not found")
+	ErrNotAdjustment       = errors.New("line item is not an adjustment")
+	ErrCreditNoteTarget    = errors.New("credit notes can only be issued against sent or paid invoices")
+	ErrCreditExceedsTotal  = errors.New("credits exceed the original invoice total")
+	ErrHasCreditNotes      = errors.New("invoice has credit notes")
+	ErrStatusTransition    = errors.New("status transition not allowed")
+)
+
+// Invoice kinds
+const (
+	InvoiceKindInvoice    = "invoice"
+	InvoiceKindCreditNote = "credit_note"
 )
 
+// invoiceTransitions lists the statuses an invoice may move to from each
+// status. Review sits between draft and sent; paid is reached from sent.
+var invoiceTransitions = map[string][]string{
+	"draft":  {"review", "sent"},
+	"review": {"draft", "sent"},
+	"sent":   {"draft", "paid"},
+	"paid":   {"draft", "sent"},
+}
+
+// canTransition reports whether an invoice may move from one status to another
+func canTransition(from, to string) bool {
+	for _, next := range invoiceTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// isIssued reports whether an invoice in the given status has gone to the
+// client, so that it counts towards balances and can take payments
+func isIssued(status string) bool {
+	return status == "sent" || status == "paid"
+}
+
+// Line item kinds
+const (
+	LineItemKindTime       = "time"
+	LineItemKindExpense    = "expense"
+	LineItemKindAdjustment = "adjustment"
+	LineItemKindFee        = "fee"
+	LineItemKindOverage    = "overage"
+)
+
+// HasHours reports whether the line item bills hours at a rate, rather than
+// a fixed amount (adjustments, expenses and fees)
+func (item InvoiceLineItem) HasHours() bool {
+	return item.Kind == LineItemKindTime || item.Kind == LineItemKindOverage
+}
+
 // Invoice represents a stored invoice
 type Invoice struct {
 	ID               uuid.UUID
@@ -31,8 +86,14 @@ type Invoice struct {
 	PeriodEnd        time.Time
 	InvoiceDate      time.Time
 	Status           string
+	Kind             string
+	// OriginalInvoiceID is set on credit notes to the invoice being corrected
+	OriginalInvoiceID *uuid.UUID
 	TotalHours       float64
 	TotalAmount      float64
+	AmountPaid       float64 // Sum of recorded payments
+	BalanceDue       float64 // Total less issued credit notes and payments
+	// Sheets export details, joined from invoice_exports
 	SpreadsheetID    *string
 	SpreadsheetURL   *string
 	WorksheetID      *int
@@ -47,32 +108,56 @@ type Invoice struct {
 type InvoiceLineItem struct {
 	ID          uuid.UUID
 	InvoiceID   uuid.UUID
-	TimeEntryID uuid.UUID
+	TimeEntryID *uuid.UUID // set on time line items only
+	ExpenseID   *uuid.UUID // set on expense line items
+	Kind        string
 	Date        time.Time
 	Description string
 	Hours       float64
 	HourlyRate  float64
 	Amount      float64
+	RateSource  string     // "project", "client" or "none"
+	RateID      *uuid.UUID // Billing period or client rate applied at creation
+	// RoundingHours is how much of Hours the period's daily minimum or
+	// increment added; RoundingRule describes that rule
+	RoundingHours float64
+	RoundingRule  *string
+	// Joined data, loaded only for exports that include notes
+	Notes []*TimeEntryNote
 }
 
+// Line item rate sources
+const (
+	RateSourceProject = "project"
+	RateSourceClient  = "client"
+	RateSourceNone    = "none"
+)
+
 // InvoiceStore provides PostgreSQL-backed invoice storage
 type InvoiceStore struct {
 	pool           *pgxpool.Pool
 	timeEntries    *TimeEntryStore
 	billingPeriods *BillingPeriodStore
+	clientRates    *ClientRateStore
 	projects       *ProjectStore
 }
 
 // NewInvoiceStore creates a new PostgreSQL invoice store
-func NewInvoiceStore(pool *pgxpool.Pool, timeEntries *TimeEntryStore, billingPeriods *BillingPeriodStore, projects *ProjectStore) *InvoiceStore {
+func NewInvoiceStore(pool *pgxpool.Pool, timeEntries *TimeEntryStore, billingPeriods *BillingPeriodStore, clientRates *ClientRateStore, projects *ProjectStore) *InvoiceStore {
 	return &InvoiceStore{
 		pool:           pool,
 		timeEntries:    timeEntries,
 		billingPeriods: billingPeriods,
+		clientRates:    clientRates,
 		projects:       projects,
 	}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *InvoiceStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // generateInvoiceNumber creates an invoice number in format PROJECT-YEAR-SEQ
 func (s *InvoiceStore) generateInvoiceNumber(ctx context.Context, tx pgx.Tx, userID, projectID uuid.UUID, invoiceDate time.Time) (string, error) {
 	// Get project for short_code or name
@@ -111,6 +196,7 @@ func (s *InvoiceStore) generateInvoiceNumber(ctx context.Context, tx pgx.Tx, use
 		FROM invoices
 		WHERE user_id = $1
 		  AND project_id = $2
+		  AND kind = 'invoice'
 		  AND EXTRACT(YEAR FROM invoice_date) = $3
 	`, userID, projectID, year).Scan(&maxSeq)
 	if err != nil {
@@ -124,12 +210,18 @@ func (s *InvoiceStore) generateInvoiceNumber(ctx context.Context, tx pgx.Tx, use
 // Create generates an invoice from unbilled time entries
 func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID, periodStart, periodEnd, invoiceDate time.Time) (*Invoice, error) {
 	// Start transaction
-	tx, err := s.pool.Begin(ctx)
+	tx, err := s.db(ctx).Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback(ctx)
 
+	// Generated line descriptions are in the user's language
+	loc, err := userLocale(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Fetch unbilled time entries in date range
 	rows, err := tx.Query(ctx, `
 		SELECT id, project_id, date, hours, title, description
@@ -139,6 +231,7 @@ func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID,
 		  AND date >= $3
 		  AND date <= $4
 		  AND invoice_id IS NULL
+		  AND deleted_at IS NULL
 		ORDER BY date ASC
 	`, userID, projectID, periodStart, periodEnd)
 	if err != nil {
@@ -175,8 +268,35 @@ func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID,
 		return nil, err
 	}
 
-	if len(timeEntries) == 0 {
-		return nil, ErrNoUnbilledEntries
+	// Billable expenses in the period go on the invoice alongside the time
+	expenseRows, err := tx.Query(ctx, `
+		SELECT id, date, amount, category, description
+		FROM expenses
+		WHERE user_id = $1
+		  AND project_id = $2
+		  AND date >= $3
+		  AND date <= $4
+		  AND is_billable = true
+		  AND invoice_id IS NULL
+		ORDER BY date ASC, created_at ASC
+	`, userID, projectID, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var expenses []Expense
+	for expenseRows.Next() {
+		var expense Expense
+		if err := expenseRows.Scan(&expense.ID, &expense.Date, &expense.Amount, &expense.Category, &expense.Description); err != nil {
+			expenseRows.Close()
+			return nil, err
+		}
+		expenses = append(expenses, expense)
+	}
+	expenseRows.Close()
+
+	if err := expenseRows.Err(); err != nil {
+		return nil, err
 	}
 
 	// Fetch all billing periods for this project once (instead of N queries)
@@ -185,6 +305,19 @@ func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID,
 		return nil, err
 	}
 
+	// Client rates are the fallback when no project billing period covers a date
+	var clientRates []*ClientRate
+	project, err := s.projects.GetByID(ctx, userID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.Client != nil && *project.Client != "" && s.clientRates != nil {
+		clientRates, err = s.clientRates.List(ctx, userID, project.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Helper function to find billing period for a date
 	findPeriodForDate := func(date time.Time) *BillingPeriod {
 		for _, period := range billingPeriods {
@@ -195,6 +328,15 @@ func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID,
 		return nil
 	}
 
+	findClientRateForDate := func(date time.Time) *ClientRate {
+		for _, rate := range clientRates {
+			if rate.Covers(date) {
+				return rate
+			}
+		}
+		return nil
+	}
+
 	// Generate invoice number
 	invoiceNumber, err := s.generateInvoiceNumber(ctx, tx, userID, projectID, invoiceDate)
 	if err != nil {
@@ -211,30 +353,47 @@ func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID,
 		PeriodEnd:     periodEnd,
 		InvoiceDate:   invoiceDate,
 		Status:        "draft",
+		Kind:          InvoiceKindInvoice,
 		TotalHours:    0,
 		TotalAmount:   0,
 		CreatedAt:     time.Now().UTC(),
 		UpdatedAt:     time.Now().UTC(),
 	}
 
-	// Create line items and calculate totals
+	// Create line items and calculate totals. Each day's hours are rounded
+	// by its period's minimum and increment, if any. Hours under fixed-fee
+	// and retainer periods are listed at no charge and tallied per month so
+	// the period's fee and overage lines can be added afterwards.
 	var lineItems []InvoiceLineItem
+	periodHours := make(map[periodMonth]float64)
 	for _, entry := range timeEntries {
-		// Find billing period for this date (in-memory lookup)
+		// Find billing period for this date (in-memory lookup), falling back
+		// to the client's rate. The rate is snapshotted on the line item so
+		// later edits to either table don't change issued invoices.
 		period := findPeriodForDate(entry.Date)
 		var hourlyRate float64
 		var billingPeriodID *uuid.UUID
+		rateSource := RateSourceNone
+		var rateID *uuid.UUID
 
-		if period == nil {
-			// No billing period found - use $0/hr
-			hourlyRate = 0
-		} else {
-			hourlyRate = period.HourlyRate
+		if period != nil {
+			if period.IsHourly() {
+				hourlyRate = period.HourlyRate
+			}
 			billingPeriodID = &period.ID
+			rateSource = RateSourceProject
+			rateID = billingPeriodID
 			// Set invoice's billing_period_id to the first one found
 			if invoice.BillingPeriodID == nil {
 				invoice.BillingPeriodID = billingPeriodID
 			}
+		} else if clientRate := findClientRateForDate(entry.Date); clientRate != nil {
+			hourlyRate = clientRate.HourlyRate
+			rateSource = RateSourceClient
+			rateID = &clientRate.ID
+		} else {
+			// No rate found - use $0/hr
+			hourlyRate = 0
 		}
 
 		amount := entry.Hours * hourlyRate
@@ -249,50 +408,76 @@ func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID,
 		} else if entry.Description != nil {
 			desc = *entry.Description
 		} else {
-			desc = "Time entry"
+			desc = loc.T(locale.TimeEntryLine)
 		}
 
 		lineItem := InvoiceLineItem{
 			ID:          uuid.New(),
 			InvoiceID:   invoice.ID,
-			TimeEntryID: entry.ID,
+			TimeEntryID: &entry.ID,
+			Kind:        LineItemKindTime,
 			Date:        entry.Date,
 			Description: desc,
 			Hours:       entry.Hours,
 			HourlyRate:  hourlyRate,
 			Amount:      amount,
+			RateSource:  rateSource,
+			RateID:      rateID,
 		}
 		lineItems = append(lineItems, lineItem)
+	}
 
-		invoice.TotalHours += entry.Hours
-		invoice.TotalAmount += amount
+	applyDailyRounding(lineItems, billingPeriods)
+	for _, item := range lineItems {
+		invoice.TotalHours += item.Hours
+		invoice.TotalAmount += item.Amount
+		if period := findPeriodByID(billingPeriods, item.RateID); period != nil && !period.IsHourly() {
+			periodHours[periodMonth{period.ID, monthStart(item.Date)}] += item.Hours
+		}
 	}
 
-	// Insert invoice
-	_, err = tx.Exec(ctx, `
-		INSERT INTO invoices (
-			id, user_id, project_id, billing_period_id, invoice_number,
-			period_start, period_end, invoice_date, status,
-			total_hours, total_amount, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
-	`, invoice.ID, invoice.UserID, invoice.ProjectID, invoice.BillingPeriodID,
-		invoice.InvoiceNumber, invoice.PeriodStart, invoice.PeriodEnd,
-		invoice.InvoiceDate, invoice.Status, invoice.TotalHours,
-		invoice.TotalAmount, invoice.CreatedAt, invoice.UpdatedAt)
+	charges, err := periodCharges(ctx, tx, userID, invoice.ID, billingPeriods, periodHours, periodStart, periodEnd)
 	if err != nil {
 		return nil, err
 	}
+	for _, item := range charges {
+		if invoice.BillingPeriodID == nil {
+			invoice.BillingPeriodID = item.RateID
+		}
+		lineItems = append(lineItems, item)
+		invoice.TotalAmount += item.Amount
+	}
+
+	for _, expense := range expenses {
+		desc := expense.Category
+		if expense.Description != nil && *expense.Description != "" {
+			desc = desc + " - " + *expense.Description
+		}
+		lineItems = append(lineItems, InvoiceLineItem{
+			ID:          uuid.New(),
+			InvoiceID:   invoice.ID,
+			ExpenseID:   &expense.ID,
+			Kind:        LineItemKindExpense,
+			Date:        expense.Date,
+			Description: desc,
+			Amount:      expense.Amount,
+			RateSource:  RateSourceNone,
+		})
+		invoice.TotalAmount += expense.Amount
+	}
+
+	if len(lineItems) == 0 {
+		return nil, ErrNoUnbilledEntries
+	}
+
+	// Insert invoice
+	if err := insertInvoice(ctx, tx, invoice); err != nil {
+		return nil, err
+	}
 
 	// Insert line items
 	for _, item := range lineItems {
-		_, err = tx.Exec(ctx, `
-			INSERT INTO invoice_line_items (
-				id, invoice_id, time_entry_id, date, description,
-				hours, hourly_rate, amount
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		`, item.ID, item.InvoiceID, item.TimeEntryID, item.Date,
-			item.Description, item.Hours, item.HourlyRate, item.Amount)
-		if err != nil {
+		if err := insertLineItem(ctx, tx, item); err != nil {
 			return nil, err
 		}
 	}
@@ -311,41 +496,87 @@ func (s *InvoiceStore) Create(ctx context.Context, userID, projectID uuid.UUID,
 		return nil, err
 	}
 
+	// Invoiced expenses are locked the same way
+	if len(expenses) > 0 {
+		expenseIDs := make([]uuid.UUID, len(expenses))
+		for i, e := range expenses {
+			expenseIDs[i] = e.ID
+		}
+		_, err = tx.Exec(ctx, `
+			UPDATE expenses
+			SET invoice_id = $1, updated_at = NOW()
+			WHERE id = ANY($2)
+		`, invoice.ID, expenseIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Commit transaction
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
 	invoice.LineItems = lineItems
-
-	// Load project data
-	project, err := s.projects.GetByID(ctx, userID, projectID)
-	if err == nil {
-		invoice.Project = project
-	}
+	invoice.Project = project
 
 	return invoice, nil
 }
 
+// insertInvoice writes the invoice row (without line items)
+func insertInvoice(ctx context.Context, tx pgx.Tx, invoice *Invoice) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO invoices (
+			id, user_id, project_id, billing_period_id, invoice_number,
+			period_start, period_end, invoice_date, status, kind, original_invoice_id,
+			total_hours, total_amount, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`, invoice.ID, invoice.UserID, invoice.ProjectID, invoice.BillingPeriodID,
+		invoice.InvoiceNumber, invoice.PeriodStart, invoice.PeriodEnd,
+		invoice.InvoiceDate, invoice.Status, invoice.Kind, invoice.OriginalInvoiceID,
+		invoice.TotalHours, invoice.TotalAmount, invoice.CreatedAt, invoice.UpdatedAt)
+	return err
+}
+
+// insertLineItem writes a single line item
+func insertLineItem(ctx context.Context, tx pgx.Tx, item InvoiceLineItem) error {
+	rateSource := item.RateSource
+	if rateSource == "" {
+		rateSource = RateSourceNone
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO invoice_line_items (
+			id, invoice_id, time_entry_id, expense_id, kind, date, description,
+			hours, hourly_rate, amount, rate_source, rate_id, rounding_hours, rounding_rule
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`, item.ID, item.InvoiceID, item.TimeEntryID, item.ExpenseID, item.Kind, item.Date,
+		item.Description, item.Hours, item.HourlyRate, item.Amount,
+		rateSource, item.RateID, item.RoundingHours, item.RoundingRule)
+	return err
+}
+
 // GetByID retrieves an invoice with line items and project data
 func (s *InvoiceStore) GetByID(ctx context.Context, userID, invoiceID uuid.UUID) (*Invoice, error) {
 	invoice := &Invoice{Project: &Project{}}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT i.id, i.user_id, i.project_id, i.billing_period_id,
 		       i.invoice_number, i.period_start, i.period_end,
-		       i.invoice_date, i.status, i.total_hours, i.total_amount,
-		       i.spreadsheet_id, i.spreadsheet_url, i.worksheet_id,
+		       i.invoice_date, i.status, i.kind, i.original_invoice_id,
+		       i.total_hours, i.total_amount,`+invoiceBalanceSQL+`,
+		       ie.external_id, ie.external_url, (ie.metadata->>'worksheet_id')::int,
 		       i.created_at, i.updated_at,
 		       p.id, p.user_id, p.name, p.short_code, p.client, p.color,
 		       p.is_billable, p.is_archived, p.is_hidden_by_default,
 		       p.does_not_accumulate_hours, p.created_at, p.updated_at
 		FROM invoices i
 		JOIN projects p ON i.project_id = p.id
+		LEFT JOIN invoice_exports ie ON ie.invoice_id = i.id AND ie.format = 'sheets'
 		WHERE i.id = $1 AND i.user_id = $2
 	`, invoiceID, userID).Scan(
 		&invoice.ID, &invoice.UserID, &invoice.ProjectID, &invoice.BillingPeriodID,
 		&invoice.InvoiceNumber, &invoice.PeriodStart, &invoice.PeriodEnd,
-		&invoice.InvoiceDate, &invoice.Status, &invoice.TotalHours, &invoice.TotalAmount,
+		&invoice.InvoiceDate, &invoice.Status, &invoice.Kind, &invoice.OriginalInvoiceID,
+		&invoice.TotalHours, &invoice.TotalAmount, &invoice.AmountPaid, &invoice.BalanceDue,
 		&invoice.SpreadsheetID, &invoice.SpreadsheetURL, &invoice.WorksheetID,
 		&invoice.CreatedAt, &invoice.UpdatedAt,
 		// Project fields
@@ -362,40 +593,58 @@ func (s *InvoiceStore) GetByID(ctx context.Context, userID, invoiceID uuid.UUID)
 		return nil, err
 	}
 
-	// Load line items - JOIN to time_entries for current hours/date/description
-	// Amount is recalculated as hours × rate to stay in sync with time entry
-	// (for sent/paid invoices, time entries are locked so values won't change)
-	rows, err := s.pool.Query(ctx, `
-		SELECT ili.id, ili.invoice_id, ili.time_entry_id,
-		       te.date,
-		       COALESCE(te.title || CASE WHEN te.description IS NOT NULL AND te.description != '' THEN ' - ' || te.description ELSE '' END, te.description, 'Time entry') as description,
-		       te.hours, ili.hourly_rate, te.hours * ili.hourly_rate as amount
+	lineItems, err := s.ListLineItems(ctx, userID, []uuid.UUID{invoiceID})
+	if err != nil {
+		return nil, err
+	}
+	invoice.LineItems = lineItems[invoiceID]
+	return invoice, nil
+}
+
+// ListLineItems loads the line items of several of the user's invoices in
+// one query, keyed by invoice ID. Invoices without line items, or that
+// belong to another user, are absent from the result.
+//
+// Line items JOIN to time_entries for current hours/date/description.
+// Amount is recalculated as hours × rate to stay in sync with time entry
+// (for sent/paid invoices, time entries are locked so values won't change).
+// Rounding added at creation stays on top of the entry's hours.
+// Entries without a title or description keep the localized text stored
+// at creation.
+// Adjustments, expenses and fees have no time entry and use their stored values.
+func (s *InvoiceStore) ListLineItems(ctx context.Context, userID uuid.UUID, invoiceIDs []uuid.UUID) (map[uuid.UUID][]InvoiceLineItem, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT ili.id, ili.invoice_id, ili.time_entry_id, ili.expense_id, ili.kind,
+		       COALESCE(te.date, ili.date),
+		       CASE WHEN te.id IS NULL THEN COALESCE(ili.description, 'Adjustment')
+		            ELSE COALESCE(te.title || CASE WHEN te.description IS NOT NULL AND te.description != '' THEN ' - ' || te.description ELSE '' END, te.description, ili.description, 'Time entry')
+		       END as description,
+		       COALESCE(te.hours + ili.rounding_hours, ili.hours), ili.hourly_rate,
+		       CASE WHEN te.id IS NULL THEN ili.amount ELSE (te.hours + ili.rounding_hours) * ili.hourly_rate END as amount,
+		       ili.rate_source, ili.rate_id, ili.rounding_hours, ili.rounding_rule
 		FROM invoice_line_items ili
-		JOIN time_entries te ON ili.time_entry_id = te.id
-		WHERE ili.invoice_id = $1
-		ORDER BY te.date ASC
-	`, invoiceID)
+		JOIN invoices i ON i.id = ili.invoice_id
+		LEFT JOIN time_entries te ON ili.time_entry_id = te.id
+		WHERE ili.invoice_id = ANY($2) AND i.user_id = $1
+		ORDER BY COALESCE(te.date, ili.date) ASC, ili.kind DESC
+	`, userID, invoiceIDs)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var lineItems []InvoiceLineItem
+	lineItems := make(map[uuid.UUID][]InvoiceLineItem)
 	for rows.Next() {
 		var item InvoiceLineItem
-		if err := rows.Scan(&item.ID, &item.InvoiceID, &item.TimeEntryID,
-			&item.Date, &item.Description, &item.Hours, &item.HourlyRate, &item.Amount); err != nil {
+		if err := rows.Scan(&item.ID, &item.InvoiceID, &item.TimeEntryID, &item.ExpenseID, &item.Kind,
+			&item.Date, &item.Description, &item.Hours, &item.HourlyRate, &item.Amount,
+			&item.RateSource, &item.RateID, &item.RoundingHours, &item.RoundingRule); err != nil {
 			return nil, err
 		}
-		lineItems = append(lineItems, item)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, err
+		lineItems[item.InvoiceID] = append(lineItems[item.InvoiceID], item)
 	}
 
-	invoice.LineItems = lineItems
-	return invoice, nil
+	return lineItems, rows.Err()
 }
 
 // List retrieves all invoices for a user with optional filters
@@ -403,14 +652,16 @@ func (s *InvoiceStore) List(ctx context.Context, userID uuid.UUID, projectID *uu
 	query := `
 		SELECT i.id, i.user_id, i.project_id, i.billing_period_id,
 		       i.invoice_number, i.period_start, i.period_end,
-		       i.invoice_date, i.status, i.total_hours, i.total_amount,
-		       i.spreadsheet_id, i.spreadsheet_url, i.worksheet_id,
+		       i.invoice_date, i.status, i.kind, i.original_invoice_id,
+		       i.total_hours, i.total_amount,`+invoiceBalanceSQL+`,
+		       ie.external_id, ie.external_url, (ie.metadata->>'worksheet_id')::int,
 		       i.created_at, i.updated_at,
 		       p.id, p.user_id, p.name, p.short_code, p.client, p.color,
 		       p.is_billable, p.is_archived, p.is_hidden_by_default,
 		       p.does_not_accumulate_hours, p.created_at, p.updated_at
 		FROM invoices i
 		JOIN projects p ON i.project_id = p.id
+		LEFT JOIN invoice_exports ie ON ie.invoice_id = i.id AND ie.format = 'sheets'
 		WHERE i.user_id = $1
 	`
 
@@ -431,7 +682,7 @@ func (s *InvoiceStore) List(ctx context.Context, userID uuid.UUID, projectID *uu
 
 	query += " ORDER BY i.invoice_date DESC, i.created_at DESC"
 
-	rows, err := s.pool.Query(ctx, query, args...)
+	rows, err := s.db(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -443,7 +694,8 @@ func (s *InvoiceStore) List(ctx context.Context, userID uuid.UUID, projectID *uu
 		if err := rows.Scan(
 			&invoice.ID, &invoice.UserID, &invoice.ProjectID, &invoice.BillingPeriodID,
 			&invoice.InvoiceNumber, &invoice.PeriodStart, &invoice.PeriodEnd,
-			&invoice.InvoiceDate, &invoice.Status, &invoice.TotalHours, &invoice.TotalAmount,
+			&invoice.InvoiceDate, &invoice.Status, &invoice.Kind, &invoice.OriginalInvoiceID,
+			&invoice.TotalHours, &invoice.TotalAmount, &invoice.AmountPaid, &invoice.BalanceDue,
 			&invoice.SpreadsheetID, &invoice.SpreadsheetURL, &invoice.WorksheetID,
 			&invoice.CreatedAt, &invoice.UpdatedAt,
 			// Project fields
@@ -468,12 +720,12 @@ func (s *InvoiceStore) List(ctx context.Context, userID uuid.UUID, projectID *uu
 // UpdateStatus updates an invoice status and handles time entry locking
 func (s *InvoiceStore) UpdateStatus(ctx context.Context, userID, invoiceID uuid.UUID, newStatus string) (*Invoice, error) {
 	// Validate status
-	if newStatus != "draft" && newStatus != "sent" && newStatus != "paid" {
+	if _, ok := invoiceTransitions[newStatus]; !ok {
 		return nil, ErrInvalidStatusChange
 	}
 
 	// Start transaction
-	tx, err := s.pool.Begin(ctx)
+	tx, err := s.db(ctx).Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -481,11 +733,15 @@ func (s *InvoiceStore) UpdateStatus(ctx context.Context, userID, invoiceID uuid.
 
 	// Get current invoice status
 	var currentStatus string
+	var originalID *uuid.UUID
+	var creditNotes, payments int
 	err = tx.QueryRow(ctx, `
-		SELECT status
+		SELECT status, original_invoice_id,
+		       (SELECT COUNT(*) FROM invoices cn WHERE cn.original_invoice_id = invoices.id),
+		       (SELECT COUNT(*) FROM payments pay WHERE pay.invoice_id = invoices.id)
 		FROM invoices
 		WHERE id = $1 AND user_id = $2
-	`, invoiceID, userID).Scan(&currentStatus)
+	`, invoiceID, userID).Scan(&currentStatus, &originalID, &creditNotes, &payments)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrInvoiceNotFound
@@ -498,6 +754,17 @@ func (s *InvoiceStore) UpdateStatus(ctx context.Context, userID, invoiceID uuid.
 		// No change needed
 		return s.GetByID(ctx, userID, invoiceID)
 	}
+	if !canTransition(currentStatus, newStatus) {
+		return nil, ErrStatusTransition
+	}
+
+	// An invoice that has been corrected by credit notes must stay issued
+	if newStatus == "draft" && creditNotes > 0 {
+		return nil, ErrHasCreditNotes
+	}
+	if newStatus == "draft" && payments > 0 {
+		return nil, ErrHasPayments
+	}
 
 	// Note: Time entries have invoice_id set at invoice creation time and remain
 	// locked regardless of invoice status changes. Only deleting the invoice
@@ -513,6 +780,13 @@ func (s *InvoiceStore) UpdateStatus(ctx context.Context, userID, invoiceID uuid.
 		return nil, err
 	}
 
+	// Issuing or withdrawing a credit note changes the original's balance
+	if originalID != nil {
+		if err := syncPaidStatus(ctx, tx, *originalID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Commit transaction
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
@@ -525,7 +799,7 @@ func (s *InvoiceStore) UpdateStatus(ctx context.Context, userID, invoiceID uuid.
 // Delete removes an invoice (only allowed for draft invoices)
 func (s *InvoiceStore) Delete(ctx context.Context, userID, invoiceID uuid.UUID) error {
 	// Start transaction
-	tx, err := s.pool.Begin(ctx)
+	tx, err := s.db(ctx).Begin(ctx)
 	if err != nil {
 		return err
 	}
@@ -547,7 +821,7 @@ func (s *InvoiceStore) Delete(ctx context.Context, userID, invoiceID uuid.UUID)
 		return ErrInvoiceNotDraft
 	}
 
-	// Clear invoice_id on time entries (unlocks them for editing)
+	// Clear invoice_id on time entries and expenses (unlocks them for editing)
 	_, err = tx.Exec(ctx, `
 		UPDATE time_entries SET invoice_id = NULL, updated_at = NOW()
 		WHERE invoice_id = $1
@@ -555,6 +829,13 @@ func (s *InvoiceStore) Delete(ctx context.Context, userID, invoiceID uuid.UUID)
 	if err != nil {
 		return err
 	}
+	_, err = tx.Exec(ctx, `
+		UPDATE expenses SET invoice_id = NULL, updated_at = NOW()
+		WHERE invoice_id = $1
+	`, invoiceID)
+	if err != nil {
+		return err
+	}
 
 	// Delete line items
 	_, err = tx.Exec(ctx, `
@@ -579,23 +860,249 @@ func (s *InvoiceStore) Delete(ctx context.Context, userID, invoiceID uuid.UUID)
 	return tx.Commit(ctx)
 }
 
-// UpdateSpreadsheetInfo updates the Sheets export metadata
-func (s *InvoiceStore) UpdateSpreadsheetInfo(ctx context.Context, userID, invoiceID uuid.UUID, spreadsheetID, spreadsheetURL string, worksheetID int) error {
-	result, err := s.pool.Exec(ctx, `
-		UPDATE invoices
-		SET spreadsheet_id = $1,
-		    spreadsheet_url = $2,
-		    worksheet_id = $3,
-		    updated_at = NOW()
-		WHERE id = $4 AND user_id = $5
-	`, spreadsheetID, spreadsheetURL, worksheetID, invoiceID, userID)
+// AddAdjustment adds a fixed-amount line item to a draft invoice or credit note.
+// Amount may be negative (a discount or correction).
+func (s *InvoiceStore) AddAdjustment(ctx context.Context, userID, invoiceID uuid.UUID, date time.Time, description string, amount float64) (*Invoice, error) {
+	tx, err := s.db(ctx).Begin(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer tx.Rollback(ctx)
 
-	if result.RowsAffected() == 0 {
-		return ErrInvoiceNotFound
+	status, kind, originalID, err := lockInvoice(ctx, tx, userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if status != "draft" {
+		return nil, ErrInvoiceNotDraft
+	}
+
+	item := InvoiceLineItem{
+		ID:          uuid.New(),
+		InvoiceID:   invoiceID,
+		Kind:        LineItemKindAdjustment,
+		Date:        date,
+		Description: description,
+		Amount:      amount,
+	}
+	if err := insertLineItem(ctx, tx, item); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE invoices SET total_amount = total_amount + $1, updated_at = NOW()
+		WHERE id = $2 AND user_id = $3
+	`, amount, invoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind == InvoiceKindCreditNote {
+		if err := checkCreditBalance(ctx, tx, *originalID, invoiceID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, userID, invoiceID)
+}
+
+// RemoveAdjustment deletes an adjustment line item from a draft invoice or credit note
+func (s *InvoiceStore) RemoveAdjustment(ctx context.Context, userID, invoiceID, lineItemID uuid.UUID) (*Invoice, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	status, kind, originalID, err := lockInvoice(ctx, tx, userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if status != "draft" {
+		return nil, ErrInvoiceNotDraft
+	}
+
+	var itemKind string
+	var amount float64
+	err = tx.QueryRow(ctx, `
+		SELECT kind, amount FROM invoice_line_items WHERE id = $1 AND invoice_id = $2
+	`, lineItemID, invoiceID).Scan(&itemKind, &amount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrLineItemNotFound
+		}
+		return nil, err
+	}
+	if itemKind != LineItemKindAdjustment {
+		return nil, ErrNotAdjustment
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM invoice_line_items WHERE id = $1`, lineItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE invoices SET total_amount = total_amount - $1, updated_at = NOW()
+		WHERE id = $2 AND user_id = $3
+	`, amount, invoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind == InvoiceKindCreditNote {
+		if err := checkCreditBalance(ctx, tx, *originalID, invoiceID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, userID, invoiceID)
+}
+
+// CreditNoteLine is a single credit on a credit note. Amount is the positive
+// value being credited; it is stored as a negative adjustment.
+type CreditNoteLine struct {
+	Description string
+	Amount      float64
+}
+
+// CreateCreditNote issues a draft credit note against a sent or paid invoice.
+// With no lines, the credit note reverses whatever balance remains on the
+// original after earlier credit notes.
+func (s *InvoiceStore) CreateCreditNote(ctx context.Context, userID, originalID uuid.UUID, invoiceDate time.Time, lines []CreditNoteLine) (*Invoice, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	original := &Invoice{}
+	err = tx.QueryRow(ctx, `
+		SELECT id, project_id, invoice_number, period_start, period_end, status, kind, total_amount
+		FROM invoices
+		WHERE id = $1 AND user_id = $2
+		FOR UPDATE
+	`, originalID, userID).Scan(&original.ID, &original.ProjectID, &original.InvoiceNumber,
+		&original.PeriodStart, &original.PeriodEnd, &original.Status, &original.Kind, &original.TotalAmount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+	if original.Kind != InvoiceKindInvoice || !isIssued(original.Status) {
+		return nil, ErrCreditNoteTarget
 	}
 
+	var existing int
+	var credited float64
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*), COALESCE(SUM(total_amount), 0)
+		FROM invoices
+		WHERE original_invoice_id = $1
+	`, originalID).Scan(&existing, &credited)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 {
+		remaining := original.TotalAmount + credited
+		if remaining <= 0 {
+			return nil, ErrCreditExceedsTotal
+		}
+		lines = []CreditNoteLine{{
+			Description: fmt.Sprintf("Credit for invoice %s", original.InvoiceNumber),
+			Amount:      remaining,
+		}}
+	}
+
+	creditNote := &Invoice{
+		ID:                uuid.New(),
+		UserID:            userID,
+		ProjectID:         original.ProjectID,
+		InvoiceNumber:     fmt.Sprintf("%s-CN%d", original.InvoiceNumber, existing+1),
+		PeriodStart:       original.PeriodStart,
+		PeriodEnd:         original.PeriodEnd,
+		InvoiceDate:       invoiceDate,
+		Status:            "draft",
+		Kind:              InvoiceKindCreditNote,
+		OriginalInvoiceID: &original.ID,
+		CreatedAt:         time.Now().UTC(),
+		UpdatedAt:         time.Now().UTC(),
+	}
+
+	var lineItems []InvoiceLineItem
+	for _, line := range lines {
+		item := InvoiceLineItem{
+			ID:          uuid.New(),
+			InvoiceID:   creditNote.ID,
+			Kind:        LineItemKindAdjustment,
+			Date:        invoiceDate,
+			Description: line.Description,
+			Amount:      -line.Amount,
+		}
+		lineItems = append(lineItems, item)
+		creditNote.TotalAmount += item.Amount
+	}
+
+	if err := insertInvoice(ctx, tx, creditNote); err != nil {
+		return nil, err
+	}
+	for _, item := range lineItems {
+		if err := insertLineItem(ctx, tx, item); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkCreditBalance(ctx, tx, original.ID, creditNote.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.GetByID(ctx, userID, creditNote.ID)
+}
+
+// lockInvoice reads and row-locks an invoice before its line items change
+func lockInvoice(ctx context.Context, tx pgx.Tx, userID, invoiceID uuid.UUID) (status, kind string, originalID *uuid.UUID, err error) {
+	err = tx.QueryRow(ctx, `
+		SELECT status, kind, original_invoice_id
+		FROM invoices
+		WHERE id = $1 AND user_id = $2
+		FOR UPDATE
+	`, invoiceID, userID).Scan(&status, &kind, &originalID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = ErrInvoiceNotFound
+	}
+	return status, kind, originalID, err
+}
+
+// checkCreditBalance verifies that a credit note is a net credit and that all
+// credit notes together don't exceed the original invoice total
+func checkCreditBalance(ctx context.Context, tx pgx.Tx, originalID, creditNoteID uuid.UUID) error {
+	var noteTotal, balance float64
+	err := tx.QueryRow(ctx, `
+		SELECT
+			(SELECT total_amount FROM invoices WHERE id = $2),
+			(SELECT total_amount FROM invoices WHERE id = $1) +
+			COALESCE((SELECT SUM(total_amount) FROM invoices WHERE original_invoice_id = $1), 0)
+	`, originalID, creditNoteID).Scan(&noteTotal, &balance)
+	if err != nil {
+		return err
+	}
+	// Compare in cents to avoid float noise from DECIMAL round-trips
+	if toCents(noteTotal) > 0 || toCents(balance) < 0 {
+		return ErrCreditExceedsTotal
+	}
 	return nil
 }
@@ -4,6 +4,7 @@ package store_test
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -45,7 +46,7 @@ func TestInvoiceTimeEntryProtection(t *testing.T) {
 	projectStore := store.NewProjectStore(db.Pool)
 	timeEntryStore := store.NewTimeEntryStore(db.Pool)
 	billingPeriodStore := store.NewBillingPeriodStore(db.Pool)
-	invoiceStore := store.NewInvoiceStore(db.Pool)
+	invoiceStore := store.NewInvoiceStore(db.Pool, timeEntryStore, billingPeriodStore, store.NewClientRateStore(db.Pool), projectStore)
 
 	// Create test user
 	testEmail := "invoice-test-" + uuid.New().String()[:8] + "@test.com"
@@ -56,14 +57,14 @@ func TestInvoiceTimeEntryProtection(t *testing.T) {
 	defer cleanupUser(t, db.Pool, user.ID)
 
 	// Create test project
-	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, nil, nil, false, false, nil, nil, nil)
+	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, "#000000", false, false, false)
 	if err != nil {
 		t.Fatalf("Failed to create test project: %v", err)
 	}
 
 	// Create billing period
 	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	billingPeriod, err := billingPeriodStore.Create(ctx, user.ID, project.ID, startDate, nil, 100.00)
+	_, err = billingPeriodStore.Create(ctx, user.ID, project.ID, startDate, nil, 100.00, store.BillingTerms{})
 	if err != nil {
 		t.Fatalf("Failed to create billing period: %v", err)
 	}
@@ -94,7 +95,7 @@ func TestInvoiceTimeEntryProtection(t *testing.T) {
 
 	// Create invoice with the time entries
 	periodEnd := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
-	invoice, err := invoiceStore.Create(ctx, user.ID, project.ID, &billingPeriod.ID, startDate, periodEnd)
+	invoice, err := invoiceStore.Create(ctx, user.ID, project.ID, startDate, periodEnd, periodEnd)
 	if err != nil {
 		t.Fatalf("Failed to create invoice: %v", err)
 	}
@@ -178,3 +179,231 @@ func cleanupUser(t *testing.T, pool *pgxpool.Pool, userID uuid.UUID) {
 		t.Logf("Warning: failed to cleanup test user: %v", err)
 	}
 }
+
+// TestInvoiceCreditNotes covers adjustments on drafts and credit notes
+// against issued invoices
+func TestInvoiceCreditNotes(t *testing.T) {
+	f := newInvoiceFixture(t)
+	ctx := context.Background()
+	invoices := f.invoices
+	date := f.invoice.InvoiceDate
+
+	t.Run("adjustments change a draft's total", func(t *testing.T) {
+		inv, err := invoices.AddAdjustment(ctx, f.userID, f.invoice.ID, date, "Discount", -50)
+		if err != nil {
+			t.Fatalf("AddAdjustment: %v", err)
+		}
+		if inv.TotalAmount != 450 {
+			t.Errorf("expected total 450 after a 50 discount, got %v", inv.TotalAmount)
+		}
+
+		var adjustment, timeLine uuid.UUID
+		for _, item := range inv.LineItems {
+			switch item.Kind {
+			case store.LineItemKindAdjustment:
+				adjustment = item.ID
+			case store.LineItemKindTime:
+				timeLine = item.ID
+			}
+		}
+		if _, err := invoices.RemoveAdjustment(ctx, f.userID, f.invoice.ID, timeLine); !errors.Is(err, store.ErrNotAdjustment) {
+			t.Errorf("expected removing a time line to fail with ErrNotAdjustment, got %v", err)
+		}
+		if _, err := invoices.RemoveAdjustment(ctx, f.userID, f.invoice.ID, uuid.New()); !errors.Is(err, store.ErrLineItemNotFound) {
+			t.Errorf("expected ErrLineItemNotFound, got %v", err)
+		}
+		inv, err = invoices.RemoveAdjustment(ctx, f.userID, f.invoice.ID, adjustment)
+		if err != nil {
+			t.Fatalf("RemoveAdjustment: %v", err)
+		}
+		if inv.TotalAmount != 500 {
+			t.Errorf("expected total back at 500, got %v", inv.TotalAmount)
+		}
+	})
+
+	if _, err := invoices.CreateCreditNote(ctx, f.userID, f.invoice.ID, date, nil); !errors.Is(err, store.ErrCreditNoteTarget) {
+		t.Errorf("expected a draft to refuse credit notes, got %v", err)
+	}
+	if _, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "sent"); err != nil {
+		t.Fatalf("Failed to send invoice: %v", err)
+	}
+	if _, err := invoices.AddAdjustment(ctx, f.userID, f.invoice.ID, date, "Late fee", 25); !errors.Is(err, store.ErrInvoiceNotDraft) {
+		t.Errorf("expected a sent invoice to refuse adjustments, got %v", err)
+	}
+
+	refund, err := invoices.CreateCreditNote(ctx, f.userID, f.invoice.ID, date, []store.CreditNoteLine{{Description: "Refund", Amount: 200}})
+	if err != nil {
+		t.Fatalf("CreateCreditNote: %v", err)
+	}
+	if refund.Kind != store.InvoiceKindCreditNote || refund.TotalAmount != -200 || refund.Status != "draft" {
+		t.Errorf("expected a -200 draft credit note, got %s %v %s", refund.Kind, refund.TotalAmount, refund.Status)
+	}
+	if refund.OriginalInvoiceID == nil || *refund.OriginalInvoiceID != f.invoice.ID {
+		t.Errorf("expected the credit note to point at the original, got %v", refund.OriginalInvoiceID)
+	}
+	if want := f.invoice.InvoiceNumber + "-CN1"; refund.InvoiceNumber != want {
+		t.Errorf("expected number %s, got %s", want, refund.InvoiceNumber)
+	}
+
+	t.Run("credits can't exceed the original", func(t *testing.T) {
+		if _, err := invoices.CreateCreditNote(ctx, f.userID, f.invoice.ID, date, []store.CreditNoteLine{{Description: "Too much", Amount: 400}}); !errors.Is(err, store.ErrCreditExceedsTotal) {
+			t.Errorf("expected ErrCreditExceedsTotal, got %v", err)
+		}
+		// A credit note has to stay a net credit
+		if _, err := invoices.AddAdjustment(ctx, f.userID, refund.ID, date, "Charge", 250); !errors.Is(err, store.ErrCreditExceedsTotal) {
+			t.Errorf("expected a net charge to be refused, got %v", err)
+		}
+	})
+
+	// Without lines, a credit note reverses what is left
+	rest, err := invoices.CreateCreditNote(ctx, f.userID, f.invoice.ID, date, nil)
+	if err != nil {
+		t.Fatalf("CreateCreditNote: %v", err)
+	}
+	if rest.TotalAmount != -300 || rest.InvoiceNumber != f.invoice.InvoiceNumber+"-CN2" {
+		t.Errorf("expected %s-CN2 for -300, got %s for %v", f.invoice.InvoiceNumber, rest.InvoiceNumber, rest.TotalAmount)
+	}
+	if _, err := invoices.CreateCreditNote(ctx, f.userID, f.invoice.ID, date, nil); !errors.Is(err, store.ErrCreditExceedsTotal) {
+		t.Errorf("expected nothing left to credit, got %v", err)
+	}
+
+	if _, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "draft"); !errors.Is(err, store.ErrHasCreditNotes) {
+		t.Errorf("expected a credited invoice to stay issued, got %v", err)
+	}
+}
+
+// TestInvoiceReview covers the review status and its comments. A review
+// invoice is locked but not issued, so it can't be paid or credited.
+func TestInvoiceReview(t *testing.T) {
+	f := newInvoiceFixture(t)
+	ctx := context.Background()
+	invoices := f.invoices
+	date := f.invoice.InvoiceDate
+
+	inv, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "review")
+	if err != nil {
+		t.Fatalf("UpdateStatus review: %v", err)
+	}
+	if inv.Status != "review" {
+		t.Errorf("expected status review, got %s", inv.Status)
+	}
+
+	if _, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "paid"); !errors.Is(err, store.ErrStatusTransition) {
+		t.Errorf("expected review to paid to be rejected, got %v", err)
+	}
+	if _, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "void"); !errors.Is(err, store.ErrInvalidStatusChange) {
+		t.Errorf("expected an unknown status to be rejected, got %v", err)
+	}
+	if _, err := invoices.AddAdjustment(ctx, f.userID, f.invoice.ID, date, "Discount", -50); !errors.Is(err, store.ErrInvoiceNotDraft) {
+		t.Errorf("expected a review invoice to be locked, got %v", err)
+	}
+	if _, err := store.NewPaymentStore(f.pool).Record(ctx, f.userID, f.invoice.ID, 100, date, nil, nil); !errors.Is(err, store.ErrInvoiceNotPayable) {
+		t.Errorf("expected a review invoice not to take payments, got %v", err)
+	}
+	if _, err := invoices.CreateCreditNote(ctx, f.userID, f.invoice.ID, date, nil); !errors.Is(err, store.ErrCreditNoteTarget) {
+		t.Errorf("expected a review invoice not to take credit notes, got %v", err)
+	}
+
+	t.Run("comments", func(t *testing.T) {
+		comments := store.NewInvoiceCommentStore(f.pool)
+		first, err := comments.Add(ctx, f.userID, f.invoice.ID, "Check the hours")
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		if _, err := comments.Add(ctx, f.userID, f.invoice.ID, "Looks good"); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		if _, err := comments.Add(ctx, uuid.New(), f.invoice.ID, "Not mine"); !errors.Is(err, store.ErrInvoiceNotFound) {
+			t.Errorf("expected commenting on another user's invoice to be not found, got %v", err)
+		}
+
+		list, err := comments.List(ctx, f.userID, f.invoice.ID)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if len(list) != 2 || list[0].ID != first.ID {
+			t.Fatalf("expected two comments oldest first, got %v", list)
+		}
+		if other, err := comments.List(ctx, uuid.New(), f.invoice.ID); err != nil || len(other) != 0 {
+			t.Errorf("expected no comments for another user, got %v, %v", other, err)
+		}
+
+		if err := comments.Delete(ctx, f.userID, f.invoice.ID, first.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if err := comments.Delete(ctx, f.userID, f.invoice.ID, first.ID); !errors.Is(err, store.ErrInvoiceCommentNotFound) {
+			t.Errorf("expected deleting twice to be not found, got %v", err)
+		}
+	})
+
+	// Review can go back to draft or on to sent
+	if _, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "draft"); err != nil {
+		t.Fatalf("UpdateStatus draft: %v", err)
+	}
+	if _, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "review"); err != nil {
+		t.Fatalf("UpdateStatus review: %v", err)
+	}
+	if inv, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "sent"); err != nil || inv.Status != "sent" {
+		t.Fatalf("expected review to move on to sent, got %v", err)
+	}
+	if _, err := invoices.UpdateStatus(ctx, f.userID, f.invoice.ID, "review"); !errors.Is(err, store.ErrStatusTransition) {
+		t.Errorf("expected a sent invoice not to return to review, got %v", err)
+	}
+}
+
+// invoiceFixture is a test user with an hourly project and a draft invoice
+// for five hours at 100
+type invoiceFixture struct {
+	pool     *pgxpool.Pool
+	invoices *store.InvoiceStore
+	userID   uuid.UUID
+	invoice  *store.Invoice
+}
+
+func newInvoiceFixture(t *testing.T) *invoiceFixture {
+	t.Helper()
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	t.Cleanup(db.Close)
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	projectStore := store.NewProjectStore(db.Pool)
+	timeEntryStore := store.NewTimeEntryStore(db.Pool)
+	billingPeriodStore := store.NewBillingPeriodStore(db.Pool)
+	invoiceStore := store.NewInvoiceStore(db.Pool, timeEntryStore, billingPeriodStore, store.NewClientRateStore(db.Pool), projectStore)
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "invoice-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	t.Cleanup(func() { cleanupUser(t, db.Pool, user.ID) })
+
+	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	if _, err := billingPeriodStore.Create(ctx, user.ID, project.ID, start, nil, 100, store.BillingTerms{}); err != nil {
+		t.Fatalf("Failed to create billing period: %v", err)
+	}
+	if _, err := timeEntryStore.Create(ctx, user.ID, project.ID, start.AddDate(0, 0, 14), 5, nil); err != nil {
+		t.Fatalf("Failed to create time entry: %v", err)
+	}
+	invoice, err := invoiceStore.Create(ctx, user.ID, project.ID, start, end, end)
+	if err != nil {
+		t.Fatalf("Failed to create invoice: %v", err)
+	}
+
+	return &invoiceFixture{pool: db.Pool, invoices: invoiceStore, userID: user.ID, invoice: invoice}
+}
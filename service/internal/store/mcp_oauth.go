@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var (
@@ -22,12 +23,14 @@ var (
 	ErrCodeChallengeInvalid  = errors.New("code verifier does not match challenge")
 	ErrMCPTokenNotFound      = errors.New("MCP access token not found")
 	ErrMCPTokenExpired       = errors.New("MCP access token expired")
+	ErrOAuthClientMismatch   = errors.New("authorization code was issued to another client")
 )
 
 // MCPOAuthSession represents an in-progress OAuth authorization
 type MCPOAuthSession struct {
 	ID                  uuid.UUID
 	State               string
+	ClientID            *string
 	CodeChallenge       string
 	CodeChallengeMethod string
 	RedirectURI         string
@@ -65,6 +68,11 @@ func NewMCPOAuthStore(pool *pgxpool.Pool) *MCPOAuthStore {
 	return &MCPOAuthStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *MCPOAuthStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // generateState creates a random state parameter
 func generateState() (string, error) {
 	b := make([]byte, 32)
@@ -118,8 +126,9 @@ func verifyCodeChallenge(verifier, challenge, method string) bool {
 	return computed == challenge
 }
 
-// CreateSession starts a new OAuth authorization session
-func (s *MCPOAuthStore) CreateSession(ctx context.Context, codeChallenge, codeChallengeMethod, redirectURI string) (*MCPOAuthSession, error) {
+// CreateSession starts a new OAuth authorization session. clientID is nil
+// when the user authorizes directly rather than through a registered client.
+func (s *MCPOAuthStore) CreateSession(ctx context.Context, clientID *string, codeChallenge, codeChallengeMethod, redirectURI string) (*MCPOAuthSession, error) {
 	state, err := generateState()
 	if err != nil {
 		return nil, err
@@ -128,6 +137,7 @@ func (s *MCPOAuthStore) CreateSession(ctx context.Context, codeChallenge, codeCh
 	session := &MCPOAuthSession{
 		ID:                  uuid.New(),
 		State:               state,
+		ClientID:            clientID,
 		CodeChallenge:       codeChallenge,
 		CodeChallengeMethod: codeChallengeMethod,
 		RedirectURI:         redirectURI,
@@ -135,10 +145,10 @@ func (s *MCPOAuthStore) CreateSession(ctx context.Context, codeChallenge, codeCh
 		ExpiresAt:           time.Now().UTC().Add(10 * time.Minute),
 	}
 
-	_, err = s.pool.Exec(ctx, `
-		INSERT INTO mcp_oauth_sessions (id, state, code_challenge, code_challenge_method, redirect_uri, created_at, expires_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-	`, session.ID, session.State, session.CodeChallenge, session.CodeChallengeMethod,
+	_, err = s.db(ctx).Exec(ctx, `
+		INSERT INTO mcp_oauth_sessions (id, state, client_id, code_challenge, code_challenge_method, redirect_uri, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, session.ID, session.State, session.ClientID, session.CodeChallenge, session.CodeChallengeMethod,
 	   session.RedirectURI, session.CreatedAt, session.ExpiresAt)
 
 	if err != nil {
@@ -151,13 +161,13 @@ func (s *MCPOAuthStore) CreateSession(ctx context.Context, codeChallenge, codeCh
 // GetSessionByState retrieves an OAuth session by state parameter
 func (s *MCPOAuthStore) GetSessionByState(ctx context.Context, state string) (*MCPOAuthSession, error) {
 	var session MCPOAuthSession
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, state, code_challenge, code_challenge_method, redirect_uri,
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, state, client_id, code_challenge, code_challenge_method, redirect_uri,
 		       auth_code, auth_code_expires_at, user_id, created_at, expires_at
 		FROM mcp_oauth_sessions
 		WHERE state = $1
 	`, state).Scan(
-		&session.ID, &session.State, &session.CodeChallenge, &session.CodeChallengeMethod,
+		&session.ID, &session.State, &session.ClientID, &session.CodeChallenge, &session.CodeChallengeMethod,
 		&session.RedirectURI, &session.AuthCode, &session.AuthCodeExpiresAt,
 		&session.UserID, &session.CreatedAt, &session.ExpiresAt,
 	)
@@ -190,7 +200,7 @@ func (s *MCPOAuthStore) CompleteAuthorization(ctx context.Context, state string,
 
 	authCodeExpires := time.Now().UTC().Add(5 * time.Minute)
 
-	_, err = s.pool.Exec(ctx, `
+	_, err = s.db(ctx).Exec(ctx, `
 		UPDATE mcp_oauth_sessions
 		SET auth_code = $1, auth_code_expires_at = $2, user_id = $3
 		WHERE id = $4
@@ -203,17 +213,18 @@ func (s *MCPOAuthStore) CompleteAuthorization(ctx context.Context, state string,
 	return authCode, session.RedirectURI, nil
 }
 
-// ExchangeAuthCode exchanges an authorization code for an access token
-func (s *MCPOAuthStore) ExchangeAuthCode(ctx context.Context, authCode, codeVerifier string) (*MCPAccessTokenWithSecret, error) {
+// ExchangeAuthCode exchanges an authorization code for an access token.
+// Codes issued through a registered client can only be redeemed by it.
+func (s *MCPOAuthStore) ExchangeAuthCode(ctx context.Context, clientID, authCode, codeVerifier string) (*MCPAccessTokenWithSecret, error) {
 	// Find the session by auth code
 	var session MCPOAuthSession
-	err := s.pool.QueryRow(ctx, `
-		SELECT id, state, code_challenge, code_challenge_method, redirect_uri,
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, state, client_id, code_challenge, code_challenge_method, redirect_uri,
 		       auth_code, auth_code_expires_at, user_id, created_at, expires_at
 		FROM mcp_oauth_sessions
 		WHERE auth_code = $1
 	`, authCode).Scan(
-		&session.ID, &session.State, &session.CodeChallenge, &session.CodeChallengeMethod,
+		&session.ID, &session.State, &session.ClientID, &session.CodeChallenge, &session.CodeChallengeMethod,
 		&session.RedirectURI, &session.AuthCode, &session.AuthCodeExpiresAt,
 		&session.UserID, &session.CreatedAt, &session.ExpiresAt,
 	)
@@ -230,6 +241,10 @@ func (s *MCPOAuthStore) ExchangeAuthCode(ctx context.Context, authCode, codeVeri
 		return nil, ErrAuthCodeExpired
 	}
 
+	if session.ClientID != nil && *session.ClientID != clientID {
+		return nil, ErrOAuthClientMismatch
+	}
+
 	// Verify PKCE
 	if !verifyCodeChallenge(codeVerifier, session.CodeChallenge, session.CodeChallengeMethod) {
 		return nil, ErrCodeChallengeInvalid
@@ -240,7 +255,7 @@ func (s *MCPOAuthStore) ExchangeAuthCode(ctx context.Context, authCode, codeVeri
 	}
 
 	// Delete the session (auth codes are single-use)
-	_, _ = s.pool.Exec(ctx, `DELETE FROM mcp_oauth_sessions WHERE id = $1`, session.ID)
+	_, _ = s.db(ctx).Exec(ctx, `DELETE FROM mcp_oauth_sessions WHERE id = $1`, session.ID)
 
 	// Generate access token
 	token, prefix, hash, err := generateMCPToken()
@@ -260,7 +275,7 @@ func (s *MCPOAuthStore) ExchangeAuthCode(ctx context.Context, authCode, codeVeri
 		Token: token,
 	}
 
-	_, err = s.pool.Exec(ctx, `
+	_, err = s.db(ctx).Exec(ctx, `
 		INSERT INTO mcp_access_tokens (id, user_id, token_hash, token_prefix, expires_at, created_at)
 		VALUES ($1, $2, $3, $4, $5, $6)
 	`, accessToken.ID, accessToken.UserID, hash, prefix, accessToken.ExpiresAt, accessToken.CreatedAt)
@@ -280,7 +295,7 @@ func (s *MCPOAuthStore) ValidateToken(ctx context.Context, token string) (uuid.U
 	var tokenID uuid.UUID
 	var expiresAt time.Time
 
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, user_id, expires_at FROM mcp_access_tokens WHERE token_hash = $1
 	`, hash).Scan(&tokenID, &userID, &expiresAt)
 
@@ -309,14 +324,14 @@ func (s *MCPOAuthStore) ValidateToken(ctx context.Context, token string) (uuid.U
 
 // CleanupExpired removes expired sessions and tokens
 func (s *MCPOAuthStore) CleanupExpired(ctx context.Context) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		DELETE FROM mcp_oauth_sessions WHERE expires_at < NOW()
 	`)
 	if err != nil {
 		return err
 	}
 
-	_, err = s.pool.Exec(ctx, `
+	_, err = s.db(ctx).Exec(ctx, `
 		DELETE FROM mcp_access_tokens WHERE expires_at < NOW()
 	`)
 	return err
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrOAuthClientNotFound = errors.New("OAuth client not found")
+
+// MCPOAuthClient is a public client registered through dynamic client
+// registration (RFC 7591). Clients authenticate with PKCE, so they have no
+// secret.
+type MCPOAuthClient struct {
+	ClientID     string
+	ClientName   string
+	RedirectURIs []string
+	CreatedAt    time.Time
+}
+
+// generateClientID creates a random client identifier
+// Format: mcp_client_<32 random hex chars>
+func generateClientID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "mcp_client_" + hex.EncodeToString(b), nil
+}
+
+// RegisterClient stores a new client with the redirect URIs it may use
+func (s *MCPOAuthStore) RegisterClient(ctx context.Context, clientName string, redirectURIs []string) (*MCPOAuthClient, error) {
+	clientID, err := generateClientID()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &MCPOAuthClient{
+		ClientID:     clientID,
+		ClientName:   clientName,
+		RedirectURIs: redirectURIs,
+		CreatedAt:    time.Now().UTC(),
+	}
+
+	_, err = s.db(ctx).Exec(ctx, `
+		INSERT INTO mcp_oauth_clients (client_id, client_name, redirect_uris, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, client.ClientID, client.ClientName, client.RedirectURIs, client.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// GetClient retrieves a registered client
+func (s *MCPOAuthStore) GetClient(ctx context.Context, clientID string) (*MCPOAuthClient, error) {
+	var client MCPOAuthClient
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT client_id, client_name, redirect_uris, created_at
+		FROM mcp_oauth_clients
+		WHERE client_id = $1
+	`, clientID).Scan(&client.ClientID, &client.ClientName, &client.RedirectURIs, &client.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOAuthClientNotFound
+		}
+		return nil, err
+	}
+	return &client, nil
+}
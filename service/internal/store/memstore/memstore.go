@@ -0,0 +1,655 @@
+// Package memstore provides in-memory implementations of the project and
+// time entry stores. They follow the same semantics and error values as the
+// PostgreSQL stores closely enough for handler-level tests, without needing
+// a database.
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Store groups the in-memory stores, which share one dataset so that
+// cross-table rules (e.g. projects with entries can't be deleted) hold.
+type Store struct {
+	Projects    *ProjectStore
+	TimeEntries *TimeEntryStore
+}
+
+// data is the dataset shared by the stores
+type data struct {
+	mu       sync.Mutex
+	projects map[uuid.UUID]*store.Project
+	entries  map[uuid.UUID]*store.TimeEntry
+}
+
+// New creates an empty in-memory store
+func New() *Store {
+	d := &data{
+		projects: make(map[uuid.UUID]*store.Project),
+		entries:  make(map[uuid.UUID]*store.TimeEntry),
+	}
+	return &Store{
+		Projects:    &ProjectStore{d: d},
+		TimeEntries: &TimeEntryStore{d: d},
+	}
+}
+
+// ProjectStore is an in-memory project store
+type ProjectStore struct {
+	d *data
+}
+
+// Create adds a new project
+func (s *ProjectStore) Create(ctx context.Context, userID uuid.UUID, name string, shortCode, client *string, color string, isBillable, isHiddenByDefault, doesNotAccumulateHours bool) (*store.Project, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	if shortCode != nil && s.d.shortCodeTaken(userID, *shortCode, uuid.Nil) {
+		return nil, store.ErrDuplicateShortCode
+	}
+
+	now := time.Now().UTC()
+	p := &store.Project{
+		ID:                     uuid.New(),
+		UserID:                 userID,
+		Name:                   name,
+		ShortCode:              shortCode,
+		Client:                 client,
+		Color:                  color,
+		IsBillable:             isBillable,
+		IsHiddenByDefault:      isHiddenByDefault,
+		DoesNotAccumulateHours: doesNotAccumulateHours,
+		ArchiveHideFromPickers: true,
+		CreatedAt:              now,
+		UpdatedAt:              now,
+	}
+	s.d.projects[p.ID] = p
+	return copyProject(p), nil
+}
+
+// GetByID retrieves a project by ID for a specific user
+func (s *ProjectStore) GetByID(ctx context.Context, userID, projectID uuid.UUID) (*store.Project, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	p, ok := s.d.projects[projectID]
+	if !ok || p.UserID != userID {
+		return nil, store.ErrProjectNotFound
+	}
+	return copyProject(p), nil
+}
+
+// List retrieves all projects for a user, ordered by name
+func (s *ProjectStore) List(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*store.Project, error) {
+	return s.Query(ctx, userID, store.ProjectListOptions{IncludeArchived: includeArchived})
+}
+
+// Query retrieves a user's projects matching opts, with the same sort
+// orders as store.ProjectStore.Query
+func (s *ProjectStore) Query(ctx context.Context, userID uuid.UUID, opts store.ProjectListOptions) ([]*store.Project, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	var projects []*store.Project
+	for _, p := range s.d.projects {
+		if p.UserID != userID {
+			continue
+		}
+		if opts.Archived != nil {
+			if p.IsArchived != *opts.Archived {
+				continue
+			}
+		} else if p.IsArchived && p.ArchiveHideFromPickers && !opts.IncludeArchived {
+			continue
+		}
+		if opts.Client != nil && (p.Client == nil || *p.Client != *opts.Client) {
+			continue
+		}
+		if opts.Billable != nil && p.IsBillable != *opts.Billable {
+			continue
+		}
+		projects = append(projects, copyProject(p))
+	}
+
+	// Per-project activity for the activity sorts
+	lastDate := make(map[uuid.UUID]time.Time)
+	monthHours := make(map[uuid.UUID]float64)
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for _, e := range s.d.entries {
+		if e.UserID != userID || e.DeletedAt != nil {
+			continue
+		}
+		if e.Date.After(lastDate[e.ProjectID]) {
+			lastDate[e.ProjectID] = e.Date
+		}
+		if !e.Date.Before(monthStart) {
+			monthHours[e.ProjectID] += e.Hours
+		}
+	}
+
+	sort.Slice(projects, func(i, j int) bool {
+		a, b := projects[i], projects[j]
+		switch opts.Sort {
+		case store.ProjectSortRecent:
+			if !lastDate[a.ID].Equal(lastDate[b.ID]) {
+				return lastDate[a.ID].After(lastDate[b.ID])
+			}
+		case store.ProjectSortMonthHours:
+			if monthHours[a.ID] != monthHours[b.ID] {
+				return monthHours[a.ID] > monthHours[b.ID]
+			}
+		}
+		return a.Name < b.Name
+	})
+
+	if opts.Offset >= len(projects) {
+		return nil, nil
+	}
+	projects = projects[opts.Offset:]
+	if opts.Limit > 0 && opts.Limit < len(projects) {
+		projects = projects[:opts.Limit]
+	}
+	return projects, nil
+}
+
+// Update modifies an existing project. Keys are the column names accepted
+// by store.ProjectStore.Update.
+func (s *ProjectStore) Update(ctx context.Context, userID, projectID uuid.UUID, updates map[string]interface{}) (*store.Project, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	existing, ok := s.d.projects[projectID]
+	if !ok || existing.UserID != userID {
+		return nil, store.ErrProjectNotFound
+	}
+
+	// Apply to a copy so a bad update leaves the stored project untouched
+	p := copyProject(existing)
+	for key, value := range updates {
+		switch key {
+		case "name":
+			p.Name = value.(string)
+		case "short_code":
+			code := value.(string)
+			if s.d.shortCodeTaken(userID, code, projectID) {
+				return nil, store.ErrDuplicateShortCode
+			}
+			p.ShortCode = &code
+		case "client":
+			client := value.(string)
+			p.Client = &client
+		case "color":
+			p.Color = value.(string)
+		case "is_billable":
+			p.IsBillable = value.(bool)
+		case "is_archived":
+			p.IsArchived = value.(bool)
+		case "is_hidden_by_default":
+			p.IsHiddenByDefault = value.(bool)
+		case "does_not_accumulate_hours":
+			p.DoesNotAccumulateHours = value.(bool)
+		case "fingerprint_domains":
+			p.FingerprintDomains = value.([]string)
+		case "fingerprint_emails":
+			p.FingerprintEmails = value.([]string)
+		case "fingerprint_keywords":
+			p.FingerprintKeywords = value.([]string)
+		case "fingerprint_exclude_domains":
+			p.FingerprintExcludeDomains = value.([]string)
+		case "fingerprint_exclude_keywords":
+			p.FingerprintExcludeKeywords = value.([]string)
+		case "fingerprint_weights":
+			p.FingerprintWeights = value.(map[string]float64)
+		case "github_repos":
+			p.GitHubRepos = value.([]string)
+		case "archive_disable_rules":
+			p.ArchiveDisableRules = value.(bool)
+		case "archive_stop_fingerprints":
+			p.ArchiveStopFingerprints = value.(bool)
+		case "archive_hide_from_pickers":
+			p.ArchiveHideFromPickers = value.(bool)
+		case "updated_at":
+		default:
+			return nil, fmt.Errorf("memstore: unsupported project column %q", key)
+		}
+	}
+	p.UpdatedAt = time.Now().UTC()
+
+	s.d.projects[projectID] = p
+	return copyProject(p), nil
+}
+
+// Delete removes a project. Trashed time entries don't block deletion and
+// are purged along with it.
+func (s *ProjectStore) Delete(ctx context.Context, userID, projectID uuid.UUID) error {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	p, ok := s.d.projects[projectID]
+	if !ok || p.UserID != userID {
+		return store.ErrProjectNotFound
+	}
+	for _, e := range s.d.entries {
+		if e.ProjectID == projectID && e.DeletedAt == nil {
+			return store.ErrProjectHasEntries
+		}
+	}
+
+	for id, e := range s.d.entries {
+		if e.ProjectID == projectID && e.InvoiceID == nil {
+			delete(s.d.entries, id)
+		}
+	}
+	delete(s.d.projects, projectID)
+	return nil
+}
+
+// TimeEntryStore is an in-memory time entry store
+type TimeEntryStore struct {
+	d *data
+}
+
+// Put stores an entry as-is, replacing any entry with the same ID. It lets
+// tests set up states the handlers can't reach directly, such as invoiced
+// or divergent entries.
+func (s *TimeEntryStore) Put(entry *store.TimeEntry) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	e := copyEntry(entry)
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+		entry.ID = e.ID
+	}
+	s.d.entries[e.ID] = e
+}
+
+// Create adds a new time entry or adds to the one that exists for the same
+// project/date, capturing snapshot_computed_hours for staleness detection
+func (s *TimeEntryStore) Create(ctx context.Context, userID, projectID uuid.UUID, date time.Time, hours float64, description *string) (*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	now := time.Now().UTC()
+	if e := s.d.entryForSlot(userID, projectID, date); e != nil {
+		if e.DeletedAt != nil {
+			e.Hours = hours
+			e.Description = description
+		} else {
+			e.Hours += hours
+			if description != nil {
+				e.Description = description
+			}
+		}
+		e.HasUserEdits = true
+		e.SnapshotComputedHours = e.ComputedHours
+		e.DeletedAt = nil
+		e.UpdatedAt = now
+		return s.d.withProject(e), nil
+	}
+
+	e := &store.TimeEntry{
+		ID:           uuid.New(),
+		UserID:       userID,
+		ProjectID:    projectID,
+		Date:         date,
+		Hours:        hours,
+		Description:  description,
+		Source:       "manual",
+		HasUserEdits: true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	s.d.entries[e.ID] = e
+	return s.d.withProject(e), nil
+}
+
+// GetByID retrieves a live time entry by ID for a specific user
+func (s *TimeEntryStore) GetByID(ctx context.Context, userID, entryID uuid.UUID) (*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	e, err := s.d.liveEntry(userID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	return s.d.withProject(e), nil
+}
+
+// Update modifies an existing time entry, capturing snapshot_computed_hours
+// for staleness detection
+func (s *TimeEntryStore) Update(ctx context.Context, userID, entryID uuid.UUID, hours *float64, description *string) (*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	e, err := s.d.liveEntry(userID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if e.InvoiceID != nil {
+		return nil, store.ErrTimeEntryInvoiced
+	}
+
+	if hours != nil {
+		e.Hours = *hours
+	}
+	if description != nil {
+		e.Description = description
+	}
+	e.HasUserEdits = true
+	e.SnapshotComputedHours = e.ComputedHours
+	e.UpdatedAt = time.Now().UTC()
+	return s.d.withProject(e), nil
+}
+
+// Split divides an entry into manual entries, one per part. A part on the
+// entry's project is written to the entry; without one the entry is
+// suppressed. Parts on other projects replace trashed entries in their slot
+// and fail with ErrTimeEntrySlotTaken on live ones.
+func (s *TimeEntryStore) Split(ctx context.Context, userID, entryID uuid.UUID, parts []store.SplitPart) ([]*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	original, err := s.d.liveEntry(userID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if original.InvoiceID != nil {
+		return nil, store.ErrTimeEntryInvoiced
+	}
+	for _, part := range parts {
+		if part.ProjectID == original.ProjectID {
+			continue
+		}
+		if e := s.d.entryForSlot(userID, part.ProjectID, original.Date); e != nil && e.DeletedAt == nil {
+			return nil, store.ErrTimeEntrySlotTaken
+		}
+	}
+
+	now := time.Now().UTC()
+	result := make([]*store.TimeEntry, len(parts))
+	keepsOriginal := false
+	for i, part := range parts {
+		e := original
+		if part.ProjectID == original.ProjectID {
+			keepsOriginal = true
+			e.SnapshotComputedHours = e.ComputedHours
+		} else if e = s.d.entryForSlot(userID, part.ProjectID, original.Date); e != nil {
+			e.Source = "manual"
+			e.IsSuppressed = false
+			e.SnapshotComputedHours = e.ComputedHours
+			e.DeletedAt = nil
+		} else {
+			e = &store.TimeEntry{
+				ID:        uuid.New(),
+				UserID:    userID,
+				ProjectID: part.ProjectID,
+				Date:      original.Date,
+				Source:    "manual",
+				CreatedAt: now,
+			}
+			s.d.entries[e.ID] = e
+		}
+		e.Hours = part.Hours
+		e.Description = part.Description
+		e.HasUserEdits = true
+		e.UpdatedAt = now
+		result[i] = e
+	}
+	if !keepsOriginal {
+		original.IsSuppressed = true
+		original.HasUserEdits = true
+		original.UpdatedAt = now
+	}
+
+	for i, e := range result {
+		result[i] = s.d.withProject(e)
+	}
+	return result, nil
+}
+
+// Trash soft-deletes a time entry
+func (s *TimeEntryStore) Trash(ctx context.Context, userID, entryID uuid.UUID) error {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	e, err := s.d.liveEntry(userID, entryID)
+	if err != nil {
+		return err
+	}
+	if e.InvoiceID != nil {
+		return store.ErrTimeEntryInvoiced
+	}
+	now := time.Now().UTC()
+	e.DeletedAt = &now
+	return nil
+}
+
+// RefreshComputedValues updates only the computed hours of an entry
+func (s *TimeEntryStore) RefreshComputedValues(ctx context.Context, userID, entryID uuid.UUID, computedHours float64) error {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	if e, ok := s.d.entries[entryID]; ok && e.UserID == userID {
+		e.ComputedHours = &computedHours
+	}
+	return nil
+}
+
+// ResetToComputed resets a time entry to computed values, clearing staleness
+func (s *TimeEntryStore) ResetToComputed(ctx context.Context, userID, entryID uuid.UUID, hours float64, title, description string, details []byte, eventIDs []uuid.UUID) (*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	e, err := s.d.liveEntry(userID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	e.Hours = hours
+	e.Title = &title
+	e.Description = &description
+	e.ComputedHours = &hours
+	e.ComputedTitle = &title
+	e.ComputedDescription = &description
+	e.SnapshotComputedHours = &hours
+	e.CalculationDetails = details
+	e.ContributingEvents = append([]uuid.UUID(nil), eventIDs...)
+	e.IsStale = false
+	e.UpdatedAt = time.Now().UTC()
+	return s.d.withProject(e), nil
+}
+
+// UpsertFromComputed creates or updates a time entry from computed values.
+// Invoiced entries keep their current values and are marked stale if the
+// computed values differ.
+func (s *TimeEntryStore) UpsertFromComputed(ctx context.Context, userID, projectID uuid.UUID, date time.Time, hours float64, title, description string, details []byte, eventIDs []uuid.UUID) (*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	now := time.Now().UTC()
+	e := s.d.entryForSlot(userID, projectID, date)
+	if e == nil {
+		e = &store.TimeEntry{
+			ID:        uuid.New(),
+			UserID:    userID,
+			ProjectID: projectID,
+			Date:      date,
+			Source:    "calendar",
+			CreatedAt: now,
+		}
+		s.d.entries[e.ID] = e
+	}
+
+	e.ComputedHours = &hours
+	e.ComputedTitle = &title
+	e.ComputedDescription = &description
+	e.CalculationDetails = details
+	if e.InvoiceID != nil {
+		e.IsStale = e.Hours != hours || deref(e.Title) != title || deref(e.Description) != description
+	} else {
+		e.Hours = hours
+		e.Title = &title
+		e.Description = &description
+		e.IsStale = false
+	}
+	e.ContributingEvents = append([]uuid.UUID(nil), eventIDs...)
+	e.DeletedAt = nil
+	e.UpdatedAt = now
+	return s.d.withProject(e), nil
+}
+
+// ListDivergent returns live entries whose hours differ from their computed
+// hours, oldest first. Unless includeAcknowledged is set, entries whose
+// computed hours were acknowledged via KeepManual are left out.
+func (s *TimeEntryStore) ListDivergent(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, includeAcknowledged bool) ([]*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	var entries []*store.TimeEntry
+	for _, e := range s.d.entries {
+		if e.UserID != userID || e.DeletedAt != nil || e.ComputedHours == nil || e.Hours == *e.ComputedHours {
+			continue
+		}
+		if startDate != nil && e.Date.Before(*startDate) {
+			continue
+		}
+		if endDate != nil && e.Date.After(*endDate) {
+			continue
+		}
+		if !includeAcknowledged && e.SnapshotComputedHours != nil && *e.SnapshotComputedHours == *e.ComputedHours {
+			continue
+		}
+		entries = append(entries, s.d.withProject(e))
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].Date.Before(entries[j].Date)
+		}
+		return projectName(entries[i]) < projectName(entries[j])
+	})
+	return entries, nil
+}
+
+// AcceptComputed replaces an entry's values with its computed values and
+// clears user edits. Invoiced entries are rejected.
+func (s *TimeEntryStore) AcceptComputed(ctx context.Context, userID, entryID uuid.UUID) (*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	e, err := s.d.liveEntry(userID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if e.InvoiceID != nil {
+		return nil, store.ErrTimeEntryInvoiced
+	}
+	if e.ComputedHours != nil {
+		e.Hours = *e.ComputedHours
+	}
+	if e.ComputedTitle != nil {
+		e.Title = e.ComputedTitle
+	}
+	if e.ComputedDescription != nil {
+		e.Description = e.ComputedDescription
+	}
+	e.SnapshotComputedHours = e.ComputedHours
+	e.HasUserEdits = false
+	e.IsStale = false
+	e.UpdatedAt = time.Now().UTC()
+	return s.d.withProject(e), nil
+}
+
+// KeepManual keeps an entry's values and acknowledges its computed hours
+func (s *TimeEntryStore) KeepManual(ctx context.Context, userID, entryID uuid.UUID) (*store.TimeEntry, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	e, err := s.d.liveEntry(userID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	e.SnapshotComputedHours = e.ComputedHours
+	e.HasUserEdits = true
+	e.IsStale = false
+	e.UpdatedAt = time.Now().UTC()
+	return s.d.withProject(e), nil
+}
+
+// shortCodeTaken reports whether another of the user's projects uses code
+func (d *data) shortCodeTaken(userID uuid.UUID, code string, except uuid.UUID) bool {
+	for _, p := range d.projects {
+		if p.UserID == userID && p.ID != except && p.ShortCode != nil && *p.ShortCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// liveEntry returns the stored (not trashed) entry, or ErrTimeEntryNotFound
+func (d *data) liveEntry(userID, entryID uuid.UUID) (*store.TimeEntry, error) {
+	e, ok := d.entries[entryID]
+	if !ok || e.UserID != userID || e.DeletedAt != nil {
+		return nil, store.ErrTimeEntryNotFound
+	}
+	return e, nil
+}
+
+// entryForSlot returns the entry, trashed or not, occupying the
+// user/project/date slot
+func (d *data) entryForSlot(userID, projectID uuid.UUID, date time.Time) *store.TimeEntry {
+	for _, e := range d.entries {
+		if e.UserID == userID && e.ProjectID == projectID && sameDay(e.Date, date) {
+			return e
+		}
+	}
+	return nil
+}
+
+// withProject returns a copy of the entry with its project joined
+func (d *data) withProject(e *store.TimeEntry) *store.TimeEntry {
+	c := copyEntry(e)
+	if p, ok := d.projects[e.ProjectID]; ok {
+		c.Project = copyProject(p)
+	}
+	return c
+}
+
+func copyProject(p *store.Project) *store.Project {
+	c := *p
+	return &c
+}
+
+func copyEntry(e *store.TimeEntry) *store.TimeEntry {
+	c := *e
+	c.Project = nil
+	c.ContributingEvents = append([]uuid.UUID(nil), e.ContributingEvents...)
+	return &c
+}
+
+func projectName(e *store.TimeEntry) string {
+	if e.Project == nil {
+		return ""
+	}
+	return e.Project.Name
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ProviderGoogleWorkspace is the provider of connections an organization
+// provisions through its service account. They sync like Google
+// connections, but tokens are minted rather than refreshed.
+const ProviderGoogleWorkspace = "google_workspace"
+
+// Calendars selected when provisioning a member through delegation
+const (
+	DelegationCalendarsPrimary = "primary"
+	DelegationCalendarsAll     = "all"
+	DelegationCalendarsNone    = "none"
+)
+
+// ErrServiceAccountNotConfigured is returned when an organization has no
+// Google service account
+var ErrServiceAccountNotConfigured = errors.New("organization has no Google service account")
+
+// ServiceAccount is a Google service account's JSON key with the fields
+// admins need to authorize it for domain-wide delegation
+type ServiceAccount struct {
+	Key         []byte
+	ClientEmail string
+	ClientID    string
+}
+
+// DelegationSettings are an organization's domain-wide delegation
+// settings. A nil service account keeps the stored one.
+type DelegationSettings struct {
+	ServiceAccount *ServiceAccount
+	Calendars      string
+}
+
+// UpdateDelegation saves an organization's service account and the
+// calendars selected for members by default
+func (s *OrganizationStore) UpdateDelegation(ctx context.Context, orgID uuid.UUID, settings DelegationSettings) (*Organization, error) {
+	var encrypted []byte
+	var email, clientID *string
+	if sa := settings.ServiceAccount; sa != nil {
+		orgCrypto, err := s.orgCrypto(orgID)
+		if err != nil {
+			return nil, err
+		}
+		if encrypted, err = orgCrypto.Encrypt(sa.Key); err != nil {
+			return nil, err
+		}
+		email, clientID = &sa.ClientEmail, &sa.ClientID
+	}
+
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE organizations SET
+			google_service_account_encrypted = COALESCE($2, google_service_account_encrypted),
+			google_service_account_email = COALESCE($3, google_service_account_email),
+			google_service_account_client_id = COALESCE($4, google_service_account_client_id),
+			delegation_calendars = $5,
+			updated_at = NOW()
+		WHERE id = $1
+	`, orgID, encrypted, email, clientID, settings.Calendars)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrOrganizationNotFound
+	}
+	return s.Get(ctx, orgID)
+}
+
+// GoogleServiceAccountKey returns an organization's decrypted service
+// account key
+func (s *OrganizationStore) GoogleServiceAccountKey(ctx context.Context, orgID uuid.UUID) ([]byte, error) {
+	var encrypted []byte
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT google_service_account_encrypted FROM organizations WHERE id = $1
+	`, orgID).Scan(&encrypted)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, err
+	}
+	if encrypted == nil {
+		return nil, ErrServiceAccountNotConfigured
+	}
+	orgCrypto, err := s.orgCrypto(orgID)
+	if err != nil {
+		return nil, err
+	}
+	return orgCrypto.Decrypt(encrypted)
+}
+
+// SetMemberDelegationCalendars sets which calendars are selected when the
+// member is provisioned; nil falls back to the organization's default
+func (s *OrganizationStore) SetMemberDelegationCalendars(ctx context.Context, orgID, userID uuid.UUID, calendars *string) (*OrganizationMember, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE organization_members SET delegation_calendars = $3, updated_at = NOW()
+		WHERE organization_id = $1 AND user_id = $2
+	`, orgID, userID, calendars)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrOrganizationMemberNotFound
+	}
+	return s.GetMember(ctx, orgID, userID)
+}
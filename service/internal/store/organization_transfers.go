@@ -0,0 +1,222 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrTransferInvoiceNumberTaken is returned when the receiving member
+// already has an invoice numbered like one of the project's
+var ErrTransferInvoiceNumberTaken = errors.New("invoice number already used by the receiving member")
+
+// ProjectTransfer hands a project from one organization member to another
+type ProjectTransfer struct {
+	ProjectID         uuid.UUID
+	FromUserID        uuid.UUID
+	ToUserID          uuid.UUID
+	RevokeConnections bool
+}
+
+// ProjectTransferResult counts what a project transfer moved or revoked
+type ProjectTransferResult struct {
+	TimeEntries        int
+	Invoices           int
+	Expenses           int
+	BillingPeriods     int
+	RevokedConnections int
+}
+
+// TransferProject moves a project and its billing history from one member
+// of the organization to another in one transaction. The receiving member
+// must be active; the departing one may already be deactivated. What only
+// made sense for the departing member, their rules, goals, recalculations
+// and event classifications for the project, is removed, and their
+// calendar connections are deleted when t.RevokeConnections is set.
+// Statements span both members, so callers run it with an unscoped context.
+func (s *OrganizationStore) TransferProject(ctx context.Context, orgID uuid.UUID, t ProjectTransfer) (*ProjectTransferResult, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var members int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM organization_members
+		WHERE organization_id = $1 AND (
+			(user_id = $2) OR (user_id = $3 AND deactivated_at IS NULL)
+		)
+	`, orgID, t.FromUserID, t.ToUserID).Scan(&members)
+	if err != nil {
+		return nil, err
+	}
+	if members != 2 {
+		return nil, ErrOrganizationMemberNotFound
+	}
+
+	var id uuid.UUID
+	err = tx.QueryRow(ctx, `
+		SELECT id FROM projects WHERE id = $1 AND user_id = $2 FOR UPDATE
+	`, t.ProjectID, t.FromUserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	var numberTaken bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM invoices i
+			JOIN invoices other ON other.user_id = $3 AND other.invoice_number = i.invoice_number
+			WHERE i.project_id = $1 AND i.user_id = $2
+		)
+	`, t.ProjectID, t.FromUserID, t.ToUserID).Scan(&numberTaken)
+	if err != nil {
+		return nil, err
+	}
+	if numberTaken {
+		return nil, ErrTransferInvoiceNumberTaken
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE projects SET user_id = $3, updated_at = NOW() WHERE id = $1 AND user_id = $2
+	`, t.ProjectID, t.FromUserID, t.ToUserID)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, ErrDuplicateShortCode
+		}
+		return nil, err
+	}
+
+	result := &ProjectTransferResult{}
+	move := func(table string, count *int) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE `+table+` SET user_id = $3 WHERE project_id = $1 AND user_id = $2
+		`, t.ProjectID, t.FromUserID, t.ToUserID)
+		if err != nil {
+			return err
+		}
+		if count != nil {
+			*count = int(tag.RowsAffected())
+		}
+		return nil
+	}
+	for _, m := range []struct {
+		table string
+		count *int
+	}{
+		{"time_entries", &result.TimeEntries},
+		{"invoices", &result.Invoices},
+		{"expenses", &result.Expenses},
+		{"billing_periods", &result.BillingPeriods},
+	} {
+		if err := move(m.table, m.count); err != nil {
+			return nil, err
+		}
+	}
+
+	// Payments and exports follow their invoices
+	for _, table := range []string{"payments", "invoice_exports"} {
+		_, err = tx.Exec(ctx, `
+			UPDATE `+table+` SET user_id = $3
+			WHERE user_id = $2 AND invoice_id IN (
+				SELECT id FROM invoices WHERE project_id = $1 AND user_id = $3
+			)
+		`, t.ProjectID, t.FromUserID, t.ToUserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Tags belong to a user, so the moved entries are relinked to the
+	// receiving member's tags of the same name, creating any they lack
+	_, err = tx.Exec(ctx, `
+		INSERT INTO tags (id, user_id, name, created_at)
+		SELECT DISTINCT ON (lower(tg.name)) gen_random_uuid(), $3, tg.name, NOW()
+		FROM time_entry_tags tt
+		JOIN tags tg ON tg.id = tt.tag_id AND tg.user_id = $2
+		JOIN time_entries te ON te.id = tt.time_entry_id
+		WHERE te.project_id = $1 AND te.user_id = $3
+		ON CONFLICT (user_id, lower(name)) DO NOTHING
+	`, t.ProjectID, t.FromUserID, t.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO time_entry_tags (time_entry_id, tag_id)
+		SELECT tt.time_entry_id, mine.id
+		FROM time_entry_tags tt
+		JOIN tags tg ON tg.id = tt.tag_id AND tg.user_id = $2
+		JOIN time_entries te ON te.id = tt.time_entry_id
+		JOIN tags mine ON mine.user_id = $3 AND lower(mine.name) = lower(tg.name)
+		WHERE te.project_id = $1 AND te.user_id = $3
+		ON CONFLICT DO NOTHING
+	`, t.ProjectID, t.FromUserID, t.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx, `
+		DELETE FROM time_entry_tags tt
+		USING tags tg, time_entries te
+		WHERE tg.id = tt.tag_id AND tg.user_id = $2
+		  AND te.id = tt.time_entry_id AND te.project_id = $1 AND te.user_id = $3
+	`, t.ProjectID, t.FromUserID, t.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moving entries doesn't change their dates, so the trigger only marks
+	// the departing member's days stale
+	_, err = tx.Exec(ctx, `
+		SELECT mark_daily_hours_stale($2, d.date)
+		FROM (SELECT DISTINCT date FROM time_entries WHERE project_id = $1 AND user_id = $2) d
+	`, t.ProjectID, t.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, table := range []string{"daily_project_hours", "classification_rules", "hour_goals", "recalculation_jobs"} {
+		_, err = tx.Exec(ctx, `
+			DELETE FROM `+table+` WHERE project_id = $1 AND user_id = $2
+		`, t.ProjectID, t.FromUserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE calendar_events
+		SET project_id = NULL, classification_status = 'pending', classification_source = NULL,
+			classification_confidence = NULL, classification_rule_id = NULL, updated_at = NOW()
+		WHERE project_id = $1 AND user_id = $2
+	`, t.ProjectID, t.FromUserID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE calendar_event_classifications SET project_id = NULL
+		WHERE project_id = $1 AND user_id = $2
+	`, t.ProjectID, t.FromUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.RevokeConnections {
+		tag, err := tx.Exec(ctx, `
+			DELETE FROM calendar_connections WHERE user_id = $1 AND provider <> $2
+		`, t.FromUserID, ProviderFocus)
+		if err != nil {
+			return nil, err
+		}
+		result.RevokedConnections = int(tag.RowsAffected())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
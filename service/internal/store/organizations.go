@@ -0,0 +1,573 @@
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/crypto"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var (
+	ErrOrganizationNotFound       = errors.New("organization not found")
+	ErrOrganizationSlugTaken      = errors.New("organization slug already exists")
+	ErrOrganizationMemberNotFound = errors.New("organization member not found")
+	ErrAlreadyOrganizationMember  = errors.New("user is already a member of the organization")
+	ErrExternalIDTaken            = errors.New("external ID already used in the organization")
+	ErrDomainNotFound             = errors.New("domain not claimed by the organization")
+	ErrDomainVerifiedElsewhere    = errors.New("domain is verified by another organization")
+	ErrInvalidSCIMToken           = errors.New("invalid SCIM token")
+	ErrSSOSecretsUnavailable      = errors.New("storing SSO client secrets needs encryption")
+)
+
+// Organization member roles. Owners and admins manage SSO, domains and SCIM.
+const (
+	OrganizationRoleOwner  = "owner"
+	OrganizationRoleAdmin  = "admin"
+	OrganizationRoleMember = "member"
+)
+
+// Organization is a group of users signing in through one identity provider
+type Organization struct {
+	ID                  uuid.UUID
+	Name                string
+	Slug                string
+	SSOEnabled          bool
+	OIDCIssuer          *string
+	OIDCClientID        *string
+	HasOIDCClientSecret bool
+	SCIMTokenPrefix     *string // First characters of the SCIM token, for display
+	// Google Workspace service account with domain-wide delegation, if set
+	GoogleServiceAccountEmail    *string
+	GoogleServiceAccountClientID *string
+	DelegationCalendars          string // Default calendars selected for members
+	Domains                      []OrganizationDomain
+	CreatedAt                    time.Time
+	UpdatedAt                    time.Time
+}
+
+// SSOConfigured reports whether SSO is enabled with a complete OIDC client
+func (o *Organization) SSOConfigured() bool {
+	return o.SSOEnabled && o.OIDCIssuer != nil && o.OIDCClientID != nil && o.HasOIDCClientSecret
+}
+
+// OrganizationDomain is an email domain claimed by an organization
+type OrganizationDomain struct {
+	OrganizationID    uuid.UUID
+	Domain            string
+	VerificationToken string
+	VerifiedAt        *time.Time
+	AutoJoin          bool
+	CreatedAt         time.Time
+}
+
+// OrganizationMember is a user's membership of an organization, with the
+// user's email and name
+type OrganizationMember struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Email          string
+	Name           string
+	Role           string
+	ExternalID     *string
+	DeactivatedAt  *time.Time
+	// Calendars selected when provisioning the member through delegation;
+	// nil uses the organization's default
+	DelegationCalendars *string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// Active reports whether the member may sign in
+func (m *OrganizationMember) Active() bool {
+	return m.DeactivatedAt == nil
+}
+
+// CanManage reports whether the member may change the organization's
+// settings
+func (m *OrganizationMember) CanManage() bool {
+	return m.Active() && (m.Role == OrganizationRoleOwner || m.Role == OrganizationRoleAdmin)
+}
+
+// SSOSettings are the OIDC settings of an organization. A nil client
+// secret keeps the stored one.
+type SSOSettings struct {
+	Enabled      bool
+	Issuer       string
+	ClientID     string
+	ClientSecret *string
+}
+
+// OrganizationMemberUpdate changes a membership; nil fields are unchanged
+type OrganizationMemberUpdate struct {
+	ExternalID *string
+	Active     *bool
+}
+
+// OrganizationStore provides PostgreSQL-backed organization storage. The
+// encryption service, which may be nil, protects OIDC client secrets.
+type OrganizationStore struct {
+	pool   *pgxpool.Pool
+	crypto *crypto.EncryptionService
+}
+
+// NewOrganizationStore creates a new organization store
+func NewOrganizationStore(pool *pgxpool.Pool, cryptoSvc *crypto.EncryptionService) *OrganizationStore {
+	return &OrganizationStore{pool: pool, crypto: cryptoSvc}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *OrganizationStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+func (s *OrganizationStore) orgCrypto(orgID uuid.UUID) (*crypto.EncryptionService, error) {
+	if s.crypto == nil {
+		return nil, ErrSSOSecretsUnavailable
+	}
+	return s.crypto.ForScope("organization:" + orgID.String())
+}
+
+const organizationColumns = `id, name, slug, sso_enabled, oidc_issuer, oidc_client_id,
+	oidc_client_secret_encrypted IS NOT NULL, scim_token_prefix, google_service_account_email,
+	google_service_account_client_id, delegation_calendars, created_at, updated_at`
+
+func scanOrganization(row pgx.Row) (*Organization, error) {
+	o := &Organization{}
+	err := row.Scan(&o.ID, &o.Name, &o.Slug, &o.SSOEnabled, &o.OIDCIssuer, &o.OIDCClientID,
+		&o.HasOIDCClientSecret, &o.SCIMTokenPrefix, &o.GoogleServiceAccountEmail,
+		&o.GoogleServiceAccountClientID, &o.DelegationCalendars, &o.CreatedAt, &o.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, err
+	}
+	return o, nil
+}
+
+// Create adds an organization with the user as its owner
+func (s *OrganizationStore) Create(ctx context.Context, ownerID uuid.UUID, name, slug string) (*Organization, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	o, err := scanOrganization(tx.QueryRow(ctx, `
+		INSERT INTO organizations (name, slug) VALUES ($1, $2)
+		RETURNING `+organizationColumns, name, slug))
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, ErrOrganizationSlugTaken
+		}
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role) VALUES ($1, $2, $3)
+	`, o.ID, ownerID, OrganizationRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	o.Domains = []OrganizationDomain{}
+	return o, nil
+}
+
+// Get returns an organization with its domains
+func (s *OrganizationStore) Get(ctx context.Context, orgID uuid.UUID) (*Organization, error) {
+	o, err := scanOrganization(s.db(ctx).QueryRow(ctx, `
+		SELECT `+organizationColumns+` FROM organizations WHERE id = $1
+	`, orgID))
+	if err != nil {
+		return nil, err
+	}
+	if o.Domains, err = s.ListDomains(ctx, orgID); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// GetBySlug returns an organization by its slug, without its domains
+func (s *OrganizationStore) GetBySlug(ctx context.Context, slug string) (*Organization, error) {
+	return scanOrganization(s.db(ctx).QueryRow(ctx, `
+		SELECT `+organizationColumns+` FROM organizations WHERE slug = $1
+	`, slug))
+}
+
+// GetByVerifiedDomain returns the organization that verified an email
+// domain, and the domain's settings
+func (s *OrganizationStore) GetByVerifiedDomain(ctx context.Context, domain string) (*Organization, *OrganizationDomain, error) {
+	var orgID uuid.UUID
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT organization_id FROM organization_domains
+		WHERE domain = $1 AND verified_at IS NOT NULL
+	`, domain).Scan(&orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrOrganizationNotFound
+		}
+		return nil, nil, err
+	}
+	o, err := s.Get(ctx, orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range o.Domains {
+		if o.Domains[i].Domain == domain {
+			return o, &o.Domains[i], nil
+		}
+	}
+	return nil, nil, ErrOrganizationNotFound
+}
+
+// ListForUser returns the organizations the user belongs to, with the
+// user's membership of each
+func (s *OrganizationStore) ListForUser(ctx context.Context, userID uuid.UUID) ([]*Organization, []*OrganizationMember, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT organization_id FROM organization_members WHERE user_id = $1
+	`, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var orgIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		orgIDs = append(orgIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var orgs []*Organization
+	var memberships []*OrganizationMember
+	for _, id := range orgIDs {
+		o, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		m, err := s.GetMember(ctx, id, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		orgs = append(orgs, o)
+		memberships = append(memberships, m)
+	}
+	return orgs, memberships, nil
+}
+
+// UpdateSSO saves an organization's OIDC settings
+func (s *OrganizationStore) UpdateSSO(ctx context.Context, orgID uuid.UUID, settings SSOSettings) (*Organization, error) {
+	var encrypted []byte
+	if settings.ClientSecret != nil {
+		orgCrypto, err := s.orgCrypto(orgID)
+		if err != nil {
+			return nil, err
+		}
+		if encrypted, err = orgCrypto.Encrypt([]byte(*settings.ClientSecret)); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE organizations SET
+			sso_enabled = $2,
+			oidc_issuer = $3,
+			oidc_client_id = $4,
+			oidc_client_secret_encrypted = COALESCE($5, oidc_client_secret_encrypted),
+			updated_at = NOW()
+		WHERE id = $1
+	`, orgID, settings.Enabled, settings.Issuer, settings.ClientID, encrypted)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrOrganizationNotFound
+	}
+	return s.Get(ctx, orgID)
+}
+
+// SSOClientSecret returns an organization's decrypted OIDC client secret
+func (s *OrganizationStore) SSOClientSecret(ctx context.Context, orgID uuid.UUID) (string, error) {
+	var encrypted []byte
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT oidc_client_secret_encrypted FROM organizations WHERE id = $1
+	`, orgID).Scan(&encrypted)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrOrganizationNotFound
+		}
+		return "", err
+	}
+	if encrypted == nil {
+		return "", ErrOrganizationNotFound
+	}
+	orgCrypto, err := s.orgCrypto(orgID)
+	if err != nil {
+		return "", err
+	}
+	secret, err := orgCrypto.Decrypt(encrypted)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// CanStoreSecrets reports whether OIDC client secrets can be saved
+func (s *OrganizationStore) CanStoreSecrets() bool {
+	return s.crypto != nil
+}
+
+const organizationDomainColumns = `organization_id, domain, verification_token, verified_at, auto_join, created_at`
+
+func scanOrganizationDomain(row pgx.Row) (*OrganizationDomain, error) {
+	d := &OrganizationDomain{}
+	err := row.Scan(&d.OrganizationID, &d.Domain, &d.VerificationToken, &d.VerifiedAt, &d.AutoJoin, &d.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDomainNotFound
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
+// ListDomains returns the domains an organization has claimed
+func (s *OrganizationStore) ListDomains(ctx context.Context, orgID uuid.UUID) ([]OrganizationDomain, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+organizationDomainColumns+`
+		FROM organization_domains
+		WHERE organization_id = $1
+		ORDER BY domain
+	`, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	domains := []OrganizationDomain{}
+	for rows.Next() {
+		d, err := scanOrganizationDomain(rows)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, *d)
+	}
+	return domains, rows.Err()
+}
+
+// GetDomain returns one of an organization's domains
+func (s *OrganizationStore) GetDomain(ctx context.Context, orgID uuid.UUID, domain string) (*OrganizationDomain, error) {
+	return scanOrganizationDomain(s.db(ctx).QueryRow(ctx, `
+		SELECT `+organizationDomainColumns+`
+		FROM organization_domains WHERE organization_id = $1 AND domain = $2
+	`, orgID, domain))
+}
+
+// PutDomain claims a domain for an organization, or changes whether an
+// already claimed one auto-joins users. New claims start unverified with a
+// fresh verification token.
+func (s *OrganizationStore) PutDomain(ctx context.Context, orgID uuid.UUID, domain string, autoJoin bool) (*OrganizationDomain, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return scanOrganizationDomain(s.db(ctx).QueryRow(ctx, `
+		INSERT INTO organization_domains (organization_id, domain, verification_token, auto_join)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (organization_id, domain) DO UPDATE SET auto_join = EXCLUDED.auto_join
+		RETURNING `+organizationDomainColumns+`
+	`, orgID, domain, hex.EncodeToString(b), autoJoin))
+}
+
+// MarkDomainVerified records that the organization proved it owns a domain
+func (s *OrganizationStore) MarkDomainVerified(ctx context.Context, orgID uuid.UUID, domain string) (*OrganizationDomain, error) {
+	d, err := scanOrganizationDomain(s.db(ctx).QueryRow(ctx, `
+		UPDATE organization_domains SET verified_at = COALESCE(verified_at, NOW())
+		WHERE organization_id = $1 AND domain = $2
+		RETURNING `+organizationDomainColumns+`
+	`, orgID, domain))
+	if err != nil && isDuplicateKeyError(err) {
+		return nil, ErrDomainVerifiedElsewhere
+	}
+	return d, err
+}
+
+// DeleteDomain releases an organization's claim on a domain
+func (s *OrganizationStore) DeleteDomain(ctx context.Context, orgID uuid.UUID, domain string) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM organization_domains WHERE organization_id = $1 AND domain = $2
+	`, orgID, domain)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrDomainNotFound
+	}
+	return nil
+}
+
+// RotateSCIMToken replaces an organization's SCIM token and returns the
+// new one. Only its hash is stored.
+func (s *OrganizationStore) RotateSCIMToken(ctx context.Context, orgID uuid.UUID) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := "scim_" + hex.EncodeToString(b)
+
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE organizations SET scim_token_hash = $2, scim_token_prefix = $3, updated_at = NOW()
+		WHERE id = $1
+	`, orgID, hashKey(token), token[:13])
+	if err != nil {
+		return "", err
+	}
+	if result.RowsAffected() == 0 {
+		return "", ErrOrganizationNotFound
+	}
+	return token, nil
+}
+
+// ValidateSCIMToken returns the organization a SCIM token belongs to, with
+// its domains
+func (s *OrganizationStore) ValidateSCIMToken(ctx context.Context, token string) (*Organization, error) {
+	var orgID uuid.UUID
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id FROM organizations WHERE scim_token_hash = $1
+	`, hashKey(token)).Scan(&orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidSCIMToken
+		}
+		return nil, err
+	}
+	return s.Get(ctx, orgID)
+}
+
+const organizationMemberColumns = `m.organization_id, m.user_id, u.email, u.name, m.role,
+	m.external_id, m.deactivated_at, m.delegation_calendars, m.created_at, m.updated_at`
+
+func scanOrganizationMember(row pgx.Row) (*OrganizationMember, error) {
+	m := &OrganizationMember{}
+	err := row.Scan(&m.OrganizationID, &m.UserID, &m.Email, &m.Name, &m.Role,
+		&m.ExternalID, &m.DeactivatedAt, &m.DelegationCalendars, &m.CreatedAt, &m.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrOrganizationMemberNotFound
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetMember returns a user's membership of an organization
+func (s *OrganizationStore) GetMember(ctx context.Context, orgID, userID uuid.UUID) (*OrganizationMember, error) {
+	return scanOrganizationMember(s.db(ctx).QueryRow(ctx, `
+		SELECT `+organizationMemberColumns+`
+		FROM organization_members m JOIN users u ON u.id = m.user_id
+		WHERE m.organization_id = $1 AND m.user_id = $2
+	`, orgID, userID))
+}
+
+// ListMembers returns an organization's members ordered by email
+func (s *OrganizationStore) ListMembers(ctx context.Context, orgID uuid.UUID) ([]*OrganizationMember, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT `+organizationMemberColumns+`
+		FROM organization_members m JOIN users u ON u.id = m.user_id
+		WHERE m.organization_id = $1
+		ORDER BY u.email
+	`, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*OrganizationMember
+	for rows.Next() {
+		m, err := scanOrganizationMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+	return members, rows.Err()
+}
+
+// AddMember adds a user to an organization
+func (s *OrganizationStore) AddMember(ctx context.Context, orgID, userID uuid.UUID, role string, externalID *string, active bool) (*OrganizationMember, error) {
+	var deactivatedAt *time.Time
+	if !active {
+		now := time.Now().UTC()
+		deactivatedAt = &now
+	}
+	_, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role, external_id, deactivated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, orgID, userID, role, externalID, deactivatedAt)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			if contains(err.Error(), "external_id") {
+				return nil, ErrExternalIDTaken
+			}
+			return nil, ErrAlreadyOrganizationMember
+		}
+		return nil, err
+	}
+	return s.GetMember(ctx, orgID, userID)
+}
+
+// UpdateMember changes a member's external ID or deactivates and
+// reactivates them
+func (s *OrganizationStore) UpdateMember(ctx context.Context, orgID, userID uuid.UUID, update OrganizationMemberUpdate) (*OrganizationMember, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE organization_members SET
+			external_id = CASE WHEN $3 THEN $4 ELSE external_id END,
+			deactivated_at = CASE
+				WHEN $5::boolean IS NULL THEN deactivated_at
+				WHEN $5 THEN NULL
+				ELSE COALESCE(deactivated_at, NOW())
+			END,
+			updated_at = NOW()
+		WHERE organization_id = $1 AND user_id = $2
+	`, orgID, userID, update.ExternalID != nil, update.ExternalID, update.Active)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, ErrExternalIDTaken
+		}
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrOrganizationMemberNotFound
+	}
+	return s.GetMember(ctx, orgID, userID)
+}
+
+// RemoveMember removes a user from an organization. The user's account and
+// data are kept.
+func (s *OrganizationStore) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2
+	`, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrOrganizationMemberNotFound
+	}
+	return nil
+}
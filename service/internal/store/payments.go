@@ -0,0 +1,267 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var (
+	ErrPaymentNotFound    = errors.New("payment not found")
+	ErrInvoiceNotPayable  = errors.New("payments can only be recorded against sent or paid invoices")
+	ErrPaymentExceedsDue  = errors.New("payment exceeds outstanding balance")
+	ErrInvalidPaymentSize = errors.New("payment amount must be positive")
+	ErrHasPayments        = errors.New("invoice has payments")
+)
+
+// Payment represents a payment received against an invoice
+type Payment struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	InvoiceID uuid.UUID
+	Amount    float64
+	PaidOn    time.Time
+	Method    *string
+	Reference *string
+	CreatedAt time.Time
+}
+
+// ClientBalance summarizes issued invoices for one client
+type ClientBalance struct {
+	Client       string
+	InvoiceCount int
+	Invoiced     float64
+	Credited     float64
+	Paid         float64
+	Outstanding  float64
+}
+
+// invoiceBalanceSQL computes amount paid and balance due for the invoice aliased as i.
+// Issued (non-draft) credit notes reduce the balance of the invoice they reference.
+const invoiceBalanceSQL = `
+		       COALESCE((SELECT SUM(pay.amount) FROM payments pay WHERE pay.invoice_id = i.id), 0),
+		       i.total_amount
+		       + COALESCE((SELECT SUM(cn.total_amount) FROM invoices cn WHERE cn.original_invoice_id = i.id AND cn.status IN ('sent', 'paid')), 0)
+		       - COALESCE((SELECT SUM(pay.amount) FROM payments pay WHERE pay.invoice_id = i.id), 0)`
+
+// PaymentStore provides PostgreSQL-backed payment storage
+type PaymentStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPaymentStore creates a new PostgreSQL payment store
+func NewPaymentStore(pool *pgxpool.Pool) *PaymentStore {
+	return &PaymentStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *PaymentStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Record adds a payment to an issued invoice. When the payment brings the
+// outstanding balance to zero the invoice is marked paid.
+func (s *PaymentStore) Record(ctx context.Context, userID, invoiceID uuid.UUID, amount float64, paidOn time.Time, method, reference *string) (*Payment, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidPaymentSize
+	}
+
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	status, kind, _, err := lockInvoice(ctx, tx, userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if !isIssued(status) || kind != InvoiceKindInvoice {
+		return nil, ErrInvoiceNotPayable
+	}
+
+	balance, err := invoiceBalance(ctx, tx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if toCents(amount) > toCents(balance) {
+		return nil, ErrPaymentExceedsDue
+	}
+
+	payment := &Payment{
+		ID:        uuid.New(),
+		UserID:    userID,
+		InvoiceID: invoiceID,
+		Amount:    amount,
+		PaidOn:    paidOn,
+		Method:    method,
+		Reference: reference,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO payments (id, user_id, invoice_id, amount, paid_on, method, reference, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, payment.ID, payment.UserID, payment.InvoiceID, payment.Amount, payment.PaidOn,
+		payment.Method, payment.Reference, payment.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syncPaidStatus(ctx, tx, invoiceID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// ListByInvoice retrieves all payments for an invoice, oldest first
+func (s *PaymentStore) ListByInvoice(ctx context.Context, userID, invoiceID uuid.UUID) ([]*Payment, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, user_id, invoice_id, amount, paid_on, method, reference, created_at
+		FROM payments
+		WHERE user_id = $1 AND invoice_id = $2
+		ORDER BY paid_on ASC, created_at ASC
+	`, userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []*Payment
+	for rows.Next() {
+		p := &Payment{}
+		if err := rows.Scan(&p.ID, &p.UserID, &p.InvoiceID, &p.Amount, &p.PaidOn,
+			&p.Method, &p.Reference, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+
+	return payments, rows.Err()
+}
+
+// Delete removes a payment. A paid invoice that now has a balance due
+// returns to sent.
+func (s *PaymentStore) Delete(ctx context.Context, userID, paymentID uuid.UUID) error {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var invoiceID uuid.UUID
+	err = tx.QueryRow(ctx, `
+		DELETE FROM payments WHERE id = $1 AND user_id = $2
+		RETURNING invoice_id
+	`, paymentID, userID).Scan(&invoiceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPaymentNotFound
+		}
+		return err
+	}
+
+	if err := syncPaidStatus(ctx, tx, invoiceID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ClientBalances summarizes issued invoices per client. Projects without a
+// client are grouped under the empty string.
+func (s *PaymentStore) ClientBalances(ctx context.Context, userID uuid.UUID, client *string) ([]*ClientBalance, error) {
+	query := `
+		SELECT client, COUNT(*), SUM(total_amount), SUM(credited), SUM(paid), SUM(total_amount + credited - paid)
+		FROM (
+			SELECT COALESCE(p.client, '') AS client,
+			       i.total_amount,
+			       COALESCE((SELECT SUM(cn.total_amount) FROM invoices cn WHERE cn.original_invoice_id = i.id AND cn.status IN ('sent', 'paid')), 0) AS credited,
+			       COALESCE((SELECT SUM(pay.amount) FROM payments pay WHERE pay.invoice_id = i.id), 0) AS paid
+			FROM invoices i
+			JOIN projects p ON i.project_id = p.id
+			WHERE i.user_id = $1 AND i.kind = 'invoice' AND i.status IN ('sent', 'paid')
+		) per_invoice`
+	args := []interface{}{userID}
+	if client != nil {
+		query += " WHERE client = $2"
+		args = append(args, *client)
+	}
+	query += " GROUP BY client ORDER BY client"
+
+	rows, err := s.db(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var balances []*ClientBalance
+	for rows.Next() {
+		b := &ClientBalance{}
+		if err := rows.Scan(&b.Client, &b.InvoiceCount, &b.Invoiced, &b.Credited, &b.Paid, &b.Outstanding); err != nil {
+			return nil, err
+		}
+		balances = append(balances, b)
+	}
+
+	return balances, rows.Err()
+}
+
+// invoiceBalance returns the outstanding balance of an invoice within a transaction
+func invoiceBalance(ctx context.Context, tx pgx.Tx, invoiceID uuid.UUID) (float64, error) {
+	var paid, balance float64
+	err := tx.QueryRow(ctx, `
+		SELECT `+invoiceBalanceSQL+`
+		FROM invoices i
+		WHERE i.id = $1
+	`, invoiceID).Scan(&paid, &balance)
+	return balance, err
+}
+
+// syncPaidStatus moves an issued invoice to paid when nothing is outstanding,
+// and back to sent when a balance reappears (e.g. a payment was removed)
+func syncPaidStatus(ctx context.Context, tx pgx.Tx, invoiceID uuid.UUID) error {
+	var status string
+	if err := tx.QueryRow(ctx, `SELECT status FROM invoices WHERE id = $1`, invoiceID).Scan(&status); err != nil {
+		return err
+	}
+	if !isIssued(status) {
+		return nil
+	}
+
+	balance, err := invoiceBalance(ctx, tx, invoiceID)
+	if err != nil {
+		return err
+	}
+
+	newStatus := status
+	if toCents(balance) <= 0 {
+		newStatus = "paid"
+	} else if status == "paid" {
+		newStatus = "sent"
+	}
+	if newStatus == status {
+		return nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE invoices SET status = $1, updated_at = NOW() WHERE id = $2
+	`, newStatus, invoiceID)
+	return err
+}
+
+// toCents rounds a currency amount to whole cents for comparisons
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
@@ -0,0 +1,110 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestPayments checks that payments only go against issued invoices, can't
+// exceed the balance, and move the invoice between sent and paid
+func TestPayments(t *testing.T) {
+	f := newInvoiceFixture(t)
+	ctx := context.Background()
+	payments := store.NewPaymentStore(f.pool)
+	invoiceID := f.invoice.ID
+	paidOn := f.invoice.InvoiceDate
+
+	if _, err := payments.Record(ctx, f.userID, invoiceID, 100, paidOn, nil, nil); !errors.Is(err, store.ErrInvoiceNotPayable) {
+		t.Errorf("expected a draft to refuse payments, got %v", err)
+	}
+	if _, err := f.invoices.UpdateStatus(ctx, f.userID, invoiceID, "sent"); err != nil {
+		t.Fatalf("Failed to send invoice: %v", err)
+	}
+	if _, err := payments.Record(ctx, f.userID, invoiceID, 0, paidOn, nil, nil); !errors.Is(err, store.ErrInvalidPaymentSize) {
+		t.Errorf("expected ErrInvalidPaymentSize, got %v", err)
+	}
+	if _, err := payments.Record(ctx, f.userID, invoiceID, 500.01, paidOn, nil, nil); !errors.Is(err, store.ErrPaymentExceedsDue) {
+		t.Errorf("expected ErrPaymentExceedsDue, got %v", err)
+	}
+
+	expectInvoice := func(t *testing.T, status string, paid, due float64) {
+		t.Helper()
+		inv, err := f.invoices.GetByID(ctx, f.userID, invoiceID)
+		if err != nil {
+			t.Fatalf("Failed to get invoice: %v", err)
+		}
+		if inv.Status != status || inv.AmountPaid != paid || inv.BalanceDue != due {
+			t.Errorf("expected %s with %v paid and %v due, got %s with %v paid and %v due",
+				status, paid, due, inv.Status, inv.AmountPaid, inv.BalanceDue)
+		}
+	}
+
+	method := "bank transfer"
+	first, err := payments.Record(ctx, f.userID, invoiceID, 200, paidOn, &method, nil)
+	if err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	expectInvoice(t, "sent", 200, 300)
+
+	if _, err := f.invoices.UpdateStatus(ctx, f.userID, invoiceID, "draft"); !errors.Is(err, store.ErrHasPayments) {
+		t.Errorf("expected an invoice with payments to stay issued, got %v", err)
+	}
+
+	// An issued credit note reduces what is due
+	credit, err := f.invoices.CreateCreditNote(ctx, f.userID, invoiceID, paidOn, []store.CreditNoteLine{{Description: "Goodwill", Amount: 100}})
+	if err != nil {
+		t.Fatalf("CreateCreditNote: %v", err)
+	}
+	expectInvoice(t, "sent", 200, 300)
+	if _, err := f.invoices.UpdateStatus(ctx, f.userID, credit.ID, "sent"); err != nil {
+		t.Fatalf("Failed to send credit note: %v", err)
+	}
+	expectInvoice(t, "sent", 200, 200)
+	if _, err := payments.Record(ctx, f.userID, credit.ID, 10, paidOn, nil, nil); !errors.Is(err, store.ErrInvoiceNotPayable) {
+		t.Errorf("expected a credit note to refuse payments, got %v", err)
+	}
+
+	// Paying the rest marks the invoice paid
+	if _, err := payments.Record(ctx, f.userID, invoiceID, 200, paidOn, nil, nil); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	expectInvoice(t, "paid", 400, 0)
+
+	balances, err := payments.ClientBalances(ctx, f.userID, nil)
+	if err != nil {
+		t.Fatalf("ClientBalances: %v", err)
+	}
+	if len(balances) != 1 {
+		t.Fatalf("expected one client balance, got %d", len(balances))
+	}
+	if b := balances[0]; b.InvoiceCount != 1 || b.Invoiced != 500 || b.Credited != -100 || b.Paid != 400 || b.Outstanding != 0 {
+		t.Errorf("unexpected client balance %+v", *b)
+	}
+
+	// Removing a payment reopens the balance
+	if err := payments.Delete(ctx, uuid.New(), first.ID); !errors.Is(err, store.ErrPaymentNotFound) {
+		t.Errorf("expected another user's delete to be not found, got %v", err)
+	}
+	if err := payments.Delete(ctx, f.userID, first.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	expectInvoice(t, "sent", 200, 200)
+	if err := payments.Delete(ctx, f.userID, first.ID); !errors.Is(err, store.ErrPaymentNotFound) {
+		t.Errorf("expected ErrPaymentNotFound, got %v", err)
+	}
+
+	list, err := payments.ListByInvoice(ctx, f.userID, invoiceID)
+	if err != nil {
+		t.Fatalf("ListByInvoice: %v", err)
+	}
+	if len(list) != 1 || list[0].Amount != 200 || list[0].Method != nil {
+		t.Errorf("expected the second payment to remain, got %v", list)
+	}
+}
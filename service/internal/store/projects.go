@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var (
@@ -33,15 +34,40 @@ type Project struct {
 	FingerprintDomains     []string
 	FingerprintEmails      []string
 	FingerprintKeywords    []string
-	SheetsSpreadsheetID    *string
-	SheetsSpreadsheetURL   *string
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+
+	// Exclusion fingerprints vote against the project when they match
+	FingerprintExcludeDomains  []string
+	FingerprintExcludeKeywords []string
+	// FingerprintWeights overrides the vote weight of individual
+	// fingerprints, keyed by "kind:value"
+	FingerprintWeights map[string]float64
+	// GitHubRepos lists the "owner/name" repositories whose activity is
+	// attributed to the project
+	GitHubRepos []string
+
+	// Archive options take effect while the project is archived: its rules
+	// are disabled, its fingerprints stop matching, and it is left out of
+	// pickers (active project lists)
+	ArchiveDisableRules     bool
+	ArchiveStopFingerprints bool
+	ArchiveHideFromPickers  bool
+
+	SheetsSpreadsheetID  *string
+	SheetsSpreadsheetURL *string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// FingerprintsActive reports whether the project's fingerprints take part
+// in classification, which archiving with ArchiveStopFingerprints suspends
+func (p *Project) FingerprintsActive() bool {
+	return !p.IsArchived || !p.ArchiveStopFingerprints
 }
 
 // ProjectStore provides PostgreSQL-backed project storage
 type ProjectStore struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	changes changeHooks
 }
 
 // NewProjectStore creates a new PostgreSQL project store
@@ -49,6 +75,17 @@ func NewProjectStore(pool *pgxpool.Pool) *ProjectStore {
 	return &ProjectStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *ProjectStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// OnChange registers a hook that runs after a user's projects are created,
+// updated or deleted
+func (s *ProjectStore) OnChange(fn ChangeHook) {
+	s.changes.add(fn)
+}
+
 // Create adds a new project
 func (s *ProjectStore) Create(ctx context.Context, userID uuid.UUID, name string, shortCode, client *string, color string, isBillable, isHiddenByDefault, doesNotAccumulateHours bool) (*Project, error) {
 	project := &Project{
@@ -60,13 +97,14 @@ func (s *ProjectStore) Create(ctx context.Context, userID uuid.UUID, name string
 		Color:                  color,
 		IsBillable:             isBillable,
 		IsArchived:             false,
+		ArchiveHideFromPickers: true,
 		IsHiddenByDefault:      isHiddenByDefault,
 		DoesNotAccumulateHours: doesNotAccumulateHours,
 		CreatedAt:              time.Now().UTC(),
 		UpdatedAt:              time.Now().UTC(),
 	}
 
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		INSERT INTO projects (id, user_id, name, short_code, client, color, is_billable, is_archived, is_hidden_by_default, does_not_accumulate_hours, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`, project.ID, project.UserID, project.Name, project.ShortCode, project.Client, project.Color,
@@ -80,16 +118,20 @@ func (s *ProjectStore) Create(ctx context.Context, userID uuid.UUID, name string
 		return nil, err
 	}
 
+	s.changes.notify(ctx, userID)
 	return project, nil
 }
 
 // GetByID retrieves a project by ID for a specific user
 func (s *ProjectStore) GetByID(ctx context.Context, userID, projectID uuid.UUID) (*Project, error) {
 	project := &Project{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, user_id, name, short_code, client, color, is_billable, is_archived,
 		       is_hidden_by_default, does_not_accumulate_hours,
 		       fingerprint_domains, fingerprint_emails, fingerprint_keywords,
+		       fingerprint_exclude_domains, fingerprint_exclude_keywords, fingerprint_weights,
+		       github_repos,
+		       archive_disable_rules, archive_stop_fingerprints, archive_hide_from_pickers,
 		       sheets_spreadsheet_id, sheets_spreadsheet_url,
 		       created_at, updated_at
 		FROM projects WHERE id = $1 AND user_id = $2
@@ -98,6 +140,9 @@ func (s *ProjectStore) GetByID(ctx context.Context, userID, projectID uuid.UUID)
 		&project.IsBillable, &project.IsArchived, &project.IsHiddenByDefault,
 		&project.DoesNotAccumulateHours,
 		&project.FingerprintDomains, &project.FingerprintEmails, &project.FingerprintKeywords,
+		&project.FingerprintExcludeDomains, &project.FingerprintExcludeKeywords, &project.FingerprintWeights,
+		&project.GitHubRepos,
+		&project.ArchiveDisableRules, &project.ArchiveStopFingerprints, &project.ArchiveHideFromPickers,
 		&project.SheetsSpreadsheetID, &project.SheetsSpreadsheetURL,
 		&project.CreatedAt, &project.UpdatedAt,
 	)
@@ -111,22 +156,94 @@ func (s *ProjectStore) GetByID(ctx context.Context, userID, projectID uuid.UUID)
 	return project, nil
 }
 
+// Project list sort orders
+const (
+	ProjectSortName       = "name"
+	ProjectSortRecent     = "recent"
+	ProjectSortMonthHours = "month_hours"
+)
+
+// ProjectListOptions filters, sorts and pages a project listing. Nil
+// filters match everything; a zero Limit returns every match.
+type ProjectListOptions struct {
+	IncludeArchived bool
+	// Archived, when set, keeps only archived or only active projects and
+	// takes precedence over IncludeArchived
+	Archived *bool
+	Client   *string
+	Billable *bool
+	// Sort is one of the ProjectSort constants; empty sorts by name
+	Sort   string
+	Limit  int
+	Offset int
+}
+
 // List retrieves all projects for a user
 func (s *ProjectStore) List(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*Project, error) {
+	return s.Query(ctx, userID, ProjectListOptions{IncludeArchived: includeArchived})
+}
+
+// Query retrieves a user's projects matching opts
+func (s *ProjectStore) Query(ctx context.Context, userID uuid.UUID, opts ProjectListOptions) ([]*Project, error) {
 	query := `
 		SELECT id, user_id, name, short_code, client, color, is_billable, is_archived,
 		       is_hidden_by_default, does_not_accumulate_hours,
 		       fingerprint_domains, fingerprint_emails, fingerprint_keywords,
+		       fingerprint_exclude_domains, fingerprint_exclude_keywords, fingerprint_weights,
+		       github_repos,
+		       archive_disable_rules, archive_stop_fingerprints, archive_hide_from_pickers,
 		       sheets_spreadsheet_id, sheets_spreadsheet_url,
 		       created_at, updated_at
-		FROM projects WHERE user_id = $1
+		FROM projects p WHERE user_id = $1
 	`
-	if !includeArchived {
-		query += " AND is_archived = false"
+	args := []interface{}{userID}
+	argNum := 2
+
+	if opts.Archived != nil {
+		query += fmt.Sprintf(" AND is_archived = $%d", argNum)
+		args = append(args, *opts.Archived)
+		argNum++
+	} else if !opts.IncludeArchived {
+		query += " AND (is_archived = false OR archive_hide_from_pickers = false)"
+	}
+	if opts.Client != nil {
+		query += fmt.Sprintf(" AND client = $%d", argNum)
+		args = append(args, *opts.Client)
+		argNum++
+	}
+	if opts.Billable != nil {
+		query += fmt.Sprintf(" AND is_billable = $%d", argNum)
+		args = append(args, *opts.Billable)
+		argNum++
+	}
+
+	switch opts.Sort {
+	case ProjectSortRecent:
+		query += ` ORDER BY (
+			SELECT MAX(te.date) FROM time_entries te
+			WHERE te.project_id = p.id AND te.deleted_at IS NULL
+		) DESC NULLS LAST, name`
+	case ProjectSortMonthHours:
+		query += ` ORDER BY (
+			SELECT COALESCE(SUM(te.hours), 0) FROM time_entries te
+			WHERE te.project_id = p.id AND te.deleted_at IS NULL
+			  AND te.date >= date_trunc('month', CURRENT_DATE)
+		) DESC, name`
+	default:
+		query += " ORDER BY name"
+	}
+
+	if opts.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argNum)
+		args = append(args, opts.Limit)
+		argNum++
+	}
+	if opts.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", argNum)
+		args = append(args, opts.Offset)
 	}
-	query += " ORDER BY name"
 
-	rows, err := s.pool.Query(ctx, query, userID)
+	rows, err := s.db(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +257,9 @@ func (s *ProjectStore) List(ctx context.Context, userID uuid.UUID, includeArchiv
 			&p.IsBillable, &p.IsArchived, &p.IsHiddenByDefault,
 			&p.DoesNotAccumulateHours,
 			&p.FingerprintDomains, &p.FingerprintEmails, &p.FingerprintKeywords,
+			&p.FingerprintExcludeDomains, &p.FingerprintExcludeKeywords, &p.FingerprintWeights,
+			&p.GitHubRepos,
+			&p.ArchiveDisableRules, &p.ArchiveStopFingerprints, &p.ArchiveHideFromPickers,
 			&p.SheetsSpreadsheetID, &p.SheetsSpreadsheetURL,
 			&p.CreatedAt, &p.UpdatedAt,
 		)
@@ -170,14 +290,17 @@ func (s *ProjectStore) Update(ctx context.Context, userID, projectID uuid.UUID,
 		argNum++
 	}
 
-	query := "UPDATE projects SET " + setClauses + " WHERE id = $1 AND user_id = $2 RETURNING id, user_id, name, short_code, client, color, is_billable, is_archived, is_hidden_by_default, does_not_accumulate_hours, fingerprint_domains, fingerprint_emails, fingerprint_keywords, created_at, updated_at"
+	query := "UPDATE projects SET " + setClauses + " WHERE id = $1 AND user_id = $2 RETURNING id, user_id, name, short_code, client, color, is_billable, is_archived, is_hidden_by_default, does_not_accumulate_hours, fingerprint_domains, fingerprint_emails, fingerprint_keywords, fingerprint_exclude_domains, fingerprint_exclude_keywords, fingerprint_weights, github_repos, archive_disable_rules, archive_stop_fingerprints, archive_hide_from_pickers, created_at, updated_at"
 
 	project := &Project{}
-	err := s.pool.QueryRow(ctx, query, args...).Scan(
+	err := s.db(ctx).QueryRow(ctx, query, args...).Scan(
 		&project.ID, &project.UserID, &project.Name, &project.ShortCode, &project.Client, &project.Color,
 		&project.IsBillable, &project.IsArchived, &project.IsHiddenByDefault,
 		&project.DoesNotAccumulateHours,
 		&project.FingerprintDomains, &project.FingerprintEmails, &project.FingerprintKeywords,
+		&project.FingerprintExcludeDomains, &project.FingerprintExcludeKeywords, &project.FingerprintWeights,
+		&project.GitHubRepos,
+		&project.ArchiveDisableRules, &project.ArchiveStopFingerprints, &project.ArchiveHideFromPickers,
 		&project.CreatedAt, &project.UpdatedAt,
 	)
 
@@ -191,16 +314,52 @@ func (s *ProjectStore) Update(ctx context.Context, userID, projectID uuid.UUID,
 		return nil, err
 	}
 
+	_, archiving := updates["is_archived"]
+	_, cascading := updates["archive_disable_rules"]
+	if archiving || cascading {
+		if err := s.cascadeArchive(ctx, project); err != nil {
+			return nil, err
+		}
+	}
+
+	s.changes.notify(ctx, userID)
 	return project, nil
 }
 
-// Delete removes a project
+// cascadeArchive brings the project's rules in line with its archive state.
+// While archived with ArchiveDisableRules its enabled rules are disabled and
+// marked; otherwise the marked rules are re-enabled.
+func (s *ProjectStore) cascadeArchive(ctx context.Context, project *Project) error {
+	if project.IsArchived && project.ArchiveDisableRules {
+		_, err := s.db(ctx).Exec(ctx, `
+			UPDATE classification_rules
+			SET is_enabled = false, disabled_by_archive = true, updated_at = NOW()
+			WHERE user_id = $1 AND project_id = $2 AND is_enabled = true AND deleted_at IS NULL
+		`, project.UserID, project.ID)
+		return err
+	}
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE classification_rules
+		SET is_enabled = true, disabled_by_archive = false, updated_at = NOW()
+		WHERE user_id = $1 AND project_id = $2 AND disabled_by_archive = true
+	`, project.UserID, project.ID)
+	return err
+}
+
+// Delete removes a project. Trashed time entries don't block deletion and
+// are purged along with it.
 func (s *ProjectStore) Delete(ctx context.Context, userID, projectID uuid.UUID) error {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
 	// Check if project has time entries
 	var hasEntries bool
-	err := s.pool.QueryRow(ctx,
-		"SELECT EXISTS(SELECT 1 FROM time_entries WHERE project_id = $1)",
-		projectID,
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS(SELECT 1 FROM time_entries WHERE project_id = $1 AND user_id = $2 AND deleted_at IS NULL)",
+		projectID, userID,
 	).Scan(&hasEntries)
 	if err != nil {
 		return err
@@ -209,7 +368,15 @@ func (s *ProjectStore) Delete(ctx context.Context, userID, projectID uuid.UUID)
 		return ErrProjectHasEntries
 	}
 
-	result, err := s.pool.Exec(ctx,
+	_, err = tx.Exec(ctx,
+		"DELETE FROM time_entries WHERE project_id = $1 AND user_id = $2 AND deleted_at IS NOT NULL AND invoice_id IS NULL",
+		projectID, userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx,
 		"DELETE FROM projects WHERE id = $1 AND user_id = $2",
 		projectID, userID,
 	)
@@ -221,6 +388,10 @@ func (s *ProjectStore) Delete(ctx context.Context, userID, projectID uuid.UUID)
 		return ErrProjectNotFound
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	s.changes.notify(ctx, userID)
 	return nil
 }
 
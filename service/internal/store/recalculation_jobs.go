@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrRecalculationJobNotFound = errors.New("recalculation job not found")
+
+// RecalculationJobStatus defines the status of a recalculation job
+type RecalculationJobStatus string
+
+const (
+	RecalculationJobPending   RecalculationJobStatus = "pending"
+	RecalculationJobRunning   RecalculationJobStatus = "running"
+	RecalculationJobCompleted RecalculationJobStatus = "completed"
+	RecalculationJobFailed    RecalculationJobStatus = "failed"
+)
+
+// recalculationJobStallTimeout is how long an unfinished job may go without
+// progress before it is taken to have died, e.g. with a server restart
+const recalculationJobStallTimeout = 10 * time.Minute
+
+// RecalculationJob is a background recomputation of time entries
+type RecalculationJob struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Status          RecalculationJobStatus
+	StartDate       time.Time
+	EndDate         time.Time
+	ProjectID       *uuid.UUID
+	TotalDays       int
+	ProcessedDays   int
+	EntriesComputed int
+	EntriesCleared  int
+	ErrorMessage    *string
+	CreatedAt       time.Time
+	StartedAt       *time.Time
+	CompletedAt     *time.Time
+}
+
+// RecalculationJobProgress is the running tally recorded after each day
+type RecalculationJobProgress struct {
+	TotalDays       int
+	ProcessedDays   int
+	EntriesComputed int
+	EntriesCleared  int
+}
+
+// RecalculationJobStore provides PostgreSQL-backed recalculation job storage
+type RecalculationJobStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewRecalculationJobStore creates a new store
+func NewRecalculationJobStore(pool *pgxpool.Pool) *RecalculationJobStore {
+	return &RecalculationJobStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *RecalculationJobStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+const recalculationJobColumns = `id, user_id, status, start_date, end_date, project_id,
+	total_days, processed_days, entries_computed, entries_cleared,
+	error_message, created_at, started_at, completed_at`
+
+func scanRecalculationJob(row pgx.Row) (*RecalculationJob, error) {
+	job := &RecalculationJob{}
+	err := row.Scan(
+		&job.ID, &job.UserID, &job.Status, &job.StartDate, &job.EndDate, &job.ProjectID,
+		&job.TotalDays, &job.ProcessedDays, &job.EntriesComputed, &job.EntriesCleared,
+		&job.ErrorMessage, &job.CreatedAt, &job.StartedAt, &job.CompletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRecalculationJobNotFound
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// Create records a new pending job
+func (s *RecalculationJobStore) Create(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time, projectID *uuid.UUID) (*RecalculationJob, error) {
+	return scanRecalculationJob(s.db(ctx).QueryRow(ctx, `
+		INSERT INTO recalculation_jobs (user_id, start_date, end_date, project_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+recalculationJobColumns,
+		userID, startDate, endDate, projectID,
+	))
+}
+
+// FindActive returns the user's unfinished job with exactly this scope, so
+// a repeated request can join it rather than start another. Jobs that have
+// stopped making progress are ignored.
+func (s *RecalculationJobStore) FindActive(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time, projectID *uuid.UUID) (*RecalculationJob, error) {
+	return scanRecalculationJob(s.db(ctx).QueryRow(ctx, `
+		SELECT `+recalculationJobColumns+`
+		FROM recalculation_jobs
+		WHERE user_id = $1 AND start_date = $2 AND end_date = $3
+		  AND project_id IS NOT DISTINCT FROM $4
+		  AND status IN ('pending', 'running')
+		  AND updated_at > $5
+		ORDER BY created_at DESC
+		LIMIT 1
+	`, userID, startDate, endDate, projectID, time.Now().Add(-recalculationJobStallTimeout)))
+}
+
+// GetByID retrieves a job owned by the user
+func (s *RecalculationJobStore) GetByID(ctx context.Context, userID, jobID uuid.UUID) (*RecalculationJob, error) {
+	return scanRecalculationJob(s.db(ctx).QueryRow(ctx, `
+		SELECT `+recalculationJobColumns+`
+		FROM recalculation_jobs
+		WHERE id = $1 AND user_id = $2
+	`, jobID, userID))
+}
+
+// Start marks a job as running
+func (s *RecalculationJobStore) Start(ctx context.Context, jobID uuid.UUID) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE recalculation_jobs
+		SET status = 'running', started_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, jobID)
+	return err
+}
+
+// UpdateProgress records the tally after a day
+func (s *RecalculationJobStore) UpdateProgress(ctx context.Context, jobID uuid.UUID, progress RecalculationJobProgress) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE recalculation_jobs
+		SET total_days = $2, processed_days = $3, entries_computed = $4,
+		    entries_cleared = $5, updated_at = NOW()
+		WHERE id = $1
+	`, jobID, progress.TotalDays, progress.ProcessedDays, progress.EntriesComputed, progress.EntriesCleared)
+	return err
+}
+
+// Complete marks a job as finished
+func (s *RecalculationJobStore) Complete(ctx context.Context, jobID uuid.UUID) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE recalculation_jobs
+		SET status = 'completed', completed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, jobID)
+	return err
+}
+
+// Fail marks a job as failed with an error message
+func (s *RecalculationJobStore) Fail(ctx context.Context, jobID uuid.UUID, errMsg string) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE recalculation_jobs
+		SET status = 'failed', error_message = $2, completed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, jobID, errMsg)
+	return err
+}
@@ -0,0 +1,83 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestScopeAudit runs store operations under the scope auditor and checks
+// every statement carried the acting user's ID
+func TestScopeAudit(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	auditor := database.NewScopeAuditor(log.Printf)
+
+	db, err := database.New(ctx, dbURL, database.WithScopeAudit(auditor))
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+	if err := auditor.LoadTenantTables(ctx, db.Pool); err != nil {
+		t.Fatal(err)
+	}
+
+	userStore := store.NewUserStore(db.Pool)
+	projectStore := store.NewProjectStore(db.Pool)
+
+	user, err := userStore.Create(ctx, "scope-audit-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	auditor.Reset()
+	userCtx := database.WithScope(ctx, database.Scope{UserID: user.ID})
+
+	project, err := projectStore.Create(userCtx, user.ID, "Audited", nil, nil, "#000000", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := projectStore.GetByID(userCtx, user.ID, project.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := projectStore.List(userCtx, user.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := projectStore.Update(userCtx, user.ID, project.ID, map[string]interface{}{"name": "Renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := projectStore.Delete(userCtx, user.ID, project.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range auditor.Violations() {
+		t.Errorf("unscoped query: %s", v)
+	}
+
+	// A query that ignores the scope is caught
+	auditor.Reset()
+	var count int
+	if err := db.Pool.QueryRow(userCtx, "SELECT count(*) FROM projects").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if len(auditor.Violations()) != 1 {
+		t.Errorf("expected the unscoped count to be flagged, got %v", auditor.Violations())
+	}
+}
@@ -0,0 +1,140 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrSuppressionRuleNotFound = errors.New("suppression rule not found")
+
+// SuppressionRule keeps matching events out of the pending queue. A rule
+// with only a calendar suppresses everything from that calendar; a rule
+// with a query suppresses matching events, limited to the calendar if set.
+type SuppressionRule struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	CalendarID *uuid.UUID
+	Query      *string
+	IsEnabled  bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// SuppressionRuleStore provides PostgreSQL-backed suppression rule storage
+type SuppressionRuleStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewSuppressionRuleStore creates a new suppression rule store
+func NewSuppressionRuleStore(pool *pgxpool.Pool) *SuppressionRuleStore {
+	return &SuppressionRuleStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *SuppressionRuleStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Create adds a new suppression rule
+func (s *SuppressionRuleStore) Create(ctx context.Context, userID uuid.UUID, calendarID *uuid.UUID, query *string) (*SuppressionRule, error) {
+	now := time.Now().UTC()
+	rule := &SuppressionRule{
+		ID:         uuid.New(),
+		UserID:     userID,
+		CalendarID: calendarID,
+		Query:      query,
+		IsEnabled:  true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	_, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO suppression_rules (id, user_id, calendar_id, query, is_enabled, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, rule.ID, rule.UserID, rule.CalendarID, rule.Query, rule.IsEnabled, rule.CreatedAt, rule.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// GetByID retrieves a suppression rule
+func (s *SuppressionRuleStore) GetByID(ctx context.Context, userID, ruleID uuid.UUID) (*SuppressionRule, error) {
+	r := &SuppressionRule{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT id, user_id, calendar_id, query, is_enabled, created_at, updated_at
+		FROM suppression_rules
+		WHERE id = $1 AND user_id = $2
+	`, ruleID, userID).Scan(
+		&r.ID, &r.UserID, &r.CalendarID, &r.Query, &r.IsEnabled, &r.CreatedAt, &r.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSuppressionRuleNotFound
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+// List returns a user's suppression rules, optionally only enabled ones
+func (s *SuppressionRuleStore) List(ctx context.Context, userID uuid.UUID, includeDisabled bool) ([]*SuppressionRule, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, user_id, calendar_id, query, is_enabled, created_at, updated_at
+		FROM suppression_rules
+		WHERE user_id = $1 AND ($2 OR is_enabled)
+		ORDER BY created_at
+	`, userID, includeDisabled)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*SuppressionRule
+	for rows.Next() {
+		r := &SuppressionRule{}
+		if err := rows.Scan(
+			&r.ID, &r.UserID, &r.CalendarID, &r.Query, &r.IsEnabled, &r.CreatedAt, &r.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
+
+// SetEnabled enables or disables a suppression rule
+func (s *SuppressionRuleStore) SetEnabled(ctx context.Context, userID, ruleID uuid.UUID, enabled bool) (*SuppressionRule, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE suppression_rules SET is_enabled = $3, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+	`, ruleID, userID, enabled)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrSuppressionRuleNotFound
+	}
+	return s.GetByID(ctx, userID, ruleID)
+}
+
+// Delete removes a suppression rule
+func (s *SuppressionRuleStore) Delete(ctx context.Context, userID, ruleID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM suppression_rules WHERE id = $1 AND user_id = $2",
+		ruleID, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrSuppressionRuleNotFound
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var ErrSyncJobNotFound = errors.New("sync job not found")
@@ -56,6 +57,11 @@ func NewSyncJobStore(pool *pgxpool.Pool) *SyncJobStore {
 	return &SyncJobStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *SyncJobStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // Create creates a new sync job
 func (s *SyncJobStore) Create(ctx context.Context, job *SyncJob) (*SyncJob, error) {
 	now := time.Now().UTC()
@@ -63,7 +69,7 @@ func (s *SyncJobStore) Create(ctx context.Context, job *SyncJob) (*SyncJob, erro
 	job.Status = SyncJobStatusPending
 	job.CreatedAt = now
 
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		INSERT INTO calendar_sync_jobs (
 			id, calendar_id, job_type, target_min_date, target_max_date,
 			status, priority, created_at
@@ -84,7 +90,7 @@ func (s *SyncJobStore) Create(ctx context.Context, job *SyncJob) (*SyncJob, erro
 // GetByID retrieves a sync job by ID
 func (s *SyncJobStore) GetByID(ctx context.Context, jobID uuid.UUID) (*SyncJob, error) {
 	job := &SyncJob{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, calendar_id, job_type, target_min_date, target_max_date,
 		       status, priority, created_at, claimed_at, completed_at,
 		       error_message, claimed_by
@@ -108,7 +114,7 @@ func (s *SyncJobStore) GetByID(ctx context.Context, jobID uuid.UUID) (*SyncJob,
 
 // ListPendingByCalendar returns all pending jobs for a calendar, ordered by priority and creation time
 func (s *SyncJobStore) ListPendingByCalendar(ctx context.Context, calendarID uuid.UUID) ([]*SyncJob, error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT id, calendar_id, job_type, target_min_date, target_max_date,
 		       status, priority, created_at, claimed_at, completed_at,
 		       error_message, claimed_by
@@ -131,7 +137,7 @@ func (s *SyncJobStore) ClaimNextJob(ctx context.Context, workerID string) (*Sync
 	now := time.Now().UTC()
 
 	job := &SyncJob{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		UPDATE calendar_sync_jobs
 		SET status = 'running', claimed_at = $1, claimed_by = $2
 		WHERE id = (
@@ -166,7 +172,7 @@ func (s *SyncJobStore) ClaimNextJobForCalendar(ctx context.Context, calendarID u
 	now := time.Now().UTC()
 
 	job := &SyncJob{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		UPDATE calendar_sync_jobs
 		SET status = 'running', claimed_at = $2, claimed_by = $3
 		WHERE id = (
@@ -198,7 +204,7 @@ func (s *SyncJobStore) ClaimNextJobForCalendar(ctx context.Context, calendarID u
 // MarkCompleted marks a job as successfully completed
 func (s *SyncJobStore) MarkCompleted(ctx context.Context, jobID uuid.UUID) error {
 	now := time.Now().UTC()
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendar_sync_jobs
 		SET status = 'completed', completed_at = $2
 		WHERE id = $1
@@ -209,7 +215,7 @@ func (s *SyncJobStore) MarkCompleted(ctx context.Context, jobID uuid.UUID) error
 // MarkFailed marks a job as failed with an error message
 func (s *SyncJobStore) MarkFailed(ctx context.Context, jobID uuid.UUID, errorMessage string) error {
 	now := time.Now().UTC()
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE calendar_sync_jobs
 		SET status = 'failed', completed_at = $2, error_message = $3
 		WHERE id = $1
@@ -217,10 +223,21 @@ func (s *SyncJobStore) MarkFailed(ctx context.Context, jobID uuid.UUID, errorMes
 	return err
 }
 
+// Release returns a running job to the queue, e.g. when its worker is
+// shutting down before the job could finish
+func (s *SyncJobStore) Release(ctx context.Context, jobID uuid.UUID) error {
+	_, err := s.db(ctx).Exec(ctx, `
+		UPDATE calendar_sync_jobs
+		SET status = 'pending', claimed_at = NULL, claimed_by = NULL
+		WHERE id = $1 AND status = 'running'
+	`, jobID)
+	return err
+}
+
 // CoalescePendingJobs finds all pending jobs for a calendar and returns a coalesced date range.
 // This doesn't modify the jobs - caller should delete them after processing.
 func (s *SyncJobStore) CoalescePendingJobs(ctx context.Context, calendarID uuid.UUID) (minDate, maxDate time.Time, jobIDs []uuid.UUID, err error) {
-	rows, err := s.pool.Query(ctx, `
+	rows, err := s.db(ctx).Query(ctx, `
 		SELECT id, target_min_date, target_max_date
 		FROM calendar_sync_jobs
 		WHERE calendar_id = $1 AND status = 'pending'
@@ -268,7 +285,7 @@ func (s *SyncJobStore) DeleteJobs(ctx context.Context, jobIDs []uuid.UUID) error
 		return nil
 	}
 
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		DELETE FROM calendar_sync_jobs
 		WHERE id = ANY($1)
 	`, jobIDs)
@@ -279,7 +296,7 @@ func (s *SyncJobStore) DeleteJobs(ctx context.Context, jobIDs []uuid.UUID) error
 func (s *SyncJobStore) DeleteOldCompletedJobs(ctx context.Context, olderThan time.Duration) (int64, error) {
 	cutoff := time.Now().Add(-olderThan)
 
-	result, err := s.pool.Exec(ctx, `
+	result, err := s.db(ctx).Exec(ctx, `
 		DELETE FROM calendar_sync_jobs
 		WHERE status IN ('completed', 'failed')
 		  AND completed_at < $1
@@ -294,13 +311,35 @@ func (s *SyncJobStore) DeleteOldCompletedJobs(ctx context.Context, olderThan tim
 // CountPendingByCalendar returns the count of pending jobs for a calendar
 func (s *SyncJobStore) CountPendingByCalendar(ctx context.Context, calendarID uuid.UUID) (int, error) {
 	var count int
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT COUNT(*) FROM calendar_sync_jobs
 		WHERE calendar_id = $1 AND status = 'pending'
 	`, calendarID).Scan(&count)
 	return count, err
 }
 
+// ListStuck returns jobs that have been running longer than runningFor or
+// waiting longer than pendingFor, oldest first. A running job is usually
+// stuck because its worker died after claiming it.
+func (s *SyncJobStore) ListStuck(ctx context.Context, runningFor, pendingFor time.Duration) ([]*SyncJob, error) {
+	now := time.Now().UTC()
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, calendar_id, job_type, target_min_date, target_max_date,
+		       status, priority, created_at, claimed_at, completed_at,
+		       error_message, claimed_by
+		FROM calendar_sync_jobs
+		WHERE (status = 'running' AND claimed_at < $1)
+		   OR (status = 'pending' AND created_at < $2)
+		ORDER BY created_at ASC
+	`, now.Add(-runningFor), now.Add(-pendingFor))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSyncJobs(rows)
+}
+
 // Helper function to scan sync jobs from rows
 func scanSyncJobs(rows pgx.Rows) ([]*SyncJob, error) {
 	var jobs []*SyncJob
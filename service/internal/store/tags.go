@@ -0,0 +1,319 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var (
+	ErrTagNotFound = errors.New("tag not found")
+	ErrTagExists   = errors.New("tag already exists")
+)
+
+// eventTagsColumn selects an event's tag names, for queries over
+// calendar_events ce
+const eventTagsColumn = `ARRAY(
+		           SELECT t.name FROM calendar_event_tags et JOIN tags t ON t.id = et.tag_id
+		           WHERE et.event_id = ce.id ORDER BY lower(t.name)
+		       )`
+
+// entryTagsColumn selects an entry's tag names, for queries over
+// time_entries te
+const entryTagsColumn = `ARRAY(
+		           SELECT t.name FROM time_entry_tags tt JOIN tags t ON t.id = tt.tag_id
+		           WHERE tt.time_entry_id = te.id ORDER BY lower(t.name)
+		       )`
+
+// Tag is a free-form label the user puts on events and time entries
+type Tag struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	EventCount int
+	EntryCount int
+	CreatedAt  time.Time
+}
+
+// TagHours is the time entry hours carrying one tag
+type TagHours struct {
+	Tag   string
+	Hours float64
+}
+
+// TagStore provides PostgreSQL-backed tag storage
+type TagStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewTagStore creates a new PostgreSQL tag store
+func NewTagStore(pool *pgxpool.Pool) *TagStore {
+	return &TagStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *TagStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// NormalizeTags trims the names, drops empty ones and removes
+// case-insensitive duplicates, keeping the first spelling
+func NormalizeTags(names []string) []string {
+	seen := make(map[string]bool)
+	result := []string{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, name)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return strings.ToLower(result[i]) < strings.ToLower(result[j])
+	})
+	return result
+}
+
+// List returns the user's tags with how many events and entries carry each
+func (s *TagStore) List(ctx context.Context, userID uuid.UUID) ([]*Tag, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT t.id, t.user_id, t.name, t.created_at,
+		       (SELECT COUNT(*) FROM calendar_event_tags WHERE tag_id = t.id),
+		       (SELECT COUNT(*) FROM time_entry_tags tt
+		        JOIN time_entries te ON te.id = tt.time_entry_id
+		        WHERE tt.tag_id = t.id AND te.deleted_at IS NULL)
+		FROM tags t
+		WHERE t.user_id = $1
+		ORDER BY lower(t.name)
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*Tag
+	for rows.Next() {
+		t := &Tag{}
+		if err := rows.Scan(&t.ID, &t.UserID, &t.Name, &t.CreatedAt, &t.EventCount, &t.EntryCount); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
+
+// Create adds a tag. Names are unique per user, ignoring case.
+func (s *TagStore) Create(ctx context.Context, userID uuid.UUID, name string) (*Tag, error) {
+	tag := &Tag{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	_, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO tags (id, user_id, name, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, tag.ID, tag.UserID, tag.Name, tag.CreatedAt)
+	if err != nil {
+		if isTagNameViolation(err) {
+			return nil, ErrTagExists
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+// Rename changes a tag's name everywhere it is used
+func (s *TagStore) Rename(ctx context.Context, userID, tagID uuid.UUID, name string) (*Tag, error) {
+	tag := &Tag{}
+	err := s.db(ctx).QueryRow(ctx, `
+		UPDATE tags SET name = $3
+		WHERE id = $1 AND user_id = $2
+		RETURNING id, user_id, name, created_at,
+		          (SELECT COUNT(*) FROM calendar_event_tags WHERE tag_id = tags.id),
+		          (SELECT COUNT(*) FROM time_entry_tags tt
+		           JOIN time_entries te ON te.id = tt.time_entry_id
+		           WHERE tt.tag_id = tags.id AND te.deleted_at IS NULL)
+	`, tagID, userID, name).Scan(&tag.ID, &tag.UserID, &tag.Name, &tag.CreatedAt, &tag.EventCount, &tag.EntryCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTagNotFound
+		}
+		if isTagNameViolation(err) {
+			return nil, ErrTagExists
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+// Delete removes a tag from everything carrying it
+func (s *TagStore) Delete(ctx context.Context, userID, tagID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM tags WHERE id = $1 AND user_id = $2
+	`, tagID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrTagNotFound
+	}
+	return nil
+}
+
+// SetEventTags replaces the tags on one of the user's events, creating tags
+// that don't exist yet. It returns the event's tags.
+func (s *TagStore) SetEventTags(ctx context.Context, userID, eventID uuid.UUID, names []string) ([]string, error) {
+	var exists bool
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM calendar_events WHERE id = $1 AND user_id = $2)
+	`, eventID, userID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrCalendarEventNotFound
+	}
+
+	return s.setTags(ctx, userID, names, `
+		DELETE FROM calendar_event_tags WHERE event_id = $1
+	`, `
+		INSERT INTO calendar_event_tags (event_id, tag_id)
+		SELECT $1, unnest($2::uuid[])
+	`, eventID)
+}
+
+// SetEntryTags replaces the tags on one of the user's time entries, creating
+// tags that don't exist yet. It returns the entry's tags.
+func (s *TagStore) SetEntryTags(ctx context.Context, userID, entryID uuid.UUID, names []string) ([]string, error) {
+	var exists bool
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM time_entries WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL)
+	`, entryID, userID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrTimeEntryNotFound
+	}
+
+	return s.setTags(ctx, userID, names, `
+		DELETE FROM time_entry_tags WHERE time_entry_id = $1
+	`, `
+		INSERT INTO time_entry_tags (time_entry_id, tag_id)
+		SELECT $1, unnest($2::uuid[])
+	`, entryID)
+}
+
+// setTags resolves names to tag IDs, creating missing tags, and replaces the
+// target's links using the given delete and insert statements
+func (s *TagStore) setTags(ctx context.Context, userID uuid.UUID, names []string, deleteSQL, insertSQL string, targetID uuid.UUID) ([]string, error) {
+	names = NormalizeTags(names)
+
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ids := make([]uuid.UUID, 0, len(names))
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		// A tag created concurrently under the same name is picked up by
+		// the lookup after the insert is skipped
+		_, err := tx.Exec(ctx, `
+			INSERT INTO tags (id, user_id, name, created_at)
+			VALUES ($1, $2, $3, NOW())
+			ON CONFLICT (user_id, lower(name)) DO NOTHING
+		`, uuid.New(), userID, name)
+		if err != nil {
+			return nil, err
+		}
+
+		var id uuid.UUID
+		var stored string
+		err = tx.QueryRow(ctx, `
+			SELECT id, name FROM tags WHERE user_id = $1 AND lower(name) = lower($2)
+		`, userID, name).Scan(&id, &stored)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		result = append(result, stored)
+	}
+
+	if _, err := tx.Exec(ctx, deleteSQL, targetID); err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		if _, err := tx.Exec(ctx, insertSQL, targetID, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// HoursByTag totals the hours of the user's time entries dated within the
+// range by tag, leaving out projects that don't accumulate hours. An entry
+// with several tags counts toward each of them.
+func (s *TagStore) HoursByTag(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) ([]TagHours, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT t.name, SUM(te.hours)
+		FROM time_entry_tags tt
+		JOIN tags t ON t.id = tt.tag_id
+		JOIN time_entries te ON te.id = tt.time_entry_id
+		JOIN projects p ON p.id = te.project_id
+		WHERE t.user_id = $1
+		  AND te.deleted_at IS NULL
+		  AND p.does_not_accumulate_hours = false
+		  AND te.is_suppressed = false
+		  AND te.date >= $2 AND te.date <= $3
+		GROUP BY t.id, t.name
+		ORDER BY SUM(te.hours) DESC, lower(t.name)
+	`, userID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []TagHours
+	for rows.Next() {
+		var th TagHours
+		if err := rows.Scan(&th.Tag, &th.Hours); err != nil {
+			return nil, err
+		}
+		result = append(result, th)
+	}
+	return result, rows.Err()
+}
+
+// HasTag reports whether tags contains name, ignoring case
+func HasTag(tags []string, name string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTagNameViolation reports whether err is a duplicate tag name
+func isTagNameViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_tags_user_name"
+}
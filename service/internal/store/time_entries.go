@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 )
 
 var (
@@ -38,9 +39,11 @@ type TimeEntry struct {
 	CalculationDetails    []byte   // JSONB stored as bytes
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
+	DeletedAt             *time.Time // Set while the entry is in the trash
 	// Joined data
 	Project            *Project
 	ContributingEvents []uuid.UUID // From junction table
+	Tags               []string    // Names from time_entry_tags
 }
 
 // TimeEntryStore provides PostgreSQL-backed time entry storage
@@ -53,6 +56,11 @@ func NewTimeEntryStore(pool *pgxpool.Pool) *TimeEntryStore {
 	return &TimeEntryStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *TimeEntryStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // Create adds a new time entry or updates if one exists for the same project/date
 // On upsert, captures snapshot_computed_hours for staleness detection
 func (s *TimeEntryStore) Create(ctx context.Context, userID, projectID uuid.UUID, date time.Time, hours float64, description *string) (*TimeEntry, error) {
@@ -71,14 +79,22 @@ func (s *TimeEntryStore) Create(ctx context.Context, userID, projectID uuid.UUID
 
 	// Use upsert - if entry exists for same project/date, add hours
 	// On conflict, capture snapshot_computed_hours to anchor staleness detection
-	_, err := s.pool.Exec(ctx, `
+	// A trashed entry in the same slot is replaced rather than added to
+	_, err := s.db(ctx).Exec(ctx, `
 		INSERT INTO time_entries (id, user_id, project_id, date, hours, description, source, has_user_edits, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		ON CONFLICT (user_id, project_id, date) DO UPDATE SET
-			hours = time_entries.hours + EXCLUDED.hours,
-			description = COALESCE(EXCLUDED.description, time_entries.description),
+			hours = CASE
+				WHEN time_entries.deleted_at IS NOT NULL THEN EXCLUDED.hours
+				ELSE time_entries.hours + EXCLUDED.hours
+			END,
+			description = CASE
+				WHEN time_entries.deleted_at IS NOT NULL THEN EXCLUDED.description
+				ELSE COALESCE(EXCLUDED.description, time_entries.description)
+			END,
 			has_user_edits = true,
 			snapshot_computed_hours = time_entries.computed_hours,
+			deleted_at = NULL,
 			updated_at = EXCLUDED.updated_at
 	`, entry.ID, entry.UserID, entry.ProjectID, entry.Date, entry.Hours,
 		entry.Description, entry.Source, entry.HasUserEdits, entry.CreatedAt, entry.UpdatedAt)
@@ -94,18 +110,20 @@ func (s *TimeEntryStore) Create(ctx context.Context, userID, projectID uuid.UUID
 // GetByID retrieves a time entry by ID for a specific user
 func (s *TimeEntryStore) GetByID(ctx context.Context, userID, entryID uuid.UUID) (*TimeEntry, error) {
 	entry := &TimeEntry{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, user_id, project_id, date, hours, title, description, source, invoice_id, has_user_edits,
 		       is_stale, is_suppressed,
 		       computed_hours, computed_title, computed_description, snapshot_computed_hours,
-		       calculation_details, created_at, updated_at
-		FROM time_entries WHERE id = $1 AND user_id = $2
+		       calculation_details, created_at, updated_at,
+		       `+entryTagsColumn+`
+		FROM time_entries te WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 	`, entryID, userID).Scan(
 		&entry.ID, &entry.UserID, &entry.ProjectID, &entry.Date, &entry.Hours,
 		&entry.Title, &entry.Description, &entry.Source, &entry.InvoiceID, &entry.HasUserEdits,
 		&entry.IsStale, &entry.IsSuppressed,
 		&entry.ComputedHours, &entry.ComputedTitle, &entry.ComputedDescription, &entry.SnapshotComputedHours,
 		&entry.CalculationDetails, &entry.CreatedAt, &entry.UpdatedAt,
+		&entry.Tags,
 	)
 
 	if err != nil {
@@ -120,18 +138,20 @@ func (s *TimeEntryStore) GetByID(ctx context.Context, userID, entryID uuid.UUID)
 // GetByProjectAndDate retrieves a time entry by project and date
 func (s *TimeEntryStore) GetByProjectAndDate(ctx context.Context, userID, projectID uuid.UUID, date time.Time) (*TimeEntry, error) {
 	entry := &TimeEntry{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, user_id, project_id, date, hours, title, description, source, invoice_id, has_user_edits,
 		       is_stale, is_suppressed,
 		       computed_hours, computed_title, computed_description, snapshot_computed_hours,
-		       calculation_details, created_at, updated_at
-		FROM time_entries WHERE user_id = $1 AND project_id = $2 AND date = $3
+		       calculation_details, created_at, updated_at,
+		       `+entryTagsColumn+`
+		FROM time_entries te WHERE user_id = $1 AND project_id = $2 AND date = $3 AND deleted_at IS NULL
 	`, userID, projectID, date).Scan(
 		&entry.ID, &entry.UserID, &entry.ProjectID, &entry.Date, &entry.Hours,
 		&entry.Title, &entry.Description, &entry.Source, &entry.InvoiceID, &entry.HasUserEdits,
 		&entry.IsStale, &entry.IsSuppressed,
 		&entry.ComputedHours, &entry.ComputedTitle, &entry.ComputedDescription, &entry.SnapshotComputedHours,
 		&entry.CalculationDetails, &entry.CreatedAt, &entry.UpdatedAt,
+		&entry.Tags,
 	)
 
 	if err != nil {
@@ -152,10 +172,11 @@ func (s *TimeEntryStore) List(ctx context.Context, userID uuid.UUID, startDate,
 		       te.computed_hours, te.computed_title, te.computed_description, te.snapshot_computed_hours,
 		       te.calculation_details, te.created_at, te.updated_at,
 		       p.id, p.user_id, p.name, p.short_code, p.color, p.is_billable, p.is_archived,
-		       p.is_hidden_by_default, p.does_not_accumulate_hours, p.created_at, p.updated_at
+		       p.is_hidden_by_default, p.does_not_accumulate_hours, p.created_at, p.updated_at,
+		       ` + entryTagsColumn + `
 		FROM time_entries te
 		JOIN projects p ON te.project_id = p.id
-		WHERE te.user_id = $1
+		WHERE te.user_id = $1 AND te.deleted_at IS NULL
 	`
 	args := []interface{}{userID}
 	argNum := 2
@@ -177,7 +198,7 @@ func (s *TimeEntryStore) List(ctx context.Context, userID uuid.UUID, startDate,
 
 	query += " ORDER BY te.date DESC, p.name"
 
-	rows, err := s.pool.Query(ctx, query, args...)
+	rows, err := s.db(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +217,7 @@ func (s *TimeEntryStore) List(ctx context.Context, userID uuid.UUID, startDate,
 			&e.Project.Color, &e.Project.IsBillable, &e.Project.IsArchived,
 			&e.Project.IsHiddenByDefault, &e.Project.DoesNotAccumulateHours,
 			&e.Project.CreatedAt, &e.Project.UpdatedAt,
+			&e.Tags,
 		)
 		if err != nil {
 			return nil, err
@@ -231,14 +253,14 @@ func (s *TimeEntryStore) Update(ctx context.Context, userID, entryID uuid.UUID,
 
 	// Capture snapshot_computed_hours at materialization time
 	// This anchors the staleness check to know what computed_hours was when user made their edit
-	_, err = s.pool.Exec(ctx, `
+	_, err = s.db(ctx).Exec(ctx, `
 		UPDATE time_entries
 		SET hours = $3,
 		    description = $4,
 		    has_user_edits = true,
 		    snapshot_computed_hours = computed_hours,
 		    updated_at = $5
-		WHERE id = $1 AND user_id = $2
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 	`, entryID, userID, entry.Hours, entry.Description, now)
 
 	if err != nil {
@@ -266,20 +288,23 @@ func (s *TimeEntryStore) CreateFromCalendar(ctx context.Context, userID, project
 	}
 
 	// Use upsert - if entry exists for same project/date, add hours (unless user edited)
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		INSERT INTO time_entries (id, user_id, project_id, date, hours, description, source, has_user_edits, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		ON CONFLICT (user_id, project_id, date) DO UPDATE SET
 			hours = CASE
+				WHEN time_entries.deleted_at IS NOT NULL THEN EXCLUDED.hours
 				WHEN time_entries.has_user_edits THEN time_entries.hours
 				ELSE time_entries.hours + EXCLUDED.hours
 			END,
 			description = CASE
+				WHEN time_entries.deleted_at IS NOT NULL THEN EXCLUDED.description
 				WHEN time_entries.has_user_edits THEN time_entries.description
 				WHEN time_entries.description IS NULL THEN EXCLUDED.description
 				WHEN EXCLUDED.description IS NULL THEN time_entries.description
 				ELSE time_entries.description || E'\n' || EXCLUDED.description
 			END,
+			deleted_at = NULL,
 			updated_at = EXCLUDED.updated_at
 	`, entry.ID, entry.UserID, entry.ProjectID, entry.Date, entry.Hours,
 		entry.Description, entry.Source, entry.HasUserEdits, entry.CreatedAt, entry.UpdatedAt)
@@ -303,7 +328,7 @@ func (s *TimeEntryStore) Delete(ctx context.Context, userID, entryID uuid.UUID)
 		return ErrTimeEntryInvoiced
 	}
 
-	result, err := s.pool.Exec(ctx,
+	result, err := s.db(ctx).Exec(ctx,
 		"DELETE FROM time_entries WHERE id = $1 AND user_id = $2",
 		entryID, userID,
 	)
@@ -318,12 +343,110 @@ func (s *TimeEntryStore) Delete(ctx context.Context, userID, entryID uuid.UUID)
 	return nil
 }
 
+// --- Trash ---
+
+// Trash soft-deletes a time entry so a user-initiated delete can be undone.
+// Automatic cleanup during recalculation uses Delete instead.
+func (s *TimeEntryStore) Trash(ctx context.Context, userID, entryID uuid.UUID) error {
+	entry, err := s.GetByID(ctx, userID, entryID)
+	if err != nil {
+		return err
+	}
+	if entry.InvoiceID != nil {
+		return ErrTimeEntryInvoiced
+	}
+
+	result, err := s.db(ctx).Exec(ctx,
+		"UPDATE time_entries SET deleted_at = NOW() WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL",
+		entryID, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrTimeEntryNotFound
+	}
+	return nil
+}
+
+// ListTrashed returns a user's trashed time entries, most recently deleted first
+func (s *TimeEntryStore) ListTrashed(ctx context.Context, userID uuid.UUID) ([]*TimeEntry, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT te.id, te.user_id, te.project_id, te.date, te.hours, te.title, te.description,
+		       te.source, te.invoice_id, te.has_user_edits,
+		       te.is_stale, te.is_suppressed,
+		       te.computed_hours, te.computed_title, te.computed_description, te.snapshot_computed_hours,
+		       te.calculation_details, te.created_at, te.updated_at, te.deleted_at,
+		       p.id, p.user_id, p.name, p.short_code, p.color, p.is_billable, p.is_archived,
+		       p.is_hidden_by_default, p.does_not_accumulate_hours, p.created_at, p.updated_at
+		FROM time_entries te
+		JOIN projects p ON te.project_id = p.id
+		WHERE te.user_id = $1 AND te.deleted_at IS NOT NULL
+		ORDER BY te.deleted_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*TimeEntry
+	for rows.Next() {
+		e := &TimeEntry{Project: &Project{}}
+		err := rows.Scan(
+			&e.ID, &e.UserID, &e.ProjectID, &e.Date, &e.Hours, &e.Title, &e.Description,
+			&e.Source, &e.InvoiceID, &e.HasUserEdits,
+			&e.IsStale, &e.IsSuppressed,
+			&e.ComputedHours, &e.ComputedTitle, &e.ComputedDescription, &e.SnapshotComputedHours,
+			&e.CalculationDetails, &e.CreatedAt, &e.UpdatedAt, &e.DeletedAt,
+			&e.Project.ID, &e.Project.UserID, &e.Project.Name, &e.Project.ShortCode,
+			&e.Project.Color, &e.Project.IsBillable, &e.Project.IsArchived,
+			&e.Project.IsHiddenByDefault, &e.Project.DoesNotAccumulateHours,
+			&e.Project.CreatedAt, &e.Project.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// Restore takes a time entry out of the trash
+func (s *TimeEntryStore) Restore(ctx context.Context, userID, entryID uuid.UUID) (*TimeEntry, error) {
+	result, err := s.db(ctx).Exec(ctx,
+		"UPDATE time_entries SET deleted_at = NULL, updated_at = NOW() WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL",
+		entryID, userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrTimeEntryNotFound
+	}
+	return s.GetByID(ctx, userID, entryID)
+}
+
+// PurgeTrashed permanently deletes time entries trashed before the cutoff
+func (s *TimeEntryStore) PurgeTrashed(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx,
+		"DELETE FROM time_entries WHERE deleted_at < $1 AND invoice_id IS NULL",
+		before,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 // --- Contributing Events (Junction Table) ---
 
-// SetContributingEvents replaces the contributing events for a time entry
+// SetContributingEvents replaces the contributing events for a time entry.
+// The entry now reflects those events, so their duration-changed flags are
+// cleared.
 func (s *TimeEntryStore) SetContributingEvents(ctx context.Context, entryID uuid.UUID, eventIDs []uuid.UUID) error {
 	// Delete existing
-	_, err := s.pool.Exec(ctx,
+	_, err := s.db(ctx).Exec(ctx,
 		"DELETE FROM time_entry_events WHERE time_entry_id = $1",
 		entryID,
 	)
@@ -333,7 +456,7 @@ func (s *TimeEntryStore) SetContributingEvents(ctx context.Context, entryID uuid
 
 	// Insert new
 	for _, eventID := range eventIDs {
-		_, err := s.pool.Exec(ctx,
+		_, err := s.db(ctx).Exec(ctx,
 			"INSERT INTO time_entry_events (time_entry_id, calendar_event_id) VALUES ($1, $2)",
 			entryID, eventID,
 		)
@@ -342,12 +465,22 @@ func (s *TimeEntryStore) SetContributingEvents(ctx context.Context, entryID uuid
 		}
 	}
 
+	if len(eventIDs) > 0 {
+		_, err = s.db(ctx).Exec(ctx, `
+			UPDATE calendar_events SET duration_changed_at = NULL
+			WHERE id = ANY($1) AND duration_changed_at IS NOT NULL
+		`, eventIDs)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // GetContributingEvents returns the event IDs that contribute to a time entry
 func (s *TimeEntryStore) GetContributingEvents(ctx context.Context, entryID uuid.UUID) ([]uuid.UUID, error) {
-	rows, err := s.pool.Query(ctx,
+	rows, err := s.db(ctx).Query(ctx,
 		"SELECT calendar_event_id FROM time_entry_events WHERE time_entry_id = $1",
 		entryID,
 	)
@@ -368,12 +501,38 @@ func (s *TimeEntryStore) GetContributingEvents(ctx context.Context, entryID uuid
 	return eventIDs, rows.Err()
 }
 
+// ListContributingEvents returns the contributing event IDs of several of
+// the user's time entries in one query, keyed by time entry ID
+func (s *TimeEntryStore) ListContributingEvents(ctx context.Context, userID uuid.UUID, entryIDs []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT tee.time_entry_id, tee.calendar_event_id
+		FROM time_entry_events tee
+		JOIN time_entries te ON te.id = tee.time_entry_id
+		WHERE tee.time_entry_id = ANY($2) AND te.user_id = $1
+	`, userID, entryIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	eventIDs := make(map[uuid.UUID][]uuid.UUID)
+	for rows.Next() {
+		var entryID, eventID uuid.UUID
+		if err := rows.Scan(&entryID, &eventID); err != nil {
+			return nil, err
+		}
+		eventIDs[entryID] = append(eventIDs[entryID], eventID)
+	}
+
+	return eventIDs, rows.Err()
+}
+
 // --- Protection Model ---
 
 // Refresh accepts computed values for a protected time entry (stays protected)
 // Updates snapshot_computed_hours to clear staleness
 func (s *TimeEntryStore) Refresh(ctx context.Context, userID, entryID uuid.UUID) error {
-	result, err := s.pool.Exec(ctx, `
+	result, err := s.db(ctx).Exec(ctx, `
 		UPDATE time_entries
 		SET hours = COALESCE(computed_hours, hours),
 		    title = COALESCE(computed_title, title),
@@ -381,7 +540,7 @@ func (s *TimeEntryStore) Refresh(ctx context.Context, userID, entryID uuid.UUID)
 		    snapshot_computed_hours = computed_hours,
 		    is_stale = false,
 		    updated_at = $3
-		WHERE id = $1 AND user_id = $2
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
 	`, entryID, userID, time.Now().UTC())
 
 	if err != nil {
@@ -401,7 +560,7 @@ func (s *TimeEntryStore) ResetToComputed(ctx context.Context, userID, entryID uu
 
 	// Update to computed values
 	// Also update snapshot to match, clearing any staleness
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE time_entries
 		SET hours = $3,
 		    title = $4,
@@ -428,8 +587,128 @@ func (s *TimeEntryStore) ResetToComputed(ctx context.Context, userID, entryID uu
 	return s.GetByID(ctx, userID, entryID)
 }
 
+// --- Reconciliation ---
+
+// ListDivergent returns materialized entries whose hours differ from their
+// computed hours, oldest first. Unless includeAcknowledged is set, entries
+// whose current computed hours were already acknowledged via KeepManual are
+// left out.
+func (s *TimeEntryStore) ListDivergent(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, includeAcknowledged bool) ([]*TimeEntry, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT te.id, te.user_id, te.project_id, te.date, te.hours, te.title, te.description,
+		       te.source, te.invoice_id, te.has_user_edits,
+		       te.is_stale, te.is_suppressed,
+		       te.computed_hours, te.computed_title, te.computed_description, te.snapshot_computed_hours,
+		       te.calculation_details, te.created_at, te.updated_at,
+		       p.id, p.user_id, p.name, p.short_code, p.color, p.is_billable, p.is_archived,
+		       p.is_hidden_by_default, p.does_not_accumulate_hours, p.created_at, p.updated_at
+		FROM time_entries te
+		JOIN projects p ON te.project_id = p.id
+		WHERE te.user_id = $1
+		  AND te.deleted_at IS NULL
+		  AND te.computed_hours IS NOT NULL
+		  AND te.hours != te.computed_hours
+		  AND ($2::date IS NULL OR te.date >= $2)
+		  AND ($3::date IS NULL OR te.date <= $3)
+		  AND ($4 OR te.snapshot_computed_hours IS DISTINCT FROM te.computed_hours)
+		ORDER BY te.date, p.name
+	`, userID, startDate, endDate, includeAcknowledged)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*TimeEntry
+	for rows.Next() {
+		e := &TimeEntry{Project: &Project{}}
+		err := rows.Scan(
+			&e.ID, &e.UserID, &e.ProjectID, &e.Date, &e.Hours, &e.Title, &e.Description,
+			&e.Source, &e.InvoiceID, &e.HasUserEdits,
+			&e.IsStale, &e.IsSuppressed,
+			&e.ComputedHours, &e.ComputedTitle, &e.ComputedDescription, &e.SnapshotComputedHours,
+			&e.CalculationDetails, &e.CreatedAt, &e.UpdatedAt,
+			&e.Project.ID, &e.Project.UserID, &e.Project.Name, &e.Project.ShortCode,
+			&e.Project.Color, &e.Project.IsBillable, &e.Project.IsArchived,
+			&e.Project.IsHiddenByDefault, &e.Project.DoesNotAccumulateHours,
+			&e.Project.CreatedAt, &e.Project.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
+
+// AcceptComputed replaces an entry's values with its stored computed values
+// and clears user edits, so the entry auto-updates again. Invoiced entries
+// are rejected.
+func (s *TimeEntryStore) AcceptComputed(ctx context.Context, userID, entryID uuid.UUID) (*TimeEntry, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE time_entries
+		SET hours = COALESCE(computed_hours, hours),
+		    title = COALESCE(computed_title, title),
+		    description = COALESCE(computed_description, description),
+		    snapshot_computed_hours = computed_hours,
+		    has_user_edits = false,
+		    is_stale = false,
+		    updated_at = $3
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL AND invoice_id IS NULL
+	`, entryID, userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		// Distinguish invoiced from missing
+		if _, err := s.GetByID(ctx, userID, entryID); err != nil {
+			return nil, err
+		}
+		return nil, ErrTimeEntryInvoiced
+	}
+	return s.GetByID(ctx, userID, entryID)
+}
+
+// KeepManual keeps an entry's recorded values and acknowledges its current
+// computed hours, clearing staleness until they drift again
+func (s *TimeEntryStore) KeepManual(ctx context.Context, userID, entryID uuid.UUID) (*TimeEntry, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE time_entries
+		SET snapshot_computed_hours = computed_hours,
+		    has_user_edits = true,
+		    is_stale = false,
+		    updated_at = $3
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+	`, entryID, userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrTimeEntryNotFound
+	}
+	return s.GetByID(ctx, userID, entryID)
+}
+
 // --- Computed Fields Update ---
 
+// Protect marks the user's time entries dated within [startDate, endDate] as
+// edited, so recomputing them never deletes them or changes their hours.
+// Returns how many entries were newly protected.
+func (s *TimeEntryStore) Protect(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) (int64, error) {
+	result, err := s.db(ctx).Exec(ctx, `
+		UPDATE time_entries
+		SET has_user_edits = true,
+		    snapshot_computed_hours = COALESCE(snapshot_computed_hours, computed_hours),
+		    updated_at = NOW()
+		WHERE user_id = $1 AND date >= $2 AND date <= $3
+		  AND has_user_edits = false AND invoice_id IS NULL AND deleted_at IS NULL
+	`, userID, startDate, endDate)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 // UpdateComputed updates the computed fields for a time entry.
 // If the entry is not invoiced, it also updates the current values.
 // If invoiced, it only updates computed fields and marks as stale if different.
@@ -437,7 +716,7 @@ func (s *TimeEntryStore) UpdateComputed(ctx context.Context, userID uuid.UUID, e
 	now := time.Now().UTC()
 
 	// Update computed fields and conditionally update current values
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE time_entries
 		SET computed_hours = $3,
 		    computed_title = $4,
@@ -467,7 +746,7 @@ func (s *TimeEntryStore) UpdateComputed(ctx context.Context, userID uuid.UUID, e
 // This is called before Update to ensure snapshot_computed_hours captures the
 // current computed value, which is needed for correct staleness detection.
 func (s *TimeEntryStore) RefreshComputedValues(ctx context.Context, userID, entryID uuid.UUID, computedHours float64) error {
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		UPDATE time_entries
 		SET computed_hours = $3
 		WHERE id = $1 AND user_id = $2
@@ -482,7 +761,7 @@ func (s *TimeEntryStore) UpsertFromComputed(ctx context.Context, userID, project
 	now := time.Now().UTC()
 
 	// Use upsert - only update if not invoiced
-	_, err := s.pool.Exec(ctx, `
+	_, err := s.db(ctx).Exec(ctx, `
 		INSERT INTO time_entries (
 			id, user_id, project_id, date, hours, title, description, source,
 			computed_hours, computed_title, computed_description, calculation_details,
@@ -515,6 +794,7 @@ func (s *TimeEntryStore) UpsertFromComputed(ctx context.Context, userID, project
 				THEN (time_entries.hours != EXCLUDED.hours OR COALESCE(time_entries.title, '') != EXCLUDED.title OR COALESCE(time_entries.description, '') != EXCLUDED.description)
 				ELSE false
 			END,
+			deleted_at = NULL,
 			updated_at = EXCLUDED.updated_at
 	`, entryID, userID, projectID, date, hours, title, description, details, now, now)
 	if err != nil {
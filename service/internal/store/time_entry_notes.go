@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+var ErrTimeEntryNoteNotFound = errors.New("time entry note not found")
+
+// TimeEntryNote is one note in a time entry's thread
+type TimeEntryNote struct {
+	ID          uuid.UUID
+	TimeEntryID uuid.UUID
+	UserID      uuid.UUID
+	AuthorName  string // Joined from users
+	Body        string
+	CreatedAt   time.Time
+}
+
+// TimeEntryNoteStore provides PostgreSQL-backed time entry note storage
+type TimeEntryNoteStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewTimeEntryNoteStore creates a new PostgreSQL time entry note store
+func NewTimeEntryNoteStore(pool *pgxpool.Pool) *TimeEntryNoteStore {
+	return &TimeEntryNoteStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *TimeEntryNoteStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Add appends a note to one of the user's time entries
+func (s *TimeEntryNoteStore) Add(ctx context.Context, userID, entryID uuid.UUID, body string) (*TimeEntryNote, error) {
+	note := &TimeEntryNote{
+		ID:          uuid.New(),
+		TimeEntryID: entryID,
+		UserID:      userID,
+		Body:        body,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	err := s.db(ctx).QueryRow(ctx, `
+		WITH inserted AS (
+			INSERT INTO time_entry_notes (id, time_entry_id, user_id, body, created_at)
+			SELECT $1, te.id, $3, $4, $5
+			FROM time_entries te
+			WHERE te.id = $2 AND te.user_id = $3 AND te.deleted_at IS NULL
+			RETURNING user_id
+		)
+		SELECT u.name FROM inserted JOIN users u ON u.id = inserted.user_id
+	`, note.ID, note.TimeEntryID, note.UserID, note.Body, note.CreatedAt).Scan(&note.AuthorName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+	return note, nil
+}
+
+// List returns the notes on one of the user's time entries, oldest first
+func (s *TimeEntryNoteStore) List(ctx context.Context, userID, entryID uuid.UUID) ([]*TimeEntryNote, error) {
+	return s.query(ctx, `
+		SELECT n.id, n.time_entry_id, n.user_id, u.name, n.body, n.created_at
+		FROM time_entry_notes n
+		JOIN time_entries te ON n.time_entry_id = te.id
+		JOIN users u ON n.user_id = u.id
+		WHERE te.id = $1 AND te.user_id = $2
+		ORDER BY n.created_at ASC
+	`, entryID, userID)
+}
+
+// ListForInvoice returns the notes on the time entries billed by one of the
+// user's invoices, keyed by time entry
+func (s *TimeEntryNoteStore) ListForInvoice(ctx context.Context, userID, invoiceID uuid.UUID) (map[uuid.UUID][]*TimeEntryNote, error) {
+	notes, err := s.query(ctx, `
+		SELECT n.id, n.time_entry_id, n.user_id, u.name, n.body, n.created_at
+		FROM time_entry_notes n
+		JOIN invoice_line_items ili ON ili.time_entry_id = n.time_entry_id
+		JOIN invoices i ON ili.invoice_id = i.id
+		JOIN users u ON n.user_id = u.id
+		WHERE i.id = $1 AND i.user_id = $2
+		ORDER BY n.created_at ASC
+	`, invoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byEntry := make(map[uuid.UUID][]*TimeEntryNote)
+	for _, n := range notes {
+		byEntry[n.TimeEntryID] = append(byEntry[n.TimeEntryID], n)
+	}
+	return byEntry, nil
+}
+
+func (s *TimeEntryNoteStore) query(ctx context.Context, query string, args ...interface{}) ([]*TimeEntryNote, error) {
+	rows, err := s.db(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notes []*TimeEntryNote
+	for rows.Next() {
+		n := &TimeEntryNote{}
+		if err := rows.Scan(&n.ID, &n.TimeEntryID, &n.UserID, &n.AuthorName, &n.Body, &n.CreatedAt); err != nil {
+			return nil, err
+		}
+		notes = append(notes, n)
+	}
+
+	return notes, rows.Err()
+}
+
+// Delete removes a note the user left on one of their time entries
+func (s *TimeEntryNoteStore) Delete(ctx context.Context, userID, entryID, noteID uuid.UUID) error {
+	result, err := s.db(ctx).Exec(ctx, `
+		DELETE FROM time_entry_notes
+		WHERE id = $1 AND time_entry_id = $2 AND user_id = $3
+	`, noteID, entryID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return ErrTimeEntryNoteNotFound
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestTimeEntryReconciliation checks that entries whose manual hours drifted
+// from their computed hours are listed until accepted or kept
+func TestTimeEntryReconciliation(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	projectStore := store.NewProjectStore(db.Pool)
+	timeEntryStore := store.NewTimeEntryStore(db.Pool)
+	billingPeriodStore := store.NewBillingPeriodStore(db.Pool)
+	invoiceStore := store.NewInvoiceStore(db.Pool, timeEntryStore, billingPeriodStore, store.NewClientRateStore(db.Pool), projectStore)
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "reconcile-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	project, err := projectStore.Create(ctx, user.ID, "Test Project", nil, nil, "#000000", true, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+	day := func(d int) time.Time {
+		return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+	if _, err := billingPeriodStore.Create(ctx, user.ID, project.ID, day(1), nil, 100, store.BillingTerms{}); err != nil {
+		t.Fatalf("Failed to create billing period: %v", err)
+	}
+
+	// Each entry is computed at 3h, edited to 5h, then recomputed at 4h
+	drifted := func(date time.Time) *store.TimeEntry {
+		t.Helper()
+		entry, err := timeEntryStore.UpsertFromComputed(ctx, user.ID, project.ID, date, 3, "Work", "", nil, nil)
+		if err != nil {
+			t.Fatalf("UpsertFromComputed: %v", err)
+		}
+		hours := 5.0
+		if _, err := timeEntryStore.Update(ctx, user.ID, entry.ID, &hours, nil); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if err := timeEntryStore.RefreshComputedValues(ctx, user.ID, entry.ID, 4); err != nil {
+			t.Fatalf("RefreshComputedValues: %v", err)
+		}
+		return entry
+	}
+	accepted := drifted(day(10))
+	kept := drifted(day(11))
+	invoiced := drifted(day(20))
+	if _, err := invoiceStore.Create(ctx, user.ID, project.ID, day(20), day(20), day(21)); err != nil {
+		t.Fatalf("Failed to create invoice: %v", err)
+	}
+
+	ids := func(entries []*store.TimeEntry) []uuid.UUID {
+		var out []uuid.UUID
+		for _, e := range entries {
+			out = append(out, e.ID)
+		}
+		return out
+	}
+	list := func(start, end *time.Time, includeAcknowledged bool) []uuid.UUID {
+		t.Helper()
+		entries, err := timeEntryStore.ListDivergent(ctx, user.ID, start, end, includeAcknowledged)
+		if err != nil {
+			t.Fatalf("ListDivergent: %v", err)
+		}
+		return ids(entries)
+	}
+
+	if got := list(nil, nil, false); len(got) != 3 || got[0] != accepted.ID || got[1] != kept.ID || got[2] != invoiced.ID {
+		t.Errorf("expected all three entries oldest first, got %v", got)
+	}
+	start, end := day(11), day(11)
+	if got := list(&start, &end, false); len(got) != 1 || got[0] != kept.ID {
+		t.Errorf("expected only the entry in range, got %v", got)
+	}
+
+	entry, err := timeEntryStore.AcceptComputed(ctx, user.ID, accepted.ID)
+	if err != nil {
+		t.Fatalf("AcceptComputed: %v", err)
+	}
+	if entry.Hours != 4 || entry.HasUserEdits {
+		t.Errorf("expected the computed 4h without user edits, got %v (edits %v)", entry.Hours, entry.HasUserEdits)
+	}
+	if _, err := timeEntryStore.AcceptComputed(ctx, user.ID, invoiced.ID); !errors.Is(err, store.ErrTimeEntryInvoiced) {
+		t.Errorf("expected ErrTimeEntryInvoiced, got %v", err)
+	}
+	if _, err := timeEntryStore.AcceptComputed(ctx, uuid.New(), kept.ID); !errors.Is(err, store.ErrTimeEntryNotFound) {
+		t.Errorf("expected another user's accept to be not found, got %v", err)
+	}
+
+	entry, err = timeEntryStore.KeepManual(ctx, user.ID, kept.ID)
+	if err != nil {
+		t.Fatalf("KeepManual: %v", err)
+	}
+	if entry.Hours != 5 || !entry.HasUserEdits || entry.SnapshotComputedHours == nil || *entry.SnapshotComputedHours != 4 {
+		t.Errorf("expected 5h kept with 4h acknowledged, got %v (snapshot %v)", entry.Hours, entry.SnapshotComputedHours)
+	}
+	if _, err := timeEntryStore.KeepManual(ctx, uuid.New(), kept.ID); !errors.Is(err, store.ErrTimeEntryNotFound) {
+		t.Errorf("expected another user's keep to be not found, got %v", err)
+	}
+
+	// The kept entry is acknowledged, the accepted one no longer diverges
+	if got := list(nil, nil, false); len(got) != 1 || got[0] != invoiced.ID {
+		t.Errorf("expected only the invoiced entry, got %v", got)
+	}
+	if got := list(nil, nil, true); len(got) != 2 || got[0] != kept.ID || got[1] != invoiced.ID {
+		t.Errorf("expected the kept entry when acknowledged ones are included, got %v", got)
+	}
+}
@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrTimeEntrySlotTaken is returned when a split part would land on a
+// project that already has an entry for the day
+var ErrTimeEntrySlotTaken = errors.New("time entry already exists for project and date")
+
+// SplitPart is one piece of a time entry being split
+type SplitPart struct {
+	ProjectID   uuid.UUID
+	Hours       float64
+	Description *string
+}
+
+// Split divides a time entry into manual entries, one per part, on the
+// entry's date. Entries are unique per project and day, so each part is on
+// a different project.
+//
+// A part on the entry's own project is written to the entry itself, which
+// keeps its contributing events since they are classified to that project.
+// Without such a part the entry is suppressed, so its computed hours don't
+// come back, and keeps its events as the record of where the time came from.
+// Parts on other projects are new manual entries with no events.
+//
+// It returns the part entries in the order given.
+func (s *TimeEntryStore) Split(ctx context.Context, userID, entryID uuid.UUID, parts []SplitPart) ([]*TimeEntry, error) {
+	tx, err := s.db(ctx).Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var projectID uuid.UUID
+	var date time.Time
+	var invoiceID *uuid.UUID
+	err = tx.QueryRow(ctx, `
+		SELECT project_id, date, invoice_id FROM time_entries
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+		FOR UPDATE
+	`, entryID, userID).Scan(&projectID, &date, &invoiceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+	if invoiceID != nil {
+		return nil, ErrTimeEntryInvoiced
+	}
+
+	now := time.Now().UTC()
+	ids := make([]uuid.UUID, len(parts))
+	keepsOriginal := false
+	for i, part := range parts {
+		if part.ProjectID == projectID {
+			keepsOriginal = true
+			ids[i] = entryID
+			_, err := tx.Exec(ctx, `
+				UPDATE time_entries
+				SET hours = $3,
+				    description = $4,
+				    has_user_edits = true,
+				    snapshot_computed_hours = computed_hours,
+				    updated_at = $5
+				WHERE id = $1 AND user_id = $2
+			`, entryID, userID, part.Hours, part.Description, now)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// A trashed entry in the slot is replaced, as with Create
+		err := tx.QueryRow(ctx, `
+			INSERT INTO time_entries (id, user_id, project_id, date, hours, description, source, has_user_edits, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, 'manual', true, $7, $7)
+			ON CONFLICT (user_id, project_id, date) DO UPDATE SET
+				hours = EXCLUDED.hours,
+				description = EXCLUDED.description,
+				source = EXCLUDED.source,
+				has_user_edits = true,
+				is_suppressed = false,
+				snapshot_computed_hours = time_entries.computed_hours,
+				deleted_at = NULL,
+				updated_at = EXCLUDED.updated_at
+			WHERE time_entries.deleted_at IS NOT NULL
+			RETURNING id
+		`, uuid.New(), userID, part.ProjectID, date, part.Hours, part.Description, now).Scan(&ids[i])
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrTimeEntrySlotTaken
+			}
+			return nil, err
+		}
+	}
+
+	if !keepsOriginal {
+		_, err := tx.Exec(ctx, `
+			UPDATE time_entries
+			SET is_suppressed = true, has_user_edits = true, updated_at = $3
+			WHERE id = $1 AND user_id = $2
+		`, entryID, userID, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*TimeEntry, len(ids))
+	for i, id := range ids {
+		entry, err := s.GetByID(ctx, userID, id)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
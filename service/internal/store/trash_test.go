@@ -0,0 +1,180 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestTrash checks that trashed time entries and rules are hidden, can be
+// restored, and are gone for good once purged
+func TestTrash(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	timeEntryStore := store.NewTimeEntryStore(db.Pool)
+	ruleStore := store.NewClassificationRuleStore(db.Pool)
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "trash-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	project, err := store.NewProjectStore(db.Pool).Create(ctx, user.ID, "Test Project", nil, nil, "#000000", false, false, false)
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	entry, err := timeEntryStore.Create(ctx, user.ID, project.ID, date, 2, nil)
+	if err != nil {
+		t.Fatalf("Failed to create time entry: %v", err)
+	}
+
+	t.Run("trashed entries can be restored", func(t *testing.T) {
+		if err := timeEntryStore.Trash(ctx, user.ID, entry.ID); err != nil {
+			t.Fatalf("Trash: %v", err)
+		}
+		if _, err := timeEntryStore.GetByID(ctx, user.ID, entry.ID); !errors.Is(err, store.ErrTimeEntryNotFound) {
+			t.Errorf("expected a trashed entry to be hidden, got %v", err)
+		}
+		if err := timeEntryStore.Trash(ctx, user.ID, entry.ID); !errors.Is(err, store.ErrTimeEntryNotFound) {
+			t.Errorf("expected trashing twice to be not found, got %v", err)
+		}
+
+		trashed, err := timeEntryStore.ListTrashed(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("ListTrashed: %v", err)
+		}
+		if len(trashed) != 1 || trashed[0].ID != entry.ID || trashed[0].DeletedAt == nil {
+			t.Fatalf("expected the entry in the trash, got %v", trashed)
+		}
+
+		restored, err := timeEntryStore.Restore(ctx, user.ID, entry.ID)
+		if err != nil {
+			t.Fatalf("Restore: %v", err)
+		}
+		if restored.Hours != 2 || restored.DeletedAt != nil {
+			t.Errorf("expected the entry back with 2h, got %vh deleted at %v", restored.Hours, restored.DeletedAt)
+		}
+		if _, err := timeEntryStore.Restore(ctx, user.ID, entry.ID); !errors.Is(err, store.ErrTimeEntryNotFound) {
+			t.Errorf("expected restoring a live entry to be not found, got %v", err)
+		}
+	})
+
+	t.Run("a new entry replaces a trashed one", func(t *testing.T) {
+		if err := timeEntryStore.Trash(ctx, user.ID, entry.ID); err != nil {
+			t.Fatalf("Trash: %v", err)
+		}
+		// The trashed hours aren't added to the new entry's
+		created, err := timeEntryStore.Create(ctx, user.ID, project.ID, date, 3, nil)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if created.Hours != 3 || created.DeletedAt != nil {
+			t.Errorf("expected a live entry with 3h, got %vh deleted at %v", created.Hours, created.DeletedAt)
+		}
+		trashed, err := timeEntryStore.ListTrashed(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("ListTrashed: %v", err)
+		}
+		if len(trashed) != 0 {
+			t.Errorf("expected an empty trash, got %d entries", len(trashed))
+		}
+	})
+
+	rule, err := ruleStore.Create(ctx, &store.ClassificationRule{UserID: user.ID, Query: "title:standup", ProjectID: &project.ID, IsEnabled: true})
+	if err != nil {
+		t.Fatalf("Failed to create rule: %v", err)
+	}
+
+	t.Run("trashed rules can be restored", func(t *testing.T) {
+		if err := ruleStore.Trash(ctx, user.ID, rule.ID); err != nil {
+			t.Fatalf("Trash: %v", err)
+		}
+		rules, err := ruleStore.List(ctx, user.ID, true)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if len(rules) != 0 {
+			t.Errorf("expected a trashed rule to be hidden, got %d rules", len(rules))
+		}
+		trashed, err := ruleStore.ListTrashed(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("ListTrashed: %v", err)
+		}
+		if len(trashed) != 1 || trashed[0].ID != rule.ID {
+			t.Fatalf("expected the rule in the trash, got %v", trashed)
+		}
+
+		restored, err := ruleStore.Restore(ctx, user.ID, rule.ID)
+		if err != nil {
+			t.Fatalf("Restore: %v", err)
+		}
+		if restored.Query != rule.Query {
+			t.Errorf("expected query %q, got %q", rule.Query, restored.Query)
+		}
+		if _, err := ruleStore.Restore(ctx, uuid.New(), rule.ID); !errors.Is(err, store.ErrClassificationRuleNotFound) {
+			t.Errorf("expected another user's restore to be not found, got %v", err)
+		}
+	})
+
+	t.Run("purge removes only what is past the cutoff", func(t *testing.T) {
+		if err := timeEntryStore.Trash(ctx, user.ID, entry.ID); err != nil {
+			t.Fatalf("Trash: %v", err)
+		}
+		if err := ruleStore.Trash(ctx, user.ID, rule.ID); err != nil {
+			t.Fatalf("Trash: %v", err)
+		}
+
+		// Trashed just now, so an hour-old cutoff keeps them
+		if _, err := timeEntryStore.PurgeTrashed(ctx, time.Now().Add(-time.Hour)); err != nil {
+			t.Fatalf("PurgeTrashed: %v", err)
+		}
+		if _, err := ruleStore.PurgeTrashed(ctx, time.Now().Add(-time.Hour)); err != nil {
+			t.Fatalf("PurgeTrashed: %v", err)
+		}
+		if entries, _ := timeEntryStore.ListTrashed(ctx, user.ID); len(entries) != 1 {
+			t.Errorf("expected the entry to survive an earlier cutoff, got %d", len(entries))
+		}
+		if rules, _ := ruleStore.ListTrashed(ctx, user.ID); len(rules) != 1 {
+			t.Errorf("expected the rule to survive an earlier cutoff, got %d", len(rules))
+		}
+
+		cutoff := time.Now().Add(time.Minute)
+		if n, err := timeEntryStore.PurgeTrashed(ctx, cutoff); err != nil || n < 1 {
+			t.Fatalf("PurgeTrashed: purged %d, %v", n, err)
+		}
+		if n, err := ruleStore.PurgeTrashed(ctx, cutoff); err != nil || n < 1 {
+			t.Fatalf("PurgeTrashed: purged %d, %v", n, err)
+		}
+		if _, err := timeEntryStore.Restore(ctx, user.ID, entry.ID); !errors.Is(err, store.ErrTimeEntryNotFound) {
+			t.Errorf("expected a purged entry to be gone, got %v", err)
+		}
+		if _, err := ruleStore.Restore(ctx, user.ID, rule.ID); !errors.Is(err, store.ErrClassificationRuleNotFound) {
+			t.Errorf("expected a purged rule to be gone, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// TestUnitOfWork checks that writes through several stores commit or roll
+// back together, including stores that open their own transaction
+func TestUnitOfWork(t *testing.T) {
+	dbURL := os.Getenv("TEST_DATABASE_URL")
+	if dbURL == "" {
+		t.Skip("TEST_DATABASE_URL not set, skipping integration test")
+	}
+
+	ctx := context.Background()
+	db, err := database.New(ctx, dbURL)
+	if err != nil {
+		t.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	projectStore := store.NewProjectStore(db.Pool)
+	timeEntryStore := store.NewTimeEntryStore(db.Pool)
+	billingPeriodStore := store.NewBillingPeriodStore(db.Pool)
+	ruleStore := store.NewClassificationRuleStore(db.Pool)
+	invoiceStore := store.NewInvoiceStore(db.Pool, timeEntryStore, billingPeriodStore, store.NewClientRateStore(db.Pool), projectStore)
+	uow := database.NewUnitOfWork(db.Pool)
+
+	user, err := store.NewUserStore(db.Pool).Create(ctx, "uow-test-"+uuid.New().String()[:8]+"@test.com", "Test User", "password123")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	defer cleanupUser(t, db.Pool, user.ID)
+
+	notified := 0
+	projectStore.OnChange(func(uuid.UUID) { notified++ })
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	write := func(ctx context.Context) error {
+		project, err := projectStore.Create(ctx, user.ID, "Acme", nil, nil, "#000000", true, false, false)
+		if err != nil {
+			return err
+		}
+		if _, err := billingPeriodStore.Create(ctx, user.ID, project.ID, start, nil, 100, store.BillingTerms{}); err != nil {
+			return err
+		}
+		if _, err := timeEntryStore.Create(ctx, user.ID, project.ID, start.AddDate(0, 0, 14), 5, nil); err != nil {
+			return err
+		}
+		if _, err := ruleStore.Create(ctx, &store.ClassificationRule{UserID: user.ID, Query: "title:Acme", ProjectID: &project.ID}); err != nil {
+			return err
+		}
+		// Opens its own transaction, which becomes a savepoint
+		_, err = invoiceStore.Create(ctx, user.ID, project.ID, start, end, end)
+		return err
+	}
+	count := func(table string) int {
+		t.Helper()
+		var n int
+		if err := db.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM "+table+" WHERE user_id = $1", user.ID).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	tables := []string{"projects", "billing_periods", "time_entries", "classification_rules", "invoices"}
+
+	errBoom := errors.New("boom")
+	err = uow.Do(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected fn's error, got %v", err)
+	}
+	for _, table := range tables {
+		if n := count(table); n != 0 {
+			t.Errorf("expected %s rolled back, got %d rows", table, n)
+		}
+	}
+	if notified != 0 {
+		t.Errorf("expected no change hooks for a rolled back write, got %d", notified)
+	}
+
+	if err := uow.Do(ctx, write); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	for _, table := range tables {
+		if n := count(table); n != 1 {
+			t.Errorf("expected one committed row in %s, got %d", table, n)
+		}
+	}
+	if notified != 1 {
+		t.Errorf("expected the change hook once the write committed, got %d", notified)
+	}
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"time"
 
@@ -10,12 +12,16 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"golang.org/x/crypto/bcrypt"
+
+	"github.com/michaelw/timesheet-app/service/internal/database"
+	"github.com/michaelw/timesheet-app/service/internal/locale"
 )
 
 var (
 	ErrUserNotFound      = errors.New("user not found")
 	ErrEmailAlreadyTaken = errors.New("email already registered")
 	ErrInvalidPassword   = errors.New("invalid password")
+	ErrUserDeactivated   = errors.New("user deactivated by their organization")
 )
 
 // User represents a stored user
@@ -37,6 +43,11 @@ func NewUserStore(pool *pgxpool.Pool) *UserStore {
 	return &UserStore{pool: pool}
 }
 
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *UserStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
 // Create adds a new user with the given email, name, and password
 func (s *UserStore) Create(ctx context.Context, email, name, password string) (*User, error) {
 	// Hash password
@@ -53,7 +64,7 @@ func (s *UserStore) Create(ctx context.Context, email, name, password string) (*
 		CreatedAt:    time.Now().UTC(),
 	}
 
-	_, err = s.pool.Exec(ctx, `
+	_, err = s.db(ctx).Exec(ctx, `
 		INSERT INTO users (id, email, name, password_hash, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $5)
 	`, user.ID, email, name, user.PasswordHash, user.CreatedAt)
@@ -69,10 +80,35 @@ func (s *UserStore) Create(ctx context.Context, email, name, password string) (*
 	return user, nil
 }
 
+// CreateExternal adds a user whose identity provider authenticates them,
+// as SSO and SCIM provision. The account gets a random password nobody
+// knows, so it can only sign in through SSO until the password is reset.
+func (s *UserStore) CreateExternal(ctx context.Context, email, name string) (*User, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return s.Create(ctx, email, name, hex.EncodeToString(b))
+}
+
+// UpdateName changes a user's display name
+func (s *UserStore) UpdateName(ctx context.Context, id uuid.UUID, name string) error {
+	tag, err := s.db(ctx).Exec(ctx, `
+		UPDATE users SET name = $2, updated_at = NOW() WHERE id = $1
+	`, id, name)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
 // GetByID retrieves a user by ID
 func (s *UserStore) GetByID(ctx context.Context, id openapi_types.UUID) (*User, error) {
 	user := &User{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, email, name, password_hash, created_at
 		FROM users WHERE id = $1
 	`, id).Scan(&user.ID, &user.Email, &user.Name, &user.PasswordHash, &user.CreatedAt)
@@ -89,7 +125,7 @@ func (s *UserStore) GetByID(ctx context.Context, id openapi_types.UUID) (*User,
 // GetByEmail retrieves a user by email
 func (s *UserStore) GetByEmail(ctx context.Context, email string) (*User, error) {
 	user := &User{}
-	err := s.pool.QueryRow(ctx, `
+	err := s.db(ctx).QueryRow(ctx, `
 		SELECT id, email, name, password_hash, created_at
 		FROM users WHERE email = $1
 	`, email).Scan(&user.ID, &user.Email, &user.Name, &user.PasswordHash, &user.CreatedAt)
@@ -117,9 +153,195 @@ func (s *UserStore) Authenticate(ctx context.Context, email, password string) (*
 		return nil, ErrInvalidPassword
 	}
 
+	// An organization's identity provider decides whether its members can
+	// sign in, with a password too
+	var deactivated bool
+	err = s.db(ctx).QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM organization_members WHERE user_id = $1 AND deactivated_at IS NOT NULL
+		)
+	`, user.ID).Scan(&deactivated)
+	if err != nil {
+		return nil, err
+	}
+	if deactivated {
+		return nil, ErrUserDeactivated
+	}
+
 	return user, nil
 }
 
+// GetLocale returns the user's locale, or the default locale if the stored
+// tag is no longer supported
+func (s *UserStore) GetLocale(ctx context.Context, id uuid.UUID) (*locale.Locale, error) {
+	return userLocale(ctx, s.pool, id)
+}
+
+// SetLocale saves the user's locale
+func (s *UserStore) SetLocale(ctx context.Context, id uuid.UUID, loc *locale.Locale) error {
+	tag, err := s.db(ctx).Exec(ctx, `
+		UPDATE users SET locale = $2, updated_at = NOW() WHERE id = $1
+	`, id, loc.Tag)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// SyncCadence is a user's sync freshness settings in minutes; nil fields
+// use the service default
+type SyncCadence struct {
+	StaleAfterMinutes *int
+	IntervalMinutes   *int
+}
+
+// GetSyncCadence returns the user's sync freshness settings
+func (s *UserStore) GetSyncCadence(ctx context.Context, id uuid.UUID) (*SyncCadence, error) {
+	c := &SyncCadence{}
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT sync_stale_after_minutes, sync_interval_minutes FROM users WHERE id = $1
+	`, id).Scan(&c.StaleAfterMinutes, &c.IntervalMinutes)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// SetSyncCadence saves the user's sync freshness settings
+func (s *UserStore) SetSyncCadence(ctx context.Context, id uuid.UUID, c SyncCadence) error {
+	tag, err := s.db(ctx).Exec(ctx, `
+		UPDATE users SET sync_stale_after_minutes = $2, sync_interval_minutes = $3, updated_at = NOW()
+		WHERE id = $1
+	`, id, c.StaleAfterMinutes, c.IntervalMinutes)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// UserRetention is a user's event retention period
+type UserRetention struct {
+	UserID      uuid.UUID
+	EventMonths int
+}
+
+// GetRetention returns how many months of calendar events the user keeps,
+// or nil to keep them forever
+func (s *UserStore) GetRetention(ctx context.Context, id uuid.UUID) (*int, error) {
+	var months *int
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT retention_event_months FROM users WHERE id = $1
+	`, id).Scan(&months)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return months, nil
+}
+
+// SetRetention saves how many months of calendar events the user keeps;
+// nil keeps them forever
+func (s *UserStore) SetRetention(ctx context.Context, id uuid.UUID, eventMonths *int) error {
+	tag, err := s.db(ctx).Exec(ctx, `
+		UPDATE users SET retention_event_months = $2, updated_at = NOW()
+		WHERE id = $1
+	`, id, eventMonths)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// GetCompanyDomains returns the email domains of the user's own organization
+func (s *UserStore) GetCompanyDomains(ctx context.Context, id uuid.UUID) ([]string, error) {
+	var domains []string
+	err := s.db(ctx).QueryRow(ctx, `
+		SELECT company_domains FROM users WHERE id = $1
+	`, id).Scan(&domains)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return domains, nil
+}
+
+// SetCompanyDomains replaces the email domains of the user's own
+// organization. Domains are expected lowercase.
+func (s *UserStore) SetCompanyDomains(ctx context.Context, id uuid.UUID, domains []string) error {
+	if domains == nil {
+		domains = []string{}
+	}
+	tag, err := s.db(ctx).Exec(ctx, `
+		UPDATE users SET company_domains = $2, updated_at = NOW()
+		WHERE id = $1
+	`, id, domains)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+// ListRetention returns the users who set a retention period
+func (s *UserStore) ListRetention(ctx context.Context) ([]UserRetention, error) {
+	rows, err := s.db(ctx).Query(ctx, `
+		SELECT id, retention_event_months FROM users
+		WHERE retention_event_months IS NOT NULL
+		ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []UserRetention
+	for rows.Next() {
+		var r UserRetention
+		if err := rows.Scan(&r.UserID, &r.EventMonths); err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, rows.Err()
+}
+
+// userLocale loads a user's locale with any querier, so it can be read
+// inside a transaction
+func userLocale(ctx context.Context, q interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}, id uuid.UUID) (*locale.Locale, error) {
+	var tag string
+	err := q.QueryRow(ctx, `SELECT locale FROM users WHERE id = $1`, id).Scan(&tag)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if loc, ok := locale.Lookup(tag); ok {
+		return loc, nil
+	}
+	return locale.Default(), nil
+}
+
 // isDuplicateKeyError checks if the error is a PostgreSQL unique constraint violation
 func isDuplicateKeyError(err error) bool {
 	// PostgreSQL error code 23505 is unique_violation
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
+)
+
+// DefaultDailyHours is the working-hours profile used until a user sets one:
+// eight hours Monday to Friday
+var DefaultDailyHours = [7]float64{8, 8, 8, 8, 8, 0, 0}
+
+// WorkingHours is a user's weekly capacity profile
+type WorkingHours struct {
+	UserID     uuid.UUID
+	DailyHours [7]float64 // Monday first
+	IsDefault  bool       // True when the user hasn't saved a profile
+	UpdatedAt  *time.Time
+}
+
+// WorkingHoursStore provides PostgreSQL-backed working-hours profiles
+type WorkingHoursStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewWorkingHoursStore creates a new working hours store
+func NewWorkingHoursStore(pool *pgxpool.Pool) *WorkingHoursStore {
+	return &WorkingHoursStore{pool: pool}
+}
+
+// db returns the unit of work's transaction in ctx, or the pool
+func (s *WorkingHoursStore) db(ctx context.Context) database.Querier {
+	return database.Conn(ctx, s.pool)
+}
+
+// Get returns the user's profile, or the default profile if none is saved
+func (s *WorkingHoursStore) Get(ctx context.Context, userID uuid.UUID) (*WorkingHours, error) {
+	var daily []float64
+	var updatedAt time.Time
+	err := s.db(ctx).QueryRow(ctx,
+		"SELECT daily_hours, updated_at FROM working_hours WHERE user_id = $1",
+		userID,
+	).Scan(&daily, &updatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &WorkingHours{UserID: userID, DailyHours: DefaultDailyHours, IsDefault: true}, nil
+		}
+		return nil, err
+	}
+
+	wh := &WorkingHours{UserID: userID, UpdatedAt: &updatedAt}
+	copy(wh.DailyHours[:], daily)
+	return wh, nil
+}
+
+// Set saves the user's profile
+func (s *WorkingHoursStore) Set(ctx context.Context, userID uuid.UUID, dailyHours [7]float64) (*WorkingHours, error) {
+	now := time.Now().UTC()
+	_, err := s.db(ctx).Exec(ctx, `
+		INSERT INTO working_hours (user_id, daily_hours, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			daily_hours = EXCLUDED.daily_hours,
+			updated_at = EXCLUDED.updated_at
+	`, userID, dailyHours[:], now)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkingHours{UserID: userID, DailyHours: dailyHours, UpdatedAt: &now}, nil
+}
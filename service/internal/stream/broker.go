@@ -0,0 +1,166 @@
+// Package stream fans out database change notifications to connected clients.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Channel is the Postgres NOTIFY channel written by the change triggers
+const Channel = "timesheet_changes"
+
+// Event types emitted by the database triggers
+const (
+	EventTimeEntry       = "time_entry"
+	EventEventClassified = "event_classified"
+	EventSyncCompleted   = "sync_completed"
+	EventSyncFailed      = "sync_failed"
+	EventReauthNeeded    = "reauth_needed"
+	EventAnomaly         = "anomaly"
+)
+
+// Event is a single change notification
+type Event struct {
+	Type   string    `json:"type"`
+	Op     string    `json:"op"`
+	UserID uuid.UUID `json:"user_id"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// subscriberBuffer bounds how far a slow client can fall behind before
+// events are dropped for it
+const subscriberBuffer = 64
+
+// reconnectDelay is how long to wait before re-establishing LISTEN after
+// the connection is lost
+const reconnectDelay = 5 * time.Second
+
+// Broker holds a dedicated LISTEN connection and delivers each notification
+// to the subscribers of the user it belongs to
+type Broker struct {
+	pool *pgxpool.Pool
+
+	mu          sync.Mutex
+	subscribers map[uuid.UUID]map[chan Event]struct{}
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewBroker creates a new change broker
+func NewBroker(pool *pgxpool.Pool) *Broker {
+	return &Broker{
+		pool:        pool,
+		subscribers: make(map[uuid.UUID]map[chan Event]struct{}),
+		stopCh:      make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+}
+
+// Start begins listening for notifications
+func (b *Broker) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-b.stopCh
+		cancel()
+	}()
+
+	go func() {
+		defer close(b.doneCh)
+		for {
+			if err := b.listen(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Change stream listener error: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+				log.Println("Change stream broker stopped")
+				return
+			case <-time.After(reconnectDelay):
+			}
+		}
+	}()
+}
+
+// Stop stops listening and waits for the listener to exit
+func (b *Broker) Stop() {
+	close(b.stopCh)
+	<-b.doneCh
+}
+
+// Done is closed when the broker is stopped, so long-lived streams can end
+// before the HTTP server waits on them during shutdown
+func (b *Broker) Done() <-chan struct{} {
+	return b.stopCh
+}
+
+func (b *Broker) listen(ctx context.Context) error {
+	pooled, err := b.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection carries LISTEN session state, so take it out of the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(n.Payload), &event); err != nil {
+			log.Printf("Change stream: invalid payload %q: %v", n.Payload, err)
+			continue
+		}
+		b.Publish(event)
+	}
+}
+
+// Publish delivers an event to all subscribers of its user. Subscribers whose
+// buffer is full miss the event rather than blocking the broker.
+func (b *Broker) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[event.UserID] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe registers for a user's events. The returned function must be
+// called to unsubscribe; it closes the channel.
+func (b *Broker) Subscribe(userID uuid.UUID) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[chan Event]struct{})
+	}
+	b.subscribers[userID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers[userID], ch)
+			if len(b.subscribers[userID]) == 0 {
+				delete(b.subscribers, userID)
+			}
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
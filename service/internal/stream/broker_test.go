@@ -0,0 +1,55 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestBroker_PublishRoutesByUser(t *testing.T) {
+	b := NewBroker(nil)
+	alice, bob := uuid.New(), uuid.New()
+
+	aliceEvents, unsubscribeAlice := b.Subscribe(alice)
+	defer unsubscribeAlice()
+	bobEvents, unsubscribeBob := b.Subscribe(bob)
+	defer unsubscribeBob()
+
+	entryID := uuid.New()
+	b.Publish(Event{Type: EventTimeEntry, Op: "update", UserID: alice, ID: entryID})
+
+	select {
+	case e := <-aliceEvents:
+		if e.ID != entryID || e.Type != EventTimeEntry {
+			t.Errorf("unexpected event %+v", e)
+		}
+	default:
+		t.Fatal("expected event for subscribed user")
+	}
+
+	select {
+	case e := <-bobEvents:
+		t.Errorf("other user received %+v", e)
+	default:
+	}
+}
+
+func TestBroker_SlowSubscriberDoesNotBlock(t *testing.T) {
+	b := NewBroker(nil)
+	userID := uuid.New()
+	events, unsubscribe := b.Subscribe(userID)
+
+	for i := 0; i < subscriberBuffer*2; i++ {
+		b.Publish(Event{Type: EventSyncCompleted, UserID: userID})
+	}
+	if len(events) != subscriberBuffer {
+		t.Errorf("expected buffer to hold %d events, got %d", subscriberBuffer, len(events))
+	}
+
+	unsubscribe()
+	unsubscribe() // idempotent
+	b.Publish(Event{Type: EventSyncCompleted, UserID: userID})
+	if len(b.subscribers) != 0 {
+		t.Errorf("expected no subscribers after unsubscribe, got %d", len(b.subscribers))
+	}
+}
@@ -9,29 +9,37 @@ import (
 
 // BackgroundSyncConfig configures the background sync scheduler
 type BackgroundSyncConfig struct {
-	// Interval between sync runs (default: 24h)
+	// Interval between sync runs (default: 1h). Each run only syncs the
+	// calendars whose user's cadence is due, so this is the finest cadence
+	// users can choose.
 	Interval time.Duration
 	// Enabled controls whether background sync is active
 	Enabled bool
+	// InitialDelay lets the server start up before the first run (default: 30s)
+	InitialDelay time.Duration
 }
 
 // DefaultBackgroundSyncConfig returns the default configuration
 func DefaultBackgroundSyncConfig() BackgroundSyncConfig {
 	return BackgroundSyncConfig{
-		Interval: 24 * time.Hour,
-		Enabled:  true,
+		Interval:     MinSyncInterval,
+		Enabled:      true,
+		InitialDelay: 30 * time.Second,
 	}
 }
 
-// BackgroundSyncRunner is the interface for the sync callback
+// BackgroundSyncRunner is the interface for the sync callback. The runner
+// must Enter the gate before each calendar and stop when Enter fails.
 type BackgroundSyncRunner interface {
-	RunBackgroundSync(ctx context.Context) error
+	RunBackgroundSync(ctx context.Context, gate *Gate) error
 }
 
 // BackgroundScheduler handles periodic background synchronization
 type BackgroundScheduler struct {
 	config BackgroundSyncConfig
 	runner BackgroundSyncRunner
+	gate   *Gate
+	cancel context.CancelFunc
 	stopCh chan struct{}
 	doneCh chan struct{}
 }
@@ -41,6 +49,8 @@ func NewBackgroundScheduler(config BackgroundSyncConfig, runner BackgroundSyncRu
 	return &BackgroundScheduler{
 		config: config,
 		runner: runner,
+		gate:   &Gate{},
+		cancel: func() {},
 		stopCh: make(chan struct{}),
 		doneCh: make(chan struct{}),
 	}
@@ -56,12 +66,16 @@ func (s *BackgroundScheduler) Start(ctx context.Context) {
 
 	log.Printf("Starting background sync scheduler (interval: %v)", s.config.Interval)
 
+	// In-flight syncs run on their own context so shutdown can let them
+	// finish before cancelling
+	ctx, s.cancel = context.WithCancel(ctx)
+
 	go func() {
 		defer close(s.doneCh)
 
 		// Initial delay to let the server start up
 		select {
-		case <-time.After(30 * time.Second):
+		case <-time.After(s.config.InitialDelay):
 		case <-s.stopCh:
 			return
 		case <-ctx.Done():
@@ -89,17 +103,32 @@ func (s *BackgroundScheduler) Start(ctx context.Context) {
 	}()
 }
 
-// Stop gracefully stops the background sync scheduler
+// Stop gracefully stops the background sync scheduler, waiting for the
+// calendar in progress however long it takes
 func (s *BackgroundScheduler) Stop() {
+	s.Shutdown(context.Background())
+}
+
+// Shutdown stops the scheduler from starting new calendar syncs and waits
+// for the one in progress until ctx is done, then cancels it
+func (s *BackgroundScheduler) Shutdown(ctx context.Context) error {
+	s.gate.Close()
 	close(s.stopCh)
+
+	err := s.gate.Wait(ctx)
+	if err != nil {
+		log.Println("Background sync: drain deadline reached, cancelling in-flight sync")
+	}
+	s.cancel()
 	<-s.doneCh
+	return err
 }
 
 // runSync performs a single sync run
 func (s *BackgroundScheduler) runSync(ctx context.Context) {
 	log.Println("Background sync: starting run")
 
-	if err := s.runner.RunBackgroundSync(ctx); err != nil {
+	if err := s.runner.RunBackgroundSync(ctx, s.gate); err != nil {
 		log.Printf("Background sync: run failed: %v", err)
 		return
 	}
@@ -0,0 +1,45 @@
+package sync
+
+import "time"
+
+// Bounds on the per-user cadence settings
+const (
+	MinStaleAfter   = 15 * time.Minute
+	MaxStaleAfter   = 7 * 24 * time.Hour
+	MinSyncInterval = time.Hour
+	MaxSyncInterval = 7 * 24 * time.Hour
+)
+
+// Cadence is how fresh a user's calendars are kept
+type Cadence struct {
+	// StaleAfter is the age at which reading synced data refreshes it
+	StaleAfter time.Duration
+	// Interval is how often background sync refreshes the calendars
+	Interval time.Duration
+}
+
+// DefaultCadence applies to users who haven't chosen their own
+var DefaultCadence = Cadence{StaleAfter: StalenessThreshold, Interval: StalenessThreshold}
+
+// ResolveCadence applies a user's settings, in minutes, where set and
+// clamps them to the allowed bounds
+func ResolveCadence(staleAfterMinutes, intervalMinutes *int) Cadence {
+	c := DefaultCadence
+	if staleAfterMinutes != nil {
+		c.StaleAfter = time.Duration(*staleAfterMinutes) * time.Minute
+	}
+	if intervalMinutes != nil {
+		c.Interval = time.Duration(*intervalMinutes) * time.Minute
+	}
+	c.StaleAfter = min(max(c.StaleAfter, MinStaleAfter), MaxStaleAfter)
+	c.Interval = min(max(c.Interval, MinSyncInterval), MaxSyncInterval)
+	return c
+}
+
+// IsStale checks if the last sync time is older than the cadence allows
+func (c Cadence) IsStale(lastSyncedAt *time.Time) bool {
+	if lastSyncedAt == nil {
+		return true
+	}
+	return time.Since(*lastSyncedAt) > c.StaleAfter
+}
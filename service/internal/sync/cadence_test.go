@@ -0,0 +1,71 @@
+package sync
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveCadence(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name       string
+		staleAfter *int
+		interval   *int
+		expected   Cadence
+	}{
+		{
+			name:     "unset uses the default",
+			expected: DefaultCadence,
+		},
+		{
+			name:       "settings within bounds apply",
+			staleAfter: intPtr(60),
+			interval:   intPtr(120),
+			expected:   Cadence{StaleAfter: time.Hour, Interval: 2 * time.Hour},
+		},
+		{
+			name:       "settings below bounds are clamped",
+			staleAfter: intPtr(1),
+			interval:   intPtr(5),
+			expected:   Cadence{StaleAfter: MinStaleAfter, Interval: MinSyncInterval},
+		},
+		{
+			name:       "settings above bounds are clamped",
+			staleAfter: intPtr(100000),
+			interval:   intPtr(100000),
+			expected:   Cadence{StaleAfter: MaxStaleAfter, Interval: MaxSyncInterval},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveCadence(tt.staleAfter, tt.interval); got != tt.expected {
+				t.Errorf("ResolveCadence() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCadenceDecideSync(t *testing.T) {
+	minSynced := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	maxSynced := time.Date(2025, 1, 26, 23, 59, 59, 0, time.UTC)
+	targetStart := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	targetEnd := time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)
+	twoHoursAgo := timePtr(time.Now().Add(-2 * time.Hour))
+
+	t.Run("default cadence keeps two hour old data", func(t *testing.T) {
+		decision := DefaultCadence.DecideSync(&minSynced, &maxSynced, twoHoursAgo, targetStart, targetEnd)
+		if decision.NeedsSync {
+			t.Errorf("Expected no sync, got reason %s", decision.Reason)
+		}
+	})
+
+	t.Run("hourly cadence refreshes two hour old data", func(t *testing.T) {
+		hourly := Cadence{StaleAfter: time.Hour, Interval: time.Hour}
+		decision := hourly.DecideSync(&minSynced, &maxSynced, twoHoursAgo, targetStart, targetEnd)
+		if !decision.NeedsSync || decision.Reason != "stale_data" {
+			t.Errorf("Expected stale_data sync, got NeedsSync=%v reason=%s", decision.NeedsSync, decision.Reason)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/michaelw/timesheet-app/service/internal/redact"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+// quotaReasons are the Google API error reasons for exhausted quota. Google
+// reports some of them as 403 rather than 429.
+var quotaReasons = map[string]bool{
+	"rateLimitExceeded":     true,
+	"userRateLimitExceeded": true,
+	"quotaExceeded":         true,
+	"dailyLimitExceeded":    true,
+}
+
+// ClassifyError decides whether a failed sync can succeed on retry. Errors
+// the Google API or token endpoint didn't answer, such as network failures,
+// are transient.
+func ClassifyError(err error) store.SyncFailureCategory {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		// The token endpoint rejects revoked or expired grants with 400/401
+		if retrieveErr.Response != nil && retrieveErr.Response.StatusCode >= 500 {
+			return store.SyncFailureTransient
+		}
+		return store.SyncFailureAuth
+	}
+
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return store.SyncFailureTransient
+	}
+	for _, item := range apiErr.Errors {
+		if quotaReasons[item.Reason] {
+			return store.SyncFailureQuota
+		}
+		if item.Reason == "insufficientPermissions" {
+			// The user revoked the calendar scope; reconnecting grants it again
+			return store.SyncFailureAuth
+		}
+	}
+	switch {
+	case apiErr.Code == http.StatusUnauthorized:
+		return store.SyncFailureAuth
+	case apiErr.Code == http.StatusTooManyRequests:
+		return store.SyncFailureQuota
+	case apiErr.Code == http.StatusForbidden, apiErr.Code == http.StatusNotFound, apiErr.Code == http.StatusGone:
+		// The calendar was deleted or is no longer shared with the user
+		return store.SyncFailurePermanent
+	default:
+		return store.SyncFailureTransient
+	}
+}
+
+// RecordFailure classifies a calendar's sync error and records it, so the
+// calendar is retried, waits for re-authentication or is disabled
+func RecordFailure(ctx context.Context, calendars *store.CalendarStore, cal *store.Calendar, err error) store.SyncFailureCategory {
+	category := ClassifyError(err)
+	if recErr := calendars.RecordSyncFailure(ctx, cal.ID, category, err.Error()); recErr != nil {
+		log.Printf("[SYNC] failed to record failure: calendar=%s error=%v", redact.Text(cal.Name), recErr)
+	}
+	if category == store.SyncFailurePermanent {
+		log.Printf("[SYNC] disabled: calendar=%s error=%v", redact.Text(cal.Name), err)
+	}
+	return category
+}
@@ -0,0 +1,53 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+)
+
+func TestClassifyError(t *testing.T) {
+	apiErr := func(code int, reason string) error {
+		err := &googleapi.Error{Code: code}
+		if reason != "" {
+			err.Errors = []googleapi.ErrorItem{{Reason: reason}}
+		}
+		return err
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected store.SyncFailureCategory
+	}{
+		{"revoked grant", &oauth2.RetrieveError{Response: &http.Response{StatusCode: 400}, ErrorCode: "invalid_grant"}, store.SyncFailureAuth},
+		{"token endpoint down", &oauth2.RetrieveError{Response: &http.Response{StatusCode: 503}}, store.SyncFailureTransient},
+		{"wrapped by the HTTP client", &url.Error{Op: "Get", Err: &oauth2.RetrieveError{Response: &http.Response{StatusCode: 401}}}, store.SyncFailureAuth},
+		{"unauthorized", apiErr(401, ""), store.SyncFailureAuth},
+		{"scope revoked", apiErr(403, "insufficientPermissions"), store.SyncFailureAuth},
+		{"rate limited", apiErr(429, ""), store.SyncFailureQuota},
+		{"rate limit reported as 403", apiErr(403, "userRateLimitExceeded"), store.SyncFailureQuota},
+		{"calendar no longer shared", apiErr(403, "forbidden"), store.SyncFailurePermanent},
+		{"calendar deleted", apiErr(404, "notFound"), store.SyncFailurePermanent},
+		{"calendar gone", apiErr(410, "deleted"), store.SyncFailurePermanent},
+		{"server error", apiErr(500, "backendError"), store.SyncFailureTransient},
+		{"wrapped API error", fmt.Errorf("fetch events: %w", apiErr(404, "")), store.SyncFailurePermanent},
+		{"timeout", context.DeadlineExceeded, store.SyncFailureTransient},
+		{"network error", errors.New("connection reset by peer"), store.SyncFailureTransient},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.err); got != tt.expected {
+				t.Errorf("ClassifyError(%v) = %s, want %s", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
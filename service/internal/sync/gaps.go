@@ -0,0 +1,185 @@
+package sync
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// DefaultGapCheckInterval is how often the gap healer runs
+const DefaultGapCheckInterval = 6 * time.Hour
+
+// PersistentGapAge is how long a gap can stay open before it is reported as
+// persistent rather than waiting on a queued job
+const PersistentGapAge = 24 * time.Hour
+
+// gapJobPriority keeps gap-filling behind user-initiated and operator jobs
+const gapJobPriority = 0
+
+// GapStore finds weeks inside a calendar's synced window that were never
+// fetched
+type GapStore interface {
+	ListForGapCheck(ctx context.Context) ([]*store.Calendar, error)
+	MissingSyncedWeeks(ctx context.Context, calendarID uuid.UUID) ([]time.Time, error)
+	SetGapDetected(ctx context.Context, calendarID uuid.UUID, hasGaps bool) error
+	ListPersistentGaps(ctx context.Context, openFor time.Duration) ([]*store.CalendarGap, error)
+}
+
+// GapJobQueue queues the jobs that fill gaps
+type GapJobQueue interface {
+	CountPendingByCalendar(ctx context.Context, calendarID uuid.UUID) (int, error)
+	Create(ctx context.Context, job *store.SyncJob) (*store.SyncJob, error)
+}
+
+// GapReport summarizes one gap healer run
+type GapReport struct {
+	Checked    int
+	WithGaps   int
+	JobsQueued int
+	Persistent []*store.CalendarGap
+}
+
+// GapHealer periodically compares each calendar's synced window with the
+// weeks actually fetched and re-queues jobs for the holes. Holes appear when
+// an on-demand sync lands outside the window, the window is expanded over
+// it, and the job filling the space in between fails.
+type GapHealer struct {
+	calendars GapStore
+	jobs      GapJobQueue
+	interval  time.Duration
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// NewGapHealer creates a new gap healer
+func NewGapHealer(calendars GapStore, jobs GapJobQueue, interval time.Duration) *GapHealer {
+	return &GapHealer{
+		calendars: calendars,
+		jobs:      jobs,
+		interval:  interval,
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// Start begins the gap check loop
+func (h *GapHealer) Start(ctx context.Context) {
+	log.Printf("Starting sync gap healer (interval: %v)", h.interval)
+
+	go func() {
+		defer close(h.doneCh)
+
+		// Initial delay to let the server start up
+		select {
+		case <-time.After(time.Minute):
+		case <-h.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		h.run(ctx)
+
+		ticker := time.NewTicker(h.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				h.run(ctx)
+			case <-h.stopCh:
+				log.Println("Sync gap healer stopped")
+				return
+			case <-ctx.Done():
+				log.Println("Sync gap healer context cancelled")
+				return
+			}
+		}
+	}()
+}
+
+// Stop gracefully stops the gap healer
+func (h *GapHealer) Stop() {
+	close(h.stopCh)
+	<-h.doneCh
+}
+
+func (h *GapHealer) run(ctx context.Context) {
+	report, err := h.RunOnce(ctx)
+	if err != nil {
+		log.Printf("[SYNC] gap check failed: %v", err)
+		return
+	}
+	if report.WithGaps > 0 {
+		log.Printf("[SYNC] gap check: %d of %d calendars have gaps, queued %d jobs",
+			report.WithGaps, report.Checked, report.JobsQueued)
+	}
+	for _, gap := range report.Persistent {
+		log.Printf("[SYNC] persistent gap: calendar=%s user=%s missing_weeks=%d since=%s",
+			gap.CalendarID, gap.UserID, gap.MissingWeeks, gap.GapDetectedAt.Format(time.RFC3339))
+	}
+}
+
+// RunOnce checks every calendar once. Calendars with pending jobs are left
+// alone so a slow queue isn't flooded with duplicates; the gap stays marked
+// and is re-checked on the next run.
+func (h *GapHealer) RunOnce(ctx context.Context) (*GapReport, error) {
+	calendars, err := h.calendars.ListForGapCheck(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &GapReport{Checked: len(calendars)}
+	for _, cal := range calendars {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+
+		missing, err := h.calendars.MissingSyncedWeeks(ctx, cal.ID)
+		if err != nil {
+			log.Printf("[SYNC] gap check failed: calendar=%s error=%v", cal.ID, err)
+			continue
+		}
+		if err := h.calendars.SetGapDetected(ctx, cal.ID, len(missing) > 0); err != nil {
+			log.Printf("[SYNC] failed to record gap state: calendar=%s error=%v", cal.ID, err)
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		report.WithGaps++
+
+		pending, err := h.jobs.CountPendingByCalendar(ctx, cal.ID)
+		if err != nil {
+			log.Printf("[SYNC] failed to count pending jobs: calendar=%s error=%v", cal.ID, err)
+			continue
+		}
+		if pending > 0 {
+			continue
+		}
+
+		for _, batch := range BatchContiguousWeeks(missing) {
+			_, err := h.jobs.Create(ctx, &store.SyncJob{
+				CalendarID:    cal.ID,
+				JobType:       store.SyncJobTypeExpandWatermarks,
+				TargetMinDate: batch[0],
+				TargetMaxDate: NormalizeToWeekEnd(batch[len(batch)-1]),
+				Priority:      gapJobPriority,
+			})
+			if err != nil {
+				log.Printf("[SYNC] failed to queue gap job: calendar=%s error=%v", cal.ID, err)
+				continue
+			}
+			report.JobsQueued++
+		}
+	}
+
+	report.Persistent, err = h.calendars.ListPersistentGaps(ctx, PersistentGapAge)
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
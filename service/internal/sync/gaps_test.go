@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// fakeGaps is an in-memory GapStore and GapJobQueue
+type fakeGaps struct {
+	calendars []*store.Calendar
+	missing   map[uuid.UUID][]time.Time
+	pending   map[uuid.UUID]int
+	detected  map[uuid.UUID]bool
+	jobs      []*store.SyncJob
+}
+
+func (f *fakeGaps) ListForGapCheck(ctx context.Context) ([]*store.Calendar, error) {
+	return f.calendars, nil
+}
+
+func (f *fakeGaps) MissingSyncedWeeks(ctx context.Context, calendarID uuid.UUID) ([]time.Time, error) {
+	return f.missing[calendarID], nil
+}
+
+func (f *fakeGaps) SetGapDetected(ctx context.Context, calendarID uuid.UUID, hasGaps bool) error {
+	f.detected[calendarID] = hasGaps
+	return nil
+}
+
+func (f *fakeGaps) ListPersistentGaps(ctx context.Context, openFor time.Duration) ([]*store.CalendarGap, error) {
+	return nil, nil
+}
+
+func (f *fakeGaps) CountPendingByCalendar(ctx context.Context, calendarID uuid.UUID) (int, error) {
+	return f.pending[calendarID], nil
+}
+
+func (f *fakeGaps) Create(ctx context.Context, job *store.SyncJob) (*store.SyncJob, error) {
+	f.jobs = append(f.jobs, job)
+	return job, nil
+}
+
+func TestGapHealer_RunOnce(t *testing.T) {
+	week := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+	complete, gappy, busy := &store.Calendar{ID: uuid.New()}, &store.Calendar{ID: uuid.New()}, &store.Calendar{ID: uuid.New()}
+	f := &fakeGaps{
+		calendars: []*store.Calendar{complete, gappy, busy},
+		missing: map[uuid.UUID][]time.Time{
+			gappy.ID: {week(1, 6), week(1, 13), week(3, 3)},
+			busy.ID:  {week(1, 6)},
+		},
+		pending:  map[uuid.UUID]int{busy.ID: 1},
+		detected: make(map[uuid.UUID]bool),
+	}
+
+	report, err := NewGapHealer(f, f, DefaultGapCheckInterval).RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	if report.Checked != 3 || report.WithGaps != 2 || report.JobsQueued != 2 {
+		t.Errorf("expected 3 checked, 2 with gaps, 2 jobs; got %+v", report)
+	}
+	if f.detected[complete.ID] || !f.detected[gappy.ID] || !f.detected[busy.ID] {
+		t.Errorf("unexpected gap state: %v", f.detected)
+	}
+
+	// One job per contiguous run, none for the calendar with pending work
+	if len(f.jobs) != 2 {
+		t.Fatalf("expected 2 jobs, got %d", len(f.jobs))
+	}
+	first := f.jobs[0]
+	if first.CalendarID != gappy.ID || !first.TargetMinDate.Equal(week(1, 6)) || !first.TargetMaxDate.Equal(NormalizeToWeekEnd(week(1, 13))) {
+		t.Errorf("unexpected first job: %+v", first)
+	}
+	if !f.jobs[1].TargetMinDate.Equal(week(3, 3)) {
+		t.Errorf("expected second job to start Mar 3, got %v", f.jobs[1].TargetMinDate)
+	}
+}
@@ -3,11 +3,13 @@ package sync
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/michaelw/timesheet-app/service/internal/database"
 	"github.com/michaelw/timesheet-app/service/internal/google"
 	"github.com/michaelw/timesheet-app/service/internal/store"
 	gcal "google.golang.org/api/calendar/v3"
@@ -35,15 +37,23 @@ func DefaultJobWorkerConfig() JobWorkerConfig {
 	}
 }
 
+// Suppressor applies suppression rules to a calendar's events after sync
+type Suppressor interface {
+	ApplySuppressionRules(ctx context.Context, userID uuid.UUID, calendarID *uuid.UUID) (suppressed, released int, err error)
+}
+
 // JobWorker processes background sync jobs from the queue
 type JobWorker struct {
 	config     JobWorkerConfig
-	pool       *pgxpool.Pool
+	uow        *database.UnitOfWork
 	jobStore   *store.SyncJobStore
 	calStore   *store.CalendarStore
 	connStore  *store.CalendarConnectionStore
 	eventStore *store.CalendarEventStore
 	googleSvc  google.CalendarClient
+	suppressor Suppressor
+	gate       *Gate
+	cancel     context.CancelFunc
 	stopCh     chan struct{}
 	doneCh     chan struct{}
 }
@@ -57,15 +67,19 @@ func NewJobWorker(
 	connStore *store.CalendarConnectionStore,
 	eventStore *store.CalendarEventStore,
 	googleSvc google.CalendarClient,
+	suppressor Suppressor,
 ) *JobWorker {
 	return &JobWorker{
 		config:     config,
-		pool:       pool,
+		uow:        database.NewUnitOfWork(pool),
 		jobStore:   jobStore,
 		calStore:   calStore,
 		connStore:  connStore,
 		eventStore: eventStore,
 		googleSvc:  googleSvc,
+		suppressor: suppressor,
+		gate:       &Gate{},
+		cancel:     func() {},
 		stopCh:     make(chan struct{}),
 		doneCh:     make(chan struct{}),
 	}
@@ -81,6 +95,10 @@ func (w *JobWorker) Start(ctx context.Context) {
 
 	log.Printf("Starting job worker (poll interval: %v, worker ID: %s)", w.config.PollInterval, w.config.WorkerID)
 
+	// In-flight jobs run on their own context so shutdown can let them
+	// finish before cancelling
+	ctx, w.cancel = context.WithCancel(ctx)
+
 	go func() {
 		defer close(w.doneCh)
 
@@ -105,10 +123,26 @@ func (w *JobWorker) Start(ctx context.Context) {
 	}()
 }
 
-// Stop gracefully stops the job worker
+// Stop gracefully stops the job worker, waiting for the job in progress
+// however long it takes
 func (w *JobWorker) Stop() {
+	w.Shutdown(context.Background())
+}
+
+// Shutdown stops the worker from claiming new jobs and waits for the one in
+// progress until ctx is done, then cancels it. A cancelled job is returned
+// to the queue.
+func (w *JobWorker) Shutdown(ctx context.Context) error {
+	w.gate.Close()
 	close(w.stopCh)
+
+	err := w.gate.Wait(ctx)
+	if err != nil {
+		log.Println("Job worker: drain deadline reached, cancelling in-flight job")
+	}
+	w.cancel()
 	<-w.doneCh
+	return err
 }
 
 // processJobs processes available jobs
@@ -122,38 +156,71 @@ func (w *JobWorker) processJobs(ctx context.Context) {
 		default:
 		}
 
-		// Claim the next job
-		job, err := w.jobStore.ClaimNextJob(ctx, w.config.WorkerID)
-		if err != nil {
-			log.Printf("Job worker: error claiming job: %v", err)
+		if !w.gate.Enter() {
 			return
 		}
-
-		if job == nil {
-			// No more jobs to process
+		more := w.processNextJob(ctx)
+		w.gate.Leave()
+		if !more {
 			return
 		}
+	}
+}
+
+// processNextJob claims and runs one job. It reports whether the queue may
+// have more work.
+func (w *JobWorker) processNextJob(ctx context.Context) bool {
+	// Claim the next job
+	job, err := w.jobStore.ClaimNextJob(ctx, w.config.WorkerID)
+	if err != nil {
+		log.Printf("Job worker: error claiming job: %v", err)
+		return false
+	}
 
-		log.Printf("Job worker: processing job %s (calendar: %s, type: %s, range: %s to %s)",
-			job.ID, job.CalendarID, job.JobType,
-			job.TargetMinDate.Format("2006-01-02"), job.TargetMaxDate.Format("2006-01-02"))
+	if job == nil {
+		// No more jobs to process
+		return false
+	}
 
-		// Process the job
-		if err := w.processJob(ctx, job); err != nil {
-			log.Printf("Job worker: job %s failed: %v", job.ID, err)
-			if markErr := w.jobStore.MarkFailed(ctx, job.ID, err.Error()); markErr != nil {
-				log.Printf("Job worker: failed to mark job as failed: %v", markErr)
+	log.Printf("Job worker: processing job %s (calendar: %s, type: %s, range: %s to %s)",
+		job.ID, job.CalendarID, job.JobType,
+		job.TargetMinDate.Format("2006-01-02"), job.TargetMaxDate.Format("2006-01-02"))
+
+	// Process the job while holding the calendar's sync lease
+	err = WithCalendarLease(ctx, w.calStore, job.CalendarID, func() error {
+		return w.processJob(ctx, job)
+	})
+	if errors.Is(err, ErrSyncInProgress) {
+		// Another sync has the calendar; retry on a later poll
+		log.Printf("Job worker: job %s deferred, calendar %s is syncing", job.ID, job.CalendarID)
+		if relErr := w.jobStore.Release(ctx, job.ID); relErr != nil {
+			log.Printf("Job worker: failed to release job: %v", relErr)
+		}
+		return false
+	}
+	if err != nil {
+		// Cancelled by shutdown: put the job back for the next worker
+		if ctx.Err() != nil {
+			log.Printf("Job worker: job %s interrupted, returning it to the queue", job.ID)
+			if relErr := w.jobStore.Release(context.WithoutCancel(ctx), job.ID); relErr != nil {
+				log.Printf("Job worker: failed to release job: %v", relErr)
 			}
-			continue
+			return false
 		}
-
-		// Mark job as completed
-		if err := w.jobStore.MarkCompleted(ctx, job.ID); err != nil {
-			log.Printf("Job worker: failed to mark job as completed: %v", err)
+		log.Printf("Job worker: job %s failed: %v", job.ID, err)
+		if markErr := w.jobStore.MarkFailed(ctx, job.ID, err.Error()); markErr != nil {
+			log.Printf("Job worker: failed to mark job as failed: %v", markErr)
 		}
+		return true
+	}
 
-		log.Printf("Job worker: job %s completed successfully", job.ID)
+	// Mark job as completed
+	if err := w.jobStore.MarkCompleted(ctx, job.ID); err != nil {
+		log.Printf("Job worker: failed to mark job as completed: %v", err)
 	}
+
+	log.Printf("Job worker: job %s completed successfully", job.ID)
+	return true
 }
 
 // processJob processes a single sync job
@@ -170,11 +237,21 @@ func (w *JobWorker) processJob(ctx context.Context, job *store.SyncJob) error {
 		return err
 	}
 
+	// The user paused the connection after the job was queued
+	if conn.PausedAt != nil {
+		return errConnectionPaused
+	}
+
 	// Check if calendar needs re-auth
 	if cal.NeedsReauth {
 		return errNeedsReauth
 	}
 
+	// Check if a permanent failure disabled the calendar
+	if cal.SyncDisabledAt != nil {
+		return errSyncDisabled
+	}
+
 	// Check if we've exceeded failure threshold
 	if cal.SyncFailureCount >= 3 {
 		return errTooManyFailures
@@ -185,7 +262,9 @@ func (w *JobWorker) processJob(ctx context.Context, job *store.SyncJob) error {
 	if time.Now().After(creds.Expiry.Add(-5 * time.Minute)) {
 		newCreds, err := w.googleSvc.RefreshToken(ctx, creds)
 		if err != nil {
-			w.calStore.MarkNeedsReauth(ctx, cal.ID)
+			if ctx.Err() == nil {
+				RecordFailure(ctx, w.calStore, cal, err)
+			}
 			return err
 		}
 		creds = newCreds
@@ -195,78 +274,80 @@ func (w *JobWorker) processJob(ctx context.Context, job *store.SyncJob) error {
 	// Fetch events from Google
 	result, err := w.googleSvc.FetchEvents(ctx, creds, cal.ExternalID, job.TargetMinDate, job.TargetMaxDate)
 	if err != nil {
-		// Track failure
-		if incrementErr := w.calStore.IncrementSyncFailureCount(ctx, cal.ID); incrementErr != nil {
-			log.Printf("Job worker: failed to increment failure count: %v", incrementErr)
+		// Track failure, unless the fetch was cancelled by shutdown
+		if ctx.Err() != nil {
+			return err
 		}
+		RecordFailure(ctx, w.calStore, cal, err)
 		return err
 	}
 
-	// Use a transaction to atomically update events and water marks
-	tx, err := w.pool.Begin(ctx)
-	if err != nil {
-		return err
-	}
-	defer tx.Rollback(ctx)
+	// Update events, water marks and the sync token atomically, so a failed
+	// job leaves the calendar as it was and the retry starts clean
+	err = w.uow.Do(ctx, func(ctx context.Context) error {
+		// Track external IDs for orphaning
+		externalIDs := make([]string, 0, len(result.Events))
+
+		// Upsert events
+		for _, ge := range result.Events {
+			if ge.Status == "cancelled" {
+				// Mark as orphaned
+				if err := w.eventStore.MarkOrphanedByExternalIDAndCalendar(ctx, cal.ID, ge.Id); err != nil {
+					return err
+				}
+				continue
+			}
 
-	// Track external IDs for orphaning
-	externalIDs := make([]string, 0, len(result.Events))
+			// Skip working location events - these indicate where someone is working
+			// (office, home, etc.) rather than actual meetings or work items
+			if ge.EventType == "workingLocation" {
+				continue
+			}
 
-	// Upsert events
-	for _, ge := range result.Events {
-		if ge.Status == "cancelled" {
-			// Mark as orphaned
-			if err := w.eventStore.MarkOrphanedByExternalIDAndCalendar(ctx, cal.ID, ge.Id); err != nil {
-				log.Printf("Job worker: failed to mark event as orphaned: %v", err)
+			externalIDs = append(externalIDs, ge.Id)
+			event := googleEventToStore(ge, conn.ID, cal.ID, cal.UserID)
+			if _, err := w.eventStore.Upsert(ctx, event); err != nil {
+				return err
 			}
-			continue
 		}
 
-		// Skip working location events - these indicate where someone is working
-		// (office, home, etc.) rather than actual meetings or work items
-		if ge.EventType == "workingLocation" {
-			continue
+		// Mark events within the synced range as orphaned if not in the result
+		if len(externalIDs) > 0 {
+			if _, err := w.eventStore.MarkOrphanedInRangeExceptByCalendar(ctx, cal.ID, externalIDs, job.TargetMinDate, job.TargetMaxDate); err != nil {
+				return err
+			}
 		}
 
-		externalIDs = append(externalIDs, ge.Id)
-		event := googleEventToStore(ge, conn.ID, cal.ID, cal.UserID)
-		if _, err := w.eventStore.Upsert(ctx, event); err != nil {
+		// Expand water marks to include the synced range
+		if err := w.calStore.ExpandSyncedWindow(ctx, job.CalendarID, job.TargetMinDate, job.TargetMaxDate); err != nil {
 			return err
 		}
-	}
 
-	// Mark events within the synced range as orphaned if not in the result
-	if len(externalIDs) > 0 {
-		if _, err := w.eventStore.MarkOrphanedInRangeExceptByCalendar(ctx, cal.ID, externalIDs, job.TargetMinDate, job.TargetMaxDate); err != nil {
-			return err
+		// Update sync token if we got a new one
+		if result.NextSyncToken != "" {
+			if err := w.calStore.UpdateSyncToken(ctx, job.CalendarID, result.NextSyncToken); err != nil {
+				return err
+			}
 		}
-	}
 
-	// Expand water marks to include the synced range
-	if err := w.calStore.ExpandSyncedWindow(ctx, job.CalendarID, job.TargetMinDate, job.TargetMaxDate); err != nil {
-		return err
-	}
-
-	// Update sync token if we got a new one
-	if result.NextSyncToken != "" {
-		if err := w.calStore.UpdateSyncToken(ctx, job.CalendarID, result.NextSyncToken); err != nil {
+		// Update last synced timestamp
+		if err := w.calStore.UpdateLastSynced(ctx, job.CalendarID); err != nil {
 			return err
 		}
-	}
-
-	// Update last synced timestamp
-	if err := w.calStore.UpdateLastSynced(ctx, job.CalendarID); err != nil {
-		return err
-	}
 
-	// Reset failure count on success
-	if err := w.calStore.ResetSyncFailureCount(ctx, job.CalendarID); err != nil {
+		// Reset failure count on success
+		return w.calStore.ResetSyncFailureCount(ctx, job.CalendarID)
+	})
+	if err != nil {
 		return err
 	}
 
-	// Commit the transaction
-	if err := tx.Commit(ctx); err != nil {
-		return err
+	// Keep events matched by suppression rules out of the pending queue. The
+	// rules read the synced events, so they run once those are committed.
+	if w.suppressor != nil {
+		if _, _, err := w.suppressor.ApplySuppressionRules(ctx, cal.UserID, &cal.ID); err != nil {
+			log.Printf("Job worker: failed to apply suppression rules: %v", err)
+		}
 	}
 
 	return nil
@@ -309,6 +390,7 @@ func googleEventToStore(ge *gcal.Event, connID, calID uuid.UUID, userID uuid.UUI
 	attendeeSet := make(map[string]bool)
 	for _, a := range ge.Attendees {
 		event.Attendees = append(event.Attendees, a.Email)
+		event.AttendeeDetails = append(event.AttendeeDetails, attendeeDetail(a))
 		attendeeSet[a.Email] = true
 		if a.Self && a.ResponseStatus != "" {
 			event.ResponseStatus = &a.ResponseStatus
@@ -319,6 +401,9 @@ func googleEventToStore(ge *gcal.Event, connID, calID uuid.UUID, userID uuid.UUI
 	// Google Calendar doesn't always include the organizer in the attendees list
 	if ge.Organizer != nil && ge.Organizer.Email != "" && !attendeeSet[ge.Organizer.Email] {
 		event.Attendees = append(event.Attendees, ge.Organizer.Email)
+		organizer := store.NewEventAttendee(ge.Organizer.Email)
+		organizer.IsOrganizer = true
+		event.AttendeeDetails = append(event.AttendeeDetails, organizer)
 	}
 
 	event.IsRecurring = ge.RecurringEventId != ""
@@ -330,12 +415,25 @@ func googleEventToStore(ge *gcal.Event, connID, calID uuid.UUID, userID uuid.UUI
 	return event
 }
 
+// attendeeDetail converts a Google attendee to a normalized attendee row
+func attendeeDetail(a *gcal.EventAttendee) store.EventAttendee {
+	detail := store.NewEventAttendee(a.Email)
+	if a.ResponseStatus != "" {
+		response := a.ResponseStatus
+		detail.ResponseStatus = &response
+	}
+	detail.IsOrganizer = a.Organizer
+	return detail
+}
+
 // Error types for job failures
 type syncError string
 
 func (e syncError) Error() string { return string(e) }
 
 const (
-	errNeedsReauth     syncError = "calendar needs re-authentication"
-	errTooManyFailures syncError = "too many consecutive sync failures"
+	errNeedsReauth      syncError = "calendar needs re-authentication"
+	errTooManyFailures  syncError = "too many consecutive sync failures"
+	errSyncDisabled     syncError = "calendar sync disabled after a permanent failure"
+	errConnectionPaused syncError = "calendar connection is paused"
 )
@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LeaseTTL is how long a sync lease lasts. It bounds how long a crashed
+// holder can keep other syncs off a calendar, so it must outlast the
+// slowest sync.
+const LeaseTTL = 15 * time.Minute
+
+// ErrSyncInProgress is returned when another sync holds the calendar's lease
+var ErrSyncInProgress = errors.New("calendar sync already in progress")
+
+// LeaseStore grants per-calendar sync leases
+type LeaseStore interface {
+	TryAcquireSyncLease(ctx context.Context, calendarID uuid.UUID, holder string, ttl time.Duration) (bool, error)
+	ReleaseSyncLease(ctx context.Context, calendarID uuid.UUID, holder string) error
+}
+
+// WithCalendarLease runs fn while holding the calendar's sync lease, so a
+// manual sync, an on-demand fetch, background sync and the job worker never
+// fetch the same calendar or move its watermarks at once. It returns
+// ErrSyncInProgress without running fn when the lease is held elsewhere.
+func WithCalendarLease(ctx context.Context, leases LeaseStore, calendarID uuid.UUID, fn func() error) error {
+	holder := uuid.NewString()
+	acquired, err := leases.TryAcquireSyncLease(ctx, calendarID, holder, LeaseTTL)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return ErrSyncInProgress
+	}
+
+	// Release even when fn was cancelled by shutdown
+	defer func() {
+		if err := leases.ReleaseSyncLease(context.WithoutCancel(ctx), calendarID, holder); err != nil {
+			log.Printf("[SYNC] failed to release lease: calendar=%s error=%v", calendarID, err)
+		}
+	}()
+
+	return fn()
+}
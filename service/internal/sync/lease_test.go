@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fakeLeases is an in-memory LeaseStore without expiry
+type fakeLeases struct {
+	mu      gosync.Mutex
+	holders map[uuid.UUID]string
+}
+
+func (f *fakeLeases) TryAcquireSyncLease(ctx context.Context, calendarID uuid.UUID, holder string, ttl time.Duration) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, held := f.holders[calendarID]; held {
+		return false, nil
+	}
+	f.holders[calendarID] = holder
+	return true, nil
+}
+
+func (f *fakeLeases) ReleaseSyncLease(ctx context.Context, calendarID uuid.UUID, holder string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.holders[calendarID] == holder {
+		delete(f.holders, calendarID)
+	}
+	return nil
+}
+
+func TestWithCalendarLease(t *testing.T) {
+	leases := &fakeLeases{holders: make(map[uuid.UUID]string)}
+	ctx := context.Background()
+	calendarID := uuid.New()
+
+	var nested error
+	err := WithCalendarLease(ctx, leases, calendarID, func() error {
+		nested = WithCalendarLease(ctx, leases, calendarID, func() error {
+			t.Error("second sync ran while the first held the lease")
+			return nil
+		})
+		// Other calendars are unaffected
+		return WithCalendarLease(ctx, leases, uuid.New(), func() error { return nil })
+	})
+	if err != nil {
+		t.Fatalf("WithCalendarLease: %v", err)
+	}
+	if !errors.Is(nested, ErrSyncInProgress) {
+		t.Errorf("expected ErrSyncInProgress for the overlapping sync, got %v", nested)
+	}
+
+	fnErr := errors.New("fetch failed")
+	if err := WithCalendarLease(ctx, leases, calendarID, func() error { return fnErr }); !errors.Is(err, fnErr) {
+		t.Errorf("expected fn's error after release, got %v", err)
+	}
+	if len(leases.holders) != 0 {
+		t.Errorf("expected all leases released, got %v", leases.holders)
+	}
+}
@@ -0,0 +1,118 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"log"
+	gosync "sync"
+	"time"
+)
+
+// DefaultDrainTimeout is how long shutdown waits for in-flight calendar
+// syncs before cancelling them
+const DefaultDrainTimeout = 20 * time.Second
+
+// Gate tracks in-flight units of background work (one calendar, one job) so
+// shutdown can stop new units from starting and wait for the current ones.
+// A nil Gate admits everything.
+type Gate struct {
+	mu     gosync.Mutex
+	closed bool
+	wg     gosync.WaitGroup
+}
+
+// Enter reports whether a new unit may start. Every successful Enter must be
+// paired with Leave.
+func (g *Gate) Enter() bool {
+	if g == nil {
+		return true
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return false
+	}
+	g.wg.Add(1)
+	return true
+}
+
+// Leave marks a unit started with Enter as finished
+func (g *Gate) Leave() {
+	if g == nil {
+		return
+	}
+	g.wg.Done()
+}
+
+// Close stops further units from entering
+func (g *Gate) Close() {
+	g.mu.Lock()
+	g.closed = true
+	g.mu.Unlock()
+}
+
+// Wait blocks until all entered units have left or ctx is done
+func (g *Gate) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Drainable is a background component that can be shut down gracefully
+type Drainable interface {
+	// Shutdown stops new work, waits for in-flight work until ctx is done,
+	// then cancels whatever is left. It returns ctx.Err() if work had to be
+	// cancelled.
+	Shutdown(ctx context.Context) error
+}
+
+// Lifecycle shuts down the background sync components together. Progress is
+// committed per calendar and per job as each finishes, so a sync cancelled
+// at the deadline leaves the calendar's previous watermarks and sync token
+// in place, and an interrupted job goes back on the queue.
+type Lifecycle struct {
+	components []Drainable
+}
+
+// NewLifecycle creates an empty lifecycle manager
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{}
+}
+
+// Add registers a component to drain on shutdown
+func (l *Lifecycle) Add(c Drainable) {
+	l.components = append(l.components, c)
+}
+
+// Shutdown drains all components concurrently, giving them until timeout
+func (l *Lifecycle) Shutdown(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	errs := make([]error, len(l.components))
+	var wg gosync.WaitGroup
+	for i, c := range l.components {
+		wg.Add(1)
+		go func(i int, c Drainable) {
+			defer wg.Done()
+			errs[i] = c.Shutdown(ctx)
+		}(i, c)
+	}
+	wg.Wait()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		log.Printf("Background sync drain incomplete: %v", err)
+	} else {
+		log.Println("Background sync drained")
+	}
+	return err
+}
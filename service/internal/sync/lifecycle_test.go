@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeRunner syncs the given number of calendars, each taking perCalendar or until
+// the run context is cancelled
+type fakeRunner struct {
+	calendars   int
+	perCalendar time.Duration
+	started     chan struct{}
+	completed   atomic.Int32
+	cancelled   atomic.Int32
+}
+
+func (r *fakeRunner) RunBackgroundSync(ctx context.Context, gate *Gate) error {
+	for i := 0; i < r.calendars; i++ {
+		if !gate.Enter() {
+			return nil
+		}
+		if i == 0 {
+			close(r.started)
+		}
+		select {
+		case <-time.After(r.perCalendar):
+			r.completed.Add(1)
+		case <-ctx.Done():
+			r.cancelled.Add(1)
+		}
+		gate.Leave()
+	}
+	return nil
+}
+
+func startScheduler(t *testing.T, runner *fakeRunner) *BackgroundScheduler {
+	t.Helper()
+	s := NewBackgroundScheduler(BackgroundSyncConfig{Interval: time.Hour, Enabled: true}, runner)
+	s.Start(context.Background())
+	select {
+	case <-runner.started:
+	case <-time.After(time.Second):
+		t.Fatal("background sync did not start")
+	}
+	return s
+}
+
+func TestLifecycle_DrainsCalendarInProgress(t *testing.T) {
+	runner := &fakeRunner{calendars: 3, perCalendar: 50 * time.Millisecond, started: make(chan struct{})}
+	s := startScheduler(t, runner)
+
+	l := NewLifecycle()
+	l.Add(s)
+	if err := l.Shutdown(time.Second); err != nil {
+		t.Fatalf("expected clean drain, got %v", err)
+	}
+
+	if got := runner.completed.Load(); got != 1 {
+		t.Errorf("expected only the in-flight calendar to finish, %d did", got)
+	}
+	if got := runner.cancelled.Load(); got != 0 {
+		t.Errorf("expected no cancellations, got %d", got)
+	}
+}
+
+func TestLifecycle_CancelsAtDeadline(t *testing.T) {
+	runner := &fakeRunner{calendars: 1, perCalendar: time.Hour, started: make(chan struct{})}
+	s := startScheduler(t, runner)
+
+	l := NewLifecycle()
+	l.Add(s)
+	err := l.Shutdown(20 * time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+	if got := runner.cancelled.Load(); got != 1 {
+		t.Errorf("expected the in-flight calendar to be cancelled, got %d", got)
+	}
+}
+
+func TestGate_RejectsAfterClose(t *testing.T) {
+	var g Gate
+	if !g.Enter() {
+		t.Fatal("expected open gate to admit")
+	}
+	g.Close()
+	if g.Enter() {
+		t.Error("expected closed gate to reject")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := g.Wait(ctx); err == nil {
+		t.Error("expected Wait to time out while a unit is in flight")
+	}
+
+	g.Leave()
+	if err := g.Wait(context.Background()); err != nil {
+		t.Errorf("expected Wait to return once drained, got %v", err)
+	}
+
+	var nilGate *Gate
+	if !nilGate.Enter() {
+		t.Error("expected nil gate to admit")
+	}
+	nilGate.Leave()
+}
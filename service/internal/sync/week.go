@@ -33,24 +33,19 @@ func IsWeekWithinRange(weekDate time.Time, minSynced, maxSynced *time.Time) bool
 	return !weekStart.Before(*minSynced) && !weekEnd.After(*maxSynced)
 }
 
-// StalenessThreshold is the duration after which synced data is considered stale.
+// StalenessThreshold is the default duration after which synced data is
+// considered stale; users can choose their own (see Cadence).
 const StalenessThreshold = 24 * time.Hour
 
 // IsStale checks if the last sync time is older than the staleness threshold.
 func IsStale(lastSyncedAt *time.Time) bool {
-	if lastSyncedAt == nil {
-		return true
-	}
-	return time.Since(*lastSyncedAt) > StalenessThreshold
+	return DefaultCadence.IsStale(lastSyncedAt)
 }
 
 // DefaultInitialWindow returns the default sync window for new calendars.
 // Returns (startDate, endDate) representing -4 weeks to +1 week from now.
 func DefaultInitialWindow() (time.Time, time.Time) {
-	now := time.Now().UTC()
-	start := NormalizeToWeekStart(now.AddDate(0, 0, -28)) // -4 weeks
-	end := NormalizeToWeekEnd(now.AddDate(0, 0, 7))       // +1 week
-	return start, end
+	return InitialWindow.Range(time.Now())
 }
 
 // DefaultBackgroundWindow returns the target sync window for background expansion.
@@ -85,6 +80,11 @@ type SyncDecision struct {
 // DecideSync determines if a sync is needed for a given date range.
 // Returns a SyncDecision indicating whether sync is needed and which weeks to fetch.
 func DecideSync(minSynced, maxSynced, lastSyncedAt *time.Time, targetStart, targetEnd time.Time) SyncDecision {
+	return DefaultCadence.DecideSync(minSynced, maxSynced, lastSyncedAt, targetStart, targetEnd)
+}
+
+// DecideSync is DecideSync with the cadence's staleness threshold
+func (c Cadence) DecideSync(minSynced, maxSynced, lastSyncedAt *time.Time, targetStart, targetEnd time.Time) SyncDecision {
 	targetStart = NormalizeToWeekStart(targetStart)
 	targetEnd = NormalizeToWeekEnd(targetEnd)
 
@@ -98,14 +98,16 @@ func DecideSync(minSynced, maxSynced, lastSyncedAt *time.Time, targetStart, targ
 		}
 	}
 
-	// Check if target range is within synced window
+	// Check if target range is within synced window. Watermarks are stored
+	// as dates, so compare whole weeks as MissingWeeks does: the week holding
+	// the high watermark counts as synced.
 	targetWeekStart := NormalizeToWeekStart(targetStart)
-	targetWeekEnd := NormalizeToWeekEnd(targetEnd)
-	withinWindow := !targetWeekStart.Before(*minSynced) && !targetWeekEnd.After(*maxSynced)
+	targetWeekEnd := NormalizeToWeekStart(targetEnd)
+	withinWindow := !targetWeekStart.Before(NormalizeToWeekStart(*minSynced)) && !targetWeekEnd.After(NormalizeToWeekStart(*maxSynced))
 
 	if withinWindow {
 		// Data is within synced window - check staleness
-		if IsStale(lastSyncedAt) {
+		if c.IsStale(lastSyncedAt) {
 			// Case A' - stale data, need incremental refresh
 			return SyncDecision{
 				NeedsSync:      true,
@@ -160,3 +162,33 @@ func MissingWeeks(minSynced, maxSynced *time.Time, targetStart, targetEnd time.T
 
 	return missing
 }
+
+// BatchContiguousWeeks groups contiguous weeks into batches for efficient fetching.
+// This reduces the number of Google Calendar API calls when syncing multiple weeks.
+// For example, weeks [Jan 6, Jan 13, Jan 20, Feb 10, Feb 17] becomes:
+// [[Jan 6, Jan 13, Jan 20], [Feb 10, Feb 17]]
+func BatchContiguousWeeks(weeks []time.Time) [][]time.Time {
+	if len(weeks) == 0 {
+		return nil
+	}
+
+	var batches [][]time.Time
+	currentBatch := []time.Time{weeks[0]}
+
+	for i := 1; i < len(weeks); i++ {
+		// Weeks are contiguous if they're exactly 7 days apart
+		expected := currentBatch[len(currentBatch)-1].AddDate(0, 0, 7)
+		if weeks[i].Equal(expected) {
+			currentBatch = append(currentBatch, weeks[i])
+		} else {
+			// Non-contiguous, start a new batch
+			batches = append(batches, currentBatch)
+			currentBatch = []time.Time{weeks[i]}
+		}
+	}
+
+	// Don't forget the last batch
+	batches = append(batches, currentBatch)
+
+	return batches
+}
@@ -255,6 +255,20 @@ func TestDecideSync(t *testing.T) {
 		}
 	})
 
+	t.Run("Case A': stale data within a window stored as dates", func(t *testing.T) {
+		// The database keeps watermarks as dates, so the high watermark of a
+		// window synced through Sunday comes back as Sunday midnight
+		storedMax := time.Date(2025, 1, 26, 0, 0, 0, 0, time.UTC)
+		targetStart := time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)
+		targetEnd := time.Date(2025, 1, 26, 23, 59, 59, 0, time.UTC)
+
+		decision := DecideSync(&minSynced, &storedMax, staleSync, targetStart, targetEnd)
+
+		if !decision.IsStaleRefresh {
+			t.Errorf("Expected a stale refresh for the last synced week, got %+v", decision)
+		}
+	})
+
 	t.Run("Case B: week before synced window", func(t *testing.T) {
 		targetStart := time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC) // Week of Dec 30
 		targetEnd := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
@@ -307,3 +321,19 @@ func TestDecideSync(t *testing.T) {
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
+
+func TestBatchContiguousWeeks(t *testing.T) {
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+
+	batches := BatchContiguousWeeks([]time.Time{day(1, 6), day(1, 13), day(1, 20), day(2, 10), day(2, 17)})
+	if len(batches) != 2 || len(batches[0]) != 3 || len(batches[1]) != 2 {
+		t.Fatalf("Expected batches of 3 and 2 weeks, got %v", batches)
+	}
+	if !batches[1][0].Equal(day(2, 10)) {
+		t.Errorf("Expected second batch to start Feb 10, got %v", batches[1][0])
+	}
+
+	if batches := BatchContiguousWeeks(nil); batches != nil {
+		t.Errorf("Expected no batches for no weeks, got %v", batches)
+	}
+}
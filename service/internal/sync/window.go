@@ -0,0 +1,64 @@
+package sync
+
+import "time"
+
+// Window is how far a sync reaches around today, in days of history and
+// days of future
+type Window struct {
+	HistoryDays int
+	FutureDays  int
+}
+
+var (
+	// InitialWindow is fetched for a calendar with no sync token:
+	// -4 weeks to +1 week (see DefaultInitialWindow)
+	InitialWindow = Window{HistoryDays: 28, FutureDays: 7}
+	// ManualWindow is fetched by a manual sync without explicit dates
+	ManualWindow = Window{HistoryDays: 90, FutureDays: 30}
+)
+
+// Resolve applies a connection's own historical depth and future horizon,
+// where set, and caps the result at limit. Zero fields in limit are
+// uncapped.
+func (w Window) Resolve(historyDays, futureDays *int, limit Window) Window {
+	if historyDays != nil {
+		w.HistoryDays = *historyDays
+	}
+	if futureDays != nil {
+		w.FutureDays = *futureDays
+	}
+	if limit.HistoryDays > 0 && w.HistoryDays > limit.HistoryDays {
+		w.HistoryDays = limit.HistoryDays
+	}
+	if limit.FutureDays > 0 && w.FutureDays > limit.FutureDays {
+		w.FutureDays = limit.FutureDays
+	}
+	return w
+}
+
+// Range returns the week-aligned dates the window covers around now
+func (w Window) Range(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	start := NormalizeToWeekStart(now.AddDate(0, 0, -w.HistoryDays))
+	end := NormalizeToWeekEnd(now.AddDate(0, 0, w.FutureDays))
+	return start, end
+}
+
+// Bounds returns the furthest a connection may sync: its own depth and
+// horizon where set, otherwise the global limit. Zero fields are unbounded.
+func Bounds(historyDays, futureDays *int, limit Window) Window {
+	return limit.Resolve(historyDays, futureDays, limit)
+}
+
+// Clamp trims start..end to the bounds around now, keeping week alignment.
+// It reports false when nothing of the range is left.
+func (w Window) Clamp(start, end, now time.Time) (time.Time, time.Time, bool) {
+	boundStart, boundEnd := w.Range(now)
+	if w.HistoryDays > 0 && start.Before(boundStart) {
+		start = boundStart
+	}
+	if w.FutureDays > 0 && end.After(boundEnd) {
+		end = boundEnd
+	}
+	return start, end, !start.After(end)
+}
@@ -0,0 +1,81 @@
+package sync
+
+import (
+	"testing"
+	"time"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestWindowResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		history  *int
+		future   *int
+		limit    Window
+		expected Window
+	}{
+		{
+			name:     "defaults",
+			expected: ManualWindow,
+		},
+		{
+			name:     "connection overrides",
+			history:  intPtr(365),
+			future:   intPtr(14),
+			expected: Window{HistoryDays: 365, FutureDays: 14},
+		},
+		{
+			name:     "capped by the global limit",
+			history:  intPtr(365),
+			limit:    Window{HistoryDays: 180, FutureDays: 10},
+			expected: Window{HistoryDays: 180, FutureDays: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ManualWindow.Resolve(tt.history, tt.future, tt.limit)
+			if got != tt.expected {
+				t.Errorf("Resolve() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWindowRange(t *testing.T) {
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC) // Wednesday
+	start, end := InitialWindow.Range(now)
+
+	wantStart := time.Date(2024, 12, 9, 0, 0, 0, 0, time.UTC)
+	wantEnd := time.Date(2025, 1, 19, 23, 59, 59, 0, time.UTC)
+	if !start.Equal(wantStart) || !end.Equal(wantEnd) {
+		t.Errorf("Range() = %s..%s, want %s..%s", start, end, wantStart, wantEnd)
+	}
+}
+
+func TestWindowClamp(t *testing.T) {
+	now := time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC) // Wednesday
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 29, 23, 59, 59, 0, time.UTC)
+
+	// Unbounded leaves the range alone
+	gotStart, gotEnd, ok := Bounds(nil, nil, Window{}).Clamp(start, end, now)
+	if !ok || !gotStart.Equal(start) || !gotEnd.Equal(end) {
+		t.Errorf("unbounded Clamp() = %s..%s %v", gotStart, gotEnd, ok)
+	}
+
+	// A 28-day history and the 7-day global future cap trim both ends
+	gotStart, gotEnd, ok = Bounds(intPtr(28), nil, Window{FutureDays: 7}).Clamp(start, end, now)
+	wantStart := time.Date(2024, 12, 9, 0, 0, 0, 0, time.UTC)
+	wantEnd := time.Date(2025, 1, 19, 23, 59, 59, 0, time.UTC)
+	if !ok || !gotStart.Equal(wantStart) || !gotEnd.Equal(wantEnd) {
+		t.Errorf("Clamp() = %s..%s %v, want %s..%s", gotStart, gotEnd, ok, wantStart, wantEnd)
+	}
+
+	// A range entirely beyond the horizon is dropped
+	_, _, ok = Bounds(nil, intPtr(7), Window{}).Clamp(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), end, now)
+	if ok {
+		t.Error("expected a range past the horizon to be outside the window")
+	}
+}
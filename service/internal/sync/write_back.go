@@ -0,0 +1,206 @@
+package sync
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// DefaultWriteBackInterval is how often classified projects are written back
+const DefaultWriteBackInterval = 5 * time.Minute
+
+// WriteBackHistory is how far back write-back reaches, so turning it on
+// doesn't rewrite years of events
+const WriteBackHistory = 30 * 24 * time.Hour
+
+// writeBackBatchSize bounds the events written in one run
+const writeBackBatchSize = 500
+
+// WriteBackStore lists and records the events waiting for write-back
+type WriteBackStore interface {
+	ListPendingWriteBack(ctx context.Context, since time.Time, limit int) ([]*store.PendingWriteBack, error)
+	MarkWrittenBack(ctx context.Context, eventID uuid.UUID, projectID *uuid.UUID) error
+}
+
+// WriteBackConnections loads and refreshes the credentials write-back uses
+type WriteBackConnections interface {
+	GetByIDForSync(ctx context.Context, connID uuid.UUID) (*store.CalendarConnection, error)
+	UpdateCredentials(ctx context.Context, connID uuid.UUID, creds store.OAuthCredentials) error
+}
+
+// WriteBackReport summarizes one write-back run
+type WriteBackReport struct {
+	Pending int
+	Written int
+	Skipped int // Events Google refused permanently, such as on read-only calendars
+}
+
+// WriteBacker writes each event's classified project back to its Google
+// event, for connections that turned write-back on. Events are written when
+// their project changes, so the calendar itself shows what it was billed to.
+type WriteBacker struct {
+	events      WriteBackStore
+	connections WriteBackConnections
+	google      google.CalendarClient
+	interval    time.Duration
+	stopCh      chan struct{}
+	doneCh      chan struct{}
+}
+
+// NewWriteBacker creates a new write-backer
+func NewWriteBacker(events WriteBackStore, connections WriteBackConnections, googleSvc google.CalendarClient, interval time.Duration) *WriteBacker {
+	return &WriteBacker{
+		events:      events,
+		connections: connections,
+		google:      googleSvc,
+		interval:    interval,
+		stopCh:      make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+}
+
+// Start begins the write-back loop
+func (w *WriteBacker) Start(ctx context.Context) {
+	log.Printf("Starting calendar write-back (interval: %v)", w.interval)
+
+	go func() {
+		defer close(w.doneCh)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				w.run(ctx)
+			case <-w.stopCh:
+				log.Println("Calendar write-back stopped")
+				return
+			case <-ctx.Done():
+				log.Println("Calendar write-back context cancelled")
+				return
+			}
+		}
+	}()
+}
+
+// Stop gracefully stops the write-backer
+func (w *WriteBacker) Stop() {
+	close(w.stopCh)
+	<-w.doneCh
+}
+
+func (w *WriteBacker) run(ctx context.Context) {
+	report, err := w.RunOnce(ctx)
+	if err != nil {
+		log.Printf("[SYNC] write-back failed: %v", err)
+		return
+	}
+	if report.Pending > 0 {
+		log.Printf("[SYNC] write-back: wrote %d of %d events, %d refused",
+			report.Written, report.Pending, report.Skipped)
+	}
+}
+
+// RunOnce writes back one batch of pending events. A connection whose token
+// is rejected or whose quota is exhausted is left for the next run; events
+// Google refuses outright are marked written so they aren't retried.
+func (w *WriteBacker) RunOnce(ctx context.Context) (*WriteBackReport, error) {
+	pending, err := w.events.ListPendingWriteBack(ctx, time.Now().Add(-WriteBackHistory), writeBackBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &WriteBackReport{Pending: len(pending)}
+	var creds *store.OAuthCredentials
+	var current, blocked uuid.UUID
+	for _, p := range pending {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		if p.ConnectionID == blocked {
+			continue
+		}
+		if p.ConnectionID != current {
+			current = p.ConnectionID
+			creds, err = w.credentials(ctx, p.ConnectionID)
+			if err != nil {
+				log.Printf("[SYNC] write-back skipped: connection=%s error=%v", p.ConnectionID, err)
+				blocked = p.ConnectionID
+				continue
+			}
+			if creds == nil {
+				blocked = p.ConnectionID
+				continue
+			}
+		}
+
+		err := w.google.WriteEventProject(ctx, creds, p.CalendarExternalID, p.ExternalID, eventProject(p))
+		if err != nil {
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			switch ClassifyError(err) {
+			case store.SyncFailureAuth, store.SyncFailureQuota:
+				log.Printf("[SYNC] write-back paused: connection=%s error=%v", p.ConnectionID, err)
+				blocked = p.ConnectionID
+				continue
+			case store.SyncFailureTransient:
+				log.Printf("[SYNC] write-back failed: event=%s error=%v", p.EventID, err)
+				continue
+			}
+			log.Printf("[SYNC] write-back refused: event=%s error=%v", p.EventID, err)
+			report.Skipped++
+		} else {
+			report.Written++
+		}
+
+		if err := w.events.MarkWrittenBack(ctx, p.EventID, p.ProjectID); err != nil {
+			log.Printf("[SYNC] failed to record write-back: event=%s error=%v", p.EventID, err)
+		}
+	}
+
+	return report, nil
+}
+
+// credentials returns the connection's credentials, refreshed if they are
+// about to expire, or nil if the user hasn't granted write access
+func (w *WriteBacker) credentials(ctx context.Context, connID uuid.UUID) (*store.OAuthCredentials, error) {
+	conn, err := w.connections.GetByIDForSync(ctx, connID)
+	if err != nil {
+		return nil, err
+	}
+	if !google.HasAccess(conn.GrantedScopes, google.AccessCalendarWrite) {
+		return nil, nil
+	}
+
+	creds := &conn.Credentials
+	if time.Now().After(creds.Expiry.Add(-5 * time.Minute)) {
+		newCreds, err := w.google.RefreshToken(ctx, creds)
+		if err != nil {
+			return nil, err
+		}
+		creds = newCreds
+		w.connections.UpdateCredentials(ctx, conn.ID, *creds)
+	}
+	return creds, nil
+}
+
+// eventProject is what to write back for a pending event
+func eventProject(p *store.PendingWriteBack) google.EventProject {
+	project := google.EventProject{SetColor: p.Mode == store.WriteBackColor}
+	if p.ProjectID != nil {
+		project.ID = p.ProjectID.String()
+		if p.ProjectName != nil {
+			project.Name = *p.ProjectName
+		}
+		if p.ProjectColor != nil {
+			project.Color = *p.ProjectColor
+		}
+	}
+	return project
+}
@@ -0,0 +1,133 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/michaelw/timesheet-app/service/internal/google"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+	"google.golang.org/api/googleapi"
+)
+
+// fakeWriteBack is an in-memory WriteBackStore and WriteBackConnections
+type fakeWriteBack struct {
+	pending     []*store.PendingWriteBack
+	connections map[uuid.UUID]*store.CalendarConnection
+	written     map[uuid.UUID]*uuid.UUID
+}
+
+func (f *fakeWriteBack) ListPendingWriteBack(ctx context.Context, since time.Time, limit int) ([]*store.PendingWriteBack, error) {
+	return f.pending, nil
+}
+
+func (f *fakeWriteBack) MarkWrittenBack(ctx context.Context, eventID uuid.UUID, projectID *uuid.UUID) error {
+	f.written[eventID] = projectID
+	return nil
+}
+
+func (f *fakeWriteBack) GetByIDForSync(ctx context.Context, connID uuid.UUID) (*store.CalendarConnection, error) {
+	conn, ok := f.connections[connID]
+	if !ok {
+		return nil, store.ErrCalendarConnectionNotFound
+	}
+	return conn, nil
+}
+
+func (f *fakeWriteBack) UpdateCredentials(ctx context.Context, connID uuid.UUID, creds store.OAuthCredentials) error {
+	return nil
+}
+
+func newFakeWriteBack(t *testing.T) (*fakeWriteBack, *store.PendingWriteBack, *store.PendingWriteBack) {
+	t.Helper()
+	writable := &store.CalendarConnection{
+		ID:            uuid.New(),
+		GrantedScopes: google.AccessCalendarWrite.Scopes(),
+		Credentials:   store.OAuthCredentials{AccessToken: "token", Expiry: time.Now().Add(time.Hour)},
+	}
+	readOnly := &store.CalendarConnection{
+		ID:            uuid.New(),
+		GrantedScopes: google.AccessCalendar.Scopes(),
+		Credentials:   store.OAuthCredentials{AccessToken: "token", Expiry: time.Now().Add(time.Hour)},
+	}
+
+	projectID := uuid.New()
+	name, color := "Acme", "#dc2626"
+	colored := &store.PendingWriteBack{
+		EventID: uuid.New(), ConnectionID: writable.ID, ExternalID: "evt1", CalendarExternalID: "primary",
+		Mode: store.WriteBackColor, ProjectID: &projectID, ProjectName: &name, ProjectColor: &color,
+	}
+	unwritable := &store.PendingWriteBack{
+		EventID: uuid.New(), ConnectionID: readOnly.ID, ExternalID: "evt2", CalendarExternalID: "primary",
+		Mode: store.WriteBackProperties, ProjectID: &projectID,
+	}
+
+	f := &fakeWriteBack{
+		pending:     []*store.PendingWriteBack{colored, unwritable},
+		connections: map[uuid.UUID]*store.CalendarConnection{writable.ID: writable, readOnly.ID: readOnly},
+		written:     make(map[uuid.UUID]*uuid.UUID),
+	}
+	return f, colored, unwritable
+}
+
+func TestWriteBacker_RunOnce(t *testing.T) {
+	f, colored, unwritable := newFakeWriteBack(t)
+	mock := google.NewMockCalendarClient()
+
+	report, err := NewWriteBacker(f, f, mock, DefaultWriteBackInterval).RunOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RunOnce: %v", err)
+	}
+	if report.Pending != 2 || report.Written != 1 || report.Skipped != 0 {
+		t.Errorf("expected 1 of 2 written, got %+v", report)
+	}
+
+	if len(mock.WriteCalls) != 1 {
+		t.Fatalf("expected 1 write, got %d", len(mock.WriteCalls))
+	}
+	call := mock.WriteCalls[0]
+	want := google.EventProject{ID: colored.ProjectID.String(), Name: "Acme", Color: "#dc2626", SetColor: true}
+	if call.EventID != "evt1" || call.Project != want {
+		t.Errorf("unexpected write: %+v", call)
+	}
+
+	if got, ok := f.written[colored.EventID]; !ok || *got != *colored.ProjectID {
+		t.Errorf("expected the written project to be recorded, got %v", got)
+	}
+	if _, ok := f.written[unwritable.EventID]; ok {
+		t.Error("Expected the event without write access to stay pending")
+	}
+}
+
+func TestWriteBacker_RunOnce_Failures(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		marked     bool
+		wantReport WriteBackReport
+	}{
+		{"event deleted", &googleapi.Error{Code: 404}, true, WriteBackReport{Pending: 2, Skipped: 1}},
+		{"server error", &googleapi.Error{Code: 503}, false, WriteBackReport{Pending: 2}},
+		{"write access revoked", &googleapi.Error{Code: 401}, false, WriteBackReport{Pending: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, colored, _ := newFakeWriteBack(t)
+			mock := google.NewMockCalendarClient()
+			mock.WriteError = tt.err
+
+			report, err := NewWriteBacker(f, f, mock, DefaultWriteBackInterval).RunOnce(context.Background())
+			if err != nil {
+				t.Fatalf("RunOnce: %v", err)
+			}
+			if *report != tt.wantReport {
+				t.Errorf("expected %+v, got %+v", tt.wantReport, *report)
+			}
+			if _, ok := f.written[colored.EventID]; ok != tt.marked {
+				t.Errorf("expected marked=%v, got %v", tt.marked, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package timeentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/analyzer"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// ExclusionReason says why an event classified to an entry's project doesn't
+// count toward the entry
+type ExclusionReason string
+
+const (
+	// ExcludedSkipped is an event the user skipped
+	ExcludedSkipped ExclusionReason = "skipped"
+	// ExcludedNotAttended is an event an attendance rule skipped
+	ExcludedNotAttended ExclusionReason = "not_attended"
+	// ExcludedNoHours is an event of a project that doesn't accumulate hours
+	ExcludedNoHours ExclusionReason = "project_does_not_accumulate_hours"
+	// ExcludedNotYetCounted is an event classified since the entry was last
+	// computed
+	ExcludedNotYetCounted ExclusionReason = "not_yet_counted"
+)
+
+// ExcludedEvent is an event on the entry's day and project left out of its hours
+type ExcludedEvent struct {
+	ID        uuid.UUID
+	Title     string
+	StartTime time.Time
+	EndTime   time.Time
+	Reason    ExclusionReason
+}
+
+// Explanation is everything that went into an entry's hours
+type Explanation struct {
+	Entry         *store.TimeEntry
+	Details       analyzer.CalculationDetails
+	Contributions []analyzer.EventContribution
+	Excluded      []ExcludedEvent
+	// Narrative walks through the calculation one step per line
+	Narrative []string
+}
+
+// Explain gathers the calculation behind an entry, the events on its day
+// classified to its project that were left out, and how the entry is
+// protected from recalculation
+func (s *Service) Explain(ctx context.Context, userID uuid.UUID, entry *store.TimeEntry) (*Explanation, error) {
+	var details analyzer.CalculationDetails
+	if len(entry.CalculationDetails) > 0 {
+		if err := json.Unmarshal(entry.CalculationDetails, &details); err != nil {
+			details = analyzer.CalculationDetails{}
+		}
+	}
+
+	day := time.Date(entry.Date.Year(), entry.Date.Month(), entry.Date.Day(), 0, 0, 0, 0, time.UTC)
+	events, err := s.eventStore.List(ctx, userID, &day, &day, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	x := &Explanation{
+		Entry:         entry,
+		Details:       details,
+		Contributions: analyzer.Contributions(details),
+		Excluded:      excludedEvents(entry, details, events),
+	}
+	x.Narrative = narrate(x)
+	return x, nil
+}
+
+// excludedEvents lists the events classified to the entry's project that
+// aren't in its calculation, with the reason, in start order
+func excludedEvents(entry *store.TimeEntry, details analyzer.CalculationDetails, events []*store.CalendarEvent) []ExcludedEvent {
+	counted := make(map[string]bool, len(details.Events))
+	for _, d := range details.Events {
+		counted[d.ID] = true
+	}
+
+	var excluded []ExcludedEvent
+	for _, e := range events {
+		if e.ProjectID == nil || *e.ProjectID != entry.ProjectID || counted[e.ID.String()] {
+			continue
+		}
+		reason := ExcludedNotYetCounted
+		switch {
+		case e.IsSkipped && e.ClassificationSource != nil && *e.ClassificationSource == store.SourceManual:
+			reason = ExcludedSkipped
+		case e.IsSkipped:
+			reason = ExcludedNotAttended
+		case e.Project != nil && e.Project.DoesNotAccumulateHours:
+			reason = ExcludedNoHours
+		}
+		excluded = append(excluded, ExcludedEvent{
+			ID:        e.ID,
+			Title:     e.Title,
+			StartTime: e.StartTime,
+			EndTime:   e.EndTime,
+			Reason:    reason,
+		})
+	}
+	return excluded
+}
+
+// narrate describes the calculation in order: events, overlap merges,
+// rounding, exclusions, then protection
+func narrate(x *Explanation) []string {
+	entry, details := x.Entry, x.Details
+	var steps []string
+	add := func(format string, args ...any) {
+		steps = append(steps, fmt.Sprintf(format, args...))
+	}
+
+	if len(details.Events) == 0 {
+		add("The entry has no contributing events: it was entered by hand or its events have gone.")
+	} else {
+		add("%d event(s) on %s were classified to the project.", len(details.Events), entry.Date.Format("2006-01-02"))
+	}
+	for _, c := range x.Contributions {
+		switch {
+		case c.IsAllDay:
+			add("%q is an all-day event and adds no time.", c.Title)
+		case c.CountedMinutes == 0 && c.RawMinutes > 0:
+			add("%q (%dm) falls entirely within earlier events and adds no time.", c.Title, c.RawMinutes)
+		case c.OverlapMinutes > 0:
+			add("%q (%dm) overlaps earlier events by %dm, so %dm counts.", c.Title, c.RawMinutes, c.OverlapMinutes, c.CountedMinutes)
+		default:
+			add("%q counts in full: %dm.", c.Title, c.CountedMinutes)
+		}
+	}
+	if len(details.TimeRanges) > 0 {
+		add("Merged where they overlap or touch, the events cover %d range(s) totalling %dm.", len(details.TimeRanges), details.UnionMinutes)
+	}
+	if len(details.Events) > 0 {
+		if details.RoundingApplied == "" || details.RoundingApplied == "none" {
+			add("No rounding was needed: %dm is %.2fh.", details.FinalMinutes, float64(details.FinalMinutes)/60)
+		} else {
+			add("Rounding adjusted %dm by %s to %dm, %.2fh.", details.UnionMinutes, details.RoundingApplied, details.FinalMinutes, float64(details.FinalMinutes)/60)
+		}
+	}
+
+	for _, e := range x.Excluded {
+		switch e.Reason {
+		case ExcludedSkipped:
+			add("%q was skipped by hand and doesn't count.", e.Title)
+		case ExcludedNotAttended:
+			add("%q was skipped by an attendance rule and doesn't count.", e.Title)
+		case ExcludedNoHours:
+			add("%q doesn't count because the project doesn't accumulate hours.", e.Title)
+		case ExcludedNotYetCounted:
+			add("%q was classified after the entry was last computed; recalculate to include it.", e.Title)
+		}
+	}
+
+	if entry.ComputedHours != nil && *entry.ComputedHours != entry.Hours {
+		add("The entry's hours (%.2fh) differ from the computed %.2fh.", entry.Hours, *entry.ComputedHours)
+	}
+	if entry.InvoiceID != nil {
+		add("The entry is invoiced, so recalculation no longer changes its hours, title or description.")
+		if entry.IsStale {
+			add("Its events changed after invoicing; the entry is marked stale.")
+		}
+	}
+	if entry.HasUserEdits {
+		add("The entry was edited by hand, so it is kept, marked stale, if its events go away.")
+	}
+	if entry.IsSuppressed {
+		add("The entry is suppressed.")
+	}
+	if entry.DeletedAt != nil {
+		add("The entry is in the trash.")
+	}
+	return steps
+}
@@ -0,0 +1,95 @@
+package timeentry
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/analyzer"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+func TestExplain(t *testing.T) {
+	userID := uuid.New()
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, 3, 10, hour, minute, 0, 0, time.UTC)
+	}
+	manual, rule := store.SourceManual, store.SourceRule
+
+	standup := analyzer.Event{ID: uuid.New(), ProjectID: projectID, Title: "Standup", StartTime: at(9, 0), EndTime: at(9, 30)}
+	review := analyzer.Event{ID: uuid.New(), ProjectID: projectID, Title: "Review", StartTime: at(9, 15), EndTime: at(10, 7)}
+	computed := analyzer.Compute(date, []analyzer.Event{standup, review}, analyzer.DefaultRoundingConfig())
+	details, _ := json.Marshal(computed[0].CalculationDetails)
+
+	invoiceID := uuid.New()
+	entry := &store.TimeEntry{
+		ID:                 uuid.New(),
+		ProjectID:          projectID,
+		Date:               date,
+		Hours:              computed[0].Hours,
+		ComputedHours:      &computed[0].Hours,
+		InvoiceID:          &invoiceID,
+		CalculationDetails: details,
+	}
+
+	event := func(title string, project uuid.UUID, skipped bool, source *store.ClassificationSource) *store.CalendarEvent {
+		return &store.CalendarEvent{
+			ID:                   uuid.New(),
+			Title:                title,
+			StartTime:            at(11, 0),
+			EndTime:              at(12, 0),
+			ProjectID:            &project,
+			ClassificationStatus: store.StatusClassified,
+			ClassificationSource: source,
+			IsSkipped:            skipped,
+		}
+	}
+	events := &mockEventStore{events: []*store.CalendarEvent{
+		{ID: standup.ID, Title: "Standup", StartTime: standup.StartTime, EndTime: standup.EndTime, ProjectID: &projectID},
+		{ID: review.ID, Title: "Review", StartTime: review.StartTime, EndTime: review.EndTime, ProjectID: &projectID},
+		event("Offsite", projectID, true, &manual),
+		event("All hands", projectID, true, &rule),
+		event("Late sync", projectID, false, &rule),
+		event("Other client", otherProjectID, false, &rule),
+	}}
+
+	svc := &Service{eventStore: events}
+	x, err := svc.Explain(context.Background(), userID, entry)
+	if err != nil {
+		t.Fatalf("Explain: %v", err)
+	}
+
+	want := map[string]ExclusionReason{
+		"Offsite":   ExcludedSkipped,
+		"All hands": ExcludedNotAttended,
+		"Late sync": ExcludedNotYetCounted,
+	}
+	if len(x.Excluded) != len(want) {
+		t.Fatalf("expected %d excluded events, got %+v", len(want), x.Excluded)
+	}
+	for _, e := range x.Excluded {
+		if want[e.Title] != e.Reason {
+			t.Errorf("%s: expected reason %q, got %q", e.Title, want[e.Title], e.Reason)
+		}
+	}
+
+	narrative := strings.Join(x.Narrative, "\n")
+	for _, s := range []string{
+		`"Review" (52m) overlaps earlier events by 15m, so 37m counts.`,
+		"totalling 67m",
+		"Rounding adjusted 67m by +8m to 75m, 1.25h.",
+		`"All hands" was skipped by an attendance rule`,
+		"The entry is invoiced",
+	} {
+		if !strings.Contains(narrative, s) {
+			t.Errorf("narrative missing %q:\n%s", s, narrative)
+		}
+	}
+}
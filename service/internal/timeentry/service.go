@@ -24,6 +24,7 @@ type TimeEntryStore interface {
 	UpsertFromComputed(ctx context.Context, userID, projectID uuid.UUID, date time.Time, hours float64, title, description string, details []byte, eventIDs []uuid.UUID) (*store.TimeEntry, error)
 	UpdateComputed(ctx context.Context, userID uuid.UUID, entryID uuid.UUID, hours float64, title, description string, details []byte, eventIDs []uuid.UUID) error
 	Delete(ctx context.Context, userID, entryID uuid.UUID) error
+	Protect(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) (int64, error)
 }
 
 // Service orchestrates time entry computation and persistence.
@@ -45,6 +46,14 @@ func NewService(eventStore *store.CalendarEventStore, timeEntryStore *store.Time
 // RecalculateForDate recomputes all time entries for a specific date.
 // This is called after calendar sync or event classification changes.
 func (s *Service) RecalculateForDate(ctx context.Context, userID uuid.UUID, date time.Time) error {
+	_, _, err := s.recalculateDate(ctx, userID, date, nil)
+	return err
+}
+
+// recalculateDate recomputes the time entries for a date, limited to one
+// project when projectID is set. It returns how many entries were computed
+// and how many were cleared because their events are gone.
+func (s *Service) recalculateDate(ctx context.Context, userID uuid.UUID, date time.Time, projectID *uuid.UUID) (int, int, error) {
 	// Get all classified events for this date
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
 	endOfDay := startOfDay.AddDate(0, 0, 1)
@@ -53,13 +62,16 @@ func (s *Service) RecalculateForDate(ctx context.Context, userID uuid.UUID, date
 	classifiedStatus := store.StatusClassified
 	events, err := s.eventStore.List(ctx, userID, &startOfDay, &date, &classifiedStatus, nil)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
 	// Filter to only events that have a project assigned, are not skipped,
 	// and the project accumulates hours
 	var projectEvents []store.CalendarEvent
 	for _, e := range events {
+		if projectID != nil && (e.ProjectID == nil || *e.ProjectID != *projectID) {
+			continue
+		}
 		if e.ProjectID != nil && !e.IsSkipped && e.StartTime.Before(endOfDay) {
 			// Skip events from projects that don't accumulate hours
 			if e.Project != nil && e.Project.DoesNotAccumulateHours {
@@ -95,7 +107,7 @@ func (s *Service) RecalculateForDate(ctx context.Context, userID uuid.UUID, date
 
 		details, err := json.Marshal(c.CalculationDetails)
 		if err != nil {
-			return err
+			return 0, 0, err
 		}
 
 		_, err = s.timeEntryStore.UpsertFromComputed(
@@ -110,17 +122,18 @@ func (s *Service) RecalculateForDate(ctx context.Context, userID uuid.UUID, date
 			c.ContributingEvents,
 		)
 		if err != nil {
-			return err
+			return 0, 0, err
 		}
 	}
 
 	// Clean up entries for projects that no longer have classified events
 	// Get existing entries for this date
-	existingEntries, err := s.timeEntryStore.List(ctx, userID, &startOfDay, &startOfDay, nil)
+	existingEntries, err := s.timeEntryStore.List(ctx, userID, &startOfDay, &startOfDay, projectID)
 	if err != nil {
-		return err
+		return 0, 0, err
 	}
 
+	cleared := 0
 	for _, entry := range existingEntries {
 		// Skip if this project has computed entries
 		if computedProjects[entry.ProjectID] {
@@ -136,32 +149,76 @@ func (s *Service) RecalculateForDate(ctx context.Context, userID uuid.UUID, date
 				"union_minutes": 0,
 				"final_minutes": 0,
 			})
-			_ = s.timeEntryStore.UpdateComputed(ctx, userID, entry.ID, 0, "", "", emptyDetails, []uuid.UUID{})
+			if s.timeEntryStore.UpdateComputed(ctx, userID, entry.ID, 0, "", "", emptyDetails, []uuid.UUID{}) == nil {
+				cleared++
+			}
 			continue
 		}
 
 		// Delete unprotected entries that no longer have events
 		// This only happens for auto-created entries with no user modifications
-		_ = s.timeEntryStore.Delete(ctx, userID, entry.ID)
+		if s.timeEntryStore.Delete(ctx, userID, entry.ID) == nil {
+			cleared++
+		}
 	}
 
-	return nil
+	return len(computed), cleared, nil
 }
 
 // RecalculateForDateRange recomputes time entries for a range of dates.
 // Used after bulk operations like calendar sync.
 func (s *Service) RecalculateForDateRange(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) error {
+	_, err := s.RecalculateRange(ctx, userID, startDate, endDate, RecalculateOptions{})
+	return err
+}
+
+// RecalculateProgress is the running tally of a range recalculation
+type RecalculateProgress struct {
+	TotalDays       int
+	ProcessedDays   int
+	EntriesComputed int
+	EntriesCleared  int
+}
+
+// RecalculateOptions scopes a range recalculation and reports its progress
+type RecalculateOptions struct {
+	// ProjectID limits the recalculation to one project's entries
+	ProjectID *uuid.UUID
+	// OnDay, if set, is called with the tally after each day. An error
+	// stops the run.
+	OnDay func(RecalculateProgress) error
+}
+
+// RecalculateRange recomputes time entries day by day over a range, within
+// the options' scope. Recomputing is
+// idempotent, so a run that stops part way can simply be repeated. It
+// returns the tally so far, even on error.
+func (s *Service) RecalculateRange(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time, opts RecalculateOptions) (RecalculateProgress, error) {
 	current := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, time.UTC)
 	end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, time.UTC)
 
+	var progress RecalculateProgress
+	if !end.Before(current) {
+		progress.TotalDays = int(end.Sub(current).Hours()/24) + 1
+	}
+
 	for !current.After(end) {
-		if err := s.RecalculateForDate(ctx, userID, current); err != nil {
-			return err
+		computed, cleared, err := s.recalculateDate(ctx, userID, current, opts.ProjectID)
+		if err != nil {
+			return progress, err
+		}
+		progress.ProcessedDays++
+		progress.EntriesComputed += computed
+		progress.EntriesCleared += cleared
+		if opts.OnDay != nil {
+			if err := opts.OnDay(progress); err != nil {
+				return progress, err
+			}
 		}
 		current = current.AddDate(0, 0, 1)
 	}
 
-	return nil
+	return progress, nil
 }
 
 // RecalculateForEvent recomputes the time entry affected by a specific event.
@@ -497,6 +554,36 @@ func (s *Service) MaterializeForRange(ctx context.Context, userID uuid.UUID, pro
 	return resultIDs, nil
 }
 
+// Preserve stores the time entries computed from a date range's events and
+// protects every entry in the range as if the user had edited it, so the
+// entries keep their hours after the events are purged. Returns how many
+// entries were newly protected.
+func (s *Service) Preserve(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) (int64, error) {
+	materialized, err := s.timeEntryStore.List(ctx, userID, &startDate, &endDate, nil)
+	if err != nil {
+		return 0, err
+	}
+	existing := make(map[string]bool)
+	for _, e := range materialized {
+		existing[e.ProjectID.String()+"|"+e.Date.Format("2006-01-02")] = true
+	}
+
+	ephemeral, err := s.computeEphemeralForRange(ctx, userID, startDate, endDate, nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, eph := range ephemeral {
+		if existing[eph.ProjectID.String()+"|"+eph.Date.Format("2006-01-02")] {
+			continue
+		}
+		if _, err := s.materializeEntry(ctx, userID, eph); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.timeEntryStore.Protect(ctx, userID, startDate, endDate)
+}
+
 // materializeEntry creates a time entry in the database from computed values.
 // This is used when invoicing to ensure ephemeral entries have proper IDs.
 func (s *Service) materializeEntry(ctx context.Context, userID uuid.UUID, eph *store.TimeEntry) (*store.TimeEntry, error) {
@@ -38,6 +38,7 @@ type mockTimeEntryStore struct {
 	upsertedCount  int
 	deletedIDs     []uuid.UUID
 	updatedCompIDs []uuid.UUID
+	protectedIDs   []uuid.UUID
 }
 
 func (m *mockTimeEntryStore) List(ctx context.Context, userID uuid.UUID, startDate, endDate *time.Time, projectID *uuid.UUID) ([]*store.TimeEntry, error) {
@@ -103,6 +104,19 @@ func (m *mockTimeEntryStore) Delete(ctx context.Context, userID, entryID uuid.UU
 	return nil
 }
 
+func (m *mockTimeEntryStore) Protect(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) (int64, error) {
+	var protected int64
+	for _, e := range m.entries {
+		if e.Date.Before(startDate) || e.Date.After(endDate) || e.HasUserEdits || e.InvoiceID != nil {
+			continue
+		}
+		e.HasUserEdits = true
+		m.protectedIDs = append(m.protectedIDs, e.ID)
+		protected++
+	}
+	return protected, nil
+}
+
 func TestRecalculateForDate_ReclassifyEvent(t *testing.T) {
 	// Test scenario: Event reclassified from Project A to Project B
 	// Expected: Project A entry should be deleted, Project B entry should be created
@@ -381,3 +395,131 @@ func TestRecalculateForDate_MultipleProjects(t *testing.T) {
 		t.Errorf("Expected 2 upserted entries (B updated, C created), got %d", entryStore.upsertedCount)
 	}
 }
+
+func TestRecalculateRange_ProjectScope(t *testing.T) {
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	projectA := uuid.MustParse("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	projectB := uuid.MustParse("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+	staleBID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	eventStore := &mockEventStore{
+		events: []*store.CalendarEvent{
+			{
+				ID:                   uuid.New(),
+				UserID:               userID,
+				Title:                "Design",
+				StartTime:            start.Add(9 * time.Hour),
+				EndTime:              start.Add(10 * time.Hour),
+				ClassificationStatus: store.StatusClassified,
+				ProjectID:            &projectA,
+			},
+			{
+				ID:                   uuid.New(),
+				UserID:               userID,
+				Title:                "Review",
+				StartTime:            start.AddDate(0, 0, 1).Add(9 * time.Hour),
+				EndTime:              start.AddDate(0, 0, 1).Add(11 * time.Hour),
+				ClassificationStatus: store.StatusClassified,
+				ProjectID:            &projectB,
+			},
+		},
+	}
+
+	// Project B has an entry on the first day with no events behind it
+	entryStore := &mockTimeEntryStore{
+		entries: []*store.TimeEntry{
+			{
+				ID:        staleBID,
+				UserID:    userID,
+				ProjectID: projectB,
+				Date:      start,
+				Hours:     1.0,
+			},
+		},
+	}
+
+	svc := &Service{
+		eventStore:     eventStore,
+		timeEntryStore: entryStore,
+	}
+
+	var days []int
+	progress, err := svc.RecalculateRange(context.Background(), userID, start, start.AddDate(0, 0, 2), RecalculateOptions{
+		ProjectID: &projectA,
+		OnDay: func(p RecalculateProgress) error {
+			days = append(days, p.ProcessedDays)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("RecalculateRange() error = %v", err)
+	}
+
+	if progress.TotalDays != 3 || progress.ProcessedDays != 3 {
+		t.Errorf("Expected 3 of 3 days processed, got %d of %d", progress.ProcessedDays, progress.TotalDays)
+	}
+	if len(days) != 3 || days[2] != 3 {
+		t.Errorf("Expected progress after each of 3 days, got %v", days)
+	}
+
+	// Only Project A's entry is computed, and Project B's is left alone
+	if progress.EntriesComputed != 1 || entryStore.upsertedCount != 1 {
+		t.Errorf("Expected 1 computed entry, got %d (%d upserts)", progress.EntriesComputed, entryStore.upsertedCount)
+	}
+	if progress.EntriesCleared != 0 || len(entryStore.deletedIDs) != 0 {
+		t.Errorf("Expected Project B's entry to be left alone, got %d cleared", progress.EntriesCleared)
+	}
+}
+
+func TestPreserve(t *testing.T) {
+	// Test scenario: Events are about to be purged; one day already has a
+	// stored entry, the other is only computed
+	day1 := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	userID := uuid.New()
+	projectID := uuid.New()
+	storedID := uuid.New()
+
+	eventStore := &mockEventStore{
+		events: []*store.CalendarEvent{
+			{
+				ID: uuid.New(), UserID: userID, Title: "Planning",
+				StartTime: day1.Add(9 * time.Hour), EndTime: day1.Add(10 * time.Hour),
+				ClassificationStatus: store.StatusClassified, ProjectID: &projectID,
+			},
+			{
+				ID: uuid.New(), UserID: userID, Title: "Review",
+				StartTime: day2.Add(9 * time.Hour), EndTime: day2.Add(11 * time.Hour),
+				ClassificationStatus: store.StatusClassified, ProjectID: &projectID,
+			},
+		},
+	}
+	entryStore := &mockTimeEntryStore{
+		entries: []*store.TimeEntry{
+			{ID: storedID, UserID: userID, ProjectID: projectID, Date: day1, Hours: 1.5},
+		},
+	}
+
+	svc := &Service{eventStore: eventStore, timeEntryStore: entryStore}
+	protected, err := svc.Preserve(context.Background(), userID, day1, day2)
+	if err != nil {
+		t.Fatalf("Preserve() error = %v", err)
+	}
+
+	// Only the computed day is stored; the stored entry keeps its hours
+	if entryStore.upsertedCount != 1 {
+		t.Errorf("Expected 1 upserted entry, got %d", entryStore.upsertedCount)
+	}
+	if entryStore.entries[0].Hours != 1.5 {
+		t.Errorf("Expected the stored entry to keep 1.5h, got %v", entryStore.entries[0].Hours)
+	}
+	if protected != 2 {
+		t.Errorf("Expected 2 protected entries, got %d", protected)
+	}
+	for _, e := range entryStore.entries {
+		if !e.HasUserEdits {
+			t.Errorf("Expected entry for %s to be protected", e.Date.Format("2006-01-02"))
+		}
+	}
+}
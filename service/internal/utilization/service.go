@@ -0,0 +1,112 @@
+package utilization
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/michaelw/timesheet-app/service/internal/aggregate"
+	"github.com/michaelw/timesheet-app/service/internal/store"
+)
+
+// Service loads daily project totals and the working-hours profile to build
+// utilization reports
+type Service struct {
+	aggregates   *aggregate.Service
+	projects     *store.ProjectStore
+	workingHours *store.WorkingHoursStore
+	expenses     *store.ExpenseStore
+	tags         *store.TagStore
+}
+
+// NewService creates a new utilization service
+func NewService(aggregates *aggregate.Service, projects *store.ProjectStore, workingHours *store.WorkingHoursStore, expenses *store.ExpenseStore, tags *store.TagStore) *Service {
+	return &Service{
+		aggregates:   aggregates,
+		projects:     projects,
+		workingHours: workingHours,
+		expenses:     expenses,
+		tags:         tags,
+	}
+}
+
+// WorkingHours returns the user's working-hours profile, the capacity
+// utilization is measured against
+func (s *Service) WorkingHours(ctx context.Context, userID uuid.UUID) (*store.WorkingHours, error) {
+	return s.workingHours.Get(ctx, userID)
+}
+
+// Report computes utilization for the inclusive date range from the daily
+// project totals, which count both materialized and computed time entries. Projects that don't accumulate
+// hours are left out of the hours but still report their expenses. The tag
+// breakdown covers saved entries, since computed entries have no tags.
+func (s *Service) Report(ctx context.Context, userID uuid.UUID, start, end time.Time) (*Report, error) {
+	profile, err := s.workingHours.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projects.List(ctx, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	projectMap := make(map[uuid.UUID]*store.Project, len(projects))
+	for _, p := range projects {
+		projectMap[p.ID] = p
+	}
+
+	totals, err := s.aggregates.DailyHours(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(totals))
+	for _, t := range totals {
+		p, ok := projectMap[t.ProjectID]
+		if !ok || p.DoesNotAccumulateHours {
+			continue
+		}
+		entries = append(entries, Entry{
+			ProjectID:   p.ID,
+			ProjectName: p.Name,
+			IsBillable:  p.IsBillable,
+			Date:        t.Date,
+			Hours:       t.Hours,
+		})
+	}
+
+	report := Compute(profile.DailyHours, entries, start, end)
+
+	expenseList, err := s.expenses.List(ctx, userID, store.ExpenseFilter{StartDate: &start, EndDate: &end})
+	if err != nil {
+		return nil, err
+	}
+	expenses := make([]Expense, 0, len(expenseList))
+	for _, e := range expenseList {
+		p, ok := projectMap[e.ProjectID]
+		if !ok {
+			continue
+		}
+		expenses = append(expenses, Expense{
+			ProjectID:   p.ID,
+			ProjectName: p.Name,
+			IsBillable:  p.IsBillable,
+			Amount:      e.Amount,
+			Billable:    e.IsBillable,
+		})
+	}
+	report.AddExpenses(expenses)
+
+	tagHours, err := s.tags.HoursByTag(ctx, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	byTag := make(map[string]float64, len(tagHours))
+	for _, th := range tagHours {
+		byTag[th.Tag] = th.Hours
+	}
+	report.AddTags(byTag)
+
+	return report, nil
+}
@@ -0,0 +1,219 @@
+// Package utilization computes billable utilization against a working-hours
+// capacity profile.
+package utilization
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entry is one day of hours on one project
+type Entry struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+	IsBillable  bool
+	Date        time.Time
+	Hours       float64
+}
+
+// Expense is one expense on one project
+type Expense struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+	IsBillable  bool // Whether the project is billable
+	Amount      float64
+	Billable    bool // Whether the expense itself is billable
+}
+
+// Week is utilization for one Monday-start week, limited to the report range
+type Week struct {
+	WeekStart     time.Time
+	CapacityHours float64
+	BillableHours float64
+	TotalHours    float64
+	Utilization   float64 // Billable hours / capacity; 0 when there is no capacity
+}
+
+// ProjectShare is a project's portion of the hours in the report
+type ProjectShare struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+	IsBillable  bool
+	Hours       float64
+	Share       float64 // Project hours / total hours
+
+	Expenses         float64 // Total of the project's expenses in the range
+	BillableExpenses float64 // Portion of Expenses that is billable
+}
+
+// TagShare is the hours on saved time entries carrying a tag. An entry with
+// several tags counts toward each, so shares can add up to more than 1.
+type TagShare struct {
+	Tag   string
+	Hours float64
+	Share float64 // Tag hours / total hours
+}
+
+// AudienceSplit divides counted event time between internal events and
+// client-facing ones, which have an attendee outside the company domains
+type AudienceSplit struct {
+	InternalHours     float64
+	ClientFacingHours float64
+	ClientFacingShare float64 // Client-facing hours / both
+}
+
+// Report summarizes utilization over a date range
+type Report struct {
+	StartDate     time.Time
+	EndDate       time.Time
+	CapacityHours float64
+	BillableHours float64
+	TotalHours    float64
+	Utilization   float64
+	Weeks         []Week
+	Projects      []ProjectShare
+	Tags          []TagShare
+	Audience      *AudienceSplit // Nil until the user declares company domains
+}
+
+// Compute builds a report for the inclusive range [start, end]. dailyHours is
+// the capacity for each weekday, Monday first. Entries outside the range are
+// ignored.
+func Compute(dailyHours [7]float64, entries []Entry, start, end time.Time) *Report {
+	start = truncateDay(start)
+	end = truncateDay(end)
+
+	report := &Report{StartDate: start, EndDate: end}
+	if end.Before(start) {
+		return report
+	}
+
+	weekIndex := make(map[time.Time]int)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		ws := weekStart(day)
+		i, ok := weekIndex[ws]
+		if !ok {
+			i = len(report.Weeks)
+			weekIndex[ws] = i
+			report.Weeks = append(report.Weeks, Week{WeekStart: ws})
+		}
+		capacity := dailyHours[(int(day.Weekday())+6)%7]
+		report.Weeks[i].CapacityHours += capacity
+		report.CapacityHours += capacity
+	}
+
+	projectIndex := make(map[uuid.UUID]int)
+	for _, e := range entries {
+		day := truncateDay(e.Date)
+		if day.Before(start) || day.After(end) {
+			continue
+		}
+		w := &report.Weeks[weekIndex[weekStart(day)]]
+		w.TotalHours += e.Hours
+		report.TotalHours += e.Hours
+		if e.IsBillable {
+			w.BillableHours += e.Hours
+			report.BillableHours += e.Hours
+		}
+
+		i, ok := projectIndex[e.ProjectID]
+		if !ok {
+			i = len(report.Projects)
+			projectIndex[e.ProjectID] = i
+			report.Projects = append(report.Projects, ProjectShare{
+				ProjectID:   e.ProjectID,
+				ProjectName: e.ProjectName,
+				IsBillable:  e.IsBillable,
+			})
+		}
+		report.Projects[i].Hours += e.Hours
+	}
+
+	for i := range report.Weeks {
+		w := &report.Weeks[i]
+		w.Utilization = ratio(w.BillableHours, w.CapacityHours)
+	}
+	report.Utilization = ratio(report.BillableHours, report.CapacityHours)
+
+	for i := range report.Projects {
+		report.Projects[i].Share = ratio(report.Projects[i].Hours, report.TotalHours)
+	}
+	sort.SliceStable(report.Projects, func(i, j int) bool {
+		return report.Projects[i].Hours > report.Projects[j].Hours
+	})
+
+	return report
+}
+
+// AddExpenses totals expenses onto the report's projects. Projects with
+// expenses but no hours are appended after the ones with hours.
+func (r *Report) AddExpenses(expenses []Expense) {
+	projectIndex := make(map[uuid.UUID]int, len(r.Projects))
+	for i, p := range r.Projects {
+		projectIndex[p.ProjectID] = i
+	}
+
+	for _, e := range expenses {
+		i, ok := projectIndex[e.ProjectID]
+		if !ok {
+			i = len(r.Projects)
+			projectIndex[e.ProjectID] = i
+			r.Projects = append(r.Projects, ProjectShare{
+				ProjectID:   e.ProjectID,
+				ProjectName: e.ProjectName,
+				IsBillable:  e.IsBillable,
+			})
+		}
+		r.Projects[i].Expenses += e.Amount
+		if e.Billable {
+			r.Projects[i].BillableExpenses += e.Amount
+		}
+	}
+}
+
+// AddTags sets the report's tag breakdown from the hours per tag, most hours
+// first
+func (r *Report) AddTags(hours map[string]float64) {
+	r.Tags = make([]TagShare, 0, len(hours))
+	for tag, h := range hours {
+		r.Tags = append(r.Tags, TagShare{
+			Tag:   tag,
+			Hours: h,
+			Share: ratio(h, r.TotalHours),
+		})
+	}
+	sort.Slice(r.Tags, func(i, j int) bool {
+		if r.Tags[i].Hours != r.Tags[j].Hours {
+			return r.Tags[i].Hours > r.Tags[j].Hours
+		}
+		return r.Tags[i].Tag < r.Tags[j].Tag
+	})
+}
+
+// AddAudience sets the report's internal vs client-facing split from event
+// hours, which may differ from the entry hours where events overlap
+func (r *Report) AddAudience(internal, clientFacing float64) {
+	r.Audience = &AudienceSplit{
+		InternalHours:     internal,
+		ClientFacingHours: clientFacing,
+		ClientFacingShare: ratio(clientFacing, internal+clientFacing),
+	}
+}
+
+func ratio(num, den float64) float64 {
+	if den <= 0 {
+		return 0
+	}
+	return num / den
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weekStart returns the Monday of the week containing day
+func weekStart(day time.Time) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
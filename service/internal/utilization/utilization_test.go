@@ -0,0 +1,156 @@
+package utilization
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestCompute_WeeklyUtilization(t *testing.T) {
+	billable := uuid.New()
+	internal := uuid.New()
+	profile := [7]float64{8, 8, 8, 8, 8, 0, 0}
+
+	entries := []Entry{
+		{ProjectID: billable, ProjectName: "Acme", IsBillable: true, Date: date("2025-07-07"), Hours: 6},
+		{ProjectID: internal, ProjectName: "Admin", Date: date("2025-07-07"), Hours: 2},
+		{ProjectID: billable, ProjectName: "Acme", IsBillable: true, Date: date("2025-07-15"), Hours: 8},
+		// Outside the range
+		{ProjectID: billable, ProjectName: "Acme", IsBillable: true, Date: date("2025-07-30"), Hours: 8},
+	}
+
+	// Monday 7 July through Sunday 20 July: two full weeks
+	r := Compute(profile, entries, date("2025-07-07"), date("2025-07-20"))
+
+	if len(r.Weeks) != 2 {
+		t.Fatalf("expected 2 weeks, got %d", len(r.Weeks))
+	}
+	if !approx(r.CapacityHours, 80) {
+		t.Errorf("capacity = %v, want 80", r.CapacityHours)
+	}
+	if !approx(r.BillableHours, 14) || !approx(r.TotalHours, 16) {
+		t.Errorf("billable/total = %v/%v, want 14/16", r.BillableHours, r.TotalHours)
+	}
+	if !approx(r.Utilization, 14.0/80) {
+		t.Errorf("utilization = %v, want %v", r.Utilization, 14.0/80)
+	}
+	if !approx(r.Weeks[0].Utilization, 6.0/40) || !approx(r.Weeks[1].Utilization, 8.0/40) {
+		t.Errorf("weekly utilization = %v, %v", r.Weeks[0].Utilization, r.Weeks[1].Utilization)
+	}
+
+	if len(r.Projects) != 2 || r.Projects[0].ProjectID != billable {
+		t.Fatalf("expected billable project first, got %+v", r.Projects)
+	}
+	if !approx(r.Projects[0].Share, 14.0/16) || !approx(r.Projects[1].Share, 2.0/16) {
+		t.Errorf("shares = %v, %v", r.Projects[0].Share, r.Projects[1].Share)
+	}
+}
+
+func TestCompute_PartialWeeksCountOnlyDaysInRange(t *testing.T) {
+	profile := [7]float64{8, 8, 8, 8, 8, 0, 0}
+
+	// Thursday 3 July through Tuesday 8 July
+	r := Compute(profile, nil, date("2025-07-03"), date("2025-07-08"))
+
+	if len(r.Weeks) != 2 {
+		t.Fatalf("expected 2 weeks, got %d", len(r.Weeks))
+	}
+	if !r.Weeks[0].WeekStart.Equal(date("2025-06-30")) {
+		t.Errorf("first week starts %v, want 2025-06-30", r.Weeks[0].WeekStart)
+	}
+	if !approx(r.Weeks[0].CapacityHours, 16) || !approx(r.Weeks[1].CapacityHours, 16) {
+		t.Errorf("capacity = %v, %v, want 16, 16", r.Weeks[0].CapacityHours, r.Weeks[1].CapacityHours)
+	}
+	if r.Utilization != 0 {
+		t.Errorf("utilization with no entries = %v, want 0", r.Utilization)
+	}
+}
+
+func TestCompute_NoCapacity(t *testing.T) {
+	id := uuid.New()
+	r := Compute([7]float64{}, []Entry{{ProjectID: id, IsBillable: true, Date: date("2025-07-05"), Hours: 3}},
+		date("2025-07-05"), date("2025-07-06"))
+	if r.Utilization != 0 || r.Weeks[0].Utilization != 0 {
+		t.Errorf("expected zero utilization without capacity, got %v", r.Utilization)
+	}
+	if !approx(r.BillableHours, 3) {
+		t.Errorf("billable = %v, want 3", r.BillableHours)
+	}
+}
+
+func TestReport_AddExpenses(t *testing.T) {
+	acme := uuid.New()
+	travel := uuid.New()
+	profile := [7]float64{8, 8, 8, 8, 8, 0, 0}
+
+	entries := []Entry{
+		{ProjectID: acme, ProjectName: "Acme", IsBillable: true, Date: date("2025-07-07"), Hours: 4},
+	}
+	r := Compute(profile, entries, date("2025-07-07"), date("2025-07-13"))
+	r.AddExpenses([]Expense{
+		{ProjectID: acme, ProjectName: "Acme", IsBillable: true, Amount: 120, Billable: true},
+		{ProjectID: acme, ProjectName: "Acme", IsBillable: true, Amount: 30},
+		{ProjectID: travel, ProjectName: "Travel", Amount: 55},
+	})
+
+	if len(r.Projects) != 2 {
+		t.Fatalf("expected 2 projects, got %d", len(r.Projects))
+	}
+	if p := r.Projects[0]; p.ProjectID != acme || !approx(p.Expenses, 150) || !approx(p.BillableExpenses, 120) {
+		t.Errorf("acme = %+v, want expenses 150 with 120 billable", p)
+	}
+	// Expense-only projects follow the projects with hours and don't change the shares
+	if p := r.Projects[1]; p.ProjectID != travel || p.Hours != 0 || p.Share != 0 || !approx(p.Expenses, 55) || p.BillableExpenses != 0 {
+		t.Errorf("travel = %+v, want 55 non-billable expenses and no hours", p)
+	}
+	if !approx(r.Projects[0].Share, 1) {
+		t.Errorf("acme share = %v, want 1", r.Projects[0].Share)
+	}
+}
+
+func TestReport_AddTags(t *testing.T) {
+	acme := uuid.New()
+	profile := [7]float64{8, 8, 8, 8, 8, 0, 0}
+
+	entries := []Entry{
+		{ProjectID: acme, ProjectName: "Acme", IsBillable: true, Date: date("2025-07-07"), Hours: 8},
+	}
+	r := Compute(profile, entries, date("2025-07-07"), date("2025-07-13"))
+	r.AddTags(map[string]float64{"travel": 2, "deep-work": 6, "billable-dispute": 2})
+
+	if len(r.Tags) != 3 {
+		t.Fatalf("expected 3 tags, got %d", len(r.Tags))
+	}
+	// Most hours first, ties by name
+	want := []string{"deep-work", "billable-dispute", "travel"}
+	for i, tag := range want {
+		if r.Tags[i].Tag != tag {
+			t.Errorf("tags[%d] = %q, want %q", i, r.Tags[i].Tag, tag)
+		}
+	}
+	if !approx(r.Tags[0].Share, 0.75) || !approx(r.Tags[2].Share, 0.25) {
+		t.Errorf("shares = %v, %v, want 0.75 and 0.25", r.Tags[0].Share, r.Tags[2].Share)
+	}
+}
+
+func TestReport_AddAudience(t *testing.T) {
+	r := Compute([7]float64{8, 8, 8, 8, 8, 0, 0}, nil, date("2025-07-07"), date("2025-07-13"))
+	if r.Audience != nil {
+		t.Fatalf("expected no audience split before one is added")
+	}
+	r.AddAudience(6, 2)
+	if !approx(r.Audience.ClientFacingShare, 0.25) {
+		t.Errorf("client-facing share = %v, want 0.25", r.Audience.ClientFacingShare)
+	}
+}
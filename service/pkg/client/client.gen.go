@@ -0,0 +1,29545 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for CalendarSyncFailureCategory.
+const (
+	Auth      CalendarSyncFailureCategory = "auth"
+	Permanent CalendarSyncFailureCategory = "permanent"
+	Quota     CalendarSyncFailureCategory = "quota"
+	Transient CalendarSyncFailureCategory = "transient"
+)
+
+// Defines values for CalendarConnectionProvider.
+const (
+	Google          CalendarConnectionProvider = "google"
+	GoogleWorkspace CalendarConnectionProvider = "google_workspace"
+)
+
+// Defines values for CalendarEventClassificationSource.
+const (
+	CalendarEventClassificationSourceFingerprint CalendarEventClassificationSource = "fingerprint"
+	CalendarEventClassificationSourceLlm         CalendarEventClassificationSource = "llm"
+	CalendarEventClassificationSourceManual      CalendarEventClassificationSource = "manual"
+	CalendarEventClassificationSourceRule        CalendarEventClassificationSource = "rule"
+)
+
+// Defines values for CalendarEventClassificationStatus.
+const (
+	CalendarEventClassificationStatusClassified CalendarEventClassificationStatus = "classified"
+	CalendarEventClassificationStatusPending    CalendarEventClassificationStatus = "pending"
+)
+
+// Defines values for CalendarEventDiffItemKind.
+const (
+	Added     CalendarEventDiffItemKind = "added"
+	Cancelled CalendarEventDiffItemKind = "cancelled"
+	Changed   CalendarEventDiffItemKind = "changed"
+	Moved     CalendarEventDiffItemKind = "moved"
+)
+
+// Defines values for CalendarWriteBackMode.
+const (
+	Color      CalendarWriteBackMode = "color"
+	Off        CalendarWriteBackMode = "off"
+	Properties CalendarWriteBackMode = "properties"
+)
+
+// Defines values for ClassificationActionKind.
+const (
+	ApplyRules   ClassificationActionKind = "apply_rules"
+	BulkClassify ClassificationActionKind = "bulk_classify"
+	Classify     ClassificationActionKind = "classify"
+)
+
+// Defines values for ClassificationJobStatus.
+const (
+	ClassificationJobStatusCompleted ClassificationJobStatus = "completed"
+	ClassificationJobStatusFailed    ClassificationJobStatus = "failed"
+	ClassificationJobStatusPending   ClassificationJobStatus = "pending"
+	ClassificationJobStatusRunning   ClassificationJobStatus = "running"
+)
+
+// Defines values for DayAnomalyKind.
+const (
+	ExcessiveHours DayAnomalyKind = "excessive_hours"
+	MissingHours   DayAnomalyKind = "missing_hours"
+	UnusualTotal   DayAnomalyKind = "unusual_total"
+)
+
+// Defines values for DelegationProvisionMemberStatus.
+const (
+	DelegationProvisionMemberStatusFailed      DelegationProvisionMemberStatus = "failed"
+	DelegationProvisionMemberStatusProvisioned DelegationProvisionMemberStatus = "provisioned"
+	DelegationProvisionMemberStatusSkipped     DelegationProvisionMemberStatus = "skipped"
+)
+
+// Defines values for EventClassificationHistoryEntrySource.
+const (
+	EventClassificationHistoryEntrySourceFingerprint EventClassificationHistoryEntrySource = "fingerprint"
+	EventClassificationHistoryEntrySourceLlm         EventClassificationHistoryEntrySource = "llm"
+	EventClassificationHistoryEntrySourceManual      EventClassificationHistoryEntrySource = "manual"
+	EventClassificationHistoryEntrySourceRule        EventClassificationHistoryEntrySource = "rule"
+)
+
+// Defines values for EventClassificationVoteSource.
+const (
+	EventClassificationVoteSourceFingerprint EventClassificationVoteSource = "fingerprint"
+	EventClassificationVoteSourceRule        EventClassificationVoteSource = "rule"
+)
+
+// Defines values for FingerprintKind.
+const (
+	Domain  FingerprintKind = "domain"
+	Email   FingerprintKind = "email"
+	Keyword FingerprintKind = "keyword"
+)
+
+// Defines values for GoogleAccess.
+const (
+	GoogleAccessCalendar      GoogleAccess = "calendar"
+	GoogleAccessCalendarWrite GoogleAccess = "calendar_write"
+	GoogleAccessSheets        GoogleAccess = "sheets"
+)
+
+// Defines values for InvoiceKind.
+const (
+	InvoiceKindCreditNote InvoiceKind = "credit_note"
+	InvoiceKindInvoice    InvoiceKind = "invoice"
+)
+
+// Defines values for InvoiceStatus.
+const (
+	InvoiceStatusDraft  InvoiceStatus = "draft"
+	InvoiceStatusPaid   InvoiceStatus = "paid"
+	InvoiceStatusReview InvoiceStatus = "review"
+	InvoiceStatusSent   InvoiceStatus = "sent"
+)
+
+// Defines values for InvoiceLineItemKind.
+const (
+	InvoiceLineItemKindAdjustment InvoiceLineItemKind = "adjustment"
+	InvoiceLineItemKindExpense    InvoiceLineItemKind = "expense"
+	InvoiceLineItemKindFee        InvoiceLineItemKind = "fee"
+	InvoiceLineItemKindOverage    InvoiceLineItemKind = "overage"
+	InvoiceLineItemKindTime       InvoiceLineItemKind = "time"
+)
+
+// Defines values for InvoiceLineItemRateSource.
+const (
+	InvoiceLineItemRateSourceClient  InvoiceLineItemRateSource = "client"
+	InvoiceLineItemRateSourceNone    InvoiceLineItemRateSource = "none"
+	InvoiceLineItemRateSourceProject InvoiceLineItemRateSource = "project"
+)
+
+// Defines values for MemberDelegationInputCalendars.
+const (
+	MemberDelegationInputCalendarsAll     MemberDelegationInputCalendars = "all"
+	MemberDelegationInputCalendarsNone    MemberDelegationInputCalendars = "none"
+	MemberDelegationInputCalendarsPrimary MemberDelegationInputCalendars = "primary"
+)
+
+// Defines values for OrganizationRole.
+const (
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleMember OrganizationRole = "member"
+	OrganizationRoleOwner  OrganizationRole = "owner"
+)
+
+// Defines values for OrganizationDelegationDefaultCalendars.
+const (
+	OrganizationDelegationDefaultCalendarsAll     OrganizationDelegationDefaultCalendars = "all"
+	OrganizationDelegationDefaultCalendarsNone    OrganizationDelegationDefaultCalendars = "none"
+	OrganizationDelegationDefaultCalendarsPrimary OrganizationDelegationDefaultCalendars = "primary"
+)
+
+// Defines values for OrganizationDelegationInputDefaultCalendars.
+const (
+	OrganizationDelegationInputDefaultCalendarsAll     OrganizationDelegationInputDefaultCalendars = "all"
+	OrganizationDelegationInputDefaultCalendarsNone    OrganizationDelegationInputDefaultCalendars = "none"
+	OrganizationDelegationInputDefaultCalendarsPrimary OrganizationDelegationInputDefaultCalendars = "primary"
+)
+
+// Defines values for OrganizationMemberDelegationCalendars.
+const (
+	All     OrganizationMemberDelegationCalendars = "all"
+	None    OrganizationMemberDelegationCalendars = "none"
+	Primary OrganizationMemberDelegationCalendars = "primary"
+)
+
+// Defines values for OrganizationMemberRole.
+const (
+	OrganizationMemberRoleAdmin  OrganizationMemberRole = "admin"
+	OrganizationMemberRoleMember OrganizationMemberRole = "member"
+	OrganizationMemberRoleOwner  OrganizationMemberRole = "owner"
+)
+
+// Defines values for ReconcileRequestResolution.
+const (
+	AcceptComputed ReconcileRequestResolution = "accept_computed"
+	KeepManual     ReconcileRequestResolution = "keep_manual"
+)
+
+// Defines values for ReviewDecisionDecision.
+const (
+	Accept   ReviewDecisionDecision = "accept"
+	Override ReviewDecisionDecision = "override"
+)
+
+// Defines values for RuleEvaluationSource.
+const (
+	RuleEvaluationSourceFingerprint RuleEvaluationSource = "fingerprint"
+	RuleEvaluationSourceRule        RuleEvaluationSource = "rule"
+)
+
+// Defines values for RuleLintIssueKind.
+const (
+	AlwaysFalse     RuleLintIssueKind = "always_false"
+	AlwaysTrue      RuleLintIssueKind = "always_true"
+	Conflict        RuleLintIssueKind = "conflict"
+	Duplicate       RuleLintIssueKind = "duplicate"
+	Redundant       RuleLintIssueKind = "redundant"
+	SyntaxError     RuleLintIssueKind = "syntax_error"
+	UnknownProperty RuleLintIssueKind = "unknown_property"
+)
+
+// Defines values for RuleLintIssueSeverity.
+const (
+	RuleLintIssueSeverityError   RuleLintIssueSeverity = "error"
+	RuleLintIssueSeverityWarning RuleLintIssueSeverity = "warning"
+)
+
+// Defines values for TimeEntrySource.
+const (
+	TimeEntrySourceCalendar TimeEntrySource = "calendar"
+	TimeEntrySourceImport   TimeEntrySource = "import"
+	TimeEntrySourceManual   TimeEntrySource = "manual"
+)
+
+// Defines values for TimeEntryExcludedEventReason.
+const (
+	TimeEntryExcludedEventReasonNotAttended                   TimeEntryExcludedEventReason = "not_attended"
+	TimeEntryExcludedEventReasonNotYetCounted                 TimeEntryExcludedEventReason = "not_yet_counted"
+	TimeEntryExcludedEventReasonProjectDoesNotAccumulateHours TimeEntryExcludedEventReason = "project_does_not_accumulate_hours"
+	TimeEntryExcludedEventReasonSkipped                       TimeEntryExcludedEventReason = "skipped"
+)
+
+// Defines values for ListCalendarEventsParamsClassificationStatus.
+const (
+	Classified ListCalendarEventsParamsClassificationStatus = "classified"
+	Pending    ListCalendarEventsParamsClassificationStatus = "pending"
+	Skipped    ListCalendarEventsParamsClassificationStatus = "skipped"
+)
+
+// Defines values for ListInvoicesParamsStatus.
+const (
+	ListInvoicesParamsStatusDraft  ListInvoicesParamsStatus = "draft"
+	ListInvoicesParamsStatusPaid   ListInvoicesParamsStatus = "paid"
+	ListInvoicesParamsStatusReview ListInvoicesParamsStatus = "review"
+	ListInvoicesParamsStatusSent   ListInvoicesParamsStatus = "sent"
+)
+
+// Defines values for ExportInvoiceParamsFormat.
+const (
+	Csv    ExportInvoiceParamsFormat = "csv"
+	Pdf    ExportInvoiceParamsFormat = "pdf"
+	Sheets ExportInvoiceParamsFormat = "sheets"
+)
+
+// Defines values for UpdateInvoiceStatusJSONBodyStatus.
+const (
+	Draft  UpdateInvoiceStatusJSONBodyStatus = "draft"
+	Paid   UpdateInvoiceStatusJSONBodyStatus = "paid"
+	Review UpdateInvoiceStatusJSONBodyStatus = "review"
+	Sent   UpdateInvoiceStatusJSONBodyStatus = "sent"
+)
+
+// AnalyzeAnomaliesRequest defines model for AnalyzeAnomaliesRequest.
+type AnalyzeAnomaliesRequest struct {
+	// EndDate Defaults to yesterday
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	// AllowedTools MCP tools this key may call. Empty allows every tool.
+	AllowedTools []string  `json:"allowed_tools"`
+	CreatedAt    time.Time `json:"created_at"`
+
+	// DeniedTools MCP tools this key may never call, even if allowed_tools lists them
+	DeniedTools []string           `json:"denied_tools"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// KeyPrefix First few characters of the key for identification
+	KeyPrefix  string     `json:"key_prefix"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+
+	// Name User-provided name for this key
+	Name string `json:"name"`
+
+	// RequireConfirmation Whether destructive MCP tools (bulk_classify, apply_rules) called with
+	// this key first return a preview and a confirmation token, and only
+	// execute when called again with the token
+	RequireConfirmation bool               `json:"require_confirmation"`
+	UserId              openapi_types.UUID `json:"user_id"`
+}
+
+// ApiKeyCreate defines model for ApiKeyCreate.
+type ApiKeyCreate struct {
+	// AllowedTools MCP tools this key may call. Empty allows every tool.
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// DeniedTools MCP tools this key may never call, even if allowed_tools lists them
+	DeniedTools *[]string `json:"denied_tools,omitempty"`
+
+	// Name A memorable name for this key
+	Name string `json:"name"`
+
+	// RequireConfirmation Whether destructive MCP tools (bulk_classify, apply_rules) called with
+	// this key first return a preview and a confirmation token, and only
+	// execute when called again with the token
+	RequireConfirmation *bool `json:"require_confirmation,omitempty"`
+}
+
+// ApiKeyUpdate Settings to change; omitted fields are left as they are
+type ApiKeyUpdate struct {
+	// AllowedTools MCP tools this key may call. Empty allows every tool.
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// DeniedTools MCP tools this key may never call, even if allowed_tools lists them
+	DeniedTools *[]string `json:"denied_tools,omitempty"`
+
+	// RequireConfirmation Whether destructive MCP tools (bulk_classify, apply_rules) called with
+	// this key first return a preview and a confirmation token, and only
+	// execute when called again with the token
+	RequireConfirmation *bool `json:"require_confirmation,omitempty"`
+}
+
+// ApiKeyWithSecret defines model for ApiKeyWithSecret.
+type ApiKeyWithSecret struct {
+	AllowedTools []string           `json:"allowed_tools"`
+	CreatedAt    time.Time          `json:"created_at"`
+	DeniedTools  []string           `json:"denied_tools"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// Key The full API key. This is only returned once at creation time.
+	// Store it securely - it cannot be retrieved again.
+	Key                 string             `json:"key"`
+	KeyPrefix           string             `json:"key_prefix"`
+	Name                string             `json:"name"`
+	RequireConfirmation bool               `json:"require_confirmation"`
+	UserId              openapi_types.UUID `json:"user_id"`
+}
+
+// ApplyRulesAsyncRequest defines model for ApplyRulesAsyncRequest.
+type ApplyRulesAsyncRequest struct {
+	// BatchSize Events loaded and evaluated per batch
+	BatchSize *int `json:"batch_size,omitempty"`
+
+	// DryRun If true, count what would be classified without making changes
+	DryRun    *bool               `json:"dry_run,omitempty"`
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// ApplyRulesRequest defines model for ApplyRulesRequest.
+type ApplyRulesRequest struct {
+	// DryRun If true, return what would be classified without making changes
+	DryRun    *bool               `json:"dry_run,omitempty"`
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// ApplyRulesResponse defines model for ApplyRulesResponse.
+type ApplyRulesResponse struct {
+	// ActionId Journal entry for this run, when any events changed (not set for dry runs)
+	ActionId   *openapi_types.UUID `json:"action_id,omitempty"`
+	Classified []ClassifiedEvent   `json:"classified"`
+
+	// Skipped Events that matched no rules or were below confidence threshold
+	Skipped int `json:"skipped"`
+}
+
+// AttendeeAlias defines model for AttendeeAlias.
+type AttendeeAlias struct {
+	// AliasEmail Another email of the same person
+	AliasEmail string `json:"alias_email"`
+
+	// CanonicalEmail The person's main email
+	CanonicalEmail string             `json:"canonical_email"`
+	CreatedAt      time.Time          `json:"created_at"`
+	Id             openapi_types.UUID `json:"id"`
+}
+
+// AttendeeAliasCreate defines model for AttendeeAliasCreate.
+type AttendeeAliasCreate struct {
+	AliasEmail     string `json:"alias_email"`
+	CanonicalEmail string `json:"canonical_email"`
+}
+
+// AudienceSplit Time on internal vs client-facing events, from the durations of
+// counted classified events (overlapping events each count in full).
+// Absent until company domains are set.
+type AudienceSplit struct {
+	// ClientFacingHours Events with an attendee outside the company domains
+	ClientFacingHours float64 `json:"client_facing_hours"`
+
+	// ClientFacingShare Client-facing hours / both
+	ClientFacingShare float64 `json:"client_facing_share"`
+	InternalHours     float64 `json:"internal_hours"`
+}
+
+// AuthResponse defines model for AuthResponse.
+type AuthResponse struct {
+	Token string `json:"token"`
+	User  User   `json:"user"`
+}
+
+// BillingPeriod defines model for BillingPeriod.
+type BillingPeriod struct {
+	// BillingMode hourly, fixed (monthly fee) or retainer (monthly fee with included hours and an overage rate)
+	BillingMode string    `json:"billing_mode"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// EndsOn End date of the billing period (null means ongoing)
+	EndsOn *openapi_types.Date `json:"ends_on"`
+
+	// FixedFee Monthly fee for fixed and retainer periods
+	FixedFee *float64 `json:"fixed_fee"`
+
+	// HourlyRate Hourly rate for this period; used by hourly periods only
+	HourlyRate float32            `json:"hourly_rate"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// IncludedHours Hours per month covered by a retainer's fee
+	IncludedHours *float64 `json:"included_hours"`
+
+	// IncrementHours Each day's hours are rounded up to a multiple of this (e.g. 0.25)
+	IncrementHours *float64 `json:"increment_hours"`
+
+	// MinDailyHours Each day with time is billed at least this many hours
+	MinDailyHours *float64 `json:"min_daily_hours"`
+
+	// OverageRate Hourly rate for retainer hours beyond the included hours
+	OverageRate *float64           `json:"overage_rate"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+
+	// StartsOn Start date of the billing period
+	StartsOn  openapi_types.Date `json:"starts_on"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	UserId    openapi_types.UUID `json:"user_id"`
+}
+
+// BillingPeriodCreate defines model for BillingPeriodCreate.
+type BillingPeriodCreate struct {
+	// BillingMode Defaults to hourly. hourly, fixed (monthly fee) or retainer (monthly fee with included hours and an overage rate)
+	BillingMode *string `json:"billing_mode,omitempty"`
+
+	// EndsOn End date in YYYY-MM-DD format (omit or null for ongoing)
+	EndsOn *openapi_types.Date `json:"ends_on"`
+
+	// FixedFee Monthly fee for fixed and retainer periods
+	FixedFee   *float64 `json:"fixed_fee"`
+	HourlyRate float32  `json:"hourly_rate"`
+
+	// IncludedHours Hours per month covered by a retainer's fee
+	IncludedHours *float64 `json:"included_hours"`
+
+	// IncrementHours Each day's hours are rounded up to a multiple of this (e.g. 0.25)
+	IncrementHours *float64 `json:"increment_hours"`
+
+	// MinDailyHours Each day with time is billed at least this many hours
+	MinDailyHours *float64 `json:"min_daily_hours"`
+
+	// OverageRate Hourly rate for retainer hours beyond the included hours
+	OverageRate *float64           `json:"overage_rate"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+
+	// StartsOn Start date in YYYY-MM-DD format
+	StartsOn openapi_types.Date `json:"starts_on"`
+}
+
+// BillingPeriodUpdate defines model for BillingPeriodUpdate.
+type BillingPeriodUpdate struct {
+	// BillingMode hourly, fixed (monthly fee) or retainer (monthly fee with included hours and an overage rate)
+	BillingMode *string `json:"billing_mode,omitempty"`
+
+	// EndsOn Set to empty string to clear (make ongoing)
+	EndsOn *openapi_types.Date `json:"ends_on"`
+
+	// FixedFee Monthly fee for fixed and retainer periods
+	FixedFee   *float64 `json:"fixed_fee,omitempty"`
+	HourlyRate *float32 `json:"hourly_rate,omitempty"`
+
+	// IncludedHours Hours per month covered by a retainer's fee
+	IncludedHours *float64 `json:"included_hours,omitempty"`
+
+	// IncrementHours Each day's hours are rounded up to a multiple of this (e.g. 0.25). Set to 0 to remove.
+	IncrementHours *float64 `json:"increment_hours,omitempty"`
+
+	// MinDailyHours Each day with time is billed at least this many hours. Set to 0 to remove.
+	MinDailyHours *float64 `json:"min_daily_hours,omitempty"`
+
+	// OverageRate Hourly rate for retainer hours beyond the included hours
+	OverageRate *float64            `json:"overage_rate,omitempty"`
+	StartsOn    *openapi_types.Date `json:"starts_on,omitempty"`
+}
+
+// BulkClassifyRequest defines model for BulkClassifyRequest.
+type BulkClassifyRequest struct {
+	// ProjectId Project to assign matching events to. Omit to skip events.
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// Query Gmail-style query to match events (e.g., "domain:acme.com title:sync")
+	Query string `json:"query"`
+
+	// Skip If true, mark matching events as skipped (did not attend)
+	Skip *bool `json:"skip,omitempty"`
+}
+
+// BulkClassifyResponse defines model for BulkClassifyResponse.
+type BulkClassifyResponse struct {
+	// ActionId Journal entry for this change, when any events changed
+	ActionId *openapi_types.UUID `json:"action_id,omitempty"`
+
+	// ClassifiedCount Number of events classified to a project
+	ClassifiedCount int `json:"classified_count"`
+
+	// SkippedCount Number of events marked as skipped
+	SkippedCount int `json:"skipped_count"`
+
+	// TimeEntriesCreated Number of time entries created
+	TimeEntriesCreated *int `json:"time_entries_created,omitempty"`
+}
+
+// CalculationDetails Audit trail showing how hours were calculated
+type CalculationDetails struct {
+	Events *[]struct {
+		End        *string `json:"end,omitempty"`
+		Id         *string `json:"id,omitempty"`
+		IsAllDay   *bool   `json:"is_all_day,omitempty"`
+		RawMinutes *int    `json:"raw_minutes,omitempty"`
+		Start      *string `json:"start,omitempty"`
+		Title      *string `json:"title,omitempty"`
+	} `json:"events,omitempty"`
+	FinalMinutes    *int    `json:"final_minutes,omitempty"`
+	RoundingApplied *string `json:"rounding_applied,omitempty"`
+	TimeRanges      *[]struct {
+		End     *string `json:"end,omitempty"`
+		Minutes *int    `json:"minutes,omitempty"`
+		Start   *string `json:"start,omitempty"`
+	} `json:"time_ranges,omitempty"`
+	UnionMinutes *int `json:"union_minutes,omitempty"`
+}
+
+// Calendar defines model for Calendar.
+type Calendar struct {
+	// Color Calendar color (hex code)
+	Color        *string            `json:"color,omitempty"`
+	ConnectionId openapi_types.UUID `json:"connection_id"`
+	CreatedAt    time.Time          `json:"created_at"`
+
+	// DefaultProjectId Project this calendar's events default to
+	DefaultProjectId *openapi_types.UUID `json:"default_project_id"`
+
+	// DefaultProjectWeight Vote weight of the default project; null for a low-weight hint that needs review
+	DefaultProjectWeight *float64 `json:"default_project_weight"`
+
+	// DisplayColor User override of the color (hex code)
+	DisplayColor *string `json:"display_color"`
+
+	// DisplayName User override of the name
+	DisplayName *string `json:"display_name"`
+
+	// ExternalId Google Calendar ID (e.g., "primary", "user@example.com")
+	ExternalId string             `json:"external_id"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// IsPrimary Whether this is the user's primary calendar
+	IsPrimary bool `json:"is_primary"`
+
+	// IsSelected Whether this calendar is selected for syncing
+	IsSelected   bool       `json:"is_selected"`
+	LastSyncedAt *time.Time `json:"last_synced_at"`
+
+	// Name Display name of the calendar
+	Name string `json:"name"`
+
+	// SyncDisabledAt When a permanent failure stopped syncing; deselect and reselect the calendar to retry
+	SyncDisabledAt *time.Time `json:"sync_disabled_at"`
+
+	// SyncError Error message of the last failed sync
+	SyncError *string `json:"sync_error"`
+
+	// SyncFailureCategory Why the last sync failed; null once a sync succeeds. `auth` needs
+	// the calendar reconnected, `permanent` (deleted or unshared
+	// calendar) disables sync, `transient` is retried up to three
+	// times and `quota` is retried when the API quota resets.
+	SyncFailureCategory *CalendarSyncFailureCategory `json:"sync_failure_category"`
+	UpdatedAt           *time.Time                   `json:"updated_at,omitempty"`
+}
+
+// CalendarSyncFailureCategory Why the last sync failed; null once a sync succeeds. `auth` needs
+// the calendar reconnected, `permanent` (deleted or unshared
+// calendar) disables sync, `transient` is retried up to three
+// times and `quota` is retried when the API quota resets.
+type CalendarSyncFailureCategory string
+
+// CalendarConnection defines model for CalendarConnection.
+type CalendarConnection struct {
+	// Access Features the granted scopes cover
+	Access    *[]GoogleAccess `json:"access,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+
+	// GrantedScopes OAuth scopes the user consented to
+	GrantedScopes *[]string          `json:"granted_scopes,omitempty"`
+	Id            openapi_types.UUID `json:"id"`
+	LastSyncedAt  *time.Time         `json:"last_synced_at"`
+
+	// PausedAt When the user paused syncing; null while syncing
+	PausedAt *time.Time `json:"paused_at"`
+
+	// Provider google_workspace connections are provisioned by an organization's
+	// service account and can't be reauthorized or written back to
+	Provider CalendarConnectionProvider `json:"provider"`
+
+	// SyncFutureDays Days of future to sync; null uses the server default
+	SyncFutureDays *int `json:"sync_future_days"`
+
+	// SyncHistoryDays Days of history to sync; null uses the server default
+	SyncHistoryDays *int               `json:"sync_history_days"`
+	UpdatedAt       *time.Time         `json:"updated_at,omitempty"`
+	UserId          openapi_types.UUID `json:"user_id"`
+
+	// WriteBack How classified projects are written back to Google events
+	WriteBack *CalendarWriteBackMode `json:"write_back,omitempty"`
+}
+
+// CalendarConnectionProvider google_workspace connections are provisioned by an organization's
+// service account and can't be reauthorized or written back to
+type CalendarConnectionProvider string
+
+// CalendarEvent defines model for CalendarEvent.
+type CalendarEvent struct {
+	Attendees *[]string `json:"attendees,omitempty"`
+
+	// CalendarColor Color of the source calendar (hex code)
+	CalendarColor *string `json:"calendar_color"`
+
+	// CalendarId Google Calendar ID (typically the calendar email, e.g., "user@example.com" or "primary")
+	CalendarId *string `json:"calendar_id"`
+
+	// CalendarName Name of the source calendar
+	CalendarName *string `json:"calendar_name"`
+
+	// ClassificationConfidence Confidence score from rule-based classification
+	ClassificationConfidence *float32                           `json:"classification_confidence"`
+	ClassificationSource     *CalendarEventClassificationSource `json:"classification_source"`
+	ClassificationStatus     CalendarEventClassificationStatus  `json:"classification_status"`
+	ConnectionId             openapi_types.UUID                 `json:"connection_id"`
+	CreatedAt                time.Time                          `json:"created_at"`
+	Description              *string                            `json:"description"`
+
+	// DurationChangedAt Set when a re-sync changed the duration of this classified event
+	// materially (15 minutes or more) since its time entry was computed.
+	// Cleared when the entry is recomputed.
+	DurationChangedAt *time.Time         `json:"duration_changed_at"`
+	EndTime           time.Time          `json:"end_time"`
+	ExternalId        string             `json:"external_id"`
+	Id                openapi_types.UUID `json:"id"`
+
+	// IsAllDay Whether this is an all-day event (no specific start/end times)
+	IsAllDay *bool `json:"is_all_day,omitempty"`
+
+	// IsInternal True when no attendee is outside the user's company domains,
+	// including events without attendees; null until company domains
+	// are set
+	IsInternal  *bool `json:"is_internal"`
+	IsOrphaned  *bool `json:"is_orphaned,omitempty"`
+	IsRecurring *bool `json:"is_recurring,omitempty"`
+
+	// IsSkipped Whether this event is marked as skipped (excluded from time entries)
+	IsSkipped    *bool `json:"is_skipped,omitempty"`
+	IsSuppressed *bool `json:"is_suppressed,omitempty"`
+
+	// NeedsReview True if event was auto-classified with medium confidence and should be reviewed
+	NeedsReview    *bool               `json:"needs_review,omitempty"`
+	Project        *Project            `json:"project,omitempty"`
+	ProjectId      *openapi_types.UUID `json:"project_id"`
+	ResponseStatus *string             `json:"response_status"`
+	StartTime      time.Time           `json:"start_time"`
+
+	// Tags The user's tags on this event
+	Tags         *[]string          `json:"tags,omitempty"`
+	Title        string             `json:"title"`
+	Transparency *string            `json:"transparency"`
+	UpdatedAt    *time.Time         `json:"updated_at,omitempty"`
+	UserId       openapi_types.UUID `json:"user_id"`
+}
+
+// CalendarEventClassificationSource defines model for CalendarEvent.ClassificationSource.
+type CalendarEventClassificationSource string
+
+// CalendarEventClassificationStatus defines model for CalendarEvent.ClassificationStatus.
+type CalendarEventClassificationStatus string
+
+// CalendarEventChange defines model for CalendarEventChange.
+type CalendarEventChange struct {
+	EventId openapi_types.UUID `json:"event_id"`
+
+	// Field The changed field: title, description, start_time, end_time, is_all_day, response_status, transparency, or is_orphaned when the event was cancelled in Google or came back
+	Field    string             `json:"field"`
+	Id       openapi_types.UUID `json:"id"`
+	NewValue *string            `json:"new_value"`
+	OldValue *string            `json:"old_value"`
+
+	// SyncedAt When the sync that made the change ran
+	SyncedAt time.Time `json:"synced_at"`
+}
+
+// CalendarEventDiff defines model for CalendarEventDiff.
+type CalendarEventDiff struct {
+	Added     int                `json:"added"`
+	Cancelled int                `json:"cancelled"`
+	Changed   int                `json:"changed"`
+	EndDate   openapi_types.Date `json:"end_date"`
+
+	// Events The changed events, by start time
+	Events    []CalendarEventDiffItem `json:"events"`
+	Moved     int                     `json:"moved"`
+	Since     time.Time               `json:"since"`
+	StartDate openapi_types.Date      `json:"start_date"`
+
+	// Summary e.g. "3 events added, 1 moved, 2 cancelled since Mon Mar 10"
+	Summary string    `json:"summary"`
+	Until   time.Time `json:"until"`
+}
+
+// CalendarEventDiffItem defines model for CalendarEventDiffItem.
+type CalendarEventDiffItem struct {
+	// After An event's synced fields at one point in time. Absent when the event didn't exist yet or was cancelled.
+	After *CalendarEventSnapshot `json:"after,omitempty"`
+
+	// Before An event's synced fields at one point in time. Absent when the event didn't exist yet or was cancelled.
+	Before *CalendarEventSnapshot `json:"before,omitempty"`
+
+	// ChangedFields Fields that differ between before and after
+	ChangedFields []string                  `json:"changed_fields"`
+	EventId       openapi_types.UUID        `json:"event_id"`
+	Kind          CalendarEventDiffItemKind `json:"kind"`
+}
+
+// CalendarEventDiffItemKind defines model for CalendarEventDiffItem.Kind.
+type CalendarEventDiffItemKind string
+
+// CalendarEventSnapshot An event's synced fields at one point in time. Absent when the event didn't exist yet or was cancelled.
+type CalendarEventSnapshot struct {
+	Description    *string   `json:"description,omitempty"`
+	EndTime        time.Time `json:"end_time"`
+	IsAllDay       bool      `json:"is_all_day"`
+	ResponseStatus *string   `json:"response_status,omitempty"`
+	StartTime      time.Time `json:"start_time"`
+	Title          string    `json:"title"`
+	Transparency   *string   `json:"transparency,omitempty"`
+}
+
+// CalendarSourceOverrides defines model for CalendarSourceOverrides.
+type CalendarSourceOverrides struct {
+	DefaultProjectId *openapi_types.UUID `json:"default_project_id"`
+
+	// DefaultProjectWeight Vote weight of the default project, above 0 and below 100; requires default_project_id
+	DefaultProjectWeight *float64 `json:"default_project_weight"`
+
+	// DisplayColor Hex color such as "#3b82f6"
+	DisplayColor *string `json:"display_color"`
+	DisplayName  *string `json:"display_name"`
+}
+
+// CalendarSyncWindowInput defines model for CalendarSyncWindowInput.
+type CalendarSyncWindowInput struct {
+	// FutureDays Days of future to sync; null for the server default
+	FutureDays *int `json:"future_days"`
+
+	// HistoryDays Days of history to sync; null for the server default
+	HistoryDays *int `json:"history_days"`
+}
+
+// CalendarWriteBackInput defines model for CalendarWriteBackInput.
+type CalendarWriteBackInput struct {
+	// Mode How classified projects are written back to Google events
+	Mode CalendarWriteBackMode `json:"mode"`
+}
+
+// CalendarWriteBackMode How classified projects are written back to Google events
+type CalendarWriteBackMode string
+
+// ChangeFeedEntry defines model for ChangeFeedEntry.
+type ChangeFeedEntry struct {
+	ChangedAt time.Time `json:"changed_at"`
+
+	// Operation upsert when the resource was created or updated, delete when it was removed
+	Operation  string             `json:"operation"`
+	ResourceId openapi_types.UUID `json:"resource_id"`
+
+	// ResourceType calendar_event, time_entry or invoice
+	ResourceType string `json:"resource_type"`
+}
+
+// ChangeFeedPage defines model for ChangeFeedPage.
+type ChangeFeedPage struct {
+	Changes []ChangeFeedEntry `json:"changes"`
+
+	// HasMore Whether more changes are available right away
+	HasMore bool `json:"has_more"`
+
+	// NextCursor Cursor to pass as since on the next call; unchanged when there are no new changes
+	NextCursor string `json:"next_cursor"`
+}
+
+// ClassificationAction defines model for ClassificationAction.
+type ClassificationAction struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+
+	// EventCount Number of events the action changed
+	EventCount int                      `json:"event_count"`
+	Id         openapi_types.UUID       `json:"id"`
+	Kind       ClassificationActionKind `json:"kind"`
+	UndoneAt   *time.Time               `json:"undone_at"`
+}
+
+// ClassificationActionKind defines model for ClassificationAction.Kind.
+type ClassificationActionKind string
+
+// ClassificationExplanation defines model for ClassificationExplanation.
+type ClassificationExplanation struct {
+	Event CalendarEvent `json:"event"`
+
+	// Outcome Human-readable summary of classification decision
+	Outcome string `json:"outcome"`
+
+	// RuleEvaluations All project rules evaluated
+	RuleEvaluations []RuleEvaluation `json:"rule_evaluations"`
+
+	// SkipConfidence Confidence of skip decision (0-1)
+	SkipConfidence *float32 `json:"skip_confidence,omitempty"`
+
+	// SkipEvaluations All skip rules evaluated
+	SkipEvaluations *[]RuleEvaluation `json:"skip_evaluations,omitempty"`
+
+	// TargetScores Score breakdown by project
+	TargetScores []TargetScore `json:"target_scores"`
+
+	// TotalWeight Sum of all matching rule weights
+	TotalWeight *float32 `json:"total_weight,omitempty"`
+
+	// WinnerConfidence Confidence of the winning classification (0-1)
+	WinnerConfidence *float32 `json:"winner_confidence,omitempty"`
+
+	// WinnerProjectId Project ID of the winning classification
+	WinnerProjectId *openapi_types.UUID `json:"winner_project_id"`
+
+	// WouldBeSkipped Whether skip rules would mark this event as skipped
+	WouldBeSkipped *bool `json:"would_be_skipped,omitempty"`
+}
+
+// ClassificationJob defines model for ClassificationJob.
+type ClassificationJob struct {
+	// ActionId Journal entry for undo, once the job has changed events
+	ActionId  *openapi_types.UUID `json:"action_id,omitempty"`
+	BatchSize int                 `json:"batch_size"`
+
+	// Changed Events whose classification changed so far
+	Changed int `json:"changed"`
+
+	// Classified Events classified to a project so far
+	Classified  int                 `json:"classified"`
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	DryRun      bool                `json:"dry_run"`
+	EndDate     *openapi_types.Date `json:"end_date,omitempty"`
+	Error       *string             `json:"error,omitempty"`
+	Id          openapi_types.UUID  `json:"id"`
+
+	// Matched Events evaluated so far; for a backfill, those the rule matches
+	Matched         int `json:"matched"`
+	ProcessedEvents int `json:"processed_events"`
+
+	// RuleId The rule being backfilled, for jobs started on rule creation
+	RuleId *openapi_types.UUID `json:"rule_id,omitempty"`
+
+	// SkipApplied Events marked as skipped so far
+	SkipApplied int `json:"skip_applied"`
+
+	// Skipped Events that matched no project rules so far
+	Skipped   int                     `json:"skipped"`
+	StartDate *openapi_types.Date     `json:"start_date,omitempty"`
+	StartedAt *time.Time              `json:"started_at,omitempty"`
+	Status    ClassificationJobStatus `json:"status"`
+
+	// TotalEvents Events in range when the job started
+	TotalEvents int `json:"total_events"`
+}
+
+// ClassificationJobStatus defines model for ClassificationJob.Status.
+type ClassificationJobStatus string
+
+// ClassificationRule defines model for ClassificationRule.
+type ClassificationRule struct {
+	// Attended For attendance rules - true=attended, false=did not attend
+	Attended    *bool              `json:"attended"`
+	BackfillJob *ClassificationJob `json:"backfill_job,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+
+	// DeletedAt When the rule was moved to the trash
+	DeletedAt *time.Time         `json:"deleted_at"`
+	Id        openapi_types.UUID `json:"id"`
+	IsEnabled bool               `json:"is_enabled"`
+
+	// ProjectColor Color of target project (joined for convenience)
+	ProjectColor *string `json:"project_color"`
+
+	// ProjectId Target project for matching events (null for attendance rules)
+	ProjectId *openapi_types.UUID `json:"project_id"`
+
+	// ProjectName Name of target project (joined for convenience)
+	ProjectName *string `json:"project_name"`
+
+	// Query Gmail-style query (e.g., "domain:acme.com title:standup")
+	Query     string             `json:"query"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	UserId    openapi_types.UUID `json:"user_id"`
+
+	// Weight Rule weight for scoring (higher = stronger vote)
+	Weight float32 `json:"weight"`
+}
+
+// ClassificationSnapshot defines model for ClassificationSnapshot.
+type ClassificationSnapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventCount Number of events captured
+	EventCount int                `json:"event_count"`
+	Id         openapi_types.UUID `json:"id"`
+	Label      *string            `json:"label"`
+	WeekStart  openapi_types.Date `json:"week_start"`
+}
+
+// ClassificationSnapshotCreate defines model for ClassificationSnapshotCreate.
+type ClassificationSnapshotCreate struct {
+	Label *string `json:"label,omitempty"`
+
+	// WeekStart Any date in the week; normalized to its Monday
+	WeekStart openapi_types.Date `json:"week_start"`
+}
+
+// ClassifiedEvent defines model for ClassifiedEvent.
+type ClassifiedEvent struct {
+	Confidence float32            `json:"confidence"`
+	EventId    openapi_types.UUID `json:"event_id"`
+
+	// NeedsReview True if confidence is between floor and ceiling thresholds
+	NeedsReview bool               `json:"needs_review"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+}
+
+// ClassifyEventRequest Classify an event by assigning it to a project, skipping it, or unskipping it.
+// - To assign to project: provide project_id
+// - To skip (mark as "did not attend"): set skip to true
+// - To unskip (reset to pending): set skip to false with no project_id
+type ClassifyEventRequest struct {
+	// ProjectId Project to assign this event to.
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// Skip Set to true to skip, or false to unskip (reset to pending state).
+	Skip *bool `json:"skip,omitempty"`
+}
+
+// ClassifyEventResponse defines model for ClassifyEventResponse.
+type ClassifyEventResponse struct {
+	// ActionId Journal entry for this change; pass to POST /api/actions/{id}/undo to revert
+	ActionId  *openapi_types.UUID `json:"action_id,omitempty"`
+	Event     CalendarEvent       `json:"event"`
+	TimeEntry *TimeEntry          `json:"time_entry,omitempty"`
+}
+
+// ClientBalance defines model for ClientBalance.
+type ClientBalance struct {
+	// Client Project client (empty for projects without a client)
+	Client string `json:"client"`
+
+	// Credited Issued credit notes (negative)
+	Credited     float32 `json:"credited"`
+	InvoiceCount int     `json:"invoice_count"`
+	Invoiced     float32 `json:"invoiced"`
+	Outstanding  float32 `json:"outstanding"`
+	Paid         float32 `json:"paid"`
+}
+
+// ClientRate defines model for ClientRate.
+type ClientRate struct {
+	// Client Client name, matched against project client
+	Client    string    `json:"client"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// EndsOn Last day the rate applies (null means ongoing)
+	EndsOn     *openapi_types.Date `json:"ends_on"`
+	HourlyRate float32             `json:"hourly_rate"`
+	Id         openapi_types.UUID  `json:"id"`
+
+	// StartsOn First day the rate applies
+	StartsOn  openapi_types.Date `json:"starts_on"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	UserId    openapi_types.UUID `json:"user_id"`
+}
+
+// ClientRateCreate defines model for ClientRateCreate.
+type ClientRateCreate struct {
+	Client string `json:"client"`
+
+	// EndsOn End date in YYYY-MM-DD format (omit or null for ongoing)
+	EndsOn     *openapi_types.Date `json:"ends_on"`
+	HourlyRate float32             `json:"hourly_rate"`
+
+	// StartsOn Start date in YYYY-MM-DD format
+	StartsOn openapi_types.Date `json:"starts_on"`
+}
+
+// ClientRateUpdate defines model for ClientRateUpdate.
+type ClientRateUpdate struct {
+	// EndsOn Set to empty string to clear (make ongoing)
+	EndsOn     *openapi_types.Date `json:"ends_on"`
+	HourlyRate *float32            `json:"hourly_rate,omitempty"`
+	StartsOn   *openapi_types.Date `json:"starts_on,omitempty"`
+}
+
+// CompanyDomains defines model for CompanyDomains.
+type CompanyDomains struct {
+	// Domains Email domains of the user's own organization, lowercase
+	Domains []string `json:"domains"`
+}
+
+// ConfigExport defines model for ConfigExport.
+type ConfigExport struct {
+	// ExportedAt When this export was created
+	ExportedAt time.Time `json:"exported_at"`
+
+	// Projects All projects in the export
+	Projects []ProjectExport `json:"projects"`
+
+	// Rules All classification rules in the export
+	Rules []RuleExport `json:"rules"`
+
+	// Version Export format version
+	Version string `json:"version"`
+}
+
+// ConfigImport defines model for ConfigImport.
+type ConfigImport struct {
+	// Projects Projects to import
+	Projects []ProjectExport `json:"projects"`
+
+	// Rules Rules to import
+	Rules []RuleExport `json:"rules"`
+
+	// Version Export format version (optional, for compatibility)
+	Version *string `json:"version,omitempty"`
+}
+
+// ConfigImportResult defines model for ConfigImportResult.
+type ConfigImportResult struct {
+	// ProjectsCreated Number of new projects created
+	ProjectsCreated int `json:"projects_created"`
+
+	// ProjectsUpdated Number of existing projects updated
+	ProjectsUpdated int `json:"projects_updated"`
+
+	// RulesCreated Number of new rules created
+	RulesCreated int `json:"rules_created"`
+
+	// RulesSkipped Number of rules skipped (e.g., project not found)
+	RulesSkipped *int `json:"rules_skipped,omitempty"`
+
+	// RulesUpdated Number of existing rules updated
+	RulesUpdated int `json:"rules_updated"`
+
+	// Warnings Any warnings during import
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// ConflictingRule defines model for ConflictingRule.
+type ConflictingRule struct {
+	// ClassifiedShared Shared events classified to this rule's project
+	ClassifiedShared int `json:"classified_shared"`
+
+	// ConfirmedShared Of those, events classified by hand
+	ConfirmedShared int                `json:"confirmed_shared"`
+	MatchedEvents   int                `json:"matched_events"`
+	ProjectId       openapi_types.UUID `json:"project_id"`
+	Query           string             `json:"query"`
+	RuleId          openapi_types.UUID `json:"rule_id"`
+	Weight          float32            `json:"weight"`
+}
+
+// ConnectionReauth defines model for ConnectionReauth.
+type ConnectionReauth struct {
+	// Calendars Selected calendars waiting on re-consent
+	Calendars    []ReauthCalendar   `json:"calendars"`
+	ConnectionId openapi_types.UUID `json:"connection_id"`
+	Provider     string             `json:"provider"`
+}
+
+// CreditNoteCreate defines model for CreditNoteCreate.
+type CreditNoteCreate struct {
+	// InvoiceDate Credit note date (defaults to today)
+	InvoiceDate *openapi_types.Date `json:"invoice_date,omitempty"`
+
+	// Lines Amounts to credit. Omit to credit the full remaining balance.
+	Lines *[]CreditNoteLine `json:"lines,omitempty"`
+}
+
+// CreditNoteLine defines model for CreditNoteLine.
+type CreditNoteLine struct {
+	// Amount Positive amount to credit
+	Amount      float32 `json:"amount"`
+	Description string  `json:"description"`
+}
+
+// DayAnomaly defines model for DayAnomaly.
+type DayAnomaly struct {
+	// BaselineHours Average daily total the day was compared against (unusual_total only)
+	BaselineHours *float64           `json:"baseline_hours,omitempty"`
+	Date          openapi_types.Date `json:"date"`
+	DetectedAt    time.Time          `json:"detected_at"`
+	DismissedAt   *time.Time         `json:"dismissed_at,omitempty"`
+
+	// EventCount Classified meetings on the day (missing_hours only)
+	EventCount *int `json:"event_count,omitempty"`
+
+	// Hours Hours attributed on the day
+	Hours   float64            `json:"hours"`
+	Id      openapi_types.UUID `json:"id"`
+	Kind    DayAnomalyKind     `json:"kind"`
+	Message string             `json:"message"`
+}
+
+// DayAnomalyKind defines model for DayAnomaly.Kind.
+type DayAnomalyKind string
+
+// DayProjectTotal defines model for DayProjectTotal.
+type DayProjectTotal struct {
+	Color       *string            `json:"color,omitempty"`
+	Hours       float32            `json:"hours"`
+	IsBillable  bool               `json:"is_billable"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+}
+
+// DaySummary defines model for DaySummary.
+type DaySummary struct {
+	Date openapi_types.Date `json:"date"`
+
+	// Entries Materialized and ephemeral entries for the day
+	Entries []TimeEntry     `json:"entries"`
+	Events  []CalendarEvent `json:"events"`
+
+	// Locked Whether the day has entries and all of them are invoiced
+	Locked bool `json:"locked"`
+
+	// LockedEntryIds Entries locked by an invoice
+	LockedEntryIds []openapi_types.UUID `json:"locked_entry_ids"`
+
+	// PendingCount Events on this day still pending classification
+	PendingCount int       `json:"pending_count"`
+	Totals       DayTotals `json:"totals"`
+}
+
+// DayTotals defines model for DayTotals.
+type DayTotals struct {
+	BillableHours float32 `json:"billable_hours"`
+
+	// Hours Total hours, excluding projects that do not accumulate hours
+	Hours    float32           `json:"hours"`
+	Projects []DayProjectTotal `json:"projects"`
+}
+
+// DelegationProvisionInput defines model for DelegationProvisionInput.
+type DelegationProvisionInput struct {
+	// UserIds Members to provision; omit for every active member
+	UserIds *[]openapi_types.UUID `json:"user_ids,omitempty"`
+}
+
+// DelegationProvisionMember defines model for DelegationProvisionMember.
+type DelegationProvisionMember struct {
+	// Calendars Calendars found for the member
+	Calendars    *int                `json:"calendars,omitempty"`
+	ConnectionId *openapi_types.UUID `json:"connection_id"`
+	Email        string              `json:"email"`
+
+	// Error Why the member was skipped or failed
+	Error *string `json:"error"`
+
+	// Selected Calendars selected for sync
+	Selected *int                            `json:"selected,omitempty"`
+	Status   DelegationProvisionMemberStatus `json:"status"`
+	UserId   openapi_types.UUID              `json:"user_id"`
+}
+
+// DelegationProvisionMemberStatus defines model for DelegationProvisionMember.Status.
+type DelegationProvisionMemberStatus string
+
+// DelegationProvisionResult defines model for DelegationProvisionResult.
+type DelegationProvisionResult struct {
+	Members []DelegationProvisionMember `json:"members"`
+}
+
+// DescriptionSuggestion defines model for DescriptionSuggestion.
+type DescriptionSuggestion struct {
+	Activities []GitHubActivity `json:"activities"`
+
+	// CurrentDescription The time entry's current description
+	CurrentDescription *string `json:"current_description,omitempty"`
+
+	// Description Suggested description built from the activity
+	Description string `json:"description"`
+
+	// Hours Hours on that time entry
+	Hours       *float32           `json:"hours,omitempty"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+
+	// TimeEntryId The project's time entry for the day, if any
+	TimeEntryId *openapi_types.UUID `json:"time_entry_id,omitempty"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code string `json:"code"`
+
+	// Details Machine-readable context for the error. For invalid_query:
+	// message, position (byte offset into the query), length and
+	// token (the offending text, absent at the end of the query),
+	// and suggestion (the nearest valid property, for an unknown one).
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Message string                  `json:"message"`
+}
+
+// EventClassificationHistoryEntry defines model for EventClassificationHistoryEntry.
+type EventClassificationHistoryEntry struct {
+	ClassifiedAt time.Time           `json:"classified_at"`
+	Confidence   *float32            `json:"confidence"`
+	EventId      openapi_types.UUID  `json:"event_id"`
+	Id           openapi_types.UUID  `json:"id"`
+	IsSkipped    bool                `json:"is_skipped"`
+	ProjectId    *openapi_types.UUID `json:"project_id"`
+
+	// RuleVotes Every rule that matched, for any project. Absent for manual classifications.
+	RuleVotes *[]EventClassificationVote            `json:"rule_votes,omitempty"`
+	Source    EventClassificationHistoryEntrySource `json:"source"`
+}
+
+// EventClassificationHistoryEntrySource defines model for EventClassificationHistoryEntry.Source.
+type EventClassificationHistoryEntrySource string
+
+// EventClassificationVote defines model for EventClassificationVote.
+type EventClassificationVote struct {
+	ProjectId string `json:"project_id"`
+
+	// RuleId The rule's ID, or a generated ID for project fingerprints
+	RuleId string                        `json:"rule_id"`
+	Source EventClassificationVoteSource `json:"source"`
+	Weight float32                       `json:"weight"`
+}
+
+// EventClassificationVoteSource defines model for EventClassificationVote.Source.
+type EventClassificationVoteSource string
+
+// EventSuppressionUpdate defines model for EventSuppressionUpdate.
+type EventSuppressionUpdate struct {
+	Suppressed bool `json:"suppressed"`
+}
+
+// Expense defines model for Expense.
+type Expense struct {
+	Amount      float64            `json:"amount"`
+	Category    string             `json:"category"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// InvoiceId Invoice the expense was billed on; set while the expense is locked
+	InvoiceId *openapi_types.UUID `json:"invoice_id"`
+
+	// IsBillable Billable expenses are put on the project's invoices
+	IsBillable bool               `json:"is_billable"`
+	ProjectId  openapi_types.UUID `json:"project_id"`
+
+	// ReceiptUrl Link to the receipt
+	ReceiptUrl *string   `json:"receipt_url"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ExpenseCreate defines model for ExpenseCreate.
+type ExpenseCreate struct {
+	// Amount Must be positive
+	Amount      float64            `json:"amount"`
+	Category    string             `json:"category"`
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description,omitempty"`
+	IsBillable  *bool              `json:"is_billable,omitempty"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ReceiptUrl  *string            `json:"receipt_url,omitempty"`
+}
+
+// ExpenseUpdate defines model for ExpenseUpdate.
+type ExpenseUpdate struct {
+	Amount   *float64            `json:"amount,omitempty"`
+	Category *string             `json:"category,omitempty"`
+	Date     *openapi_types.Date `json:"date,omitempty"`
+
+	// Description Set to empty string to clear
+	Description *string             `json:"description,omitempty"`
+	IsBillable  *bool               `json:"is_billable,omitempty"`
+	ProjectId   *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// ReceiptUrl Set to empty string to clear
+	ReceiptUrl *string `json:"receipt_url,omitempty"`
+}
+
+// FingerprintClaim defines model for FingerprintClaim.
+type FingerprintClaim struct {
+	IsArchived  bool               `json:"is_archived"`
+	Kind        FingerprintKind    `json:"kind"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+
+	// Value The claiming fingerprint
+	Value string `json:"value"`
+}
+
+// FingerprintInput defines model for FingerprintInput.
+type FingerprintInput struct {
+	Kind FingerprintKind `json:"kind"`
+
+	// Value A domain (acme.com), an email address, or a keyword
+	Value string `json:"value"`
+
+	// Weight Vote weight for this fingerprint (default 1.0)
+	Weight *float32 `json:"weight,omitempty"`
+}
+
+// FingerprintKind defines model for FingerprintKind.
+type FingerprintKind string
+
+// FocusBlock defines model for FocusBlock.
+type FocusBlock struct {
+	EndTime   time.Time `json:"end_time"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// FocusGapsInput defines model for FocusGapsInput.
+type FocusGapsInput struct {
+	// DayStart When the working day starts, HH:MM in UTC. Defaults to 09:00.
+	DayStart *string            `json:"day_start,omitempty"`
+	EndDate  openapi_types.Date `json:"end_date"`
+
+	// MinFocusMinutes Shortest gap worth recording. Defaults to 30.
+	MinFocusMinutes *int               `json:"min_focus_minutes,omitempty"`
+	ProjectId       openapi_types.UUID `json:"project_id"`
+	StartDate       openapi_types.Date `json:"start_date"`
+
+	// Title Title of the recorded sessions. Defaults to "Focus time".
+	Title *string `json:"title,omitempty"`
+}
+
+// FocusGapsResult defines model for FocusGapsResult.
+type FocusGapsResult struct {
+	// Events The recorded focus sessions, in start order
+	Events     []CalendarEvent `json:"events"`
+	FocusHours float64         `json:"focus_hours"`
+}
+
+// FocusSessionInput defines model for FocusSessionInput.
+type FocusSessionInput struct {
+	EndTime time.Time `json:"end_time"`
+
+	// ExternalId The timer's ID for the session, used to de-duplicate retries. Defaults to the start time.
+	ExternalId *string            `json:"external_id,omitempty"`
+	ProjectId  openapi_types.UUID `json:"project_id"`
+	StartTime  time.Time          `json:"start_time"`
+
+	// Title Event title. Defaults to "Focus session".
+	Title *string `json:"title,omitempty"`
+}
+
+// FocusSessionResult defines model for FocusSessionResult.
+type FocusSessionResult struct {
+	// Events The stored events; more than one when a meeting split the session
+	Events []CalendarEvent `json:"events"`
+
+	// TrimmedMinutes Minutes cut out because they overlapped meetings
+	TrimmedMinutes int `json:"trimmed_minutes"`
+}
+
+// GitHubActivity defines model for GitHubActivity.
+type GitHubActivity struct {
+	At time.Time `json:"at"`
+
+	// Kind commit or review
+	Kind string `json:"kind"`
+
+	// Number Pull request number, for reviews
+	Number *int `json:"number,omitempty"`
+
+	// Repo Repository as "owner/name"
+	Repo string `json:"repo"`
+
+	// Title First line of the commit message, or the pull request title
+	Title string `json:"title"`
+	Url   string `json:"url"`
+}
+
+// GitHubConnection defines model for GitHubConnection.
+type GitHubConnection struct {
+	ConnectedAt time.Time `json:"connected_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Username    string    `json:"username"`
+}
+
+// GitHubConnectionInput defines model for GitHubConnectionInput.
+type GitHubConnectionInput struct {
+	// Token Personal access token with read access to the repositories
+	Token string `json:"token"`
+
+	// Username GitHub login whose activity is suggested
+	Username string `json:"username"`
+}
+
+// GoalProgress defines model for GoalProgress.
+type GoalProgress struct {
+	ActualHours float64 `json:"actual_hours"`
+
+	// ExpectedHours Target pro-rated by the working-hours capacity elapsed so far
+	ExpectedHours float64  `json:"expected_hours"`
+	Goal          HourGoal `json:"goal"`
+
+	// OnTrack Whether the actual hours are at least the expected hours
+	OnTrack bool `json:"on_track"`
+
+	// Percent Actual hours / target
+	Percent     float64            `json:"percent"`
+	PeriodEnd   openapi_types.Date `json:"period_end"`
+	PeriodStart openapi_types.Date `json:"period_start"`
+	ProjectName *string            `json:"project_name"`
+
+	// RemainingHours Hours still needed to reach the target; 0 once met
+	RemainingHours float64 `json:"remaining_hours"`
+}
+
+// GoogleAccess A feature's Google permissions. `calendar` reads calendars for sync;
+// `sheets` creates the spreadsheets invoices are exported to;
+// `calendar_write` writes classified projects back to events.
+type GoogleAccess string
+
+// HourGoal defines model for HourGoal.
+type HourGoal struct {
+	// BillableOnly Count only hours on billable projects
+	BillableOnly bool               `json:"billable_only"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// Period week or month
+	Period string `json:"period"`
+
+	// ProjectId The project the goal covers; null for all projects
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	TargetHours float64             `json:"target_hours"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// HourGoalInput defines model for HourGoalInput.
+type HourGoalInput struct {
+	BillableOnly *bool `json:"billable_only,omitempty"`
+
+	// Period week or month
+	Period string `json:"period"`
+
+	// ProjectId Limit the goal to one project; omit for all projects
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	TargetHours float64             `json:"target_hours"`
+}
+
+// IntegrationConnection defines model for IntegrationConnection.
+type IntegrationConnection struct {
+	ConnectedAt time.Time `json:"connected_at"`
+
+	// ExpiresAt When the provider stops accepting the credentials, if known
+	ExpiresAt *time.Time `json:"expires_at"`
+
+	// KeyVersion How the credentials are encrypted; older versions are re-encrypted on rotation
+	KeyVersion int        `json:"key_version"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Provider   string     `json:"provider"`
+
+	// RotatedAt When the credentials were last replaced
+	RotatedAt *time.Time `json:"rotated_at"`
+
+	// Scopes Scopes the credentials were granted
+	Scopes    []string  `json:"scopes"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IntegrationConnectionInput defines model for IntegrationConnectionInput.
+type IntegrationConnectionInput struct {
+	// Credentials Provider-specific credential fields
+	Credentials map[string]string `json:"credentials"`
+
+	// ExpiresAt When the credentials expire, if they do
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Scopes    *[]string  `json:"scopes,omitempty"`
+}
+
+// Invoice defines model for Invoice.
+type Invoice struct {
+	// AmountPaid Sum of payments recorded against this invoice
+	AmountPaid *float32 `json:"amount_paid,omitempty"`
+
+	// BalanceDue Total less issued credit notes and payments
+	BalanceDue *float32 `json:"balance_due,omitempty"`
+
+	// BillingPeriodId Primary billing period for this invoice
+	BillingPeriodId *openapi_types.UUID `json:"billing_period_id"`
+	CreatedAt       time.Time           `json:"created_at"`
+	Id              openapi_types.UUID  `json:"id"`
+
+	// InvoiceDate Date invoice was created
+	InvoiceDate openapi_types.Date `json:"invoice_date"`
+
+	// InvoiceNumber Auto-generated invoice number (PROJECT-YEAR-SEQ)
+	InvoiceNumber string `json:"invoice_number"`
+
+	// Kind Credit notes reference the invoice they correct and carry negative totals
+	Kind *InvoiceKind `json:"kind,omitempty"`
+
+	// LineItems Invoice line items (included in detail view)
+	LineItems *[]InvoiceLineItem `json:"line_items,omitempty"`
+
+	// OriginalInvoiceId Invoice corrected by this credit note
+	OriginalInvoiceId *openapi_types.UUID `json:"original_invoice_id"`
+
+	// PeriodEnd End date of invoiced period
+	PeriodEnd openapi_types.Date `json:"period_end"`
+
+	// PeriodStart Start date of invoiced period
+	PeriodStart openapi_types.Date `json:"period_start"`
+	Project     *Project           `json:"project,omitempty"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+
+	// SpreadsheetId Google Sheets spreadsheet ID
+	SpreadsheetId *string `json:"spreadsheet_id"`
+
+	// SpreadsheetUrl Full URL to Google Sheets
+	SpreadsheetUrl *string `json:"spreadsheet_url"`
+
+	// Status Invoice status
+	Status InvoiceStatus `json:"status"`
+
+	// TotalAmount Total invoice amount
+	TotalAmount float32 `json:"total_amount"`
+
+	// TotalHours Total billable hours
+	TotalHours float32            `json:"total_hours"`
+	UpdatedAt  *time.Time         `json:"updated_at,omitempty"`
+	UserId     openapi_types.UUID `json:"user_id"`
+
+	// WorksheetId Sheet ID within the spreadsheet
+	WorksheetId *int `json:"worksheet_id"`
+}
+
+// InvoiceKind Credit notes reference the invoice they correct and carry negative totals
+type InvoiceKind string
+
+// InvoiceStatus Invoice status
+type InvoiceStatus string
+
+// InvoiceAdjustmentCreate defines model for InvoiceAdjustmentCreate.
+type InvoiceAdjustmentCreate struct {
+	// Amount Fixed amount; negative for discounts or corrections
+	Amount float32 `json:"amount"`
+
+	// Date Date shown on the line (defaults to the invoice date)
+	Date        *openapi_types.Date `json:"date,omitempty"`
+	Description string              `json:"description"`
+}
+
+// InvoiceComment defines model for InvoiceComment.
+type InvoiceComment struct {
+	Body      string             `json:"body"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+
+	// UserId Author of the comment
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// InvoiceCommentCreate defines model for InvoiceCommentCreate.
+type InvoiceCommentCreate struct {
+	Body string `json:"body"`
+}
+
+// InvoiceCreate defines model for InvoiceCreate.
+type InvoiceCreate struct {
+	// InvoiceDate Invoice date (defaults to today if omitted)
+	InvoiceDate *openapi_types.Date `json:"invoice_date,omitempty"`
+
+	// PeriodEnd End date for unbilled entries (YYYY-MM-DD)
+	PeriodEnd openapi_types.Date `json:"period_end"`
+
+	// PeriodStart Start date for unbilled entries (YYYY-MM-DD)
+	PeriodStart openapi_types.Date `json:"period_start"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+}
+
+// InvoiceExport defines model for InvoiceExport.
+type InvoiceExport struct {
+	ContentType string `json:"content_type"`
+
+	// Downloadable Whether the rendered file can be fetched from the download endpoint
+	Downloadable bool      `json:"downloadable"`
+	ExportedAt   time.Time `json:"exported_at"`
+
+	// ExternalId Remote document ID (e.g. spreadsheet ID)
+	ExternalId *string `json:"external_id"`
+
+	// ExternalUrl Link to the remote document
+	ExternalUrl *string `json:"external_url"`
+	Filename    string  `json:"filename"`
+
+	// Format Export format (csv, pdf, sheets)
+	Format    string             `json:"format"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+}
+
+// InvoiceLineItem defines model for InvoiceLineItem.
+type InvoiceLineItem struct {
+	// Amount Calculated amount (hours * hourly_rate)
+	Amount float32 `json:"amount"`
+
+	// Date Date of the work
+	Date openapi_types.Date `json:"date"`
+
+	// Description Work description from time entry
+	Description string `json:"description"`
+
+	// ExpenseId Source expense (set on expense lines)
+	ExpenseId *openapi_types.UUID `json:"expense_id"`
+
+	// HourlyRate Rate at time of invoice creation (snapshot)
+	HourlyRate float32 `json:"hourly_rate"`
+
+	// Hours Hours worked
+	Hours     float32            `json:"hours"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+
+	// Kind Time lines are billed from a time entry; expenses, adjustments and
+	// fees carry a fixed amount; overage lines bill retainer hours beyond
+	// the month's included hours
+	Kind *InvoiceLineItemKind `json:"kind,omitempty"`
+
+	// RateId Billing period or client rate that was applied
+	RateId *openapi_types.UUID `json:"rate_id"`
+
+	// RateSource Which rate table supplied hourly_rate when the invoice was created
+	RateSource *InvoiceLineItemRateSource `json:"rate_source,omitempty"`
+
+	// RoundingHours Hours added to this line by the billing period's daily minimum or increment; included in hours
+	RoundingHours *float64 `json:"rounding_hours,omitempty"`
+
+	// RoundingRule The rounding rule that added rounding_hours, as it stood when the invoice was created
+	RoundingRule *string `json:"rounding_rule"`
+
+	// TimeEntryId Source time entry (null for adjustments and expenses)
+	TimeEntryId *openapi_types.UUID `json:"time_entry_id"`
+}
+
+// InvoiceLineItemKind Time lines are billed from a time entry; expenses, adjustments and
+// fees carry a fixed amount; overage lines bill retainer hours beyond
+// the month's included hours
+type InvoiceLineItemKind string
+
+// InvoiceLineItemRateSource Which rate table supplied hourly_rate when the invoice was created
+type InvoiceLineItemRateSource string
+
+// LocaleSettings defines model for LocaleSettings.
+type LocaleSettings struct {
+	// Locale Locale tag, e.g. en or de-DE
+	Locale string `json:"locale"`
+
+	// Supported Tags that can be set
+	Supported []string `json:"supported"`
+}
+
+// LocaleUpdate defines model for LocaleUpdate.
+type LocaleUpdate struct {
+	// Locale A supported tag. A bare language such as "de" selects its regional locale.
+	Locale string `json:"locale"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// MatchedEvent defines model for MatchedEvent.
+type MatchedEvent struct {
+	EventId   openapi_types.UUID `json:"event_id"`
+	StartTime time.Time          `json:"start_time"`
+	Title     string             `json:"title"`
+}
+
+// MeetingCostReport defines model for MeetingCostReport.
+type MeetingCostReport struct {
+	Cost        float64            `json:"cost"`
+	EndDate     openapi_types.Date `json:"end_date"`
+	PersonHours float64            `json:"person_hours"`
+
+	// Priced False when no rates were given; costs are then 0
+	Priced    bool                 `json:"priced"`
+	Projects  []ProjectMeetingCost `json:"projects"`
+	Series    []MeetingSeriesCost  `json:"series"`
+	StartDate openapi_types.Date   `json:"start_date"`
+}
+
+// MeetingLoadDay defines model for MeetingLoadDay.
+type MeetingLoadDay struct {
+	Date        openapi_types.Date `json:"date"`
+	FocusBlocks []FocusBlock       `json:"focus_blocks"`
+	FocusHours  float64            `json:"focus_hours"`
+
+	// FragmentedHours Gaps too short to count as focus time
+	FragmentedHours float64 `json:"fragmented_hours"`
+	MeetingHours    float64 `json:"meeting_hours"`
+	MeetingShare    float64 `json:"meeting_share"`
+
+	// Meetings Meetings overlapping the working day
+	Meetings     int     `json:"meetings"`
+	WorkingHours float64 `json:"working_hours"`
+}
+
+// MeetingLoadReport defines model for MeetingLoadReport.
+type MeetingLoadReport struct {
+	// DayStart When each working day starts, HH:MM in UTC
+	DayStart string `json:"day_start"`
+
+	// Days Working days only, in date order
+	Days            []MeetingLoadDay   `json:"days"`
+	EndDate         openapi_types.Date `json:"end_date"`
+	FocusHours      float64            `json:"focus_hours"`
+	FragmentedHours float64            `json:"fragmented_hours"`
+	MeetingHours    float64            `json:"meeting_hours"`
+
+	// MeetingShare Meeting hours / working hours
+	MeetingShare    float64            `json:"meeting_share"`
+	MinFocusMinutes int                `json:"min_focus_minutes"`
+	StartDate       openapi_types.Date `json:"start_date"`
+
+	// Weeks Monday-start weeks, in date order
+	Weeks        []MeetingLoadWeek `json:"weeks"`
+	WorkingHours float64           `json:"working_hours"`
+}
+
+// MeetingLoadWeek defines model for MeetingLoadWeek.
+type MeetingLoadWeek struct {
+	FocusHours      float64            `json:"focus_hours"`
+	FragmentedHours float64            `json:"fragmented_hours"`
+	MeetingHours    float64            `json:"meeting_hours"`
+	MeetingShare    float64            `json:"meeting_share"`
+	WeekStart       openapi_types.Date `json:"week_start"`
+	WorkingHours    float64            `json:"working_hours"`
+}
+
+// MeetingSeriesCost defines model for MeetingSeriesCost.
+type MeetingSeriesCost struct {
+	AvgAttendees float64 `json:"avg_attendees"`
+	Cost         float64 `json:"cost"`
+
+	// Hours Meeting time over all occurrences
+	Hours float64 `json:"hours"`
+
+	// Key Google's recurring event ID, or the title when unknown
+	Key         string              `json:"key"`
+	LastHeld    time.Time           `json:"last_held"`
+	Occurrences int                 `json:"occurrences"`
+	PersonHours float64             `json:"person_hours"`
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	ProjectName *string             `json:"project_name"`
+
+	// Title Title of the latest occurrence
+	Title string `json:"title"`
+}
+
+// MemberDelegationInput defines model for MemberDelegationInput.
+type MemberDelegationInput struct {
+	// Calendars Null uses the organization's default
+	Calendars *MemberDelegationInputCalendars `json:"calendars"`
+}
+
+// MemberDelegationInputCalendars Null uses the organization's default
+type MemberDelegationInputCalendars string
+
+// OAuthAuthorizeResponse defines model for OAuthAuthorizeResponse.
+type OAuthAuthorizeResponse struct {
+	// State State token for CSRF protection
+	State string `json:"state"`
+
+	// Url URL to redirect user for OAuth consent
+	Url string `json:"url"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt        time.Time              `json:"created_at"`
+	Domains          []OrganizationDomain   `json:"domains"`
+	GoogleDelegation OrganizationDelegation `json:"google_delegation"`
+	Id               openapi_types.UUID     `json:"id"`
+	Name             string                 `json:"name"`
+
+	// Role The user's role in the organization
+	Role OrganizationRole `json:"role"`
+
+	// ScimTokenPrefix Start of the current SCIM token, if one was issued
+	ScimTokenPrefix *string         `json:"scim_token_prefix"`
+	Slug            string          `json:"slug"`
+	Sso             OrganizationSSO `json:"sso"`
+}
+
+// OrganizationRole The user's role in the organization
+type OrganizationRole string
+
+// OrganizationCreate defines model for OrganizationCreate.
+type OrganizationCreate struct {
+	Name string `json:"name"`
+
+	// Slug Lowercase letters, digits and hyphens
+	Slug string `json:"slug"`
+}
+
+// OrganizationDelegation defines model for OrganizationDelegation.
+type OrganizationDelegation struct {
+	ClientEmail *string `json:"client_email"`
+
+	// ClientId Client ID to authorize for domain-wide delegation
+	ClientId *string `json:"client_id"`
+
+	// Configured Whether a service account key is stored
+	Configured bool `json:"configured"`
+
+	// DefaultCalendars Which newly found calendars of a member are selected
+	DefaultCalendars OrganizationDelegationDefaultCalendars `json:"default_calendars"`
+
+	// Scopes OAuth scopes to authorize for the client ID
+	Scopes []string `json:"scopes"`
+}
+
+// OrganizationDelegationDefaultCalendars Which newly found calendars of a member are selected
+type OrganizationDelegationDefaultCalendars string
+
+// OrganizationDelegationInput defines model for OrganizationDelegationInput.
+type OrganizationDelegationInput struct {
+	DefaultCalendars OrganizationDelegationInputDefaultCalendars `json:"default_calendars"`
+
+	// ServiceAccountKey The service account's JSON key. Omit to keep the stored one.
+	ServiceAccountKey *string `json:"service_account_key,omitempty"`
+}
+
+// OrganizationDelegationInputDefaultCalendars defines model for OrganizationDelegationInput.DefaultCalendars.
+type OrganizationDelegationInputDefaultCalendars string
+
+// OrganizationDomain defines model for OrganizationDomain.
+type OrganizationDomain struct {
+	AutoJoin         bool   `json:"auto_join"`
+	Domain           string `json:"domain"`
+	VerificationHost string `json:"verification_host"`
+
+	// VerificationRecord TXT record value that proves ownership
+	VerificationRecord string     `json:"verification_record"`
+	Verified           bool       `json:"verified"`
+	VerifiedAt         *time.Time `json:"verified_at"`
+}
+
+// OrganizationDomainInput defines model for OrganizationDomainInput.
+type OrganizationDomainInput struct {
+	AutoJoin bool `json:"auto_join"`
+}
+
+// OrganizationMember defines model for OrganizationMember.
+type OrganizationMember struct {
+	// Active False once the identity provider deactivated the user
+	Active bool `json:"active"`
+
+	// DelegationCalendars Calendars provisioning selects; null uses the organization's default
+	DelegationCalendars *OrganizationMemberDelegationCalendars `json:"delegation_calendars"`
+	Email               string                                 `json:"email"`
+
+	// ExternalId The identity provider's ID for the user
+	ExternalId *string                `json:"external_id"`
+	JoinedAt   time.Time              `json:"joined_at"`
+	Name       string                 `json:"name"`
+	Role       OrganizationMemberRole `json:"role"`
+	UserId     openapi_types.UUID     `json:"user_id"`
+}
+
+// OrganizationMemberDelegationCalendars Calendars provisioning selects; null uses the organization's default
+type OrganizationMemberDelegationCalendars string
+
+// OrganizationMemberRole defines model for OrganizationMember.Role.
+type OrganizationMemberRole string
+
+// OrganizationSSO defines model for OrganizationSSO.
+type OrganizationSSO struct {
+	ClientId        *string `json:"client_id"`
+	Enabled         bool    `json:"enabled"`
+	HasClientSecret bool    `json:"has_client_secret"`
+	Issuer          *string `json:"issuer"`
+
+	// RedirectUri Callback URL to register with the identity provider
+	RedirectUri string `json:"redirect_uri"`
+}
+
+// OrganizationSSOInput defines model for OrganizationSSOInput.
+type OrganizationSSOInput struct {
+	ClientId string `json:"client_id"`
+
+	// ClientSecret Omit to keep the stored secret
+	ClientSecret *string `json:"client_secret,omitempty"`
+	Enabled      bool    `json:"enabled"`
+
+	// Issuer OpenID Connect issuer URL
+	Issuer string `json:"issuer"`
+}
+
+// Payment defines model for Payment.
+type Payment struct {
+	Amount    float32            `json:"amount"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	InvoiceId openapi_types.UUID `json:"invoice_id"`
+
+	// Method How the payment was made (e.g. bank transfer, check)
+	Method *string            `json:"method"`
+	PaidOn openapi_types.Date `json:"paid_on"`
+
+	// Reference Transaction or check reference
+	Reference *string `json:"reference"`
+}
+
+// PaymentCreate defines model for PaymentCreate.
+type PaymentCreate struct {
+	Amount float32 `json:"amount"`
+	Method *string `json:"method,omitempty"`
+
+	// PaidOn Date received (defaults to today)
+	PaidOn    *openapi_types.Date `json:"paid_on,omitempty"`
+	Reference *string             `json:"reference,omitempty"`
+}
+
+// PreviewStats defines model for PreviewStats.
+type PreviewStats struct {
+	// AlreadyCorrect Events already classified to the target project
+	AlreadyCorrect int `json:"already_correct"`
+
+	// ManualConflicts Events with manual classifications that conflict
+	ManualConflicts int `json:"manual_conflicts"`
+	TotalMatches    int `json:"total_matches"`
+
+	// WouldChange Events that would be reclassified
+	WouldChange int `json:"would_change"`
+}
+
+// Project defines model for Project.
+type Project struct {
+	// ArchiveOptions What archiving the project cascades to. The options take effect while
+	// the project is archived and are undone when it is unarchived: rules
+	// disabled by the archive are re-enabled, and fingerprints match again.
+	// On update, omitted options are left unchanged.
+	ArchiveOptions *ProjectArchiveOptions `json:"archive_options,omitempty"`
+
+	// Client Client name for classification filtering
+	Client                 *string   `json:"client,omitempty"`
+	Color                  string    `json:"color"`
+	CreatedAt              time.Time `json:"created_at"`
+	DoesNotAccumulateHours *bool     `json:"does_not_accumulate_hours,omitempty"`
+
+	// FingerprintDomains Domain patterns for auto-classification (e.g., "acme.com")
+	FingerprintDomains *[]string `json:"fingerprint_domains,omitempty"`
+
+	// FingerprintEmails Email addresses for auto-classification
+	FingerprintEmails *[]string `json:"fingerprint_emails,omitempty"`
+
+	// FingerprintExcludeDomains Domains that vote against this project when an attendee matches
+	FingerprintExcludeDomains *[]string `json:"fingerprint_exclude_domains,omitempty"`
+
+	// FingerprintExcludeKeywords Keywords that vote against this project when they appear in an event
+	FingerprintExcludeKeywords *[]string `json:"fingerprint_exclude_keywords,omitempty"`
+
+	// FingerprintKeywords Keywords to match in event titles/descriptions
+	FingerprintKeywords *[]string `json:"fingerprint_keywords,omitempty"`
+
+	// FingerprintWeights Vote weight of individual fingerprints, keyed by "kind:value"
+	// (e.g. "email:ceo@acme.com"). Fingerprints not listed weigh 1.0.
+	FingerprintWeights *map[string]float32 `json:"fingerprint_weights,omitempty"`
+
+	// GithubRepos GitHub repositories ("owner/name") whose activity belongs to this project
+	GithubRepos       *[]string          `json:"github_repos,omitempty"`
+	Id                openapi_types.UUID `json:"id"`
+	IsArchived        bool               `json:"is_archived"`
+	IsBillable        bool               `json:"is_billable"`
+	IsHiddenByDefault *bool              `json:"is_hidden_by_default,omitempty"`
+	Name              string             `json:"name"`
+	ShortCode         *string            `json:"short_code,omitempty"`
+	UpdatedAt         *time.Time         `json:"updated_at,omitempty"`
+	UserId            openapi_types.UUID `json:"user_id"`
+}
+
+// ProjectArchiveOptions What archiving the project cascades to. The options take effect while
+// the project is archived and are undone when it is unarchived: rules
+// disabled by the archive are re-enabled, and fingerprints match again.
+// On update, omitted options are left unchanged.
+type ProjectArchiveOptions struct {
+	// DisableRules Disable the project's enabled rules while it is archived
+	DisableRules *bool `json:"disable_rules,omitempty"`
+
+	// HideFromPickers Leave the archived project out of active project lists (default true)
+	HideFromPickers *bool `json:"hide_from_pickers,omitempty"`
+
+	// StopFingerprints Stop matching the project's fingerprints during classification while it is archived
+	StopFingerprints *bool `json:"stop_fingerprints,omitempty"`
+}
+
+// ProjectCreate defines model for ProjectCreate.
+type ProjectCreate struct {
+	Client                 *string   `json:"client,omitempty"`
+	Color                  *string   `json:"color,omitempty"`
+	DoesNotAccumulateHours *bool     `json:"does_not_accumulate_hours,omitempty"`
+	FingerprintDomains     *[]string `json:"fingerprint_domains,omitempty"`
+	FingerprintEmails      *[]string `json:"fingerprint_emails,omitempty"`
+	FingerprintKeywords    *[]string `json:"fingerprint_keywords,omitempty"`
+	IsBillable             *bool     `json:"is_billable,omitempty"`
+	IsHiddenByDefault      *bool     `json:"is_hidden_by_default,omitempty"`
+	Name                   string    `json:"name"`
+	ShortCode              *string   `json:"short_code,omitempty"`
+}
+
+// ProjectExport Project data for export/import (name is the unique identifier)
+type ProjectExport struct {
+	Client                     *string             `json:"client,omitempty"`
+	Color                      *string             `json:"color,omitempty"`
+	DoesNotAccumulateHours     *bool               `json:"does_not_accumulate_hours,omitempty"`
+	FingerprintDomains         *[]string           `json:"fingerprint_domains,omitempty"`
+	FingerprintEmails          *[]string           `json:"fingerprint_emails,omitempty"`
+	FingerprintExcludeDomains  *[]string           `json:"fingerprint_exclude_domains,omitempty"`
+	FingerprintExcludeKeywords *[]string           `json:"fingerprint_exclude_keywords,omitempty"`
+	FingerprintKeywords        *[]string           `json:"fingerprint_keywords,omitempty"`
+	FingerprintWeights         *map[string]float32 `json:"fingerprint_weights,omitempty"`
+	GithubRepos                *[]string           `json:"github_repos,omitempty"`
+	IsArchived                 *bool               `json:"is_archived,omitempty"`
+	IsBillable                 *bool               `json:"is_billable,omitempty"`
+	IsHiddenByDefault          *bool               `json:"is_hidden_by_default,omitempty"`
+
+	// Name Project name (used as unique identifier)
+	Name      string  `json:"name"`
+	ShortCode *string `json:"short_code,omitempty"`
+}
+
+// ProjectMeetingCost defines model for ProjectMeetingCost.
+type ProjectMeetingCost struct {
+	Cost        float64 `json:"cost"`
+	PersonHours float64 `json:"person_hours"`
+
+	// ProjectId Null for unclassified meetings
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	ProjectName *string             `json:"project_name"`
+	Series      int                 `json:"series"`
+}
+
+// ProjectShare defines model for ProjectShare.
+type ProjectShare struct {
+	// BillableExpenses Portion of expenses that is billable
+	BillableExpenses *float64 `json:"billable_expenses,omitempty"`
+
+	// Expenses Total of the project's expenses dated in the report range
+	Expenses    *float64           `json:"expenses,omitempty"`
+	Hours       float64            `json:"hours"`
+	IsBillable  bool               `json:"is_billable"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+
+	// Share Project hours / total hours
+	Share float64 `json:"share"`
+}
+
+// ProjectTransfer defines model for ProjectTransfer.
+type ProjectTransfer struct {
+	BillingPeriods int                `json:"billing_periods"`
+	Expenses       int                `json:"expenses"`
+	FromUserId     openapi_types.UUID `json:"from_user_id"`
+
+	// Invoices Invoices and credit notes moved with their payments
+	Invoices  int                `json:"invoices"`
+	ProjectId openapi_types.UUID `json:"project_id"`
+
+	// RevokedConnections Calendar connections deleted from the departing member
+	RevokedConnections int `json:"revoked_connections"`
+
+	// TimeEntries Time entries moved, including trashed ones
+	TimeEntries int                `json:"time_entries"`
+	ToUserId    openapi_types.UUID `json:"to_user_id"`
+}
+
+// ProjectTransferInput defines model for ProjectTransferInput.
+type ProjectTransferInput struct {
+	// FromUserId The member who owns the project now
+	FromUserId openapi_types.UUID `json:"from_user_id"`
+	ProjectId  openapi_types.UUID `json:"project_id"`
+
+	// RevokeConnections Delete the departing member's calendar connections
+	RevokeConnections *bool `json:"revoke_connections,omitempty"`
+
+	// ToUserId The active member taking the project over
+	ToUserId openapi_types.UUID `json:"to_user_id"`
+}
+
+// ProjectUpdate defines model for ProjectUpdate.
+type ProjectUpdate struct {
+	// ArchiveOptions What archiving the project cascades to. The options take effect while
+	// the project is archived and are undone when it is unarchived: rules
+	// disabled by the archive are re-enabled, and fingerprints match again.
+	// On update, omitted options are left unchanged.
+	ArchiveOptions             *ProjectArchiveOptions `json:"archive_options,omitempty"`
+	Client                     *string                `json:"client,omitempty"`
+	Color                      *string                `json:"color,omitempty"`
+	DoesNotAccumulateHours     *bool                  `json:"does_not_accumulate_hours,omitempty"`
+	FingerprintDomains         *[]string              `json:"fingerprint_domains,omitempty"`
+	FingerprintEmails          *[]string              `json:"fingerprint_emails,omitempty"`
+	FingerprintExcludeDomains  *[]string              `json:"fingerprint_exclude_domains,omitempty"`
+	FingerprintExcludeKeywords *[]string              `json:"fingerprint_exclude_keywords,omitempty"`
+	FingerprintKeywords        *[]string              `json:"fingerprint_keywords,omitempty"`
+	FingerprintWeights         *map[string]float32    `json:"fingerprint_weights,omitempty"`
+	GithubRepos                *[]string              `json:"github_repos,omitempty"`
+	IsArchived                 *bool                  `json:"is_archived,omitempty"`
+	IsBillable                 *bool                  `json:"is_billable,omitempty"`
+	IsHiddenByDefault          *bool                  `json:"is_hidden_by_default,omitempty"`
+	Name                       *string                `json:"name,omitempty"`
+	ShortCode                  *string                `json:"short_code,omitempty"`
+}
+
+// ReauthCalendar defines model for ReauthCalendar.
+type ReauthCalendar struct {
+	Id openapi_types.UUID `json:"id"`
+
+	// LastError Why the last sync failed
+	LastError *string `json:"last_error"`
+	Name      string  `json:"name"`
+}
+
+// RecalculateTimeEntriesRequest defines model for RecalculateTimeEntriesRequest.
+type RecalculateTimeEntriesRequest struct {
+	// EndDate Inclusive; at most 366 days after start_date
+	EndDate openapi_types.Date `json:"end_date"`
+
+	// ProjectId Only recompute this project's entries
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	StartDate openapi_types.Date  `json:"start_date"`
+}
+
+// RecalculationJob defines model for RecalculationJob.
+type RecalculationJob struct {
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	EndDate     openapi_types.Date `json:"end_date"`
+
+	// EntriesCleared Entries whose events are gone, removed or zeroed so far
+	EntriesCleared int `json:"entries_cleared"`
+
+	// EntriesComputed Entries recomputed from their events so far
+	EntriesComputed int                 `json:"entries_computed"`
+	Error           *string             `json:"error,omitempty"`
+	Id              openapi_types.UUID  `json:"id"`
+	ProcessedDays   int                 `json:"processed_days"`
+	ProjectId       *openapi_types.UUID `json:"project_id,omitempty"`
+	StartDate       openapi_types.Date  `json:"start_date"`
+	StartedAt       *time.Time          `json:"started_at,omitempty"`
+
+	// Status One of pending, running, completed or failed
+	Status    string `json:"status"`
+	TotalDays int    `json:"total_days"`
+}
+
+// ReconcileRequest defines model for ReconcileRequest.
+type ReconcileRequest struct {
+	Resolution ReconcileRequestResolution `json:"resolution"`
+}
+
+// ReconcileRequestResolution defines model for ReconcileRequest.Resolution.
+type ReconcileRequestResolution string
+
+// ReconciliationItem defines model for ReconciliationItem.
+type ReconciliationItem struct {
+	ComputedHours float32 `json:"computed_hours"`
+
+	// Difference Recorded hours minus computed hours
+	Difference float32   `json:"difference"`
+	Entry      TimeEntry `json:"entry"`
+}
+
+// ReconciliationReport defines model for ReconciliationReport.
+type ReconciliationReport struct {
+	Items []ReconciliationItem `json:"items"`
+
+	// TotalDifference Sum of hours minus computed hours across all items
+	TotalDifference float32 `json:"total_difference"`
+}
+
+// RestoreSnapshotResponse defines model for RestoreSnapshotResponse.
+type RestoreSnapshotResponse struct {
+	// RecalculatedDates Days whose time entries were recalculated
+	RecalculatedDates []openapi_types.Date `json:"recalculated_dates"`
+
+	// RestoredCount Number of events whose classification changed
+	RestoredCount int                    `json:"restored_count"`
+	Snapshot      ClassificationSnapshot `json:"snapshot"`
+}
+
+// RetentionPreview defines model for RetentionPreview.
+type RetentionPreview struct {
+	// ArchivedEvents Archived calendar events that would be purged
+	ArchivedEvents int `json:"archived_events"`
+
+	// Cutoff Events starting before this are purged; null when events are kept forever
+	Cutoff *time.Time `json:"cutoff"`
+
+	// Events Calendar events that would be purged
+	Events int `json:"events"`
+
+	// ProtectedEvents Events before the cutoff kept because they feed invoiced time entries
+	ProtectedEvents int `json:"protected_events"`
+}
+
+// RetentionSettings defines model for RetentionSettings.
+type RetentionSettings struct {
+	// EventMonths Months of calendar events kept; null keeps them forever
+	EventMonths *int `json:"event_months"`
+}
+
+// RetentionSettingsUpdate defines model for RetentionSettingsUpdate.
+type RetentionSettingsUpdate struct {
+	EventMonths *int `json:"event_months"`
+}
+
+// ReviewDecision defines model for ReviewDecision.
+type ReviewDecision struct {
+	Decision ReviewDecisionDecision `json:"decision"`
+
+	// ProjectId Project to assign when overriding
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// Skip Skip the event when overriding
+	Skip *bool `json:"skip,omitempty"`
+}
+
+// ReviewDecisionDecision defines model for ReviewDecision.Decision.
+type ReviewDecisionDecision string
+
+// ReviewQueue defines model for ReviewQueue.
+type ReviewQueue struct {
+	Events []CalendarEvent `json:"events"`
+
+	// Total Number of events needing review in the range
+	Total int `json:"total"`
+}
+
+// ReviewResult defines model for ReviewResult.
+type ReviewResult struct {
+	// ActionId Journal entry for this change; pass to POST /api/actions/{id}/undo to revert
+	ActionId *openapi_types.UUID `json:"action_id,omitempty"`
+	Event    CalendarEvent       `json:"event"`
+	Next     *CalendarEvent      `json:"next,omitempty"`
+
+	// Remaining Number of events still needing review
+	Remaining int `json:"remaining"`
+}
+
+// RuleConflict defines model for RuleConflict.
+type RuleConflict struct {
+	CurrentProjectId *openapi_types.UUID `json:"current_project_id"`
+
+	// CurrentSource How the event was classified (manual, rule, llm)
+	CurrentSource     *string             `json:"current_source,omitempty"`
+	EventId           openapi_types.UUID  `json:"event_id"`
+	ProposedProjectId *openapi_types.UUID `json:"proposed_project_id"`
+}
+
+// RuleConflictReport defines model for RuleConflictReport.
+type RuleConflictReport struct {
+	Conflicts       []RulePairConflict `json:"conflicts"`
+	EndDate         openapi_types.Date `json:"end_date"`
+	EventsEvaluated int                `json:"events_evaluated"`
+	StartDate       openapi_types.Date `json:"start_date"`
+}
+
+// RuleConflictSample defines model for RuleConflictSample.
+type RuleConflictSample struct {
+	ClassificationSource *string            `json:"classification_source,omitempty"`
+	EventId              openapi_types.UUID `json:"event_id"`
+
+	// ProjectId Current project, if classified
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	StartTime time.Time           `json:"start_time"`
+	Title     string              `json:"title"`
+}
+
+// RuleCreate defines model for RuleCreate.
+type RuleCreate struct {
+	// ApplyEndDate With apply_to_existing, only events on or before this date
+	ApplyEndDate *openapi_types.Date `json:"apply_end_date,omitempty"`
+
+	// ApplyStartDate With apply_to_existing, only events on or after this date
+	ApplyStartDate *openapi_types.Date `json:"apply_start_date,omitempty"`
+
+	// ApplyToExisting Also classify existing events the new rule matches, in a
+	// background job. Matching events are evaluated against all
+	// enabled rules, as apply_rules would. The rule must be enabled.
+	ApplyToExisting *bool `json:"apply_to_existing,omitempty"`
+
+	// Attended For attendance rules - false means "did not attend"
+	Attended  *bool `json:"attended,omitempty"`
+	IsEnabled *bool `json:"is_enabled,omitempty"`
+
+	// ProjectId Target project (required unless attended is set)
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// Query Gmail-style query string
+	Query  string   `json:"query"`
+	Weight *float32 `json:"weight,omitempty"`
+}
+
+// RuleEvaluation defines model for RuleEvaluation.
+type RuleEvaluation struct {
+	// Matched Whether this rule matched the event
+	Matched bool `json:"matched"`
+
+	// Query The rule query pattern
+	Query string `json:"query"`
+
+	// RuleId Rule identifier
+	RuleId *string `json:"rule_id,omitempty"`
+
+	// Source Whether from explicit rule or fingerprint
+	Source *RuleEvaluationSource `json:"source,omitempty"`
+
+	// TargetId Target project ID or "skip"
+	TargetId *string `json:"target_id,omitempty"`
+
+	// TargetName Target project name
+	TargetName *string `json:"target_name,omitempty"`
+
+	// Weight Rule weight
+	Weight *float32 `json:"weight,omitempty"`
+}
+
+// RuleEvaluationSource Whether from explicit rule or fingerprint
+type RuleEvaluationSource string
+
+// RuleExport Classification rule for export/import (references projects by name)
+type RuleExport struct {
+	IsEnabled *bool `json:"is_enabled,omitempty"`
+
+	// ProjectName Target project name (instead of ID for portability)
+	ProjectName *string `json:"project_name,omitempty"`
+
+	// Query Gmail-style query string
+	Query string `json:"query"`
+
+	// Skip If true, this is a skip rule (attended=false)
+	Skip   *bool    `json:"skip,omitempty"`
+	Weight *float32 `json:"weight,omitempty"`
+}
+
+// RuleLintIssue defines model for RuleLintIssue.
+type RuleLintIssue struct {
+	Kind RuleLintIssueKind `json:"kind"`
+
+	// Length Length of the offending text; absent for issues about the whole query
+	Length  *int   `json:"length,omitempty"`
+	Message string `json:"message"`
+
+	// Position Byte offset of the offending text in the query
+	Position *int `json:"position,omitempty"`
+
+	// RuleId Existing rule concerned, for duplicate and conflict
+	RuleId    *openapi_types.UUID `json:"rule_id,omitempty"`
+	RuleQuery *string             `json:"rule_query,omitempty"`
+
+	// Severity error when the rule can't work as written, warning when it probably isn't what was meant
+	Severity RuleLintIssueSeverity `json:"severity"`
+
+	// Suggestion Replacement for the offending text
+	Suggestion *string `json:"suggestion,omitempty"`
+}
+
+// RuleLintIssueKind defines model for RuleLintIssue.Kind.
+type RuleLintIssueKind string
+
+// RuleLintIssueSeverity error when the rule can't work as written, warning when it probably isn't what was meant
+type RuleLintIssueSeverity string
+
+// RuleLintRequest defines model for RuleLintRequest.
+type RuleLintRequest struct {
+	// Attended For attendance rules, whether matching events were attended
+	Attended *bool `json:"attended,omitempty"`
+
+	// ProjectId Project the rule would classify to
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	Query     string              `json:"query"`
+
+	// RuleId Rule being edited, left out of the comparison
+	RuleId *openapi_types.UUID `json:"rule_id,omitempty"`
+}
+
+// RuleLintResponse defines model for RuleLintResponse.
+type RuleLintResponse struct {
+	Issues []RuleLintIssue `json:"issues"`
+	Query  string          `json:"query"`
+
+	// Valid Whether the query parses
+	Valid bool `json:"valid"`
+}
+
+// RulePack A portable set of rules and fingerprints referencing projects by name
+type RulePack struct {
+	Description  *string               `json:"description,omitempty"`
+	ExportedAt   time.Time             `json:"exported_at"`
+	Fingerprints []RulePackFingerprint `json:"fingerprints"`
+
+	// FormatVersion Pack format version
+	FormatVersion string       `json:"format_version"`
+	Name          string       `json:"name"`
+	Rules         []RuleExport `json:"rules"`
+}
+
+// RulePackConflict defines model for RulePackConflict.
+type RulePackConflict struct {
+	// ExistingRuleId For duplicate_query, the rule already using the query
+	ExistingRuleId *openapi_types.UUID `json:"existing_rule_id,omitempty"`
+
+	// Kind duplicate_query, invalid_query or unknown_project
+	Kind        string  `json:"kind"`
+	Message     string  `json:"message"`
+	ProjectName *string `json:"project_name,omitempty"`
+	Query       *string `json:"query,omitempty"`
+}
+
+// RulePackExportRequest defines model for RulePackExportRequest.
+type RulePackExportRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the pack
+	Name string `json:"name"`
+
+	// ProjectNames Only include rules and fingerprints for these projects. Defaults to all.
+	ProjectNames *[]string `json:"project_names,omitempty"`
+}
+
+// RulePackFingerprint Fingerprints of one pack project
+type RulePackFingerprint struct {
+	Domains         *[]string           `json:"domains,omitempty"`
+	Emails          *[]string           `json:"emails,omitempty"`
+	ExcludeDomains  *[]string           `json:"exclude_domains,omitempty"`
+	ExcludeKeywords *[]string           `json:"exclude_keywords,omitempty"`
+	Keywords        *[]string           `json:"keywords,omitempty"`
+	ProjectName     string              `json:"project_name"`
+	Weights         *map[string]float32 `json:"weights,omitempty"`
+}
+
+// RulePackImportRequest defines model for RulePackImportRequest.
+type RulePackImportRequest struct {
+	// DryRun Report what would happen without writing anything
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Pack A portable set of rules and fingerprints referencing projects by name
+	Pack RulePack `json:"pack"`
+
+	// ProjectMapping Maps pack project names to projects in this account
+	ProjectMapping *map[string]openapi_types.UUID `json:"project_mapping,omitempty"`
+
+	// SkipUnmapped Drop rules and fingerprints of unresolved projects instead of refusing to apply
+	SkipUnmapped *bool `json:"skip_unmapped,omitempty"`
+}
+
+// RulePackImportResult defines model for RulePackImportResult.
+type RulePackImportResult struct {
+	// Applied Whether changes were written. False for dry runs and when projects are unmapped.
+	Applied   bool               `json:"applied"`
+	Conflicts []RulePackConflict `json:"conflicts"`
+
+	// FingerprintsMerged Projects whose fingerprints gained entries, or would
+	FingerprintsMerged int `json:"fingerprints_merged"`
+
+	// RulesCreated Rules created, or that would be created
+	RulesCreated int `json:"rules_created"`
+
+	// RulesSkipped Rules not imported because of a conflict
+	RulesSkipped int `json:"rules_skipped"`
+
+	// UnmappedProjects Pack project names that resolved to no project
+	UnmappedProjects []string `json:"unmapped_projects"`
+}
+
+// RulePairConflict defines model for RulePairConflict.
+type RulePairConflict struct {
+	// Overlap Shared events over the smaller rule's matches
+	Overlap float32         `json:"overlap"`
+	RuleA   ConflictingRule `json:"rule_a"`
+	RuleB   ConflictingRule `json:"rule_b"`
+
+	// Samples Up to five shared events
+	Samples      []RuleConflictSample `json:"samples"`
+	SharedEvents int                  `json:"shared_events"`
+
+	// Suggestion A weight that makes the favoured rule win its shared events
+	Suggestion *RuleWeightSuggestion `json:"suggestion,omitempty"`
+
+	// Tie The rules weigh the same, so shared events can land on either project from one run to the next
+	Tie bool `json:"tie"`
+}
+
+// RulePreviewRequest defines model for RulePreviewRequest.
+type RulePreviewRequest struct {
+	// EndDate End of date range to search
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// ProjectId Target project (for conflict detection)
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+
+	// Query Query to preview
+	Query string `json:"query"`
+
+	// StartDate Start of date range to search
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// RulePreviewResponse defines model for RulePreviewResponse.
+type RulePreviewResponse struct {
+	Conflicts []RuleConflict `json:"conflicts"`
+	Matches   []MatchedEvent `json:"matches"`
+	Stats     PreviewStats   `json:"stats"`
+}
+
+// RuleTestEvent A synthetic event. Omitted fields are empty.
+type RuleTestEvent struct {
+	// Attendees Attendee email addresses
+	Attendees    *[]string  `json:"attendees,omitempty"`
+	CalendarName *string    `json:"calendar_name,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
+
+	// Id Label echoed in the result; defaults to the event's position
+	Id          *string `json:"id,omitempty"`
+	IsRecurring *bool   `json:"is_recurring,omitempty"`
+
+	// ResponseStatus accepted, declined, needsAction or tentative
+	ResponseStatus *string    `json:"response_status,omitempty"`
+	StartTime      *time.Time `json:"start_time,omitempty"`
+	Tags           *[]string  `json:"tags,omitempty"`
+	Title          *string    `json:"title,omitempty"`
+
+	// Transparency opaque or transparent
+	Transparency *string `json:"transparency,omitempty"`
+}
+
+// RuleTestRequest defines model for RuleTestRequest.
+type RuleTestRequest struct {
+	Events []RuleTestEvent `json:"events"`
+
+	// Query Query to test
+	Query string `json:"query"`
+}
+
+// RuleTestResponse defines model for RuleTestResponse.
+type RuleTestResponse struct {
+	MatchedCount int              `json:"matched_count"`
+	Query        string           `json:"query"`
+	Results      []RuleTestResult `json:"results"`
+}
+
+// RuleTestResult defines model for RuleTestResult.
+type RuleTestResult struct {
+	Id      string `json:"id"`
+	Matched bool   `json:"matched"`
+	Trace   []struct {
+		// Condition The condition in query syntax
+		Condition string `json:"condition"`
+		Matched   bool   `json:"matched"`
+	} `json:"trace"`
+}
+
+// RuleUpdate defines model for RuleUpdate.
+type RuleUpdate struct {
+	Attended  *bool               `json:"attended"`
+	IsEnabled *bool               `json:"is_enabled,omitempty"`
+	ProjectId *openapi_types.UUID `json:"project_id"`
+	Query     *string             `json:"query,omitempty"`
+	Weight    *float32            `json:"weight,omitempty"`
+}
+
+// RuleWeightSuggestion A weight that makes the favoured rule win its shared events
+type RuleWeightSuggestion struct {
+	CurrentWeight   float32            `json:"current_weight"`
+	Reason          string             `json:"reason"`
+	RuleId          openapi_types.UUID `json:"rule_id"`
+	SuggestedWeight float32            `json:"suggested_weight"`
+}
+
+// SCIMToken defines model for SCIMToken.
+type SCIMToken struct {
+	Prefix string `json:"prefix"`
+
+	// ScimUrl SCIM 2.0 base URL to configure in the identity provider
+	ScimUrl string `json:"scim_url"`
+
+	// Token Bearer token for SCIM requests; shown only once
+	Token string `json:"token"`
+}
+
+// SSOStartRequest defines model for SSOStartRequest.
+type SSOStartRequest struct {
+	// Email Finds the organization by the email's verified domain
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Organization Organization slug; takes precedence over email
+	Organization *string `json:"organization,omitempty"`
+}
+
+// SSOStartResponse defines model for SSOStartResponse.
+type SSOStartResponse struct {
+	// AuthorizationUrl Identity provider login URL to send the browser to
+	AuthorizationUrl string `json:"authorization_url"`
+}
+
+// SignupRequest defines model for SignupRequest.
+type SignupRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Name     string              `json:"name"`
+	Password string              `json:"password"`
+}
+
+// SuppressionRule defines model for SuppressionRule.
+type SuppressionRule struct {
+	CalendarId *openapi_types.UUID `json:"calendar_id"`
+	CreatedAt  time.Time           `json:"created_at"`
+	Id         openapi_types.UUID  `json:"id"`
+	IsEnabled  bool                `json:"is_enabled"`
+	Query      *string             `json:"query"`
+}
+
+// SuppressionRuleCreate defines model for SuppressionRuleCreate.
+type SuppressionRuleCreate struct {
+	CalendarId *openapi_types.UUID `json:"calendar_id,omitempty"`
+
+	// Query Query in the rule syntax; at least one of calendar_id and query is required
+	Query *string `json:"query,omitempty"`
+}
+
+// SuppressionRuleResult defines model for SuppressionRuleResult.
+type SuppressionRuleResult struct {
+	// ReleasedCount Events returned to the pending queue
+	ReleasedCount int             `json:"released_count"`
+	Rule          SuppressionRule `json:"rule"`
+
+	// SuppressedCount Pending events newly suppressed
+	SuppressedCount int `json:"suppressed_count"`
+}
+
+// SuppressionRuleUpdate defines model for SuppressionRuleUpdate.
+type SuppressionRuleUpdate struct {
+	IsEnabled bool `json:"is_enabled"`
+}
+
+// SyncResult defines model for SyncResult.
+type SyncResult struct {
+	EventsCreated  int `json:"events_created"`
+	EventsOrphaned int `json:"events_orphaned"`
+	EventsUpdated  int `json:"events_updated"`
+}
+
+// SyncSettings defines model for SyncSettings.
+type SyncSettings struct {
+	// IntervalMinutes How often background sync refreshes the calendars
+	IntervalMinutes int `json:"interval_minutes"`
+
+	// IsDefault True when the user hasn't chosen their own cadence
+	IsDefault bool `json:"is_default"`
+
+	// StaleAfterMinutes Age at which reading synced events refreshes them
+	StaleAfterMinutes int `json:"stale_after_minutes"`
+}
+
+// SyncSettingsUpdate defines model for SyncSettingsUpdate.
+type SyncSettingsUpdate struct {
+	IntervalMinutes   *int `json:"interval_minutes"`
+	StaleAfterMinutes *int `json:"stale_after_minutes"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EntryCount Time entries carrying the tag
+	EntryCount int `json:"entry_count"`
+
+	// EventCount Events carrying the tag
+	EventCount int                `json:"event_count"`
+	Id         openapi_types.UUID `json:"id"`
+	Name       string             `json:"name"`
+}
+
+// TagAssignment defines model for TagAssignment.
+type TagAssignment struct {
+	// Tags Tag names; replaces the existing tags
+	Tags []string `json:"tags"`
+}
+
+// TagCreate defines model for TagCreate.
+type TagCreate struct {
+	Name string `json:"name"`
+}
+
+// TagShare defines model for TagShare.
+type TagShare struct {
+	Hours float64 `json:"hours"`
+
+	// Share Tag hours / total hours. Entries with several tags count toward
+	// each, so shares can add up to more than 1.
+	Share float64 `json:"share"`
+	Tag   string  `json:"tag"`
+}
+
+// TargetScore defines model for TargetScore.
+type TargetScore struct {
+	// ExclusionWeight Negative weight from exclusion fingerprints that matched
+	ExclusionWeight *float32 `json:"exclusion_weight,omitempty"`
+
+	// FingerprintWeight Weight from project fingerprint matches
+	FingerprintWeight *float32 `json:"fingerprint_weight,omitempty"`
+
+	// IsWinner Whether this project won the classification
+	IsWinner *bool `json:"is_winner,omitempty"`
+
+	// RuleWeight Weight from explicit user-defined rules
+	RuleWeight *float32 `json:"rule_weight,omitempty"`
+
+	// TargetId Project ID
+	TargetId openapi_types.UUID `json:"target_id"`
+
+	// TargetName Project name
+	TargetName *string `json:"target_name,omitempty"`
+
+	// TotalWeight Total accumulated weight for this project
+	TotalWeight float32 `json:"total_weight"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// CalculationDetails Audit trail showing how hours were calculated
+	CalculationDetails *CalculationDetails `json:"calculation_details,omitempty"`
+
+	// ComputedDescription Generated description from events
+	ComputedDescription *string `json:"computed_description,omitempty"`
+
+	// ComputedHours Latest calculated hours from events
+	ComputedHours *float32 `json:"computed_hours,omitempty"`
+
+	// ComputedTitle Generated title from events
+	ComputedTitle *string `json:"computed_title,omitempty"`
+
+	// ContributingEvents Event IDs that contribute to this entry
+	ContributingEvents *[]openapi_types.UUID `json:"contributing_events,omitempty"`
+	CreatedAt          time.Time             `json:"created_at"`
+
+	// Date The calendar day for this entry
+	Date openapi_types.Date `json:"date"`
+
+	// DeletedAt When the entry was moved to the trash
+	DeletedAt   *time.Time `json:"deleted_at"`
+	Description *string    `json:"description,omitempty"`
+
+	// HasUserEdits Whether user has modified this entry
+	HasUserEdits *bool              `json:"has_user_edits,omitempty"`
+	Hours        float32            `json:"hours"`
+	Id           openapi_types.UUID `json:"id"`
+
+	// InvoiceId If invoiced, the invoice ID
+	InvoiceId *openapi_types.UUID `json:"invoice_id"`
+
+	// IsLocked Entry is locked (via Lock Day/Week)
+	IsLocked *bool `json:"is_locked,omitempty"`
+
+	// IsPinned Entry is pinned (user has edited it)
+	IsPinned *bool `json:"is_pinned,omitempty"`
+
+	// IsStale Computed values differ from current values
+	IsStale *bool `json:"is_stale,omitempty"`
+
+	// IsSuppressed User explicitly suppressed this entry
+	IsSuppressed *bool              `json:"is_suppressed,omitempty"`
+	Project      *Project           `json:"project,omitempty"`
+	ProjectId    openapi_types.UUID `json:"project_id"`
+
+	// SnapshotComputedHours Computed hours at the time of materialization (for staleness detection)
+	SnapshotComputedHours *float32 `json:"snapshot_computed_hours,omitempty"`
+
+	// Source How this entry was created
+	Source TimeEntrySource `json:"source"`
+
+	// Tags The user's tags on this entry; computed entries have none
+	Tags *[]string `json:"tags,omitempty"`
+
+	// Title Short title (generated from events or user-provided)
+	Title     *string            `json:"title,omitempty"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	UserId    openapi_types.UUID `json:"user_id"`
+}
+
+// TimeEntrySource How this entry was created
+type TimeEntrySource string
+
+// TimeEntryBreakdown defines model for TimeEntryBreakdown.
+type TimeEntryBreakdown struct {
+	// ComputedHours Hours computed from the events, when the entry has been computed
+	ComputedHours *float64                  `json:"computed_hours,omitempty"`
+	Date          openapi_types.Date        `json:"date"`
+	Events        []TimeEntryBreakdownEvent `json:"events"`
+
+	// FinalMinutes Minutes after rounding
+	FinalMinutes int `json:"final_minutes"`
+
+	// Hours The entry's hours, including any user edits
+	Hours     float64            `json:"hours"`
+	ProjectId openapi_types.UUID `json:"project_id"`
+
+	// RoundingApplied Adjustment made by rounding, e.g. "+10m", or "none"
+	RoundingApplied string             `json:"rounding_applied"`
+	TimeEntryId     openapi_types.UUID `json:"time_entry_id"`
+
+	// TimeRanges The events' times merged where they overlap or touch
+	TimeRanges []struct {
+		// End Local time of day (HH:MM)
+		End     string `json:"end"`
+		Minutes int    `json:"minutes"`
+
+		// Start Local time of day (HH:MM)
+		Start string `json:"start"`
+	} `json:"time_ranges"`
+
+	// UnionMinutes Total minutes of the merged ranges
+	UnionMinutes int `json:"union_minutes"`
+}
+
+// TimeEntryBreakdownEvent defines model for TimeEntryBreakdownEvent.
+type TimeEntryBreakdownEvent struct {
+	// CountedEnd End of the part of the event that counted, omitted when none did
+	CountedEnd *time.Time `json:"counted_end,omitempty"`
+
+	// CountedMinutes Minutes not already covered by an earlier event
+	CountedMinutes int `json:"counted_minutes"`
+
+	// CountedStart Start of the part of the event that counted, omitted when none did
+	CountedStart *time.Time `json:"counted_start,omitempty"`
+	End          *time.Time `json:"end,omitempty"`
+	EventId      string     `json:"event_id"`
+
+	// IsAllDay All-day events are listed but contribute no time
+	IsAllDay bool `json:"is_all_day"`
+
+	// OverlapMinutes Minutes already covered by an earlier event
+	OverlapMinutes int `json:"overlap_minutes"`
+
+	// RawMinutes The event's full length
+	RawMinutes int        `json:"raw_minutes"`
+	Start      *time.Time `json:"start,omitempty"`
+	Title      string     `json:"title"`
+}
+
+// TimeEntryCreate defines model for TimeEntryCreate.
+type TimeEntryCreate struct {
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description,omitempty"`
+	Hours       float32            `json:"hours"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+}
+
+// TimeEntryDraft defines model for TimeEntryDraft.
+type TimeEntryDraft struct {
+	// Complete Whether the draft has a duration and a project
+	Complete    bool               `json:"complete"`
+	Date        openapi_types.Date `json:"date"`
+	Description *string            `json:"description"`
+	Entry       *TimeEntry         `json:"entry,omitempty"`
+
+	// Hours Parsed duration, 0 when none was found
+	Hours float32 `json:"hours"`
+
+	// Match How the project was matched - short_code, name, fingerprint or fuzzy
+	Match       *string             `json:"match"`
+	ProjectId   *openapi_types.UUID `json:"project_id"`
+	ProjectName *string             `json:"project_name"`
+
+	// Warnings Parts of the text that could not be understood
+	Warnings []string `json:"warnings"`
+}
+
+// TimeEntryExcludedEvent defines model for TimeEntryExcludedEvent.
+type TimeEntryExcludedEvent struct {
+	EndTime time.Time          `json:"end_time"`
+	EventId openapi_types.UUID `json:"event_id"`
+
+	// Reason skipped: skipped by hand. not_attended: skipped by an attendance
+	// rule. not_yet_counted: classified since the entry was last
+	// computed.
+	Reason    TimeEntryExcludedEventReason `json:"reason"`
+	StartTime time.Time                    `json:"start_time"`
+	Title     string                       `json:"title"`
+}
+
+// TimeEntryExcludedEventReason skipped: skipped by hand. not_attended: skipped by an attendance
+// rule. not_yet_counted: classified since the entry was last
+// computed.
+type TimeEntryExcludedEventReason string
+
+// TimeEntryExplanation defines model for TimeEntryExplanation.
+type TimeEntryExplanation struct {
+	Breakdown TimeEntryBreakdown `json:"breakdown"`
+
+	// ExcludedEvents Events on the entry's day classified to its project that don't count toward it
+	ExcludedEvents []TimeEntryExcludedEvent `json:"excluded_events"`
+
+	// Narrative The calculation in plain language, one step per item
+	Narrative  []string            `json:"narrative"`
+	Protection TimeEntryProtection `json:"protection"`
+}
+
+// TimeEntryNote defines model for TimeEntryNote.
+type TimeEntryNote struct {
+	AuthorName  string             `json:"author_name"`
+	Body        string             `json:"body"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Id          openapi_types.UUID `json:"id"`
+	TimeEntryId openapi_types.UUID `json:"time_entry_id"`
+
+	// UserId Author of the note
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// TimeEntryNoteCreate defines model for TimeEntryNoteCreate.
+type TimeEntryNoteCreate struct {
+	Body string `json:"body"`
+}
+
+// TimeEntryParseRequest defines model for TimeEntryParseRequest.
+type TimeEntryParseRequest struct {
+	// Text Free-text entry, e.g. "2h yesterday on Acme — code review"
+	Text string `json:"text"`
+
+	// Timezone IANA time zone used to resolve relative dates. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// TimeEntryProtection defines model for TimeEntryProtection.
+type TimeEntryProtection struct {
+	// Deleted The entry is in the trash
+	Deleted   bool                `json:"deleted"`
+	InvoiceId *openapi_types.UUID `json:"invoice_id,omitempty"`
+
+	// Invoiced Recalculation no longer changes the entry's hours, title or description
+	Invoiced bool `json:"invoiced"`
+
+	// Stale The entry's events changed after it was protected
+	Stale      bool `json:"stale"`
+	Suppressed bool `json:"suppressed"`
+
+	// UserEdited The entry is kept, marked stale, when its events go away
+	UserEdited bool `json:"user_edited"`
+}
+
+// TimeEntrySplit defines model for TimeEntrySplit.
+type TimeEntrySplit struct {
+	// Date Required when splitting an ephemeral entry (to materialize it)
+	Date  *openapi_types.Date  `json:"date,omitempty"`
+	Parts []TimeEntrySplitPart `json:"parts"`
+
+	// ProjectId Required when splitting an ephemeral entry (to materialize it)
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+}
+
+// TimeEntrySplitPart defines model for TimeEntrySplitPart.
+type TimeEntrySplitPart struct {
+	Description *string            `json:"description,omitempty"`
+	Hours       float32            `json:"hours"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+}
+
+// TimeEntryUpdate defines model for TimeEntryUpdate.
+type TimeEntryUpdate struct {
+	// Date Required when updating an ephemeral entry (to materialize it)
+	Date        *openapi_types.Date `json:"date,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Hours       *float32            `json:"hours,omitempty"`
+
+	// ProjectId Required when updating an ephemeral entry (to materialize it)
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+}
+
+// Trash defines model for Trash.
+type Trash struct {
+	Rules       []ClassificationRule `json:"rules"`
+	TimeEntries []TimeEntry          `json:"time_entries"`
+}
+
+// UndoActionResponse defines model for UndoActionResponse.
+type UndoActionResponse struct {
+	Action ClassificationAction `json:"action"`
+
+	// RecalculatedDates Days whose time entries were recalculated
+	RecalculatedDates []openapi_types.Date `json:"recalculated_dates"`
+}
+
+// UpdateCalendarSourcesRequest defines model for UpdateCalendarSourcesRequest.
+type UpdateCalendarSourcesRequest struct {
+	// CalendarIds IDs of calendars to enable for syncing
+	CalendarIds []openapi_types.UUID `json:"calendar_ids"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt time.Time           `json:"created_at"`
+	Email     openapi_types.Email `json:"email"`
+	Id        openapi_types.UUID  `json:"id"`
+	Name      string              `json:"name"`
+}
+
+// UtilizationReport defines model for UtilizationReport.
+type UtilizationReport struct {
+	// Audience Time on internal vs client-facing events, from the durations of
+	// counted classified events (overlapping events each count in full).
+	// Absent until company domains are set.
+	Audience      *AudienceSplit     `json:"audience,omitempty"`
+	BillableHours float64            `json:"billable_hours"`
+	CapacityHours float64            `json:"capacity_hours"`
+	EndDate       openapi_types.Date `json:"end_date"`
+	Projects      []ProjectShare     `json:"projects"`
+	StartDate     openapi_types.Date `json:"start_date"`
+
+	// Tags Hours on saved time entries by tag, most hours first
+	Tags       *[]TagShare `json:"tags,omitempty"`
+	TotalHours float64     `json:"total_hours"`
+
+	// Utilization Billable hours / capacity (0 when there is no capacity)
+	Utilization float64           `json:"utilization"`
+	Weeks       []UtilizationWeek `json:"weeks"`
+}
+
+// UtilizationWeek defines model for UtilizationWeek.
+type UtilizationWeek struct {
+	BillableHours float64 `json:"billable_hours"`
+
+	// CapacityHours Capacity for the days of this week inside the report range
+	CapacityHours float64            `json:"capacity_hours"`
+	TotalHours    float64            `json:"total_hours"`
+	Utilization   float64            `json:"utilization"`
+	WeekStart     openapi_types.Date `json:"week_start"`
+}
+
+// WeekGrid defines model for WeekGrid.
+type WeekGrid struct {
+	Days []WeekGridDay      `json:"days"`
+	End  openapi_types.Date `json:"end"`
+
+	// Rows One row per project with entries in the week, by name
+	Rows       []WeekGridRow      `json:"rows"`
+	Start      openapi_types.Date `json:"start"`
+	TotalHours float32            `json:"total_hours"`
+}
+
+// WeekGridCell defines model for WeekGridCell.
+type WeekGridCell struct {
+	Date openapi_types.Date `json:"date"`
+
+	// EntryId The entry behind the cell, if any (ephemeral entries have stable IDs too)
+	EntryId   *openapi_types.UUID `json:"entry_id"`
+	Hours     float32             `json:"hours"`
+	InvoiceId *openapi_types.UUID `json:"invoice_id"`
+
+	// Locked Whether the entry is locked by an invoice
+	Locked bool `json:"locked"`
+}
+
+// WeekGridDay defines model for WeekGridDay.
+type WeekGridDay struct {
+	Date  openapi_types.Date `json:"date"`
+	Hours float32            `json:"hours"`
+
+	// UnclassifiedCount Events starting this day still pending classification
+	UnclassifiedCount int `json:"unclassified_count"`
+}
+
+// WeekGridRow defines model for WeekGridRow.
+type WeekGridRow struct {
+	// Cells One cell per day, in the order of `days`
+	Cells                  []WeekGridCell     `json:"cells"`
+	Color                  *string            `json:"color,omitempty"`
+	DoesNotAccumulateHours bool               `json:"does_not_accumulate_hours"`
+	ProjectId              openapi_types.UUID `json:"project_id"`
+	ProjectName            string             `json:"project_name"`
+	TotalHours             float32            `json:"total_hours"`
+}
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	// DailyHours Hours available each weekday, Monday first
+	DailyHours []float64 `json:"daily_hours"`
+
+	// IsDefault True until the user saves a profile
+	IsDefault bool       `json:"is_default"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// WorkingHoursUpdate defines model for WorkingHoursUpdate.
+type WorkingHoursUpdate struct {
+	// DailyHours Hours available each weekday, Monday first (0-24 each)
+	DailyHours []float64 `json:"daily_hours"`
+}
+
+// ListClassificationActionsParams defines parameters for ListClassificationActions.
+type ListClassificationActionsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListAnomaliesParams defines parameters for ListAnomalies.
+type ListAnomaliesParams struct {
+	// StartDate First day to include (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day to include (YYYY-MM-DD)
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// IncludeDismissed Also return anomalies that were already dismissed
+	IncludeDismissed *bool `form:"include_dismissed,omitempty" json:"include_dismissed,omitempty"`
+}
+
+// GoogleAuthorizeParams defines parameters for GoogleAuthorize.
+type GoogleAuthorizeParams struct {
+	// Access Access to request; defaults to calendar
+	Access *GoogleAccess `form:"access,omitempty" json:"access,omitempty"`
+}
+
+// GoogleCallbackParams defines parameters for GoogleCallback.
+type GoogleCallbackParams struct {
+	// Code Authorization code from Google
+	Code string `form:"code" json:"code"`
+
+	// State State parameter for CSRF protection
+	State string `form:"state" json:"state"`
+}
+
+// PreviewRetentionParams defines parameters for PreviewRetention.
+type PreviewRetentionParams struct {
+	EventMonths *int `form:"event_months,omitempty" json:"event_months,omitempty"`
+}
+
+// ListBillingPeriodsParams defines parameters for ListBillingPeriods.
+type ListBillingPeriodsParams struct {
+	ProjectId openapi_types.UUID `form:"project_id" json:"project_id"`
+}
+
+// ListClientBalancesParams defines parameters for ListClientBalances.
+type ListClientBalancesParams struct {
+	// Client Only return the balance for this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+}
+
+// ListCalendarEventsParams defines parameters for ListCalendarEvents.
+type ListCalendarEventsParams struct {
+	// StartDate Start date (YYYY-MM-DD). Defaults to 30 days ago.
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate End date (YYYY-MM-DD). Defaults to today.
+	EndDate              *openapi_types.Date                           `form:"end_date,omitempty" json:"end_date,omitempty"`
+	ClassificationStatus *ListCalendarEventsParamsClassificationStatus `form:"classification_status,omitempty" json:"classification_status,omitempty"`
+	ConnectionId         *openapi_types.UUID                           `form:"connection_id,omitempty" json:"connection_id,omitempty"`
+
+	// Tag Only return events carrying this tag (case-insensitive)
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// ListCalendarEventsParamsClassificationStatus defines parameters for ListCalendarEvents.
+type ListCalendarEventsParamsClassificationStatus string
+
+// DiffCalendarEventsParams defines parameters for DiffCalendarEvents.
+type DiffCalendarEventsParams struct {
+	StartDate openapi_types.Date `form:"start_date" json:"start_date"`
+
+	// EndDate Last day of the range, inclusive
+	EndDate openapi_types.Date `form:"end_date" json:"end_date"`
+	Since   time.Time          `form:"since" json:"since"`
+
+	// Until Defaults to now
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetReviewQueueParams defines parameters for GetReviewQueue.
+type GetReviewQueueParams struct {
+	// StartDate Only events starting on or after this date (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Only events starting on or before this date (YYYY-MM-DD)
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+	Limit   *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SyncCalendarParams defines parameters for SyncCalendar.
+type SyncCalendarParams struct {
+	// StartDate Start date for on-demand sync (defaults to the connection's sync window, 90 days ago unless configured)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate End date for on-demand sync (defaults to the connection's sync window, 30 days from now unless configured)
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// ListChangesParams defines parameters for ListChanges.
+type ListChangesParams struct {
+	// Since Opaque cursor returned as next_cursor by a previous call
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// ResourceType Only return changes to calendar_event, time_entry or invoice resources
+	ResourceType *string `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// Limit Maximum number of changes to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListClientRatesParams defines parameters for ListClientRates.
+type ListClientRatesParams struct {
+	// Client Only return rates for this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+}
+
+// ExportConfigParams defines parameters for ExportConfig.
+type ExportConfigParams struct {
+	// IncludeArchived Include archived projects in export
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// ListExpensesParams defines parameters for ListExpenses.
+type ListExpensesParams struct {
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// StartDate First expense date to include (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last expense date to include (YYYY-MM-DD)
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Billable Only billable (true) or non-billable (false) expenses
+	Billable *bool `form:"billable,omitempty" json:"billable,omitempty"`
+}
+
+// ListFingerprintClaimsParams defines parameters for ListFingerprintClaims.
+type ListFingerprintClaimsParams struct {
+	// Domain Domain to look up, e.g. acme.com
+	Domain string `form:"domain" json:"domain"`
+}
+
+// GetGoalsProgressParams defines parameters for GetGoalsProgress.
+type GetGoalsProgressParams struct {
+	// Date Day to measure progress on (YYYY-MM-DD). Defaults to today.
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// ListInvoicesParams defines parameters for ListInvoices.
+type ListInvoicesParams struct {
+	ProjectId *openapi_types.UUID       `form:"project_id,omitempty" json:"project_id,omitempty"`
+	Status    *ListInvoicesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListInvoicesParamsStatus defines parameters for ListInvoices.
+type ListInvoicesParamsStatus string
+
+// ExportInvoiceParams defines parameters for ExportInvoice.
+type ExportInvoiceParams struct {
+	Format ExportInvoiceParamsFormat `form:"format" json:"format"`
+
+	// IncludeNotes Add each time entry's notes below its line
+	IncludeNotes *bool `form:"include_notes,omitempty" json:"include_notes,omitempty"`
+}
+
+// ExportInvoiceParamsFormat defines parameters for ExportInvoice.
+type ExportInvoiceParamsFormat string
+
+// UpdateInvoiceStatusJSONBody defines parameters for UpdateInvoiceStatus.
+type UpdateInvoiceStatusJSONBody struct {
+	Status UpdateInvoiceStatusJSONBodyStatus `json:"status"`
+}
+
+// UpdateInvoiceStatusJSONBodyStatus defines parameters for UpdateInvoiceStatus.
+type UpdateInvoiceStatusJSONBodyStatus string
+
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// IncludeArchived Include archived/inactive projects
+	IncludeArchived *bool `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+
+	// Archived Only archived (true) or only active (false) projects; overrides include_archived
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+
+	// Client Only projects for this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+
+	// Billable Only billable (true) or non-billable (false) projects
+	Billable *bool `form:"billable,omitempty" json:"billable,omitempty"`
+
+	// Sort Sort order: "name", "recent" (most recent time entry first) or
+	// "month_hours" (most hours logged this month first)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of projects to return; all when omitted
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of projects to skip, for paging with limit
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetMeetingCostReportParams defines parameters for GetMeetingCostReport.
+type GetMeetingCostReportParams struct {
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// DefaultRate Hourly cost of an attendee without a more specific rate
+	DefaultRate *float64 `form:"default_rate,omitempty" json:"default_rate,omitempty"`
+
+	// Rate Per-person hourly rates as email:amount or domain:amount, e.g.
+	// `ceo@acme.com:400`. An email rate beats its domain's.
+	Rate *[]string `form:"rate,omitempty" json:"rate,omitempty"`
+}
+
+// GetMeetingLoadReportParams defines parameters for GetMeetingLoadReport.
+type GetMeetingLoadReportParams struct {
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// DayStart When the working day starts, HH:MM in UTC. Defaults to 09:00.
+	DayStart *string `form:"day_start,omitempty" json:"day_start,omitempty"`
+
+	// MinFocusMinutes Shortest gap between meetings that counts as focus time. Defaults to 30.
+	MinFocusMinutes *int `form:"min_focus_minutes,omitempty" json:"min_focus_minutes,omitempty"`
+}
+
+// GetUtilizationReportParams defines parameters for GetUtilizationReport.
+type GetUtilizationReportParams struct {
+	// StartDate First day of the report (YYYY-MM-DD)
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate Last day of the report (YYYY-MM-DD). Defaults to today.
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// ListRulesParams defines parameters for ListRules.
+type ListRulesParams struct {
+	// IncludeDisabled Include disabled rules
+	IncludeDisabled *bool `form:"include_disabled,omitempty" json:"include_disabled,omitempty"`
+
+	// ProjectId Only rules targeting this project
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Client Only rules targeting a project of this client
+	Client *string `form:"client,omitempty" json:"client,omitempty"`
+
+	// Sort Sort order: "weight" (heaviest first), "recent" (most recently
+	// updated first) or "project" (by target project name)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Maximum number of rules to return; all when omitted
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of rules to skip, for paging with limit
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetRuleConflictsParams defines parameters for GetRuleConflicts.
+type GetRuleConflictsParams struct {
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// MinOverlap Share of the smaller rule's matches that must be shared (default 0.5)
+	MinOverlap *float32 `form:"min_overlap,omitempty" json:"min_overlap,omitempty"`
+}
+
+// ListClassificationSnapshotsParams defines parameters for ListClassificationSnapshots.
+type ListClassificationSnapshotsParams struct {
+	// WeekStart Any date in the week; normalized to its Monday
+	WeekStart *openapi_types.Date `form:"week_start,omitempty" json:"week_start,omitempty"`
+}
+
+// ListTimeEntriesParams defines parameters for ListTimeEntries.
+type ListTimeEntriesParams struct {
+	// StartDate Start date (YYYY-MM-DD). Defaults to 7 days ago.
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate End date (YYYY-MM-DD). Defaults to today.
+	EndDate *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// ProjectId Filter by project
+	ProjectId *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Tag Only return saved entries carrying this tag (case-insensitive)
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// SuggestDescriptionsParams defines parameters for SuggestDescriptions.
+type SuggestDescriptionsParams struct {
+	// Date Day to suggest descriptions for (YYYY-MM-DD)
+	Date openapi_types.Date `form:"date" json:"date"`
+}
+
+// ParseTimeEntryParams defines parameters for ParseTimeEntry.
+type ParseTimeEntryParams struct {
+	// Commit Create the time entry when the draft is complete
+	Commit *bool `form:"commit,omitempty" json:"commit,omitempty"`
+}
+
+// GetReconciliationReportParams defines parameters for GetReconciliationReport.
+type GetReconciliationReportParams struct {
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// IncludeAcknowledged Also list divergences previously resolved with keep_manual
+	IncludeAcknowledged *bool `form:"include_acknowledged,omitempty" json:"include_acknowledged,omitempty"`
+}
+
+// AnalyzeAnomaliesJSONRequestBody defines body for AnalyzeAnomalies for application/json ContentType.
+type AnalyzeAnomaliesJSONRequestBody = AnalyzeAnomaliesRequest
+
+// CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
+type CreateApiKeyJSONRequestBody = ApiKeyCreate
+
+// UpdateApiKeyJSONRequestBody defines body for UpdateApiKey for application/json ContentType.
+type UpdateApiKeyJSONRequestBody = ApiKeyUpdate
+
+// CreateAttendeeAliasJSONRequestBody defines body for CreateAttendeeAlias for application/json ContentType.
+type CreateAttendeeAliasJSONRequestBody = AttendeeAliasCreate
+
+// UpdateCompanyDomainsJSONRequestBody defines body for UpdateCompanyDomains for application/json ContentType.
+type UpdateCompanyDomainsJSONRequestBody = CompanyDomains
+
+// UpdateLocaleJSONRequestBody defines body for UpdateLocale for application/json ContentType.
+type UpdateLocaleJSONRequestBody = LocaleUpdate
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequest
+
+// UpdateRetentionSettingsJSONRequestBody defines body for UpdateRetentionSettings for application/json ContentType.
+type UpdateRetentionSettingsJSONRequestBody = RetentionSettingsUpdate
+
+// SignupJSONRequestBody defines body for Signup for application/json ContentType.
+type SignupJSONRequestBody = SignupRequest
+
+// StartSsoJSONRequestBody defines body for StartSso for application/json ContentType.
+type StartSsoJSONRequestBody = SSOStartRequest
+
+// UpdateSyncSettingsJSONRequestBody defines body for UpdateSyncSettings for application/json ContentType.
+type UpdateSyncSettingsJSONRequestBody = SyncSettingsUpdate
+
+// CreateBillingPeriodJSONRequestBody defines body for CreateBillingPeriod for application/json ContentType.
+type CreateBillingPeriodJSONRequestBody = BillingPeriodCreate
+
+// UpdateBillingPeriodJSONRequestBody defines body for UpdateBillingPeriod for application/json ContentType.
+type UpdateBillingPeriodJSONRequestBody = BillingPeriodUpdate
+
+// BulkClassifyEventsJSONRequestBody defines body for BulkClassifyEvents for application/json ContentType.
+type BulkClassifyEventsJSONRequestBody = BulkClassifyRequest
+
+// ClassifyCalendarEventJSONRequestBody defines body for ClassifyCalendarEvent for application/json ContentType.
+type ClassifyCalendarEventJSONRequestBody = ClassifyEventRequest
+
+// ReviewCalendarEventJSONRequestBody defines body for ReviewCalendarEvent for application/json ContentType.
+type ReviewCalendarEventJSONRequestBody = ReviewDecision
+
+// SetEventSuppressionJSONRequestBody defines body for SetEventSuppression for application/json ContentType.
+type SetEventSuppressionJSONRequestBody = EventSuppressionUpdate
+
+// SetEventTagsJSONRequestBody defines body for SetEventTags for application/json ContentType.
+type SetEventTagsJSONRequestBody = TagAssignment
+
+// UpdateCalendarSourcesJSONRequestBody defines body for UpdateCalendarSources for application/json ContentType.
+type UpdateCalendarSourcesJSONRequestBody = UpdateCalendarSourcesRequest
+
+// UpdateCalendarSourceJSONRequestBody defines body for UpdateCalendarSource for application/json ContentType.
+type UpdateCalendarSourceJSONRequestBody = CalendarSourceOverrides
+
+// UpdateCalendarSyncWindowJSONRequestBody defines body for UpdateCalendarSyncWindow for application/json ContentType.
+type UpdateCalendarSyncWindowJSONRequestBody = CalendarSyncWindowInput
+
+// UpdateCalendarWriteBackJSONRequestBody defines body for UpdateCalendarWriteBack for application/json ContentType.
+type UpdateCalendarWriteBackJSONRequestBody = CalendarWriteBackInput
+
+// ApplyRulesAsyncJSONRequestBody defines body for ApplyRulesAsync for application/json ContentType.
+type ApplyRulesAsyncJSONRequestBody = ApplyRulesAsyncRequest
+
+// CreateClientRateJSONRequestBody defines body for CreateClientRate for application/json ContentType.
+type CreateClientRateJSONRequestBody = ClientRateCreate
+
+// UpdateClientRateJSONRequestBody defines body for UpdateClientRate for application/json ContentType.
+type UpdateClientRateJSONRequestBody = ClientRateUpdate
+
+// ImportConfigJSONRequestBody defines body for ImportConfig for application/json ContentType.
+type ImportConfigJSONRequestBody = ConfigImport
+
+// CreateExpenseJSONRequestBody defines body for CreateExpense for application/json ContentType.
+type CreateExpenseJSONRequestBody = ExpenseCreate
+
+// UpdateExpenseJSONRequestBody defines body for UpdateExpense for application/json ContentType.
+type UpdateExpenseJSONRequestBody = ExpenseUpdate
+
+// LogFocusSessionJSONRequestBody defines body for LogFocusSession for application/json ContentType.
+type LogFocusSessionJSONRequestBody = FocusSessionInput
+
+// RecordFocusGapsJSONRequestBody defines body for RecordFocusGaps for application/json ContentType.
+type RecordFocusGapsJSONRequestBody = FocusGapsInput
+
+// CreateGoalJSONRequestBody defines body for CreateGoal for application/json ContentType.
+type CreateGoalJSONRequestBody = HourGoalInput
+
+// UpdateGoalJSONRequestBody defines body for UpdateGoal for application/json ContentType.
+type UpdateGoalJSONRequestBody = HourGoalInput
+
+// ConnectGitHubJSONRequestBody defines body for ConnectGitHub for application/json ContentType.
+type ConnectGitHubJSONRequestBody = GitHubConnectionInput
+
+// ConnectIntegrationJSONRequestBody defines body for ConnectIntegration for application/json ContentType.
+type ConnectIntegrationJSONRequestBody = IntegrationConnectionInput
+
+// CreateInvoiceJSONRequestBody defines body for CreateInvoice for application/json ContentType.
+type CreateInvoiceJSONRequestBody = InvoiceCreate
+
+// AddInvoiceAdjustmentJSONRequestBody defines body for AddInvoiceAdjustment for application/json ContentType.
+type AddInvoiceAdjustmentJSONRequestBody = InvoiceAdjustmentCreate
+
+// AddInvoiceCommentJSONRequestBody defines body for AddInvoiceComment for application/json ContentType.
+type AddInvoiceCommentJSONRequestBody = InvoiceCommentCreate
+
+// CreateCreditNoteJSONRequestBody defines body for CreateCreditNote for application/json ContentType.
+type CreateCreditNoteJSONRequestBody = CreditNoteCreate
+
+// RecordInvoicePaymentJSONRequestBody defines body for RecordInvoicePayment for application/json ContentType.
+type RecordInvoicePaymentJSONRequestBody = PaymentCreate
+
+// UpdateInvoiceStatusJSONRequestBody defines body for UpdateInvoiceStatus for application/json ContentType.
+type UpdateInvoiceStatusJSONRequestBody UpdateInvoiceStatusJSONBody
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = OrganizationCreate
+
+// PutOrganizationDomainJSONRequestBody defines body for PutOrganizationDomain for application/json ContentType.
+type PutOrganizationDomainJSONRequestBody = OrganizationDomainInput
+
+// UpdateOrganizationGoogleDelegationJSONRequestBody defines body for UpdateOrganizationGoogleDelegation for application/json ContentType.
+type UpdateOrganizationGoogleDelegationJSONRequestBody = OrganizationDelegationInput
+
+// ProvisionOrganizationCalendarsJSONRequestBody defines body for ProvisionOrganizationCalendars for application/json ContentType.
+type ProvisionOrganizationCalendarsJSONRequestBody = DelegationProvisionInput
+
+// UpdateOrganizationMemberDelegationJSONRequestBody defines body for UpdateOrganizationMemberDelegation for application/json ContentType.
+type UpdateOrganizationMemberDelegationJSONRequestBody = MemberDelegationInput
+
+// TransferOrganizationProjectJSONRequestBody defines body for TransferOrganizationProject for application/json ContentType.
+type TransferOrganizationProjectJSONRequestBody = ProjectTransferInput
+
+// UpdateOrganizationSsoJSONRequestBody defines body for UpdateOrganizationSso for application/json ContentType.
+type UpdateOrganizationSsoJSONRequestBody = OrganizationSSOInput
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = ProjectCreate
+
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody = ProjectUpdate
+
+// AddProjectFingerprintJSONRequestBody defines body for AddProjectFingerprint for application/json ContentType.
+type AddProjectFingerprintJSONRequestBody = FingerprintInput
+
+// ExportRulePackJSONRequestBody defines body for ExportRulePack for application/json ContentType.
+type ExportRulePackJSONRequestBody = RulePackExportRequest
+
+// ImportRulePackJSONRequestBody defines body for ImportRulePack for application/json ContentType.
+type ImportRulePackJSONRequestBody = RulePackImportRequest
+
+// CreateRuleJSONRequestBody defines body for CreateRule for application/json ContentType.
+type CreateRuleJSONRequestBody = RuleCreate
+
+// ApplyRulesJSONRequestBody defines body for ApplyRules for application/json ContentType.
+type ApplyRulesJSONRequestBody = ApplyRulesRequest
+
+// LintRuleJSONRequestBody defines body for LintRule for application/json ContentType.
+type LintRuleJSONRequestBody = RuleLintRequest
+
+// PreviewRuleJSONRequestBody defines body for PreviewRule for application/json ContentType.
+type PreviewRuleJSONRequestBody = RulePreviewRequest
+
+// TestRuleJSONRequestBody defines body for TestRule for application/json ContentType.
+type TestRuleJSONRequestBody = RuleTestRequest
+
+// UpdateRuleJSONRequestBody defines body for UpdateRule for application/json ContentType.
+type UpdateRuleJSONRequestBody = RuleUpdate
+
+// CreateClassificationSnapshotJSONRequestBody defines body for CreateClassificationSnapshot for application/json ContentType.
+type CreateClassificationSnapshotJSONRequestBody = ClassificationSnapshotCreate
+
+// CreateSuppressionRuleJSONRequestBody defines body for CreateSuppressionRule for application/json ContentType.
+type CreateSuppressionRuleJSONRequestBody = SuppressionRuleCreate
+
+// UpdateSuppressionRuleJSONRequestBody defines body for UpdateSuppressionRule for application/json ContentType.
+type UpdateSuppressionRuleJSONRequestBody = SuppressionRuleUpdate
+
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = TagCreate
+
+// RenameTagJSONRequestBody defines body for RenameTag for application/json ContentType.
+type RenameTagJSONRequestBody = TagCreate
+
+// CreateTimeEntryJSONRequestBody defines body for CreateTimeEntry for application/json ContentType.
+type CreateTimeEntryJSONRequestBody = TimeEntryCreate
+
+// ParseTimeEntryJSONRequestBody defines body for ParseTimeEntry for application/json ContentType.
+type ParseTimeEntryJSONRequestBody = TimeEntryParseRequest
+
+// RecalculateTimeEntriesJSONRequestBody defines body for RecalculateTimeEntries for application/json ContentType.
+type RecalculateTimeEntriesJSONRequestBody = RecalculateTimeEntriesRequest
+
+// UpdateTimeEntryJSONRequestBody defines body for UpdateTimeEntry for application/json ContentType.
+type UpdateTimeEntryJSONRequestBody = TimeEntryUpdate
+
+// AddTimeEntryNoteJSONRequestBody defines body for AddTimeEntryNote for application/json ContentType.
+type AddTimeEntryNoteJSONRequestBody = TimeEntryNoteCreate
+
+// ReconcileTimeEntryJSONRequestBody defines body for ReconcileTimeEntry for application/json ContentType.
+type ReconcileTimeEntryJSONRequestBody = ReconcileRequest
+
+// SplitTimeEntryJSONRequestBody defines body for SplitTimeEntry for application/json ContentType.
+type SplitTimeEntryJSONRequestBody = TimeEntrySplit
+
+// SetTimeEntryTagsJSONRequestBody defines body for SetTimeEntryTags for application/json ContentType.
+type SetTimeEntryTagsJSONRequestBody = TagAssignment
+
+// UpdateWorkingHoursJSONRequestBody defines body for UpdateWorkingHours for application/json ContentType.
+type UpdateWorkingHoursJSONRequestBody = WorkingHoursUpdate
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListClassificationActions request
+	ListClassificationActions(ctx context.Context, params *ListClassificationActionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UndoClassificationAction request
+	UndoClassificationAction(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAnomalies request
+	ListAnomalies(ctx context.Context, params *ListAnomaliesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AnalyzeAnomaliesWithBody request with any body
+	AnalyzeAnomaliesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AnalyzeAnomalies(ctx context.Context, body AnalyzeAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DismissAnomaly request
+	DismissAnomaly(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListApiKeys request
+	ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateApiKeyWithBody request with any body
+	CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiKey request
+	DeleteApiKey(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateApiKeyWithBody request with any body
+	UpdateApiKeyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateApiKey(ctx context.Context, id openapi_types.UUID, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAttendeeAliases request
+	ListAttendeeAliases(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAttendeeAliasWithBody request with any body
+	CreateAttendeeAliasWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAttendeeAlias(ctx context.Context, body CreateAttendeeAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAttendeeAlias request
+	DeleteAttendeeAlias(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCompanyDomains request
+	GetCompanyDomains(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCompanyDomainsWithBody request with any body
+	UpdateCompanyDomainsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCompanyDomains(ctx context.Context, body UpdateCompanyDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GoogleAuthorize request
+	GoogleAuthorize(ctx context.Context, params *GoogleAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GoogleCallback request
+	GoogleCallback(ctx context.Context, params *GoogleCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLocale request
+	GetLocale(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateLocaleWithBody request with any body
+	UpdateLocaleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateLocale(ctx context.Context, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LoginWithBody request with any body
+	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Logout request
+	Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCurrentUser request
+	GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRetentionSettings request
+	GetRetentionSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRetentionSettingsWithBody request with any body
+	UpdateRetentionSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRetentionSettings(ctx context.Context, body UpdateRetentionSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewRetention request
+	PreviewRetention(ctx context.Context, params *PreviewRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SignupWithBody request with any body
+	SignupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Signup(ctx context.Context, body SignupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartSsoWithBody request with any body
+	StartSsoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartSso(ctx context.Context, body StartSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSyncSettings request
+	GetSyncSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSyncSettingsWithBody request with any body
+	UpdateSyncSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSyncSettings(ctx context.Context, body UpdateSyncSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBillingPeriods request
+	ListBillingPeriods(ctx context.Context, params *ListBillingPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBillingPeriodWithBody request with any body
+	CreateBillingPeriodWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBillingPeriod(ctx context.Context, body CreateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBillingPeriod request
+	DeleteBillingPeriod(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBillingPeriodWithBody request with any body
+	UpdateBillingPeriodWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBillingPeriod(ctx context.Context, id openapi_types.UUID, body UpdateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClientBalances request
+	ListClientBalances(ctx context.Context, params *ListClientBalancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCalendarEvents request
+	ListCalendarEvents(ctx context.Context, params *ListCalendarEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BulkClassifyEventsWithBody request with any body
+	BulkClassifyEventsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkClassifyEvents(ctx context.Context, body BulkClassifyEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DiffCalendarEvents request
+	DiffCalendarEvents(ctx context.Context, params *DiffCalendarEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReviewQueue request
+	GetReviewQueue(ctx context.Context, params *GetReviewQueueParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEventChanges request
+	ListEventChanges(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClassifyCalendarEventWithBody request with any body
+	ClassifyCalendarEventWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ClassifyCalendarEvent(ctx context.Context, id openapi_types.UUID, body ClassifyCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExplainEventClassification request
+	ExplainEventClassification(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEventClassificationHistory request
+	ListEventClassificationHistory(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReviewCalendarEventWithBody request with any body
+	ReviewCalendarEventWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReviewCalendarEvent(ctx context.Context, id openapi_types.UUID, body ReviewCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetEventSuppressionWithBody request with any body
+	SetEventSuppressionWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetEventSuppression(ctx context.Context, id openapi_types.UUID, body SetEventSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetEventTagsWithBody request with any body
+	SetEventTagsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetEventTags(ctx context.Context, id openapi_types.UUID, body SetEventTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCalendarConnections request
+	ListCalendarConnections(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListConnectionsNeedingReauth request
+	ListConnectionsNeedingReauth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCalendarConnection request
+	DeleteCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseCalendarConnection request
+	PauseCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReauthorizeCalendarConnection request
+	ReauthorizeCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumeCalendarConnection request
+	ResumeCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCalendarSources request
+	ListCalendarSources(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCalendarSourcesWithBody request with any body
+	UpdateCalendarSourcesWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCalendarSources(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCalendarSourceWithBody request with any body
+	UpdateCalendarSourceWithBody(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCalendarSource(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, body UpdateCalendarSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncCalendar request
+	SyncCalendar(ctx context.Context, id openapi_types.UUID, params *SyncCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCalendarSyncWindowWithBody request with any body
+	UpdateCalendarSyncWindowWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCalendarSyncWindow(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSyncWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCalendarWriteBackWithBody request with any body
+	UpdateCalendarWriteBackWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCalendarWriteBack(ctx context.Context, id openapi_types.UUID, body UpdateCalendarWriteBackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListChanges request
+	ListChanges(ctx context.Context, params *ListChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApplyRulesAsyncWithBody request with any body
+	ApplyRulesAsyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApplyRulesAsync(ctx context.Context, body ApplyRulesAsyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClassificationJob request
+	GetClassificationJob(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClientRates request
+	ListClientRates(ctx context.Context, params *ListClientRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClientRateWithBody request with any body
+	CreateClientRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateClientRate(ctx context.Context, body CreateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClientRate request
+	DeleteClientRate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateClientRateWithBody request with any body
+	UpdateClientRateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateClientRate(ctx context.Context, id openapi_types.UUID, body UpdateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportConfig request
+	ExportConfig(ctx context.Context, params *ExportConfigParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportConfigWithBody request with any body
+	ImportConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportConfig(ctx context.Context, body ImportConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDaySummary request
+	GetDaySummary(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExpenses request
+	ListExpenses(ctx context.Context, params *ListExpensesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateExpenseWithBody request with any body
+	CreateExpenseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateExpense(ctx context.Context, body CreateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteExpense request
+	DeleteExpense(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExpense request
+	GetExpense(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateExpenseWithBody request with any body
+	UpdateExpenseWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateExpense(ctx context.Context, id openapi_types.UUID, body UpdateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFingerprintClaims request
+	ListFingerprintClaims(ctx context.Context, params *ListFingerprintClaimsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogFocusSessionWithBody request with any body
+	LogFocusSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LogFocusSession(ctx context.Context, body LogFocusSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecordFocusGapsWithBody request with any body
+	RecordFocusGapsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecordFocusGaps(ctx context.Context, body RecordFocusGapsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListGoals request
+	ListGoals(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateGoalWithBody request with any body
+	CreateGoalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateGoal(ctx context.Context, body CreateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGoalsProgress request
+	GetGoalsProgress(ctx context.Context, params *GetGoalsProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteGoal request
+	DeleteGoal(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateGoalWithBody request with any body
+	UpdateGoalWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateGoal(ctx context.Context, id openapi_types.UUID, body UpdateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIntegrations request
+	ListIntegrations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisconnectGitHub request
+	DisconnectGitHub(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetGitHubConnection request
+	GetGitHubConnection(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConnectGitHubWithBody request with any body
+	ConnectGitHubWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConnectGitHub(ctx context.Context, body ConnectGitHubJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisconnectIntegration request
+	DisconnectIntegration(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConnectIntegrationWithBody request with any body
+	ConnectIntegrationWithBody(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConnectIntegration(ctx context.Context, provider string, body ConnectIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadInvoiceExport request
+	DownloadInvoiceExport(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInvoices request
+	ListInvoices(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInvoiceWithBody request with any body
+	CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInvoice request
+	DeleteInvoice(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInvoice request
+	GetInvoice(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddInvoiceAdjustmentWithBody request with any body
+	AddInvoiceAdjustmentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddInvoiceAdjustment(ctx context.Context, id openapi_types.UUID, body AddInvoiceAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInvoiceAdjustment request
+	DeleteInvoiceAdjustment(ctx context.Context, id openapi_types.UUID, lineItemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInvoiceComments request
+	ListInvoiceComments(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddInvoiceCommentWithBody request with any body
+	AddInvoiceCommentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddInvoiceComment(ctx context.Context, id openapi_types.UUID, body AddInvoiceCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInvoiceComment request
+	DeleteInvoiceComment(ctx context.Context, id openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCreditNoteWithBody request with any body
+	CreateCreditNoteWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCreditNote(ctx context.Context, id openapi_types.UUID, body CreateCreditNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportInvoice request
+	ExportInvoice(ctx context.Context, id openapi_types.UUID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportInvoiceCSV request
+	ExportInvoiceCSV(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportInvoiceSheets request
+	ExportInvoiceSheets(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInvoiceExports request
+	ListInvoiceExports(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInvoicePayments request
+	ListInvoicePayments(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecordInvoicePaymentWithBody request with any body
+	RecordInvoicePaymentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecordInvoicePayment(ctx context.Context, id openapi_types.UUID, body RecordInvoicePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateInvoiceStatusWithBody request with any body
+	UpdateInvoiceStatusWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateInvoiceStatus(ctx context.Context, id openapi_types.UUID, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizations request
+	ListOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOrganizationWithBody request with any body
+	CreateOrganizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOrganization(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganization request
+	GetOrganization(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOrganizationDomain request
+	DeleteOrganizationDomain(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutOrganizationDomainWithBody request with any body
+	PutOrganizationDomainWithBody(ctx context.Context, id openapi_types.UUID, domain string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutOrganizationDomain(ctx context.Context, id openapi_types.UUID, domain string, body PutOrganizationDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyOrganizationDomain request
+	VerifyOrganizationDomain(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationGoogleDelegationWithBody request with any body
+	UpdateOrganizationGoogleDelegationWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationGoogleDelegation(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationGoogleDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProvisionOrganizationCalendarsWithBody request with any body
+	ProvisionOrganizationCalendarsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ProvisionOrganizationCalendars(ctx context.Context, id openapi_types.UUID, body ProvisionOrganizationCalendarsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOrganizationMembers request
+	ListOrganizationMembers(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationMemberDelegationWithBody request with any body
+	UpdateOrganizationMemberDelegationWithBody(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationMemberDelegation(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, body UpdateOrganizationMemberDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferOrganizationProjectWithBody request with any body
+	TransferOrganizationProjectWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferOrganizationProject(ctx context.Context, id openapi_types.UUID, body TransferOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateOrganizationScimToken request
+	RotateOrganizationScimToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrganizationSsoWithBody request with any body
+	UpdateOrganizationSsoWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrganizationSso(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePayment request
+	DeletePayment(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListProjects request
+	ListProjects(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectWithBody request with any body
+	CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProject request
+	DeleteProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProject request
+	GetProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectWithBody request with any body
+	UpdateProjectWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProject(ctx context.Context, id openapi_types.UUID, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddProjectFingerprintWithBody request with any body
+	AddProjectFingerprintWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddProjectFingerprint(ctx context.Context, id openapi_types.UUID, body AddProjectFingerprintJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveProjectFingerprint request
+	RemoveProjectFingerprint(ctx context.Context, id openapi_types.UUID, kind FingerprintKind, value string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMeetingCostReport request
+	GetMeetingCostReport(ctx context.Context, params *GetMeetingCostReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMeetingLoadReport request
+	GetMeetingLoadReport(ctx context.Context, params *GetMeetingLoadReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUtilizationReport request
+	GetUtilizationReport(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportRulePackWithBody request with any body
+	ExportRulePackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExportRulePack(ctx context.Context, body ExportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportRulePackWithBody request with any body
+	ImportRulePackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportRulePack(ctx context.Context, body ImportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRules request
+	ListRules(ctx context.Context, params *ListRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRuleWithBody request with any body
+	CreateRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRule(ctx context.Context, body CreateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApplyRulesWithBody request with any body
+	ApplyRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApplyRules(ctx context.Context, body ApplyRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRuleConflicts request
+	GetRuleConflicts(ctx context.Context, params *GetRuleConflictsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LintRuleWithBody request with any body
+	LintRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LintRule(ctx context.Context, body LintRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewRuleWithBody request with any body
+	PreviewRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewRule(ctx context.Context, body PreviewRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TestRuleWithBody request with any body
+	TestRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TestRule(ctx context.Context, body TestRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRule request
+	DeleteRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRule request
+	GetRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRuleWithBody request with any body
+	UpdateRuleWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRule(ctx context.Context, id openapi_types.UUID, body UpdateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClassificationSnapshots request
+	ListClassificationSnapshots(ctx context.Context, params *ListClassificationSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClassificationSnapshotWithBody request with any body
+	CreateClassificationSnapshotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateClassificationSnapshot(ctx context.Context, body CreateClassificationSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClassificationSnapshot request
+	DeleteClassificationSnapshot(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreClassificationSnapshot request
+	RestoreClassificationSnapshot(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSuppressionRules request
+	ListSuppressionRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSuppressionRuleWithBody request with any body
+	CreateSuppressionRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSuppressionRule(ctx context.Context, body CreateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSuppressionRule request
+	DeleteSuppressionRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSuppressionRuleWithBody request with any body
+	UpdateSuppressionRuleWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSuppressionRule(ctx context.Context, id openapi_types.UUID, body UpdateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTags request
+	ListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTagWithBody request with any body
+	CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTag request
+	DeleteTag(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenameTagWithBody request with any body
+	RenameTagWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RenameTag(ctx context.Context, id openapi_types.UUID, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTimeEntries request
+	ListTimeEntries(ctx context.Context, params *ListTimeEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTimeEntryWithBody request with any body
+	CreateTimeEntryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTimeEntry(ctx context.Context, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SuggestDescriptions request
+	SuggestDescriptions(ctx context.Context, params *SuggestDescriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ParseTimeEntryWithBody request with any body
+	ParseTimeEntryWithBody(ctx context.Context, params *ParseTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ParseTimeEntry(ctx context.Context, params *ParseTimeEntryParams, body ParseTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecalculateTimeEntriesWithBody request with any body
+	RecalculateTimeEntriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecalculateTimeEntries(ctx context.Context, body RecalculateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecalculationJob request
+	GetRecalculationJob(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReconciliationReport request
+	GetReconciliationReport(ctx context.Context, params *GetReconciliationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTimeEntry request
+	DeleteTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimeEntry request
+	GetTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTimeEntryWithBody request with any body
+	UpdateTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTimeEntry(ctx context.Context, id openapi_types.UUID, body UpdateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTimeEntryBreakdown request
+	GetTimeEntryBreakdown(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExplainTimeEntry request
+	ExplainTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTimeEntryNotes request
+	ListTimeEntryNotes(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTimeEntryNoteWithBody request with any body
+	AddTimeEntryNoteWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddTimeEntryNote(ctx context.Context, id openapi_types.UUID, body AddTimeEntryNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTimeEntryNote request
+	DeleteTimeEntryNote(ctx context.Context, id openapi_types.UUID, noteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReconcileTimeEntryWithBody request with any body
+	ReconcileTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReconcileTimeEntry(ctx context.Context, id openapi_types.UUID, body ReconcileTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshTimeEntry request
+	RefreshTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SplitTimeEntryWithBody request with any body
+	SplitTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SplitTimeEntry(ctx context.Context, id openapi_types.UUID, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTimeEntryTagsWithBody request with any body
+	SetTimeEntryTagsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTimeEntryTags(ctx context.Context, id openapi_types.UUID, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTrash request
+	ListTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreRule request
+	RestoreRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTimeEntry request
+	RestoreTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWeekGrid request
+	GetWeekGrid(ctx context.Context, start openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkingHours request
+	GetWorkingHours(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWorkingHoursWithBody request with any body
+	UpdateWorkingHoursWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWorkingHours(ctx context.Context, body UpdateWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListClassificationActions(ctx context.Context, params *ListClassificationActionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClassificationActionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UndoClassificationAction(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUndoClassificationActionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAnomalies(ctx context.Context, params *ListAnomaliesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAnomaliesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AnalyzeAnomaliesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnalyzeAnomaliesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AnalyzeAnomalies(ctx context.Context, body AnalyzeAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnalyzeAnomaliesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DismissAnomaly(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDismissAnomalyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListApiKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiKey(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiKeyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateApiKeyWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateApiKeyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateApiKey(ctx context.Context, id openapi_types.UUID, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateApiKeyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAttendeeAliases(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAttendeeAliasesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAttendeeAliasWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAttendeeAliasRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAttendeeAlias(ctx context.Context, body CreateAttendeeAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAttendeeAliasRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAttendeeAlias(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAttendeeAliasRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCompanyDomains(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCompanyDomainsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCompanyDomainsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCompanyDomainsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCompanyDomains(ctx context.Context, body UpdateCompanyDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCompanyDomainsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoogleAuthorize(ctx context.Context, params *GoogleAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoogleAuthorizeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GoogleCallback(ctx context.Context, params *GoogleCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGoogleCallbackRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLocale(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLocaleRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLocaleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLocaleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLocale(ctx context.Context, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLocaleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCurrentUserRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRetentionSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRetentionSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRetentionSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRetentionSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRetentionSettings(ctx context.Context, body UpdateRetentionSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRetentionSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewRetention(ctx context.Context, params *PreviewRetentionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewRetentionRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignupRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Signup(ctx context.Context, body SignupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignupRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartSsoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartSsoRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartSso(ctx context.Context, body StartSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartSsoRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSyncSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSyncSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSyncSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSyncSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSyncSettings(ctx context.Context, body UpdateSyncSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSyncSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBillingPeriods(ctx context.Context, params *ListBillingPeriodsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBillingPeriodsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBillingPeriodWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBillingPeriodRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBillingPeriod(ctx context.Context, body CreateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBillingPeriodRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteBillingPeriod(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBillingPeriodRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBillingPeriodWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBillingPeriodRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBillingPeriod(ctx context.Context, id openapi_types.UUID, body UpdateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBillingPeriodRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClientBalances(ctx context.Context, params *ListClientBalancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClientBalancesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCalendarEvents(ctx context.Context, params *ListCalendarEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCalendarEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkClassifyEventsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkClassifyEventsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkClassifyEvents(ctx context.Context, body BulkClassifyEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkClassifyEventsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DiffCalendarEvents(ctx context.Context, params *DiffCalendarEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffCalendarEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReviewQueue(ctx context.Context, params *GetReviewQueueParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReviewQueueRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListEventChanges(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEventChangesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ClassifyCalendarEventWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClassifyCalendarEventRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ClassifyCalendarEvent(ctx context.Context, id openapi_types.UUID, body ClassifyCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClassifyCalendarEventRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExplainEventClassification(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainEventClassificationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListEventClassificationHistory(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEventClassificationHistoryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReviewCalendarEventWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewCalendarEventRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReviewCalendarEvent(ctx context.Context, id openapi_types.UUID, body ReviewCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewCalendarEventRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetEventSuppressionWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEventSuppressionRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetEventSuppression(ctx context.Context, id openapi_types.UUID, body SetEventSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEventSuppressionRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetEventTagsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEventTagsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetEventTags(ctx context.Context, id openapi_types.UUID, body SetEventTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEventTagsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCalendarConnections(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCalendarConnectionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListConnectionsNeedingReauth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListConnectionsNeedingReauthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCalendarConnectionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PauseCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseCalendarConnectionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReauthorizeCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReauthorizeCalendarConnectionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResumeCalendarConnection(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeCalendarConnectionRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCalendarSources(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCalendarSourcesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarSourcesWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarSourcesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarSources(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarSourcesRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarSourceWithBody(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarSourceRequestWithBody(c.Server, id, calendarId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarSource(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, body UpdateCalendarSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarSourceRequest(c.Server, id, calendarId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SyncCalendar(ctx context.Context, id openapi_types.UUID, params *SyncCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncCalendarRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarSyncWindowWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarSyncWindowRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarSyncWindow(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSyncWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarSyncWindowRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarWriteBackWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarWriteBackRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCalendarWriteBack(ctx context.Context, id openapi_types.UUID, body UpdateCalendarWriteBackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCalendarWriteBackRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListChanges(ctx context.Context, params *ListChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListChangesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyRulesAsyncWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyRulesAsyncRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyRulesAsync(ctx context.Context, body ApplyRulesAsyncJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyRulesAsyncRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClassificationJob(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClassificationJobRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClientRates(ctx context.Context, params *ListClientRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClientRatesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateClientRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientRateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateClientRate(ctx context.Context, body CreateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientRateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteClientRate(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClientRateRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateClientRateWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientRateRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateClientRate(ctx context.Context, id openapi_types.UUID, body UpdateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientRateRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportConfig(ctx context.Context, params *ExportConfigParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportConfigRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportConfigRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportConfig(ctx context.Context, body ImportConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportConfigRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDaySummary(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDaySummaryRequest(c.Server, date)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListExpenses(ctx context.Context, params *ListExpensesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExpensesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateExpenseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExpenseRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateExpense(ctx context.Context, body CreateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExpenseRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteExpense(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteExpenseRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetExpense(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExpenseRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateExpenseWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExpenseRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateExpense(ctx context.Context, id openapi_types.UUID, body UpdateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateExpenseRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListFingerprintClaims(ctx context.Context, params *ListFingerprintClaimsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFingerprintClaimsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogFocusSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogFocusSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogFocusSession(ctx context.Context, body LogFocusSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogFocusSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordFocusGapsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordFocusGapsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordFocusGaps(ctx context.Context, body RecordFocusGapsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordFocusGapsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListGoals(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListGoalsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateGoalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGoalRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateGoal(ctx context.Context, body CreateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGoalRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGoalsProgress(ctx context.Context, params *GetGoalsProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGoalsProgressRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteGoal(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteGoalRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateGoalWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGoalRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateGoal(ctx context.Context, id openapi_types.UUID, body UpdateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGoalRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIntegrations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIntegrationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisconnectGitHub(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisconnectGitHubRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetGitHubConnection(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetGitHubConnectionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConnectGitHubWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectGitHubRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConnectGitHub(ctx context.Context, body ConnectGitHubJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectGitHubRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DisconnectIntegration(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisconnectIntegrationRequest(c.Server, provider)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConnectIntegrationWithBody(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectIntegrationRequestWithBody(c.Server, provider, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConnectIntegration(ctx context.Context, provider string, body ConnectIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectIntegrationRequest(c.Server, provider, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadInvoiceExport(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadInvoiceExportRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInvoices(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInvoicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInvoice(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInvoiceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteInvoice(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInvoiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInvoice(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddInvoiceAdjustmentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceAdjustmentRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddInvoiceAdjustment(ctx context.Context, id openapi_types.UUID, body AddInvoiceAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceAdjustmentRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteInvoiceAdjustment(ctx context.Context, id openapi_types.UUID, lineItemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInvoiceAdjustmentRequest(c.Server, id, lineItemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInvoiceComments(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInvoiceCommentsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddInvoiceCommentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceCommentRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddInvoiceComment(ctx context.Context, id openapi_types.UUID, body AddInvoiceCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddInvoiceCommentRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteInvoiceComment(ctx context.Context, id openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInvoiceCommentRequest(c.Server, id, commentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCreditNoteWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCreditNoteRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCreditNote(ctx context.Context, id openapi_types.UUID, body CreateCreditNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCreditNoteRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportInvoice(ctx context.Context, id openapi_types.UUID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportInvoiceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportInvoiceCSV(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportInvoiceCSVRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportInvoiceSheets(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportInvoiceSheetsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInvoiceExports(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInvoiceExportsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInvoicePayments(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInvoicePaymentsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordInvoicePaymentWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordInvoicePaymentRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordInvoicePayment(ctx context.Context, id openapi_types.UUID, body RecordInvoicePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordInvoicePaymentRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInvoiceStatusWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceStatusRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInvoiceStatus(ctx context.Context, id openapi_types.UUID, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceStatusRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOrganizations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOrganizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOrganization(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOrganizationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrganization(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteOrganizationDomain(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOrganizationDomainRequest(c.Server, id, domain)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutOrganizationDomainWithBody(ctx context.Context, id openapi_types.UUID, domain string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrganizationDomainRequestWithBody(c.Server, id, domain, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutOrganizationDomain(ctx context.Context, id openapi_types.UUID, domain string, body PutOrganizationDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutOrganizationDomainRequest(c.Server, id, domain, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyOrganizationDomain(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyOrganizationDomainRequest(c.Server, id, domain)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizationGoogleDelegationWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationGoogleDelegationRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizationGoogleDelegation(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationGoogleDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationGoogleDelegationRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProvisionOrganizationCalendarsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProvisionOrganizationCalendarsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProvisionOrganizationCalendars(ctx context.Context, id openapi_types.UUID, body ProvisionOrganizationCalendarsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProvisionOrganizationCalendarsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOrganizationMembers(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOrganizationMembersRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizationMemberDelegationWithBody(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationMemberDelegationRequestWithBody(c.Server, id, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizationMemberDelegation(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, body UpdateOrganizationMemberDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationMemberDelegationRequest(c.Server, id, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferOrganizationProjectWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferOrganizationProjectRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferOrganizationProject(ctx context.Context, id openapi_types.UUID, body TransferOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferOrganizationProjectRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RotateOrganizationScimToken(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateOrganizationScimTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizationSsoWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationSsoRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOrganizationSso(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrganizationSsoRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePayment(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePaymentRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListProjects(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateProject(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProjectWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProject(ctx context.Context, id openapi_types.UUID, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddProjectFingerprintWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProjectFingerprintRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddProjectFingerprint(ctx context.Context, id openapi_types.UUID, body AddProjectFingerprintJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProjectFingerprintRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveProjectFingerprint(ctx context.Context, id openapi_types.UUID, kind FingerprintKind, value string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveProjectFingerprintRequest(c.Server, id, kind, value)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMeetingCostReport(ctx context.Context, params *GetMeetingCostReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeetingCostReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMeetingLoadReport(ctx context.Context, params *GetMeetingLoadReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeetingLoadReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUtilizationReport(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUtilizationReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportRulePackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportRulePackRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportRulePack(ctx context.Context, body ExportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportRulePackRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportRulePackWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportRulePackRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportRulePack(ctx context.Context, body ImportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportRulePackRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRules(ctx context.Context, params *ListRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRulesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRuleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRule(ctx context.Context, body CreateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRuleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyRulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyRulesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyRules(ctx context.Context, body ApplyRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyRulesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRuleConflicts(ctx context.Context, params *GetRuleConflictsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRuleConflictsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LintRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLintRuleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LintRule(ctx context.Context, body LintRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLintRuleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewRuleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewRule(ctx context.Context, body PreviewRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewRuleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TestRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestRuleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TestRule(ctx context.Context, body TestRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestRuleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRuleRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRuleRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRuleWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRuleRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRule(ctx context.Context, id openapi_types.UUID, body UpdateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRuleRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClassificationSnapshots(ctx context.Context, params *ListClassificationSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClassificationSnapshotsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateClassificationSnapshotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClassificationSnapshotRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateClassificationSnapshot(ctx context.Context, body CreateClassificationSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClassificationSnapshotRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteClassificationSnapshot(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClassificationSnapshotRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreClassificationSnapshot(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreClassificationSnapshotRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSuppressionRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSuppressionRulesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSuppressionRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSuppressionRuleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSuppressionRule(ctx context.Context, body CreateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSuppressionRuleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSuppressionRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSuppressionRuleRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSuppressionRuleWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSuppressionRuleRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSuppressionRule(ctx context.Context, id openapi_types.UUID, body UpdateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSuppressionRuleRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTagWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTag(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTag(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenameTagWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameTagRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RenameTag(ctx context.Context, id openapi_types.UUID, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenameTagRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTimeEntries(ctx context.Context, params *ListTimeEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTimeEntriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTimeEntryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimeEntryRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTimeEntry(ctx context.Context, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTimeEntryRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SuggestDescriptions(ctx context.Context, params *SuggestDescriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSuggestDescriptionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ParseTimeEntryWithBody(ctx context.Context, params *ParseTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewParseTimeEntryRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ParseTimeEntry(ctx context.Context, params *ParseTimeEntryParams, body ParseTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewParseTimeEntryRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecalculateTimeEntriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecalculateTimeEntriesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecalculateTimeEntries(ctx context.Context, body RecalculateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecalculateTimeEntriesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRecalculationJob(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecalculationJobRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetReconciliationReport(ctx context.Context, params *GetReconciliationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReconciliationReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTimeEntryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimeEntryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimeEntryRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTimeEntry(ctx context.Context, id openapi_types.UUID, body UpdateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimeEntryRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTimeEntryBreakdown(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTimeEntryBreakdownRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExplainTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExplainTimeEntryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTimeEntryNotes(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTimeEntryNotesRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddTimeEntryNoteWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTimeEntryNoteRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddTimeEntryNote(ctx context.Context, id openapi_types.UUID, body AddTimeEntryNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTimeEntryNoteRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTimeEntryNote(ctx context.Context, id openapi_types.UUID, noteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTimeEntryNoteRequest(c.Server, id, noteId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReconcileTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReconcileTimeEntryRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReconcileTimeEntry(ctx context.Context, id openapi_types.UUID, body ReconcileTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReconcileTimeEntryRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RefreshTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTimeEntryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SplitTimeEntryWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSplitTimeEntryRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SplitTimeEntry(ctx context.Context, id openapi_types.UUID, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSplitTimeEntryRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTimeEntryTagsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeEntryTagsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetTimeEntryTags(ctx context.Context, id openapi_types.UUID, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTimeEntryTagsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTrashRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreRule(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreRuleRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreTimeEntry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTimeEntryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWeekGrid(ctx context.Context, start openapi_types.Date, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWeekGridRequest(c.Server, start)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkingHours(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkingHoursRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWorkingHoursWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWorkingHoursRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWorkingHours(ctx context.Context, body UpdateWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWorkingHoursRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListClassificationActionsRequest generates requests for ListClassificationActions
+func NewListClassificationActionsRequest(server string, params *ListClassificationActionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/actions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUndoClassificationActionRequest generates requests for UndoClassificationAction
+func NewUndoClassificationActionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/actions/%s/undo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAnomaliesRequest generates requests for ListAnomalies
+func NewListAnomaliesRequest(server string, params *ListAnomaliesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/anomalies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDismissed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_dismissed", runtime.ParamLocationQuery, *params.IncludeDismissed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAnalyzeAnomaliesRequest calls the generic AnalyzeAnomalies builder with application/json body
+func NewAnalyzeAnomaliesRequest(server string, body AnalyzeAnomaliesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAnalyzeAnomaliesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAnalyzeAnomaliesRequestWithBody generates requests for AnalyzeAnomalies with any type of body
+func NewAnalyzeAnomaliesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/anomalies/analyze")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDismissAnomalyRequest generates requests for DismissAnomaly
+func NewDismissAnomalyRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/anomalies/%s/dismiss", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListApiKeysRequest generates requests for ListApiKeys
+func NewListApiKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateApiKeyRequest calls the generic CreateApiKey builder with application/json body
+func NewCreateApiKeyRequest(server string, body CreateApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateApiKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateApiKeyRequestWithBody generates requests for CreateApiKey with any type of body
+func NewCreateApiKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiKeyRequest generates requests for DeleteApiKey
+func NewDeleteApiKeyRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateApiKeyRequest calls the generic UpdateApiKey builder with application/json body
+func NewUpdateApiKeyRequest(server string, id openapi_types.UUID, body UpdateApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateApiKeyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateApiKeyRequestWithBody generates requests for UpdateApiKey with any type of body
+func NewUpdateApiKeyRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/api-keys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAttendeeAliasesRequest generates requests for ListAttendeeAliases
+func NewListAttendeeAliasesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/attendee-aliases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAttendeeAliasRequest calls the generic CreateAttendeeAlias builder with application/json body
+func NewCreateAttendeeAliasRequest(server string, body CreateAttendeeAliasJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAttendeeAliasRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAttendeeAliasRequestWithBody generates requests for CreateAttendeeAlias with any type of body
+func NewCreateAttendeeAliasRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/attendee-aliases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAttendeeAliasRequest generates requests for DeleteAttendeeAlias
+func NewDeleteAttendeeAliasRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/attendee-aliases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCompanyDomainsRequest generates requests for GetCompanyDomains
+func NewGetCompanyDomainsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/company-domains")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCompanyDomainsRequest calls the generic UpdateCompanyDomains builder with application/json body
+func NewUpdateCompanyDomainsRequest(server string, body UpdateCompanyDomainsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCompanyDomainsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateCompanyDomainsRequestWithBody generates requests for UpdateCompanyDomains with any type of body
+func NewUpdateCompanyDomainsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/company-domains")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGoogleAuthorizeRequest generates requests for GoogleAuthorize
+func NewGoogleAuthorizeRequest(server string, params *GoogleAuthorizeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/google/authorize")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Access != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "access", runtime.ParamLocationQuery, *params.Access); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGoogleCallbackRequest generates requests for GoogleCallback
+func NewGoogleCallbackRequest(server string, params *GoogleCallbackParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/google/callback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, params.Code); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, params.State); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLocaleRequest generates requests for GetLocale
+func NewGetLocaleRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/locale")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateLocaleRequest calls the generic UpdateLocale builder with application/json body
+func NewUpdateLocaleRequest(server string, body UpdateLocaleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateLocaleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateLocaleRequestWithBody generates requests for UpdateLocale with any type of body
+func NewUpdateLocaleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/locale")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogoutRequest generates requests for Logout
+func NewLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCurrentUserRequest generates requests for GetCurrentUser
+func NewGetCurrentUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRetentionSettingsRequest generates requests for GetRetentionSettings
+func NewGetRetentionSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/retention-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRetentionSettingsRequest calls the generic UpdateRetentionSettings builder with application/json body
+func NewUpdateRetentionSettingsRequest(server string, body UpdateRetentionSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRetentionSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateRetentionSettingsRequestWithBody generates requests for UpdateRetentionSettings with any type of body
+func NewUpdateRetentionSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/retention-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewRetentionRequest generates requests for PreviewRetention
+func NewPreviewRetentionRequest(server string, params *PreviewRetentionParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/retention-settings/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EventMonths != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event_months", runtime.ParamLocationQuery, *params.EventMonths); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignupRequest calls the generic Signup builder with application/json body
+func NewSignupRequest(server string, body SignupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSignupRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSignupRequestWithBody generates requests for Signup with any type of body
+func NewSignupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/signup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStartSsoRequest calls the generic StartSso builder with application/json body
+func NewStartSsoRequest(server string, body StartSsoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartSsoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewStartSsoRequestWithBody generates requests for StartSso with any type of body
+func NewStartSsoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/sso/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSyncSettingsRequest generates requests for GetSyncSettings
+func NewGetSyncSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/sync-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSyncSettingsRequest calls the generic UpdateSyncSettings builder with application/json body
+func NewUpdateSyncSettingsRequest(server string, body UpdateSyncSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSyncSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateSyncSettingsRequestWithBody generates requests for UpdateSyncSettings with any type of body
+func NewUpdateSyncSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth/sync-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBillingPeriodsRequest generates requests for ListBillingPeriods
+func NewListBillingPeriodsRequest(server string, params *ListBillingPeriodsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/billing-periods")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, params.ProjectId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBillingPeriodRequest calls the generic CreateBillingPeriod builder with application/json body
+func NewCreateBillingPeriodRequest(server string, body CreateBillingPeriodJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBillingPeriodRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBillingPeriodRequestWithBody generates requests for CreateBillingPeriod with any type of body
+func NewCreateBillingPeriodRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/billing-periods")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBillingPeriodRequest generates requests for DeleteBillingPeriod
+func NewDeleteBillingPeriodRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/billing-periods/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBillingPeriodRequest calls the generic UpdateBillingPeriod builder with application/json body
+func NewUpdateBillingPeriodRequest(server string, id openapi_types.UUID, body UpdateBillingPeriodJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBillingPeriodRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateBillingPeriodRequestWithBody generates requests for UpdateBillingPeriod with any type of body
+func NewUpdateBillingPeriodRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/billing-periods/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListClientBalancesRequest generates requests for ListClientBalances
+func NewListClientBalancesRequest(server string, params *ListClientBalancesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/billing/balances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Client != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client", runtime.ParamLocationQuery, *params.Client); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCalendarEventsRequest generates requests for ListCalendarEvents
+func NewListCalendarEventsRequest(server string, params *ListCalendarEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ClassificationStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "classification_status", runtime.ParamLocationQuery, *params.ClassificationStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ConnectionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "connection_id", runtime.ParamLocationQuery, *params.ConnectionId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBulkClassifyEventsRequest calls the generic BulkClassifyEvents builder with application/json body
+func NewBulkClassifyEventsRequest(server string, body BulkClassifyEventsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkClassifyEventsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBulkClassifyEventsRequestWithBody generates requests for BulkClassifyEvents with any type of body
+func NewBulkClassifyEventsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/bulk-classify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDiffCalendarEventsRequest generates requests for DiffCalendarEvents
+func NewDiffCalendarEventsRequest(server string, params *DiffCalendarEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/diff")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, params.StartDate); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, params.EndDate); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, params.Since); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReviewQueueRequest generates requests for GetReviewQueue
+func NewGetReviewQueueRequest(server string, params *GetReviewQueueParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/review-queue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListEventChangesRequest generates requests for ListEventChanges
+func NewListEventChangesRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/%s/changes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewClassifyCalendarEventRequest calls the generic ClassifyCalendarEvent builder with application/json body
+func NewClassifyCalendarEventRequest(server string, id openapi_types.UUID, body ClassifyCalendarEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewClassifyCalendarEventRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewClassifyCalendarEventRequestWithBody generates requests for ClassifyCalendarEvent with any type of body
+func NewClassifyCalendarEventRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/%s/classify", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExplainEventClassificationRequest generates requests for ExplainEventClassification
+func NewExplainEventClassificationRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/%s/explain", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListEventClassificationHistoryRequest generates requests for ListEventClassificationHistory
+func NewListEventClassificationHistoryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReviewCalendarEventRequest calls the generic ReviewCalendarEvent builder with application/json body
+func NewReviewCalendarEventRequest(server string, id openapi_types.UUID, body ReviewCalendarEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReviewCalendarEventRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewReviewCalendarEventRequestWithBody generates requests for ReviewCalendarEvent with any type of body
+func NewReviewCalendarEventRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/%s/review", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetEventSuppressionRequest calls the generic SetEventSuppression builder with application/json body
+func NewSetEventSuppressionRequest(server string, id openapi_types.UUID, body SetEventSuppressionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetEventSuppressionRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetEventSuppressionRequestWithBody generates requests for SetEventSuppression with any type of body
+func NewSetEventSuppressionRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/%s/suppression", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetEventTagsRequest calls the generic SetEventTags builder with application/json body
+func NewSetEventTagsRequest(server string, id openapi_types.UUID, body SetEventTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetEventTagsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetEventTagsRequestWithBody generates requests for SetEventTags with any type of body
+func NewSetEventTagsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendar-events/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCalendarConnectionsRequest generates requests for ListCalendarConnections
+func NewListCalendarConnectionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListConnectionsNeedingReauthRequest generates requests for ListConnectionsNeedingReauth
+func NewListConnectionsNeedingReauthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/needs-reauth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteCalendarConnectionRequest generates requests for DeleteCalendarConnection
+func NewDeleteCalendarConnectionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPauseCalendarConnectionRequest generates requests for PauseCalendarConnection
+func NewPauseCalendarConnectionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReauthorizeCalendarConnectionRequest generates requests for ReauthorizeCalendarConnection
+func NewReauthorizeCalendarConnectionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/reauthorize", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResumeCalendarConnectionRequest generates requests for ResumeCalendarConnection
+func NewResumeCalendarConnectionRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCalendarSourcesRequest generates requests for ListCalendarSources
+func NewListCalendarSourcesRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/sources", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCalendarSourcesRequest calls the generic UpdateCalendarSources builder with application/json body
+func NewUpdateCalendarSourcesRequest(server string, id openapi_types.UUID, body UpdateCalendarSourcesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCalendarSourcesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateCalendarSourcesRequestWithBody generates requests for UpdateCalendarSources with any type of body
+func NewUpdateCalendarSourcesRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/sources", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateCalendarSourceRequest calls the generic UpdateCalendarSource builder with application/json body
+func NewUpdateCalendarSourceRequest(server string, id openapi_types.UUID, calendarId openapi_types.UUID, body UpdateCalendarSourceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCalendarSourceRequestWithBody(server, id, calendarId, "application/json", bodyReader)
+}
+
+// NewUpdateCalendarSourceRequestWithBody generates requests for UpdateCalendarSource with any type of body
+func NewUpdateCalendarSourceRequestWithBody(server string, id openapi_types.UUID, calendarId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "calendar_id", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/sources/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSyncCalendarRequest generates requests for SyncCalendar
+func NewSyncCalendarRequest(server string, id openapi_types.UUID, params *SyncCalendarParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/sync", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCalendarSyncWindowRequest calls the generic UpdateCalendarSyncWindow builder with application/json body
+func NewUpdateCalendarSyncWindowRequest(server string, id openapi_types.UUID, body UpdateCalendarSyncWindowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCalendarSyncWindowRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateCalendarSyncWindowRequestWithBody generates requests for UpdateCalendarSyncWindow with any type of body
+func NewUpdateCalendarSyncWindowRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/sync-window", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateCalendarWriteBackRequest calls the generic UpdateCalendarWriteBack builder with application/json body
+func NewUpdateCalendarWriteBackRequest(server string, id openapi_types.UUID, body UpdateCalendarWriteBackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCalendarWriteBackRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateCalendarWriteBackRequestWithBody generates requests for UpdateCalendarWriteBack with any type of body
+func NewUpdateCalendarWriteBackRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/calendars/%s/write-back", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListChangesRequest generates requests for ListChanges
+func NewListChangesRequest(server string, params *ListChangesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ResourceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource_type", runtime.ParamLocationQuery, *params.ResourceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApplyRulesAsyncRequest calls the generic ApplyRulesAsync builder with application/json body
+func NewApplyRulesAsyncRequest(server string, body ApplyRulesAsyncJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApplyRulesAsyncRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApplyRulesAsyncRequestWithBody generates requests for ApplyRulesAsync with any type of body
+func NewApplyRulesAsyncRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/classification/apply-rules:async")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetClassificationJobRequest generates requests for GetClassificationJob
+func NewGetClassificationJobRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/classification/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClientRatesRequest generates requests for ListClientRates
+func NewListClientRatesRequest(server string, params *ListClientRatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/client-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Client != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client", runtime.ParamLocationQuery, *params.Client); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateClientRateRequest calls the generic CreateClientRate builder with application/json body
+func NewCreateClientRateRequest(server string, body CreateClientRateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClientRateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateClientRateRequestWithBody generates requests for CreateClientRate with any type of body
+func NewCreateClientRateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/client-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteClientRateRequest generates requests for DeleteClientRate
+func NewDeleteClientRateRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/client-rates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateClientRateRequest calls the generic UpdateClientRate builder with application/json body
+func NewUpdateClientRateRequest(server string, id openapi_types.UUID, body UpdateClientRateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClientRateRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateClientRateRequestWithBody generates requests for UpdateClientRate with any type of body
+func NewUpdateClientRateRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/client-rates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportConfigRequest generates requests for ExportConfig
+func NewExportConfigRequest(server string, params *ExportConfigParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/config/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_archived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportConfigRequest calls the generic ImportConfig builder with application/json body
+func NewImportConfigRequest(server string, body ImportConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportConfigRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewImportConfigRequestWithBody generates requests for ImportConfig with any type of body
+func NewImportConfigRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/config/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDaySummaryRequest generates requests for GetDaySummary
+func NewGetDaySummaryRequest(server string, date openapi_types.Date) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/day/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListExpensesRequest generates requests for ListExpenses
+func NewListExpensesRequest(server string, params *ListExpensesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/expenses")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateExpenseRequest calls the generic CreateExpense builder with application/json body
+func NewCreateExpenseRequest(server string, body CreateExpenseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateExpenseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateExpenseRequestWithBody generates requests for CreateExpense with any type of body
+func NewCreateExpenseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/expenses")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteExpenseRequest generates requests for DeleteExpense
+func NewDeleteExpenseRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/expenses/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetExpenseRequest generates requests for GetExpense
+func NewGetExpenseRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/expenses/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateExpenseRequest calls the generic UpdateExpense builder with application/json body
+func NewUpdateExpenseRequest(server string, id openapi_types.UUID, body UpdateExpenseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateExpenseRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateExpenseRequestWithBody generates requests for UpdateExpense with any type of body
+func NewUpdateExpenseRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/expenses/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFingerprintClaimsRequest generates requests for ListFingerprintClaims
+func NewListFingerprintClaimsRequest(server string, params *ListFingerprintClaimsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/fingerprints/claims")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "domain", runtime.ParamLocationQuery, params.Domain); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLogFocusSessionRequest calls the generic LogFocusSession builder with application/json body
+func NewLogFocusSessionRequest(server string, body LogFocusSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLogFocusSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLogFocusSessionRequestWithBody generates requests for LogFocusSession with any type of body
+func NewLogFocusSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/focus-sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRecordFocusGapsRequest calls the generic RecordFocusGaps builder with application/json body
+func NewRecordFocusGapsRequest(server string, body RecordFocusGapsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecordFocusGapsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRecordFocusGapsRequestWithBody generates requests for RecordFocusGaps with any type of body
+func NewRecordFocusGapsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/focus-sessions/from-gaps")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListGoalsRequest generates requests for ListGoals
+func NewListGoalsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/goals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateGoalRequest calls the generic CreateGoal builder with application/json body
+func NewCreateGoalRequest(server string, body CreateGoalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateGoalRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateGoalRequestWithBody generates requests for CreateGoal with any type of body
+func NewCreateGoalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/goals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetGoalsProgressRequest generates requests for GetGoalsProgress
+func NewGetGoalsProgressRequest(server string, params *GetGoalsProgressParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/goals/progress")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Date != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, *params.Date); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteGoalRequest generates requests for DeleteGoal
+func NewDeleteGoalRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/goals/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateGoalRequest calls the generic UpdateGoal builder with application/json body
+func NewUpdateGoalRequest(server string, id openapi_types.UUID, body UpdateGoalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateGoalRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateGoalRequestWithBody generates requests for UpdateGoal with any type of body
+func NewUpdateGoalRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/goals/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListIntegrationsRequest generates requests for ListIntegrations
+func NewListIntegrationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDisconnectGitHubRequest generates requests for DisconnectGitHub
+func NewDisconnectGitHubRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/github")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetGitHubConnectionRequest generates requests for GetGitHubConnection
+func NewGetGitHubConnectionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/github")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConnectGitHubRequest calls the generic ConnectGitHub builder with application/json body
+func NewConnectGitHubRequest(server string, body ConnectGitHubJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConnectGitHubRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConnectGitHubRequestWithBody generates requests for ConnectGitHub with any type of body
+func NewConnectGitHubRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/github")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisconnectIntegrationRequest generates requests for DisconnectIntegration
+func NewDisconnectIntegrationRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConnectIntegrationRequest calls the generic ConnectIntegration builder with application/json body
+func NewConnectIntegrationRequest(server string, provider string, body ConnectIntegrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConnectIntegrationRequestWithBody(server, provider, "application/json", bodyReader)
+}
+
+// NewConnectIntegrationRequestWithBody generates requests for ConnectIntegration with any type of body
+func NewConnectIntegrationRequestWithBody(server string, provider string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/integrations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDownloadInvoiceExportRequest generates requests for DownloadInvoiceExport
+func NewDownloadInvoiceExportRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoice-exports/%s/download", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInvoicesRequest generates requests for ListInvoices
+func NewListInvoicesRequest(server string, params *ListInvoicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInvoiceRequest calls the generic CreateInvoice builder with application/json body
+func NewCreateInvoiceRequest(server string, body CreateInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInvoiceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInvoiceRequestWithBody generates requests for CreateInvoice with any type of body
+func NewCreateInvoiceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteInvoiceRequest generates requests for DeleteInvoice
+func NewDeleteInvoiceRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInvoiceRequest generates requests for GetInvoice
+func NewGetInvoiceRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddInvoiceAdjustmentRequest calls the generic AddInvoiceAdjustment builder with application/json body
+func NewAddInvoiceAdjustmentRequest(server string, id openapi_types.UUID, body AddInvoiceAdjustmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddInvoiceAdjustmentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddInvoiceAdjustmentRequestWithBody generates requests for AddInvoiceAdjustment with any type of body
+func NewAddInvoiceAdjustmentRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/adjustments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteInvoiceAdjustmentRequest generates requests for DeleteInvoiceAdjustment
+func NewDeleteInvoiceAdjustmentRequest(server string, id openapi_types.UUID, lineItemId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "lineItemId", runtime.ParamLocationPath, lineItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/adjustments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInvoiceCommentsRequest generates requests for ListInvoiceComments
+func NewListInvoiceCommentsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddInvoiceCommentRequest calls the generic AddInvoiceComment builder with application/json body
+func NewAddInvoiceCommentRequest(server string, id openapi_types.UUID, body AddInvoiceCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddInvoiceCommentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddInvoiceCommentRequestWithBody generates requests for AddInvoiceComment with any type of body
+func NewAddInvoiceCommentRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteInvoiceCommentRequest generates requests for DeleteInvoiceComment
+func NewDeleteInvoiceCommentRequest(server string, id openapi_types.UUID, commentId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "commentId", runtime.ParamLocationPath, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCreditNoteRequest calls the generic CreateCreditNote builder with application/json body
+func NewCreateCreditNoteRequest(server string, id openapi_types.UUID, body CreateCreditNoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCreditNoteRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateCreditNoteRequestWithBody generates requests for CreateCreditNote with any type of body
+func NewCreateCreditNoteRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/credit-notes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportInvoiceRequest generates requests for ExportInvoice
+func NewExportInvoiceRequest(server string, id openapi_types.UUID, params *ExportInvoiceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.IncludeNotes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_notes", runtime.ParamLocationQuery, *params.IncludeNotes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportInvoiceCSVRequest generates requests for ExportInvoiceCSV
+func NewExportInvoiceCSVRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/export/csv", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportInvoiceSheetsRequest generates requests for ExportInvoiceSheets
+func NewExportInvoiceSheetsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/export/sheets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInvoiceExportsRequest generates requests for ListInvoiceExports
+func NewListInvoiceExportsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/exports", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInvoicePaymentsRequest generates requests for ListInvoicePayments
+func NewListInvoicePaymentsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/payments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRecordInvoicePaymentRequest calls the generic RecordInvoicePayment builder with application/json body
+func NewRecordInvoicePaymentRequest(server string, id openapi_types.UUID, body RecordInvoicePaymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecordInvoicePaymentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRecordInvoicePaymentRequestWithBody generates requests for RecordInvoicePayment with any type of body
+func NewRecordInvoicePaymentRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/payments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateInvoiceStatusRequest calls the generic UpdateInvoiceStatus builder with application/json body
+func NewUpdateInvoiceStatusRequest(server string, id openapi_types.UUID, body UpdateInvoiceStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateInvoiceStatusRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateInvoiceStatusRequestWithBody generates requests for UpdateInvoiceStatus with any type of body
+func NewUpdateInvoiceStatusRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/invoices/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationsRequest generates requests for ListOrganizations
+func NewListOrganizationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOrganizationRequest calls the generic CreateOrganization builder with application/json body
+func NewCreateOrganizationRequest(server string, body CreateOrganizationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrganizationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateOrganizationRequestWithBody generates requests for CreateOrganization with any type of body
+func NewCreateOrganizationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOrganizationRequest generates requests for GetOrganization
+func NewGetOrganizationRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteOrganizationDomainRequest generates requests for DeleteOrganizationDomain
+func NewDeleteOrganizationDomainRequest(server string, id openapi_types.UUID, domain string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "domain", runtime.ParamLocationPath, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/domains/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutOrganizationDomainRequest calls the generic PutOrganizationDomain builder with application/json body
+func NewPutOrganizationDomainRequest(server string, id openapi_types.UUID, domain string, body PutOrganizationDomainJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutOrganizationDomainRequestWithBody(server, id, domain, "application/json", bodyReader)
+}
+
+// NewPutOrganizationDomainRequestWithBody generates requests for PutOrganizationDomain with any type of body
+func NewPutOrganizationDomainRequestWithBody(server string, id openapi_types.UUID, domain string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "domain", runtime.ParamLocationPath, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/domains/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyOrganizationDomainRequest generates requests for VerifyOrganizationDomain
+func NewVerifyOrganizationDomainRequest(server string, id openapi_types.UUID, domain string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "domain", runtime.ParamLocationPath, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/domains/%s/verify", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationGoogleDelegationRequest calls the generic UpdateOrganizationGoogleDelegation builder with application/json body
+func NewUpdateOrganizationGoogleDelegationRequest(server string, id openapi_types.UUID, body UpdateOrganizationGoogleDelegationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationGoogleDelegationRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationGoogleDelegationRequestWithBody generates requests for UpdateOrganizationGoogleDelegation with any type of body
+func NewUpdateOrganizationGoogleDelegationRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/google-delegation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewProvisionOrganizationCalendarsRequest calls the generic ProvisionOrganizationCalendars builder with application/json body
+func NewProvisionOrganizationCalendarsRequest(server string, id openapi_types.UUID, body ProvisionOrganizationCalendarsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProvisionOrganizationCalendarsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewProvisionOrganizationCalendarsRequestWithBody generates requests for ProvisionOrganizationCalendars with any type of body
+func NewProvisionOrganizationCalendarsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/google-delegation/provision", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOrganizationMembersRequest generates requests for ListOrganizationMembers
+func NewListOrganizationMembersRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationMemberDelegationRequest calls the generic UpdateOrganizationMemberDelegation builder with application/json body
+func NewUpdateOrganizationMemberDelegationRequest(server string, id openapi_types.UUID, userId openapi_types.UUID, body UpdateOrganizationMemberDelegationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationMemberDelegationRequestWithBody(server, id, userId, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationMemberDelegationRequestWithBody generates requests for UpdateOrganizationMemberDelegation with any type of body
+func NewUpdateOrganizationMemberDelegationRequestWithBody(server string, id openapi_types.UUID, userId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/members/%s/delegation", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransferOrganizationProjectRequest calls the generic TransferOrganizationProject builder with application/json body
+func NewTransferOrganizationProjectRequest(server string, id openapi_types.UUID, body TransferOrganizationProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTransferOrganizationProjectRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewTransferOrganizationProjectRequestWithBody generates requests for TransferOrganizationProject with any type of body
+func NewTransferOrganizationProjectRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/project-transfers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRotateOrganizationScimTokenRequest generates requests for RotateOrganizationScimToken
+func NewRotateOrganizationScimTokenRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/scim-token", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrganizationSsoRequest calls the generic UpdateOrganizationSso builder with application/json body
+func NewUpdateOrganizationSsoRequest(server string, id openapi_types.UUID, body UpdateOrganizationSsoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrganizationSsoRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateOrganizationSsoRequestWithBody generates requests for UpdateOrganizationSso with any type of body
+func NewUpdateOrganizationSsoRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/organizations/%s/sso", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePaymentRequest generates requests for DeletePayment
+func NewDeletePaymentRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/payments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectsRequest generates requests for ListProjects
+func NewListProjectsRequest(server string, params *ListProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_archived", runtime.ParamLocationQuery, *params.IncludeArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Archived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Client != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client", runtime.ParamLocationQuery, *params.Client); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Billable != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "billable", runtime.ParamLocationQuery, *params.Billable); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateProjectRequestWithBody generates requests for CreateProject with any type of body
+func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectRequest generates requests for DeleteProject
+func NewDeleteProjectRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
+func NewUpdateProjectRequest(server string, id openapi_types.UUID, body UpdateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateProjectRequestWithBody generates requests for UpdateProject with any type of body
+func NewUpdateProjectRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddProjectFingerprintRequest calls the generic AddProjectFingerprint builder with application/json body
+func NewAddProjectFingerprintRequest(server string, id openapi_types.UUID, body AddProjectFingerprintJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddProjectFingerprintRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddProjectFingerprintRequestWithBody generates requests for AddProjectFingerprint with any type of body
+func NewAddProjectFingerprintRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/%s/fingerprints", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveProjectFingerprintRequest generates requests for RemoveProjectFingerprint
+func NewRemoveProjectFingerprintRequest(server string, id openapi_types.UUID, kind FingerprintKind, value string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "kind", runtime.ParamLocationPath, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "value", runtime.ParamLocationPath, value)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/projects/%s/fingerprints/%s/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMeetingCostReportRequest generates requests for GetMeetingCostReport
+func NewGetMeetingCostReportRequest(server string, params *GetMeetingCostReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reports/meeting-cost")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DefaultRate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "default_rate", runtime.ParamLocationQuery, *params.DefaultRate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Rate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rate", runtime.ParamLocationQuery, *params.Rate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMeetingLoadReportRequest generates requests for GetMeetingLoadReport
+func NewGetMeetingLoadReportRequest(server string, params *GetMeetingLoadReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reports/meeting-load")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DayStart != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "day_start", runtime.ParamLocationQuery, *params.DayStart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinFocusMinutes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_focus_minutes", runtime.ParamLocationQuery, *params.MinFocusMinutes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUtilizationReportRequest generates requests for GetUtilizationReport
+func NewGetUtilizationReportRequest(server string, params *GetUtilizationReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reports/utilization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportRulePackRequest calls the generic ExportRulePack builder with application/json body
+func NewExportRulePackRequest(server string, body ExportRulePackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExportRulePackRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExportRulePackRequestWithBody generates requests for ExportRulePack with any type of body
+func NewExportRulePackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rule-packs/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportRulePackRequest calls the generic ImportRulePack builder with application/json body
+func NewImportRulePackRequest(server string, body ImportRulePackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportRulePackRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewImportRulePackRequestWithBody generates requests for ImportRulePack with any type of body
+func NewImportRulePackRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rule-packs/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRulesRequest generates requests for ListRules
+func NewListRulesRequest(server string, params *ListRulesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeDisabled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_disabled", runtime.ParamLocationQuery, *params.IncludeDisabled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Client != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client", runtime.ParamLocationQuery, *params.Client); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRuleRequest calls the generic CreateRule builder with application/json body
+func NewCreateRuleRequest(server string, body CreateRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRuleRequestWithBody generates requests for CreateRule with any type of body
+func NewCreateRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApplyRulesRequest calls the generic ApplyRules builder with application/json body
+func NewApplyRulesRequest(server string, body ApplyRulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApplyRulesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApplyRulesRequestWithBody generates requests for ApplyRules with any type of body
+func NewApplyRulesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/apply")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRuleConflictsRequest generates requests for GetRuleConflicts
+func NewGetRuleConflictsRequest(server string, params *GetRuleConflictsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/conflicts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinOverlap != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "min_overlap", runtime.ParamLocationQuery, *params.MinOverlap); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLintRuleRequest calls the generic LintRule builder with application/json body
+func NewLintRuleRequest(server string, body LintRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLintRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLintRuleRequestWithBody generates requests for LintRule with any type of body
+func NewLintRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/lint")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewRuleRequest calls the generic PreviewRule builder with application/json body
+func NewPreviewRuleRequest(server string, body PreviewRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPreviewRuleRequestWithBody generates requests for PreviewRule with any type of body
+func NewPreviewRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTestRuleRequest calls the generic TestRule builder with application/json body
+func NewTestRuleRequest(server string, body TestRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTestRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTestRuleRequestWithBody generates requests for TestRule with any type of body
+func NewTestRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/test")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRuleRequest generates requests for DeleteRule
+func NewDeleteRuleRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRuleRequest generates requests for GetRule
+func NewGetRuleRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRuleRequest calls the generic UpdateRule builder with application/json body
+func NewUpdateRuleRequest(server string, id openapi_types.UUID, body UpdateRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRuleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateRuleRequestWithBody generates requests for UpdateRule with any type of body
+func NewUpdateRuleRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListClassificationSnapshotsRequest generates requests for ListClassificationSnapshots
+func NewListClassificationSnapshotsRequest(server string, params *ListClassificationSnapshotsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/snapshots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.WeekStart != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "week_start", runtime.ParamLocationQuery, *params.WeekStart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateClassificationSnapshotRequest calls the generic CreateClassificationSnapshot builder with application/json body
+func NewCreateClassificationSnapshotRequest(server string, body CreateClassificationSnapshotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClassificationSnapshotRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateClassificationSnapshotRequestWithBody generates requests for CreateClassificationSnapshot with any type of body
+func NewCreateClassificationSnapshotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/snapshots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteClassificationSnapshotRequest generates requests for DeleteClassificationSnapshot
+func NewDeleteClassificationSnapshotRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/snapshots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreClassificationSnapshotRequest generates requests for RestoreClassificationSnapshot
+func NewRestoreClassificationSnapshotRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/snapshots/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSuppressionRulesRequest generates requests for ListSuppressionRules
+func NewListSuppressionRulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/suppression-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSuppressionRuleRequest calls the generic CreateSuppressionRule builder with application/json body
+func NewCreateSuppressionRuleRequest(server string, body CreateSuppressionRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSuppressionRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSuppressionRuleRequestWithBody generates requests for CreateSuppressionRule with any type of body
+func NewCreateSuppressionRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/suppression-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSuppressionRuleRequest generates requests for DeleteSuppressionRule
+func NewDeleteSuppressionRuleRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/suppression-rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSuppressionRuleRequest calls the generic UpdateSuppressionRule builder with application/json body
+func NewUpdateSuppressionRuleRequest(server string, id openapi_types.UUID, body UpdateSuppressionRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSuppressionRuleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateSuppressionRuleRequestWithBody generates requests for UpdateSuppressionRule with any type of body
+func NewUpdateSuppressionRuleRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/suppression-rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTagsRequest generates requests for ListTags
+func NewListTagsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTagRequest calls the generic CreateTag builder with application/json body
+func NewCreateTagRequest(server string, body CreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
+func NewCreateTagRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRenameTagRequest calls the generic RenameTag builder with application/json body
+func NewRenameTagRequest(server string, id openapi_types.UUID, body RenameTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRenameTagRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRenameTagRequestWithBody generates requests for RenameTag with any type of body
+func NewRenameTagRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTimeEntriesRequest generates requests for ListTimeEntries
+func NewListTimeEntriesRequest(server string, params *ListTimeEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "project_id", runtime.ParamLocationQuery, *params.ProjectId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTimeEntryRequest calls the generic CreateTimeEntry builder with application/json body
+func NewCreateTimeEntryRequest(server string, body CreateTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTimeEntryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTimeEntryRequestWithBody generates requests for CreateTimeEntry with any type of body
+func NewCreateTimeEntryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSuggestDescriptionsRequest generates requests for SuggestDescriptions
+func NewSuggestDescriptionsRequest(server string, params *SuggestDescriptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/description-suggestions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, params.Date); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewParseTimeEntryRequest calls the generic ParseTimeEntry builder with application/json body
+func NewParseTimeEntryRequest(server string, params *ParseTimeEntryParams, body ParseTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewParseTimeEntryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewParseTimeEntryRequestWithBody generates requests for ParseTimeEntry with any type of body
+func NewParseTimeEntryRequestWithBody(server string, params *ParseTimeEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/parse")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Commit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "commit", runtime.ParamLocationQuery, *params.Commit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRecalculateTimeEntriesRequest calls the generic RecalculateTimeEntries builder with application/json body
+func NewRecalculateTimeEntriesRequest(server string, body RecalculateTimeEntriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecalculateTimeEntriesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRecalculateTimeEntriesRequestWithBody generates requests for RecalculateTimeEntries with any type of body
+func NewRecalculateTimeEntriesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/recalculate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRecalculationJobRequest generates requests for GetRecalculationJob
+func NewGetRecalculationJobRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/recalculate/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReconciliationReportRequest generates requests for GetReconciliationReport
+func NewGetReconciliationReportRequest(server string, params *GetReconciliationReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/reconciliation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_date", runtime.ParamLocationQuery, *params.StartDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndDate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_date", runtime.ParamLocationQuery, *params.EndDate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeAcknowledged != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_acknowledged", runtime.ParamLocationQuery, *params.IncludeAcknowledged); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTimeEntryRequest generates requests for DeleteTimeEntry
+func NewDeleteTimeEntryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTimeEntryRequest generates requests for GetTimeEntry
+func NewGetTimeEntryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTimeEntryRequest calls the generic UpdateTimeEntry builder with application/json body
+func NewUpdateTimeEntryRequest(server string, id openapi_types.UUID, body UpdateTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTimeEntryRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateTimeEntryRequestWithBody generates requests for UpdateTimeEntry with any type of body
+func NewUpdateTimeEntryRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTimeEntryBreakdownRequest generates requests for GetTimeEntryBreakdown
+func NewGetTimeEntryBreakdownRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/breakdown", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExplainTimeEntryRequest generates requests for ExplainTimeEntry
+func NewExplainTimeEntryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/explain", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTimeEntryNotesRequest generates requests for ListTimeEntryNotes
+func NewListTimeEntryNotesRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/notes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTimeEntryNoteRequest calls the generic AddTimeEntryNote builder with application/json body
+func NewAddTimeEntryNoteRequest(server string, id openapi_types.UUID, body AddTimeEntryNoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTimeEntryNoteRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddTimeEntryNoteRequestWithBody generates requests for AddTimeEntryNote with any type of body
+func NewAddTimeEntryNoteRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/notes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTimeEntryNoteRequest generates requests for DeleteTimeEntryNote
+func NewDeleteTimeEntryNoteRequest(server string, id openapi_types.UUID, noteId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "noteId", runtime.ParamLocationPath, noteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/notes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReconcileTimeEntryRequest calls the generic ReconcileTimeEntry builder with application/json body
+func NewReconcileTimeEntryRequest(server string, id openapi_types.UUID, body ReconcileTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReconcileTimeEntryRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewReconcileTimeEntryRequestWithBody generates requests for ReconcileTimeEntry with any type of body
+func NewReconcileTimeEntryRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/reconcile", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRefreshTimeEntryRequest generates requests for RefreshTimeEntry
+func NewRefreshTimeEntryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/refresh", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSplitTimeEntryRequest calls the generic SplitTimeEntry builder with application/json body
+func NewSplitTimeEntryRequest(server string, id openapi_types.UUID, body SplitTimeEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSplitTimeEntryRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSplitTimeEntryRequestWithBody generates requests for SplitTimeEntry with any type of body
+func NewSplitTimeEntryRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/split", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetTimeEntryTagsRequest calls the generic SetTimeEntryTags builder with application/json body
+func NewSetTimeEntryTagsRequest(server string, id openapi_types.UUID, body SetTimeEntryTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTimeEntryTagsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetTimeEntryTagsRequestWithBody generates requests for SetTimeEntryTags with any type of body
+func NewSetTimeEntryTagsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/time-entries/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTrashRequest generates requests for ListTrash
+func NewListTrashRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreRuleRequest generates requests for RestoreRule
+func NewRestoreRuleRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/trash/rules/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreTimeEntryRequest generates requests for RestoreTimeEntry
+func NewRestoreTimeEntryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/trash/time-entries/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWeekGridRequest generates requests for GetWeekGrid
+func NewGetWeekGridRequest(server string, start openapi_types.Date) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "start", runtime.ParamLocationPath, start)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/weeks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkingHoursRequest generates requests for GetWorkingHours
+func NewGetWorkingHoursRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/working-hours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWorkingHoursRequest calls the generic UpdateWorkingHours builder with application/json body
+func NewUpdateWorkingHoursRequest(server string, body UpdateWorkingHoursJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWorkingHoursRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateWorkingHoursRequestWithBody generates requests for UpdateWorkingHours with any type of body
+func NewUpdateWorkingHoursRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/working-hours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListClassificationActionsWithResponse request
+	ListClassificationActionsWithResponse(ctx context.Context, params *ListClassificationActionsParams, reqEditors ...RequestEditorFn) (*ListClassificationActionsResult, error)
+
+	// UndoClassificationActionWithResponse request
+	UndoClassificationActionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*UndoClassificationActionResult, error)
+
+	// ListAnomaliesWithResponse request
+	ListAnomaliesWithResponse(ctx context.Context, params *ListAnomaliesParams, reqEditors ...RequestEditorFn) (*ListAnomaliesResult, error)
+
+	// AnalyzeAnomaliesWithBodyWithResponse request with any body
+	AnalyzeAnomaliesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnalyzeAnomaliesResult, error)
+
+	AnalyzeAnomaliesWithResponse(ctx context.Context, body AnalyzeAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*AnalyzeAnomaliesResult, error)
+
+	// DismissAnomalyWithResponse request
+	DismissAnomalyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DismissAnomalyResult, error)
+
+	// ListApiKeysWithResponse request
+	ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResult, error)
+
+	// CreateApiKeyWithBodyWithResponse request with any body
+	CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResult, error)
+
+	CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResult, error)
+
+	// DeleteApiKeyWithResponse request
+	DeleteApiKeyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiKeyResult, error)
+
+	// UpdateApiKeyWithBodyWithResponse request with any body
+	UpdateApiKeyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyResult, error)
+
+	UpdateApiKeyWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyResult, error)
+
+	// ListAttendeeAliasesWithResponse request
+	ListAttendeeAliasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAttendeeAliasesResult, error)
+
+	// CreateAttendeeAliasWithBodyWithResponse request with any body
+	CreateAttendeeAliasWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAttendeeAliasResult, error)
+
+	CreateAttendeeAliasWithResponse(ctx context.Context, body CreateAttendeeAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAttendeeAliasResult, error)
+
+	// DeleteAttendeeAliasWithResponse request
+	DeleteAttendeeAliasWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAttendeeAliasResult, error)
+
+	// GetCompanyDomainsWithResponse request
+	GetCompanyDomainsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCompanyDomainsResult, error)
+
+	// UpdateCompanyDomainsWithBodyWithResponse request with any body
+	UpdateCompanyDomainsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompanyDomainsResult, error)
+
+	UpdateCompanyDomainsWithResponse(ctx context.Context, body UpdateCompanyDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompanyDomainsResult, error)
+
+	// GoogleAuthorizeWithResponse request
+	GoogleAuthorizeWithResponse(ctx context.Context, params *GoogleAuthorizeParams, reqEditors ...RequestEditorFn) (*GoogleAuthorizeResult, error)
+
+	// GoogleCallbackWithResponse request
+	GoogleCallbackWithResponse(ctx context.Context, params *GoogleCallbackParams, reqEditors ...RequestEditorFn) (*GoogleCallbackResult, error)
+
+	// GetLocaleWithResponse request
+	GetLocaleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLocaleResult, error)
+
+	// UpdateLocaleWithBodyWithResponse request with any body
+	UpdateLocaleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLocaleResult, error)
+
+	UpdateLocaleWithResponse(ctx context.Context, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocaleResult, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResult, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResult, error)
+
+	// LogoutWithResponse request
+	LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResult, error)
+
+	// GetCurrentUserWithResponse request
+	GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResult, error)
+
+	// GetRetentionSettingsWithResponse request
+	GetRetentionSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRetentionSettingsResult, error)
+
+	// UpdateRetentionSettingsWithBodyWithResponse request with any body
+	UpdateRetentionSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRetentionSettingsResult, error)
+
+	UpdateRetentionSettingsWithResponse(ctx context.Context, body UpdateRetentionSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRetentionSettingsResult, error)
+
+	// PreviewRetentionWithResponse request
+	PreviewRetentionWithResponse(ctx context.Context, params *PreviewRetentionParams, reqEditors ...RequestEditorFn) (*PreviewRetentionResult, error)
+
+	// SignupWithBodyWithResponse request with any body
+	SignupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignupResult, error)
+
+	SignupWithResponse(ctx context.Context, body SignupJSONRequestBody, reqEditors ...RequestEditorFn) (*SignupResult, error)
+
+	// StartSsoWithBodyWithResponse request with any body
+	StartSsoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartSsoResult, error)
+
+	StartSsoWithResponse(ctx context.Context, body StartSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*StartSsoResult, error)
+
+	// GetSyncSettingsWithResponse request
+	GetSyncSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSyncSettingsResult, error)
+
+	// UpdateSyncSettingsWithBodyWithResponse request with any body
+	UpdateSyncSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSyncSettingsResult, error)
+
+	UpdateSyncSettingsWithResponse(ctx context.Context, body UpdateSyncSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSyncSettingsResult, error)
+
+	// ListBillingPeriodsWithResponse request
+	ListBillingPeriodsWithResponse(ctx context.Context, params *ListBillingPeriodsParams, reqEditors ...RequestEditorFn) (*ListBillingPeriodsResult, error)
+
+	// CreateBillingPeriodWithBodyWithResponse request with any body
+	CreateBillingPeriodWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBillingPeriodResult, error)
+
+	CreateBillingPeriodWithResponse(ctx context.Context, body CreateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBillingPeriodResult, error)
+
+	// DeleteBillingPeriodWithResponse request
+	DeleteBillingPeriodWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBillingPeriodResult, error)
+
+	// UpdateBillingPeriodWithBodyWithResponse request with any body
+	UpdateBillingPeriodWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBillingPeriodResult, error)
+
+	UpdateBillingPeriodWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBillingPeriodResult, error)
+
+	// ListClientBalancesWithResponse request
+	ListClientBalancesWithResponse(ctx context.Context, params *ListClientBalancesParams, reqEditors ...RequestEditorFn) (*ListClientBalancesResult, error)
+
+	// ListCalendarEventsWithResponse request
+	ListCalendarEventsWithResponse(ctx context.Context, params *ListCalendarEventsParams, reqEditors ...RequestEditorFn) (*ListCalendarEventsResult, error)
+
+	// BulkClassifyEventsWithBodyWithResponse request with any body
+	BulkClassifyEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkClassifyEventsResult, error)
+
+	BulkClassifyEventsWithResponse(ctx context.Context, body BulkClassifyEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkClassifyEventsResult, error)
+
+	// DiffCalendarEventsWithResponse request
+	DiffCalendarEventsWithResponse(ctx context.Context, params *DiffCalendarEventsParams, reqEditors ...RequestEditorFn) (*DiffCalendarEventsResult, error)
+
+	// GetReviewQueueWithResponse request
+	GetReviewQueueWithResponse(ctx context.Context, params *GetReviewQueueParams, reqEditors ...RequestEditorFn) (*GetReviewQueueResult, error)
+
+	// ListEventChangesWithResponse request
+	ListEventChangesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListEventChangesResult, error)
+
+	// ClassifyCalendarEventWithBodyWithResponse request with any body
+	ClassifyCalendarEventWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClassifyCalendarEventResult, error)
+
+	ClassifyCalendarEventWithResponse(ctx context.Context, id openapi_types.UUID, body ClassifyCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*ClassifyCalendarEventResult, error)
+
+	// ExplainEventClassificationWithResponse request
+	ExplainEventClassificationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExplainEventClassificationResult, error)
+
+	// ListEventClassificationHistoryWithResponse request
+	ListEventClassificationHistoryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListEventClassificationHistoryResult, error)
+
+	// ReviewCalendarEventWithBodyWithResponse request with any body
+	ReviewCalendarEventWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewCalendarEventResult, error)
+
+	ReviewCalendarEventWithResponse(ctx context.Context, id openapi_types.UUID, body ReviewCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewCalendarEventResult, error)
+
+	// SetEventSuppressionWithBodyWithResponse request with any body
+	SetEventSuppressionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEventSuppressionResult, error)
+
+	SetEventSuppressionWithResponse(ctx context.Context, id openapi_types.UUID, body SetEventSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetEventSuppressionResult, error)
+
+	// SetEventTagsWithBodyWithResponse request with any body
+	SetEventTagsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEventTagsResult, error)
+
+	SetEventTagsWithResponse(ctx context.Context, id openapi_types.UUID, body SetEventTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetEventTagsResult, error)
+
+	// ListCalendarConnectionsWithResponse request
+	ListCalendarConnectionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCalendarConnectionsResult, error)
+
+	// ListConnectionsNeedingReauthWithResponse request
+	ListConnectionsNeedingReauthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListConnectionsNeedingReauthResult, error)
+
+	// DeleteCalendarConnectionWithResponse request
+	DeleteCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCalendarConnectionResult, error)
+
+	// PauseCalendarConnectionWithResponse request
+	PauseCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PauseCalendarConnectionResult, error)
+
+	// ReauthorizeCalendarConnectionWithResponse request
+	ReauthorizeCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReauthorizeCalendarConnectionResult, error)
+
+	// ResumeCalendarConnectionWithResponse request
+	ResumeCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ResumeCalendarConnectionResult, error)
+
+	// ListCalendarSourcesWithResponse request
+	ListCalendarSourcesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListCalendarSourcesResult, error)
+
+	// UpdateCalendarSourcesWithBodyWithResponse request with any body
+	UpdateCalendarSourcesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarSourcesResult, error)
+
+	UpdateCalendarSourcesWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarSourcesResult, error)
+
+	// UpdateCalendarSourceWithBodyWithResponse request with any body
+	UpdateCalendarSourceWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarSourceResult, error)
+
+	UpdateCalendarSourceWithResponse(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, body UpdateCalendarSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarSourceResult, error)
+
+	// SyncCalendarWithResponse request
+	SyncCalendarWithResponse(ctx context.Context, id openapi_types.UUID, params *SyncCalendarParams, reqEditors ...RequestEditorFn) (*SyncCalendarResult, error)
+
+	// UpdateCalendarSyncWindowWithBodyWithResponse request with any body
+	UpdateCalendarSyncWindowWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarSyncWindowResult, error)
+
+	UpdateCalendarSyncWindowWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSyncWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarSyncWindowResult, error)
+
+	// UpdateCalendarWriteBackWithBodyWithResponse request with any body
+	UpdateCalendarWriteBackWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarWriteBackResult, error)
+
+	UpdateCalendarWriteBackWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateCalendarWriteBackJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarWriteBackResult, error)
+
+	// ListChangesWithResponse request
+	ListChangesWithResponse(ctx context.Context, params *ListChangesParams, reqEditors ...RequestEditorFn) (*ListChangesResult, error)
+
+	// ApplyRulesAsyncWithBodyWithResponse request with any body
+	ApplyRulesAsyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyRulesAsyncResult, error)
+
+	ApplyRulesAsyncWithResponse(ctx context.Context, body ApplyRulesAsyncJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyRulesAsyncResult, error)
+
+	// GetClassificationJobWithResponse request
+	GetClassificationJobWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetClassificationJobResult, error)
+
+	// ListClientRatesWithResponse request
+	ListClientRatesWithResponse(ctx context.Context, params *ListClientRatesParams, reqEditors ...RequestEditorFn) (*ListClientRatesResult, error)
+
+	// CreateClientRateWithBodyWithResponse request with any body
+	CreateClientRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientRateResult, error)
+
+	CreateClientRateWithResponse(ctx context.Context, body CreateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientRateResult, error)
+
+	// DeleteClientRateWithResponse request
+	DeleteClientRateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteClientRateResult, error)
+
+	// UpdateClientRateWithBodyWithResponse request with any body
+	UpdateClientRateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientRateResult, error)
+
+	UpdateClientRateWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientRateResult, error)
+
+	// ExportConfigWithResponse request
+	ExportConfigWithResponse(ctx context.Context, params *ExportConfigParams, reqEditors ...RequestEditorFn) (*ExportConfigResult, error)
+
+	// ImportConfigWithBodyWithResponse request with any body
+	ImportConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportConfigResult, error)
+
+	ImportConfigWithResponse(ctx context.Context, body ImportConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportConfigResult, error)
+
+	// GetDaySummaryWithResponse request
+	GetDaySummaryWithResponse(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*GetDaySummaryResult, error)
+
+	// ListExpensesWithResponse request
+	ListExpensesWithResponse(ctx context.Context, params *ListExpensesParams, reqEditors ...RequestEditorFn) (*ListExpensesResult, error)
+
+	// CreateExpenseWithBodyWithResponse request with any body
+	CreateExpenseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExpenseResult, error)
+
+	CreateExpenseWithResponse(ctx context.Context, body CreateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExpenseResult, error)
+
+	// DeleteExpenseWithResponse request
+	DeleteExpenseWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteExpenseResult, error)
+
+	// GetExpenseWithResponse request
+	GetExpenseWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetExpenseResult, error)
+
+	// UpdateExpenseWithBodyWithResponse request with any body
+	UpdateExpenseWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExpenseResult, error)
+
+	UpdateExpenseWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExpenseResult, error)
+
+	// ListFingerprintClaimsWithResponse request
+	ListFingerprintClaimsWithResponse(ctx context.Context, params *ListFingerprintClaimsParams, reqEditors ...RequestEditorFn) (*ListFingerprintClaimsResult, error)
+
+	// LogFocusSessionWithBodyWithResponse request with any body
+	LogFocusSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogFocusSessionResult, error)
+
+	LogFocusSessionWithResponse(ctx context.Context, body LogFocusSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*LogFocusSessionResult, error)
+
+	// RecordFocusGapsWithBodyWithResponse request with any body
+	RecordFocusGapsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordFocusGapsResult, error)
+
+	RecordFocusGapsWithResponse(ctx context.Context, body RecordFocusGapsJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordFocusGapsResult, error)
+
+	// ListGoalsWithResponse request
+	ListGoalsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListGoalsResult, error)
+
+	// CreateGoalWithBodyWithResponse request with any body
+	CreateGoalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGoalResult, error)
+
+	CreateGoalWithResponse(ctx context.Context, body CreateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGoalResult, error)
+
+	// GetGoalsProgressWithResponse request
+	GetGoalsProgressWithResponse(ctx context.Context, params *GetGoalsProgressParams, reqEditors ...RequestEditorFn) (*GetGoalsProgressResult, error)
+
+	// DeleteGoalWithResponse request
+	DeleteGoalWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteGoalResult, error)
+
+	// UpdateGoalWithBodyWithResponse request with any body
+	UpdateGoalWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGoalResult, error)
+
+	UpdateGoalWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGoalResult, error)
+
+	// ListIntegrationsWithResponse request
+	ListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIntegrationsResult, error)
+
+	// DisconnectGitHubWithResponse request
+	DisconnectGitHubWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DisconnectGitHubResult, error)
+
+	// GetGitHubConnectionWithResponse request
+	GetGitHubConnectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGitHubConnectionResult, error)
+
+	// ConnectGitHubWithBodyWithResponse request with any body
+	ConnectGitHubWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConnectGitHubResult, error)
+
+	ConnectGitHubWithResponse(ctx context.Context, body ConnectGitHubJSONRequestBody, reqEditors ...RequestEditorFn) (*ConnectGitHubResult, error)
+
+	// DisconnectIntegrationWithResponse request
+	DisconnectIntegrationWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*DisconnectIntegrationResult, error)
+
+	// ConnectIntegrationWithBodyWithResponse request with any body
+	ConnectIntegrationWithBodyWithResponse(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConnectIntegrationResult, error)
+
+	ConnectIntegrationWithResponse(ctx context.Context, provider string, body ConnectIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*ConnectIntegrationResult, error)
+
+	// DownloadInvoiceExportWithResponse request
+	DownloadInvoiceExportWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DownloadInvoiceExportResult, error)
+
+	// ListInvoicesWithResponse request
+	ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResult, error)
+
+	// CreateInvoiceWithBodyWithResponse request with any body
+	CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResult, error)
+
+	CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResult, error)
+
+	// DeleteInvoiceWithResponse request
+	DeleteInvoiceWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvoiceResult, error)
+
+	// GetInvoiceWithResponse request
+	GetInvoiceWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInvoiceResult, error)
+
+	// AddInvoiceAdjustmentWithBodyWithResponse request with any body
+	AddInvoiceAdjustmentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceAdjustmentResult, error)
+
+	AddInvoiceAdjustmentWithResponse(ctx context.Context, id openapi_types.UUID, body AddInvoiceAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceAdjustmentResult, error)
+
+	// DeleteInvoiceAdjustmentWithResponse request
+	DeleteInvoiceAdjustmentWithResponse(ctx context.Context, id openapi_types.UUID, lineItemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvoiceAdjustmentResult, error)
+
+	// ListInvoiceCommentsWithResponse request
+	ListInvoiceCommentsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListInvoiceCommentsResult, error)
+
+	// AddInvoiceCommentWithBodyWithResponse request with any body
+	AddInvoiceCommentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceCommentResult, error)
+
+	AddInvoiceCommentWithResponse(ctx context.Context, id openapi_types.UUID, body AddInvoiceCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceCommentResult, error)
+
+	// DeleteInvoiceCommentWithResponse request
+	DeleteInvoiceCommentWithResponse(ctx context.Context, id openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvoiceCommentResult, error)
+
+	// CreateCreditNoteWithBodyWithResponse request with any body
+	CreateCreditNoteWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCreditNoteResult, error)
+
+	CreateCreditNoteWithResponse(ctx context.Context, id openapi_types.UUID, body CreateCreditNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCreditNoteResult, error)
+
+	// ExportInvoiceWithResponse request
+	ExportInvoiceWithResponse(ctx context.Context, id openapi_types.UUID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*ExportInvoiceResult, error)
+
+	// ExportInvoiceCSVWithResponse request
+	ExportInvoiceCSVWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExportInvoiceCSVResult, error)
+
+	// ExportInvoiceSheetsWithResponse request
+	ExportInvoiceSheetsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExportInvoiceSheetsResult, error)
+
+	// ListInvoiceExportsWithResponse request
+	ListInvoiceExportsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListInvoiceExportsResult, error)
+
+	// ListInvoicePaymentsWithResponse request
+	ListInvoicePaymentsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListInvoicePaymentsResult, error)
+
+	// RecordInvoicePaymentWithBodyWithResponse request with any body
+	RecordInvoicePaymentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordInvoicePaymentResult, error)
+
+	RecordInvoicePaymentWithResponse(ctx context.Context, id openapi_types.UUID, body RecordInvoicePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordInvoicePaymentResult, error)
+
+	// UpdateInvoiceStatusWithBodyWithResponse request with any body
+	UpdateInvoiceStatusWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResult, error)
+
+	UpdateInvoiceStatusWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResult, error)
+
+	// ListOrganizationsWithResponse request
+	ListOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganizationsResult, error)
+
+	// CreateOrganizationWithBodyWithResponse request with any body
+	CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResult, error)
+
+	CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResult, error)
+
+	// GetOrganizationWithResponse request
+	GetOrganizationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrganizationResult, error)
+
+	// DeleteOrganizationDomainWithResponse request
+	DeleteOrganizationDomainWithResponse(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*DeleteOrganizationDomainResult, error)
+
+	// PutOrganizationDomainWithBodyWithResponse request with any body
+	PutOrganizationDomainWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, domain string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrganizationDomainResult, error)
+
+	PutOrganizationDomainWithResponse(ctx context.Context, id openapi_types.UUID, domain string, body PutOrganizationDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrganizationDomainResult, error)
+
+	// VerifyOrganizationDomainWithResponse request
+	VerifyOrganizationDomainWithResponse(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*VerifyOrganizationDomainResult, error)
+
+	// UpdateOrganizationGoogleDelegationWithBodyWithResponse request with any body
+	UpdateOrganizationGoogleDelegationWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationGoogleDelegationResult, error)
+
+	UpdateOrganizationGoogleDelegationWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationGoogleDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationGoogleDelegationResult, error)
+
+	// ProvisionOrganizationCalendarsWithBodyWithResponse request with any body
+	ProvisionOrganizationCalendarsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProvisionOrganizationCalendarsResult, error)
+
+	ProvisionOrganizationCalendarsWithResponse(ctx context.Context, id openapi_types.UUID, body ProvisionOrganizationCalendarsJSONRequestBody, reqEditors ...RequestEditorFn) (*ProvisionOrganizationCalendarsResult, error)
+
+	// ListOrganizationMembersWithResponse request
+	ListOrganizationMembersWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResult, error)
+
+	// UpdateOrganizationMemberDelegationWithBodyWithResponse request with any body
+	UpdateOrganizationMemberDelegationWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberDelegationResult, error)
+
+	UpdateOrganizationMemberDelegationWithResponse(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, body UpdateOrganizationMemberDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberDelegationResult, error)
+
+	// TransferOrganizationProjectWithBodyWithResponse request with any body
+	TransferOrganizationProjectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferOrganizationProjectResult, error)
+
+	TransferOrganizationProjectWithResponse(ctx context.Context, id openapi_types.UUID, body TransferOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferOrganizationProjectResult, error)
+
+	// RotateOrganizationScimTokenWithResponse request
+	RotateOrganizationScimTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RotateOrganizationScimTokenResult, error)
+
+	// UpdateOrganizationSsoWithBodyWithResponse request with any body
+	UpdateOrganizationSsoWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationSsoResult, error)
+
+	UpdateOrganizationSsoWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationSsoResult, error)
+
+	// DeletePaymentWithResponse request
+	DeletePaymentWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeletePaymentResult, error)
+
+	// ListProjectsWithResponse request
+	ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResult, error)
+
+	// CreateProjectWithBodyWithResponse request with any body
+	CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResult, error)
+
+	CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResult, error)
+
+	// DeleteProjectWithResponse request
+	DeleteProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteProjectResult, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetProjectResult, error)
+
+	// UpdateProjectWithBodyWithResponse request with any body
+	UpdateProjectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResult, error)
+
+	UpdateProjectWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResult, error)
+
+	// AddProjectFingerprintWithBodyWithResponse request with any body
+	AddProjectFingerprintWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProjectFingerprintResult, error)
+
+	AddProjectFingerprintWithResponse(ctx context.Context, id openapi_types.UUID, body AddProjectFingerprintJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProjectFingerprintResult, error)
+
+	// RemoveProjectFingerprintWithResponse request
+	RemoveProjectFingerprintWithResponse(ctx context.Context, id openapi_types.UUID, kind FingerprintKind, value string, reqEditors ...RequestEditorFn) (*RemoveProjectFingerprintResult, error)
+
+	// GetMeetingCostReportWithResponse request
+	GetMeetingCostReportWithResponse(ctx context.Context, params *GetMeetingCostReportParams, reqEditors ...RequestEditorFn) (*GetMeetingCostReportResult, error)
+
+	// GetMeetingLoadReportWithResponse request
+	GetMeetingLoadReportWithResponse(ctx context.Context, params *GetMeetingLoadReportParams, reqEditors ...RequestEditorFn) (*GetMeetingLoadReportResult, error)
+
+	// GetUtilizationReportWithResponse request
+	GetUtilizationReportWithResponse(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*GetUtilizationReportResult, error)
+
+	// ExportRulePackWithBodyWithResponse request with any body
+	ExportRulePackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportRulePackResult, error)
+
+	ExportRulePackWithResponse(ctx context.Context, body ExportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportRulePackResult, error)
+
+	// ImportRulePackWithBodyWithResponse request with any body
+	ImportRulePackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportRulePackResult, error)
+
+	ImportRulePackWithResponse(ctx context.Context, body ImportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportRulePackResult, error)
+
+	// ListRulesWithResponse request
+	ListRulesWithResponse(ctx context.Context, params *ListRulesParams, reqEditors ...RequestEditorFn) (*ListRulesResult, error)
+
+	// CreateRuleWithBodyWithResponse request with any body
+	CreateRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRuleResult, error)
+
+	CreateRuleWithResponse(ctx context.Context, body CreateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRuleResult, error)
+
+	// ApplyRulesWithBodyWithResponse request with any body
+	ApplyRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyRulesResult, error)
+
+	ApplyRulesWithResponse(ctx context.Context, body ApplyRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyRulesResult, error)
+
+	// GetRuleConflictsWithResponse request
+	GetRuleConflictsWithResponse(ctx context.Context, params *GetRuleConflictsParams, reqEditors ...RequestEditorFn) (*GetRuleConflictsResult, error)
+
+	// LintRuleWithBodyWithResponse request with any body
+	LintRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LintRuleResult, error)
+
+	LintRuleWithResponse(ctx context.Context, body LintRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*LintRuleResult, error)
+
+	// PreviewRuleWithBodyWithResponse request with any body
+	PreviewRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewRuleResult, error)
+
+	PreviewRuleWithResponse(ctx context.Context, body PreviewRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewRuleResult, error)
+
+	// TestRuleWithBodyWithResponse request with any body
+	TestRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestRuleResult, error)
+
+	TestRuleWithResponse(ctx context.Context, body TestRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*TestRuleResult, error)
+
+	// DeleteRuleWithResponse request
+	DeleteRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteRuleResult, error)
+
+	// GetRuleWithResponse request
+	GetRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetRuleResult, error)
+
+	// UpdateRuleWithBodyWithResponse request with any body
+	UpdateRuleWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRuleResult, error)
+
+	UpdateRuleWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRuleResult, error)
+
+	// ListClassificationSnapshotsWithResponse request
+	ListClassificationSnapshotsWithResponse(ctx context.Context, params *ListClassificationSnapshotsParams, reqEditors ...RequestEditorFn) (*ListClassificationSnapshotsResult, error)
+
+	// CreateClassificationSnapshotWithBodyWithResponse request with any body
+	CreateClassificationSnapshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClassificationSnapshotResult, error)
+
+	CreateClassificationSnapshotWithResponse(ctx context.Context, body CreateClassificationSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClassificationSnapshotResult, error)
+
+	// DeleteClassificationSnapshotWithResponse request
+	DeleteClassificationSnapshotWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteClassificationSnapshotResult, error)
+
+	// RestoreClassificationSnapshotWithResponse request
+	RestoreClassificationSnapshotWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreClassificationSnapshotResult, error)
+
+	// ListSuppressionRulesWithResponse request
+	ListSuppressionRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSuppressionRulesResult, error)
+
+	// CreateSuppressionRuleWithBodyWithResponse request with any body
+	CreateSuppressionRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSuppressionRuleResult, error)
+
+	CreateSuppressionRuleWithResponse(ctx context.Context, body CreateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSuppressionRuleResult, error)
+
+	// DeleteSuppressionRuleWithResponse request
+	DeleteSuppressionRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteSuppressionRuleResult, error)
+
+	// UpdateSuppressionRuleWithBodyWithResponse request with any body
+	UpdateSuppressionRuleWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSuppressionRuleResult, error)
+
+	UpdateSuppressionRuleWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSuppressionRuleResult, error)
+
+	// ListTagsWithResponse request
+	ListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTagsResult, error)
+
+	// CreateTagWithBodyWithResponse request with any body
+	CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResult, error)
+
+	CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResult, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTagResult, error)
+
+	// RenameTagWithBodyWithResponse request with any body
+	RenameTagWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameTagResult, error)
+
+	RenameTagWithResponse(ctx context.Context, id openapi_types.UUID, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameTagResult, error)
+
+	// ListTimeEntriesWithResponse request
+	ListTimeEntriesWithResponse(ctx context.Context, params *ListTimeEntriesParams, reqEditors ...RequestEditorFn) (*ListTimeEntriesResult, error)
+
+	// CreateTimeEntryWithBodyWithResponse request with any body
+	CreateTimeEntryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimeEntryResult, error)
+
+	CreateTimeEntryWithResponse(ctx context.Context, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimeEntryResult, error)
+
+	// SuggestDescriptionsWithResponse request
+	SuggestDescriptionsWithResponse(ctx context.Context, params *SuggestDescriptionsParams, reqEditors ...RequestEditorFn) (*SuggestDescriptionsResult, error)
+
+	// ParseTimeEntryWithBodyWithResponse request with any body
+	ParseTimeEntryWithBodyWithResponse(ctx context.Context, params *ParseTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ParseTimeEntryResult, error)
+
+	ParseTimeEntryWithResponse(ctx context.Context, params *ParseTimeEntryParams, body ParseTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ParseTimeEntryResult, error)
+
+	// RecalculateTimeEntriesWithBodyWithResponse request with any body
+	RecalculateTimeEntriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecalculateTimeEntriesResult, error)
+
+	RecalculateTimeEntriesWithResponse(ctx context.Context, body RecalculateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*RecalculateTimeEntriesResult, error)
+
+	// GetRecalculationJobWithResponse request
+	GetRecalculationJobWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetRecalculationJobResult, error)
+
+	// GetReconciliationReportWithResponse request
+	GetReconciliationReportWithResponse(ctx context.Context, params *GetReconciliationReportParams, reqEditors ...RequestEditorFn) (*GetReconciliationReportResult, error)
+
+	// DeleteTimeEntryWithResponse request
+	DeleteTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTimeEntryResult, error)
+
+	// GetTimeEntryWithResponse request
+	GetTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimeEntryResult, error)
+
+	// UpdateTimeEntryWithBodyWithResponse request with any body
+	UpdateTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeEntryResult, error)
+
+	UpdateTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeEntryResult, error)
+
+	// GetTimeEntryBreakdownWithResponse request
+	GetTimeEntryBreakdownWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimeEntryBreakdownResult, error)
+
+	// ExplainTimeEntryWithResponse request
+	ExplainTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExplainTimeEntryResult, error)
+
+	// ListTimeEntryNotesWithResponse request
+	ListTimeEntryNotesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListTimeEntryNotesResult, error)
+
+	// AddTimeEntryNoteWithBodyWithResponse request with any body
+	AddTimeEntryNoteWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeEntryNoteResult, error)
+
+	AddTimeEntryNoteWithResponse(ctx context.Context, id openapi_types.UUID, body AddTimeEntryNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeEntryNoteResult, error)
+
+	// DeleteTimeEntryNoteWithResponse request
+	DeleteTimeEntryNoteWithResponse(ctx context.Context, id openapi_types.UUID, noteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTimeEntryNoteResult, error)
+
+	// ReconcileTimeEntryWithBodyWithResponse request with any body
+	ReconcileTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReconcileTimeEntryResult, error)
+
+	ReconcileTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body ReconcileTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ReconcileTimeEntryResult, error)
+
+	// RefreshTimeEntryWithResponse request
+	RefreshTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RefreshTimeEntryResult, error)
+
+	// SplitTimeEntryWithBodyWithResponse request with any body
+	SplitTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SplitTimeEntryResult, error)
+
+	SplitTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*SplitTimeEntryResult, error)
+
+	// SetTimeEntryTagsWithBodyWithResponse request with any body
+	SetTimeEntryTagsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResult, error)
+
+	SetTimeEntryTagsWithResponse(ctx context.Context, id openapi_types.UUID, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResult, error)
+
+	// ListTrashWithResponse request
+	ListTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTrashResult, error)
+
+	// RestoreRuleWithResponse request
+	RestoreRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreRuleResult, error)
+
+	// RestoreTimeEntryWithResponse request
+	RestoreTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreTimeEntryResult, error)
+
+	// GetWeekGridWithResponse request
+	GetWeekGridWithResponse(ctx context.Context, start openapi_types.Date, reqEditors ...RequestEditorFn) (*GetWeekGridResult, error)
+
+	// GetWorkingHoursWithResponse request
+	GetWorkingHoursWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWorkingHoursResult, error)
+
+	// UpdateWorkingHoursWithBodyWithResponse request with any body
+	UpdateWorkingHoursWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkingHoursResult, error)
+
+	UpdateWorkingHoursWithResponse(ctx context.Context, body UpdateWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkingHoursResult, error)
+}
+
+type ListClassificationActionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClassificationAction
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClassificationActionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClassificationActionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UndoClassificationActionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UndoActionResponse
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UndoClassificationActionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UndoClassificationActionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAnomaliesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DayAnomaly
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAnomaliesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAnomaliesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AnalyzeAnomaliesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DayAnomaly
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AnalyzeAnomaliesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnalyzeAnomaliesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DismissAnomalyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DayAnomaly
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DismissAnomalyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DismissAnomalyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListApiKeysResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ApiKey
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListApiKeysResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListApiKeysResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateApiKeyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ApiKeyWithSecret
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateApiKeyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateApiKeyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiKeyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiKeyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiKeyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateApiKeyResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApiKey
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateApiKeyResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateApiKeyResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAttendeeAliasesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AttendeeAlias
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAttendeeAliasesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAttendeeAliasesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAttendeeAliasResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AttendeeAlias
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAttendeeAliasResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAttendeeAliasResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAttendeeAliasResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAttendeeAliasResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAttendeeAliasResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCompanyDomainsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompanyDomains
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCompanyDomainsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCompanyDomainsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCompanyDomainsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompanyDomains
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCompanyDomainsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCompanyDomainsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GoogleAuthorizeResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthAuthorizeResponse
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GoogleAuthorizeResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GoogleAuthorizeResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GoogleCallbackResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CalendarConnection
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GoogleCallbackResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GoogleCallbackResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLocaleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LocaleSettings
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLocaleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLocaleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLocaleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LocaleSettings
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLocaleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLocaleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCurrentUserResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCurrentUserResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCurrentUserResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRetentionSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RetentionSettings
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRetentionSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRetentionSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRetentionSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RetentionSettings
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRetentionSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRetentionSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewRetentionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RetentionPreview
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewRetentionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewRetentionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SignupResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AuthResponse
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SignupResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SignupResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartSsoResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SSOStartResponse
+	JSON400      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StartSsoResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartSsoResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSyncSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncSettings
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSyncSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSyncSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSyncSettingsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncSettings
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSyncSettingsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSyncSettingsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBillingPeriodsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BillingPeriod
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBillingPeriodsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBillingPeriodsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBillingPeriodResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BillingPeriod
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBillingPeriodResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBillingPeriodResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBillingPeriodResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBillingPeriodResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBillingPeriodResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBillingPeriodResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BillingPeriod
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBillingPeriodResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBillingPeriodResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClientBalancesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClientBalance
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClientBalancesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClientBalancesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCalendarEventsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CalendarEvent
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCalendarEventsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCalendarEventsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkClassifyEventsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BulkClassifyResponse
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkClassifyEventsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkClassifyEventsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffCalendarEventsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarEventDiff
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffCalendarEventsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffCalendarEventsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReviewQueueResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewQueue
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReviewQueueResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReviewQueueResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEventChangesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CalendarEventChange
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventChangesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventChangesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClassifyCalendarEventResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassifyEventResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ClassifyCalendarEventResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClassifyCalendarEventResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExplainEventClassificationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassificationExplanation
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExplainEventClassificationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExplainEventClassificationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEventClassificationHistoryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EventClassificationHistoryEntry
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventClassificationHistoryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventClassificationHistoryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReviewCalendarEventResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReviewCalendarEventResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReviewCalendarEventResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetEventSuppressionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarEvent
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetEventSuppressionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetEventSuppressionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetEventTagsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarEvent
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetEventTagsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetEventTagsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCalendarConnectionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CalendarConnection
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCalendarConnectionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCalendarConnectionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListConnectionsNeedingReauthResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ConnectionReauth
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListConnectionsNeedingReauthResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListConnectionsNeedingReauthResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCalendarConnectionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCalendarConnectionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCalendarConnectionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PauseCalendarConnectionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarConnection
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PauseCalendarConnectionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PauseCalendarConnectionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReauthorizeCalendarConnectionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthAuthorizeResponse
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReauthorizeCalendarConnectionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReauthorizeCalendarConnectionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResumeCalendarConnectionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarConnection
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumeCalendarConnectionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeCalendarConnectionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCalendarSourcesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Calendar
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCalendarSourcesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCalendarSourcesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCalendarSourcesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Calendar
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCalendarSourcesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCalendarSourcesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCalendarSourceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Calendar
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCalendarSourceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCalendarSourceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncCalendarResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SyncResult
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncCalendarResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncCalendarResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCalendarSyncWindowResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarConnection
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCalendarSyncWindowResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCalendarSyncWindowResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCalendarWriteBackResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarConnection
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCalendarWriteBackResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCalendarWriteBackResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListChangesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChangeFeedPage
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListChangesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListChangesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyRulesAsyncResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClassificationJob
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyRulesAsyncResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyRulesAsyncResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClassificationJobResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassificationJob
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClassificationJobResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClassificationJobResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClientRatesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClientRate
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClientRatesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClientRatesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClientRateResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClientRate
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClientRateResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClientRateResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClientRateResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClientRateResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClientRateResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClientRateResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientRate
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClientRateResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClientRateResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportConfigResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfigExport
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportConfigResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportConfigResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportConfigResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfigImportResult
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportConfigResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportConfigResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDaySummaryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DaySummary
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDaySummaryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDaySummaryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListExpensesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Expense
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExpensesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExpensesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateExpenseResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Expense
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateExpenseResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateExpenseResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteExpenseResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteExpenseResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteExpenseResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExpenseResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Expense
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExpenseResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExpenseResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateExpenseResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Expense
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateExpenseResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateExpenseResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFingerprintClaimsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FingerprintClaim
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFingerprintClaimsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFingerprintClaimsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogFocusSessionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FocusSessionResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LogFocusSessionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogFocusSessionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecordFocusGapsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FocusGapsResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordFocusGapsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordFocusGapsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListGoalsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]HourGoal
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListGoalsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListGoalsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateGoalResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HourGoal
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateGoalResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateGoalResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGoalsProgressResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]GoalProgress
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGoalsProgressResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGoalsProgressResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteGoalResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteGoalResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteGoalResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateGoalResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HourGoal
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateGoalResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateGoalResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIntegrationsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IntegrationConnection
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIntegrationsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIntegrationsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisconnectGitHubResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DisconnectGitHubResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisconnectGitHubResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGitHubConnectionResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GitHubConnection
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGitHubConnectionResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGitHubConnectionResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConnectGitHubResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GitHubConnection
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConnectGitHubResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConnectGitHubResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisconnectIntegrationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DisconnectIntegrationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisconnectIntegrationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConnectIntegrationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IntegrationConnection
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConnectIntegrationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConnectIntegrationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadInvoiceExportResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadInvoiceExportResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadInvoiceExportResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInvoicesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Invoice
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoicesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoicesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInvoiceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invoice
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInvoiceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInvoiceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInvoiceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInvoiceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInvoiceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddInvoiceAdjustmentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invoice
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddInvoiceAdjustmentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddInvoiceAdjustmentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInvoiceAdjustmentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInvoiceAdjustmentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInvoiceAdjustmentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInvoiceCommentsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InvoiceComment
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoiceCommentsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoiceCommentsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddInvoiceCommentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InvoiceComment
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddInvoiceCommentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddInvoiceCommentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInvoiceCommentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInvoiceCommentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInvoiceCommentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCreditNoteResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Invoice
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCreditNoteResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCreditNoteResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportInvoiceResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceExport
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON502      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportInvoiceResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportInvoiceResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportInvoiceCSVResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportInvoiceCSVResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportInvoiceCSVResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportInvoiceSheetsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		SpreadsheetId  *string `json:"spreadsheet_id,omitempty"`
+		SpreadsheetUrl *string `json:"spreadsheet_url,omitempty"`
+		WorksheetId    *int    `json:"worksheet_id,omitempty"`
+	}
+	JSON401 *Error
+	JSON404 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportInvoiceSheetsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportInvoiceSheetsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInvoiceExportsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InvoiceExport
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoiceExportsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoiceExportsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInvoicePaymentsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Payment
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInvoicePaymentsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInvoicePaymentsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecordInvoicePaymentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Payment
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordInvoicePaymentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordInvoicePaymentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateInvoiceStatusResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Invoice
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateInvoiceStatusResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateInvoiceStatusResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganizationsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Organization
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrganizationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Organization
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrganizationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrganizationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Organization
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOrganizationDomainResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOrganizationDomainResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOrganizationDomainResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutOrganizationDomainResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationDomain
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutOrganizationDomainResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutOrganizationDomainResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyOrganizationDomainResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationDomain
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyOrganizationDomainResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyOrganizationDomainResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOrganizationGoogleDelegationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Organization
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationGoogleDelegationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationGoogleDelegationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProvisionOrganizationCalendarsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DelegationProvisionResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ProvisionOrganizationCalendarsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProvisionOrganizationCalendarsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOrganizationMembersResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OrganizationMember
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOrganizationMembersResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOrganizationMembersResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOrganizationMemberDelegationResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationMember
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationMemberDelegationResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationMemberDelegationResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferOrganizationProjectResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectTransfer
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferOrganizationProjectResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferOrganizationProjectResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RotateOrganizationScimTokenResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SCIMToken
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateOrganizationScimTokenResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateOrganizationScimTokenResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOrganizationSsoResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Organization
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrganizationSsoResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrganizationSsoResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePaymentResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePaymentResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePaymentResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListProjectsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Project
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateProjectResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProjectResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddProjectFingerprintResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddProjectFingerprintResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddProjectFingerprintResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveProjectFingerprintResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveProjectFingerprintResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveProjectFingerprintResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeetingCostReportResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeetingCostReport
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeetingCostReportResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeetingCostReportResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeetingLoadReportResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeetingLoadReport
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeetingLoadReportResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeetingLoadReportResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUtilizationReportResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UtilizationReport
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUtilizationReportResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUtilizationReportResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportRulePackResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RulePack
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportRulePackResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportRulePackResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportRulePackResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RulePackImportResult
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportRulePackResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportRulePackResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRulesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClassificationRule
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRulesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRulesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClassificationRule
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyRulesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ApplyRulesResponse
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyRulesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyRulesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRuleConflictsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RuleConflictReport
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRuleConflictsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRuleConflictsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LintRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RuleLintResponse
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LintRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LintRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RulePreviewResponse
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TestRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RuleTestResponse
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TestRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TestRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassificationRule
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassificationRule
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClassificationSnapshotsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClassificationSnapshot
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClassificationSnapshotsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClassificationSnapshotsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClassificationSnapshotResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClassificationSnapshot
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClassificationSnapshotResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClassificationSnapshotResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClassificationSnapshotResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClassificationSnapshotResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClassificationSnapshotResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreClassificationSnapshotResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RestoreSnapshotResponse
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreClassificationSnapshotResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreClassificationSnapshotResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSuppressionRulesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SuppressionRule
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSuppressionRulesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSuppressionRulesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSuppressionRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SuppressionRuleResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSuppressionRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSuppressionRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSuppressionRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSuppressionRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSuppressionRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSuppressionRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuppressionRuleResult
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSuppressionRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSuppressionRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Tag
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Tag
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RenameTagResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tag
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RenameTagResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RenameTagResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTimeEntriesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTimeEntriesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTimeEntriesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeEntry
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SuggestDescriptionsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DescriptionSuggestion
+	JSON401      *Error
+	JSON409      *Error
+	JSON502      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SuggestDescriptionsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SuggestDescriptionsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ParseTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntryDraft
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ParseTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ParseTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecalculateTimeEntriesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *RecalculationJob
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RecalculateTimeEntriesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecalculateTimeEntriesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecalculationJobResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecalculationJob
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecalculationJobResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecalculationJobResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReconciliationReportResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReconciliationReport
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReconciliationReportResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReconciliationReportResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTimeEntryBreakdownResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntryBreakdown
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTimeEntryBreakdownResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTimeEntryBreakdownResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExplainTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntryExplanation
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExplainTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExplainTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTimeEntryNotesResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntryNote
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTimeEntryNotesResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTimeEntryNotesResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTimeEntryNoteResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeEntryNote
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTimeEntryNoteResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTimeEntryNoteResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTimeEntryNoteResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTimeEntryNoteResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTimeEntryNoteResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReconcileTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReconcileTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReconcileTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SplitTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeEntry
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SplitTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SplitTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTimeEntryTagsResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTimeEntryTagsResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTimeEntryTagsResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTrashResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Trash
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTrashResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTrashResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreRuleResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassificationRule
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreRuleResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreRuleResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTimeEntryResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeEntry
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTimeEntryResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTimeEntryResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWeekGridResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WeekGrid
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWeekGridResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWeekGridResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkingHoursResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkingHours
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkingHoursResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkingHoursResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWorkingHoursResult struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkingHours
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWorkingHoursResult) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWorkingHoursResult) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListClassificationActionsWithResponse request returning *ListClassificationActionsResult
+func (c *ClientWithResponses) ListClassificationActionsWithResponse(ctx context.Context, params *ListClassificationActionsParams, reqEditors ...RequestEditorFn) (*ListClassificationActionsResult, error) {
+	rsp, err := c.ListClassificationActions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClassificationActionsResult(rsp)
+}
+
+// UndoClassificationActionWithResponse request returning *UndoClassificationActionResult
+func (c *ClientWithResponses) UndoClassificationActionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*UndoClassificationActionResult, error) {
+	rsp, err := c.UndoClassificationAction(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUndoClassificationActionResult(rsp)
+}
+
+// ListAnomaliesWithResponse request returning *ListAnomaliesResult
+func (c *ClientWithResponses) ListAnomaliesWithResponse(ctx context.Context, params *ListAnomaliesParams, reqEditors ...RequestEditorFn) (*ListAnomaliesResult, error) {
+	rsp, err := c.ListAnomalies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAnomaliesResult(rsp)
+}
+
+// AnalyzeAnomaliesWithBodyWithResponse request with arbitrary body returning *AnalyzeAnomaliesResult
+func (c *ClientWithResponses) AnalyzeAnomaliesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnalyzeAnomaliesResult, error) {
+	rsp, err := c.AnalyzeAnomaliesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalyzeAnomaliesResult(rsp)
+}
+
+func (c *ClientWithResponses) AnalyzeAnomaliesWithResponse(ctx context.Context, body AnalyzeAnomaliesJSONRequestBody, reqEditors ...RequestEditorFn) (*AnalyzeAnomaliesResult, error) {
+	rsp, err := c.AnalyzeAnomalies(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalyzeAnomaliesResult(rsp)
+}
+
+// DismissAnomalyWithResponse request returning *DismissAnomalyResult
+func (c *ClientWithResponses) DismissAnomalyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DismissAnomalyResult, error) {
+	rsp, err := c.DismissAnomaly(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDismissAnomalyResult(rsp)
+}
+
+// ListApiKeysWithResponse request returning *ListApiKeysResult
+func (c *ClientWithResponses) ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResult, error) {
+	rsp, err := c.ListApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListApiKeysResult(rsp)
+}
+
+// CreateApiKeyWithBodyWithResponse request with arbitrary body returning *CreateApiKeyResult
+func (c *ClientWithResponses) CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResult, error) {
+	rsp, err := c.CreateApiKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResult, error) {
+	rsp, err := c.CreateApiKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResult(rsp)
+}
+
+// DeleteApiKeyWithResponse request returning *DeleteApiKeyResult
+func (c *ClientWithResponses) DeleteApiKeyWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteApiKeyResult, error) {
+	rsp, err := c.DeleteApiKey(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiKeyResult(rsp)
+}
+
+// UpdateApiKeyWithBodyWithResponse request with arbitrary body returning *UpdateApiKeyResult
+func (c *ClientWithResponses) UpdateApiKeyWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyResult, error) {
+	rsp, err := c.UpdateApiKeyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateApiKeyResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateApiKeyWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyResult, error) {
+	rsp, err := c.UpdateApiKey(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateApiKeyResult(rsp)
+}
+
+// ListAttendeeAliasesWithResponse request returning *ListAttendeeAliasesResult
+func (c *ClientWithResponses) ListAttendeeAliasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAttendeeAliasesResult, error) {
+	rsp, err := c.ListAttendeeAliases(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAttendeeAliasesResult(rsp)
+}
+
+// CreateAttendeeAliasWithBodyWithResponse request with arbitrary body returning *CreateAttendeeAliasResult
+func (c *ClientWithResponses) CreateAttendeeAliasWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAttendeeAliasResult, error) {
+	rsp, err := c.CreateAttendeeAliasWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAttendeeAliasResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateAttendeeAliasWithResponse(ctx context.Context, body CreateAttendeeAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAttendeeAliasResult, error) {
+	rsp, err := c.CreateAttendeeAlias(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAttendeeAliasResult(rsp)
+}
+
+// DeleteAttendeeAliasWithResponse request returning *DeleteAttendeeAliasResult
+func (c *ClientWithResponses) DeleteAttendeeAliasWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAttendeeAliasResult, error) {
+	rsp, err := c.DeleteAttendeeAlias(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAttendeeAliasResult(rsp)
+}
+
+// GetCompanyDomainsWithResponse request returning *GetCompanyDomainsResult
+func (c *ClientWithResponses) GetCompanyDomainsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCompanyDomainsResult, error) {
+	rsp, err := c.GetCompanyDomains(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCompanyDomainsResult(rsp)
+}
+
+// UpdateCompanyDomainsWithBodyWithResponse request with arbitrary body returning *UpdateCompanyDomainsResult
+func (c *ClientWithResponses) UpdateCompanyDomainsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompanyDomainsResult, error) {
+	rsp, err := c.UpdateCompanyDomainsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCompanyDomainsResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCompanyDomainsWithResponse(ctx context.Context, body UpdateCompanyDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompanyDomainsResult, error) {
+	rsp, err := c.UpdateCompanyDomains(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCompanyDomainsResult(rsp)
+}
+
+// GoogleAuthorizeWithResponse request returning *GoogleAuthorizeResult
+func (c *ClientWithResponses) GoogleAuthorizeWithResponse(ctx context.Context, params *GoogleAuthorizeParams, reqEditors ...RequestEditorFn) (*GoogleAuthorizeResult, error) {
+	rsp, err := c.GoogleAuthorize(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoogleAuthorizeResult(rsp)
+}
+
+// GoogleCallbackWithResponse request returning *GoogleCallbackResult
+func (c *ClientWithResponses) GoogleCallbackWithResponse(ctx context.Context, params *GoogleCallbackParams, reqEditors ...RequestEditorFn) (*GoogleCallbackResult, error) {
+	rsp, err := c.GoogleCallback(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoogleCallbackResult(rsp)
+}
+
+// GetLocaleWithResponse request returning *GetLocaleResult
+func (c *ClientWithResponses) GetLocaleWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLocaleResult, error) {
+	rsp, err := c.GetLocale(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLocaleResult(rsp)
+}
+
+// UpdateLocaleWithBodyWithResponse request with arbitrary body returning *UpdateLocaleResult
+func (c *ClientWithResponses) UpdateLocaleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLocaleResult, error) {
+	rsp, err := c.UpdateLocaleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLocaleResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLocaleWithResponse(ctx context.Context, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocaleResult, error) {
+	rsp, err := c.UpdateLocale(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLocaleResult(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResult
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResult, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResult(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResult, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResult(rsp)
+}
+
+// LogoutWithResponse request returning *LogoutResult
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResult, error) {
+	rsp, err := c.Logout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResult(rsp)
+}
+
+// GetCurrentUserWithResponse request returning *GetCurrentUserResult
+func (c *ClientWithResponses) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResult, error) {
+	rsp, err := c.GetCurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCurrentUserResult(rsp)
+}
+
+// GetRetentionSettingsWithResponse request returning *GetRetentionSettingsResult
+func (c *ClientWithResponses) GetRetentionSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRetentionSettingsResult, error) {
+	rsp, err := c.GetRetentionSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRetentionSettingsResult(rsp)
+}
+
+// UpdateRetentionSettingsWithBodyWithResponse request with arbitrary body returning *UpdateRetentionSettingsResult
+func (c *ClientWithResponses) UpdateRetentionSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRetentionSettingsResult, error) {
+	rsp, err := c.UpdateRetentionSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRetentionSettingsResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRetentionSettingsWithResponse(ctx context.Context, body UpdateRetentionSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRetentionSettingsResult, error) {
+	rsp, err := c.UpdateRetentionSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRetentionSettingsResult(rsp)
+}
+
+// PreviewRetentionWithResponse request returning *PreviewRetentionResult
+func (c *ClientWithResponses) PreviewRetentionWithResponse(ctx context.Context, params *PreviewRetentionParams, reqEditors ...RequestEditorFn) (*PreviewRetentionResult, error) {
+	rsp, err := c.PreviewRetention(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewRetentionResult(rsp)
+}
+
+// SignupWithBodyWithResponse request with arbitrary body returning *SignupResult
+func (c *ClientWithResponses) SignupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignupResult, error) {
+	rsp, err := c.SignupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignupResult(rsp)
+}
+
+func (c *ClientWithResponses) SignupWithResponse(ctx context.Context, body SignupJSONRequestBody, reqEditors ...RequestEditorFn) (*SignupResult, error) {
+	rsp, err := c.Signup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignupResult(rsp)
+}
+
+// StartSsoWithBodyWithResponse request with arbitrary body returning *StartSsoResult
+func (c *ClientWithResponses) StartSsoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartSsoResult, error) {
+	rsp, err := c.StartSsoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartSsoResult(rsp)
+}
+
+func (c *ClientWithResponses) StartSsoWithResponse(ctx context.Context, body StartSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*StartSsoResult, error) {
+	rsp, err := c.StartSso(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartSsoResult(rsp)
+}
+
+// GetSyncSettingsWithResponse request returning *GetSyncSettingsResult
+func (c *ClientWithResponses) GetSyncSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSyncSettingsResult, error) {
+	rsp, err := c.GetSyncSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSyncSettingsResult(rsp)
+}
+
+// UpdateSyncSettingsWithBodyWithResponse request with arbitrary body returning *UpdateSyncSettingsResult
+func (c *ClientWithResponses) UpdateSyncSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSyncSettingsResult, error) {
+	rsp, err := c.UpdateSyncSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSyncSettingsResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSyncSettingsWithResponse(ctx context.Context, body UpdateSyncSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSyncSettingsResult, error) {
+	rsp, err := c.UpdateSyncSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSyncSettingsResult(rsp)
+}
+
+// ListBillingPeriodsWithResponse request returning *ListBillingPeriodsResult
+func (c *ClientWithResponses) ListBillingPeriodsWithResponse(ctx context.Context, params *ListBillingPeriodsParams, reqEditors ...RequestEditorFn) (*ListBillingPeriodsResult, error) {
+	rsp, err := c.ListBillingPeriods(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBillingPeriodsResult(rsp)
+}
+
+// CreateBillingPeriodWithBodyWithResponse request with arbitrary body returning *CreateBillingPeriodResult
+func (c *ClientWithResponses) CreateBillingPeriodWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBillingPeriodResult, error) {
+	rsp, err := c.CreateBillingPeriodWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBillingPeriodResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateBillingPeriodWithResponse(ctx context.Context, body CreateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBillingPeriodResult, error) {
+	rsp, err := c.CreateBillingPeriod(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBillingPeriodResult(rsp)
+}
+
+// DeleteBillingPeriodWithResponse request returning *DeleteBillingPeriodResult
+func (c *ClientWithResponses) DeleteBillingPeriodWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBillingPeriodResult, error) {
+	rsp, err := c.DeleteBillingPeriod(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBillingPeriodResult(rsp)
+}
+
+// UpdateBillingPeriodWithBodyWithResponse request with arbitrary body returning *UpdateBillingPeriodResult
+func (c *ClientWithResponses) UpdateBillingPeriodWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBillingPeriodResult, error) {
+	rsp, err := c.UpdateBillingPeriodWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBillingPeriodResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBillingPeriodWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateBillingPeriodJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBillingPeriodResult, error) {
+	rsp, err := c.UpdateBillingPeriod(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBillingPeriodResult(rsp)
+}
+
+// ListClientBalancesWithResponse request returning *ListClientBalancesResult
+func (c *ClientWithResponses) ListClientBalancesWithResponse(ctx context.Context, params *ListClientBalancesParams, reqEditors ...RequestEditorFn) (*ListClientBalancesResult, error) {
+	rsp, err := c.ListClientBalances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClientBalancesResult(rsp)
+}
+
+// ListCalendarEventsWithResponse request returning *ListCalendarEventsResult
+func (c *ClientWithResponses) ListCalendarEventsWithResponse(ctx context.Context, params *ListCalendarEventsParams, reqEditors ...RequestEditorFn) (*ListCalendarEventsResult, error) {
+	rsp, err := c.ListCalendarEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCalendarEventsResult(rsp)
+}
+
+// BulkClassifyEventsWithBodyWithResponse request with arbitrary body returning *BulkClassifyEventsResult
+func (c *ClientWithResponses) BulkClassifyEventsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkClassifyEventsResult, error) {
+	rsp, err := c.BulkClassifyEventsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkClassifyEventsResult(rsp)
+}
+
+func (c *ClientWithResponses) BulkClassifyEventsWithResponse(ctx context.Context, body BulkClassifyEventsJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkClassifyEventsResult, error) {
+	rsp, err := c.BulkClassifyEvents(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkClassifyEventsResult(rsp)
+}
+
+// DiffCalendarEventsWithResponse request returning *DiffCalendarEventsResult
+func (c *ClientWithResponses) DiffCalendarEventsWithResponse(ctx context.Context, params *DiffCalendarEventsParams, reqEditors ...RequestEditorFn) (*DiffCalendarEventsResult, error) {
+	rsp, err := c.DiffCalendarEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffCalendarEventsResult(rsp)
+}
+
+// GetReviewQueueWithResponse request returning *GetReviewQueueResult
+func (c *ClientWithResponses) GetReviewQueueWithResponse(ctx context.Context, params *GetReviewQueueParams, reqEditors ...RequestEditorFn) (*GetReviewQueueResult, error) {
+	rsp, err := c.GetReviewQueue(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReviewQueueResult(rsp)
+}
+
+// ListEventChangesWithResponse request returning *ListEventChangesResult
+func (c *ClientWithResponses) ListEventChangesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListEventChangesResult, error) {
+	rsp, err := c.ListEventChanges(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventChangesResult(rsp)
+}
+
+// ClassifyCalendarEventWithBodyWithResponse request with arbitrary body returning *ClassifyCalendarEventResult
+func (c *ClientWithResponses) ClassifyCalendarEventWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClassifyCalendarEventResult, error) {
+	rsp, err := c.ClassifyCalendarEventWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClassifyCalendarEventResult(rsp)
+}
+
+func (c *ClientWithResponses) ClassifyCalendarEventWithResponse(ctx context.Context, id openapi_types.UUID, body ClassifyCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*ClassifyCalendarEventResult, error) {
+	rsp, err := c.ClassifyCalendarEvent(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClassifyCalendarEventResult(rsp)
+}
+
+// ExplainEventClassificationWithResponse request returning *ExplainEventClassificationResult
+func (c *ClientWithResponses) ExplainEventClassificationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExplainEventClassificationResult, error) {
+	rsp, err := c.ExplainEventClassification(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainEventClassificationResult(rsp)
+}
+
+// ListEventClassificationHistoryWithResponse request returning *ListEventClassificationHistoryResult
+func (c *ClientWithResponses) ListEventClassificationHistoryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListEventClassificationHistoryResult, error) {
+	rsp, err := c.ListEventClassificationHistory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventClassificationHistoryResult(rsp)
+}
+
+// ReviewCalendarEventWithBodyWithResponse request with arbitrary body returning *ReviewCalendarEventResult
+func (c *ClientWithResponses) ReviewCalendarEventWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewCalendarEventResult, error) {
+	rsp, err := c.ReviewCalendarEventWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewCalendarEventResult(rsp)
+}
+
+func (c *ClientWithResponses) ReviewCalendarEventWithResponse(ctx context.Context, id openapi_types.UUID, body ReviewCalendarEventJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewCalendarEventResult, error) {
+	rsp, err := c.ReviewCalendarEvent(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewCalendarEventResult(rsp)
+}
+
+// SetEventSuppressionWithBodyWithResponse request with arbitrary body returning *SetEventSuppressionResult
+func (c *ClientWithResponses) SetEventSuppressionWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEventSuppressionResult, error) {
+	rsp, err := c.SetEventSuppressionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEventSuppressionResult(rsp)
+}
+
+func (c *ClientWithResponses) SetEventSuppressionWithResponse(ctx context.Context, id openapi_types.UUID, body SetEventSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetEventSuppressionResult, error) {
+	rsp, err := c.SetEventSuppression(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEventSuppressionResult(rsp)
+}
+
+// SetEventTagsWithBodyWithResponse request with arbitrary body returning *SetEventTagsResult
+func (c *ClientWithResponses) SetEventTagsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEventTagsResult, error) {
+	rsp, err := c.SetEventTagsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEventTagsResult(rsp)
+}
+
+func (c *ClientWithResponses) SetEventTagsWithResponse(ctx context.Context, id openapi_types.UUID, body SetEventTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetEventTagsResult, error) {
+	rsp, err := c.SetEventTags(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEventTagsResult(rsp)
+}
+
+// ListCalendarConnectionsWithResponse request returning *ListCalendarConnectionsResult
+func (c *ClientWithResponses) ListCalendarConnectionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCalendarConnectionsResult, error) {
+	rsp, err := c.ListCalendarConnections(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCalendarConnectionsResult(rsp)
+}
+
+// ListConnectionsNeedingReauthWithResponse request returning *ListConnectionsNeedingReauthResult
+func (c *ClientWithResponses) ListConnectionsNeedingReauthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListConnectionsNeedingReauthResult, error) {
+	rsp, err := c.ListConnectionsNeedingReauth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListConnectionsNeedingReauthResult(rsp)
+}
+
+// DeleteCalendarConnectionWithResponse request returning *DeleteCalendarConnectionResult
+func (c *ClientWithResponses) DeleteCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteCalendarConnectionResult, error) {
+	rsp, err := c.DeleteCalendarConnection(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCalendarConnectionResult(rsp)
+}
+
+// PauseCalendarConnectionWithResponse request returning *PauseCalendarConnectionResult
+func (c *ClientWithResponses) PauseCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PauseCalendarConnectionResult, error) {
+	rsp, err := c.PauseCalendarConnection(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseCalendarConnectionResult(rsp)
+}
+
+// ReauthorizeCalendarConnectionWithResponse request returning *ReauthorizeCalendarConnectionResult
+func (c *ClientWithResponses) ReauthorizeCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReauthorizeCalendarConnectionResult, error) {
+	rsp, err := c.ReauthorizeCalendarConnection(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReauthorizeCalendarConnectionResult(rsp)
+}
+
+// ResumeCalendarConnectionWithResponse request returning *ResumeCalendarConnectionResult
+func (c *ClientWithResponses) ResumeCalendarConnectionWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ResumeCalendarConnectionResult, error) {
+	rsp, err := c.ResumeCalendarConnection(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeCalendarConnectionResult(rsp)
+}
+
+// ListCalendarSourcesWithResponse request returning *ListCalendarSourcesResult
+func (c *ClientWithResponses) ListCalendarSourcesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListCalendarSourcesResult, error) {
+	rsp, err := c.ListCalendarSources(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCalendarSourcesResult(rsp)
+}
+
+// UpdateCalendarSourcesWithBodyWithResponse request with arbitrary body returning *UpdateCalendarSourcesResult
+func (c *ClientWithResponses) UpdateCalendarSourcesWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarSourcesResult, error) {
+	rsp, err := c.UpdateCalendarSourcesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarSourcesResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCalendarSourcesWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSourcesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarSourcesResult, error) {
+	rsp, err := c.UpdateCalendarSources(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarSourcesResult(rsp)
+}
+
+// UpdateCalendarSourceWithBodyWithResponse request with arbitrary body returning *UpdateCalendarSourceResult
+func (c *ClientWithResponses) UpdateCalendarSourceWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarSourceResult, error) {
+	rsp, err := c.UpdateCalendarSourceWithBody(ctx, id, calendarId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarSourceResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCalendarSourceWithResponse(ctx context.Context, id openapi_types.UUID, calendarId openapi_types.UUID, body UpdateCalendarSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarSourceResult, error) {
+	rsp, err := c.UpdateCalendarSource(ctx, id, calendarId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarSourceResult(rsp)
+}
+
+// SyncCalendarWithResponse request returning *SyncCalendarResult
+func (c *ClientWithResponses) SyncCalendarWithResponse(ctx context.Context, id openapi_types.UUID, params *SyncCalendarParams, reqEditors ...RequestEditorFn) (*SyncCalendarResult, error) {
+	rsp, err := c.SyncCalendar(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncCalendarResult(rsp)
+}
+
+// UpdateCalendarSyncWindowWithBodyWithResponse request with arbitrary body returning *UpdateCalendarSyncWindowResult
+func (c *ClientWithResponses) UpdateCalendarSyncWindowWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarSyncWindowResult, error) {
+	rsp, err := c.UpdateCalendarSyncWindowWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarSyncWindowResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCalendarSyncWindowWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateCalendarSyncWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarSyncWindowResult, error) {
+	rsp, err := c.UpdateCalendarSyncWindow(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarSyncWindowResult(rsp)
+}
+
+// UpdateCalendarWriteBackWithBodyWithResponse request with arbitrary body returning *UpdateCalendarWriteBackResult
+func (c *ClientWithResponses) UpdateCalendarWriteBackWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCalendarWriteBackResult, error) {
+	rsp, err := c.UpdateCalendarWriteBackWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarWriteBackResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCalendarWriteBackWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateCalendarWriteBackJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCalendarWriteBackResult, error) {
+	rsp, err := c.UpdateCalendarWriteBack(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCalendarWriteBackResult(rsp)
+}
+
+// ListChangesWithResponse request returning *ListChangesResult
+func (c *ClientWithResponses) ListChangesWithResponse(ctx context.Context, params *ListChangesParams, reqEditors ...RequestEditorFn) (*ListChangesResult, error) {
+	rsp, err := c.ListChanges(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListChangesResult(rsp)
+}
+
+// ApplyRulesAsyncWithBodyWithResponse request with arbitrary body returning *ApplyRulesAsyncResult
+func (c *ClientWithResponses) ApplyRulesAsyncWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyRulesAsyncResult, error) {
+	rsp, err := c.ApplyRulesAsyncWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyRulesAsyncResult(rsp)
+}
+
+func (c *ClientWithResponses) ApplyRulesAsyncWithResponse(ctx context.Context, body ApplyRulesAsyncJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyRulesAsyncResult, error) {
+	rsp, err := c.ApplyRulesAsync(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyRulesAsyncResult(rsp)
+}
+
+// GetClassificationJobWithResponse request returning *GetClassificationJobResult
+func (c *ClientWithResponses) GetClassificationJobWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetClassificationJobResult, error) {
+	rsp, err := c.GetClassificationJob(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClassificationJobResult(rsp)
+}
+
+// ListClientRatesWithResponse request returning *ListClientRatesResult
+func (c *ClientWithResponses) ListClientRatesWithResponse(ctx context.Context, params *ListClientRatesParams, reqEditors ...RequestEditorFn) (*ListClientRatesResult, error) {
+	rsp, err := c.ListClientRates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClientRatesResult(rsp)
+}
+
+// CreateClientRateWithBodyWithResponse request with arbitrary body returning *CreateClientRateResult
+func (c *ClientWithResponses) CreateClientRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClientRateResult, error) {
+	rsp, err := c.CreateClientRateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientRateResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateClientRateWithResponse(ctx context.Context, body CreateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClientRateResult, error) {
+	rsp, err := c.CreateClientRate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientRateResult(rsp)
+}
+
+// DeleteClientRateWithResponse request returning *DeleteClientRateResult
+func (c *ClientWithResponses) DeleteClientRateWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteClientRateResult, error) {
+	rsp, err := c.DeleteClientRate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClientRateResult(rsp)
+}
+
+// UpdateClientRateWithBodyWithResponse request with arbitrary body returning *UpdateClientRateResult
+func (c *ClientWithResponses) UpdateClientRateWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientRateResult, error) {
+	rsp, err := c.UpdateClientRateWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientRateResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClientRateWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateClientRateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientRateResult, error) {
+	rsp, err := c.UpdateClientRate(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientRateResult(rsp)
+}
+
+// ExportConfigWithResponse request returning *ExportConfigResult
+func (c *ClientWithResponses) ExportConfigWithResponse(ctx context.Context, params *ExportConfigParams, reqEditors ...RequestEditorFn) (*ExportConfigResult, error) {
+	rsp, err := c.ExportConfig(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportConfigResult(rsp)
+}
+
+// ImportConfigWithBodyWithResponse request with arbitrary body returning *ImportConfigResult
+func (c *ClientWithResponses) ImportConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportConfigResult, error) {
+	rsp, err := c.ImportConfigWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportConfigResult(rsp)
+}
+
+func (c *ClientWithResponses) ImportConfigWithResponse(ctx context.Context, body ImportConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportConfigResult, error) {
+	rsp, err := c.ImportConfig(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportConfigResult(rsp)
+}
+
+// GetDaySummaryWithResponse request returning *GetDaySummaryResult
+func (c *ClientWithResponses) GetDaySummaryWithResponse(ctx context.Context, date openapi_types.Date, reqEditors ...RequestEditorFn) (*GetDaySummaryResult, error) {
+	rsp, err := c.GetDaySummary(ctx, date, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDaySummaryResult(rsp)
+}
+
+// ListExpensesWithResponse request returning *ListExpensesResult
+func (c *ClientWithResponses) ListExpensesWithResponse(ctx context.Context, params *ListExpensesParams, reqEditors ...RequestEditorFn) (*ListExpensesResult, error) {
+	rsp, err := c.ListExpenses(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExpensesResult(rsp)
+}
+
+// CreateExpenseWithBodyWithResponse request with arbitrary body returning *CreateExpenseResult
+func (c *ClientWithResponses) CreateExpenseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExpenseResult, error) {
+	rsp, err := c.CreateExpenseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExpenseResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateExpenseWithResponse(ctx context.Context, body CreateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExpenseResult, error) {
+	rsp, err := c.CreateExpense(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExpenseResult(rsp)
+}
+
+// DeleteExpenseWithResponse request returning *DeleteExpenseResult
+func (c *ClientWithResponses) DeleteExpenseWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteExpenseResult, error) {
+	rsp, err := c.DeleteExpense(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteExpenseResult(rsp)
+}
+
+// GetExpenseWithResponse request returning *GetExpenseResult
+func (c *ClientWithResponses) GetExpenseWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetExpenseResult, error) {
+	rsp, err := c.GetExpense(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExpenseResult(rsp)
+}
+
+// UpdateExpenseWithBodyWithResponse request with arbitrary body returning *UpdateExpenseResult
+func (c *ClientWithResponses) UpdateExpenseWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateExpenseResult, error) {
+	rsp, err := c.UpdateExpenseWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExpenseResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateExpenseWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateExpenseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateExpenseResult, error) {
+	rsp, err := c.UpdateExpense(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateExpenseResult(rsp)
+}
+
+// ListFingerprintClaimsWithResponse request returning *ListFingerprintClaimsResult
+func (c *ClientWithResponses) ListFingerprintClaimsWithResponse(ctx context.Context, params *ListFingerprintClaimsParams, reqEditors ...RequestEditorFn) (*ListFingerprintClaimsResult, error) {
+	rsp, err := c.ListFingerprintClaims(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFingerprintClaimsResult(rsp)
+}
+
+// LogFocusSessionWithBodyWithResponse request with arbitrary body returning *LogFocusSessionResult
+func (c *ClientWithResponses) LogFocusSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogFocusSessionResult, error) {
+	rsp, err := c.LogFocusSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogFocusSessionResult(rsp)
+}
+
+func (c *ClientWithResponses) LogFocusSessionWithResponse(ctx context.Context, body LogFocusSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*LogFocusSessionResult, error) {
+	rsp, err := c.LogFocusSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogFocusSessionResult(rsp)
+}
+
+// RecordFocusGapsWithBodyWithResponse request with arbitrary body returning *RecordFocusGapsResult
+func (c *ClientWithResponses) RecordFocusGapsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordFocusGapsResult, error) {
+	rsp, err := c.RecordFocusGapsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordFocusGapsResult(rsp)
+}
+
+func (c *ClientWithResponses) RecordFocusGapsWithResponse(ctx context.Context, body RecordFocusGapsJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordFocusGapsResult, error) {
+	rsp, err := c.RecordFocusGaps(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordFocusGapsResult(rsp)
+}
+
+// ListGoalsWithResponse request returning *ListGoalsResult
+func (c *ClientWithResponses) ListGoalsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListGoalsResult, error) {
+	rsp, err := c.ListGoals(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListGoalsResult(rsp)
+}
+
+// CreateGoalWithBodyWithResponse request with arbitrary body returning *CreateGoalResult
+func (c *ClientWithResponses) CreateGoalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGoalResult, error) {
+	rsp, err := c.CreateGoalWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGoalResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateGoalWithResponse(ctx context.Context, body CreateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGoalResult, error) {
+	rsp, err := c.CreateGoal(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGoalResult(rsp)
+}
+
+// GetGoalsProgressWithResponse request returning *GetGoalsProgressResult
+func (c *ClientWithResponses) GetGoalsProgressWithResponse(ctx context.Context, params *GetGoalsProgressParams, reqEditors ...RequestEditorFn) (*GetGoalsProgressResult, error) {
+	rsp, err := c.GetGoalsProgress(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGoalsProgressResult(rsp)
+}
+
+// DeleteGoalWithResponse request returning *DeleteGoalResult
+func (c *ClientWithResponses) DeleteGoalWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteGoalResult, error) {
+	rsp, err := c.DeleteGoal(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteGoalResult(rsp)
+}
+
+// UpdateGoalWithBodyWithResponse request with arbitrary body returning *UpdateGoalResult
+func (c *ClientWithResponses) UpdateGoalWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGoalResult, error) {
+	rsp, err := c.UpdateGoalWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGoalResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateGoalWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateGoalJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGoalResult, error) {
+	rsp, err := c.UpdateGoal(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGoalResult(rsp)
+}
+
+// ListIntegrationsWithResponse request returning *ListIntegrationsResult
+func (c *ClientWithResponses) ListIntegrationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIntegrationsResult, error) {
+	rsp, err := c.ListIntegrations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIntegrationsResult(rsp)
+}
+
+// DisconnectGitHubWithResponse request returning *DisconnectGitHubResult
+func (c *ClientWithResponses) DisconnectGitHubWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DisconnectGitHubResult, error) {
+	rsp, err := c.DisconnectGitHub(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisconnectGitHubResult(rsp)
+}
+
+// GetGitHubConnectionWithResponse request returning *GetGitHubConnectionResult
+func (c *ClientWithResponses) GetGitHubConnectionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetGitHubConnectionResult, error) {
+	rsp, err := c.GetGitHubConnection(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGitHubConnectionResult(rsp)
+}
+
+// ConnectGitHubWithBodyWithResponse request with arbitrary body returning *ConnectGitHubResult
+func (c *ClientWithResponses) ConnectGitHubWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConnectGitHubResult, error) {
+	rsp, err := c.ConnectGitHubWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectGitHubResult(rsp)
+}
+
+func (c *ClientWithResponses) ConnectGitHubWithResponse(ctx context.Context, body ConnectGitHubJSONRequestBody, reqEditors ...RequestEditorFn) (*ConnectGitHubResult, error) {
+	rsp, err := c.ConnectGitHub(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectGitHubResult(rsp)
+}
+
+// DisconnectIntegrationWithResponse request returning *DisconnectIntegrationResult
+func (c *ClientWithResponses) DisconnectIntegrationWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*DisconnectIntegrationResult, error) {
+	rsp, err := c.DisconnectIntegration(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisconnectIntegrationResult(rsp)
+}
+
+// ConnectIntegrationWithBodyWithResponse request with arbitrary body returning *ConnectIntegrationResult
+func (c *ClientWithResponses) ConnectIntegrationWithBodyWithResponse(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConnectIntegrationResult, error) {
+	rsp, err := c.ConnectIntegrationWithBody(ctx, provider, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectIntegrationResult(rsp)
+}
+
+func (c *ClientWithResponses) ConnectIntegrationWithResponse(ctx context.Context, provider string, body ConnectIntegrationJSONRequestBody, reqEditors ...RequestEditorFn) (*ConnectIntegrationResult, error) {
+	rsp, err := c.ConnectIntegration(ctx, provider, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectIntegrationResult(rsp)
+}
+
+// DownloadInvoiceExportWithResponse request returning *DownloadInvoiceExportResult
+func (c *ClientWithResponses) DownloadInvoiceExportWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DownloadInvoiceExportResult, error) {
+	rsp, err := c.DownloadInvoiceExport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadInvoiceExportResult(rsp)
+}
+
+// ListInvoicesWithResponse request returning *ListInvoicesResult
+func (c *ClientWithResponses) ListInvoicesWithResponse(ctx context.Context, params *ListInvoicesParams, reqEditors ...RequestEditorFn) (*ListInvoicesResult, error) {
+	rsp, err := c.ListInvoices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoicesResult(rsp)
+}
+
+// CreateInvoiceWithBodyWithResponse request with arbitrary body returning *CreateInvoiceResult
+func (c *ClientWithResponses) CreateInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInvoiceResult, error) {
+	rsp, err := c.CreateInvoiceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateInvoiceWithResponse(ctx context.Context, body CreateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInvoiceResult, error) {
+	rsp, err := c.CreateInvoice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInvoiceResult(rsp)
+}
+
+// DeleteInvoiceWithResponse request returning *DeleteInvoiceResult
+func (c *ClientWithResponses) DeleteInvoiceWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvoiceResult, error) {
+	rsp, err := c.DeleteInvoice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInvoiceResult(rsp)
+}
+
+// GetInvoiceWithResponse request returning *GetInvoiceResult
+func (c *ClientWithResponses) GetInvoiceWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInvoiceResult, error) {
+	rsp, err := c.GetInvoice(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceResult(rsp)
+}
+
+// AddInvoiceAdjustmentWithBodyWithResponse request with arbitrary body returning *AddInvoiceAdjustmentResult
+func (c *ClientWithResponses) AddInvoiceAdjustmentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceAdjustmentResult, error) {
+	rsp, err := c.AddInvoiceAdjustmentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceAdjustmentResult(rsp)
+}
+
+func (c *ClientWithResponses) AddInvoiceAdjustmentWithResponse(ctx context.Context, id openapi_types.UUID, body AddInvoiceAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceAdjustmentResult, error) {
+	rsp, err := c.AddInvoiceAdjustment(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceAdjustmentResult(rsp)
+}
+
+// DeleteInvoiceAdjustmentWithResponse request returning *DeleteInvoiceAdjustmentResult
+func (c *ClientWithResponses) DeleteInvoiceAdjustmentWithResponse(ctx context.Context, id openapi_types.UUID, lineItemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvoiceAdjustmentResult, error) {
+	rsp, err := c.DeleteInvoiceAdjustment(ctx, id, lineItemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInvoiceAdjustmentResult(rsp)
+}
+
+// ListInvoiceCommentsWithResponse request returning *ListInvoiceCommentsResult
+func (c *ClientWithResponses) ListInvoiceCommentsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListInvoiceCommentsResult, error) {
+	rsp, err := c.ListInvoiceComments(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoiceCommentsResult(rsp)
+}
+
+// AddInvoiceCommentWithBodyWithResponse request with arbitrary body returning *AddInvoiceCommentResult
+func (c *ClientWithResponses) AddInvoiceCommentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddInvoiceCommentResult, error) {
+	rsp, err := c.AddInvoiceCommentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceCommentResult(rsp)
+}
+
+func (c *ClientWithResponses) AddInvoiceCommentWithResponse(ctx context.Context, id openapi_types.UUID, body AddInvoiceCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*AddInvoiceCommentResult, error) {
+	rsp, err := c.AddInvoiceComment(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddInvoiceCommentResult(rsp)
+}
+
+// DeleteInvoiceCommentWithResponse request returning *DeleteInvoiceCommentResult
+func (c *ClientWithResponses) DeleteInvoiceCommentWithResponse(ctx context.Context, id openapi_types.UUID, commentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteInvoiceCommentResult, error) {
+	rsp, err := c.DeleteInvoiceComment(ctx, id, commentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInvoiceCommentResult(rsp)
+}
+
+// CreateCreditNoteWithBodyWithResponse request with arbitrary body returning *CreateCreditNoteResult
+func (c *ClientWithResponses) CreateCreditNoteWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCreditNoteResult, error) {
+	rsp, err := c.CreateCreditNoteWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCreditNoteResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateCreditNoteWithResponse(ctx context.Context, id openapi_types.UUID, body CreateCreditNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCreditNoteResult, error) {
+	rsp, err := c.CreateCreditNote(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCreditNoteResult(rsp)
+}
+
+// ExportInvoiceWithResponse request returning *ExportInvoiceResult
+func (c *ClientWithResponses) ExportInvoiceWithResponse(ctx context.Context, id openapi_types.UUID, params *ExportInvoiceParams, reqEditors ...RequestEditorFn) (*ExportInvoiceResult, error) {
+	rsp, err := c.ExportInvoice(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportInvoiceResult(rsp)
+}
+
+// ExportInvoiceCSVWithResponse request returning *ExportInvoiceCSVResult
+func (c *ClientWithResponses) ExportInvoiceCSVWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExportInvoiceCSVResult, error) {
+	rsp, err := c.ExportInvoiceCSV(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportInvoiceCSVResult(rsp)
+}
+
+// ExportInvoiceSheetsWithResponse request returning *ExportInvoiceSheetsResult
+func (c *ClientWithResponses) ExportInvoiceSheetsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExportInvoiceSheetsResult, error) {
+	rsp, err := c.ExportInvoiceSheets(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportInvoiceSheetsResult(rsp)
+}
+
+// ListInvoiceExportsWithResponse request returning *ListInvoiceExportsResult
+func (c *ClientWithResponses) ListInvoiceExportsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListInvoiceExportsResult, error) {
+	rsp, err := c.ListInvoiceExports(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoiceExportsResult(rsp)
+}
+
+// ListInvoicePaymentsWithResponse request returning *ListInvoicePaymentsResult
+func (c *ClientWithResponses) ListInvoicePaymentsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListInvoicePaymentsResult, error) {
+	rsp, err := c.ListInvoicePayments(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInvoicePaymentsResult(rsp)
+}
+
+// RecordInvoicePaymentWithBodyWithResponse request with arbitrary body returning *RecordInvoicePaymentResult
+func (c *ClientWithResponses) RecordInvoicePaymentWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordInvoicePaymentResult, error) {
+	rsp, err := c.RecordInvoicePaymentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordInvoicePaymentResult(rsp)
+}
+
+func (c *ClientWithResponses) RecordInvoicePaymentWithResponse(ctx context.Context, id openapi_types.UUID, body RecordInvoicePaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordInvoicePaymentResult, error) {
+	rsp, err := c.RecordInvoicePayment(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordInvoicePaymentResult(rsp)
+}
+
+// UpdateInvoiceStatusWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceStatusResult
+func (c *ClientWithResponses) UpdateInvoiceStatusWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResult, error) {
+	rsp, err := c.UpdateInvoiceStatusWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceStatusResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateInvoiceStatusWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateInvoiceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceStatusResult, error) {
+	rsp, err := c.UpdateInvoiceStatus(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceStatusResult(rsp)
+}
+
+// ListOrganizationsWithResponse request returning *ListOrganizationsResult
+func (c *ClientWithResponses) ListOrganizationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOrganizationsResult, error) {
+	rsp, err := c.ListOrganizations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationsResult(rsp)
+}
+
+// CreateOrganizationWithBodyWithResponse request with arbitrary body returning *CreateOrganizationResult
+func (c *ClientWithResponses) CreateOrganizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrganizationResult, error) {
+	rsp, err := c.CreateOrganizationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrganizationWithResponse(ctx context.Context, body CreateOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrganizationResult, error) {
+	rsp, err := c.CreateOrganization(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrganizationResult(rsp)
+}
+
+// GetOrganizationWithResponse request returning *GetOrganizationResult
+func (c *ClientWithResponses) GetOrganizationWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetOrganizationResult, error) {
+	rsp, err := c.GetOrganization(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationResult(rsp)
+}
+
+// DeleteOrganizationDomainWithResponse request returning *DeleteOrganizationDomainResult
+func (c *ClientWithResponses) DeleteOrganizationDomainWithResponse(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*DeleteOrganizationDomainResult, error) {
+	rsp, err := c.DeleteOrganizationDomain(ctx, id, domain, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOrganizationDomainResult(rsp)
+}
+
+// PutOrganizationDomainWithBodyWithResponse request with arbitrary body returning *PutOrganizationDomainResult
+func (c *ClientWithResponses) PutOrganizationDomainWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, domain string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutOrganizationDomainResult, error) {
+	rsp, err := c.PutOrganizationDomainWithBody(ctx, id, domain, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrganizationDomainResult(rsp)
+}
+
+func (c *ClientWithResponses) PutOrganizationDomainWithResponse(ctx context.Context, id openapi_types.UUID, domain string, body PutOrganizationDomainJSONRequestBody, reqEditors ...RequestEditorFn) (*PutOrganizationDomainResult, error) {
+	rsp, err := c.PutOrganizationDomain(ctx, id, domain, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutOrganizationDomainResult(rsp)
+}
+
+// VerifyOrganizationDomainWithResponse request returning *VerifyOrganizationDomainResult
+func (c *ClientWithResponses) VerifyOrganizationDomainWithResponse(ctx context.Context, id openapi_types.UUID, domain string, reqEditors ...RequestEditorFn) (*VerifyOrganizationDomainResult, error) {
+	rsp, err := c.VerifyOrganizationDomain(ctx, id, domain, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyOrganizationDomainResult(rsp)
+}
+
+// UpdateOrganizationGoogleDelegationWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationGoogleDelegationResult
+func (c *ClientWithResponses) UpdateOrganizationGoogleDelegationWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationGoogleDelegationResult, error) {
+	rsp, err := c.UpdateOrganizationGoogleDelegationWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationGoogleDelegationResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationGoogleDelegationWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationGoogleDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationGoogleDelegationResult, error) {
+	rsp, err := c.UpdateOrganizationGoogleDelegation(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationGoogleDelegationResult(rsp)
+}
+
+// ProvisionOrganizationCalendarsWithBodyWithResponse request with arbitrary body returning *ProvisionOrganizationCalendarsResult
+func (c *ClientWithResponses) ProvisionOrganizationCalendarsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProvisionOrganizationCalendarsResult, error) {
+	rsp, err := c.ProvisionOrganizationCalendarsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProvisionOrganizationCalendarsResult(rsp)
+}
+
+func (c *ClientWithResponses) ProvisionOrganizationCalendarsWithResponse(ctx context.Context, id openapi_types.UUID, body ProvisionOrganizationCalendarsJSONRequestBody, reqEditors ...RequestEditorFn) (*ProvisionOrganizationCalendarsResult, error) {
+	rsp, err := c.ProvisionOrganizationCalendars(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProvisionOrganizationCalendarsResult(rsp)
+}
+
+// ListOrganizationMembersWithResponse request returning *ListOrganizationMembersResult
+func (c *ClientWithResponses) ListOrganizationMembersWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListOrganizationMembersResult, error) {
+	rsp, err := c.ListOrganizationMembers(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOrganizationMembersResult(rsp)
+}
+
+// UpdateOrganizationMemberDelegationWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationMemberDelegationResult
+func (c *ClientWithResponses) UpdateOrganizationMemberDelegationWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberDelegationResult, error) {
+	rsp, err := c.UpdateOrganizationMemberDelegationWithBody(ctx, id, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationMemberDelegationResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationMemberDelegationWithResponse(ctx context.Context, id openapi_types.UUID, userId openapi_types.UUID, body UpdateOrganizationMemberDelegationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationMemberDelegationResult, error) {
+	rsp, err := c.UpdateOrganizationMemberDelegation(ctx, id, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationMemberDelegationResult(rsp)
+}
+
+// TransferOrganizationProjectWithBodyWithResponse request with arbitrary body returning *TransferOrganizationProjectResult
+func (c *ClientWithResponses) TransferOrganizationProjectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferOrganizationProjectResult, error) {
+	rsp, err := c.TransferOrganizationProjectWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferOrganizationProjectResult(rsp)
+}
+
+func (c *ClientWithResponses) TransferOrganizationProjectWithResponse(ctx context.Context, id openapi_types.UUID, body TransferOrganizationProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*TransferOrganizationProjectResult, error) {
+	rsp, err := c.TransferOrganizationProject(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferOrganizationProjectResult(rsp)
+}
+
+// RotateOrganizationScimTokenWithResponse request returning *RotateOrganizationScimTokenResult
+func (c *ClientWithResponses) RotateOrganizationScimTokenWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RotateOrganizationScimTokenResult, error) {
+	rsp, err := c.RotateOrganizationScimToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateOrganizationScimTokenResult(rsp)
+}
+
+// UpdateOrganizationSsoWithBodyWithResponse request with arbitrary body returning *UpdateOrganizationSsoResult
+func (c *ClientWithResponses) UpdateOrganizationSsoWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrganizationSsoResult, error) {
+	rsp, err := c.UpdateOrganizationSsoWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationSsoResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrganizationSsoWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateOrganizationSsoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrganizationSsoResult, error) {
+	rsp, err := c.UpdateOrganizationSso(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrganizationSsoResult(rsp)
+}
+
+// DeletePaymentWithResponse request returning *DeletePaymentResult
+func (c *ClientWithResponses) DeletePaymentWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeletePaymentResult, error) {
+	rsp, err := c.DeletePayment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePaymentResult(rsp)
+}
+
+// ListProjectsWithResponse request returning *ListProjectsResult
+func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResult, error) {
+	rsp, err := c.ListProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsResult(rsp)
+}
+
+// CreateProjectWithBodyWithResponse request with arbitrary body returning *CreateProjectResult
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResult, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResult, error) {
+	rsp, err := c.CreateProject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectResult(rsp)
+}
+
+// DeleteProjectWithResponse request returning *DeleteProjectResult
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteProjectResult, error) {
+	rsp, err := c.DeleteProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectResult(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResult
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetProjectResult, error) {
+	rsp, err := c.GetProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResult(rsp)
+}
+
+// UpdateProjectWithBodyWithResponse request with arbitrary body returning *UpdateProjectResult
+func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResult, error) {
+	rsp, err := c.UpdateProjectWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResult, error) {
+	rsp, err := c.UpdateProject(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResult(rsp)
+}
+
+// AddProjectFingerprintWithBodyWithResponse request with arbitrary body returning *AddProjectFingerprintResult
+func (c *ClientWithResponses) AddProjectFingerprintWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProjectFingerprintResult, error) {
+	rsp, err := c.AddProjectFingerprintWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProjectFingerprintResult(rsp)
+}
+
+func (c *ClientWithResponses) AddProjectFingerprintWithResponse(ctx context.Context, id openapi_types.UUID, body AddProjectFingerprintJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProjectFingerprintResult, error) {
+	rsp, err := c.AddProjectFingerprint(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProjectFingerprintResult(rsp)
+}
+
+// RemoveProjectFingerprintWithResponse request returning *RemoveProjectFingerprintResult
+func (c *ClientWithResponses) RemoveProjectFingerprintWithResponse(ctx context.Context, id openapi_types.UUID, kind FingerprintKind, value string, reqEditors ...RequestEditorFn) (*RemoveProjectFingerprintResult, error) {
+	rsp, err := c.RemoveProjectFingerprint(ctx, id, kind, value, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveProjectFingerprintResult(rsp)
+}
+
+// GetMeetingCostReportWithResponse request returning *GetMeetingCostReportResult
+func (c *ClientWithResponses) GetMeetingCostReportWithResponse(ctx context.Context, params *GetMeetingCostReportParams, reqEditors ...RequestEditorFn) (*GetMeetingCostReportResult, error) {
+	rsp, err := c.GetMeetingCostReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeetingCostReportResult(rsp)
+}
+
+// GetMeetingLoadReportWithResponse request returning *GetMeetingLoadReportResult
+func (c *ClientWithResponses) GetMeetingLoadReportWithResponse(ctx context.Context, params *GetMeetingLoadReportParams, reqEditors ...RequestEditorFn) (*GetMeetingLoadReportResult, error) {
+	rsp, err := c.GetMeetingLoadReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeetingLoadReportResult(rsp)
+}
+
+// GetUtilizationReportWithResponse request returning *GetUtilizationReportResult
+func (c *ClientWithResponses) GetUtilizationReportWithResponse(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*GetUtilizationReportResult, error) {
+	rsp, err := c.GetUtilizationReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUtilizationReportResult(rsp)
+}
+
+// ExportRulePackWithBodyWithResponse request with arbitrary body returning *ExportRulePackResult
+func (c *ClientWithResponses) ExportRulePackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportRulePackResult, error) {
+	rsp, err := c.ExportRulePackWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportRulePackResult(rsp)
+}
+
+func (c *ClientWithResponses) ExportRulePackWithResponse(ctx context.Context, body ExportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportRulePackResult, error) {
+	rsp, err := c.ExportRulePack(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportRulePackResult(rsp)
+}
+
+// ImportRulePackWithBodyWithResponse request with arbitrary body returning *ImportRulePackResult
+func (c *ClientWithResponses) ImportRulePackWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportRulePackResult, error) {
+	rsp, err := c.ImportRulePackWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportRulePackResult(rsp)
+}
+
+func (c *ClientWithResponses) ImportRulePackWithResponse(ctx context.Context, body ImportRulePackJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportRulePackResult, error) {
+	rsp, err := c.ImportRulePack(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportRulePackResult(rsp)
+}
+
+// ListRulesWithResponse request returning *ListRulesResult
+func (c *ClientWithResponses) ListRulesWithResponse(ctx context.Context, params *ListRulesParams, reqEditors ...RequestEditorFn) (*ListRulesResult, error) {
+	rsp, err := c.ListRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRulesResult(rsp)
+}
+
+// CreateRuleWithBodyWithResponse request with arbitrary body returning *CreateRuleResult
+func (c *ClientWithResponses) CreateRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRuleResult, error) {
+	rsp, err := c.CreateRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRuleResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateRuleWithResponse(ctx context.Context, body CreateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRuleResult, error) {
+	rsp, err := c.CreateRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRuleResult(rsp)
+}
+
+// ApplyRulesWithBodyWithResponse request with arbitrary body returning *ApplyRulesResult
+func (c *ClientWithResponses) ApplyRulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyRulesResult, error) {
+	rsp, err := c.ApplyRulesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyRulesResult(rsp)
+}
+
+func (c *ClientWithResponses) ApplyRulesWithResponse(ctx context.Context, body ApplyRulesJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyRulesResult, error) {
+	rsp, err := c.ApplyRules(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyRulesResult(rsp)
+}
+
+// GetRuleConflictsWithResponse request returning *GetRuleConflictsResult
+func (c *ClientWithResponses) GetRuleConflictsWithResponse(ctx context.Context, params *GetRuleConflictsParams, reqEditors ...RequestEditorFn) (*GetRuleConflictsResult, error) {
+	rsp, err := c.GetRuleConflicts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRuleConflictsResult(rsp)
+}
+
+// LintRuleWithBodyWithResponse request with arbitrary body returning *LintRuleResult
+func (c *ClientWithResponses) LintRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LintRuleResult, error) {
+	rsp, err := c.LintRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLintRuleResult(rsp)
+}
+
+func (c *ClientWithResponses) LintRuleWithResponse(ctx context.Context, body LintRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*LintRuleResult, error) {
+	rsp, err := c.LintRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLintRuleResult(rsp)
+}
+
+// PreviewRuleWithBodyWithResponse request with arbitrary body returning *PreviewRuleResult
+func (c *ClientWithResponses) PreviewRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewRuleResult, error) {
+	rsp, err := c.PreviewRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewRuleResult(rsp)
+}
+
+func (c *ClientWithResponses) PreviewRuleWithResponse(ctx context.Context, body PreviewRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewRuleResult, error) {
+	rsp, err := c.PreviewRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewRuleResult(rsp)
+}
+
+// TestRuleWithBodyWithResponse request with arbitrary body returning *TestRuleResult
+func (c *ClientWithResponses) TestRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestRuleResult, error) {
+	rsp, err := c.TestRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestRuleResult(rsp)
+}
+
+func (c *ClientWithResponses) TestRuleWithResponse(ctx context.Context, body TestRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*TestRuleResult, error) {
+	rsp, err := c.TestRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestRuleResult(rsp)
+}
+
+// DeleteRuleWithResponse request returning *DeleteRuleResult
+func (c *ClientWithResponses) DeleteRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteRuleResult, error) {
+	rsp, err := c.DeleteRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRuleResult(rsp)
+}
+
+// GetRuleWithResponse request returning *GetRuleResult
+func (c *ClientWithResponses) GetRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetRuleResult, error) {
+	rsp, err := c.GetRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRuleResult(rsp)
+}
+
+// UpdateRuleWithBodyWithResponse request with arbitrary body returning *UpdateRuleResult
+func (c *ClientWithResponses) UpdateRuleWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRuleResult, error) {
+	rsp, err := c.UpdateRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRuleResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRuleWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRuleResult, error) {
+	rsp, err := c.UpdateRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRuleResult(rsp)
+}
+
+// ListClassificationSnapshotsWithResponse request returning *ListClassificationSnapshotsResult
+func (c *ClientWithResponses) ListClassificationSnapshotsWithResponse(ctx context.Context, params *ListClassificationSnapshotsParams, reqEditors ...RequestEditorFn) (*ListClassificationSnapshotsResult, error) {
+	rsp, err := c.ListClassificationSnapshots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClassificationSnapshotsResult(rsp)
+}
+
+// CreateClassificationSnapshotWithBodyWithResponse request with arbitrary body returning *CreateClassificationSnapshotResult
+func (c *ClientWithResponses) CreateClassificationSnapshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClassificationSnapshotResult, error) {
+	rsp, err := c.CreateClassificationSnapshotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClassificationSnapshotResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateClassificationSnapshotWithResponse(ctx context.Context, body CreateClassificationSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClassificationSnapshotResult, error) {
+	rsp, err := c.CreateClassificationSnapshot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClassificationSnapshotResult(rsp)
+}
+
+// DeleteClassificationSnapshotWithResponse request returning *DeleteClassificationSnapshotResult
+func (c *ClientWithResponses) DeleteClassificationSnapshotWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteClassificationSnapshotResult, error) {
+	rsp, err := c.DeleteClassificationSnapshot(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClassificationSnapshotResult(rsp)
+}
+
+// RestoreClassificationSnapshotWithResponse request returning *RestoreClassificationSnapshotResult
+func (c *ClientWithResponses) RestoreClassificationSnapshotWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreClassificationSnapshotResult, error) {
+	rsp, err := c.RestoreClassificationSnapshot(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreClassificationSnapshotResult(rsp)
+}
+
+// ListSuppressionRulesWithResponse request returning *ListSuppressionRulesResult
+func (c *ClientWithResponses) ListSuppressionRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSuppressionRulesResult, error) {
+	rsp, err := c.ListSuppressionRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSuppressionRulesResult(rsp)
+}
+
+// CreateSuppressionRuleWithBodyWithResponse request with arbitrary body returning *CreateSuppressionRuleResult
+func (c *ClientWithResponses) CreateSuppressionRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSuppressionRuleResult, error) {
+	rsp, err := c.CreateSuppressionRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSuppressionRuleResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateSuppressionRuleWithResponse(ctx context.Context, body CreateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSuppressionRuleResult, error) {
+	rsp, err := c.CreateSuppressionRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSuppressionRuleResult(rsp)
+}
+
+// DeleteSuppressionRuleWithResponse request returning *DeleteSuppressionRuleResult
+func (c *ClientWithResponses) DeleteSuppressionRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteSuppressionRuleResult, error) {
+	rsp, err := c.DeleteSuppressionRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSuppressionRuleResult(rsp)
+}
+
+// UpdateSuppressionRuleWithBodyWithResponse request with arbitrary body returning *UpdateSuppressionRuleResult
+func (c *ClientWithResponses) UpdateSuppressionRuleWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSuppressionRuleResult, error) {
+	rsp, err := c.UpdateSuppressionRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSuppressionRuleResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSuppressionRuleWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateSuppressionRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSuppressionRuleResult, error) {
+	rsp, err := c.UpdateSuppressionRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSuppressionRuleResult(rsp)
+}
+
+// ListTagsWithResponse request returning *ListTagsResult
+func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTagsResult, error) {
+	rsp, err := c.ListTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsResult(rsp)
+}
+
+// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResult
+func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResult, error) {
+	rsp, err := c.CreateTagWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResult, error) {
+	rsp, err := c.CreateTag(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResult(rsp)
+}
+
+// DeleteTagWithResponse request returning *DeleteTagResult
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTagResult, error) {
+	rsp, err := c.DeleteTag(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagResult(rsp)
+}
+
+// RenameTagWithBodyWithResponse request with arbitrary body returning *RenameTagResult
+func (c *ClientWithResponses) RenameTagWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenameTagResult, error) {
+	rsp, err := c.RenameTagWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameTagResult(rsp)
+}
+
+func (c *ClientWithResponses) RenameTagWithResponse(ctx context.Context, id openapi_types.UUID, body RenameTagJSONRequestBody, reqEditors ...RequestEditorFn) (*RenameTagResult, error) {
+	rsp, err := c.RenameTag(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenameTagResult(rsp)
+}
+
+// ListTimeEntriesWithResponse request returning *ListTimeEntriesResult
+func (c *ClientWithResponses) ListTimeEntriesWithResponse(ctx context.Context, params *ListTimeEntriesParams, reqEditors ...RequestEditorFn) (*ListTimeEntriesResult, error) {
+	rsp, err := c.ListTimeEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTimeEntriesResult(rsp)
+}
+
+// CreateTimeEntryWithBodyWithResponse request with arbitrary body returning *CreateTimeEntryResult
+func (c *ClientWithResponses) CreateTimeEntryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTimeEntryResult, error) {
+	rsp, err := c.CreateTimeEntryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTimeEntryResult(rsp)
+}
+
+func (c *ClientWithResponses) CreateTimeEntryWithResponse(ctx context.Context, body CreateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTimeEntryResult, error) {
+	rsp, err := c.CreateTimeEntry(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTimeEntryResult(rsp)
+}
+
+// SuggestDescriptionsWithResponse request returning *SuggestDescriptionsResult
+func (c *ClientWithResponses) SuggestDescriptionsWithResponse(ctx context.Context, params *SuggestDescriptionsParams, reqEditors ...RequestEditorFn) (*SuggestDescriptionsResult, error) {
+	rsp, err := c.SuggestDescriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSuggestDescriptionsResult(rsp)
+}
+
+// ParseTimeEntryWithBodyWithResponse request with arbitrary body returning *ParseTimeEntryResult
+func (c *ClientWithResponses) ParseTimeEntryWithBodyWithResponse(ctx context.Context, params *ParseTimeEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ParseTimeEntryResult, error) {
+	rsp, err := c.ParseTimeEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseParseTimeEntryResult(rsp)
+}
+
+func (c *ClientWithResponses) ParseTimeEntryWithResponse(ctx context.Context, params *ParseTimeEntryParams, body ParseTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ParseTimeEntryResult, error) {
+	rsp, err := c.ParseTimeEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseParseTimeEntryResult(rsp)
+}
+
+// RecalculateTimeEntriesWithBodyWithResponse request with arbitrary body returning *RecalculateTimeEntriesResult
+func (c *ClientWithResponses) RecalculateTimeEntriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecalculateTimeEntriesResult, error) {
+	rsp, err := c.RecalculateTimeEntriesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecalculateTimeEntriesResult(rsp)
+}
+
+func (c *ClientWithResponses) RecalculateTimeEntriesWithResponse(ctx context.Context, body RecalculateTimeEntriesJSONRequestBody, reqEditors ...RequestEditorFn) (*RecalculateTimeEntriesResult, error) {
+	rsp, err := c.RecalculateTimeEntries(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecalculateTimeEntriesResult(rsp)
+}
+
+// GetRecalculationJobWithResponse request returning *GetRecalculationJobResult
+func (c *ClientWithResponses) GetRecalculationJobWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetRecalculationJobResult, error) {
+	rsp, err := c.GetRecalculationJob(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecalculationJobResult(rsp)
+}
+
+// GetReconciliationReportWithResponse request returning *GetReconciliationReportResult
+func (c *ClientWithResponses) GetReconciliationReportWithResponse(ctx context.Context, params *GetReconciliationReportParams, reqEditors ...RequestEditorFn) (*GetReconciliationReportResult, error) {
+	rsp, err := c.GetReconciliationReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReconciliationReportResult(rsp)
+}
+
+// DeleteTimeEntryWithResponse request returning *DeleteTimeEntryResult
+func (c *ClientWithResponses) DeleteTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTimeEntryResult, error) {
+	rsp, err := c.DeleteTimeEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTimeEntryResult(rsp)
+}
+
+// GetTimeEntryWithResponse request returning *GetTimeEntryResult
+func (c *ClientWithResponses) GetTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimeEntryResult, error) {
+	rsp, err := c.GetTimeEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimeEntryResult(rsp)
+}
+
+// UpdateTimeEntryWithBodyWithResponse request with arbitrary body returning *UpdateTimeEntryResult
+func (c *ClientWithResponses) UpdateTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeEntryResult, error) {
+	rsp, err := c.UpdateTimeEntryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTimeEntryResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body UpdateTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeEntryResult, error) {
+	rsp, err := c.UpdateTimeEntry(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTimeEntryResult(rsp)
+}
+
+// GetTimeEntryBreakdownWithResponse request returning *GetTimeEntryBreakdownResult
+func (c *ClientWithResponses) GetTimeEntryBreakdownWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTimeEntryBreakdownResult, error) {
+	rsp, err := c.GetTimeEntryBreakdown(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTimeEntryBreakdownResult(rsp)
+}
+
+// ExplainTimeEntryWithResponse request returning *ExplainTimeEntryResult
+func (c *ClientWithResponses) ExplainTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExplainTimeEntryResult, error) {
+	rsp, err := c.ExplainTimeEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExplainTimeEntryResult(rsp)
+}
+
+// ListTimeEntryNotesWithResponse request returning *ListTimeEntryNotesResult
+func (c *ClientWithResponses) ListTimeEntryNotesWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListTimeEntryNotesResult, error) {
+	rsp, err := c.ListTimeEntryNotes(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTimeEntryNotesResult(rsp)
+}
+
+// AddTimeEntryNoteWithBodyWithResponse request with arbitrary body returning *AddTimeEntryNoteResult
+func (c *ClientWithResponses) AddTimeEntryNoteWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeEntryNoteResult, error) {
+	rsp, err := c.AddTimeEntryNoteWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTimeEntryNoteResult(rsp)
+}
+
+func (c *ClientWithResponses) AddTimeEntryNoteWithResponse(ctx context.Context, id openapi_types.UUID, body AddTimeEntryNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeEntryNoteResult, error) {
+	rsp, err := c.AddTimeEntryNote(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTimeEntryNoteResult(rsp)
+}
+
+// DeleteTimeEntryNoteWithResponse request returning *DeleteTimeEntryNoteResult
+func (c *ClientWithResponses) DeleteTimeEntryNoteWithResponse(ctx context.Context, id openapi_types.UUID, noteId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteTimeEntryNoteResult, error) {
+	rsp, err := c.DeleteTimeEntryNote(ctx, id, noteId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTimeEntryNoteResult(rsp)
+}
+
+// ReconcileTimeEntryWithBodyWithResponse request with arbitrary body returning *ReconcileTimeEntryResult
+func (c *ClientWithResponses) ReconcileTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReconcileTimeEntryResult, error) {
+	rsp, err := c.ReconcileTimeEntryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReconcileTimeEntryResult(rsp)
+}
+
+func (c *ClientWithResponses) ReconcileTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body ReconcileTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ReconcileTimeEntryResult, error) {
+	rsp, err := c.ReconcileTimeEntry(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReconcileTimeEntryResult(rsp)
+}
+
+// RefreshTimeEntryWithResponse request returning *RefreshTimeEntryResult
+func (c *ClientWithResponses) RefreshTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RefreshTimeEntryResult, error) {
+	rsp, err := c.RefreshTimeEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTimeEntryResult(rsp)
+}
+
+// SplitTimeEntryWithBodyWithResponse request with arbitrary body returning *SplitTimeEntryResult
+func (c *ClientWithResponses) SplitTimeEntryWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SplitTimeEntryResult, error) {
+	rsp, err := c.SplitTimeEntryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSplitTimeEntryResult(rsp)
+}
+
+func (c *ClientWithResponses) SplitTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, body SplitTimeEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*SplitTimeEntryResult, error) {
+	rsp, err := c.SplitTimeEntry(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSplitTimeEntryResult(rsp)
+}
+
+// SetTimeEntryTagsWithBodyWithResponse request with arbitrary body returning *SetTimeEntryTagsResult
+func (c *ClientWithResponses) SetTimeEntryTagsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResult, error) {
+	rsp, err := c.SetTimeEntryTagsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeEntryTagsResult(rsp)
+}
+
+func (c *ClientWithResponses) SetTimeEntryTagsWithResponse(ctx context.Context, id openapi_types.UUID, body SetTimeEntryTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTimeEntryTagsResult, error) {
+	rsp, err := c.SetTimeEntryTags(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTimeEntryTagsResult(rsp)
+}
+
+// ListTrashWithResponse request returning *ListTrashResult
+func (c *ClientWithResponses) ListTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTrashResult, error) {
+	rsp, err := c.ListTrash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTrashResult(rsp)
+}
+
+// RestoreRuleWithResponse request returning *RestoreRuleResult
+func (c *ClientWithResponses) RestoreRuleWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreRuleResult, error) {
+	rsp, err := c.RestoreRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreRuleResult(rsp)
+}
+
+// RestoreTimeEntryWithResponse request returning *RestoreTimeEntryResult
+func (c *ClientWithResponses) RestoreTimeEntryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreTimeEntryResult, error) {
+	rsp, err := c.RestoreTimeEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTimeEntryResult(rsp)
+}
+
+// GetWeekGridWithResponse request returning *GetWeekGridResult
+func (c *ClientWithResponses) GetWeekGridWithResponse(ctx context.Context, start openapi_types.Date, reqEditors ...RequestEditorFn) (*GetWeekGridResult, error) {
+	rsp, err := c.GetWeekGrid(ctx, start, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWeekGridResult(rsp)
+}
+
+// GetWorkingHoursWithResponse request returning *GetWorkingHoursResult
+func (c *ClientWithResponses) GetWorkingHoursWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWorkingHoursResult, error) {
+	rsp, err := c.GetWorkingHours(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkingHoursResult(rsp)
+}
+
+// UpdateWorkingHoursWithBodyWithResponse request with arbitrary body returning *UpdateWorkingHoursResult
+func (c *ClientWithResponses) UpdateWorkingHoursWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWorkingHoursResult, error) {
+	rsp, err := c.UpdateWorkingHoursWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWorkingHoursResult(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWorkingHoursWithResponse(ctx context.Context, body UpdateWorkingHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWorkingHoursResult, error) {
+	rsp, err := c.UpdateWorkingHours(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWorkingHoursResult(rsp)
+}
+
+// ParseListClassificationActionsResult parses an HTTP response from a ListClassificationActionsWithResponse call
+func ParseListClassificationActionsResult(rsp *http.Response) (*ListClassificationActionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClassificationActionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClassificationAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUndoClassificationActionResult parses an HTTP response from a UndoClassificationActionWithResponse call
+func ParseUndoClassificationActionResult(rsp *http.Response) (*UndoClassificationActionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UndoClassificationActionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UndoActionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAnomaliesResult parses an HTTP response from a ListAnomaliesWithResponse call
+func ParseListAnomaliesResult(rsp *http.Response) (*ListAnomaliesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAnomaliesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DayAnomaly
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAnalyzeAnomaliesResult parses an HTTP response from a AnalyzeAnomaliesWithResponse call
+func ParseAnalyzeAnomaliesResult(rsp *http.Response) (*AnalyzeAnomaliesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnalyzeAnomaliesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DayAnomaly
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDismissAnomalyResult parses an HTTP response from a DismissAnomalyWithResponse call
+func ParseDismissAnomalyResult(rsp *http.Response) (*DismissAnomalyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DismissAnomalyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DayAnomaly
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListApiKeysResult parses an HTTP response from a ListApiKeysWithResponse call
+func ParseListApiKeysResult(rsp *http.Response) (*ListApiKeysResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListApiKeysResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ApiKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateApiKeyResult parses an HTTP response from a CreateApiKeyWithResponse call
+func ParseCreateApiKeyResult(rsp *http.Response) (*CreateApiKeyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateApiKeyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApiKeyWithSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiKeyResult parses an HTTP response from a DeleteApiKeyWithResponse call
+func ParseDeleteApiKeyResult(rsp *http.Response) (*DeleteApiKeyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiKeyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateApiKeyResult parses an HTTP response from a UpdateApiKeyWithResponse call
+func ParseUpdateApiKeyResult(rsp *http.Response) (*UpdateApiKeyResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateApiKeyResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAttendeeAliasesResult parses an HTTP response from a ListAttendeeAliasesWithResponse call
+func ParseListAttendeeAliasesResult(rsp *http.Response) (*ListAttendeeAliasesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAttendeeAliasesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AttendeeAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAttendeeAliasResult parses an HTTP response from a CreateAttendeeAliasWithResponse call
+func ParseCreateAttendeeAliasResult(rsp *http.Response) (*CreateAttendeeAliasResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAttendeeAliasResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AttendeeAlias
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAttendeeAliasResult parses an HTTP response from a DeleteAttendeeAliasWithResponse call
+func ParseDeleteAttendeeAliasResult(rsp *http.Response) (*DeleteAttendeeAliasResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAttendeeAliasResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCompanyDomainsResult parses an HTTP response from a GetCompanyDomainsWithResponse call
+func ParseGetCompanyDomainsResult(rsp *http.Response) (*GetCompanyDomainsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCompanyDomainsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompanyDomains
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCompanyDomainsResult parses an HTTP response from a UpdateCompanyDomainsWithResponse call
+func ParseUpdateCompanyDomainsResult(rsp *http.Response) (*UpdateCompanyDomainsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCompanyDomainsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompanyDomains
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGoogleAuthorizeResult parses an HTTP response from a GoogleAuthorizeWithResponse call
+func ParseGoogleAuthorizeResult(rsp *http.Response) (*GoogleAuthorizeResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GoogleAuthorizeResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthAuthorizeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGoogleCallbackResult parses an HTTP response from a GoogleCallbackWithResponse call
+func ParseGoogleCallbackResult(rsp *http.Response) (*GoogleCallbackResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GoogleCallbackResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CalendarConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLocaleResult parses an HTTP response from a GetLocaleWithResponse call
+func ParseGetLocaleResult(rsp *http.Response) (*GetLocaleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLocaleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LocaleSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateLocaleResult parses an HTTP response from a UpdateLocaleWithResponse call
+func ParseUpdateLocaleResult(rsp *http.Response) (*UpdateLocaleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateLocaleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LocaleSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResult parses an HTTP response from a LoginWithResponse call
+func ParseLoginResult(rsp *http.Response) (*LoginResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutResult parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResult(rsp *http.Response) (*LogoutResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCurrentUserResult parses an HTTP response from a GetCurrentUserWithResponse call
+func ParseGetCurrentUserResult(rsp *http.Response) (*GetCurrentUserResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCurrentUserResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRetentionSettingsResult parses an HTTP response from a GetRetentionSettingsWithResponse call
+func ParseGetRetentionSettingsResult(rsp *http.Response) (*GetRetentionSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRetentionSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RetentionSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRetentionSettingsResult parses an HTTP response from a UpdateRetentionSettingsWithResponse call
+func ParseUpdateRetentionSettingsResult(rsp *http.Response) (*UpdateRetentionSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRetentionSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RetentionSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewRetentionResult parses an HTTP response from a PreviewRetentionWithResponse call
+func ParsePreviewRetentionResult(rsp *http.Response) (*PreviewRetentionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewRetentionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RetentionPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSignupResult parses an HTTP response from a SignupWithResponse call
+func ParseSignupResult(rsp *http.Response) (*SignupResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignupResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartSsoResult parses an HTTP response from a StartSsoWithResponse call
+func ParseStartSsoResult(rsp *http.Response) (*StartSsoResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartSsoResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SSOStartResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSyncSettingsResult parses an HTTP response from a GetSyncSettingsWithResponse call
+func ParseGetSyncSettingsResult(rsp *http.Response) (*GetSyncSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSyncSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSyncSettingsResult parses an HTTP response from a UpdateSyncSettingsWithResponse call
+func ParseUpdateSyncSettingsResult(rsp *http.Response) (*UpdateSyncSettingsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSyncSettingsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBillingPeriodsResult parses an HTTP response from a ListBillingPeriodsWithResponse call
+func ParseListBillingPeriodsResult(rsp *http.Response) (*ListBillingPeriodsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBillingPeriodsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BillingPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBillingPeriodResult parses an HTTP response from a CreateBillingPeriodWithResponse call
+func ParseCreateBillingPeriodResult(rsp *http.Response) (*CreateBillingPeriodResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBillingPeriodResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BillingPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBillingPeriodResult parses an HTTP response from a DeleteBillingPeriodWithResponse call
+func ParseDeleteBillingPeriodResult(rsp *http.Response) (*DeleteBillingPeriodResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBillingPeriodResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBillingPeriodResult parses an HTTP response from a UpdateBillingPeriodWithResponse call
+func ParseUpdateBillingPeriodResult(rsp *http.Response) (*UpdateBillingPeriodResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBillingPeriodResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BillingPeriod
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClientBalancesResult parses an HTTP response from a ListClientBalancesWithResponse call
+func ParseListClientBalancesResult(rsp *http.Response) (*ListClientBalancesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClientBalancesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClientBalance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCalendarEventsResult parses an HTTP response from a ListCalendarEventsWithResponse call
+func ParseListCalendarEventsResult(rsp *http.Response) (*ListCalendarEventsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCalendarEventsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CalendarEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBulkClassifyEventsResult parses an HTTP response from a BulkClassifyEventsWithResponse call
+func ParseBulkClassifyEventsResult(rsp *http.Response) (*BulkClassifyEventsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BulkClassifyEventsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkClassifyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDiffCalendarEventsResult parses an HTTP response from a DiffCalendarEventsWithResponse call
+func ParseDiffCalendarEventsResult(rsp *http.Response) (*DiffCalendarEventsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DiffCalendarEventsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarEventDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReviewQueueResult parses an HTTP response from a GetReviewQueueWithResponse call
+func ParseGetReviewQueueResult(rsp *http.Response) (*GetReviewQueueResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReviewQueueResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewQueue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListEventChangesResult parses an HTTP response from a ListEventChangesWithResponse call
+func ParseListEventChangesResult(rsp *http.Response) (*ListEventChangesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEventChangesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CalendarEventChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseClassifyCalendarEventResult parses an HTTP response from a ClassifyCalendarEventWithResponse call
+func ParseClassifyCalendarEventResult(rsp *http.Response) (*ClassifyCalendarEventResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ClassifyCalendarEventResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassifyEventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExplainEventClassificationResult parses an HTTP response from a ExplainEventClassificationWithResponse call
+func ParseExplainEventClassificationResult(rsp *http.Response) (*ExplainEventClassificationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExplainEventClassificationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassificationExplanation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListEventClassificationHistoryResult parses an HTTP response from a ListEventClassificationHistoryWithResponse call
+func ParseListEventClassificationHistoryResult(rsp *http.Response) (*ListEventClassificationHistoryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListEventClassificationHistoryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []EventClassificationHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReviewCalendarEventResult parses an HTTP response from a ReviewCalendarEventWithResponse call
+func ParseReviewCalendarEventResult(rsp *http.Response) (*ReviewCalendarEventResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReviewCalendarEventResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetEventSuppressionResult parses an HTTP response from a SetEventSuppressionWithResponse call
+func ParseSetEventSuppressionResult(rsp *http.Response) (*SetEventSuppressionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetEventSuppressionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetEventTagsResult parses an HTTP response from a SetEventTagsWithResponse call
+func ParseSetEventTagsResult(rsp *http.Response) (*SetEventTagsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetEventTagsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCalendarConnectionsResult parses an HTTP response from a ListCalendarConnectionsWithResponse call
+func ParseListCalendarConnectionsResult(rsp *http.Response) (*ListCalendarConnectionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCalendarConnectionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CalendarConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListConnectionsNeedingReauthResult parses an HTTP response from a ListConnectionsNeedingReauthWithResponse call
+func ParseListConnectionsNeedingReauthResult(rsp *http.Response) (*ListConnectionsNeedingReauthResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListConnectionsNeedingReauthResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ConnectionReauth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCalendarConnectionResult parses an HTTP response from a DeleteCalendarConnectionWithResponse call
+func ParseDeleteCalendarConnectionResult(rsp *http.Response) (*DeleteCalendarConnectionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCalendarConnectionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePauseCalendarConnectionResult parses an HTTP response from a PauseCalendarConnectionWithResponse call
+func ParsePauseCalendarConnectionResult(rsp *http.Response) (*PauseCalendarConnectionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PauseCalendarConnectionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReauthorizeCalendarConnectionResult parses an HTTP response from a ReauthorizeCalendarConnectionWithResponse call
+func ParseReauthorizeCalendarConnectionResult(rsp *http.Response) (*ReauthorizeCalendarConnectionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReauthorizeCalendarConnectionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthAuthorizeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResumeCalendarConnectionResult parses an HTTP response from a ResumeCalendarConnectionWithResponse call
+func ParseResumeCalendarConnectionResult(rsp *http.Response) (*ResumeCalendarConnectionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResumeCalendarConnectionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCalendarSourcesResult parses an HTTP response from a ListCalendarSourcesWithResponse call
+func ParseListCalendarSourcesResult(rsp *http.Response) (*ListCalendarSourcesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCalendarSourcesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Calendar
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCalendarSourcesResult parses an HTTP response from a UpdateCalendarSourcesWithResponse call
+func ParseUpdateCalendarSourcesResult(rsp *http.Response) (*UpdateCalendarSourcesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCalendarSourcesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Calendar
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCalendarSourceResult parses an HTTP response from a UpdateCalendarSourceWithResponse call
+func ParseUpdateCalendarSourceResult(rsp *http.Response) (*UpdateCalendarSourceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCalendarSourceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Calendar
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSyncCalendarResult parses an HTTP response from a SyncCalendarWithResponse call
+func ParseSyncCalendarResult(rsp *http.Response) (*SyncCalendarResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SyncCalendarResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCalendarSyncWindowResult parses an HTTP response from a UpdateCalendarSyncWindowWithResponse call
+func ParseUpdateCalendarSyncWindowResult(rsp *http.Response) (*UpdateCalendarSyncWindowResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCalendarSyncWindowResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCalendarWriteBackResult parses an HTTP response from a UpdateCalendarWriteBackWithResponse call
+func ParseUpdateCalendarWriteBackResult(rsp *http.Response) (*UpdateCalendarWriteBackResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCalendarWriteBackResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListChangesResult parses an HTTP response from a ListChangesWithResponse call
+func ParseListChangesResult(rsp *http.Response) (*ListChangesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListChangesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChangeFeedPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApplyRulesAsyncResult parses an HTTP response from a ApplyRulesAsyncWithResponse call
+func ParseApplyRulesAsyncResult(rsp *http.Response) (*ApplyRulesAsyncResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApplyRulesAsyncResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClassificationJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClassificationJobResult parses an HTTP response from a GetClassificationJobWithResponse call
+func ParseGetClassificationJobResult(rsp *http.Response) (*GetClassificationJobResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClassificationJobResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassificationJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClientRatesResult parses an HTTP response from a ListClientRatesWithResponse call
+func ParseListClientRatesResult(rsp *http.Response) (*ListClientRatesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClientRatesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClientRate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClientRateResult parses an HTTP response from a CreateClientRateWithResponse call
+func ParseCreateClientRateResult(rsp *http.Response) (*CreateClientRateResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClientRateResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClientRate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClientRateResult parses an HTTP response from a DeleteClientRateWithResponse call
+func ParseDeleteClientRateResult(rsp *http.Response) (*DeleteClientRateResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClientRateResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClientRateResult parses an HTTP response from a UpdateClientRateWithResponse call
+func ParseUpdateClientRateResult(rsp *http.Response) (*UpdateClientRateResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClientRateResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientRate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportConfigResult parses an HTTP response from a ExportConfigWithResponse call
+func ParseExportConfigResult(rsp *http.Response) (*ExportConfigResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportConfigResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportConfigResult parses an HTTP response from a ImportConfigWithResponse call
+func ParseImportConfigResult(rsp *http.Response) (*ImportConfigResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportConfigResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDaySummaryResult parses an HTTP response from a GetDaySummaryWithResponse call
+func ParseGetDaySummaryResult(rsp *http.Response) (*GetDaySummaryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDaySummaryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DaySummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExpensesResult parses an HTTP response from a ListExpensesWithResponse call
+func ParseListExpensesResult(rsp *http.Response) (*ListExpensesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExpensesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Expense
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateExpenseResult parses an HTTP response from a CreateExpenseWithResponse call
+func ParseCreateExpenseResult(rsp *http.Response) (*CreateExpenseResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateExpenseResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Expense
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteExpenseResult parses an HTTP response from a DeleteExpenseWithResponse call
+func ParseDeleteExpenseResult(rsp *http.Response) (*DeleteExpenseResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteExpenseResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExpenseResult parses an HTTP response from a GetExpenseWithResponse call
+func ParseGetExpenseResult(rsp *http.Response) (*GetExpenseResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExpenseResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Expense
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateExpenseResult parses an HTTP response from a UpdateExpenseWithResponse call
+func ParseUpdateExpenseResult(rsp *http.Response) (*UpdateExpenseResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateExpenseResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Expense
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFingerprintClaimsResult parses an HTTP response from a ListFingerprintClaimsWithResponse call
+func ParseListFingerprintClaimsResult(rsp *http.Response) (*ListFingerprintClaimsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFingerprintClaimsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FingerprintClaim
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogFocusSessionResult parses an HTTP response from a LogFocusSessionWithResponse call
+func ParseLogFocusSessionResult(rsp *http.Response) (*LogFocusSessionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogFocusSessionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FocusSessionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRecordFocusGapsResult parses an HTTP response from a RecordFocusGapsWithResponse call
+func ParseRecordFocusGapsResult(rsp *http.Response) (*RecordFocusGapsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RecordFocusGapsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FocusGapsResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListGoalsResult parses an HTTP response from a ListGoalsWithResponse call
+func ParseListGoalsResult(rsp *http.Response) (*ListGoalsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListGoalsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []HourGoal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateGoalResult parses an HTTP response from a CreateGoalWithResponse call
+func ParseCreateGoalResult(rsp *http.Response) (*CreateGoalResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateGoalResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HourGoal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGoalsProgressResult parses an HTTP response from a GetGoalsProgressWithResponse call
+func ParseGetGoalsProgressResult(rsp *http.Response) (*GetGoalsProgressResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGoalsProgressResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []GoalProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteGoalResult parses an HTTP response from a DeleteGoalWithResponse call
+func ParseDeleteGoalResult(rsp *http.Response) (*DeleteGoalResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteGoalResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateGoalResult parses an HTTP response from a UpdateGoalWithResponse call
+func ParseUpdateGoalResult(rsp *http.Response) (*UpdateGoalResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateGoalResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HourGoal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIntegrationsResult parses an HTTP response from a ListIntegrationsWithResponse call
+func ParseListIntegrationsResult(rsp *http.Response) (*ListIntegrationsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIntegrationsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IntegrationConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisconnectGitHubResult parses an HTTP response from a DisconnectGitHubWithResponse call
+func ParseDisconnectGitHubResult(rsp *http.Response) (*DisconnectGitHubResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisconnectGitHubResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetGitHubConnectionResult parses an HTTP response from a GetGitHubConnectionWithResponse call
+func ParseGetGitHubConnectionResult(rsp *http.Response) (*GetGitHubConnectionResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGitHubConnectionResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GitHubConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConnectGitHubResult parses an HTTP response from a ConnectGitHubWithResponse call
+func ParseConnectGitHubResult(rsp *http.Response) (*ConnectGitHubResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConnectGitHubResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GitHubConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisconnectIntegrationResult parses an HTTP response from a DisconnectIntegrationWithResponse call
+func ParseDisconnectIntegrationResult(rsp *http.Response) (*DisconnectIntegrationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisconnectIntegrationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConnectIntegrationResult parses an HTTP response from a ConnectIntegrationWithResponse call
+func ParseConnectIntegrationResult(rsp *http.Response) (*ConnectIntegrationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConnectIntegrationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IntegrationConnection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadInvoiceExportResult parses an HTTP response from a DownloadInvoiceExportWithResponse call
+func ParseDownloadInvoiceExportResult(rsp *http.Response) (*DownloadInvoiceExportResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadInvoiceExportResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInvoicesResult parses an HTTP response from a ListInvoicesWithResponse call
+func ParseListInvoicesResult(rsp *http.Response) (*ListInvoicesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInvoicesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInvoiceResult parses an HTTP response from a CreateInvoiceWithResponse call
+func ParseCreateInvoiceResult(rsp *http.Response) (*CreateInvoiceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInvoiceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInvoiceResult parses an HTTP response from a DeleteInvoiceWithResponse call
+func ParseDeleteInvoiceResult(rsp *http.Response) (*DeleteInvoiceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInvoiceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInvoiceResult parses an HTTP response from a GetInvoiceWithResponse call
+func ParseGetInvoiceResult(rsp *http.Response) (*GetInvoiceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInvoiceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddInvoiceAdjustmentResult parses an HTTP response from a AddInvoiceAdjustmentWithResponse call
+func ParseAddInvoiceAdjustmentResult(rsp *http.Response) (*AddInvoiceAdjustmentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddInvoiceAdjustmentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInvoiceAdjustmentResult parses an HTTP response from a DeleteInvoiceAdjustmentWithResponse call
+func ParseDeleteInvoiceAdjustmentResult(rsp *http.Response) (*DeleteInvoiceAdjustmentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInvoiceAdjustmentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInvoiceCommentsResult parses an HTTP response from a ListInvoiceCommentsWithResponse call
+func ParseListInvoiceCommentsResult(rsp *http.Response) (*ListInvoiceCommentsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInvoiceCommentsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InvoiceComment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddInvoiceCommentResult parses an HTTP response from a AddInvoiceCommentWithResponse call
+func ParseAddInvoiceCommentResult(rsp *http.Response) (*AddInvoiceCommentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddInvoiceCommentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InvoiceComment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInvoiceCommentResult parses an HTTP response from a DeleteInvoiceCommentWithResponse call
+func ParseDeleteInvoiceCommentResult(rsp *http.Response) (*DeleteInvoiceCommentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInvoiceCommentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCreditNoteResult parses an HTTP response from a CreateCreditNoteWithResponse call
+func ParseCreateCreditNoteResult(rsp *http.Response) (*CreateCreditNoteResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCreditNoteResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportInvoiceResult parses an HTTP response from a ExportInvoiceWithResponse call
+func ParseExportInvoiceResult(rsp *http.Response) (*ExportInvoiceResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportInvoiceResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportInvoiceCSVResult parses an HTTP response from a ExportInvoiceCSVWithResponse call
+func ParseExportInvoiceCSVResult(rsp *http.Response) (*ExportInvoiceCSVResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportInvoiceCSVResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportInvoiceSheetsResult parses an HTTP response from a ExportInvoiceSheetsWithResponse call
+func ParseExportInvoiceSheetsResult(rsp *http.Response) (*ExportInvoiceSheetsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportInvoiceSheetsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			SpreadsheetId  *string `json:"spreadsheet_id,omitempty"`
+			SpreadsheetUrl *string `json:"spreadsheet_url,omitempty"`
+			WorksheetId    *int    `json:"worksheet_id,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInvoiceExportsResult parses an HTTP response from a ListInvoiceExportsWithResponse call
+func ParseListInvoiceExportsResult(rsp *http.Response) (*ListInvoiceExportsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInvoiceExportsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InvoiceExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInvoicePaymentsResult parses an HTTP response from a ListInvoicePaymentsWithResponse call
+func ParseListInvoicePaymentsResult(rsp *http.Response) (*ListInvoicePaymentsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInvoicePaymentsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Payment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRecordInvoicePaymentResult parses an HTTP response from a RecordInvoicePaymentWithResponse call
+func ParseRecordInvoicePaymentResult(rsp *http.Response) (*RecordInvoicePaymentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RecordInvoicePaymentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Payment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateInvoiceStatusResult parses an HTTP response from a UpdateInvoiceStatusWithResponse call
+func ParseUpdateInvoiceStatusResult(rsp *http.Response) (*UpdateInvoiceStatusResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateInvoiceStatusResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Invoice
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganizationsResult parses an HTTP response from a ListOrganizationsWithResponse call
+func ParseListOrganizationsResult(rsp *http.Response) (*ListOrganizationsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizationsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrganizationResult parses an HTTP response from a CreateOrganizationWithResponse call
+func ParseCreateOrganizationResult(rsp *http.Response) (*CreateOrganizationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrganizationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationResult parses an HTTP response from a GetOrganizationWithResponse call
+func ParseGetOrganizationResult(rsp *http.Response) (*GetOrganizationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOrganizationDomainResult parses an HTTP response from a DeleteOrganizationDomainWithResponse call
+func ParseDeleteOrganizationDomainResult(rsp *http.Response) (*DeleteOrganizationDomainResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOrganizationDomainResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutOrganizationDomainResult parses an HTTP response from a PutOrganizationDomainWithResponse call
+func ParsePutOrganizationDomainResult(rsp *http.Response) (*PutOrganizationDomainResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutOrganizationDomainResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationDomain
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyOrganizationDomainResult parses an HTTP response from a VerifyOrganizationDomainWithResponse call
+func ParseVerifyOrganizationDomainResult(rsp *http.Response) (*VerifyOrganizationDomainResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyOrganizationDomainResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationDomain
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrganizationGoogleDelegationResult parses an HTTP response from a UpdateOrganizationGoogleDelegationWithResponse call
+func ParseUpdateOrganizationGoogleDelegationResult(rsp *http.Response) (*UpdateOrganizationGoogleDelegationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrganizationGoogleDelegationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProvisionOrganizationCalendarsResult parses an HTTP response from a ProvisionOrganizationCalendarsWithResponse call
+func ParseProvisionOrganizationCalendarsResult(rsp *http.Response) (*ProvisionOrganizationCalendarsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProvisionOrganizationCalendarsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DelegationProvisionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOrganizationMembersResult parses an HTTP response from a ListOrganizationMembersWithResponse call
+func ParseListOrganizationMembersResult(rsp *http.Response) (*ListOrganizationMembersResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOrganizationMembersResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OrganizationMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrganizationMemberDelegationResult parses an HTTP response from a UpdateOrganizationMemberDelegationWithResponse call
+func ParseUpdateOrganizationMemberDelegationResult(rsp *http.Response) (*UpdateOrganizationMemberDelegationResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrganizationMemberDelegationResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransferOrganizationProjectResult parses an HTTP response from a TransferOrganizationProjectWithResponse call
+func ParseTransferOrganizationProjectResult(rsp *http.Response) (*TransferOrganizationProjectResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferOrganizationProjectResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRotateOrganizationScimTokenResult parses an HTTP response from a RotateOrganizationScimTokenWithResponse call
+func ParseRotateOrganizationScimTokenResult(rsp *http.Response) (*RotateOrganizationScimTokenResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RotateOrganizationScimTokenResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SCIMToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrganizationSsoResult parses an HTTP response from a UpdateOrganizationSsoWithResponse call
+func ParseUpdateOrganizationSsoResult(rsp *http.Response) (*UpdateOrganizationSsoResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrganizationSsoResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePaymentResult parses an HTTP response from a DeletePaymentWithResponse call
+func ParseDeletePaymentResult(rsp *http.Response) (*DeletePaymentResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePaymentResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectsResult parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResult(rsp *http.Response) (*ListProjectsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectResult parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResult(rsp *http.Response) (*CreateProjectResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteProjectResult parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResult(rsp *http.Response) (*DeleteProjectResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteProjectResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectResult parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResult(rsp *http.Response) (*GetProjectResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectResult parses an HTTP response from a UpdateProjectWithResponse call
+func ParseUpdateProjectResult(rsp *http.Response) (*UpdateProjectResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddProjectFingerprintResult parses an HTTP response from a AddProjectFingerprintWithResponse call
+func ParseAddProjectFingerprintResult(rsp *http.Response) (*AddProjectFingerprintResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddProjectFingerprintResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveProjectFingerprintResult parses an HTTP response from a RemoveProjectFingerprintWithResponse call
+func ParseRemoveProjectFingerprintResult(rsp *http.Response) (*RemoveProjectFingerprintResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveProjectFingerprintResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeetingCostReportResult parses an HTTP response from a GetMeetingCostReportWithResponse call
+func ParseGetMeetingCostReportResult(rsp *http.Response) (*GetMeetingCostReportResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeetingCostReportResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeetingCostReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeetingLoadReportResult parses an HTTP response from a GetMeetingLoadReportWithResponse call
+func ParseGetMeetingLoadReportResult(rsp *http.Response) (*GetMeetingLoadReportResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeetingLoadReportResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeetingLoadReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUtilizationReportResult parses an HTTP response from a GetUtilizationReportWithResponse call
+func ParseGetUtilizationReportResult(rsp *http.Response) (*GetUtilizationReportResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUtilizationReportResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UtilizationReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportRulePackResult parses an HTTP response from a ExportRulePackWithResponse call
+func ParseExportRulePackResult(rsp *http.Response) (*ExportRulePackResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportRulePackResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RulePack
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportRulePackResult parses an HTTP response from a ImportRulePackWithResponse call
+func ParseImportRulePackResult(rsp *http.Response) (*ImportRulePackResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportRulePackResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RulePackImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRulesResult parses an HTTP response from a ListRulesWithResponse call
+func ParseListRulesResult(rsp *http.Response) (*ListRulesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRulesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClassificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRuleResult parses an HTTP response from a CreateRuleWithResponse call
+func ParseCreateRuleResult(rsp *http.Response) (*CreateRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClassificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApplyRulesResult parses an HTTP response from a ApplyRulesWithResponse call
+func ParseApplyRulesResult(rsp *http.Response) (*ApplyRulesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApplyRulesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApplyRulesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRuleConflictsResult parses an HTTP response from a GetRuleConflictsWithResponse call
+func ParseGetRuleConflictsResult(rsp *http.Response) (*GetRuleConflictsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRuleConflictsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RuleConflictReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLintRuleResult parses an HTTP response from a LintRuleWithResponse call
+func ParseLintRuleResult(rsp *http.Response) (*LintRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LintRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RuleLintResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewRuleResult parses an HTTP response from a PreviewRuleWithResponse call
+func ParsePreviewRuleResult(rsp *http.Response) (*PreviewRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RulePreviewResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTestRuleResult parses an HTTP response from a TestRuleWithResponse call
+func ParseTestRuleResult(rsp *http.Response) (*TestRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TestRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RuleTestResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRuleResult parses an HTTP response from a DeleteRuleWithResponse call
+func ParseDeleteRuleResult(rsp *http.Response) (*DeleteRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRuleResult parses an HTTP response from a GetRuleWithResponse call
+func ParseGetRuleResult(rsp *http.Response) (*GetRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRuleResult parses an HTTP response from a UpdateRuleWithResponse call
+func ParseUpdateRuleResult(rsp *http.Response) (*UpdateRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClassificationSnapshotsResult parses an HTTP response from a ListClassificationSnapshotsWithResponse call
+func ParseListClassificationSnapshotsResult(rsp *http.Response) (*ListClassificationSnapshotsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClassificationSnapshotsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClassificationSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClassificationSnapshotResult parses an HTTP response from a CreateClassificationSnapshotWithResponse call
+func ParseCreateClassificationSnapshotResult(rsp *http.Response) (*CreateClassificationSnapshotResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClassificationSnapshotResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClassificationSnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClassificationSnapshotResult parses an HTTP response from a DeleteClassificationSnapshotWithResponse call
+func ParseDeleteClassificationSnapshotResult(rsp *http.Response) (*DeleteClassificationSnapshotResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClassificationSnapshotResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreClassificationSnapshotResult parses an HTTP response from a RestoreClassificationSnapshotWithResponse call
+func ParseRestoreClassificationSnapshotResult(rsp *http.Response) (*RestoreClassificationSnapshotResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreClassificationSnapshotResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RestoreSnapshotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSuppressionRulesResult parses an HTTP response from a ListSuppressionRulesWithResponse call
+func ParseListSuppressionRulesResult(rsp *http.Response) (*ListSuppressionRulesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSuppressionRulesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SuppressionRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSuppressionRuleResult parses an HTTP response from a CreateSuppressionRuleWithResponse call
+func ParseCreateSuppressionRuleResult(rsp *http.Response) (*CreateSuppressionRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSuppressionRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SuppressionRuleResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSuppressionRuleResult parses an HTTP response from a DeleteSuppressionRuleWithResponse call
+func ParseDeleteSuppressionRuleResult(rsp *http.Response) (*DeleteSuppressionRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSuppressionRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSuppressionRuleResult parses an HTTP response from a UpdateSuppressionRuleWithResponse call
+func ParseUpdateSuppressionRuleResult(rsp *http.Response) (*UpdateSuppressionRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSuppressionRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuppressionRuleResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTagsResult parses an HTTP response from a ListTagsWithResponse call
+func ParseListTagsResult(rsp *http.Response) (*ListTagsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTagsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTagResult parses an HTTP response from a CreateTagWithResponse call
+func ParseCreateTagResult(rsp *http.Response) (*CreateTagResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTagResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTagResult parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResult(rsp *http.Response) (*DeleteTagResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTagResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRenameTagResult parses an HTTP response from a RenameTagWithResponse call
+func ParseRenameTagResult(rsp *http.Response) (*RenameTagResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RenameTagResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTimeEntriesResult parses an HTTP response from a ListTimeEntriesWithResponse call
+func ParseListTimeEntriesResult(rsp *http.Response) (*ListTimeEntriesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTimeEntriesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTimeEntryResult parses an HTTP response from a CreateTimeEntryWithResponse call
+func ParseCreateTimeEntryResult(rsp *http.Response) (*CreateTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSuggestDescriptionsResult parses an HTTP response from a SuggestDescriptionsWithResponse call
+func ParseSuggestDescriptionsResult(rsp *http.Response) (*SuggestDescriptionsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SuggestDescriptionsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DescriptionSuggestion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseParseTimeEntryResult parses an HTTP response from a ParseTimeEntryWithResponse call
+func ParseParseTimeEntryResult(rsp *http.Response) (*ParseTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ParseTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntryDraft
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRecalculateTimeEntriesResult parses an HTTP response from a RecalculateTimeEntriesWithResponse call
+func ParseRecalculateTimeEntriesResult(rsp *http.Response) (*RecalculateTimeEntriesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RecalculateTimeEntriesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest RecalculationJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecalculationJobResult parses an HTTP response from a GetRecalculationJobWithResponse call
+func ParseGetRecalculationJobResult(rsp *http.Response) (*GetRecalculationJobResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecalculationJobResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecalculationJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetReconciliationReportResult parses an HTTP response from a GetReconciliationReportWithResponse call
+func ParseGetReconciliationReportResult(rsp *http.Response) (*GetReconciliationReportResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReconciliationReportResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReconciliationReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTimeEntryResult parses an HTTP response from a DeleteTimeEntryWithResponse call
+func ParseDeleteTimeEntryResult(rsp *http.Response) (*DeleteTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTimeEntryResult parses an HTTP response from a GetTimeEntryWithResponse call
+func ParseGetTimeEntryResult(rsp *http.Response) (*GetTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTimeEntryResult parses an HTTP response from a UpdateTimeEntryWithResponse call
+func ParseUpdateTimeEntryResult(rsp *http.Response) (*UpdateTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTimeEntryBreakdownResult parses an HTTP response from a GetTimeEntryBreakdownWithResponse call
+func ParseGetTimeEntryBreakdownResult(rsp *http.Response) (*GetTimeEntryBreakdownResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTimeEntryBreakdownResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntryBreakdown
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExplainTimeEntryResult parses an HTTP response from a ExplainTimeEntryWithResponse call
+func ParseExplainTimeEntryResult(rsp *http.Response) (*ExplainTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExplainTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntryExplanation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTimeEntryNotesResult parses an HTTP response from a ListTimeEntryNotesWithResponse call
+func ParseListTimeEntryNotesResult(rsp *http.Response) (*ListTimeEntryNotesResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTimeEntryNotesResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntryNote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddTimeEntryNoteResult parses an HTTP response from a AddTimeEntryNoteWithResponse call
+func ParseAddTimeEntryNoteResult(rsp *http.Response) (*AddTimeEntryNoteResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddTimeEntryNoteResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TimeEntryNote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTimeEntryNoteResult parses an HTTP response from a DeleteTimeEntryNoteWithResponse call
+func ParseDeleteTimeEntryNoteResult(rsp *http.Response) (*DeleteTimeEntryNoteResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTimeEntryNoteResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReconcileTimeEntryResult parses an HTTP response from a ReconcileTimeEntryWithResponse call
+func ParseReconcileTimeEntryResult(rsp *http.Response) (*ReconcileTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReconcileTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshTimeEntryResult parses an HTTP response from a RefreshTimeEntryWithResponse call
+func ParseRefreshTimeEntryResult(rsp *http.Response) (*RefreshTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSplitTimeEntryResult parses an HTTP response from a SplitTimeEntryWithResponse call
+func ParseSplitTimeEntryResult(rsp *http.Response) (*SplitTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SplitTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTimeEntryTagsResult parses an HTTP response from a SetTimeEntryTagsWithResponse call
+func ParseSetTimeEntryTagsResult(rsp *http.Response) (*SetTimeEntryTagsResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTimeEntryTagsResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTrashResult parses an HTTP response from a ListTrashWithResponse call
+func ParseListTrashResult(rsp *http.Response) (*ListTrashResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTrashResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Trash
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreRuleResult parses an HTTP response from a RestoreRuleWithResponse call
+func ParseRestoreRuleResult(rsp *http.Response) (*RestoreRuleResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreRuleResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreTimeEntryResult parses an HTTP response from a RestoreTimeEntryWithResponse call
+func ParseRestoreTimeEntryResult(rsp *http.Response) (*RestoreTimeEntryResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreTimeEntryResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TimeEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWeekGridResult parses an HTTP response from a GetWeekGridWithResponse call
+func ParseGetWeekGridResult(rsp *http.Response) (*GetWeekGridResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWeekGridResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WeekGrid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWorkingHoursResult parses an HTTP response from a GetWorkingHoursWithResponse call
+func ParseGetWorkingHoursResult(rsp *http.Response) (*GetWorkingHoursResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWorkingHoursResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkingHours
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWorkingHoursResult parses an HTTP response from a UpdateWorkingHoursWithResponse call
+func ParseUpdateWorkingHoursResult(rsp *http.Response) (*UpdateWorkingHoursResult, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWorkingHoursResult{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkingHours
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// IdempotencyKeyHeader names the header that makes a retried create safe:
+// the server replays its first response instead of creating twice
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// New returns a client for the Timesheet API at server that authenticates
+// with an API key (ts_...) or a JWT. Transient failures are retried with
+// DefaultRetryPolicy unless opts include WithRetries.
+func New(server, token string, opts ...ClientOption) (*ClientWithResponses, error) {
+	opts = append([]ClientOption{WithAPIKey(token)}, opts...)
+	opts = append(opts, defaultRetries)
+	return NewClientWithResponses(server, opts...)
+}
+
+// WithAPIKey authenticates every request with an API key or JWT
+func WithAPIKey(token string) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// IdempotencyKey sets the Idempotency-Key header on one request, so it can
+// be retried without being applied twice
+func IdempotencyKey(key string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(IdempotencyKeyHeader, key)
+		return nil
+	}
+}
+
+// APIError is an error response from the API
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Details    map[string]interface{}
+}
+
+func (e *APIError) Error() string {
+	status := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message == "" {
+		return status
+	}
+	return status + ": " + e.Message
+}
+
+// CheckResponse returns an *APIError for a response outside the 2xx range,
+// with the code and message from its body when it has them
+func CheckResponse(resp *http.Response, body []byte) error {
+	if resp == nil {
+		return errors.New("no response")
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	var e Error
+	if json.Unmarshal(body, &e) == nil {
+		apiErr.Code, apiErr.Message = e.Code, e.Message
+		if e.Details != nil {
+			apiErr.Details = *e.Details
+		}
+	}
+	return apiErr
+}
+
+// Decoded checks a response and returns its decoded body, one of the
+// result's JSON fields for a success status:
+//
+//	res, err := c.ListProjectsWithResponse(ctx, nil)
+//	if err != nil {
+//		return err
+//	}
+//	projects, err := client.Decoded(res.HTTPResponse, res.Body, res.JSON200)
+func Decoded[T any](resp *http.Response, body []byte, v *T) (T, error) {
+	var zero T
+	if err := CheckResponse(resp, body); err != nil {
+		return zero, err
+	}
+	if v == nil {
+		return zero, fmt.Errorf("unexpected %d response with content type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	return *v, nil
+}
+
+// IsNotFound reports whether err is an API error with status 404
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var fastRetries = RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...ClientOption) *ClientWithResponses {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	c, err := New(srv.URL, "ts_test", append([]ClientOption{WithHTTPClient(srv.Client())}, opts...)...)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestNew_SendsAPIKey(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ts_test" {
+			writeJSON(w, http.StatusUnauthorized, Error{Code: "unauthorized", Message: "Authentication required"})
+			return
+		}
+		writeJSON(w, http.StatusOK, []Project{})
+	})
+
+	res, err := c.ListProjectsWithResponse(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Decoded(res.HTTPResponse, res.Body, res.JSON200); err != nil {
+		t.Errorf("expected the API key to authenticate: %v", err)
+	}
+}
+
+func TestDecoded_APIError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, Error{Code: "not_found", Message: "Project not found"})
+	})
+
+	res, err := c.GetProjectWithResponse(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *APIError, got %v", err)
+	}
+	if apiErr.Code != "not_found" || apiErr.Message != "Project not found" {
+		t.Errorf("unexpected error %+v", apiErr)
+	}
+	if got, want := err.Error(), "404 Not Found: Project not found"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !IsNotFound(err) {
+		t.Error("expected IsNotFound")
+	}
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		status   int
+		key      bool
+		attempts int32
+	}{
+		{"GET after 503", http.MethodGet, http.StatusServiceUnavailable, false, 3},
+		{"GET after 500", http.MethodGet, http.StatusInternalServerError, false, 1},
+		{"POST after 429", http.MethodPost, http.StatusTooManyRequests, false, 3},
+		{"POST after 503", http.MethodPost, http.StatusServiceUnavailable, false, 1},
+		{"POST with key after 503", http.MethodPost, http.StatusServiceUnavailable, true, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				if r.Method == http.MethodPost {
+					if body, _ := io.ReadAll(r.Body); len(body) == 0 {
+						t.Error("expected the body on every attempt")
+					}
+				}
+				w.Header().Set("Retry-After", "0")
+				writeJSON(w, tt.status, Error{Code: "unavailable", Message: "Try again"})
+			}, WithRetries(fastRetries))
+
+			ctx := context.Background()
+			var editors []RequestEditorFn
+			if tt.key {
+				editors = append(editors, IdempotencyKey(uuid.NewString()))
+			}
+			var err error
+			if tt.method == http.MethodGet {
+				_, err = c.ListProjects(ctx, nil, editors...)
+			} else {
+				_, err = c.CreateProject(ctx, ProjectCreate{Name: "Acme"}, editors...)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := attempts.Load(); got != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, got)
+			}
+		})
+	}
+}
+
+func TestRetries_Succeeds(t *testing.T) {
+	var attempts atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			writeJSON(w, http.StatusBadGateway, Error{Code: "bad_gateway", Message: "Upstream failed"})
+			return
+		}
+		writeJSON(w, http.StatusOK, []Project{{Name: "Acme"}})
+	}, WithRetries(fastRetries))
+
+	res, err := c.ListProjectsWithResponse(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, err := Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	if err != nil || len(projects) != 1 {
+		t.Errorf("expected the retry to succeed, got %v, %v", projects, err)
+	}
+}
+
+func TestAllProjects(t *testing.T) {
+	var names []string
+	for i := range 5 {
+		names = append(names, "Project "+strconv.Itoa(i))
+	}
+	var requests int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		page := []Project{}
+		for i := offset; i < len(names) && i < offset+limit; i++ {
+			page = append(page, Project{Name: names[i]})
+		}
+		writeJSON(w, http.StatusOK, page)
+	})
+
+	limit := 2
+	var got []string
+	for p, err := range c.AllProjects(context.Background(), &ListProjectsParams{Limit: &limit}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, p.Name)
+	}
+	if len(got) != len(names) {
+		t.Fatalf("expected %d projects, got %v", len(names), got)
+	}
+	for i := range names {
+		if got[i] != names[i] {
+			t.Errorf("project %d = %q, want %q", i, got[i], names[i])
+		}
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 pages, got %d", requests)
+	}
+}
+
+func TestChangesSince(t *testing.T) {
+	pages := map[string]ChangeFeedPage{
+		"":   {Changes: []ChangeFeedEntry{{ResourceType: "time_entry"}}, NextCursor: "c1", HasMore: true},
+		"c1": {Changes: []ChangeFeedEntry{{ResourceType: "invoice"}}, NextCursor: "c2"},
+	}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Query().Get("since")]
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, Error{Code: "invalid_cursor", Message: "Invalid cursor"})
+			return
+		}
+		writeJSON(w, http.StatusOK, page)
+	})
+
+	changes, cursor, err := c.ChangesSince(context.Background(), "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 || cursor != "c2" {
+		t.Errorf("expected 2 changes up to c2, got %d up to %q", len(changes), cursor)
+	}
+
+	if _, cursor, err := c.ChangesSince(context.Background(), "bogus", nil); err == nil || cursor != "bogus" {
+		t.Errorf("expected an invalid cursor to fail and be kept, got %q, %v", cursor, err)
+	}
+}
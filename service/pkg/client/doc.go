@@ -0,0 +1,24 @@
+// Package client is a Go SDK for the Timesheet API.
+//
+// The request and response types and the client methods are generated from
+// the OpenAPI spec (docs/v2/api-spec.yaml) into client.gen.go; regenerate
+// with `make generate-client`. The rest of the package adds what scripts
+// need on top: API key authentication, retries of transient failures,
+// paging through lists and the change feed, and errors carrying the API's
+// code and message.
+//
+//	c, err := client.New("https://timesheet.example.com", os.Getenv("TIMESHEET_API_KEY"))
+//	if err != nil {
+//		return err
+//	}
+//	for project, err := range c.AllProjects(ctx, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(project.Name)
+//	}
+//
+// Every generated method has a WithResponse variant returning the decoded
+// body for each documented status. Decoded returns the success body, or an
+// *APIError for an error status.
+package client
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultPageSize is how many items each request fetches when paging
+const DefaultPageSize = 100
+
+// PageFunc fetches up to limit items of a list, skipping the first offset
+type PageFunc[T any] func(ctx context.Context, limit, offset int) ([]T, error)
+
+// Pages yields every item of a list paged with limit and offset, fetching
+// pageSize items at a time until a page comes back short. The first error
+// is yielded and ends the sequence.
+func Pages[T any](ctx context.Context, pageSize int, fetch PageFunc[T]) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return func(yield func(T, error) bool) {
+		for offset := 0; ; offset += pageSize {
+			page, err := fetch(ctx, pageSize, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(page) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+// AllProjects yields every project the filters in params match, paging
+// through them. The params' Limit sets the page size; Offset is ignored.
+func (c *ClientWithResponses) AllProjects(ctx context.Context, params *ListProjectsParams) iter.Seq2[Project, error] {
+	p := ListProjectsParams{}
+	if params != nil {
+		p = *params
+	}
+	pageSize := DefaultPageSize
+	if p.Limit != nil {
+		pageSize = *p.Limit
+	}
+	return Pages(ctx, pageSize, func(ctx context.Context, limit, offset int) ([]Project, error) {
+		p.Limit, p.Offset = &limit, &offset
+		res, err := c.ListProjectsWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		return Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	})
+}
+
+// AllRules yields every classification rule the filters in params match,
+// paging through them. The params' Limit sets the page size; Offset is
+// ignored.
+func (c *ClientWithResponses) AllRules(ctx context.Context, params *ListRulesParams) iter.Seq2[ClassificationRule, error] {
+	p := ListRulesParams{}
+	if params != nil {
+		p = *params
+	}
+	pageSize := DefaultPageSize
+	if p.Limit != nil {
+		pageSize = *p.Limit
+	}
+	return Pages(ctx, pageSize, func(ctx context.Context, limit, offset int) ([]ClassificationRule, error) {
+		p.Limit, p.Offset = &limit, &offset
+		res, err := c.ListRulesWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		return Decoded(res.HTTPResponse, res.Body, res.JSON200)
+	})
+}
+
+// ChangesSince fetches every change after the since cursor, following
+// has_more, and returns them with the cursor to poll from next. An empty
+// since starts from the oldest retained change.
+func (c *ClientWithResponses) ChangesSince(ctx context.Context, since string, params *ListChangesParams) ([]ChangeFeedEntry, string, error) {
+	p := ListChangesParams{}
+	if params != nil {
+		p = *params
+	}
+	var changes []ChangeFeedEntry
+	for {
+		p.Since = nil
+		if since != "" {
+			p.Since = &since
+		}
+		res, err := c.ListChangesWithResponse(ctx, &p)
+		if err != nil {
+			return changes, since, err
+		}
+		page, err := Decoded(res.HTTPResponse, res.Body, res.JSON200)
+		if err != nil {
+			return changes, since, err
+		}
+		changes = append(changes, page.Changes...)
+		since = page.NextCursor
+		if !page.HasMore {
+			return changes, since, nil
+		}
+	}
+}
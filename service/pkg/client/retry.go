@@ -0,0 +1,128 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides how often and how long to wait before retrying a
+// request that failed in a way that may pass on its own
+type RetryPolicy struct {
+	MaxAttempts int           // Including the first; 1 or less never retries
+	MinBackoff  time.Duration // Wait before the first retry, doubled for each one after
+	MaxBackoff  time.Duration // Longest wait, including one asked for by Retry-After
+}
+
+// DefaultRetryPolicy makes up to three attempts over about two seconds
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, MinBackoff: 500 * time.Millisecond, MaxBackoff: 10 * time.Second}
+
+// WithRetries retries requests the server rejected with 429 Too Many
+// Requests, and safe requests that failed to connect or got a 502, 503 or
+// 504. Requests that aren't safe to repeat (POST, PATCH) are only retried
+// after those failures when they carry an Idempotency-Key. Pass it after
+// WithHTTPClient, whose client it wraps.
+func WithRetries(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if r, ok := c.Client.(*retrier); ok {
+			r.policy = policy
+			return nil
+		}
+		c.Client = &retrier{next: doer(c.Client), policy: policy}
+		return nil
+	}
+}
+
+// defaultRetries applies DefaultRetryPolicy unless WithRetries already set
+// a policy
+func defaultRetries(c *Client) error {
+	if _, ok := c.Client.(*retrier); ok {
+		return nil
+	}
+	return WithRetries(DefaultRetryPolicy)(c)
+}
+
+func doer(d HttpRequestDoer) HttpRequestDoer {
+	if d == nil {
+		return &http.Client{}
+	}
+	return d
+}
+
+type retrier struct {
+	next   HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (r *retrier) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := r.next.Do(req)
+		if attempt >= r.policy.MaxAttempts || !r.retryable(req, resp, err) {
+			return resp, err
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, berr := req.GetBody()
+			if berr != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+
+		wait := r.backoff(attempt, resp)
+		if resp != nil {
+			// Drain so the connection can be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (r *retrier) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		default:
+			return false
+		}
+	} else if err == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+// backoff doubles the wait for each attempt, or waits as long as the
+// response's Retry-After asks
+func (r *retrier) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := r.policy.MinBackoff << (attempt - 1)
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+			wait = time.Duration(secs) * time.Second
+		}
+	}
+	if r.policy.MaxBackoff > 0 && (wait > r.policy.MaxBackoff || wait < 0) {
+		wait = r.policy.MaxBackoff
+	}
+	return wait
+}
@@ -12,6 +12,7 @@ import type {
 	CalendarEvent,
 	SyncResult,
 	OAuthAuthorizeResponse,
+	ConnectionReauth,
 	ClassifyEventRequest,
 	ClassifyEventResponse,
 	UpdateCalendarSourcesRequest,
@@ -170,6 +171,22 @@ class ApiClient {
 		return this.request('DELETE', `/calendars/${id}`);
 	}
 
+	async listConnectionsNeedingReauth(): Promise<ConnectionReauth[]> {
+		return this.request('GET', '/calendars/needs-reauth');
+	}
+
+	async reauthorizeCalendarConnection(id: string): Promise<OAuthAuthorizeResponse> {
+		return this.request('POST', `/calendars/${id}/reauthorize`);
+	}
+
+	async pauseCalendarConnection(id: string): Promise<CalendarConnection> {
+		return this.request('POST', `/calendars/${id}/pause`);
+	}
+
+	async resumeCalendarConnection(id: string): Promise<CalendarConnection> {
+		return this.request('POST', `/calendars/${id}/resume`);
+	}
+
 	async syncCalendar(id: string, params?: { start_date?: string; end_date?: string }): Promise<SyncResult> {
 		const searchParams = new URLSearchParams();
 		if (params?.start_date) searchParams.set('start_date', params.start_date);
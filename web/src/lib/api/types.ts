@@ -119,8 +119,10 @@ export interface TimeEntryUpdate {
 export interface CalendarConnection {
 	id: string;
 	user_id: string;
-	provider: 'google';
+	// google_workspace connections are provisioned by an organization admin
+	provider: 'google' | 'google_workspace';
 	last_synced_at?: string | null;
+	paused_at?: string | null;
 	created_at: string;
 	updated_at?: string;
 }
@@ -179,6 +181,18 @@ export interface SyncResult {
 	events_orphaned: number;
 }
 
+export interface ReauthCalendar {
+	id: string;
+	name: string;
+	last_error?: string | null;
+}
+
+export interface ConnectionReauth {
+	connection_id: string;
+	provider: string;
+	calendars: ReauthCalendar[];
+}
+
 export interface OAuthAuthorizeResponse {
 	url: string;
 	state: string;